@@ -0,0 +1,44 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeAccounting       = "accounting"
+	routeAccountingIssuer = "accounting/issuers/"
+)
+
+// GetAccounting returns the messages and bytes that were issued per payload type and by the given number of issuers
+// that issued the most bytes within the given window. A window of zero requests the whole window of the node.
+func (api *GoShimmerAPI) GetAccounting(window time.Duration, topIssuers int) (*jsonmodels.AccountingResponse, error) {
+	res := &jsonmodels.AccountingResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s?top=%d%s", routeAccounting, topIssuers, windowQuery(window, "&")), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetAccountingIssuer returns the messages and bytes that were issued by the issuer with the given base58 encoded public
+// key within the given window. A window of zero requests the whole window of the node.
+func (api *GoShimmerAPI) GetAccountingIssuer(base58EncodedPublicKey string, window time.Duration) (*jsonmodels.AccountingIssuerResponse, error) {
+	res := &jsonmodels.AccountingIssuerResponse{}
+	if err := api.do(http.MethodGet, routeAccountingIssuer+base58EncodedPublicKey+windowQuery(window, "?"), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// windowQuery returns the query parameter of the given window with the given separator, or an empty string if the
+// window is zero.
+func windowQuery(window time.Duration, separator string) string {
+	if window == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%swindow=%s", separator, window)
+}
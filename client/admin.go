@@ -0,0 +1,137 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeAdminPlugins    = "admin/plugins"
+	pathStartPlugin      = "start"
+	pathStopPlugin       = "stop"
+	routeSchedulerBuffer = "admin/scheduler/buffer"
+	routeSchedulerPause  = "admin/scheduler/pause"
+	routeSchedulerResume = "admin/scheduler/resume"
+	routeSchedulerStep   = "admin/scheduler/step"
+	routeAdminBranches   = "admin/branches"
+	pathPreference       = "preference"
+	pathPreferences      = "preferences"
+	routeNeighborSizes   = "admin/autopeering/neighborsizes"
+)
+
+// GetRuntimePlugins returns the plugins that can be started and stopped while the node is running.
+func (api *GoShimmerAPI) GetRuntimePlugins() (*jsonmodels.RuntimePluginsResponse, error) {
+	res := &jsonmodels.RuntimePluginsResponse{}
+	if err := api.do(http.MethodGet, routeAdminPlugins, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StartPlugin starts the plugin with the given name while the node is running.
+func (api *GoShimmerAPI) StartPlugin(name string) (*jsonmodels.RuntimePluginResponse, error) {
+	res := &jsonmodels.RuntimePluginResponse{}
+	if err := api.do(http.MethodPost, fmt.Sprintf("%s/%s/%s", routeAdminPlugins, name, pathStartPlugin), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StopPlugin stops the plugin with the given name while the node is running.
+func (api *GoShimmerAPI) StopPlugin(name string) (*jsonmodels.RuntimePluginResponse, error) {
+	res := &jsonmodels.RuntimePluginResponse{}
+	if err := api.do(http.MethodPost, fmt.Sprintf("%s/%s/%s", routeAdminPlugins, name, pathStopPlugin), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PauseScheduler stops the scheduling of messages on the node until ResumeScheduler is called.
+func (api *GoShimmerAPI) PauseScheduler() (*jsonmodels.SchedulerStateResponse, error) {
+	res := &jsonmodels.SchedulerStateResponse{}
+	if err := api.do(http.MethodPost, routeSchedulerPause, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ResumeScheduler continues the scheduling of messages on the node.
+func (api *GoShimmerAPI) ResumeScheduler() (*jsonmodels.SchedulerStateResponse, error) {
+	res := &jsonmodels.SchedulerStateResponse{}
+	if err := api.do(http.MethodPost, routeSchedulerResume, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// StepScheduler schedules the next message while the scheduler of the node is paused.
+func (api *GoShimmerAPI) StepScheduler() (*jsonmodels.SchedulerStepResponse, error) {
+	res := &jsonmodels.SchedulerStepResponse{}
+	if err := api.do(http.MethodPost, routeSchedulerStep, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSchedulerBuffer returns the contents of the buffer of the scheduler of the node.
+func (api *GoShimmerAPI) GetSchedulerBuffer() (*jsonmodels.SchedulerBufferResponse, error) {
+	res := &jsonmodels.SchedulerBufferResponse{}
+	if err := api.do(http.MethodGet, routeSchedulerBuffer, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetBranchPreferences returns the branches whose preferences are overridden on the node.
+func (api *GoShimmerAPI) GetBranchPreferences() (*jsonmodels.BranchPreferencesResponse, error) {
+	res := &jsonmodels.BranchPreferencesResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%s", routeAdminBranches, pathPreferences), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetBranchPreference forces the node to like or dislike the given branch regardless of its approval weight.
+func (api *GoShimmerAPI) SetBranchPreference(base58EncodedBranchID string, preference otv.Preference) (*jsonmodels.BranchPreferenceResponse, error) {
+	res := &jsonmodels.BranchPreferenceResponse{}
+	if err := api.do(http.MethodPost, fmt.Sprintf("%s/%s/%s", routeAdminBranches, base58EncodedBranchID, pathPreference),
+		&jsonmodels.BranchPreferenceRequest{Preference: preference.String()}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RemoveBranchPreference removes the override of the preference of the given branch, so that its approval weight
+// decides again whether it is liked.
+func (api *GoShimmerAPI) RemoveBranchPreference(base58EncodedBranchID string) (*jsonmodels.BranchPreferenceResponse, error) {
+	res := &jsonmodels.BranchPreferenceResponse{}
+	if err := api.do(http.MethodDelete, fmt.Sprintf("%s/%s/%s", routeAdminBranches, base58EncodedBranchID, pathPreference), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetNeighborSizes returns the number of inbound and outbound neighbors of the autopeering of the node.
+func (api *GoShimmerAPI) GetNeighborSizes() (*jsonmodels.NeighborSizesResponse, error) {
+	res := &jsonmodels.NeighborSizesResponse{}
+	if err := api.do(http.MethodGet, routeNeighborSizes, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SetNeighborSizes changes the number of inbound and outbound neighbors of the autopeering of the node, which renews its
+// salts to rebalance the neighborhood.
+func (api *GoShimmerAPI) SetNeighborSizes(inboundNeighborSize, outboundNeighborSize int) (*jsonmodels.NeighborSizesResponse, error) {
+	res := &jsonmodels.NeighborSizesResponse{}
+	if err := api.do(http.MethodPost, routeNeighborSizes, &jsonmodels.NeighborSizesRequest{
+		InboundNeighborSize:  inboundNeighborSize,
+		OutboundNeighborSize: outboundNeighborSize,
+	}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
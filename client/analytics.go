@@ -0,0 +1,21 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeAnalytics = "metrics/analytics"
+)
+
+// GetAnalytics returns the rolling statistics about the Tangle, i.e. its width, the confirmation latency, the orphanage
+// rate and the conflict resolution time.
+func (api *GoShimmerAPI) GetAnalytics() (*jsonmodels.AnalyticsResponse, error) {
+	res := &jsonmodels.AnalyticsResponse{}
+	if err := api.do(http.MethodGet, routeAnalytics, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/anchor"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	routeAnchors = "anchors"
+)
+
+// GetAnchors returns the latest anchors of the designated issuers that the node received and the sync status of the
+// node that is estimated from them.
+func (api *GoShimmerAPI) GetAnchors() (*jsonmodels.AnchorsResponse, error) {
+	res := &jsonmodels.AnchorsResponse{}
+	if err := api.do(http.MethodGet, routeAnchors, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetVerifiedAnchors returns the latest anchors of the given issuers that the node received, after verifying their
+// signatures, so that the node doesn't need to be trusted. Anchors of other issuers are ignored, while a forged anchor
+// results in an error that wraps anchor.ErrInvalidSignature.
+func (api *GoShimmerAPI) GetVerifiedAnchors(issuers []ed25519.PublicKey) ([]*anchor.Anchor, error) {
+	res, err := api.GetAnchors()
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := anchor.NewTracker(issuers)
+	for _, jsonAnchor := range res.Anchors {
+		anchorBytes, err := base58.Decode(jsonAnchor.Bytes)
+		if err != nil {
+			return nil, errors.Errorf("failed to decode anchor of %s: %w", jsonAnchor.IssuerPublicKey, err)
+		}
+		anchorPayload, _, err := anchor.FromBytes(anchorBytes)
+		if err != nil {
+			return nil, err
+		}
+		messageID, err := tangle.NewMessageID(jsonAnchor.MessageID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = tracker.Add(anchorPayload, messageID); err != nil && !errors.Is(err, anchor.ErrUnknownIssuer) {
+			return nil, err
+		}
+	}
+
+	return tracker.Anchors(), nil
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/anchor"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestGoShimmerAPI_GetVerifiedAnchors(t *testing.T) {
+	issuer := identity.GenerateLocalIdentity()
+	otherIssuer := identity.GenerateLocalIdentity()
+	newAnchor := func(issuer *identity.LocalIdentity) *anchor.Anchor {
+		lastConfirmedMessage := tangle.LastConfirmedMessage{MessageID: tangle.EmptyMessageID, Time: time.Unix(1000, 0)}
+		return &anchor.Anchor{Payload: anchor.NewPayload(lastConfirmedMessage, time.Unix(1010, 0), issuer.PublicKey(), issuer.Sign)}
+	}
+	anchors := []*anchor.Anchor{newAnchor(issuer), newAnchor(otherIssuer)}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, contentTypeJSON)
+		_ = json.NewEncoder(w).Encode(jsonmodels.NewAnchorsResponse(anchors, time.Unix(990, 0), &anchor.SyncStatus{}))
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL)
+	verifiedAnchors, err := api.GetVerifiedAnchors([]ed25519.PublicKey{issuer.PublicKey()})
+	require.NoError(t, err)
+	require.Len(t, verifiedAnchors, 1)
+	assert.Equal(t, issuer.PublicKey(), verifiedAnchors[0].IssuerPublicKey)
+
+	// a forged anchor is detected
+	anchors[0].TangleTime = time.Unix(2000, 0)
+	_, err = api.GetVerifiedAnchors([]ed25519.PublicKey{issuer.PublicKey()})
+	assert.ErrorIs(t, err, anchor.ErrInvalidSignature)
+}
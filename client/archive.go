@@ -0,0 +1,32 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeArchiveAddresses = "archive/addresses/"
+	routeArchiveIssuers   = "archive/issuers/"
+)
+
+// GetAddressHistory returns the IDs of the messages that contain a transaction which spends from or sends to the given
+// address. It requires the node to run in archive mode.
+func (api *GoShimmerAPI) GetAddressHistory(base58EncodedAddress string) (*jsonmodels.ArchiveHistory, error) {
+	res := &jsonmodels.ArchiveHistory{}
+	if err := api.do(http.MethodGet, routeArchiveAddresses+base58EncodedAddress, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetIssuerHistory returns the IDs of the messages that were issued by the node with the given base58 encoded public
+// key. It requires the node to run in archive mode.
+func (api *GoShimmerAPI) GetIssuerHistory(base58EncodedPublicKey string) (*jsonmodels.ArchiveHistory, error) {
+	res := &jsonmodels.ArchiveHistory{}
+	if err := api.do(http.MethodGet, routeArchiveIssuers+base58EncodedPublicKey, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -8,7 +8,8 @@ import (
 )
 
 const (
-	routeGetAutopeeringNeighbors = "autopeering/neighbors"
+	routeGetAutopeeringNeighbors   = "autopeering/neighbors"
+	routeGetAutopeeringDiagnostics = "autopeering/diagnostics"
 )
 
 // GetAutopeeringNeighbors gets the chosen/accepted neighbors.
@@ -25,3 +26,13 @@ func (api *GoShimmerAPI) GetAutopeeringNeighbors(knownPeers bool) (*jsonmodels.G
 	}
 	return res, nil
 }
+
+// GetAutopeeringDiagnostics gets the salts, the candidates with their distances, the neighbors and the recently dropped
+// neighbors of the neighbor selection.
+func (api *GoShimmerAPI) GetAutopeeringDiagnostics() (*jsonmodels.GetAutopeeringDiagnosticsResponse, error) {
+	res := &jsonmodels.GetAutopeeringDiagnosticsResponse{}
+	if err := api.do(http.MethodGet, routeGetAutopeeringDiagnostics, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
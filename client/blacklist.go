@@ -0,0 +1,41 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeBlacklist = "blacklist"
+)
+
+// GetBlacklist returns the issuers that are currently blacklisted by the node.
+func (api *GoShimmerAPI) GetBlacklist() (*jsonmodels.BlacklistResponse, error) {
+	res := &jsonmodels.BlacklistResponse{}
+	if err := api.do(http.MethodGet, routeBlacklist, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AddToBlacklist blacklists the given issuer for the given duration.
+func (api *GoShimmerAPI) AddToBlacklist(issuerID identity.ID, duration time.Duration) (*jsonmodels.BlacklistResponse, error) {
+	res := &jsonmodels.BlacklistResponse{}
+	if err := api.do(http.MethodPost, routeBlacklist, &jsonmodels.BlacklistRequest{
+		IssuerID: issuerID.EncodeBase58(),
+		Duration: duration.String(),
+	}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RemoveFromBlacklist removes the given issuer from the blacklist of the node.
+func (api *GoShimmerAPI) RemoveFromBlacklist(issuerID identity.ID) error {
+	return api.do(http.MethodDelete, fmt.Sprintf("%s/%s", routeBlacklist, issuerID.EncodeBase58()), nil, nil)
+}
@@ -13,7 +13,7 @@ const (
 // Data sends the given data (payload) by creating a message in the backend.
 func (api *GoShimmerAPI) Data(data []byte) (string, error) {
 	res := &jsonmodels.DataResponse{}
-	if err := api.do(http.MethodPost, routeData,
+	if err := api.doIssuance(http.MethodPost, routeData,
 		&jsonmodels.DataRequest{Data: data}, res); err != nil {
 		return "", err
 	}
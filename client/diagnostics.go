@@ -3,29 +3,39 @@ package client
 import (
 	"encoding/csv"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 )
 
 const (
 	routeDiagnostics = "tools/diagnostic"
+	routeDebug       = "debug"
 	// RouteDiagnosticMessages is the API route for message diagnostics.
-	RouteDiagnosticMessages = routeDiagnostics + "/messages"
+	RouteDiagnosticMessages = routeDebug + "/messages"
 	// RouteDiagnosticsFirstWeakMessageReferences is the API route for first weak message diagnostics.
 	RouteDiagnosticsFirstWeakMessageReferences = RouteDiagnosticMessages + "/firstweakreferences"
-	// RouteDiagnosticsMessageRank is the API route for message diagnostics with a rank filter.
-	RouteDiagnosticsMessageRank = RouteDiagnosticMessages + "/rank/:rank"
 	// RouteDiagnosticsUtxoDag is the API route for Utxo Dag diagnostics.
-	RouteDiagnosticsUtxoDag = routeDiagnostics + "/utxodag"
+	RouteDiagnosticsUtxoDag = routeDebug + "/utxodag"
 	// RouteDiagnosticsBranches is the API route for branches diagnostics.
-	RouteDiagnosticsBranches = routeDiagnostics + "/branches"
-	// RouteDiagnosticsLazyBookedBranches is the API route for booked branches diagnostics.
-	RouteDiagnosticsLazyBookedBranches = RouteDiagnosticsBranches + "/lazybooked"
-	// RouteDiagnosticsInvalidBranches is the API route for invalid branches diagnostics.
-	RouteDiagnosticsInvalidBranches = RouteDiagnosticsBranches + "/invalid"
+	RouteDiagnosticsBranches = routeDebug + "/branches"
+	// RouteDiagnosticsMarkers is the API route for marker sequence diagnostics.
+	RouteDiagnosticsMarkers = routeDebug + "/markers"
+	// RouteDiagnosticsSequences is the API route for sequence number diagnostics.
+	RouteDiagnosticsSequences = routeDebug + "/sequences"
 	// RouteDiagnosticsTips is the API route for tips diagnostics.
-	RouteDiagnosticsTips = routeDiagnostics + "/tips"
+	RouteDiagnosticsTips = routeDebug + "/tips"
 	// RouteDiagnosticsDRNG is the API route for DRNG diagnostics.
-	RouteDiagnosticsDRNG = routeDiagnostics + "/drng"
+	RouteDiagnosticsDRNG = routeDebug + "/drng"
+	// RouteDiagnosticsUnconfirmedCone is the API route for the export of the unconfirmed cone of the Tangle.
+	RouteDiagnosticsUnconfirmedCone = routeDiagnostics + "/unconfirmedcone"
+	// RouteDiagnosticsGraph is the API route for the export of a region of the message DAG and the branch DAG.
+	RouteDiagnosticsGraph = routeDiagnostics + "/graph"
+	// RouteDiagnosticsSolidification is the API route for the dependency graph of the solidification.
+	RouteDiagnosticsSolidification = routeDiagnostics + "/solidification"
 )
 
 // GetDiagnosticsMessages runs full message diagnostics
@@ -40,7 +50,7 @@ func (api *GoShimmerAPI) GetDiagnosticsFirstWeakMessageReferences() (*csv.Reader
 
 // GetDiagnosticsMessagesByRank run diagnostics for messages whose markers are equal or above a certain rank
 func (api *GoShimmerAPI) GetDiagnosticsMessagesByRank(rank uint64) (*csv.Reader, error) {
-	return api.diagnose(fmt.Sprintf("%s?rank=%d", RouteDiagnosticMessages, rank))
+	return api.diagnose(fmt.Sprintf("%s?minRank=%d", RouteDiagnosticMessages, rank))
 }
 
 // GetDiagnosticsUtxoDag runs diagnostics over utxo dag.
@@ -65,15 +75,24 @@ func (api *GoShimmerAPI) GetDiagnosticsBranches() (*csv.Reader, error) {
 //
 //	ID,ConflictSet,IssuanceTime,SolidTime,LazyBooked,GradeOfFinality
 func (api *GoShimmerAPI) GetDiagnosticsLazyBookedBranches() (*csv.Reader, error) {
-	return api.diagnose(RouteDiagnosticsLazyBookedBranches)
+	return api.diagnose(RouteDiagnosticsBranches + "?lazyBooked=true")
 }
 
-// GetDiagnosticsInvalidBranches runs diagnostics over invalid branches.
+// GetDiagnosticsMarkers runs diagnostics over the marker sequences.
 // Returns csv with the following fields:
 //
-//	ID,ConflictSet,IssuanceTime,SolidTime,LazyBooked,GradeOfFinality
-func (api *GoShimmerAPI) GetDiagnosticsInvalidBranches() (*csv.Reader, error) {
-	return api.diagnose(RouteDiagnosticsInvalidBranches)
+//	ID,LowestIndex,HighestIndex,ReferencedMarkers,LowestMarkerMessageID,HighestMarkerMessageID,HighestMarkerBranchIDs
+func (api *GoShimmerAPI) GetDiagnosticsMarkers() (*csv.Reader, error) {
+	return api.diagnose(RouteDiagnosticsMarkers)
+}
+
+// GetDiagnosticsSequences runs diagnostics over the sequence numbers of the issuers.
+// Returns csv with the following fields:
+//
+//	IssuerID,HighestSequenceNumber,Messages,Gaps,MissingSequenceNumbers,OutstandingMissing,Reuses,OutOfOrder,
+//	OutsideOfWindow
+func (api *GoShimmerAPI) GetDiagnosticsSequences() (*csv.Reader, error) {
+	return api.diagnose(RouteDiagnosticsSequences)
 }
 
 // GetDiagnosticsTips runs diagnostics over tips
@@ -90,6 +109,43 @@ func (api *GoShimmerAPI) GetDiagnosticsDRNG() (*csv.Reader, error) {
 	return api.diagnose(RouteDiagnosticsDRNG)
 }
 
+// StreamDiagnostics requests the table of the given diagnostics route with the given filters and returns the body of
+// the response while it is still being received, so that large tables never have to be held in memory. The format of
+// the table is selected with the "format" query parameter ("csv" or "ndjson"). The caller has to close the body.
+func (api *GoShimmerAPI) StreamDiagnostics(route string, query url.Values) (io.ReadCloser, error) {
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(api.context(), http.MethodGet, fmt.Sprintf("%s/%s", api.baseURL, route), nil)
+	if err != nil {
+		return nil, err
+	}
+	if api.basicAuth.IsEnabled() {
+		req.SetBasicAuth(api.basicAuth.Credentials())
+	}
+
+	res, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, interpretBody(res, nil)
+	}
+
+	return res.Body, nil
+}
+
+// GetUnconfirmedCone exports the unconfirmed cone of the Tangle as a compressed archive that can be loaded with
+// conearchive.Read.
+func (api *GoShimmerAPI) GetUnconfirmedCone() ([]byte, error) {
+	var archive []byte
+	if err := api.do(http.MethodGet, RouteDiagnosticsUnconfirmedCone, nil, &archive); err != nil {
+		return nil, err
+	}
+	return archive, nil
+}
+
 // run an api call on a certain route and return a csv.
 func (api *GoShimmerAPI) diagnose(route string) (*csv.Reader, error) {
 	reader := &csv.Reader{}
@@ -98,3 +154,66 @@ func (api *GoShimmerAPI) diagnose(route string) (*csv.Reader, error) {
 	}
 	return reader, nil
 }
+
+// GraphExportOptions defines the region and the attributes of an export of the message DAG and the branch DAG. The zero
+// value exports the past cone of the tips with the default attributes, bounded by the maximum of the node.
+type GraphExportOptions struct {
+	// EntryPoints contains the base58 encoded IDs of the messages whose past cone is exported.
+	EntryPoints []string
+	// MaxMessages defines the maximum number of exported messages (0 uses the maximum of the node).
+	MaxMessages int
+	// MaxDepth defines the maximum distance of an exported message to the entry points (0 disables the limit).
+	MaxDepth int
+	// MessageAttributes contains the names of the exported attributes of messages.
+	MessageAttributes []string
+	// BranchAttributes contains the names of the exported attributes of branches.
+	BranchAttributes []string
+	// ExcludeBranches omits the branch DAG from the export.
+	ExcludeBranches bool
+}
+
+// GetGraph exports a region of the message DAG and the branch DAG in the given format (graphml, dot or json).
+func (api *GoShimmerAPI) GetGraph(format string, options GraphExportOptions) ([]byte, error) {
+	query := url.Values{}
+	query.Set("format", format)
+	for _, entryPoint := range options.EntryPoints {
+		query.Add("entryPoint", entryPoint)
+	}
+	if options.MaxMessages > 0 {
+		query.Set("maxMessages", strconv.Itoa(options.MaxMessages))
+	}
+	if options.MaxDepth > 0 {
+		query.Set("maxDepth", strconv.Itoa(options.MaxDepth))
+	}
+	for _, messageAttribute := range options.MessageAttributes {
+		query.Add("messageAttribute", messageAttribute)
+	}
+	for _, branchAttribute := range options.BranchAttributes {
+		query.Add("branchAttribute", branchAttribute)
+	}
+	if options.ExcludeBranches {
+		query.Set("branches", "false")
+	}
+
+	var graph []byte
+	if err := api.do(http.MethodGet, RouteDiagnosticsGraph+"?"+query.Encode(), nil, &graph); err != nil {
+		return nil, err
+	}
+	return graph, nil
+}
+
+// GetSolidification returns the dependency graph of the solidification, i.e. the missing messages together with the
+// messages that wait for them and the state of their requests. The number of blocked descendants that are counted per
+// missing message is limited by maxBlockedDescendants (0 uses the limit of the node).
+func (api *GoShimmerAPI) GetSolidification(maxBlockedDescendants int) (*jsonmodels.SolidificationResponse, error) {
+	route := RouteDiagnosticsSolidification
+	if maxBlockedDescendants > 0 {
+		route += "?maxBlockedDescendants=" + strconv.Itoa(maxBlockedDescendants)
+	}
+
+	res := &jsonmodels.SolidificationResponse{}
+	if err := api.do(http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -15,7 +15,7 @@ const (
 // BroadcastCollectiveBeacon sends the given collective beacon (payload) by creating a message in the backend.
 func (api *GoShimmerAPI) BroadcastCollectiveBeacon(payload []byte) (string, error) {
 	res := &jsonmodels.CollectiveBeaconResponse{}
-	if err := api.do(http.MethodPost, routeCollectiveBeacon,
+	if err := api.doIssuance(http.MethodPost, routeCollectiveBeacon,
 		&jsonmodels.CollectiveBeaconRequest{Payload: payload}, res); err != nil {
 		return "", err
 	}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeEpochs     = "epochs/"
+	pathEpochsDiffs = "/diffs"
+)
+
+// GetEpochDiff returns the outputs created and spent and the branches resolved by the confirmed ledger state in the
+// given epoch.
+func (api *GoShimmerAPI) GetEpochDiff(index epochs.Index) (*jsonmodels.EpochDiff, error) {
+	res := &jsonmodels.EpochDiff{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s%d%s", routeEpochs, index, pathEpochsDiffs), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -50,7 +50,7 @@ func (api *GoShimmerAPI) SendFaucetRequest(base58EncodedAddr string, powTarget i
 	}
 
 	res := &jsonmodels.FaucetResponse{}
-	if err := api.do(http.MethodPost, routeFaucet,
+	if err := api.doIssuance(http.MethodPost, routeFaucet,
 		&jsonmodels.FaucetRequest{
 			Address:               base58EncodedAddr,
 			AccessManaPledgeID:    base58.Encode(aManaPledgeID.Bytes()),
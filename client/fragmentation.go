@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/fragmentation"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeFragmentedData = "fragmentation/data/"
+)
+
+// SendFragmentedData splits data that exceeds the maximum size of a payload into fragments and issues every fragment
+// in a separate message. It returns the DataID under which the data can be retrieved from nodes that run the
+// fragmentation plugin and the IDs of the messages in the order of the fragments. If the context of the API carries an
+// idempotency key, every fragment is issued with a key that is derived from it, so that a failed call can be repeated
+// with the same key without issuing the fragments that were sent already a second time.
+func (api *GoShimmerAPI) SendFragmentedData(data []byte) (base58EncodedDataID string, messageIDs []string, err error) {
+	fragments, err := fragmentation.Fragment(data)
+	if err != nil {
+		return "", nil, err
+	}
+
+	idempotencyKey, hasIdempotencyKey := IdempotencyKeyFromContext(api.context())
+	messageIDs = make([]string, len(fragments))
+	for i, fragment := range fragments {
+		fragmentAPI := api
+		if hasIdempotencyKey {
+			fragmentAPI = api.WithContext(ContextWithIdempotencyKey(api.context(), fmt.Sprintf("%s-%d", idempotencyKey, i)))
+		}
+
+		if messageIDs[i], err = fragmentAPI.SendPayload(fragment.Bytes()); err != nil {
+			return "", messageIDs[:i], errors.Errorf("failed to send fragment %d of %d: %w", i, len(fragments), err)
+		}
+	}
+
+	return fragments[0].DataID.Base58(), messageIDs, nil
+}
+
+// GetFragmentedData returns the state of the reassembly of the fragmented data with the given DataID, which contains
+// the data once all of its fragments were received by the node.
+func (api *GoShimmerAPI) GetFragmentedData(base58EncodedDataID string) (*jsonmodels.FragmentedDataResponse, error) {
+	res := &jsonmodels.FragmentedDataResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return strings.Join([]string{routeFragmentedData, base58EncodedDataID}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
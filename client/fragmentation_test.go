@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/fragmentation"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestGoShimmerAPI_SendFragmentedData(t *testing.T) {
+	var mutex sync.Mutex
+	var fragments []*fragmentation.Payload
+	var idempotencyKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		request := &jsonmodels.PostPayloadRequest{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+		fragment, _, err := fragmentation.FromBytes(request.Payload)
+		require.NoError(t, err)
+		fragments = append(fragments, fragment)
+		idempotencyKeys = append(idempotencyKeys, r.Header.Get(jsonmodels.IdempotencyKeyHeader))
+
+		w.Header().Set(contentType, contentTypeJSON)
+		_, _ = fmt.Fprintf(w, `{"id":"message%d"}`, len(fragments)-1)
+	}))
+	defer server.Close()
+
+	data := make([]byte, 2*fragmentation.MaxFragmentSize+1)
+	data[len(data)-1] = 1
+
+	api := NewGoShimmerAPI(server.URL)
+	dataID, messageIDs, err := api.WithContext(ContextWithIdempotencyKey(context.Background(), "key")).SendFragmentedData(data)
+	require.NoError(t, err)
+	assert.Equal(t, fragmentation.NewDataID(data).Base58(), dataID)
+	assert.Equal(t, []string{"message0", "message1", "message2"}, messageIDs)
+
+	// every fragment has its own idempotency key
+	assert.Equal(t, []string{"key-0", "key-1", "key-2"}, idempotencyKeys)
+
+	reassembled, err := fragmentation.Reassemble(fragments)
+	require.NoError(t, err)
+	assert.Equal(t, data, reassembled)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const routeChallenge = "challenge"
+
+// region issuerIdentity ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithIssuerIdentity makes the client prove the given identity in every request that issues a message. Nodes that
+// prioritize issuance requests serve the requests in the order of the access mana of their issuers.
+func WithIssuerIdentity(localIdentity *identity.LocalIdentity) Option {
+	return func(g *GoShimmerAPI) {
+		g.issuer = &issuerIdentity{
+			localIdentity: localIdentity,
+		}
+	}
+}
+
+// GetChallenge returns a challenge that can be signed to prove the identity of the issuer of a request.
+func (api *GoShimmerAPI) GetChallenge() (*jsonmodels.GetChallengeResponse, error) {
+	res := &jsonmodels.GetChallengeResponse{}
+	if err := api.do(http.MethodGet, routeChallenge, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// issuerIdentity signs the requests of the client together with a challenge of the node.
+type issuerIdentity struct {
+	localIdentity *identity.LocalIdentity
+}
+
+// headers returns the headers that prove the identity of the issuer of the given request. Every request is signed with
+// a new challenge, since the node accepts every challenge only once.
+func (i *issuerIdentity) headers(api *GoShimmerAPI, method, path string, body []byte) (map[string]string, error) {
+	res, err := api.GetChallenge()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		jsonmodels.IssuerPublicKeyHeader: i.localIdentity.PublicKey().String(),
+		jsonmodels.IssuerChallengeHeader: res.Challenge,
+		jsonmodels.IssuerSignatureHeader: i.localIdentity.Sign(jsonmodels.IssuerSignedMessage(res.Challenge, method, path, body)).String(),
+	}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
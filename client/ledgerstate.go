@@ -1,10 +1,16 @@
 package client
 
 import (
+	"encoding/base64"
+	"fmt"
 	"net/http"
+	"net/url"
 	"strings"
 
+	"github.com/gorilla/websocket"
+
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/webapiproto"
 )
 
 const (
@@ -14,15 +20,21 @@ const (
 	routeGetOutputs       = "ledgerstate/outputs/"
 	routeGetTransactions  = "ledgerstate/transactions/"
 	routePostTransactions = "ledgerstate/transactions"
+	routeGetSupply        = "ledgerstate/supply"
+	routePostOverlay      = "ledgerstate/overlay"
+	routePostOutputsBatch = "ledgerstate/outputs:batch"
 
 	// route path modifiers.
 	pathUnspentOutputs = "/unspentOutputs"
+	pathBalance        = "/balance"
 	pathChildren       = "/children"
 	pathConflicts      = "/conflicts"
 	pathConsumers      = "/consumers"
 	pathMetadata       = "/metadata"
 	pathVoters         = "/voters"
 	pathAttachments    = "/attachments"
+	pathInclusion      = "/inclusion/subscribe"
+	pathReservation    = "/reservation"
 )
 
 // GetAddressOutputs gets the spent and unspent outputs of an address.
@@ -47,6 +59,17 @@ func (api *GoShimmerAPI) GetAddressUnspentOutputs(base58EncodedAddress string) (
 	return res, nil
 }
 
+// GetAddressBalance gets the balance of an address split into confirmed, pending and conflicting portions.
+func (api *GoShimmerAPI) GetAddressBalance(base58EncodedAddress string) (*jsonmodels.GetAddressBalanceResponse, error) {
+	res := &jsonmodels.GetAddressBalanceResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return strings.Join([]string{routeGetAddresses, base58EncodedAddress, pathBalance}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // PostAddressUnspentOutputs gets the unspent outputs of several addresses.
 func (api *GoShimmerAPI) PostAddressUnspentOutputs(base58EncodedAddresses []string) (*jsonmodels.PostAddressesUnspentOutputsResponse, error) {
 	res := &jsonmodels.PostAddressesUnspentOutputsResponse{}
@@ -58,6 +81,52 @@ func (api *GoShimmerAPI) PostAddressUnspentOutputs(base58EncodedAddresses []stri
 	return res, nil
 }
 
+// PostAddressUnspentOutputsProtobuf gets the unspent outputs of several addresses like PostAddressUnspentOutputs, but
+// encodes the request and the response with protobuf. The outputs are returned in their serialized form.
+func (api *GoShimmerAPI) PostAddressUnspentOutputsProtobuf(base58EncodedAddresses []string) (*webapiproto.PostAddressesUnspentOutputsResponse, error) {
+	res := &webapiproto.PostAddressesUnspentOutputsResponse{}
+	if err := api.do(http.MethodPost, func() string {
+		return strings.Join([]string{routeGetAddresses, "unspentOutputs"}, "")
+	}(), &webapiproto.PostAddressesUnspentOutputsRequest{Addresses: base58EncodedAddresses}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PostAddressesBalanceProof gets the outputs of several addresses that can be unspent under some resolution of the
+// pending conflicts, together with the balances that are confirmed and unspent under every resolution.
+func (api *GoShimmerAPI) PostAddressesBalanceProof(base58EncodedAddresses []string) (*jsonmodels.PostAddressesBalanceProofResponse, error) {
+	res := &jsonmodels.PostAddressesBalanceProofResponse{}
+	if err := api.do(http.MethodPost, func() string {
+		return strings.Join([]string{routeGetAddresses, "balanceProof"}, "")
+	}(), &jsonmodels.PostAddressesBalanceProofRequest{Addresses: base58EncodedAddresses}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetSupply gets the supply statistics of the confirmed ledger state with the given number of addresses holding the
+// largest balances.
+func (api *GoShimmerAPI) GetSupply(top int) (*jsonmodels.GetSupplyResponse, error) {
+	res := &jsonmodels.GetSupplyResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s?top=%d", routeGetSupply, top), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PostOverlay applies the given hypothetical transactions(bytes) to a temporary overlay of the ledger state of the
+// node and returns the results of the transactions and the resulting unspent outputs of the given addresses. Nothing
+// is persisted or issued.
+func (api *GoShimmerAPI) PostOverlay(transactionsBytes [][]byte, base58EncodedAddresses []string) (*jsonmodels.PostOverlayResponse, error) {
+	res := &jsonmodels.PostOverlayResponse{}
+	if err := api.do(http.MethodPost, routePostOverlay,
+		&jsonmodels.PostOverlayRequest{TransactionsBytes: transactionsBytes, Addresses: base58EncodedAddresses}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetBranch gets the branch information.
 func (api *GoShimmerAPI) GetBranch(base58EncodedBranchID string) (*jsonmodels.Branch, error) {
 	res := &jsonmodels.Branch{}
@@ -113,6 +182,28 @@ func (api *GoShimmerAPI) GetOutput(base58EncodedOutputID string) (*jsonmodels.Ou
 	return res, nil
 }
 
+// GetOutputProtobuf gets the serialized output corresponding to OutputID encoded with protobuf.
+func (api *GoShimmerAPI) GetOutputProtobuf(base58EncodedOutputID string) (*webapiproto.Output, error) {
+	res := &webapiproto.Output{}
+	if err := api.do(http.MethodGet, func() string {
+		return strings.Join([]string{routeGetOutputs, base58EncodedOutputID}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// PostOutputsBatch gets the outputs corresponding to the given OutputIDs together with their metadata and the inclusion
+// state of their branches in a single request.
+func (api *GoShimmerAPI) PostOutputsBatch(base58EncodedOutputIDs []string) (*jsonmodels.PostOutputsBatchResponse, error) {
+	res := &jsonmodels.PostOutputsBatchResponse{}
+	if err := api.do(http.MethodPost, routePostOutputsBatch,
+		&jsonmodels.PostOutputsBatchRequest{OutputIDs: base58EncodedOutputIDs}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetOutputConsumers gets the consumers of the output corresponding to OutputID.
 func (api *GoShimmerAPI) GetOutputConsumers(base58EncodedOutputID string) (*jsonmodels.GetOutputConsumersResponse, error) {
 	res := &jsonmodels.GetOutputConsumersResponse{}
@@ -135,6 +226,31 @@ func (api *GoShimmerAPI) GetOutputMetadata(base58EncodedOutputID string) (*jsonm
 	return res, nil
 }
 
+// ReserveOutput reserves the output corresponding to OutputID for the given owner for the given number of seconds, so
+// that other wallet instances of the same seed do not spend it. The reservation is advisory and renewed if the output is
+// already reserved by the same owner.
+func (api *GoShimmerAPI) ReserveOutput(base58EncodedOutputID string, owner string, ttl int64) (*jsonmodels.OutputReservation, error) {
+	res := &jsonmodels.OutputReservation{}
+	if err := api.do(http.MethodPost, func() string {
+		return strings.Join([]string{routeGetOutputs, base58EncodedOutputID, pathReservation}, "")
+	}(), &jsonmodels.ReserveOutputRequest{Owner: owner, TTL: ttl}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ReleaseOutputReservation releases the reservation of the output corresponding to OutputID that is held by the given
+// owner.
+func (api *GoShimmerAPI) ReleaseOutputReservation(base58EncodedOutputID string, owner string) (*jsonmodels.ReleaseOutputReservationResponse, error) {
+	res := &jsonmodels.ReleaseOutputReservationResponse{}
+	if err := api.do(http.MethodDelete, func() string {
+		return strings.Join([]string{routeGetOutputs, base58EncodedOutputID, pathReservation, "?owner=", url.QueryEscape(owner)}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetTransaction gets the transaction of the corresponding to TransactionID.
 func (api *GoShimmerAPI) GetTransaction(base58EncodedTransactionID string) (*jsonmodels.Transaction, error) {
 	res := &jsonmodels.Transaction{}
@@ -168,10 +284,74 @@ func (api *GoShimmerAPI) GetTransactionAttachments(base58EncodedTransactionID st
 	return res, nil
 }
 
+// SubscribeTransactionInclusion subscribes to the grade of finality changes of the transaction corresponding to
+// TransactionID. The callback is called with the metadata of the transaction for every change. The call blocks until
+// the transaction reached the highest grade of finality, the node closed the subscription or the context is done.
+func (api *GoShimmerAPI) SubscribeTransactionInclusion(base58EncodedTransactionID string, callback func(metadata *jsonmodels.TransactionMetadata)) error {
+	ctx := api.context()
+
+	header := http.Header{}
+	if api.basicAuth.IsEnabled() {
+		username, password := api.basicAuth.Credentials()
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	}
+
+	url := strings.Join([]string{strings.Replace(api.baseURL, "http", "ws", 1), "/", routeGetTransactions, base58EncodedTransactionID, pathInclusion}, "")
+	conn, res, err := websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		// the node rejected the subscription with a regular response
+		if res != nil && res.StatusCode != http.StatusSwitchingProtocols {
+			if interpretErr := interpretBody(res, &jsonmodels.TransactionMetadata{}); interpretErr != nil {
+				return interpretErr
+			}
+		}
+		return err
+	}
+	defer conn.Close()
+
+	// unblock the read if the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		metadata := &jsonmodels.TransactionMetadata{}
+		if err = conn.ReadJSON(metadata); err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		callback(metadata)
+	}
+}
+
 // PostTransaction sends the transaction(bytes) to the Tangle and returns its transaction ID.
 func (api *GoShimmerAPI) PostTransaction(transactionBytes []byte) (*jsonmodels.PostTransactionResponse, error) {
 	res := &jsonmodels.PostTransactionResponse{}
-	if err := api.do(http.MethodPost, routePostTransactions,
+	if err := api.doIssuance(http.MethodPost, routePostTransactions,
+		&jsonmodels.PostTransactionRequest{TransactionBytes: transactionBytes}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// PostTransactionDryRun validates the transaction(bytes) like PostTransaction without issuing it and returns the
+// diagnosis of all checks.
+func (api *GoShimmerAPI) PostTransactionDryRun(transactionBytes []byte) (*jsonmodels.DryRunResponse, error) {
+	res := &jsonmodels.DryRunResponse{}
+	if err := api.do(http.MethodPost, fmt.Sprintf("%s?%s=true", routePostTransactions, jsonmodels.DryRunQueryParameter),
 		&jsonmodels.PostTransactionRequest{TransactionBytes: transactionBytes}, res); err != nil {
 		return nil, err
 	}
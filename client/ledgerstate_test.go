@@ -0,0 +1,47 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestGoShimmerAPI_SubscribeTransactionInclusion(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ledgerstate/transactions/transactionID/inclusion/subscribe" {
+			w.Header().Set(contentType, contentTypeJSON)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid transaction ID"}`))
+			return
+		}
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		for _, gradeOfFinality := range []gof.GradeOfFinality{gof.Low, gof.Medium, gof.High} {
+			require.NoError(t, conn.WriteJSON(&jsonmodels.TransactionMetadata{TransactionID: "transactionID", GradeOfFinality: gradeOfFinality}))
+		}
+		require.NoError(t, conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second)))
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL)
+
+	var updates []gof.GradeOfFinality
+	require.NoError(t, api.SubscribeTransactionInclusion("transactionID", func(metadata *jsonmodels.TransactionMetadata) {
+		updates = append(updates, metadata.GradeOfFinality)
+	}))
+	assert.Equal(t, []gof.GradeOfFinality{gof.Low, gof.Medium, gof.High}, updates)
+
+	assert.ErrorIs(t, api.SubscribeTransactionInclusion("invalid", func(*jsonmodels.TransactionMetadata) {}), ErrBadRequest)
+}
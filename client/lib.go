@@ -13,6 +13,9 @@ import (
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 )
 
 var (
@@ -31,9 +34,10 @@ var (
 )
 
 const (
-	contentType     = "Content-Type"
-	contentTypeJSON = "application/json"
-	contentTypeCSV  = "text/csv"
+	contentType            = "Content-Type"
+	contentTypeJSON        = "application/json"
+	contentTypeCSV         = "text/csv"
+	contentTypeOctetStream = "application/octet-stream"
 )
 
 // Option is a function which sets the given option.
@@ -87,13 +91,82 @@ func NewGoShimmerAPI(baseURL string, setters ...Option) *GoShimmerAPI {
 
 // GoShimmerAPI is an API wrapper over the web API of GoShimmer.
 type GoShimmerAPI struct {
-	baseURL    string
-	httpClient http.Client
-	basicAuth  BasicAuth
+	baseURL          string
+	httpClient       http.Client
+	basicAuth        BasicAuth
+	retryPolicy      RetryPolicy
+	issuer           *issuerIdentity
+	apiKey           string
+	responseVerifier *responseVerifier
+	ctx              context.Context
+}
+
+// Error is the error that is returned if the node rejected a request. It wraps the error of the HTTP status code, e.g.
+// ErrBadRequest, and contains the machine-readable code of the error reported by the node.
+type Error struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Code is the machine-readable code of the error.
+	Code jsonmodels.ErrorCode
+	// Subsystem is the part of the node that caused the error.
+	Subsystem jsonmodels.Subsystem
+	// Retryable defines if the request can succeed when it is retried later.
+	Retryable bool
+	// Message is the human-readable error message.
+	Message string
+
+	statusErr error
+}
+
+// newError creates an Error from the given response. Errors of nodes that don't report a code get the generic code of
+// the HTTP status code.
+func newError(res *http.Response, errRes *jsonmodels.ErrorResponse) *Error {
+	err := &Error{
+		StatusCode: res.StatusCode,
+		Code:       errRes.Code,
+		Subsystem:  errRes.Subsystem,
+		Retryable:  errRes.Retryable,
+		Message:    errRes.Error,
+	}
+	if err.Code == "" || err.Code == jsonmodels.ErrorCodeUnknown {
+		err.Code = jsonmodels.ErrorCodeFromStatus(res.StatusCode)
+	}
+
+	switch res.StatusCode {
+	case http.StatusInternalServerError:
+		err.statusErr = ErrInternalServerError
+	case http.StatusNotFound:
+		err.statusErr = ErrNotFound
+		err.Message = res.Request.URL.String()
+	case http.StatusBadRequest:
+		err.statusErr = ErrBadRequest
+	case http.StatusUnauthorized:
+		err.statusErr = ErrUnauthorized
+	case http.StatusNotImplemented:
+		err.statusErr = ErrNotImplemented
+	default:
+		err.statusErr = ErrUnknownError
+	}
+
+	return err
 }
 
-type errorresponse struct {
-	Error string `json:"error"`
+// Error returns a human-readable version of the Error.
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s: %s", e.statusErr, e.Message)
+}
+
+// Unwrap returns the error of the HTTP status code.
+func (e *Error) Unwrap() error {
+	return e.statusErr
+}
+
+// Is reports whether the node reported the same code as the one registered for the given error, which allows to check
+// for the sentinel errors of the node, e.g. errors.Is(err, tangle.ErrNoStrongParents).
+func (e *Error) Is(target error) bool {
+	targetCode := jsonmodels.ErrorCodeOf(target)
+
+	return targetCode.Code != jsonmodels.ErrorCodeUnknown && targetCode.Code == e.Code && targetCode.Subsystem == e.Subsystem
 }
 
 func interpretBody(res *http.Response, decodeTo interface{}) error {
@@ -110,77 +183,150 @@ func interpretBody(res *http.Response, decodeTo interface{}) error {
 		case strings.HasPrefix(contType, contentTypeCSV):
 			*decodeTo.(*csv.Reader) = *csv.NewReader(bufio.NewReader(bytes.NewReader(resBody)))
 			return nil
+		case strings.HasPrefix(contType, contentTypeOctetStream):
+			*decodeTo.(*[]byte) = resBody
+			return nil
+		case strings.HasPrefix(contType, jsonmodels.ContentTypeProtobuf):
+			return proto.Unmarshal(resBody, decodeTo.(proto.Message))
 		default:
 			return fmt.Errorf("can't decode %s content-type", contType)
 		}
 	}
-	errRes := &errorresponse{}
+	errRes := &jsonmodels.ErrorResponse{}
 	if err := json.Unmarshal(resBody, errRes); err != nil {
 		return fmt.Errorf("unable to read error from response body: %w repsonseBody: %s", err, resBody)
 	}
 
-	switch res.StatusCode {
-	case http.StatusInternalServerError:
-		return fmt.Errorf("%w: %s", ErrInternalServerError, errRes.Error)
-	case http.StatusNotFound:
-		return fmt.Errorf("%w: %s", ErrNotFound, res.Request.URL.String())
-	case http.StatusBadRequest:
-		return fmt.Errorf("%w: %s", ErrBadRequest, errRes.Error)
-	case http.StatusUnauthorized:
-		return fmt.Errorf("%w: %s", ErrUnauthorized, errRes.Error)
-	case http.StatusNotImplemented:
-		return fmt.Errorf("%w: %s", ErrNotImplemented, errRes.Error)
+	return newError(res, errRes)
+}
+
+func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, resObj interface{}) error {
+	return api.doWithHeaders(method, route, reqObj, resObj, nil, nil)
+}
+
+// doIssuance executes a request against an endpoint that issues a message. The request carries an idempotency key, so
+// that it can be retried without issuing duplicate messages, and if configured the signature of the issuer.
+func (api *GoShimmerAPI) doIssuance(method string, route string, reqObj interface{}, resObj interface{}) error {
+	idempotencyKey, exists := IdempotencyKeyFromContext(api.context())
+	if !exists {
+		idempotencyKey = NewIdempotencyKey()
 	}
 
-	return fmt.Errorf("%w: %s", ErrUnknownError, errRes.Error)
+	headers := map[string]string{
+		jsonmodels.IdempotencyKeyHeader: idempotencyKey,
+	}
+
+	return api.doWithHeaders(method, route, reqObj, resObj, headers, api.issuer)
 }
 
-func (api *GoShimmerAPI) do(method string, route string, reqObj interface{}, resObj interface{}) error {
+// doWithHeaders executes a request with the given headers. If an issuer is given, every attempt is signed with a new
+// challenge of the node, since the challenges can only be used once.
+func (api *GoShimmerAPI) doWithHeaders(method string, route string, reqObj interface{}, resObj interface{}, headers map[string]string, issuer *issuerIdentity) error {
 	// marshal request object
 	var data []byte
+	requestContentType := contentTypeJSON
 	if reqObj != nil {
 		var err error
-		data, err = json.Marshal(reqObj)
+		if protobufRequest, isProtobuf := reqObj.(proto.Message); isProtobuf {
+			data, err = proto.Marshal(protobufRequest)
+			requestContentType = jsonmodels.ContentTypeProtobuf
+		} else {
+			data, err = json.Marshal(reqObj)
+		}
 		if err != nil {
 			return err
 		}
 	}
-	ctx := context.TODO()
-	// construct request
-	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", api.baseURL, route), func() io.Reader {
-		if data == nil {
-			return nil
+
+	ctx := api.context()
+	_, hasIdempotencyKey := headers[jsonmodels.IdempotencyKeyHeader]
+	retryAllowed := isIdempotentMethod(method) || hasIdempotencyKey
+
+	for attempt := 1; ; attempt++ {
+		// construct request
+		req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", api.baseURL, route), func() io.Reader {
+			if data == nil {
+				return nil
+			}
+			return bytes.NewReader(data)
+		}())
+		if err != nil {
+			return err
 		}
-		return bytes.NewReader(data)
-	}())
-	if err != nil {
-		return err
-	}
 
-	if data != nil {
-		req.Header.Set("Content-Type", contentTypeJSON)
-	}
+		if data != nil {
+			req.Header.Set("Content-Type", requestContentType)
+		}
+		if _, isProtobuf := resObj.(proto.Message); isProtobuf {
+			req.Header.Set("Accept", jsonmodels.ContentTypeProtobuf)
+		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		if issuer != nil {
+			issuerHeaders, err := issuer.headers(api, method, req.URL.Path, data)
+			if err != nil {
+				return fmt.Errorf("failed to prove issuer identity: %w", err)
+			}
+			for key, value := range issuerHeaders {
+				req.Header.Set(key, value)
+			}
+		}
 
-	// if enabled, add the basic-auth
-	if api.basicAuth.IsEnabled() {
-		req.SetBasicAuth(api.basicAuth.Credentials())
-	}
+		// if enabled, add the basic-auth
+		if api.basicAuth.IsEnabled() {
+			req.SetBasicAuth(api.basicAuth.Credentials())
+		}
+		if api.apiKey != "" {
+			req.Header.Set(jsonmodels.APIKeyHeader, api.apiKey)
+		}
 
-	// make the request
-	res, err := api.httpClient.Do(req)
-	if err != nil {
-		return err
-	}
+		// make the request
+		res, err := api.httpClient.Do(req)
+		if retryAllowed && attempt < api.retryPolicy.MaxAttempts && isRetryable(ctx, res, err) {
+			if res != nil {
+				_, _ = io.Copy(io.Discard, res.Body)
+				_ = res.Body.Close()
+			}
+			if waitErr := api.retryPolicy.wait(ctx, attempt); waitErr != nil {
+				return waitErr
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
 
-	if resObj == nil {
-		return nil
+		if api.responseVerifier != nil {
+			if err = api.responseVerifier.verify(res); err != nil {
+				return err
+			}
+		}
+
+		if resObj == nil {
+			return res.Body.Close()
+		}
+
+		// write response into response object
+		return interpretBody(res, resObj)
 	}
+}
 
-	// write response into response object
-	if err := interpretBody(res, resObj); err != nil {
-		return err
+// WithContext returns a copy of the API whose calls use the given context, e.g. to set a deadline or to cancel calls.
+func (api *GoShimmerAPI) WithContext(ctx context.Context) *GoShimmerAPI {
+	apiWithContext := *api
+	apiWithContext.ctx = ctx
+
+	return &apiWithContext
+}
+
+// context returns the context of the calls of the API.
+func (api *GoShimmerAPI) context() context.Context {
+	if api.ctx == nil {
+		return context.Background()
 	}
-	return nil
+
+	return api.ctx
 }
 
 // BaseURL returns the baseURL of the API.
@@ -0,0 +1,205 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/webapiproto"
+)
+
+var testRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     10 * time.Millisecond,
+	Jitter:         0.2,
+}
+
+func TestGoShimmerAPI_RetryIssuance(t *testing.T) {
+	var mutex sync.Mutex
+	var idempotencyKeys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		idempotencyKeys = append(idempotencyKeys, r.Header.Get(jsonmodels.IdempotencyKeyHeader))
+		if len(idempotencyKeys) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set(contentType, contentTypeJSON)
+		_, _ = w.Write([]byte(`{"id":"messageID"}`))
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL, WithRetryPolicy(testRetryPolicy))
+	messageID, err := api.Data([]byte("test"))
+	require.NoError(t, err)
+	assert.Equal(t, "messageID", messageID)
+
+	// all attempts use the same generated key
+	require.Len(t, idempotencyKeys, 3)
+	assert.NotEmpty(t, idempotencyKeys[0])
+	assert.Equal(t, idempotencyKeys[0], idempotencyKeys[1])
+	assert.Equal(t, idempotencyKeys[0], idempotencyKeys[2])
+
+	// a key from the context is used instead of a generated one
+	idempotencyKeys = nil
+	_, err = api.WithContext(ContextWithIdempotencyKey(context.Background(), "key")).Data([]byte("test"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"key"}, idempotencyKeys[len(idempotencyKeys)-1:])
+}
+
+func TestGoShimmerAPI_NoRetryWithoutIdempotencyKey(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL, WithRetryPolicy(testRetryPolicy))
+	assert.Error(t, api.do(http.MethodPost, "test", struct{}{}, &struct{}{}))
+	assert.Equal(t, 1, attempts)
+
+	// idempotent methods are retried
+	attempts = 0
+	assert.Error(t, api.do(http.MethodGet, "test", nil, &struct{}{}))
+	assert.Equal(t, testRetryPolicy.MaxAttempts, attempts)
+}
+
+func TestGoShimmerAPI_WithContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	api := NewGoShimmerAPI(server.URL, WithRetryPolicy(RetryPolicy{MaxAttempts: 100, InitialBackoff: time.Second}))
+	_, err := api.WithContext(ctx).Info()
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestGoShimmerAPI_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, contentTypeJSON)
+		w.WriteHeader(http.StatusBadRequest)
+		switch r.URL.Path {
+		case "/coded":
+			_ = json.NewEncoder(w).Encode(jsonmodels.NewErrorResponse(errors.Errorf("failed to issue message: %w", tangle.ErrNoStrongParents)))
+		default:
+			_, _ = w.Write([]byte(`{"error":"something went wrong"}`))
+		}
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL)
+
+	err := api.do(http.MethodGet, "coded", nil, &struct{}{})
+	assert.ErrorIs(t, err, ErrBadRequest)
+	assert.ErrorIs(t, err, tangle.ErrNoStrongParents)
+	assert.NotErrorIs(t, err, tangle.ErrNotSynced)
+	var apiErr *Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, jsonmodels.ErrorCodeNoStrongParents, apiErr.Code)
+	assert.Equal(t, jsonmodels.SubsystemTangle, apiErr.Subsystem)
+	assert.True(t, apiErr.Retryable)
+	assert.Equal(t, "bad request: failed to issue message: missing strong messages in first parent block", err.Error())
+
+	// errors of nodes that don't report a code get the code of the status
+	err = api.do(http.MethodGet, "uncoded", nil, &struct{}{})
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, jsonmodels.ErrorCodeBadRequest, apiErr.Code)
+	assert.False(t, apiErr.Retryable)
+	assert.NotErrorIs(t, err, tangle.ErrNoStrongParents)
+}
+
+func TestGoShimmerAPI_Protobuf(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(contentType) != jsonmodels.ContentTypeProtobuf || r.Header.Get("Accept") != jsonmodels.ContentTypeProtobuf {
+			w.Header().Set(contentType, contentTypeJSON)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"protobuf expected"}`))
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		request := new(webapiproto.PostPayloadRequest)
+		require.NoError(t, proto.Unmarshal(body, request))
+
+		response, err := proto.Marshal(&webapiproto.PostPayloadResponse{Id: append([]byte("id:"), request.Payload...)})
+		require.NoError(t, err)
+		w.Header().Set(contentType, jsonmodels.ContentTypeProtobuf)
+		_, _ = w.Write(response)
+	}))
+	defer server.Close()
+
+	messageID, err := NewGoShimmerAPI(server.URL).SendPayloadProtobuf([]byte("payload"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("id:payload"), messageID)
+
+	// errors are reported as JSON
+	_, err = NewGoShimmerAPI(server.URL).SendPayload([]byte("payload"))
+	assert.ErrorIs(t, err, ErrBadRequest)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	assert.Equal(t, 100*time.Millisecond, policy.Backoff(1))
+	assert.Equal(t, 400*time.Millisecond, policy.Backoff(3))
+	assert.Equal(t, time.Second, policy.Backoff(10))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		assert.InDelta(t, float64(100*time.Millisecond), float64(policy.Backoff(1)), float64(50*time.Millisecond))
+	}
+}
+
+func TestGoShimmerAPI_IssuerIdentity(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+
+	challengeRequests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, contentTypeJSON)
+		if r.URL.Path == "/"+routeChallenge {
+			challengeRequests++
+			_, _ = w.Write([]byte(fmt.Sprintf(`{"challenge":"challenge","expiresAt":%d}`, time.Now().Add(time.Minute).Unix())))
+			return
+		}
+
+		assert.Equal(t, localIdentity.PublicKey().String(), r.Header.Get(jsonmodels.IssuerPublicKeyHeader))
+		assert.Equal(t, "challenge", r.Header.Get(jsonmodels.IssuerChallengeHeader))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		signedMessage := jsonmodels.IssuerSignedMessage("challenge", r.Method, r.URL.Path, body)
+		assert.Equal(t, localIdentity.Sign(signedMessage).String(), r.Header.Get(jsonmodels.IssuerSignatureHeader))
+		_, _ = w.Write([]byte(`{"id":"messageID"}`))
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL, WithIssuerIdentity(localIdentity))
+	for i := 0; i < 2; i++ {
+		_, err := api.Data([]byte("test"))
+		require.NoError(t, err)
+	}
+
+	// every request is signed with a new challenge
+	assert.Equal(t, 2, challengeRequests)
+}
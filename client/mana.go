@@ -3,6 +3,9 @@ package client
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"time"
 
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 )
@@ -15,10 +18,12 @@ const (
 	routeGetOnlineConsensusMana   = "mana/consensus/online"
 	routeGetNHighestAccessMana    = "mana/access/nhighest"
 	routeGetNHighestConsensusMana = "mana/consensus/nhighest"
+	routeAccessManaProjection     = "mana/access/projection"
 	routePending                  = "mana/pending"
 	routePastConsensusVector      = "mana/consensus/past"
 	routePastConsensusEventLogs   = "mana/consensus/logs"
 	routeAllowedPledgeNodeIDs     = "mana/allowedManaPledge"
+	routeManaAudit                = "mana/audit"
 )
 
 // GetOwnMana returns the access and consensus mana of the node this api client is communicating with.
@@ -129,6 +134,19 @@ func (api *GoShimmerAPI) GetNHighestConsensusMana(n int) (*jsonmodels.GetNHighes
 	return res, nil
 }
 
+// GetAccessManaProjection projects the access mana of the node over the given interval and returns the number of
+// messages of the given size that the node can issue during that interval.
+func (api *GoShimmerAPI) GetAccessManaProjection(messageSize int, interval time.Duration) (*jsonmodels.AccessManaProjectionResponse, error) {
+	res := &jsonmodels.AccessManaProjectionResponse{}
+	if err := api.do(http.MethodGet, routeAccessManaProjection, &jsonmodels.AccessManaProjectionRequest{
+		MessageSize: messageSize,
+		Interval:    int64(interval.Seconds()),
+	}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
 // GetPending returns the mana (bm2) that will be pledged by spending the output specified.
 func (api *GoShimmerAPI) GetPending(outputID string) (*jsonmodels.PendingResponse, error) {
 	res := &jsonmodels.PendingResponse{}
@@ -177,3 +195,33 @@ func (api *GoShimmerAPI) GetAllowedManaPledgeNodeIDs() (*jsonmodels.AllowedManaP
 
 	return res, nil
 }
+
+// GetManaAudit returns the recorded mana pledges and revocations of the given node (base58 encoded) and transaction
+// that happened since the given time. Empty IDs and a zero time do not restrict the result and a limit greater than 0
+// only returns the most recent records.
+func (api *GoShimmerAPI) GetManaAudit(nodeID, transactionID string, since time.Time, limit int) (*jsonmodels.ManaAuditResponse, error) {
+	query := url.Values{}
+	if nodeID != "" {
+		query.Set("nodeID", nodeID)
+	}
+	if transactionID != "" {
+		query.Set("transactionID", transactionID)
+	}
+	if !since.IsZero() {
+		query.Set("since", strconv.FormatInt(since.Unix(), 10))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	route := routeManaAudit
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.ManaAuditResponse{}
+	if err := api.do(http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
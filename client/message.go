@@ -1,16 +1,30 @@
 package client
 
 import (
+	"encoding/base64"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/gorilla/websocket"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+	"github.com/iotaledger/goshimmer/packages/webapiproto"
 )
 
 const (
-	routeMessage         = "messages/"
-	routeMessageMetadata = "/metadata"
-	routeSendPayload     = "messages/payload"
-	routeSendMessage     = "tools/message"
+	routeMessage                    = "messages/"
+	routeMessageMetadata            = "/metadata"
+	routeMessageApprovedByConfirmed = "/approvedbyconfirmed"
+	routeSendPayload                = "messages/payload"
+	routeSendMessage                = "tools/message"
+	routeIssuerMessages             = "messages/issuer/"
+	routeMessageStream              = "messages/stream"
 )
 
 // GetMessage is the handler for the /messages/:messageID endpoint.
@@ -29,6 +43,17 @@ func (api *GoShimmerAPI) GetMessage(base58EncodedID string) (*jsonmodels.Message
 	return res, nil
 }
 
+// GetMessageProtobuf gets the serialized message with the given ID encoded with protobuf, which is considerably cheaper
+// than GetMessage for clients that fetch many messages.
+func (api *GoShimmerAPI) GetMessageProtobuf(base58EncodedID string) (*webapiproto.Message, error) {
+	res := &webapiproto.Message{}
+	if err := api.do(http.MethodGet, routeMessage+base58EncodedID, nil, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // GetMessageMetadata is the handler for the /messages/:messageID/metadata endpoint.
 func (api *GoShimmerAPI) GetMessageMetadata(base58EncodedID string) (*jsonmodels.MessageMetadata, error) {
 	res := &jsonmodels.MessageMetadata{}
@@ -45,10 +70,112 @@ func (api *GoShimmerAPI) GetMessageMetadata(base58EncodedID string) (*jsonmodels
 	return res, nil
 }
 
+// GetMessageApprovedByConfirmed is the handler for the /messages/:messageID/approvedbyconfirmed endpoint.
+func (api *GoShimmerAPI) GetMessageApprovedByConfirmed(base58EncodedID string) (*jsonmodels.MessageApprovedByConfirmed, error) {
+	res := &jsonmodels.MessageApprovedByConfirmed{}
+
+	if err := api.do(
+		http.MethodGet,
+		routeMessage+base58EncodedID+routeMessageApprovedByConfirmed,
+		nil,
+		res,
+	); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetIssuerMessages returns the messages of the issuer with the given public key whose issuing time lies within
+// [from, to). A zero time leaves the corresponding end of the range open. The node only indexes the recent messages of
+// every issuer, which is indicated by the Truncated flag of the response.
+func (api *GoShimmerAPI) GetIssuerMessages(base58EncodedPublicKey string, from, to time.Time) (*jsonmodels.IssuerMessagesResponse, error) {
+	query := url.Values{}
+	if !from.IsZero() {
+		query.Set("from", strconv.FormatInt(from.Unix(), 10))
+	}
+	if !to.IsZero() {
+		query.Set("to", strconv.FormatInt(to.Unix(), 10))
+	}
+
+	route := routeIssuerMessages + base58EncodedPublicKey
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.IssuerMessagesResponse{}
+	if err := api.do(http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SubscribeMessages subscribes to the stream of messages that reach the given grade of finality. If payload types are
+// given, only messages with one of these payload types are streamed. The callback is called for every message. The call
+// blocks until the node closes the subscription or the context is done. A node closes the subscription of a client
+// that can not keep up with the stream.
+func (api *GoShimmerAPI) SubscribeMessages(minGoF gof.GradeOfFinality, payloadTypes []payload.Type, callback func(message *jsonmodels.StreamedMessage)) error {
+	ctx := api.context()
+
+	header := http.Header{}
+	if api.basicAuth.IsEnabled() {
+		username, password := api.basicAuth.Credentials()
+		header.Set("Authorization", "Basic "+base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	}
+	if api.apiKey != "" {
+		header.Set(jsonmodels.APIKeyHeader, api.apiKey)
+	}
+
+	query := url.Values{}
+	query.Set("minGoF", strconv.FormatUint(uint64(minGoF), 10))
+	for _, payloadType := range payloadTypes {
+		query.Add("payloadType", strconv.FormatUint(uint64(payloadType), 10))
+	}
+
+	streamURL := strings.Join([]string{strings.Replace(api.baseURL, "http", "ws", 1), "/", routeMessageStream, "?", query.Encode()}, "")
+	conn, res, err := websocket.DefaultDialer.DialContext(ctx, streamURL, header)
+	if err != nil {
+		// the node rejected the subscription with a regular response
+		if res != nil && res.StatusCode != http.StatusSwitchingProtocols {
+			if interpretErr := interpretBody(res, &jsonmodels.StreamedMessage{}); interpretErr != nil {
+				return interpretErr
+			}
+		}
+		return err
+	}
+	defer conn.Close()
+
+	// unblock the read if the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		message := &jsonmodels.StreamedMessage{}
+		if err = conn.ReadJSON(message); err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		callback(message)
+	}
+}
+
 // SendPayload send a message with the given payload.
 func (api *GoShimmerAPI) SendPayload(payload []byte) (string, error) {
 	res := &jsonmodels.PostPayloadResponse{}
-	if err := api.do(http.MethodPost, routeSendPayload,
+	if err := api.doIssuance(http.MethodPost, routeSendPayload,
 		&jsonmodels.PostPayloadRequest{Payload: payload}, res); err != nil {
 		return "", err
 	}
@@ -56,10 +183,33 @@ func (api *GoShimmerAPI) SendPayload(payload []byte) (string, error) {
 	return res.ID, nil
 }
 
+// SendPayloadProtobuf sends the given payload like SendPayload, but encodes the request and the response with
+// protobuf. It returns the ID of the issued message.
+func (api *GoShimmerAPI) SendPayloadProtobuf(payload []byte) (messageID []byte, err error) {
+	res := &webapiproto.PostPayloadResponse{}
+	if err = api.doIssuance(http.MethodPost, routeSendPayload, &webapiproto.PostPayloadRequest{Payload: payload}, res); err != nil {
+		return nil, err
+	}
+
+	return res.Id, nil
+}
+
+// SendPayloadDryRun validates the given payload like SendPayload without issuing it and returns the diagnosis of all
+// checks.
+func (api *GoShimmerAPI) SendPayloadDryRun(payload []byte) (*jsonmodels.DryRunResponse, error) {
+	res := &jsonmodels.DryRunResponse{}
+	if err := api.do(http.MethodPost, fmt.Sprintf("%s?%s=true", routeSendPayload, jsonmodels.DryRunQueryParameter),
+		&jsonmodels.PostPayloadRequest{Payload: payload}, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // SendMessage sends the given message to the backend.
 func (api *GoShimmerAPI) SendMessage(req *jsonmodels.SendMessageRequest) (string, error) {
 	res := &jsonmodels.DataResponse{}
-	if err := api.do(http.MethodPost, routeSendMessage, req, res); err != nil {
+	if err := api.doIssuance(http.MethodPost, routeSendMessage, req, res); err != nil {
 		return "", err
 	}
 
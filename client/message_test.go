@@ -0,0 +1,49 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestGoShimmerAPI_SubscribeMessages(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/messages/stream" || r.URL.Query().Get("minGoF") != "2" {
+			w.Header().Set(contentType, contentTypeJSON)
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid minGoF"}`))
+			return
+		}
+		assert.Equal(t, []string{"0", "7"}, r.URL.Query()["payloadType"])
+
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		for _, messageID := range []string{"message1", "message2"} {
+			require.NoError(t, conn.WriteJSON(&jsonmodels.StreamedMessage{ID: messageID, GradeOfFinality: gof.Medium}))
+		}
+		require.NoError(t, conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second)))
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL)
+
+	var messageIDs []string
+	require.NoError(t, api.SubscribeMessages(gof.Medium, []payload.Type{payload.GenericDataPayloadType, payload.Type(7)}, func(message *jsonmodels.StreamedMessage) {
+		messageIDs = append(messageIDs, message.ID)
+	}))
+	assert.Equal(t, []string{"message1", "message2"}, messageIDs)
+
+	assert.ErrorIs(t, api.SubscribeMessages(gof.High, nil, func(*jsonmodels.StreamedMessage) {}), ErrBadRequest)
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/nameregistry"
+)
+
+const (
+	routeNames = "names"
+)
+
+// ResolveName returns the record of the given name that is registered on the Tangle.
+func (api *GoShimmerAPI) ResolveName(name string) (*jsonmodels.NameRecord, error) {
+	res := &jsonmodels.NameRecord{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%s", routeNames, name), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RegisterName issues a registration of the given name that resolves to the given target and is signed with the given
+// key pair. The sequence has to be greater than the sequence of the latest registration of the name when it is updated.
+// It returns the ID of the issued message.
+func (api *GoShimmerAPI) RegisterName(name string, target ledgerstate.Address, sequence uint64, keyPair ed25519.KeyPair) (string, error) {
+	if err := nameregistry.ValidateName(name); err != nil {
+		return "", err
+	}
+
+	return api.SendPayload(nameregistry.NewPayload(name, target, sequence, keyPair.PublicKey, keyPair.PrivateKey.Sign).Bytes())
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	routeOTVDecisions  = "otv/decisions"
+	routeOTVStatistics = "otv/statistics"
+	routeOTVStatements = "otv/statements"
+)
+
+// GetOTVDecisions returns the recorded preference flips of the OTV consensus. An undefined branchID, a zero since and
+// a zero limit do not restrict the result.
+func (api *GoShimmerAPI) GetOTVDecisions(branchID ledgerstate.BranchID, since time.Time, limit int) (*jsonmodels.OTVDecisionsResponse, error) {
+	query := url.Values{}
+	if branchID != ledgerstate.UndefinedBranchID {
+		query.Set("branchID", branchID.Base58())
+	}
+	if !since.IsZero() {
+		query.Set("since", strconv.FormatInt(since.Unix(), 10))
+	}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	route := routeOTVDecisions
+	if len(query) > 0 {
+		route += "?" + query.Encode()
+	}
+
+	res := &jsonmodels.OTVDecisionsResponse{}
+	if err := api.do(http.MethodGet, route, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOTVStatistics returns the statistics about the preference flips of the OTV consensus.
+func (api *GoShimmerAPI) GetOTVStatistics() (*jsonmodels.OTVStatisticsResponse, error) {
+	res := &jsonmodels.OTVStatisticsResponse{}
+	if err := api.do(http.MethodGet, routeOTVStatistics, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetOTVStatements returns up to limit statements of the node starting at the given index together with the signature
+// of the node (a zero limit returns the maximum number of statements per request). The export can be verified via
+// res.Export() and statementlog.Export.Verify().
+func (api *GoShimmerAPI) GetOTVStatements(start uint64, limit int) (*jsonmodels.OTVStatementsResponse, error) {
+	query := url.Values{}
+	query.Set("start", strconv.FormatUint(start, 10))
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	res := &jsonmodels.OTVStatementsResponse{}
+	if err := api.do(http.MethodGet, routeOTVStatements+"?"+query.Encode(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
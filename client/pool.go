@@ -0,0 +1,315 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+var (
+	// ErrNoNodes is returned if a NodePool is created without nodes.
+	ErrNoNodes = errors.New("no nodes in the pool")
+	// ErrQuorumNotReached is returned if not enough nodes agreed on the result of a quorum read.
+	ErrQuorumNotReached = errors.New("quorum not reached")
+)
+
+// region NodePool /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodePool is a client that distributes calls across several nodes. Calls fail over to the next node if a node
+// returns an error, and critical reads can be cross-checked against a quorum of nodes to detect desynced nodes.
+type NodePool struct {
+	nodes            []*GoShimmerAPI
+	quorum           int
+	failoverCooldown time.Duration
+	state            *nodePoolState
+	ctx              context.Context
+}
+
+// nodePoolState contains the state that is shared between the copies of a NodePool.
+type nodePoolState struct {
+	next           int
+	unhealthyUntil []time.Time
+	mutex          sync.Mutex
+}
+
+// NewNodePool returns a NodePool for the given nodes. By default, quorum reads require the majority of the nodes to
+// agree on the result.
+func NewNodePool(nodes []*GoShimmerAPI, options ...NodePoolOption) (*NodePool, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoNodes
+	}
+
+	pool := &NodePool{
+		nodes:            nodes,
+		quorum:           len(nodes)/2 + 1,
+		failoverCooldown: 10 * time.Second,
+		state: &nodePoolState{
+			unhealthyUntil: make([]time.Time, len(nodes)),
+		},
+	}
+	for _, option := range options {
+		option(pool)
+	}
+
+	if pool.quorum < 1 || pool.quorum > len(nodes) {
+		return nil, errors.Errorf("quorum of %d is not possible with %d nodes", pool.quorum, len(nodes))
+	}
+
+	return pool, nil
+}
+
+// Nodes returns the nodes of the pool.
+func (p *NodePool) Nodes() []*GoShimmerAPI {
+	return p.nodes
+}
+
+// WithContext returns a copy of the pool whose calls use the given context.
+func (p *NodePool) WithContext(ctx context.Context) *NodePool {
+	poolWithContext := *p
+	poolWithContext.ctx = ctx
+
+	return &poolWithContext
+}
+
+// Node returns the node that should be used for the next call. Calls that issue messages should be sent to a single
+// node, since failing over to another node after a timeout might issue the message twice.
+func (p *NodePool) Node() *GoShimmerAPI {
+	return p.withContext(p.nodes[p.order()[0]])
+}
+
+// Do executes the call on the nodes of the pool until it succeeds. Nodes that fail are skipped by subsequent calls for
+// the duration of the failover cooldown. The call should only read data, since it might be executed several times.
+func (p *NodePool) Do(call func(api *GoShimmerAPI) error) (err error) {
+	for _, index := range p.order() {
+		if err = call(p.withContext(p.nodes[index])); err == nil {
+			p.markHealthy(index)
+			return nil
+		}
+
+		// the request itself is invalid or was canceled, so no other node will succeed
+		if errors.Is(err, ErrBadRequest) || p.context().Err() != nil {
+			return err
+		}
+		p.markUnhealthy(index)
+	}
+
+	return errors.Errorf("call failed on all %d nodes: %w", len(p.nodes), err)
+}
+
+// Quorum executes the read on all nodes of the pool and returns the result as soon as the configured number of nodes
+// returned results with the same fingerprint. The fingerprint should only contain the parts of the result that all
+// synced nodes agree on.
+func (p *NodePool) Quorum(read QuorumRead) (result interface{}, err error) {
+	ctx, cancel := context.WithCancel(p.context())
+	defer cancel()
+
+	type response struct {
+		index       int
+		result      interface{}
+		fingerprint string
+		err         error
+	}
+	responses := make(chan response, len(p.nodes))
+	for index, node := range p.nodes {
+		go func(index int, node *GoShimmerAPI) {
+			readResult, fingerprint, readErr := read(node.WithContext(ctx))
+			responses <- response{index: index, result: readResult, fingerprint: fingerprint, err: readErr}
+		}(index, node)
+	}
+
+	votes := make(map[string]int)
+	var lastErr error
+	for i := 0; i < len(p.nodes); i++ {
+		res := <-responses
+		if res.err != nil {
+			lastErr = res.err
+			p.markUnhealthy(res.index)
+			continue
+		}
+		p.markHealthy(res.index)
+
+		if votes[res.fingerprint]++; votes[res.fingerprint] >= p.quorum {
+			return res.result, nil
+		}
+	}
+
+	if err = p.context().Err(); err != nil {
+		return nil, err
+	}
+	if lastErr != nil {
+		return nil, errors.Errorf("%d of %d nodes agreed (last error: %s): %w", maxVotes(votes), p.quorum, lastErr, ErrQuorumNotReached)
+	}
+
+	return nil, errors.Errorf("%d of %d nodes agreed: %w", maxVotes(votes), p.quorum, ErrQuorumNotReached)
+}
+
+// GetAddressUnspentOutputs gets the unspent outputs of an address from a quorum of nodes.
+func (p *NodePool) GetAddressUnspentOutputs(base58EncodedAddress string) (*jsonmodels.GetAddressResponse, error) {
+	result, err := p.Quorum(func(api *GoShimmerAPI) (interface{}, string, error) {
+		res, err := api.GetAddressUnspentOutputs(base58EncodedAddress)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return res, outputsFingerprint(res.Outputs), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*jsonmodels.GetAddressResponse), nil
+}
+
+// PostAddressUnspentOutputs gets the unspent outputs of several addresses from a quorum of nodes.
+func (p *NodePool) PostAddressUnspentOutputs(base58EncodedAddresses []string) (*jsonmodels.PostAddressesUnspentOutputsResponse, error) {
+	result, err := p.Quorum(func(api *GoShimmerAPI) (interface{}, string, error) {
+		res, err := api.PostAddressUnspentOutputs(base58EncodedAddresses)
+		if err != nil {
+			return nil, "", err
+		}
+
+		fingerprints := make([]string, 0, len(res.UnspentOutputs))
+		for _, outputsOnAddress := range res.UnspentOutputs {
+			outputs := make([]*jsonmodels.Output, 0, len(outputsOnAddress.Outputs))
+			for i := range outputsOnAddress.Outputs {
+				outputs = append(outputs, &outputsOnAddress.Outputs[i].Output)
+			}
+			fingerprints = append(fingerprints, outputsOnAddress.Address.Base58+":"+outputsFingerprint(outputs))
+		}
+		sort.Strings(fingerprints)
+
+		return res, strings.Join(fingerprints, ";"), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*jsonmodels.PostAddressesUnspentOutputsResponse), nil
+}
+
+// GetTransactionMetadata gets the metadata of a transaction from a quorum of nodes that agree on its grade of finality.
+func (p *NodePool) GetTransactionMetadata(base58EncodedTransactionID string) (*jsonmodels.TransactionMetadata, error) {
+	result, err := p.Quorum(func(api *GoShimmerAPI) (interface{}, string, error) {
+		res, err := api.GetTransactionMetadata(base58EncodedTransactionID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return res, res.GradeOfFinality.String(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result.(*jsonmodels.TransactionMetadata), nil
+}
+
+// order returns the indices of the nodes in the order in which they should be tried. The nodes are rotated with every
+// call and unhealthy nodes are only tried after all healthy nodes.
+func (p *NodePool) order() []int {
+	p.state.mutex.Lock()
+	defer p.state.mutex.Unlock()
+
+	now := time.Now()
+	healthy := make([]int, 0, len(p.nodes))
+	unhealthy := make([]int, 0)
+	for i := range p.nodes {
+		index := (p.state.next + i) % len(p.nodes)
+		if now.Before(p.state.unhealthyUntil[index]) {
+			unhealthy = append(unhealthy, index)
+			continue
+		}
+		healthy = append(healthy, index)
+	}
+	p.state.next = (p.state.next + 1) % len(p.nodes)
+
+	return append(healthy, unhealthy...)
+}
+
+func (p *NodePool) markHealthy(index int) {
+	p.state.mutex.Lock()
+	defer p.state.mutex.Unlock()
+
+	p.state.unhealthyUntil[index] = time.Time{}
+}
+
+func (p *NodePool) markUnhealthy(index int) {
+	p.state.mutex.Lock()
+	defer p.state.mutex.Unlock()
+
+	p.state.unhealthyUntil[index] = time.Now().Add(p.failoverCooldown)
+}
+
+func (p *NodePool) withContext(node *GoShimmerAPI) *GoShimmerAPI {
+	if p.ctx == nil {
+		return node
+	}
+
+	return node.WithContext(p.ctx)
+}
+
+func (p *NodePool) context() context.Context {
+	if p.ctx == nil {
+		return context.Background()
+	}
+
+	return p.ctx
+}
+
+// QuorumRead is a read that is executed by NodePool.Quorum. It returns the result of a node together with the
+// fingerprint that is used to compare the results of the different nodes.
+type QuorumRead func(api *GoShimmerAPI) (result interface{}, fingerprint string, err error)
+
+// outputsFingerprint returns a fingerprint of the given outputs that is independent of their order.
+func outputsFingerprint(outputs []*jsonmodels.Output) string {
+	fingerprints := make([]string, 0, len(outputs))
+	for _, output := range outputs {
+		var outputID string
+		if output.OutputID != nil {
+			outputID = output.OutputID.Base58
+		}
+		fingerprints = append(fingerprints, outputID+"="+string(output.Output))
+	}
+	sort.Strings(fingerprints)
+
+	return strings.Join(fingerprints, ",")
+}
+
+func maxVotes(votes map[string]int) (max int) {
+	for _, count := range votes {
+		if count > max {
+			max = count
+		}
+	}
+
+	return max
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NodePoolOption ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodePoolOption is an option of a NodePool.
+type NodePoolOption func(pool *NodePool)
+
+// WithQuorum sets the number of nodes that need to agree on the result of a quorum read.
+func WithQuorum(quorum int) NodePoolOption {
+	return func(pool *NodePool) {
+		pool.quorum = quorum
+	}
+}
+
+// WithFailoverCooldown sets the duration for which a failed node is only used if all other nodes failed as well.
+func WithFailoverCooldown(cooldown time.Duration) NodePoolOption {
+	return func(pool *NodePool) {
+		pool.failoverCooldown = cooldown
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodePool_Do(t *testing.T) {
+	var failingCalls, healthyCalls int32
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&failingCalls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	healthy := newTestNode(&healthyCalls, `{"outputs":[]}`)
+	defer healthy.Close()
+
+	pool, err := NewNodePool([]*GoShimmerAPI{NewGoShimmerAPI(failing.URL), NewGoShimmerAPI(healthy.URL)}, WithFailoverCooldown(time.Minute))
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		require.NoError(t, pool.Do(func(api *GoShimmerAPI) error {
+			_, err := api.GetAddressUnspentOutputs("address")
+			return err
+		}))
+	}
+
+	// the failing node is skipped during its cooldown
+	assert.EqualValues(t, 1, atomic.LoadInt32(&failingCalls))
+	assert.EqualValues(t, 4, atomic.LoadInt32(&healthyCalls))
+
+	// bad requests are not failed over
+	badRequest := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, contentTypeJSON)
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid address"}`))
+	}))
+	defer badRequest.Close()
+	pool, err = NewNodePool([]*GoShimmerAPI{NewGoShimmerAPI(badRequest.URL), NewGoShimmerAPI(healthy.URL)})
+	require.NoError(t, err)
+	assert.ErrorIs(t, pool.Do(func(api *GoShimmerAPI) error {
+		_, err := api.GetAddressUnspentOutputs("address")
+		return err
+	}), ErrBadRequest)
+}
+
+func TestNodePool_Quorum(t *testing.T) {
+	synced := `{"outputs":[{"outputID":{"base58":"A"},"type":"SigLockedSingleOutputType","output":{"balances":{"IOTA":100}}},{"outputID":{"base58":"B"},"type":"SigLockedSingleOutputType","output":{"balances":{"IOTA":5}}}]}`
+	syncedReordered := `{"outputs":[{"outputID":{"base58":"B"},"type":"SigLockedSingleOutputType","output":{"balances":{"IOTA":5}}},{"outputID":{"base58":"A"},"type":"SigLockedSingleOutputType","output":{"balances":{"IOTA":100}}}]}`
+	desynced := `{"outputs":[{"outputID":{"base58":"A"},"type":"SigLockedSingleOutputType","output":{"balances":{"IOTA":100}}}]}`
+
+	nodes := make([]*GoShimmerAPI, 0)
+	for _, body := range []string{desynced, synced, syncedReordered} {
+		server := newTestNode(nil, body)
+		defer server.Close()
+		nodes = append(nodes, NewGoShimmerAPI(server.URL))
+	}
+
+	pool, err := NewNodePool(nodes)
+	require.NoError(t, err)
+	res, err := pool.GetAddressUnspentOutputs("address")
+	require.NoError(t, err)
+	assert.Len(t, res.Outputs, 2)
+
+	// all nodes need to agree
+	pool, err = NewNodePool(nodes, WithQuorum(3))
+	require.NoError(t, err)
+	_, err = pool.GetAddressUnspentOutputs("address")
+	assert.ErrorIs(t, err, ErrQuorumNotReached)
+
+	_, err = NewNodePool(nodes, WithQuorum(4))
+	assert.Error(t, err)
+	_, err = NewNodePool(nil)
+	assert.ErrorIs(t, err, ErrNoNodes)
+}
+
+func TestNodePool_WithContext(t *testing.T) {
+	blocking := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer blocking.Close()
+
+	pool, err := NewNodePool([]*GoShimmerAPI{NewGoShimmerAPI(blocking.URL), NewGoShimmerAPI(blocking.URL)})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = pool.WithContext(ctx).GetTransactionMetadata("transactionID")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func newTestNode(calls *int32, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls != nil {
+			atomic.AddInt32(calls, 1)
+		}
+		w.Header().Set(contentType, contentTypeJSON)
+		_, _ = fmt.Fprint(w, body)
+	}))
+}
@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/profiling"
+)
+
+const (
+	routeProfileCaptures = "profiling/captures"
+)
+
+// GetProfileCaptures returns the profiles that were captured by the node when an anomaly was detected.
+func (api *GoShimmerAPI) GetProfileCaptures() (*jsonmodels.ProfileCapturesResponse, error) {
+	res := &jsonmodels.ProfileCapturesResponse{}
+	if err := api.do(http.MethodGet, routeProfileCaptures, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetProfile returns the profile of the given kind of a capture in the pprof format.
+func (api *GoShimmerAPI) GetProfile(captureID string, kind profiling.Kind) ([]byte, error) {
+	var res []byte
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%s/%s", routeProfileCaptures, captureID, kind), nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
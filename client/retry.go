@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"math"
+	mathrand "math/rand"
+	"net/http"
+	"time"
+
+	"github.com/mr-tron/base58"
+)
+
+// region RetryPolicy //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RetryPolicy defines how failed requests are retried. Only requests that can safely be repeated are retried, i.e.
+// requests with an idempotent method and requests to issuance endpoints that carry an idempotency key.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts (including the first one). Values smaller than 2 disable retries.
+	MaxAttempts int
+	// InitialBackoff is the time to wait before the first retry. The backoff doubles with every retry.
+	InitialBackoff time.Duration
+	// MaxBackoff is the upper bound of the backoff.
+	MaxBackoff time.Duration
+	// Jitter is the fraction (between 0 and 1) of the backoff that is randomized.
+	Jitter float64
+}
+
+// DefaultRetryPolicy is a RetryPolicy that is suitable for most integrations.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+	Jitter:         0.2,
+}
+
+// WithRetryPolicy sets the RetryPolicy of the client. By default, requests are not retried.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(g *GoShimmerAPI) {
+		g.retryPolicy = policy
+	}
+}
+
+// Backoff returns the time to wait before the given retry (starting at 1).
+func (r RetryPolicy) Backoff(retry int) time.Duration {
+	backoff := float64(r.InitialBackoff) * math.Pow(2, float64(retry-1))
+	if r.MaxBackoff > 0 && backoff > float64(r.MaxBackoff) {
+		backoff = float64(r.MaxBackoff)
+	}
+	if r.Jitter > 0 {
+		// randomize the backoff in [backoff * (1 - jitter), backoff * (1 + jitter)]
+		backoff *= 1 + r.Jitter*(2*mathrand.Float64()-1) //nolint:gosec // no need for a cryptographically secure jitter
+	}
+
+	return time.Duration(backoff)
+}
+
+// wait blocks until the backoff of the given retry has passed or the context is done.
+func (r RetryPolicy) wait(ctx context.Context, retry int) error {
+	timer := time.NewTimer(r.Backoff(retry))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// isRetryable returns true if the outcome of a request indicates a transient failure.
+func isRetryable(ctx context.Context, res *http.Response, err error) bool {
+	if err != nil {
+		// errors caused by the context of the caller are final
+		return ctx.Err() == nil
+	}
+
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isIdempotentMethod returns true if repeating a request with the given method has no additional effect.
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region idempotency keys /////////////////////////////////////////////////////////////////////////////////////////////
+
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey returns a context that makes issuance calls use the given idempotency key. Repeating a call
+// with the same key (e.g. after a timeout) returns the result of the first call instead of issuing a new message. If no
+// key is set, every call uses a new random key that is only reused for the retries of the call.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key that was set with ContextWithIdempotencyKey.
+func IdempotencyKeyFromContext(ctx context.Context) (key string, exists bool) {
+	key, exists = ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key, exists && key != ""
+}
+
+// NewIdempotencyKey returns a new random idempotency key.
+func NewIdempotencyKey() string {
+	keyBytes := make([]byte, 16)
+	if _, err := rand.Read(keyBytes); err != nil {
+		panic(err)
+	}
+
+	return base58.Encode(keyBytes)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// region responseVerifier /////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithResponseVerification makes the client verify the signed responses of the node with the given public key.
+// Responses whose signature is invalid or that were signed by another node are rejected, and the verified responses
+// are handed to the given callback (if it is not nil), so that they can be kept as proofs of the answers of the node.
+// The signatures only match if the base URL of the client has no path, i.e. the node sees the same request URI.
+func WithResponseVerification(publicKey ed25519.PublicKey, onVerifiedResponse func(signedResponse *jsonmodels.SignedResponse)) Option {
+	return func(g *GoShimmerAPI) {
+		g.responseVerifier = &responseVerifier{
+			publicKey:          publicKey,
+			onVerifiedResponse: onVerifiedResponse,
+		}
+	}
+}
+
+// VerifyResponse verifies that the given response with the given body was signed by the node with the given public
+// key and returns the SignedResponse.
+func VerifyResponse(res *http.Response, body []byte, publicKey ed25519.PublicKey) (signedResponse *jsonmodels.SignedResponse, err error) {
+	if signedResponse, err = jsonmodels.SignedResponseFromHeader(res.Request.Method, res.Request.URL.RequestURI(), res.StatusCode, res.Header, body); err != nil {
+		return nil, err
+	}
+	if err = signedResponse.Verify(publicKey); err != nil {
+		return nil, err
+	}
+
+	return signedResponse, nil
+}
+
+// responseVerifier verifies the signatures of the responses of a node.
+type responseVerifier struct {
+	publicKey          ed25519.PublicKey
+	onVerifiedResponse func(signedResponse *jsonmodels.SignedResponse)
+}
+
+// verify verifies the signature of the given response if it is signed. The body of the response is read and replaced,
+// so that it can still be decoded afterwards.
+func (r *responseVerifier) verify(res *http.Response) error {
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return errors.Errorf("unable to read response body: %w", err)
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	signedResponse, err := VerifyResponse(res, body, r.publicKey)
+	if err != nil {
+		if errors.Is(err, jsonmodels.ErrResponseNotSigned) {
+			return nil
+		}
+
+		return err
+	}
+
+	if r.onVerifiedResponse != nil {
+		r.onVerifiedResponse(signedResponse)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestGoShimmerAPI_ResponseVerification(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := []byte(`{"address":{"base58":"address"},"balances":{"IOTA":1337}}`)
+		if r.URL.Path != "/info" {
+			signedResponse := jsonmodels.NewSignedResponse(r.Method, r.URL.RequestURI(), http.StatusOK, time.Now().Unix(), body, localIdentity.PublicKey(), localIdentity.Sign)
+			for key, values := range signedResponse.Header() {
+				w.Header()[key] = values
+			}
+		}
+		w.Header().Set(contentType, contentTypeJSON)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	var verifiedResponses []*jsonmodels.SignedResponse
+	api := NewGoShimmerAPI(server.URL, WithResponseVerification(localIdentity.PublicKey(), func(signedResponse *jsonmodels.SignedResponse) {
+		verifiedResponses = append(verifiedResponses, signedResponse)
+	}))
+
+	balance, err := api.GetAddressBalance("address")
+	require.NoError(t, err)
+	assert.Equal(t, "address", balance.Address.Base58)
+	require.Len(t, verifiedResponses, 1)
+	assert.Equal(t, "/ledgerstate/addresses/address/balance", verifiedResponses[0].RequestURI)
+	assert.NoError(t, verifiedResponses[0].Verify(localIdentity.PublicKey()))
+
+	// unsigned responses are accepted without evidence
+	_, err = api.Info()
+	require.NoError(t, err)
+	assert.Len(t, verifiedResponses, 1)
+
+	// responses signed by another node are rejected
+	_, err = NewGoShimmerAPI(server.URL, WithResponseVerification(identity.GenerateLocalIdentity().PublicKey(), nil)).GetAddressBalance("address")
+	assert.ErrorIs(t, err, jsonmodels.ErrInvalidResponseSignature)
+}
@@ -0,0 +1,23 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+)
+
+const (
+	routeChunkedSnapshot = "snapshot/v2"
+)
+
+// DownloadSnapshot downloads the chunked snapshot of the node to the given path. Interrupted downloads are resumed
+// from the last verified chunk when the method is called again with the same path.
+func (api *GoShimmerAPI) DownloadSnapshot(ctx context.Context, path string) error {
+	return snapshot.Download(ctx, &api.httpClient, fmt.Sprintf("%s/%s", api.baseURL, routeChunkedSnapshot), path, func(req *http.Request) {
+		if api.basicAuth.IsEnabled() {
+			req.SetBasicAuth(api.basicAuth.Credentials())
+		}
+	})
+}
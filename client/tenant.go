@@ -0,0 +1,67 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeTenant          = "tenant"
+	routeTenantMessages  = "tenant/messages"
+	routeTenantAddresses = "tenant/addresses"
+)
+
+// WithAPIKey scopes every request of the client to the tenant that the given API key belongs to.
+func WithAPIKey(apiKey string) Option {
+	return func(g *GoShimmerAPI) {
+		g.apiKey = apiKey
+	}
+}
+
+// GetTenant returns the issuance quota and the usage of the tenant that the API key of the client belongs to.
+func (api *GoShimmerAPI) GetTenant() (*jsonmodels.TenantResponse, error) {
+	res := &jsonmodels.TenantResponse{}
+	if err := api.do(http.MethodGet, routeTenant, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetTenantMessages returns the IDs of the messages that were issued by the tenant of the client.
+func (api *GoShimmerAPI) GetTenantMessages() (*jsonmodels.TenantMessagesResponse, error) {
+	res := &jsonmodels.TenantMessagesResponse{}
+	if err := api.do(http.MethodGet, routeTenantMessages, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetWatchedAddresses returns the addresses that are watched by the tenant of the client.
+func (api *GoShimmerAPI) GetWatchedAddresses() (*jsonmodels.TenantAddressesResponse, error) {
+	res := &jsonmodels.TenantAddressesResponse{}
+	if err := api.do(http.MethodGet, routeTenantAddresses, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WatchAddress adds the given base58 encoded address to the addresses that are watched by the tenant of the client.
+func (api *GoShimmerAPI) WatchAddress(base58EncodedAddress string) (*jsonmodels.TenantAddressesResponse, error) {
+	res := &jsonmodels.TenantAddressesResponse{}
+	if err := api.do(http.MethodPut, fmt.Sprintf("%s/%s", routeTenantAddresses, base58EncodedAddress), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// UnwatchAddress removes the given base58 encoded address from the addresses that are watched by the tenant of the
+// client.
+func (api *GoShimmerAPI) UnwatchAddress(base58EncodedAddress string) (*jsonmodels.TenantAddressesResponse, error) {
+	res := &jsonmodels.TenantAddressesResponse{}
+	if err := api.do(http.MethodDelete, fmt.Sprintf("%s/%s", routeTenantAddresses, base58EncodedAddress), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
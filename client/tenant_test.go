@@ -0,0 +1,47 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestGoShimmerAPI_WithAPIKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, contentTypeJSON)
+		if r.Header.Get(jsonmodels.APIKeyHeader) != "aliceKey" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"invalid API key","code":"unauthorized"}`))
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/tenant":
+			require.NoError(t, json.NewEncoder(w).Encode(&jsonmodels.TenantResponse{Name: "alice", IssuanceQuota: 10}))
+		case r.Method == http.MethodPut && r.URL.Path == "/tenant/addresses/address1":
+			require.NoError(t, json.NewEncoder(w).Encode(&jsonmodels.TenantAddressesResponse{Addresses: []string{"address1"}}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	tenant, err := NewGoShimmerAPI(server.URL, WithAPIKey("aliceKey")).GetTenant()
+	require.NoError(t, err)
+	assert.Equal(t, "alice", tenant.Name)
+	assert.Equal(t, 10, tenant.IssuanceQuota)
+
+	addresses, err := NewGoShimmerAPI(server.URL, WithAPIKey("aliceKey")).WatchAddress("address1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"address1"}, addresses.Addresses)
+
+	_, err = NewGoShimmerAPI(server.URL).GetTenant()
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
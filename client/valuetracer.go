@@ -0,0 +1,34 @@
+package client
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeValueTracerStatistics = "valuetracer/statistics"
+	routeValueTracerTrace      = "valuetracer/traces/"
+)
+
+// GetValueTracerStatistics returns the aggregated end-to-end latencies of the value transfers that were traced by the
+// node.
+func (api *GoShimmerAPI) GetValueTracerStatistics() (*jsonmodels.ValueTracerStatisticsResponse, error) {
+	res := &jsonmodels.ValueTracerStatisticsResponse{}
+	if err := api.do(http.MethodGet, routeValueTracerStatistics, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetValueTracerTrace returns the stages that the given transaction passed on the node.
+func (api *GoShimmerAPI) GetValueTracerTrace(base58EncodedTransactionID string) (*jsonmodels.ValueTracerTraceResponse, error) {
+	res := &jsonmodels.ValueTracerTraceResponse{}
+	if err := api.do(http.MethodGet, func() string {
+		return strings.Join([]string{routeValueTracerTrace, base58EncodedTransactionID}, "")
+	}(), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
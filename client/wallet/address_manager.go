@@ -44,6 +44,17 @@ func (addressManager *AddressManager) Address(addressIndex uint64) address.Addre
 	return addressManager.seed.Address(addressIndex)
 }
 
+// DeriveAddresses returns count addresses starting at the given index. The addresses are derived deterministically from
+// the seed without modifying the state of the AddressManager.
+func (addressManager *AddressManager) DeriveAddresses(startIndex, count uint64) (addresses []address.Address) {
+	addresses = make([]address.Address, count)
+	for i := uint64(0); i < count; i++ {
+		addresses[i] = addressManager.seed.Address(startIndex + i)
+	}
+
+	return
+}
+
 // Addresses returns a list of all addresses of the wallet.
 func (addressManager *AddressManager) Addresses() (addresses []address.Address) {
 	addresses = make([]address.Address, addressManager.lastAddressIndex+1)
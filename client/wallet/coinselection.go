@@ -0,0 +1,265 @@
+package wallet
+
+import (
+	"bytes"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	// CoinSelectionLargestFirst is the name of the LargestFirstCoinSelection.
+	CoinSelectionLargestFirst = "largestFirst"
+	// CoinSelectionConfidence is the name of the ConfidenceCoinSelection.
+	CoinSelectionConfidence = "confidence"
+	// CoinSelectionPrivacy is the name of the PrivacyCoinSelection.
+	CoinSelectionPrivacy = "privacy"
+	// CoinSelectionDustMinimizing is the name of the DustMinimizingCoinSelection.
+	CoinSelectionDustMinimizing = "dustMinimizing"
+
+	// DefaultDustThreshold is the amount of IOTA below which an output is considered dust by the wallet.
+	DefaultDustThreshold = ledgerstate.DustThresholdAliasOutputIOTA
+)
+
+// region CoinSelectionStrategy ////////////////////////////////////////////////////////////////////////////////////////
+
+// CoinSelectionStrategy decides which of the unspent outputs of the wallet are consumed to fund a transaction.
+type CoinSelectionStrategy interface {
+	// SelectOutputs returns the candidates that are consumed to fund the target balances. The candidates are sorted by
+	// their OutputID and each of them holds at least one of the colors of the target. If the candidates are not
+	// sufficient, all of them can be returned.
+	SelectOutputs(candidates []*Output, target map[ledgerstate.Color]uint64) (selected []*Output)
+}
+
+// NewCoinSelectionStrategy returns the CoinSelectionStrategy with the given name.
+func NewCoinSelectionStrategy(name string) (strategy CoinSelectionStrategy, err error) {
+	switch name {
+	case CoinSelectionLargestFirst:
+		return LargestFirstCoinSelection{}, nil
+	case CoinSelectionConfidence, "":
+		return ConfidenceCoinSelection{}, nil
+	case CoinSelectionPrivacy:
+		return NewPrivacyCoinSelection(rand.NewSource(time.Now().UnixNano())), nil
+	case CoinSelectionDustMinimizing:
+		return DustMinimizingCoinSelection{}, nil
+	default:
+		return nil, errors.Errorf("unknown coin selection strategy '%s'", name)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region LargestFirstCoinSelection ////////////////////////////////////////////////////////////////////////////////////
+
+// LargestFirstCoinSelection consumes the outputs with the largest balances first, which minimizes the number of inputs
+// of the transaction.
+type LargestFirstCoinSelection struct{}
+
+// SelectOutputs implements the CoinSelectionStrategy interface.
+func (LargestFirstCoinSelection) SelectOutputs(candidates []*Output, target map[ledgerstate.Color]uint64) (selected []*Output) {
+	return selectInOrder(sortedByBalance(candidates, target, false), target)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConfidenceCoinSelection //////////////////////////////////////////////////////////////////////////////////////
+
+// ConfidenceCoinSelection consumes the outputs with the highest grade of finality first and prefers outputs that are
+// not booked into a conflict, so that a transaction depends as little as possible on pending outputs that could still
+// be rejected. Outputs with the same confidence are consumed largest first.
+type ConfidenceCoinSelection struct{}
+
+// SelectOutputs implements the CoinSelectionStrategy interface.
+func (ConfidenceCoinSelection) SelectOutputs(candidates []*Output, target map[ledgerstate.Color]uint64) (selected []*Output) {
+	sorted := sortedByBalance(candidates, target, false)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].GradeOfFinality != sorted[j].GradeOfFinality {
+			return sorted[i].GradeOfFinality > sorted[j].GradeOfFinality
+		}
+
+		return !sorted[i].Metadata.Conflicting && sorted[j].Metadata.Conflicting
+	})
+
+	return selectInOrder(sorted, target)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PrivacyCoinSelection /////////////////////////////////////////////////////////////////////////////////////////
+
+// PrivacyCoinSelection consumes the outputs in a random order, so that the inputs of a transaction don't reveal the
+// order in which the wallet received its funds. It prefers to fund a transaction from a single, randomly chosen address
+// to avoid linking several addresses of the wallet in the same transaction.
+type PrivacyCoinSelection struct {
+	random *rand.Rand
+}
+
+// NewPrivacyCoinSelection creates a PrivacyCoinSelection that draws its randomness from the given source.
+func NewPrivacyCoinSelection(source rand.Source) *PrivacyCoinSelection {
+	return &PrivacyCoinSelection{
+		random: rand.New(source),
+	}
+}
+
+// SelectOutputs implements the CoinSelectionStrategy interface.
+func (p *PrivacyCoinSelection) SelectOutputs(candidates []*Output, target map[ledgerstate.Color]uint64) (selected []*Output) {
+	shuffled := make([]*Output, len(candidates))
+	copy(shuffled, candidates)
+	p.random.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+
+	candidatesByAddress := make(map[string][]*Output)
+	addresses := make([]string, 0)
+	for _, candidate := range shuffled {
+		addressKey := candidate.Address.Base58()
+		if _, exists := candidatesByAddress[addressKey]; !exists {
+			addresses = append(addresses, addressKey)
+		}
+		candidatesByAddress[addressKey] = append(candidatesByAddress[addressKey], candidate)
+	}
+
+	for _, addressKey := range addresses {
+		if selected = selectInOrder(candidatesByAddress[addressKey], target); enoughCollected(totalBalances(selected), target) {
+			return selected
+		}
+	}
+
+	return selectInOrder(shuffled, target)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DustMinimizingCoinSelection //////////////////////////////////////////////////////////////////////////////////
+
+// DustMinimizingCoinSelection funds a transaction with as few large outputs as possible and additionally consumes the
+// dust outputs of the wallet (as long as the input limit of a transaction allows it), so that the dust is merged into the
+// remainder. If the remainder would be dust itself, it consumes another output to lift the remainder above the threshold.
+type DustMinimizingCoinSelection struct {
+	// DustThreshold is the amount of IOTA below which an output is considered dust (DefaultDustThreshold if 0).
+	DustThreshold uint64
+}
+
+// SelectOutputs implements the CoinSelectionStrategy interface.
+func (d DustMinimizingCoinSelection) SelectOutputs(candidates []*Output, target map[ledgerstate.Color]uint64) (selected []*Output) {
+	dustThreshold := d.DustThreshold
+	if dustThreshold == 0 {
+		dustThreshold = DefaultDustThreshold
+	}
+
+	if selected = selectInOrder(sortedByBalance(candidates, target, false), target); !enoughCollected(totalBalances(selected), target) {
+		return selected
+	}
+
+	isSelected := make(map[ledgerstate.OutputID]bool)
+	for _, output := range selected {
+		isSelected[output.Object.ID()] = true
+	}
+
+	// sweep the dust into the remainder
+	ascending := sortedByBalance(candidates, target, true)
+	for _, candidate := range ascending {
+		if len(selected) >= ledgerstate.MaxInputCount {
+			break
+		}
+		if !isSelected[candidate.Object.ID()] && iotaBalance(candidate) < dustThreshold {
+			selected = append(selected, candidate)
+			isSelected[candidate.Object.ID()] = true
+		}
+	}
+
+	// avoid creating a new dust output for the remainder
+	if remainder := iotaRemainder(selected, target); remainder == 0 || remainder >= dustThreshold || len(selected) >= ledgerstate.MaxInputCount {
+		return selected
+	}
+	for _, candidate := range ascending {
+		if !isSelected[candidate.Object.ID()] && iotaRemainder(selected, target)+iotaBalance(candidate) >= dustThreshold {
+			return append(selected, candidate)
+		}
+	}
+
+	return selected
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// selectInOrder returns the shortest prefix of the given outputs that funds the target (or all outputs if they are not
+// sufficient).
+func selectInOrder(outputs []*Output, target map[ledgerstate.Color]uint64) (selected []*Output) {
+	collected := make(map[ledgerstate.Color]uint64)
+	for _, output := range outputs {
+		selected = append(selected, output)
+		output.Object.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			collected[color] += balance
+			return true
+		})
+
+		if enoughCollected(collected, target) {
+			return selected
+		}
+	}
+
+	return selected
+}
+
+// sortedByBalance returns a copy of the given outputs that is sorted by the balance of the colors of the target. Ties
+// are broken by the OutputID, so that the order is deterministic.
+func sortedByBalance(outputs []*Output, target map[ledgerstate.Color]uint64, ascending bool) (sorted []*Output) {
+	targetBalance := func(output *Output) (balance uint64) {
+		output.Object.Balances().ForEach(func(color ledgerstate.Color, colorBalance uint64) bool {
+			if _, isTarget := target[color]; isTarget {
+				balance += colorBalance
+			}
+			return true
+		})
+		return balance
+	}
+
+	sorted = make([]*Output, len(outputs))
+	copy(sorted, outputs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if balanceI, balanceJ := targetBalance(sorted[i]), targetBalance(sorted[j]); balanceI != balanceJ {
+			return (balanceI < balanceJ) == ascending
+		}
+
+		return bytes.Compare(sorted[i].Object.ID().Bytes(), sorted[j].Object.ID().Bytes()) < 0
+	})
+
+	return sorted
+}
+
+// totalBalances returns the sum of the balances of the given outputs.
+func totalBalances(outputs []*Output) (balances map[ledgerstate.Color]uint64) {
+	balances = make(map[ledgerstate.Color]uint64)
+	for _, output := range outputs {
+		output.Object.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			balances[color] += balance
+			return true
+		})
+	}
+
+	return balances
+}
+
+// iotaRemainder returns the amount of IOTA that the given outputs hold in excess of the target.
+func iotaRemainder(outputs []*Output, target map[ledgerstate.Color]uint64) uint64 {
+	if collected := totalBalances(outputs)[ledgerstate.ColorIOTA]; collected > target[ledgerstate.ColorIOTA] {
+		return collected - target[ledgerstate.ColorIOTA]
+	}
+
+	return 0
+}
+
+// iotaBalance returns the amount of IOTA held by the given output.
+func iotaBalance(output *Output) (balance uint64) {
+	balance, _ = output.Object.Balances().Get(ledgerstate.ColorIOTA)
+	return balance
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,181 @@
+package wallet
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestLargestFirstCoinSelection(t *testing.T) {
+	addy := seed.NewSeed().Address(0)
+	candidates := fragmentedOutputs(addy, 50, 5)
+	candidates = append(candidates, newTestOutput(addy, 50, 1000, gof.High), newTestOutput(addy, 51, 500, gof.High))
+
+	selected := LargestFirstCoinSelection{}.SelectOutputs(candidates, iotaTarget(1200))
+	assert.Equal(t, []uint64{1000, 500}, iotaBalances(selected))
+
+	// all candidates are returned if they are not sufficient
+	assert.Len(t, LargestFirstCoinSelection{}.SelectOutputs(candidates, iotaTarget(10000)), len(candidates))
+}
+
+func TestConfidenceCoinSelection(t *testing.T) {
+	addy := seed.NewSeed().Address(0)
+	conflicting := newTestOutput(addy, 0, 1000, gof.Low)
+	conflicting.Metadata.Conflicting = true
+	candidates := []*Output{
+		conflicting,
+		newTestOutput(addy, 1, 500, gof.Low),
+		newTestOutput(addy, 2, 100, gof.High),
+		newTestOutput(addy, 3, 50, gof.Medium),
+	}
+
+	assert.Equal(t, []uint64{100, 50}, iotaBalances(ConfidenceCoinSelection{}.SelectOutputs(candidates, iotaTarget(150))))
+	assert.Equal(t, []uint64{100, 50, 500}, iotaBalances(ConfidenceCoinSelection{}.SelectOutputs(candidates, iotaTarget(600))))
+	assert.Equal(t, []uint64{100, 50, 500, 1000}, iotaBalances(ConfidenceCoinSelection{}.SelectOutputs(candidates, iotaTarget(700))))
+}
+
+func TestPrivacyCoinSelection(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	candidates := append(fragmentedOutputs(walletSeed.Address(0), 10, 100), fragmentedOutputs(walletSeed.Address(1), 10, 100)...)
+
+	orders := make(map[string]bool)
+	for i := int64(0); i < 10; i++ {
+		selected := NewPrivacyCoinSelection(rand.NewSource(i)).SelectOutputs(candidates, iotaTarget(500))
+		require.Len(t, selected, 5)
+
+		// a single address is sufficient, so the addresses are not linked
+		for _, output := range selected {
+			assert.Equal(t, selected[0].Address, output.Address)
+		}
+		orders[selected[0].Object.ID().Base58()] = true
+	}
+	assert.Greater(t, len(orders), 1)
+
+	// several addresses are combined if necessary
+	selected := NewPrivacyCoinSelection(rand.NewSource(0)).SelectOutputs(candidates, iotaTarget(1500))
+	assert.Equal(t, uint64(1500), totalBalances(selected)[ledgerstate.ColorIOTA])
+}
+
+func TestDustMinimizingCoinSelection(t *testing.T) {
+	addy := seed.NewSeed().Address(0)
+
+	// the dust is swept into the remainder
+	candidates := []*Output{
+		newTestOutput(addy, 0, 1000, gof.High),
+		newTestOutput(addy, 1, 10, gof.High),
+		newTestOutput(addy, 2, 20, gof.High),
+		newTestOutput(addy, 3, 300, gof.High),
+	}
+	selected := DustMinimizingCoinSelection{}.SelectOutputs(candidates, iotaTarget(500))
+	assert.Equal(t, []uint64{1000, 10, 20}, iotaBalances(selected))
+
+	// no dust remainder is created
+	candidates = []*Output{
+		newTestOutput(addy, 0, 600, gof.High),
+		newTestOutput(addy, 1, 150, gof.High),
+		newTestOutput(addy, 2, 400, gof.High),
+	}
+	assert.Equal(t, []uint64{600}, iotaBalances(LargestFirstCoinSelection{}.SelectOutputs(candidates, iotaTarget(550))))
+	assert.Equal(t, []uint64{600, 150}, iotaBalances(DustMinimizingCoinSelection{}.SelectOutputs(candidates, iotaTarget(550))))
+	assert.Equal(t, []uint64{600}, iotaBalances(DustMinimizingCoinSelection{DustThreshold: 10}.SelectOutputs(candidates, iotaTarget(550))))
+
+	// the input limit of a transaction is respected
+	candidates = append(fragmentedOutputs(addy, 200, 1), newTestOutput(addy, 200, 1000, gof.High))
+	selected = DustMinimizingCoinSelection{}.SelectOutputs(candidates, iotaTarget(500))
+	assert.Len(t, selected, ledgerstate.MaxInputCount)
+	assert.Equal(t, uint64(1000), iotaBalance(selected[0]))
+}
+
+func TestWallet_CollectOutputsForFunding(t *testing.T) {
+	walletSeed := seed.NewSeed()
+	addy := walletSeed.Address(0)
+
+	conflicting := newTestOutput(addy, 0, 1000, gof.Low)
+	conflicting.Metadata.Conflicting = true
+	connector := &mockConnector{outputs: []*Output{
+		conflicting,
+		newTestOutput(addy, 1, 100, gof.High),
+		newTestOutput(addy, 2, 50, gof.Low),
+	}}
+	wallet := New(Import(walletSeed, 0, nil, NewAssetRegistry(DefaultAssetRegistryNetwork)), GenericConnector(connector), CoinSelection(LargestFirstCoinSelection{}))
+
+	collected, err := wallet.collectOutputsForFunding(iotaTarget(100), false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, collected.OutputCount())
+
+	// pending outputs of conflicts are never collected
+	collected, err = wallet.collectOutputsForFunding(iotaTarget(150), true)
+	require.NoError(t, err)
+	assert.Equal(t, map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 150}, collected.TotalFundsInOutputs())
+	_, err = wallet.collectOutputsForFunding(iotaTarget(200), true)
+	assert.Error(t, err)
+
+	// fragmented funds exceeding the input limit require a consolidation
+	connector = &mockConnector{outputs: fragmentedOutputs(addy, 200, 1)}
+	wallet = New(Import(walletSeed, 0, nil, NewAssetRegistry(DefaultAssetRegistryNetwork)), GenericConnector(connector), CoinSelection(LargestFirstCoinSelection{}))
+	_, err = wallet.collectOutputsForFunding(iotaTarget(150), false)
+	assert.True(t, errors.Is(err, ErrTooManyOutputs))
+}
+
+// fragmentedOutputs creates the given number of outputs with the same balance on the given address.
+func fragmentedOutputs(addy address.Address, count int, balance uint64) (outputs []*Output) {
+	outputs = make([]*Output, count)
+	for i := range outputs {
+		outputs[i] = newTestOutput(addy, i, balance, gof.High)
+	}
+
+	return outputs
+}
+
+// newTestOutput creates an Output with the given balance of IOTA.
+func newTestOutput(addy address.Address, index int, balance uint64, gradeOfFinality gof.GradeOfFinality) *Output {
+	object := ledgerstate.NewSigLockedSingleOutput(balance, addy.Address())
+	object.SetID(ledgerstate.NewOutputID(ledgerstate.TransactionID{byte(index), byte(index >> 8), byte(balance)}, 0))
+
+	return &Output{
+		Address:                addy,
+		Object:                 object,
+		GradeOfFinality:        gradeOfFinality,
+		GradeOfFinalityReached: gradeOfFinality == gof.High,
+	}
+}
+
+func iotaTarget(amount uint64) map[ledgerstate.Color]uint64 {
+	return map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: amount}
+}
+
+func iotaBalances(outputs []*Output) (balances []uint64) {
+	for _, output := range outputs {
+		balances = append(balances, iotaBalance(output))
+	}
+
+	return balances
+}
+
+// mockConnector is a Connector that serves a fixed set of unspent outputs.
+type mockConnector struct {
+	Connector
+
+	outputs []*Output
+}
+
+func (m *mockConnector) UnspentOutputs(addresses ...address.Address) (unspentOutputs OutputsByAddressAndOutputID, err error) {
+	unspentOutputs = NewAddressToOutputs()
+	for _, output := range m.outputs {
+		if _, exists := unspentOutputs[output.Address]; !exists {
+			unspentOutputs[output.Address] = make(map[ledgerstate.OutputID]*Output)
+		}
+		outputCopy := *output
+		unspentOutputs[output.Address][output.Object.ID()] = &outputCopy
+	}
+
+	return unspentOutputs, nil
+}
@@ -74,6 +74,13 @@ func AssetRegistryNetwork(network string) Option {
 	}
 }
 
+// CoinSelection defines the strategy that the wallet uses to choose the outputs that fund a transaction.
+func CoinSelection(strategy CoinSelectionStrategy) Option {
+	return func(wallet *Wallet) {
+		wallet.coinSelection = strategy
+	}
+}
+
 // GenericConnector allows us to provide a generic connector to the wallet. It can be used to mock the behavior of a
 // real connector in tests or to provide new connection methods for nodes.
 func GenericConnector(connector Connector) Option {
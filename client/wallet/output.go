@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/iotaledger/goshimmer/client/wallet/packages/address"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
 
@@ -13,6 +14,8 @@ type Output struct {
 	Object                 ledgerstate.Output
 	Metadata               OutputMetadata
 	GradeOfFinalityReached bool
+	// GradeOfFinality is the grade of finality of the output when it was last fetched from the node.
+	GradeOfFinality gof.GradeOfFinality
 	// Spent is a local wallet-only property that gets set once an output is spent from within the same wallet.
 	Spent bool
 }
@@ -21,6 +24,8 @@ type Output struct {
 type OutputMetadata struct {
 	// Timestamp is the timestamp of the tx that created the output.
 	Timestamp time.Time
+	// Conflicting is true if the output is booked into a conflict branch, i.e. if it could still be rolled back.
+	Conflicting bool
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
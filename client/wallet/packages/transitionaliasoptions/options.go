@@ -0,0 +1,124 @@
+package transitionaliasoptions
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// TransitionAliasOption is a function that provides an option.
+type TransitionAliasOption func(options *TransitionAliasOptions) error
+
+// WaitForConfirmation defines if the call should wait for confirmation before it returns.
+func WaitForConfirmation(wait bool) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		options.WaitForConfirmation = wait
+		return nil
+	}
+}
+
+// AccessManaPledgeID is an option for TransitionAlias call that defines the nodeID to pledge access mana to.
+func AccessManaPledgeID(nodeID string) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		options.AccessManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// ConsensusManaPledgeID is an option for TransitionAlias call that defines the nodeID to pledge consensus mana to.
+func ConsensusManaPledgeID(nodeID string) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		options.ConsensusManaPledgeID = nodeID
+		return nil
+	}
+}
+
+// Alias specifies which alias to transition.
+func Alias(aliasID string) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		parsed, err := ledgerstate.AliasAddressFromBase58EncodedString(aliasID)
+		if err != nil {
+			return err
+		}
+		options.Alias = parsed
+		return nil
+	}
+}
+
+// StateData sets the new state data of the alias. Changing the state data is a state transition that has to be
+// signed by the state controller.
+func StateData(data []byte) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		if len(data) > ledgerstate.MaxOutputPayloadSize {
+			return errors.Errorf("state data size %d exceeds maximum allowed size of %d", len(data), ledgerstate.MaxOutputPayloadSize)
+		}
+		options.StateData = data
+		return nil
+	}
+}
+
+// StateAddress sets the new state controller of the alias. Changing the state controller is a governance transition
+// that has to be signed by the governor.
+func StateAddress(address string) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		parsed, err := ledgerstate.AddressFromBase58EncodedString(address)
+		if err != nil {
+			return err
+		}
+		options.StateAddress = parsed
+		return nil
+	}
+}
+
+// GovernanceMetadata sets the new governance metadata of the alias. Changing the governance metadata is a governance
+// transition that has to be signed by the governor.
+func GovernanceMetadata(data []byte) TransitionAliasOption {
+	return func(options *TransitionAliasOptions) error {
+		if len(data) > ledgerstate.MaxOutputPayloadSize {
+			return errors.Errorf("governance metadata size %d exceeds maximum allowed size of %d", len(data), ledgerstate.MaxOutputPayloadSize)
+		}
+		options.GovernanceMetadata = data
+		return nil
+	}
+}
+
+// TransitionAliasOptions is a struct that is used to aggregate the optional parameters in the TransitionAlias call.
+type TransitionAliasOptions struct {
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	Alias                 *ledgerstate.AliasAddress
+	StateData             []byte
+	StateAddress          ledgerstate.Address
+	GovernanceMetadata    []byte
+	WaitForConfirmation   bool
+}
+
+// IsGovernanceTransition returns true if the options describe a governance transition.
+func (o *TransitionAliasOptions) IsGovernanceTransition() bool {
+	return o.StateAddress != nil || o.GovernanceMetadata != nil
+}
+
+// Build build the options.
+func Build(options ...TransitionAliasOption) (result *TransitionAliasOptions, err error) {
+	// create options to collect the arguments provided
+	result = &TransitionAliasOptions{}
+
+	// apply arguments to our options
+	for _, option := range options {
+		if err = option(result); err != nil {
+			return
+		}
+	}
+
+	if result.Alias == nil {
+		return nil, errors.Errorf("an alias identifier must be specified for transition")
+	}
+	if result.StateData == nil && !result.IsGovernanceTransition() {
+		return nil, errors.Errorf("either the state data or the state address and governance metadata must be changed")
+	}
+	if result.StateData != nil && result.IsGovernanceTransition() {
+		return nil, errors.Errorf("state data can't be changed in the same transition as the state address or governance metadata")
+	}
+
+	return
+}
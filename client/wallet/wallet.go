@@ -1,7 +1,9 @@
 package wallet
 
 import (
+	"bytes"
 	"reflect"
+	"sort"
 	"time"
 	"unsafe"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sweepnftownednftsoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/sweepnftownedoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/transfernftoptions"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/transitionaliasoptions"
 	"github.com/iotaledger/goshimmer/client/wallet/packages/withdrawfromnftoptions"
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
@@ -50,6 +53,7 @@ type Wallet struct {
 	assetRegistry  *AssetRegistry
 	outputManager  *OutputManager
 	connector      Connector
+	coinSelection  CoinSelectionStrategy
 
 	faucetPowDifficulty int
 	// if this option is enabled the wallet will use a single reusable address instead of changing addresses.
@@ -87,6 +91,10 @@ func New(options ...Option) (wallet *Wallet) {
 		wallet.assetRegistry = NewAssetRegistry(DefaultAssetRegistryNetwork)
 	}
 
+	if wallet.coinSelection == nil {
+		wallet.coinSelection = ConfidenceCoinSelection{}
+	}
+
 	// initialize wallet with default connector (server) if none was provided
 	if wallet.connector == nil {
 		panic("you need to provide a connector for your wallet")
@@ -151,7 +159,7 @@ func (wallet *Wallet) SendFunds(options ...sendoptions.SendFundsOption) (tx *led
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsAsOutputsInOrder, tx)
+	ok, err := validateTransaction(inputsAsOutputsInOrder, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +240,7 @@ func (wallet *Wallet) ConsolidateFunds(options ...consolidateoptions.Consolidate
 		}
 
 		// check tx validity (balances, unlock blocks)
-		ok, cErr := checkBalancesAndUnlocks(inputsAsOutputsInOrder, tx)
+		ok, cErr := validateTransaction(inputsAsOutputsInOrder, tx)
 		if cErr != nil {
 			return nil, cErr
 		}
@@ -309,7 +317,7 @@ func (wallet *Wallet) ClaimConditionalFunds(options ...claimconditionaloptions.C
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsAsOutputsInOrder, tx)
+	ok, err := validateTransaction(inputsAsOutputsInOrder, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -478,7 +486,7 @@ func (wallet *Wallet) DelegateFunds(options ...delegateoptions.DelegateFundsOpti
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsAsOutputsInOrder, tx)
+	ok, err := validateTransaction(inputsAsOutputsInOrder, tx)
 	if err != nil {
 		return
 	}
@@ -605,7 +613,7 @@ func (wallet *Wallet) CreateNFT(options ...createnftoptions.CreateNFTOption) (tx
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
+	ok, err := validateTransaction(inputsInOrder, tx)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -726,7 +734,7 @@ func (wallet *Wallet) TransferNFT(options ...transfernftoptions.TransferNFTOptio
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(ledgerstate.Outputs{alias}, tx)
+	ok, err := validateTransaction(ledgerstate.Outputs{alias}, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -752,6 +760,101 @@ func (wallet *Wallet) TransferNFT(options ...transfernftoptions.TransferNFTOptio
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region TransitionAlias //////////////////////////////////////////////////////////////////////////////////////////////
+
+// TransitionAlias transitions an alias to its next state. Changing the state data is a state transition that requires
+// the wallet to be the state controller, changing the state address or the governance metadata is a governance
+// transition that requires the wallet to be the governor.
+func (wallet *Wallet) TransitionAlias(options ...transitionaliasoptions.TransitionAliasOption) (tx *ledgerstate.Transaction, err error) {
+	transitionOptions, err := transitionaliasoptions.Build(options...)
+	if err != nil {
+		return
+	}
+
+	// derive mana pledge IDs
+	accessPledgeNodeID, consensusPledgeNodeID, err := wallet.derivePledgeIDs(transitionOptions.AccessManaPledgeID, transitionOptions.ConsensusManaPledgeID)
+	if err != nil {
+		return
+	}
+
+	// look up if we have the alias output with the role that is required for the transition
+	var walletAlias *Output
+	if transitionOptions.IsGovernanceTransition() {
+		walletAlias, err = wallet.findGovernedAliasOutputByAliasID(transitionOptions.Alias)
+	} else {
+		walletAlias, err = wallet.findStateControlledAliasOutputByAliasID(transitionOptions.Alias)
+	}
+	if err != nil {
+		return
+	}
+	alias := walletAlias.Object.(*ledgerstate.AliasOutput)
+
+	nextAlias := alias.NewAliasOutputNext(transitionOptions.IsGovernanceTransition())
+	if transitionOptions.IsGovernanceTransition() {
+		if alias.DelegationTimeLockedNow(time.Now()) {
+			err = errors.Errorf("alias %s is delegation timelocked until %s", alias.GetAliasAddress().Base58(),
+				alias.DelegationTimelock().String())
+			return
+		}
+		if transitionOptions.StateAddress != nil {
+			if err = nextAlias.SetStateAddress(transitionOptions.StateAddress); err != nil {
+				return
+			}
+			// the governor stays the same, even if the alias was self governed before
+			nextAlias.SetGoverningAddress(alias.GetGoverningAddress())
+		}
+		if transitionOptions.GovernanceMetadata != nil {
+			if err = nextAlias.SetGovernanceMetadata(transitionOptions.GovernanceMetadata); err != nil {
+				return
+			}
+		}
+	} else if err = nextAlias.SetStateData(transitionOptions.StateData); err != nil {
+		return
+	}
+
+	essence := ledgerstate.NewTransactionEssence(0, time.Now(), accessPledgeNodeID, consensusPledgeNodeID,
+		ledgerstate.NewInputs(alias.Input()),
+		ledgerstate.NewOutputs(nextAlias),
+	)
+	// there is only one input, so signing is easy
+	keyPair := wallet.Seed().KeyPair(walletAlias.Address.Index)
+	tx = ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
+		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))),
+	})
+
+	// check syntactical validity by marshaling an unmarshaling
+	tx, err = new(ledgerstate.Transaction).FromBytes(tx.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// check tx validity (balances, unlock blocks)
+	ok, err := validateTransaction(ledgerstate.Outputs{alias}, tx)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, errors.Errorf("created transaction is invalid: %s", tx.String())
+	}
+
+	wallet.markOutputsAndAddressesSpent(OutputsByAddressAndOutputID{walletAlias.Address: {
+		walletAlias.Object.ID(): walletAlias,
+	}})
+
+	err = wallet.connector.SendTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	if transitionOptions.WaitForConfirmation {
+		err = wallet.WaitForTxConfirmation(tx.ID())
+	}
+
+	return tx, err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region DestroyNFT ///////////////////////////////////////////////////////////////////////////////////////////////////
 
 // DestroyNFT destroys the given nft (alias).
@@ -822,7 +925,7 @@ func (wallet *Wallet) DestroyNFT(options ...destroynftoptions.DestroyNFTOption)
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(ledgerstate.Outputs{alias}, tx)
+	ok, err := validateTransaction(ledgerstate.Outputs{alias}, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -934,7 +1037,7 @@ func (wallet *Wallet) WithdrawFundsFromNFT(options ...withdrawfromnftoptions.Wit
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(ledgerstate.Outputs{alias}, tx)
+	ok, err := validateTransaction(ledgerstate.Outputs{alias}, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1048,7 +1151,7 @@ func (wallet *Wallet) DepositFundsToNFT(options ...deposittonftoptions.DepositFu
 		return nil, err
 	}
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
+	ok, err := validateTransaction(inputsInOrder, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1188,7 +1291,7 @@ func (wallet Wallet) SweepNFTOwnedFunds(options ...sweepnftownedoptions.SweepNFT
 	}
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
+	ok, err := validateTransaction(inputsInOrder, tx)
 	if err != nil {
 		return nil, err
 	}
@@ -1332,7 +1435,7 @@ func (wallet *Wallet) SweepNFTOwnedNFTs(options ...sweepnftownednftsoptions.Swee
 	tx = ledgerstate.NewTransaction(essence, unlockBlocks)
 
 	// check tx validity (balances, unlock blocks)
-	ok, err := checkBalancesAndUnlocks(inputsInOrder, tx)
+	ok, err := validateTransaction(inputsInOrder, tx)
 	if err != nil {
 		return
 	}
@@ -1378,6 +1481,16 @@ func (wallet *Wallet) ServerStatus() (status ServerStatus, err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region IssuableMessages /////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuableMessages returns the number of messages of the given size that the connected node can issue during the given
+// interval, which allows to pace the transactions that are sent by the wallet.
+func (wallet *Wallet) IssuableMessages(messageSize int, interval time.Duration) (issuableMessages int, err error) {
+	return wallet.connector.(*WebConnector).IssuableMessages(messageSize, interval)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region AllowedPledgeNodeIDs /////////////////////////////////////////////////////////////////////////////////////////
 
 // AllowedPledgeNodeIDs retrieves the allowed pledge node IDs.
@@ -2067,8 +2180,9 @@ func (wallet *Wallet) findStateControlledAliasOutputByAliasID(id *ledgerstate.Al
 	return nil, err
 }
 
-// collectOutputsForFunding tries to collect unspent outputs to fund fundingBalance.
-// It may collect pending outputs according to flag.
+// collectOutputsForFunding tries to collect unspent outputs to fund fundingBalance using the CoinSelectionStrategy of
+// the wallet. It may collect pending outputs according to flag, but never collects pending outputs that are booked into
+// a conflict branch.
 func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[ledgerstate.Color]uint64, includePending bool) (OutputsByAddressAndOutputID, error) {
 	if fundingBalance == nil {
 		return nil, errors.Errorf("can't collect fund: empty fundingBalance provided")
@@ -2078,12 +2192,10 @@ func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[ledgerstate.Co
 	addresses := wallet.addressManager.Addresses()
 	unspentOutputs := wallet.outputManager.UnspentValueOutputs(includePending, addresses...)
 
-	collected := make(map[ledgerstate.Color]uint64)
-	outputsToConsume := NewAddressToOutputs()
-	numOfCollectedOutputs := 0
+	candidates := make([]*Output, 0)
 	now := time.Now()
 	for _, addy := range addresses {
-		for outputID, output := range unspentOutputs[addy] {
+		for _, output := range unspentOutputs[addy] {
 			if output.Object.Type() == ledgerstate.ExtendedLockedOutputType {
 				casted := output.Object.(*ledgerstate.ExtendedLockedOutput)
 				if casted.TimeLockedNow(now) || !casted.UnlockAddressNow(now).Equals(addy.Address()) {
@@ -2091,37 +2203,46 @@ func (wallet *Wallet) collectOutputsForFunding(fundingBalance map[ledgerstate.Co
 					continue
 				}
 			}
+			if output.Metadata.Conflicting && !output.GradeOfFinalityReached {
+				// skip the output because it could still be rolled back
+				continue
+			}
 			contributingOutput := false
 			output.Object.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
-				_, has := fundingBalance[color]
-				if has {
-					collected[color] += balance
-					contributingOutput = true
-				}
-				return true
+				_, contributingOutput = fundingBalance[color]
+				return !contributingOutput
 			})
 			if contributingOutput {
-				// store the output in the outputs to use for the transfer
-				if _, addressEntryExists := outputsToConsume[addy]; !addressEntryExists {
-					outputsToConsume[addy] = make(map[ledgerstate.OutputID]*Output)
-				}
-				outputsToConsume[addy][outputID] = output
-				numOfCollectedOutputs++
-				if enoughCollected(collected, fundingBalance) && numOfCollectedOutputs <= ledgerstate.MaxInputCount {
-					return outputsToConsume, nil
-				}
+				candidates = append(candidates, output)
 			}
 		}
 	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return bytes.Compare(candidates[i].Object.ID().Bytes(), candidates[j].Object.ID().Bytes()) < 0
+	})
+
+	selected := wallet.coinSelection.SelectOutputs(candidates, fundingBalance)
+	collected := totalBalances(selected)
+	if !enoughCollected(collected, fundingBalance) {
+		return nil, errors.Errorf("failed to gather initial funds \n %s, there are only \n %s funds available",
+			ledgerstate.NewColoredBalances(fundingBalance).String(),
+			ledgerstate.NewColoredBalances(totalBalances(candidates)).String(),
+		)
+	}
 
-	if enoughCollected(collected, fundingBalance) && numOfCollectedOutputs > ledgerstate.MaxOutputCount {
+	outputsToConsume := NewAddressToOutputs()
+	for _, output := range selected {
+		if _, addressEntryExists := outputsToConsume[output.Address]; !addressEntryExists {
+			outputsToConsume[output.Address] = make(map[ledgerstate.OutputID]*Output)
+		}
+		outputsToConsume[output.Address][output.Object.ID()] = output
+	}
+
+	if len(selected) > ledgerstate.MaxInputCount {
 		return outputsToConsume, errors.Errorf("failed to collect outputs: %w", ErrTooManyOutputs)
 	}
 
-	return nil, errors.Errorf("failed to gather initial funds \n %s, there are only \n %s funds available",
-		ledgerstate.NewColoredBalances(fundingBalance).String(),
-		ledgerstate.NewColoredBalances(collected).String(),
-	)
+	return outputsToConsume, nil
 }
 
 // enoughCollected checks if collected has at least target funds.
@@ -2287,14 +2408,12 @@ func (wallet *Wallet) chooseToAddress(consumedOutputs OutputsByAddressAndOutputI
 	return optionsToAddress
 }
 
-// checkBalancesAndUnlocks checks if tx balances are okay and unlock blocks are valid.
-func checkBalancesAndUnlocks(inputs ledgerstate.Outputs, tx *ledgerstate.Transaction) (bool, error) {
-	balancesValid := ledgerstate.TransactionBalancesValid(inputs, tx.Essence().Outputs())
-	unlocksValid, err := ledgerstate.UnlockBlocksValidWithError(inputs, tx)
-	if err != nil {
+// validateTransaction checks the created tx against the validation rules of the ledger before it is submitted.
+func validateTransaction(inputs ledgerstate.Outputs, tx *ledgerstate.Transaction) (bool, error) {
+	if err := ledgerstate.ValidateTransaction(tx, inputs); err != nil {
 		return false, err
 	}
-	return balancesValid && unlocksValid, nil
+	return true, nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,6 +1,8 @@
 package wallet
 
 import (
+	"time"
+
 	"github.com/cockroachdb/errors"
 
 	"github.com/iotaledger/goshimmer/client"
@@ -82,9 +84,11 @@ func (webConnector WebConnector) UnspentOutputs(addresses ...address.Address) (u
 				Address:                addr,
 				Object:                 lOutput,
 				GradeOfFinalityReached: output.GradeOfFinality == gof.High,
+				GradeOfFinality:        output.GradeOfFinality,
 				Spent:                  false,
 				Metadata: OutputMetadata{
-					Timestamp: output.Metadata.Timestamp,
+					Timestamp:   output.Metadata.Timestamp,
+					Conflicting: output.Metadata.Conflicting,
 				},
 			}
 
@@ -130,6 +134,17 @@ func (webConnector WebConnector) GetAllowedPledgeIDs() (pledgeIDMap map[mana.Typ
 	return
 }
 
+// IssuableMessages returns the number of messages of the given size that the node can issue during the given interval
+// according to the projection of its access mana.
+func (webConnector WebConnector) IssuableMessages(messageSize int, interval time.Duration) (issuableMessages int, err error) {
+	res, err := webConnector.client.GetAccessManaProjection(messageSize, interval)
+	if err != nil {
+		return
+	}
+
+	return res.IssuableMessages, nil
+}
+
 // GetUnspentAliasOutput returns the current unspent alias output that belongs to a given alias address.
 func (webConnector WebConnector) GetUnspentAliasOutput(addr *ledgerstate.AliasAddress) (output *ledgerstate.AliasOutput, err error) {
 	res, err := webConnector.client.GetAddressUnspentOutputs(addr.Base58())
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	routeWatchList   = "watch"
+	routeWatchEvents = "events"
+)
+
+// GetWatchItems returns the addresses, outputs and transactions that are watched by the node.
+func (api *GoShimmerAPI) GetWatchItems() (*jsonmodels.WatchItemsResponse, error) {
+	res := &jsonmodels.WatchItemsResponse{}
+	if err := api.do(http.MethodGet, routeWatchList, nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// AddWatchItem adds the given base58 encoded address, OutputID or TransactionID to the watch list of the node.
+func (api *GoShimmerAPI) AddWatchItem(base58EncodedID string) (*jsonmodels.WatchItem, error) {
+	res := &jsonmodels.WatchItem{}
+	if err := api.do(http.MethodPost, routeWatchList, &jsonmodels.WatchItemRequest{ID: base58EncodedID}, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// RemoveWatchItem removes the given base58 encoded address, OutputID or TransactionID and its history from the watch
+// list of the node. It returns the items that are still watched.
+func (api *GoShimmerAPI) RemoveWatchItem(base58EncodedID string) (*jsonmodels.WatchItemsResponse, error) {
+	res := &jsonmodels.WatchItemsResponse{}
+	if err := api.do(http.MethodDelete, fmt.Sprintf("%s/%s", routeWatchList, base58EncodedID), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetWatchEvents returns the history of the given base58 encoded address, OutputID or TransactionID, which needs to be
+// on the watch list of the node.
+func (api *GoShimmerAPI) GetWatchEvents(base58EncodedID string) (*jsonmodels.WatchEventsResponse, error) {
+	res := &jsonmodels.WatchEventsResponse{}
+	if err := api.do(http.MethodGet, fmt.Sprintf("%s/%s/%s", routeWatchList, base58EncodedID, routeWatchEvents), nil, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
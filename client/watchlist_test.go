@@ -0,0 +1,49 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestGoShimmerAPI_WatchList(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(contentType, contentTypeJSON)
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/watch":
+			request := &jsonmodels.WatchItemRequest{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(request))
+			require.NoError(t, json.NewEncoder(w).Encode(&jsonmodels.WatchItem{ID: request.ID, Type: "transaction"}))
+		case r.Method == http.MethodGet && r.URL.Path == "/watch/transaction1/events":
+			require.NoError(t, json.NewEncoder(w).Encode(&jsonmodels.WatchEventsResponse{
+				Item:   &jsonmodels.WatchItem{ID: "transaction1", Type: "transaction"},
+				Events: []*jsonmodels.WatchEvent{{Type: "transactionBooked", TransactionID: "transaction1", MessageID: "message1"}},
+			}))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":"transaction2 is not watched","code":"not_found"}`))
+		}
+	}))
+	defer server.Close()
+
+	api := NewGoShimmerAPI(server.URL)
+
+	item, err := api.AddWatchItem("transaction1")
+	require.NoError(t, err)
+	assert.Equal(t, "transaction1", item.ID)
+
+	events, err := api.GetWatchEvents("transaction1")
+	require.NoError(t, err)
+	require.Len(t, events.Events, 1)
+	assert.Equal(t, "message1", events.Events[0].MessageID)
+
+	_, err = api.RemoveWatchItem("transaction2")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -189,6 +189,11 @@ module.exports = {
           },
         ]
       },
+      {
+        type: 'doc',
+        label: 'Conformance Test Vectors',
+        id: 'protocol_specification/conformance',
+      },
       {
         type: 'doc',
         label: 'Glossary',
@@ -231,6 +236,24 @@ module.exports = {
         id: 'apis/manual_peering',
       },
 
+      {
+        type: 'doc',
+        label: 'Blacklist',
+        id: 'apis/blacklist',
+      },
+
+      {
+        type: 'doc',
+        label: 'Admin',
+        id: 'apis/admin',
+      },
+
+      {
+        type: 'doc',
+        label: 'Tenants',
+        id: 'apis/tenants',
+      },
+
       {
         type: 'doc',
         label: 'Communication Layer',
@@ -243,6 +266,66 @@ module.exports = {
         id: 'apis/ledgerstate',
       },
 
+      {
+        type: 'doc',
+        label: 'Epochs',
+        id: 'apis/epochs',
+      },
+
+      {
+        type: 'doc',
+        label: 'Archive',
+        id: 'apis/archive',
+      },
+
+      {
+        type: 'doc',
+        label: 'Watch List',
+        id: 'apis/watchlist',
+      },
+
+      {
+        type: 'doc',
+        label: 'Name Registry',
+        id: 'apis/nameregistry',
+      },
+
+      {
+        type: 'doc',
+        label: 'OTV',
+        id: 'apis/otv',
+      },
+
+      {
+        type: 'doc',
+        label: 'Analytics',
+        id: 'apis/analytics',
+      },
+
+      {
+        type: 'doc',
+        label: 'Accounting',
+        id: 'apis/accounting',
+      },
+
+      {
+        type: 'doc',
+        label: 'Value Tracer',
+        id: 'apis/valuetracer',
+      },
+
+      {
+        type: 'doc',
+        label: 'Fragmentation',
+        id: 'apis/fragmentation',
+      },
+
+      {
+        type: 'doc',
+        label: 'Anchor',
+        id: 'apis/anchor',
+      },
+
       {
         type: 'doc',
         label: 'Mana',
@@ -261,6 +344,12 @@ module.exports = {
         id: 'apis/snapshot',
       },
 
+      {
+        type: 'doc',
+        label: 'Profiling',
+        id: 'apis/profiling',
+      },
+
       {
         type: 'doc',
         label: 'Faucet',
@@ -278,6 +367,12 @@ module.exports = {
         label: 'Tools',
         id: 'apis/tools',
       },
+
+      {
+        type: 'doc',
+        label: 'Debug',
+        id: 'apis/debug',
+      },
     ],
   },
   {
@@ -302,6 +397,42 @@ module.exports = {
         label: 'Integration Tests',
         id: 'tooling/integration_tests',
       },
+
+      {
+        type: 'doc',
+        label: 'Genesis Snapshot Builder',
+        id: 'tooling/genesis',
+      },
+
+      {
+        type: 'doc',
+        label: 'Double Spend Alerts',
+        id: 'tooling/double_spend_alert',
+      },
+
+      {
+        type: 'doc',
+        label: 'Webhooks',
+        id: 'tooling/webhooks',
+      },
+
+      {
+        type: 'doc',
+        label: 'Remote Metrics',
+        id: 'tooling/remote_metrics',
+      },
+
+      {
+        type: 'doc',
+        label: 'Ledger State Audit',
+        id: 'tooling/ledger_audit',
+      },
+
+      {
+        type: 'doc',
+        label: 'Tangle Benchmark',
+        id: 'tooling/benchmark',
+      },
     ],
   },
   {
@@ -0,0 +1,253 @@
+package accounting
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+// region Accountant ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Accountant keeps track of the number of messages and bytes that were issued per issuer and per payload type within a
+// rolling window. The window is divided into buckets of the configured resolution, so that the usage of any shorter
+// window can be queried and old usage can be dropped cheaply.
+type Accountant struct {
+	params   Params
+	timeFunc func() time.Time
+
+	buckets []*bucket
+	mutex   sync.RWMutex
+}
+
+// New is the constructor of the Accountant.
+func New(params Params, opts ...Option) (accountant *Accountant) {
+	accountant = &Accountant{
+		params:   params,
+		timeFunc: clock.SyncedTime,
+		buckets:  make([]*bucket, 0),
+	}
+
+	for _, opt := range opts {
+		opt(accountant)
+	}
+
+	return accountant
+}
+
+// RecordMessage records a message of the given size with the given payload type that was issued by the given issuer.
+func (a *Accountant) RecordMessage(issuer ed25519.PublicKey, payloadType payload.Type, size int) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	now := a.timeFunc()
+	a.prune(now)
+
+	bucketStart := now.Truncate(a.params.Resolution)
+	if len(a.buckets) == 0 || a.buckets[len(a.buckets)-1].start.Before(bucketStart) {
+		a.buckets = append(a.buckets, newBucket(bucketStart))
+	}
+
+	a.buckets[len(a.buckets)-1].record(issuer, payloadType, size)
+}
+
+// Report returns the usage within the given window, which is capped at the window of the Accountant.
+func (a *Accountant) Report(window time.Duration) (report *Report) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	if window <= 0 || window > a.params.Window {
+		window = a.params.Window
+	}
+
+	report = newReport(window)
+	windowStart := a.timeFunc().Add(-window)
+	for _, bucket := range a.buckets {
+		if bucket.start.Add(a.params.Resolution).After(windowStart) {
+			bucket.addTo(report)
+		}
+	}
+
+	return report
+}
+
+// prune drops the buckets that left the window.
+func (a *Accountant) prune(now time.Time) {
+	windowStart := now.Add(-a.params.Window)
+
+	expiredBuckets := 0
+	for expiredBuckets < len(a.buckets) && !a.buckets[expiredBuckets].start.Add(a.params.Resolution).After(windowStart) {
+		expiredBuckets++
+	}
+	a.buckets = append(a.buckets[:0], a.buckets[expiredBuckets:]...)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Params ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Params contains the parameters of the Accountant.
+type Params struct {
+	// Window defines the maximum time span that is covered by the usage.
+	Window time.Duration
+	// Resolution defines the granularity of the window, i.e. the time span that is covered by a single bucket.
+	Resolution time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option is the type of the optional parameters of the Accountant.
+type Option func(accountant *Accountant)
+
+// WithTimeFunc is an Option for the Accountant that overrides the function that determines the current time.
+func WithTimeFunc(timeFunc func() time.Time) Option {
+	return func(accountant *Accountant) {
+		accountant.timeFunc = timeFunc
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Usage ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Usage contains the number of messages and bytes that were issued.
+type Usage struct {
+	// Messages is the number of issued messages.
+	Messages int
+	// Bytes is the number of issued bytes.
+	Bytes int
+}
+
+// add adds the given Usage.
+func (u *Usage) add(other *Usage) {
+	u.Messages += other.Messages
+	u.Bytes += other.Bytes
+}
+
+// PayloadUsage contains the Usage per payload type.
+type PayloadUsage map[payload.Type]*Usage
+
+// add adds the given Usage of the given payload type.
+func (p PayloadUsage) add(payloadType payload.Type, usage *Usage) {
+	if _, exists := p[payloadType]; !exists {
+		p[payloadType] = &Usage{}
+	}
+	p[payloadType].add(usage)
+}
+
+// IssuerUsage contains the Usage of a single issuer.
+type IssuerUsage struct {
+	Usage
+
+	// Issuer is the public key of the issuer.
+	Issuer ed25519.PublicKey
+	// PayloadTypes contains the Usage of the issuer per payload type.
+	PayloadTypes PayloadUsage
+}
+
+// newIssuerUsage returns an empty IssuerUsage of the given issuer.
+func newIssuerUsage(issuer ed25519.PublicKey) *IssuerUsage {
+	return &IssuerUsage{
+		Issuer:       issuer,
+		PayloadTypes: make(PayloadUsage),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Report ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Report contains the usage within a window.
+type Report struct {
+	// Window is the time span that is covered by the Report.
+	Window time.Duration
+	// Total is the Usage of all issuers.
+	Total Usage
+	// PayloadTypes contains the Usage of all issuers per payload type.
+	PayloadTypes PayloadUsage
+	// Issuers contains the Usage per issuer.
+	Issuers map[ed25519.PublicKey]*IssuerUsage
+}
+
+// newReport returns an empty Report of the given window.
+func newReport(window time.Duration) *Report {
+	return &Report{
+		Window:       window,
+		PayloadTypes: make(PayloadUsage),
+		Issuers:      make(map[ed25519.PublicKey]*IssuerUsage),
+	}
+}
+
+// TopIssuers returns the given number of issuers that issued the most bytes in descending order of their bytes.
+func (r *Report) TopIssuers(count int) (topIssuers []*IssuerUsage) {
+	topIssuers = make([]*IssuerUsage, 0, len(r.Issuers))
+	for _, issuerUsage := range r.Issuers {
+		topIssuers = append(topIssuers, issuerUsage)
+	}
+
+	sort.Slice(topIssuers, func(i, j int) bool {
+		if topIssuers[i].Bytes != topIssuers[j].Bytes {
+			return topIssuers[i].Bytes > topIssuers[j].Bytes
+		}
+
+		return topIssuers[i].Issuer.String() < topIssuers[j].Issuer.String()
+	})
+
+	if count >= 0 && len(topIssuers) > count {
+		topIssuers = topIssuers[:count]
+	}
+
+	return topIssuers
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region bucket ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// bucket contains the usage per issuer and payload type of a time span of the size of the resolution.
+type bucket struct {
+	start time.Time
+	usage map[ed25519.PublicKey]PayloadUsage
+}
+
+// newBucket returns an empty bucket starting at the given time.
+func newBucket(start time.Time) *bucket {
+	return &bucket{
+		start: start,
+		usage: make(map[ed25519.PublicKey]PayloadUsage),
+	}
+}
+
+// record records a message of the given issuer, payload type and size.
+func (b *bucket) record(issuer ed25519.PublicKey, payloadType payload.Type, size int) {
+	if _, exists := b.usage[issuer]; !exists {
+		b.usage[issuer] = make(PayloadUsage)
+	}
+	b.usage[issuer].add(payloadType, &Usage{Messages: 1, Bytes: size})
+}
+
+// addTo adds the usage of the bucket to the given Report.
+func (b *bucket) addTo(report *Report) {
+	for issuer, payloadUsage := range b.usage {
+		issuerUsage, exists := report.Issuers[issuer]
+		if !exists {
+			issuerUsage = newIssuerUsage(issuer)
+			report.Issuers[issuer] = issuerUsage
+		}
+
+		for payloadType, usage := range payloadUsage {
+			report.Total.add(usage)
+			report.PayloadTypes.add(payloadType, usage)
+			issuerUsage.Usage.add(usage)
+			issuerUsage.PayloadTypes.add(payloadType, usage)
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,57 @@
+package accounting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestAccountant(t *testing.T) {
+	now := time.Unix(1000, 0)
+	accountant := New(Params{
+		Window:     10 * time.Minute,
+		Resolution: time.Minute,
+	}, WithTimeFunc(func() time.Time { return now }))
+
+	issuerA := ed25519.GenerateKeyPair().PublicKey
+	issuerB := ed25519.GenerateKeyPair().PublicKey
+	otherPayloadType := payload.Type(1337)
+
+	accountant.RecordMessage(issuerA, payload.GenericDataPayloadType, 100)
+	accountant.RecordMessage(issuerA, otherPayloadType, 300)
+	now = now.Add(5 * time.Minute)
+	accountant.RecordMessage(issuerB, payload.GenericDataPayloadType, 200)
+	accountant.RecordMessage(issuerB, payload.GenericDataPayloadType, 250)
+
+	report := accountant.Report(0)
+	assert.Equal(t, 10*time.Minute, report.Window)
+	assert.Equal(t, Usage{Messages: 4, Bytes: 850}, report.Total)
+	assert.Equal(t, &Usage{Messages: 3, Bytes: 550}, report.PayloadTypes[payload.GenericDataPayloadType])
+	assert.Equal(t, &Usage{Messages: 1, Bytes: 300}, report.PayloadTypes[otherPayloadType])
+
+	topIssuers := report.TopIssuers(1)
+	require.Len(t, topIssuers, 1)
+	assert.Equal(t, issuerB, topIssuers[0].Issuer)
+	assert.Equal(t, Usage{Messages: 2, Bytes: 450}, topIssuers[0].Usage)
+
+	require.Contains(t, report.Issuers, issuerA)
+	assert.Equal(t, &Usage{Messages: 1, Bytes: 300}, report.Issuers[issuerA].PayloadTypes[otherPayloadType])
+
+	// shorter windows only contain the recent usage
+	report = accountant.Report(time.Minute)
+	assert.Equal(t, Usage{Messages: 2, Bytes: 450}, report.Total)
+	assert.NotContains(t, report.Issuers, issuerA)
+
+	// the usage leaves the window
+	now = now.Add(6 * time.Minute)
+	accountant.RecordMessage(issuerA, payload.GenericDataPayloadType, 10)
+
+	report = accountant.Report(0)
+	assert.Equal(t, Usage{Messages: 3, Bytes: 460}, report.Total)
+	assert.Len(t, accountant.buckets, 2)
+}
@@ -0,0 +1,360 @@
+package analytics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Analytics ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Analytics computes rolling statistics about the Tangle, i.e. its width, the confirmation latency of messages, the
+// rate of orphaned messages and the time it takes to resolve conflicts. The statistics cover the messages and
+// conflicts that were resolved within the configured window.
+type Analytics struct {
+	params   Params
+	timeFunc func() time.Time
+
+	widths          *rollingSamples
+	latencies       *rollingSamples
+	orphans         *rollingSamples
+	resolutionTimes *rollingSamples
+
+	pendingMessages map[tangle.MessageID]*pendingMessage
+	pendingBranches map[ledgerstate.BranchID]time.Time
+	mutex           sync.Mutex
+}
+
+// New is the constructor of the Analytics.
+func New(params Params, opts ...Option) (analytics *Analytics) {
+	analytics = &Analytics{
+		params:          params,
+		timeFunc:        clock.SyncedTime,
+		widths:          newRollingSamples(params.MaxSamples),
+		latencies:       newRollingSamples(params.MaxSamples),
+		orphans:         newRollingSamples(params.MaxSamples),
+		resolutionTimes: newRollingSamples(params.MaxSamples),
+		pendingMessages: make(map[tangle.MessageID]*pendingMessage),
+		pendingBranches: make(map[ledgerstate.BranchID]time.Time),
+	}
+
+	for _, opt := range opts {
+		opt(analytics)
+	}
+
+	return analytics
+}
+
+// MessageBooked starts tracking the given message, which is considered to be orphaned if it is not confirmed within
+// the OrphanageThreshold.
+func (a *Analytics) MessageBooked(messageID tangle.MessageID, issuingTime time.Time) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.pendingMessages[messageID] = &pendingMessage{
+		issuingTime: issuingTime,
+		bookedTime:  a.timeFunc(),
+	}
+}
+
+// MessageConfirmed records the confirmation latency of the given message.
+func (a *Analytics) MessageConfirmed(messageID tangle.MessageID) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	pending, exists := a.pendingMessages[messageID]
+	if !exists {
+		return
+	}
+	delete(a.pendingMessages, messageID)
+
+	now := a.timeFunc()
+	a.latencies.add(now, float64(now.Sub(pending.issuingTime)))
+}
+
+// MessageOrphaned records that the given message was orphaned, e.g. because it could not be solidified.
+func (a *Analytics) MessageOrphaned(messageID tangle.MessageID) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	delete(a.pendingMessages, messageID)
+	a.orphans.add(a.timeFunc(), 1)
+}
+
+// BranchCreated starts tracking the resolution time of the given branch.
+func (a *Analytics) BranchCreated(branchID ledgerstate.BranchID) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.pendingBranches[branchID] = a.timeFunc()
+}
+
+// BranchResolved records the resolution time of the given branch, which was either confirmed or rejected.
+func (a *Analytics) BranchResolved(branchID ledgerstate.BranchID) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	createdTime, exists := a.pendingBranches[branchID]
+	if !exists {
+		return
+	}
+	delete(a.pendingBranches, branchID)
+
+	now := a.timeFunc()
+	a.resolutionTimes.add(now, float64(now.Sub(createdTime)))
+}
+
+// SampleWidth records the given width of the Tangle, i.e. the number of its tips. It also marks the messages as
+// orphaned that exceeded the OrphanageThreshold and removes the samples that left the window, so it should be called
+// periodically.
+func (a *Analytics) SampleWidth(width int) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	now := a.timeFunc()
+	a.widths.add(now, float64(width))
+
+	for messageID, pending := range a.pendingMessages {
+		if now.Sub(pending.bookedTime) > a.params.OrphanageThreshold {
+			delete(a.pendingMessages, messageID)
+			a.orphans.add(now, 1)
+		}
+	}
+
+	a.prune(now)
+}
+
+// Statistics returns the statistics of the current window.
+func (a *Analytics) Statistics() (statistics *Statistics) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.prune(a.timeFunc())
+
+	statistics = &Statistics{
+		Window:                 a.params.Window,
+		TangleWidth:            newWidthStatistics(a.widths.values()),
+		ConfirmationLatency:    NewDurationStatistics(a.latencies.values()),
+		ConfirmedMessages:      a.latencies.len(),
+		OrphanedMessages:       a.orphans.len(),
+		ConflictResolutionTime: NewDurationStatistics(a.resolutionTimes.values()),
+		PendingConflicts:       len(a.pendingBranches),
+	}
+	if resolvedMessages := statistics.ConfirmedMessages + statistics.OrphanedMessages; resolvedMessages != 0 {
+		statistics.OrphanageRate = float64(statistics.OrphanedMessages) / float64(resolvedMessages)
+	}
+
+	return statistics
+}
+
+// prune removes the samples that left the window.
+func (a *Analytics) prune(now time.Time) {
+	windowStart := now.Add(-a.params.Window)
+
+	a.widths.prune(windowStart)
+	a.latencies.prune(windowStart)
+	a.orphans.prune(windowStart)
+	a.resolutionTimes.prune(windowStart)
+}
+
+// pendingMessage contains the information about a message that is neither confirmed nor orphaned, yet.
+type pendingMessage struct {
+	issuingTime time.Time
+	bookedTime  time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Params ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Params contains the parameters of the Analytics.
+type Params struct {
+	// Window defines the time span that is covered by the statistics.
+	Window time.Duration
+	// OrphanageThreshold defines the time after which a booked message that was not confirmed is considered orphaned.
+	OrphanageThreshold time.Duration
+	// MaxSamples defines the maximum number of samples per statistic that are kept within the window.
+	MaxSamples int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option is the type of the optional parameters of the Analytics.
+type Option func(analytics *Analytics)
+
+// WithTimeFunc is an Option for the Analytics that overrides the function that determines the current time.
+func WithTimeFunc(timeFunc func() time.Time) Option {
+	return func(analytics *Analytics) {
+		analytics.timeFunc = timeFunc
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Statistics ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Statistics contains the statistics about the Tangle within the window of the Analytics.
+type Statistics struct {
+	// Window is the time span that is covered by the statistics.
+	Window time.Duration
+	// TangleWidth contains the statistics about the number of tips.
+	TangleWidth *WidthStatistics
+	// ConfirmationLatency contains the statistics about the time between the issuance and the confirmation of messages.
+	ConfirmationLatency *DurationStatistics
+	// ConfirmedMessages is the number of messages that were confirmed.
+	ConfirmedMessages int
+	// OrphanedMessages is the number of messages that were orphaned.
+	OrphanedMessages int
+	// OrphanageRate is the fraction of the resolved messages that were orphaned.
+	OrphanageRate float64
+	// ConflictResolutionTime contains the statistics about the time between the creation and the resolution of
+	// branches.
+	ConflictResolutionTime *DurationStatistics
+	// PendingConflicts is the number of branches that were created but not resolved, yet.
+	PendingConflicts int
+}
+
+// WidthStatistics contains the statistics about the width of the Tangle.
+type WidthStatistics struct {
+	// Current is the most recent width.
+	Current int
+	// Average is the average width.
+	Average float64
+	// Max is the maximum width.
+	Max int
+}
+
+// newWidthStatistics returns the WidthStatistics of the given samples, which are ordered by their time.
+func newWidthStatistics(samples []float64) (statistics *WidthStatistics) {
+	statistics = &WidthStatistics{}
+	if len(samples) == 0 {
+		return statistics
+	}
+
+	sum := float64(0)
+	for _, sample := range samples {
+		sum += sample
+		if int(sample) > statistics.Max {
+			statistics.Max = int(sample)
+		}
+	}
+	statistics.Current = int(samples[len(samples)-1])
+	statistics.Average = sum / float64(len(samples))
+
+	return statistics
+}
+
+// DurationStatistics contains the average and the percentiles of a set of durations.
+type DurationStatistics struct {
+	// Average is the average duration.
+	Average time.Duration
+	// P50 is the median duration.
+	P50 time.Duration
+	// P90 is the 90th percentile of the durations.
+	P90 time.Duration
+	// P99 is the 99th percentile of the durations.
+	P99 time.Duration
+}
+
+// NewDurationStatistics returns the DurationStatistics of the given samples.
+func NewDurationStatistics(samples []float64) (statistics *DurationStatistics) {
+	statistics = &DurationStatistics{}
+	if len(samples) == 0 {
+		return statistics
+	}
+
+	sortedSamples := make([]float64, len(samples))
+	copy(sortedSamples, samples)
+	sort.Float64s(sortedSamples)
+
+	sum := float64(0)
+	for _, sample := range sortedSamples {
+		sum += sample
+	}
+	statistics.Average = time.Duration(sum / float64(len(sortedSamples)))
+	statistics.P50 = time.Duration(percentile(sortedSamples, 0.5))
+	statistics.P90 = time.Duration(percentile(sortedSamples, 0.9))
+	statistics.P99 = time.Duration(percentile(sortedSamples, 0.99))
+
+	return statistics
+}
+
+// percentile returns the given percentile of the sorted samples using the nearest-rank method.
+func percentile(sortedSamples []float64, p float64) float64 {
+	rank := int(p*float64(len(sortedSamples))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sortedSamples) {
+		rank = len(sortedSamples) - 1
+	}
+
+	return sortedSamples[rank]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region rollingSamples ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// rollingSamples contains the samples of a statistic ordered by their time.
+type rollingSamples struct {
+	times      []time.Time
+	samples    []float64
+	maxSamples int
+}
+
+// newRollingSamples creates a new rollingSamples instance that keeps at most the given number of samples (0 keeps all
+// samples).
+func newRollingSamples(maxSamples int) *rollingSamples {
+	return &rollingSamples{
+		times:      make([]time.Time, 0),
+		samples:    make([]float64, 0),
+		maxSamples: maxSamples,
+	}
+}
+
+// add adds a sample and drops the oldest sample if the maximum number of samples is exceeded.
+func (r *rollingSamples) add(sampleTime time.Time, sample float64) {
+	r.times = append(r.times, sampleTime)
+	r.samples = append(r.samples, sample)
+
+	if r.maxSamples > 0 && len(r.samples) > r.maxSamples {
+		r.drop(len(r.samples) - r.maxSamples)
+	}
+}
+
+// prune removes the samples that are older than the given time.
+func (r *rollingSamples) prune(windowStart time.Time) {
+	expiredSamples := sort.Search(len(r.times), func(i int) bool {
+		return !r.times[i].Before(windowStart)
+	})
+	r.drop(expiredSamples)
+}
+
+// drop removes the given number of oldest samples.
+func (r *rollingSamples) drop(count int) {
+	if count == 0 {
+		return
+	}
+
+	r.times = append(r.times[:0], r.times[count:]...)
+	r.samples = append(r.samples[:0], r.samples[count:]...)
+}
+
+// values returns the samples.
+func (r *rollingSamples) values() []float64 {
+	return r.samples
+}
+
+// len returns the number of samples.
+func (r *rollingSamples) len() int {
+	return len(r.samples)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,83 @@
+package analytics
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestAnalytics(t *testing.T) {
+	now := time.Unix(1000, 0)
+	analytics := New(Params{
+		Window:             time.Minute,
+		OrphanageThreshold: 10 * time.Second,
+	}, WithTimeFunc(func() time.Time { return now }))
+
+	// four messages are booked, three of them are confirmed after 1, 2 and 3 seconds
+	messageIDs := make([]tangle.MessageID, 4)
+	for i := range messageIDs {
+		messageIDs[i] = randomMessageID()
+		analytics.MessageBooked(messageIDs[i], now)
+	}
+	for i := 0; i < 3; i++ {
+		now = now.Add(time.Second)
+		analytics.MessageConfirmed(messageIDs[i])
+	}
+
+	branchID := ledgerstate.BranchIDFromRandomness()
+	analytics.BranchCreated(branchID)
+	analytics.BranchCreated(ledgerstate.BranchIDFromRandomness())
+	now = now.Add(5 * time.Second)
+	analytics.BranchResolved(branchID)
+
+	analytics.SampleWidth(4)
+	analytics.SampleWidth(8)
+
+	statistics := analytics.Statistics()
+	assert.Equal(t, 3, statistics.ConfirmedMessages)
+	assert.Equal(t, 0, statistics.OrphanedMessages)
+	assert.Equal(t, 2*time.Second, statistics.ConfirmationLatency.Average)
+	assert.Equal(t, 2*time.Second, statistics.ConfirmationLatency.P50)
+	assert.Equal(t, 3*time.Second, statistics.ConfirmationLatency.P99)
+	assert.Equal(t, 5*time.Second, statistics.ConflictResolutionTime.P50)
+	assert.Equal(t, 1, statistics.PendingConflicts)
+	assert.Equal(t, &WidthStatistics{Current: 8, Average: 6, Max: 8}, statistics.TangleWidth)
+
+	// the unconfirmed message is orphaned once it exceeds the threshold
+	now = now.Add(10 * time.Second)
+	analytics.SampleWidth(2)
+	analytics.MessageOrphaned(randomMessageID())
+
+	statistics = analytics.Statistics()
+	assert.Equal(t, 2, statistics.OrphanedMessages)
+	assert.Equal(t, 0.4, statistics.OrphanageRate)
+
+	// samples leave the window
+	now = now.Add(time.Minute + time.Second)
+	statistics = analytics.Statistics()
+	assert.Equal(t, 0, statistics.ConfirmedMessages)
+	assert.Equal(t, 0, statistics.OrphanedMessages)
+	assert.Equal(t, &WidthStatistics{}, statistics.TangleWidth)
+}
+
+func TestRollingSamples_MaxSamples(t *testing.T) {
+	samples := newRollingSamples(2)
+	for i := 0; i < 3; i++ {
+		samples.add(time.Unix(int64(i), 0), float64(i))
+	}
+
+	assert.Equal(t, []float64{1, 2}, samples.values())
+}
+
+func randomMessageID() (messageID tangle.MessageID) {
+	if _, err := rand.Read(messageID[:]); err != nil {
+		panic(err)
+	}
+
+	return messageID
+}
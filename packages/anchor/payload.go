@@ -0,0 +1,175 @@
+package anchor
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+const (
+	// PayloadName defines the name of the anchor payload.
+	PayloadName = "anchor"
+	payloadType = 13
+
+	// essenceLength contains the amount of bytes of the signed part of a marshaled Payload (IssuerPublicKey,
+	// IssuingTime, TangleTime and ConfirmedRoot).
+	essenceLength = ed25519.PublicKeySize + marshalutil.Int64Size*2 + tangle.MessageIDLength
+
+	// payloadLength contains the amount of bytes of a marshaled Payload without its size and type.
+	payloadLength = essenceLength + ed25519.SignatureSize
+)
+
+// ErrInvalidSignature is returned if the signature of an anchor does not match its content.
+var ErrInvalidSignature = errors.New("invalid anchor signature")
+
+// region Payload //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Payload represents an anchor, i.e. a summary of the confirmed part of the Tangle that is signed by a designated node.
+// Anchors allow other nodes and light clients to estimate how far they lag behind the network without having to trust
+// the node that serves them. The payload carries its own signature, so it can be verified without the message that
+// contains it.
+type Payload struct {
+	// IssuerPublicKey is the public key of the node that signed the anchor.
+	IssuerPublicKey ed25519.PublicKey
+	// IssuingTime is the time at which the anchor was signed.
+	IssuingTime time.Time
+	// TangleTime is the TangleTime of the issuer, i.e. the issuing time of its last confirmed message.
+	TangleTime time.Time
+	// ConfirmedRoot is the last confirmed message of the issuer, whose past cone is confirmed as well.
+	ConfirmedRoot tangle.MessageID
+	// Signature is the signature of the issuer over the other fields.
+	Signature ed25519.Signature
+}
+
+// NewPayload creates an anchor of the given last confirmed message that is signed with the given identity.
+func NewPayload(lastConfirmedMessage tangle.LastConfirmedMessage, issuingTime time.Time, publicKey ed25519.PublicKey, sign func(message []byte) ed25519.Signature) (anchor *Payload) {
+	anchor = &Payload{
+		IssuerPublicKey: publicKey,
+		IssuingTime:     issuingTime,
+		TangleTime:      lastConfirmedMessage.Time,
+		ConfirmedRoot:   lastConfirmedMessage.MessageID,
+	}
+	anchor.Signature = sign(anchor.essence())
+
+	return anchor
+}
+
+// FromBytes unmarshals a Payload from a sequence of bytes.
+func FromBytes(bytes []byte) (result *Payload, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if result, err = FromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse anchor Payload from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FromMarshalUtil unmarshals a Payload using a MarshalUtil (for easier unmarshaling).
+func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (result *Payload, err error) {
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = errors.Errorf("failed to parse payload size of anchor payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	parsedType, err := payload.TypeFromMarshalUtil(marshalUtil)
+	if err != nil {
+		err = errors.Errorf("failed to parse payload type of anchor payload: %w", err)
+		return
+	}
+	if parsedType != payloadType {
+		err = errors.Errorf("invalid payload type %s: %w", parsedType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	result = &Payload{}
+	if result.IssuerPublicKey, err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse issuer public key of anchor payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.IssuingTime, err = marshalUtil.ReadTime(); err != nil {
+		err = errors.Errorf("failed to parse issuing time of anchor payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.TangleTime, err = marshalUtil.ReadTime(); err != nil {
+		err = errors.Errorf("failed to parse tangle time of anchor payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.ConfirmedRoot, err = tangle.ReferenceFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse confirmed root of anchor payload: %w", err)
+		return
+	}
+	if result.Signature, err = ed25519.ParseSignature(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse signature of anchor payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return result, nil
+}
+
+// Verify checks that the anchor was signed by its issuer.
+func (p *Payload) Verify() error {
+	if !p.IssuerPublicKey.VerifySignature(p.essence(), p.Signature) {
+		return errors.Errorf("anchor of %s does not match its signature: %w", p.IssuerPublicKey, ErrInvalidSignature)
+	}
+
+	return nil
+}
+
+// Bytes returns a marshaled version of the Payload.
+func (p *Payload) Bytes() []byte {
+	return marshalutil.New(marshalutil.Uint32Size + payload.TypeLength + payloadLength).
+		WriteUint32(payload.TypeLength + payloadLength).
+		WriteBytes(Type.Bytes()).
+		WriteBytes(p.essence()).
+		WriteBytes(p.Signature.Bytes()).
+		Bytes()
+}
+
+// Type returns the type of the Payload.
+func (p *Payload) Type() payload.Type {
+	return Type
+}
+
+// String returns a human-friendly representation of the Payload.
+func (p *Payload) String() string {
+	return stringify.Struct("AnchorPayload",
+		stringify.StructField("issuerPublicKey", p.IssuerPublicKey),
+		stringify.StructField("issuingTime", p.IssuingTime),
+		stringify.StructField("tangleTime", p.TangleTime),
+		stringify.StructField("confirmedRoot", p.ConfirmedRoot),
+		stringify.StructField("signature", p.Signature),
+	)
+}
+
+// essence returns the signed part of the Payload.
+func (p *Payload) essence() []byte {
+	return marshalutil.New(essenceLength).
+		WriteBytes(p.IssuerPublicKey.Bytes()).
+		WriteTime(p.IssuingTime).
+		WriteTime(p.TangleTime).
+		WriteBytes(p.ConfirmedRoot.Bytes()).
+		Bytes()
+}
+
+// Type represents the identifier which addresses the anchor payload type.
+var Type = payload.NewType(payloadType, PayloadName, func(data []byte) (payload payload.Payload, err error) {
+	var consumedBytes int
+	payload, consumedBytes, err = FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if consumedBytes != len(data) {
+		return nil, errors.New("not all payload bytes were consumed")
+	}
+	return
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
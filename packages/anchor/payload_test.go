@@ -0,0 +1,39 @@
+package anchor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestPayload(t *testing.T) {
+	issuer := identity.GenerateLocalIdentity()
+	anchor := newTestAnchor(issuer, time.Unix(1000, 0), time.Unix(990, 0))
+	require.NoError(t, anchor.Verify())
+
+	parsedPayload, _, err := payload.FromBytes(anchor.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, Type, parsedPayload.Type())
+	parsedAnchor := parsedPayload.(*Payload)
+	assert.Equal(t, anchor.IssuerPublicKey, parsedAnchor.IssuerPublicKey)
+	assert.True(t, anchor.IssuingTime.Equal(parsedAnchor.IssuingTime))
+	assert.True(t, anchor.TangleTime.Equal(parsedAnchor.TangleTime))
+	assert.Equal(t, anchor.ConfirmedRoot, parsedAnchor.ConfirmedRoot)
+	assert.NoError(t, parsedAnchor.Verify())
+
+	// a modified anchor does not match its signature
+	parsedAnchor.TangleTime = parsedAnchor.TangleTime.Add(time.Second)
+	assert.ErrorIs(t, parsedAnchor.Verify(), ErrInvalidSignature)
+}
+
+func newTestAnchor(issuer *identity.LocalIdentity, issuingTime, tangleTime time.Time) *Payload {
+	lastConfirmedMessage := tangle.LastConfirmedMessage{MessageID: tangle.MessageID{byte(tangleTime.Unix())}, Time: tangleTime}
+
+	return NewPayload(lastConfirmedMessage, issuingTime, issuer.PublicKey(), issuer.Sign)
+}
@@ -0,0 +1,127 @@
+package anchor
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var (
+	// ErrUnknownIssuer is returned if an anchor was not signed by one of the designated issuers.
+	ErrUnknownIssuer = errors.New("anchor was not issued by a designated issuer")
+
+	// ErrOutdatedAnchor is returned if an anchor is not newer than the last anchor of its issuer.
+	ErrOutdatedAnchor = errors.New("anchor is outdated")
+)
+
+// region Tracker //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Tracker keeps track of the latest anchor of each designated issuer and estimates the sync status of the node from
+// them.
+type Tracker struct {
+	issuers map[ed25519.PublicKey]bool
+	anchors map[ed25519.PublicKey]*Anchor
+	mutex   sync.RWMutex
+}
+
+// NewTracker is the constructor of the Tracker that accepts the anchors of the given issuers.
+func NewTracker(issuers []ed25519.PublicKey) (tracker *Tracker) {
+	tracker = &Tracker{
+		issuers: make(map[ed25519.PublicKey]bool, len(issuers)),
+		anchors: make(map[ed25519.PublicKey]*Anchor, len(issuers)),
+	}
+	for _, issuer := range issuers {
+		tracker.issuers[issuer] = true
+	}
+
+	return tracker
+}
+
+// Add adds the anchor that was received in the message with the given ID. Anchors of unknown issuers, with an invalid
+// signature or that are not newer than the last anchor of their issuer are rejected.
+func (t *Tracker) Add(anchor *Payload, messageID tangle.MessageID) error {
+	if !t.issuers[anchor.IssuerPublicKey] {
+		return errors.Errorf("failed to add anchor of %s: %w", anchor.IssuerPublicKey, ErrUnknownIssuer)
+	}
+	if err := anchor.Verify(); err != nil {
+		return err
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if latest, exists := t.anchors[anchor.IssuerPublicKey]; exists && !anchor.IssuingTime.After(latest.IssuingTime) {
+		return errors.Errorf("anchor of %s issued at %s is not newer than %s: %w", anchor.IssuerPublicKey, anchor.IssuingTime, latest.IssuingTime, ErrOutdatedAnchor)
+	}
+	t.anchors[anchor.IssuerPublicKey] = &Anchor{Payload: anchor, MessageID: messageID}
+
+	return nil
+}
+
+// Anchors returns the latest anchor of each issuer, ordered by their TangleTime (newest first).
+func (t *Tracker) Anchors() (anchors []*Anchor) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	anchors = make([]*Anchor, 0, len(t.anchors))
+	for _, anchor := range t.anchors {
+		anchors = append(anchors, anchor)
+	}
+	sort.Slice(anchors, func(i, j int) bool {
+		return anchors[i].TangleTime.After(anchors[j].TangleTime)
+	})
+
+	return anchors
+}
+
+// SyncStatus estimates how far the given TangleTime of the node lags behind the TangleTime of the network, which is
+// the median of the TangleTimes of the latest anchors. The median prevents a single issuer from distorting the estimate.
+func (t *Tracker) SyncStatus(tangleTime time.Time) (syncStatus *SyncStatus) {
+	anchors := t.Anchors()
+	syncStatus = &SyncStatus{
+		Anchors: len(anchors),
+	}
+	if len(anchors) == 0 {
+		return syncStatus
+	}
+
+	syncStatus.NetworkTangleTime = anchors[len(anchors)/2].TangleTime
+	if lag := syncStatus.NetworkTangleTime.Sub(tangleTime); lag > 0 {
+		syncStatus.Lag = lag
+	}
+
+	return syncStatus
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Anchor ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Anchor is an anchor Payload together with the ID of the message that contained it.
+type Anchor struct {
+	*Payload
+
+	// MessageID is the ID of the message that contained the anchor.
+	MessageID tangle.MessageID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SyncStatus ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SyncStatus is the estimation of the sync status of a node based on the anchors that it received.
+type SyncStatus struct {
+	// Anchors is the number of issuers whose anchors were received.
+	Anchors int
+	// NetworkTangleTime is the estimated TangleTime of the network (zero if no anchors were received).
+	NetworkTangleTime time.Time
+	// Lag is the duration by which the TangleTime of the node lags behind NetworkTangleTime.
+	Lag time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,45 @@
+package anchor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestTracker(t *testing.T) {
+	issuers := []*identity.LocalIdentity{identity.GenerateLocalIdentity(), identity.GenerateLocalIdentity(), identity.GenerateLocalIdentity()}
+	tracker := NewTracker([]ed25519.PublicKey{issuers[0].PublicKey(), issuers[1].PublicKey(), issuers[2].PublicKey()})
+
+	// without anchors the sync status is unknown
+	syncStatus := tracker.SyncStatus(time.Unix(1000, 0))
+	assert.Zero(t, syncStatus.Anchors)
+	assert.True(t, syncStatus.NetworkTangleTime.IsZero())
+
+	require.NoError(t, tracker.Add(newTestAnchor(issuers[0], time.Unix(100, 0), time.Unix(1000, 0)), tangle.EmptyMessageID))
+	require.NoError(t, tracker.Add(newTestAnchor(issuers[1], time.Unix(100, 0), time.Unix(1010, 0)), tangle.EmptyMessageID))
+	require.NoError(t, tracker.Add(newTestAnchor(issuers[2], time.Unix(100, 0), time.Unix(5000, 0)), tangle.EmptyMessageID))
+
+	// anchors of other nodes, forged anchors and outdated anchors are rejected
+	assert.ErrorIs(t, tracker.Add(newTestAnchor(identity.GenerateLocalIdentity(), time.Unix(100, 0), time.Unix(1000, 0)), tangle.EmptyMessageID), ErrUnknownIssuer)
+	forgedAnchor := newTestAnchor(issuers[0], time.Unix(200, 0), time.Unix(1000, 0))
+	forgedAnchor.TangleTime = time.Unix(6000, 0)
+	assert.ErrorIs(t, tracker.Add(forgedAnchor, tangle.EmptyMessageID), ErrInvalidSignature)
+	assert.ErrorIs(t, tracker.Add(newTestAnchor(issuers[1], time.Unix(100, 0), time.Unix(2000, 0)), tangle.EmptyMessageID), ErrOutdatedAnchor)
+
+	anchors := tracker.Anchors()
+	require.Len(t, anchors, 3)
+	assert.Equal(t, issuers[2].PublicKey(), anchors[0].IssuerPublicKey)
+
+	// the outlier does not distort the estimated TangleTime of the network
+	syncStatus = tracker.SyncStatus(time.Unix(1000, 0))
+	assert.Equal(t, 3, syncStatus.Anchors)
+	assert.True(t, time.Unix(1010, 0).Equal(syncStatus.NetworkTangleTime))
+	assert.Equal(t, 10*time.Second, syncStatus.Lag)
+	assert.Zero(t, tracker.SyncStatus(time.Unix(2000, 0)).Lag)
+}
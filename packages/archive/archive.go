@@ -0,0 +1,221 @@
+// Package archive maintains the history indexes of an archive node, which allow to look up all messages that ever
+// touched an address or that were issued by a node.
+package archive
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/generics/objectstorage"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/stringify"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	// PrefixAddressIndex defines the storage prefix of the address index.
+	PrefixAddressIndex byte = iota
+
+	// PrefixIssuerIndex defines the storage prefix of the issuer index.
+	PrefixIssuerIndex
+)
+
+// IndexType represents the type of a history index that is maintained by the Archive.
+type IndexType string
+
+const (
+	// AddressIndex is the index of the messages that contain a transaction which spends from or sends to an address.
+	AddressIndex IndexType = "address"
+
+	// IssuerIndex is the index of the messages that were issued by a node.
+	IssuerIndex IndexType = "issuer"
+)
+
+// region Archive //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Archive maintains the history indexes of an archive node. The indexes are only ever extended, so together with the
+// messages that are retained in the message storage of the Tangle they allow to answer historic queries.
+type Archive struct {
+	tangle              *tangle.Tangle
+	addressIndexStorage *objectstorage.ObjectStorage[*IndexEntry]
+	issuerIndexStorage  *objectstorage.ObjectStorage[*IndexEntry]
+
+	messageStoredClosure *event.Closure[tangle.MessageID]
+	messageBookedClosure *event.Closure[tangle.MessageID]
+}
+
+// New creates a new Archive that indexes the messages of the given Tangle in the given store.
+func New(t *tangle.Tangle, store kvstore.KVStore) (archive *Archive) {
+	archive = &Archive{
+		tangle:              t,
+		addressIndexStorage: objectstorage.New[*IndexEntry](store.WithRealm([]byte{database.PrefixArchive, PrefixAddressIndex}), objectstorage.PartitionKey(ledgerstate.AddressLength, tangle.MessageIDLength), objectstorage.LeakDetectionEnabled(false), objectstorage.StoreOnCreation(true)),
+		issuerIndexStorage:  objectstorage.New[*IndexEntry](store.WithRealm([]byte{database.PrefixArchive, PrefixIssuerIndex}), objectstorage.PartitionKey(ed25519.PublicKeySize, tangle.MessageIDLength), objectstorage.LeakDetectionEnabled(false), objectstorage.StoreOnCreation(true)),
+	}
+	archive.messageStoredClosure = event.NewClosure(archive.indexIssuer)
+	archive.messageBookedClosure = event.NewClosure(archive.indexAddresses)
+
+	return archive
+}
+
+// Setup attaches the Archive to the events of the Tangle.
+func (a *Archive) Setup() {
+	a.tangle.Storage.Events.MessageStored.Attach(a.messageStoredClosure)
+	a.tangle.Booker.Events.MessageBooked.Attach(a.messageBookedClosure)
+}
+
+// Indexes returns the types of the history indexes that are maintained by the Archive.
+func (a *Archive) Indexes() []IndexType {
+	return []IndexType{AddressIndex, IssuerIndex}
+}
+
+// MessageIDsByAddress returns the MessageIDs of the messages that contain a transaction which spends from or sends to
+// the given address.
+func (a *Archive) MessageIDsByAddress(address ledgerstate.Address) tangle.MessageIDs {
+	return messageIDs(a.addressIndexStorage, address.Bytes())
+}
+
+// MessageIDsByIssuer returns the MessageIDs of the messages that were issued by the node with the given public key.
+func (a *Archive) MessageIDsByIssuer(issuerPublicKey ed25519.PublicKey) tangle.MessageIDs {
+	return messageIDs(a.issuerIndexStorage, issuerPublicKey.Bytes())
+}
+
+// Shutdown detaches the Archive from the Tangle and persists its indexes.
+func (a *Archive) Shutdown() {
+	a.tangle.Storage.Events.MessageStored.Detach(a.messageStoredClosure)
+	a.tangle.Booker.Events.MessageBooked.Detach(a.messageBookedClosure)
+
+	a.addressIndexStorage.Shutdown()
+	a.issuerIndexStorage.Shutdown()
+}
+
+// indexIssuer adds the stored message to the issuer index.
+func (a *Archive) indexIssuer(messageID tangle.MessageID) {
+	a.tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		issuerPublicKey := message.IssuerPublicKey()
+		storeIndexEntry(a.issuerIndexStorage, issuerPublicKey.Bytes(), messageID)
+	})
+}
+
+// indexAddresses adds the booked message to the address index of the addresses of the outputs that are created and
+// spent by its transaction. The message is indexed once it is booked, as the spent outputs are known by then.
+func (a *Archive) indexAddresses(messageID tangle.MessageID) {
+	a.tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		transaction, isTransaction := message.Payload().(*ledgerstate.Transaction)
+		if !isTransaction {
+			return
+		}
+
+		addresses := make(map[string]ledgerstate.Address)
+		for _, output := range transaction.Essence().Outputs() {
+			addresses[string(output.Address().Bytes())] = output.Address()
+		}
+		for _, input := range transaction.Essence().Inputs() {
+			utxoInput, isUTXOInput := input.(*ledgerstate.UTXOInput)
+			if !isUTXOInput {
+				continue
+			}
+
+			a.tangle.LedgerState.CachedOutput(utxoInput.ReferencedOutputID()).Consume(func(output ledgerstate.Output) {
+				addresses[string(output.Address().Bytes())] = output.Address()
+			})
+		}
+
+		for _, address := range addresses {
+			storeIndexEntry(a.addressIndexStorage, address.Bytes(), messageID)
+		}
+	})
+}
+
+// storeIndexEntry adds the given message to the given index under the given key.
+func storeIndexEntry(indexStorage *objectstorage.ObjectStorage[*IndexEntry], indexKey []byte, messageID tangle.MessageID) {
+	if cachedIndexEntry, stored := indexStorage.StoreIfAbsent(NewIndexEntry(indexKey, messageID)); stored {
+		cachedIndexEntry.Release()
+	}
+}
+
+// messageIDs returns the MessageIDs that are stored in the given index under the given key.
+func messageIDs(indexStorage *objectstorage.ObjectStorage[*IndexEntry], indexKey []byte) (messageIDs tangle.MessageIDs) {
+	messageIDs = tangle.NewMessageIDs()
+	indexStorage.ForEach(func(_ []byte, cachedIndexEntry *objectstorage.CachedObject[*IndexEntry]) bool {
+		cachedIndexEntry.Consume(func(indexEntry *IndexEntry) {
+			messageIDs.Add(indexEntry.MessageID())
+		})
+
+		return true
+	}, objectstorage.WithIteratorPrefix(indexKey))
+
+	return messageIDs
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IndexEntry ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// IndexEntry is an entry of a history index that maps an index key (e.g. an address) to a message.
+type IndexEntry struct {
+	objectstorage.StorableObjectFlags
+
+	indexKey  []byte
+	messageID tangle.MessageID
+}
+
+// NewIndexEntry creates a new IndexEntry that maps the given index key to the given message.
+func NewIndexEntry(indexKey []byte, messageID tangle.MessageID) *IndexEntry {
+	return &IndexEntry{
+		indexKey:  indexKey,
+		messageID: messageID,
+	}
+}
+
+// FromObjectStorage creates an IndexEntry from sequences of key and bytes.
+func (i *IndexEntry) FromObjectStorage(key, _ []byte) (objectstorage.StorableObject, error) {
+	if len(key) < tangle.MessageIDLength {
+		return nil, errors.Errorf("failed to parse index entry from object storage: key of %d bytes is too short", len(key))
+	}
+
+	indexKeyLength := len(key) - tangle.MessageIDLength
+	indexEntry := &IndexEntry{
+		indexKey: key[:indexKeyLength:indexKeyLength],
+	}
+	copy(indexEntry.messageID[:], key[indexKeyLength:])
+
+	return indexEntry, nil
+}
+
+// IndexKey returns the index key of the IndexEntry.
+func (i *IndexEntry) IndexKey() []byte {
+	return i.indexKey
+}
+
+// MessageID returns the MessageID of the IndexEntry.
+func (i *IndexEntry) MessageID() tangle.MessageID {
+	return i.messageID
+}
+
+// String returns a human-readable version of the IndexEntry.
+func (i *IndexEntry) String() string {
+	return stringify.Struct("IndexEntry",
+		stringify.StructField("indexKey", i.indexKey),
+		stringify.StructField("messageID", i.messageID),
+	)
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database.
+func (i *IndexEntry) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(i.indexKey, i.messageID.Bytes())
+}
+
+// ObjectStorageValue marshals the IndexEntry into a sequence of bytes. Since all of the information of the IndexEntry
+// is stored in its key, the value is empty.
+func (i *IndexEntry) ObjectStorageValue() []byte {
+	return nil
+}
+
+// Interface contract: make compiler warn if the interface is not implemented correctly.
+var _ objectstorage.StorableObject = new(IndexEntry)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,67 @@
+package archive
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestArchive(t *testing.T) {
+	store := mapdb.NewMapDB()
+	testTangle := tangle.NewTestTangle(tangle.Store(store))
+	defer testTangle.Shutdown()
+
+	archive := New(testTangle, store)
+	defer archive.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(
+		testTangle,
+		tangle.WithGenesisOutput("G", 3),
+	)
+
+	testTangle.Setup()
+	archive.Setup()
+
+	issuer1 := ed25519.GenerateKeyPair().PublicKey
+	issuer2 := ed25519.GenerateKeyPair().PublicKey
+
+	testFramework.CreateMessage("Message1", tangle.WithStrongParents("Genesis"), tangle.WithIssuer(issuer1), tangle.WithInputs("G"), tangle.WithOutput("A", 1), tangle.WithOutput("B", 2))
+	testFramework.CreateMessage("Message2", tangle.WithStrongParents("Message1"), tangle.WithIssuer(issuer2), tangle.WithInputs("A"), tangle.WithOutput("C", 1))
+	testFramework.CreateMessage("Message3", tangle.WithStrongParents("Message2"), tangle.WithIssuer(issuer1))
+	testFramework.IssueMessages("Message1").WaitMessagesBooked()
+	testFramework.IssueMessages("Message2").WaitMessagesBooked()
+	testFramework.IssueMessages("Message3").WaitMessagesBooked()
+
+	message1ID := testFramework.Message("Message1").ID()
+	message2ID := testFramework.Message("Message2").ID()
+	message3ID := testFramework.Message("Message3").ID()
+
+	assert.Equal(t, tangle.NewMessageIDs(message1ID, message3ID), archive.MessageIDsByIssuer(issuer1))
+	assert.Equal(t, tangle.NewMessageIDs(message2ID), archive.MessageIDsByIssuer(issuer2))
+
+	// the address of a spent output is indexed for the messages that created and spent it
+	spentAddress := outputAddress(t, testTangle, testFramework.Transaction("Message2").Essence().Inputs()[0])
+	assert.Equal(t, tangle.NewMessageIDs(message1ID, message2ID), archive.MessageIDsByAddress(spentAddress))
+
+	genesisAddress := outputAddress(t, testTangle, testFramework.Transaction("Message1").Essence().Inputs()[0])
+	assert.Equal(t, tangle.NewMessageIDs(message1ID), archive.MessageIDsByAddress(genesisAddress))
+
+	createdAddress := testFramework.Transaction("Message2").Essence().Outputs()[0].Address()
+	assert.Equal(t, tangle.NewMessageIDs(message2ID), archive.MessageIDsByAddress(createdAddress))
+
+	assert.Empty(t, archive.MessageIDsByAddress(ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)))
+}
+
+// outputAddress returns the address of the output that is referenced by the given input.
+func outputAddress(t *testing.T, testTangle *tangle.Tangle, input ledgerstate.Input) (address ledgerstate.Address) {
+	assert.True(t, testTangle.LedgerState.CachedOutput(input.(*ledgerstate.UTXOInput).ReferencedOutputID()).Consume(func(output ledgerstate.Output) {
+		address = output.Address()
+	}))
+
+	return address
+}
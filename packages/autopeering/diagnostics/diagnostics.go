@@ -0,0 +1,299 @@
+package diagnostics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/salt"
+	"github.com/iotaledger/hive.go/autopeering/selection"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// MaxRecentDrops defines how many of the most recent drops are kept by the Tracker.
+const MaxRecentDrops = 100
+
+// region Direction ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Direction defines whether a neighbor was chosen by the node itself or accepted upon its request.
+type Direction string
+
+const (
+	// DirectionOutbound marks neighbors that were chosen by the node (using its public salt).
+	DirectionOutbound Direction = "outbound"
+
+	// DirectionInbound marks neighbors that were accepted upon their request (using the private salt of the node).
+	DirectionInbound Direction = "inbound"
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DropReason ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DropReason describes why a neighbor was dropped.
+type DropReason string
+
+const (
+	// DropReasonSelection is used for neighbors that were dropped by the neighbor selection, i.e. that were replaced by
+	// a closer candidate or that sent a peering drop themselves.
+	DropReasonSelection DropReason = "selection"
+
+	// DropReasonGossipFailure is used for neighbors whose gossip connection could not be established.
+	DropReasonGossipFailure DropReason = "gossipFailure"
+
+	// DropReasonGossipDisconnect is used for neighbors whose gossip connection was closed.
+	DropReasonGossipDisconnect DropReason = "gossipDisconnect"
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Tracker //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Tracker keeps track of the neighbors of the autopeering and of the reasons why they were dropped, so that neighbor
+// churn can be diagnosed without verbose logs.
+type Tracker struct {
+	// Events contains the Events of the Tracker.
+	Events *Events
+
+	local                *peer.Local
+	inboundNeighborSize  int
+	outboundNeighborSize int
+	candidatesFunc       func() []*peer.Peer
+	neighbors            map[identity.ID]*Neighbor
+	dropReasons          map[identity.ID]DropReason
+	recentDrops          []*Drop
+	mutex                sync.RWMutex
+}
+
+// NewTracker creates a new Tracker for the given local peer and the configured number of inbound and outbound neighbor
+// slots. The candidatesFunc returns the peers that are considered by the neighbor selection (e.g. the verified peers of
+// the peer discovery).
+func NewTracker(local *peer.Local, inboundNeighborSize, outboundNeighborSize int, candidatesFunc func() []*peer.Peer) *Tracker {
+	return &Tracker{
+		Events: &Events{
+			NeighborAdded:   event.New[*Neighbor]("Tracker.NeighborAdded"),
+			NeighborDropped: event.New[*Drop]("Tracker.NeighborDropped"),
+		},
+		local:                local,
+		inboundNeighborSize:  inboundNeighborSize,
+		outboundNeighborSize: outboundNeighborSize,
+		candidatesFunc:       candidatesFunc,
+		neighbors:            make(map[identity.ID]*Neighbor),
+		dropReasons:          make(map[identity.ID]DropReason),
+		recentDrops:          make([]*Drop, 0, MaxRecentDrops),
+	}
+}
+
+// Attach attaches the Tracker to the events of the neighbor selection.
+func (t *Tracker) Attach(selectionEvents selection.Events) {
+	selectionEvents.OutgoingPeering.Attach(events.NewClosure(func(ev *selection.PeeringEvent) {
+		t.OnPeering(ev, DirectionOutbound)
+	}))
+	selectionEvents.IncomingPeering.Attach(events.NewClosure(func(ev *selection.PeeringEvent) {
+		t.OnPeering(ev, DirectionInbound)
+	}))
+	selectionEvents.Dropped.Attach(events.NewClosure(t.OnDropped))
+}
+
+// OnPeering records the neighbor of a successful peering.
+func (t *Tracker) OnPeering(ev *selection.PeeringEvent, direction Direction) {
+	if !ev.Status {
+		return
+	}
+
+	neighbor := &Neighbor{
+		Peer:      ev.Peer,
+		Direction: direction,
+		Distance:  ev.Distance,
+		Since:     time.Now(),
+	}
+
+	t.mutex.Lock()
+	t.neighbors[ev.Peer.ID()] = neighbor
+	delete(t.dropReasons, ev.Peer.ID())
+	t.mutex.Unlock()
+
+	t.Events.NeighborAdded.Trigger(neighbor)
+}
+
+// OnDropped records the drop of a neighbor.
+func (t *Tracker) OnDropped(ev *selection.DroppedEvent) {
+	drop := &Drop{
+		PeerID: ev.DroppedID,
+		Reason: DropReasonSelection,
+		Time:   time.Now(),
+	}
+
+	t.mutex.Lock()
+	if neighbor, exists := t.neighbors[ev.DroppedID]; exists {
+		drop.Direction = neighbor.Direction
+		drop.Distance = neighbor.Distance
+		drop.Duration = drop.Time.Sub(neighbor.Since)
+		delete(t.neighbors, ev.DroppedID)
+	}
+	if reason, exists := t.dropReasons[ev.DroppedID]; exists {
+		drop.Reason = reason
+		delete(t.dropReasons, ev.DroppedID)
+	}
+	if len(t.recentDrops) == MaxRecentDrops {
+		t.recentDrops = append(t.recentDrops[:0], t.recentDrops[1:]...)
+	}
+	t.recentDrops = append(t.recentDrops, drop)
+	t.mutex.Unlock()
+
+	t.Events.NeighborDropped.Trigger(drop)
+}
+
+// SetDropReason defines the reason of the next drop of the given neighbor. It needs to be called before the neighbor is
+// removed from the neighbor selection.
+func (t *Tracker) SetDropReason(id identity.ID, reason DropReason) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.dropReasons[id] = reason
+}
+
+// Neighbors returns the current neighbors.
+func (t *Tracker) Neighbors() (neighbors []*Neighbor) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	neighbors = make([]*Neighbor, 0, len(t.neighbors))
+	for _, neighbor := range t.neighbors {
+		neighbors = append(neighbors, neighbor)
+	}
+
+	return neighbors
+}
+
+// RecentDrops returns the most recent drops (ordered from old to new).
+func (t *Tracker) RecentDrops() (drops []*Drop) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return append(make([]*Drop, 0, len(t.recentDrops)), t.recentDrops...)
+}
+
+// InboundNeighborSize returns the maximum number of inbound neighbors.
+func (t *Tracker) InboundNeighborSize() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.inboundNeighborSize
+}
+
+// OutboundNeighborSize returns the maximum number of outbound neighbors.
+func (t *Tracker) OutboundNeighborSize() int {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	return t.outboundNeighborSize
+}
+
+// SetNeighborSizes updates the maximum number of inbound and outbound neighbors after they were changed at runtime.
+func (t *Tracker) SetNeighborSizes(inboundNeighborSize, outboundNeighborSize int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.inboundNeighborSize = inboundNeighborSize
+	t.outboundNeighborSize = outboundNeighborSize
+}
+
+// PublicSalt returns the current public salt of the node, which determines the distance to outbound candidates.
+func (t *Tracker) PublicSalt() *salt.Salt {
+	return t.local.GetPublicSalt()
+}
+
+// PrivateSalt returns the current private salt of the node, which determines the distance to inbound candidates.
+func (t *Tracker) PrivateSalt() *salt.Salt {
+	return t.local.GetPrivateSalt()
+}
+
+// OutboundCandidates returns the candidates for outbound neighbors, sorted by their distance using the public salt.
+func (t *Tracker) OutboundCandidates() []*Candidate {
+	return t.candidates(t.PublicSalt())
+}
+
+// InboundCandidates returns the candidates for inbound neighbors, sorted by their distance using the private salt.
+func (t *Tracker) InboundCandidates() []*Candidate {
+	return t.candidates(t.PrivateSalt())
+}
+
+// candidates returns the candidates sorted by their distance using the given salt.
+func (t *Tracker) candidates(s *salt.Salt) (candidates []*Candidate) {
+	if s == nil {
+		return nil
+	}
+
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	distances := peer.SortBySalt(t.local.ID().Bytes(), s.GetBytes(), t.candidatesFunc())
+	candidates = make([]*Candidate, len(distances))
+	for i, peerDistance := range distances {
+		_, isNeighbor := t.neighbors[peerDistance.Remote.ID()]
+		candidates[i] = &Candidate{
+			Peer:     peerDistance.Remote,
+			Distance: peerDistance.Distance,
+			Neighbor: isNeighbor,
+		}
+	}
+
+	return candidates
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Neighbor /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Neighbor contains the information about a current neighbor. The Distance is the one at the time the peering was
+// established.
+type Neighbor struct {
+	Peer      *peer.Peer
+	Direction Direction
+	Distance  uint32
+	Since     time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Candidate ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Candidate contains a peer that is considered by the neighbor selection and its distance to the node.
+type Candidate struct {
+	Peer     *peer.Peer
+	Distance uint32
+	Neighbor bool
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Drop /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Drop contains the information about a dropped neighbor.
+type Drop struct {
+	PeerID    identity.ID
+	Direction Direction
+	Distance  uint32
+	Duration  time.Duration
+	Reason    DropReason
+	Time      time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events contains the events of the Tracker.
+type Events struct {
+	// NeighborAdded is triggered when a peering was established.
+	NeighborAdded *event.Event[*Neighbor]
+
+	// NeighborDropped is triggered when a neighbor was dropped.
+	NeighborDropped *event.Event[*Drop]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,87 @@
+package diagnostics
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	"github.com/iotaledger/hive.go/autopeering/salt"
+	"github.com/iotaledger/hive.go/autopeering/selection"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestTracker(t *testing.T) {
+	db, err := peer.NewDB(mapdb.NewMapDB())
+	require.NoError(t, err)
+	local, err := peer.NewLocal(net.ParseIP("127.0.0.1"), newTestServices(), db)
+	require.NoError(t, err)
+	publicSalt, err := salt.NewSalt(time.Hour)
+	require.NoError(t, err)
+	local.SetPublicSalt(publicSalt)
+	privateSalt, err := salt.NewSalt(time.Hour)
+	require.NoError(t, err)
+	local.SetPrivateSalt(privateSalt)
+
+	peers := []*peer.Peer{newTestPeer(), newTestPeer(), newTestPeer()}
+	tracker := NewTracker(local, 8, 2, func() []*peer.Peer { return peers })
+
+	var droppedEvents []*Drop
+	tracker.Events.NeighborDropped.Attach(event.NewClosure(func(drop *Drop) {
+		droppedEvents = append(droppedEvents, drop)
+	}))
+
+	tracker.OnPeering(&selection.PeeringEvent{Peer: peers[0], Status: true, Distance: 42}, DirectionOutbound)
+	tracker.OnPeering(&selection.PeeringEvent{Peer: peers[1], Status: true, Distance: 7}, DirectionInbound)
+	tracker.OnPeering(&selection.PeeringEvent{Peer: peers[2], Status: false}, DirectionOutbound)
+	assert.Len(t, tracker.Neighbors(), 2)
+	assert.Equal(t, 8, tracker.InboundNeighborSize())
+	assert.Equal(t, 2, tracker.OutboundNeighborSize())
+
+	outboundCandidates := tracker.OutboundCandidates()
+	require.Len(t, outboundCandidates, 3)
+	for i := 1; i < len(outboundCandidates); i++ {
+		assert.LessOrEqual(t, outboundCandidates[i-1].Distance, outboundCandidates[i].Distance)
+	}
+	for _, candidate := range tracker.InboundCandidates() {
+		assert.Equal(t, candidate.Peer != peers[2], candidate.Neighbor)
+	}
+
+	tracker.SetDropReason(peers[0].ID(), DropReasonGossipDisconnect)
+	tracker.OnDropped(&selection.DroppedEvent{Peer: peers[0], DroppedID: peers[0].ID()})
+	tracker.OnDropped(&selection.DroppedEvent{Peer: peers[1], DroppedID: peers[1].ID()})
+	assert.Empty(t, tracker.Neighbors())
+
+	recentDrops := tracker.RecentDrops()
+	require.Len(t, recentDrops, 2)
+	assert.Equal(t, droppedEvents, recentDrops)
+	assert.Equal(t, DropReasonGossipDisconnect, recentDrops[0].Reason)
+	assert.Equal(t, DirectionOutbound, recentDrops[0].Direction)
+	assert.EqualValues(t, 42, recentDrops[0].Distance)
+	assert.Equal(t, DropReasonSelection, recentDrops[1].Reason)
+	assert.Equal(t, DirectionInbound, recentDrops[1].Direction)
+
+	for i := 0; i < MaxRecentDrops; i++ {
+		tracker.OnDropped(&selection.DroppedEvent{Peer: peers[2], DroppedID: peers[2].ID()})
+	}
+	assert.Len(t, tracker.RecentDrops(), MaxRecentDrops)
+	assert.Equal(t, peers[2].ID(), tracker.RecentDrops()[0].PeerID)
+}
+
+func newTestPeer() *peer.Peer {
+	return peer.NewPeer(identity.GenerateIdentity(), net.ParseIP("127.0.0.1"), newTestServices())
+}
+
+func newTestServices() *service.Record {
+	services := service.New()
+	services.Update(service.PeeringKey, "udp", 14626)
+	services.Update(service.GossipKey, "tcp", 14666)
+
+	return services
+}
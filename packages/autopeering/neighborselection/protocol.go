@@ -0,0 +1,245 @@
+package neighborselection
+
+import (
+	"net"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/salt"
+	"github.com/iotaledger/hive.go/autopeering/selection"
+	"github.com/iotaledger/hive.go/autopeering/server"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+// ErrInvalidNeighborSize is returned if a neighborhood size is not positive.
+var ErrInvalidNeighborSize = errors.New("the number of neighbors must be positive")
+
+// region Protocol /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Protocol is a neighbor selection whose number of inbound and outbound neighbors can be changed at runtime. The
+// selection of hive.go fixes the sizes of its neighborhoods when it is created, so the Protocol replaces it with a new
+// selection whenever the sizes change. The new selection starts with new salts, which rebalances the neighborhood.
+type Protocol struct {
+	local *peer.Local
+	disc  selection.DiscoverProtocol
+	opts  []selection.Option
+
+	events               selection.Events
+	sender               server.Sender
+	inboundNeighborSize  int
+	outboundNeighborSize int
+	selection            *selection.Protocol
+	forwardingClosures   []*events.Closure
+	mutex                sync.RWMutex
+}
+
+// New creates a new Protocol with the given number of inbound and outbound neighbors.
+func New(local *peer.Local, disc selection.DiscoverProtocol, inboundNeighborSize, outboundNeighborSize int, opts ...selection.Option) (protocol *Protocol, err error) {
+	if err = validateNeighborSizes(inboundNeighborSize, outboundNeighborSize); err != nil {
+		return nil, err
+	}
+
+	protocol = &Protocol{
+		local: local,
+		disc:  disc,
+		opts:  opts,
+		events: selection.Events{
+			SaltUpdated:     events.NewEvent(saltUpdatedCaller),
+			OutgoingPeering: events.NewEvent(peeringCaller),
+			IncomingPeering: events.NewEvent(peeringCaller),
+			Dropped:         events.NewEvent(droppedCaller),
+		},
+		inboundNeighborSize:  inboundNeighborSize,
+		outboundNeighborSize: outboundNeighborSize,
+	}
+	protocol.createSelection()
+
+	return protocol, nil
+}
+
+// Start starts the neighbor selection over the provided Sender.
+func (p *Protocol) Start(sender server.Sender) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.sender = sender
+	p.selection.Start(sender)
+}
+
+// Close finalizes the neighbor selection.
+func (p *Protocol) Close() {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	p.selection.Close()
+}
+
+// Events returns the events that are triggered during the neighbor selection. They stay the same when the sizes of
+// the neighborhoods are changed.
+func (p *Protocol) Events() selection.Events {
+	return p.events
+}
+
+// NeighborSizes returns the number of inbound and outbound neighbors.
+func (p *Protocol) NeighborSizes() (inboundNeighborSize, outboundNeighborSize int) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.inboundNeighborSize, p.outboundNeighborSize
+}
+
+// SetNeighborSizes changes the number of inbound and outbound neighbors. All current neighbors are dropped and the salts
+// are renewed, before a new selection chooses the neighbors for the new sizes.
+func (p *Protocol) SetNeighborSizes(inboundNeighborSize, outboundNeighborSize int) (err error) {
+	if err = validateNeighborSizes(inboundNeighborSize, outboundNeighborSize); err != nil {
+		return err
+	}
+
+	publicSalt, err := salt.NewSalt(selection.DefaultSaltLifetime)
+	if err != nil {
+		return errors.Errorf("failed to create public salt: %w", err)
+	}
+	privateSalt, err := salt.NewSalt(selection.DefaultSaltLifetime)
+	if err != nil {
+		return errors.Errorf("failed to create private salt: %w", err)
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	previousSelection := p.selection
+	neighbors := previousSelection.GetNeighbors()
+	p.detachSelection()
+	previousSelection.Close()
+	for _, neighbor := range neighbors {
+		if p.sender != nil {
+			previousSelection.PeeringDrop(neighbor)
+		}
+		p.events.Dropped.Trigger(&selection.DroppedEvent{Peer: neighbor, DroppedID: neighbor.ID()})
+	}
+
+	// the new salts change the distances to all candidates, so that the neighborhood is rebalanced
+	p.local.SetPublicSalt(publicSalt)
+	p.local.SetPrivateSalt(privateSalt)
+	p.events.SaltUpdated.Trigger(&selection.SaltUpdatedEvent{Public: publicSalt, Private: privateSalt})
+
+	p.inboundNeighborSize, p.outboundNeighborSize = inboundNeighborSize, outboundNeighborSize
+	p.createSelection()
+	if p.sender != nil {
+		p.selection.Start(p.sender)
+	}
+
+	return nil
+}
+
+// GetNeighbors returns the current neighbors.
+func (p *Protocol) GetNeighbors() []*peer.Peer {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.selection.GetNeighbors()
+}
+
+// GetIncomingNeighbors returns the current incoming neighbors.
+func (p *Protocol) GetIncomingNeighbors() []*peer.Peer {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.selection.GetIncomingNeighbors()
+}
+
+// GetOutgoingNeighbors returns the current outgoing neighbors.
+func (p *Protocol) GetOutgoingNeighbors() []*peer.Peer {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.selection.GetOutgoingNeighbors()
+}
+
+// RemoveNeighbor removes the neighbor with the given ID from the neighborhood.
+func (p *Protocol) RemoveNeighbor(id identity.ID) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	p.selection.RemoveNeighbor(id)
+}
+
+// BlockNeighbor removes the neighbor with the given ID from the neighborhood and blocks it for some time.
+func (p *Protocol) BlockNeighbor(id identity.ID) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	p.selection.BlockNeighbor(id)
+}
+
+// HandleMessage passes the messages of the neighbor selection to the current selection.
+func (p *Protocol) HandleMessage(s *server.Server, fromAddr *net.UDPAddr, from *identity.Identity, data []byte) (bool, error) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.selection.HandleMessage(s, fromAddr, from, data)
+}
+
+// createSelection creates the selection for the current sizes and forwards its events. It expects the mutex to be
+// locked.
+func (p *Protocol) createSelection() {
+	// the neighborhood sizes are global parameters of the selection and need to be set before it is created
+	selection.SetParameters(selection.Parameters{
+		InboundNeighborSize:  p.inboundNeighborSize,
+		OutboundNeighborSize: p.outboundNeighborSize,
+	})
+	p.selection = selection.New(p.local, p.disc, p.opts...)
+
+	p.forwardingClosures = []*events.Closure{
+		events.NewClosure(func(ev *selection.SaltUpdatedEvent) { p.events.SaltUpdated.Trigger(ev) }),
+		events.NewClosure(func(ev *selection.PeeringEvent) { p.events.OutgoingPeering.Trigger(ev) }),
+		events.NewClosure(func(ev *selection.PeeringEvent) { p.events.IncomingPeering.Trigger(ev) }),
+		events.NewClosure(func(ev *selection.DroppedEvent) { p.events.Dropped.Trigger(ev) }),
+	}
+	for i, event := range p.selectionEvents() {
+		event.Attach(p.forwardingClosures[i])
+	}
+}
+
+// detachSelection stops forwarding the events of the current selection. It expects the mutex to be locked.
+func (p *Protocol) detachSelection() {
+	for i, event := range p.selectionEvents() {
+		event.Detach(p.forwardingClosures[i])
+	}
+}
+
+// selectionEvents returns the events of the current selection in the order of the forwardingClosures.
+func (p *Protocol) selectionEvents() []*events.Event {
+	selectionEvents := p.selection.Events()
+
+	return []*events.Event{selectionEvents.SaltUpdated, selectionEvents.OutgoingPeering, selectionEvents.IncomingPeering, selectionEvents.Dropped}
+}
+
+// validateNeighborSizes returns an error if one of the given sizes is not positive.
+func validateNeighborSizes(inboundNeighborSize, outboundNeighborSize int) error {
+	if inboundNeighborSize <= 0 || outboundNeighborSize <= 0 {
+		return errors.Errorf("invalid neighbor sizes (inbound: %d, outbound: %d): %w", inboundNeighborSize, outboundNeighborSize, ErrInvalidNeighborSize)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region event callers ////////////////////////////////////////////////////////////////////////////////////////////////
+
+func saltUpdatedCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*selection.SaltUpdatedEvent))(params[0].(*selection.SaltUpdatedEvent))
+}
+
+func peeringCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*selection.PeeringEvent))(params[0].(*selection.PeeringEvent))
+}
+
+func droppedCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*selection.DroppedEvent))(params[0].(*selection.DroppedEvent))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,65 @@
+package neighborselection
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	"github.com/iotaledger/hive.go/autopeering/salt"
+	"github.com/iotaledger/hive.go/autopeering/selection"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocol_SetNeighborSizes(t *testing.T) {
+	db, err := peer.NewDB(mapdb.NewMapDB())
+	require.NoError(t, err)
+	services := service.New()
+	services.Update(service.PeeringKey, "udp", 14626)
+	local, err := peer.NewLocal(net.ParseIP("127.0.0.1"), services, db)
+	require.NoError(t, err)
+	initialSalt, err := salt.NewSalt(time.Hour)
+	require.NoError(t, err)
+	local.SetPublicSalt(initialSalt)
+	local.SetPrivateSalt(initialSalt)
+
+	_, err = New(local, &emptyDiscovery{}, 0, 4)
+	assert.ErrorIs(t, err, ErrInvalidNeighborSize)
+
+	protocol, err := New(local, &emptyDiscovery{}, 4, 4)
+	require.NoError(t, err)
+	defer protocol.Close()
+
+	var saltUpdates int
+	protocol.Events().SaltUpdated.Attach(events.NewClosure(func(*selection.SaltUpdatedEvent) { saltUpdates++ }))
+
+	// sizes of 0 are rejected instead of falling back to the defaults of the selection
+	assert.ErrorIs(t, protocol.SetNeighborSizes(8, 0), ErrInvalidNeighborSize)
+	assert.Equal(t, 0, saltUpdates)
+
+	// the new sizes renew the salts, so that the neighborhood is rebalanced
+	require.NoError(t, protocol.SetNeighborSizes(8, 2))
+	inboundNeighborSize, outboundNeighborSize := protocol.NeighborSizes()
+	assert.Equal(t, 8, inboundNeighborSize)
+	assert.Equal(t, 2, outboundNeighborSize)
+	assert.Equal(t, 1, saltUpdates)
+	assert.NotEqual(t, initialSalt.GetBytes(), local.GetPublicSalt().GetBytes())
+	assert.NotEqual(t, initialSalt.GetBytes(), local.GetPrivateSalt().GetBytes())
+	assert.Empty(t, protocol.GetNeighbors())
+}
+
+// emptyDiscovery is a peer discovery that does not know any peers.
+type emptyDiscovery struct{}
+
+func (*emptyDiscovery) IsVerified(identity.ID, net.IP) bool { return false }
+
+func (*emptyDiscovery) EnsureVerified(*peer.Peer) error { return nil }
+
+func (*emptyDiscovery) GetVerifiedPeer(identity.ID) *peer.Peer { return nil }
+
+func (*emptyDiscovery) GetVerifiedPeers() []*peer.Peer { return nil }
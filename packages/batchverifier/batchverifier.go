@@ -0,0 +1,166 @@
+package batchverifier
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	oed25519 "github.com/oasisprotocol/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/pool"
+)
+
+// DefaultMaxBatchSize defines the maximum number of signatures that are verified in a single batch. Bigger batches do
+// not improve the performance as the underlying implementation splits them into batches of this size.
+const DefaultMaxBatchSize = 64
+
+// BatchVerifier verifies ed25519 signatures in batches on multiple cores. As long as one of its workers is idle, a
+// signature is verified immediately. Signatures that arrive while all workers are busy are queued and verified together
+// by the next free worker, so that the batch size adapts to the load without adding latency.
+//
+// A batch is verified using the batch verification of ed25519 which is significantly cheaper than verifying the
+// signatures one by one. If the batch contains an invalid signature, its signatures are verified individually, so that
+// the result of every signature is the same as the one of PublicKey.VerifySignature.
+type BatchVerifier struct {
+	maxBatchSize  int
+	workerCount   int
+	pending       []*verification
+	activeWorkers int
+	closed        bool
+	mutex         sync.Mutex
+	wg            sync.WaitGroup
+}
+
+// New creates a new BatchVerifier that uses up to workerCount workers (defaults to the number of cores if it is not
+// positive) and verifies at most maxBatchSize signatures at once (defaults to DefaultMaxBatchSize if it is not
+// positive).
+func New(workerCount, maxBatchSize int) *BatchVerifier {
+	if workerCount <= 0 {
+		workerCount = runtime.NumCPU()
+	}
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxBatchSize
+	}
+
+	return &BatchVerifier{
+		maxBatchSize: maxBatchSize,
+		workerCount:  workerCount,
+	}
+}
+
+// Verify queues the verification of the signature of the given data and calls the callback with the result once it is
+// verified. The callback is called from one of the workers. Signatures that are verified after the BatchVerifier was
+// shut down are verified synchronously.
+func (b *BatchVerifier) Verify(publicKey ed25519.PublicKey, data []byte, signature ed25519.Signature, callback func(valid bool)) {
+	b.mutex.Lock()
+	if b.closed {
+		b.mutex.Unlock()
+		callback(publicKey.VerifySignature(data, signature))
+		return
+	}
+
+	b.pending = append(b.pending, &verification{
+		publicKey: publicKey,
+		data:      data,
+		signature: signature,
+		callback:  callback,
+	})
+	if b.activeWorkers < b.workerCount {
+		b.activeWorkers++
+		b.wg.Add(1)
+		go b.work()
+	}
+	b.mutex.Unlock()
+}
+
+// Shutdown verifies the queued signatures and waits until all workers are done.
+func (b *BatchVerifier) Shutdown() {
+	b.mutex.Lock()
+	b.closed = true
+	b.mutex.Unlock()
+
+	b.wg.Wait()
+}
+
+// work verifies the queued signatures until the queue is empty.
+func (b *BatchVerifier) work() {
+	defer b.wg.Done()
+
+	for batch := b.nextBatch(); batch != nil; batch = b.nextBatch() {
+		verifyBatch(batch)
+	}
+}
+
+// nextBatch removes the next batch from the queue. It returns nil and retires the worker if the queue is empty.
+func (b *BatchVerifier) nextBatch() (batch []*verification) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if len(b.pending) == 0 {
+		b.pending = nil
+		b.activeWorkers--
+		return nil
+	}
+
+	batchSize := len(b.pending)
+	if batchSize > b.maxBatchSize {
+		batchSize = b.maxBatchSize
+	}
+	batch, b.pending = b.pending[:batchSize], b.pending[batchSize:]
+
+	return batch
+}
+
+// verifyBatch verifies the given signatures and calls their callbacks.
+func verifyBatch(batch []*verification) {
+	buffers := batchBuffersPool.Get()
+	defer batchBuffersPool.Put(buffers)
+
+	for _, v := range batch {
+		buffers.publicKeys = append(buffers.publicKeys, v.publicKey[:])
+		buffers.messages = append(buffers.messages, v.data)
+		buffers.signatures = append(buffers.signatures, v.signature[:])
+	}
+
+	_, valid, err := oed25519.VerifyBatch(nil, buffers.publicKeys, buffers.messages, buffers.signatures, &oed25519.Options{})
+	for i, v := range batch {
+		if err != nil {
+			v.callback(v.publicKey.VerifySignature(v.data, v.signature))
+			continue
+		}
+		v.callback(valid[i])
+	}
+}
+
+// verification is a signature that is queued for verification.
+type verification struct {
+	publicKey ed25519.PublicKey
+	data      []byte
+	signature ed25519.Signature
+	callback  func(valid bool)
+}
+
+// batchBuffersPool contains the buffers that are used to pass a batch to the batch verification of ed25519.
+var batchBuffersPool = pool.New(func() *batchBuffers {
+	return &batchBuffers{}
+}, (*batchBuffers).reset)
+
+// batchBuffers contains the public keys, messages and signatures of a batch.
+type batchBuffers struct {
+	publicKeys []oed25519.PublicKey
+	messages   [][]byte
+	signatures [][]byte
+}
+
+// reset empties the buffers and releases the references to the verified data.
+func (b *batchBuffers) reset() {
+	for i := range b.publicKeys {
+		b.publicKeys[i] = nil
+		b.messages[i] = nil
+		b.signatures[i] = nil
+	}
+
+	b.publicKeys = b.publicKeys[:0]
+	b.messages = b.messages[:0]
+	b.signatures = b.signatures[:0]
+}
@@ -0,0 +1,50 @@
+package batchverifier
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+func TestBatchVerifier(t *testing.T) {
+	const signatureCount = 1000
+
+	keyPair := ed25519.GenerateKeyPair()
+	verifier := New(2, 0)
+
+	var results sync.Map
+	validCount := atomic.NewInt32(0)
+	for i := 0; i < signatureCount; i++ {
+		data := []byte(fmt.Sprintf("data %d", i))
+		signature := keyPair.PrivateKey.Sign(data)
+		// invalidate every tenth signature
+		if i%10 == 0 {
+			data = []byte("tampered")
+		}
+
+		i := i
+		verifier.Verify(keyPair.PublicKey, data, signature, func(valid bool) {
+			if valid {
+				validCount.Inc()
+			}
+			results.Store(i, valid)
+		})
+	}
+	verifier.Shutdown()
+
+	assert.EqualValues(t, signatureCount-signatureCount/10, validCount.Load())
+	for i := 0; i < signatureCount; i++ {
+		valid, exists := results.Load(i)
+		assert.True(t, exists)
+		assert.Equal(t, i%10 != 0, valid)
+	}
+
+	// signatures are verified synchronously after the shutdown
+	var verified bool
+	verifier.Verify(keyPair.PublicKey, []byte("data"), keyPair.PrivateKey.Sign([]byte("data")), func(valid bool) { verified = valid })
+	assert.True(t, verified)
+}
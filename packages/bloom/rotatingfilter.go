@@ -0,0 +1,131 @@
+package bloom
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"math"
+	"sync"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// RotatingFilter is a bloom filter that remembers the most recently added elements. It consists of two generations
+// that each hold up to capacity elements: once the current generation is full, it replaces the previous one and a new
+// empty generation is started. An element is therefore remembered for at least capacity and at most 2*capacity
+// additions.
+//
+// As every bloom filter, it may report an element as contained although it was never added (false positive), but it
+// never misses an element that was added within the last capacity additions. The elements are hashed with a random key
+// of the filter, so that others can not craft elements that collide in the filters of other nodes.
+type RotatingFilter struct {
+	key       []byte
+	capacity  int
+	bitCount  uint64
+	hashCount int
+	current   []uint64
+	previous  []uint64
+	count     int
+	mutex     sync.Mutex
+}
+
+// NewRotatingFilter creates a RotatingFilter whose generations hold capacity elements each with the given false
+// positive rate.
+func NewRotatingFilter(capacity int, falsePositiveRate float64) *RotatingFilter {
+	bitCount := uint64(math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	if bitCount < 64 {
+		bitCount = 64
+	}
+
+	hashCount := int(math.Round(float64(bitCount) / float64(capacity) * math.Ln2))
+	if hashCount < 1 {
+		hashCount = 1
+	}
+
+	key := make([]byte, blake2b.Size256)
+	if _, err := rand.Read(key); err != nil {
+		panic(err)
+	}
+
+	return &RotatingFilter{
+		key:       key,
+		capacity:  capacity,
+		bitCount:  bitCount,
+		hashCount: hashCount,
+		current:   newBitSet(bitCount),
+		previous:  newBitSet(bitCount),
+	}
+}
+
+// Add adds the given data to the filter. It returns false if the data was (probably) added before.
+func (r *RotatingFilter) Add(data []byte) (added bool) {
+	indexes := r.indexes(data)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if contains(r.current, indexes) || contains(r.previous, indexes) {
+		return false
+	}
+
+	if r.count == r.capacity {
+		r.previous, r.current = r.current, newBitSet(r.bitCount)
+		r.count = 0
+	}
+	for _, index := range indexes {
+		r.current[index/64] |= 1 << (index % 64)
+	}
+	r.count++
+
+	return true
+}
+
+// Contains returns true if the given data was (probably) added before.
+func (r *RotatingFilter) Contains(data []byte) bool {
+	indexes := r.indexes(data)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return contains(r.current, indexes) || contains(r.previous, indexes)
+}
+
+// Size returns the memory used by the bit sets of the filter in bytes.
+func (r *RotatingFilter) Size() int {
+	return 2 * len(r.current) * 8
+}
+
+// indexes returns the positions of the bits that represent the given data. They are derived from its keyed hash using
+// double hashing.
+func (r *RotatingFilter) indexes(data []byte) (indexes []uint64) {
+	hasher, err := blake2b.New256(r.key)
+	if err != nil {
+		panic(err)
+	}
+	hasher.Write(data)
+	hash := hasher.Sum(nil)
+	h1 := binary.LittleEndian.Uint64(hash[:8])
+	h2 := binary.LittleEndian.Uint64(hash[8:16]) | 1
+
+	indexes = make([]uint64, r.hashCount)
+	for i := range indexes {
+		indexes[i] = (h1 + uint64(i)*h2) % r.bitCount
+	}
+
+	return indexes
+}
+
+// newBitSet returns a bit set with the given number of bits.
+func newBitSet(bitCount uint64) []uint64 {
+	return make([]uint64, (bitCount+63)/64)
+}
+
+// contains returns true if all bits at the given indexes are set.
+func contains(bitSet []uint64, indexes []uint64) bool {
+	for _, index := range indexes {
+		if bitSet[index/64]&(1<<(index%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
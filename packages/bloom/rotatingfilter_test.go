@@ -0,0 +1,65 @@
+package bloom
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRotatingFilter(t *testing.T) {
+	const capacity = 1000
+	filter := NewRotatingFilter(capacity, 0.0001)
+
+	assert.True(t, filter.Add(element(0)))
+	assert.False(t, filter.Add(element(0)))
+	assert.True(t, filter.Contains(element(0)))
+
+	// elements are remembered for at least capacity additions
+	for i := 1; i < capacity; i++ {
+		filter.Add(element(i))
+	}
+	assert.True(t, filter.Contains(element(0)))
+	for i := capacity; i < 2*capacity; i++ {
+		filter.Add(element(i))
+	}
+	assert.True(t, filter.Contains(element(0)))
+	assert.True(t, filter.Contains(element(2*capacity-1)))
+
+	// elements are forgotten after two generations
+	filter.Add(element(2 * capacity))
+	assert.False(t, filter.Contains(element(0)))
+	assert.True(t, filter.Contains(element(capacity)))
+}
+
+func TestRotatingFilter_Key(t *testing.T) {
+	filter := NewRotatingFilter(1000, 0.0001)
+	otherFilter := NewRotatingFilter(1000, 0.0001)
+
+	// the filters use different keys, so that a collision in one filter does not collide in the other one
+	assert.Equal(t, filter.indexes(element(0)), filter.indexes(element(0)))
+	assert.NotEqual(t, filter.indexes(element(0)), otherFilter.indexes(element(0)))
+}
+
+func TestRotatingFilter_FalsePositiveRate(t *testing.T) {
+	const capacity = 10000
+	filter := NewRotatingFilter(capacity, 0.001)
+	for i := 0; i < capacity; i++ {
+		filter.Add(element(i))
+	}
+
+	falsePositives := 0
+	for i := capacity; i < 2*capacity; i++ {
+		if filter.Contains(element(i)) {
+			falsePositives++
+		}
+	}
+	assert.Less(t, falsePositives, 50)
+}
+
+func element(i int) []byte {
+	data := make([]byte, 8)
+	binary.LittleEndian.PutUint64(data, uint64(i))
+
+	return data
+}
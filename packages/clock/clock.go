@@ -0,0 +1,294 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// region Clock ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Clock is the source of the current time of the components that make time-dependent decisions. It allows to replace
+// the synchronized time of the node with a VirtualClock, so that tests and simulations can advance the time
+// deterministically.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+
+	// Since returns the time elapsed since t.
+	Since(t time.Time) time.Duration
+
+	// AfterFunc calls f in its own goroutine once the given duration elapsed and returns a Timer that can be used to
+	// cancel the call.
+	AfterFunc(duration time.Duration, f func()) Timer
+
+	// NewTicker returns a Ticker that delivers the time of the Clock on its channel every interval.
+	NewTicker(interval time.Duration) Ticker
+}
+
+// Timer is a scheduled call of a Clock.
+type Timer interface {
+	// Stop prevents the Timer from firing and returns false if it already fired or was stopped.
+	Stop() bool
+}
+
+// Ticker delivers the time of a Clock in regular intervals. Like a time.Ticker, it drops the ticks that are not read
+// in time.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+
+	// Reset stops the Ticker and resets its interval to the given duration.
+	Reset(interval time.Duration)
+
+	// Stop turns off the Ticker. It does not close the channel.
+	Stop()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SyncedClock //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SyncedClock is the Clock that returns the synchronized time of the node.
+type SyncedClock struct{}
+
+// Now returns the synchronized time of the node.
+func (SyncedClock) Now() time.Time {
+	return SyncedTime()
+}
+
+// Since returns the time elapsed since t according to the synchronized time of the node.
+func (SyncedClock) Since(t time.Time) time.Duration {
+	return Since(t)
+}
+
+// AfterFunc calls f in its own goroutine once the given duration elapsed.
+func (SyncedClock) AfterFunc(duration time.Duration, f func()) Timer {
+	return time.AfterFunc(duration, f)
+}
+
+// NewTicker returns a Ticker that ticks every interval of the wall clock.
+func (SyncedClock) NewTicker(interval time.Duration) Ticker {
+	return &syncedTicker{ticker: time.NewTicker(interval)}
+}
+
+// syncedTicker is the Ticker of the SyncedClock.
+type syncedTicker struct {
+	ticker *time.Ticker
+}
+
+// C returns the channel on which the ticks are delivered.
+func (s *syncedTicker) C() <-chan time.Time {
+	return s.ticker.C
+}
+
+// Reset stops the Ticker and resets its interval to the given duration.
+func (s *syncedTicker) Reset(interval time.Duration) {
+	s.ticker.Reset(interval)
+}
+
+// Stop turns off the Ticker.
+func (s *syncedTicker) Stop() {
+	s.ticker.Stop()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region VirtualClock /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// VirtualClock is a Clock whose time only changes when it is set or advanced explicitly. The calls that were scheduled
+// with AfterFunc are executed by Set and Advance in the order of their due time, and the virtual time is moved to the
+// due time of each call before it is executed.
+type VirtualClock struct {
+	now    time.Time
+	timers []*virtualTimer
+	mutex  sync.RWMutex
+}
+
+// NewVirtualClock returns a VirtualClock that starts at the given time.
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{
+		now: start,
+	}
+}
+
+// Now returns the current virtual time.
+func (v *VirtualClock) Now() time.Time {
+	v.mutex.RLock()
+	defer v.mutex.RUnlock()
+
+	return v.now
+}
+
+// Since returns the virtual time elapsed since t.
+func (v *VirtualClock) Since(t time.Time) time.Duration {
+	return v.Now().Sub(t)
+}
+
+// AfterFunc calls f once the virtual time advanced by the given duration. If the duration is not positive, f is called
+// right away in its own goroutine.
+func (v *VirtualClock) AfterFunc(duration time.Duration, f func()) Timer {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	timer := &virtualTimer{clock: v, dueTime: v.now.Add(duration), f: f}
+	if duration <= 0 {
+		go f()
+		return timer
+	}
+
+	index := sort.Search(len(v.timers), func(i int) bool { return v.timers[i].dueTime.After(timer.dueTime) })
+	v.timers = append(v.timers, nil)
+	copy(v.timers[index+1:], v.timers[index:])
+	v.timers[index] = timer
+	timer.scheduled = true
+
+	return timer
+}
+
+// NewTicker returns a Ticker that ticks every time the virtual time advanced by the given interval.
+func (v *VirtualClock) NewTicker(interval time.Duration) Ticker {
+	ticker := &virtualTicker{
+		clock: v,
+		c:     make(chan time.Time, 1),
+	}
+	ticker.Reset(interval)
+
+	return ticker
+}
+
+// Set sets the virtual time to the given time and executes the calls that became due.
+func (v *VirtualClock) Set(now time.Time) {
+	v.moveTo(now)
+}
+
+// Advance moves the virtual time forward by the given duration, executes the calls that became due and returns the new
+// time.
+func (v *VirtualClock) Advance(duration time.Duration) (now time.Time) {
+	v.mutex.RLock()
+	now = v.now.Add(duration)
+	v.mutex.RUnlock()
+
+	v.moveTo(now)
+
+	return now
+}
+
+// moveTo moves the virtual time to the given time and executes the scheduled calls that are due until then. The calls
+// are executed one after the other without holding the mutex, so that they can schedule further calls.
+func (v *VirtualClock) moveTo(target time.Time) {
+	for {
+		v.mutex.Lock()
+		if len(v.timers) == 0 || v.timers[0].dueTime.After(target) {
+			v.now = target
+			v.mutex.Unlock()
+
+			return
+		}
+		timer := v.timers[0]
+		v.timers = v.timers[1:]
+		timer.scheduled = false
+		if timer.dueTime.After(v.now) {
+			v.now = timer.dueTime
+		}
+		v.mutex.Unlock()
+
+		timer.f()
+	}
+}
+
+// virtualTimer is a call that was scheduled with VirtualClock.AfterFunc.
+type virtualTimer struct {
+	clock     *VirtualClock
+	dueTime   time.Time
+	f         func()
+	scheduled bool
+}
+
+// Stop removes the call from the scheduled calls of the VirtualClock.
+func (v *virtualTimer) Stop() bool {
+	v.clock.mutex.Lock()
+	defer v.clock.mutex.Unlock()
+
+	if !v.scheduled {
+		return false
+	}
+	v.scheduled = false
+
+	for i, timer := range v.clock.timers {
+		if timer == v {
+			v.clock.timers = append(v.clock.timers[:i], v.clock.timers[i+1:]...)
+			break
+		}
+	}
+
+	return true
+}
+
+// virtualTicker is a Ticker of the VirtualClock that reschedules itself with AfterFunc after every tick.
+type virtualTicker struct {
+	clock    *VirtualClock
+	c        chan time.Time
+	interval time.Duration
+	timer    Timer
+	// generation is increased by Reset and Stop, so that a tick that fired concurrently is dropped
+	generation uint64
+	mutex      sync.Mutex
+}
+
+// C returns the channel on which the ticks are delivered.
+func (v *virtualTicker) C() <-chan time.Time {
+	return v.c
+}
+
+// Reset stops the Ticker and resets its interval to the given duration.
+func (v *virtualTicker) Reset(interval time.Duration) {
+	if interval <= 0 {
+		panic("non-positive interval for VirtualClock.NewTicker")
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.timer != nil {
+		v.timer.Stop()
+	}
+	v.interval = interval
+	v.generation++
+	v.schedule()
+}
+
+// Stop turns off the Ticker.
+func (v *virtualTicker) Stop() {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	v.generation++
+	if v.timer != nil {
+		v.timer.Stop()
+	}
+}
+
+// schedule schedules the next tick of the current generation. It expects the mutex to be locked.
+func (v *virtualTicker) schedule() {
+	generation := v.generation
+	v.timer = v.clock.AfterFunc(v.interval, func() { v.tick(generation) })
+}
+
+// tick delivers the current virtual time (unless the previous tick was not read yet) and schedules the next tick.
+func (v *virtualTicker) tick(generation uint64) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if generation != v.generation {
+		return
+	}
+
+	select {
+	case v.c <- v.clock.Now():
+	default:
+	}
+	v.schedule()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
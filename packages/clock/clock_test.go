@@ -0,0 +1,73 @@
+package clock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualClock(t *testing.T) {
+	start := time.Unix(1616144400, 0)
+	virtualClock := NewVirtualClock(start)
+	assert.Equal(t, start, virtualClock.Now())
+
+	assert.Equal(t, start.Add(time.Minute), virtualClock.Advance(time.Minute))
+	assert.Equal(t, start.Add(time.Minute), virtualClock.Now())
+	assert.Equal(t, time.Minute, virtualClock.Since(start))
+
+	virtualClock.Set(start)
+	assert.Zero(t, virtualClock.Since(start))
+}
+
+func TestVirtualClock_AfterFunc(t *testing.T) {
+	virtualClock := NewVirtualClock(time.Unix(1616144400, 0))
+
+	var calls []string
+	virtualClock.AfterFunc(2*time.Minute, func() { calls = append(calls, "second") })
+	virtualClock.AfterFunc(time.Minute, func() {
+		calls = append(calls, "first")
+		virtualClock.AfterFunc(30*time.Second, func() { calls = append(calls, "rescheduled") })
+	})
+	stopped := virtualClock.AfterFunc(90*time.Second, func() { calls = append(calls, "stopped") })
+	assert.True(t, stopped.Stop())
+	assert.False(t, stopped.Stop())
+
+	virtualClock.Advance(59 * time.Second)
+	assert.Empty(t, calls)
+
+	virtualClock.Advance(2 * time.Minute)
+	assert.Equal(t, []string{"first", "rescheduled", "second"}, calls)
+}
+
+func TestVirtualClock_NewTicker(t *testing.T) {
+	start := time.Unix(1616144400, 0)
+	virtualClock := NewVirtualClock(start)
+
+	ticker := virtualClock.NewTicker(time.Minute)
+	virtualClock.Advance(59 * time.Second)
+	assert.Empty(t, ticker.C())
+
+	// ticks that are not read in time are dropped
+	virtualClock.Advance(2 * time.Minute)
+	assert.Equal(t, start.Add(time.Minute), <-ticker.C())
+	assert.Empty(t, ticker.C())
+
+	ticker.Reset(time.Second)
+	assert.Equal(t, start.Add(3*time.Minute), virtualClock.Advance(time.Second))
+	assert.Equal(t, start.Add(3*time.Minute), <-ticker.C())
+
+	ticker.Stop()
+	virtualClock.Advance(time.Minute)
+	assert.Empty(t, ticker.C())
+}
+
+func TestSyncedClock(t *testing.T) {
+	var syncedClock Clock = SyncedClock{}
+
+	before := SyncedTime()
+	now := syncedClock.Now()
+	assert.False(t, now.Before(before))
+	assert.False(t, SyncedTime().Before(now))
+	assert.GreaterOrEqual(t, syncedClock.Since(before), time.Duration(0))
+}
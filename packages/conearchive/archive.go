@@ -0,0 +1,336 @@
+package conearchive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/generics/walker"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Format ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// Version is the version of the archive format.
+	Version byte = 1
+
+	// magicString contains the byte sequence that every (uncompressed) archive starts with.
+	magicString = "GUC1"
+)
+
+var (
+	// ErrInvalidFormat is returned if the data does not contain an archive of the unconfirmed cone.
+	ErrInvalidFormat = errors.New("invalid unconfirmed cone archive format")
+	// ErrLimitExceeded is returned if the unconfirmed cone contains more Messages than allowed.
+	ErrLimitExceeded = errors.New("unconfirmed cone exceeds the message limit")
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Archive //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Archive contains the currently unconfirmed portion of the Tangle of a node. It is used to capture the state of a node
+// that reports a "stuck" Tangle, so that it can be analyzed offline.
+type Archive struct {
+	// Created contains the time when the Archive was captured.
+	Created time.Time
+	// Messages contains the unconfirmed Messages ordered by their issuing time.
+	Messages []*MessageEntry
+	// Branches contains the Branches that the unconfirmed Messages are booked into.
+	Branches []*BranchEntry
+}
+
+// Capture collects the unconfirmed cone of the given Tangle by walking the past cone of its tips until reaching
+// confirmed Messages. It returns an ErrLimitExceeded if the cone contains more than maxMessages Messages (a value <= 0
+// disables the limit).
+func Capture(tangleInstance *tangle.Tangle, maxMessages int) (archive *Archive, err error) {
+	archive = &Archive{
+		Created:  time.Now(),
+		Messages: make([]*MessageEntry, 0),
+		Branches: make([]*BranchEntry, 0),
+	}
+
+	branchIDs := ledgerstate.NewBranchIDs()
+	tangleInstance.Utils.WalkMessageAndMetadata(func(message *tangle.Message, messageMetadata *tangle.MessageMetadata, messageWalker *walker.Walker[tangle.MessageID]) {
+		if tangleInstance.ConfirmationOracle.IsMessageConfirmed(message.ID()) {
+			return
+		}
+		if maxMessages > 0 && len(archive.Messages) >= maxMessages {
+			err = errors.Errorf("failed to capture more than %d messages: %w", maxMessages, ErrLimitExceeded)
+			messageWalker.StopWalk()
+			return
+		}
+
+		entry := &MessageEntry{
+			Message:   message,
+			Metadata:  messageMetadata,
+			BranchIDs: ledgerstate.NewBranchIDs(),
+		}
+		if messageMetadata.IsBooked() {
+			if messageBranchIDs, branchIDsErr := tangleInstance.Booker.MessageBranchIDs(message.ID()); branchIDsErr == nil {
+				entry.BranchIDs = messageBranchIDs
+				branchIDs.AddAll(messageBranchIDs)
+			}
+		}
+		archive.Messages = append(archive.Messages, entry)
+
+		message.ForEachParent(func(parent tangle.Parent) {
+			if parent.ID != tangle.EmptyMessageID {
+				messageWalker.Push(parent.ID)
+			}
+		})
+	}, tangleInstance.TipManager.AllTips())
+	if err != nil {
+		return nil, err
+	}
+
+	for branchID := range branchIDs {
+		tangleInstance.LedgerState.Branch(branchID).Consume(func(branch *ledgerstate.Branch) {
+			archive.Branches = append(archive.Branches, &BranchEntry{
+				BranchID:       branchID,
+				Parents:        branch.Parents(),
+				InclusionState: branch.InclusionState(),
+				Weight:         tangleInstance.ApprovalWeightManager.WeightOfBranch(branchID),
+			})
+		})
+	}
+
+	archive.sort()
+
+	return archive, nil
+}
+
+// Read reads a compressed Archive from the given reader.
+func Read(reader io.Reader) (archive *Archive, err error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, errors.Errorf("failed to open compressed archive (%v): %w", err, ErrInvalidFormat)
+	}
+	defer gzipReader.Close()
+
+	data, err := io.ReadAll(gzipReader)
+	if err != nil {
+		return nil, errors.Errorf("failed to decompress archive: %w", err)
+	}
+	if len(data) < len(magicString)+1 || !bytes.Equal(data[:len(magicString)], []byte(magicString)) {
+		return nil, errors.Errorf("unknown magic bytes: %w", ErrInvalidFormat)
+	}
+	if version := data[len(magicString)]; version != Version {
+		return nil, errors.Errorf("unsupported archive version %d: %w", version, ErrInvalidFormat)
+	}
+
+	marshalUtil := marshalutil.New(data[len(magicString)+1:])
+	archive = &Archive{}
+	if archive.Created, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse creation time (%v): %w", err, ErrInvalidFormat)
+	}
+
+	messageCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse message count (%v): %w", err, ErrInvalidFormat)
+	}
+	archive.Messages = make([]*MessageEntry, messageCount)
+	for i := range archive.Messages {
+		if archive.Messages[i], err = messageEntryFromMarshalUtil(marshalUtil); err != nil {
+			return nil, errors.Errorf("failed to parse message entry %d: %w", i, err)
+		}
+	}
+
+	branchCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse branch count (%v): %w", err, ErrInvalidFormat)
+	}
+	archive.Branches = make([]*BranchEntry, branchCount)
+	for i := range archive.Branches {
+		if archive.Branches[i], err = branchEntryFromMarshalUtil(marshalUtil); err != nil {
+			return nil, errors.Errorf("failed to parse branch entry %d: %w", i, err)
+		}
+	}
+
+	return archive, nil
+}
+
+// WriteTo writes the compressed Archive to the given writer.
+func (a *Archive) WriteTo(writer io.Writer) (n int64, err error) {
+	marshalUtil := marshalutil.New()
+	marshalUtil.WriteBytes([]byte(magicString))
+	marshalUtil.WriteByte(Version)
+	marshalUtil.WriteTime(a.Created)
+	marshalUtil.WriteUint32(uint32(len(a.Messages)))
+	for _, entry := range a.Messages {
+		entry.writeTo(marshalUtil)
+	}
+	marshalUtil.WriteUint32(uint32(len(a.Branches)))
+	for _, entry := range a.Branches {
+		entry.writeTo(marshalUtil)
+	}
+
+	countingWriter := &countingWriter{writer: writer}
+	bufferedWriter := bufio.NewWriter(countingWriter)
+	gzipWriter := gzip.NewWriter(bufferedWriter)
+	if _, err = gzipWriter.Write(marshalUtil.Bytes()); err != nil {
+		return countingWriter.count, errors.Errorf("failed to compress archive: %w", err)
+	}
+	if err = gzipWriter.Close(); err != nil {
+		return countingWriter.count, errors.Errorf("failed to compress archive: %w", err)
+	}
+	err = bufferedWriter.Flush()
+
+	return countingWriter.count, err
+}
+
+// Branch returns the BranchEntry of the given BranchID.
+func (a *Archive) Branch(branchID ledgerstate.BranchID) (branchEntry *BranchEntry, exists bool) {
+	for _, branchEntry = range a.Branches {
+		if branchEntry.BranchID == branchID {
+			return branchEntry, true
+		}
+	}
+
+	return nil, false
+}
+
+// sort orders the entries of the Archive deterministically.
+func (a *Archive) sort() {
+	sort.Slice(a.Messages, func(i, j int) bool {
+		if a.Messages[i].Message.IssuingTime().Equal(a.Messages[j].Message.IssuingTime()) {
+			return bytes.Compare(a.Messages[i].Message.ID().Bytes(), a.Messages[j].Message.ID().Bytes()) < 0
+		}
+
+		return a.Messages[i].Message.IssuingTime().Before(a.Messages[j].Message.IssuingTime())
+	})
+	sort.Slice(a.Branches, func(i, j int) bool {
+		return bytes.Compare(a.Branches[i].BranchID.Bytes(), a.Branches[j].BranchID.Bytes()) < 0
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MessageEntry /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MessageEntry contains an unconfirmed Message together with its metadata and the Branches it is booked into.
+type MessageEntry struct {
+	Message   *tangle.Message
+	Metadata  *tangle.MessageMetadata
+	BranchIDs ledgerstate.BranchIDs
+}
+
+// messageEntryFromMarshalUtil unmarshals a MessageEntry using a MarshalUtil.
+func messageEntryFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (entry *MessageEntry, err error) {
+	entry = &MessageEntry{}
+
+	messageBytes, err := readBytes(marshalUtil)
+	if err != nil {
+		return nil, errors.Errorf("failed to read message bytes: %w", err)
+	}
+	if entry.Message, err = new(tangle.Message).FromBytes(messageBytes); err != nil {
+		return nil, errors.Errorf("failed to parse message: %w", err)
+	}
+
+	metadataBytes, err := readBytes(marshalUtil)
+	if err != nil {
+		return nil, errors.Errorf("failed to read message metadata bytes: %w", err)
+	}
+	if entry.Metadata, err = new(tangle.MessageMetadata).FromBytes(metadataBytes); err != nil {
+		return nil, errors.Errorf("failed to parse message metadata: %w", err)
+	}
+
+	if entry.BranchIDs, err = ledgerstate.BranchIDsFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse branch ids: %w", err)
+	}
+
+	return entry, nil
+}
+
+// writeTo marshals the MessageEntry into the given MarshalUtil.
+func (m *MessageEntry) writeTo(marshalUtil *marshalutil.MarshalUtil) {
+	writeBytes(marshalUtil, m.Message.Bytes())
+	writeBytes(marshalUtil, m.Metadata.Bytes())
+	marshalUtil.Write(m.BranchIDs)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BranchEntry //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// BranchEntry contains the state of a Branch that unconfirmed Messages are booked into.
+type BranchEntry struct {
+	BranchID       ledgerstate.BranchID
+	Parents        ledgerstate.BranchIDs
+	InclusionState ledgerstate.InclusionState
+	Weight         float64
+}
+
+// branchEntryFromMarshalUtil unmarshals a BranchEntry using a MarshalUtil.
+func branchEntryFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (entry *BranchEntry, err error) {
+	entry = &BranchEntry{}
+	if entry.BranchID, err = ledgerstate.BranchIDFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse branch id: %w", err)
+	}
+	if entry.Parents, err = ledgerstate.BranchIDsFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse branch parents: %w", err)
+	}
+	if entry.InclusionState, err = ledgerstate.InclusionStateFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse inclusion state: %w", err)
+	}
+	if entry.Weight, err = marshalUtil.ReadFloat64(); err != nil {
+		return nil, errors.Errorf("failed to parse branch weight (%v): %w", err, ErrInvalidFormat)
+	}
+
+	return entry, nil
+}
+
+// writeTo marshals the BranchEntry into the given MarshalUtil.
+func (b *BranchEntry) writeTo(marshalUtil *marshalutil.MarshalUtil) {
+	marshalUtil.Write(b.BranchID)
+	marshalUtil.Write(b.Parents)
+	marshalUtil.Write(b.InclusionState)
+	marshalUtil.WriteFloat64(b.Weight)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// writeBytes writes the given bytes prefixed with their length.
+func writeBytes(marshalUtil *marshalutil.MarshalUtil, data []byte) {
+	marshalUtil.WriteUint32(uint32(len(data)))
+	marshalUtil.WriteBytes(data)
+}
+
+// readBytes reads bytes that were prefixed with their length.
+func readBytes(marshalUtil *marshalutil.MarshalUtil) (data []byte, err error) {
+	length, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Errorf("failed to read length (%v): %w", err, ErrInvalidFormat)
+	}
+	if data, err = marshalUtil.ReadBytes(int(length)); err != nil {
+		return nil, errors.Errorf("failed to read %d bytes (%v): %w", length, err, ErrInvalidFormat)
+	}
+
+	return data, nil
+}
+
+// countingWriter is a writer that counts the bytes that were written to the underlying writer.
+type countingWriter struct {
+	writer io.Writer
+	count  int64
+}
+
+// Write writes the given bytes to the underlying writer.
+func (c *countingWriter) Write(p []byte) (n int, err error) {
+	n, err = c.writer.Write(p)
+	c.count += int64(n)
+
+	return n, err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,57 @@
+package conearchive
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestArchive(t *testing.T) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(testTangle, tangle.WithGenesisOutput("G", 3))
+	testTangle.Setup()
+
+	testFramework.CreateMessage("Payment", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("A", 3))
+	testFramework.CreateMessage("DoubleSpend", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("B", 3))
+	testFramework.CreateMessage("Data", tangle.WithStrongParents("Payment", "DoubleSpend"))
+	testFramework.IssueMessages("Payment", "DoubleSpend").WaitMessagesBooked()
+	testFramework.IssueMessages("Data").WaitMessagesBooked()
+	testTangle.TipManager.AddTip(testFramework.Message("Data"))
+
+	archive, err := Capture(testTangle, 0)
+	require.NoError(t, err)
+	require.Len(t, archive.Messages, 3)
+	require.Len(t, archive.Branches, 2)
+
+	_, err = Capture(testTangle, 2)
+	assert.ErrorIs(t, err, ErrLimitExceeded)
+
+	var buffer bytes.Buffer
+	_, err = archive.WriteTo(&buffer)
+	require.NoError(t, err)
+
+	restoredArchive, err := Read(&buffer)
+	require.NoError(t, err)
+	assert.True(t, archive.Created.Equal(restoredArchive.Created))
+	require.Len(t, restoredArchive.Messages, len(archive.Messages))
+	for i, entry := range archive.Messages {
+		assert.Equal(t, entry.Message.ID(), restoredArchive.Messages[i].Message.ID())
+		assert.Equal(t, entry.Metadata.IsBooked(), restoredArchive.Messages[i].Metadata.IsBooked())
+		assert.Equal(t, entry.BranchIDs, restoredArchive.Messages[i].BranchIDs)
+	}
+	assert.Equal(t, archive.Branches, restoredArchive.Branches)
+
+	branchEntry, exists := restoredArchive.Branch(ledgerstate.NewBranchID(testFramework.TransactionID("Payment")))
+	require.True(t, exists)
+	assert.Equal(t, ledgerstate.Pending, branchEntry.InclusionState)
+
+	_, err = Read(bytes.NewReader([]byte("invalid")))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
@@ -0,0 +1,269 @@
+// Package conformance provides canonical test vectors for the serialization and the IDs of the objects of the protocol.
+// The vectors are generated from the Go types and are published in testdata/vectors.json, so that other
+// implementations of the protocol can verify that they parse, serialize and identify the objects in the same way.
+package conformance
+
+import (
+	"bytes"
+	"encoding/hex"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+// VectorsVersion is the version of the test vectors. It is increased whenever the vectors change.
+const VectorsVersion = 1
+
+// region TestVectors //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TestVectors is the set of test vectors that is published to other implementations.
+type TestVectors struct {
+	// Version is the version of the test vectors.
+	Version int `json:"version"`
+	// Seed is the hex encoded seed of the ed25519 key that signs the transactions and messages of the vectors.
+	Seed string `json:"seed"`
+	// NetworkID is the network ID that the messages of the vectors are signed for.
+	NetworkID uint32 `json:"networkID"`
+	// Vectors contains the test vectors.
+	Vectors []*TestVector `json:"vectors"`
+}
+
+// TestVector is the serialized form of a single object of the protocol together with its ID.
+type TestVector struct {
+	// Name is the unique name of the test vector.
+	Name string `json:"name"`
+	// Type is the type of the object.
+	Type VectorType `json:"type"`
+	// Description describes the object.
+	Description string `json:"description"`
+	// Bytes is the hex encoded serialized form of the object.
+	Bytes string `json:"bytes"`
+	// ID is the hex encoded ID of the object (if the object has an ID).
+	ID string `json:"id,omitempty"`
+	// Base58 is the base58 encoded ID of the object or the base58 encoded address.
+	Base58 string `json:"base58,omitempty"`
+}
+
+// Verify parses the bytes of the TestVector and checks that the parsed object serializes to the same bytes and that it
+// has the ID of the TestVector.
+func (t *TestVector) Verify() (err error) {
+	vectorBytes, err := hex.DecodeString(t.Bytes)
+	if err != nil {
+		return errors.Errorf("failed to decode bytes of %s: %w", t.Name, err)
+	}
+
+	var serialized []byte
+	var id []byte
+	var base58 string
+	switch t.Type {
+	case VectorTypeAddress:
+		address, _, parseErr := ledgerstate.AddressFromBytes(vectorBytes)
+		if err = parseErr; err == nil {
+			serialized, base58 = address.Bytes(), address.Base58()
+		}
+	case VectorTypeOutput:
+		output, _, parseErr := ledgerstate.OutputFromBytes(vectorBytes)
+		if err = parseErr; err == nil {
+			serialized = output.Bytes()
+		}
+	case VectorTypeOutputID:
+		outputID, _, parseErr := ledgerstate.OutputIDFromBytes(vectorBytes)
+		if err = parseErr; err == nil {
+			serialized, id, base58 = outputID.Bytes(), outputID.Bytes(), outputID.Base58()
+		}
+	case VectorTypeTransactionEssence:
+		essence, _, parseErr := ledgerstate.TransactionEssenceFromBytes(vectorBytes)
+		if err = parseErr; err == nil {
+			serialized = essence.Bytes()
+		}
+	case VectorTypeTransaction:
+		transaction, parseErr := new(ledgerstate.Transaction).FromBytes(vectorBytes)
+		if err = parseErr; err == nil {
+			serialized, id, base58 = transaction.Bytes(), transaction.ID().Bytes(), transaction.ID().Base58()
+		}
+	case VectorTypeMessage:
+		message, parseErr := new(tangle.Message).FromBytes(vectorBytes)
+		if err = parseErr; err == nil {
+			serialized, id, base58 = message.Bytes(), message.ID().Bytes(), message.ID().Base58()
+		}
+	default:
+		return errors.Errorf("unknown type %s of %s", t.Type, t.Name)
+	}
+	if err != nil {
+		return errors.Errorf("failed to parse %s: %w", t.Name, err)
+	}
+
+	if !bytes.Equal(serialized, vectorBytes) {
+		return errors.Errorf("%s serializes to %s", t.Name, hex.EncodeToString(serialized))
+	}
+	if hex.EncodeToString(id) != t.ID {
+		return errors.Errorf("%s has ID %s", t.Name, hex.EncodeToString(id))
+	}
+	if base58 != t.Base58 {
+		return errors.Errorf("%s has base58 representation %s", t.Name, base58)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region VectorType ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// VectorType is the type of the object of a TestVector.
+type VectorType string
+
+const (
+	// VectorTypeAddress is the type of the vectors of Addresses.
+	VectorTypeAddress VectorType = "address"
+	// VectorTypeOutput is the type of the vectors of Outputs.
+	VectorTypeOutput VectorType = "output"
+	// VectorTypeOutputID is the type of the vectors of OutputIDs.
+	VectorTypeOutputID VectorType = "outputID"
+	// VectorTypeTransactionEssence is the type of the vectors of TransactionEssences.
+	VectorTypeTransactionEssence VectorType = "transactionEssence"
+	// VectorTypeTransaction is the type of the vectors of Transactions.
+	VectorTypeTransaction VectorType = "transaction"
+	// VectorTypeMessage is the type of the vectors of Messages.
+	VectorTypeMessage VectorType = "message"
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Generate /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+var (
+	// seed is the seed of the key that signs the transactions and messages of the vectors.
+	seed = blake2b.Sum256([]byte("goshimmer conformance"))
+
+	// timestamp is the timestamp of the transactions and messages of the vectors.
+	timestamp = time.Unix(1640995200, 0)
+)
+
+// Generate creates the TestVectors from the Go types. The result is deterministic.
+func Generate() (testVectors *TestVectors, err error) {
+	privateKey := ed25519.PrivateKeyFromSeed(seed[:])
+	publicKey := privateKey.Public()
+	pledgeID := identity.NewID(publicKey)
+
+	testVectors = &TestVectors{
+		Version: VectorsVersion,
+		Seed:    hex.EncodeToString(seed[:]),
+	}
+	add := func(name string, vectorType VectorType, description string, objectBytes, id []byte, base58 string) {
+		testVectors.Vectors = append(testVectors.Vectors, &TestVector{
+			Name:        name,
+			Type:        vectorType,
+			Description: description,
+			Bytes:       hex.EncodeToString(objectBytes),
+			ID:          hex.EncodeToString(id),
+			Base58:      base58,
+		})
+	}
+
+	ed25519Address := ledgerstate.NewED25519Address(publicKey)
+	aliasAddress := ledgerstate.NewAliasAddress([]byte("conformance alias"))
+	add("address_ed25519", VectorTypeAddress, "ED25519Address of the public key of the seed", ed25519Address.Bytes(), nil, ed25519Address.Base58())
+	add("address_alias", VectorTypeAddress, "AliasAddress derived from the data 'conformance alias'", aliasAddress.Bytes(), nil, aliasAddress.Base58())
+
+	color := ledgerstate.Color(blake2b.Sum256([]byte("conformance color")))
+	extendedLockedOutput := ledgerstate.NewExtendedLockedOutput(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 500}, ed25519Address).
+		WithFallbackOptions(aliasAddress, timestamp.Add(time.Hour)).
+		WithTimeLock(timestamp.Add(time.Minute))
+	if err = extendedLockedOutput.SetPayload([]byte("conformance payload")); err != nil {
+		return nil, errors.Errorf("failed to set payload of ExtendedLockedOutput: %w", err)
+	}
+	aliasOutput, err := ledgerstate.NewAliasOutputMint(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 200}, ed25519Address, []byte("immutable"))
+	if err != nil {
+		return nil, errors.Errorf("failed to create AliasOutput: %w", err)
+	}
+	outputs := []struct {
+		name        string
+		description string
+		output      ledgerstate.Output
+	}{
+		{"output_sig_locked_single", "SigLockedSingleOutput holding 1000 IOTA", ledgerstate.NewSigLockedSingleOutput(1000, ed25519Address)},
+		{"output_sig_locked_colored", "SigLockedColoredOutput holding 300 IOTA and 700 tokens of a color", ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{ledgerstate.ColorIOTA: 300, color: 700}), aliasAddress)},
+		{"output_extended_locked", "ExtendedLockedOutput with fallback options, time lock and payload", extendedLockedOutput},
+		{"output_alias", "AliasOutput that mints a new alias with immutable data", aliasOutput},
+	}
+
+	inputs := make([]ledgerstate.Input, 2)
+	for i := range inputs {
+		inputs[i] = ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, uint16(i)))
+	}
+	transactionOutputs := make([]ledgerstate.Output, len(outputs))
+	for i, output := range outputs {
+		transactionOutputs[i] = output.output
+	}
+	essence := ledgerstate.NewTransactionEssence(0, timestamp, pledgeID, pledgeID, ledgerstate.NewInputs(inputs...), ledgerstate.NewOutputs(transactionOutputs...))
+	transaction := ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{
+		ledgerstate.NewSignatureUnlockBlock(ledgerstate.NewED25519Signature(publicKey, privateKey.Sign(essence.Bytes()))),
+		ledgerstate.NewReferenceUnlockBlock(0),
+	})
+
+	for _, output := range outputs {
+		add(output.name, VectorTypeOutput, output.description, output.output.Bytes(), nil, "")
+	}
+	outputID := transaction.Essence().Outputs()[0].ID()
+	add("output_id", VectorTypeOutputID, "OutputID of the first output of the transaction", outputID.Bytes(), outputID.Bytes(), outputID.Base58())
+	add("transaction_essence", VectorTypeTransactionEssence, "essence of the transaction that is signed by the seed", essence.Bytes(), nil, "")
+	add("transaction", VectorTypeTransaction, "transaction that spends two genesis outputs into the outputs of the vectors", transaction.Bytes(), transaction.ID().Bytes(), transaction.ID().Base58())
+
+	messages := []struct {
+		name        string
+		description string
+		parents     tangle.ParentMessageIDs
+		payload     payload.Payload
+	}{
+		{
+			"message_data", "message with a data payload and strong, weak and shallow like parents",
+			tangle.NewParentMessageIDs().
+				AddStrong(testMessageID("strong 1")).
+				AddStrong(testMessageID("strong 2")).
+				Add(tangle.WeakParentType, testMessageID("weak")).
+				Add(tangle.ShallowLikeParentType, testMessageID("shallow like")),
+			payload.NewGenericDataPayload([]byte("conformance data")),
+		},
+		{
+			"message_transaction", "message with the transaction of the vectors as payload",
+			tangle.NewParentMessageIDs().AddStrong(tangle.EmptyMessageID),
+			transaction,
+		},
+	}
+	for i, messageDefinition := range messages {
+		message, messageErr := signedMessage(messageDefinition.parents, privateKey, uint64(i), messageDefinition.payload, testVectors.NetworkID)
+		if messageErr != nil {
+			return nil, errors.Errorf("failed to create %s: %w", messageDefinition.name, messageErr)
+		}
+		add(messageDefinition.name, VectorTypeMessage, messageDefinition.description, message.Bytes(), message.ID().Bytes(), message.ID().Base58())
+	}
+
+	return testVectors, nil
+}
+
+// signedMessage creates a Message with the given parents and payload that is signed by the given key.
+func signedMessage(parents tangle.ParentMessageIDs, privateKey ed25519.PrivateKey, sequenceNumber uint64, messagePayload payload.Payload, networkID uint32) (message *tangle.Message, err error) {
+	unsignedMessage, err := tangle.NewMessage(parents, timestamp, privateKey.Public(), sequenceNumber, messagePayload, 0, ed25519.EmptySignature)
+	if err != nil {
+		return nil, err
+	}
+	unsignedBytes := unsignedMessage.Bytes()
+	signature := privateKey.Sign(tangle.SignatureContent(networkID, unsignedBytes[:len(unsignedBytes)-ed25519.SignatureSize]))
+
+	return tangle.NewMessage(parents, timestamp, privateKey.Public(), sequenceNumber, messagePayload, 0, signature)
+}
+
+// testMessageID returns a MessageID that is derived from the given name.
+func testMessageID(name string) tangle.MessageID {
+	return blake2b.Sum256([]byte(name))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,71 @@
+package conformance
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var update = flag.Bool("update", false, "update the test vectors in testdata")
+
+var vectorsPath = filepath.Join("testdata", "vectors.json")
+
+func TestGenerate(t *testing.T) {
+	testVectors, err := Generate()
+	require.NoError(t, err)
+
+	generatedJSON, err := json.MarshalIndent(testVectors, "", "  ")
+	require.NoError(t, err)
+	generatedJSON = append(generatedJSON, '\n')
+
+	if *update {
+		require.NoError(t, os.WriteFile(vectorsPath, generatedJSON, 0o644))
+	}
+
+	// the published vectors must not change unnoticed
+	publishedJSON, err := os.ReadFile(vectorsPath)
+	require.NoError(t, err)
+	assert.Equal(t, string(publishedJSON), string(generatedJSON), "the test vectors changed: increase VectorsVersion and run the tests with -update")
+
+	regenerated, err := Generate()
+	require.NoError(t, err)
+	assert.Equal(t, testVectors, regenerated)
+}
+
+func TestTestVector_Verify(t *testing.T) {
+	publishedJSON, err := os.ReadFile(vectorsPath)
+	require.NoError(t, err)
+	testVectors := new(TestVectors)
+	require.NoError(t, json.Unmarshal(publishedJSON, testVectors))
+
+	names := make(map[string]bool)
+	for _, testVector := range testVectors.Vectors {
+		assert.False(t, names[testVector.Name], "duplicate vector %s", testVector.Name)
+		names[testVector.Name] = true
+
+		assert.NoError(t, testVector.Verify())
+
+		if testVector.Type == VectorTypeMessage {
+			messageBytes, err := hex.DecodeString(testVector.Bytes)
+			require.NoError(t, err)
+			message, err := new(tangle.Message).FromBytes(messageBytes)
+			require.NoError(t, err)
+			assert.True(t, message.VerifySignatureInNetwork(testVectors.NetworkID), testVector.Name)
+		}
+	}
+
+	// modified vectors are detected
+	corrupted := *testVectors.Vectors[len(testVectors.Vectors)-1]
+	corrupted.ID = corrupted.ID[2:] + corrupted.ID[:2]
+	assert.Error(t, corrupted.Verify())
+	corrupted.Type = "unknown"
+	assert.Error(t, corrupted.Verify())
+}
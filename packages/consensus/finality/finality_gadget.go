@@ -3,8 +3,8 @@ package finality
 import (
 	"fmt"
 	"sync"
+	"time"
 
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/set"
 	"github.com/iotaledger/hive.go/generics/walker"
 	"github.com/iotaledger/hive.go/types"
@@ -19,80 +19,33 @@ import (
 type Gadget interface {
 	HandleMarker(marker *markers.Marker, aw float64) (err error)
 	HandleBranch(branchID ledgerstate.BranchID, aw float64) (err error)
+	ReplayJournal() (replayedEntries int, err error)
+	GoFTranslation() GoFTranslation
 	tangle.ConfirmationOracle
 }
 
-// MessageThresholdTranslation is a function which translates approval weight to a gof.GradeOfFinality.
-type MessageThresholdTranslation func(aw float64) gof.GradeOfFinality
-
-// BranchThresholdTranslation is a function which translates approval weight to a gof.GradeOfFinality.
-type BranchThresholdTranslation func(branchID ledgerstate.BranchID, aw float64) gof.GradeOfFinality
-
-const (
-	lowLowerBound    = 0.25
-	mediumLowerBound = 0.45
-	highLowerBound   = 0.67
-)
-
-var (
-	// DefaultBranchGoFTranslation is the default function to translate the approval weight to gof.GradeOfFinality of a branch.
-	DefaultBranchGoFTranslation BranchThresholdTranslation = func(branchID ledgerstate.BranchID, aw float64) gof.GradeOfFinality {
-		switch {
-		case aw >= lowLowerBound && aw < mediumLowerBound:
-			return gof.Low
-		case aw >= mediumLowerBound && aw < highLowerBound:
-			return gof.Medium
-		case aw >= highLowerBound:
-			return gof.High
-		default:
-			return gof.None
-		}
-	}
-
-	// DefaultMessageGoFTranslation is the default function to translate the approval weight to gof.GradeOfFinality of a message.
-	DefaultMessageGoFTranslation MessageThresholdTranslation = func(aw float64) gof.GradeOfFinality {
-		switch {
-		case aw >= lowLowerBound && aw < mediumLowerBound:
-			return gof.Low
-		case aw >= mediumLowerBound && aw < highLowerBound:
-			return gof.Medium
-		case aw >= highLowerBound:
-			return gof.High
-		default:
-			return gof.None
-		}
-	}
-)
-
 // Option is a function setting an option on an Options struct.
 type Option func(*Options)
 
 // Options defines the options for a SimpleFinalityGadget.
 type Options struct {
-	BranchTransFunc        BranchThresholdTranslation
-	MessageTransFunc       MessageThresholdTranslation
+	GoFTranslation         GoFTranslation
 	BranchGoFReachedLevel  gof.GradeOfFinality
 	MessageGoFReachedLevel gof.GradeOfFinality
+	Journal                *Journal
 }
 
 var defaultOpts = []Option{
-	WithBranchThresholdTranslation(DefaultBranchGoFTranslation),
-	WithMessageThresholdTranslation(DefaultMessageGoFTranslation),
+	WithGoFTranslation(DefaultGoFTranslation),
 	WithBranchGoFReachedLevel(gof.High),
 	WithMessageGoFReachedLevel(gof.High),
 }
 
-// WithMessageThresholdTranslation returns an Option setting the MessageThresholdTranslation.
-func WithMessageThresholdTranslation(f MessageThresholdTranslation) Option {
+// WithGoFTranslation returns an Option setting the GoFTranslation that translates approval weight to a
+// gof.GradeOfFinality.
+func WithGoFTranslation(goFTranslation GoFTranslation) Option {
 	return func(opts *Options) {
-		opts.MessageTransFunc = f
-	}
-}
-
-// WithBranchThresholdTranslation returns an Option setting the BranchThresholdTranslation.
-func WithBranchThresholdTranslation(f BranchThresholdTranslation) Option {
-	return func(opts *Options) {
-		opts.BranchTransFunc = f
+		opts.GoFTranslation = goFTranslation
 	}
 }
 
@@ -110,6 +63,14 @@ func WithMessageGoFReachedLevel(msgGradeOfFinality gof.GradeOfFinality) Option {
 	}
 }
 
+// WithJournal returns an Option setting the Journal that records the confirmation cascades, so that they can be
+// replayed after a crash (nil disables the journaling).
+func WithJournal(journal *Journal) Option {
+	return func(opts *Options) {
+		opts.Journal = journal
+	}
+}
+
 // SimpleFinalityGadget is a Gadget which simply translates approval weight down to gof.GradeOfFinality
 // and then applies it to messages, branches, transactions and outputs.
 type SimpleFinalityGadget struct {
@@ -126,11 +87,7 @@ func NewSimpleFinalityGadget(t *tangle.Tangle, opts ...Option) *SimpleFinalityGa
 		tangle:               t,
 		opts:                 &Options{},
 		lastConfirmedMarkers: make(map[markers.SequenceID]markers.Index),
-		events: &tangle.ConfirmationEvents{
-			MessageConfirmed:     events.NewEvent(tangle.MessageIDCaller),
-			TransactionConfirmed: events.NewEvent(ledgerstate.TransactionIDEventHandler),
-			BranchConfirmed:      events.NewEvent(ledgerstate.BranchIDEventHandler),
-		},
+		events:               tangle.NewConfirmationEvents(),
 	}
 
 	for _, defOpt := range defaultOpts {
@@ -148,6 +105,11 @@ func (s *SimpleFinalityGadget) Events() *tangle.ConfirmationEvents {
 	return s.events
 }
 
+// GoFTranslation returns the GoFTranslation that is used to translate approval weight to a gof.GradeOfFinality.
+func (s *SimpleFinalityGadget) GoFTranslation() GoFTranslation {
+	return s.opts.GoFTranslation
+}
+
 // IsMarkerConfirmed returns whether the given marker is confirmed.
 func (s *SimpleFinalityGadget) IsMarkerConfirmed(marker *markers.Marker) (confirmed bool) {
 	messageID := s.tangle.Booker.MarkersManager.MessageID(marker)
@@ -233,7 +195,7 @@ func (s *SimpleFinalityGadget) IsOutputConfirmed(outputID ledgerstate.OutputID)
 
 // HandleMarker receives a marker and its current approval weight. It propagates the GoF according to AW to its past cone.
 func (s *SimpleFinalityGadget) HandleMarker(marker *markers.Marker, aw float64) (err error) {
-	gradeOfFinality := s.opts.MessageTransFunc(aw)
+	gradeOfFinality := s.opts.GoFTranslation.MessageGoF(aw)
 	if gradeOfFinality == gof.None {
 		return nil
 	}
@@ -249,7 +211,9 @@ func (s *SimpleFinalityGadget) HandleMarker(marker *markers.Marker, aw float64)
 			s.setMarkerConfirmed(marker)
 		}
 
-		s.propagateGoFToMessagePastCone(messageID, gradeOfFinality)
+		err = s.journaled(NewMessageJournalEntry(messageID, gradeOfFinality, s.tangle.Options.Clock.Now()), func() {
+			s.propagateGoFToMessagePastCone(messageID, gradeOfFinality, time.Time{})
+		})
 	})
 
 	return err
@@ -269,8 +233,9 @@ func (s *SimpleFinalityGadget) setMarkerConfirmed(marker *markers.Marker) (updat
 	return true
 }
 
-// propagateGoFToMessagePastCone propagates the given GradeOfFinality to the past cone of the Message.
-func (s *SimpleFinalityGadget) propagateGoFToMessagePastCone(messageID tangle.MessageID, gradeOfFinality gof.GradeOfFinality) {
+// propagateGoFToMessagePastCone propagates the given GradeOfFinality to the past cone of the Message. If since is set,
+// the propagation is replayed and also walks through the Messages whose GradeOfFinality was set at or after that time.
+func (s *SimpleFinalityGadget) propagateGoFToMessagePastCone(messageID tangle.MessageID, gradeOfFinality gof.GradeOfFinality, since time.Time) {
 	strongParentWalker := walker.New[tangle.MessageID](false).Push(messageID)
 	weakParentsSet := set.New[tangle.MessageID]()
 
@@ -281,7 +246,7 @@ func (s *SimpleFinalityGadget) propagateGoFToMessagePastCone(messageID tangle.Me
 		}
 
 		s.tangle.Storage.MessageMetadata(strongParentMessageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
-			if messageMetadata.GradeOfFinality() >= gradeOfFinality || !s.setMessageGoF(messageMetadata, gradeOfFinality) {
+			if !s.propagateMessageGoF(messageMetadata, gradeOfFinality, since) {
 				return
 			}
 
@@ -302,67 +267,120 @@ func (s *SimpleFinalityGadget) propagateGoFToMessagePastCone(messageID tangle.Me
 			return
 		}
 		s.tangle.Storage.MessageMetadata(weakParent).Consume(func(messageMetadata *tangle.MessageMetadata) {
-			if messageMetadata.GradeOfFinality() >= gradeOfFinality {
-				return
-			}
-			s.setMessageGoF(messageMetadata, gradeOfFinality)
+			s.propagateMessageGoF(messageMetadata, gradeOfFinality, since)
 		})
 	})
 }
 
+// propagateMessageGoF sets the given GradeOfFinality of a Message in the past cone of a propagation and returns true
+// if the propagation needs to continue with its parents.
+func (s *SimpleFinalityGadget) propagateMessageGoF(messageMetadata *tangle.MessageMetadata, gradeOfFinality gof.GradeOfFinality, since time.Time) (propagate bool) {
+	if messageMetadata.GradeOfFinality() < gradeOfFinality {
+		return s.setMessageGoF(messageMetadata, gradeOfFinality)
+	}
+
+	// a replayed propagation continues with the Messages that were updated by the propagation (or a later one), as their
+	// payloads and parents might not have been persisted
+	if since.IsZero() || messageMetadata.GradeOfFinalityTime().Before(since) {
+		return false
+	}
+	s.setPayloadGoF(messageMetadata.ID(), messageMetadata.GradeOfFinality(), true)
+
+	return true
+}
+
 // HandleBranch receives a branchID and its approval weight. It propagates the GoF according to AW to transactions
 // in the branch (UTXO future cone) and their outputs.
 func (s *SimpleFinalityGadget) HandleBranch(branchID ledgerstate.BranchID, aw float64) (err error) {
-	newGradeOfFinality := s.opts.BranchTransFunc(branchID, aw)
+	newGradeOfFinality := s.opts.GoFTranslation.BranchGoF(branchID, aw)
+
+	// only the propagations that change the GoF of the branch are journaled, as the others abort right away
+	var gradeOfFinalityChanged bool
+	s.tangle.LedgerState.UTXODAG.CachedTransactionMetadata(branchID.TransactionID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		gradeOfFinalityChanged = transactionMetadata.GradeOfFinality() != newGradeOfFinality
+	})
+	if !gradeOfFinalityChanged {
+		s.propagateBranchGoF(branchID, newGradeOfFinality, time.Time{})
+		return nil
+	}
 
+	return s.journaled(NewBranchJournalEntry(branchID, newGradeOfFinality, s.tangle.Options.Clock.Now()), func() {
+		s.propagateBranchGoF(branchID, newGradeOfFinality, time.Time{})
+	})
+}
+
+// propagateBranchGoF propagates the given GradeOfFinality to the transactions in the branch (UTXO future cone) and
+// their outputs. If since is set, the propagation is replayed and also walks through the transactions whose
+// GradeOfFinality was set at or after that time.
+func (s *SimpleFinalityGadget) propagateBranchGoF(branchID ledgerstate.BranchID, newGradeOfFinality gof.GradeOfFinality, since time.Time) {
 	// update GoF of txs within the same branch
 	txGoFPropWalker := walker.New[ledgerstate.TransactionID]()
 	s.tangle.LedgerState.UTXODAG.CachedTransactionMetadata(branchID.TransactionID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
-		s.updateTransactionGoF(transactionMetadata, newGradeOfFinality, txGoFPropWalker)
+		s.updateTransactionGoF(transactionMetadata, newGradeOfFinality, txGoFPropWalker, since)
 	})
 	for txGoFPropWalker.HasNext() {
-		s.forwardPropagateBranchGoFToTxs(txGoFPropWalker.Next(), branchID, newGradeOfFinality, txGoFPropWalker)
+		s.forwardPropagateBranchGoFToTxs(txGoFPropWalker.Next(), branchID, newGradeOfFinality, txGoFPropWalker, since)
 	}
 
 	if newGradeOfFinality >= s.opts.BranchGoFReachedLevel {
+		if !since.IsZero() && s.tangle.Options.LedgerState.MergeBranches {
+			s.tangle.LedgerState.BranchDAG.RepairBranchConfirmed(branchID)
+		}
+
 		s.events.BranchConfirmed.Trigger(branchID)
 	}
-
-	return err
 }
 
-func (s *SimpleFinalityGadget) forwardPropagateBranchGoFToTxs(candidateTxID ledgerstate.TransactionID, candidateBranchID ledgerstate.BranchID, newGradeOfFinality gof.GradeOfFinality, txGoFPropWalker *walker.Walker[ledgerstate.TransactionID]) bool {
+func (s *SimpleFinalityGadget) forwardPropagateBranchGoFToTxs(candidateTxID ledgerstate.TransactionID, candidateBranchID ledgerstate.BranchID, newGradeOfFinality gof.GradeOfFinality, txGoFPropWalker *walker.Walker[ledgerstate.TransactionID], since time.Time) bool {
 	return s.tangle.LedgerState.UTXODAG.CachedTransactionMetadata(candidateTxID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 		// we stop if we walk outside our branch
 		if !transactionMetadata.BranchIDs().Contains(candidateBranchID) {
 			return
 		}
 
+		// the best attachment is the one with the highest GoF
 		var maxAttachmentGoF gof.GradeOfFinality
-		s.tangle.Storage.Attachments(transactionMetadata.ID()).Consume(func(attachment *tangle.Attachment) {
-			s.tangle.Storage.MessageMetadata(attachment.MessageID()).Consume(func(messageMetadata *tangle.MessageMetadata) {
-				if maxAttachmentGoF < messageMetadata.GradeOfFinality() {
-					maxAttachmentGoF = messageMetadata.GradeOfFinality()
-				}
+		if bestAttachmentID, err := s.tangle.Utils.BestAttachment(transactionMetadata.ID()); err == nil {
+			s.tangle.Storage.MessageMetadata(bestAttachmentID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+				maxAttachmentGoF = messageMetadata.GradeOfFinality()
 			})
-		})
+		}
 
 		// only adjust tx GoF if attachments have at least GoF derived from UTXO parents
 		if maxAttachmentGoF < newGradeOfFinality {
 			return
 		}
 
-		s.updateTransactionGoF(transactionMetadata, newGradeOfFinality, txGoFPropWalker)
+		s.updateTransactionGoF(transactionMetadata, newGradeOfFinality, txGoFPropWalker, since)
 	})
 }
 
-func (s *SimpleFinalityGadget) updateTransactionGoF(transactionMetadata *ledgerstate.TransactionMetadata, newGradeOfFinality gof.GradeOfFinality, txGoFPropWalker *walker.Walker[ledgerstate.TransactionID]) {
-	// abort if the grade of finality did not change
-	if !transactionMetadata.SetGradeOfFinality(newGradeOfFinality) {
+func (s *SimpleFinalityGadget) updateTransactionGoF(transactionMetadata *ledgerstate.TransactionMetadata, newGradeOfFinality gof.GradeOfFinality, txGoFPropWalker *walker.Walker[ledgerstate.TransactionID], since time.Time) {
+	// abort if the grade of finality did not change (unless a replayed propagation updated it before the crash)
+	if !transactionMetadata.SetGradeOfFinality(newGradeOfFinality, s.tangle.Options.Clock.Now()) {
+		if since.IsZero() || transactionMetadata.GradeOfFinalityTime().Before(since) {
+			return
+		}
+
+		s.adjustTransactionOutputsGoF(transactionMetadata.ID(), newGradeOfFinality, txGoFPropWalker)
 		return
 	}
 
-	s.tangle.LedgerState.UTXODAG.CachedTransaction(transactionMetadata.ID()).Consume(func(transaction *ledgerstate.Transaction) {
+	s.adjustTransactionOutputsGoF(transactionMetadata.ID(), newGradeOfFinality, txGoFPropWalker)
+	s.events.TransactionGoFChanged.Trigger(&tangle.TransactionGoFChangedEvent{
+		TransactionID:   transactionMetadata.ID(),
+		GradeOfFinality: newGradeOfFinality,
+	})
+	if transactionMetadata.GradeOfFinality() >= s.opts.BranchGoFReachedLevel {
+		s.events.TransactionConfirmed.Trigger(transactionMetadata.ID())
+	}
+}
+
+// adjustTransactionOutputsGoF sets the given GradeOfFinality of the outputs of a transaction and adds their consumers
+// to the walker.
+func (s *SimpleFinalityGadget) adjustTransactionOutputsGoF(transactionID ledgerstate.TransactionID, newGradeOfFinality gof.GradeOfFinality, txGoFPropWalker *walker.Walker[ledgerstate.TransactionID]) {
+
+	s.tangle.LedgerState.UTXODAG.CachedTransaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
 		// we use a set of consumer txs as our candidate tx can consume multiple outputs from the same txs,
 		// but we want to add such tx only once to the walker
 		consumerTxs := make(ledgerstate.TransactionIDs)
@@ -372,14 +390,11 @@ func (s *SimpleFinalityGadget) updateTransactionGoF(transactionMetadata *ledgers
 			s.adjustOutputGoF(output, newGradeOfFinality, consumerTxs, txGoFPropWalker)
 		}
 	})
-	if transactionMetadata.GradeOfFinality() >= s.opts.BranchGoFReachedLevel {
-		s.events.TransactionConfirmed.Trigger(transactionMetadata.ID())
-	}
 }
 
 func (s *SimpleFinalityGadget) adjustOutputGoF(output ledgerstate.Output, newGradeOfFinality gof.GradeOfFinality, consumerTxs ledgerstate.TransactionIDs, txGoFPropWalker *walker.Walker[ledgerstate.TransactionID]) bool {
 	return s.tangle.LedgerState.UTXODAG.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
-		outputMetadata.SetGradeOfFinality(newGradeOfFinality)
+		outputMetadata.SetGradeOfFinality(newGradeOfFinality, s.tangle.Options.Clock.Now())
 		s.tangle.LedgerState.Consumers(output.ID()).Consume(func(consumer *ledgerstate.Consumer) {
 			if _, has := consumerTxs[consumer.TransactionID()]; !has {
 				consumerTxs[consumer.TransactionID()] = types.Empty{}
@@ -391,13 +406,19 @@ func (s *SimpleFinalityGadget) adjustOutputGoF(output ledgerstate.Output, newGra
 
 func (s *SimpleFinalityGadget) setMessageGoF(messageMetadata *tangle.MessageMetadata, gradeOfFinality gof.GradeOfFinality) (modified bool) {
 	// abort if message has GoF already set
-	if modified = messageMetadata.SetGradeOfFinality(gradeOfFinality); !modified {
+	previousGradeOfFinality := messageMetadata.GradeOfFinality()
+	if modified = messageMetadata.SetGradeOfFinality(gradeOfFinality, s.tangle.Options.Clock.Now()); !modified {
 		return
 	}
 
 	// set GoF of payload (applicable only to transactions)
-	s.setPayloadGoF(messageMetadata.ID(), gradeOfFinality)
+	s.setPayloadGoF(messageMetadata.ID(), gradeOfFinality, false)
 
+	s.Events().MessageGoFChanged.Trigger(&tangle.MessageGoFChangedEvent{
+		MessageID:               messageMetadata.ID(),
+		GradeOfFinality:         gradeOfFinality,
+		PreviousGradeOfFinality: previousGradeOfFinality,
+	})
 	if gradeOfFinality >= s.opts.MessageGoFReachedLevel {
 		s.Events().MessageConfirmed.Trigger(messageMetadata.ID())
 	}
@@ -405,7 +426,9 @@ func (s *SimpleFinalityGadget) setMessageGoF(messageMetadata *tangle.MessageMeta
 	return modified
 }
 
-func (s *SimpleFinalityGadget) setPayloadGoF(messageID tangle.MessageID, gradeOfFinality gof.GradeOfFinality) {
+// setPayloadGoF sets the GradeOfFinality of the transaction in the payload of the given Message. If repair is set, the
+// GradeOfFinality of the outputs is also set if the one of the transaction did not change.
+func (s *SimpleFinalityGadget) setPayloadGoF(messageID tangle.MessageID, gradeOfFinality gof.GradeOfFinality, repair bool) {
 	s.tangle.Utils.ComputeIfTransaction(messageID, func(transactionID ledgerstate.TransactionID) {
 		s.tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 			// A transaction can't have a higher GoF than its branch, thus we need to evaluate based on min(branchGoF,max(messageGoF,transactionGoF)).
@@ -428,7 +451,8 @@ func (s *SimpleFinalityGadget) setPayloadGoF(messageID tangle.MessageID, gradeOf
 			}
 
 			// abort if transaction has GoF already set
-			if !transactionMetadata.SetGradeOfFinality(gradeOfFinality) {
+			modified := transactionMetadata.SetGradeOfFinality(gradeOfFinality, s.tangle.Options.Clock.Now())
+			if !modified && !repair {
 				return
 			}
 
@@ -436,11 +460,18 @@ func (s *SimpleFinalityGadget) setPayloadGoF(messageID tangle.MessageID, gradeOf
 			s.tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
 				for _, output := range transaction.Essence().Outputs() {
 					s.tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
-						outputMetadata.SetGradeOfFinality(gradeOfFinality)
+						outputMetadata.SetGradeOfFinality(gradeOfFinality, s.tangle.Options.Clock.Now())
 					})
 				}
 			})
+			if !modified {
+				return
+			}
 
+			s.Events().TransactionGoFChanged.Trigger(&tangle.TransactionGoFChangedEvent{
+				TransactionID:   transactionID,
+				GradeOfFinality: gradeOfFinality,
+			})
 			if gradeOfFinality >= s.opts.BranchGoFReachedLevel {
 				s.Events().TransactionConfirmed.Trigger(transactionID)
 			}
@@ -462,3 +493,50 @@ func (s *SimpleFinalityGadget) getTransactionBranchesGoF(transactionMetadata *le
 	}
 	return
 }
+
+// ReplayJournal rolls the confirmation cascades of the Journal forward, so that the cones of cascades that were only
+// persisted partially before the node stopped (e.g. because of a crash or a power loss) end up in a consistent state.
+// It needs to be called after the Tangle was set up and before new messages are processed.
+func (s *SimpleFinalityGadget) ReplayJournal() (replayedEntries int, err error) {
+	if s.opts.Journal == nil {
+		return 0, nil
+	}
+
+	entries, err := s.opts.Journal.Entries()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, entry := range entries {
+		switch entry.Type {
+		case MessageCascade:
+			s.propagateGoFToMessagePastCone(entry.MessageID, entry.GradeOfFinality, entry.StartTime)
+		case BranchCascade:
+			s.propagateBranchGoF(entry.BranchID, entry.GradeOfFinality, entry.StartTime)
+		}
+
+		// the repaired metadata is cached again, so the entry needs to be kept for another retention period
+		if err = s.opts.Journal.Complete(entry, s.tangle.Options.Clock.Now()); err != nil {
+			return replayedEntries, err
+		}
+		replayedEntries++
+	}
+
+	return replayedEntries, nil
+}
+
+// journaled records the given JournalEntry in the Journal (if it is enabled) and marks it as completed after the
+// cascade finished.
+func (s *SimpleFinalityGadget) journaled(entry *JournalEntry, cascade func()) (err error) {
+	if s.opts.Journal == nil {
+		cascade()
+		return nil
+	}
+
+	if err = s.opts.Journal.Begin(entry); err != nil {
+		return err
+	}
+	cascade()
+
+	return s.opts.Journal.Complete(entry, s.tangle.Options.Clock.Now())
+}
@@ -2,13 +2,14 @@ package finality
 
 import (
 	"testing"
+	"time"
 
-	"github.com/iotaledger/hive.go/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
@@ -17,38 +18,14 @@ type EventHandlerMock struct {
 	mock.Mock
 }
 
-const (
-	testingLowBound    = 0.2
-	testingMediumBound = 0.3
-	testingHighBound   = 0.5
-)
-
 var (
-	TestBranchGoFTranslation BranchThresholdTranslation = func(branchID ledgerstate.BranchID, aw float64) gof.GradeOfFinality {
-		switch {
-		case aw >= testingLowBound && aw < testingMediumBound:
-			return gof.Low
-		case aw >= testingMediumBound && aw < testingHighBound:
-			return gof.Medium
-		case aw >= testingHighBound:
-			return gof.High
-		default:
-			return gof.None
-		}
+	testingThresholds = Thresholds{
+		Low:    0.2,
+		Medium: 0.3,
+		High:   0.5,
 	}
 
-	TestMessageGoFTranslation MessageThresholdTranslation = func(aw float64) gof.GradeOfFinality {
-		switch {
-		case aw >= testingLowBound && aw < testingMediumBound:
-			return gof.Low
-		case aw >= testingMediumBound && aw < testingHighBound:
-			return gof.Medium
-		case aw >= testingHighBound:
-			return gof.High
-		default:
-			return gof.None
-		}
-	}
+	TestGoFTranslation = NewThresholdTranslation(testingThresholds, testingThresholds)
 )
 
 func (handler *EventHandlerMock) MessageConfirmed(msgID tangle.MessageID) {
@@ -64,9 +41,9 @@ func (handler *EventHandlerMock) TransactionConfirmed(txID ledgerstate.Transacti
 }
 
 func (handler *EventHandlerMock) WireUpFinalityGadget(fg Gadget) {
-	fg.Events().MessageConfirmed.Attach(events.NewClosure(handler.MessageConfirmed))
-	fg.Events().BranchConfirmed.Attach(events.NewClosure(handler.BranchConfirmed))
-	fg.Events().TransactionConfirmed.Attach(events.NewClosure(handler.TransactionConfirmed))
+	fg.Events().MessageConfirmed.Attach(event.NewClosure(handler.MessageConfirmed))
+	fg.Events().BranchConfirmed.Attach(event.NewClosure(handler.BranchConfirmed))
+	fg.Events().TransactionConfirmed.Attach(event.NewClosure(handler.TransactionConfirmed))
 }
 
 func TestSimpleFinalityGadget(t *testing.T) {
@@ -80,8 +57,7 @@ func TestSimpleFinalityGadget(t *testing.T) {
 	processMsgScenario.Tangle.Configure(tangle.MergeBranches(false))
 
 	testOpts := []Option{
-		WithBranchThresholdTranslation(TestBranchGoFTranslation),
-		WithMessageThresholdTranslation(TestMessageGoFTranslation),
+		WithGoFTranslation(TestGoFTranslation),
 	}
 
 	sfg := NewSimpleFinalityGadget(processMsgScenario.Tangle, testOpts...)
@@ -399,8 +375,7 @@ func TestWeakVsStrongParentWalk(t *testing.T) {
 	processMsgScenario.Tangle.Configure(tangle.MergeBranches(false))
 
 	testOpts := []Option{
-		WithBranchThresholdTranslation(TestBranchGoFTranslation),
-		WithMessageThresholdTranslation(TestMessageGoFTranslation),
+		WithGoFTranslation(TestGoFTranslation),
 	}
 
 	sfg := NewSimpleFinalityGadget(processMsgScenario.Tangle, testOpts...)
@@ -445,7 +420,7 @@ func TestWeakVsStrongParentWalk(t *testing.T) {
 		// Message4
 		{
 			Post: func(t *testing.T, testFramework *tangle.MessageTestFramework, testEventMock *tangle.EventMock, nodes tangle.NodeIdentities) {
-				sfg.propagateGoFToMessagePastCone(testFramework.Message("Message4").ID(), gof.High)
+				sfg.propagateGoFToMessagePastCone(testFramework.Message("Message4").ID(), gof.High, time.Time{})
 				assertMsgsGoFs(t, testFramework, map[gof.GradeOfFinality][]string{
 					gof.High:   {"Message1", "Message2", "Message3", "Message4"},
 					gof.Medium: {},
@@ -499,12 +474,12 @@ func assertBranchsGoFs(t *testing.T, testFramework *tangle.MessageTestFramework,
 }
 
 func wireUpEvents(t *testing.T, testTangle *tangle.Tangle, fg Gadget) {
-	testTangle.ApprovalWeightManager.Events.MarkerWeightChanged.Attach(events.NewClosure(func(e *tangle.MarkerWeightChangedEvent) {
+	testTangle.ApprovalWeightManager.Events.MarkerWeightChanged.Attach(event.NewClosure(func(e *tangle.MarkerWeightChangedEvent) {
 		if err := fg.HandleMarker(e.Marker, e.Weight); err != nil {
 			t.Log(err)
 		}
 	}))
-	testTangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(events.NewClosure(func(e *tangle.BranchWeightChangedEvent) {
+	testTangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(event.NewClosure(func(e *tangle.BranchWeightChangedEvent) {
 		if err := fg.HandleBranch(e.BranchID, e.Weight); err != nil {
 			t.Log(err)
 		}
@@ -0,0 +1,107 @@
+package finality
+
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+var (
+	// DefaultThresholds are the default approval weight thresholds of the grades of finality.
+	DefaultThresholds = Thresholds{
+		Low:    0.25,
+		Medium: 0.45,
+		High:   0.67,
+	}
+
+	// DefaultGoFTranslation is the default GoFTranslation that uses the DefaultThresholds for messages and branches.
+	DefaultGoFTranslation GoFTranslation = NewThresholdTranslation(DefaultThresholds, DefaultThresholds)
+)
+
+// region GoFTranslation ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// GoFTranslation is the strategy of the finality gadget that translates approval weight to a gof.GradeOfFinality. It
+// can be replaced to deploy alternative finality ladders in a network.
+type GoFTranslation interface {
+	// MessageGoF translates the approval weight of a message to its gof.GradeOfFinality.
+	MessageGoF(aw float64) gof.GradeOfFinality
+
+	// BranchGoF translates the approval weight of a branch to its gof.GradeOfFinality.
+	BranchGoF(branchID ledgerstate.BranchID, aw float64) gof.GradeOfFinality
+
+	// Thresholds returns the approval weight thresholds of the grades of finality of messages and branches.
+	Thresholds() (messageThresholds, branchThresholds Thresholds)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ThresholdTranslation /////////////////////////////////////////////////////////////////////////////////////////
+
+// ThresholdTranslation is a GoFTranslation that translates approval weight using a fixed ladder of Thresholds.
+type ThresholdTranslation struct {
+	messageThresholds Thresholds
+	branchThresholds  Thresholds
+}
+
+// NewThresholdTranslation returns a ThresholdTranslation that uses the given Thresholds for messages and branches.
+func NewThresholdTranslation(messageThresholds, branchThresholds Thresholds) *ThresholdTranslation {
+	return &ThresholdTranslation{
+		messageThresholds: messageThresholds,
+		branchThresholds:  branchThresholds,
+	}
+}
+
+// MessageGoF translates the approval weight of a message to its gof.GradeOfFinality.
+func (t *ThresholdTranslation) MessageGoF(aw float64) gof.GradeOfFinality {
+	return t.messageThresholds.GradeOfFinality(aw)
+}
+
+// BranchGoF translates the approval weight of a branch to its gof.GradeOfFinality.
+func (t *ThresholdTranslation) BranchGoF(_ ledgerstate.BranchID, aw float64) gof.GradeOfFinality {
+	return t.branchThresholds.GradeOfFinality(aw)
+}
+
+// Thresholds returns the approval weight thresholds of the grades of finality of messages and branches.
+func (t *ThresholdTranslation) Thresholds() (messageThresholds, branchThresholds Thresholds) {
+	return t.messageThresholds, t.branchThresholds
+}
+
+// code contract (make sure the type implements all required methods).
+var _ GoFTranslation = &ThresholdTranslation{}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Thresholds ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Thresholds defines the approval weight that is at least needed to reach each gof.GradeOfFinality.
+type Thresholds struct {
+	Low    float64
+	Medium float64
+	High   float64
+}
+
+// GradeOfFinality returns the gof.GradeOfFinality that is reached with the given approval weight.
+func (t Thresholds) GradeOfFinality(aw float64) gof.GradeOfFinality {
+	switch {
+	case aw >= t.High:
+		return gof.High
+	case aw >= t.Medium:
+		return gof.Medium
+	case aw >= t.Low:
+		return gof.Low
+	default:
+		return gof.None
+	}
+}
+
+// Validate checks that the Thresholds are ascending and within the range of possible approval weights.
+func (t Thresholds) Validate() (err error) {
+	if t.Low <= 0 || t.Low > t.Medium || t.Medium > t.High || t.High > 1 {
+		return errors.Errorf("thresholds need to satisfy 0 < low (%f) <= medium (%f) <= high (%f) <= 1", t.Low, t.Medium, t.High)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,36 @@
+package finality
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestThresholdTranslation(t *testing.T) {
+	translation := NewThresholdTranslation(DefaultThresholds, Thresholds{Low: 0.5, Medium: 0.5, High: 0.9})
+
+	assert.Equal(t, gof.None, translation.MessageGoF(0.2))
+	assert.Equal(t, gof.Low, translation.MessageGoF(0.25))
+	assert.Equal(t, gof.Medium, translation.MessageGoF(0.5))
+	assert.Equal(t, gof.High, translation.MessageGoF(0.67))
+
+	// thresholds can be equal to skip a grade of finality
+	assert.Equal(t, gof.None, translation.BranchGoF(ledgerstate.UndefinedBranchID, 0.4))
+	assert.Equal(t, gof.Medium, translation.BranchGoF(ledgerstate.UndefinedBranchID, 0.6))
+	assert.Equal(t, gof.High, translation.BranchGoF(ledgerstate.UndefinedBranchID, 1))
+
+	messageThresholds, branchThresholds := translation.Thresholds()
+	assert.Equal(t, DefaultThresholds, messageThresholds)
+	assert.Equal(t, 0.9, branchThresholds.High)
+}
+
+func TestThresholds_Validate(t *testing.T) {
+	assert.NoError(t, DefaultThresholds.Validate())
+	assert.NoError(t, Thresholds{Low: 0.5, Medium: 0.5, High: 1}.Validate())
+	assert.Error(t, Thresholds{Low: 0, Medium: 0.5, High: 0.6}.Validate())
+	assert.Error(t, Thresholds{Low: 0.5, Medium: 0.4, High: 0.6}.Validate())
+	assert.Error(t, Thresholds{Low: 0.5, Medium: 0.6, High: 1.1}.Validate())
+}
@@ -0,0 +1,312 @@
+package finality
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Journal //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Journal is a write barrier for the confirmation cascades of the SimpleFinalityGadget. Every cascade is recorded
+// before it starts to update the metadata of its cone, so that a cascade that was only persisted partially (e.g.
+// because the node crashed while the metadata was still cached) can be rolled forward when the node restarts.
+//
+// The Journal is stored in the same database as the metadata that it protects. Its entries are written right away,
+// while the updated metadata is only written once it leaves the caches of the object storages, so the database can not
+// persist the metadata of a cascade without its entry (even if the writes are not synced to disk). The entries of
+// completed cascades are only removed by a Checkpoint after the pending writes of the metadata were committed, and all
+// entries are removed after a clean shutdown.
+type Journal struct {
+	store     kvstore.KVStore
+	retention time.Duration
+	nextIndex uint64
+	completed []*JournalEntry
+	mutex     sync.Mutex
+}
+
+// NewJournal is the constructor of the Journal that keeps the entries of completed cascades for at least the given
+// retention. The given store needs to be the store of the metadata.
+func NewJournal(store kvstore.KVStore, retention time.Duration) (journal *Journal, err error) {
+	journal = &Journal{
+		store:     store.WithRealm([]byte{database.PrefixConsensus, PrefixConfirmationJournal}),
+		retention: retention,
+	}
+
+	if err = journal.store.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		if index := binary.BigEndian.Uint64(key); index >= journal.nextIndex {
+			journal.nextIndex = index + 1
+		}
+
+		return true
+	}); err != nil {
+		return nil, errors.Errorf("failed to restore the confirmation journal: %w", err)
+	}
+
+	return journal, nil
+}
+
+// Begin persists the given JournalEntry before its cascade is started.
+func (j *Journal) Begin(entry *JournalEntry) (err error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	entry.Index = j.nextIndex
+	if err = j.store.Set(entry.key(), entry.Bytes()); err != nil {
+		return errors.Errorf("failed to store journal entry %d: %w", entry.Index, err)
+	}
+	j.nextIndex++
+
+	return nil
+}
+
+// Complete marks the cascade of the given JournalEntry as completed.
+func (j *Journal) Complete(entry *JournalEntry, completionTime time.Time) (err error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	entry.CompletionTime = completionTime
+	if err = j.store.Set(entry.key(), entry.Bytes()); err != nil {
+		return errors.Errorf("failed to store journal entry %d: %w", entry.Index, err)
+	}
+	j.completed = append(j.completed, entry)
+
+	return nil
+}
+
+// Checkpoint removes the entries of the cascades that completed at least the retention before the given time, which
+// needs to be the time at which the given commit of the pending writes of the metadata is started. The retention covers
+// the time that the updated metadata stays in the caches of the object storages before it is written, and the entries
+// are only removed if the commit succeeded. The removal is written after the metadata, so the database can not persist
+// it without the metadata.
+func (j *Journal) Checkpoint(commitStart time.Time, commit func() error) (removedEntries int, err error) {
+	j.mutex.Lock()
+	persistedEntries := make([]*JournalEntry, 0)
+	for _, entry := range j.completed {
+		if commitStart.Sub(entry.CompletionTime) < j.retention {
+			break
+		}
+		persistedEntries = append(persistedEntries, entry)
+	}
+	j.mutex.Unlock()
+
+	if len(persistedEntries) == 0 {
+		return 0, nil
+	}
+	if err = commit(); err != nil {
+		return 0, errors.Errorf("failed to commit the pending writes: %w", err)
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	for _, entry := range persistedEntries {
+		// the Journal was cleared during the commit
+		if len(j.completed) == 0 || j.completed[0] != entry {
+			break
+		}
+
+		if err = j.store.Delete(entry.key()); err != nil {
+			return removedEntries, errors.Errorf("failed to delete journal entry %d: %w", entry.Index, err)
+		}
+		j.completed = j.completed[1:]
+		removedEntries++
+	}
+
+	return removedEntries, nil
+}
+
+// Entries returns the persisted JournalEntries ordered by their Index.
+func (j *Journal) Entries() (entries []*JournalEntry, err error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	entries = make([]*JournalEntry, 0)
+	if iterateErr := j.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		var entry *JournalEntry
+		if entry, err = journalEntryFromBytes(key, value); err != nil {
+			return false
+		}
+		entries = append(entries, entry)
+
+		return true
+	}); iterateErr != nil {
+		return nil, errors.Errorf("failed to iterate the confirmation journal: %w", iterateErr)
+	}
+	if err != nil {
+		return nil, errors.Errorf("failed to parse the confirmation journal: %w", err)
+	}
+
+	return entries, nil
+}
+
+// Clear removes all entries of the Journal. It is called after the confirmation state was persisted completely during
+// a clean shutdown.
+func (j *Journal) Clear() (err error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if err = j.store.Clear(); err != nil {
+		return errors.Errorf("failed to clear the confirmation journal: %w", err)
+	}
+	j.completed = nil
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region JournalEntry /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// CascadeType defines the kind of confirmation cascade that is recorded by a JournalEntry.
+type CascadeType uint8
+
+const (
+	// MessageCascade is the propagation of a GradeOfFinality to the past cone of a message.
+	MessageCascade CascadeType = iota
+	// BranchCascade is the propagation of a GradeOfFinality to the transactions and outputs of a branch.
+	BranchCascade
+)
+
+// String returns a human-readable version of the CascadeType.
+func (c CascadeType) String() string {
+	switch c {
+	case MessageCascade:
+		return "MessageCascade"
+	case BranchCascade:
+		return "BranchCascade"
+	default:
+		return "CascadeType(unknown)"
+	}
+}
+
+// JournalEntry is an entry of the Journal that describes a confirmation cascade.
+type JournalEntry struct {
+	// Index is the position of the JournalEntry in the Journal.
+	Index uint64
+	// Type is the kind of the cascade.
+	Type CascadeType
+	// MessageID is the root of a MessageCascade.
+	MessageID tangle.MessageID
+	// BranchID is the root of a BranchCascade.
+	BranchID ledgerstate.BranchID
+	// GradeOfFinality is the GradeOfFinality that is propagated by the cascade.
+	GradeOfFinality gof.GradeOfFinality
+	// StartTime is the time at which the cascade was started.
+	StartTime time.Time
+	// CompletionTime is the time at which the cascade completed (zero if it did not complete).
+	CompletionTime time.Time
+}
+
+// NewMessageJournalEntry returns a JournalEntry for the propagation of the given GradeOfFinality to the past cone of
+// the given message.
+func NewMessageJournalEntry(messageID tangle.MessageID, gradeOfFinality gof.GradeOfFinality, startTime time.Time) *JournalEntry {
+	return &JournalEntry{
+		Type:            MessageCascade,
+		MessageID:       messageID,
+		GradeOfFinality: gradeOfFinality,
+		StartTime:       startTime,
+	}
+}
+
+// NewBranchJournalEntry returns a JournalEntry for the propagation of the given GradeOfFinality to the transactions
+// and outputs of the given branch.
+func NewBranchJournalEntry(branchID ledgerstate.BranchID, gradeOfFinality gof.GradeOfFinality, startTime time.Time) *JournalEntry {
+	return &JournalEntry{
+		Type:            BranchCascade,
+		BranchID:        branchID,
+		GradeOfFinality: gradeOfFinality,
+		StartTime:       startTime,
+	}
+}
+
+// journalEntryFromBytes unmarshals a JournalEntry from its storage key and value.
+func journalEntryFromBytes(key, value []byte) (entry *JournalEntry, err error) {
+	entry = &JournalEntry{
+		Index: binary.BigEndian.Uint64(key),
+	}
+
+	marshalUtil := marshalutil.New(value)
+	cascadeType, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse type of journal entry %d: %w", entry.Index, err)
+	}
+	switch entry.Type = CascadeType(cascadeType); entry.Type {
+	case MessageCascade:
+		if entry.MessageID, err = tangle.ReferenceFromMarshalUtil(marshalUtil); err != nil {
+			return nil, errors.Errorf("failed to parse message id of journal entry %d: %w", entry.Index, err)
+		}
+	case BranchCascade:
+		if entry.BranchID, err = ledgerstate.BranchIDFromMarshalUtil(marshalUtil); err != nil {
+			return nil, errors.Errorf("failed to parse branch id of journal entry %d: %w", entry.Index, err)
+		}
+	default:
+		return nil, errors.Errorf("unsupported type of journal entry %d: %s", entry.Index, entry.Type)
+	}
+
+	gradeOfFinality, err := marshalUtil.ReadUint8()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse grade of finality of journal entry %d: %w", entry.Index, err)
+	}
+	entry.GradeOfFinality = gof.GradeOfFinality(gradeOfFinality)
+
+	if entry.StartTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse start time of journal entry %d: %w", entry.Index, err)
+	}
+	if entry.CompletionTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse completion time of journal entry %d: %w", entry.Index, err)
+	}
+
+	return entry, nil
+}
+
+// Completed returns true if the cascade of the JournalEntry completed before the node stopped.
+func (j *JournalEntry) Completed() bool {
+	return !j.CompletionTime.IsZero()
+}
+
+// Bytes returns a marshaled version of the JournalEntry (without its Index, which is part of its storage key).
+func (j *JournalEntry) Bytes() []byte {
+	marshalUtil := marshalutil.New().WriteUint8(uint8(j.Type))
+	switch j.Type {
+	case MessageCascade:
+		marshalUtil.Write(j.MessageID)
+	case BranchCascade:
+		marshalUtil.Write(j.BranchID)
+	}
+
+	return marshalUtil.
+		WriteUint8(uint8(j.GradeOfFinality)).
+		WriteTime(j.StartTime).
+		WriteTime(j.CompletionTime).
+		Bytes()
+}
+
+// key returns the storage key of the JournalEntry.
+func (j *JournalEntry) key() []byte {
+	key := make([]byte, marshalutil.Uint64Size)
+	binary.BigEndian.PutUint64(key, j.Index)
+
+	return key
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region keys /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// PrefixConfirmationJournal defines the storage prefix of the Journal (the sub-prefix 0 of the consensus packages is
+	// used by the otv.DecisionLog).
+	PrefixConfirmationJournal byte = iota + 1
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
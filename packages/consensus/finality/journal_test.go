@@ -0,0 +1,116 @@
+package finality
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestJournal(t *testing.T) {
+	store := mapdb.NewMapDB()
+	journal, err := NewJournal(store, time.Minute)
+	require.NoError(t, err)
+
+	startTime := time.Now()
+	messageEntry := NewMessageJournalEntry(tangle.EmptyMessageID, gof.High, startTime)
+	branchEntry := NewBranchJournalEntry(ledgerstate.MasterBranchID, gof.Medium, startTime.Add(time.Second))
+	require.NoError(t, journal.Begin(messageEntry))
+	require.NoError(t, journal.Begin(branchEntry))
+	require.NoError(t, journal.Complete(messageEntry, startTime.Add(2*time.Second)))
+
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, uint64(0), entries[0].Index)
+	assert.Equal(t, MessageCascade, entries[0].Type)
+	assert.Equal(t, gof.High, entries[0].GradeOfFinality)
+	assert.True(t, entries[0].Completed())
+	assert.Equal(t, uint64(1), entries[1].Index)
+	assert.Equal(t, BranchCascade, entries[1].Type)
+	assert.Equal(t, ledgerstate.MasterBranchID, entries[1].BranchID)
+	assert.True(t, startTime.Add(time.Second).Equal(entries[1].StartTime))
+	assert.False(t, entries[1].Completed())
+
+	require.NoError(t, journal.Complete(branchEntry, startTime.Add(2*time.Minute)))
+
+	// the entries of completed cascades are kept if the commit fails
+	removedEntries, err := journal.Checkpoint(startTime.Add(3*time.Minute), func() error { return assert.AnError })
+	assert.ErrorIs(t, err, assert.AnError)
+	assert.Zero(t, removedEntries)
+
+	// the entries of completed cascades are removed after the retention and a successful commit
+	committed := false
+	removedEntries, err = journal.Checkpoint(startTime.Add(2*time.Minute), func() error {
+		committed = true
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, committed)
+	assert.Equal(t, 1, removedEntries)
+	entries, err = journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, uint64(1), entries[0].Index)
+
+	// a restored Journal continues with the next index
+	restoredJournal, err := NewJournal(store, time.Minute)
+	require.NoError(t, err)
+	nextEntry := NewMessageJournalEntry(tangle.EmptyMessageID, gof.Low, startTime)
+	require.NoError(t, restoredJournal.Begin(nextEntry))
+	assert.Equal(t, uint64(2), nextEntry.Index)
+
+	require.NoError(t, restoredJournal.Clear())
+	entries, err = restoredJournal.Entries()
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestSimpleFinalityGadget_ReplayJournal(t *testing.T) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(testTangle, tangle.WithGenesisOutput("G", 3))
+	testTangle.Setup()
+
+	testFramework.CreateMessage("Message0", tangle.WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message1", tangle.WithStrongParents("Message0"))
+	testFramework.CreateMessage("Message2", tangle.WithStrongParents("Message1"), tangle.WithInputs("G"), tangle.WithOutput("A", 3))
+	testFramework.CreateMessage("Message3", tangle.WithStrongParents("Message2"))
+	testFramework.IssueMessages("Message0", "Message1", "Message2", "Message3").WaitMessagesBooked()
+
+	journal, err := NewJournal(mapdb.NewMapDB(), time.Minute)
+	require.NoError(t, err)
+	sfg := NewSimpleFinalityGadget(testTangle, WithGoFTranslation(TestGoFTranslation), WithJournal(journal))
+
+	// Message1 was confirmed by an earlier cascade whose entry was already removed, so the replay stops there
+	startTime := time.Now()
+	testFramework.MessageMetadata("Message1").SetGradeOfFinality(gof.High, startTime.Add(-time.Minute))
+
+	// the cascade of Message3 was interrupted after only the root of its cone was persisted
+	require.NoError(t, journal.Begin(NewMessageJournalEntry(testFramework.Message("Message3").ID(), gof.High, startTime)))
+	testFramework.MessageMetadata("Message3").SetGradeOfFinality(gof.High, startTime)
+
+	replayedEntries, err := sfg.ReplayJournal()
+	require.NoError(t, err)
+	assert.Equal(t, 1, replayedEntries)
+
+	assertMsgsGoFs(t, testFramework, map[gof.GradeOfFinality][]string{
+		gof.High: {"Message1", "Message2", "Message3"},
+		gof.None: {"Message0"},
+	})
+	assertTxsGoFs(t, testFramework, map[gof.GradeOfFinality][]string{
+		gof.High: {"Message2"},
+	})
+
+	entries, err := journal.Entries()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.True(t, entries[0].Completed())
+}
@@ -0,0 +1,354 @@
+package otv
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/paulbellamy/ratecounter"
+
+	"github.com/iotaledger/goshimmer/packages/consensus"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/markers"
+)
+
+// region DecisionLog //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DecisionLog persistently records every flip of the preference of the consensus.Mechanism for a branch together with
+// the weights of the conflicting branches at that time, so that metastability incidents can be analyzed afterwards.
+type DecisionLog struct {
+	// Events contains the events that are triggered by the DecisionLog.
+	Events *DecisionLogEvents
+
+	store       kvstore.KVStore
+	mechanism   consensus.Mechanism
+	weightFunc  consensus.WeightFunc
+	preferences map[ledgerstate.BranchID]bool
+	flips       map[ledgerstate.BranchID]uint64
+	nextIndex   uint64
+	totalFlips  uint64
+	flipCounter *ratecounter.RateCounter
+	mutex       sync.RWMutex
+}
+
+// NewDecisionLog is the constructor of the DecisionLog that checks the preferences of the given consensus.Mechanism.
+func NewDecisionLog(store kvstore.KVStore, mechanism consensus.Mechanism, weightFunc consensus.WeightFunc) (decisionLog *DecisionLog, err error) {
+	decisionLog = &DecisionLog{
+		Events: &DecisionLogEvents{
+			PreferenceFlipped: event.New[*Decision]("DecisionLog.PreferenceFlipped"),
+		},
+		store:       store.WithRealm([]byte{database.PrefixConsensus, PrefixDecisionLog}),
+		mechanism:   mechanism,
+		weightFunc:  weightFunc,
+		preferences: make(map[ledgerstate.BranchID]bool),
+		flips:       make(map[ledgerstate.BranchID]uint64),
+		flipCounter: ratecounter.NewRateCounter(time.Minute),
+	}
+
+	if err = decisionLog.store.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		if index := binary.BigEndian.Uint64(key); index >= decisionLog.nextIndex {
+			decisionLog.nextIndex = index + 1
+		}
+
+		return true
+	}); err != nil {
+		return nil, errors.Errorf("failed to restore the decision log: %w", err)
+	}
+
+	return decisionLog, nil
+}
+
+// Update checks the preferences for the given branches and their conflicting branches and records a Decision for
+// every preference that flipped since the last check. The triggeringMarker is the marker of the message whose votes
+// caused the update (nil if it is unknown).
+func (d *DecisionLog) Update(branchIDs ledgerstate.BranchIDs, triggeringMarker *markers.Marker) (err error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	checkedBranchIDs := ledgerstate.NewBranchIDs()
+	for branchID := range branchIDs {
+		if checkedBranchIDs.Contains(branchID) || branchID == ledgerstate.MasterBranchID {
+			continue
+		}
+
+		_, conflictMembers := d.mechanism.LikedConflictMember(branchID)
+		conflictMembers.Add(branchID)
+		checkedBranchIDs.AddAll(conflictMembers)
+
+		if err = d.updateConflictMembers(conflictMembers, triggeringMarker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Forget stops tracking the preference for the given branch (e.g. because it was resolved).
+func (d *DecisionLog) Forget(branchID ledgerstate.BranchID) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	delete(d.preferences, branchID)
+	delete(d.flips, branchID)
+}
+
+// Decisions returns the recorded Decisions that match the given DecisionFilter ordered by their Index.
+func (d *DecisionLog) Decisions(filter *DecisionFilter) (decisions []*Decision, err error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	decisions = make([]*Decision, 0)
+	if iterateErr := d.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		var decision *Decision
+		if decision, err = decisionFromBytes(key, value); err != nil {
+			return false
+		}
+
+		if filter.matches(decision) {
+			decisions = append(decisions, decision)
+		}
+
+		return true
+	}); iterateErr != nil {
+		return nil, errors.Errorf("failed to iterate the decision log: %w", iterateErr)
+	}
+	if err != nil {
+		return nil, errors.Errorf("failed to parse the decision log: %w", err)
+	}
+
+	// only the most recent Decisions are returned if the result is limited
+	if filter.Limit > 0 && len(decisions) > filter.Limit {
+		decisions = decisions[len(decisions)-filter.Limit:]
+	}
+
+	return decisions, nil
+}
+
+// Statistics returns the statistics about the preference flips since the DecisionLog was started.
+func (d *DecisionLog) Statistics() (statistics *DecisionStatistics) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	statistics = &DecisionStatistics{
+		TotalFlips:      d.totalFlips,
+		FlipsLastMinute: d.flipCounter.Rate(),
+		FlipsPerBranch:  make(map[ledgerstate.BranchID]uint64, len(d.flips)),
+	}
+	for branchID, flips := range d.flips {
+		statistics.FlipsPerBranch[branchID] = flips
+	}
+
+	return statistics
+}
+
+// updateConflictMembers checks the preferences for the given members of a conflict set and records their flips.
+func (d *DecisionLog) updateConflictMembers(conflictMembers ledgerstate.BranchIDs, triggeringMarker *markers.Marker) (err error) {
+	var weights map[ledgerstate.BranchID]float64
+	for branchID := range conflictMembers {
+		newPreference := d.mechanism.BranchLiked(branchID)
+		oldPreference, known := d.preferences[branchID]
+		d.preferences[branchID] = newPreference
+
+		if !known || oldPreference == newPreference {
+			continue
+		}
+
+		if weights == nil {
+			weights = make(map[ledgerstate.BranchID]float64, len(conflictMembers))
+			for conflictMember := range conflictMembers {
+				weights[conflictMember] = d.weightFunc(conflictMember)
+			}
+		}
+
+		if err = d.record(&Decision{
+			BranchID:         branchID,
+			Time:             time.Now(),
+			OldPreference:    oldPreference,
+			NewPreference:    newPreference,
+			Weights:          weights,
+			TriggeringMarker: triggeringMarker,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// record persists the given Decision and updates the statistics.
+func (d *DecisionLog) record(decision *Decision) (err error) {
+	decision.Index = d.nextIndex
+	if err = d.store.Set(decision.key(), decision.Bytes()); err != nil {
+		return errors.Errorf("failed to store decision about branch %s: %w", decision.BranchID, err)
+	}
+
+	d.nextIndex++
+	d.totalFlips++
+	d.flips[decision.BranchID]++
+	d.flipCounter.Incr(1)
+
+	d.Events.PreferenceFlipped.Trigger(decision)
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Decision /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Decision is an entry of the DecisionLog that describes a flip of the preference for a branch.
+type Decision struct {
+	// Index is the position of the Decision in the DecisionLog.
+	Index uint64
+	// BranchID is the branch whose preference flipped.
+	BranchID ledgerstate.BranchID
+	// Time is the time at which the flip was detected.
+	Time time.Time
+	// OldPreference is true if the branch was liked before the flip.
+	OldPreference bool
+	// NewPreference is true if the branch is liked after the flip.
+	NewPreference bool
+	// Weights contains the approval weights of the branch and its conflicting branches at the time of the flip.
+	Weights map[ledgerstate.BranchID]float64
+	// TriggeringMarker is the marker of the message whose votes caused the flip (nil if it is unknown).
+	TriggeringMarker *markers.Marker
+}
+
+// decisionFromBytes unmarshals a Decision from its storage key and value.
+func decisionFromBytes(key, value []byte) (decision *Decision, err error) {
+	decision = &Decision{
+		Index: binary.BigEndian.Uint64(key),
+	}
+
+	marshalUtil := marshalutil.New(value)
+	if decision.BranchID, err = ledgerstate.BranchIDFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse branch id of decision %d: %w", decision.Index, err)
+	}
+	if decision.Time, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse time of decision %d: %w", decision.Index, err)
+	}
+	if decision.OldPreference, err = marshalUtil.ReadBool(); err != nil {
+		return nil, errors.Errorf("failed to parse old preference of decision %d: %w", decision.Index, err)
+	}
+	if decision.NewPreference, err = marshalUtil.ReadBool(); err != nil {
+		return nil, errors.Errorf("failed to parse new preference of decision %d: %w", decision.Index, err)
+	}
+
+	weightsCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse weights count of decision %d: %w", decision.Index, err)
+	}
+	decision.Weights = make(map[ledgerstate.BranchID]float64, weightsCount)
+	for i := uint32(0); i < weightsCount; i++ {
+		branchID, branchIDErr := ledgerstate.BranchIDFromMarshalUtil(marshalUtil)
+		if branchIDErr != nil {
+			return nil, errors.Errorf("failed to parse weight of decision %d: %w", decision.Index, branchIDErr)
+		}
+		if decision.Weights[branchID], err = marshalUtil.ReadFloat64(); err != nil {
+			return nil, errors.Errorf("failed to parse weight of decision %d: %w", decision.Index, err)
+		}
+	}
+
+	hasTriggeringMarker, err := marshalUtil.ReadBool()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse triggering marker flag of decision %d: %w", decision.Index, err)
+	}
+	if hasTriggeringMarker {
+		if decision.TriggeringMarker, err = markers.MarkerFromMarshalUtil(marshalUtil); err != nil {
+			return nil, errors.Errorf("failed to parse triggering marker of decision %d: %w", decision.Index, err)
+		}
+	}
+
+	return decision, nil
+}
+
+// Bytes returns a marshaled version of the Decision (without its Index, which is part of its storage key).
+func (d *Decision) Bytes() []byte {
+	marshalUtil := marshalutil.New().
+		Write(d.BranchID).
+		WriteTime(d.Time).
+		WriteBool(d.OldPreference).
+		WriteBool(d.NewPreference).
+		WriteUint32(uint32(len(d.Weights)))
+	for branchID, weight := range d.Weights {
+		marshalUtil.Write(branchID).WriteFloat64(weight)
+	}
+
+	marshalUtil.WriteBool(d.TriggeringMarker != nil)
+	if d.TriggeringMarker != nil {
+		marshalUtil.Write(d.TriggeringMarker)
+	}
+
+	return marshalUtil.Bytes()
+}
+
+// key returns the storage key of the Decision.
+func (d *Decision) key() []byte {
+	key := make([]byte, marshalutil.Uint64Size)
+	binary.BigEndian.PutUint64(key, d.Index)
+
+	return key
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DecisionFilter ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// DecisionFilter defines which Decisions are returned by a query of the DecisionLog.
+type DecisionFilter struct {
+	// BranchID restricts the Decisions to the given branch (ledgerstate.UndefinedBranchID returns all branches).
+	BranchID ledgerstate.BranchID
+	// Since restricts the Decisions to the ones that happened at or after the given time.
+	Since time.Time
+	// Limit restricts the number of returned Decisions to the most recent ones (0 returns all Decisions).
+	Limit int
+}
+
+// matches returns true if the given Decision matches the DecisionFilter.
+func (f *DecisionFilter) matches(decision *Decision) bool {
+	if f.BranchID != ledgerstate.UndefinedBranchID && f.BranchID != decision.BranchID {
+		return false
+	}
+
+	return !decision.Time.Before(f.Since)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DecisionStatistics ///////////////////////////////////////////////////////////////////////////////////////////
+
+// DecisionStatistics contains statistics about the preference flips that were recorded by the DecisionLog.
+type DecisionStatistics struct {
+	// TotalFlips is the number of preference flips since the DecisionLog was started.
+	TotalFlips uint64
+	// FlipsLastMinute is the number of preference flips during the last minute.
+	FlipsLastMinute int64
+	// FlipsPerBranch contains the number of preference flips of the unresolved branches.
+	FlipsPerBranch map[ledgerstate.BranchID]uint64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DecisionLogEvents ////////////////////////////////////////////////////////////////////////////////////////////
+
+// DecisionLogEvents represents events happening in the DecisionLog.
+type DecisionLogEvents struct {
+	// PreferenceFlipped is triggered when the preference for a branch flipped.
+	PreferenceFlipped *event.Event[*Decision]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region keys /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// PrefixDecisionLog defines the storage prefix of the DecisionLog.
+	PrefixDecisionLog byte = iota
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,96 @@
+package otv
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/markers"
+)
+
+func TestDecisionLog(t *testing.T) {
+	branchA := ledgerstate.BranchIDFromRandomness()
+	branchB := ledgerstate.BranchIDFromRandomness()
+	mechanism := &mockMechanism{
+		liked:     map[ledgerstate.BranchID]bool{branchA: true},
+		conflicts: map[ledgerstate.BranchID]ledgerstate.BranchIDs{branchA: ledgerstate.NewBranchIDs(branchB), branchB: ledgerstate.NewBranchIDs(branchA)},
+	}
+	weights := map[ledgerstate.BranchID]float64{branchA: 0.3, branchB: 0.2}
+
+	store := mapdb.NewMapDB()
+	decisionLog, err := NewDecisionLog(store, mechanism, func(branchID ledgerstate.BranchID) float64 { return weights[branchID] })
+	require.NoError(t, err)
+
+	// the first check only learns the preferences
+	require.NoError(t, decisionLog.Update(ledgerstate.NewBranchIDs(branchA), nil))
+	assert.EqualValues(t, 0, decisionLog.Statistics().TotalFlips)
+
+	// the preference flips from A to B
+	weights[branchB] = 0.4
+	mechanism.liked = map[ledgerstate.BranchID]bool{branchB: true}
+	triggeringMarker := markers.NewMarker(1, 5)
+	require.NoError(t, decisionLog.Update(ledgerstate.NewBranchIDs(branchB), triggeringMarker))
+
+	statistics := decisionLog.Statistics()
+	assert.EqualValues(t, 2, statistics.TotalFlips)
+	assert.EqualValues(t, 2, statistics.FlipsLastMinute)
+	assert.EqualValues(t, 1, statistics.FlipsPerBranch[branchA])
+	assert.EqualValues(t, 1, statistics.FlipsPerBranch[branchB])
+
+	decisions, err := decisionLog.Decisions(&DecisionFilter{BranchID: branchA})
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	assert.True(t, decisions[0].OldPreference)
+	assert.False(t, decisions[0].NewPreference)
+	assert.Equal(t, map[ledgerstate.BranchID]float64{branchA: 0.3, branchB: 0.4}, decisions[0].Weights)
+	assert.Equal(t, triggeringMarker, decisions[0].TriggeringMarker)
+
+	decisionLog.Forget(branchA)
+	decisionLog.Forget(branchB)
+	assert.Empty(t, decisionLog.Statistics().FlipsPerBranch)
+
+	// the decisions survive a restart and new decisions are appended to the existing ones
+	restoredDecisionLog, err := NewDecisionLog(store, mechanism, func(branchID ledgerstate.BranchID) float64 { return weights[branchID] })
+	require.NoError(t, err)
+	require.NoError(t, restoredDecisionLog.Update(ledgerstate.NewBranchIDs(branchA), nil))
+	mechanism.liked = map[ledgerstate.BranchID]bool{branchA: true}
+	require.NoError(t, restoredDecisionLog.Update(ledgerstate.NewBranchIDs(branchA), nil))
+
+	decisions, err = restoredDecisionLog.Decisions(&DecisionFilter{})
+	require.NoError(t, err)
+	require.Len(t, decisions, 4)
+	for i, decision := range decisions {
+		assert.EqualValues(t, i, decision.Index)
+	}
+	assert.Nil(t, decisions[3].TriggeringMarker)
+
+	decisions, err = restoredDecisionLog.Decisions(&DecisionFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, decisions, 1)
+	assert.EqualValues(t, 3, decisions[0].Index)
+}
+
+// mockMechanism is a consensus.Mechanism with a static preference that is used for testing.
+type mockMechanism struct {
+	liked     map[ledgerstate.BranchID]bool
+	conflicts map[ledgerstate.BranchID]ledgerstate.BranchIDs
+}
+
+func (m *mockMechanism) LikedConflictMember(branchID ledgerstate.BranchID) (likedBranchID ledgerstate.BranchID, conflictMembers ledgerstate.BranchIDs) {
+	conflictMembers = ledgerstate.NewBranchIDs()
+	for conflictMember := range m.conflicts[branchID] {
+		conflictMembers.Add(conflictMember)
+		if m.liked[conflictMember] {
+			likedBranchID = conflictMember
+		}
+	}
+
+	return likedBranchID, conflictMembers
+}
+
+func (m *mockMechanism) BranchLiked(branchID ledgerstate.BranchID) (branchLiked bool) {
+	return m.liked[branchID]
+}
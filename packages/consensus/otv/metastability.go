@@ -0,0 +1,148 @@
+package otv
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/byteutils"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region MetastabilityBreaker /////////////////////////////////////////////////////////////////////////////////////////
+
+// MetastabilityBreaker breaks the ties of conflicts whose branches keep hovering around the same approval weight and
+// would otherwise stall their dependent transactions indefinitely. Once such a conflict outlived the timeout, the
+// heaviest branches are ordered by a random score that is derived from a shared source of randomness (e.g. the dRNG),
+// so that all nodes synchronously like the same randomly selected reality and its weight can escape the tie.
+//
+// The score of a branch is derived from the randomness of the first round that was issued after its conflict time plus
+// the timeout. It therefore does not depend on the round that a node is currently at, and it never changes once it was
+// determined. If that round can not be determined anymore, the score is derived from the BranchID alone, so that all
+// nodes that lack the round still break the tie in the same way. The scores are kept until their branches are
+// forgotten (e.g. because their conflict was resolved).
+type MetastabilityBreaker struct {
+	randomnessFunc   RandomnessFunc
+	conflictTimeFunc ConflictTimeFunc
+	clock            clock.Clock
+	timeout          time.Duration
+	margin           float64
+	scores           map[ledgerstate.BranchID][]byte
+	scoresMutex      sync.Mutex
+}
+
+// NewMetastabilityBreaker returns a MetastabilityBreaker that breaks the ties between the branches whose approval weight
+// is within the given margin of the heaviest branch once their conflict is older than the given timeout.
+func NewMetastabilityBreaker(randomnessFunc RandomnessFunc, conflictTimeFunc ConflictTimeFunc, clock clock.Clock, timeout time.Duration, margin float64) *MetastabilityBreaker {
+	return &MetastabilityBreaker{
+		randomnessFunc:   randomnessFunc,
+		conflictTimeFunc: conflictTimeFunc,
+		clock:            clock,
+		timeout:          timeout,
+		margin:           margin,
+		scores:           make(map[ledgerstate.BranchID][]byte),
+	}
+}
+
+// Reorder reorders the given branches, which are sorted by their descending weight, by their random score if the
+// heaviest of them are tied and their conflict outlived the timeout. It returns true if the order was changed.
+func (m *MetastabilityBreaker) Reorder(orderedBranchIDs []ledgerstate.BranchID, weights map[ledgerstate.BranchID]float64) (reordered bool) {
+	if len(orderedBranchIDs) < 2 {
+		return false
+	}
+
+	tiedBranchesCount := 1
+	for _, branchID := range orderedBranchIDs[1:] {
+		if weights[orderedBranchIDs[0]]-weights[branchID] > m.margin {
+			break
+		}
+		tiedBranchesCount++
+	}
+	if tiedBranchesCount < 2 || !m.timedOut(orderedBranchIDs[:tiedBranchesCount]) {
+		return false
+	}
+
+	tiedBranchIDs := orderedBranchIDs[:tiedBranchesCount]
+	scores := make(map[ledgerstate.BranchID][]byte, tiedBranchesCount)
+	for _, branchID := range tiedBranchIDs {
+		score, exists := m.score(branchID)
+		if !exists {
+			return false
+		}
+		scores[branchID] = score
+	}
+
+	heaviestBranchID := tiedBranchIDs[0]
+	sort.Slice(tiedBranchIDs, func(i, j int) bool {
+		return bytes.Compare(scores[tiedBranchIDs[i]], scores[tiedBranchIDs[j]]) > 0
+	})
+
+	return tiedBranchIDs[0] != heaviestBranchID
+}
+
+// Forget removes the score of the given branch. It is called once the conflict of the branch was resolved.
+func (m *MetastabilityBreaker) Forget(branchID ledgerstate.BranchID) {
+	m.scoresMutex.Lock()
+	defer m.scoresMutex.Unlock()
+
+	delete(m.scores, branchID)
+}
+
+// score returns the random score of the given branch, which is derived from the randomness of the first round after its
+// conflict time plus the timeout and is fixed once it was determined.
+func (m *MetastabilityBreaker) score(branchID ledgerstate.BranchID) (score []byte, exists bool) {
+	m.scoresMutex.Lock()
+	defer m.scoresMutex.Unlock()
+
+	if score, exists = m.scores[branchID]; exists {
+		return score, true
+	}
+
+	conflictTime, exists := m.conflictTimeFunc(branchID)
+	if !exists {
+		return nil, false
+	}
+	randomness, exists := m.randomnessFunc(conflictTime.Add(m.timeout))
+	if !exists {
+		return nil, false
+	}
+
+	hash := blake2b.Sum256(byteutils.ConcatBytes(randomness, branchID.Bytes()))
+	m.scores[branchID] = hash[:]
+
+	return hash[:], true
+}
+
+// timedOut returns true if the youngest of the given conflicting branches is older than the timeout.
+func (m *MetastabilityBreaker) timedOut(branchIDs []ledgerstate.BranchID) bool {
+	for _, branchID := range branchIDs {
+		conflictTime, exists := m.conflictTimeFunc(branchID)
+		if !exists || m.clock.Since(conflictTime) < m.timeout {
+			return false
+		}
+	}
+
+	return true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RandomnessFunc ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// RandomnessFunc returns the randomness that is shared by all nodes of the first round that was issued at or after the
+// given time (exists is false if that round is not known yet). The randomness is empty if the round can not be
+// determined anymore, which makes the MetastabilityBreaker fall back to scores that only depend on the BranchIDs.
+type RandomnessFunc func(after time.Time) (randomness []byte, exists bool)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ConflictTimeFunc /////////////////////////////////////////////////////////////////////////////////////////////
+
+// ConflictTimeFunc returns the time at which the conflicting transaction of the given branch was issued.
+type ConflictTimeFunc func(branchID ledgerstate.BranchID) (conflictTime time.Time, exists bool)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
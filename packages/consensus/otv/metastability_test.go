@@ -0,0 +1,128 @@
+package otv
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/database"
+	. "github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestMetastabilityBreaker_Reorder(t *testing.T) {
+	branchA := BranchIDFromRandomness()
+	branchB := BranchIDFromRandomness()
+	branchC := BranchIDFromRandomness()
+	weights := map[BranchID]float64{branchA: 0.45, branchB: 0.44, branchC: 0.1}
+	randomness := []byte("randomness")
+
+	conflictTime := time.Now()
+	virtualClock := clock.NewVirtualClock(conflictTime)
+	var requestedRounds []time.Time
+	breaker := NewMetastabilityBreaker(func(after time.Time) ([]byte, bool) {
+		requestedRounds = append(requestedRounds, after)
+		return randomness, true
+	}, func(BranchID) (time.Time, bool) {
+		return conflictTime, true
+	}, virtualClock, 30*time.Second, 0.05)
+
+	// the ties of young conflicts are not broken
+	orderedBranchIDs := []BranchID{branchA, branchB, branchC}
+	assert.False(t, breaker.Reorder(orderedBranchIDs, weights))
+	assert.Equal(t, []BranchID{branchA, branchB, branchC}, orderedBranchIDs)
+
+	// the light branch is never selected
+	virtualClock.Advance(time.Minute)
+	breaker.Reorder(orderedBranchIDs, weights)
+	assert.Equal(t, branchC, orderedBranchIDs[2])
+	assert.Equal(t, expectedWinner(randomness, branchA, branchB), orderedBranchIDs[0])
+
+	// the randomness of the first round after the timeout of the conflict is used
+	assert.Equal(t, []time.Time{conflictTime.Add(30 * time.Second), conflictTime.Add(30 * time.Second)}, requestedRounds)
+
+	// all nodes with the same randomness select the same branch
+	otherOrderedBranchIDs := []BranchID{branchB, branchA, branchC}
+	breaker.Reorder(otherOrderedBranchIDs, weights)
+	assert.Equal(t, orderedBranchIDs, otherOrderedBranchIDs)
+
+	// the scores are fixed once they were determined
+	winner := orderedBranchIDs[0]
+	randomness = []byte("newer round")
+	otherOrderedBranchIDs = []BranchID{branchB, branchA, branchC}
+	breaker.Reorder(otherOrderedBranchIDs, weights)
+	assert.Equal(t, winner, otherOrderedBranchIDs[0])
+
+	// conflicts with a clear winner are not touched
+	weights[branchB] = 0.3
+	orderedBranchIDs = []BranchID{branchA, branchB, branchC}
+	assert.False(t, breaker.Reorder(orderedBranchIDs, weights))
+	assert.Equal(t, []BranchID{branchA, branchB, branchC}, orderedBranchIDs)
+
+	// the scores of resolved conflicts are forgotten
+	breaker.Forget(branchA)
+	breaker.Forget(branchB)
+	assert.Empty(t, breaker.scores)
+
+	// the scores only depend on the BranchIDs if the round can not be determined anymore
+	randomness = nil
+	weights[branchB] = 0.44
+	orderedBranchIDs = []BranchID{branchA, branchB, branchC}
+	breaker.Reorder(orderedBranchIDs, weights)
+	assert.Equal(t, expectedWinner(nil, branchA, branchB), orderedBranchIDs[0])
+}
+
+func TestOnTangleVoting_MetastabilityBreaker(t *testing.T) {
+	scenario := Scenario{
+		"A": {
+			BranchID:       BranchID{2},
+			ParentBranches: NewBranchIDs(MasterBranchID),
+			Conflicting:    NewConflictIDs(ConflictID{1}),
+			ApprovalWeight: 0.5,
+		},
+		"B": {
+			BranchID:       BranchID{3},
+			ParentBranches: NewBranchIDs(MasterBranchID),
+			Conflicting:    NewConflictIDs(ConflictID{1}),
+			ApprovalWeight: 0.48,
+		},
+	}
+
+	ls := New(CacheTimeProvider(database.NewCacheTimeProvider(0)))
+	defer ls.Shutdown()
+	scenario.CreateBranches(t, ls.BranchDAG)
+
+	for _, randomness := range [][]byte{{1}, {2}, {3}, {4}} {
+		o := NewOnTangleVoting(ls.BranchDAG, WeightFuncFromScenario(t, scenario), WithMetastabilityBreaker(NewMetastabilityBreaker(func(time.Time) ([]byte, bool) {
+			return randomness, true
+		}, func(BranchID) (time.Time, bool) {
+			return time.Now().Add(-time.Hour), true
+		}, clock.SyncedClock{}, time.Minute, 0.05)))
+
+		winner := expectedWinner(randomness, scenario.BranchID("A"), scenario.BranchID("B"))
+		require.True(t, o.BranchLiked(winner))
+		for _, branchID := range []BranchID{scenario.BranchID("A"), scenario.BranchID("B")} {
+			if branchID != winner {
+				require.False(t, o.BranchLiked(branchID))
+			}
+		}
+	}
+}
+
+// expectedWinner returns the branch with the highest random score for the given randomness.
+func expectedWinner(randomness []byte, branchIDs ...BranchID) (winner BranchID) {
+	var winnerScore []byte
+	for _, branchID := range branchIDs {
+		score := blake2b.Sum256(byteutils.ConcatBytes(randomness, branchID.Bytes()))
+		if winnerScore == nil || bytes.Compare(score[:], winnerScore) > 0 {
+			winner, winnerScore = branchID, score[:]
+		}
+	}
+
+	return winner
+}
@@ -15,16 +15,24 @@ import (
 // Nakamoto consensus for the parallel-reality-based ledger state where the heaviest branch according to approval weight
 // is liked by any given node.
 type OnTangleVoting struct {
-	branchDAG  *ledgerstate.BranchDAG
-	weightFunc consensus.WeightFunc
+	branchDAG            *ledgerstate.BranchDAG
+	weightFunc           consensus.WeightFunc
+	metastabilityBreaker *MetastabilityBreaker
+	preferenceOverrides  *PreferenceOverrides
 }
 
 // NewOnTangleVoting is the constructor for OnTangleVoting.
-func NewOnTangleVoting(branchDAG *ledgerstate.BranchDAG, weightFunc consensus.WeightFunc) *OnTangleVoting {
-	return &OnTangleVoting{
+func NewOnTangleVoting(branchDAG *ledgerstate.BranchDAG, weightFunc consensus.WeightFunc, opts ...OnTangleVotingOption) *OnTangleVoting {
+	onTangleVoting := &OnTangleVoting{
 		branchDAG:  branchDAG,
 		weightFunc: weightFunc,
 	}
+
+	for _, opt := range opts {
+		opt(onTangleVoting)
+	}
+
+	return onTangleVoting
 }
 
 // LikedConflictMember returns the liked BranchID across the members of its conflict sets.
@@ -127,7 +135,39 @@ func (o *OnTangleVoting) forEachConnectedConflictingBranchInDescendingOrder(bran
 		return !(branchWeights[branchI] < branchWeights[branchJ] || (branchWeights[branchI] == branchWeights[branchJ] && bytes.Compare(branchI.Bytes(), branchJ.Bytes()) > 0))
 	})
 
+	if o.metastabilityBreaker != nil {
+		o.metastabilityBreaker.Reorder(branchesOrderedByWeight, branchWeights)
+	}
+
+	if o.preferenceOverrides != nil {
+		o.preferenceOverrides.Reorder(branchesOrderedByWeight)
+	}
+
 	for _, orderedBranchID := range branchesOrderedByWeight {
 		callback(orderedBranchID, branchWeights[orderedBranchID])
 	}
 }
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// OnTangleVotingOption represents the return type of optional parameters that can be handed into the constructor of
+// the OnTangleVoting to configure its behavior.
+type OnTangleVotingOption func(onTangleVoting *OnTangleVoting)
+
+// WithMetastabilityBreaker is an OnTangleVotingOption for the OnTangleVoting that enables the given
+// MetastabilityBreaker to break the ties of long-unresolved conflicts.
+func WithMetastabilityBreaker(metastabilityBreaker *MetastabilityBreaker) OnTangleVotingOption {
+	return func(onTangleVoting *OnTangleVoting) {
+		onTangleVoting.metastabilityBreaker = metastabilityBreaker
+	}
+}
+
+// WithPreferenceOverrides is an OnTangleVotingOption for the OnTangleVoting that makes the manually configured
+// PreferenceOverrides take precedence over the approval weight of the branches.
+func WithPreferenceOverrides(preferenceOverrides *PreferenceOverrides) OnTangleVotingOption {
+	return func(onTangleVoting *OnTangleVoting) {
+		onTangleVoting.preferenceOverrides = preferenceOverrides
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
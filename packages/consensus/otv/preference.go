@@ -0,0 +1,147 @@
+package otv
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region Preference ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Preference is the manually configured opinion on a branch that overrides the opinion derived from the approval weight.
+type Preference uint8
+
+const (
+	// Like forces a branch to be liked over its conflicting branches.
+	Like Preference = iota + 1
+
+	// Dislike forces a branch to be disliked in favor of its conflicting branches.
+	Dislike
+)
+
+// PreferenceFromString returns the Preference with the given name.
+func PreferenceFromString(name string) (preference Preference, err error) {
+	switch name {
+	case "like":
+		return Like, nil
+	case "dislike":
+		return Dislike, nil
+	default:
+		return 0, errors.Errorf("unknown preference %s (must be like or dislike)", name)
+	}
+}
+
+// String returns a human-readable version of the Preference.
+func (p Preference) String() string {
+	switch p {
+	case Like:
+		return "like"
+	case Dislike:
+		return "dislike"
+	default:
+		return "unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PreferenceOverrides //////////////////////////////////////////////////////////////////////////////////////////
+
+// PreferenceOverrides holds the manually configured preferences of branches, which take precedence over the approval
+// weight when the OnTangleVoting decides which of the conflicting branches is liked. A liked branch wins against all of
+// its conflicting branches that are not liked as well (the heavier one wins among liked branches), and a disliked
+// branch loses against all of its conflicting branches. This allows tests to force the resolution of conflicts
+// deterministically and must never be used in a production network.
+type PreferenceOverrides struct {
+	preferences map[ledgerstate.BranchID]Preference
+	mutex       sync.RWMutex
+}
+
+// NewPreferenceOverrides returns an empty set of PreferenceOverrides.
+func NewPreferenceOverrides() *PreferenceOverrides {
+	return &PreferenceOverrides{
+		preferences: make(map[ledgerstate.BranchID]Preference),
+	}
+}
+
+// Set overrides the preference of the given branch.
+func (p *PreferenceOverrides) Set(branchID ledgerstate.BranchID, preference Preference) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.preferences[branchID] = preference
+}
+
+// Remove removes the override of the preference of the given branch. It returns false if there was none.
+func (p *PreferenceOverrides) Remove(branchID ledgerstate.BranchID) (removed bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if _, removed = p.preferences[branchID]; removed {
+		delete(p.preferences, branchID)
+	}
+
+	return removed
+}
+
+// Get returns the overridden preference of the given branch.
+func (p *PreferenceOverrides) Get(branchID ledgerstate.BranchID) (preference Preference, exists bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	preference, exists = p.preferences[branchID]
+
+	return preference, exists
+}
+
+// All returns a copy of all overridden preferences.
+func (p *PreferenceOverrides) All() (preferences map[ledgerstate.BranchID]Preference) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	preferences = make(map[ledgerstate.BranchID]Preference, len(p.preferences))
+	for branchID, preference := range p.preferences {
+		preferences[branchID] = preference
+	}
+
+	return preferences
+}
+
+// Reorder moves the liked branches to the front and the disliked branches to the back of the given branches, which are
+// sorted by their descending weight, while keeping the order within each group. It returns true if the order was
+// changed.
+func (p *PreferenceOverrides) Reorder(orderedBranchIDs []ledgerstate.BranchID) (reordered bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if len(p.preferences) == 0 {
+		return false
+	}
+
+	rank := func(branchID ledgerstate.BranchID) int {
+		switch p.preferences[branchID] {
+		case Like:
+			return 0
+		case Dislike:
+			return 2
+		default:
+			return 1
+		}
+	}
+
+	reordered = !sort.SliceIsSorted(orderedBranchIDs, func(i, j int) bool {
+		return rank(orderedBranchIDs[i]) < rank(orderedBranchIDs[j])
+	})
+	if reordered {
+		sort.SliceStable(orderedBranchIDs, func(i, j int) bool {
+			return rank(orderedBranchIDs[i]) < rank(orderedBranchIDs[j])
+		})
+	}
+
+	return reordered
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
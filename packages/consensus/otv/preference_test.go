@@ -0,0 +1,100 @@
+package otv
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	. "github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestPreferenceOverrides_Reorder(t *testing.T) {
+	branchA := BranchIDFromRandomness()
+	branchB := BranchIDFromRandomness()
+	branchC := BranchIDFromRandomness()
+	overrides := NewPreferenceOverrides()
+
+	// without overrides the order is not touched
+	orderedBranchIDs := []BranchID{branchA, branchB, branchC}
+	assert.False(t, overrides.Reorder(orderedBranchIDs))
+	assert.Equal(t, []BranchID{branchA, branchB, branchC}, orderedBranchIDs)
+
+	overrides.Set(branchA, Dislike)
+	overrides.Set(branchC, Like)
+	assert.True(t, overrides.Reorder(orderedBranchIDs))
+	assert.Equal(t, []BranchID{branchC, branchB, branchA}, orderedBranchIDs)
+	assert.False(t, overrides.Reorder(orderedBranchIDs))
+
+	preference, exists := overrides.Get(branchC)
+	assert.True(t, exists)
+	assert.Equal(t, Like, preference)
+	assert.Equal(t, map[BranchID]Preference{branchA: Dislike, branchC: Like}, overrides.All())
+
+	assert.True(t, overrides.Remove(branchC))
+	assert.False(t, overrides.Remove(branchC))
+	_, exists = overrides.Get(branchC)
+	assert.False(t, exists)
+}
+
+func TestPreferenceFromString(t *testing.T) {
+	for _, preference := range []Preference{Like, Dislike} {
+		parsed, err := PreferenceFromString(preference.String())
+		require.NoError(t, err)
+		assert.Equal(t, preference, parsed)
+	}
+
+	_, err := PreferenceFromString("indifferent")
+	assert.Error(t, err)
+}
+
+func TestOnTangleVoting_PreferenceOverrides(t *testing.T) {
+	scenario := Scenario{
+		"A": {
+			BranchID:       BranchID{2},
+			ParentBranches: NewBranchIDs(MasterBranchID),
+			Conflicting:    NewConflictIDs(ConflictID{1}),
+			ApprovalWeight: 0.6,
+		},
+		"B": {
+			BranchID:       BranchID{3},
+			ParentBranches: NewBranchIDs(MasterBranchID),
+			Conflicting:    NewConflictIDs(ConflictID{1}),
+			ApprovalWeight: 0.3,
+		},
+		"C": {
+			BranchID:       BranchID{4},
+			ParentBranches: NewBranchIDs(MasterBranchID),
+			Conflicting:    NewConflictIDs(ConflictID{1}),
+			ApprovalWeight: 0.1,
+		},
+	}
+
+	ls := New(CacheTimeProvider(database.NewCacheTimeProvider(0)))
+	defer ls.Shutdown()
+	scenario.CreateBranches(t, ls.BranchDAG)
+
+	overrides := NewPreferenceOverrides()
+	o := NewOnTangleVoting(ls.BranchDAG, WeightFuncFromScenario(t, scenario), WithPreferenceOverrides(overrides))
+	require.True(t, o.BranchLiked(scenario.BranchID("A")))
+
+	// the lightest branch is liked if it is forced to be liked
+	overrides.Set(scenario.BranchID("C"), Like)
+	require.True(t, o.BranchLiked(scenario.BranchID("C")))
+	require.False(t, o.BranchLiked(scenario.BranchID("A")))
+	require.False(t, o.BranchLiked(scenario.BranchID("B")))
+
+	// the heaviest branch loses if it is forced to be disliked
+	overrides.Remove(scenario.BranchID("C"))
+	overrides.Set(scenario.BranchID("A"), Dislike)
+	require.True(t, o.BranchLiked(scenario.BranchID("B")))
+	require.False(t, o.BranchLiked(scenario.BranchID("A")))
+
+	likedBranchID, _ := o.LikedConflictMember(scenario.BranchID("A"))
+	require.Equal(t, scenario.BranchID("B"), likedBranchID)
+
+	// the approval weight decides again once the override is removed
+	overrides.Remove(scenario.BranchID("A"))
+	require.True(t, o.BranchLiked(scenario.BranchID("A")))
+}
@@ -0,0 +1,293 @@
+package statementlog
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region StatementLog /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// StatementLog persistently records the statements of the node, i.e. the branches that the messages issued by the node
+// vote for. The statements form a hash chain, so that an export signed by the node commits to its whole voting history
+// and can be verified by external auditors.
+type StatementLog struct {
+	store         kvstore.KVStore
+	localIdentity *identity.LocalIdentity
+	nextIndex     uint64
+	lastHash      Hash
+	mutex         sync.RWMutex
+}
+
+// New is the constructor of the StatementLog that signs its exports with the given identity.
+func New(store kvstore.KVStore, localIdentity *identity.LocalIdentity) (statementLog *StatementLog, err error) {
+	statementLog = &StatementLog{
+		store:         store.WithRealm([]byte{database.PrefixStatementLog}),
+		localIdentity: localIdentity,
+	}
+
+	found := false
+	if err = statementLog.store.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		if index := binary.BigEndian.Uint64(key); !found || index >= statementLog.nextIndex {
+			statementLog.nextIndex = index + 1
+			found = true
+		}
+
+		return true
+	}); err != nil {
+		return nil, errors.Errorf("failed to restore the statement log: %w", err)
+	}
+
+	if found {
+		lastStatement, loadErr := statementLog.statement(statementLog.nextIndex - 1)
+		if loadErr != nil {
+			return nil, errors.Errorf("failed to restore the statement log: %w", loadErr)
+		}
+		statementLog.lastHash = lastStatement.Hash()
+	}
+
+	return statementLog, nil
+}
+
+// Record appends a Statement about the given message of the node, that votes for the given branches, to the log.
+func (s *StatementLog) Record(message *tangle.Message, branchIDs ledgerstate.BranchIDs) (statement *Statement, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	statement = &Statement{
+		Index:          s.nextIndex,
+		MessageID:      message.ID(),
+		IssuingTime:    message.IssuingTime(),
+		SequenceNumber: message.SequenceNumber(),
+		BranchIDs:      branchIDs.Clone(),
+		RecordedTime:   time.Now(),
+		PreviousHash:   s.lastHash,
+	}
+	if err = s.store.Set(statement.key(), statement.Bytes()); err != nil {
+		return nil, errors.Errorf("failed to store statement about message %s: %w", statement.MessageID, err)
+	}
+
+	s.nextIndex++
+	s.lastHash = statement.Hash()
+
+	return statement, nil
+}
+
+// Export returns up to limit Statements starting at the given index (0 returns all Statements) together with the
+// signature of the node.
+func (s *StatementLog) Export(startIndex uint64, limit int) (export *Export, err error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	export = &Export{
+		IssuerPublicKey: s.localIdentity.PublicKey(),
+		Statements:      make([]*Statement, 0),
+	}
+	for index := startIndex; index < s.nextIndex && (limit <= 0 || len(export.Statements) < limit); index++ {
+		statement, statementErr := s.statement(index)
+		if statementErr != nil {
+			return nil, statementErr
+		}
+		export.Statements = append(export.Statements, statement)
+	}
+
+	if len(export.Statements) != 0 {
+		lastHash := export.Statements[len(export.Statements)-1].Hash()
+		export.Signature = s.localIdentity.Sign(lastHash[:])
+	}
+
+	return export, nil
+}
+
+// Size returns the number of recorded Statements.
+func (s *StatementLog) Size() uint64 {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.nextIndex
+}
+
+// statement loads the Statement with the given index.
+func (s *StatementLog) statement(index uint64) (statement *Statement, err error) {
+	value, err := s.store.Get(indexKey(index))
+	if err != nil {
+		return nil, errors.Errorf("failed to load statement %d: %w", index, err)
+	}
+
+	if statement, err = StatementFromBytes(value); err != nil {
+		return nil, errors.Errorf("failed to parse statement %d: %w", index, err)
+	}
+
+	return statement, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Statement ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Statement is an entry of the StatementLog that describes the branches that a message of the node votes for.
+type Statement struct {
+	// Index is the position of the Statement in the StatementLog.
+	Index uint64
+	// MessageID is the message of the node that contains the votes.
+	MessageID tangle.MessageID
+	// IssuingTime is the issuing time of the message.
+	IssuingTime time.Time
+	// SequenceNumber is the sequence number of the message, which defines the power of its votes.
+	SequenceNumber uint64
+	// BranchIDs contains the branches that the message voted for when its votes were counted.
+	BranchIDs ledgerstate.BranchIDs
+	// RecordedTime is the time at which the votes of the message were counted.
+	RecordedTime time.Time
+	// PreviousHash is the Hash of the previous Statement (empty for the first Statement).
+	PreviousHash Hash
+}
+
+// StatementFromBytes unmarshals a Statement from a sequence of bytes.
+func StatementFromBytes(statementBytes []byte) (statement *Statement, err error) {
+	marshalUtil := marshalutil.New(statementBytes)
+
+	statement = &Statement{}
+	if statement.Index, err = marshalUtil.ReadUint64(); err != nil {
+		return nil, errors.Errorf("failed to parse index: %w", err)
+	}
+	if statement.MessageID, err = tangle.ReferenceFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse message id: %w", err)
+	}
+	if statement.IssuingTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse issuing time: %w", err)
+	}
+	if statement.SequenceNumber, err = marshalUtil.ReadUint64(); err != nil {
+		return nil, errors.Errorf("failed to parse sequence number: %w", err)
+	}
+	if statement.RecordedTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse recorded time: %w", err)
+	}
+
+	branchIDsCount, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return nil, errors.Errorf("failed to parse branch ids count: %w", err)
+	}
+	statement.BranchIDs = ledgerstate.NewBranchIDs()
+	for i := uint32(0); i < branchIDsCount; i++ {
+		branchID, branchIDErr := ledgerstate.BranchIDFromMarshalUtil(marshalUtil)
+		if branchIDErr != nil {
+			return nil, errors.Errorf("failed to parse branch id: %w", branchIDErr)
+		}
+		statement.BranchIDs.Add(branchID)
+	}
+
+	previousHashBytes, err := marshalUtil.ReadBytes(HashLength)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse previous hash: %w", err)
+	}
+	copy(statement.PreviousHash[:], previousHashBytes)
+
+	return statement, nil
+}
+
+// Bytes returns a marshaled version of the Statement. The branches are sorted, so that the Hash of a Statement does not
+// depend on the order of its branches.
+func (s *Statement) Bytes() []byte {
+	branchIDs := make([]ledgerstate.BranchID, 0, len(s.BranchIDs))
+	for branchID := range s.BranchIDs {
+		branchIDs = append(branchIDs, branchID)
+	}
+	sort.Slice(branchIDs, func(i, j int) bool {
+		return bytes.Compare(branchIDs[i].Bytes(), branchIDs[j].Bytes()) < 0
+	})
+
+	marshalUtil := marshalutil.New().
+		WriteUint64(s.Index).
+		Write(s.MessageID).
+		WriteTime(s.IssuingTime).
+		WriteUint64(s.SequenceNumber).
+		WriteTime(s.RecordedTime).
+		WriteUint32(uint32(len(branchIDs)))
+	for _, branchID := range branchIDs {
+		marshalUtil.Write(branchID)
+	}
+
+	return marshalUtil.WriteBytes(s.PreviousHash[:]).Bytes()
+}
+
+// Hash returns the Hash of the Statement that is referenced by the next Statement.
+func (s *Statement) Hash() Hash {
+	return blake2b.Sum256(s.Bytes())
+}
+
+// key returns the storage key of the Statement.
+func (s *Statement) key() []byte {
+	return indexKey(s.Index)
+}
+
+// indexKey returns the storage key of the Statement with the given index.
+func indexKey(index uint64) []byte {
+	key := make([]byte, marshalutil.Uint64Size)
+	binary.BigEndian.PutUint64(key, index)
+
+	return key
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Hash /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// HashLength defines the length of the Hash of a Statement.
+const HashLength = blake2b.Size256
+
+// Hash is the hash of a Statement.
+type Hash [HashLength]byte
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Export ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Export is a consecutive range of Statements that is signed by the node that recorded them.
+type Export struct {
+	// IssuerPublicKey is the public key of the node.
+	IssuerPublicKey ed25519.PublicKey
+	// Statements contains the exported Statements ordered by their Index.
+	Statements []*Statement
+	// Signature is the signature of the Hash of the last Statement, which commits to all previous Statements.
+	Signature ed25519.Signature
+}
+
+// Verify returns an error if the Statements of the Export are not consecutive, if they do not form a hash chain or if
+// the signature is invalid.
+func (e *Export) Verify() error {
+	if len(e.Statements) == 0 {
+		return nil
+	}
+
+	for i := 1; i < len(e.Statements); i++ {
+		if e.Statements[i].Index != e.Statements[i-1].Index+1 {
+			return errors.Errorf("statement %d does not follow statement %d", e.Statements[i].Index, e.Statements[i-1].Index)
+		}
+		if e.Statements[i].PreviousHash != e.Statements[i-1].Hash() {
+			return errors.Errorf("statement %d does not reference the hash of statement %d", e.Statements[i].Index, e.Statements[i-1].Index)
+		}
+	}
+
+	lastHash := e.Statements[len(e.Statements)-1].Hash()
+	if !e.IssuerPublicKey.VerifySignature(lastHash[:], e.Signature) {
+		return errors.New("invalid signature of the statements")
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
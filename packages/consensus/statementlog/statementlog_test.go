@@ -0,0 +1,65 @@
+package statementlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestStatementLog(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+	store := mapdb.NewMapDB()
+
+	statementLog, err := New(store, localIdentity)
+	require.NoError(t, err)
+
+	branchA := ledgerstate.BranchIDFromRandomness()
+	branchB := ledgerstate.BranchIDFromRandomness()
+	for i, branchIDs := range []ledgerstate.BranchIDs{ledgerstate.NewBranchIDs(ledgerstate.MasterBranchID), ledgerstate.NewBranchIDs(branchA, branchB), ledgerstate.NewBranchIDs(branchB)} {
+		statement, recordErr := statementLog.Record(newTestMessage(t, localIdentity, uint64(i)), branchIDs)
+		require.NoError(t, recordErr)
+		assert.EqualValues(t, i, statement.Index)
+	}
+
+	export, err := statementLog.Export(0, 0)
+	require.NoError(t, err)
+	require.Len(t, export.Statements, 3)
+	assert.Equal(t, Hash{}, export.Statements[0].PreviousHash)
+	assert.Equal(t, ledgerstate.NewBranchIDs(branchA, branchB), export.Statements[1].BranchIDs)
+	assert.NoError(t, export.Verify())
+
+	// the hash chain and the index are restored after a restart
+	restoredStatementLog, err := New(store, localIdentity)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, restoredStatementLog.Size())
+	statement, err := restoredStatementLog.Record(newTestMessage(t, localIdentity, 3), ledgerstate.NewBranchIDs(branchA))
+	require.NoError(t, err)
+	assert.Equal(t, export.Statements[2].Hash(), statement.PreviousHash)
+
+	// a partial export can be verified on its own
+	partialExport, err := restoredStatementLog.Export(2, 1)
+	require.NoError(t, err)
+	require.Len(t, partialExport.Statements, 1)
+	assert.EqualValues(t, 2, partialExport.Statements[0].Index)
+	assert.NoError(t, partialExport.Verify())
+
+	// tampered statements are detected
+	export.Statements[1].BranchIDs = ledgerstate.NewBranchIDs(branchA)
+	assert.Error(t, export.Verify())
+}
+
+func newTestMessage(t *testing.T, localIdentity *identity.LocalIdentity, sequenceNumber uint64) *tangle.Message {
+	message, err := tangle.NewMessage(tangle.NewParentMessageIDs().AddStrong(tangle.EmptyMessageID), time.Now(), localIdentity.PublicKey(), sequenceNumber, payload.NewGenericDataPayload([]byte("test")), 0, ed25519.Signature{})
+	require.NoError(t, err)
+
+	return message
+}
@@ -0,0 +1,315 @@
+package database
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+// region GroupCommitStore /////////////////////////////////////////////////////////////////////////////////////////////
+
+// GroupCommitStore is a KVStore that commits the batched mutations of all of its realms together. Instead of writing
+// every batch on its own, the batches that are committed during a flush interval are combined into a single write to
+// the underlying store, so that bursts of metadata updates of the different object storages (e.g. during booking or
+// confirmation cascades) result in a single write per flush interval.
+//
+// Commit blocks until the combined write was executed, so that a committed mutation is visible to all readers once
+// Commit returns. If the pending mutations reach the configured limit, they are written before the end of the flush
+// interval. Writes that are not batched are passed through to the underlying store.
+type GroupCommitStore struct {
+	kvstore.KVStore
+
+	committer *groupCommitter
+}
+
+// NewGroupCommitStore creates a new GroupCommitStore that combines the batched mutations of the given store into a
+// single write every flushInterval, or as soon as maxPendingMutations mutations are pending (0 disables the
+// limit).
+func NewGroupCommitStore(store kvstore.KVStore, flushInterval time.Duration, maxPendingMutations int) *GroupCommitStore {
+	return &GroupCommitStore{
+		KVStore:   store,
+		committer: newGroupCommitter(store.WithRealm(kvstore.EmptyPrefix), flushInterval, maxPendingMutations),
+	}
+}
+
+// WithRealm returns a GroupCommitStore for the given realm that shares the group commits with this store.
+func (g *GroupCommitStore) WithRealm(realm kvstore.Realm) kvstore.KVStore {
+	return &GroupCommitStore{
+		KVStore:   g.KVStore.WithRealm(realm),
+		committer: g.committer,
+	}
+}
+
+// Batched returns a BatchedMutations interface whose mutations are committed with the next group commit.
+func (g *GroupCommitStore) Batched() kvstore.BatchedMutations {
+	return &groupedMutations{
+		realm:     g.Realm(),
+		committer: g.committer,
+	}
+}
+
+// Commit writes the pending batched mutations without waiting for the end of the flush interval. Unlike Flush, it does
+// not persist the outstanding write operations to disk.
+func (g *GroupCommitStore) Commit() error {
+	return g.committer.flush()
+}
+
+// Flush commits the pending batched mutations and persists all outstanding write operations to disk.
+func (g *GroupCommitStore) Flush() error {
+	if err := g.committer.flush(); err != nil {
+		return err
+	}
+
+	return g.KVStore.Flush()
+}
+
+// Stop commits the pending batched mutations and stops the group commits. Batched mutations that are committed
+// afterwards are written directly.
+func (g *GroupCommitStore) Stop() {
+	g.committer.stop()
+}
+
+// GroupCommits returns the number of combined writes and the number of batches that were committed by them.
+func (g *GroupCommitStore) GroupCommits() (writes, batches uint64) {
+	return g.committer.stats()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region groupCommitter ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// groupCommitter combines the batches of a store into a single write per flush interval.
+type groupCommitter struct {
+	store               kvstore.KVStore
+	flushInterval       time.Duration
+	maxPendingMutations int
+	pending             []*committedBatch
+	pendingMutations    int
+	writes              uint64
+	batches             uint64
+	stopped             bool
+	mutex               sync.Mutex
+	writeMutex          sync.Mutex
+	flushSignal         chan struct{}
+	shutdown            chan struct{}
+	wg                  sync.WaitGroup
+}
+
+// newGroupCommitter creates a new groupCommitter that writes to the given store (without a realm).
+func newGroupCommitter(store kvstore.KVStore, flushInterval time.Duration, maxPendingMutations int) (committer *groupCommitter) {
+	committer = &groupCommitter{
+		store:               store,
+		flushInterval:       flushInterval,
+		maxPendingMutations: maxPendingMutations,
+		flushSignal:         make(chan struct{}, 1),
+		shutdown:            make(chan struct{}),
+	}
+
+	committer.wg.Add(1)
+	go committer.run()
+
+	return committer
+}
+
+// commit queues the given mutations and waits until they were written. If too many mutations are pending, the
+// periodic group commit is triggered early. Mutations are written directly after the groupCommitter was stopped.
+func (g *groupCommitter) commit(mutations []mutation) error {
+	batch := &committedBatch{
+		mutations: mutations,
+		done:      make(chan error, 1),
+	}
+
+	g.mutex.Lock()
+	if g.stopped {
+		g.mutex.Unlock()
+
+		return g.write([]*committedBatch{batch})
+	}
+	g.pending = append(g.pending, batch)
+	g.pendingMutations += len(mutations)
+	if g.maxPendingMutations > 0 && g.pendingMutations >= g.maxPendingMutations {
+		select {
+		case g.flushSignal <- struct{}{}:
+		default:
+		}
+	}
+	g.mutex.Unlock()
+
+	return <-batch.done
+}
+
+// run writes the pending batches every flush interval until the groupCommitter is stopped.
+func (g *groupCommitter) run() {
+	defer g.wg.Done()
+
+	ticker := time.NewTicker(g.flushInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			_ = g.flush()
+		case <-g.flushSignal:
+			_ = g.flush()
+		case <-g.shutdown:
+			_ = g.flush()
+			return
+		}
+	}
+}
+
+// flush writes the pending batches and notifies their committers about the result.
+func (g *groupCommitter) flush() (err error) {
+	// the pending batches are taken while holding the writeMutex, so that they are written in the order of their commits
+	g.writeMutex.Lock()
+	defer g.writeMutex.Unlock()
+
+	g.mutex.Lock()
+	batches := g.pending
+	g.pending = nil
+	g.pendingMutations = 0
+	g.mutex.Unlock()
+
+	if len(batches) == 0 {
+		return nil
+	}
+
+	err = g.writeBatches(batches)
+	for _, batch := range batches {
+		batch.done <- err
+	}
+
+	return err
+}
+
+// write writes the given batches.
+func (g *groupCommitter) write(batches []*committedBatch) error {
+	g.writeMutex.Lock()
+	defer g.writeMutex.Unlock()
+
+	return g.writeBatches(batches)
+}
+
+// writeBatches combines the given batches into a single write. The mutations are applied in the order in which they
+// were committed, so that the last mutation of a key wins. It expects the writeMutex to be locked.
+func (g *groupCommitter) writeBatches(batches []*committedBatch) error {
+	combinedBatch := g.store.Batched()
+	for _, batch := range batches {
+		for _, m := range batch.mutations {
+			var err error
+			if m.deleted {
+				err = combinedBatch.Delete(m.key)
+			} else {
+				err = combinedBatch.Set(m.key, m.value)
+			}
+			if err != nil {
+				combinedBatch.Cancel()
+				return err
+			}
+		}
+	}
+	if err := combinedBatch.Commit(); err != nil {
+		return err
+	}
+
+	g.mutex.Lock()
+	g.writes++
+	g.batches += uint64(len(batches))
+	g.mutex.Unlock()
+
+	return nil
+}
+
+// stop writes the pending batches and stops the periodic group commits.
+func (g *groupCommitter) stop() {
+	g.mutex.Lock()
+	if g.stopped {
+		g.mutex.Unlock()
+		return
+	}
+	g.stopped = true
+	g.mutex.Unlock()
+
+	close(g.shutdown)
+	g.wg.Wait()
+}
+
+// stats returns the number of combined writes and the number of batches that were committed by them.
+func (g *groupCommitter) stats() (writes, batches uint64) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	return g.writes, g.batches
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region groupedMutations /////////////////////////////////////////////////////////////////////////////////////////////
+
+// groupedMutations collects the mutations of a batch until it is committed by the groupCommitter.
+type groupedMutations struct {
+	realm     kvstore.Realm
+	committer *groupCommitter
+	mutations []mutation
+	mutex     sync.Mutex
+}
+
+// Set sets the given key and value.
+func (g *groupedMutations) Set(key kvstore.Key, value kvstore.Value) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.mutations = append(g.mutations, mutation{key: byteutils.ConcatBytes(g.realm, key), value: value})
+
+	return nil
+}
+
+// Delete deletes the entry for the given key.
+func (g *groupedMutations) Delete(key kvstore.Key) error {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.mutations = append(g.mutations, mutation{key: byteutils.ConcatBytes(g.realm, key), deleted: true})
+
+	return nil
+}
+
+// Cancel cancels the batched mutations.
+func (g *groupedMutations) Cancel() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.mutations = nil
+}
+
+// Commit commits the mutations with the next group commit and waits until they were written. The mutations are taken
+// from the batch before waiting, so that the batch is not locked while the group commit is pending.
+func (g *groupedMutations) Commit() error {
+	g.mutex.Lock()
+	mutations := g.mutations
+	g.mutations = nil
+	g.mutex.Unlock()
+
+	if len(mutations) == 0 {
+		return nil
+	}
+
+	return g.committer.commit(mutations)
+}
+
+// committedBatch contains the mutations of a batch that wait for the next group commit.
+type committedBatch struct {
+	mutations []mutation
+	done      chan error
+}
+
+// mutation is a single mutation of a batch.
+type mutation struct {
+	key     kvstore.Key
+	value   kvstore.Value
+	deleted bool
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,114 @@
+package database
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupCommitStore(t *testing.T) {
+	underlyingStore := mapdb.NewMapDB()
+	store := NewGroupCommitStore(underlyingStore, 10*time.Millisecond, 0)
+
+	var wg sync.WaitGroup
+	for i := byte(0); i < 10; i++ {
+		wg.Add(1)
+		go func(realm byte) {
+			defer wg.Done()
+
+			batch := store.WithRealm([]byte{realm}).Batched()
+			require.NoError(t, batch.Set([]byte("key"), []byte{realm}))
+			require.NoError(t, batch.Set([]byte("deleted"), []byte{realm}))
+			require.NoError(t, batch.Delete([]byte("deleted")))
+			require.NoError(t, batch.Commit())
+
+			// committed mutations are visible once Commit returns
+			value, err := underlyingStore.WithRealm([]byte{realm}).Get([]byte("key"))
+			require.NoError(t, err)
+			assert.Equal(t, []byte{realm}, value)
+		}(i)
+	}
+	wg.Wait()
+
+	for i := byte(0); i < 10; i++ {
+		has, err := underlyingStore.WithRealm([]byte{i}).Has([]byte("deleted"))
+		require.NoError(t, err)
+		assert.False(t, has)
+	}
+
+	writes, batches := store.GroupCommits()
+	assert.Equal(t, uint64(10), batches)
+	assert.LessOrEqual(t, writes, batches)
+
+	// batches are written directly once the store was stopped
+	store.Stop()
+	batch := store.Batched()
+	require.NoError(t, batch.Set([]byte("key"), []byte("value")))
+	require.NoError(t, batch.Commit())
+
+	value, err := underlyingStore.Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
+
+func TestGroupCommitStore_MaxPendingMutations(t *testing.T) {
+	underlyingStore := mapdb.NewMapDB()
+	store := NewGroupCommitStore(underlyingStore, time.Hour, 2)
+	defer store.Stop()
+
+	// the mutations are written before the end of the flush interval once enough of them are pending
+	batch := store.Batched()
+	require.NoError(t, batch.Set([]byte("key1"), []byte("value1")))
+	require.NoError(t, batch.Set([]byte("key2"), []byte("value2")))
+
+	committed := make(chan error, 1)
+	go func() {
+		committed <- batch.Commit()
+	}()
+
+	select {
+	case err := <-committed:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "mutations were not written early")
+	}
+
+	value, err := underlyingStore.Get([]byte("key2"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value2"), value)
+}
+
+func TestGroupCommitStore_Commit(t *testing.T) {
+	underlyingStore := mapdb.NewMapDB()
+	store := NewGroupCommitStore(underlyingStore, time.Hour, 0)
+	defer store.Stop()
+
+	batch := store.Batched()
+	require.NoError(t, batch.Set([]byte("key"), []byte("value")))
+
+	committed := make(chan error, 1)
+	go func() {
+		committed <- batch.Commit()
+	}()
+
+	// the pending mutations are written before the end of the flush interval by Commit
+	require.Eventually(t, func() bool {
+		require.NoError(t, store.Commit())
+
+		select {
+		case err := <-committed:
+			require.NoError(t, err)
+			return true
+		default:
+			return false
+		}
+	}, 5*time.Second, 10*time.Millisecond)
+
+	value, err := underlyingStore.Get([]byte("key"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value"), value)
+}
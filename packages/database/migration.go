@@ -0,0 +1,373 @@
+package database
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+var (
+	// ErrMigrationUnavailable is returned if no registered migrations lead from the version of the database to the
+	// version that is supported by the node.
+	ErrMigrationUnavailable = errors.New("no migration path for database version")
+	// ErrMigrationFailed is returned if a migration failed. The changes of the failed migration are rolled back, so that
+	// the database remains at the version of the last successful migration.
+	ErrMigrationFailed = errors.New("database migration failed")
+
+	// the key under which the schema version is stored if no other key was configured
+	defaultVersionKey = []byte("version")
+	// the key under which the version and realms of a migration are stored while it is applied
+	migrationInProgressKey = []byte("in_progress")
+)
+
+const (
+	// the realm (below PrefixMigrations) that holds the state of the migrations
+	migrationStateRealm byte = iota
+	// the realm (below PrefixMigrations) that holds the backups of the realms that are modified by a migration
+	migrationBackupRealm
+)
+
+// region Migration ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ProgressFunc is the type of the callback that a Migration uses to report the number of processed entries (total is 0
+// if it is unknown).
+type ProgressFunc func(processed, total uint64)
+
+// Migration is a change of the format of the stored data that upgrades the database from the previous schema version to
+// Version.
+type Migration struct {
+	// Version is the schema version of the database after the migration was applied.
+	Version byte
+	// Name describes the migration in the logs.
+	Name string
+	// Realms contains the storage prefixes of the realms that are modified by the migration. They are backed up before
+	// the migration is applied and restored if it fails or is interrupted.
+	Realms []byte
+	// Migrate applies the changes of the migration to the given (unrealmed) store.
+	Migrate func(store kvstore.KVStore, progress ProgressFunc) error
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Migrator /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Migrator upgrades the stored data to the schema version that is supported by the node by applying the registered
+// Migrations one version at a time. Every Migration is applied on a backup of the realms it modifies and the version is
+// persisted after every step, so that a failed or interrupted migration is rolled back (immediately or at the next
+// startup) and the migrations continue from the last successful step.
+type Migrator struct {
+	store       kvstore.KVStore
+	stateStore  kvstore.KVStore
+	backupStore kvstore.KVStore
+	migrations  map[byte]*Migration
+	options     *MigratorOptions
+}
+
+// NewMigrator creates a Migrator for the given store and Migrations.
+func NewMigrator(store kvstore.KVStore, migrations []*Migration, opts ...MigratorOption) (migrator *Migrator) {
+	migrator = &Migrator{
+		store:       store,
+		stateStore:  store.WithRealm([]byte{PrefixMigrations, migrationStateRealm}),
+		backupStore: store.WithRealm([]byte{PrefixMigrations, migrationBackupRealm}),
+		migrations:  make(map[byte]*Migration),
+		options: &MigratorOptions{
+			VersionKey:       defaultVersionKey,
+			ProgressInterval: 5 * time.Second,
+		},
+	}
+	for _, opt := range opts {
+		opt(migrator.options)
+	}
+	if migrator.options.VersionStore == nil {
+		migrator.options.VersionStore = migrator.stateStore
+	}
+
+	for _, migration := range migrations {
+		if _, exists := migrator.migrations[migration.Version]; exists {
+			panic(errors.Errorf("duplicate migration to database version %d", migration.Version))
+		}
+		migrator.migrations[migration.Version] = migration
+	}
+
+	return migrator
+}
+
+// Version returns the schema version of the database. It returns a kvstore.ErrKeyNotFound if no version was persisted.
+func (m *Migrator) Version() (version byte, err error) {
+	entry, err := m.options.VersionStore.Get(m.options.VersionKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(entry) == 0 {
+		return 0, errors.Errorf("%w: no database version was persisted", ErrMigrationUnavailable)
+	}
+
+	return entry[0], nil
+}
+
+// Migrate upgrades the database to the given version. It first rolls back a migration that was interrupted, and sets the
+// version of a new database without applying any Migrations. It returns an ErrMigrationUnavailable if the registered
+// Migrations do not lead to the given version (nothing is changed in that case) and an ErrMigrationFailed if one of the
+// Migrations failed.
+func (m *Migrator) Migrate(targetVersion byte) (err error) {
+	if err = m.recover(); err != nil {
+		return errors.Errorf("failed to recover from interrupted database migration: %w", err)
+	}
+
+	version, err := m.Version()
+	if errors.Is(err, kvstore.ErrKeyNotFound) {
+		return m.setVersion(targetVersion)
+	}
+	if err != nil {
+		return err
+	}
+
+	migrations, err := m.path(version, targetVersion)
+	if err != nil {
+		return err
+	}
+	if len(migrations) == 0 {
+		return nil
+	}
+
+	m.logInfof("Migrating database from version %d to version %d in %d steps...", version, targetVersion, len(migrations))
+	for i, migration := range migrations {
+		start := time.Now()
+		m.logInfof("Applying database migration %d/%d to version %d (%s)...", i+1, len(migrations), migration.Version, migration.Name)
+		if err = m.apply(migration); err != nil {
+			return err
+		}
+		m.logInfof("Applied database migration to version %d (%s), took %v", migration.Version, migration.Name, time.Since(start).Truncate(time.Millisecond))
+	}
+
+	return nil
+}
+
+// path returns the Migrations that lead from the given version to the target version.
+func (m *Migrator) path(version, targetVersion byte) (migrations []*Migration, err error) {
+	if version > targetVersion {
+		return nil, errors.Errorf("%w: supported version: %d, version of database: %d", ErrMigrationUnavailable, targetVersion, version)
+	}
+
+	migrations = make([]*Migration, 0, targetVersion-version)
+	for nextVersion := int(version) + 1; nextVersion <= int(targetVersion); nextVersion++ {
+		migration, exists := m.migrations[byte(nextVersion)]
+		if !exists {
+			return nil, errors.Errorf("%w: supported version: %d, version of database: %d, missing migration to version %d", ErrMigrationUnavailable, targetVersion, version, nextVersion)
+		}
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// apply backs up the realms of the given Migration, applies it and persists its version. The backup is restored if the
+// Migration fails.
+func (m *Migrator) apply(migration *Migration) (err error) {
+	if err = m.backup(migration.Realms); err != nil {
+		return errors.Errorf("failed to back up the database before migration to version %d: %w", migration.Version, err)
+	}
+	if err = m.stateStore.Set(migrationInProgressKey, append([]byte{migration.Version}, migration.Realms...)); err != nil {
+		return errors.Errorf("failed to mark migration to version %d as in progress: %w", migration.Version, err)
+	}
+	if err = m.store.Flush(); err != nil {
+		return errors.Errorf("failed to flush the database before migration to version %d: %w", migration.Version, err)
+	}
+
+	if migrationErr := migration.Migrate(m.store, m.progressFunc(migration)); migrationErr != nil {
+		m.logWarnf("Database migration to version %d (%s) failed, rolling back: %s", migration.Version, migration.Name, migrationErr)
+		if err = m.rollback(migration.Realms); err != nil {
+			return errors.Errorf("failed to roll back migration to version %d (%s) after it failed with '%s': %w", migration.Version, migration.Name, migrationErr, err)
+		}
+
+		return errors.Errorf("%w: migration to version %d (%s) was rolled back: %s", ErrMigrationFailed, migration.Version, migration.Name, migrationErr)
+	}
+
+	if err = m.setVersion(migration.Version); err != nil {
+		return err
+	}
+
+	return m.finish()
+}
+
+// recover rolls back a migration that was interrupted before its version was persisted and discards the backup of a
+// migration that was interrupted after its version was persisted.
+func (m *Migrator) recover() (err error) {
+	marker, err := m.stateStore.Get(migrationInProgressKey)
+	if errors.Is(err, kvstore.ErrKeyNotFound) {
+		// discard the leftovers of a backup that was interrupted before the migration started
+		return m.backupStore.Clear()
+	}
+	if err != nil {
+		return err
+	}
+	if len(marker) == 0 {
+		return errors.New("invalid migration marker was persisted")
+	}
+
+	version, err := m.Version()
+	if err != nil {
+		return err
+	}
+	if version >= marker[0] {
+		return m.finish()
+	}
+
+	m.logWarnf("Rolling back interrupted database migration to version %d...", marker[0])
+
+	return m.rollback(marker[1:])
+}
+
+// backup copies the given realms to the backup realm.
+func (m *Migrator) backup(realms []byte) (err error) {
+	if err = m.backupStore.Clear(); err != nil {
+		return err
+	}
+
+	for _, realm := range realms {
+		if err = copyEntries(m.store.WithRealm([]byte{realm}), m.backupStore, []byte{realm}); err != nil {
+			return err
+		}
+	}
+
+	return m.backupStore.Flush()
+}
+
+// rollback restores the given realms from the backup realm and removes the state of the migration.
+func (m *Migrator) rollback(realms []byte) (err error) {
+	for _, realm := range realms {
+		realmStore := m.store.WithRealm([]byte{realm})
+		if err = realmStore.Clear(); err != nil {
+			return err
+		}
+
+		var restoreErr error
+		if err = m.backupStore.Iterate([]byte{realm}, func(key kvstore.Key, value kvstore.Value) bool {
+			restoreErr = realmStore.Set(key[1:], value)
+			return restoreErr == nil
+		}); err != nil {
+			return err
+		}
+		if restoreErr != nil {
+			return restoreErr
+		}
+	}
+
+	if err = m.store.Flush(); err != nil {
+		return err
+	}
+
+	return m.finish()
+}
+
+// finish removes the marker and the backup of the migration that was applied or rolled back.
+func (m *Migrator) finish() (err error) {
+	if err = m.stateStore.Delete(migrationInProgressKey); err != nil && !errors.Is(err, kvstore.ErrKeyNotFound) {
+		return err
+	}
+	if err = m.backupStore.Clear(); err != nil {
+		return err
+	}
+
+	return m.store.Flush()
+}
+
+// setVersion persists the given schema version.
+func (m *Migrator) setVersion(version byte) (err error) {
+	if err = m.options.VersionStore.Set(m.options.VersionKey, []byte{version}); err != nil {
+		return errors.Errorf("failed to persist database version %d: %w", version, err)
+	}
+
+	return m.options.VersionStore.Flush()
+}
+
+// progressFunc returns the ProgressFunc of the given Migration that logs its progress once per progress interval.
+func (m *Migrator) progressFunc(migration *Migration) ProgressFunc {
+	lastReport := time.Now()
+
+	return func(processed, total uint64) {
+		if time.Since(lastReport) < m.options.ProgressInterval {
+			return
+		}
+		lastReport = time.Now()
+
+		if total == 0 {
+			m.logInfof("Database migration to version %d (%s): processed %d entries...", migration.Version, migration.Name, processed)
+			return
+		}
+		m.logInfof("Database migration to version %d (%s): processed %d/%d entries (%.1f%%)...", migration.Version, migration.Name, processed, total, float64(processed)*100/float64(total))
+	}
+}
+
+func (m *Migrator) logInfof(template string, args ...interface{}) {
+	if m.options.Logger != nil {
+		m.options.Logger.Infof(template, args...)
+	}
+}
+
+func (m *Migrator) logWarnf(template string, args ...interface{}) {
+	if m.options.Logger != nil {
+		m.options.Logger.Warnf(template, args...)
+	}
+}
+
+// copyEntries copies all entries of the source to the target, prepending the given prefix to their keys.
+func copyEntries(source, target kvstore.KVStore, prefix []byte) (err error) {
+	var copyErr error
+	if err = source.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		copyErr = target.Set(append(append(make([]byte, 0, len(prefix)+len(key)), prefix...), key...), value)
+		return copyErr == nil
+	}); err != nil {
+		return err
+	}
+
+	return copyErr
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MigratorOptions //////////////////////////////////////////////////////////////////////////////////////////////
+
+// Logger is the interface of the loggers that report the progress of a Migrator.
+type Logger interface {
+	Infof(template string, args ...interface{})
+	Warnf(template string, args ...interface{})
+}
+
+// MigratorOptions is a container for the options of a Migrator.
+type MigratorOptions struct {
+	VersionStore     kvstore.KVStore
+	VersionKey       kvstore.Key
+	ProgressInterval time.Duration
+	Logger           Logger
+}
+
+// MigratorOption is the type of the functional options of a Migrator.
+type MigratorOption func(*MigratorOptions)
+
+// WithVersionKey returns a MigratorOption that sets the store and key under which the schema version of the database is
+// persisted.
+func WithVersionKey(store kvstore.KVStore, key kvstore.Key) MigratorOption {
+	return func(options *MigratorOptions) {
+		options.VersionStore = store
+		options.VersionKey = key
+	}
+}
+
+// WithProgressInterval returns a MigratorOption that sets the interval in which the progress of a long-running Migration
+// is logged.
+func WithProgressInterval(progressInterval time.Duration) MigratorOption {
+	return func(options *MigratorOptions) {
+		options.ProgressInterval = progressInterval
+	}
+}
+
+// WithLogger returns a MigratorOption that sets the Logger that reports the progress of a Migrator.
+func WithLogger(logger Logger) MigratorOption {
+	return func(options *MigratorOptions) {
+		options.Logger = logger
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,90 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrator(t *testing.T) {
+	const realm = PrefixTangle
+	store := mapdb.NewMapDB()
+	realmStore := store.WithRealm([]byte{realm})
+
+	// appends the given suffix to all values of the realm
+	appendToValues := func(suffix byte, failAfter int) func(kvstore.KVStore, ProgressFunc) error {
+		return func(store kvstore.KVStore, progress ProgressFunc) error {
+			processed := 0
+			return store.WithRealm([]byte{realm}).Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+				if processed == failAfter {
+					return false
+				}
+				require.NoError(t, store.WithRealm([]byte{realm}).Set(key, append(value, suffix)))
+				processed++
+				progress(uint64(processed), 2)
+
+				return true
+			})
+		}
+	}
+	migrations := []*Migration{
+		{Version: 2, Name: "first", Realms: []byte{realm}, Migrate: appendToValues(2, -1)},
+		{Version: 3, Name: "failing", Realms: []byte{realm}, Migrate: func(store kvstore.KVStore, progress ProgressFunc) error {
+			require.NoError(t, appendToValues(3, 1)(store, progress))
+			return errors.New("corrupted entry")
+		}},
+	}
+
+	// the version of a new database is set without applying any migrations
+	require.NoError(t, NewMigrator(store, migrations).Migrate(1))
+	require.NoError(t, realmStore.Set([]byte("a"), []byte{1}))
+	require.NoError(t, realmStore.Set([]byte("b"), []byte{1}))
+
+	// no migrations lead to version 4 and to older versions
+	migrator := NewMigrator(store, migrations)
+	assert.True(t, errors.Is(migrator.Migrate(4), ErrMigrationUnavailable))
+	require.NoError(t, migrator.Migrate(2))
+	assert.True(t, errors.Is(migrator.Migrate(1), ErrMigrationUnavailable))
+	assertVersion(t, migrator, 2)
+	assertValues(t, realmStore, []byte{1, 2})
+
+	// the changes of a failed migration are rolled back
+	assert.True(t, errors.Is(migrator.Migrate(3), ErrMigrationFailed))
+	assertVersion(t, migrator, 2)
+	assertValues(t, realmStore, []byte{1, 2})
+
+	// a migration that was interrupted is rolled back at the next startup
+	require.NoError(t, migrator.backup([]byte{realm}))
+	require.NoError(t, migrator.stateStore.Set(migrationInProgressKey, []byte{3, realm}))
+	require.NoError(t, realmStore.Set([]byte("a"), []byte{1, 2, 3}))
+	migrations[1].Migrate = appendToValues(3, -1)
+	require.NoError(t, NewMigrator(store, migrations).Migrate(3))
+	assertVersion(t, migrator, 3)
+	assertValues(t, realmStore, []byte{1, 2, 3})
+
+	has, err := migrator.stateStore.Has(migrationInProgressKey)
+	require.NoError(t, err)
+	assert.False(t, has)
+	require.NoError(t, migrator.backupStore.IterateKeys(kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		assert.Failf(t, "backup was not discarded", "key %v", key)
+		return true
+	}))
+}
+
+func assertVersion(t *testing.T, migrator *Migrator, expected byte) {
+	version, err := migrator.Version()
+	require.NoError(t, err)
+	assert.Equal(t, expected, version)
+}
+
+func assertValues(t *testing.T, store kvstore.KVStore, expected []byte) {
+	for _, key := range []string{"a", "b"} {
+		value, err := store.Get([]byte(key))
+		require.NoError(t, err)
+		assert.Equal(t, expected, value, "value of key %s", key)
+	}
+}
@@ -21,4 +21,25 @@ const (
 
 	// PrefixEpochs defines the storage prefix for the epochs package.
 	PrefixEpochs
+
+	// PrefixConsensus defines the storage prefix for the consensus packages.
+	PrefixConsensus
+
+	// PrefixArchive defines the storage prefix for the history indexes of archive nodes.
+	PrefixArchive
+
+	// PrefixStatementLog defines the storage prefix for the log of the statements that were issued by the node.
+	PrefixStatementLog
+
+	// PrefixWatchList defines the storage prefix for the watched items and their events.
+	PrefixWatchList
+
+	// PrefixMigrations defines the storage prefix for the state and the backups of the database migrations.
+	PrefixMigrations
+
+	// PrefixNameRegistry defines the storage prefix for the records of the name registry.
+	PrefixNameRegistry
+
+	// PrefixDRNG defines the storage prefix for the history of the randomness of the dRNG.
+	PrefixDRNG
 )
@@ -3,6 +3,7 @@ package database
 import (
 	"runtime"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/kvstore/rocksdb"
 )
@@ -13,9 +14,37 @@ type rocksDB struct {
 	*rocksdb.RocksDB
 }
 
-// NewDB returns a new persisting DB object.
-func NewDB(dirname string) (DB, error) {
-	db, err := rocksdb.CreateDB(dirname)
+// DurabilityMode defines how the writes to a persisting DB are protected against crashes.
+type DurabilityMode string
+
+const (
+	// DurabilityNone disables the write-ahead log, so that the writes that were not flushed to disk yet are lost if
+	// the node crashes.
+	DurabilityNone DurabilityMode = "none"
+
+	// DurabilityWAL writes every write to the write-ahead log without syncing it, so that writes are only lost if the
+	// operating system crashes.
+	DurabilityWAL DurabilityMode = "wal"
+
+	// DurabilitySync writes every write to the write-ahead log and syncs it to disk before the write returns.
+	DurabilitySync DurabilityMode = "sync"
+)
+
+// NewDB returns a new persisting DB object that persists its writes with the given DurabilityMode.
+func NewDB(dirname string, durability DurabilityMode) (DB, error) {
+	var options []rocksdb.Option
+	switch durability {
+	case DurabilityNone:
+		options = append(options, rocksdb.WriteDisableWAL(true), rocksdb.WriteSync(false))
+	case DurabilityWAL:
+		options = append(options, rocksdb.WriteDisableWAL(false), rocksdb.WriteSync(false))
+	case DurabilitySync:
+		options = append(options, rocksdb.WriteDisableWAL(false), rocksdb.WriteSync(true))
+	default:
+		return nil, errors.Errorf("unknown durability mode '%s'", durability)
+	}
+
+	db, err := rocksdb.CreateDB(dirname, options...)
 	return &rocksDB{RocksDB: db}, err
 }
 
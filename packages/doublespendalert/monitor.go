@@ -0,0 +1,213 @@
+package doublespendalert
+
+import (
+	"sort"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Monitor //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Monitor watches the creation of conflicts and triggers an Alert whenever a conflict involves an address or an
+// output of the Watchlist.
+type Monitor struct {
+	Events *Events
+
+	tangle          *tangle.Tangle
+	watchlist       *Watchlist
+	onBranchCreated *event.Closure[ledgerstate.BranchID]
+}
+
+// NewMonitor creates a Monitor for the given Tangle and Watchlist.
+func NewMonitor(tangle *tangle.Tangle, watchlist *Watchlist) (monitor *Monitor) {
+	monitor = &Monitor{
+		Events: &Events{
+			DoubleSpendDetected: events.NewEvent(AlertEventHandler),
+		},
+		tangle:    tangle,
+		watchlist: watchlist,
+	}
+	monitor.onBranchCreated = event.NewClosure(monitor.checkBranch)
+
+	return monitor
+}
+
+// Watchlist returns the Watchlist of the Monitor.
+func (m *Monitor) Watchlist() *Watchlist {
+	return m.watchlist
+}
+
+// Setup attaches the Monitor to the BranchDAG.
+func (m *Monitor) Setup() {
+	m.tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(m.onBranchCreated)
+}
+
+// Shutdown detaches the Monitor from the BranchDAG.
+func (m *Monitor) Shutdown() {
+	m.tangle.LedgerState.BranchDAG.Events.BranchCreated.Detach(m.onBranchCreated)
+}
+
+// checkBranch triggers an Alert for every conflict of the given Branch that involves watched addresses or outputs. A
+// conflict is only reported once it has at least two members, i.e. once the double spend was booked.
+func (m *Monitor) checkBranch(branchID ledgerstate.BranchID) {
+	if m.watchlist.IsEmpty() {
+		return
+	}
+
+	m.tangle.LedgerState.BranchDAG.Branch(branchID).Consume(func(branch *ledgerstate.Branch) {
+		for conflictID := range branch.Conflicts() {
+			if alert := m.checkConflict(branchID, conflictID); alert != nil {
+				m.Events.DoubleSpendDetected.Trigger(alert)
+			}
+		}
+	})
+}
+
+// checkConflict returns the Alert of the given conflict or nil if the conflict does not need to be reported.
+func (m *Monitor) checkConflict(branchID ledgerstate.BranchID, conflictID ledgerstate.ConflictID) (alert *Alert) {
+	conflictingTransactionIDs := make([]ledgerstate.TransactionID, 0)
+	m.tangle.LedgerState.BranchDAG.ConflictMembers(conflictID).Consume(func(conflictMember *ledgerstate.ConflictMember) {
+		conflictingTransactionIDs = append(conflictingTransactionIDs, conflictMember.BranchID().TransactionID())
+	})
+	if len(conflictingTransactionIDs) < 2 {
+		return nil
+	}
+
+	matches := newWatchlistMatches()
+
+	// the double spent output
+	doubleSpentOutputID := conflictID.OutputID()
+	if m.watchlist.ContainsOutput(doubleSpentOutputID) {
+		matches.addOutput(doubleSpentOutputID)
+	}
+	m.tangle.LedgerState.CachedOutput(doubleSpentOutputID).Consume(func(output ledgerstate.Output) {
+		if m.watchlist.ContainsAddress(output.Address()) {
+			matches.addAddress(output.Address())
+		}
+	})
+
+	// the outputs created by the conflicting transactions (e.g. a payment that gets double spent)
+	for _, transactionID := range conflictingTransactionIDs {
+		m.tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+			for outputIndex, output := range transaction.Essence().Outputs() {
+				outputID := ledgerstate.NewOutputID(transactionID, uint16(outputIndex))
+				if m.watchlist.ContainsOutput(outputID) {
+					matches.addOutput(outputID)
+				}
+				if m.watchlist.ContainsAddress(output.Address()) {
+					matches.addAddress(output.Address())
+				}
+			}
+		})
+	}
+
+	if matches.isEmpty() {
+		return nil
+	}
+
+	return newAlert(branchID.TransactionID(), conflictID, conflictingTransactionIDs, matches, clock.SyncedTime())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Alert ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Alert contains the details of a double spend that involves watched addresses or outputs.
+type Alert struct {
+	// TransactionID is the transaction whose booking created or extended the conflict.
+	TransactionID string `json:"transactionID"`
+	// ConflictID is the base58 encoded ID of the double spent output.
+	ConflictID string `json:"conflictID"`
+	// ConflictingTransactionIDs are all known transactions that spend the output.
+	ConflictingTransactionIDs []string `json:"conflictingTransactionIDs"`
+	// WatchedAddresses are the watched addresses that are involved in the conflict.
+	WatchedAddresses []string `json:"watchedAddresses"`
+	// WatchedOutputs are the watched outputs that are involved in the conflict.
+	WatchedOutputs []string `json:"watchedOutputs"`
+	// Time is the unix time at which the conflict was detected.
+	Time int64 `json:"time"`
+}
+
+// newAlert creates a new Alert from the given details.
+func newAlert(transactionID ledgerstate.TransactionID, conflictID ledgerstate.ConflictID, conflictingTransactionIDs []ledgerstate.TransactionID, matches *watchlistMatches, detectionTime time.Time) *Alert {
+	alert := &Alert{
+		TransactionID:             transactionID.Base58(),
+		ConflictID:                conflictID.Base58(),
+		ConflictingTransactionIDs: make([]string, 0, len(conflictingTransactionIDs)),
+		WatchedAddresses:          matches.sortedAddresses(),
+		WatchedOutputs:            matches.sortedOutputs(),
+		Time:                      detectionTime.Unix(),
+	}
+	for _, conflictingTransactionID := range conflictingTransactionIDs {
+		alert.ConflictingTransactionIDs = append(alert.ConflictingTransactionIDs, conflictingTransactionID.Base58())
+	}
+	sort.Strings(alert.ConflictingTransactionIDs)
+
+	return alert
+}
+
+// watchlistMatches collects the watched addresses and outputs that are involved in a conflict.
+type watchlistMatches struct {
+	addresses map[string]struct{}
+	outputs   map[string]struct{}
+}
+
+func newWatchlistMatches() *watchlistMatches {
+	return &watchlistMatches{
+		addresses: make(map[string]struct{}),
+		outputs:   make(map[string]struct{}),
+	}
+}
+
+func (w *watchlistMatches) addAddress(address ledgerstate.Address) {
+	w.addresses[address.Base58()] = struct{}{}
+}
+
+func (w *watchlistMatches) addOutput(outputID ledgerstate.OutputID) {
+	w.outputs[outputID.Base58()] = struct{}{}
+}
+
+func (w *watchlistMatches) isEmpty() bool {
+	return len(w.addresses) == 0 && len(w.outputs) == 0
+}
+
+func (w *watchlistMatches) sortedAddresses() []string {
+	return sortedKeys(w.addresses)
+}
+
+func (w *watchlistMatches) sortedOutputs() []string {
+	return sortedKeys(w.outputs)
+}
+
+func sortedKeys(set map[string]struct{}) (keys []string) {
+	keys = make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events contains the events of the Monitor.
+type Events struct {
+	// DoubleSpendDetected is triggered when a conflict involves watched addresses or outputs.
+	DoubleSpendDetected *events.Event
+}
+
+// AlertEventHandler is the caller function for events that hand over an Alert.
+func AlertEventHandler(handler interface{}, params ...interface{}) {
+	handler.(func(*Alert))(params[0].(*Alert))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,82 @@
+package doublespendalert
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestMonitor(t *testing.T) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(testTangle, tangle.WithGenesisOutput("G", 3))
+	testTangle.Setup()
+
+	testFramework.CreateMessage("Payment", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("Merchant", 3))
+	testFramework.CreateMessage("DoubleSpend", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("Attacker", 3))
+	testFramework.CreateMessage("Unrelated", tangle.WithStrongParents("Genesis"), tangle.WithInputs("Merchant"), tangle.WithOutput("Other", 3))
+
+	payment := testFramework.Message("Payment").Payload().(*ledgerstate.Transaction)
+	merchantAddress := payment.Essence().Outputs()[0].Address()
+	doubleSpentOutputID := payment.Essence().Inputs()[0].(*ledgerstate.UTXOInput).ReferencedOutputID()
+
+	watchlist := NewWatchlist()
+	watchlist.AddAddress(merchantAddress)
+	monitor := NewMonitor(testTangle, watchlist)
+	monitor.Setup()
+	defer monitor.Shutdown()
+
+	var alertsMutex sync.Mutex
+	alerts := make([]*Alert, 0)
+	monitor.Events.DoubleSpendDetected.Attach(events.NewClosure(func(alert *Alert) {
+		alertsMutex.Lock()
+		defer alertsMutex.Unlock()
+
+		alerts = append(alerts, alert)
+	}))
+
+	testFramework.IssueMessages("Payment").WaitMessagesBooked()
+	assert.Empty(t, alerts)
+
+	testFramework.IssueMessages("DoubleSpend").WaitMessagesBooked()
+
+	alertsMutex.Lock()
+	defer alertsMutex.Unlock()
+
+	require.Len(t, alerts, 1)
+	assert.Equal(t, testFramework.TransactionID("DoubleSpend").Base58(), alerts[0].TransactionID)
+	assert.Equal(t, doubleSpentOutputID.Base58(), alerts[0].ConflictID)
+	assert.ElementsMatch(t, []string{
+		testFramework.TransactionID("Payment").Base58(),
+		testFramework.TransactionID("DoubleSpend").Base58(),
+	}, alerts[0].ConflictingTransactionIDs)
+	assert.Equal(t, []string{merchantAddress.Base58()}, alerts[0].WatchedAddresses)
+	assert.Empty(t, alerts[0].WatchedOutputs)
+}
+
+func TestWatchlist(t *testing.T) {
+	address := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	outputID := ledgerstate.NewOutputID(ledgerstate.TransactionID{1}, 0)
+
+	watchlist, err := WatchlistFromBase58([]string{address.Base58()}, []string{outputID.Base58()})
+	require.NoError(t, err)
+	assert.True(t, watchlist.ContainsAddress(address))
+	assert.True(t, watchlist.ContainsOutput(outputID))
+
+	watchlist.RemoveAddress(address)
+	watchlist.RemoveOutput(outputID)
+	assert.False(t, watchlist.ContainsAddress(address))
+	assert.False(t, watchlist.ContainsOutput(outputID))
+	assert.True(t, watchlist.IsEmpty())
+
+	_, err = WatchlistFromBase58([]string{"invalid"}, nil)
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package doublespendalert
+
+import (
+	"sync"
+
+	"github.com/iotaledger/hive.go/types"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// Watchlist contains the addresses and outputs whose double spends are reported.
+type Watchlist struct {
+	addresses map[string]ledgerstate.Address
+	outputs   map[ledgerstate.OutputID]types.Empty
+	mutex     sync.RWMutex
+}
+
+// NewWatchlist creates an empty Watchlist.
+func NewWatchlist() *Watchlist {
+	return &Watchlist{
+		addresses: make(map[string]ledgerstate.Address),
+		outputs:   make(map[ledgerstate.OutputID]types.Empty),
+	}
+}
+
+// WatchlistFromBase58 creates a Watchlist from the given base58 encoded addresses and output IDs.
+func WatchlistFromBase58(addresses, outputIDs []string) (watchlist *Watchlist, err error) {
+	watchlist = NewWatchlist()
+	for _, base58EncodedAddress := range addresses {
+		address, addressErr := ledgerstate.AddressFromBase58EncodedString(base58EncodedAddress)
+		if addressErr != nil {
+			return nil, addressErr
+		}
+		watchlist.AddAddress(address)
+	}
+	for _, base58EncodedOutputID := range outputIDs {
+		outputID, outputIDErr := ledgerstate.OutputIDFromBase58(base58EncodedOutputID)
+		if outputIDErr != nil {
+			return nil, outputIDErr
+		}
+		watchlist.AddOutput(outputID)
+	}
+
+	return watchlist, nil
+}
+
+// AddAddress adds the given address to the Watchlist.
+func (w *Watchlist) AddAddress(address ledgerstate.Address) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.addresses[address.Base58()] = address
+}
+
+// RemoveAddress removes the given address from the Watchlist.
+func (w *Watchlist) RemoveAddress(address ledgerstate.Address) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	delete(w.addresses, address.Base58())
+}
+
+// ContainsAddress returns true if the given address is watched.
+func (w *Watchlist) ContainsAddress(address ledgerstate.Address) bool {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	_, exists := w.addresses[address.Base58()]
+	return exists
+}
+
+// AddOutput adds the given output to the Watchlist.
+func (w *Watchlist) AddOutput(outputID ledgerstate.OutputID) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.outputs[outputID] = types.Void
+}
+
+// RemoveOutput removes the given output from the Watchlist.
+func (w *Watchlist) RemoveOutput(outputID ledgerstate.OutputID) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	delete(w.outputs, outputID)
+}
+
+// ContainsOutput returns true if the given output is watched.
+func (w *Watchlist) ContainsOutput(outputID ledgerstate.OutputID) bool {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	_, exists := w.outputs[outputID]
+	return exists
+}
+
+// IsEmpty returns true if neither addresses nor outputs are watched.
+func (w *Watchlist) IsEmpty() bool {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return len(w.addresses) == 0 && len(w.outputs) == 0
+}
@@ -0,0 +1,82 @@
+package doublespendalert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// WebhookDispatcher posts Alerts as JSON to a set of webhook URLs.
+type WebhookDispatcher struct {
+	urls   []string
+	client *http.Client
+	queue  chan *Alert
+}
+
+// NewWebhookDispatcher creates a WebhookDispatcher that posts to the given URLs. At most queueSize Alerts are buffered
+// while the webhooks are being called.
+func NewWebhookDispatcher(urls []string, timeout time.Duration, queueSize int) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		urls:   urls,
+		client: &http.Client{Timeout: timeout},
+		queue:  make(chan *Alert, queueSize),
+	}
+}
+
+// Submit queues the given Alert for dispatching. It never blocks and returns false if the queue is full.
+func (w *WebhookDispatcher) Submit(alert *Alert) (queued bool) {
+	select {
+	case w.queue <- alert:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run dispatches the queued Alerts until the given context is done. Failed calls are reported to the error handler.
+func (w *WebhookDispatcher) Run(ctx context.Context, errorHandler func(err error)) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case alert := <-w.queue:
+			for _, url := range w.urls {
+				if err := w.post(ctx, url, alert); err != nil {
+					errorHandler(err)
+				}
+			}
+		}
+	}
+}
+
+// post sends the given Alert to the given URL.
+func (w *WebhookDispatcher) post(ctx context.Context, url string, alert *Alert) error {
+	body, err := json.Marshal(alert)
+	if err != nil {
+		return errors.Errorf("failed to marshal alert: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errors.Errorf("failed to create request for webhook %s: %w", url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return errors.Errorf("failed to call webhook %s: %w", url, err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return errors.Errorf("webhook %s responded with status %d", url, res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package doublespendalert
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDispatcher(t *testing.T) {
+	received := make(chan *Alert, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		alert := &Alert{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(alert))
+		received <- alert
+	}))
+	defer server.Close()
+
+	failingServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failingServer.Close()
+
+	dispatcher := NewWebhookDispatcher([]string{failingServer.URL, server.URL}, time.Second, 1)
+
+	alert := &Alert{TransactionID: "tx", ConflictID: "conflict", ConflictingTransactionIDs: []string{"tx", "otherTx"}}
+	assert.True(t, dispatcher.Submit(alert))
+	assert.False(t, dispatcher.Submit(alert), "queue should be full")
+
+	errs := make(chan error, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dispatcher.Run(ctx, func(err error) { errs <- err })
+
+	select {
+	case err := <-errs:
+		assert.Contains(t, err.Error(), "500")
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "failing webhook was not reported")
+	}
+
+	select {
+	case receivedAlert := <-received:
+		assert.Equal(t, alert, receivedAlert)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "alert was not delivered")
+	}
+}
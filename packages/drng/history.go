@@ -0,0 +1,177 @@
+package drng
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+)
+
+// History keeps the most recent rounds of the randomness of a DRNG instance, so that the randomness of a round can
+// still be looked up after newer rounds were received. The rounds can be persisted in a store, so that they are still
+// known after a restart.
+type History struct {
+	rounds   []Randomness
+	capacity int
+	store    kvstore.KVStore
+
+	mutex sync.RWMutex
+}
+
+// NewHistory creates a new History that keeps up to the given number of rounds. If a store is given, the rounds are
+// persisted in it and the rounds of a previous run are restored.
+func NewHistory(capacity int, store kvstore.KVStore) (history *History, err error) {
+	history = &History{
+		rounds:   make([]Randomness, 0, capacity),
+		capacity: capacity,
+		store:    store,
+	}
+
+	if store == nil {
+		return history, nil
+	}
+	if err = history.restore(); err != nil {
+		return nil, errors.Errorf("failed to restore the history of the randomness: %w", err)
+	}
+
+	return history, nil
+}
+
+// Add adds the given randomness to the History. Rounds that are not newer than the latest round are ignored.
+func (h *History) Add(randomness Randomness) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if len(h.rounds) != 0 && randomness.Round <= h.rounds[len(h.rounds)-1].Round {
+		return
+	}
+
+	if len(h.rounds) == h.capacity {
+		h.deleteRound(h.rounds[0])
+		copy(h.rounds, h.rounds[1:])
+		h.rounds = h.rounds[:len(h.rounds)-1]
+	}
+	h.rounds = append(h.rounds, randomness)
+	h.storeRound(randomness)
+}
+
+// FirstAfter returns the randomness of the first round whose timestamp is not before the given time. The round is only
+// returned if its preceding round is known as well, as otherwise an earlier matching round might have been missed.
+func (h *History) FirstAfter(t time.Time) (randomness Randomness, exists bool) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	for i := 1; i < len(h.rounds); i++ {
+		if h.rounds[i].Timestamp.Before(t) {
+			continue
+		}
+
+		previous := h.rounds[i-1]
+		if previous.Round+1 != h.rounds[i].Round || !previous.Timestamp.Before(t) {
+			return Randomness{}, false
+		}
+
+		return h.rounds[i], true
+	}
+
+	return Randomness{}, false
+}
+
+// Passed returns true if a round whose timestamp is not before the given time was received already. If FirstAfter does
+// not return a round for such a time, the round can not be determined anymore (e.g. because it was received while the
+// node was offline or because it was dropped from the History).
+func (h *History) Passed(t time.Time) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return len(h.rounds) != 0 && !h.rounds[len(h.rounds)-1].Timestamp.Before(t)
+}
+
+// restore loads the persisted rounds and drops the ones that exceed the capacity.
+func (h *History) restore() (err error) {
+	var parseErr error
+	if err = h.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		var randomness Randomness
+		if randomness, parseErr = randomnessFromBytes(key, value); parseErr != nil {
+			return false
+		}
+		h.rounds = append(h.rounds, randomness)
+
+		return true
+	}); err != nil {
+		return err
+	}
+	if parseErr != nil {
+		return parseErr
+	}
+
+	sort.Slice(h.rounds, func(i, j int) bool { return h.rounds[i].Round < h.rounds[j].Round })
+	if excessRounds := len(h.rounds) - h.capacity; excessRounds > 0 {
+		for _, round := range h.rounds[:excessRounds] {
+			h.deleteRound(round)
+		}
+		h.rounds = append(h.rounds[:0], h.rounds[excessRounds:]...)
+	}
+
+	return nil
+}
+
+// storeRound persists the given round if the History has a store. It expects the mutex to be locked.
+func (h *History) storeRound(randomness Randomness) {
+	if h.store == nil {
+		return
+	}
+
+	// a round that fails to be persisted is only missing after a restart
+	_ = h.store.Set(roundKey(randomness.Round), marshalutil.New().
+		WriteTime(randomness.Timestamp).
+		WriteUint32(uint32(len(randomness.Randomness))).
+		WriteBytes(randomness.Randomness).
+		Bytes())
+}
+
+// deleteRound removes the given round from the store of the History. It expects the mutex to be locked.
+func (h *History) deleteRound(randomness Randomness) {
+	if h.store == nil {
+		return
+	}
+
+	_ = h.store.Delete(roundKey(randomness.Round))
+}
+
+// randomnessFromBytes unmarshals a persisted round from its storage key and value.
+func randomnessFromBytes(key, value []byte) (randomness Randomness, err error) {
+	if len(key) != marshalutil.Uint64Size {
+		return Randomness{}, errors.Errorf("invalid key of persisted round: %x", key)
+	}
+	randomness.Round = binary.BigEndian.Uint64(key)
+
+	marshalUtil := marshalutil.New(value)
+	if randomness.Timestamp, err = marshalUtil.ReadTime(); err != nil {
+		return Randomness{}, errors.Errorf("failed to parse timestamp of round %d: %w", randomness.Round, err)
+	}
+	randomnessLength, err := marshalUtil.ReadUint32()
+	if err != nil {
+		return Randomness{}, errors.Errorf("failed to parse randomness length of round %d: %w", randomness.Round, err)
+	}
+	randomnessBytes, err := marshalUtil.ReadBytes(int(randomnessLength))
+	if err != nil {
+		return Randomness{}, errors.Errorf("failed to parse randomness of round %d: %w", randomness.Round, err)
+	}
+	// the value is only valid during the iteration of the store
+	randomness.Randomness = append([]byte(nil), randomnessBytes...)
+
+	return randomness, nil
+}
+
+// roundKey returns the storage key of the given round.
+func roundKey(round uint64) []byte {
+	key := make([]byte, marshalutil.Uint64Size)
+	binary.BigEndian.PutUint64(key, round)
+
+	return key
+}
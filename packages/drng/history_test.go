@@ -0,0 +1,76 @@
+package drng
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistory(t *testing.T) {
+	start := time.Now()
+	history, err := NewHistory(3, nil)
+	require.NoError(t, err)
+
+	for round := uint64(1); round <= 4; round++ {
+		history.Add(Randomness{Round: round, Randomness: []byte{byte(round)}, Timestamp: start.Add(time.Duration(round) * 10 * time.Second)})
+	}
+	// older rounds are ignored
+	history.Add(Randomness{Round: 3, Randomness: []byte{0}, Timestamp: start})
+
+	// the first round at or after the given time is returned
+	randomness, exists := history.FirstAfter(start.Add(25 * time.Second))
+	require.True(t, exists)
+	assert.EqualValues(t, 3, randomness.Round)
+	assert.Equal(t, []byte{3}, randomness.Randomness)
+
+	randomness, exists = history.FirstAfter(start.Add(40 * time.Second))
+	require.True(t, exists)
+	assert.EqualValues(t, 4, randomness.Round)
+
+	// round 1 was dropped, so it is unknown whether round 2 is the first matching round
+	_, exists = history.FirstAfter(start.Add(15 * time.Second))
+	assert.False(t, exists)
+
+	assert.True(t, history.Passed(start.Add(15*time.Second)))
+
+	// the round has not been received yet
+	_, exists = history.FirstAfter(start.Add(45 * time.Second))
+	assert.False(t, exists)
+	assert.False(t, history.Passed(start.Add(45*time.Second)))
+
+	// missing rounds make the lookup fail
+	history.Add(Randomness{Round: 6, Randomness: []byte{6}, Timestamp: start.Add(60 * time.Second)})
+	_, exists = history.FirstAfter(start.Add(45 * time.Second))
+	assert.False(t, exists)
+	assert.True(t, history.Passed(start.Add(45*time.Second)))
+}
+
+func TestHistory_Persistence(t *testing.T) {
+	start := time.Unix(1616144400, 0)
+	store := mapdb.NewMapDB()
+
+	history, err := NewHistory(3, store)
+	require.NoError(t, err)
+	for round := uint64(1); round <= 4; round++ {
+		history.Add(Randomness{Round: round, Randomness: []byte{byte(round)}, Timestamp: start.Add(time.Duration(round) * 10 * time.Second)})
+	}
+
+	// the rounds are restored after a restart
+	restoredHistory, err := NewHistory(2, store)
+	require.NoError(t, err)
+	randomness, exists := restoredHistory.FirstAfter(start.Add(35 * time.Second))
+	require.True(t, exists)
+	assert.EqualValues(t, 4, randomness.Round)
+	assert.Equal(t, []byte{4}, randomness.Randomness)
+	assert.True(t, start.Add(40*time.Second).Equal(randomness.Timestamp))
+
+	// the rounds that exceed the capacity are dropped from the store as well
+	_, exists = restoredHistory.FirstAfter(start.Add(25 * time.Second))
+	assert.False(t, exists)
+	has, err := store.Has(roundKey(2))
+	require.NoError(t, err)
+	assert.False(t, has)
+}
@@ -0,0 +1,190 @@
+package epochs
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region DiffStore ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DiffStore persists the changes of the confirmed ledger state per epoch, so that they can be served to indexers even
+// after the corresponding transactions were pruned.
+type DiffStore struct {
+	store kvstore.KVStore
+	mutex sync.RWMutex
+}
+
+// NewDiffStore is the constructor of the DiffStore.
+func NewDiffStore(store kvstore.KVStore) *DiffStore {
+	return &DiffStore{
+		store: store.WithRealm([]byte{database.PrefixEpochs, PrefixDiffs}),
+	}
+}
+
+// StoreCreatedOutputs adds the given outputs to the created outputs of the given epoch.
+func (d *DiffStore) StoreCreatedOutputs(index Index, outputs ledgerstate.Outputs) (err error) {
+	return d.storeOutputs(index, diffEntryCreatedOutput, outputs)
+}
+
+// StoreSpentOutputs adds the given outputs to the spent outputs of the given epoch.
+func (d *DiffStore) StoreSpentOutputs(index Index, outputs ledgerstate.Outputs) (err error) {
+	return d.storeOutputs(index, diffEntrySpentOutput, outputs)
+}
+
+// StoreBranchResolution records that the given Branch was resolved with the given InclusionState in the given epoch.
+func (d *DiffStore) StoreBranchResolution(index Index, branchID ledgerstate.BranchID, inclusionState ledgerstate.InclusionState) (err error) {
+	var entryType diffEntryType
+	switch inclusionState {
+	case ledgerstate.Confirmed:
+		entryType = diffEntryConfirmedBranch
+	case ledgerstate.Rejected:
+		entryType = diffEntryRejectedBranch
+	default:
+		return errors.Errorf("branch %s is not resolved (%s)", branchID, inclusionState)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if err = d.store.Set(diffEntryKey(index, entryType, branchID.Bytes()), []byte{}); err != nil {
+		return errors.Errorf("failed to store resolution of branch %s: %w", branchID, err)
+	}
+
+	return nil
+}
+
+// Diff returns the changes of the confirmed ledger state that happened in the given epoch.
+func (d *DiffStore) Diff(index Index) (diff *Diff, err error) {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+
+	diff = &Diff{
+		Index:             index,
+		Created:           make(ledgerstate.Outputs, 0),
+		Spent:             make(ledgerstate.Outputs, 0),
+		ConfirmedBranches: ledgerstate.NewBranchIDs(),
+		RejectedBranches:  ledgerstate.NewBranchIDs(),
+	}
+
+	if iterateErr := d.store.Iterate(indexPrefix(index), func(key kvstore.Key, value kvstore.Value) bool {
+		if err = diff.addEntry(diffEntryType(key[marshalutil.Uint64Size]), key[marshalutil.Uint64Size+1:], value); err != nil {
+			return false
+		}
+
+		return true
+	}); iterateErr != nil {
+		return nil, errors.Errorf("failed to iterate diff of epoch %d: %w", index, iterateErr)
+	}
+	if err != nil {
+		return nil, errors.Errorf("failed to parse diff of epoch %d: %w", index, err)
+	}
+
+	return diff, nil
+}
+
+func (d *DiffStore) storeOutputs(index Index, entryType diffEntryType, outputs ledgerstate.Outputs) (err error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	for _, output := range outputs {
+		if err = d.store.Set(diffEntryKey(index, entryType, output.ID().Bytes()), output.Bytes()); err != nil {
+			return errors.Errorf("failed to store output %s: %w", output.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Diff /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Diff contains the changes of the confirmed ledger state that happened in a single epoch.
+type Diff struct {
+	Index             Index
+	Created           ledgerstate.Outputs
+	Spent             ledgerstate.Outputs
+	ConfirmedBranches ledgerstate.BranchIDs
+	RejectedBranches  ledgerstate.BranchIDs
+}
+
+func (d *Diff) addEntry(entryType diffEntryType, id []byte, value []byte) error {
+	switch entryType {
+	case diffEntryCreatedOutput, diffEntrySpentOutput:
+		output, err := entryOutput(id, value)
+		if err != nil {
+			return err
+		}
+		if entryType == diffEntryCreatedOutput {
+			d.Created = append(d.Created, output)
+		} else {
+			d.Spent = append(d.Spent, output)
+		}
+	case diffEntryConfirmedBranch, diffEntryRejectedBranch:
+		branchID, _, err := ledgerstate.BranchIDFromBytes(id)
+		if err != nil {
+			return errors.Errorf("failed to parse branch id: %w", err)
+		}
+		if entryType == diffEntryConfirmedBranch {
+			d.ConfirmedBranches.Add(branchID)
+		} else {
+			d.RejectedBranches.Add(branchID)
+		}
+	default:
+		return errors.Errorf("unknown diff entry type %d", entryType)
+	}
+
+	return nil
+}
+
+func entryOutput(id []byte, value []byte) (output ledgerstate.Output, err error) {
+	outputID, _, err := ledgerstate.OutputIDFromBytes(id)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse output id: %w", err)
+	}
+	if output, _, err = ledgerstate.OutputFromBytes(value); err != nil {
+		return nil, errors.Errorf("failed to parse output %s: %w", outputID, err)
+	}
+	output.SetID(outputID)
+
+	return output, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region keys /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// PrefixDiffs defines the storage prefix of the epoch diffs.
+	PrefixDiffs byte = iota
+)
+
+// diffEntryType is the type of an entry of a Diff that is encoded in its storage key.
+type diffEntryType byte
+
+const (
+	diffEntryCreatedOutput diffEntryType = iota
+	diffEntrySpentOutput
+	diffEntryConfirmedBranch
+	diffEntryRejectedBranch
+)
+
+func indexPrefix(index Index) []byte {
+	prefix := make([]byte, marshalutil.Uint64Size)
+	binary.BigEndian.PutUint64(prefix, uint64(index))
+
+	return prefix
+}
+
+func diffEntryKey(index Index, entryType diffEntryType, id []byte) []byte {
+	return append(append(indexPrefix(index), byte(entryType)), id...)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,65 @@
+package epochs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestIndexFromTime(t *testing.T) {
+	genesisTime := time.Unix(tangle.DefaultGenesisTime, 0)
+
+	assert.Equal(t, Index(0), IndexFromTime(genesisTime.Add(-time.Hour)))
+	assert.Equal(t, Index(0), IndexFromTime(genesisTime.Add(Duration-time.Second)))
+	assert.Equal(t, Index(5), IndexFromTime(genesisTime.Add(5*Duration)))
+	assert.Equal(t, genesisTime.Add(5*Duration), Index(5).StartTime())
+	assert.Equal(t, Index(6).StartTime(), Index(5).EndTime())
+}
+
+func TestDiffStore(t *testing.T) {
+	diffStore := NewDiffStore(mapdb.NewMapDB())
+
+	created := newTestOutput(ledgerstate.GenesisTransactionID, 0, 100)
+	spent := newTestOutput(ledgerstate.GenesisTransactionID, 1, 200)
+	confirmedBranchID := ledgerstate.NewBranchID(ledgerstate.TransactionID{1})
+	rejectedBranchID := ledgerstate.NewBranchID(ledgerstate.TransactionID{2})
+
+	require.NoError(t, diffStore.StoreCreatedOutputs(1, ledgerstate.NewOutputs(created)))
+	require.NoError(t, diffStore.StoreSpentOutputs(1, ledgerstate.NewOutputs(spent)))
+	require.NoError(t, diffStore.StoreBranchResolution(1, confirmedBranchID, ledgerstate.Confirmed))
+	require.NoError(t, diffStore.StoreBranchResolution(1, rejectedBranchID, ledgerstate.Rejected))
+	require.Error(t, diffStore.StoreBranchResolution(1, rejectedBranchID, ledgerstate.Pending))
+	require.NoError(t, diffStore.StoreCreatedOutputs(2, ledgerstate.NewOutputs(spent)))
+
+	diff, err := diffStore.Diff(1)
+	require.NoError(t, err)
+	assert.Equal(t, Index(1), diff.Index)
+	require.Len(t, diff.Created, 1)
+	assert.Equal(t, created.ID(), diff.Created[0].ID())
+	assert.Equal(t, created.Bytes(), diff.Created[0].Bytes())
+	require.Len(t, diff.Spent, 1)
+	assert.Equal(t, spent.ID(), diff.Spent[0].ID())
+	assert.Equal(t, ledgerstate.NewBranchIDs(confirmedBranchID), diff.ConfirmedBranches)
+	assert.Equal(t, ledgerstate.NewBranchIDs(rejectedBranchID), diff.RejectedBranches)
+
+	emptyDiff, err := diffStore.Diff(3)
+	require.NoError(t, err)
+	assert.Empty(t, emptyDiff.Created)
+	assert.Empty(t, emptyDiff.Spent)
+	assert.Empty(t, emptyDiff.ConfirmedBranches)
+	assert.Empty(t, emptyDiff.RejectedBranches)
+}
+
+func newTestOutput(transactionID ledgerstate.TransactionID, index uint16, balance uint64) ledgerstate.Output {
+	output := ledgerstate.NewSigLockedSingleOutput(balance, ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))
+	output.SetID(ledgerstate.NewOutputID(transactionID, index))
+
+	return output
+}
@@ -0,0 +1,37 @@
+package epochs
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Index ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Duration defines the duration of an epoch.
+const Duration = time.Minute
+
+// Index is the index of an epoch, counted from the genesis time of the network.
+type Index uint64
+
+// IndexFromTime returns the index of the epoch that contains the given time.
+func IndexFromTime(t time.Time) Index {
+	elapsed := t.Unix() - tangle.DefaultGenesisTime
+	if elapsed < 0 {
+		return 0
+	}
+
+	return Index(elapsed / int64(Duration/time.Second))
+}
+
+// StartTime returns the time at which the epoch starts.
+func (i Index) StartTime() time.Time {
+	return time.Unix(tangle.DefaultGenesisTime+int64(i)*int64(Duration/time.Second), 0)
+}
+
+// EndTime returns the time at which the epoch ends (and the next epoch starts).
+func (i Index) EndTime() time.Time {
+	return i.StartTime().Add(Duration)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,49 @@
+package event
+
+import (
+	"reflect"
+	"runtime"
+	"strings"
+
+	"go.uber.org/atomic"
+)
+
+var closureIDCounter = atomic.NewUint64(0)
+
+// region Closure //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Closure is a handler function that can be attached to an Event. Its ID allows to detach it again.
+type Closure[T any] struct {
+	// ID is the unique identifier of the Closure.
+	ID uint64
+	// Name is the name of the function that is wrapped by the Closure, which identifies it in the handler metrics.
+	Name string
+	// Function is the handler function.
+	Function func(event T)
+}
+
+// NewClosure creates a new Closure for the given handler function.
+func NewClosure[T any](function func(event T)) *Closure[T] {
+	return &Closure[T]{
+		ID:       closureIDCounter.Inc(),
+		Name:     functionName(function),
+		Function: function,
+	}
+}
+
+// functionName returns the name of the given function without the path of its package.
+func functionName(function interface{}) string {
+	runtimeFunc := runtime.FuncForPC(reflect.ValueOf(function).Pointer())
+	if runtimeFunc == nil {
+		return "unknown"
+	}
+
+	name := runtimeFunc.Name()
+	if lastSlash := strings.LastIndex(name, "/"); lastSlash != -1 {
+		name = name[lastSlash+1:]
+	}
+
+	return name
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,119 @@
+package event
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/generics/orderedmap"
+)
+
+// region Event ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Event is a typed event that synchronously notifies the attached Closures when it is triggered. The execution of
+// every handler is measured, so that slow consumers that stall the caller of Trigger can be detected.
+type Event[T any] struct {
+	name          string
+	handlers      *orderedmap.OrderedMap[uint64, *handler[T]]
+	afterHandlers *orderedmap.OrderedMap[uint64, *handler[T]]
+}
+
+// New is the constructor of an Event with the given name. The name is used to identify the Event in the handler
+// metrics and in the reports of slow handlers.
+func New[T any](name string) *Event[T] {
+	return &Event[T]{
+		name:          name,
+		handlers:      orderedmap.New[uint64, *handler[T]](),
+		afterHandlers: orderedmap.New[uint64, *handler[T]](),
+	}
+}
+
+// Name returns the name of the Event.
+func (e *Event[T]) Name() string {
+	return e.name
+}
+
+// Attach registers the given Closure to be executed when the Event is triggered.
+func (e *Event[T]) Attach(closure *Closure[T]) {
+	if closure == nil {
+		return
+	}
+
+	e.handlers.Set(closure.ID, e.newHandler(closure))
+}
+
+// AttachAfter registers the given Closure to be executed after all Closures that were attached using Attach.
+func (e *Event[T]) AttachAfter(closure *Closure[T]) {
+	if closure == nil {
+		return
+	}
+
+	e.afterHandlers.Set(closure.ID, e.newHandler(closure))
+}
+
+// Detach unregisters the given Closure.
+func (e *Event[T]) Detach(closure *Closure[T]) {
+	if closure == nil {
+		return
+	}
+
+	e.handlers.Delete(closure.ID)
+	e.afterHandlers.Delete(closure.ID)
+}
+
+// DetachAll unregisters all Closures.
+func (e *Event[T]) DetachAll() {
+	e.handlers.Clear()
+	e.afterHandlers.Clear()
+}
+
+// Trigger executes the attached Closures with the given parameter.
+func (e *Event[T]) Trigger(event T) {
+	e.handlers.ForEach(e.executeHandler(event))
+	e.afterHandlers.ForEach(e.executeHandler(event))
+}
+
+// executeHandler returns the consumer that executes the handlers of the Event and reports the slow ones.
+func (e *Event[T]) executeHandler(event T) func(uint64, *handler[T]) bool {
+	return func(_ uint64, handler *handler[T]) bool {
+		if duration := handler.execute(event); duration >= SlowHandlerThreshold() && e.name != slowHandlerEventName {
+			Events.SlowHandler.Trigger(&SlowHandlerEvent{
+				EventName: e.name,
+				Handler:   handler.stats.handlerName,
+				Duration:  duration,
+			})
+		}
+
+		return true
+	}
+}
+
+func (e *Event[T]) newHandler(closure *Closure[T]) *handler[T] {
+	return &handler[T]{
+		function: closure.Function,
+		stats:    registry.stats(e.name, closure.Name),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region handler //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// handler wraps a Closure that is attached to an Event and records its executions.
+type handler[T any] struct {
+	function func(T)
+	stats    *handlerStats
+}
+
+// execute executes the handler with the given parameter and returns the duration of the execution.
+func (h *handler[T]) execute(event T) (duration time.Duration) {
+	h.stats.started()
+
+	start := time.Now()
+	h.function(event)
+	duration = time.Since(start)
+
+	h.stats.completed(duration)
+
+	return duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,73 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvent(t *testing.T) {
+	testEvent := New[int]("Test.Event")
+
+	var triggered []int
+	closure := NewClosure(func(value int) {
+		triggered = append(triggered, value)
+	})
+	testEvent.AttachAfter(NewClosure(func(value int) {
+		triggered = append(triggered, -value)
+	}))
+	testEvent.Attach(closure)
+
+	testEvent.Trigger(1)
+	assert.Equal(t, []int{1, -1}, triggered)
+
+	testEvent.Detach(closure)
+	testEvent.Trigger(2)
+	assert.Equal(t, []int{1, -1, -2}, triggered)
+
+	testEvent.DetachAll()
+	testEvent.Trigger(3)
+	assert.Equal(t, []int{1, -1, -2}, triggered)
+}
+
+func TestEvent_SlowHandler(t *testing.T) {
+	SetSlowHandlerThreshold(10 * time.Millisecond)
+	defer SetSlowHandlerThreshold(DefaultSlowHandlerThreshold)
+
+	var slowHandlers []*SlowHandlerEvent
+	slowHandlerClosure := NewClosure(func(event *SlowHandlerEvent) {
+		slowHandlers = append(slowHandlers, event)
+	})
+	Events.SlowHandler.Attach(slowHandlerClosure)
+	defer Events.SlowHandler.Detach(slowHandlerClosure)
+
+	testEvent := New[int]("Test.SlowEvent")
+	testEvent.Attach(NewClosure(func(int) {}))
+	testEvent.Attach(NewClosure(slowTestHandler))
+
+	testEvent.Trigger(1)
+	testEvent.Trigger(2)
+
+	require.Len(t, slowHandlers, 2)
+	assert.Equal(t, "Test.SlowEvent", slowHandlers[0].EventName)
+	assert.Equal(t, "event.slowTestHandler", slowHandlers[0].Handler)
+	assert.GreaterOrEqual(t, slowHandlers[0].Duration, 10*time.Millisecond)
+
+	var slowHandlerMetrics *HandlerMetrics
+	for _, handlerMetrics := range AllHandlerMetrics() {
+		if handlerMetrics.EventName == "Test.SlowEvent" && handlerMetrics.Handler == "event.slowTestHandler" {
+			slowHandlerMetrics = handlerMetrics
+		}
+	}
+	require.NotNil(t, slowHandlerMetrics)
+	assert.Equal(t, uint64(2), slowHandlerMetrics.Executions)
+	assert.Equal(t, uint64(2), slowHandlerMetrics.SlowExecutions)
+	assert.Equal(t, int64(0), slowHandlerMetrics.Pending)
+	assert.GreaterOrEqual(t, slowHandlerMetrics.MaxDuration, 10*time.Millisecond)
+}
+
+func slowTestHandler(int) {
+	time.Sleep(20 * time.Millisecond)
+}
@@ -0,0 +1,190 @@
+package event
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+// DefaultSlowHandlerThreshold is the default duration after which the execution of a handler is reported as slow.
+const DefaultSlowHandlerThreshold = 100 * time.Millisecond
+
+// slowHandlerEventName is the name of the SlowHandler event, whose own handlers are not checked for being slow.
+const slowHandlerEventName = "Event.SlowHandler"
+
+var (
+	// Events contains the events that report on the handlers of all Events.
+	Events = &EventsEvents{
+		SlowHandler: New[*SlowHandlerEvent](slowHandlerEventName),
+	}
+
+	slowHandlerThreshold = atomic.NewDuration(DefaultSlowHandlerThreshold)
+
+	registry = &handlerRegistry{
+		handlerStats: make(map[handlerKey]*handlerStats),
+	}
+)
+
+// SlowHandlerThreshold returns the duration after which the execution of a handler is reported as slow.
+func SlowHandlerThreshold() time.Duration {
+	return slowHandlerThreshold.Load()
+}
+
+// SetSlowHandlerThreshold sets the duration after which the execution of a handler is reported as slow.
+func SetSlowHandlerThreshold(threshold time.Duration) {
+	slowHandlerThreshold.Store(threshold)
+}
+
+// AllHandlerMetrics returns the metrics of all handlers that were attached to an Event. The metrics of handlers that
+// were created from the same function and attached to Events of the same name (i.e. of different Tangle instances) are
+// aggregated.
+func AllHandlerMetrics() []*HandlerMetrics {
+	return registry.metrics()
+}
+
+// region EventsEvents /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EventsEvents represents the events that report on the handlers of all Events.
+type EventsEvents struct {
+	// SlowHandler is triggered when the execution of a handler took longer than the SlowHandlerThreshold.
+	SlowHandler *Event[*SlowHandlerEvent]
+}
+
+// SlowHandlerEvent contains information about a handler whose execution took longer than the SlowHandlerThreshold.
+type SlowHandlerEvent struct {
+	// EventName is the name of the Event that was triggered.
+	EventName string
+	// Handler is the name of the slow handler.
+	Handler string
+	// Duration is the duration of the execution of the handler.
+	Duration time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region HandlerMetrics ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// HandlerMetrics contains the metrics of a handler that is attached to an Event.
+type HandlerMetrics struct {
+	// EventName is the name of the Event that the handler is attached to.
+	EventName string
+	// Handler is the name of the function of the handler.
+	Handler string
+	// Pending is the number of executions of the handler that are currently in progress, i.e. the number of callers
+	// of Trigger that are waiting for the handler.
+	Pending int64
+	// Executions is the number of completed executions of the handler.
+	Executions uint64
+	// SlowExecutions is the number of executions of the handler that took longer than the SlowHandlerThreshold.
+	SlowExecutions uint64
+	// TotalDuration is the accumulated duration of all completed executions of the handler.
+	TotalDuration time.Duration
+	// MaxDuration is the duration of the slowest execution of the handler.
+	MaxDuration time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region handlerRegistry //////////////////////////////////////////////////////////////////////////////////////////////
+
+// handlerKey identifies the handlers of the same function that are attached to Events of the same name.
+type handlerKey struct {
+	eventName   string
+	handlerName string
+}
+
+// handlerRegistry keeps track of the statistics of all handlers.
+type handlerRegistry struct {
+	handlerStats map[handlerKey]*handlerStats
+	mutex        sync.RWMutex
+}
+
+// stats returns the statistics of the given handler of the given Event.
+func (r *handlerRegistry) stats(eventName, handlerName string) *handlerStats {
+	key := handlerKey{eventName: eventName, handlerName: handlerName}
+
+	r.mutex.RLock()
+	stats, exists := r.handlerStats[key]
+	r.mutex.RUnlock()
+	if exists {
+		return stats
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if stats, exists = r.handlerStats[key]; !exists {
+		stats = &handlerStats{eventName: eventName, handlerName: handlerName}
+		r.handlerStats[key] = stats
+	}
+
+	return stats
+}
+
+func (r *handlerRegistry) metrics() (metrics []*HandlerMetrics) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	metrics = make([]*HandlerMetrics, 0, len(r.handlerStats))
+	for _, stats := range r.handlerStats {
+		metrics = append(metrics, stats.metrics())
+	}
+
+	return metrics
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region handlerStats /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// handlerStats records the executions of a handler.
+type handlerStats struct {
+	eventName      string
+	handlerName    string
+	pending        int64
+	executions     uint64
+	slowExecutions uint64
+	totalDuration  time.Duration
+	maxDuration    time.Duration
+	mutex          sync.Mutex
+}
+
+func (s *handlerStats) started() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.pending++
+}
+
+func (s *handlerStats) completed(duration time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.pending--
+	s.executions++
+	s.totalDuration += duration
+	if duration > s.maxDuration {
+		s.maxDuration = duration
+	}
+	if duration >= SlowHandlerThreshold() {
+		s.slowExecutions++
+	}
+}
+
+func (s *handlerStats) metrics() *HandlerMetrics {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return &HandlerMetrics{
+		EventName:      s.eventName,
+		Handler:        s.handlerName,
+		Pending:        s.pending,
+		Executions:     s.executions,
+		SlowExecutions: s.slowExecutions,
+		TotalDuration:  s.totalDuration,
+		MaxDuration:    s.maxDuration,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
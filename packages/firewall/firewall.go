@@ -3,24 +3,24 @@ package firewall
 import (
 	"sync"
 
-	"github.com/iotaledger/hive.go/autopeering/selection"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/logger"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 )
 
 // Firewall is a object responsible for taking actions on faulty peers.
 type Firewall struct {
 	gossipMgr                 *gossip.Manager
-	autopeering               *selection.Protocol
+	autopeering               *neighborselection.Protocol
 	log                       *logger.Logger
 	peersFaultinessCountMutex sync.RWMutex
 	peersFaultinessCount      map[identity.ID]int
 }
 
 // NewFirewall create a new instance of Firewall object.
-func NewFirewall(gossipMgr *gossip.Manager, autopeering *selection.Protocol, log *logger.Logger) (*Firewall, error) {
+func NewFirewall(gossipMgr *gossip.Manager, autopeering *neighborselection.Protocol, log *logger.Logger) (*Firewall, error) {
 	return &Firewall{
 		gossipMgr:            gossipMgr,
 		autopeering:          autopeering,
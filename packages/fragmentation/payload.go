@@ -0,0 +1,336 @@
+package fragmentation
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+const (
+	// PayloadName defines the name of the fragment payload.
+	PayloadName = "fragment"
+	payloadType = 7
+
+	// fragmentHeaderLength contains the amount of bytes of a marshaled Payload that precede the data of the fragment
+	// (DataID, Index, Count, DataSize and the length of the fragment data).
+	fragmentHeaderLength = DataIDLength + marshalutil.Uint16Size*2 + marshalutil.Uint32Size*2
+
+	// MaxFragmentSize contains the maximum amount of data that fits into a single fragment.
+	MaxFragmentSize = payload.MaxSize - payload.TypeLength - fragmentHeaderLength
+
+	// MaxFragmentCount contains the maximum number of fragments that the data can be split into.
+	MaxFragmentCount = 1024
+
+	// MaxDataSize contains the maximum amount of data that can be fragmented.
+	MaxDataSize = MaxFragmentCount * MaxFragmentSize
+)
+
+var (
+	// ErrDataEmpty is returned if empty data is fragmented.
+	ErrDataEmpty = errors.New("data is empty")
+
+	// ErrDataTooLarge is returned if the data exceeds MaxDataSize.
+	ErrDataTooLarge = errors.New("data is too large")
+
+	// ErrInconsistentFragment is returned if the metadata of a fragment does not match the other fragments of the data.
+	ErrInconsistentFragment = errors.New("fragment is inconsistent with the other fragments")
+
+	// ErrIntegrityViolated is returned if the reassembled data does not match its DataID.
+	ErrIntegrityViolated = errors.New("reassembled data does not match its DataID")
+
+	// ErrFragmentsMissing is returned if data is reassembled from an incomplete set of fragments.
+	ErrFragmentsMissing = errors.New("fragments are missing")
+)
+
+// region DataID ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DataIDLength contains the amount of bytes that a marshaled version of the DataID contains.
+const DataIDLength = blake2b.Size256
+
+// DataID is the identifier of fragmented data, which is the hash of the complete data. It links the fragments of the
+// same data and allows to verify the integrity of the reassembled data.
+type DataID [DataIDLength]byte
+
+// NewDataID returns the DataID of the given data.
+func NewDataID(data []byte) DataID {
+	return blake2b.Sum256(data)
+}
+
+// DataIDFromBase58 creates a DataID from a base58 encoded string.
+func DataIDFromBase58(base58String string) (dataID DataID, err error) {
+	bytes, err := base58.Decode(base58String)
+	if err != nil {
+		err = errors.Errorf("error while decoding base58 encoded DataID (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+		return
+	}
+
+	if dataID, err = DataIDFromMarshalUtil(marshalutil.New(bytes)); err != nil {
+		err = errors.Errorf("failed to parse DataID from bytes: %w", err)
+		return
+	}
+
+	return
+}
+
+// DataIDFromMarshalUtil unmarshals a DataID using a MarshalUtil (for easier unmarshaling).
+func DataIDFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (dataID DataID, err error) {
+	dataIDBytes, err := marshalUtil.ReadBytes(DataIDLength)
+	if err != nil {
+		err = errors.Errorf("failed to parse DataID (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	copy(dataID[:], dataIDBytes)
+
+	return
+}
+
+// Bytes returns a marshaled version of the DataID.
+func (d DataID) Bytes() []byte {
+	return d[:]
+}
+
+// Base58 returns a base58 encoded version of the DataID.
+func (d DataID) Base58() string {
+	return base58.Encode(d[:])
+}
+
+// String creates a human readable version of the DataID.
+func (d DataID) String() string {
+	return "DataID(" + d.Base58() + ")"
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Payload //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Payload represents a fragment of data that exceeds the maximum size of a payload. Every fragment carries the metadata
+// that is required to put the data back together: the DataID, the position of the fragment and the size of the data.
+type Payload struct {
+	DataID   DataID
+	Index    uint16
+	Count    uint16
+	DataSize uint32
+	Data     []byte
+}
+
+// Fragment splits the given data into fragments of at most MaxFragmentSize bytes, which can be issued in separate
+// messages.
+func Fragment(data []byte) (fragments []*Payload, err error) {
+	if len(data) == 0 {
+		return nil, ErrDataEmpty
+	}
+	if len(data) > MaxDataSize {
+		return nil, errors.Errorf("%d bytes exceed the maximum of %d bytes: %w", len(data), MaxDataSize, ErrDataTooLarge)
+	}
+
+	dataID := NewDataID(data)
+	count := (len(data) + MaxFragmentSize - 1) / MaxFragmentSize
+	fragments = make([]*Payload, count)
+	for i := range fragments {
+		end := (i + 1) * MaxFragmentSize
+		if end > len(data) {
+			end = len(data)
+		}
+
+		fragments[i] = &Payload{
+			DataID:   dataID,
+			Index:    uint16(i),
+			Count:    uint16(count),
+			DataSize: uint32(len(data)),
+			Data:     data[i*MaxFragmentSize : end],
+		}
+	}
+
+	return fragments, nil
+}
+
+// Reassemble puts the data back together from the given fragments, which can be in any order and can contain
+// duplicates. It returns an error if fragments are missing or if the reassembled data does not match its DataID.
+func Reassemble(fragments []*Payload) (data []byte, err error) {
+	if len(fragments) == 0 {
+		return nil, ErrFragmentsMissing
+	}
+
+	ordered := make([]*Payload, fragments[0].Count)
+	for _, fragment := range fragments {
+		if err = fragment.consistentWith(fragments[0]); err != nil {
+			return nil, err
+		}
+		ordered[fragment.Index] = fragment
+	}
+
+	data = make([]byte, 0, fragments[0].DataSize)
+	for i, fragment := range ordered {
+		if fragment == nil {
+			return nil, errors.Errorf("fragment %d of %d is missing: %w", i, len(ordered), ErrFragmentsMissing)
+		}
+		data = append(data, fragment.Data...)
+	}
+
+	if uint32(len(data)) != fragments[0].DataSize || NewDataID(data) != fragments[0].DataID {
+		return nil, errors.Errorf("failed to reassemble %s: %w", fragments[0].DataID, ErrIntegrityViolated)
+	}
+
+	return data, nil
+}
+
+// FromBytes unmarshals a Payload from a sequence of bytes.
+func FromBytes(bytes []byte) (result *Payload, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if result, err = FromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse fragment Payload from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FromMarshalUtil unmarshals a Payload using a MarshalUtil (for easier unmarshaling).
+func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (result *Payload, err error) {
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = errors.Errorf("failed to parse payload size of fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	parsedType, err := payload.TypeFromMarshalUtil(marshalUtil)
+	if err != nil {
+		err = errors.Errorf("failed to parse payload type of fragment payload: %w", err)
+		return
+	}
+	if parsedType != payloadType {
+		err = errors.Errorf("invalid payload type %s: %w", parsedType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	result = &Payload{}
+	if result.DataID, err = DataIDFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse DataID of fragment payload: %w", err)
+		return
+	}
+	if result.Index, err = marshalUtil.ReadUint16(); err != nil {
+		err = errors.Errorf("failed to parse index of fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.Count, err = marshalUtil.ReadUint16(); err != nil {
+		err = errors.Errorf("failed to parse count of fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.DataSize, err = marshalUtil.ReadUint32(); err != nil {
+		err = errors.Errorf("failed to parse data size of fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	dataLength, err := marshalUtil.ReadUint32()
+	if err != nil {
+		err = errors.Errorf("failed to parse data length of fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if dataLength > MaxFragmentSize {
+		err = errors.Errorf("data length %d exceeds the maximum of %d bytes: %w", dataLength, MaxFragmentSize, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.Data, err = marshalUtil.ReadBytes(int(dataLength)); err != nil {
+		err = errors.Errorf("failed to parse data of fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	if err = result.validate(); err != nil {
+		err = errors.Errorf("invalid fragment payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return result, nil
+}
+
+// Bytes returns a marshaled version of the Payload.
+func (p *Payload) Bytes() []byte {
+	payloadLength := fragmentHeaderLength + len(p.Data)
+
+	return marshalutil.New(marshalutil.Uint32Size + payload.TypeLength + payloadLength).
+		WriteUint32(payload.TypeLength + uint32(payloadLength)).
+		WriteBytes(Type.Bytes()).
+		WriteBytes(p.DataID.Bytes()).
+		WriteUint16(p.Index).
+		WriteUint16(p.Count).
+		WriteUint32(p.DataSize).
+		WriteUint32(uint32(len(p.Data))).
+		WriteBytes(p.Data).
+		Bytes()
+}
+
+// Type returns the type of the Payload.
+func (p *Payload) Type() payload.Type {
+	return Type
+}
+
+// String returns a human-friendly representation of the Payload.
+func (p *Payload) String() string {
+	return stringify.Struct("FragmentPayload",
+		stringify.StructField("dataID", p.DataID),
+		stringify.StructField("index", p.Index),
+		stringify.StructField("count", p.Count),
+		stringify.StructField("dataSize", p.DataSize),
+		stringify.StructField("data", len(p.Data)),
+	)
+}
+
+// validate checks that the metadata of the fragment is well-formed.
+func (p *Payload) validate() error {
+	switch {
+	case p.Count == 0 || p.Count > MaxFragmentCount:
+		return errors.Errorf("count %d is not within [1, %d]", p.Count, MaxFragmentCount)
+	case p.Index >= p.Count:
+		return errors.Errorf("index %d is not smaller than the count %d", p.Index, p.Count)
+	case p.DataSize == 0 || uint64(p.DataSize) > uint64(p.Count)*MaxFragmentSize:
+		return errors.Errorf("data size %d does not fit into %d fragments", p.DataSize, p.Count)
+	case p.Index < p.Count-1 && len(p.Data) != MaxFragmentSize:
+		return errors.Errorf("fragment %d is not the last one but holds only %d bytes", p.Index, len(p.Data))
+	}
+
+	return nil
+}
+
+// validLayout checks that the fragment count and the length of the fragment match the data size.
+func (p *Payload) validLayout() error {
+	if p.DataSize == 0 || p.DataSize > MaxDataSize || int(p.Count) != (int(p.DataSize)+MaxFragmentSize-1)/MaxFragmentSize || p.Index >= p.Count {
+		return errors.Errorf("fragment %d of %s has an invalid layout: %w", p.Index, p.DataID, ErrInconsistentFragment)
+	}
+
+	expectedLength := MaxFragmentSize
+	if p.Index == p.Count-1 {
+		expectedLength = int(p.DataSize) - int(p.Index)*MaxFragmentSize
+	}
+	if len(p.Data) != expectedLength {
+		return errors.Errorf("fragment %d of %s has unexpected length %d: %w", p.Index, p.DataID, len(p.Data), ErrInconsistentFragment)
+	}
+
+	return nil
+}
+
+// consistentWith checks that the fragment belongs to the same data as the given fragment.
+func (p *Payload) consistentWith(other *Payload) error {
+	if p.DataID != other.DataID || p.Count != other.Count || p.DataSize != other.DataSize || p.Index >= other.Count {
+		return errors.Errorf("fragment %d of %s: %w", p.Index, p.DataID, ErrInconsistentFragment)
+	}
+
+	return nil
+}
+
+// Type represents the identifier which addresses the fragment payload type.
+var Type = payload.NewType(payloadType, PayloadName, func(data []byte) (payload payload.Payload, err error) {
+	var consumedBytes int
+	payload, consumedBytes, err = FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if consumedBytes != len(data) {
+		return nil, errors.New("not all payload bytes were consumed")
+	}
+	return
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,97 @@
+package fragmentation
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestFragment(t *testing.T) {
+	data := randomData(t, 2*MaxFragmentSize+100)
+
+	fragments, err := Fragment(data)
+	require.NoError(t, err)
+	require.Len(t, fragments, 3)
+	assert.Len(t, fragments[2].Data, 100)
+
+	for i, fragment := range fragments {
+		assert.Equal(t, uint16(i), fragment.Index)
+		assert.Equal(t, NewDataID(data), fragment.DataID)
+
+		// every fragment fits into a message
+		fragmentBytes := fragment.Bytes()
+		parsedPayload, _, err := payload.FromBytes(fragmentBytes)
+		require.NoError(t, err)
+		assert.Equal(t, Type, parsedPayload.Type())
+		assert.Equal(t, fragment, parsedPayload)
+	}
+
+	_, err = Fragment(nil)
+	assert.True(t, errors.Is(err, ErrDataEmpty))
+	_, err = Fragment(make([]byte, MaxDataSize+1))
+	assert.True(t, errors.Is(err, ErrDataTooLarge))
+}
+
+func TestReassemble(t *testing.T) {
+	data := randomData(t, 3*MaxFragmentSize)
+	fragments, err := Fragment(data)
+	require.NoError(t, err)
+
+	// the order and duplicates don't matter
+	reassembled, err := Reassemble([]*Payload{fragments[2], fragments[0], fragments[2], fragments[1]})
+	require.NoError(t, err)
+	assert.Equal(t, data, reassembled)
+
+	_, err = Reassemble(fragments[:2])
+	assert.True(t, errors.Is(err, ErrFragmentsMissing))
+
+	tampered := *fragments[1]
+	tampered.Data = make([]byte, len(fragments[1].Data))
+	_, err = Reassemble([]*Payload{fragments[0], &tampered, fragments[2]})
+	assert.True(t, errors.Is(err, ErrIntegrityViolated))
+
+	foreign, err := Fragment(randomData(t, 10))
+	require.NoError(t, err)
+	_, err = Reassemble([]*Payload{fragments[0], foreign[0]})
+	assert.True(t, errors.Is(err, ErrInconsistentFragment))
+}
+
+func TestFromBytes_Invalid(t *testing.T) {
+	fragments, err := Fragment(randomData(t, MaxFragmentSize+1))
+	require.NoError(t, err)
+
+	for _, invalid := range []Payload{
+		{DataID: fragments[0].DataID, Index: 2, Count: 2, DataSize: MaxFragmentSize + 1, Data: []byte{1}},
+		{DataID: fragments[0].DataID, Index: 0, Count: 2, DataSize: 3 * MaxFragmentSize, Data: fragments[0].Data},
+		{DataID: fragments[0].DataID, Index: 0, Count: 2, DataSize: MaxFragmentSize + 1, Data: []byte{1}},
+		{DataID: fragments[0].DataID, Index: 0, Count: 0, DataSize: 1, Data: []byte{1}},
+	} {
+		invalid := invalid
+		_, _, err = FromBytes(invalid.Bytes())
+		assert.Error(t, err, invalid.String())
+	}
+}
+
+func TestDataIDFromBase58(t *testing.T) {
+	dataID := NewDataID([]byte("data"))
+
+	parsed, err := DataIDFromBase58(dataID.Base58())
+	require.NoError(t, err)
+	assert.Equal(t, dataID, parsed)
+
+	_, err = DataIDFromBase58("invalid")
+	assert.Error(t, err)
+}
+
+func randomData(t *testing.T, size int) []byte {
+	data := make([]byte, size)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	return data
+}
@@ -0,0 +1,319 @@
+package fragmentation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// ErrBufferFull is returned if a fragment of new data is received while the buffer of the Reassembler is full.
+var ErrBufferFull = errors.New("reassembly buffer is full")
+
+// region Reassembler //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Reassembler collects the fragments that are received in messages and puts the data back together once all of its
+// fragments arrived. Fragments can arrive in any order and the same fragment can be received several times, e.g. if it
+// was reattached. Both the pending and the reassembled data are kept until the configured timeout expires after the
+// first fragment was received.
+//
+// The buffer only accounts for the bytes of the received fragments, and every fragment is charged to the issuer of the
+// message that contained it, so that a single issuer can not exhaust the buffer. Fragments that claim a different
+// fragment count or data size for the same DataID are collected separately, so that a forged fragment that arrives
+// first can not prevent the genuine data from being reassembled.
+type Reassembler struct {
+	params   Params
+	timeFunc func() time.Time
+
+	reassemblies map[DataID]map[layout]*reassembly
+	bufferedSize int
+	issuerSizes  map[identity.ID]int
+	mutex        sync.Mutex
+}
+
+// NewReassembler is the constructor of the Reassembler.
+func NewReassembler(params Params, opts ...Option) (reassembler *Reassembler) {
+	reassembler = &Reassembler{
+		params:       params,
+		timeFunc:     clock.SyncedTime,
+		reassemblies: make(map[DataID]map[layout]*reassembly),
+		issuerSizes:  make(map[identity.ID]int),
+	}
+
+	for _, opt := range opts {
+		opt(reassembler)
+	}
+
+	return reassembler
+}
+
+// AddFragment adds a fragment that was received in the message with the given ID from the given issuer. It returns true
+// together with the reassembled data if the fragment completed the data. Fragments with an invalid layout are
+// rejected, and if the complete data does not match its DataID, all of its fragments are dropped, so that the data can
+// be received again.
+func (r *Reassembler) AddFragment(fragment *Payload, messageID tangle.MessageID, issuer identity.ID) (data []byte, completed bool, err error) {
+	if err = fragment.validLayout(); err != nil {
+		return nil, false, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	variants, exists := r.reassemblies[fragment.DataID]
+	if !exists {
+		variants = make(map[layout]*reassembly)
+		r.reassemblies[fragment.DataID] = variants
+	}
+	for _, variant := range variants {
+		if variant.data != nil {
+			return nil, false, nil
+		}
+	}
+
+	fragmentLayout := layoutOf(fragment)
+	pending, exists := variants[fragmentLayout]
+	if exists && pending.fragments[fragment.Index] != nil {
+		return nil, false, nil
+	}
+
+	fragmentSize := len(fragment.Data)
+	if r.params.MaxBufferedSize > 0 && r.bufferedSize+fragmentSize > r.params.MaxBufferedSize {
+		r.removeEmpty(fragment.DataID)
+		return nil, false, errors.Errorf("failed to buffer %d bytes of %s: %w", fragmentSize, fragment.DataID, ErrBufferFull)
+	}
+	if r.params.MaxBufferedSizePerIssuer > 0 && r.issuerSizes[issuer]+fragmentSize > r.params.MaxBufferedSizePerIssuer {
+		r.removeEmpty(fragment.DataID)
+		return nil, false, errors.Errorf("failed to buffer %d bytes of %s from %s: %w", fragmentSize, fragment.DataID, issuer, ErrBufferFull)
+	}
+
+	if !exists {
+		pending = newReassembly(fragment, r.timeFunc())
+		variants[fragmentLayout] = pending
+	}
+	pending.fragments[fragment.Index] = fragment
+	pending.messageIDs[fragment.Index] = messageID
+	pending.charge(issuer, fragmentSize)
+	r.bufferedSize += fragmentSize
+	r.issuerSizes[issuer] += fragmentSize
+
+	if pending.received++; pending.received < len(pending.fragments) {
+		return nil, false, nil
+	}
+
+	if pending.data, err = Reassemble(pending.fragments); err != nil {
+		r.removeVariant(fragment.DataID, fragmentLayout)
+		return nil, false, err
+	}
+	pending.fragments = nil
+
+	// the other variants of the data were forged, as only one layout can match the DataID
+	for otherLayout := range variants {
+		if otherLayout != fragmentLayout {
+			r.removeVariant(fragment.DataID, otherLayout)
+		}
+	}
+
+	return pending.data, true, nil
+}
+
+// Reassembly returns the Reassembly of the data with the given DataID. If fragments with different layouts were
+// received for the DataID, the completed data or the variant with the most received fragments is returned.
+func (r *Reassembler) Reassembly(dataID DataID) (result *Reassembly, exists bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var best *reassembly
+	for _, variant := range r.reassemblies[dataID] {
+		if best == nil || variant.data != nil || (best.data == nil && variant.received > best.received) {
+			best = variant
+		}
+	}
+	if best == nil {
+		return nil, false
+	}
+
+	return best.snapshot(), true
+}
+
+// BufferedSize returns the total size of the pending and the reassembled data that is kept by the Reassembler.
+func (r *Reassembler) BufferedSize() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.bufferedSize
+}
+
+// BufferedSizeOfIssuer returns the size of the fragments of the given issuer that are kept by the Reassembler.
+func (r *Reassembler) BufferedSizeOfIssuer(issuer identity.ID) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.issuerSizes[issuer]
+}
+
+// Prune drops the data whose first fragment was received longer than the timeout ago.
+func (r *Reassembler) Prune() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	expiryTime := r.timeFunc().Add(-r.params.Timeout)
+	for dataID, variants := range r.reassemblies {
+		for fragmentLayout, pending := range variants {
+			if pending.firstSeen.Before(expiryTime) {
+				r.removeVariant(dataID, fragmentLayout)
+			}
+		}
+	}
+}
+
+// removeVariant drops the data with the given DataID and layout and releases its buffer (the mutex needs to be held by
+// the caller).
+func (r *Reassembler) removeVariant(dataID DataID, fragmentLayout layout) {
+	pending, exists := r.reassemblies[dataID][fragmentLayout]
+	if !exists {
+		return
+	}
+
+	for issuer, size := range pending.charges {
+		r.bufferedSize -= size
+		if r.issuerSizes[issuer] -= size; r.issuerSizes[issuer] <= 0 {
+			delete(r.issuerSizes, issuer)
+		}
+	}
+
+	delete(r.reassemblies[dataID], fragmentLayout)
+	r.removeEmpty(dataID)
+}
+
+// removeEmpty drops the entry of the given DataID if it has no variants (the mutex needs to be held by the caller).
+func (r *Reassembler) removeEmpty(dataID DataID) {
+	if len(r.reassemblies[dataID]) == 0 {
+		delete(r.reassemblies, dataID)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region layout ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// layout contains the metadata of a fragment that needs to be the same for all fragments of the same data.
+type layout struct {
+	count    uint16
+	dataSize uint32
+}
+
+// layoutOf returns the layout of the given fragment.
+func layoutOf(fragment *Payload) layout {
+	return layout{
+		count:    fragment.Count,
+		dataSize: fragment.DataSize,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Reassembly ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Reassembly contains the state of the reassembly of fragmented data.
+type Reassembly struct {
+	// DataID is the identifier of the data.
+	DataID DataID
+	// DataSize is the size of the complete data.
+	DataSize uint32
+	// FragmentCount is the number of fragments that the data was split into.
+	FragmentCount int
+	// MessageIDs contains the IDs of the messages that contained the fragments, in the order of the fragments (an
+	// EmptyMessageID marks a fragment that was not received, yet).
+	MessageIDs []tangle.MessageID
+	// ReceivedFragments is the number of fragments that were received.
+	ReceivedFragments int
+	// FirstSeen is the time at which the first fragment was received.
+	FirstSeen time.Time
+	// Data contains the reassembled data (nil if the data is not complete, yet).
+	Data []byte
+}
+
+// Complete returns true if all fragments were received and the data was reassembled.
+func (r *Reassembly) Complete() bool {
+	return r.Data != nil
+}
+
+// reassembly is the internal state of the reassembly of fragmented data.
+type reassembly struct {
+	dataID     DataID
+	dataSize   uint32
+	fragments  []*Payload
+	messageIDs []tangle.MessageID
+	received   int
+	charges    map[identity.ID]int
+	firstSeen  time.Time
+	data       []byte
+}
+
+// newReassembly creates the reassembly of the data that the given fragment belongs to.
+func newReassembly(fragment *Payload, firstSeen time.Time) *reassembly {
+	return &reassembly{
+		dataID:     fragment.DataID,
+		dataSize:   fragment.DataSize,
+		fragments:  make([]*Payload, fragment.Count),
+		messageIDs: make([]tangle.MessageID, fragment.Count),
+		charges:    make(map[identity.ID]int),
+		firstSeen:  firstSeen,
+	}
+}
+
+// charge records that the given amount of buffered bytes was received from the given issuer.
+func (r *reassembly) charge(issuer identity.ID, size int) {
+	r.charges[issuer] += size
+}
+
+// snapshot returns a copy of the reassembly that can be handed out to callers.
+func (r *reassembly) snapshot() *Reassembly {
+	messageIDs := make([]tangle.MessageID, len(r.messageIDs))
+	copy(messageIDs, r.messageIDs)
+
+	return &Reassembly{
+		DataID:            r.dataID,
+		DataSize:          r.dataSize,
+		FragmentCount:     len(r.messageIDs),
+		MessageIDs:        messageIDs,
+		ReceivedFragments: r.received,
+		FirstSeen:         r.firstSeen,
+		Data:              r.data,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Params ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Params contains the parameters of the Reassembler.
+type Params struct {
+	// Timeout defines the time after the first fragment was received after which the data is dropped.
+	Timeout time.Duration
+	// MaxBufferedSize defines the maximum total size of the data that is kept by the Reassembler (0 disables the limit).
+	MaxBufferedSize int
+	// MaxBufferedSizePerIssuer defines the maximum size of the fragments of a single issuer that are kept by the
+	// Reassembler (0 disables the limit).
+	MaxBufferedSizePerIssuer int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option is the type of the optional parameters of the Reassembler.
+type Option func(reassembler *Reassembler)
+
+// WithTimeFunc is an Option for the Reassembler that overrides the function that determines the current time.
+func WithTimeFunc(timeFunc func() time.Time) Option {
+	return func(reassembler *Reassembler) {
+		reassembler.timeFunc = timeFunc
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,169 @@
+package fragmentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestReassembler(t *testing.T) {
+	issuer := identity.GenerateIdentity().ID()
+	now := time.Now()
+	reassembler := NewReassembler(Params{Timeout: time.Minute, MaxBufferedSize: 5 * MaxFragmentSize}, WithTimeFunc(func() time.Time { return now }))
+
+	data := randomData(t, 2*MaxFragmentSize+10)
+	fragments, err := Fragment(data)
+	require.NoError(t, err)
+
+	for i, index := range []int{2, 0, 2} {
+		_, completed, err := reassembler.AddFragment(fragments[index], messageID(i), issuer)
+		require.NoError(t, err)
+		assert.False(t, completed)
+	}
+
+	assert.Equal(t, len(fragments[0].Data)+len(fragments[2].Data), reassembler.BufferedSize())
+	assert.Equal(t, reassembler.BufferedSize(), reassembler.BufferedSizeOfIssuer(issuer))
+
+	reassembly, exists := reassembler.Reassembly(fragments[0].DataID)
+	require.True(t, exists)
+	assert.False(t, reassembly.Complete())
+	assert.Equal(t, 2, reassembly.ReceivedFragments)
+	assert.Equal(t, 3, reassembly.FragmentCount)
+	assert.Equal(t, []tangle.MessageID{messageID(1), tangle.EmptyMessageID, messageID(0)}, reassembly.MessageIDs)
+
+	reassembled, completed, err := reassembler.AddFragment(fragments[1], messageID(3), issuer)
+	require.NoError(t, err)
+	assert.True(t, completed)
+	assert.Equal(t, data, reassembled)
+
+	// fragments of completed data are ignored
+	_, completed, err = reassembler.AddFragment(fragments[1], messageID(4), issuer)
+	require.NoError(t, err)
+	assert.False(t, completed)
+
+	reassembly, exists = reassembler.Reassembly(fragments[0].DataID)
+	require.True(t, exists)
+	assert.True(t, reassembly.Complete())
+	assert.Equal(t, data, reassembly.Data)
+
+	// the buffer is limited by the received bytes
+	otherFragments, err := Fragment(randomData(t, 4*MaxFragmentSize))
+	require.NoError(t, err)
+	for i, fragment := range otherFragments[:2] {
+		_, _, err = reassembler.AddFragment(fragment, messageID(5+i), issuer)
+		require.NoError(t, err)
+	}
+	_, _, err = reassembler.AddFragment(otherFragments[2], messageID(7), issuer)
+	assert.True(t, errors.Is(err, ErrBufferFull))
+
+	// the data is dropped after the timeout
+	now = now.Add(2 * time.Minute)
+	reassembler.Prune()
+	_, exists = reassembler.Reassembly(fragments[0].DataID)
+	assert.False(t, exists)
+	assert.Zero(t, reassembler.BufferedSize())
+}
+
+func TestReassembler_IssuerLimit(t *testing.T) {
+	reassembler := NewReassembler(Params{Timeout: time.Minute, MaxBufferedSizePerIssuer: 2 * MaxFragmentSize})
+	spammer := identity.GenerateIdentity().ID()
+	issuer := identity.GenerateIdentity().ID()
+
+	spam, err := Fragment(randomData(t, 4*MaxFragmentSize))
+	require.NoError(t, err)
+	for i, fragment := range spam[:2] {
+		_, _, err = reassembler.AddFragment(fragment, messageID(i), spammer)
+		require.NoError(t, err)
+	}
+	_, _, err = reassembler.AddFragment(spam[2], messageID(2), spammer)
+	assert.True(t, errors.Is(err, ErrBufferFull))
+
+	// other issuers are not affected by the spammer
+	data := randomData(t, MaxFragmentSize+10)
+	fragments, err := Fragment(data)
+	require.NoError(t, err)
+	for i, fragment := range fragments {
+		reassembled, _, err := reassembler.AddFragment(fragment, messageID(3+i), issuer)
+		require.NoError(t, err)
+		if i == len(fragments)-1 {
+			assert.Equal(t, data, reassembled)
+		}
+	}
+	assert.Equal(t, 2*MaxFragmentSize, reassembler.BufferedSizeOfIssuer(spammer))
+	assert.Equal(t, len(data), reassembler.BufferedSizeOfIssuer(issuer))
+}
+
+func TestReassembler_ForgedFirstFragment(t *testing.T) {
+	issuer := identity.GenerateIdentity().ID()
+	reassembler := NewReassembler(Params{Timeout: time.Minute})
+
+	data := randomData(t, MaxFragmentSize+10)
+	fragments, err := Fragment(data)
+	require.NoError(t, err)
+
+	// a fragment that claims a different layout for the same DataID does not block the genuine fragments
+	forged := &Payload{DataID: fragments[0].DataID, Index: 0, Count: 3, DataSize: 2*MaxFragmentSize + 1, Data: make([]byte, MaxFragmentSize)}
+	_, _, err = reassembler.AddFragment(forged, messageID(0), issuer)
+	require.NoError(t, err)
+
+	for i, fragment := range fragments {
+		reassembled, _, err := reassembler.AddFragment(fragment, messageID(1+i), issuer)
+		require.NoError(t, err)
+		if i == len(fragments)-1 {
+			assert.Equal(t, data, reassembled)
+		}
+	}
+
+	reassembly, exists := reassembler.Reassembly(fragments[0].DataID)
+	require.True(t, exists)
+	assert.True(t, reassembly.Complete())
+	assert.Equal(t, len(data), reassembler.BufferedSize())
+}
+
+func TestReassembler_IntegrityViolated(t *testing.T) {
+	issuer := identity.GenerateIdentity().ID()
+	reassembler := NewReassembler(Params{Timeout: time.Minute})
+
+	data := randomData(t, MaxFragmentSize+10)
+	fragments, err := Fragment(data)
+	require.NoError(t, err)
+
+	tampered := *fragments[1]
+	tampered.Data = make([]byte, len(fragments[1].Data))
+	_, _, err = reassembler.AddFragment(fragments[0], messageID(0), issuer)
+	require.NoError(t, err)
+	_, _, err = reassembler.AddFragment(&tampered, messageID(1), issuer)
+	assert.True(t, errors.Is(err, ErrIntegrityViolated))
+
+	// the data can be received again
+	_, exists := reassembler.Reassembly(fragments[0].DataID)
+	assert.False(t, exists)
+	for i, fragment := range fragments {
+		reassembled, _, err := reassembler.AddFragment(fragment, messageID(i), issuer)
+		require.NoError(t, err)
+		if i == len(fragments)-1 {
+			assert.Equal(t, data, reassembled)
+		}
+	}
+
+	// fragments with an invalid layout are rejected
+	inconsistent := *fragments[0]
+	inconsistent.Count = 3
+	reassembler = NewReassembler(Params{Timeout: time.Minute})
+	_, _, err = reassembler.AddFragment(fragments[1], messageID(0), issuer)
+	require.NoError(t, err)
+	_, _, err = reassembler.AddFragment(&inconsistent, messageID(1), issuer)
+	assert.True(t, errors.Is(err, ErrInconsistentFragment))
+}
+
+func messageID(index int) (id tangle.MessageID) {
+	id[0] = byte(index + 1)
+
+	return id
+}
@@ -0,0 +1,171 @@
+package gossip
+
+import (
+	"context"
+	"net"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/iotaledger/goshimmer/packages/libp2putil"
+)
+
+// maxAdvertisedAddresses defines the maximum number of addresses that are accepted from a neighbor.
+const maxAdvertisedAddresses = 8
+
+// ErrInvalidAddress is returned if a gossip address is not a valid host:port pair.
+var ErrInvalidAddress = errors.New("invalid gossip address")
+
+// region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithAdvertisedAddresses allows to set the addresses (host:port with an IPv4, IPv6 or DNS host) under which the node
+// can be reached. They are announced to the neighbors during the handshake in the given order of preference, so that
+// neighbors can reconnect via any of them.
+func WithAdvertisedAddresses(addresses ...string) ManagerOption {
+	return func(m *Manager) {
+		m.advertisedAddresses = addresses
+	}
+}
+
+// WithAddresses returns a ConnectPeerOption that sets the addresses (host:port with an IPv4, IPv6 or DNS host) of the
+// peer that are tried before the addresses that the peer announced and the address of its peer record.
+func WithAddresses(addresses ...string) ConnectPeerOption {
+	return func(conf *connectPeerConfig) {
+		conf.addresses = addresses
+	}
+}
+
+// AdvertisedAddresses returns the addresses that are announced to the neighbors during the handshake.
+func (m *Manager) AdvertisedAddresses() []string {
+	return append([]string{}, m.advertisedAddresses...)
+}
+
+// PeerAddresses returns the addresses of the given peer in the order in which they are dialed: the addresses that the
+// peer announced during the last handshake followed by the address of its peer record.
+func (m *Manager) PeerAddresses(p *peer.Peer) []string {
+	return m.dialAddresses(p, &connectPeerConfig{})
+}
+
+// dialAddresses returns the addresses of the given peer in the order in which they are dialed.
+func (m *Manager) dialAddresses(p *peer.Peer, conf *connectPeerConfig) (addresses []string) {
+	seen := make(map[string]bool)
+	addAddresses := func(candidates ...string) {
+		for _, address := range candidates {
+			if !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+
+	addAddresses(conf.addresses...)
+	if libp2pID, err := libp2putil.ToLibp2pPeerID(p); err == nil {
+		m.peerAddressesMutex.RLock()
+		addAddresses(m.peerAddresses[libp2pID]...)
+		m.peerAddressesMutex.RUnlock()
+	}
+	if gossipEndpoint := p.Services().Get(service.GossipKey); gossipEndpoint != nil && !p.IP().IsUnspecified() {
+		addAddresses(net.JoinHostPort(p.IP().String(), strconv.Itoa(gossipEndpoint.Port())))
+	}
+
+	return addresses
+}
+
+// rememberAddresses stores the valid addresses that the given neighbor announced during the handshake.
+func (m *Manager) rememberAddresses(libp2pID libp2ppeer.ID, announced []string) {
+	addresses := make([]string, 0, len(announced))
+	for _, address := range announced {
+		if len(addresses) == maxAdvertisedAddresses {
+			break
+		}
+		if _, err := AddressToMultiaddr(address); err != nil {
+			m.log.Debugw("ignoring invalid address announced by neighbor", "id", libp2pID, "addr", address, "err", err)
+			continue
+		}
+		addresses = append(addresses, address)
+	}
+
+	m.peerAddressesMutex.Lock()
+	defer m.peerAddressesMutex.Unlock()
+
+	if len(addresses) == 0 {
+		delete(m.peerAddresses, libp2pID)
+		return
+	}
+	m.peerAddresses[libp2pID] = addresses
+}
+
+// connectAddress connects to the peer with the given ID via the given address only, so that the addresses of a peer
+// can be tried one after the other in the order of preference. DNS hosts are resolved by the libp2p host.
+func (m *Manager) connectAddress(ctx context.Context, libp2pID libp2ppeer.ID, address string) error {
+	addr, err := AddressToMultiaddr(address)
+	if err != nil {
+		return err
+	}
+
+	m.Libp2pHost.Peerstore().ClearAddrs(libp2pID)
+	m.Libp2pHost.Peerstore().AddAddr(libp2pID, addr, peerstore.ConnectedAddrTTL)
+
+	return m.Libp2pHost.Connect(ctx, libp2ppeer.AddrInfo{ID: libp2pID})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// AddressToMultiaddr returns the TCP multiaddr of the given gossip address (host:port), whose host can be an IPv4
+// address, an IPv6 address or a DNS name.
+func AddressToMultiaddr(address string) (multiaddr.Multiaddr, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, errors.Errorf("failed to split %s (%v): %w", address, err, ErrInvalidAddress)
+	}
+	if portNumber, err := strconv.ParseUint(port, 10, 16); err != nil || portNumber == 0 {
+		return nil, errors.Errorf("invalid port of %s: %w", address, ErrInvalidAddress)
+	}
+
+	var protocol string
+	switch ip := net.ParseIP(host); {
+	case ip == nil && host != "":
+		protocol = "dns"
+	case ip == nil || ip.IsUnspecified():
+		return nil, errors.Errorf("missing host of %s: %w", address, ErrInvalidAddress)
+	case ip.To4() != nil:
+		protocol, host = "ip4", ip.To4().String()
+	default:
+		protocol = "ip6"
+	}
+
+	addr, err := multiaddr.NewMultiaddr("/" + protocol + "/" + host + "/tcp/" + port)
+	if err != nil {
+		return nil, errors.Errorf("failed to create multiaddr of %s (%v): %w", address, err, ErrInvalidAddress)
+	}
+
+	return addr, nil
+}
+
+// ListenMultiaddr returns the TCP multiaddr that the gossip server listens on for the given bind address, whose host
+// must be an IPv4 or an IPv6 address (which can be unspecified to listen on all interfaces).
+func ListenMultiaddr(bindAddress string) (multiaddr.Multiaddr, error) {
+	tcpAddress, err := net.ResolveTCPAddr("tcp", bindAddress)
+	if err != nil {
+		return nil, errors.Errorf("failed to resolve %s (%v): %w", bindAddress, err, ErrInvalidAddress)
+	}
+
+	protocol, ip := "ip6", tcpAddress.IP
+	switch {
+	case ip == nil:
+		protocol, ip = "ip4", net.IPv4zero
+	case ip.To4() != nil:
+		protocol, ip = "ip4", ip.To4()
+	}
+
+	return multiaddr.NewMultiaddr("/" + protocol + "/" + ip.String() + "/tcp/" + strconv.Itoa(tcpAddress.Port))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,60 @@
+package gossip
+
+import (
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressToMultiaddr(t *testing.T) {
+	for address, expected := range map[string]string{
+		"203.0.113.1:14666":      "/ip4/203.0.113.1/tcp/14666",
+		"[2001:db8::1]:14666":    "/ip6/2001:db8::1/tcp/14666",
+		"[::ffff:10.0.0.1]:1":    "/ip4/10.0.0.1/tcp/1",
+		"node.example.org:14666": "/dns/node.example.org/tcp/14666",
+	} {
+		addr, err := AddressToMultiaddr(address)
+		require.NoError(t, err)
+		assert.Equal(t, expected, addr.String())
+	}
+
+	for _, address := range []string{"203.0.113.1", "0.0.0.0:14666", "[::]:14666", ":14666", "host:0", "host:port"} {
+		_, err := AddressToMultiaddr(address)
+		assert.ErrorIs(t, err, ErrInvalidAddress, address)
+	}
+}
+
+func TestListenMultiaddr(t *testing.T) {
+	for bindAddress, expected := range map[string]string{
+		"0.0.0.0:14666": "/ip4/0.0.0.0/tcp/14666",
+		"[::]:14666":    "/ip6/::/tcp/14666",
+		":14666":        "/ip4/0.0.0.0/tcp/14666",
+	} {
+		addr, err := ListenMultiaddr(bindAddress)
+		require.NoError(t, err)
+		assert.Equal(t, expected, addr.String())
+	}
+}
+
+func TestAdvertisedAddresses(t *testing.T) {
+	testMgrs := newTestManagers(t, false /* doMock */, t.Name()+"_A", t.Name()+"_B")
+	mgrA, closeA, peerA := testMgrs[0].manager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].manager, testMgrs[1].close, testMgrs[1].peer
+	defer closeA()
+	defer closeB()
+
+	mgrA.advertisedAddresses = []string{"[2001:db8::1]:14666", "invalid", "node.example.org:14666"}
+	recordAddress := net.JoinHostPort(peerA.IP().String(), strconv.Itoa(peerA.Services().Get(service.GossipKey).Port()))
+	assert.Equal(t, []string{recordAddress}, mgrB.PeerAddresses(peerA))
+
+	connectManagers(t, mgrA, peerA, mgrB, peerB)
+
+	// the announced addresses are preferred over the address of the peer record and invalid ones are ignored
+	assert.Equal(t, []string{"[2001:db8::1]:14666", "node.example.org:14666", recordAddress}, mgrB.PeerAddresses(peerA))
+	assert.Equal(t, []string{"203.0.113.1:14666", "[2001:db8::1]:14666", "node.example.org:14666", recordAddress}, mgrB.dialAddresses(peerA, buildConnectPeerConfig([]ConnectPeerOption{WithAddresses("203.0.113.1:14666")})))
+	assert.Equal(t, []string{recordAddress}, mgrA.PeerAddresses(peerB))
+}
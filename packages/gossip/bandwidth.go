@@ -0,0 +1,155 @@
+package gossip
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/paulbellamy/ratecounter"
+	"go.uber.org/atomic"
+)
+
+// DefaultBandwidthWindow defines the default time window in which the traffic of every neighbor is accounted.
+const DefaultBandwidthWindow = time.Minute
+
+// region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithBandwidthWindow allows to set the rolling time window in which the traffic of every neighbor is accounted.
+func WithBandwidthWindow(window time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.bandwidthWindow = window
+	}
+}
+
+// WithOutboundBandwidthCap allows to set the soft cap of bytes per bandwidth window that are sent to every neighbor
+// whose cap was not set with SetOutboundBandwidthCap. A cap of 0 disables the throttling.
+func WithOutboundBandwidthCap(bytesPerWindow uint64) ManagerOption {
+	return func(m *Manager) {
+		m.defaultOutboundBandwidthCap = bytesPerWindow
+	}
+}
+
+// BandwidthWindow returns the rolling time window in which the traffic of every neighbor is accounted.
+func (m *Manager) BandwidthWindow() time.Duration {
+	return m.bandwidthWindow
+}
+
+// SetOutboundBandwidthCap sets the soft cap of bytes per bandwidth window that are sent to the neighbor with the given
+// ID, overriding the default cap. Once a neighbor reaches its cap, the messages that are gossiped to it are dropped
+// until its traffic of the window falls below the cap again. A cap of 0 disables the throttling of the neighbor. The
+// cap applies to the current connection of the neighbor as well as to future ones.
+func (m *Manager) SetOutboundBandwidthCap(id identity.ID, bytesPerWindow uint64) {
+	m.outboundBandwidthCapsMutex.Lock()
+	m.outboundBandwidthCaps[id] = bytesPerWindow
+	m.outboundBandwidthCapsMutex.Unlock()
+
+	if nbr, err := m.GetNeighbor(id); err == nil {
+		nbr.bandwidth.outboundCap.Store(bytesPerWindow)
+	}
+}
+
+// ResetOutboundBandwidthCap removes the cap that was set for the neighbor with the given ID, so that the default cap
+// applies to it again.
+func (m *Manager) ResetOutboundBandwidthCap(id identity.ID) {
+	m.outboundBandwidthCapsMutex.Lock()
+	delete(m.outboundBandwidthCaps, id)
+	m.outboundBandwidthCapsMutex.Unlock()
+
+	if nbr, err := m.GetNeighbor(id); err == nil {
+		nbr.bandwidth.outboundCap.Store(m.defaultOutboundBandwidthCap)
+	}
+}
+
+// OutboundBandwidthCap returns the soft cap of bytes per bandwidth window that are sent to the neighbor with the given
+// ID (0 if the neighbor is not throttled).
+func (m *Manager) OutboundBandwidthCap(id identity.ID) uint64 {
+	m.outboundBandwidthCapsMutex.RLock()
+	defer m.outboundBandwidthCapsMutex.RUnlock()
+
+	if bytesPerWindow, exists := m.outboundBandwidthCaps[id]; exists {
+		return bytesPerWindow
+	}
+
+	return m.defaultOutboundBandwidthCap
+}
+
+// ThrottledOutboundMessagesCount returns the number of messages that were not sent to neighbors because they reached
+// their outbound bandwidth cap.
+func (m *Manager) ThrottledOutboundMessagesCount() uint64 {
+	return m.throttledOutboundMessages.Load()
+}
+
+// throttle returns true and counts the message if the neighbor reached its outbound bandwidth cap.
+func (m *Manager) throttle(nbr *Neighbor) bool {
+	if !nbr.IsThrottled() {
+		return false
+	}
+	nbr.bandwidth.throttledMessages.Inc()
+	m.throttledOutboundMessages.Inc()
+
+	return true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region bandwidthAccount /////////////////////////////////////////////////////////////////////////////////////////////
+
+// bandwidthAccount keeps track of the traffic of a single neighbor in a rolling time window.
+type bandwidthAccount struct {
+	window      time.Duration
+	inbound     *ratecounter.RateCounter
+	outbound    *ratecounter.RateCounter
+	outboundCap *atomic.Uint64
+
+	throttledMessages *atomic.Uint64
+}
+
+// newBandwidthAccount creates a bandwidthAccount with the given window and outbound cap (0 disables the throttling).
+func newBandwidthAccount(window time.Duration, outboundCap uint64) *bandwidthAccount {
+	return &bandwidthAccount{
+		window:            window,
+		inbound:           ratecounter.NewRateCounter(window),
+		outbound:          ratecounter.NewRateCounter(window),
+		outboundCap:       atomic.NewUint64(outboundCap),
+		throttledMessages: atomic.NewUint64(0),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Neighbor /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// BandwidthWindow returns the rolling time window in which the traffic of the neighbor is accounted.
+func (n *Neighbor) BandwidthWindow() time.Duration {
+	return n.bandwidth.window
+}
+
+// InboundBandwidth returns the number of bytes that were received from the neighbor during the bandwidth window.
+func (n *Neighbor) InboundBandwidth() uint64 {
+	return uint64(n.bandwidth.inbound.Rate())
+}
+
+// OutboundBandwidth returns the number of bytes that were sent to the neighbor during the bandwidth window.
+func (n *Neighbor) OutboundBandwidth() uint64 {
+	return uint64(n.bandwidth.outbound.Rate())
+}
+
+// OutboundBandwidthCap returns the soft cap of bytes per bandwidth window that are sent to the neighbor (0 if the
+// neighbor is not throttled).
+func (n *Neighbor) OutboundBandwidthCap() uint64 {
+	return n.bandwidth.outboundCap.Load()
+}
+
+// IsThrottled returns true if the neighbor reached its outbound bandwidth cap, so that no messages are gossiped to it.
+func (n *Neighbor) IsThrottled() bool {
+	outboundCap := n.bandwidth.outboundCap.Load()
+
+	return outboundCap != 0 && n.OutboundBandwidth() >= outboundCap
+}
+
+// ThrottledMessages returns the number of messages that were not sent to the neighbor because it reached its outbound
+// bandwidth cap.
+func (n *Neighbor) ThrottledMessages() uint64 {
+	return n.bandwidth.throttledMessages.Load()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,53 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
+)
+
+func TestOutboundBandwidthCap(t *testing.T) {
+	testMgrs := newTestManagers(t, false /* doMock */, t.Name()+"_A", t.Name()+"_B")
+	mgrA, closeA, peerA := testMgrs[0].manager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].manager, testMgrs[1].close, testMgrs[1].peer
+	defer closeA()
+	defer closeB()
+
+	messageSize := uint64(proto.Size(&pb.Packet{Body: &pb.Packet_Message{Message: &pb.Message{Data: testMessageData}}}))
+	mgrA.SetOutboundBandwidthCap(peerB.ID(), 2*messageSize)
+	assert.Equal(t, 2*messageSize, mgrA.OutboundBandwidthCap(peerB.ID()))
+	assert.Zero(t, mgrB.OutboundBandwidthCap(peerA.ID()))
+
+	connectManagers(t, mgrA, peerA, mgrB, peerB)
+	neighborB, err := mgrA.GetNeighbor(peerB.ID())
+	require.NoError(t, err)
+	neighborA, err := mgrB.GetNeighbor(peerA.ID())
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBandwidthWindow, neighborB.BandwidthWindow())
+	assert.Equal(t, 2*messageSize, neighborB.OutboundBandwidthCap())
+
+	// the messages are gossiped until the cap is reached
+	for i := 0; i < 3; i++ {
+		mgrA.SendMessage(testMessageData)
+		time.Sleep(graceTime)
+	}
+	assert.Eventually(t, func() bool { return neighborA.InboundBandwidth() == 2*messageSize }, time.Second, graceTime)
+	assert.Equal(t, 2*messageSize, neighborB.OutboundBandwidth())
+	assert.True(t, neighborB.IsThrottled())
+	assert.EqualValues(t, 1, neighborB.ThrottledMessages())
+	assert.EqualValues(t, 1, mgrA.ThrottledOutboundMessagesCount())
+
+	// message requests are still sent to a throttled neighbor
+	mgrA.RequestMessage([]byte{1})
+	assert.Eventually(t, func() bool { return neighborA.InboundBandwidth() > 2*messageSize }, time.Second, graceTime)
+
+	// removing the cap ends the throttling of the neighbor
+	mgrA.ResetOutboundBandwidthCap(peerB.ID())
+	assert.Zero(t, neighborB.OutboundBandwidthCap())
+	assert.False(t, neighborB.IsThrottled())
+}
@@ -3,12 +3,18 @@ package gossip
 import (
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/events"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
 // Events defines all the events related to the gossip protocol.
 type Events struct {
 	// Fired when a new message was received via the gossip protocol.
 	MessageReceived *events.Event
+	// Fired when a requested transaction payload was received via the gossip protocol.
+	TransactionReceived *events.Event
+	// Fired when a neighbor requested a message that could not be loaded.
+	MessageRequestUnanswered *events.Event
 }
 
 // NeighborsEvents is a collection of events specific for a particular neighbors group, e.g "manual" or "auto".
@@ -35,6 +41,14 @@ type MessageReceivedEvent struct {
 	Peer *peer.Peer
 }
 
+// MessageRequestUnansweredEvent holds data about a message request that could not be answered.
+type MessageRequestUnansweredEvent struct {
+	// The ID of the requested message.
+	MessageID tangle.MessageID
+	// The neighbor that requested the message.
+	Peer *peer.Peer
+}
+
 func neighborCaller(handler interface{}, params ...interface{}) {
 	handler.(func(*Neighbor))(params[0].(*Neighbor))
 }
@@ -42,3 +56,7 @@ func neighborCaller(handler interface{}, params ...interface{}) {
 func messageReceived(handler interface{}, params ...interface{}) {
 	handler.(func(*MessageReceivedEvent))(params[0].(*MessageReceivedEvent))
 }
+
+func messageRequestUnanswered(handler interface{}, params ...interface{}) {
+	handler.(func(*MessageRequestUnansweredEvent))(params[0].(*MessageRequestUnansweredEvent))
+}
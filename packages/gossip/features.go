@@ -0,0 +1,187 @@
+package gossip
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/types"
+
+	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Feature //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Feature is an optional capability of the gossip protocol that is announced to the neighbors during the handshake.
+type Feature string
+
+const (
+	// FeatureCompression announces that the node is able to exchange compressed packets.
+	FeatureCompression Feature = "compression"
+
+	// FeatureWarpSync announces that the node serves the state of its Tangle to syncing neighbors.
+	FeatureWarpSync Feature = "warpsync"
+
+	// FeatureTransactionRequests announces that the node serves transaction payloads that are requested by their ID.
+	FeatureTransactionRequests Feature = "txrequests"
+)
+
+// legacyMessageVersion is the only message version that is supported by neighbors that do not announce their features.
+const legacyMessageVersion uint8 = 1
+
+var (
+	// ErrIncompatibleVersion is returned if the message versions supported by a neighbor do not overlap with our own ones.
+	ErrIncompatibleVersion = errors.New("incompatible message versions")
+
+	// ErrNetworkMismatch is returned if a neighbor belongs to a different network.
+	ErrNetworkMismatch = errors.New("network mismatch")
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Features /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Features contains the range of supported message versions and the optional capabilities of a node. They are
+// exchanged during the handshake, so that new message versions and capabilities can be rolled out gradually.
+type Features struct {
+	// MinMessageVersion is the oldest message version that is supported.
+	MinMessageVersion uint8
+	// MaxMessageVersion is the newest message version that is supported.
+	MaxMessageVersion uint8
+	// Flags contains the optional capabilities that are supported.
+	Flags map[Feature]types.Empty
+	// NetworkID is the ID of the network the node belongs to (neighbors that do not announce it belong to network 0).
+	NetworkID uint32
+}
+
+// NewFeatures creates a new Features object from the given message version range and capabilities.
+func NewFeatures(minMessageVersion, maxMessageVersion uint8, flags ...Feature) (features *Features) {
+	features = &Features{
+		MinMessageVersion: minMessageVersion,
+		MaxMessageVersion: maxMessageVersion,
+		Flags:             make(map[Feature]types.Empty),
+	}
+	for _, flag := range flags {
+		features.Flags[flag] = types.Void
+	}
+
+	return features
+}
+
+// DefaultFeatures returns the Features of a node that supports the current message version without any optional
+// capabilities.
+func DefaultFeatures() *Features {
+	return NewFeatures(tangle.MessageVersion, tangle.MessageVersion)
+}
+
+// legacyFeatures returns the Features of a neighbor that does not announce its capabilities.
+func legacyFeatures() *Features {
+	return NewFeatures(legacyMessageVersion, legacyMessageVersion)
+}
+
+// featuresFromNegotiation creates the Features that were announced in the given negotiation packet.
+func featuresFromNegotiation(negotiation *pb.Negotiation) (features *Features) {
+	if negotiation.GetMaxMessageVersion() == 0 {
+		return legacyFeatures()
+	}
+
+	features = NewFeatures(uint8(negotiation.GetMinMessageVersion()), uint8(negotiation.GetMaxMessageVersion()))
+	features.NetworkID = negotiation.GetNetworkID()
+	for _, flag := range negotiation.GetFeatures() {
+		features.Flags[Feature(flag)] = types.Void
+	}
+
+	return features
+}
+
+// Has returns true if the given capability is supported.
+func (f *Features) Has(feature Feature) bool {
+	_, exists := f.Flags[feature]
+
+	return exists
+}
+
+// SupportsMessageVersion returns true if the given message version is supported.
+func (f *Features) SupportsMessageVersion(version uint8) bool {
+	return version >= f.MinMessageVersion && version <= f.MaxMessageVersion
+}
+
+// Negotiate returns the Features that are supported by both sides. It returns an ErrNetworkMismatch if both sides
+// belong to different networks and an ErrIncompatibleVersion if there is no message version that is supported by both
+// sides.
+func (f *Features) Negotiate(remote *Features) (negotiated *Features, err error) {
+	if f.NetworkID != remote.NetworkID {
+		return nil, errors.Errorf("local network %d and remote network %d differ: %w", f.NetworkID, remote.NetworkID, ErrNetworkMismatch)
+	}
+
+	negotiated = NewFeatures(maxUint8(f.MinMessageVersion, remote.MinMessageVersion), minUint8(f.MaxMessageVersion, remote.MaxMessageVersion))
+	negotiated.NetworkID = f.NetworkID
+	if negotiated.MinMessageVersion > negotiated.MaxMessageVersion {
+		return nil, errors.Errorf("local versions %d-%d and remote versions %d-%d do not overlap: %w", f.MinMessageVersion, f.MaxMessageVersion, remote.MinMessageVersion, remote.MaxMessageVersion, ErrIncompatibleVersion)
+	}
+
+	for flag := range f.Flags {
+		if remote.Has(flag) {
+			negotiated.Flags[flag] = types.Void
+		}
+	}
+
+	return negotiated, nil
+}
+
+// Clone creates a deep copy of the Features.
+func (f *Features) Clone() (clone *Features) {
+	clone = NewFeatures(f.MinMessageVersion, f.MaxMessageVersion)
+	clone.NetworkID = f.NetworkID
+	for flag := range f.Flags {
+		clone.Flags[flag] = types.Void
+	}
+
+	return clone
+}
+
+// FlagNames returns the sorted names of the supported capabilities.
+func (f *Features) FlagNames() (names []string) {
+	names = make([]string, 0, len(f.Flags))
+	for flag := range f.Flags {
+		names = append(names, string(flag))
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// String returns a human-readable version of the Features.
+func (f *Features) String() string {
+	return fmt.Sprintf("Features{NetworkID: %d, MessageVersions: %d-%d, Flags: [%s]}", f.NetworkID, f.MinMessageVersion, f.MaxMessageVersion, strings.Join(f.FlagNames(), ", "))
+}
+
+// negotiation returns the negotiation packet that announces the Features.
+func (f *Features) negotiation() *pb.Negotiation {
+	return &pb.Negotiation{
+		MinMessageVersion: uint32(f.MinMessageVersion),
+		MaxMessageVersion: uint32(f.MaxMessageVersion),
+		Features:          f.FlagNames(),
+		NetworkID:         f.NetworkID,
+	}
+}
+
+func minUint8(a, b uint8) uint8 {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+func maxUint8(a, b uint8) uint8 {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
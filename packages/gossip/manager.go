@@ -5,19 +5,24 @@ import (
 	"fmt"
 	"runtime"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/logger"
+	"github.com/iotaledger/hive.go/types"
 	"github.com/iotaledger/hive.go/workerpool"
 	"github.com/libp2p/go-libp2p-core/host"
 	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/paulbellamy/ratecounter"
+	"go.uber.org/atomic"
 
 	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
 	"github.com/iotaledger/goshimmer/packages/ratelimiter"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
 )
 
 var (
@@ -26,6 +31,10 @@ var (
 
 	messageRequestWorkerCount     = runtime.GOMAXPROCS(0)
 	messageRequestWorkerQueueSize = 100
+
+	// WriteWorkerPoolName is the name of the worker pool that writes the outgoing packets to the neighbors.
+	WriteWorkerPoolName  = "GossipWrite"
+	writeWorkerQueueSize = 1000
 )
 
 // LoadMessageFunc defines a function that returns the message for the given id.
@@ -36,6 +45,7 @@ type ConnectPeerOption func(conf *connectPeerConfig)
 
 type connectPeerConfig struct {
 	useDefaultTimeout bool
+	addresses         []string
 }
 
 func buildConnectPeerConfig(opts []ConnectPeerOption) *connectPeerConfig {
@@ -64,10 +74,11 @@ type Manager struct {
 	acceptMutex sync.RWMutex
 	acceptMap   map[libp2ppeer.ID]*acceptMatcher
 
-	loadMessageFunc LoadMessageFunc
-	log             *logger.Logger
-	events          Events
-	neighborsEvents map[NeighborsGroup]NeighborsEvents
+	loadMessageFunc     LoadMessageFunc
+	loadTransactionFunc LoadTransactionFunc
+	log                 *logger.Logger
+	events              Events
+	neighborsEvents     map[NeighborsGroup]NeighborsEvents
 
 	stopMutex sync.RWMutex
 	isStopped bool
@@ -82,6 +93,50 @@ type Manager struct {
 	messageWorkerPool *workerpool.NonBlockingQueuedWorkerPool
 
 	messageRequestWorkerPool *workerpool.NonBlockingQueuedWorkerPool
+
+	// inboundMessageRateLimit defines the number of message packets per second that are accepted from all neighbors.
+	inboundMessageRateLimit *atomic.Int64
+	// inboundMessageCounter counts the message packets that were accepted during the last second.
+	inboundMessageCounter *ratecounter.RateCounter
+
+	// workerPools is the shared Manager that creates the writeWorkerPool.
+	workerPools *workerpools.Manager
+	// writeWorkerPool defines a best-effort worker pool where all outgoing packets are written to the neighbors.
+	writeWorkerPool *workerpools.Pool
+
+	// features contains the Features that are announced to the neighbors during the handshake.
+	features      *Features
+	featuresMutex sync.RWMutex
+	// incompatibleNeighbors counts the neighbors that were rejected because of incompatible message versions or networks.
+	incompatibleNeighbors *atomic.Uint64
+	// unsupportedInboundMessages counts the received messages whose version was not negotiated with the sender.
+	unsupportedInboundMessages *atomic.Uint64
+	// unsupportedOutboundMessages counts the messages that were not sent to neighbors that do not support their version.
+	unsupportedOutboundMessages *atomic.Uint64
+
+	// advertisedAddresses contains the addresses of the node that are announced to the neighbors during the handshake.
+	advertisedAddresses []string
+	// peerAddresses contains the addresses that the neighbors announced during the last handshake.
+	peerAddresses      map[libp2ppeer.ID][]string
+	peerAddressesMutex sync.RWMutex
+
+	// bandwidthWindow defines the rolling time window in which the traffic of every neighbor is accounted.
+	bandwidthWindow time.Duration
+	// defaultOutboundBandwidthCap defines the soft cap of bytes per window of neighbors without an explicit cap.
+	defaultOutboundBandwidthCap uint64
+	// outboundBandwidthCaps contains the soft caps of bytes per window that were set for specific neighbors.
+	outboundBandwidthCaps      map[identity.ID]uint64
+	outboundBandwidthCapsMutex sync.RWMutex
+	// throttledOutboundMessages counts the messages that were not sent to neighbors that reached their cap.
+	throttledOutboundMessages *atomic.Uint64
+	// droppedInboundPackets counts the received packets that were discarded because the worker pools were full.
+	droppedInboundPackets *atomic.Uint64
+	// droppedOutboundPackets counts the packets that were not sent because the writeWorkerPool was full.
+	droppedOutboundPackets *atomic.Uint64
+	// droppingInboundPackets and droppingOutboundPackets are set while packets are being discarded, so that only the
+	// beginning of every period of discarded packets is logged.
+	droppingInboundPackets  *atomic.Bool
+	droppingOutboundPackets *atomic.Bool
 }
 
 // ManagerOption configures the Manager instance.
@@ -97,34 +152,131 @@ func NewManager(libp2pHost host.Host, local *peer.Local, f LoadMessageFunc, log
 		loadMessageFunc: f,
 		log:             log,
 		events: Events{
-			MessageReceived: events.NewEvent(messageReceived),
+			MessageReceived:          events.NewEvent(messageReceived),
+			TransactionReceived:      events.NewEvent(transactionReceived),
+			MessageRequestUnanswered: events.NewEvent(messageRequestUnanswered),
 		},
 		neighborsEvents: map[NeighborsGroup]NeighborsEvents{
 			NeighborsGroupAuto:   NewNeighborsEvents(),
 			NeighborsGroupManual: NewNeighborsEvents(),
 		},
-		neighbors: map[identity.ID]*Neighbor{},
+		neighbors:               map[identity.ID]*Neighbor{},
+		inboundMessageRateLimit: atomic.NewInt64(0),
+		inboundMessageCounter:   ratecounter.NewRateCounter(time.Second),
+		features:                DefaultFeatures(),
+		peerAddresses:           map[libp2ppeer.ID][]string{},
+		bandwidthWindow:         DefaultBandwidthWindow,
+		outboundBandwidthCaps:   map[identity.ID]uint64{},
+
+		incompatibleNeighbors:       atomic.NewUint64(0),
+		unsupportedInboundMessages:  atomic.NewUint64(0),
+		unsupportedOutboundMessages: atomic.NewUint64(0),
+		throttledOutboundMessages:   atomic.NewUint64(0),
+		droppedInboundPackets:       atomic.NewUint64(0),
+		droppedOutboundPackets:      atomic.NewUint64(0),
+		droppingInboundPackets:      atomic.NewBool(false),
+		droppingOutboundPackets:     atomic.NewBool(false),
 	}
 	m.messageWorkerPool = workerpool.NewNonBlockingQueuedWorkerPool(func(task workerpool.Task) {
-		m.processMessagePacket(task.Param(0).(*pb.Packet_Message), task.Param(1).(*Neighbor))
+		switch packetBody := task.Param(0).(type) {
+		case *pb.Packet_Message:
+			m.processMessagePacket(packetBody, task.Param(1).(*Neighbor))
+		case *pb.Packet_Transaction:
+			m.processTransactionPacket(packetBody, task.Param(1).(*Neighbor))
+		}
 
 		task.Return(nil)
 	}, workerpool.WorkerCount(messageWorkerCount), workerpool.QueueSize(messageWorkerQueueSize))
 
 	m.messageRequestWorkerPool = workerpool.NewNonBlockingQueuedWorkerPool(func(task workerpool.Task) {
-		m.processMessageRequestPacket(task.Param(0).(*pb.Packet_MessageRequest), task.Param(1).(*Neighbor))
+		switch packetBody := task.Param(0).(type) {
+		case *pb.Packet_MessageRequest:
+			m.processMessageRequestPacket(packetBody, task.Param(1).(*Neighbor))
+		case *pb.Packet_TransactionRequest:
+			m.processTransactionRequestPacket(packetBody, task.Param(1).(*Neighbor))
+		}
 
 		task.Return(nil)
 	}, workerpool.WorkerCount(messageRequestWorkerCount), workerpool.QueueSize(messageRequestWorkerQueueSize))
 
 	m.Libp2pHost.SetStreamHandler(protocolID, m.streamHandler)
+	m.Libp2pHost.SetStreamHandler(legacyProtocolID, m.streamHandler)
 	for _, opt := range opts {
 		opt(m)
 	}
+	m.announceTransactionRequests()
+
+	if m.workerPools == nil {
+		m.workerPools = workerpools.NewManager(0, nil)
+	}
+	m.writeWorkerPool = m.workerPools.CreatePool(WriteWorkerPoolName, workerpools.PriorityBestEffort, workerpools.PoolParams{
+		WorkerCount: runtime.GOMAXPROCS(0),
+		QueueSize:   writeWorkerQueueSize,
+	})
 
 	return m
 }
 
+// WithWorkerPools allows to set the shared worker pools Manager that creates the worker pool for outgoing packets.
+func WithWorkerPools(workerPools *workerpools.Manager) ManagerOption {
+	return func(m *Manager) {
+		m.workerPools = workerPools
+	}
+}
+
+// WithFeatures allows to set the Features that are announced to the neighbors during the handshake.
+func WithFeatures(features *Features) ManagerOption {
+	return func(m *Manager) {
+		m.features = features
+	}
+}
+
+// Features returns a copy of the Features that are announced to the neighbors during the handshake.
+func (m *Manager) Features() *Features {
+	m.featuresMutex.RLock()
+	defer m.featuresMutex.RUnlock()
+
+	return m.features.Clone()
+}
+
+// EnableFeature adds the given capability to the Features that are announced to new neighbors.
+func (m *Manager) EnableFeature(feature Feature) {
+	m.featuresMutex.Lock()
+	defer m.featuresMutex.Unlock()
+
+	m.features.Flags[feature] = types.Void
+}
+
+// IncompatibleNeighborsCount returns the number of neighbors that were rejected because of incompatible message
+// versions or because they belong to a different network.
+func (m *Manager) IncompatibleNeighborsCount() uint64 {
+	return m.incompatibleNeighbors.Load()
+}
+
+// UnsupportedInboundMessagesCount returns the number of received messages that were dropped because their version was
+// not negotiated with the sending neighbor.
+func (m *Manager) UnsupportedInboundMessagesCount() uint64 {
+	return m.unsupportedInboundMessages.Load()
+}
+
+// UnsupportedOutboundMessagesCount returns the number of messages that were not sent to neighbors because they do not
+// support their version.
+func (m *Manager) UnsupportedOutboundMessagesCount() uint64 {
+	return m.unsupportedOutboundMessages.Load()
+}
+
+// DroppedInboundPacketsCount returns the number of received packets that were discarded because the worker pools that
+// process them were full.
+func (m *Manager) DroppedInboundPacketsCount() uint64 {
+	return m.droppedInboundPackets.Load()
+}
+
+// DroppedOutboundPacketsCount returns the number of packets that were not sent to neighbors because the worker pool that
+// writes them was full.
+func (m *Manager) DroppedOutboundPacketsCount() uint64 {
+	return m.droppedOutboundPackets.Load()
+}
+
 // WithMessagesRateLimiter allows to set a PeerRateLimiter instance
 // to be used as messages rate limiter in the gossip manager.
 func WithMessagesRateLimiter(prl *ratelimiter.PeerRateLimiter) ManagerOption {
@@ -161,6 +313,8 @@ func (m *Manager) Stop() {
 	}
 	m.isStopped = true
 	m.Libp2pHost.RemoveStreamHandler(protocolID)
+	m.Libp2pHost.RemoveStreamHandler(legacyProtocolID)
+	m.writeWorkerPool.Shutdown()
 	m.dropAllNeighbors()
 
 	m.messageWorkerPool.Stop()
@@ -284,14 +438,41 @@ func (m *Manager) send(packet *pb.Packet, to ...identity.ID) []*Neighbor {
 	}
 
 	for _, nbr := range neighbors {
-		if err := nbr.ps.writePacket(packet); err != nil {
-			m.log.Warnw("send error", "peer-id", nbr.ID(), "err", err)
-			nbr.close()
+		nbr := nbr
+		if !nbr.supportsPacket(packet) {
+			m.unsupportedOutboundMessages.Inc()
+			continue
 		}
+		if _, isMessage := packet.GetBody().(*pb.Packet_Message); isMessage && m.throttle(nbr) {
+			continue
+		}
+		m.submitWrite(nbr, packet)
 	}
 	return neighbors
 }
 
+// submitWrite queues the given packet for being written to the neighbor. It never blocks, as requests are sent from the
+// critical workers of the Solidifier: if the writeWorkerPool is full, the packet is discarded (and counted), and
+// discarded requests are sent again by the re-request timer of the Requester.
+func (m *Manager) submitWrite(nbr *Neighbor, packet *pb.Packet) {
+	if m.writeWorkerPool.TrySubmit(func() { m.writePacket(nbr, packet) }) {
+		m.droppingOutboundPackets.Store(false)
+		return
+	}
+
+	m.droppedOutboundPackets.Inc()
+	if m.droppingOutboundPackets.CAS(false, true) {
+		m.log.Warnw("writeWorkerPool full: discarding outbound packets", "peer-id", nbr.ID(), "dropped", m.droppedOutboundPackets.Load())
+	}
+}
+
+func (m *Manager) writePacket(nbr *Neighbor, packet *pb.Packet) {
+	if err := nbr.writePacket(packet); err != nil {
+		m.log.Warnw("send error", "peer-id", nbr.ID(), "err", err)
+		nbr.close()
+	}
+}
+
 func (m *Manager) addNeighbor(ctx context.Context, p *peer.Peer, group NeighborsGroup,
 	connectorFunc func(context.Context, *peer.Peer, []ConnectPeerOption) (*packetsStream, error),
 	connectOpts []ConnectPeerOption,
@@ -313,8 +494,20 @@ func (m *Manager) addNeighbor(ctx context.Context, p *peer.Peer, group Neighbors
 		return errors.WithStack(err)
 	}
 
+	features, err := m.Features().Negotiate(ps.remoteFeatures)
+	if err != nil {
+		m.incompatibleNeighbors.Inc()
+		m.log.Warnw("Rejected incompatible neighbor", "id", p.ID(), "features", ps.remoteFeatures, "err", err)
+		if closeErr := ps.Close(); closeErr != nil {
+			err = errors.CombineErrors(err, closeErr)
+		}
+		return errors.WithStack(err)
+	}
+
 	// create and add the neighbor
 	nbr := NewNeighbor(p, group, ps, m.log)
+	nbr.features = features
+	nbr.bandwidth = newBandwidthAccount(m.bandwidthWindow, m.OutboundBandwidthCap(p.ID()))
 	if err := m.setNeighbor(nbr); err != nil {
 		if resetErr := ps.Close(); resetErr != nil {
 			err = errors.CombineErrors(err, resetErr)
@@ -363,21 +556,57 @@ func (m *Manager) setNeighbor(nbr *Neighbor) error {
 func (m *Manager) handlePacket(packet *pb.Packet, nbr *Neighbor) error {
 	switch packetBody := packet.GetBody().(type) {
 	case *pb.Packet_Message:
+		if limit := m.inboundMessageRateLimit.Load(); limit > 0 && m.inboundMessageCounter.Rate() >= limit {
+			return fmt.Errorf("inbound message rate limit of %d messages/s exceeded: packet message discarded", limit)
+		}
+		m.inboundMessageCounter.Incr(1)
+
 		if _, added := m.messageWorkerPool.TrySubmit(packetBody, nbr); !added {
-			return fmt.Errorf("messageWorkerPool full: packet message discarded")
+			return m.dropInboundPacket(nbr, "messageWorkerPool full: packet message discarded")
 		}
 	case *pb.Packet_MessageRequest:
 		if _, added := m.messageRequestWorkerPool.TrySubmit(packetBody, nbr); !added {
-			return fmt.Errorf("messageRequestWorkerPool full: message request discarded")
+			return m.dropInboundPacket(nbr, "messageRequestWorkerPool full: message request discarded")
+		}
+	case *pb.Packet_TransactionRequest:
+		if _, added := m.messageRequestWorkerPool.TrySubmit(packetBody, nbr); !added {
+			return m.dropInboundPacket(nbr, "messageRequestWorkerPool full: transaction request discarded")
+		}
+	case *pb.Packet_Transaction:
+		if _, added := m.messageWorkerPool.TrySubmit(packetBody, nbr); !added {
+			return m.dropInboundPacket(nbr, "messageWorkerPool full: transaction discarded")
 		}
 
 	default:
 		return errors.Newf("unsupported packet; packet=%+v, packetBody=%T-%+v", packet, packetBody, packetBody)
 	}
+	m.droppingInboundPackets.Store(false)
 
 	return nil
 }
 
+// dropInboundPacket counts a received packet that was discarded because the worker pools were full and logs the
+// beginning of every period of discarded packets.
+func (m *Manager) dropInboundPacket(nbr *Neighbor, reason string) error {
+	m.droppedInboundPackets.Inc()
+	if m.droppingInboundPackets.CAS(false, true) {
+		m.log.Warnw("worker pools full: discarding inbound packets", "peer-id", nbr.ID(), "reason", reason, "dropped", m.droppedInboundPackets.Load())
+	}
+
+	return errors.New(reason)
+}
+
+// SetInboundMessageRateLimit sets the number of message packets per second that are accepted from all neighbors. The
+// message packets that exceed the limit are discarded, a limit <= 0 accepts all message packets.
+func (m *Manager) SetInboundMessageRateLimit(limit int) {
+	m.inboundMessageRateLimit.Store(int64(limit))
+}
+
+// InboundMessageRateLimit returns the number of message packets per second that are accepted from all neighbors.
+func (m *Manager) InboundMessageRateLimit() int {
+	return int(m.inboundMessageRateLimit.Load())
+}
+
 // MessageWorkerPoolStatus returns the name and the load of the workerpool.
 func (m *Manager) MessageWorkerPoolStatus() (name string, load int) {
 	return "messageWorkerPool", m.messageWorkerPool.GetPendingQueueSize()
@@ -389,6 +618,11 @@ func (m *Manager) MessageRequestWorkerPoolStatus() (name string, load int) {
 }
 
 func (m *Manager) processMessagePacket(packetMsg *pb.Packet_Message, nbr *Neighbor) {
+	if !nbr.supportsPacket(&pb.Packet{Body: packetMsg}) {
+		m.unsupportedInboundMessages.Inc()
+		nbr.log.Debugw("Dropped message with a version that was not negotiated", "features", nbr.Features())
+		return
+	}
 	if m.messagesRateLimiter != nil {
 		m.messagesRateLimiter.Count(nbr.Peer)
 	}
@@ -399,6 +633,7 @@ func (m *Manager) processMessageRequestPacket(packetMsgReq *pb.Packet_MessageReq
 	if m.messageRequestsRateLimiter != nil {
 		m.messageRequestsRateLimiter.Count(nbr.Peer)
 	}
+	nbr.messageRequestsReceived.Inc()
 	msgID, _, err := tangle.MessageIDFromBytes(packetMsgReq.MessageRequest.GetId())
 	if err != nil {
 		m.log.Debugw("invalid message id:", "err", err)
@@ -408,12 +643,17 @@ func (m *Manager) processMessageRequestPacket(packetMsgReq *pb.Packet_MessageReq
 	msgBytes, err := m.loadMessageFunc(msgID)
 	if err != nil {
 		m.log.Debugw("error loading message", "msg-id", msgID, "err", err)
+		m.events.MessageRequestUnanswered.Trigger(&MessageRequestUnansweredEvent{MessageID: msgID, Peer: nbr.Peer})
+		return
+	}
+	nbr.messageRequestHits.Inc()
+	if m.throttle(nbr) {
 		return
 	}
 
 	// send the loaded message directly to the neighbor
 	packet := &pb.Packet{Body: &pb.Packet_Message{Message: &pb.Message{Data: msgBytes}}}
-	if err := nbr.ps.writePacket(packet); err != nil {
+	if err := nbr.writePacket(packet); err != nil {
 		nbr.log.Warnw("Failed to send requested message back to the neighbor", "err", err)
 		nbr.close()
 	}
@@ -31,7 +31,7 @@ const graceTime = 10 * time.Millisecond
 
 var (
 	log             = logger.NewExampleLogger("gossip")
-	testMessageData = []byte("testMsg")
+	testMessageData = append([]byte{tangle.MessageVersion}, "testMsg"...)
 )
 
 func loadTestMessage(tangle.MessageID) ([]byte, error) { return testMessageData, nil }
@@ -487,6 +487,147 @@ func TestDropNeighborDifferentGroup(t *testing.T) {
 	}
 }
 
+func TestFeatureNegotiation(t *testing.T) {
+	testMgrs := newTestManagers(t, false /* doMock */, t.Name()+"_A", t.Name()+"_B")
+	mgrA, closeA, peerA := testMgrs[0].manager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].manager, testMgrs[1].close, testMgrs[1].peer
+	defer closeA()
+	defer closeB()
+
+	mgrA.EnableFeature(FeatureWarpSync)
+	mgrB.EnableFeature(FeatureWarpSync)
+	mgrB.EnableFeature(FeatureCompression)
+
+	connectManagers(t, mgrA, peerA, mgrB, peerB)
+
+	for _, mgr := range []*Manager{mgrA, mgrB} {
+		neighbors := mgr.AllNeighbors()
+		require.Len(t, neighbors, 1)
+		assert.Equal(t, tangle.MessageVersion, neighbors[0].Features().MinMessageVersion)
+		assert.Equal(t, tangle.MessageVersion, neighbors[0].Features().MaxMessageVersion)
+		assert.Equal(t, []string{string(FeatureWarpSync)}, neighbors[0].Features().FlagNames())
+	}
+}
+
+func TestIncompatibleVersion(t *testing.T) {
+	testMgrs := newTestManagers(t, false /* doMock */, t.Name()+"_A", t.Name()+"_B")
+	mgrA, closeA, peerA := testMgrs[0].manager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].manager, testMgrs[1].close, testMgrs[1].peer
+	defer closeA()
+	defer closeB()
+
+	mgrB.features = NewFeatures(tangle.MessageVersion+1, tangle.MessageVersion+1)
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.ErrorIs(t, mgrA.AddInbound(context.Background(), peerB, NeighborsGroupAuto), ErrIncompatibleVersion)
+	}()
+	time.Sleep(graceTime)
+	go func() {
+		defer wg.Done()
+		assert.ErrorIs(t, mgrB.AddOutbound(context.Background(), peerA, NeighborsGroupAuto), ErrIncompatibleVersion)
+	}()
+	wg.Wait()
+
+	assert.Empty(t, mgrA.AllNeighbors())
+	assert.Empty(t, mgrB.AllNeighbors())
+	assert.EqualValues(t, 1, mgrA.IncompatibleNeighborsCount())
+	assert.EqualValues(t, 1, mgrB.IncompatibleNeighborsCount())
+}
+
+func TestNetworkMismatch(t *testing.T) {
+	testMgrs := newTestManagers(t, false /* doMock */, t.Name()+"_A", t.Name()+"_B")
+	mgrA, closeA, peerA := testMgrs[0].manager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].manager, testMgrs[1].close, testMgrs[1].peer
+	defer closeA()
+	defer closeB()
+
+	mgrB.features.NetworkID = 1
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.ErrorIs(t, mgrA.AddInbound(context.Background(), peerB, NeighborsGroupAuto), ErrNetworkMismatch)
+	}()
+	time.Sleep(graceTime)
+	go func() {
+		defer wg.Done()
+		assert.ErrorIs(t, mgrB.AddOutbound(context.Background(), peerA, NeighborsGroupAuto), ErrNetworkMismatch)
+	}()
+	wg.Wait()
+
+	assert.Empty(t, mgrA.AllNeighbors())
+	assert.Empty(t, mgrB.AllNeighbors())
+	assert.EqualValues(t, 1, mgrA.IncompatibleNeighborsCount())
+	assert.EqualValues(t, 1, mgrB.IncompatibleNeighborsCount())
+}
+
+func TestUnsupportedMessageVersion(t *testing.T) {
+	testMgrs := newTestManagers(t, true /* doMock */, t.Name()+"_A", t.Name()+"_B")
+	mgrA, closeA, peerA := testMgrs[0].mockManager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].mockManager, testMgrs[1].close, testMgrs[1].peer
+
+	mgrA.On("neighborAdded", mock.Anything).Once()
+	mgrB.On("neighborAdded", mock.Anything).Once()
+	connectManagers(t, mgrA.Manager, peerA, mgrB.Manager, peerB)
+
+	// the message is neither sent nor received, as its version was not negotiated
+	mgrA.SendMessage(append([]byte{tangle.MessageVersion + 1}, "testMsg"...))
+	time.Sleep(graceTime)
+	assert.EqualValues(t, 1, mgrA.UnsupportedOutboundMessagesCount())
+
+	require.NoError(t, mgrB.AllNeighbors()[0].ps.writePacket(&pb.Packet{Body: &pb.Packet_Message{Message: &pb.Message{Data: []byte{tangle.MessageVersion + 1}}}}))
+	assert.Eventually(t, func() bool { return mgrA.UnsupportedInboundMessagesCount() == 1 }, time.Second, graceTime)
+
+	mgrA.On("neighborRemoved", mock.Anything).Once()
+	mgrB.On("neighborRemoved", mock.Anything).Once()
+
+	closeA()
+	closeB()
+	time.Sleep(graceTime)
+
+	mgrA.AssertExpectations(t)
+	mgrB.AssertExpectations(t)
+}
+
+func TestFeatures_Negotiate(t *testing.T) {
+	negotiated, err := NewFeatures(1, 3, FeatureWarpSync, FeatureCompression).Negotiate(NewFeatures(2, 4, FeatureCompression))
+	require.NoError(t, err)
+	assert.Equal(t, NewFeatures(2, 3, FeatureCompression), negotiated)
+
+	_, err = NewFeatures(1, 1).Negotiate(NewFeatures(2, 2))
+	assert.ErrorIs(t, err, ErrIncompatibleVersion)
+
+	devnetFeatures := NewFeatures(1, 1)
+	devnetFeatures.NetworkID = 1
+	_, err = NewFeatures(1, 1).Negotiate(devnetFeatures)
+	assert.ErrorIs(t, err, ErrNetworkMismatch)
+
+	// neighbors that do not announce their features only support the legacy message version
+	assert.Equal(t, legacyFeatures(), featuresFromNegotiation(&pb.Negotiation{}))
+	assert.Equal(t, NewFeatures(1, 2, FeatureWarpSync), featuresFromNegotiation(NewFeatures(1, 2, FeatureWarpSync).negotiation()))
+	assert.Equal(t, devnetFeatures, featuresFromNegotiation(devnetFeatures.negotiation()))
+}
+
+// connectManagers connects the manager B as an outbound neighbor to the manager A.
+func connectManagers(t *testing.T, mgrA *Manager, peerA *peer.Peer, mgrB *Manager, peerB *peer.Peer) {
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, mgrA.AddInbound(context.Background(), peerB, NeighborsGroupAuto))
+	}()
+	time.Sleep(graceTime)
+	go func() {
+		defer wg.Done()
+		assert.NoError(t, mgrB.AddOutbound(context.Background(), peerA, NeighborsGroupAuto))
+	}()
+	wg.Wait()
+}
+
 func newTestDB(t require.TestingT) *peer.DB {
 	db, err := peer.NewDB(mapdb.NewMapDB())
 	require.NoError(t, err)
@@ -12,6 +12,8 @@ import (
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/libp2p/go-libp2p-core/mux"
 	"github.com/libp2p/go-yamux/v2"
+	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
 )
@@ -38,7 +40,14 @@ type Neighbor struct {
 	disconnected   *events.Event
 	packetReceived *events.Event
 
-	ps *packetsStream
+	ps        *packetsStream
+	features  *Features
+	bandwidth *bandwidthAccount
+
+	messageRequestsReceived *atomic.Uint64
+	messageRequestHits      *atomic.Uint64
+
+	transactionRequestsReceived *atomic.Uint64
 }
 
 // NewNeighbor creates a new neighbor from the provided peer and connection.
@@ -57,7 +66,14 @@ func NewNeighbor(p *peer.Peer, group NeighborsGroup, ps *packetsStream, log *log
 		disconnected:   events.NewEvent(disconnected),
 		packetReceived: events.NewEvent(packetReceived),
 
-		ps: ps,
+		ps:        ps,
+		features:  DefaultFeatures(),
+		bandwidth: newBandwidthAccount(DefaultBandwidthWindow, 0),
+
+		messageRequestsReceived: atomic.NewUint64(0),
+		messageRequestHits:      atomic.NewUint64(0),
+
+		transactionRequestsReceived: atomic.NewUint64(0),
 	}
 }
 
@@ -71,6 +87,52 @@ func (n *Neighbor) PacketsWritten() uint64 {
 	return n.ps.packetsWritten.Load()
 }
 
+// MessagesRead returns number of messages this neighbor has received.
+func (n *Neighbor) MessagesRead() uint64 {
+	return n.ps.messagesRead.Load()
+}
+
+// MessagesWritten returns number of messages this neighbor has sent.
+func (n *Neighbor) MessagesWritten() uint64 {
+	return n.ps.messagesWritten.Load()
+}
+
+// BytesRead returns number of bytes this neighbor has received.
+func (n *Neighbor) BytesRead() uint64 {
+	return n.ps.bytesRead.Load()
+}
+
+// BytesWritten returns number of bytes this neighbor has sent.
+func (n *Neighbor) BytesWritten() uint64 {
+	return n.ps.bytesWritten.Load()
+}
+
+// MessageRequestsReceived returns number of message requests this neighbor has received.
+func (n *Neighbor) MessageRequestsReceived() uint64 {
+	return n.messageRequestsReceived.Load()
+}
+
+// MessageRequestHits returns number of message requests of this neighbor that could be answered with the requested
+// message.
+func (n *Neighbor) MessageRequestHits() uint64 {
+	return n.messageRequestHits.Load()
+}
+
+// Features returns the Features that were negotiated with the neighbor.
+func (n *Neighbor) Features() *Features {
+	return n.features
+}
+
+// supportsPacket returns false if the packet contains a message whose version was not negotiated with the neighbor.
+func (n *Neighbor) supportsPacket(packet *pb.Packet) bool {
+	messagePacket, isMessage := packet.GetBody().(*pb.Packet_Message)
+	if !isMessage || len(messagePacket.Message.GetData()) == 0 {
+		return true
+	}
+
+	return n.features.SupportsMessageVersion(messagePacket.Message.GetData()[0])
+}
+
 func disconnected(handler interface{}, _ ...interface{}) {
 	handler.(func())()
 }
@@ -109,11 +171,22 @@ func (n *Neighbor) readLoop() {
 				}
 				continue
 			}
+			n.bandwidth.inbound.Incr(int64(proto.Size(packet)))
 			n.packetReceived.Trigger(packet)
 		}
 	}()
 }
 
+// writePacket writes the given packet to the neighbor and accounts its size.
+func (n *Neighbor) writePacket(packet *pb.Packet) error {
+	if err := n.ps.writePacket(packet); err != nil {
+		return err
+	}
+	n.bandwidth.outbound.Incr(int64(proto.Size(packet)))
+
+	return nil
+}
+
 func (n *Neighbor) close() {
 	if err := n.disconnect(); err != nil {
 		n.log.Errorw("Failed to disconnect the neighbor", "err", err)
@@ -14,6 +14,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
 	"github.com/iotaledger/goshimmer/packages/libp2putil/libp2ptesting"
@@ -73,6 +74,11 @@ func TestNeighborWrite(t *testing.T) {
 
 	assert.Eventually(t, func() bool { return atomic.LoadUint32(&countA) == 1 }, time.Second, 10*time.Millisecond)
 	assert.Eventually(t, func() bool { return atomic.LoadUint32(&countB) == 1 }, time.Second, 10*time.Millisecond)
+
+	assert.EqualValues(t, 1, neighborA.MessagesWritten())
+	assert.EqualValues(t, 1, neighborA.MessagesRead())
+	assert.EqualValues(t, proto.Size(testPacket1), neighborA.BytesWritten())
+	assert.EqualValues(t, proto.Size(testPacket2), neighborA.BytesRead())
 }
 
 func newTestNeighbor(name string, stream network.Stream) *Neighbor {
@@ -15,9 +15,8 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
 	"github.com/libp2p/go-libp2p-core/network"
 	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
-	"github.com/libp2p/go-libp2p-core/peerstore"
-	"github.com/multiformats/go-multiaddr"
 	"go.uber.org/atomic"
+	"google.golang.org/protobuf/proto"
 
 	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
 	"github.com/iotaledger/goshimmer/packages/libp2putil"
@@ -25,7 +24,8 @@ import (
 
 const (
 	defaultConnectionTimeout = 5 * time.Second // timeout after which the connection must be established.
-	protocolID               = "gossip/0.0.1"
+	protocolID               = "gossip/0.1.0"
+	legacyProtocolID         = "gossip/0.0.1" // protocol of neighbors that do not announce their features.
 	ioTimeout                = 4 * time.Second
 )
 
@@ -36,6 +36,8 @@ var (
 	ErrDuplicateAccept = errors.New("accept request for that peer already exists")
 	// ErrNoGossip means that the given peer does not support the gossip service.
 	ErrNoGossip = errors.New("peer does not have a gossip service")
+	// ErrNoAddress means that no address of the given peer is known.
+	ErrNoAddress = errors.New("peer does not have an address")
 )
 
 func (m *Manager) dialPeer(ctx context.Context, p *peer.Peer, opts []ConnectPeerOption) (*packetsStream, error) {
@@ -49,26 +51,13 @@ func (m *Manager) dialPeer(ctx context.Context, p *peer.Peer, opts []ConnectPeer
 		return nil, errors.WithStack(err)
 	}
 
-	addressStr := fmt.Sprintf("/ip4/%s/tcp/%d", p.IP(), gossipEndpoint.Port())
-	address, err := multiaddr.NewMultiaddr(addressStr)
+	stream, err := m.dialFirstReachableAddress(ctx, p, libp2pID, conf)
 	if err != nil {
 		return nil, err
 	}
-	m.Libp2pHost.Peerstore().AddAddr(libp2pID, address, peerstore.ConnectedAddrTTL)
-
-	if conf.useDefaultTimeout {
-		var cancel context.CancelFunc
-		ctx, cancel = context.WithTimeout(ctx, defaultConnectionTimeout)
-		defer cancel()
-	}
-
-	stream, err := m.Libp2pHost.NewStream(ctx, libp2pID, protocolID)
-	if err != nil {
-		return nil, errors.Wrapf(err, "dial %s / %s failed", address, p.ID())
-	}
 	ps := newPacketsStream(stream)
-	if err := sendNegotiationMessage(ps); err != nil {
-		err = errors.Wrap(err, "failed to send negotiation message")
+	if err := m.handshake(ps, true); err != nil {
+		err = errors.Wrap(err, "handshake failed")
 		err = errors.CombineErrors(err, stream.Close())
 		return nil, err
 	}
@@ -79,6 +68,43 @@ func (m *Manager) dialPeer(ctx context.Context, p *peer.Peer, opts []ConnectPeer
 	return ps, nil
 }
 
+// dialFirstReachableAddress opens a stream to the given peer via the first of its addresses that can be reached. The
+// addresses are tried one after the other in the order of preference and every attempt has its own timeout.
+func (m *Manager) dialFirstReachableAddress(ctx context.Context, p *peer.Peer, libp2pID libp2ppeer.ID, conf *connectPeerConfig) (stream network.Stream, err error) {
+	addresses := m.dialAddresses(p, conf)
+	if len(addresses) == 0 {
+		return nil, errors.Errorf("dial %s failed: %w", p.ID(), ErrNoAddress)
+	}
+
+	for _, address := range addresses {
+		if stream, err = m.dialAddress(ctx, libp2pID, address, conf); err == nil {
+			return stream, nil
+		}
+		m.log.Debugw("dial failed", "id", p.ID(), "addr", address, "err", err)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	return nil, errors.Wrapf(err, "dial %s / %s failed", addresses, p.ID())
+}
+
+// dialAddress opens a stream to the peer with the given ID via the given address.
+func (m *Manager) dialAddress(ctx context.Context, libp2pID libp2ppeer.ID, address string, conf *connectPeerConfig) (network.Stream, error) {
+	if conf.useDefaultTimeout {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultConnectionTimeout)
+		defer cancel()
+	}
+
+	if err := m.connectAddress(ctx, libp2pID, address); err != nil {
+		return nil, err
+	}
+
+	return m.Libp2pHost.NewStream(ctx, libp2pID, protocolID, legacyProtocolID)
+}
+
 func (m *Manager) acceptPeer(ctx context.Context, p *peer.Peer, opts []ConnectPeerOption) (*packetsStream, error) {
 	gossipEndpoint := p.Services().Get(service.GossipKey)
 	if gossipEndpoint == nil {
@@ -167,8 +193,8 @@ func (m *Manager) removeAcceptMatcher(am *acceptMatcher) {
 
 func (m *Manager) streamHandler(stream network.Stream) {
 	ps := newPacketsStream(stream)
-	if err := receiveNegotiationMessage(ps); err != nil {
-		m.log.Warnw("Failed to receive negotiation message", "err", err)
+	if err := m.handshake(ps, false); err != nil {
+		m.log.Warnw("Handshake failed", "err", err)
 		m.closeStream(stream)
 		return
 	}
@@ -192,6 +218,13 @@ type packetsStream struct {
 	writer         *libp2putil.UvarintWriter
 	packetsRead    *atomic.Uint64
 	packetsWritten *atomic.Uint64
+	// messagesRead and messagesWritten count the packets that contain a message.
+	messagesRead    *atomic.Uint64
+	messagesWritten *atomic.Uint64
+	// bytesRead and bytesWritten count the size of the encoded packets without their length prefix.
+	bytesRead      *atomic.Uint64
+	bytesWritten   *atomic.Uint64
+	remoteFeatures *Features
 }
 
 func newPacketsStream(stream network.Stream) *packetsStream {
@@ -201,6 +234,11 @@ func newPacketsStream(stream network.Stream) *packetsStream {
 		writer:         libp2putil.NewDelimitedWriter(stream),
 		packetsRead:    atomic.NewUint64(0),
 		packetsWritten: atomic.NewUint64(0),
+
+		messagesRead:    atomic.NewUint64(0),
+		messagesWritten: atomic.NewUint64(0),
+		bytesRead:       atomic.NewUint64(0),
+		bytesWritten:    atomic.NewUint64(0),
 	}
 }
 
@@ -215,6 +253,10 @@ func (ps *packetsStream) writePacket(packet *pb.Packet) error {
 		return errors.WithStack(err)
 	}
 	ps.packetsWritten.Inc()
+	ps.bytesWritten.Add(uint64(proto.Size(packet)))
+	if _, isMessage := packet.GetBody().(*pb.Packet_Message); isMessage {
+		ps.messagesWritten.Inc()
+	}
 	return nil
 }
 
@@ -228,27 +270,69 @@ func (ps *packetsStream) readPacket(packet *pb.Packet) error {
 		return errors.WithStack(err)
 	}
 	ps.packetsRead.Inc()
+	ps.bytesRead.Add(uint64(proto.Size(packet)))
+	if _, isMessage := packet.GetBody().(*pb.Packet_Message); isMessage {
+		ps.messagesRead.Inc()
+	}
+	return nil
+}
+
+// handshake exchanges the Features with the peer on the other side of the stream. The dialing side announces its
+// Features first and the accepting side answers with its own ones. Peers that speak the legacy protocol only send a
+// negotiation packet from the dialing side and are treated as supporting the legacy message version only.
+func (m *Manager) handshake(ps *packetsStream, outbound bool) error {
+	legacy := ps.Protocol() == legacyProtocolID
+	localNegotiation := m.Features().negotiation()
+	localNegotiation.Addresses = m.advertisedAddresses
+
+	if outbound {
+		if err := sendNegotiationMessage(ps, localNegotiation); err != nil {
+			return errors.Wrap(err, "failed to send negotiation message")
+		}
+		if legacy {
+			ps.remoteFeatures = legacyFeatures()
+			return nil
+		}
+	}
+
+	remoteNegotiation, err := receiveNegotiationMessage(ps)
+	if err != nil {
+		return errors.Wrap(err, "failed to receive negotiation message")
+	}
+	if legacy {
+		ps.remoteFeatures = legacyFeatures()
+		return nil
+	}
+	ps.remoteFeatures = featuresFromNegotiation(remoteNegotiation)
+	m.rememberAddresses(ps.Conn().RemotePeer(), remoteNegotiation.GetAddresses())
+
+	if !outbound {
+		if err := sendNegotiationMessage(ps, localNegotiation); err != nil {
+			return errors.Wrap(err, "failed to send negotiation message")
+		}
+	}
 	return nil
 }
 
-func sendNegotiationMessage(ps *packetsStream) error {
-	packet := &pb.Packet{Body: &pb.Packet_Negotiation{Negotiation: &pb.Negotiation{}}}
+func sendNegotiationMessage(ps *packetsStream, negotiation *pb.Negotiation) error {
+	packet := &pb.Packet{Body: &pb.Packet_Negotiation{Negotiation: negotiation}}
 	return errors.WithStack(ps.writePacket(packet))
 }
 
-func receiveNegotiationMessage(ps *packetsStream) (err error) {
+func receiveNegotiationMessage(ps *packetsStream) (negotiation *pb.Negotiation, err error) {
 	packet := &pb.Packet{}
 	if err := ps.readPacket(packet); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 	packetBody := packet.GetBody()
-	if _, ok := packetBody.(*pb.Packet_Negotiation); !ok {
-		return errors.Newf(
+	negotiationBody, ok := packetBody.(*pb.Packet_Negotiation)
+	if !ok {
+		return nil, errors.Newf(
 			"received packet isn't the negotiation packet; packet=%+v, packetBody=%T-%+v",
 			packet, packetBody, packetBody,
 		)
 	}
-	return nil
+	return negotiationBody.Negotiation, nil
 }
 
 func (m *Manager) matchNewStream(stream network.Stream) *acceptMatcher {
@@ -0,0 +1,133 @@
+package gossip
+
+import (
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/types"
+
+	pb "github.com/iotaledger/goshimmer/packages/gossip/gossipproto"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// LoadTransactionFunc defines a function that returns the transaction payload for the given id.
+type LoadTransactionFunc func(transactionID ledgerstate.TransactionID) ([]byte, error)
+
+// region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WithLoadTransactionFunc allows to set the function that loads the transactions that are requested by the neighbors.
+// Setting it announces the FeatureTransactionRequests to the neighbors, as the node is then able to serve them.
+func WithLoadTransactionFunc(loadTransactionFunc LoadTransactionFunc) ManagerOption {
+	return func(m *Manager) {
+		m.loadTransactionFunc = loadTransactionFunc
+	}
+}
+
+// RequestTransaction requests the transaction payload with the given id from the neighbors that negotiated the
+// FeatureTransactionRequests. It allows to fetch only the transaction instead of a whole message, e.g. if an attachment
+// of an already known transaction is needed. If no peer is provided, all such neighbors are queried. It returns the
+// neighbors that were queried.
+func (m *Manager) RequestTransaction(transactionID []byte, to ...identity.ID) (recipients []*Neighbor) {
+	neighbors := m.getNeighborsByID(to)
+	if len(neighbors) == 0 {
+		neighbors = m.AllNeighbors()
+	}
+
+	recipients = make([]*Neighbor, 0, len(neighbors))
+	for _, nbr := range neighbors {
+		if nbr.Features().Has(FeatureTransactionRequests) {
+			recipients = append(recipients, nbr)
+		}
+	}
+	if len(recipients) == 0 {
+		return recipients
+	}
+
+	packet := &pb.Packet{Body: &pb.Packet_TransactionRequest{TransactionRequest: &pb.TransactionRequest{Id: transactionID}}}
+	for _, nbr := range recipients {
+		nbr := nbr
+		if !m.writeWorkerPool.TrySubmit(func() { m.writePacket(nbr, packet) }) {
+			m.log.Debugw("writeWorkerPool full: transaction request discarded", "peer-id", nbr.ID())
+		}
+	}
+
+	return recipients
+}
+
+// processTransactionRequestPacket answers the transaction request of the given neighbor with the requested transaction.
+func (m *Manager) processTransactionRequestPacket(packetTxReq *pb.Packet_TransactionRequest, nbr *Neighbor) {
+	if m.messageRequestsRateLimiter != nil {
+		m.messageRequestsRateLimiter.Count(nbr.Peer)
+	}
+	if m.loadTransactionFunc == nil || !nbr.Features().Has(FeatureTransactionRequests) {
+		nbr.log.Debugw("Ignored transaction request of neighbor that did not negotiate it")
+		return
+	}
+	nbr.transactionRequestsReceived.Inc()
+	transactionID, _, err := ledgerstate.TransactionIDFromBytes(packetTxReq.TransactionRequest.GetId())
+	if err != nil {
+		m.log.Debugw("invalid transaction id:", "err", err)
+		return
+	}
+
+	transactionBytes, err := m.loadTransactionFunc(transactionID)
+	if err != nil {
+		m.log.Debugw("error loading transaction", "tx-id", transactionID, "err", err)
+		return
+	}
+	if m.throttle(nbr) {
+		return
+	}
+
+	// send the loaded transaction directly to the neighbor
+	packet := &pb.Packet{Body: &pb.Packet_Transaction{Transaction: &pb.Transaction{Data: transactionBytes}}}
+	if err := nbr.writePacket(packet); err != nil {
+		nbr.log.Warnw("Failed to send requested transaction back to the neighbor", "err", err)
+		nbr.close()
+	}
+}
+
+// processTransactionPacket triggers the TransactionReceived event for the transaction that was sent by the neighbor.
+func (m *Manager) processTransactionPacket(packetTx *pb.Packet_Transaction, nbr *Neighbor) {
+	if !nbr.Features().Has(FeatureTransactionRequests) {
+		nbr.log.Debugw("Dropped transaction of neighbor that did not negotiate transaction requests")
+		return
+	}
+	if m.messagesRateLimiter != nil {
+		m.messagesRateLimiter.Count(nbr.Peer)
+	}
+	m.events.TransactionReceived.Trigger(&TransactionReceivedEvent{Data: packetTx.Transaction.GetData(), Peer: nbr.Peer})
+}
+
+// announceTransactionRequests adds the FeatureTransactionRequests to the announced Features if the node serves them.
+func (m *Manager) announceTransactionRequests() {
+	if m.loadTransactionFunc != nil {
+		m.features.Flags[FeatureTransactionRequests] = types.Void
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Neighbor /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionRequestsReceived returns number of transaction requests this neighbor has received.
+func (n *Neighbor) TransactionRequestsReceived() uint64 {
+	return n.transactionRequestsReceived.Load()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionReceivedEvent /////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionReceivedEvent holds data about a transaction received event.
+type TransactionReceivedEvent struct {
+	// The raw transaction payload.
+	Data []byte
+	// The sender of the transaction.
+	Peer *peer.Peer
+}
+
+func transactionReceived(handler interface{}, params ...interface{}) {
+	handler.(func(*TransactionReceivedEvent))(params[0].(*TransactionReceivedEvent))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,57 @@
+package gossip
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestTransactionRequest(t *testing.T) {
+	testMgrs := newTestManagers(t, false /* doMock */, t.Name()+"_A", t.Name()+"_B", t.Name()+"_C")
+	mgrA, closeA, peerA := testMgrs[0].manager, testMgrs[0].close, testMgrs[0].peer
+	mgrB, closeB, peerB := testMgrs[1].manager, testMgrs[1].close, testMgrs[1].peer
+	mgrC, closeC, peerC := testMgrs[2].manager, testMgrs[2].close, testMgrs[2].peer
+	defer closeA()
+	defer closeB()
+	defer closeC()
+
+	transactionID := ledgerstate.TransactionID{1}
+	transactionData := []byte("transaction")
+	for _, mgr := range []*Manager{mgrA, mgrB} {
+		WithLoadTransactionFunc(func(requestedID ledgerstate.TransactionID) ([]byte, error) {
+			require.Equal(t, transactionID, requestedID)
+			return transactionData, nil
+		})(mgr)
+		mgr.announceTransactionRequests()
+	}
+	assert.True(t, mgrA.Features().Has(FeatureTransactionRequests))
+	assert.False(t, mgrC.Features().Has(FeatureTransactionRequests))
+
+	connectManagers(t, mgrA, peerA, mgrB, peerB)
+	connectManagers(t, mgrA, peerA, mgrC, peerC)
+
+	receivedTransactions := atomic.NewInt32(0)
+	mgrA.Events().TransactionReceived.Attach(events.NewClosure(func(event *TransactionReceivedEvent) {
+		assert.Equal(t, transactionData, event.Data)
+		assert.Equal(t, peerB, event.Peer)
+		receivedTransactions.Inc()
+	}))
+
+	// only the neighbor that negotiated the feature is queried
+	recipients := mgrA.RequestTransaction(transactionID.Bytes())
+	require.Len(t, recipients, 1)
+	assert.Equal(t, peerB.ID(), recipients[0].ID())
+	assert.Eventually(t, func() bool { return receivedTransactions.Load() == 1 }, time.Second, graceTime)
+
+	neighborA, err := mgrB.GetNeighbor(peerA.ID())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, neighborA.TransactionRequestsReceived())
+
+	assert.Empty(t, mgrA.RequestTransaction(transactionID.Bytes(), peerC.ID()))
+}
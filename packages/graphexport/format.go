@@ -0,0 +1,270 @@
+package graphexport
+
+import (
+	"bufio"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// region Format ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ErrUnknownFormat is returned if a Graph is requested in a format that is not supported.
+var ErrUnknownFormat = errors.New("unknown graph format")
+
+// Format represents a file format that a Graph can be written in.
+type Format uint8
+
+const (
+	// GraphML is the XML based format of https://graphml.graphdrawing.org, which is read by Gephi, NetworkX and yEd.
+	GraphML Format = iota
+
+	// DOT is the format of Graphviz.
+	DOT
+
+	// JSONGraph is the JSON Graph Format (version 2) of https://jsongraphformat.info.
+	JSONGraph
+)
+
+// FormatFromString returns the Format with the given name (graphml, dot or json).
+func FormatFromString(name string) (format Format, err error) {
+	switch strings.ToLower(name) {
+	case "graphml":
+		return GraphML, nil
+	case "dot":
+		return DOT, nil
+	case "json":
+		return JSONGraph, nil
+	default:
+		return 0, errors.Errorf("%s is not one of graphml, dot or json: %w", name, ErrUnknownFormat)
+	}
+}
+
+// String returns the name of the Format.
+func (f Format) String() string {
+	switch f {
+	case GraphML:
+		return "graphml"
+	case DOT:
+		return "dot"
+	case JSONGraph:
+		return "json"
+	default:
+		return fmt.Sprintf("Format(%d)", uint8(f))
+	}
+}
+
+// FileExtension returns the file extension of the Format.
+func (f Format) FileExtension() string {
+	if f == JSONGraph {
+		return ".json"
+	}
+
+	return "." + f.String()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Writers //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Write writes the Graph in the given Format to the given writer.
+func (g *Graph) Write(writer io.Writer, format Format) (err error) {
+	bufferedWriter := bufio.NewWriter(writer)
+	switch format {
+	case GraphML:
+		err = g.writeGraphML(bufferedWriter)
+	case DOT:
+		err = g.writeDOT(bufferedWriter)
+	case JSONGraph:
+		err = g.writeJSONGraph(bufferedWriter)
+	default:
+		return errors.Errorf("failed to write graph in %s: %w", format, ErrUnknownFormat)
+	}
+	if err != nil {
+		return errors.Errorf("failed to write graph in %s: %w", format, err)
+	}
+
+	return bufferedWriter.Flush()
+}
+
+// writeGraphML writes the Graph as a GraphML document. The attributes are declared as typed keys, so that tools can
+// use them for filtering and partitioning.
+func (g *Graph) writeGraphML(writer *bufio.Writer) (err error) {
+	lines := []string{
+		xml.Header + `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`,
+		`  <key id="truncated" for="graph" attr.name="truncated" attr.type="boolean"/>`,
+		`  <key id="type" for="all" attr.name="type" attr.type="string"/>`,
+		`  <key id="label" for="node" attr.name="label" attr.type="string"/>`,
+	}
+	for _, attribute := range g.Attributes {
+		lines = append(lines, fmt.Sprintf(`  <key id=%s for="node" attr.name=%s attr.type="%s"/>`, xmlAttribute(attribute.Name), xmlAttribute(attribute.Name), attribute.Type))
+	}
+	lines = append(lines,
+		`  <graph id="tangle" edgedefault="directed">`,
+		fmt.Sprintf(`    <data key="truncated">%t</data>`, g.Truncated),
+	)
+	if _, err = writer.WriteString(strings.Join(lines, "\n") + "\n"); err != nil {
+		return err
+	}
+
+	for _, node := range g.Nodes {
+		if _, err = fmt.Fprintf(writer, "    <node id=%s>\n      <data key=\"type\">%s</data>\n      <data key=\"label\">%s</data>\n", xmlAttribute(node.ID), xmlText(string(node.Type)), xmlText(node.Label)); err != nil {
+			return err
+		}
+		for _, attribute := range g.nodeAttributes(node) {
+			if _, err = fmt.Fprintf(writer, "      <data key=%s>%s</data>\n", xmlAttribute(attribute.Name), xmlText(formatValue(node.Values[attribute.Name]))); err != nil {
+				return err
+			}
+		}
+		if _, err = writer.WriteString("    </node>\n"); err != nil {
+			return err
+		}
+	}
+
+	for _, edge := range g.Edges {
+		if _, err = fmt.Fprintf(writer, "    <edge source=%s target=%s>\n      <data key=\"type\">%s</data>\n    </edge>\n", xmlAttribute(edge.Source), xmlAttribute(edge.Target), xmlText(string(edge.Type))); err != nil {
+			return err
+		}
+	}
+
+	_, err = writer.WriteString("  </graph>\n</graphml>\n")
+
+	return err
+}
+
+// writeDOT writes the Graph as a directed DOT graph with the attributes of the nodes as node attributes.
+func (g *Graph) writeDOT(writer *bufio.Writer) (err error) {
+	if _, err = fmt.Fprintf(writer, "digraph tangle {\n  graph [truncated=%t];\n", g.Truncated); err != nil {
+		return err
+	}
+
+	for _, node := range g.Nodes {
+		attributes := []string{"type=" + dotString(string(node.Type)), "label=" + dotString(node.Label)}
+		for _, attribute := range g.nodeAttributes(node) {
+			attributes = append(attributes, attribute.Name+"="+dotString(formatValue(node.Values[attribute.Name])))
+		}
+		if _, err = fmt.Fprintf(writer, "  %s [%s];\n", dotString(node.ID), strings.Join(attributes, ", ")); err != nil {
+			return err
+		}
+	}
+
+	for _, edge := range g.Edges {
+		if _, err = fmt.Fprintf(writer, "  %s -> %s [type=%s];\n", dotString(edge.Source), dotString(edge.Target), dotString(string(edge.Type))); err != nil {
+			return err
+		}
+	}
+
+	_, err = writer.WriteString("}\n")
+
+	return err
+}
+
+// writeJSONGraph writes the Graph as a JSON Graph Format document. The type and the attributes of the nodes are stored
+// in their metadata.
+func (g *Graph) writeJSONGraph(writer *bufio.Writer) (err error) {
+	document := &jsonGraphDocument{
+		Graph: &jsonGraph{
+			ID:       "tangle",
+			Directed: true,
+			Metadata: map[string]interface{}{"truncated": g.Truncated},
+			Nodes:    make(map[string]*jsonGraphNode, len(g.Nodes)),
+			Edges:    make([]*jsonGraphEdge, 0, len(g.Edges)),
+		},
+	}
+
+	for _, node := range g.Nodes {
+		metadata := map[string]interface{}{"type": node.Type}
+		for _, attribute := range g.nodeAttributes(node) {
+			metadata[attribute.Name] = node.Values[attribute.Name]
+		}
+		document.Graph.Nodes[node.ID] = &jsonGraphNode{Label: node.Label, Metadata: metadata}
+	}
+
+	for _, edge := range g.Edges {
+		document.Graph.Edges = append(document.Graph.Edges, &jsonGraphEdge{Source: edge.Source, Target: edge.Target, Relation: edge.Type})
+	}
+
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(document)
+}
+
+// nodeAttributes returns the exported attributes of the given Node.
+func (g *Graph) nodeAttributes(node *Node) (attributes []*Attribute) {
+	for _, attribute := range g.Attributes {
+		if attribute.NodeType == node.Type {
+			attributes = append(attributes, attribute)
+		}
+	}
+
+	return attributes
+}
+
+// jsonGraphDocument is the top level object of a JSON Graph Format document.
+type jsonGraphDocument struct {
+	Graph *jsonGraph `json:"graph"`
+}
+
+// jsonGraph is a graph of a JSON Graph Format document.
+type jsonGraph struct {
+	ID       string                    `json:"id"`
+	Directed bool                      `json:"directed"`
+	Metadata map[string]interface{}    `json:"metadata"`
+	Nodes    map[string]*jsonGraphNode `json:"nodes"`
+	Edges    []*jsonGraphEdge          `json:"edges"`
+}
+
+// jsonGraphNode is a node of a JSON Graph Format document.
+type jsonGraphNode struct {
+	Label    string                 `json:"label"`
+	Metadata map[string]interface{} `json:"metadata"`
+}
+
+// jsonGraphEdge is an edge of a JSON Graph Format document.
+type jsonGraphEdge struct {
+	Source   string   `json:"source"`
+	Target   string   `json:"target"`
+	Relation EdgeType `json:"relation"`
+}
+
+// formatValue returns the textual representation of the value of an Attribute.
+func formatValue(value interface{}) string {
+	switch typedValue := value.(type) {
+	case string:
+		return typedValue
+	case int64:
+		return strconv.FormatInt(typedValue, 10)
+	case float64:
+		return strconv.FormatFloat(typedValue, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(typedValue)
+	default:
+		return fmt.Sprint(typedValue)
+	}
+}
+
+// xmlText escapes the given string for the use as the text of an XML element.
+func xmlText(text string) string {
+	var builder strings.Builder
+	_ = xml.EscapeText(&builder, []byte(text))
+
+	return builder.String()
+}
+
+// xmlAttribute escapes and quotes the given string for the use as the value of an XML attribute.
+func xmlAttribute(value string) string {
+	return `"` + xmlText(value) + `"`
+}
+
+// dotString escapes and quotes the given string for the use as an ID in a DOT graph.
+func dotString(value string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value) + `"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,83 @@
+package graphexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatFromString(t *testing.T) {
+	for _, format := range []Format{GraphML, DOT, JSONGraph} {
+		parsedFormat, err := FormatFromString(format.String())
+		require.NoError(t, err)
+		assert.Equal(t, format, parsedFormat)
+	}
+
+	_, err := FormatFromString("gexf")
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
+
+func TestGraph_Write(t *testing.T) {
+	graph := testGraph()
+
+	var graphML bytes.Buffer
+	require.NoError(t, graph.Write(&graphML, GraphML))
+	var graphMLDocument struct {
+		Keys []struct {
+			ID   string `xml:"id,attr"`
+			Type string `xml:"attr.type,attr"`
+		} `xml:"key"`
+		Nodes []struct {
+			ID   string `xml:"id,attr"`
+			Data []struct {
+				Key   string `xml:"key,attr"`
+				Value string `xml:",chardata"`
+			} `xml:"data"`
+		} `xml:"graph>node"`
+		Edges []struct {
+			Source string `xml:"source,attr"`
+			Target string `xml:"target,attr"`
+		} `xml:"graph>edge"`
+	}
+	require.NoError(t, xml.Unmarshal(graphML.Bytes(), &graphMLDocument))
+	assert.Len(t, graphMLDocument.Keys, 5)
+	assert.Equal(t, "double", graphMLDocument.Keys[4].Type)
+	require.Len(t, graphMLDocument.Nodes, 2)
+	assert.Equal(t, `issuer "<1>"`, graphMLDocument.Nodes[0].Data[2].Value)
+	require.Len(t, graphMLDocument.Edges, 1)
+	assert.Equal(t, "branch:branch1", graphMLDocument.Edges[0].Target)
+
+	var dot bytes.Buffer
+	require.NoError(t, graph.Write(&dot, DOT))
+	assert.Contains(t, dot.String(), `"message:message1" [type="message", label="message1", issuer="issuer \"<1>\""];`)
+	assert.Contains(t, dot.String(), `"message:message1" -> "branch:branch1" [type="branch"];`)
+
+	var jsonGraph bytes.Buffer
+	require.NoError(t, graph.Write(&jsonGraph, JSONGraph))
+	document := &jsonGraphDocument{}
+	require.NoError(t, json.Unmarshal(jsonGraph.Bytes(), document))
+	assert.True(t, document.Graph.Directed)
+	assert.Equal(t, 0.5, document.Graph.Nodes["branch:branch1"].Metadata["weight"])
+	assert.Equal(t, BranchEdge, document.Graph.Edges[0].Relation)
+}
+
+// testGraph returns a Graph with a message that is booked into a branch.
+func testGraph() *Graph {
+	return &Graph{
+		Nodes: []*Node{
+			{ID: "message:message1", Type: MessageNode, Label: "message1", Values: map[string]interface{}{"issuer": `issuer "<1>"`}},
+			{ID: "branch:branch1", Type: BranchNode, Label: "branch1", Values: map[string]interface{}{"weight": 0.5}},
+		},
+		Edges: []*Edge{
+			{Source: "message:message1", Target: "branch:branch1", Type: BranchEdge},
+		},
+		Attributes: []*Attribute{
+			{Name: "issuer", Type: StringAttribute, NodeType: MessageNode},
+			{Name: "weight", Type: FloatAttribute, NodeType: BranchNode},
+		},
+	}
+}
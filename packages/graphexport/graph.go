@@ -0,0 +1,329 @@
+// Package graphexport exports a bounded region of the message DAG and the branch DAG to standard graph formats
+// (GraphML, DOT and JSON Graph), so that it can be analyzed offline with tools like Gephi or NetworkX.
+package graphexport
+
+import (
+	"sort"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/conearchive"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// ErrUnknownAttribute is returned if an attribute is requested that does not exist for the type of the node.
+var ErrUnknownAttribute = errors.New("unknown attribute")
+
+// region Graph ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Graph is a directed graph that contains messages and branches as nodes. Edges point from a message to its parents
+// and to the branches it is booked into, and from a branch to its parent branches.
+type Graph struct {
+	// Nodes contains the messages ordered by their issuing time followed by the branches ordered by their ID.
+	Nodes []*Node
+	// Edges contains the edges ordered by their source, their type and their target.
+	Edges []*Edge
+	// Attributes contains the attributes of the nodes in the order in which they are exported.
+	Attributes []*Attribute
+	// Truncated is true if the region contained more messages than allowed.
+	Truncated bool
+}
+
+// Capture collects a region of the message DAG of the given Tangle and the branches that its messages are booked into.
+// The region starts at the entry points (the tips by default) and contains their past cone up to the configured depth
+// and number of messages.
+func Capture(tangleInstance *tangle.Tangle, options ...Option) (graph *Graph, err error) {
+	opts, err := newOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	entryPoints := opts.EntryPoints
+	if len(entryPoints) == 0 {
+		entryPoints = tangleInstance.TipManager.AllTips()
+	}
+
+	messages, truncated := captureMessages(tangleInstance, entryPoints, opts.MaxMessages, opts.MaxDepth)
+
+	branches := make([]*conearchive.BranchEntry, 0)
+	if opts.Branches {
+		branches = captureBranches(tangleInstance, messages)
+	}
+
+	return newGraph(messages, branches, truncated, opts), nil
+}
+
+// FromArchive creates a Graph from a captured unconfirmed cone, which is already bounded, so only the attribute and
+// branch options are taken into account.
+func FromArchive(archive *conearchive.Archive, options ...Option) (graph *Graph, err error) {
+	opts, err := newOptions(options...)
+	if err != nil {
+		return nil, err
+	}
+
+	branches := archive.Branches
+	if !opts.Branches {
+		branches = make([]*conearchive.BranchEntry, 0)
+	}
+
+	return newGraph(archive.Messages, branches, false, opts), nil
+}
+
+// newGraph creates a Graph from the given messages and branches. Edges to nodes that are not part of the region are
+// omitted.
+func newGraph(messages []*conearchive.MessageEntry, branches []*conearchive.BranchEntry, truncated bool, opts *Options) (graph *Graph) {
+	graph = &Graph{
+		Nodes:     make([]*Node, 0, len(messages)+len(branches)),
+		Edges:     make([]*Edge, 0),
+		Truncated: truncated,
+	}
+
+	for _, name := range opts.MessageAttributes {
+		graph.Attributes = append(graph.Attributes, messageAttributes[name].Attribute)
+	}
+	if opts.Branches {
+		for _, name := range opts.BranchAttributes {
+			graph.Attributes = append(graph.Attributes, branchAttributes[name].Attribute)
+		}
+	}
+
+	sortedMessages := make([]*conearchive.MessageEntry, len(messages))
+	copy(sortedMessages, messages)
+	sort.Slice(sortedMessages, func(i, j int) bool {
+		if !sortedMessages[i].Message.IssuingTime().Equal(sortedMessages[j].Message.IssuingTime()) {
+			return sortedMessages[i].Message.IssuingTime().Before(sortedMessages[j].Message.IssuingTime())
+		}
+		return sortedMessages[i].Message.ID().Base58() < sortedMessages[j].Message.ID().Base58()
+	})
+
+	sortedBranches := make([]*conearchive.BranchEntry, len(branches))
+	copy(sortedBranches, branches)
+	sort.Slice(sortedBranches, func(i, j int) bool {
+		return sortedBranches[i].BranchID.Base58() < sortedBranches[j].BranchID.Base58()
+	})
+
+	messageIDs := tangle.NewMessageIDs()
+	for _, entry := range sortedMessages {
+		messageIDs.Add(entry.Message.ID())
+	}
+	branchIDs := ledgerstate.NewBranchIDs()
+	for _, entry := range sortedBranches {
+		branchIDs.Add(entry.BranchID)
+	}
+
+	for _, entry := range sortedMessages {
+		node := newNode(MessageNode, entry.Message.ID().Base58())
+		for _, name := range opts.MessageAttributes {
+			node.Values[name] = messageAttributes[name].value(entry)
+		}
+		graph.Nodes = append(graph.Nodes, node)
+
+		entry.Message.ForEachParent(func(parent tangle.Parent) {
+			if messageIDs.Contains(parent.ID) {
+				graph.Edges = append(graph.Edges, &Edge{Source: node.ID, Target: messageNodeID(parent.ID), Type: parentEdgeTypes[parent.Type]})
+			}
+		})
+		for branchID := range entry.BranchIDs {
+			if branchIDs.Contains(branchID) {
+				graph.Edges = append(graph.Edges, &Edge{Source: node.ID, Target: branchNodeID(branchID), Type: BranchEdge})
+			}
+		}
+	}
+
+	for _, entry := range sortedBranches {
+		node := newNode(BranchNode, entry.BranchID.Base58())
+		for _, name := range opts.BranchAttributes {
+			node.Values[name] = branchAttributes[name].value(entry)
+		}
+		graph.Nodes = append(graph.Nodes, node)
+
+		for parentBranchID := range entry.Parents {
+			if branchIDs.Contains(parentBranchID) {
+				graph.Edges = append(graph.Edges, &Edge{Source: node.ID, Target: branchNodeID(parentBranchID), Type: ParentBranchEdge})
+			}
+		}
+	}
+
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].Source != graph.Edges[j].Source {
+			return graph.Edges[i].Source < graph.Edges[j].Source
+		}
+		if graph.Edges[i].Type != graph.Edges[j].Type {
+			return graph.Edges[i].Type < graph.Edges[j].Type
+		}
+		return graph.Edges[i].Target < graph.Edges[j].Target
+	})
+
+	return graph
+}
+
+// captureMessages walks the past cone of the entry points layer by layer, so that the messages closest to the entry
+// points are kept if the region is truncated.
+func captureMessages(tangleInstance *tangle.Tangle, entryPoints tangle.MessageIDs, maxMessages, maxDepth int) (messages []*conearchive.MessageEntry, truncated bool) {
+	messages = make([]*conearchive.MessageEntry, 0)
+	visited := tangle.NewMessageIDs()
+
+	currentLayer := entryPoints.Clone()
+	for depth := 0; len(currentLayer) > 0 && (maxDepth <= 0 || depth <= maxDepth); depth++ {
+		nextLayer := tangle.NewMessageIDs()
+		for messageID := range currentLayer {
+			if messageID == tangle.EmptyMessageID || visited.Contains(messageID) {
+				continue
+			}
+			if maxMessages > 0 && len(messages) >= maxMessages {
+				return messages, true
+			}
+			visited.Add(messageID)
+
+			tangleInstance.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+				tangleInstance.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+					entry := &conearchive.MessageEntry{
+						Message:   message,
+						Metadata:  messageMetadata,
+						BranchIDs: ledgerstate.NewBranchIDs(),
+					}
+					if messageMetadata.IsBooked() {
+						if messageBranchIDs, branchIDsErr := tangleInstance.Booker.MessageBranchIDs(messageID); branchIDsErr == nil {
+							entry.BranchIDs = messageBranchIDs
+						}
+					}
+					messages = append(messages, entry)
+
+					message.ForEachParent(func(parent tangle.Parent) {
+						nextLayer.Add(parent.ID)
+					})
+				})
+			})
+		}
+		currentLayer = nextLayer
+	}
+
+	return messages, false
+}
+
+// captureBranches collects the branches that the given messages are booked into together with all of their ancestors.
+func captureBranches(tangleInstance *tangle.Tangle, messages []*conearchive.MessageEntry) (branches []*conearchive.BranchEntry) {
+	branches = make([]*conearchive.BranchEntry, 0)
+
+	branchStack := make([]ledgerstate.BranchID, 0)
+	for _, entry := range messages {
+		branchStack = append(branchStack, entry.BranchIDs.Slice()...)
+	}
+
+	visited := ledgerstate.NewBranchIDs()
+	for len(branchStack) > 0 {
+		branchID := branchStack[len(branchStack)-1]
+		branchStack = branchStack[:len(branchStack)-1]
+		if visited.Contains(branchID) {
+			continue
+		}
+		visited.Add(branchID)
+
+		tangleInstance.LedgerState.Branch(branchID).Consume(func(branch *ledgerstate.Branch) {
+			branches = append(branches, &conearchive.BranchEntry{
+				BranchID:       branchID,
+				Parents:        branch.Parents(),
+				InclusionState: branch.InclusionState(),
+				Weight:         tangleInstance.ApprovalWeightManager.WeightOfBranch(branchID),
+			})
+			branchStack = append(branchStack, branch.Parents().Slice()...)
+		})
+	}
+
+	return branches
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Node /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodeType represents the type of a Node.
+type NodeType string
+
+const (
+	// MessageNode is the type of the nodes of messages.
+	MessageNode NodeType = "message"
+
+	// BranchNode is the type of the nodes of branches.
+	BranchNode NodeType = "branch"
+)
+
+// Node is a message or a branch of the Graph.
+type Node struct {
+	// ID contains the identifier of the Node, which is unique across the types of nodes.
+	ID string
+	// Type contains the type of the Node.
+	Type NodeType
+	// Label contains the base58 encoded ID of the message or the branch.
+	Label string
+	// Values contains the values of the attributes of the Node by their name.
+	Values map[string]interface{}
+}
+
+// newNode creates a Node of the given type for the message or branch with the given base58 encoded ID.
+func newNode(nodeType NodeType, base58ID string) *Node {
+	return &Node{
+		ID:     string(nodeType) + ":" + base58ID,
+		Type:   nodeType,
+		Label:  base58ID,
+		Values: make(map[string]interface{}),
+	}
+}
+
+// messageNodeID returns the ID of the Node of the message with the given ID.
+func messageNodeID(messageID tangle.MessageID) string {
+	return string(MessageNode) + ":" + messageID.Base58()
+}
+
+// branchNodeID returns the ID of the Node of the branch with the given ID.
+func branchNodeID(branchID ledgerstate.BranchID) string {
+	return string(BranchNode) + ":" + branchID.Base58()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Edge /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EdgeType represents the type of an Edge.
+type EdgeType string
+
+const (
+	// StrongParentEdge is the type of the edges from a message to its strong parents.
+	StrongParentEdge EdgeType = "strongParent"
+
+	// WeakParentEdge is the type of the edges from a message to its weak parents.
+	WeakParentEdge EdgeType = "weakParent"
+
+	// ShallowLikeParentEdge is the type of the edges from a message to its shallow like parents.
+	ShallowLikeParentEdge EdgeType = "shallowLikeParent"
+
+	// ShallowDislikeParentEdge is the type of the edges from a message to its shallow dislike parents.
+	ShallowDislikeParentEdge EdgeType = "shallowDislikeParent"
+
+	// BranchEdge is the type of the edges from a message to the branches it is booked into.
+	BranchEdge EdgeType = "branch"
+
+	// ParentBranchEdge is the type of the edges from a branch to its parent branches.
+	ParentBranchEdge EdgeType = "parentBranch"
+)
+
+// parentEdgeTypes maps the types of parents to the types of the corresponding edges.
+var parentEdgeTypes = map[tangle.ParentsType]EdgeType{
+	tangle.StrongParentType:         StrongParentEdge,
+	tangle.WeakParentType:           WeakParentEdge,
+	tangle.ShallowLikeParentType:    ShallowLikeParentEdge,
+	tangle.ShallowDislikeParentType: ShallowDislikeParentEdge,
+}
+
+// Edge is a directed edge of the Graph.
+type Edge struct {
+	// Source contains the ID of the Node that the Edge starts at.
+	Source string
+	// Target contains the ID of the Node that the Edge points to.
+	Target string
+	// Type contains the type of the Edge.
+	Type EdgeType
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,81 @@
+package graphexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestCapture(t *testing.T) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(
+		testTangle,
+		tangle.WithGenesisOutput("G", 3),
+	)
+	testTangle.Setup()
+
+	// Message2 and Message3 double spend the genesis output and create two conflict branches
+	testFramework.CreateMessage("Message1", tangle.WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message2", tangle.WithStrongParents("Message1"), tangle.WithInputs("G"), tangle.WithOutput("A", 3))
+	testFramework.CreateMessage("Message3", tangle.WithStrongParents("Message1"), tangle.WithInputs("G"), tangle.WithOutput("B", 3))
+	testFramework.CreateMessage("Message4", tangle.WithStrongParents("Message2"), tangle.WithWeakParents("Message3"))
+	testFramework.IssueMessages("Message1", "Message2", "Message3", "Message4").WaitMessagesBooked()
+
+	message4 := testFramework.Message("Message4").ID()
+	branch2 := ledgerstate.NewBranchID(testFramework.TransactionID("Message2"))
+
+	graph, err := Capture(testTangle, EntryPoints(message4), MessageAttributes("booked", "branchIDs"))
+	require.NoError(t, err)
+	assert.False(t, graph.Truncated)
+	assert.ElementsMatch(t, []string{
+		messageNodeID(testFramework.Message("Message1").ID()),
+		messageNodeID(testFramework.Message("Message2").ID()),
+		messageNodeID(testFramework.Message("Message3").ID()),
+		messageNodeID(message4),
+	}, nodeIDsOfType(graph, MessageNode))
+	assert.Contains(t, nodeIDsOfType(graph, BranchNode), branchNodeID(branch2))
+	assert.Contains(t, nodeIDsOfType(graph, BranchNode), branchNodeID(ledgerstate.MasterBranchID))
+
+	assert.Contains(t, graph.Edges, &Edge{Source: messageNodeID(message4), Target: messageNodeID(testFramework.Message("Message2").ID()), Type: StrongParentEdge})
+	assert.Contains(t, graph.Edges, &Edge{Source: messageNodeID(message4), Target: messageNodeID(testFramework.Message("Message3").ID()), Type: WeakParentEdge})
+	assert.Contains(t, graph.Edges, &Edge{Source: messageNodeID(testFramework.Message("Message2").ID()), Target: branchNodeID(branch2), Type: BranchEdge})
+	assert.Contains(t, graph.Edges, &Edge{Source: branchNodeID(branch2), Target: branchNodeID(ledgerstate.MasterBranchID), Type: ParentBranchEdge})
+
+	for _, node := range graph.Nodes {
+		if node.ID == messageNodeID(testFramework.Message("Message2").ID()) {
+			assert.Equal(t, true, node.Values["booked"])
+			assert.Equal(t, branch2.Base58(), node.Values["branchIDs"])
+		}
+	}
+
+	// the region is bounded by the depth and the number of messages
+	graph, err = Capture(testTangle, EntryPoints(message4), MaxDepth(1), Branches(false))
+	require.NoError(t, err)
+	assert.Len(t, graph.Nodes, 3)
+	assert.Empty(t, nodeIDsOfType(graph, BranchNode))
+
+	graph, err = Capture(testTangle, EntryPoints(message4), MaxMessages(2))
+	require.NoError(t, err)
+	assert.True(t, graph.Truncated)
+	assert.Len(t, nodeIDsOfType(graph, MessageNode), 2)
+
+	_, err = Capture(testTangle, MessageAttributes("unknown"))
+	assert.ErrorIs(t, err, ErrUnknownAttribute)
+}
+
+// nodeIDsOfType returns the IDs of the nodes of the given type.
+func nodeIDsOfType(graph *Graph, nodeType NodeType) (nodeIDs []string) {
+	for _, node := range graph.Nodes {
+		if node.Type == nodeType {
+			nodeIDs = append(nodeIDs, node.ID)
+		}
+	}
+
+	return nodeIDs
+}
@@ -0,0 +1,246 @@
+package graphexport
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/conearchive"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DefaultMessageAttributes contains the attributes of messages that are exported if no attributes are requested.
+var DefaultMessageAttributes = []string{"issuer", "issuingTime", "payloadType", "booked", "gradeOfFinality"}
+
+// DefaultBranchAttributes contains the attributes of branches that are exported if no attributes are requested.
+var DefaultBranchAttributes = []string{"inclusionState", "weight"}
+
+// Options defines the region and the attributes of an export.
+type Options struct {
+	// EntryPoints contains the messages whose past cone is exported (the tips if empty).
+	EntryPoints tangle.MessageIDs
+	// MaxMessages defines the maximum number of exported messages (0 disables the limit).
+	MaxMessages int
+	// MaxDepth defines the maximum distance of an exported message to the entry points (0 disables the limit).
+	MaxDepth int
+	// MessageAttributes contains the names of the exported attributes of messages.
+	MessageAttributes []string
+	// BranchAttributes contains the names of the exported attributes of branches.
+	BranchAttributes []string
+	// Branches defines whether the branch DAG is exported.
+	Branches bool
+}
+
+// Option represents the return type of optional parameters that can be handed into Capture and FromArchive.
+type Option func(*Options)
+
+// EntryPoints returns an Option that sets the messages whose past cone is exported.
+func EntryPoints(messageIDs ...tangle.MessageID) Option {
+	return func(options *Options) {
+		options.EntryPoints = tangle.NewMessageIDs(messageIDs...)
+	}
+}
+
+// MaxMessages returns an Option that sets the maximum number of exported messages.
+func MaxMessages(maxMessages int) Option {
+	return func(options *Options) {
+		options.MaxMessages = maxMessages
+	}
+}
+
+// MaxDepth returns an Option that sets the maximum distance of an exported message to the entry points.
+func MaxDepth(maxDepth int) Option {
+	return func(options *Options) {
+		options.MaxDepth = maxDepth
+	}
+}
+
+// MessageAttributes returns an Option that sets the exported attributes of messages.
+func MessageAttributes(names ...string) Option {
+	return func(options *Options) {
+		options.MessageAttributes = names
+	}
+}
+
+// BranchAttributes returns an Option that sets the exported attributes of branches.
+func BranchAttributes(names ...string) Option {
+	return func(options *Options) {
+		options.BranchAttributes = names
+	}
+}
+
+// Branches returns an Option that defines whether the branch DAG is exported.
+func Branches(branches bool) Option {
+	return func(options *Options) {
+		options.Branches = branches
+	}
+}
+
+// newOptions creates the Options from the given optional parameters and validates the requested attributes.
+func newOptions(optionalOptions ...Option) (options *Options, err error) {
+	options = &Options{
+		MessageAttributes: DefaultMessageAttributes,
+		BranchAttributes:  DefaultBranchAttributes,
+		Branches:          true,
+	}
+	for _, option := range optionalOptions {
+		option(options)
+	}
+
+	for _, name := range options.MessageAttributes {
+		if _, exists := messageAttributes[name]; !exists {
+			return nil, errors.Errorf("%s is not an attribute of messages (available: %s): %w", name, strings.Join(MessageAttributeNames(), ", "), ErrUnknownAttribute)
+		}
+	}
+	for _, name := range options.BranchAttributes {
+		if _, exists := branchAttributes[name]; !exists {
+			return nil, errors.Errorf("%s is not an attribute of branches (available: %s): %w", name, strings.Join(BranchAttributeNames(), ", "), ErrUnknownAttribute)
+		}
+	}
+
+	return options, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Attribute ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AttributeType represents the type of the values of an Attribute.
+type AttributeType string
+
+const (
+	// StringAttribute is the type of attributes with string values.
+	StringAttribute AttributeType = "string"
+
+	// IntAttribute is the type of attributes with int64 values.
+	IntAttribute AttributeType = "long"
+
+	// FloatAttribute is the type of attributes with float64 values.
+	FloatAttribute AttributeType = "double"
+
+	// BoolAttribute is the type of attributes with bool values.
+	BoolAttribute AttributeType = "boolean"
+)
+
+// Attribute is a property of the nodes of a type that is exported together with the nodes.
+type Attribute struct {
+	// Name contains the name of the Attribute.
+	Name string
+	// Type contains the type of the values of the Attribute.
+	Type AttributeType
+	// NodeType contains the type of the nodes that have the Attribute.
+	NodeType NodeType
+}
+
+// messageAttribute is an Attribute of messages together with the function that determines its value.
+type messageAttribute struct {
+	*Attribute
+
+	value func(entry *conearchive.MessageEntry) interface{}
+}
+
+// branchAttribute is an Attribute of branches together with the function that determines its value.
+type branchAttribute struct {
+	*Attribute
+
+	value func(entry *conearchive.BranchEntry) interface{}
+}
+
+// messageAttributes contains the attributes of messages that can be exported by their name.
+var messageAttributes = map[string]*messageAttribute{}
+
+// branchAttributes contains the attributes of branches that can be exported by their name.
+var branchAttributes = map[string]*branchAttribute{}
+
+// MessageAttributeNames returns the sorted names of the attributes of messages that can be exported.
+func MessageAttributeNames() (names []string) {
+	for name := range messageAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// BranchAttributeNames returns the sorted names of the attributes of branches that can be exported.
+func BranchAttributeNames() (names []string) {
+	for name := range branchAttributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// registerMessageAttribute makes the attribute of messages with the given name exportable.
+func registerMessageAttribute(name string, attributeType AttributeType, value func(entry *conearchive.MessageEntry) interface{}) {
+	messageAttributes[name] = &messageAttribute{
+		Attribute: &Attribute{Name: name, Type: attributeType, NodeType: MessageNode},
+		value:     value,
+	}
+}
+
+// registerBranchAttribute makes the attribute of branches with the given name exportable.
+func registerBranchAttribute(name string, attributeType AttributeType, value func(entry *conearchive.BranchEntry) interface{}) {
+	branchAttributes[name] = &branchAttribute{
+		Attribute: &Attribute{Name: name, Type: attributeType, NodeType: BranchNode},
+		value:     value,
+	}
+}
+
+func init() {
+	registerMessageAttribute("issuer", StringAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Message.IssuerPublicKey().String()
+	})
+	registerMessageAttribute("issuingTime", IntAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Message.IssuingTime().UnixNano()
+	})
+	registerMessageAttribute("receivedTime", IntAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.ReceivedTime().UnixNano()
+	})
+	registerMessageAttribute("sequenceNumber", IntAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return int64(entry.Message.SequenceNumber())
+	})
+	registerMessageAttribute("payloadType", StringAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Message.Payload().Type().String()
+	})
+	registerMessageAttribute("solid", BoolAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.IsSolid()
+	})
+	registerMessageAttribute("booked", BoolAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.IsBooked()
+	})
+	registerMessageAttribute("scheduled", BoolAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.Scheduled()
+	})
+	registerMessageAttribute("orphaned", BoolAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.IsOrphaned()
+	})
+	registerMessageAttribute("objectivelyInvalid", BoolAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.IsObjectivelyInvalid()
+	})
+	registerMessageAttribute("subjectivelyInvalid", BoolAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return entry.Metadata.IsSubjectivelyInvalid()
+	})
+	registerMessageAttribute("gradeOfFinality", IntAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		return int64(entry.Metadata.GradeOfFinality())
+	})
+	registerMessageAttribute("branchIDs", StringAttribute, func(entry *conearchive.MessageEntry) interface{} {
+		branchIDs := entry.BranchIDs.Base58()
+		sort.Strings(branchIDs)
+
+		return strings.Join(branchIDs, ";")
+	})
+
+	registerBranchAttribute("inclusionState", StringAttribute, func(entry *conearchive.BranchEntry) interface{} {
+		return entry.InclusionState.String()
+	})
+	registerBranchAttribute("weight", FloatAttribute, func(entry *conearchive.BranchEntry) interface{} {
+		return entry.Weight
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
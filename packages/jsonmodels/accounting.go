@@ -0,0 +1,116 @@
+package jsonmodels
+
+import (
+	"sort"
+
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/accounting"
+)
+
+// region AccountingResponse ///////////////////////////////////////////////////////////////////////////////////////////
+
+// AccountingResponse is the HTTP response containing the messages and bytes that were issued per payload type and by
+// the issuers that issued the most bytes within the window. The window is given in milliseconds.
+type AccountingResponse struct {
+	Window       int64               `json:"window"`
+	Total        *AccountingUsage    `json:"total"`
+	PayloadTypes []*PayloadTypeUsage `json:"payloadTypes"`
+	IssuerCount  int                 `json:"issuerCount"`
+	Issuers      []*AccountingIssuer `json:"issuers"`
+	Error        string              `json:"error,omitempty"`
+}
+
+// NewAccountingResponse returns the AccountingResponse of the given accounting.Report with the given number of issuers.
+func NewAccountingResponse(report *accounting.Report, topIssuers int) *AccountingResponse {
+	response := &AccountingResponse{
+		Window:       report.Window.Milliseconds(),
+		Total:        NewAccountingUsage(&report.Total),
+		PayloadTypes: NewPayloadTypeUsages(report.PayloadTypes),
+		IssuerCount:  len(report.Issuers),
+		Issuers:      make([]*AccountingIssuer, 0),
+	}
+	for _, issuerUsage := range report.TopIssuers(topIssuers) {
+		response.Issuers = append(response.Issuers, NewAccountingIssuer(issuerUsage))
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AccountingIssuerResponse /////////////////////////////////////////////////////////////////////////////////////
+
+// AccountingIssuerResponse is the HTTP response containing the messages and bytes that were issued by a single issuer
+// within the window. The window is given in milliseconds.
+type AccountingIssuerResponse struct {
+	Window int64             `json:"window"`
+	Issuer *AccountingIssuer `json:"issuer,omitempty"`
+	Error  string            `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AccountingUsage //////////////////////////////////////////////////////////////////////////////////////////////
+
+// AccountingUsage represents the JSON model of the accounting.Usage.
+type AccountingUsage struct {
+	Messages int `json:"messages"`
+	Bytes    int `json:"bytes"`
+}
+
+// NewAccountingUsage returns the AccountingUsage of the given accounting.Usage.
+func NewAccountingUsage(usage *accounting.Usage) *AccountingUsage {
+	return &AccountingUsage{
+		Messages: usage.Messages,
+		Bytes:    usage.Bytes,
+	}
+}
+
+// PayloadTypeUsage represents the JSON model of the accounting.Usage of a payload type.
+type PayloadTypeUsage struct {
+	Type     uint32 `json:"type"`
+	Name     string `json:"name"`
+	Messages int    `json:"messages"`
+	Bytes    int    `json:"bytes"`
+}
+
+// NewPayloadTypeUsages returns the PayloadTypeUsages of the given accounting.PayloadUsage ordered by their type.
+func NewPayloadTypeUsages(payloadUsage accounting.PayloadUsage) (payloadTypeUsages []*PayloadTypeUsage) {
+	payloadTypeUsages = make([]*PayloadTypeUsage, 0, len(payloadUsage))
+	for payloadType, usage := range payloadUsage {
+		payloadTypeUsages = append(payloadTypeUsages, &PayloadTypeUsage{
+			Type:     uint32(payloadType),
+			Name:     payloadType.String(),
+			Messages: usage.Messages,
+			Bytes:    usage.Bytes,
+		})
+	}
+	sort.Slice(payloadTypeUsages, func(i, j int) bool {
+		return payloadTypeUsages[i].Type < payloadTypeUsages[j].Type
+	})
+
+	return payloadTypeUsages
+}
+
+// AccountingIssuer represents the JSON model of the accounting.IssuerUsage.
+type AccountingIssuer struct {
+	PublicKey    string              `json:"publicKey"`
+	NodeID       string              `json:"nodeID"`
+	Messages     int                 `json:"messages"`
+	Bytes        int                 `json:"bytes"`
+	PayloadTypes []*PayloadTypeUsage `json:"payloadTypes"`
+}
+
+// NewAccountingIssuer returns the AccountingIssuer of the given accounting.IssuerUsage.
+func NewAccountingIssuer(issuerUsage *accounting.IssuerUsage) *AccountingIssuer {
+	return &AccountingIssuer{
+		PublicKey:    issuerUsage.Issuer.String(),
+		NodeID:       identity.NewID(issuerUsage.Issuer).String(),
+		Messages:     issuerUsage.Messages,
+		Bytes:        issuerUsage.Bytes,
+		PayloadTypes: NewPayloadTypeUsages(issuerUsage.PayloadTypes),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
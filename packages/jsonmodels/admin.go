@@ -0,0 +1,169 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region RuntimePlugin ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RuntimePlugin represents the JSON model of a runtimeplugin.Plugin.
+type RuntimePlugin struct {
+	Name          string `json:"name"`
+	Running       bool   `json:"running"`
+	EnabledAtBoot bool   `json:"enabledAtBoot"`
+}
+
+// NewRuntimePlugin returns the JSON model of the given runtimeplugin.Plugin.
+func NewRuntimePlugin(plugin *runtimeplugin.Plugin) *RuntimePlugin {
+	return &RuntimePlugin{
+		Name:          plugin.Name(),
+		Running:       plugin.Running(),
+		EnabledAtBoot: plugin.EnabledAtBoot(),
+	}
+}
+
+// RuntimePluginsResponse is the HTTP response containing the plugins that can be started and stopped at runtime.
+type RuntimePluginsResponse struct {
+	Plugins []*RuntimePlugin `json:"plugins"`
+	Error   string           `json:"error,omitempty"`
+}
+
+// RuntimePluginResponse is the HTTP response containing a plugin that was started or stopped.
+type RuntimePluginResponse struct {
+	Plugin *RuntimePlugin `json:"plugin,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SchedulerState ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// SchedulerStateResponse is the HTTP response containing the state of the scheduler after it was paused or resumed.
+type SchedulerStateResponse struct {
+	Running bool   `json:"running"`
+	Paused  bool   `json:"paused"`
+	Rate    string `json:"rate"`
+	Error   string `json:"error,omitempty"`
+}
+
+// NewSchedulerStateResponse returns the SchedulerStateResponse of the given tangle.Scheduler.
+func NewSchedulerStateResponse(scheduler *tangle.Scheduler) *SchedulerStateResponse {
+	return &SchedulerStateResponse{
+		Running: scheduler.Running(),
+		Paused:  scheduler.Paused(),
+		Rate:    scheduler.Rate().String(),
+	}
+}
+
+// SchedulerStepResponse is the HTTP response containing the message that was scheduled by a step of the scheduler.
+type SchedulerStepResponse struct {
+	Scheduled bool   `json:"scheduled"`
+	MessageID string `json:"messageID,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SchedulerBuffer //////////////////////////////////////////////////////////////////////////////////////////////
+
+// SchedulerBufferResponse is the HTTP response containing the contents of the buffer of the scheduler.
+type SchedulerBufferResponse struct {
+	Paused        bool                  `json:"paused"`
+	MaxBufferSize int                   `json:"maxBufferSize"`
+	BufferSize    int                   `json:"bufferSize"`
+	NodeQueues    []*SchedulerNodeQueue `json:"nodeQueues"`
+	Error         string                `json:"error,omitempty"`
+}
+
+// SchedulerNodeQueue represents the JSON model of a tangle.NodeQueueSnapshot.
+type SchedulerNodeQueue struct {
+	NodeID            string   `json:"nodeID"`
+	Mana              float64  `json:"mana"`
+	Deficit           float64  `json:"deficit"`
+	Size              int      `json:"size"`
+	ReadyMessages     []string `json:"readyMessages"`
+	SubmittedMessages []string `json:"submittedMessages"`
+}
+
+// NewSchedulerNodeQueue returns the SchedulerNodeQueue of the given tangle.NodeQueueSnapshot.
+func NewSchedulerNodeQueue(snapshot *tangle.NodeQueueSnapshot) *SchedulerNodeQueue {
+	nodeQueue := &SchedulerNodeQueue{
+		NodeID:            snapshot.NodeID.String(),
+		Mana:              snapshot.Mana,
+		Deficit:           snapshot.Deficit,
+		Size:              snapshot.Size,
+		ReadyMessages:     make([]string, len(snapshot.ReadyMessages)),
+		SubmittedMessages: make([]string, len(snapshot.SubmittedMessages)),
+	}
+	for i, messageID := range snapshot.ReadyMessages {
+		nodeQueue.ReadyMessages[i] = messageID.Base58()
+	}
+	for i, messageID := range snapshot.SubmittedMessages {
+		nodeQueue.SubmittedMessages[i] = messageID.Base58()
+	}
+
+	return nodeQueue
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BranchPreference /////////////////////////////////////////////////////////////////////////////////////////////
+
+// BranchPreference represents the JSON model of an overridden preference of a branch.
+type BranchPreference struct {
+	BranchID   string `json:"branchID"`
+	Preference string `json:"preference,omitempty"`
+	Liked      bool   `json:"liked"`
+}
+
+// NewBranchPreference returns the BranchPreference of the given branch, which is currently liked or not.
+func NewBranchPreference(branchID ledgerstate.BranchID, preference otv.Preference, liked bool) *BranchPreference {
+	branchPreference := &BranchPreference{
+		BranchID: branchID.Base58(),
+		Liked:    liked,
+	}
+	if preference != 0 {
+		branchPreference.Preference = preference.String()
+	}
+
+	return branchPreference
+}
+
+// BranchPreferenceRequest is the HTTP request that overrides the preference of a branch.
+type BranchPreferenceRequest struct {
+	Preference string `json:"preference"`
+}
+
+// BranchPreferenceResponse is the HTTP response containing the preference of a branch after it was overridden or reset.
+type BranchPreferenceResponse struct {
+	BranchPreference *BranchPreference `json:"branchPreference,omitempty"`
+	Error            string            `json:"error,omitempty"`
+}
+
+// BranchPreferencesResponse is the HTTP response containing all overridden preferences of branches.
+type BranchPreferencesResponse struct {
+	BranchPreferences []*BranchPreference `json:"branchPreferences"`
+	Error             string              `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NeighborSizes ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NeighborSizesRequest is the HTTP request that changes the number of inbound and outbound neighbors of the autopeering.
+type NeighborSizesRequest struct {
+	InboundNeighborSize  int `json:"inboundNeighborSize"`
+	OutboundNeighborSize int `json:"outboundNeighborSize"`
+}
+
+// NeighborSizesResponse is the HTTP response containing the number of inbound and outbound neighbors of the autopeering.
+type NeighborSizesResponse struct {
+	InboundNeighborSize  int    `json:"inboundNeighborSize,omitempty"`
+	OutboundNeighborSize int    `json:"outboundNeighborSize,omitempty"`
+	Error                string `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,66 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/analytics"
+)
+
+// region AnalyticsResponse ////////////////////////////////////////////////////////////////////////////////////////////
+
+// AnalyticsResponse is the HTTP response containing the rolling statistics about the Tangle. All durations are given in
+// milliseconds.
+type AnalyticsResponse struct {
+	Window                 int64               `json:"window"`
+	TangleWidth            *TangleWidth        `json:"tangleWidth"`
+	ConfirmationLatency    *DurationStatistics `json:"confirmationLatency"`
+	ConfirmedMessages      int                 `json:"confirmedMessages"`
+	OrphanedMessages       int                 `json:"orphanedMessages"`
+	OrphanageRate          float64             `json:"orphanageRate"`
+	ConflictResolutionTime *DurationStatistics `json:"conflictResolutionTime"`
+	PendingConflicts       int                 `json:"pendingConflicts"`
+	Error                  string              `json:"error,omitempty"`
+}
+
+// NewAnalyticsResponse returns the AnalyticsResponse of the given analytics.Statistics.
+func NewAnalyticsResponse(statistics *analytics.Statistics) *AnalyticsResponse {
+	return &AnalyticsResponse{
+		Window: statistics.Window.Milliseconds(),
+		TangleWidth: &TangleWidth{
+			Current: statistics.TangleWidth.Current,
+			Average: statistics.TangleWidth.Average,
+			Max:     statistics.TangleWidth.Max,
+		},
+		ConfirmationLatency:    NewDurationStatistics(statistics.ConfirmationLatency),
+		ConfirmedMessages:      statistics.ConfirmedMessages,
+		OrphanedMessages:       statistics.OrphanedMessages,
+		OrphanageRate:          statistics.OrphanageRate,
+		ConflictResolutionTime: NewDurationStatistics(statistics.ConflictResolutionTime),
+		PendingConflicts:       statistics.PendingConflicts,
+	}
+}
+
+// TangleWidth represents the JSON model of the analytics.WidthStatistics.
+type TangleWidth struct {
+	Current int     `json:"current"`
+	Average float64 `json:"average"`
+	Max     int     `json:"max"`
+}
+
+// DurationStatistics represents the JSON model of the analytics.DurationStatistics in milliseconds.
+type DurationStatistics struct {
+	Average int64 `json:"average"`
+	P50     int64 `json:"p50"`
+	P90     int64 `json:"p90"`
+	P99     int64 `json:"p99"`
+}
+
+// NewDurationStatistics returns the JSON model of the given analytics.DurationStatistics.
+func NewDurationStatistics(statistics *analytics.DurationStatistics) *DurationStatistics {
+	return &DurationStatistics{
+		Average: statistics.Average.Milliseconds(),
+		P50:     statistics.P50.Milliseconds(),
+		P90:     statistics.P90.Milliseconds(),
+		P99:     statistics.P99.Milliseconds(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
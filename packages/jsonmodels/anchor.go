@@ -0,0 +1,61 @@
+package jsonmodels
+
+import (
+	"time"
+
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/anchor"
+)
+
+// AnchorsResponse is the HTTP response containing the latest anchors of the designated issuers and the sync status of
+// the node that is estimated from them.
+type AnchorsResponse struct {
+	Anchors           []*Anchor `json:"anchors"`
+	TangleTime        int64     `json:"tangleTime"`
+	NetworkTangleTime int64     `json:"networkTangleTime,omitempty"`
+	Lag               int64     `json:"lag"`
+}
+
+// NewAnchorsResponse returns the AnchorsResponse of the given anchors and the sync status of a node with the given
+// TangleTime.
+func NewAnchorsResponse(anchors []*anchor.Anchor, tangleTime time.Time, syncStatus *anchor.SyncStatus) *AnchorsResponse {
+	response := &AnchorsResponse{
+		Anchors:    make([]*Anchor, len(anchors)),
+		TangleTime: tangleTime.Unix(),
+		Lag:        int64(syncStatus.Lag / time.Second),
+	}
+	for i, a := range anchors {
+		response.Anchors[i] = NewAnchor(a)
+	}
+	if !syncStatus.NetworkTangleTime.IsZero() {
+		response.NetworkTangleTime = syncStatus.NetworkTangleTime.Unix()
+	}
+
+	return response
+}
+
+// Anchor represents the JSON model of an anchor. Bytes contains the base58 encoded anchor payload, which allows
+// clients to verify the signature of the anchor themselves.
+type Anchor struct {
+	IssuerPublicKey string `json:"issuerPublicKey"`
+	IssuingTime     int64  `json:"issuingTime"`
+	TangleTime      int64  `json:"tangleTime"`
+	ConfirmedRoot   string `json:"confirmedRoot"`
+	Signature       string `json:"signature"`
+	MessageID       string `json:"messageID"`
+	Bytes           string `json:"bytes"`
+}
+
+// NewAnchor returns the Anchor from the given anchor.Anchor.
+func NewAnchor(a *anchor.Anchor) *Anchor {
+	return &Anchor{
+		IssuerPublicKey: a.IssuerPublicKey.String(),
+		IssuingTime:     a.IssuingTime.Unix(),
+		TangleTime:      a.TangleTime.Unix(),
+		ConfirmedRoot:   a.ConfirmedRoot.Base58(),
+		Signature:       a.Signature.String(),
+		MessageID:       a.MessageID.Base58(),
+		Bytes:           base58.Encode(a.Payload.Bytes()),
+	}
+}
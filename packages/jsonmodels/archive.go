@@ -0,0 +1,23 @@
+package jsonmodels
+
+import (
+	"sort"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// ArchiveHistory represents the JSON model of the messages that are stored in a history index of an archive node.
+type ArchiveHistory struct {
+	MessageIDs []string `json:"messageIDs"`
+	Error      string   `json:"error,omitempty"`
+}
+
+// NewArchiveHistory returns the JSON model of the given MessageIDs.
+func NewArchiveHistory(messageIDs tangle.MessageIDs) *ArchiveHistory {
+	base58MessageIDs := messageIDs.Base58()
+	sort.Strings(base58MessageIDs)
+
+	return &ArchiveHistory{
+		MessageIDs: base58MessageIDs,
+	}
+}
@@ -20,3 +20,47 @@ type PeerService struct {
 	ID      string `json:"id"`      // ID of the service
 	Address string `json:"address"` // network address of the service
 }
+
+// GetAutopeeringDiagnosticsResponse contains the internals of the neighbor selection.
+type GetAutopeeringDiagnosticsResponse struct {
+	InboundNeighborSize  int                       `json:"inboundNeighborSize"`
+	OutboundNeighborSize int                       `json:"outboundNeighborSize"`
+	PublicSalt           *Salt                     `json:"publicSalt,omitempty"`
+	PrivateSalt          *Salt                     `json:"privateSalt,omitempty"`
+	OutboundCandidates   []PeeringCandidate        `json:"outboundCandidates"`
+	InboundCandidates    []PeeringCandidate        `json:"inboundCandidates"`
+	Neighbors            []AutopeeringNeighbor     `json:"neighbors"`
+	RecentDrops          []AutopeeringNeighborDrop `json:"recentDrops"`
+	Error                string                    `json:"error,omitempty"`
+}
+
+// Salt contains a salt of the neighbor selection.
+type Salt struct {
+	Bytes      string `json:"bytes"`      // hex encoded salt
+	Expiration int64  `json:"expiration"` // unix timestamp (in seconds) when the salt expires
+}
+
+// PeeringCandidate contains a candidate of the neighbor selection and its distance.
+type PeeringCandidate struct {
+	ID       string `json:"id"`
+	Distance uint32 `json:"distance"`
+	Neighbor bool   `json:"neighbor"`
+}
+
+// AutopeeringNeighbor contains a current neighbor of the neighbor selection.
+type AutopeeringNeighbor struct {
+	ID        string `json:"id"`
+	Direction string `json:"direction"`
+	Distance  uint32 `json:"distance"`
+	Since     int64  `json:"since"` // unix timestamp (in seconds) when the peering was established
+}
+
+// AutopeeringNeighborDrop contains a recently dropped neighbor and the reason of the drop.
+type AutopeeringNeighborDrop struct {
+	ID        string `json:"id"`
+	Direction string `json:"direction,omitempty"`
+	Distance  uint32 `json:"distance"`
+	Duration  int64  `json:"duration"` // duration of the peering in milliseconds
+	Reason    string `json:"reason"`
+	Time      int64  `json:"time"` // unix timestamp (in seconds) of the drop
+}
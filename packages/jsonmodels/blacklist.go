@@ -0,0 +1,24 @@
+package jsonmodels
+
+// region Blacklist ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// BlacklistEntry represents the JSON model of a tangle.BlacklistEntry.
+type BlacklistEntry struct {
+	IssuerID string `json:"issuerID"`
+	Reason   string `json:"reason"`
+	Until    int64  `json:"until"`
+}
+
+// BlacklistResponse is the HTTP response containing the currently blacklisted issuers.
+type BlacklistResponse struct {
+	Entries []*BlacklistEntry `json:"entries"`
+	Error   string            `json:"error,omitempty"`
+}
+
+// BlacklistRequest is the HTTP request to blacklist an issuer.
+type BlacklistRequest struct {
+	IssuerID string `json:"issuerID"`
+	Duration string `json:"duration"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,44 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region EpochDiff ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EpochDiff represents the JSON model of the changes of the confirmed ledger state in an epoch.
+type EpochDiff struct {
+	Index             uint64    `json:"index"`
+	StartTime         int64     `json:"startTime"`
+	EndTime           int64     `json:"endTime"`
+	Created           []*Output `json:"created"`
+	Spent             []*Output `json:"spent"`
+	ConfirmedBranches []string  `json:"confirmedBranches"`
+	RejectedBranches  []string  `json:"rejectedBranches"`
+	Error             string    `json:"error,omitempty"`
+}
+
+// NewEpochDiff returns the JSON model of the given epochs.Diff.
+func NewEpochDiff(diff *epochs.Diff) *EpochDiff {
+	return &EpochDiff{
+		Index:             uint64(diff.Index),
+		StartTime:         diff.Index.StartTime().Unix(),
+		EndTime:           diff.Index.EndTime().Unix(),
+		Created:           newOutputs(diff.Created),
+		Spent:             newOutputs(diff.Spent),
+		ConfirmedBranches: diff.ConfirmedBranches.Base58(),
+		RejectedBranches:  diff.RejectedBranches.Base58(),
+	}
+}
+
+func newOutputs(outputs ledgerstate.Outputs) (jsonOutputs []*Output) {
+	jsonOutputs = make([]*Output, 0, len(outputs))
+	for _, output := range outputs {
+		jsonOutputs = append(jsonOutputs, NewOutput(output))
+	}
+
+	return jsonOutputs
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
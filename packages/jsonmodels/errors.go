@@ -0,0 +1,236 @@
+package jsonmodels
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/schedulerutils"
+)
+
+// region ErrorResponse ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ErrorResponse represents the JSON model of an error response from an API endpoint. Besides the human-readable error
+// message it contains a machine-readable code, the subsystem that caused the error and whether the request can be
+// retried, so that clients don't need to inspect the message.
+type ErrorResponse struct {
+	Error     string    `json:"error"`
+	Code      ErrorCode `json:"code,omitempty"`
+	Subsystem Subsystem `json:"subsystem,omitempty"`
+	Retryable bool      `json:"retryable"`
+}
+
+// NewErrorResponse returns am ErrorResponse from the given error. The code, subsystem and retry-ability are determined
+// by the first registered error that the given error wraps.
+func NewErrorResponse(err error) *ErrorResponse {
+	errorCode := ErrorCodeOf(err)
+
+	return &ErrorResponse{
+		Error:     err.Error(),
+		Code:      errorCode.Code,
+		Subsystem: errorCode.Subsystem,
+		Retryable: errorCode.Retryable,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ErrorCode ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ErrorCode is a machine-readable code that identifies the cause of a failed API request.
+type ErrorCode string
+
+const (
+	// ErrorCodeUnknown is the code of errors that were not registered.
+	ErrorCodeUnknown ErrorCode = "unknown"
+	// ErrorCodeBadRequest is the code of requests that are malformed.
+	ErrorCodeBadRequest ErrorCode = "bad_request"
+	// ErrorCodeUnauthorized is the code of requests that lack valid credentials.
+	ErrorCodeUnauthorized ErrorCode = "unauthorized"
+	// ErrorCodeForbidden is the code of requests that are not allowed.
+	ErrorCodeForbidden ErrorCode = "forbidden"
+	// ErrorCodeNotFound is the code of requests for routes or objects that don't exist.
+	ErrorCodeNotFound ErrorCode = "not_found"
+	// ErrorCodeInternal is the code of unexpected failures of the node.
+	ErrorCodeInternal ErrorCode = "internal"
+	// ErrorCodeOverloaded is the code of requests that were rejected because the node is under load.
+	ErrorCodeOverloaded ErrorCode = "overloaded"
+	// ErrorCodeQuotaExceeded is the code of requests that were rejected because the quota of the tenant is exhausted.
+	ErrorCodeQuotaExceeded ErrorCode = "quota_exceeded"
+
+	// ErrorCodeNotSynced is the code of requests that require the node to be in sync.
+	ErrorCodeNotSynced ErrorCode = "not_synced"
+	// ErrorCodeNoStrongParents is the code of messages that could not be issued because no strong parents were found.
+	ErrorCodeNoStrongParents ErrorCode = "no_strong_parents"
+	// ErrorCodeParentTooOld is the code of messages that reference parents which are older than the max parent age.
+	ErrorCodeParentTooOld ErrorCode = "parent_too_old"
+	// ErrorCodeParentIssuedAfterChild is the code of messages that reference parents which were issued after them.
+	ErrorCodeParentIssuedAfterChild ErrorCode = "parent_issued_after_child"
+	// ErrorCodePayloadInvalid is the code of messages whose payload was rejected by a validator of the node.
+	ErrorCodePayloadInvalid ErrorCode = "payload_invalid"
+	// ErrorCodeParentsInvalid is the code of messages that reference invalid parents.
+	ErrorCodeParentsInvalid ErrorCode = "parents_invalid"
+	// ErrorCodeInvalidParents is the code of messages whose parents blocks are malformed.
+	ErrorCodeInvalidParents ErrorCode = "invalid_parents"
+	// ErrorCodeIssuerBlacklisted is the code of messages of blacklisted issuers.
+	ErrorCodeIssuerBlacklisted ErrorCode = "issuer_blacklisted"
+	// ErrorCodeInsufficientMana is the code of messages whose issuer has not enough mana to be scheduled.
+	ErrorCodeInsufficientMana ErrorCode = "insufficient_mana"
+	// ErrorCodeInvalidMessage is the code of messages that failed the syntactical checks.
+	ErrorCodeInvalidMessage ErrorCode = "invalid_message"
+	// ErrorCodeIssuanceStopped is the code of messages that could not be issued because the message layer is shutting
+	// down.
+	ErrorCodeIssuanceStopped ErrorCode = "issuance_stopped"
+	// ErrorCodeIssuanceTimeout is the code of messages that were not issued or booked in time.
+	ErrorCodeIssuanceTimeout ErrorCode = "issuance_timeout"
+
+	// ErrorCodeTransactionInvalid is the code of transactions that are invalid.
+	ErrorCodeTransactionInvalid ErrorCode = "transaction_invalid"
+	// ErrorCodeTransactionNotSolid is the code of transactions whose inputs are unknown.
+	ErrorCodeTransactionNotSolid ErrorCode = "transaction_not_solid"
+	// ErrorCodeTransactionParked is the code of transactions that are waiting for their inputs.
+	ErrorCodeTransactionParked ErrorCode = "transaction_parked"
+
+	// ErrorCodeInvalidManaType is the code of requests for an unknown type of mana.
+	ErrorCodeInvalidManaType ErrorCode = "invalid_mana_type"
+	// ErrorCodeManaNodeNotFound is the code of requests for the mana of a node that has none.
+	ErrorCodeManaNodeNotFound ErrorCode = "mana_node_not_found"
+	// ErrorCodeManaPledgeNotAllowed is the code of transactions that pledge mana to a node that is not accepted.
+	ErrorCodeManaPledgeNotAllowed ErrorCode = "mana_pledge_not_allowed"
+
+	// ErrorCodePluginRunning is the code of requests to start a plugin that is running already.
+	ErrorCodePluginRunning ErrorCode = "plugin_running"
+	// ErrorCodePluginStopped is the code of requests to a plugin that is not running.
+	ErrorCodePluginStopped ErrorCode = "plugin_stopped"
+	// ErrorCodePluginShutdown is the code of requests to a plugin that was shut down.
+	ErrorCodePluginShutdown ErrorCode = "plugin_shutdown"
+)
+
+// Subsystem identifies the part of the node that caused an error.
+type Subsystem string
+
+const (
+	// SubsystemWebAPI is the subsystem of errors that are caused by the web API itself.
+	SubsystemWebAPI Subsystem = "webapi"
+	// SubsystemTangle is the subsystem of errors that are caused by the tangle.
+	SubsystemTangle Subsystem = "tangle"
+	// SubsystemLedgerState is the subsystem of errors that are caused by the ledger state.
+	SubsystemLedgerState Subsystem = "ledgerstate"
+	// SubsystemMana is the subsystem of errors that are caused by the mana.
+	SubsystemMana Subsystem = "mana"
+	// SubsystemPlugins is the subsystem of errors that are caused by plugins that can be started and stopped at runtime.
+	SubsystemPlugins Subsystem = "plugins"
+)
+
+// RegisteredErrorCode contains the code, the subsystem and the retry-ability of a registered error.
+type RegisteredErrorCode struct {
+	// Code is the machine-readable code of the error.
+	Code ErrorCode
+	// Subsystem is the part of the node that caused the error.
+	Subsystem Subsystem
+	// Retryable defines if a request that failed with the error can succeed when it is retried later.
+	Retryable bool
+}
+
+// registeredError contains a sentinel error and its code.
+type registeredError struct {
+	err error
+	RegisteredErrorCode
+}
+
+var (
+	registeredErrors      []*registeredError
+	registeredErrorsMutex sync.RWMutex
+)
+
+func init() {
+	RegisterErrorCode(tangle.ErrNotSynced, ErrorCodeNotSynced, SubsystemTangle, true)
+	RegisterErrorCode(tangle.ErrNoStrongParents, ErrorCodeNoStrongParents, SubsystemTangle, true)
+	RegisterErrorCode(tangle.ErrParentTooOld, ErrorCodeParentTooOld, SubsystemTangle, true)
+	RegisterErrorCode(tangle.ErrParentIssuedAfterChild, ErrorCodeParentIssuedAfterChild, SubsystemTangle, true)
+	RegisterErrorCode(tangle.ErrParentsInvalid, ErrorCodeParentsInvalid, SubsystemTangle, true)
+	RegisterErrorCode(tangle.ErrPayloadInvalid, ErrorCodePayloadInvalid, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrBlocksNotOrderedByType, ErrorCodeInvalidParents, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrParentsNotLexicographicallyOrdered, ErrorCodeInvalidParents, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrRepeatingBlockTypes, ErrorCodeInvalidParents, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrRepeatingReferencesInBlock, ErrorCodeInvalidParents, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrConflictingReferenceAcrossBlocks, ErrorCodeInvalidParents, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrIssuerBlacklisted, ErrorCodeIssuerBlacklisted, SubsystemTangle, false)
+	RegisterErrorCode(schedulerutils.ErrInsufficientMana, ErrorCodeInsufficientMana, SubsystemTangle, true)
+	RegisterErrorCode(tangle.ErrInvalidPOWDifficultly, ErrorCodeInvalidMessage, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrMessageTooSmall, ErrorCodeInvalidMessage, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrInvalidSignature, ErrorCodeInvalidMessage, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrInvalidMessageAndTransactionTimestamp, ErrorCodeInvalidMessage, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrInvalidIssuer, ErrorCodeInvalidMessage, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrStopped, ErrorCodeIssuanceStopped, SubsystemTangle, false)
+	RegisterErrorCode(tangle.ErrNotRunning, ErrorCodeIssuanceStopped, SubsystemTangle, false)
+
+	RegisterErrorCode(ledgerstate.ErrTransactionInvalid, ErrorCodeTransactionInvalid, SubsystemLedgerState, false)
+	RegisterErrorCode(ledgerstate.ErrTransactionNotSolid, ErrorCodeTransactionNotSolid, SubsystemLedgerState, true)
+	RegisterErrorCode(ledgerstate.ErrTransactionParked, ErrorCodeTransactionParked, SubsystemLedgerState, true)
+
+	RegisterErrorCode(mana.ErrUnknownManaType, ErrorCodeInvalidManaType, SubsystemMana, false)
+	RegisterErrorCode(mana.ErrInvalidTargetManaType, ErrorCodeInvalidManaType, SubsystemMana, false)
+	RegisterErrorCode(mana.ErrNodeNotFoundInBaseManaVector, ErrorCodeManaNodeNotFound, SubsystemMana, false)
+
+	RegisterErrorCode(runtimeplugin.ErrPluginRunning, ErrorCodePluginRunning, SubsystemPlugins, false)
+	RegisterErrorCode(runtimeplugin.ErrPluginStopped, ErrorCodePluginStopped, SubsystemPlugins, false)
+	RegisterErrorCode(runtimeplugin.ErrPluginShutdown, ErrorCodePluginShutdown, SubsystemPlugins, false)
+}
+
+// RegisterErrorCode registers the code of the given sentinel error, which is returned in the ErrorResponse of every
+// error that wraps it. Errors that are registered first take precedence if an error wraps several registered errors.
+func RegisterErrorCode(err error, code ErrorCode, subsystem Subsystem, retryable bool) {
+	registeredErrorsMutex.Lock()
+	defer registeredErrorsMutex.Unlock()
+
+	registeredErrors = append(registeredErrors, &registeredError{
+		err: err,
+		RegisteredErrorCode: RegisteredErrorCode{
+			Code:      code,
+			Subsystem: subsystem,
+			Retryable: retryable,
+		},
+	})
+}
+
+// ErrorCodeOf returns the code of the first registered error that the given error wraps. Errors that don't wrap a
+// registered error have the ErrorCodeUnknown.
+func ErrorCodeOf(err error) RegisteredErrorCode {
+	registeredErrorsMutex.RLock()
+	defer registeredErrorsMutex.RUnlock()
+
+	for _, registeredErr := range registeredErrors {
+		if errors.Is(err, registeredErr.err) {
+			return registeredErr.RegisteredErrorCode
+		}
+	}
+
+	return RegisteredErrorCode{Code: ErrorCodeUnknown}
+}
+
+// ErrorCodeFromStatus returns the generic ErrorCode of the given HTTP status code. It is used for errors that don't wrap
+// a registered error.
+func ErrorCodeFromStatus(statusCode int) ErrorCode {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return ErrorCodeBadRequest
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound, http.StatusMethodNotAllowed:
+		return ErrorCodeNotFound
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return ErrorCodeOverloaded
+	default:
+		return ErrorCodeInternal
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,41 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/fragmentation"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// FragmentedDataResponse is the HTTP response containing the state of the reassembly of fragmented data.
+type FragmentedDataResponse struct {
+	DataID            string   `json:"dataID,omitempty"`
+	DataSize          uint32   `json:"dataSize,omitempty"`
+	FragmentCount     int      `json:"fragmentCount,omitempty"`
+	ReceivedFragments int      `json:"receivedFragments,omitempty"`
+	MessageIDs        []string `json:"messageIDs,omitempty"`
+	FirstSeen         int64    `json:"firstSeen,omitempty"`
+	Complete          bool     `json:"complete,omitempty"`
+	Data              []byte   `json:"data,omitempty"`
+	Error             string   `json:"error,omitempty"`
+}
+
+// NewFragmentedDataResponse returns the FragmentedDataResponse of the given fragmentation.Reassembly. Fragments that
+// were not received, yet, have an empty message ID.
+func NewFragmentedDataResponse(reassembly *fragmentation.Reassembly) *FragmentedDataResponse {
+	messageIDs := make([]string, len(reassembly.MessageIDs))
+	for i, messageID := range reassembly.MessageIDs {
+		if messageID != tangle.EmptyMessageID {
+			messageIDs[i] = messageID.Base58()
+		}
+	}
+
+	return &FragmentedDataResponse{
+		DataID:            reassembly.DataID.Base58(),
+		DataSize:          reassembly.DataSize,
+		FragmentCount:     reassembly.FragmentCount,
+		ReceivedFragments: reassembly.ReceivedFragments,
+		MessageIDs:        messageIDs,
+		FirstSeen:         reassembly.FirstSeen.Unix(),
+		Complete:          reassembly.Complete(),
+		Data:              reassembly.Data,
+	}
+}
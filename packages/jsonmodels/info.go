@@ -2,6 +2,8 @@ package jsonmodels
 
 import (
 	"time"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/finality"
 )
 
 // InfoResponse holds the response of the GET request.
@@ -36,6 +38,10 @@ type InfoResponse struct {
 	ManaDecay float64 `json:"mana_decay"`
 	// Scheduler is the scheduler.
 	Scheduler Scheduler `json:"scheduler"`
+	// GradeOfFinality contains the approval weight thresholds of the grades of finality.
+	GradeOfFinality GradeOfFinality `json:"gradeOfFinality"`
+	// Archive contains the archive mode of the node.
+	Archive Archive `json:"archive"`
 	// error of the response
 	Error string `json:"error,omitempty"`
 }
@@ -58,12 +64,42 @@ type Mana struct {
 // Scheduler is the scheduler details.
 type Scheduler struct {
 	Running           bool           `json:"running"`
+	Paused            bool           `json:"paused"`
 	Rate              string         `json:"rate"`
 	MaxBufferSize     int            `json:"maxBufferSize"`
 	CurrentBufferSize int            `json:"currentBufferSizer"`
 	NodeQueueSizes    map[string]int `json:"nodeQueueSizes"`
 }
 
+// Archive contains the archive mode of the node and the history indexes that can be queried.
+type Archive struct {
+	Enabled bool     `json:"enabled"`
+	Indexes []string `json:"indexes,omitempty"`
+}
+
+// GradeOfFinality contains the approval weight thresholds of the grades of finality of messages and branches.
+type GradeOfFinality struct {
+	MessageThresholds GoFThresholds `json:"messageThresholds"`
+	BranchThresholds  GoFThresholds `json:"branchThresholds"`
+}
+
+// NewGradeOfFinality returns the GradeOfFinality of the given finality.GoFTranslation.
+func NewGradeOfFinality(goFTranslation finality.GoFTranslation) GradeOfFinality {
+	messageThresholds, branchThresholds := goFTranslation.Thresholds()
+
+	return GradeOfFinality{
+		MessageThresholds: GoFThresholds(messageThresholds),
+		BranchThresholds:  GoFThresholds(branchThresholds),
+	}
+}
+
+// GoFThresholds contains the approval weight that is at least needed to reach each grade of finality.
+type GoFThresholds struct {
+	Low    float64 `json:"low"`
+	Medium float64 `json:"medium"`
+	High   float64 `json:"high"`
+}
+
 // RateSetter is the rate setter details.
 type RateSetter struct {
 	Rate float64 `json:"rate"`
@@ -487,6 +487,8 @@ type OutputMetadata struct {
 	ConfirmedConsumer   string              `json:"confirmedConsumer,omitempty"`
 	GradeOfFinality     gof.GradeOfFinality `json:"gradeOfFinality"`
 	GradeOfFinalityTime int64               `json:"gradeOfFinalityTime"`
+	Consumers           []*OutputConsumer   `json:"consumers,omitempty"`
+	Reservation         *OutputReservation  `json:"reservation,omitempty"`
 }
 
 // NewOutputMetadata returns the OutputMetadata from the given ledgerstate.OutputMetadata.
@@ -505,6 +507,95 @@ func NewOutputMetadata(outputMetadata *ledgerstate.OutputMetadata, confirmedCons
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region OutputReservation ////////////////////////////////////////////////////////////////////////////////////////////
+
+// OutputReservation represents the JSON model of an advisory reservation of an output by a wallet instance.
+type OutputReservation struct {
+	OutputID      string `json:"outputID"`
+	Owner         string `json:"owner"`
+	ReservedUntil int64  `json:"reservedUntil"`
+}
+
+// NewOutputReservation returns the OutputReservation of the given output.
+func NewOutputReservation(outputID ledgerstate.OutputID, owner string, reservedUntil time.Time) *OutputReservation {
+	return &OutputReservation{
+		OutputID:      outputID.Base58(),
+		Owner:         owner,
+		ReservedUntil: reservedUntil.Unix(),
+	}
+}
+
+// ReserveOutputRequest is the request of a reservation of an output.
+type ReserveOutputRequest struct {
+	// Owner identifies the wallet instance that reserves the output.
+	Owner string `json:"owner"`
+	// TTL is the number of seconds for which the output is reserved.
+	TTL int64 `json:"ttl"`
+}
+
+// ReleaseOutputReservationResponse is the response of a release of the reservation of an output.
+type ReleaseOutputReservationResponse struct {
+	Released bool   `json:"released"`
+	Error    string `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostOutputsBatch /////////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOutputsBatchRequest is the request of the outputs with the given IDs.
+type PostOutputsBatchRequest struct {
+	OutputIDs []string `json:"outputIDs"`
+}
+
+// PostOutputsBatchResponse is the response of a PostOutputsBatchRequest.
+type PostOutputsBatchResponse struct {
+	Outputs []*BatchOutput `json:"outputs,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// BatchOutput represents an output of a PostOutputsBatchResponse together with its metadata and the inclusion state of
+// its branches.
+type BatchOutput struct {
+	OutputID       string          `json:"outputID"`
+	Output         *Output         `json:"output,omitempty"`
+	Metadata       *OutputMetadata `json:"metadata,omitempty"`
+	InclusionState string          `json:"inclusionState,omitempty"`
+	Error          string          `json:"error,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OutputConsumer ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// OutputConsumer represents the JSON model of a ledgerstate.Consumer together with the state of the consuming
+// transaction. Several valid consumers of the same output indicate a spend race that is resolved by the branch states.
+type OutputConsumer struct {
+	TransactionID      string              `json:"transactionID"`
+	Valid              string              `json:"valid"`
+	BranchIDs          []string            `json:"branchIDs"`
+	InclusionState     string              `json:"inclusionState"`
+	GradeOfFinality    gof.GradeOfFinality `json:"gradeOfFinality"`
+	Timestamp          int64               `json:"timestamp"`
+	SolidificationTime int64               `json:"solidificationTime"`
+}
+
+// NewOutputConsumer returns an OutputConsumer from the given ledgerstate.Consumer, the consuming transaction and its
+// metadata and the InclusionState of its branches.
+func NewOutputConsumer(consumer *ledgerstate.Consumer, transaction *ledgerstate.Transaction, transactionMetadata *ledgerstate.TransactionMetadata, inclusionState ledgerstate.InclusionState) *OutputConsumer {
+	return &OutputConsumer{
+		TransactionID:      consumer.TransactionID().Base58(),
+		Valid:              consumer.Valid().String(),
+		BranchIDs:          transactionMetadata.BranchIDs().Base58(),
+		InclusionState:     inclusionState.String(),
+		GradeOfFinality:    transactionMetadata.GradeOfFinality(),
+		Timestamp:          transaction.Essence().Timestamp().Unix(),
+		SolidificationTime: transactionMetadata.SolidificationTime().Unix(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region Consumer /////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Consumer represents the JSON model of a ledgerstate.Consumer.
@@ -1,6 +1,10 @@
 package jsonmodels
 
-import "github.com/iotaledger/goshimmer/packages/mana"
+import (
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/mana"
+)
 
 // GetManaRequest is the request for get mana.
 type GetManaRequest struct {
@@ -101,6 +105,53 @@ type PendingResponse struct {
 	Timestamp int64   `json:"timestamp"`
 }
 
+// AccessManaProjectionRequest is the request of mana/access/projection.
+type AccessManaProjectionRequest struct {
+	MessageSize int   `json:"messageSize"`
+	Interval    int64 `json:"interval"`
+}
+
+// AccessManaProjectionResponse is the response of mana/access/projection.
+type AccessManaProjectionResponse struct {
+	ShortNodeID      string                       `json:"shortNodeID"`
+	NodeID           string                       `json:"nodeID"`
+	MessageSize      int                          `json:"messageSize"`
+	Interval         int64                        `json:"interval"`
+	IssuableMessages int                          `json:"issuableMessages"`
+	Trajectory       []*AccessManaProjectionPoint `json:"trajectory"`
+	Error            string                       `json:"error,omitempty"`
+}
+
+// NewAccessManaProjectionResponse returns the AccessManaProjectionResponse of the given projection.
+func NewAccessManaProjectionResponse(projection *mana.AccessManaProjection, messageSize int, interval int64, issuableMessages int) *AccessManaProjectionResponse {
+	response := &AccessManaProjectionResponse{
+		ShortNodeID:      projection.NodeID.String(),
+		NodeID:           base58.Encode(projection.NodeID.Bytes()),
+		MessageSize:      messageSize,
+		Interval:         interval,
+		IssuableMessages: issuableMessages,
+		Trajectory:       make([]*AccessManaProjectionPoint, len(projection.Points)),
+	}
+	for i, point := range projection.Points {
+		response.Trajectory[i] = &AccessManaProjectionPoint{
+			Time:      point.Time.Unix(),
+			Mana:      point.Mana,
+			TotalMana: point.TotalMana,
+			Share:     point.Share(),
+		}
+	}
+
+	return response
+}
+
+// AccessManaProjectionPoint represents the JSON model of a mana.AccessManaProjectionPoint.
+type AccessManaProjectionPoint struct {
+	Time      int64   `json:"time"`
+	Mana      float64 `json:"mana"`
+	TotalMana float64 `json:"totalMana"`
+	Share     float64 `json:"share"`
+}
+
 // GetPercentileRequest is the request object of mana/percentile.
 type GetPercentileRequest struct {
 	NodeID string `json:"nodeID"`
@@ -129,3 +180,54 @@ type AllowedPledge struct {
 	IsFilterEnabled bool     `json:"isFilterEnabled"`
 	Allowed         []string `json:"allowed,omitempty"`
 }
+
+// ManaAuditRecord represents the JSON model of a mana.AuditRecord.
+type ManaAuditRecord struct {
+	Index         uint64  `json:"index"`
+	Type          string  `json:"type"`
+	ShortNodeID   string  `json:"shortNodeID"`
+	NodeID        string  `json:"nodeID"`
+	ManaType      string  `json:"manaType"`
+	Amount        float64 `json:"amount"`
+	Time          int64   `json:"time"`
+	TransactionID string  `json:"transactionID"`
+	InputID       string  `json:"inputID,omitempty"`
+}
+
+// NewManaAuditRecord returns the JSON model of the given mana.AuditRecord.
+func NewManaAuditRecord(record *mana.AuditRecord) *ManaAuditRecord {
+	jsonRecord := &ManaAuditRecord{
+		Index:         record.Index,
+		Type:          "pledge",
+		ShortNodeID:   record.NodeID.String(),
+		NodeID:        base58.Encode(record.NodeID.Bytes()),
+		ManaType:      record.ManaType.String(),
+		Amount:        record.Amount,
+		Time:          record.Time.Unix(),
+		TransactionID: record.TransactionID.Base58(),
+	}
+	if record.Type == mana.EventTypeRevoke {
+		jsonRecord.Type = "revoke"
+		jsonRecord.InputID = record.InputID.Base58()
+	}
+
+	return jsonRecord
+}
+
+// ManaAuditResponse is the HTTP response of a mana audit query.
+type ManaAuditResponse struct {
+	Records []*ManaAuditRecord `json:"records"`
+	Error   string             `json:"error,omitempty"`
+}
+
+// NewManaAuditResponse returns the ManaAuditResponse that contains the given mana.AuditRecords.
+func NewManaAuditResponse(records []*mana.AuditRecord) *ManaAuditResponse {
+	response := &ManaAuditResponse{
+		Records: make([]*ManaAuditRecord, len(records)),
+	}
+	for i, record := range records {
+		response.Records[i] = NewManaAuditRecord(record)
+	}
+
+	return response
+}
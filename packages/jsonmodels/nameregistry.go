@@ -0,0 +1,31 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/nameregistry"
+)
+
+// NameRecord represents the JSON model of a name that is registered on the Tangle.
+type NameRecord struct {
+	Name             string `json:"name"`
+	Target           string `json:"target"`
+	TargetType       string `json:"targetType"`
+	Sequence         uint64 `json:"sequence"`
+	Owner            string `json:"owner"`
+	MessageID        string `json:"messageID"`
+	RegistrationTime int64  `json:"registrationTime"`
+	UpdateTime       int64  `json:"updateTime"`
+}
+
+// NewNameRecord returns the JSON model of the given nameregistry.Record.
+func NewNameRecord(record *nameregistry.Record) *NameRecord {
+	return &NameRecord{
+		Name:             record.Name(),
+		Target:           record.Target().Base58(),
+		TargetType:       record.Target().Type().String(),
+		Sequence:         record.Sequence(),
+		Owner:            record.Owner().String(),
+		MessageID:        record.MessageID().Base58(),
+		RegistrationTime: record.RegistrationTime().Unix(),
+		UpdateTime:       record.UpdateTime().Unix(),
+	}
+}
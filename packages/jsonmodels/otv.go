@@ -0,0 +1,227 @@
+package jsonmodels
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/consensus/statementlog"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region OTVDecision //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// OTVDecision represents the JSON model of an otv.Decision.
+type OTVDecision struct {
+	Index            uint64             `json:"index"`
+	BranchID         string             `json:"branchID"`
+	Time             int64              `json:"time"`
+	OldPreference    bool               `json:"oldPreference"`
+	NewPreference    bool               `json:"newPreference"`
+	Weights          map[string]float64 `json:"weights"`
+	TriggeringMarker *OTVMarker         `json:"triggeringMarker,omitempty"`
+}
+
+// NewOTVDecision returns the JSON model of the given otv.Decision.
+func NewOTVDecision(decision *otv.Decision) *OTVDecision {
+	jsonDecision := &OTVDecision{
+		Index:         decision.Index,
+		BranchID:      decision.BranchID.Base58(),
+		Time:          decision.Time.Unix(),
+		OldPreference: decision.OldPreference,
+		NewPreference: decision.NewPreference,
+		Weights:       make(map[string]float64, len(decision.Weights)),
+	}
+	for branchID, weight := range decision.Weights {
+		jsonDecision.Weights[branchID.Base58()] = weight
+	}
+	if decision.TriggeringMarker != nil {
+		jsonDecision.TriggeringMarker = &OTVMarker{
+			SequenceID: uint64(decision.TriggeringMarker.SequenceID()),
+			Index:      uint64(decision.TriggeringMarker.Index()),
+		}
+	}
+
+	return jsonDecision
+}
+
+// OTVMarker represents the JSON model of the marker that triggered an otv.Decision.
+type OTVMarker struct {
+	SequenceID uint64 `json:"sequenceID"`
+	Index      uint64 `json:"index"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OTVDecisionsResponse /////////////////////////////////////////////////////////////////////////////////////////
+
+// OTVDecisionsResponse is the HTTP response containing the recorded preference flips of the OTV consensus.
+type OTVDecisionsResponse struct {
+	Decisions []*OTVDecision `json:"decisions"`
+	Error     string         `json:"error,omitempty"`
+}
+
+// NewOTVDecisionsResponse returns the OTVDecisionsResponse of the given otv.Decisions.
+func NewOTVDecisionsResponse(decisions []*otv.Decision) *OTVDecisionsResponse {
+	response := &OTVDecisionsResponse{
+		Decisions: make([]*OTVDecision, 0, len(decisions)),
+	}
+	for _, decision := range decisions {
+		response.Decisions = append(response.Decisions, NewOTVDecision(decision))
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OTVStatisticsResponse ////////////////////////////////////////////////////////////////////////////////////////
+
+// OTVStatisticsResponse is the HTTP response containing the statistics about the preference flips of the OTV consensus.
+type OTVStatisticsResponse struct {
+	TotalFlips      uint64            `json:"totalFlips"`
+	FlipsLastMinute int64             `json:"flipsLastMinute"`
+	FlipsPerBranch  map[string]uint64 `json:"flipsPerBranch"`
+	Error           string            `json:"error,omitempty"`
+}
+
+// NewOTVStatisticsResponse returns the OTVStatisticsResponse of the given otv.DecisionStatistics.
+func NewOTVStatisticsResponse(statistics *otv.DecisionStatistics) *OTVStatisticsResponse {
+	response := &OTVStatisticsResponse{
+		TotalFlips:      statistics.TotalFlips,
+		FlipsLastMinute: statistics.FlipsLastMinute,
+		FlipsPerBranch:  make(map[string]uint64, len(statistics.FlipsPerBranch)),
+	}
+	for branchID, flips := range statistics.FlipsPerBranch {
+		response.FlipsPerBranch[branchID.Base58()] = flips
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OTVStatement /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// OTVStatement represents the JSON model of a statementlog.Statement. The times are given in nanoseconds, so that the
+// hash of the statement can be recomputed from its JSON model.
+type OTVStatement struct {
+	Index          uint64   `json:"index"`
+	MessageID      string   `json:"messageID"`
+	IssuingTime    int64    `json:"issuingTime"`
+	SequenceNumber uint64   `json:"sequenceNumber"`
+	BranchIDs      []string `json:"branchIDs"`
+	RecordedTime   int64    `json:"recordedTime"`
+	PreviousHash   string   `json:"previousHash"`
+	Hash           string   `json:"hash"`
+}
+
+// NewOTVStatement returns the JSON model of the given statementlog.Statement.
+func NewOTVStatement(statement *statementlog.Statement) *OTVStatement {
+	hash := statement.Hash()
+
+	return &OTVStatement{
+		Index:          statement.Index,
+		MessageID:      statement.MessageID.Base58(),
+		IssuingTime:    statement.IssuingTime.UnixNano(),
+		SequenceNumber: statement.SequenceNumber,
+		BranchIDs:      statement.BranchIDs.Base58(),
+		RecordedTime:   statement.RecordedTime.UnixNano(),
+		PreviousHash:   base58.Encode(statement.PreviousHash[:]),
+		Hash:           base58.Encode(hash[:]),
+	}
+}
+
+// Statement returns the statementlog.Statement that is represented by the JSON model.
+func (o *OTVStatement) Statement() (statement *statementlog.Statement, err error) {
+	statement = &statementlog.Statement{
+		Index:          o.Index,
+		IssuingTime:    time.Unix(0, o.IssuingTime),
+		SequenceNumber: o.SequenceNumber,
+		BranchIDs:      ledgerstate.NewBranchIDs(),
+		RecordedTime:   time.Unix(0, o.RecordedTime),
+	}
+	if statement.MessageID, err = tangle.NewMessageID(o.MessageID); err != nil {
+		return nil, errors.Errorf("failed to parse message id of statement %d: %w", o.Index, err)
+	}
+	for _, branchIDString := range o.BranchIDs {
+		branchID, branchIDErr := ledgerstate.BranchIDFromBase58(branchIDString)
+		if branchIDErr != nil {
+			return nil, errors.Errorf("failed to parse branch id of statement %d: %w", o.Index, branchIDErr)
+		}
+		statement.BranchIDs.Add(branchID)
+	}
+
+	previousHashBytes, err := base58.Decode(o.PreviousHash)
+	if err != nil || len(previousHashBytes) != statementlog.HashLength {
+		return nil, errors.Errorf("failed to parse previous hash of statement %d", o.Index)
+	}
+	copy(statement.PreviousHash[:], previousHashBytes)
+
+	return statement, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region OTVStatementsResponse ////////////////////////////////////////////////////////////////////////////////////////
+
+// OTVStatementsResponse is the HTTP response containing a signed export of the statements of the node.
+type OTVStatementsResponse struct {
+	IssuerPublicKey string          `json:"issuerPublicKey"`
+	Statements      []*OTVStatement `json:"statements"`
+	Signature       string          `json:"signature,omitempty"`
+	TotalStatements uint64          `json:"totalStatements"`
+	Error           string          `json:"error,omitempty"`
+}
+
+// NewOTVStatementsResponse returns the OTVStatementsResponse of the given statementlog.Export.
+func NewOTVStatementsResponse(export *statementlog.Export, totalStatements uint64) *OTVStatementsResponse {
+	response := &OTVStatementsResponse{
+		IssuerPublicKey: export.IssuerPublicKey.String(),
+		Statements:      make([]*OTVStatement, 0, len(export.Statements)),
+		TotalStatements: totalStatements,
+	}
+	for _, statement := range export.Statements {
+		response.Statements = append(response.Statements, NewOTVStatement(statement))
+	}
+	if len(export.Statements) != 0 {
+		response.Signature = export.Signature.String()
+	}
+
+	return response
+}
+
+// Export returns the statementlog.Export that is represented by the response, which can be used to verify it.
+func (o *OTVStatementsResponse) Export() (export *statementlog.Export, err error) {
+	export = &statementlog.Export{
+		Statements: make([]*statementlog.Statement, 0, len(o.Statements)),
+	}
+	if export.IssuerPublicKey, err = ed25519.PublicKeyFromString(o.IssuerPublicKey); err != nil {
+		return nil, errors.Errorf("failed to parse issuer public key: %w", err)
+	}
+	for _, jsonStatement := range o.Statements {
+		statement, statementErr := jsonStatement.Statement()
+		if statementErr != nil {
+			return nil, statementErr
+		}
+		export.Statements = append(export.Statements, statement)
+	}
+
+	if o.Signature != "" {
+		signatureBytes, decodeErr := base58.Decode(o.Signature)
+		if decodeErr != nil {
+			return nil, errors.Errorf("failed to decode signature: %w", decodeErr)
+		}
+		if export.Signature, _, err = ed25519.SignatureFromBytes(signatureBytes); err != nil {
+			return nil, errors.Errorf("failed to parse signature: %w", err)
+		}
+	}
+
+	return export, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,56 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/profiling"
+)
+
+// region ProfileCapture ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// ProfileCapture represents the JSON model of a profiling.Capture.
+type ProfileCapture struct {
+	ID       string   `json:"id"`
+	Trigger  string   `json:"trigger"`
+	Reason   string   `json:"reason"`
+	Time     int64    `json:"time"`
+	Profiles []string `json:"profiles"`
+	Errors   []string `json:"errors,omitempty"`
+}
+
+// NewProfileCapture returns the JSON model of the given profiling.Capture.
+func NewProfileCapture(capture *profiling.Capture) *ProfileCapture {
+	profiles := make([]string, 0, len(capture.Profiles))
+	for _, kind := range []profiling.Kind{profiling.KindCPU, profiling.KindHeap, profiling.KindGoroutine} {
+		if _, exists := capture.Profiles[kind]; exists {
+			profiles = append(profiles, string(kind))
+		}
+	}
+
+	return &ProfileCapture{
+		ID:       capture.ID,
+		Trigger:  capture.Trigger,
+		Reason:   capture.Reason,
+		Time:     capture.Time.Unix(),
+		Profiles: profiles,
+		Errors:   capture.Errors,
+	}
+}
+
+// ProfileCapturesResponse is the HTTP response containing the stored captures of profiles.
+type ProfileCapturesResponse struct {
+	Captures []*ProfileCapture `json:"captures"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// NewProfileCapturesResponse returns the ProfileCapturesResponse of the given profiling.Captures.
+func NewProfileCapturesResponse(captures []*profiling.Capture) *ProfileCapturesResponse {
+	response := &ProfileCapturesResponse{
+		Captures: make([]*ProfileCapture, 0, len(captures)),
+	}
+	for _, capture := range captures {
+		response.Captures = append(response.Captures, NewProfileCapture(capture))
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,131 @@
+package jsonmodels
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// ResponsePublicKeyHeader is the HTTP header that carries the base58 encoded public key of the node that signed a
+	// response.
+	ResponsePublicKeyHeader = "X-Response-Public-Key"
+
+	// ResponseTimestampHeader is the HTTP header that carries the unix timestamp at which a response was signed.
+	ResponseTimestampHeader = "X-Response-Timestamp"
+
+	// ResponseSignatureHeader is the HTTP header that carries the base58 encoded signature of a response.
+	ResponseSignatureHeader = "X-Response-Signature"
+
+	// signedResponseDomain is the tag that precedes every signed response, so that the signature can not be mistaken
+	// for a signature of the node in another context.
+	signedResponseDomain = "GoShimmer Signed Response v1\n"
+)
+
+var (
+	// ErrResponseNotSigned is returned if a response does not carry a signature.
+	ErrResponseNotSigned = errors.New("response is not signed")
+
+	// ErrInvalidResponseSignature is returned if the signature of a response is invalid or was not created by the
+	// expected node.
+	ErrInvalidResponseSignature = errors.New("invalid response signature")
+)
+
+// region SignedResponse ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// SignedResponse is a response of the web API that was signed by the identity of the node. It can be kept by a light
+// client as a proof of the answer of the node to its request.
+type SignedResponse struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// RequestURI is the path and the query of the request.
+	RequestURI string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Timestamp is the unix timestamp at which the response was signed.
+	Timestamp int64
+	// Body is the body of the response.
+	Body []byte
+	// PublicKey is the public key of the node that signed the response.
+	PublicKey ed25519.PublicKey
+	// Signature is the signature of the response.
+	Signature ed25519.Signature
+}
+
+// NewSignedResponse signs the response to the given request with the given identity.
+func NewSignedResponse(method, requestURI string, statusCode int, timestamp int64, body []byte, publicKey ed25519.PublicKey, sign func(message []byte) ed25519.Signature) (signedResponse *SignedResponse) {
+	signedResponse = &SignedResponse{
+		Method:     method,
+		RequestURI: requestURI,
+		StatusCode: statusCode,
+		Timestamp:  timestamp,
+		Body:       body,
+		PublicKey:  publicKey,
+	}
+	signedResponse.Signature = sign(signedResponse.message())
+
+	return signedResponse
+}
+
+// SignedResponseFromHeader returns the SignedResponse of the given request from the status code, the headers and the
+// body of its response.
+func SignedResponseFromHeader(method, requestURI string, statusCode int, header http.Header, body []byte) (signedResponse *SignedResponse, err error) {
+	if header.Get(ResponseSignatureHeader) == "" {
+		return nil, ErrResponseNotSigned
+	}
+
+	signedResponse = &SignedResponse{
+		Method:     method,
+		RequestURI: requestURI,
+		StatusCode: statusCode,
+		Body:       body,
+	}
+	if signedResponse.Timestamp, err = strconv.ParseInt(header.Get(ResponseTimestampHeader), 10, 64); err != nil {
+		return nil, errors.Errorf("failed to parse response timestamp: %w", err)
+	}
+	if signedResponse.PublicKey, err = ed25519.PublicKeyFromString(header.Get(ResponsePublicKeyHeader)); err != nil {
+		return nil, errors.Errorf("failed to parse response public key: %w", err)
+	}
+	signatureBytes, err := base58.Decode(header.Get(ResponseSignatureHeader))
+	if err != nil {
+		return nil, errors.Errorf("failed to decode response signature: %w", err)
+	}
+	if signedResponse.Signature, _, err = ed25519.SignatureFromBytes(signatureBytes); err != nil {
+		return nil, errors.Errorf("failed to parse response signature: %w", err)
+	}
+
+	return signedResponse, nil
+}
+
+// Verify checks that the response was signed by the node with the given public key.
+func (s *SignedResponse) Verify(publicKey ed25519.PublicKey) error {
+	if s.PublicKey != publicKey {
+		return errors.Errorf("response was signed by %s instead of %s: %w", s.PublicKey, publicKey, ErrInvalidResponseSignature)
+	}
+	if !s.PublicKey.VerifySignature(s.message(), s.Signature) {
+		return errors.Errorf("signature of %s %s does not match: %w", s.Method, s.RequestURI, ErrInvalidResponseSignature)
+	}
+
+	return nil
+}
+
+// Header returns the HTTP headers that carry the signature of the response.
+func (s *SignedResponse) Header() http.Header {
+	return http.Header{
+		ResponsePublicKeyHeader: []string{s.PublicKey.String()},
+		ResponseTimestampHeader: []string{strconv.FormatInt(s.Timestamp, 10)},
+		ResponseSignatureHeader: []string{s.Signature.String()},
+	}
+}
+
+// message returns the signed message, which binds the status code and the body of the response to its request and its
+// timestamp. It starts with a fixed domain tag, so that it can not be confused with other messages signed by the node.
+func (s *SignedResponse) message() []byte {
+	return byteutils.ConcatBytes([]byte(signedResponseDomain+s.Method+" "+s.RequestURI+"\n"+strconv.Itoa(s.StatusCode)+"\n"+strconv.FormatInt(s.Timestamp, 10)+"\n"), s.Body)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
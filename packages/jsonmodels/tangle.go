@@ -1,7 +1,10 @@
 package jsonmodels
 
 import (
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
 // region Message ///////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -48,6 +51,83 @@ type MessageMetadata struct {
 	SubjectivelyInvalid bool                `json:"subjectivelyInvalid"`
 	GradeOfFinality     gof.GradeOfFinality `json:"gradeOfFinality"`
 	GradeOfFinalityTime int64               `json:"gradeOfFinalityTime"`
+	Orphaned            bool                `json:"orphaned"`
+	OrphanedTime        int64               `json:"orphanedTime"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MessageApprovedByConfirmed ///////////////////////////////////////////////////////////////////////////////////
+
+// MessageApprovedByConfirmed represents the JSON model of the result of tangle.Utils.ApprovedByConfirmed.
+type MessageApprovedByConfirmed struct {
+	ID                  string `json:"id"`
+	ApprovedByConfirmed bool   `json:"approvedByConfirmed"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region StreamedMessage //////////////////////////////////////////////////////////////////////////////////////////////
+
+// StreamedMessage represents the JSON model of a message that is pushed to the subscribers of the message stream once
+// it reached their minimum grade of finality.
+type StreamedMessage struct {
+	ID              string              `json:"id"`
+	IssuerPublicKey string              `json:"issuerPublicKey"`
+	IssuingTime     int64               `json:"issuingTime"`
+	SequenceNumber  uint64              `json:"sequenceNumber"`
+	PayloadType     uint32              `json:"payloadType"`
+	Payload         []byte              `json:"payload"`
+	GradeOfFinality gof.GradeOfFinality `json:"gradeOfFinality"`
+}
+
+// NewStreamedMessage returns the StreamedMessage of the given tangle.Message, which reached the given grade of
+// finality.
+func NewStreamedMessage(message *tangle.Message, gradeOfFinality gof.GradeOfFinality) *StreamedMessage {
+	return &StreamedMessage{
+		ID:              message.ID().Base58(),
+		IssuerPublicKey: message.IssuerPublicKey().String(),
+		IssuingTime:     message.IssuingTime().Unix(),
+		SequenceNumber:  message.SequenceNumber(),
+		PayloadType:     uint32(message.Payload().Type()),
+		Payload:         message.Payload().Bytes(),
+		GradeOfFinality: gradeOfFinality,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IssuerMessagesResponse ///////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerMessagesResponse is the HTTP response containing the messages of an issuer within a time range.
+type IssuerMessagesResponse struct {
+	IssuerPublicKey string           `json:"issuerPublicKey,omitempty"`
+	Messages        []*IssuerMessage `json:"messages,omitempty"`
+	Truncated       bool             `json:"truncated,omitempty"`
+	Error           string           `json:"error,omitempty"`
+}
+
+// NewIssuerMessagesResponse returns the IssuerMessagesResponse of the given entries of the tangle.IssuerIndex.
+func NewIssuerMessagesResponse(issuerPublicKey ed25519.PublicKey, entries []*tangle.IssuerIndexEntry, truncated bool) *IssuerMessagesResponse {
+	response := &IssuerMessagesResponse{
+		IssuerPublicKey: issuerPublicKey.String(),
+		Messages:        make([]*IssuerMessage, len(entries)),
+		Truncated:       truncated,
+	}
+	for i, entry := range entries {
+		response.Messages[i] = &IssuerMessage{
+			ID:          entry.MessageID.Base58(),
+			IssuingTime: entry.IssuingTime.Unix(),
+		}
+	}
+
+	return response
+}
+
+// IssuerMessage represents the JSON model of a message in the history of an issuer.
+type IssuerMessage struct {
+	ID          string `json:"id"`
+	IssuingTime int64  `json:"issuingTime"`
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,50 @@
+package jsonmodels
+
+// region APIKeyHeader /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// APIKeyHeader is the HTTP header that carries the API key of the tenant that a request is scoped to.
+const APIKeyHeader = "X-API-Key"
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TenantResponse ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// TenantResponse is the JSON model of a response from the GetTenant endpoint. It describes the tenant that the API key
+// of the request belongs to.
+type TenantResponse struct {
+	Name string `json:"name"`
+	// IssuanceQuota is the number of messages the tenant may issue per quota period (0 if unlimited).
+	IssuanceQuota int `json:"issuanceQuota"`
+	// IssuedMessages is the number of messages the tenant issued in the current quota period.
+	IssuedMessages int `json:"issuedMessages"`
+	// QuotaResetsAt is the time at which the current quota period ends (0 if no period started, yet).
+	QuotaResetsAt int64 `json:"quotaResetsAt"`
+	// IndexedMessages is the number of messages of the tenant that are indexed.
+	IndexedMessages int `json:"indexedMessages"`
+	// WatchedAddresses is the number of addresses that are watched by the tenant.
+	WatchedAddresses int `json:"watchedAddresses"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TenantMessagesResponse ///////////////////////////////////////////////////////////////////////////////////////
+
+// TenantMessagesResponse is the JSON model of a response from the GetTenantMessages endpoint.
+type TenantMessagesResponse struct {
+	// MessageIDs contains the IDs of the messages that were issued by the tenant in the order of their issuance.
+	MessageIDs []string `json:"messageIDs"`
+	// Truncated is true if older messages of the tenant were dropped from the index.
+	Truncated bool `json:"truncated"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TenantAddressesResponse //////////////////////////////////////////////////////////////////////////////////////
+
+// TenantAddressesResponse is the JSON model of a response from the GetTenantAddresses endpoint.
+type TenantAddressesResponse struct {
+	// Addresses contains the base58 encoded addresses that are watched by the tenant.
+	Addresses []string `json:"addresses"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,5 +1,11 @@
 package jsonmodels
 
+import (
+	"sort"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
 // PastconeRequest holds the message id to query.
 type PastconeRequest struct {
 	ID string `json:"id"`
@@ -24,3 +30,53 @@ type MissingAvailableResponse struct {
 	Availability map[string][]string `json:"msgavailability,omitempty"`
 	Count        int                 `json:"count"`
 }
+
+// SolidificationResponse is the HTTP response containing the dependency graph of the solidification, i.e. the missing
+// messages together with the messages that wait for them and the state of their requests.
+type SolidificationResponse struct {
+	MissingMessages []*MissingDependency `json:"missingMessages"`
+	Count           int                  `json:"count"`
+}
+
+// MissingDependency represents the JSON model of a missing message in the dependency graph of the solidification.
+type MissingDependency struct {
+	ID                      string   `json:"id"`
+	MissingSince            int64    `json:"missingSince"`
+	BlockedChildren         []string `json:"blockedChildren"`
+	BlockedDescendants      int      `json:"blockedDescendants"`
+	OldestBlockedDescendant int64    `json:"oldestBlockedDescendant,omitempty"`
+	Truncated               bool     `json:"truncated,omitempty"`
+	Requested               bool     `json:"requested"`
+	RequestStartTime        int64    `json:"requestStartTime,omitempty"`
+	LastRequestTime         int64    `json:"lastRequestTime,omitempty"`
+	RequestCount            int      `json:"requestCount"`
+	RequestingNeighbors     []string `json:"requestingNeighbors"`
+}
+
+// NewMissingDependency returns the MissingDependency of the given missing message.
+func NewMissingDependency(missingDependency *tangle.MissingDependency) *MissingDependency {
+	result := &MissingDependency{
+		ID:                  missingDependency.MessageID.Base58(),
+		MissingSince:        missingDependency.MissingSince.Unix(),
+		BlockedChildren:     append(make([]string, 0, len(missingDependency.BlockedChildren)), missingDependency.BlockedChildren.Base58()...),
+		BlockedDescendants:  missingDependency.BlockedDescendants,
+		Truncated:           missingDependency.Truncated,
+		RequestingNeighbors: make([]string, 0),
+	}
+	sort.Strings(result.BlockedChildren)
+	if !missingDependency.OldestBlockedDescendant.IsZero() {
+		result.OldestBlockedDescendant = missingDependency.OldestBlockedDescendant.Unix()
+	}
+	if requestStatus := missingDependency.RequestStatus; requestStatus != nil {
+		result.Requested = true
+		result.RequestStartTime = requestStatus.StartTime.Unix()
+		result.LastRequestTime = requestStatus.LastRequestTime.Unix()
+		result.RequestCount = requestStatus.RequestCount
+		for _, peerID := range requestStatus.RequestingPeers() {
+			result.RequestingNeighbors = append(result.RequestingNeighbors, peerID.String())
+		}
+		sort.Strings(result.RequestingNeighbors)
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/valuetracer"
+)
+
+// region ValueTracerStatisticsResponse ////////////////////////////////////////////////////////////////////////////////
+
+// ValueTracerStatisticsResponse is the HTTP response containing the aggregated end-to-end latencies of value transfers.
+// All durations are given in milliseconds.
+type ValueTracerStatisticsResponse struct {
+	Window                int64                          `json:"window"`
+	StageLatencies        map[string]*DurationStatistics `json:"stageLatencies"`
+	ConfirmedTransactions int                            `json:"confirmedTransactions"`
+	PendingTransactions   int                            `json:"pendingTransactions"`
+	ExpiredTransactions   int                            `json:"expiredTransactions"`
+	Error                 string                         `json:"error,omitempty"`
+}
+
+// NewValueTracerStatisticsResponse returns the ValueTracerStatisticsResponse of the given valuetracer.Statistics.
+func NewValueTracerStatisticsResponse(statistics *valuetracer.Statistics) *ValueTracerStatisticsResponse {
+	response := &ValueTracerStatisticsResponse{
+		Window:                statistics.Window.Milliseconds(),
+		StageLatencies:        make(map[string]*DurationStatistics),
+		ConfirmedTransactions: statistics.ConfirmedTransactions,
+		PendingTransactions:   statistics.PendingTransactions,
+		ExpiredTransactions:   statistics.ExpiredTransactions,
+	}
+	for stage, latency := range statistics.StageLatencies {
+		response.StageLatencies[stage.String()] = NewDurationStatistics(latency)
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ValueTracerTraceResponse /////////////////////////////////////////////////////////////////////////////////////
+
+// ValueTracerTraceResponse is the HTTP response containing the trace of a single transaction.
+type ValueTracerTraceResponse struct {
+	TransactionID  string              `json:"transactionID,omitempty"`
+	MessageID      string              `json:"messageID,omitempty"`
+	SubmissionTime int64               `json:"submissionTime,omitempty"`
+	Stages         []*ValueTracerStage `json:"stages,omitempty"`
+	Error          string              `json:"error,omitempty"`
+}
+
+// NewValueTracerTraceResponse returns the ValueTracerTraceResponse of the given valuetracer.Trace.
+func NewValueTracerTraceResponse(trace *valuetracer.Trace) *ValueTracerTraceResponse {
+	response := &ValueTracerTraceResponse{
+		TransactionID:  trace.TransactionID.Base58(),
+		MessageID:      trace.MessageID.Base58(),
+		SubmissionTime: trace.SubmissionTime.Unix(),
+		Stages:         make([]*ValueTracerStage, 0, len(trace.StageTimes)),
+	}
+	for _, stage := range valuetracer.Stages {
+		if latency, reached := trace.Latency(stage); reached {
+			response.Stages = append(response.Stages, &ValueTracerStage{
+				Stage:   stage.String(),
+				Time:    trace.StageTimes[stage].Unix(),
+				Latency: latency.Milliseconds(),
+			})
+		}
+	}
+
+	return response
+}
+
+// ValueTracerStage represents a stage that was reached by a traced transaction.
+type ValueTracerStage struct {
+	Stage   string `json:"stage"`
+	Time    int64  `json:"time"`
+	Latency int64  `json:"latency"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
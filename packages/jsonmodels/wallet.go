@@ -22,4 +22,8 @@ type WalletOutput struct {
 // WalletOutputMetadata holds metadata about the output.
 type WalletOutputMetadata struct {
 	Timestamp time.Time `json:"timestamp"`
+	// Conflicting is true if the output is booked into a conflict branch.
+	Conflicting bool `json:"conflicting,omitempty"`
+	// Reservation is the advisory reservation of the output by a wallet instance that intends to spend it.
+	Reservation *OutputReservation `json:"reservation,omitempty"`
 }
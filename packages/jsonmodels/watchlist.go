@@ -0,0 +1,67 @@
+package jsonmodels
+
+import (
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/watchlist"
+)
+
+// WatchItem represents the JSON model of an address, an output or a transaction that is watched by the node.
+type WatchItem struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	AddedTime int64  `json:"addedTime"`
+}
+
+// NewWatchItem returns the JSON model of the given watchlist.Item.
+func NewWatchItem(item *watchlist.Item) *WatchItem {
+	return &WatchItem{
+		ID:        item.Base58(),
+		Type:      item.Type().String(),
+		AddedTime: item.AddedTime().Unix(),
+	}
+}
+
+// WatchItemRequest represents the JSON model of a request that adds an item to the watch list.
+type WatchItemRequest struct {
+	ID string `json:"id"`
+}
+
+// WatchItemsResponse represents the JSON model of the items of the watch list.
+type WatchItemsResponse struct {
+	Items []*WatchItem `json:"items"`
+	Error string       `json:"error,omitempty"`
+}
+
+// WatchEvent represents the JSON model of an event that happened to a watched item.
+type WatchEvent struct {
+	Type          string `json:"type"`
+	TransactionID string `json:"transactionID"`
+	OutputID      string `json:"outputID,omitempty"`
+	MessageID     string `json:"messageID,omitempty"`
+	Time          int64  `json:"time"`
+}
+
+// NewWatchEvent returns the JSON model of the given watchlist.Event.
+func NewWatchEvent(event *watchlist.Event) *WatchEvent {
+	watchEvent := &WatchEvent{
+		Type:          event.Type().String(),
+		TransactionID: event.TransactionID().Base58(),
+		Time:          event.Time().Unix(),
+	}
+	if event.OutputID() != ledgerstate.EmptyOutputID {
+		watchEvent.OutputID = event.OutputID().Base58()
+	}
+	if event.MessageID() != tangle.EmptyMessageID {
+		watchEvent.MessageID = event.MessageID().Base58()
+	}
+
+	return watchEvent
+}
+
+// WatchEventsResponse represents the JSON model of the history of a watched item.
+type WatchEventsResponse struct {
+	Item   *WatchItem    `json:"item,omitempty"`
+	Events []*WatchEvent `json:"events"`
+	Error  string        `json:"error,omitempty"`
+}
@@ -1,6 +1,11 @@
 package jsonmodels
 
 import (
+	"crypto/sha256"
+
+	"github.com/iotaledger/hive.go/byteutils"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
@@ -50,6 +55,157 @@ type PostAddressesUnspentOutputsResponse struct {
 
 // endregion
 
+// region GetAddressBalanceResponse ////////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressBalanceResponse represents the JSON model of a response from the GetAddressBalance endpoint. The balances
+// of the unspent outputs are split by the state of the outputs and mapped by the base58 encoded color.
+type GetAddressBalanceResponse struct {
+	Address *Address `json:"address"`
+	// Confirmed contains the balances of confirmed outputs.
+	Confirmed map[string]uint64 `json:"confirmed"`
+	// Pending contains the balances of outputs that are not confirmed yet but that are not part of a conflict.
+	Pending map[string]uint64 `json:"pending"`
+	// Conflicting contains the balances of outputs that are part of a pending or rejected conflict.
+	Conflicting map[string]uint64 `json:"conflicting"`
+}
+
+// NewGetAddressBalanceResponse returns an empty GetAddressBalanceResponse for the given address.
+func NewGetAddressBalanceResponse(address ledgerstate.Address) *GetAddressBalanceResponse {
+	return &GetAddressBalanceResponse{
+		Address:     NewAddress(address),
+		Confirmed:   make(map[string]uint64),
+		Pending:     make(map[string]uint64),
+		Conflicting: make(map[string]uint64),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetSupplyResponse ////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetSupplyResponse represents the JSON model of a response from the GetSupply endpoint. It describes the unspent
+// outputs of the confirmed ledger state.
+type GetSupplyResponse struct {
+	// TotalSupply is the number of tokens held by the confirmed unspent outputs.
+	TotalSupply uint64 `json:"totalSupply"`
+	// UnspentOutputs is the number of confirmed unspent outputs.
+	UnspentOutputs int `json:"unspentOutputs"`
+	// DustOutputs is the number of confirmed unspent outputs that hold less tokens than the DustThreshold.
+	DustOutputs int `json:"dustOutputs"`
+	// DustThreshold is the balance below which an output is considered dust.
+	DustThreshold uint64 `json:"dustThreshold"`
+	// Addresses is the number of addresses with a positive balance.
+	Addresses int `json:"addresses"`
+	// TopAddresses contains the addresses with the largest balances in descending order of their balance.
+	TopAddresses []*AddressBalance `json:"topAddresses"`
+}
+
+// AddressBalance represents the JSON model of the balance of an address.
+type AddressBalance struct {
+	Address string `json:"address"`
+	Balance uint64 `json:"balance"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostAddressesBalanceProofRequest ////////////////////////////////////////////////////////////////////////////
+
+// PostAddressesBalanceProofRequest is the request object for the /ledgerstate/addresses/balanceProof endpoint.
+type PostAddressesBalanceProofRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostAddressesBalanceProofResponse ///////////////////////////////////////////////////////////////////////////
+
+// PostAddressesBalanceProofResponse is the response object for the /ledgerstate/addresses/balanceProof endpoint.
+type PostAddressesBalanceProofResponse struct {
+	BalanceProofs []*AddressBalanceProof `json:"balanceProofs"`
+}
+
+// AddressBalanceProof represents the JSON model of the outputs of an address that can be unspent under some
+// resolution of the pending conflicts, together with the balance that is unspent under every resolution.
+type AddressBalanceProof struct {
+	Address *Address              `json:"address"`
+	Outputs []*BalanceProofOutput `json:"outputs"`
+	// GuaranteedBalances contains the balances of the guaranteed outputs mapped by the base58 encoded color.
+	GuaranteedBalances map[string]uint64 `json:"guaranteedBalances"`
+}
+
+// NewAddressBalanceProof returns an empty AddressBalanceProof for the given address.
+func NewAddressBalanceProof(address ledgerstate.Address) *AddressBalanceProof {
+	return &AddressBalanceProof{
+		Address:            NewAddress(address),
+		Outputs:            make([]*BalanceProofOutput, 0),
+		GuaranteedBalances: make(map[string]uint64),
+	}
+}
+
+// BalanceProofOutput represents the JSON model of an output that is part of an AddressBalanceProof.
+type BalanceProofOutput struct {
+	Output          *Output               `json:"output"`
+	GradeOfFinality gof.GradeOfFinality   `json:"gradeOfFinality"`
+	Confirmed       bool                  `json:"confirmed"`
+	Branches        []*BalanceProofBranch `json:"branches"`
+	// PendingConsumers contains the IDs of the transactions that spend the output and that are neither confirmed nor
+	// rejected yet.
+	PendingConsumers []string `json:"pendingConsumers"`
+	// Guaranteed is true if the output is confirmed and unspent under every resolution of the pending conflicts.
+	Guaranteed bool `json:"guaranteed"`
+}
+
+// BalanceProofBranch represents the JSON model of a branch that an output of a BalanceProofOutput is booked into.
+type BalanceProofBranch struct {
+	BranchID        string              `json:"branchID"`
+	GradeOfFinality gof.GradeOfFinality `json:"gradeOfFinality"`
+	InclusionState  string              `json:"inclusionState"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostOverlayRequest ///////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOverlayRequest is the request object for the /ledgerstate/overlay endpoint.
+type PostOverlayRequest struct {
+	// TransactionsBytes contains the hypothetical transactions in the order in which they are applied.
+	TransactionsBytes [][]byte `json:"txn_bytes"`
+	// Addresses contains the base58 encoded addresses whose unspent outputs are returned.
+	Addresses []string `json:"addresses"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PostOverlayResponse //////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOverlayResponse is the response object for the /ledgerstate/overlay endpoint. It describes the ledger state that
+// results from applying the hypothetical transactions of the request on top of the ledger state of the node.
+type PostOverlayResponse struct {
+	Transactions []*OverlayTransaction `json:"transactions"`
+	Addresses    []*OverlayAddress     `json:"addresses"`
+}
+
+// OverlayTransaction represents the JSON model of the result of applying a hypothetical transaction.
+type OverlayTransaction struct {
+	TransactionID string `json:"transactionID,omitempty"`
+	// Applied is true if the transaction is valid and was applied to the overlay.
+	Applied bool `json:"applied"`
+	// Conflicts contains the IDs of the transactions of the ledger state that spend the same outputs.
+	Conflicts []string `json:"conflicts,omitempty"`
+	Error     string   `json:"error,omitempty"`
+}
+
+// OverlayAddress represents the JSON model of the unspent outputs of an address after applying the hypothetical
+// transactions.
+type OverlayAddress struct {
+	Address        *Address  `json:"address"`
+	UnspentOutputs []*Output `json:"unspentOutputs"`
+	// Balances contains the balances of the unspent outputs mapped by the base58 encoded color.
+	Balances map[string]uint64 `json:"balances"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetBranchChildrenResponse ////////////////////////////////////////////////////////////////////////////////////
 
 // GetBranchChildrenResponse represents the JSON model of a response from the GetBranchChildren endpoint.
@@ -155,20 +311,64 @@ func NewGetOutputConsumersResponse(outputID ledgerstate.OutputID, consumers []*l
 
 // GetTransactionAttachmentsResponse represents the JSON model of a response from the GetTransactionAttachments endpoint.
 type GetTransactionAttachmentsResponse struct {
-	TransactionID string   `json:"transactionID"`
-	MessageIDs    []string `json:"messageIDs"`
+	TransactionID string                   `json:"transactionID"`
+	MessageIDs    []string                 `json:"messageIDs"`
+	Attachments   []*TransactionAttachment `json:"attachments"`
+	// BestAttachment is the MessageID of the attachment that inclusion decisions are based on.
+	BestAttachment string `json:"bestAttachment,omitempty"`
+	// CanonicalAttachment is the MessageID of the attachment that booked the transaction into the ledger state.
+	CanonicalAttachment string `json:"canonicalAttachment,omitempty"`
 }
 
 // NewGetTransactionAttachmentsResponse returns a GetTransactionAttachmentsResponse from the given details.
-func NewGetTransactionAttachmentsResponse(transactionID ledgerstate.TransactionID, messageIDs tangle.MessageIDs) *GetTransactionAttachmentsResponse {
+func NewGetTransactionAttachmentsResponse(transactionID ledgerstate.TransactionID, attachments []*TransactionAttachment, bestAttachmentID, canonicalAttachmentID tangle.MessageID) *GetTransactionAttachmentsResponse {
 	var messageIDsBase58 []string
-	for messageID := range messageIDs {
-		messageIDsBase58 = append(messageIDsBase58, messageID.Base58())
+	for _, attachment := range attachments {
+		messageIDsBase58 = append(messageIDsBase58, attachment.MessageID)
 	}
 
-	return &GetTransactionAttachmentsResponse{
+	response := &GetTransactionAttachmentsResponse{
 		TransactionID: transactionID.Base58(),
 		MessageIDs:    messageIDsBase58,
+		Attachments:   attachments,
+	}
+	if bestAttachmentID != tangle.EmptyMessageID {
+		response.BestAttachment = bestAttachmentID.Base58()
+	}
+	if canonicalAttachmentID != tangle.EmptyMessageID {
+		response.CanonicalAttachment = canonicalAttachmentID.Base58()
+	}
+
+	return response
+}
+
+// TransactionAttachment represents the JSON model of a message that contains a transaction.
+type TransactionAttachment struct {
+	MessageID           string              `json:"messageID"`
+	IssuingTime         int64               `json:"issuingTime"`
+	BranchIDs           []string            `json:"branchIDs"`
+	Booked              bool                `json:"booked"`
+	BookedTime          int64               `json:"bookedTime"`
+	Scheduled           bool                `json:"scheduled"`
+	ScheduledTime       int64               `json:"scheduledTime"`
+	Orphaned            bool                `json:"orphaned"`
+	GradeOfFinality     gof.GradeOfFinality `json:"gradeOfFinality"`
+	GradeOfFinalityTime int64               `json:"gradeOfFinalityTime"`
+}
+
+// NewTransactionAttachment returns a TransactionAttachment from the given message, its metadata and its branches.
+func NewTransactionAttachment(message *tangle.Message, messageMetadata *tangle.MessageMetadata, branchIDs ledgerstate.BranchIDs) *TransactionAttachment {
+	return &TransactionAttachment{
+		MessageID:           message.ID().Base58(),
+		IssuingTime:         message.IssuingTime().Unix(),
+		BranchIDs:           branchIDs.Base58(),
+		Booked:              messageMetadata.IsBooked(),
+		BookedTime:          messageMetadata.BookedTime().Unix(),
+		Scheduled:           messageMetadata.Scheduled(),
+		ScheduledTime:       messageMetadata.ScheduledTime().Unix(),
+		Orphaned:            messageMetadata.IsOrphaned(),
+		GradeOfFinality:     messageMetadata.GradeOfFinality(),
+		GradeOfFinalityTime: messageMetadata.GradeOfFinalityTime().Unix(),
 	}
 }
 
@@ -214,20 +414,72 @@ type PostTransactionResponse struct {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
-// region ErrorResponse ////////////////////////////////////////////////////////////////////////////////////////////////
+// region DryRunResponse ///////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// DryRunQueryParameter is the query parameter that makes the submission endpoints only validate a request.
+	DryRunQueryParameter = "dryRun"
 
-// ErrorResponse represents the JSON model of an error response from an API endpoint.
-type ErrorResponse struct {
-	Error string `json:"error"`
+	// DryRunCheckPassed is the status of a check that succeeded.
+	DryRunCheckPassed = "passed"
+	// DryRunCheckFailed is the status of a check that failed.
+	DryRunCheckFailed = "failed"
+	// DryRunCheckSkipped is the status of a check that could not be performed because a check it depends on failed.
+	DryRunCheckSkipped = "skipped"
+)
+
+// DryRunResponse represents the JSON model of the diagnosis of a submission that was validated without being issued.
+type DryRunResponse struct {
+	Valid         bool           `json:"valid"`
+	TransactionID string         `json:"transactionID,omitempty"`
+	Checks        []*DryRunCheck `json:"checks"`
+}
+
+// DryRunCheck represents the JSON model of the result of a single check of a dry-run.
+type DryRunCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// NewDryRunResponse returns an empty DryRunResponse that is valid until a check fails.
+func NewDryRunResponse() *DryRunResponse {
+	return &DryRunResponse{
+		Valid:  true,
+		Checks: make([]*DryRunCheck, 0),
+	}
+}
+
+// AddCheck adds the result of the check with the given name. The check failed if err is not nil.
+func (d *DryRunResponse) AddCheck(name string, err error) (passed bool) {
+	if err != nil {
+		d.Valid = false
+		d.Checks = append(d.Checks, &DryRunCheck{Name: name, Status: DryRunCheckFailed, Error: err.Error()})
+		return false
+	}
+
+	d.Checks = append(d.Checks, &DryRunCheck{Name: name, Status: DryRunCheckPassed})
+	return true
 }
 
-// NewErrorResponse returns am ErrorResponse from the given error.
-func NewErrorResponse(err error) *ErrorResponse {
-	return &ErrorResponse{
-		Error: err.Error(),
+// SkipChecks adds the checks with the given names as skipped.
+func (d *DryRunResponse) SkipChecks(names ...string) {
+	for _, name := range names {
+		d.Checks = append(d.Checks, &DryRunCheck{Name: name, Status: DryRunCheckSkipped})
 	}
 }
 
+// Check returns the check with the given name or nil if it was not performed.
+func (d *DryRunResponse) Check(name string) *DryRunCheck {
+	for _, check := range d.Checks {
+		if check.Name == name {
+			return check
+		}
+	}
+
+	return nil
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region GetUnspentOutputsResponse ////////////////////////////////////////////////////////////////////////////////////
@@ -239,3 +491,52 @@ type GetUnspentOutputResponse struct {
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IdempotencyKeyHeader /////////////////////////////////////////////////////////////////////////////////////////
+
+// IdempotencyKeyHeader is the HTTP header that carries the idempotency key of a request. A POST request that is
+// repeated with the same idempotency key returns the response of the first request instead of being executed again.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ContentTypeProtobuf //////////////////////////////////////////////////////////////////////////////////////////
+
+// ContentTypeProtobuf is the content type of protobuf encoded request and response bodies. Endpoints that support it
+// decode protobuf requests if the Content-Type header is set to it and encode their responses with protobuf if the
+// Accept header contains it. The messages are defined in the webapiproto package.
+const ContentTypeProtobuf = "application/x-protobuf"
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetChallengeResponse /////////////////////////////////////////////////////////////////////////////////////////
+
+// GetChallengeResponse is the JSON model of a response from the GetChallenge endpoint. The challenge is signed by the
+// issuer of a request to prove its identity, so that the node can prioritize the request by the access mana of the
+// issuer.
+type GetChallengeResponse struct {
+	Challenge string `json:"challenge"`
+	ExpiresAt int64  `json:"expiresAt"`
+}
+
+const (
+	// IssuerPublicKeyHeader is the HTTP header that carries the base58 encoded public key of the issuer of a request.
+	IssuerPublicKeyHeader = "X-Issuer-Public-Key"
+
+	// IssuerChallengeHeader is the HTTP header that carries the challenge that was signed by the issuer of a request.
+	IssuerChallengeHeader = "X-Issuer-Challenge"
+
+	// IssuerSignatureHeader is the HTTP header that carries the base58 encoded signature of the IssuerSignedMessage of a
+	// request.
+	IssuerSignatureHeader = "X-Issuer-Signature"
+)
+
+// IssuerSignedMessage returns the message that is signed by the issuer of a request. It binds the challenge to the
+// method, the path and the hash of the body of the request, so that the signature can not be used for other requests.
+func IssuerSignedMessage(challenge, method, path string, body []byte) []byte {
+	bodyHash := sha256.Sum256(body)
+
+	return byteutils.ConcatBytes([]byte(challenge+"\n"+method+" "+path+"\n"), bodyHash[:])
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
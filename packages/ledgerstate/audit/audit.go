@@ -0,0 +1,437 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/iotaledger/hive.go/typeutils"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region Auditor //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Auditor replays the UTXO DAG of a Ledgerstate from its genesis (or snapshot) transactions and verifies that the
+// stored ledger state satisfies the invariants of the ledger. It only reads from the Ledgerstate, so it can be used to
+// prove that a database is not corrupted.
+type Auditor struct {
+	ledgerstate *ledgerstate.Ledgerstate
+	snapshot    *ledgerstate.Snapshot
+
+	report             *Report
+	transactions       map[ledgerstate.TransactionID]*ledgerstate.Transaction
+	rootTransactionIDs map[ledgerstate.TransactionID]bool
+	replayed           map[ledgerstate.TransactionID]bool
+	confirmedOutputs   map[ledgerstate.OutputID]uint64
+	confirmedConsumers map[ledgerstate.OutputID]ledgerstate.TransactionID
+}
+
+// New is the constructor of the Auditor.
+func New(ledgerstateInstance *ledgerstate.Ledgerstate, opts ...Option) (auditor *Auditor) {
+	auditor = &Auditor{
+		ledgerstate: ledgerstateInstance,
+	}
+
+	for _, opt := range opts {
+		opt(auditor)
+	}
+
+	return auditor
+}
+
+// Run audits the ledger state and returns the Report that contains the violations of the invariants.
+func (a *Auditor) Run() (report *Report) {
+	a.report = &Report{Violations: make([]*Violation, 0)}
+	a.transactions = make(map[ledgerstate.TransactionID]*ledgerstate.Transaction)
+	a.rootTransactionIDs = make(map[ledgerstate.TransactionID]bool)
+	a.replayed = make(map[ledgerstate.TransactionID]bool)
+	a.confirmedOutputs = make(map[ledgerstate.OutputID]uint64)
+	a.confirmedConsumers = make(map[ledgerstate.OutputID]ledgerstate.TransactionID)
+
+	a.loadTransactions()
+	a.replayTransactions()
+	a.auditSupply()
+	a.auditBranches()
+
+	return a.report
+}
+
+// loadTransactions loads all Transactions and determines the root transactions that the replay starts from.
+func (a *Auditor) loadTransactions() {
+	a.ledgerstate.ForEachTransaction(func(transaction *ledgerstate.Transaction) bool {
+		a.transactions[transaction.ID()] = transaction
+
+		return true
+	})
+	a.report.Transactions = len(a.transactions)
+
+	if a.snapshot != nil {
+		for transactionID := range a.snapshot.Transactions {
+			if _, exists := a.transactions[transactionID]; !exists {
+				a.report.addViolation(InvariantTransactionsValid, transactionID.Base58(), "snapshot transaction is missing in the ledger")
+				continue
+			}
+			a.rootTransactionIDs[transactionID] = true
+		}
+
+		return
+	}
+
+	for transactionID, transaction := range a.transactions {
+		if a.missingInputs(transaction) == len(transaction.Essence().Inputs()) {
+			a.rootTransactionIDs[transactionID] = true
+		}
+	}
+}
+
+// replayTransactions replays the Transactions starting at the root transactions, so that every Transaction is audited
+// after the Transactions that created its Inputs.
+func (a *Auditor) replayTransactions() {
+	a.report.RootTransactions = len(a.rootTransactionIDs)
+
+	queue := make([]ledgerstate.TransactionID, 0, len(a.rootTransactionIDs))
+	for transactionID := range a.rootTransactionIDs {
+		queue = append(queue, transactionID)
+	}
+
+	for len(queue) > 0 {
+		transactionID := queue[0]
+		queue = queue[1:]
+
+		if a.replayed[transactionID] {
+			continue
+		}
+		a.replayed[transactionID] = true
+		a.auditTransaction(a.transactions[transactionID])
+
+		for _, output := range a.transactions[transactionID].Essence().Outputs() {
+			a.ledgerstate.CachedConsumers(output.ID()).Consume(func(consumer *ledgerstate.Consumer) {
+				if a.replayable(consumer.TransactionID()) {
+					queue = append(queue, consumer.TransactionID())
+				}
+			})
+		}
+	}
+
+	for transactionID := range a.transactions {
+		if !a.replayed[transactionID] {
+			a.report.addViolation(InvariantTransactionsValid, transactionID.Base58(), "transaction is not reachable from the root transactions")
+		}
+	}
+}
+
+// replayable returns true if the given Transaction was not replayed, yet, and all of its Inputs were created by
+// replayed Transactions.
+func (a *Auditor) replayable(transactionID ledgerstate.TransactionID) bool {
+	transaction, exists := a.transactions[transactionID]
+	if !exists || a.replayed[transactionID] || a.rootTransactionIDs[transactionID] {
+		return false
+	}
+
+	for _, input := range transaction.Essence().Inputs() {
+		if !a.replayed[input.(*ledgerstate.UTXOInput).ReferencedOutputID().TransactionID()] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// auditTransaction verifies the invariants of a single Transaction.
+func (a *Auditor) auditTransaction(transaction *ledgerstate.Transaction) {
+	var metadata *ledgerstate.TransactionMetadata
+	if !a.ledgerstate.CachedTransactionMetadata(transaction.ID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		metadata = transactionMetadata
+	}) {
+		a.report.addViolation(InvariantTransactionsValid, transaction.ID().Base58(), "transaction metadata is missing")
+		return
+	}
+
+	if metadata.GradeOfFinality() != gof.High {
+		return
+	}
+	a.report.ConfirmedTransactions++
+
+	if a.ledgerstate.InclusionState(metadata.BranchIDs()) == ledgerstate.Rejected {
+		a.report.addViolation(InvariantBranchesConsistent, transaction.ID().Base58(), "confirmed transaction is booked into a rejected branch")
+	}
+
+	if a.rootTransactionIDs[transaction.ID()] {
+		a.auditRootTransaction(transaction)
+		return
+	}
+
+	cachedConsumedOutputs := a.ledgerstate.ConsumedOutputs(transaction)
+	consumedOutputs := cachedConsumedOutputs.Unwrap()
+	cachedConsumedOutputs.Release()
+
+	if err := ledgerstate.ValidateTransaction(transaction, consumedOutputs); err != nil {
+		a.report.addViolation(InvariantTransactionsValid, transaction.ID().Base58(), fmt.Sprintf("confirmed transaction is invalid: %s", err))
+	}
+
+	for _, input := range transaction.Essence().Inputs() {
+		outputID := input.(*ledgerstate.UTXOInput).ReferencedOutputID()
+
+		if _, confirmed := a.confirmedOutputs[outputID]; !confirmed {
+			a.report.addViolation(InvariantTransactionsValid, transaction.ID().Base58(), fmt.Sprintf("confirmed transaction consumes unconfirmed output %s", outputID.Base58()))
+		}
+
+		if consumerID, consumed := a.confirmedConsumers[outputID]; consumed {
+			a.report.addViolation(InvariantNoDoubleSpends, outputID.Base58(), fmt.Sprintf("output is consumed by the confirmed transactions %s and %s", consumerID.Base58(), transaction.ID().Base58()))
+			continue
+		}
+		a.confirmedConsumers[outputID] = transaction.ID()
+	}
+
+	for _, output := range transaction.Essence().Outputs() {
+		a.confirmedOutputs[output.ID()] = totalBalance(output)
+	}
+}
+
+// auditRootTransaction registers the stored Outputs of a confirmed root transaction, which form the supply of the
+// ledger.
+func (a *Auditor) auditRootTransaction(transaction *ledgerstate.Transaction) {
+	for _, output := range transaction.Essence().Outputs() {
+		a.ledgerstate.CachedOutput(output.ID()).Consume(func(storedOutput ledgerstate.Output) {
+			balance := totalBalance(storedOutput)
+			a.confirmedOutputs[output.ID()] = balance
+
+			if a.snapshot == nil {
+				a.report.Supply += balance
+			}
+		})
+	}
+}
+
+// auditSupply verifies that the confirmed unspent Outputs hold exactly the supply of the root transactions.
+func (a *Auditor) auditSupply() {
+	if a.snapshot != nil {
+		for _, record := range a.snapshot.Transactions {
+			for i, output := range record.Essence.Outputs() {
+				if i < len(record.UnspentOutputs) && record.UnspentOutputs[i] {
+					a.report.Supply += totalBalance(output)
+				}
+			}
+		}
+	}
+
+	for outputID, balance := range a.confirmedOutputs {
+		if _, consumed := a.confirmedConsumers[outputID]; !consumed {
+			a.report.ConfirmedUnspentBalance += balance
+		}
+	}
+
+	if a.report.ConfirmedUnspentBalance != a.report.Supply {
+		a.report.addViolation(InvariantSupplyConserved, "ledger", fmt.Sprintf("confirmed unspent outputs hold %d tokens but the supply is %d tokens", a.report.ConfirmedUnspentBalance, a.report.Supply))
+	}
+}
+
+// auditBranches verifies that the InclusionStates of the Branches are consistent with their parents and their
+// conflict sets.
+func (a *Auditor) auditBranches() {
+	branches := make(map[ledgerstate.BranchID]*ledgerstate.Branch)
+	a.ledgerstate.ForEachBranch(func(branch *ledgerstate.Branch) {
+		branches[branch.ID()] = branch
+	})
+	a.report.Branches = len(branches)
+
+	conflictMembers := make(map[ledgerstate.ConflictID]ledgerstate.BranchIDs)
+	for branchID, branch := range branches {
+		for parentBranchID := range branch.Parents() {
+			parentBranch, exists := branches[parentBranchID]
+			if !exists {
+				a.report.addViolation(InvariantBranchesConsistent, branchID.Base58(), fmt.Sprintf("parent branch %s is missing", parentBranchID.Base58()))
+				continue
+			}
+
+			if branch.InclusionState() == ledgerstate.Confirmed && parentBranch.InclusionState() != ledgerstate.Confirmed {
+				a.report.addViolation(InvariantBranchesConsistent, branchID.Base58(), fmt.Sprintf("confirmed branch has the %s parent branch %s", parentBranch.InclusionState(), parentBranchID.Base58()))
+			}
+			if parentBranch.InclusionState() == ledgerstate.Rejected && branch.InclusionState() != ledgerstate.Rejected {
+				a.report.addViolation(InvariantBranchesConsistent, branchID.Base58(), fmt.Sprintf("%s branch has the rejected parent branch %s", branch.InclusionState(), parentBranchID.Base58()))
+			}
+		}
+
+		for conflictID := range branch.Conflicts() {
+			if _, exists := conflictMembers[conflictID]; !exists {
+				conflictMembers[conflictID] = ledgerstate.NewBranchIDs()
+			}
+			conflictMembers[conflictID].Add(branchID)
+		}
+	}
+	a.report.Conflicts = len(conflictMembers)
+
+	for conflictID, memberBranchIDs := range conflictMembers {
+		a.auditConflict(conflictID, memberBranchIDs, branches)
+	}
+}
+
+// auditConflict verifies that the members of a conflict set are stored consistently and that at most one of them is
+// confirmed, in which case all others have to be rejected.
+func (a *Auditor) auditConflict(conflictID ledgerstate.ConflictID, memberBranchIDs ledgerstate.BranchIDs, branches map[ledgerstate.BranchID]*ledgerstate.Branch) {
+	storedMemberBranchIDs := ledgerstate.NewBranchIDs()
+	a.ledgerstate.ConflictMembers(conflictID).Consume(func(conflictMember *ledgerstate.ConflictMember) {
+		storedMemberBranchIDs.Add(conflictMember.BranchID())
+	})
+
+	for branchID := range memberBranchIDs {
+		if !storedMemberBranchIDs.Contains(branchID) {
+			a.report.addViolation(InvariantBranchesConsistent, conflictID.Base58(), fmt.Sprintf("branch %s is not registered as a member of its conflict", branchID.Base58()))
+		}
+	}
+	for branchID := range storedMemberBranchIDs {
+		if !memberBranchIDs.Contains(branchID) {
+			a.report.addViolation(InvariantBranchesConsistent, conflictID.Base58(), fmt.Sprintf("conflict member %s does not reference the conflict", branchID.Base58()))
+		}
+	}
+
+	confirmedBranchIDs := make([]string, 0)
+	for branchID := range memberBranchIDs {
+		if branches[branchID].InclusionState() == ledgerstate.Confirmed {
+			confirmedBranchIDs = append(confirmedBranchIDs, branchID.Base58())
+		}
+	}
+
+	switch {
+	case len(confirmedBranchIDs) > 1:
+		a.report.addViolation(InvariantBranchesConsistent, conflictID.Base58(), fmt.Sprintf("conflict has several confirmed members: %s", strings.Join(confirmedBranchIDs, ", ")))
+	case len(confirmedBranchIDs) == 1:
+		for branchID := range memberBranchIDs {
+			if inclusionState := branches[branchID].InclusionState(); inclusionState == ledgerstate.Pending {
+				a.report.addViolation(InvariantBranchesConsistent, conflictID.Base58(), fmt.Sprintf("branch %s is pending although the conflicting branch %s is confirmed", branchID.Base58(), confirmedBranchIDs[0]))
+			}
+		}
+	}
+}
+
+// missingInputs returns the number of Inputs of the given Transaction whose Outputs are not stored in the ledger.
+func (a *Auditor) missingInputs(transaction *ledgerstate.Transaction) (missingInputs int) {
+	cachedConsumedOutputs := a.ledgerstate.ConsumedOutputs(transaction)
+	defer cachedConsumedOutputs.Release()
+
+	for _, consumedOutput := range cachedConsumedOutputs.Unwrap() {
+		if typeutils.IsInterfaceNil(consumedOutput) {
+			missingInputs++
+		}
+	}
+
+	return missingInputs
+}
+
+// totalBalance returns the sum of the balances of all colors of the given Output.
+func totalBalance(output ledgerstate.Output) (balance uint64) {
+	output.Balances().ForEach(func(_ ledgerstate.Color, colorBalance uint64) bool {
+		balance += colorBalance
+		return true
+	})
+
+	return balance
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option is the type of the optional parameters of the Auditor.
+type Option func(auditor *Auditor)
+
+// WithSnapshot is an Option for the Auditor that sets the snapshot that the ledger was bootstrapped from. The
+// transactions of the snapshot are used as the root transactions of the replay and its unspent outputs define the
+// supply. Without a snapshot, the transactions whose inputs are not stored in the ledger are used as the roots.
+func WithSnapshot(snapshot *ledgerstate.Snapshot) Option {
+	return func(auditor *Auditor) {
+		auditor.snapshot = snapshot
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Report ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Report contains the result of an audit of the ledger state.
+type Report struct {
+	// Transactions is the number of stored transactions.
+	Transactions int `json:"transactions"`
+	// RootTransactions is the number of genesis or snapshot transactions that the replay started from.
+	RootTransactions int `json:"rootTransactions"`
+	// ConfirmedTransactions is the number of transactions with a high grade of finality.
+	ConfirmedTransactions int `json:"confirmedTransactions"`
+	// Branches is the number of stored branches.
+	Branches int `json:"branches"`
+	// Conflicts is the number of conflict sets.
+	Conflicts int `json:"conflicts"`
+	// Supply is the number of tokens that were created by the root transactions.
+	Supply uint64 `json:"supply"`
+	// ConfirmedUnspentBalance is the number of tokens held by confirmed outputs that are not consumed by confirmed
+	// transactions.
+	ConfirmedUnspentBalance uint64 `json:"confirmedUnspentBalance"`
+	// Violations contains the violations of the invariants that were found.
+	Violations []*Violation `json:"violations"`
+}
+
+// Consistent returns true if the ledger state does not violate any invariant.
+func (r *Report) Consistent() bool {
+	return len(r.Violations) == 0
+}
+
+// ViolationsOf returns the violations of the given Invariant.
+func (r *Report) ViolationsOf(invariant Invariant) (violations []*Violation) {
+	violations = make([]*Violation, 0)
+	for _, violation := range r.Violations {
+		if violation.Invariant == invariant {
+			violations = append(violations, violation)
+		}
+	}
+
+	return violations
+}
+
+// addViolation adds a violation of the given Invariant by the given subject to the Report.
+func (r *Report) addViolation(invariant Invariant, subject, description string) {
+	r.Violations = append(r.Violations, &Violation{
+		Invariant:   invariant,
+		Subject:     subject,
+		Description: description,
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Violation ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Invariant is the name of a property that the ledger state has to satisfy.
+type Invariant string
+
+const (
+	// InvariantTransactionsValid is violated by confirmed transactions that are invalid or consume unconfirmed outputs
+	// and by transactions that are not reachable from the root transactions.
+	InvariantTransactionsValid Invariant = "transactionsValid"
+	// InvariantNoDoubleSpends is violated by outputs that are consumed by more than one confirmed transaction.
+	InvariantNoDoubleSpends Invariant = "noDoubleSpends"
+	// InvariantSupplyConserved is violated if the confirmed unspent outputs don't hold the supply of the ledger.
+	InvariantSupplyConserved Invariant = "supplyConserved"
+	// InvariantBranchesConsistent is violated by branches whose inclusion state is inconsistent with their parents or
+	// their conflict sets.
+	InvariantBranchesConsistent Invariant = "branchesConsistent"
+)
+
+// Invariants contains all Invariants that are verified by the Auditor.
+var Invariants = []Invariant{InvariantTransactionsValid, InvariantNoDoubleSpends, InvariantSupplyConserved, InvariantBranchesConsistent}
+
+// Violation describes an element of the ledger state that violates an Invariant.
+type Violation struct {
+	// Invariant is the violated Invariant.
+	Invariant Invariant `json:"invariant"`
+	// Subject is the ID of the element that violates the Invariant.
+	Subject string `json:"subject"`
+	// Description is a human-readable description of the violation.
+	Description string `json:"description"`
+}
+
+// String returns a human-readable version of the Violation.
+func (v *Violation) String() string {
+	return fmt.Sprintf("[%s] %s: %s", v.Invariant, v.Subject, v.Description)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,136 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestAuditor(t *testing.T) {
+	testLedger := newTestLedger(t, 1000)
+	defer testLedger.Shutdown()
+
+	// a snapshot without transactions is consistent
+	report := New(testLedger.Ledgerstate).Run()
+	assert.True(t, report.Consistent(), report.Violations)
+	assert.Equal(t, 1, report.RootTransactions)
+	assert.EqualValues(t, 1000, report.Supply)
+	assert.EqualValues(t, 1000, report.ConfirmedUnspentBalance)
+
+	// a confirmed transfer keeps the ledger consistent
+	transfer := testLedger.transfer(t, testLedger.genesisOutput, 1000)
+	testLedger.setConfirmed(transfer)
+
+	report = New(testLedger.Ledgerstate, WithSnapshot(testLedger.snapshot)).Run()
+	assert.True(t, report.Consistent(), report.Violations)
+	assert.Equal(t, 2, report.Transactions)
+	assert.Equal(t, 2, report.ConfirmedTransactions)
+	assert.EqualValues(t, 1000, report.ConfirmedUnspentBalance)
+
+	// a pending double spend creates a conflict but keeps the ledger consistent
+	doubleSpend := testLedger.transfer(t, testLedger.genesisOutput, 1000)
+
+	report = New(testLedger.Ledgerstate).Run()
+	assert.True(t, report.Consistent(), report.Violations)
+	assert.Equal(t, 3, report.Branches)
+	assert.Equal(t, 1, report.Conflicts)
+
+	// confirming both spends violates the invariants
+	testLedger.setConfirmed(doubleSpend)
+	testLedger.SetBranchConfirmed(ledgerstate.NewBranchID(transfer.ID()))
+
+	report = New(testLedger.Ledgerstate).Run()
+	assert.False(t, report.Consistent())
+	assert.Len(t, report.ViolationsOf(InvariantNoDoubleSpends), 1)
+	assert.Len(t, report.ViolationsOf(InvariantSupplyConserved), 1)
+	assert.Len(t, report.ViolationsOf(InvariantBranchesConsistent), 1)
+	assert.Empty(t, report.ViolationsOf(InvariantTransactionsValid))
+	assert.EqualValues(t, 2000, report.ConfirmedUnspentBalance)
+}
+
+func TestAuditor_UnconfirmedPastCone(t *testing.T) {
+	testLedger := newTestLedger(t, 1000)
+	defer testLedger.Shutdown()
+
+	// a transaction is confirmed although the transaction that created its input is not
+	transfer := testLedger.transfer(t, testLedger.genesisOutput, 1000)
+	nextTransfer := testLedger.transfer(t, transfer.Essence().Outputs()[0], 1000)
+	testLedger.setConfirmed(nextTransfer)
+
+	report := New(testLedger.Ledgerstate, WithSnapshot(testLedger.snapshot)).Run()
+	violations := report.ViolationsOf(InvariantTransactionsValid)
+	require.Len(t, violations, 1)
+	assert.Equal(t, nextTransfer.ID().Base58(), violations[0].Subject)
+	assert.Contains(t, violations[0].Description, "consumes unconfirmed output")
+	assert.Len(t, report.ViolationsOf(InvariantSupplyConserved), 1)
+}
+
+type testLedger struct {
+	*ledgerstate.Ledgerstate
+
+	keyPair       ed25519.KeyPair
+	address       *ledgerstate.ED25519Address
+	snapshot      *ledgerstate.Snapshot
+	genesisOutput ledgerstate.Output
+}
+
+func newTestLedger(t *testing.T, supply uint64) *testLedger {
+	testLedger := &testLedger{
+		Ledgerstate: ledgerstate.New(ledgerstate.CacheTimeProvider(database.NewCacheTimeProvider(0))),
+		keyPair:     ed25519.GenerateKeyPair(),
+	}
+	require.NoError(t, testLedger.Prune())
+	testLedger.address = ledgerstate.NewED25519Address(testLedger.keyPair.PublicKey)
+
+	genesisEssence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(supply, testLedger.address)),
+	)
+	genesisTransaction := ledgerstate.NewTransaction(genesisEssence, ledgerstate.UnlockBlocks{ledgerstate.NewReferenceUnlockBlock(0)})
+
+	testLedger.snapshot = &ledgerstate.Snapshot{
+		Transactions: map[ledgerstate.TransactionID]ledgerstate.Record{
+			genesisTransaction.ID(): {
+				Essence:        genesisEssence,
+				UnlockBlocks:   ledgerstate.UnlockBlocks{ledgerstate.NewReferenceUnlockBlock(0)},
+				UnspentOutputs: []bool{true},
+			},
+		},
+	}
+	testLedger.LoadSnapshot(testLedger.snapshot)
+	testLedger.genesisOutput = genesisTransaction.Essence().Outputs()[0]
+
+	return testLedger
+}
+
+// transfer books a transaction that sends the balance of the given output back to the address of
+// the test ledger.
+func (l *testLedger) transfer(t *testing.T, output ledgerstate.Output, balance uint64) *ledgerstate.Transaction {
+	essence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(output.ID())),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(balance, l.address)),
+	)
+	signature := ledgerstate.NewED25519Signature(l.keyPair.PublicKey, l.keyPair.PrivateKey.Sign(essence.Bytes()))
+	transaction := ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(signature)})
+
+	require.NoError(t, l.CheckTransaction(transaction))
+	_, err := l.BookTransaction(transaction)
+	require.NoError(t, err)
+
+	return transaction
+}
+
+// setConfirmed sets the grade of finality of the given transaction to high.
+func (l *testLedger) setConfirmed(transaction *ledgerstate.Transaction) {
+	l.CachedTransactionMetadata(transaction.ID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		transactionMetadata.SetGradeOfFinality(gof.High, time.Now())
+	})
+}
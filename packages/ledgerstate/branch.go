@@ -1,6 +1,8 @@
 package ledgerstate
 
 import (
+	"bytes"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -14,6 +16,8 @@ import (
 	"github.com/iotaledger/hive.go/stringify"
 	"github.com/iotaledger/hive.go/types"
 	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/stringcache"
 )
 
 // region BranchID /////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -29,6 +33,9 @@ var (
 // BranchIDLength contains the amount of bytes that a marshaled version of the BranchID contains.
 const BranchIDLength = 32
 
+// BranchIDBase58CacheSize contains the number of base58 encoded BranchIDs that are cached.
+const BranchIDBase58CacheSize = 10000
+
 // BranchID is the data type that represents the identifier of a Branch.
 type BranchID [BranchIDLength]byte
 
@@ -39,11 +46,6 @@ func NewBranchID(transactionID TransactionID) (branchID BranchID) {
 	return
 }
 
-// BranchIDEventHandler is an event handler for an event with a BranchID.
-func BranchIDEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(BranchID))(params[0].(BranchID))
-}
-
 // BranchIDFromBytes unmarshals a BranchID from a sequence of bytes.
 func BranchIDFromBytes(bytes []byte) (branchID BranchID, consumedBytes int, err error) {
 	marshalUtil := marshalutil.New(bytes)
@@ -105,7 +107,7 @@ func (b BranchID) Bytes() []byte {
 
 // Base58 returns a base58 encoded version of the BranchID.
 func (b BranchID) Base58() string {
-	return base58.Encode(b.Bytes())
+	return branchIDBase58Cache.Get(b)
 }
 
 // String returns a human-readable version of the BranchID.
@@ -127,6 +129,11 @@ func (b BranchID) String() string {
 // branchIDAliases contains a list of aliases registered for a set of MessageIDs.
 var branchIDAliases = make(map[BranchID]string)
 
+// branchIDBase58Cache contains the base58 encodings of recently used BranchIDs.
+var branchIDBase58Cache = stringcache.New("BranchID", BranchIDBase58CacheSize, func(branchID BranchID) string {
+	return base58.Encode(branchID[:])
+})
+
 // RegisterBranchIDAlias registers an alias that will modify the String() output of the BranchID to show a human
 // readable string instead of the base58 encoded version of itself.
 func RegisterBranchIDAlias(branchID BranchID, alias string) {
@@ -259,11 +266,12 @@ func (b BranchIDs) Equals(o BranchIDs) bool {
 	return true
 }
 
-// Bytes returns a marshaled version of the BranchIDs.
+// Bytes returns a marshaled version of the BranchIDs. The BranchIDs are written in ascending order, so that the same
+// collection always results in the same bytes.
 func (b BranchIDs) Bytes() []byte {
 	marshalUtil := marshalutil.New(marshalutil.Uint64Size + len(b)*BranchIDLength)
 	marshalUtil.WriteUint64(uint64(len(b)))
-	for branchID := range b {
+	for _, branchID := range b.sorted() {
 		marshalUtil.WriteBytes(branchID.Bytes())
 	}
 
@@ -295,6 +303,16 @@ func (b BranchIDs) String() string {
 	return result
 }
 
+// sorted returns the BranchIDs as a slice in ascending order.
+func (b BranchIDs) sorted() (sortedBranchIDs []BranchID) {
+	sortedBranchIDs = b.Slice()
+	sort.Slice(sortedBranchIDs, func(i, j int) bool {
+		return bytes.Compare(sortedBranchIDs[i][:], sortedBranchIDs[j][:]) < 0
+	})
+
+	return sortedBranchIDs
+}
+
 // Clone creates a copy of the BranchIDs.
 func (b BranchIDs) Clone() (clonedBranchIDs BranchIDs) {
 	clonedBranchIDs = make(BranchIDs)
@@ -6,12 +6,12 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/cerrors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/objectstorage"
 	"github.com/iotaledger/hive.go/generics/set"
 	"github.com/iotaledger/hive.go/generics/walker"
 
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 // region BranchDAG ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -38,8 +38,9 @@ func NewBranchDAG(ledgerstate *Ledgerstate) (newBranchDAG *BranchDAG) {
 		conflictStorage:       objectstorage.New[*Conflict](ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixConflictStorage}), options.conflictStorageOptions...),
 		conflictMemberStorage: objectstorage.New[*ConflictMember](ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixConflictMemberStorage}), options.conflictMemberStorageOptions...),
 		Events: &BranchDAGEvents{
-			BranchCreated:        events.NewEvent(BranchIDEventHandler),
-			BranchParentsUpdated: events.NewEvent(branchParentUpdateEventCaller),
+			BranchCreated:        event.New[BranchID]("BranchDAG.BranchCreated"),
+			BranchRejected:       event.New[BranchID]("BranchDAG.BranchRejected"),
+			BranchParentsUpdated: event.New[*BranchParentUpdate]("BranchDAG.BranchParentsUpdated"),
 		},
 	}
 	newBranchDAG.init()
@@ -163,8 +164,35 @@ func (b *BranchDAG) ResolvePendingBranchIDs(branchIDs BranchIDs) (pendingBranchI
 	return
 }
 
-// SetBranchConfirmed sets the InclusionState of the given Branch to be Confirmed.
+// SetBranchConfirmed sets the InclusionState of the given Branch to be Confirmed. The Branches that are rejected as a
+// consequence are announced through the BranchRejected event.
 func (b *BranchDAG) SetBranchConfirmed(branchID BranchID) (modified bool) {
+	modified, rejectedBranchIDs := b.setBranchConfirmed(branchID, false)
+
+	// trigger the events after releasing the lock, so that handlers can query the InclusionState
+	for _, rejectedBranchID := range rejectedBranchIDs {
+		b.Events.BranchRejected.Trigger(rejectedBranchID)
+	}
+
+	return modified
+}
+
+// RepairBranchConfirmed sets the InclusionState of the given Branch to be Confirmed like SetBranchConfirmed, but it
+// does not stop at Branches that already have the expected InclusionState. It is used to repair the InclusionStates of
+// a confirmation that was only persisted partially (e.g. because the node crashed).
+func (b *BranchDAG) RepairBranchConfirmed(branchID BranchID) (modified bool) {
+	modified, rejectedBranchIDs := b.setBranchConfirmed(branchID, true)
+
+	for _, rejectedBranchID := range rejectedBranchIDs {
+		b.Events.BranchRejected.Trigger(rejectedBranchID)
+	}
+
+	return modified
+}
+
+// setBranchConfirmed sets the InclusionState of the given Branch to be Confirmed and returns the Branches that were
+// rejected as a consequence. If repair is set, it also walks through the Branches whose InclusionState did not change.
+func (b *BranchDAG) setBranchConfirmed(branchID BranchID, repair bool) (modified bool, rejectedBranchIDs []BranchID) {
 	b.inclusionStateMutex.Lock()
 	defer b.inclusionStateMutex.Unlock()
 
@@ -175,7 +203,7 @@ func (b *BranchDAG) SetBranchConfirmed(branchID BranchID) (modified bool) {
 		currentBranchID := confirmationWalker.Next()
 
 		b.Branch(currentBranchID).Consume(func(branch *Branch) {
-			if modified = branch.setInclusionState(Confirmed); !modified {
+			if modified = branch.setInclusionState(Confirmed); !modified && !repair {
 				return
 			}
 
@@ -195,9 +223,12 @@ func (b *BranchDAG) SetBranchConfirmed(branchID BranchID) (modified bool) {
 
 	for rejectedWalker.HasNext() {
 		b.Branch(rejectedWalker.Next()).Consume(func(branch *Branch) {
-			if modified = branch.setInclusionState(Rejected); !modified {
+			if modified = branch.setInclusionState(Rejected); !modified && !repair {
 				return
 			}
+			if modified {
+				rejectedBranchIDs = append(rejectedBranchIDs, branch.ID())
+			}
 
 			b.ChildBranches(branch.ID()).Consume(func(childBranch *ChildBranch) {
 				rejectedWalker.Push(childBranch.ChildBranchID())
@@ -205,7 +236,7 @@ func (b *BranchDAG) SetBranchConfirmed(branchID BranchID) (modified bool) {
 		})
 	}
 
-	return modified
+	return modified, rejectedBranchIDs
 }
 
 // InclusionState returns the InclusionState of the given BranchIDs.
@@ -450,10 +481,13 @@ func (b *BranchDAG) registerConflictMember(conflictID ConflictID, branchID Branc
 
 type BranchDAGEvents struct {
 	// BranchCreated gets triggered when a new Branch is created.
-	BranchCreated *events.Event
+	BranchCreated *event.Event[BranchID]
+
+	// BranchRejected gets triggered when a Branch is rejected because a conflicting Branch was confirmed.
+	BranchRejected *event.Event[BranchID]
 
 	// BranchParentsUpdated gets triggered whenever a Branch's parents are updated.
-	BranchParentsUpdated *events.Event
+	BranchParentsUpdated *event.Event[*BranchParentUpdate]
 }
 
 // BranchParentUpdate contains the new branch parents of a branch.
@@ -462,8 +496,4 @@ type BranchParentUpdate struct {
 	NewParents BranchIDs
 }
 
-func branchParentUpdateEventCaller(handler interface{}, params ...interface{}) {
-	handler.(func(branchParents *BranchParentUpdate))(params[0].(*BranchParentUpdate))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 func TestBranchDAG_RetrieveBranch(t *testing.T) {
@@ -117,7 +118,13 @@ func TestBranchDAG_SetBranchConfirmed(t *testing.T) {
 	branchIDs["Branch7"] = createBranch(t, ledgerstate, "Branch7", NewBranchIDs(MasterBranchID), NewConflictIDs(ConflictID{2}))
 	branchIDs["Branch8"] = createBranch(t, ledgerstate, "Branch8", NewBranchIDs(MasterBranchID), NewConflictIDs(ConflictID{2}))
 
+	rejectedBranchIDs := NewBranchIDs()
+	ledgerstate.BranchDAG.Events.BranchRejected.Attach(event.NewClosure(func(branchID BranchID) {
+		rejectedBranchIDs.Add(branchID)
+	}))
+
 	assert.True(t, ledgerstate.BranchDAG.SetBranchConfirmed(branchIDs["Branch4"]))
+	assert.Equal(t, NewBranchIDs(branchIDs["Branch3"], branchIDs["Branch5"]), rejectedBranchIDs)
 
 	assertInclusionStates(t, ledgerstate, branchIDs, map[string]InclusionState{
 		"Branch2":         Confirmed,
@@ -180,6 +187,43 @@ func TestBranchDAG_SetBranchConfirmed(t *testing.T) {
 	})
 }
 
+func TestBranchDAG_RepairBranchConfirmed(t *testing.T) {
+	ledgerstate := New(CacheTimeProvider(database.NewCacheTimeProvider(0)))
+	defer ledgerstate.Shutdown()
+
+	branchIDs := make(map[string]BranchID)
+	branchIDs["Branch2"] = createBranch(t, ledgerstate, "Branch2", NewBranchIDs(MasterBranchID), NewConflictIDs(ConflictID{0}))
+	branchIDs["Branch3"] = createBranch(t, ledgerstate, "Branch3", NewBranchIDs(MasterBranchID), NewConflictIDs(ConflictID{0}))
+	branchIDs["Branch4"] = createBranch(t, ledgerstate, "Branch4", NewBranchIDs(branchIDs["Branch2"]), NewConflictIDs(ConflictID{1}))
+	branchIDs["Branch5"] = createBranch(t, ledgerstate, "Branch5", NewBranchIDs(branchIDs["Branch2"]), NewConflictIDs(ConflictID{1}))
+
+	// only the InclusionState of the confirmed Branch was persisted before the crash
+	ledgerstate.BranchDAG.Branch(branchIDs["Branch4"]).Consume(func(branch *Branch) {
+		branch.setInclusionState(Confirmed)
+	})
+	assert.False(t, ledgerstate.BranchDAG.SetBranchConfirmed(branchIDs["Branch4"]))
+	assertInclusionStates(t, ledgerstate, branchIDs, map[string]InclusionState{
+		"Branch2": Pending,
+		"Branch3": Pending,
+		"Branch4": Confirmed,
+		"Branch5": Pending,
+	})
+
+	rejectedBranchIDs := NewBranchIDs()
+	ledgerstate.BranchDAG.Events.BranchRejected.Attach(event.NewClosure(func(branchID BranchID) {
+		rejectedBranchIDs.Add(branchID)
+	}))
+
+	ledgerstate.BranchDAG.RepairBranchConfirmed(branchIDs["Branch4"])
+	assert.Equal(t, NewBranchIDs(branchIDs["Branch3"], branchIDs["Branch5"]), rejectedBranchIDs)
+	assertInclusionStates(t, ledgerstate, branchIDs, map[string]InclusionState{
+		"Branch2": Confirmed,
+		"Branch3": Rejected,
+		"Branch4": Confirmed,
+		"Branch5": Rejected,
+	})
+}
+
 func TestArithmeticBranchIDs_Add(t *testing.T) {
 	branchID1 := BranchIDFromRandomness()
 	branchID2 := BranchIDFromRandomness()
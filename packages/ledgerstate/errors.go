@@ -10,4 +10,8 @@ var (
 
 	// ErrTransactionNotSolid is returned if a Transaction is processed whose Inputs are not known.
 	ErrTransactionNotSolid = errors.New("transaction not solid")
+
+	// ErrTransactionParked is returned if a Transaction is not booked because it would exceed the limit of conflicting
+	// consumers of one of its Inputs.
+	ErrTransactionParked = errors.New("transaction parked")
 )
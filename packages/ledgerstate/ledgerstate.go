@@ -4,6 +4,7 @@ import (
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/database"
 )
 
@@ -34,6 +35,7 @@ func (l *Ledgerstate) Configure(options ...Option) {
 		l.Options = &Options{
 			Store:              mapdb.NewMapDB(),
 			LazyBookingEnabled: true,
+			Clock:              clock.SyncedClock{},
 		}
 	}
 
@@ -58,9 +60,11 @@ type Option func(*Options)
 
 // Options is a container for all configurable parameters of the Ledgerstate.
 type Options struct {
-	Store              kvstore.KVStore
-	CacheTimeProvider  *database.CacheTimeProvider
-	LazyBookingEnabled bool
+	Store                   kvstore.KVStore
+	CacheTimeProvider       *database.CacheTimeProvider
+	LazyBookingEnabled      bool
+	MaxConflictingConsumers int
+	Clock                   clock.Clock
 }
 
 // Store is an Option for the Ledgerstate that allows to specify which storage layer is supposed to be used to persist
@@ -86,4 +90,21 @@ func LazyBookingEnabled(enabled bool) Option {
 	}
 }
 
+// MaxConflictingConsumers is an Option for the Ledgerstate that allows to limit the number of conflicting consumers of
+// an Output that are booked into their own Branches. Further conflicting Transactions are parked until the conflict is
+// resolved, so that an adversarial fan-out of conflicts can not explode the BranchDAG.
+func MaxConflictingConsumers(maxConflictingConsumers int) Option {
+	return func(options *Options) {
+		options.MaxConflictingConsumers = maxConflictingConsumers
+	}
+}
+
+// Clock is an Option for the Ledgerstate that allows to replace the synchronized time of the node, which is used to
+// record when Transactions and Outputs became solid or reached their grade of finality.
+func Clock(clock clock.Clock) Option {
+	return func(options *Options) {
+		options.Clock = clock
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -38,6 +38,9 @@ const (
 
 	// PrefixAddressOutputMappingStorage defines the storage prefix for the AddressOutputMapping object storage.
 	PrefixAddressOutputMappingStorage
+
+	// PrefixParkedTransactionStorage defines the storage prefix for the parked Transactions.
+	PrefixParkedTransactionStorage
 )
 
 // block of default cache time.
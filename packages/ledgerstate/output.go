@@ -21,7 +21,6 @@ import (
 	"github.com/mr-tron/base58"
 	"golang.org/x/crypto/blake2b"
 
-	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 )
 
@@ -2366,9 +2365,9 @@ func (o *OutputMetadata) Solid() bool {
 	return o.solid
 }
 
-// SetSolid updates the solid flag of the Output. It returns true if the solid flag was modified and updates the
-// solidification time if the Output was marked as solid.
-func (o *OutputMetadata) SetSolid(solid bool) (modified bool) {
+// SetSolid updates the solid flag of the Output. It returns true if the solid flag was modified and sets the
+// solidification time to the given time if the Output was marked as solid.
+func (o *OutputMetadata) SetSolid(solid bool, solidificationTime time.Time) (modified bool) {
 	o.solidMutex.Lock()
 	defer o.solidMutex.Unlock()
 
@@ -2378,7 +2377,7 @@ func (o *OutputMetadata) SetSolid(solid bool) (modified bool) {
 
 	if solid {
 		o.solidificationTimeMutex.Lock()
-		o.solidificationTime = time.Now()
+		o.solidificationTime = solidificationTime
 		o.solidificationTimeMutex.Unlock()
 	}
 
@@ -2424,8 +2423,9 @@ func (o *OutputMetadata) GradeOfFinality() gof.GradeOfFinality {
 	return o.gradeOfFinality
 }
 
-// SetGradeOfFinality updates the grade of finality. It returns true if it was modified.
-func (o *OutputMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality) (modified bool) {
+// SetGradeOfFinality updates the grade of finality, which was reached at the given time. It returns true if it was
+// modified.
+func (o *OutputMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality, gradeOfFinalityTime time.Time) (modified bool) {
 	o.gradeOfFinalityMutex.Lock()
 	defer o.gradeOfFinalityMutex.Unlock()
 
@@ -2434,7 +2434,7 @@ func (o *OutputMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality)
 	}
 
 	o.gradeOfFinality = gradeOfFinality
-	o.gradeOfFinalityTime = clock.SyncedTime()
+	o.gradeOfFinalityTime = gradeOfFinalityTime
 	o.SetModified()
 	modified = true
 	return
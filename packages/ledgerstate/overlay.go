@@ -0,0 +1,182 @@
+package ledgerstate
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/types"
+)
+
+// ErrTransactionExists is returned if a Transaction is applied to an Overlay that already contains it.
+var ErrTransactionExists = errors.New("transaction already exists")
+
+// region Overlay //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Overlay is a temporary, in-memory view on top of the ledger state that allows to apply hypothetical Transactions and
+// to query the resulting Outputs and balances without persisting anything. Reads that are not answered by the
+// Transactions of the Overlay fall through to the object storage of the ledger state, so the Overlay always reflects
+// the current ledger state. An Overlay is not safe for concurrent use.
+type Overlay struct {
+	ledgerstate      *Ledgerstate
+	transactions     map[TransactionID]*Transaction
+	outputs          map[OutputID]Output
+	consumers        map[OutputID]TransactionID
+	outputsByAddress map[[AddressLength]byte][]OutputID
+}
+
+// NewOverlay creates an empty Overlay on top of the given ledger state.
+func NewOverlay(ledgerstate *Ledgerstate) *Overlay {
+	return &Overlay{
+		ledgerstate:      ledgerstate,
+		transactions:     make(map[TransactionID]*Transaction),
+		outputs:          make(map[OutputID]Output),
+		consumers:        make(map[OutputID]TransactionID),
+		outputsByAddress: make(map[[AddressLength]byte][]OutputID),
+	}
+}
+
+// ApplyTransaction validates the given Transaction against the Overlay and applies it if it is valid. Transactions may
+// consume the Outputs of the ledger state and of the Transactions that were applied before. It returns the
+// Transactions of the ledger state that consume the same Outputs, i.e. the Transactions that the given Transaction
+// would conflict with if it was issued.
+func (o *Overlay) ApplyTransaction(transaction *Transaction) (conflictingTransactions TransactionIDs, err error) {
+	transactionID := transaction.ID()
+	if _, exists := o.transactions[transactionID]; exists || o.ledgerstate.CachedTransaction(transactionID).Consume(func(*Transaction) {}) {
+		return nil, errors.Errorf("transaction %s can not be applied twice: %w", transactionID, ErrTransactionExists)
+	}
+
+	consumedOutputs := make(Outputs, len(transaction.Essence().Inputs()))
+	for i, input := range transaction.Essence().Inputs() {
+		outputID := input.(*UTXOInput).ReferencedOutputID()
+		if consumerID, spent := o.consumers[outputID]; spent {
+			return nil, errors.Errorf("output %s is already spent by transaction %s of the overlay: %w", outputID.Base58(), consumerID.Base58(), ErrTransactionInvalid)
+		}
+		consumedOutputs[i], _ = o.Output(outputID)
+	}
+
+	if err = ValidateTransaction(transaction, consumedOutputs); err != nil {
+		return nil, err
+	}
+
+	conflictingTransactions = make(TransactionIDs)
+	for _, consumedOutput := range consumedOutputs {
+		if _, overlayOutput := o.outputs[consumedOutput.ID()]; overlayOutput {
+			continue
+		}
+
+		o.ledgerstate.CachedConsumers(consumedOutput.ID()).Consume(func(consumer *Consumer) {
+			conflictingTransactions[consumer.TransactionID()] = types.Void
+		})
+	}
+
+	o.transactions[transactionID] = transaction
+	for _, consumedOutput := range consumedOutputs {
+		o.consumers[consumedOutput.ID()] = transactionID
+	}
+	for _, output := range transaction.Essence().Outputs() {
+		output = output.UpdateMintingColor()
+		o.outputs[output.ID()] = output
+		for _, address := range outputAddresses(output) {
+			o.outputsByAddress[address.Array()] = append(o.outputsByAddress[address.Array()], output.ID())
+		}
+	}
+
+	return conflictingTransactions, nil
+}
+
+// Output returns the Output with the given OutputID from the Overlay or from the ledger state.
+func (o *Overlay) Output(outputID OutputID) (output Output, exists bool) {
+	if output, exists = o.outputs[outputID]; exists {
+		return output, true
+	}
+
+	o.ledgerstate.CachedOutput(outputID).Consume(func(ledgerOutput Output) {
+		output, exists = ledgerOutput, true
+	})
+
+	return output, exists
+}
+
+// IsSpent returns true if the Output with the given OutputID is consumed by a Transaction of the Overlay or of the
+// ledger state.
+func (o *Overlay) IsSpent(outputID OutputID) (spent bool) {
+	if _, spent = o.consumers[outputID]; spent {
+		return true
+	}
+	if _, overlayOutput := o.outputs[outputID]; overlayOutput {
+		return false
+	}
+
+	o.ledgerstate.CachedOutputMetadata(outputID).Consume(func(outputMetadata *OutputMetadata) {
+		spent = outputMetadata.ConsumerCount() != 0
+	})
+
+	return spent
+}
+
+// UnspentOutputs returns the Outputs of the given Address that are unspent after applying the Transactions of the
+// Overlay.
+func (o *Overlay) UnspentOutputs(address Address) (unspentOutputs Outputs) {
+	unspentOutputs = make(Outputs, 0)
+
+	o.ledgerstate.CachedAddressOutputMapping(address).Consume(func(addressOutputMapping *AddressOutputMapping) {
+		if o.IsSpent(addressOutputMapping.OutputID()) {
+			return
+		}
+		if output, exists := o.Output(addressOutputMapping.OutputID()); exists {
+			unspentOutputs = append(unspentOutputs, output)
+		}
+	})
+
+	for _, outputID := range o.outputsByAddress[address.Array()] {
+		if !o.IsSpent(outputID) {
+			unspentOutputs = append(unspentOutputs, o.outputs[outputID])
+		}
+	}
+
+	return unspentOutputs
+}
+
+// Balances returns the balances of the unspent Outputs of the given Address after applying the Transactions of the
+// Overlay.
+func (o *Overlay) Balances(address Address) (balances map[Color]uint64) {
+	balances = make(map[Color]uint64)
+	for _, output := range o.UnspentOutputs(address) {
+		output.Balances().ForEach(func(color Color, balance uint64) bool {
+			balances[color] += balance
+			return true
+		})
+	}
+
+	return balances
+}
+
+// Transaction returns the Transaction with the given TransactionID if it was applied to the Overlay.
+func (o *Overlay) Transaction(transactionID TransactionID) (transaction *Transaction, exists bool) {
+	transaction, exists = o.transactions[transactionID]
+	return transaction, exists
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// outputAddresses returns the Addresses that the given Output is associated with.
+func outputAddresses(output Output) (addresses []Address) {
+	switch output.Type() {
+	case AliasOutputType:
+		castedOutput := output.(*AliasOutput)
+		// if it is an origin alias output, we don't have the AliasAddress from the parsed bytes.
+		// that happens in utxodag output booking, so we calculate the alias address here
+		addresses = append(addresses, castedOutput.GetAliasAddress(), castedOutput.GetStateAddress())
+		if !castedOutput.IsSelfGoverned() {
+			addresses = append(addresses, castedOutput.GetGoverningAddress())
+		}
+	case ExtendedLockedOutputType:
+		castedOutput := output.(*ExtendedLockedOutput)
+		if castedOutput.FallbackAddress() != nil {
+			addresses = append(addresses, castedOutput.FallbackAddress())
+		}
+		addresses = append(addresses, output.Address())
+	default:
+		addresses = append(addresses, output.Address())
+	}
+
+	return addresses
+}
@@ -0,0 +1,75 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlay(t *testing.T) {
+	ledgerstate := setupDependencies(t)
+	defer ledgerstate.Shutdown()
+
+	wallets := createWallets(3)
+	a, b, c := wallets[0], wallets[1], wallets[2]
+	outputs := []*SigLockedSingleOutput{
+		generateOutput(ledgerstate, a.address, 0),
+		generateOutput(ledgerstate, a.address, 1),
+	}
+	for _, output := range outputs {
+		ledgerstate.ManageStoreAddressOutputMapping(output)
+	}
+
+	bookedTransaction := buildTransaction(ledgerstate, a, b, outputs[1:])
+	_, err := ledgerstate.BookTransaction(bookedTransaction)
+	require.NoError(t, err)
+	ledgerstate.ManageStoreAddressOutputMapping(bookedTransaction.Essence().Outputs()[0])
+
+	overlay := NewOverlay(ledgerstate)
+
+	transaction1 := buildTransaction(ledgerstate, a, b, outputs[:1])
+	conflicts, err := overlay.ApplyTransaction(transaction1)
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+	assert.Empty(t, overlay.Balances(a.address))
+	assert.Equal(t, map[Color]uint64{ColorIOTA: 200}, overlay.Balances(b.address))
+
+	// transactions can spend the outputs of previously applied transactions
+	transaction2 := buildTransaction(ledgerstate, b, a, []*SigLockedSingleOutput{transaction1.Essence().Outputs()[0].(*SigLockedSingleOutput)})
+	_, err = overlay.ApplyTransaction(transaction2)
+	require.NoError(t, err)
+	assert.Equal(t, map[Color]uint64{ColorIOTA: 100}, overlay.Balances(a.address))
+	assert.Equal(t, map[Color]uint64{ColorIOTA: 100}, overlay.Balances(b.address))
+	assert.True(t, overlay.IsSpent(transaction1.Essence().Outputs()[0].ID()))
+
+	// outputs can only be spent once within the overlay
+	_, err = overlay.ApplyTransaction(buildTransaction(ledgerstate, a, c, outputs[:1]))
+	assert.ErrorIs(t, err, ErrTransactionInvalid)
+
+	// spending outputs that are consumed in the ledger state reports the conflicts
+	transaction3 := buildTransaction(ledgerstate, a, c, outputs[1:])
+	conflicts, err = overlay.ApplyTransaction(transaction3)
+	require.NoError(t, err)
+	assert.Equal(t, TransactionIDs{bookedTransaction.ID(): types.Void}, conflicts)
+	assert.Equal(t, map[Color]uint64{ColorIOTA: 100}, overlay.Balances(c.address))
+
+	_, err = overlay.ApplyTransaction(transaction1)
+	assert.ErrorIs(t, err, ErrTransactionExists)
+	_, err = overlay.ApplyTransaction(bookedTransaction)
+	assert.ErrorIs(t, err, ErrTransactionExists)
+
+	unknownOutput := NewSigLockedSingleOutput(100, a.address)
+	unknownOutput.SetID(NewOutputID(GenesisTransactionID, 2))
+	_, err = overlay.ApplyTransaction(buildTransaction(ledgerstate, a, b, []*SigLockedSingleOutput{unknownOutput}))
+	assert.ErrorIs(t, err, ErrTransactionNotSolid)
+
+	// the ledger state is not modified
+	assert.False(t, ledgerstate.CachedTransaction(transaction1.ID()).Consume(func(*Transaction) {}))
+	assert.False(t, ledgerstate.CachedOutput(transaction1.Essence().Outputs()[0].ID()).Consume(func(Output) {}))
+	ledgerstate.CachedOutputMetadata(outputs[0].ID()).Consume(func(outputMetadata *OutputMetadata) {
+		assert.Equal(t, 0, outputMetadata.ConsumerCount())
+	})
+	assert.Empty(t, NewOverlay(ledgerstate).Balances(c.address))
+}
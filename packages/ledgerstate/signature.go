@@ -2,6 +2,7 @@ package ledgerstate
 
 import (
 	"bytes"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/byteutils"
@@ -124,6 +125,10 @@ func SignatureFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (signature S
 type ED25519Signature struct {
 	PublicKey ed25519.PublicKey
 	Signature ed25519.Signature
+
+	// verifiedData is the data that the Signature was already verified to sign by a BatchVerifier.
+	verifiedData      []byte
+	verifiedDataMutex sync.RWMutex
 }
 
 // NewED25519Signature is the constructor of an ED25519Signature.
@@ -193,6 +198,10 @@ func (e *ED25519Signature) Type() SignatureType {
 
 // SignatureValid returns true if the Signature signs the given data.
 func (e *ED25519Signature) SignatureValid(data []byte) bool {
+	if e.isVerified(data) {
+		return true
+	}
+
 	return e.PublicKey.VerifySignature(data, e.Signature)
 }
 
@@ -210,6 +219,22 @@ func (e *ED25519Signature) AddressSignatureValid(address Address, data []byte) b
 	return e.SignatureValid(data)
 }
 
+// markVerified remembers that the Signature was verified to sign the given data.
+func (e *ED25519Signature) markVerified(data []byte) {
+	e.verifiedDataMutex.Lock()
+	defer e.verifiedDataMutex.Unlock()
+
+	e.verifiedData = data
+}
+
+// isVerified returns true if the Signature was already verified to sign the given data.
+func (e *ED25519Signature) isVerified(data []byte) bool {
+	e.verifiedDataMutex.RLock()
+	defer e.verifiedDataMutex.RUnlock()
+
+	return e.verifiedData != nil && bytes.Equal(e.verifiedData, data)
+}
+
 // Bytes returns a marshaled version of the Signature.
 func (e *ED25519Signature) Bytes() []byte {
 	return byteutils.ConcatBytes([]byte{byte(ED25519SignatureType)}, e.PublicKey.Bytes(), e.Signature.Bytes())
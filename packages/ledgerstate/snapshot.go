@@ -1,9 +1,11 @@
 package ledgerstate
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
+	"sort"
 	"time"
 
 	"github.com/iotaledger/hive.go/identity"
@@ -29,14 +31,16 @@ type Record struct {
 	UnspentOutputs []bool
 }
 
-// WriteTo writes the snapshot data to the given writer.
+// WriteTo writes the snapshot data to the given writer. The entries are written in the order of their IDs, so that the
+// same snapshot always results in the same bytes.
 func (s *Snapshot) WriteTo(writer io.Writer) (int64, error) {
 	var bytesWritten int64
 	if err := binary.Write(writer, binary.LittleEndian, uint32(len(s.Transactions))); err != nil {
 		return 0, fmt.Errorf("unable to write transactions count: %w", err)
 	}
 	bytesWritten += 4
-	for transactionID, record := range s.Transactions {
+	for _, transactionID := range s.sortedTransactionIDs() {
+		record := s.Transactions[transactionID]
 		if err := binary.Write(writer, binary.LittleEndian, uint32(len(record.Essence.Bytes()))); err != nil {
 			return 0, fmt.Errorf("unable to write length of transaction with %s: %w", transactionID, err)
 		}
@@ -81,7 +85,8 @@ func (s *Snapshot) WriteTo(writer io.Writer) (int64, error) {
 		return 0, fmt.Errorf("unable to write AccessMana count: %w", err)
 	}
 	bytesWritten += 4
-	for nodeID, accessMana := range s.AccessManaByNode {
+	for _, nodeID := range s.sortedNodeIDs() {
+		accessMana := s.AccessManaByNode[nodeID]
 		if err := binary.Write(writer, binary.LittleEndian, nodeID.Bytes()); err != nil {
 			return 0, fmt.Errorf("unable to write nodeID with %s: %w", nodeID, err)
 		}
@@ -99,15 +104,57 @@ func (s *Snapshot) WriteTo(writer io.Writer) (int64, error) {
 	return bytesWritten, nil
 }
 
+// sortedTransactionIDs returns the IDs of the transactions of the snapshot in ascending order.
+func (s *Snapshot) sortedTransactionIDs() (transactionIDs []TransactionID) {
+	transactionIDs = make([]TransactionID, 0, len(s.Transactions))
+	for transactionID := range s.Transactions {
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+	sort.Slice(transactionIDs, func(i, j int) bool {
+		return bytes.Compare(transactionIDs[i][:], transactionIDs[j][:]) < 0
+	})
+
+	return transactionIDs
+}
+
+// sortedNodeIDs returns the IDs of the nodes of the access mana snapshot in ascending order.
+func (s *Snapshot) sortedNodeIDs() (nodeIDs []identity.ID) {
+	nodeIDs = make([]identity.ID, 0, len(s.AccessManaByNode))
+	for nodeID := range s.AccessManaByNode {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	sort.Slice(nodeIDs, func(i, j int) bool {
+		return bytes.Compare(nodeIDs[i][:], nodeIDs[j][:]) < 0
+	})
+
+	return nodeIDs
+}
+
+// SnapshotReadProgress is called while a Snapshot is read with the number of entries that were read so far.
+type SnapshotReadProgress func(transactions, unspentOutputs, accessManaEntries int)
+
 // ReadFrom reads the snapshot bytes from the given reader.
 // This function overrides existing content of the snapshot.
 func (s *Snapshot) ReadFrom(reader io.Reader) (int64, error) {
-	bytesTransactions, err := s.readTransactions(reader)
+	return s.ReadFromWithProgress(reader, nil)
+}
+
+// ReadFromWithProgress reads the snapshot bytes from the given reader and calls the (optional) progress callback after
+// every transaction and access mana entry that was read.
+// This function overrides existing content of the snapshot.
+func (s *Snapshot) ReadFromWithProgress(reader io.Reader, progress SnapshotReadProgress) (int64, error) {
+	if progress == nil {
+		progress = func(int, int, int) {}
+	}
+
+	bytesTransactions, unspentOutputs, err := s.readTransactions(reader, progress)
 	if err != nil {
 		return bytesTransactions, err
 	}
 
-	bytesAccessMana, err := s.readAccessMana(reader)
+	bytesAccessMana, err := s.readAccessMana(reader, func(accessManaEntries int) {
+		progress(len(s.Transactions), unspentOutputs, accessManaEntries)
+	})
 	if err != nil {
 		return bytesAccessMana, err
 	}
@@ -115,73 +162,77 @@ func (s *Snapshot) ReadFrom(reader io.Reader) (int64, error) {
 	return bytesTransactions + bytesAccessMana, nil
 }
 
-// readTransactions reads the transactions from the snapshot.
-func (s *Snapshot) readTransactions(reader io.Reader) (int64, error) {
+// readTransactions reads the transactions from the snapshot and returns the number of unspent outputs they contain.
+func (s *Snapshot) readTransactions(reader io.Reader, progress SnapshotReadProgress) (int64, int, error) {
 	s.Transactions = make(map[TransactionID]Record)
 	var bytesRead int64
 	var transactionCount uint32
+	var unspentOutputCount int
 
 	// read Transactions
 	if err := binary.Read(reader, binary.LittleEndian, &transactionCount); err != nil {
-		return 0, fmt.Errorf("unable to read transaction count: %w", err)
+		return 0, 0, fmt.Errorf("unable to read transaction count: %w", err)
 	}
 	bytesRead += 4
 
 	for i := 0; i < int(transactionCount); i++ {
 		var transactionLength uint32
 		if err := binary.Read(reader, binary.LittleEndian, &transactionLength); err != nil {
-			return 0, fmt.Errorf("unable to read length of transaction at index %d: %w", i, err)
+			return 0, 0, fmt.Errorf("unable to read length of transaction at index %d: %w", i, err)
 		}
 		bytesRead += 4
 
 		transactionIDBytes := make([]byte, TransactionIDLength)
 		if err := binary.Read(reader, binary.LittleEndian, &transactionIDBytes); err != nil {
-			return 0, fmt.Errorf("unable to read transactionID: %w", err)
+			return 0, 0, fmt.Errorf("unable to read transactionID: %w", err)
 		}
 
 		txID, n, e := TransactionIDFromBytes(transactionIDBytes)
 		if e != nil {
-			return 0, fmt.Errorf("unable to parse transactionID at index %d: %w", i, e)
+			return 0, 0, fmt.Errorf("unable to parse transactionID at index %d: %w", i, e)
 		}
 		bytesRead += int64(n)
 
 		transactionBytes := make([]byte, transactionLength)
 		if err := binary.Read(reader, binary.LittleEndian, &transactionBytes); err != nil {
-			return 0, fmt.Errorf("unable to read transaction at index %d: %w", i, err)
+			return 0, 0, fmt.Errorf("unable to read transaction at index %d: %w", i, err)
 		}
 
 		txEssence, n, err := TransactionEssenceFromBytes(transactionBytes)
 		if err != nil {
-			return 0, fmt.Errorf("unable to parse transaction at index %d: %w", i, err)
+			return 0, 0, fmt.Errorf("unable to parse transaction at index %d: %w", i, err)
 		}
 		bytesRead += int64(n)
 
 		var unlockBlockLength uint32
 		if err = binary.Read(reader, binary.LittleEndian, &unlockBlockLength); err != nil {
-			return 0, fmt.Errorf("unable to read length of unlockBlocks at index %d: %w", i, err)
+			return 0, 0, fmt.Errorf("unable to read length of unlockBlocks at index %d: %w", i, err)
 		}
 		bytesRead += 4
 
 		unlockBlockBytes := make([]byte, unlockBlockLength)
 		if err = binary.Read(reader, binary.LittleEndian, &unlockBlockBytes); err != nil {
-			return 0, fmt.Errorf("unable to read transactionID: %w", err)
+			return 0, 0, fmt.Errorf("unable to read transactionID: %w", err)
 		}
 		unlockBlocks, n, err := UnlockBlocksFromBytes(unlockBlockBytes)
 		if err != nil {
-			return 0, fmt.Errorf("unable to parse unlockblocks at index %d: %w", i, err)
+			return 0, 0, fmt.Errorf("unable to parse unlockblocks at index %d: %w", i, err)
 		}
 		bytesRead += int64(n)
 
 		var unspentOutputsLength uint32
 		if err := binary.Read(reader, binary.LittleEndian, &unspentOutputsLength); err != nil {
-			return 0, fmt.Errorf("unable to read unspent outputs length at index %d: %w", i, err)
+			return 0, 0, fmt.Errorf("unable to read unspent outputs length at index %d: %w", i, err)
 		}
 		bytesRead += 4
 
 		unspentOutputs := make([]bool, unspentOutputsLength)
 		for j := 0; j < int(unspentOutputsLength); j++ {
 			if err := binary.Read(reader, binary.LittleEndian, &unspentOutputs[j]); err != nil {
-				return 0, fmt.Errorf("unable to read unspent output at index %d: %w", j, err)
+				return 0, 0, fmt.Errorf("unable to read unspent output at index %d: %w", j, err)
+			}
+			if unspentOutputs[j] {
+				unspentOutputCount++
 			}
 		}
 
@@ -192,13 +243,14 @@ func (s *Snapshot) readTransactions(reader io.Reader) (int64, error) {
 			UnlockBlocks:   unlockBlocks,
 			UnspentOutputs: unspentOutputs,
 		}
+		progress(len(s.Transactions), unspentOutputCount, 0)
 	}
 
-	return bytesRead, nil
+	return bytesRead, unspentOutputCount, nil
 }
 
 // readAccessMana reads the access mana from the snapshot.
-func (s *Snapshot) readAccessMana(reader io.Reader) (int64, error) {
+func (s *Snapshot) readAccessMana(reader io.Reader, progress func(accessManaEntries int)) (int64, error) {
 	s.AccessManaByNode = make(map[identity.ID]AccessMana)
 	var bytesRead int64
 	var accessManaCount uint32
@@ -237,6 +289,7 @@ func (s *Snapshot) readAccessMana(reader io.Reader) (int64, error) {
 			Value:     accessMana,
 			Timestamp: timestamp,
 		}
+		progress(len(s.AccessManaByNode))
 	}
 
 	return bytesRead, nil
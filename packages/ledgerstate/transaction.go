@@ -2,6 +2,7 @@ package ledgerstate
 
 import (
 	"crypto/rand"
+	"encoding/binary"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,10 +19,13 @@ import (
 	"github.com/iotaledger/hive.go/types"
 	"github.com/iotaledger/hive.go/typeutils"
 	"github.com/mr-tron/base58"
+	"go.uber.org/atomic"
 	"golang.org/x/crypto/blake2b"
 
-	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/batchverifier"
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/pool"
+	"github.com/iotaledger/goshimmer/packages/stringcache"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 )
 
@@ -52,6 +56,9 @@ func init() {
 // TransactionIDLength contains the amount of bytes that a marshaled version of the ID contains.
 const TransactionIDLength = 32
 
+// TransactionIDBase58CacheSize contains the number of base58 encoded TransactionIDs that are cached.
+const TransactionIDBase58CacheSize = 10000
+
 // TransactionID is the type that represents the identifier of a Transaction.
 type TransactionID [TransactionIDLength]byte
 
@@ -112,7 +119,7 @@ func (i TransactionID) Bytes() []byte {
 
 // Base58 returns a base58 encoded version of the TransactionID.
 func (i TransactionID) Base58() string {
-	return base58.Encode(i[:])
+	return transactionIDBase58Cache.Get(i)
 }
 
 // String creates a human readable version of the TransactionID.
@@ -120,6 +127,11 @@ func (i TransactionID) String() string {
 	return "TransactionID(" + i.Base58() + ")"
 }
 
+// transactionIDBase58Cache contains the base58 encodings of recently used TransactionIDs.
+var transactionIDBase58Cache = stringcache.New("TransactionID", TransactionIDBase58CacheSize, func(transactionID TransactionID) string {
+	return base58.Encode(transactionID[:])
+})
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region TransactionIDs ///////////////////////////////////////////////////////////////////////////////////////////////
@@ -156,6 +168,14 @@ func (t TransactionIDs) Base58s() (transactionIDs []string) {
 
 // region Transaction //////////////////////////////////////////////////////////////////////////////////////////////////
 
+// transactionBytesPool contains the buffers that are used to marshal Transactions for the calculation of their IDs.
+var transactionBytesPool = pool.New(func() *[]byte {
+	buffer := make([]byte, 0, 1024)
+	return &buffer
+}, func(buffer *[]byte) {
+	*buffer = (*buffer)[:0]
+})
+
 // Transaction represents a payload that executes a value transfer in the ledger state.
 type Transaction struct {
 	id           *TransactionID
@@ -273,40 +293,13 @@ func (t *Transaction) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (tra
 		return
 	}
 
-	maxReferencedUnlockIndex := len(transaction.essence.Inputs()) - 1
-	for i, unlockBlock := range transaction.unlockBlocks {
-		switch unlockBlock.Type() {
-		case SignatureUnlockBlockType:
-			continue
-		case ReferenceUnlockBlockType:
-			if unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex() > uint16(maxReferencedUnlockIndex) {
-				err = errors.Errorf("unlock block %d references non-existent unlock block at index %d", i, unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex())
-				return
-			}
-		case AliasUnlockBlockType:
-			if unlockBlock.(*AliasUnlockBlock).AliasInputIndex() > uint16(maxReferencedUnlockIndex) {
-				err = errors.Errorf("unlock block %d references non-existent chain input at index %d", i, unlockBlock.(*AliasUnlockBlock).AliasInputIndex())
-				return
-			}
-		}
-	}
-
 	for i, output := range transaction.essence.Outputs() {
 		output.SetID(NewOutputID(transaction.ID(), uint16(i)))
-		// check if an alias output is deadlocked to itself
-		// for origin alias outputs, alias address is only known once the ID of the output is set
-		if output.Type() == AliasOutputType {
-			alias := output.(*AliasOutput)
-			aliasAddress := alias.GetAliasAddress()
-			if alias.GetStateAddress().Equals(aliasAddress) {
-				err = errors.Errorf("state address of alias output at index %d (id: %s) cannot be its own alias address", i, alias.ID().Base58())
-				return
-			}
-			if alias.GetGoverningAddress().Equals(aliasAddress) {
-				err = errors.Errorf("governing address of alias output at index %d (id: %s) cannot be its own alias address", i, alias.ID().Base58())
-				return
-			}
-		}
+	}
+
+	if err = checkTransactionStructure(transaction, nil); err != nil {
+		err = errors.Errorf("failed to parse Transaction: %w", err)
+		return
 	}
 
 	return
@@ -330,7 +323,11 @@ func (t *Transaction) ID() TransactionID {
 		return *t.id
 	}
 
-	idBytes := blake2b.Sum256(t.Bytes())
+	buffer := transactionBytesPool.Get()
+	defer transactionBytesPool.Put(buffer)
+
+	*buffer = t.appendBytes(*buffer)
+	idBytes := blake2b.Sum256(*buffer)
 	id, _, err := TransactionIDFromBytes(idBytes[:])
 	if err != nil {
 		panic(err)
@@ -355,6 +352,42 @@ func (t *Transaction) UnlockBlocks() UnlockBlocks {
 	return t.unlockBlocks
 }
 
+// VerifyUnlockSignatures verifies the ED25519 signatures of the SignatureUnlockBlocks with the given BatchVerifier and
+// calls the callback once all of them were verified. Valid signatures are remembered, so that they are not verified
+// again when the UnlockBlocks are validated against the consumed Outputs.
+func (t *Transaction) VerifyUnlockSignatures(verifier *batchverifier.BatchVerifier, callback func(valid bool)) {
+	signatures := make([]*ED25519Signature, 0, len(t.unlockBlocks))
+	for _, unlockBlock := range t.unlockBlocks {
+		if signatureUnlockBlock, isSignatureUnlockBlock := unlockBlock.(*SignatureUnlockBlock); isSignatureUnlockBlock {
+			if signature, isED25519Signature := signatureUnlockBlock.Signature().(*ED25519Signature); isED25519Signature {
+				signatures = append(signatures, signature)
+			}
+		}
+	}
+	if len(signatures) == 0 {
+		callback(true)
+		return
+	}
+
+	essenceBytes := t.essence.Bytes()
+	pendingSignatures := atomic.NewInt32(int32(len(signatures)))
+	invalidSignatureFound := atomic.NewBool(false)
+	for _, signature := range signatures {
+		signature := signature
+		verifier.Verify(signature.PublicKey, essenceBytes, signature.Signature, func(valid bool) {
+			if valid {
+				signature.markVerified(essenceBytes)
+			} else {
+				invalidSignatureFound.Store(true)
+			}
+
+			if pendingSignatures.Dec() == 0 {
+				callback(!invalidSignatureFound.Load())
+			}
+		})
+	}
+}
+
 // ReferencedTransactionIDs returns a set of TransactionIDs whose Outputs were used as Inputs in this Transaction.
 func (t *Transaction) ReferencedTransactionIDs() (referencedTransactionIDs TransactionIDs) {
 	referencedTransactionIDs = make(TransactionIDs)
@@ -372,13 +405,25 @@ func (t *Transaction) Bytes() []byte {
 		return marshalutil.New(marshalutil.Uint32Size).WriteUint32(0).Bytes()
 	}
 
-	payloadBytes := byteutils.ConcatBytes(TransactionType.Bytes(), t.essence.Bytes(), t.unlockBlocks.Bytes())
-	payloadBytesLength := len(payloadBytes)
+	return t.appendBytes(nil)
+}
 
-	return marshalutil.New(marshalutil.Uint32Size + payloadBytesLength).
-		WriteUint32(uint32(payloadBytesLength)).
-		WriteBytes(payloadBytes).
-		Bytes()
+// appendBytes appends the length prefixed marshaled version of the Transaction to the given buffer.
+func (t *Transaction) appendBytes(buffer []byte) []byte {
+	typeBytes := TransactionType.Bytes()
+	essenceBytes := t.essence.Bytes()
+	unlockBlocksBytes := t.unlockBlocks.Bytes()
+	payloadBytesLength := len(typeBytes) + len(essenceBytes) + len(unlockBlocksBytes)
+
+	if buffer == nil {
+		buffer = make([]byte, 0, marshalutil.Uint32Size+payloadBytesLength)
+	}
+	buffer = append(buffer, make([]byte, marshalutil.Uint32Size)...)
+	binary.LittleEndian.PutUint32(buffer[len(buffer)-marshalutil.Uint32Size:], uint32(payloadBytesLength))
+	buffer = append(buffer, typeBytes...)
+	buffer = append(buffer, essenceBytes...)
+
+	return append(buffer, unlockBlocksBytes...)
 }
 
 // String returns a human readable version of the Transaction.
@@ -424,6 +469,10 @@ type TransactionEssence struct {
 	inputs            Inputs
 	outputs           Outputs
 	payload           payload.Payload
+	// bytes caches the marshaled version of parsed essences, whose content does not change anymore.
+	bytes      []byte
+	parsed     bool
+	bytesMutex sync.Mutex
 }
 
 // NewTransactionEssence creates a new TransactionEssence from the given details.
@@ -497,13 +546,18 @@ func TransactionEssenceFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (tr
 		err = errors.Errorf("failed to parse Payload from MarshalUtil: %w", err)
 		return
 	}
+	transactionEssence.parsed = true
 
 	return
 }
 
 // SetPayload set the optional Payload of the TransactionEssence.
 func (t *TransactionEssence) SetPayload(p payload.Payload) {
+	t.bytesMutex.Lock()
+	defer t.bytesMutex.Unlock()
+
 	t.payload = p
+	t.bytes = nil
 }
 
 // Version returns the Version of the TransactionEssence.
@@ -542,7 +596,28 @@ func (t *TransactionEssence) Payload() payload.Payload {
 }
 
 // Bytes returns a marshaled version of the TransactionEssence.
+//
+// The bytes of parsed essences are only marshaled once, as they are needed for the verification of every UnlockBlock.
+// The returned slice must not be modified.
 func (t *TransactionEssence) Bytes() []byte {
+	t.bytesMutex.Lock()
+	defer t.bytesMutex.Unlock()
+
+	if t.bytes != nil {
+		return t.bytes
+	}
+
+	bytes := t.marshal()
+	if t.parsed {
+		// limit the capacity, so that appending to the cached bytes copies them
+		t.bytes = bytes[:len(bytes):len(bytes)]
+	}
+
+	return bytes
+}
+
+// marshal returns a marshaled version of the TransactionEssence.
+func (t *TransactionEssence) marshal() []byte {
 	marshalUtil := marshalutil.New().
 		Write(t.version).
 		WriteTime(t.timestamp).
@@ -763,9 +838,9 @@ func (t *TransactionMetadata) Solid() bool {
 	return t.solid
 }
 
-// SetSolid updates the solid flag of the Transaction. It returns true if the solid flag was modified and updates the
-// solidification time if the Transaction was marked as solid.
-func (t *TransactionMetadata) SetSolid(solid bool) (modified bool) {
+// SetSolid updates the solid flag of the Transaction. It returns true if the solid flag was modified and sets the
+// solidification time to the given time if the Transaction was marked as solid.
+func (t *TransactionMetadata) SetSolid(solid bool, solidificationTime time.Time) (modified bool) {
 	t.solidMutex.Lock()
 	defer t.solidMutex.Unlock()
 
@@ -775,7 +850,7 @@ func (t *TransactionMetadata) SetSolid(solid bool) (modified bool) {
 
 	if solid {
 		t.solidificationTimeMutex.Lock()
-		t.solidificationTime = time.Now()
+		t.solidificationTime = solidificationTime
 		t.solidificationTimeMutex.Unlock()
 	}
 
@@ -826,8 +901,9 @@ func (t *TransactionMetadata) GradeOfFinality() gof.GradeOfFinality {
 	return t.gradeOfFinality
 }
 
-// SetGradeOfFinality updates the grade of finality. It returns true if it was modified.
-func (t *TransactionMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality) (modified bool) {
+// SetGradeOfFinality updates the grade of finality, which was reached at the given time. It returns true if it was
+// modified.
+func (t *TransactionMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality, gradeOfFinalityTime time.Time) (modified bool) {
 	t.gradeOfFinalityMutex.Lock()
 	defer t.gradeOfFinalityMutex.Unlock()
 
@@ -836,7 +912,7 @@ func (t *TransactionMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFina
 	}
 
 	t.gradeOfFinality = gradeOfFinality
-	t.gradeOfFinalityTime = clock.SyncedTime()
+	t.gradeOfFinalityTime = gradeOfFinalityTime
 	t.SetModified()
 	modified = true
 	return
@@ -4,10 +4,15 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iotaledger/hive.go/byteutils"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 )
 
 var sampleColor = Color{2}
@@ -23,6 +28,31 @@ func TestTransaction_Bytes(t *testing.T) {
 	_tx, err := new(Transaction).FromBytes(bytes)
 	assert.NoError(t, err)
 	assert.Equal(t, tx.ID(), _tx.ID())
+
+	payloadBytes := byteutils.ConcatBytes(TransactionType.Bytes(), tx.Essence().Bytes(), tx.UnlockBlocks().Bytes())
+	assert.Equal(t, marshalutil.New().WriteUint32(uint32(len(payloadBytes))).WriteBytes(payloadBytes).Bytes(), bytes)
+	assert.Equal(t, TransactionID(blake2b.Sum256(bytes)), _tx.ID())
+}
+
+func TestTransactionEssence_Bytes(t *testing.T) {
+	ledgerstate := setupDependencies(t)
+	defer ledgerstate.Shutdown()
+
+	wallets := createWallets(2)
+	input := generateOutput(ledgerstate, wallets[0].address, 0)
+	tx, _ := singleInputTransaction(ledgerstate, wallets[0], wallets[1], input)
+	parsedTx, err := new(Transaction).FromBytes(tx.Bytes())
+	require.NoError(t, err)
+
+	// the bytes of parsed essences are cached
+	essenceBytes := parsedTx.Essence().Bytes()
+	assert.Equal(t, tx.Essence().Bytes(), essenceBytes)
+	assert.Equal(t, &essenceBytes[0], &parsedTx.Essence().Bytes()[0])
+	assert.Equal(t, len(essenceBytes), cap(essenceBytes))
+
+	// and updated if the essence is modified
+	parsedTx.Essence().SetPayload(payload.NewGenericDataPayload([]byte("test")))
+	assert.NotEqual(t, essenceBytes, parsedTx.Essence().Bytes())
 }
 
 func TestTransaction_Complex(t *testing.T) {
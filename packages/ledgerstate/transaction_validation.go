@@ -0,0 +1,158 @@
+package ledgerstate
+
+import (
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/typeutils"
+)
+
+// region TransactionRules /////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// TransactionRuleStructure is the name of the rule that checks the unlock blocks and outputs for structural errors.
+	TransactionRuleStructure = "structure"
+
+	// TransactionRuleInputsSolid is the name of the rule that checks that all consumed Outputs are known.
+	TransactionRuleInputsSolid = "inputsSolid"
+
+	// TransactionRuleBalances is the name of the rule that checks that the consumed and created balances match.
+	TransactionRuleBalances = "balances"
+
+	// TransactionRuleUnlockBlocks is the name of the rule that checks that the unlock blocks authorize the spending.
+	TransactionRuleUnlockBlocks = "unlockBlocks"
+
+	// TransactionRuleAliasInitialState is the name of the rule that checks the initial state of created aliases.
+	TransactionRuleAliasInitialState = "aliasInitialState"
+)
+
+// TransactionRule is a rule of the validation of Transactions. Rules only depend on the Transaction and the Outputs it
+// consumes, so that they can be checked without access to the ledger, e.g. by wallets before submitting a Transaction.
+type TransactionRule struct {
+	// Name is the identifier of the rule.
+	Name string
+
+	// Blocking is true if the subsequent rules can only be checked if this rule is satisfied.
+	Blocking bool
+
+	// Check returns an error if the Transaction violates the rule. The consumed Outputs are expected in the order of the
+	// Inputs of the Transaction, with nil for unknown Outputs.
+	Check func(transaction *Transaction, consumedOutputs Outputs) (err error)
+}
+
+// TransactionRules contains the rules of the validation of Transactions in the order in which they are checked.
+var TransactionRules = []*TransactionRule{
+	{Name: TransactionRuleStructure, Blocking: true, Check: checkTransactionStructure},
+	{Name: TransactionRuleInputsSolid, Blocking: true, Check: checkTransactionInputsSolid},
+	{Name: TransactionRuleBalances, Check: checkTransactionBalances},
+	{Name: TransactionRuleUnlockBlocks, Check: checkTransactionUnlockBlocks},
+	{Name: TransactionRuleAliasInitialState, Check: checkTransactionAliasInitialState},
+}
+
+// ValidateTransaction checks the given Transaction against all TransactionRules and returns the error of the first
+// violated rule. The consumed Outputs are expected in the order of the Inputs of the Transaction.
+//
+// It contains all checks of the ledger that do not require the ledger state. The ledger additionally checks that the
+// consumed Outputs do not reference each other in their past cone.
+func ValidateTransaction(transaction *Transaction, consumedOutputs Outputs) (err error) {
+	for _, rule := range TransactionRules {
+		if err = rule.Check(transaction, consumedOutputs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkTransactionStructure checks the references of the unlock blocks and that created aliases are not locked to
+// themselves.
+func checkTransactionStructure(transaction *Transaction, _ Outputs) (err error) {
+	if len(transaction.unlockBlocks) != len(transaction.essence.Inputs()) {
+		return errors.Errorf("amount of UnlockBlocks (%d) does not match amount of Inputs (%d): %w", len(transaction.unlockBlocks), len(transaction.essence.Inputs()), ErrTransactionInvalid)
+	}
+
+	maxReferencedUnlockIndex := len(transaction.essence.Inputs()) - 1
+	for i, unlockBlock := range transaction.unlockBlocks {
+		switch unlockBlock.Type() {
+		case ReferenceUnlockBlockType:
+			if unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex() > uint16(maxReferencedUnlockIndex) {
+				return errors.Errorf("unlock block %d references non-existent unlock block at index %d: %w", i, unlockBlock.(*ReferenceUnlockBlock).ReferencedIndex(), ErrTransactionInvalid)
+			}
+		case AliasUnlockBlockType:
+			if unlockBlock.(*AliasUnlockBlock).AliasInputIndex() > uint16(maxReferencedUnlockIndex) {
+				return errors.Errorf("unlock block %d references non-existent chain input at index %d: %w", i, unlockBlock.(*AliasUnlockBlock).AliasInputIndex(), ErrTransactionInvalid)
+			}
+		}
+	}
+
+	// for origin alias outputs, the alias address is only known once the ID of the output is set
+	for i, output := range transaction.essence.Outputs() {
+		if output.Type() != AliasOutputType {
+			continue
+		}
+
+		alias := output.(*AliasOutput)
+		aliasAddress := alias.GetAliasAddress()
+		if alias.GetStateAddress().Equals(aliasAddress) {
+			return errors.Errorf("state address of alias output at index %d (id: %s) cannot be its own alias address: %w", i, alias.ID().Base58(), ErrTransactionInvalid)
+		}
+		if alias.GetGoverningAddress().Equals(aliasAddress) {
+			return errors.Errorf("governing address of alias output at index %d (id: %s) cannot be its own alias address: %w", i, alias.ID().Base58(), ErrTransactionInvalid)
+		}
+	}
+
+	return nil
+}
+
+// checkTransactionInputsSolid checks that all consumed Outputs are known.
+func checkTransactionInputsSolid(transaction *Transaction, consumedOutputs Outputs) (err error) {
+	inputs := transaction.essence.Inputs()
+	if len(consumedOutputs) != len(inputs) {
+		return errors.Errorf("amount of consumed Outputs (%d) does not match amount of Inputs (%d): %w", len(consumedOutputs), len(inputs), ErrTransactionNotSolid)
+	}
+
+	unknownInputs := make([]string, 0)
+	for i, consumedOutput := range consumedOutputs {
+		if typeutils.IsInterfaceNil(consumedOutput) {
+			unknownInputs = append(unknownInputs, inputs[i].(*UTXOInput).ReferencedOutputID().Base58())
+		}
+	}
+	if len(unknownInputs) != 0 {
+		return errors.Errorf("not all consumedOutputs of transaction are solid (unknown inputs: %s): %w", strings.Join(unknownInputs, ", "), ErrTransactionNotSolid)
+	}
+
+	return nil
+}
+
+// checkTransactionBalances checks that the consumed and created balances match.
+func checkTransactionBalances(transaction *Transaction, consumedOutputs Outputs) (err error) {
+	if !TransactionBalancesValid(consumedOutputs, transaction.Essence().Outputs()) {
+		return errors.Errorf("sum of consumed and spent balances is not 0: %w", ErrTransactionInvalid)
+	}
+
+	return nil
+}
+
+// checkTransactionUnlockBlocks checks that the unlock blocks authorize the spending of the consumed Outputs.
+func checkTransactionUnlockBlocks(transaction *Transaction, consumedOutputs Outputs) (err error) {
+	if valid, unlockErr := UnlockBlocksValidWithError(consumedOutputs, transaction); !valid || unlockErr != nil {
+		if unlockErr != nil {
+			return errors.Errorf("spending of referenced consumedOutputs is not authorized (%s): %w", unlockErr.Error(), ErrTransactionInvalid)
+		}
+
+		return errors.Errorf("spending of referenced consumedOutputs is not authorized: %w", ErrTransactionInvalid)
+	}
+
+	return nil
+}
+
+// checkTransactionAliasInitialState checks the initial state of the aliases that are created by the Transaction.
+func checkTransactionAliasInitialState(transaction *Transaction, consumedOutputs Outputs) (err error) {
+	if !AliasInitialStateValid(consumedOutputs, transaction) {
+		return errors.Errorf("initial state of created alias output is invalid: %w", ErrTransactionInvalid)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,37 @@
+package ledgerstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTransaction(t *testing.T) {
+	wallets := createWallets(2)
+
+	consumedOutput := NewSigLockedSingleOutput(100, wallets[0].address)
+	consumedOutput.SetID(NewOutputID(GenesisTransactionID, 0))
+
+	newTransaction := func(amount uint64, signer wallet) *Transaction {
+		essence := NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, NewInputs(NewUTXOInput(consumedOutput.ID())), NewOutputs(NewSigLockedSingleOutput(amount, wallets[1].address)))
+		return NewTransaction(essence, signer.unlockBlocks(essence))
+	}
+
+	// valid transaction
+	assert.NoError(t, ValidateTransaction(newTransaction(100, wallets[0]), Outputs{consumedOutput}))
+
+	// unknown consumed Output
+	err := ValidateTransaction(newTransaction(100, wallets[0]), Outputs{nil})
+	assert.True(t, errors.Is(err, ErrTransactionNotSolid))
+
+	// unbalanced transaction
+	err = ValidateTransaction(newTransaction(99, wallets[0]), Outputs{consumedOutput})
+	assert.True(t, errors.Is(err, ErrTransactionInvalid))
+
+	// unauthorized spending
+	err = ValidateTransaction(newTransaction(100, wallets[1]), Outputs{consumedOutput})
+	assert.True(t, errors.Is(err, ErrTransactionInvalid))
+}
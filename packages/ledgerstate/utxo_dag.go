@@ -8,9 +8,9 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/byteutils"
 	"github.com/iotaledger/hive.go/cerrors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/objectstorage"
 	"github.com/iotaledger/hive.go/generics/set"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/iotaledger/hive.go/stringify"
 	"github.com/iotaledger/hive.go/types"
@@ -18,6 +18,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 // region UTXODAG //////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -40,6 +41,8 @@ type IUTXODAG interface {
 	Transaction(transactionID TransactionID) (transaction *Transaction)
 	// Transactions returns all the transactions, consumed.
 	Transactions() (transactions map[TransactionID]*Transaction)
+	// ForEachTransaction iterates over all Transactions in the object storage until the consumer returns false.
+	ForEachTransaction(consumer func(transaction *Transaction) bool)
 	// CachedTransactionMetadata retrieves the TransactionMetadata with the given TransactionID from the object storage.
 	CachedTransactionMetadata(transactionID TransactionID) (cachedTransactionMetadata *objectstorage.CachedObject[*TransactionMetadata])
 	// CachedOutput retrieves the Output with the given OutputID from the object storage.
@@ -79,6 +82,7 @@ type UTXODAG struct {
 	outputMetadataStorage       *objectstorage.ObjectStorage[*OutputMetadata]
 	consumerStorage             *objectstorage.ObjectStorage[*Consumer]
 	addressOutputMappingStorage *objectstorage.ObjectStorage[*AddressOutputMapping]
+	parkedTransactionStorage    kvstore.KVStore
 	shutdownOnce                sync.Once
 }
 
@@ -87,7 +91,9 @@ func NewUTXODAG(ledgerstate *Ledgerstate) (utxoDAG *UTXODAG) {
 	options := buildObjectStorageOptions(ledgerstate.Options.CacheTimeProvider)
 	utxoDAG = &UTXODAG{
 		events: &UTXODAGEvents{
-			TransactionBranchIDUpdatedByFork: events.NewEvent(TransactionBranchIDUpdatedByForkEventHandler),
+			TransactionBranchIDUpdatedByFork: event.New[*TransactionBranchIDUpdatedByForkEvent]("UTXODAG.TransactionBranchIDUpdatedByFork"),
+			TransactionParked:                event.New[TransactionID]("UTXODAG.TransactionParked"),
+			TransactionUnparked:              event.New[TransactionID]("UTXODAG.TransactionUnparked"),
 		},
 		ledgerstate:                 ledgerstate,
 		transactionStorage:          objectstorage.New[*Transaction](ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixTransactionStorage}), options.transactionStorageOptions...),
@@ -96,6 +102,7 @@ func NewUTXODAG(ledgerstate *Ledgerstate) (utxoDAG *UTXODAG) {
 		outputMetadataStorage:       objectstorage.New[*OutputMetadata](ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixOutputMetadataStorage}), options.outputMetadataStorageOptions...),
 		consumerStorage:             objectstorage.New[*Consumer](ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixConsumerStorage}), options.consumerStorageOptions...),
 		addressOutputMappingStorage: objectstorage.New[*AddressOutputMapping](ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixAddressOutputMappingStorage}), options.addressOutputMappingStorageOptions...),
+		parkedTransactionStorage:    ledgerstate.Options.Store.WithRealm([]byte{database.PrefixLedgerState, PrefixParkedTransactionStorage}),
 	}
 	return
 }
@@ -124,17 +131,8 @@ func (u *UTXODAG) CheckTransaction(transaction *Transaction) (err error) {
 	consumedOutputs := cachedConsumedOutputs.Unwrap()
 
 	// perform cheap checks
-	if !u.allOutputsExist(consumedOutputs) {
-		return errors.Errorf("not all consumedOutputs of transaction are solid: %w", ErrTransactionNotSolid)
-	}
-	if !TransactionBalancesValid(consumedOutputs, transaction.Essence().Outputs()) {
-		return errors.Errorf("sum of consumed and spent balances is not 0: %w", ErrTransactionInvalid)
-	}
-	if !UnlockBlocksValid(consumedOutputs, transaction) {
-		return errors.Errorf("spending of referenced consumedOutputs is not authorized: %w", ErrTransactionInvalid)
-	}
-	if !AliasInitialStateValid(consumedOutputs, transaction) {
-		return errors.Errorf("initial state of created alias output is invalid: %w", ErrTransactionInvalid)
+	if err = ValidateTransaction(transaction, consumedOutputs); err != nil {
+		return err
 	}
 
 	// retrieve the metadata of the Inputs
@@ -150,11 +148,16 @@ func (u *UTXODAG) CheckTransaction(transaction *Transaction) (err error) {
 	return nil
 }
 
-// BookTransaction books a Transaction into the ledger state.
+// BookTransaction books a Transaction into the ledger state. It returns an ErrTransactionParked if the Transaction
+// would exceed the limit of conflicting consumers of one of its Inputs.
 func (u *UTXODAG) BookTransaction(transaction *Transaction) (targetBranchIDs BranchIDs, err error) {
+	if cappedOutputIDs := u.cappedOutputIDs(transaction); len(cappedOutputIDs) != 0 {
+		return nil, u.parkTransaction(transaction.ID(), cappedOutputIDs)
+	}
+
 	// store TransactionMetadata
 	transactionMetadata := NewTransactionMetadata(transaction.ID())
-	transactionMetadata.SetSolid(true)
+	transactionMetadata.SetSolid(true, u.ledgerstate.Options.Clock.Now())
 	newTransaction := false
 	cachedTransactionMetadata := u.transactionMetadataStorage.ComputeIfAbsent(transaction.ID().Bytes(), func(key []byte) *TransactionMetadata {
 		newTransaction = true
@@ -196,6 +199,35 @@ func (u *UTXODAG) BookTransaction(transaction *Transaction) (targetBranchIDs Bra
 	return u.bookNonConflictingTransaction(transaction, transactionMetadata, inputsMetadata, parentBranchIDs), nil
 }
 
+// UnparkTransactions releases the Transactions that were parked because they conflict with the given (resolved)
+// Transaction and returns their TransactionIDs, so that they can be booked again.
+func (u *UTXODAG) UnparkTransactions(transactionID TransactionID) (unparkedTransactionIDs TransactionIDs) {
+	unparkedTransactionIDs = make(TransactionIDs)
+	for _, outputID := range u.consumedOutputIDsOfTransaction(transactionID) {
+		if err := u.parkedTransactionStorage.IterateKeys(outputID.Bytes(), func(key kvstore.Key) bool {
+			parkedTransactionID, _, err := TransactionIDFromBytes(key[OutputIDLength:])
+			if err != nil {
+				panic(fmt.Errorf("failed to parse parked Transaction of %s: %w", outputID, err))
+			}
+			unparkedTransactionIDs[parkedTransactionID] = types.Void
+
+			return true
+		}); err != nil {
+			panic(fmt.Errorf("failed to iterate the parked Transactions of %s: %w", outputID, err))
+		}
+
+		if err := u.parkedTransactionStorage.DeletePrefix(outputID.Bytes()); err != nil {
+			panic(fmt.Errorf("failed to delete the parked Transactions of %s: %w", outputID, err))
+		}
+	}
+
+	for unparkedTransactionID := range unparkedTransactionIDs {
+		u.Events().TransactionUnparked.Trigger(unparkedTransactionID)
+	}
+
+	return unparkedTransactionIDs
+}
+
 // TransactionBranchIDs returns the BranchIDs of the given Transaction.
 func (u *UTXODAG) TransactionBranchIDs(transactionID TransactionID) (branchIDs BranchIDs, err error) {
 	if !u.CachedTransactionMetadata(transactionID).Consume(func(transactionMetadata *TransactionMetadata) {
@@ -272,6 +304,18 @@ func (u *UTXODAG) Transactions() (transactions map[TransactionID]*Transaction) {
 	return
 }
 
+// ForEachTransaction iterates over all Transactions in the object storage until the consumer returns false.
+func (u *UTXODAG) ForEachTransaction(consumer func(transaction *Transaction) bool) {
+	u.transactionStorage.ForEach(func(key []byte, cachedObject *objectstorage.CachedObject[*Transaction]) (next bool) {
+		next = true
+		cachedObject.Consume(func(transaction *Transaction) {
+			next = consumer(transaction)
+		})
+
+		return next
+	})
+}
+
 // CachedTransactionMetadata retrieves the TransactionMetadata with the given TransactionID from the object storage.
 func (u *UTXODAG) CachedTransactionMetadata(transactionID TransactionID) (cachedTransactionMetadata *objectstorage.CachedObject[*TransactionMetadata]) {
 	return u.transactionMetadataStorage.Load(transactionID.Bytes())
@@ -323,8 +367,8 @@ func (u *UTXODAG) LoadSnapshot(snapshot *Snapshot) {
 			// store OutputMetadata
 			metadata := NewOutputMetadata(output.ID())
 			metadata.AddBranchID(MasterBranchID)
-			metadata.SetSolid(true)
-			metadata.SetGradeOfFinality(gof.High)
+			metadata.SetSolid(true, u.ledgerstate.Options.Clock.Now())
+			metadata.SetGradeOfFinality(gof.High, u.ledgerstate.Options.Clock.Now())
 			cachedMetadata, stored := u.outputMetadataStorage.StoreIfAbsent(metadata)
 			if stored {
 				cachedMetadata.Release()
@@ -333,9 +377,9 @@ func (u *UTXODAG) LoadSnapshot(snapshot *Snapshot) {
 
 		// store TransactionMetadata
 		txMetadata := NewTransactionMetadata(txID)
-		txMetadata.SetSolid(true)
+		txMetadata.SetSolid(true, u.ledgerstate.Options.Clock.Now())
 		txMetadata.AddBranchID(MasterBranchID)
-		txMetadata.SetGradeOfFinality(gof.High)
+		txMetadata.SetGradeOfFinality(gof.High, u.ledgerstate.Options.Clock.Now())
 
 		u.transactionMetadataStorage.ComputeIfAbsent(txID.Bytes(), func(key []byte) *TransactionMetadata {
 			txMetadata.Persist()
@@ -356,11 +400,81 @@ func (u *UTXODAG) CachedAddressOutputMapping(address Address) (cachedAddressOutp
 
 // region booking functions ////////////////////////////////////////////////////////////////////////////////////////////
 
+// cappedOutputIDs is an internal utility function that returns the Inputs of a new Transaction that already reached the
+// limit of conflicting consumers and whose conflict is not resolved, yet.
+func (u *UTXODAG) cappedOutputIDs(transaction *Transaction) (cappedOutputIDs []OutputID) {
+	maxConflictingConsumers := u.ledgerstate.Options.MaxConflictingConsumers
+	if maxConflictingConsumers <= 0 || u.CachedTransactionMetadata(transaction.ID()).Consume(func(*TransactionMetadata) {}) {
+		return nil
+	}
+
+	cachedInputsMetadata := u.transactionInputsMetadata(transaction)
+	defer cachedInputsMetadata.Release()
+
+	for _, inputMetadata := range cachedInputsMetadata.Unwrap() {
+		if inputMetadata.ConsumerCount() >= maxConflictingConsumers && !u.consumerConfirmed(inputMetadata.ID()) {
+			cappedOutputIDs = append(cappedOutputIDs, inputMetadata.ID())
+		}
+	}
+
+	return cappedOutputIDs
+}
+
+// consumerConfirmed is an internal utility function that returns true if one of the conflicting consumers of the given
+// Output was confirmed.
+func (u *UTXODAG) consumerConfirmed(outputID OutputID) (confirmed bool) {
+	u.CachedConsumers(outputID).Consume(func(consumer *Consumer) {
+		if confirmed || consumer.Valid() != types.True {
+			return
+		}
+
+		u.ledgerstate.BranchDAG.Branch(NewBranchID(consumer.TransactionID())).Consume(func(branch *Branch) {
+			confirmed = branch.InclusionState() == Confirmed
+		})
+	})
+
+	return confirmed
+}
+
+// DiscardParkedTransaction removes the given parked Transaction from the Outputs at which it waits for the resolution
+// of their conflicts, so that it is not released anymore (e.g. because the Messages that contain it expired).
+func (u *UTXODAG) DiscardParkedTransaction(transaction *Transaction) {
+	for _, input := range transaction.Essence().Inputs() {
+		outputID := input.(*UTXOInput).ReferencedOutputID()
+		if err := u.parkedTransactionStorage.Delete(byteutils.ConcatBytes(outputID.Bytes(), transaction.ID().Bytes())); err != nil {
+			panic(fmt.Errorf("failed to discard the parked Transaction with %s at %s: %w", transaction.ID(), outputID, err))
+		}
+	}
+}
+
+// parkTransaction is an internal utility function that parks the given Transaction at the given Inputs instead of
+// booking it, until the conflicts of the Inputs are resolved.
+func (u *UTXODAG) parkTransaction(transactionID TransactionID, cappedOutputIDs []OutputID) (err error) {
+	newlyParked := false
+	for _, outputID := range cappedOutputIDs {
+		key := byteutils.ConcatBytes(outputID.Bytes(), transactionID.Bytes())
+		if has, hasErr := u.parkedTransactionStorage.Has(key); hasErr != nil || has {
+			continue
+		}
+
+		if err = u.parkedTransactionStorage.Set(key, []byte{}); err != nil {
+			return errors.Errorf("failed to park Transaction with %s at %s: %w", transactionID, outputID, err)
+		}
+		newlyParked = true
+	}
+
+	if newlyParked {
+		u.Events().TransactionParked.Trigger(transactionID)
+	}
+
+	return errors.Errorf("Transaction with %s exceeds the limit of conflicting consumers of %d Inputs: %w", transactionID, len(cappedOutputIDs), ErrTransactionParked)
+}
+
 // bookNonConflictingTransaction is an internal utility function that books the Transaction into the Branch that is
 // determined by aggregating the Branches of the consumed Inputs.
 func (u *UTXODAG) bookNonConflictingTransaction(transaction *Transaction, transactionMetadata *TransactionMetadata, inputsMetadata OutputsMetadata, branchIDs BranchIDs) (targetBranchIDs BranchIDs) {
 	transactionMetadata.SetBranchIDs(branchIDs)
-	transactionMetadata.SetSolid(true)
+	transactionMetadata.SetSolid(true, u.ledgerstate.Options.Clock.Now())
 	u.bookConsumers(inputsMetadata, transaction.ID(), types.True)
 	u.bookOutputs(transaction, branchIDs)
 
@@ -388,7 +502,7 @@ func (u *UTXODAG) bookConflictingTransaction(transaction *Transaction, transacti
 
 	targetBranchIDs = NewBranchIDs(targetBranchID)
 	transactionMetadata.SetBranchIDs(targetBranchIDs)
-	transactionMetadata.SetSolid(true)
+	transactionMetadata.SetSolid(true, u.ledgerstate.Options.Clock.Now())
 	u.bookConsumers(inputsMetadata, transaction.ID(), types.True)
 	u.bookOutputs(transaction, targetBranchIDs)
 
@@ -511,7 +625,7 @@ func (u *UTXODAG) bookOutputs(transaction *Transaction, targetBranchIDs BranchID
 		// store OutputMetadata
 		metadata := NewOutputMetadata(updatedOutput.ID())
 		metadata.SetBranchIDs(targetBranchIDs)
-		metadata.SetSolid(true)
+		metadata.SetSolid(true, u.ledgerstate.Options.Clock.Now())
 		u.outputMetadataStorage.Store(metadata).Release()
 	}
 }
@@ -692,24 +806,8 @@ func (u *UTXODAG) walkFutureCone(entryPoints []OutputID, callback func(transacti
 
 // ManageStoreAddressOutputMapping manages how to store the address-output mapping dependent on which type of output it is.
 func (u *UTXODAG) ManageStoreAddressOutputMapping(output Output) {
-	switch output.Type() {
-	case AliasOutputType:
-		castedOutput := output.(*AliasOutput)
-		// if it is an origin alias output, we don't have the AliasAddress from the parsed bytes.
-		// that happens in utxodag output booking, so we calculate the alias address here
-		u.StoreAddressOutputMapping(castedOutput.GetAliasAddress(), output.ID())
-		u.StoreAddressOutputMapping(castedOutput.GetStateAddress(), output.ID())
-		if !castedOutput.IsSelfGoverned() {
-			u.StoreAddressOutputMapping(castedOutput.GetGoverningAddress(), output.ID())
-		}
-	case ExtendedLockedOutputType:
-		castedOutput := output.(*ExtendedLockedOutput)
-		if castedOutput.FallbackAddress() != nil {
-			u.StoreAddressOutputMapping(castedOutput.FallbackAddress(), output.ID())
-		}
-		u.StoreAddressOutputMapping(output.Address(), output.ID())
-	default:
-		u.StoreAddressOutputMapping(output.Address(), output.ID())
+	for _, address := range outputAddresses(output) {
+		u.StoreAddressOutputMapping(address, output.ID())
 	}
 }
 
@@ -730,12 +828,13 @@ func (u *UTXODAG) StoreAddressOutputMapping(address Address, outputID OutputID)
 // UTXODAGEvents is a container for all the UTXODAG related events.
 type UTXODAGEvents struct {
 	// TransactionBranchIDUpdatedByFork gets triggered when the BranchID of a Transaction is changed after the initial booking.
-	TransactionBranchIDUpdatedByFork *events.Event
-}
+	TransactionBranchIDUpdatedByFork *event.Event[*TransactionBranchIDUpdatedByForkEvent]
 
-// TransactionIDEventHandler is an event handler for an event with a TransactionID.
-func TransactionIDEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(TransactionID))(params[0].(TransactionID))
+	// TransactionParked gets triggered when a Transaction is parked because it exceeds the limit of conflicting consumers.
+	TransactionParked *event.Event[TransactionID]
+
+	// TransactionUnparked gets triggered when a parked Transaction is released after its conflict was resolved.
+	TransactionUnparked *event.Event[TransactionID]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -749,12 +848,6 @@ type TransactionBranchIDUpdatedByForkEvent struct {
 	ForkedBranchID BranchID
 }
 
-// TransactionBranchIDUpdatedByForkEventHandler is an event handler for an event with a
-// TransactionBranchIDUpdatedByForkEvent.
-func TransactionBranchIDUpdatedByForkEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*TransactionBranchIDUpdatedByForkEvent))(params[0].(*TransactionBranchIDUpdatedByForkEvent))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region AddressOutputMapping /////////////////////////////////////////////////////////////////////////////////////////
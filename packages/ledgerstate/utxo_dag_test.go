@@ -13,6 +13,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 var (
@@ -354,6 +355,66 @@ func TestBookConflictingTransaction(t *testing.T) {
 	assert.False(t, ledgerstate.outputsUnspent(inputsMetadata2))
 }
 
+func TestBookTransaction_MaxConflictingConsumers(t *testing.T) {
+	ledgerstate := New(CacheTimeProvider(database.NewCacheTimeProvider(0)), MaxConflictingConsumers(2))
+	defer ledgerstate.Shutdown()
+
+	parkedTransactions := make([]TransactionID, 0)
+	ledgerstate.UTXODAG.Events().TransactionParked.Attach(event.NewClosure(func(transactionID TransactionID) {
+		parkedTransactions = append(parkedTransactions, transactionID)
+	}))
+
+	wallets := createWallets(4)
+	input := generateOutput(ledgerstate, wallets[0].address, 0)
+
+	tx1 := buildTransaction(ledgerstate, wallets[0], wallets[1], []*SigLockedSingleOutput{input})
+	_, err := ledgerstate.BookTransaction(tx1)
+	require.NoError(t, err)
+	tx2 := buildTransaction(ledgerstate, wallets[0], wallets[2], []*SigLockedSingleOutput{input})
+	_, err = ledgerstate.BookTransaction(tx2)
+	require.NoError(t, err)
+
+	// the third conflicting consumer is parked instead of being booked into a new Branch
+	tx3 := buildTransaction(ledgerstate, wallets[0], wallets[3], []*SigLockedSingleOutput{input})
+	_, err = ledgerstate.BookTransaction(tx3)
+	require.ErrorIs(t, err, ErrTransactionParked)
+	assert.Equal(t, []TransactionID{tx3.ID()}, parkedTransactions)
+	assert.False(t, ledgerstate.CachedTransactionMetadata(tx3.ID()).Consume(func(*TransactionMetadata) {}))
+	assert.False(t, ledgerstate.Branch(NewBranchID(tx3.ID())).Consume(func(*Branch) {}))
+
+	// once the conflict is resolved, the parked Transaction is released and booked into a rejected Branch
+	ledgerstate.SetBranchConfirmed(NewBranchID(tx1.ID()))
+	assert.Equal(t, TransactionIDs{tx3.ID(): types.Void}, ledgerstate.UnparkTransactions(tx1.ID()))
+	assert.Empty(t, ledgerstate.UnparkTransactions(tx1.ID()))
+
+	targetBranchIDs, err := ledgerstate.BookTransaction(tx3)
+	require.NoError(t, err)
+	assert.Equal(t, NewBranchIDs(NewBranchID(tx3.ID())), targetBranchIDs)
+	assert.True(t, ledgerstate.Branch(NewBranchID(tx3.ID())).Consume(func(branch *Branch) {
+		assert.Equal(t, Rejected, branch.InclusionState())
+	}))
+}
+
+func TestDiscardParkedTransaction(t *testing.T) {
+	ledgerstate := New(CacheTimeProvider(database.NewCacheTimeProvider(0)), MaxConflictingConsumers(1))
+	defer ledgerstate.Shutdown()
+
+	wallets := createWallets(3)
+	input := generateOutput(ledgerstate, wallets[0].address, 0)
+
+	tx1 := buildTransaction(ledgerstate, wallets[0], wallets[1], []*SigLockedSingleOutput{input})
+	_, err := ledgerstate.BookTransaction(tx1)
+	require.NoError(t, err)
+	tx2 := buildTransaction(ledgerstate, wallets[0], wallets[2], []*SigLockedSingleOutput{input})
+	_, err = ledgerstate.BookTransaction(tx2)
+	require.ErrorIs(t, err, ErrTransactionParked)
+
+	// a discarded Transaction is not released once the conflict is resolved
+	ledgerstate.DiscardParkedTransaction(tx2)
+	ledgerstate.SetBranchConfirmed(NewBranchID(tx1.ID()))
+	assert.Empty(t, ledgerstate.UnparkTransactions(tx1.ID()))
+}
+
 func TestCreatedOutputIDsOfTransaction(t *testing.T) {
 	ledgerstate := setupDependencies(t)
 	defer ledgerstate.Shutdown()
@@ -580,7 +641,7 @@ func TestUTXODAG_CheckTransaction(t *testing.T) {
 		// store OutputMetadata
 		metadata := NewOutputMetadata(output.ID())
 		metadata.SetBranchIDs(NewBranchIDs(MasterBranchID))
-		metadata.SetSolid(true)
+		metadata.SetSolid(true, time.Now())
 		ledgerstate.outputMetadataStorage.Store(metadata).Release()
 	}
 
@@ -720,7 +781,7 @@ func generateOutput(ledgerstate *Ledgerstate, address Address, index uint16) *Si
 	// store OutputMetadata
 	metadata := NewOutputMetadata(output.ID())
 	metadata.AddBranchID(MasterBranchID)
-	metadata.SetSolid(true)
+	metadata.SetSolid(true, time.Now())
 	ledgerstate.outputMetadataStorage.Store(metadata).Release()
 
 	return output
@@ -737,7 +798,7 @@ func generateOutputs(ledgerstate *Ledgerstate, address Address, branchIDs Branch
 		// store OutputMetadata
 		metadata := NewOutputMetadata(outputs[i].ID())
 		metadata.AddBranchID(branchID)
-		metadata.SetSolid(true)
+		metadata.SetSolid(true, time.Now())
 		ledgerstate.outputMetadataStorage.Store(metadata).Release()
 		i++
 	}
@@ -755,13 +816,13 @@ func singleInputTransaction(ledgerstate *Ledgerstate, a, b wallet, outputToSpend
 
 	// store TransactionMetadata
 	transactionMetadata := NewTransactionMetadata(tx.ID())
-	transactionMetadata.SetSolid(true)
+	transactionMetadata.SetSolid(true, time.Now())
 	transactionMetadata.AddBranchID(MasterBranchID)
 
 	if len(optionalGradeOfFinality) >= 1 {
-		transactionMetadata.SetGradeOfFinality(optionalGradeOfFinality[0])
+		transactionMetadata.SetGradeOfFinality(optionalGradeOfFinality[0], time.Now())
 	} else {
-		transactionMetadata.SetGradeOfFinality(gof.Low)
+		transactionMetadata.SetGradeOfFinality(gof.Low, time.Now())
 	}
 
 	cachedTransactionMetadata := ledgerstate.transactionMetadataStorage.ComputeIfAbsent(tx.ID().Bytes(), func(key []byte) *TransactionMetadata {
@@ -1,6 +1,8 @@
 package utxodb
 
 import (
+	"time"
+
 	"golang.org/x/xerrors"
 
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
@@ -48,7 +50,7 @@ func (u *UtxoDB) GetOutputMetadata(outID ledgerstate.OutputID, f func(*ledgersta
 	if consumed {
 		meta.RegisterConsumer(txID)
 	}
-	meta.SetSolid(true)
+	meta.SetSolid(true, time.Now())
 	f(meta)
 	return true
 }
@@ -33,8 +33,12 @@ func ToLibp2pPrivateKey(ourPrivateKey ed25519.PrivateKey) (libp2pcrypto.PrivKey,
 
 // ToLibp2pPeerID computes libp2p peer ID from our peer object.
 func ToLibp2pPeerID(p *peer.Peer) (libp2ppeer.ID, error) {
-	pubKeyBytes := p.PublicKey().Bytes()
-	pubKeyLibp2p, err := libp2pcrypto.UnmarshalEd25519PublicKey(pubKeyBytes)
+	return PublicKeyToLibp2pPeerID(p.PublicKey())
+}
+
+// PublicKeyToLibp2pPeerID computes libp2p peer ID from the given public key.
+func PublicKeyToLibp2pPeerID(publicKey ed25519.PublicKey) (libp2ppeer.ID, error) {
+	pubKeyLibp2p, err := libp2pcrypto.UnmarshalEd25519PublicKey(publicKey.Bytes())
 	if err != nil {
 		return "", errors.WithStack(err)
 	}
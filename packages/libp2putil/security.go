@@ -0,0 +1,41 @@
+package libp2putil
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/libp2p/go-libp2p"
+	noise "github.com/libp2p/go-libp2p-noise"
+	tls "github.com/libp2p/go-libp2p-tls"
+)
+
+// SecurityMode defines which secure channel protocols are offered and accepted on libp2p connections. Every protocol
+// authenticates both sides with the libp2p key that is derived from the node identity, so the peer at the other end of
+// a connection is always the owner of the expected identity.
+type SecurityMode string
+
+const (
+	// SecurityModeAny offers Noise and TLS 1.3 (in this order of preference) and accepts either of them.
+	SecurityModeAny SecurityMode = "any"
+
+	// SecurityModeTLS only offers and accepts TLS 1.3 with certificates that are bound to the node identity.
+	SecurityModeTLS SecurityMode = "tls"
+
+	// SecurityModeNoise only offers and accepts the Noise protocol.
+	SecurityModeNoise SecurityMode = "noise"
+)
+
+// ErrUnknownSecurityMode is returned if a SecurityMode is not supported.
+var ErrUnknownSecurityMode = errors.New("unknown security mode")
+
+// GetLibp2pSecurity returns the libp2p Host option that selects the secure channel protocols of the given mode.
+func GetLibp2pSecurity(mode SecurityMode) (libp2p.Option, error) {
+	switch mode {
+	case SecurityModeAny:
+		return libp2p.DefaultSecurity, nil
+	case SecurityModeTLS:
+		return libp2p.Security(tls.ID, tls.New), nil
+	case SecurityModeNoise:
+		return libp2p.Security(noise.ID, noise.New), nil
+	default:
+		return nil, errors.Errorf("%q: %w", mode, ErrUnknownSecurityMode)
+	}
+}
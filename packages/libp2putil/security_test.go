@@ -0,0 +1,40 @@
+package libp2putil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetLibp2pSecurity(t *testing.T) {
+	tlsHost := newSecureHost(t, SecurityModeTLS)
+	anyHost := newSecureHost(t, SecurityModeAny)
+	noiseHost := newSecureHost(t, SecurityModeNoise)
+
+	require.NoError(t, anyHost.Connect(context.Background(), peer.AddrInfo{ID: tlsHost.ID(), Addrs: tlsHost.Addrs()}))
+	for _, conn := range anyHost.Network().ConnsToPeer(tlsHost.ID()) {
+		assert.Equal(t, tlsHost.ID(), conn.RemotePeer())
+		assert.True(t, conn.RemotePublicKey().Equals(tlsHost.Peerstore().PubKey(tlsHost.ID())))
+	}
+
+	assert.Error(t, noiseHost.Connect(context.Background(), peer.AddrInfo{ID: tlsHost.ID(), Addrs: tlsHost.Addrs()}))
+
+	_, err := GetLibp2pSecurity("plaintext")
+	assert.ErrorIs(t, err, ErrUnknownSecurityMode)
+}
+
+func newSecureHost(t *testing.T, mode SecurityMode) host.Host {
+	security, err := GetLibp2pSecurity(mode)
+	require.NoError(t, err)
+
+	h, err := libp2p.New(context.Background(), libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"), libp2p.DisableRelay(), security)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = h.Close() })
+
+	return h
+}
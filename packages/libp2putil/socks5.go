@@ -0,0 +1,97 @@
+package libp2putil
+
+import (
+	"context"
+	"net"
+
+	"github.com/cockroachdb/errors"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/transport"
+	tptu "github.com/libp2p/go-libp2p-transport-upgrader"
+	tcp "github.com/libp2p/go-tcp-transport"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"golang.org/x/net/proxy"
+)
+
+// SOCKS5Transport is a libp2p TCP transport that establishes all outbound connections through a SOCKS5 proxy (e.g. the
+// SOCKS port of a Tor client), so that the IP address of the node is not revealed to the dialed peers. Inbound
+// connections are accepted directly on the listen address.
+type SOCKS5Transport struct {
+	*tcp.TcpTransport
+
+	dialer proxy.ContextDialer
+}
+
+var _ transport.Transport = &SOCKS5Transport{}
+
+// NewSOCKS5Transport returns a constructor for a SOCKS5Transport that can be passed to the libp2p.Transport option.
+// The username and password are only used if the username is not empty.
+func NewSOCKS5Transport(proxyAddress, username, password string) (constructor func(upgrader *tptu.Upgrader) (*SOCKS5Transport, error)) {
+	return func(upgrader *tptu.Upgrader) (*SOCKS5Transport, error) {
+		var auth *proxy.Auth
+		if username != "" {
+			auth = &proxy.Auth{User: username, Password: password}
+		}
+
+		dialer, err := proxy.SOCKS5("tcp", proxyAddress, auth, proxy.Direct)
+		if err != nil {
+			return nil, errors.Errorf("failed to create SOCKS5 dialer for %s: %w", proxyAddress, err)
+		}
+
+		return &SOCKS5Transport{
+			TcpTransport: tcp.NewTCPTransport(upgrader),
+			dialer:       dialer.(proxy.ContextDialer),
+		}, nil
+	}
+}
+
+// Dial dials the peer at the remote address through the SOCKS5 proxy.
+func (s *SOCKS5Transport) Dial(ctx context.Context, raddr multiaddr.Multiaddr, p peer.ID) (transport.CapableConn, error) {
+	remoteAddr, err := manet.ToNetAddr(raddr)
+	if err != nil {
+		return nil, errors.Errorf("failed to convert %s: %w", raddr, err)
+	}
+
+	if s.ConnectTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.ConnectTimeout)
+		defer cancel()
+	}
+
+	conn, err := s.dialer.DialContext(ctx, "tcp", remoteAddr.String())
+	if err != nil {
+		return nil, errors.Errorf("failed to dial %s through SOCKS5 proxy: %w", raddr, err)
+	}
+
+	// the connection ends at the proxy, so we report the address of the dialed peer as its remote address instead
+	maConn, err := manet.WrapNetConn(&proxiedConn{Conn: conn, remoteAddr: remoteAddr})
+	if err != nil {
+		_ = conn.Close()
+		return nil, errors.Errorf("failed to wrap proxied connection: %w", err)
+	}
+
+	return s.Upgrader.UpgradeOutbound(ctx, s, maConn, p)
+}
+
+// Proxy returns true as the transport dials through a proxy.
+func (s *SOCKS5Transport) Proxy() bool {
+	return true
+}
+
+// String returns a human-readable version of the transport.
+func (s *SOCKS5Transport) String() string {
+	return "TCP over SOCKS5"
+}
+
+// proxiedConn is a connection to a SOCKS5 proxy that reports the address of the proxied peer as its remote address.
+type proxiedConn struct {
+	net.Conn
+
+	remoteAddr net.Addr
+}
+
+// RemoteAddr returns the address of the proxied peer.
+func (p *proxiedConn) RemoteAddr() net.Addr {
+	return p.remoteAddr
+}
@@ -0,0 +1,84 @@
+package libp2putil
+
+import (
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestSOCKS5Transport(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	proxiedConnections := atomic.NewInt32(0)
+	go serveSOCKS5(listener, proxiedConnections)
+
+	targetHost := newSecureHost(t, SecurityModeAny)
+	proxiedHost, err := libp2p.New(context.Background(),
+		libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"),
+		libp2p.DisableRelay(),
+		libp2p.Transport(NewSOCKS5Transport(listener.Addr().String(), "", "")),
+	)
+	require.NoError(t, err)
+	defer proxiedHost.Close()
+
+	require.NoError(t, proxiedHost.Connect(context.Background(), peer.AddrInfo{ID: targetHost.ID(), Addrs: targetHost.Addrs()}))
+	assert.EqualValues(t, 1, proxiedConnections.Load())
+	for _, conn := range proxiedHost.Network().ConnsToPeer(targetHost.ID()) {
+		assert.Contains(t, targetHost.Addrs(), conn.RemoteMultiaddr())
+	}
+}
+
+// serveSOCKS5 is a minimal SOCKS5 server that supports unauthenticated CONNECT requests to IPv4 addresses.
+func serveSOCKS5(listener net.Listener, proxiedConnections *atomic.Int32) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+
+			// greeting: version, number of methods, methods
+			greeting := make([]byte, 2)
+			if _, err = io.ReadFull(conn, greeting); err != nil {
+				return
+			}
+			if _, err = io.ReadFull(conn, make([]byte, greeting[1])); err != nil {
+				return
+			}
+			if _, err = conn.Write([]byte{5, 0}); err != nil {
+				return
+			}
+
+			// request: version, command, reserved, address type, IPv4 address, port
+			request := make([]byte, 10)
+			if _, err = io.ReadFull(conn, request); err != nil || request[1] != 1 || request[3] != 1 {
+				return
+			}
+			target, err := net.Dial("tcp", net.JoinHostPort(net.IP(request[4:8]).String(), strconv.Itoa(int(binary.BigEndian.Uint16(request[8:])))))
+			if err != nil {
+				_, _ = conn.Write([]byte{5, 1, 0, 1, 0, 0, 0, 0, 0, 0})
+				return
+			}
+			defer target.Close()
+			proxiedConnections.Inc()
+			if _, err = conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0}); err != nil {
+				return
+			}
+
+			go func() { _, _ = io.Copy(target, conn) }()
+			_, _ = io.Copy(conn, target)
+		}()
+	}
+}
@@ -141,6 +141,50 @@ func (a *AccessBaseManaVector) GetManaMap(optionalUpdateTime ...time.Time) (res
 	return
 }
 
+// Projection projects the effective access mana of the given node and the total effective access mana at the given
+// number of equidistant steps during the interval after the start time, assuming that no further mana is pledged. It
+// accounts for the decay of the base mana and for the recently pledged mana that the effective mana still converges
+// to, without updating the vector itself.
+func (a *AccessBaseManaVector) Projection(nodeID identity.ID, start time.Time, interval time.Duration, steps int) (projection *AccessManaProjection, err error) {
+	if steps < 1 || interval <= 0 {
+		return nil, errors.Errorf("failed to project access mana over %s in %d steps: %w", interval, steps, ErrInvalidProjection)
+	}
+
+	a.RLock()
+	baseManas := make(map[identity.ID]*AccessBaseMana, len(a.vector))
+	for ID, baseMana := range a.vector {
+		baseManaCopy := *baseMana
+		baseManas[ID] = &baseManaCopy
+	}
+	a.RUnlock()
+
+	projection = &AccessManaProjection{
+		NodeID: nodeID,
+		Points: make([]*AccessManaProjectionPoint, steps+1),
+	}
+	for i := range projection.Points {
+		point := &AccessManaProjectionPoint{
+			Time: start.Add(interval * time.Duration(i) / time.Duration(steps)),
+		}
+		for ID, baseMana := range baseManas {
+			// base mana that was updated after the projected time is used as it is
+			_ = baseMana.update(point.Time)
+
+			effectiveValue := baseMana.EffectiveValue()
+			if effectiveValue < tangle.MinMana {
+				effectiveValue = 0
+			}
+			if ID == nodeID {
+				point.Mana = effectiveValue
+			}
+			point.TotalMana += effectiveValue
+		}
+		projection.Points[i] = point
+	}
+
+	return projection, nil
+}
+
 // GetHighestManaNodes returns the n highest mana nodes in descending order.
 // It also updates the mana values for each node.
 // If n is zero, it returns all nodes.
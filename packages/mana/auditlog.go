@@ -0,0 +1,237 @@
+package mana
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region AuditLog /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AuditLog persistently records every pledge and revocation of mana together with the transaction that triggered it,
+// so that the changes of the mana balances can be traced per node and per transaction.
+type AuditLog struct {
+	// Events contains the events that are triggered by the AuditLog.
+	Events *AuditLogEvents
+
+	store     kvstore.KVStore
+	nextIndex uint64
+	mutex     sync.RWMutex
+}
+
+// NewAuditLog is the constructor of the AuditLog.
+func NewAuditLog(store kvstore.KVStore) (auditLog *AuditLog, err error) {
+	auditLog = &AuditLog{
+		Events: &AuditLogEvents{
+			EventRecorded: event.New[*AuditRecord]("AuditLog.EventRecorded"),
+		},
+		store: store.WithRealm([]byte{database.PrefixMana, PrefixAuditLog}),
+	}
+
+	if err = auditLog.store.IterateKeys([]byte{auditRecordPrefix}, func(key kvstore.Key) bool {
+		if index := binary.BigEndian.Uint64(key[1:]); index >= auditLog.nextIndex {
+			auditLog.nextIndex = index + 1
+		}
+
+		return true
+	}); err != nil {
+		return nil, errors.Errorf("failed to restore the mana audit log: %w", err)
+	}
+
+	return auditLog, nil
+}
+
+// Record persists the given pledge or revoke Event and indexes it by its node and its transaction.
+func (a *AuditLog) Record(manaEvent Event) (err error) {
+	if manaEvent.Type() != EventTypePledge && manaEvent.Type() != EventTypeRevoke {
+		return errors.Errorf("failed to record %s: %w", manaEvent, ErrUnknownManaEvent)
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	record := &AuditRecord{
+		Index:            a.nextIndex,
+		PersistableEvent: manaEvent.ToPersistable(),
+	}
+	indexBytes := record.indexBytes()
+
+	batch := a.store.Batched()
+	if err = batch.Set(byteutils.ConcatBytes([]byte{auditRecordPrefix}, indexBytes), record.Bytes()); err == nil {
+		if err = batch.Set(byteutils.ConcatBytes([]byte{auditNodePrefix}, record.NodeID.Bytes(), indexBytes), []byte{}); err == nil {
+			err = batch.Set(byteutils.ConcatBytes([]byte{auditTransactionPrefix}, record.TransactionID.Bytes(), indexBytes), []byte{})
+		}
+	}
+	if err != nil {
+		batch.Cancel()
+		return errors.Errorf("failed to store mana audit record %d: %w", record.Index, err)
+	}
+	if err = batch.Commit(); err != nil {
+		return errors.Errorf("failed to store mana audit record %d: %w", record.Index, err)
+	}
+	a.nextIndex++
+
+	a.Events.EventRecorded.Trigger(record)
+
+	return nil
+}
+
+// Records returns the recorded AuditRecords that match the given AuditFilter ordered by their Index.
+func (a *AuditLog) Records(filter *AuditFilter) (records []*AuditRecord, err error) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	var indexes []uint64
+	switch {
+	case filter.NodeID != nil:
+		indexes, err = a.indexes(byteutils.ConcatBytes([]byte{auditNodePrefix}, filter.NodeID.Bytes()))
+	case filter.TransactionID != nil:
+		indexes, err = a.indexes(byteutils.ConcatBytes([]byte{auditTransactionPrefix}, filter.TransactionID.Bytes()))
+	default:
+		indexes, err = a.indexes([]byte{auditRecordPrefix})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	records = make([]*AuditRecord, 0)
+	for _, index := range indexes {
+		record, recordErr := a.record(index)
+		if recordErr != nil {
+			return nil, recordErr
+		}
+
+		if filter.matches(record) {
+			records = append(records, record)
+		}
+	}
+
+	// only the most recent AuditRecords are returned if the result is limited
+	if filter.Limit > 0 && len(records) > filter.Limit {
+		records = records[len(records)-filter.Limit:]
+	}
+
+	return records, nil
+}
+
+// indexes returns the indexes of the AuditRecords that are stored with the given key prefix (the index is the suffix
+// of the keys).
+func (a *AuditLog) indexes(prefix []byte) (indexes []uint64, err error) {
+	if err = a.store.IterateKeys(prefix, func(key kvstore.Key) bool {
+		indexes = append(indexes, binary.BigEndian.Uint64(key[len(key)-marshalutil.Uint64Size:]))
+
+		return true
+	}); err != nil {
+		return nil, errors.Errorf("failed to iterate the mana audit log: %w", err)
+	}
+
+	return indexes, nil
+}
+
+// record loads the AuditRecord with the given index.
+func (a *AuditLog) record(index uint64) (record *AuditRecord, err error) {
+	record = &AuditRecord{Index: index}
+
+	value, err := a.store.Get(byteutils.ConcatBytes([]byte{auditRecordPrefix}, record.indexBytes()))
+	if err != nil {
+		return nil, errors.Errorf("failed to load mana audit record %d: %w", index, err)
+	}
+	if record.PersistableEvent, err = new(PersistableEvent).FromBytes(value); err != nil {
+		return nil, errors.Errorf("failed to parse mana audit record %d: %w", index, err)
+	}
+
+	return record, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AuditRecord //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AuditRecord is an entry of the AuditLog that describes a pledge or a revocation of mana.
+type AuditRecord struct {
+	// Index is the position of the AuditRecord in the AuditLog.
+	Index uint64
+
+	*PersistableEvent
+}
+
+// Event returns the pledge or revoke Event of the AuditRecord.
+func (a *AuditRecord) Event() (manaEvent Event) {
+	manaEvent, _ = FromPersistableEvent(a.PersistableEvent)
+
+	return manaEvent
+}
+
+// indexBytes returns the big endian representation of the Index, which keeps the AuditRecords in their order.
+func (a *AuditRecord) indexBytes() []byte {
+	indexBytes := make([]byte, marshalutil.Uint64Size)
+	binary.BigEndian.PutUint64(indexBytes, a.Index)
+
+	return indexBytes
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AuditFilter //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// AuditFilter defines which AuditRecords are returned by a query of the AuditLog.
+type AuditFilter struct {
+	// NodeID restricts the AuditRecords to the ones of the given node (nil returns all nodes).
+	NodeID *identity.ID
+	// TransactionID restricts the AuditRecords to the ones triggered by the given transaction (nil returns all
+	// transactions).
+	TransactionID *ledgerstate.TransactionID
+	// Since restricts the AuditRecords to the ones whose event happened at or after the given time.
+	Since time.Time
+	// Limit restricts the number of returned AuditRecords to the most recent ones (0 returns all AuditRecords).
+	Limit int
+}
+
+// matches returns true if the given AuditRecord matches the AuditFilter.
+func (f *AuditFilter) matches(record *AuditRecord) bool {
+	if f.NodeID != nil && *f.NodeID != record.NodeID {
+		return false
+	}
+	if f.TransactionID != nil && *f.TransactionID != record.TransactionID {
+		return false
+	}
+
+	return !record.Time.Before(f.Since)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region AuditLogEvents ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// AuditLogEvents represents events happening in the AuditLog.
+type AuditLogEvents struct {
+	// EventRecorded is triggered when a pledge or revoke event was recorded.
+	EventRecorded *event.Event[*AuditRecord]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region keys /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// auditRecordPrefix is the key prefix of the AuditRecords.
+	auditRecordPrefix byte = iota
+
+	// auditNodePrefix is the key prefix of the index of the AuditRecords by their node.
+	auditNodePrefix
+
+	// auditTransactionPrefix is the key prefix of the index of the AuditRecords by their transaction.
+	auditTransactionPrefix
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
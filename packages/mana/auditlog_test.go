@@ -0,0 +1,76 @@
+package mana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestAuditLog(t *testing.T) {
+	store := mapdb.NewMapDB()
+	auditLog, err := NewAuditLog(store)
+	require.NoError(t, err)
+
+	var recorded []*AuditRecord
+	auditLog.Events.EventRecorded.Attach(event.NewClosure(func(record *AuditRecord) {
+		recorded = append(recorded, record)
+	}))
+
+	pledgeTime := time.Now()
+	nodeID := randomNodeID()
+	txID := randomTxID()
+	pledge := &PledgedEvent{NodeID: nodeID, Amount: 100, Time: pledgeTime, ManaType: AccessMana, TransactionID: txID}
+	revoke := &RevokedEvent{NodeID: randomNodeID(), Amount: 100, Time: pledgeTime, ManaType: ConsensusMana, TransactionID: txID}
+	otherPledge := &PledgedEvent{NodeID: nodeID, Amount: 50, Time: pledgeTime.Add(time.Minute), ManaType: ConsensusMana, TransactionID: randomTxID()}
+	require.NoError(t, auditLog.Record(pledge))
+	require.NoError(t, auditLog.Record(revoke))
+	require.NoError(t, auditLog.Record(otherPledge))
+	assert.Error(t, auditLog.Record(newUpdateEvent()))
+	require.Len(t, recorded, 3)
+
+	records, err := auditLog.Records(&AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	for i, record := range records {
+		assert.Equal(t, uint64(i), record.Index)
+	}
+
+	records, err = auditLog.Records(&AuditFilter{NodeID: &nodeID})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, EventTypePledge, records[0].Type)
+	assert.Equal(t, AccessMana, records[0].ManaType)
+	assert.Equal(t, uint64(2), records[1].Index)
+	assert.Equal(t, otherPledge.TransactionID, records[1].Event().(*PledgedEvent).TransactionID)
+	assert.True(t, otherPledge.Time.Equal(records[1].Time))
+
+	records, err = auditLog.Records(&AuditFilter{TransactionID: &txID})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, EventTypeRevoke, records[1].Type)
+	assert.Equal(t, revoke.NodeID, records[1].NodeID)
+
+	records, err = auditLog.Records(&AuditFilter{NodeID: &nodeID, Since: pledgeTime.Add(time.Second)})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, uint64(2), records[0].Index)
+
+	records, err = auditLog.Records(&AuditFilter{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, uint64(2), records[0].Index)
+
+	// a restored AuditLog continues with the next index
+	restoredAuditLog, err := NewAuditLog(store)
+	require.NoError(t, err)
+	require.NoError(t, restoredAuditLog.Record(pledge))
+	records, err = restoredAuditLog.Records(&AuditFilter{TransactionID: &txID})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, uint64(3), records[2].Index)
+}
@@ -19,4 +19,6 @@ var (
 	ErrInvalidTargetManaType = errors.New("invalid target mana type")
 	// ErrUnknownManaEvent is returned if mana event type could not be identified.
 	ErrUnknownManaEvent = errors.New("unknown mana event")
+	// ErrInvalidProjection is returned if the interval or the number of steps of a mana projection is invalid.
+	ErrInvalidProjection = errors.New("invalid mana projection")
 )
@@ -21,4 +21,7 @@ const (
 
 	// PrefixConsensusPastMetadata is the storage prefix for consensus mana past vector metadata storage.
 	PrefixConsensusPastMetadata
+
+	// PrefixAuditLog is the storage prefix for the mana audit log.
+	PrefixAuditLog
 )
@@ -0,0 +1,61 @@
+package mana
+
+import (
+	"math"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// AccessManaProjection is the projected trajectory of the effective access mana of a node.
+type AccessManaProjection struct {
+	// NodeID is the ID of the node whose access mana is projected.
+	NodeID identity.ID
+	// Points contains the projected access mana at equidistant points in time, starting with the current one.
+	Points []*AccessManaProjectionPoint
+}
+
+// IssuableMessages returns the number of messages of the given size that the node can issue during the projection if
+// the network is congested with messages of that size and the given amount of bytes that the node issued recently is
+// still waiting in the buffer of the scheduler. The scheduler schedules one message per tick of the given rate and
+// grants the node a share of these messages that is proportional to its share of the access mana.
+func (a *AccessManaProjection) IssuableMessages(schedulerRate time.Duration, queuedBytes, messageSize int) int {
+	if messageSize <= 0 || schedulerRate <= 0 || len(a.Points) < 2 {
+		return 0
+	}
+
+	var scheduledMessages float64
+	for i := 1; i < len(a.Points); i++ {
+		averageShare := (a.Points[i-1].Share() + a.Points[i].Share()) / 2
+		scheduledMessages += averageShare * float64(a.Points[i].Time.Sub(a.Points[i-1].Time)) / float64(schedulerRate)
+	}
+
+	issuableBytes := scheduledMessages*float64(messageSize) - float64(queuedBytes)
+	if issuableBytes <= 0 {
+		return 0
+	}
+
+	return int(issuableBytes / float64(messageSize))
+}
+
+// AccessManaProjectionPoint is the projected access mana of a node at a point in time.
+type AccessManaProjectionPoint struct {
+	// Time is the time of the projected values.
+	Time time.Time
+	// Mana is the projected effective access mana of the node.
+	Mana float64
+	// TotalMana is the projected total effective access mana of all nodes.
+	TotalMana float64
+}
+
+// Share returns the projected share of the node of the total access mana. Nodes without access mana are still
+// scheduled with the minimum amount of mana.
+func (a *AccessManaProjectionPoint) Share() float64 {
+	if a.TotalMana <= 0 {
+		return 0
+	}
+
+	return math.Min(math.Max(a.Mana, tangle.MinMana)/a.TotalMana, 1)
+}
@@ -0,0 +1,46 @@
+package mana
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessBaseManaVector_Projection(t *testing.T) {
+	bmv, err := NewBaseManaVector(AccessMana)
+	require.NoError(t, err)
+
+	startTime := time.Now()
+	nodeID := randNodeID()
+	// the node recently received a pledge, so its effective mana still increases
+	bmv.SetMana(nodeID, &AccessBaseMana{BaseMana2: 1000, EffectiveBaseMana2: 100, LastUpdated: startTime})
+	bmv.SetMana(randNodeID(), &AccessBaseMana{BaseMana2: 1000, EffectiveBaseMana2: 1000, LastUpdated: startTime})
+
+	_, err = bmv.(*AccessBaseManaVector).Projection(nodeID, startTime, time.Hour, 0)
+	assert.ErrorIs(t, err, ErrInvalidProjection)
+
+	projection, err := bmv.(*AccessBaseManaVector).Projection(nodeID, startTime, time.Hour, 4)
+	require.NoError(t, err)
+	require.Len(t, projection.Points, 5)
+	assert.Equal(t, 100.0, projection.Points[0].Mana)
+	assert.Equal(t, 1100.0, projection.Points[0].TotalMana)
+	assert.True(t, startTime.Add(time.Hour).Equal(projection.Points[4].Time))
+	for i := 1; i < len(projection.Points); i++ {
+		assert.Greater(t, projection.Points[i].Mana, projection.Points[i-1].Mana)
+		assert.Greater(t, projection.Points[i].Share(), projection.Points[i-1].Share())
+	}
+
+	// the vector itself is not updated
+	mana, _, err := bmv.GetMana(nodeID, startTime)
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, mana)
+
+	// the increasing share of the node raises the number of messages above the one of its current share
+	issuableMessages := projection.IssuableMessages(time.Millisecond, 0, 1000)
+	assert.Greater(t, issuableMessages, int(projection.Points[0].Share()*3600000))
+	assert.Less(t, issuableMessages, int(projection.Points[4].Share()*3600000))
+	assert.Equal(t, issuableMessages-10, projection.IssuableMessages(time.Millisecond, 10000, 1000))
+	assert.Zero(t, projection.IssuableMessages(time.Millisecond, 0, 0))
+}
@@ -43,16 +43,19 @@ const (
 	ConnStatusConnected ConnectionStatus = "connected"
 )
 
-// KnownPeerToAdd defines a type that is used in .AddPeer() method.
+// KnownPeerToAdd defines a type that is used in .AddPeer() method. Addresses contains further addresses of the peer
+// (e.g. its IPv6 address or a DNS name), which are tried in the given order if the peer can't be reached via Address.
 type KnownPeerToAdd struct {
 	PublicKey ed25519.PublicKey `json:"publicKey"`
 	Address   string            `json:"address"`
+	Addresses []string          `json:"addresses,omitempty"`
 }
 
 // KnownPeer defines a peer record in the manual peering layer.
 type KnownPeer struct {
 	PublicKey     ed25519.PublicKey   `json:"publicKey"`
 	Address       string              `json:"address"`
+	Addresses     []string            `json:"addresses,omitempty"`
 	ConnDirection ConnectionDirection `json:"connectionDirection"`
 	ConnStatus    ConnectionStatus    `json:"connectionStatus"`
 }
@@ -163,6 +166,7 @@ func (m *Manager) GetPeers(opts ...GetPeersOption) []*KnownPeer {
 			peers = append(peers, &KnownPeer{
 				PublicKey:     kp.peer.PublicKey(),
 				Address:       kp.peerAddress,
+				Addresses:     kp.peerAddresses,
 				ConnDirection: kp.connDirection,
 				ConnStatus:    connStatus,
 			})
@@ -200,6 +204,7 @@ func (m *Manager) Stop() (err error) {
 type knownPeer struct {
 	peer          *peer.Peer
 	peerAddress   string
+	peerAddresses []string
 	connDirection ConnectionDirection
 	connStatus    *atomic.Value
 	removeCh      chan struct{}
@@ -211,6 +216,11 @@ func newKnownPeer(p *KnownPeerToAdd, connDirection ConnectionDirection) (*knownP
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to parse peer address")
 	}
+	for _, address := range p.Addresses {
+		if _, err = gossip.AddressToMultiaddr(address); err != nil {
+			return nil, errors.Wrap(err, "failed to parse peer address")
+		}
+	}
 	services := service.New()
 	// Peering key is required in order to initialize a peer,
 	// but it's not used in both manual peering and gossip layers so we just specify the default one.
@@ -219,6 +229,7 @@ func newKnownPeer(p *KnownPeerToAdd, connDirection ConnectionDirection) (*knownP
 	kp := &knownPeer{
 		peer:          peer.NewPeer(identity.New(p.PublicKey), tcpAddress.IP, services),
 		peerAddress:   p.Address,
+		peerAddresses: p.Addresses,
 		connDirection: connDirection,
 		connStatus:    &atomic.Value{},
 		removeCh:      make(chan struct{}),
@@ -323,7 +334,7 @@ func (m *Manager) keepPeerConnected(kp *knownPeer) {
 			)
 			var err error
 			if kp.connDirection == ConnDirectionOutbound {
-				err = m.gm.AddOutbound(ctx, kp.peer, gossip.NeighborsGroupManual)
+				err = m.gm.AddOutbound(ctx, kp.peer, gossip.NeighborsGroupManual, gossip.WithAddresses(append([]string{kp.peerAddress}, kp.peerAddresses...)...))
 			} else if kp.connDirection == ConnDirectionInbound {
 				err = m.gm.AddInbound(ctx, kp.peer, gossip.NeighborsGroupManual, gossip.WithNoDefaultTimeout())
 			}
@@ -192,6 +192,18 @@ func (m *Manager) Sequence(sequenceID SequenceID) *objectstorage.CachedObject[*S
 	return m.sequenceStore.Load(sequenceID.Bytes())
 }
 
+// ForEachSequence iterates over all Sequences in the object storage until the consumer returns false.
+func (m *Manager) ForEachSequence(consumer func(sequence *Sequence) bool) {
+	m.sequenceStore.ForEach(func(key []byte, cachedSequence *objectstorage.CachedObject[*Sequence]) (next bool) {
+		next = true
+		cachedSequence.Consume(func(sequence *Sequence) {
+			next = consumer(sequence)
+		})
+
+		return next
+	})
+}
+
 // Shutdown shuts down the Manager and persists its state.
 func (m *Manager) Shutdown() {
 	m.shutdownOnce.Do(func() {
@@ -0,0 +1,210 @@
+package nameregistry
+
+import (
+	"regexp"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+const (
+	// PayloadName defines the name of the name registry payload.
+	PayloadName = "nameRegistry"
+	payloadType = 14
+
+	// MinNameLength defines the minimum length of a registered name.
+	MinNameLength = 3
+
+	// MaxNameLength defines the maximum length of a registered name.
+	MaxNameLength = 64
+)
+
+var (
+	// ErrInvalidName is returned if a name does not match the rules of the registry.
+	ErrInvalidName = errors.New("invalid name")
+
+	// ErrInvalidSignature is returned if the signature of a registration does not match its content.
+	ErrInvalidSignature = errors.New("invalid registration signature")
+
+	// nameRegex defines the characters that are allowed in names: lower case letters, digits and inner hyphens.
+	nameRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+)
+
+// ValidateName checks that the given name only consists of lower case letters, digits and inner hyphens and that its
+// length is between MinNameLength and MaxNameLength.
+func ValidateName(name string) error {
+	if len(name) < MinNameLength || len(name) > MaxNameLength {
+		return errors.Errorf("name %q must have between %d and %d characters: %w", name, MinNameLength, MaxNameLength, ErrInvalidName)
+	}
+	if !nameRegex.MatchString(name) {
+		return errors.Errorf("name %q may only contain lower case letters, digits and inner hyphens: %w", name, ErrInvalidName)
+	}
+
+	return nil
+}
+
+// region Payload //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Payload represents the registration of a human-readable name or the update of its target. It is signed by the owner
+// of the name, so that only the owner that registered a name first can update it later.
+type Payload struct {
+	// Name is the human-readable name that is registered.
+	Name string
+	// Target is the address (e.g. an alias address) that the name resolves to.
+	Target ledgerstate.Address
+	// Sequence is the number of the registration, which has to increase with every update of the name.
+	Sequence uint64
+	// Owner is the public key of the owner of the name.
+	Owner ed25519.PublicKey
+	// Signature is the signature of the owner over the other fields.
+	Signature ed25519.Signature
+}
+
+// NewPayload creates a registration of the given name that resolves to the given target and that is signed by the
+// owner with the given public key.
+func NewPayload(name string, target ledgerstate.Address, sequence uint64, owner ed25519.PublicKey, sign func(message []byte) ed25519.Signature) (registration *Payload) {
+	registration = &Payload{
+		Name:     name,
+		Target:   target,
+		Sequence: sequence,
+		Owner:    owner,
+	}
+	registration.Signature = sign(registration.essence())
+
+	return registration
+}
+
+// FromBytes unmarshals a Payload from a sequence of bytes.
+func FromBytes(bytes []byte) (result *Payload, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(bytes)
+	if result, err = FromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse name registry Payload from MarshalUtil: %w", err)
+		return
+	}
+	consumedBytes = marshalUtil.ReadOffset()
+
+	return
+}
+
+// FromMarshalUtil unmarshals a Payload using a MarshalUtil (for easier unmarshaling).
+func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (result *Payload, err error) {
+	if _, err = marshalUtil.ReadUint32(); err != nil {
+		err = errors.Errorf("failed to parse payload size of name registry payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	parsedType, err := payload.TypeFromMarshalUtil(marshalUtil)
+	if err != nil {
+		err = errors.Errorf("failed to parse payload type of name registry payload: %w", err)
+		return
+	}
+	if parsedType != payloadType {
+		err = errors.Errorf("invalid payload type %s: %w", parsedType, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	result = &Payload{}
+	nameLength, err := marshalUtil.ReadUint8()
+	if err != nil {
+		err = errors.Errorf("failed to parse name length of name registry payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	nameBytes, err := marshalUtil.ReadBytes(int(nameLength))
+	if err != nil {
+		err = errors.Errorf("failed to parse name of name registry payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	result.Name = string(nameBytes)
+	if result.Target, err = ledgerstate.AddressFromMarshalUtil(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse target of name registry payload: %w", err)
+		return
+	}
+	if result.Sequence, err = marshalUtil.ReadUint64(); err != nil {
+		err = errors.Errorf("failed to parse sequence of name registry payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.Owner, err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse owner of name registry payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+	if result.Signature, err = ed25519.ParseSignature(marshalUtil); err != nil {
+		err = errors.Errorf("failed to parse signature of name registry payload (%v): %w", err, cerrors.ErrParseBytesFailed)
+		return
+	}
+
+	return result, nil
+}
+
+// Verify checks that the name is valid and that the registration was signed by its owner.
+func (p *Payload) Verify() error {
+	if err := ValidateName(p.Name); err != nil {
+		return err
+	}
+	if !p.Owner.VerifySignature(p.essence(), p.Signature) {
+		return errors.Errorf("registration of %s does not match its signature: %w", p.Name, ErrInvalidSignature)
+	}
+
+	return nil
+}
+
+// Bytes returns a marshaled version of the Payload.
+func (p *Payload) Bytes() []byte {
+	essence := p.essence()
+	payloadLength := len(essence) + ed25519.SignatureSize
+
+	return marshalutil.New(marshalutil.Uint32Size + payload.TypeLength + payloadLength).
+		WriteUint32(uint32(payload.TypeLength + payloadLength)).
+		WriteBytes(Type.Bytes()).
+		WriteBytes(essence).
+		WriteBytes(p.Signature.Bytes()).
+		Bytes()
+}
+
+// Type returns the type of the Payload.
+func (p *Payload) Type() payload.Type {
+	return Type
+}
+
+// String returns a human-friendly representation of the Payload.
+func (p *Payload) String() string {
+	return stringify.Struct("NameRegistryPayload",
+		stringify.StructField("name", p.Name),
+		stringify.StructField("target", p.Target),
+		stringify.StructField("sequence", p.Sequence),
+		stringify.StructField("owner", p.Owner),
+		stringify.StructField("signature", p.Signature),
+	)
+}
+
+// essence returns the signed part of the Payload.
+func (p *Payload) essence() []byte {
+	targetBytes := p.Target.Bytes()
+
+	return marshalutil.New(marshalutil.Uint8Size + len(p.Name) + len(targetBytes) + marshalutil.Uint64Size + ed25519.PublicKeySize).
+		WriteUint8(uint8(len(p.Name))).
+		WriteBytes([]byte(p.Name)).
+		WriteBytes(targetBytes).
+		WriteUint64(p.Sequence).
+		WriteBytes(p.Owner.Bytes()).
+		Bytes()
+}
+
+// Type represents the identifier which addresses the name registry payload type.
+var Type = payload.NewType(payloadType, PayloadName, func(data []byte) (payload payload.Payload, err error) {
+	var consumedBytes int
+	payload, consumedBytes, err = FromBytes(data)
+	if err != nil {
+		return nil, err
+	}
+	if consumedBytes != len(data) {
+		return nil, errors.New("not all payload bytes were consumed")
+	}
+	return
+})
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,46 @@
+package nameregistry
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestPayload(t *testing.T) {
+	owner := identity.GenerateLocalIdentity()
+	registration := newTestRegistration(owner, "my-dapp", ledgerstate.NewAliasAddress([]byte("alias")), 1)
+	require.NoError(t, registration.Verify())
+
+	parsedPayload, _, err := payload.FromBytes(registration.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, Type, parsedPayload.Type())
+	parsedRegistration := parsedPayload.(*Payload)
+	assert.Equal(t, registration.Name, parsedRegistration.Name)
+	assert.Equal(t, registration.Target.Bytes(), parsedRegistration.Target.Bytes())
+	assert.Equal(t, registration.Sequence, parsedRegistration.Sequence)
+	assert.Equal(t, registration.Owner, parsedRegistration.Owner)
+	assert.NoError(t, parsedRegistration.Verify())
+
+	// a modified registration does not match its signature
+	parsedRegistration.Target = ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	assert.ErrorIs(t, parsedRegistration.Verify(), ErrInvalidSignature)
+}
+
+func TestValidateName(t *testing.T) {
+	for _, name := range []string{"abc", "my-dapp", "dapp42", "a1-b2-c3"} {
+		assert.NoError(t, ValidateName(name), name)
+	}
+	for _, name := range []string{"ab", "-abc", "abc-", "MyDapp", "my_dapp", "my.dapp", string(make([]byte, MaxNameLength+1))} {
+		assert.ErrorIs(t, ValidateName(name), ErrInvalidName, name)
+	}
+}
+
+func newTestRegistration(owner *identity.LocalIdentity, name string, target ledgerstate.Address, sequence uint64) *Payload {
+	return NewPayload(name, target, sequence, owner.PublicKey(), owner.Sign)
+}
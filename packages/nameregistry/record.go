@@ -0,0 +1,141 @@
+package nameregistry
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/generics/objectstorage"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Record ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Record is the entry of the Registry for a registered name, i.e. the latest accepted registration of the name.
+type Record struct {
+	objectstorage.StorableObjectFlags
+
+	name             string
+	target           ledgerstate.Address
+	sequence         uint64
+	owner            ed25519.PublicKey
+	messageID        tangle.MessageID
+	registrationTime time.Time
+	updateTime       time.Time
+}
+
+// Name returns the registered name.
+func (r *Record) Name() string {
+	return r.name
+}
+
+// Target returns the address that the name resolves to.
+func (r *Record) Target() ledgerstate.Address {
+	return r.target
+}
+
+// Sequence returns the number of the latest accepted registration of the name.
+func (r *Record) Sequence() uint64 {
+	return r.sequence
+}
+
+// Owner returns the public key of the owner of the name.
+func (r *Record) Owner() ed25519.PublicKey {
+	return r.owner
+}
+
+// MessageID returns the ID of the message that contained the latest accepted registration of the name.
+func (r *Record) MessageID() tangle.MessageID {
+	return r.messageID
+}
+
+// RegistrationTime returns the issuing time of the message that registered the name first.
+func (r *Record) RegistrationTime() time.Time {
+	return r.registrationTime
+}
+
+// UpdateTime returns the issuing time of the message that contained the latest accepted registration of the name.
+func (r *Record) UpdateTime() time.Time {
+	return r.updateTime
+}
+
+// clone returns a copy of the Record that is not managed by the object storage.
+func (r *Record) clone() *Record {
+	return &Record{
+		name:             r.name,
+		target:           r.target,
+		sequence:         r.sequence,
+		owner:            r.owner,
+		messageID:        r.messageID,
+		registrationTime: r.registrationTime,
+		updateTime:       r.updateTime,
+	}
+}
+
+// FromObjectStorage creates a Record from sequences of key and bytes.
+func (r *Record) FromObjectStorage(key, bytes []byte) (objectstorage.StorableObject, error) {
+	record := &Record{name: string(key)}
+
+	var err error
+	marshalUtil := marshalutil.New(bytes)
+	if record.target, err = ledgerstate.AddressFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse target of record: %w", err)
+	}
+	if record.sequence, err = marshalUtil.ReadUint64(); err != nil {
+		return nil, errors.Errorf("failed to parse sequence of record (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	if record.owner, err = ed25519.ParsePublicKey(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse owner of record (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	if record.messageID, err = tangle.ReferenceFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse message ID of record: %w", err)
+	}
+	if record.registrationTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse registration time of record (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	if record.updateTime, err = marshalUtil.ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse update time of record (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+
+	return record, nil
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database.
+func (r *Record) ObjectStorageKey() []byte {
+	return []byte(r.name)
+}
+
+// ObjectStorageValue marshals the Record into a sequence of bytes.
+func (r *Record) ObjectStorageValue() []byte {
+	return marshalutil.New().
+		WriteBytes(r.target.Bytes()).
+		WriteUint64(r.sequence).
+		WriteBytes(r.owner.Bytes()).
+		WriteBytes(r.messageID.Bytes()).
+		WriteTime(r.registrationTime).
+		WriteTime(r.updateTime).
+		Bytes()
+}
+
+// String returns a human-readable version of the Record.
+func (r *Record) String() string {
+	return stringify.Struct("Record",
+		stringify.StructField("name", r.name),
+		stringify.StructField("target", r.target),
+		stringify.StructField("sequence", r.sequence),
+		stringify.StructField("owner", r.owner),
+		stringify.StructField("messageID", r.messageID),
+		stringify.StructField("registrationTime", r.registrationTime),
+		stringify.StructField("updateTime", r.updateTime),
+	)
+}
+
+// Interface contract: make compiler warn if the interface is not implemented correctly.
+var _ objectstorage.StorableObject = new(Record)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
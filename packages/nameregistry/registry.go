@@ -0,0 +1,106 @@
+// Package nameregistry maintains an index of human-readable names that resolve to addresses (e.g. alias addresses).
+// Names are registered with signed payloads on the Tangle on a first-come basis and can only be updated by their owner.
+package nameregistry
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/generics/objectstorage"
+	"github.com/iotaledger/hive.go/kvstore"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var (
+	// ErrNameTaken is returned if a name is registered by a different owner already.
+	ErrNameTaken = errors.New("name is registered by a different owner")
+
+	// ErrOutdatedRegistration is returned if the sequence of a registration is not greater than the sequence of the
+	// latest accepted registration of the name.
+	ErrOutdatedRegistration = errors.New("registration is outdated")
+)
+
+// region Registry /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Registry keeps track of the registered names. The registrations are applied in the order in which their messages are
+// booked: the first registration of a name determines its owner, while later registrations of the name are only
+// accepted if they are signed by the owner and have a greater sequence than the latest accepted registration. The
+// records are persisted in their own realm of the database, so they are retained when the Tangle is pruned.
+type Registry struct {
+	recordStorage *objectstorage.ObjectStorage[*Record]
+	mutex         sync.RWMutex
+}
+
+// New creates a Registry that persists its records in the given store.
+func New(store kvstore.KVStore) (registry *Registry) {
+	return &Registry{
+		recordStorage: objectstorage.New[*Record](store.WithRealm([]byte{database.PrefixNameRegistry}), objectstorage.LeakDetectionEnabled(false), objectstorage.StoreOnCreation(true)),
+	}
+}
+
+// Apply applies the given registration that was contained in the message with the given ID and issuing time. It returns
+// the updated Record or an error if the registration was rejected.
+func (r *Registry) Apply(registration *Payload, messageID tangle.MessageID, issuingTime time.Time) (record *Record, err error) {
+	if err = registration.Verify(); err != nil {
+		return nil, err
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	cachedRecord := r.recordStorage.Load([]byte(registration.Name))
+	defer cachedRecord.Release()
+
+	record, exists := cachedRecord.Unwrap()
+	if !exists {
+		record = &Record{
+			name:             registration.Name,
+			target:           registration.Target,
+			sequence:         registration.Sequence,
+			owner:            registration.Owner,
+			messageID:        messageID,
+			registrationTime: issuingTime,
+			updateTime:       issuingTime,
+		}
+		r.recordStorage.Store(record).Release()
+
+		return record.clone(), nil
+	}
+
+	if record.owner != registration.Owner {
+		return nil, errors.Errorf("failed to update %s: %w", registration.Name, ErrNameTaken)
+	}
+	if registration.Sequence <= record.sequence {
+		return nil, errors.Errorf("sequence %d of %s is not greater than %d: %w", registration.Sequence, registration.Name, record.sequence, ErrOutdatedRegistration)
+	}
+
+	record.target = registration.Target
+	record.sequence = registration.Sequence
+	record.messageID = messageID
+	record.updateTime = issuingTime
+	record.SetModified()
+
+	return record.clone(), nil
+}
+
+// Resolve returns the Record of the given name.
+func (r *Registry) Resolve(name string) (record *Record, exists bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	r.recordStorage.Load([]byte(name)).Consume(func(storedRecord *Record) {
+		record = storedRecord.clone()
+	})
+
+	return record, record != nil
+}
+
+// Shutdown persists the records of the Registry.
+func (r *Registry) Shutdown() {
+	r.recordStorage.Shutdown()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,56 @@
+package nameregistry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestRegistry(t *testing.T) {
+	store := mapdb.NewMapDB()
+	registry := New(store)
+	owner, other := identity.GenerateLocalIdentity(), identity.GenerateLocalIdentity()
+	target := ledgerstate.NewAliasAddress([]byte("alias"))
+	newTarget := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+
+	_, exists := registry.Resolve("my-dapp")
+	assert.False(t, exists)
+
+	// the first registration determines the owner of the name
+	record, err := registry.Apply(newTestRegistration(owner, "my-dapp", target, 1), tangle.MessageID{1}, time.Unix(100, 0))
+	require.NoError(t, err)
+	assert.Equal(t, owner.PublicKey(), record.Owner())
+
+	// registrations of other owners, outdated and forged registrations are rejected
+	_, err = registry.Apply(newTestRegistration(other, "my-dapp", newTarget, 2), tangle.MessageID{2}, time.Unix(200, 0))
+	assert.ErrorIs(t, err, ErrNameTaken)
+	_, err = registry.Apply(newTestRegistration(owner, "my-dapp", newTarget, 1), tangle.MessageID{2}, time.Unix(200, 0))
+	assert.ErrorIs(t, err, ErrOutdatedRegistration)
+	forgedRegistration := newTestRegistration(owner, "my-dapp", target, 5)
+	forgedRegistration.Target = newTarget
+	_, err = registry.Apply(forgedRegistration, tangle.MessageID{2}, time.Unix(200, 0))
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	// the owner can update the target of the name
+	_, err = registry.Apply(newTestRegistration(owner, "my-dapp", newTarget, 2), tangle.MessageID{3}, time.Unix(300, 0))
+	require.NoError(t, err)
+	registry.Shutdown()
+
+	// the records are persisted
+	record, exists = New(store).Resolve("my-dapp")
+	require.True(t, exists)
+	assert.Equal(t, newTarget.Bytes(), record.Target().Bytes())
+	assert.EqualValues(t, 2, record.Sequence())
+	assert.Equal(t, owner.PublicKey(), record.Owner())
+	assert.Equal(t, tangle.MessageID{3}, record.MessageID())
+	assert.True(t, time.Unix(100, 0).Equal(record.RegistrationTime()))
+	assert.True(t, time.Unix(300, 0).Equal(record.UpdateTime()))
+}
@@ -0,0 +1,30 @@
+package pool
+
+import (
+	"github.com/iotaledger/hive.go/marshalutil"
+)
+
+// marshalBufferPool contains the buffers that are used by Marshal.
+var marshalBufferPool = New(func() *[]byte {
+	buffer := make([]byte, 0, 1024)
+	return &buffer
+}, func(buffer *[]byte) {
+	*buffer = (*buffer)[:0]
+})
+
+// Marshal marshals an object with a MarshalUtil that writes to a pooled buffer and returns an exactly sized copy of the
+// written bytes. The returned bytes can therefore be retained (e.g. by a cached object or a batched write) without
+// keeping the pooled buffer alive.
+func Marshal(marshal func(marshalUtil *marshalutil.MarshalUtil)) (bytes []byte) {
+	buffer := marshalBufferPool.Get()
+
+	marshalUtil := marshalutil.New(*buffer)
+	marshal(marshalUtil)
+	bytes = marshalUtil.Bytes(true)
+
+	// keep the capacity that the MarshalUtil might have added to the buffer
+	*buffer = marshalUtil.Bytes()
+	marshalBufferPool.Put(buffer)
+
+	return bytes
+}
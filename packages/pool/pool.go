@@ -0,0 +1,68 @@
+// Package pool provides typed object pools for the reuse of frequently allocated objects on hot paths.
+//
+// Only objects whose lifetime ends within a single call or component should be returned to a Pool, e.g.:
+//
+//   - the buffers of the batch verification and of the signed message content,
+//   - the buffers that are used to marshal messages and their metadata, and to hash transactions,
+//   - the BranchIDs that collect the booking details of the single parents of a message,
+//   - the boxes of the elements that are waiting in the queues of the scheduler,
+//   - the MessageMetadata that is created to guard the storage of a message that turns out to be stored already.
+//
+// Objects that are handed out to other components (e.g. the MessageMetadata that is cached by the object storage) must
+// not be returned, as they might still be in use afterwards. They are released with Detach instead.
+//
+// Builds with the pooldebug tag track the objects that were obtained from a Pool but not returned yet, and panic if an
+// object is returned twice or was not obtained from the Pool.
+package pool
+
+import (
+	"sync"
+)
+
+// Pool is a typed wrapper around a sync.Pool that resets the objects before they are reused.
+type Pool[T any] struct {
+	pool  sync.Pool
+	reset func(object *T)
+
+	// outstanding contains the objects that were obtained but not returned yet (only used by pooldebug builds).
+	outstanding      map[*T]string
+	outstandingMutex sync.Mutex
+}
+
+// New creates a new Pool that creates new objects with the given function and resets returned objects with the given
+// reset function (if it is not nil).
+func New[T any](newFunc func() *T, reset func(object *T)) *Pool[T] {
+	return &Pool[T]{
+		pool: sync.Pool{
+			New: func() interface{} {
+				return newFunc()
+			},
+		},
+		reset:       reset,
+		outstanding: make(map[*T]string),
+	}
+}
+
+// Get returns an object from the Pool or creates a new one if the Pool is empty.
+func (p *Pool[T]) Get() (object *T) {
+	object = p.pool.Get().(*T)
+	p.track(object)
+
+	return object
+}
+
+// Put resets the given object and returns it to the Pool. The object must not be used anymore afterwards.
+func (p *Pool[T]) Put(object *T) {
+	p.untrack(object)
+	if p.reset != nil {
+		p.reset(object)
+	}
+
+	p.pool.Put(object)
+}
+
+// Detach releases the given object from the Pool without returning it, because it was handed out to another component
+// that keeps using it. Detached objects are not reported as outstanding objects.
+func (p *Pool[T]) Detach(object *T) {
+	p.untrack(object)
+}
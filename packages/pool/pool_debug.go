@@ -0,0 +1,50 @@
+//go:build pooldebug
+
+package pool
+
+import (
+	"fmt"
+	"runtime"
+	"sort"
+)
+
+// Debug is true if the package was built with the pooldebug tag.
+const Debug = true
+
+// Outstanding returns the call sites that obtained objects which were not returned yet.
+func (p *Pool[T]) Outstanding() (callSites []string) {
+	p.outstandingMutex.Lock()
+	defer p.outstandingMutex.Unlock()
+
+	callSites = make([]string, 0, len(p.outstanding))
+	for _, callSite := range p.outstanding {
+		callSites = append(callSites, callSite)
+	}
+	sort.Strings(callSites)
+
+	return callSites
+}
+
+// track records the caller of Get for the given object.
+func (p *Pool[T]) track(object *T) {
+	callSite := "unknown"
+	if _, file, line, ok := runtime.Caller(2); ok {
+		callSite = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	p.outstandingMutex.Lock()
+	defer p.outstandingMutex.Unlock()
+
+	p.outstanding[object] = callSite
+}
+
+// untrack removes the given object from the outstanding objects and panics if it was not obtained from the Pool.
+func (p *Pool[T]) untrack(object *T) {
+	p.outstandingMutex.Lock()
+	defer p.outstandingMutex.Unlock()
+
+	if _, exists := p.outstanding[object]; !exists {
+		panic(fmt.Sprintf("pool: %T was returned twice or was not obtained from the pool", object))
+	}
+	delete(p.outstanding, object)
+}
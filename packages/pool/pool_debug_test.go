@@ -0,0 +1,31 @@
+//go:build pooldebug
+
+package pool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool_Debug(t *testing.T) {
+	p := New(func() *int { return new(int) }, nil)
+
+	object := p.Get()
+	assert.Len(t, p.Outstanding(), 1)
+	assert.Contains(t, p.Outstanding()[0], "pool_debug_test.go")
+
+	p.Put(object)
+	assert.Empty(t, p.Outstanding())
+	assert.Panics(t, func() { p.Put(object) })
+	assert.Panics(t, func() { p.Put(new(int)) })
+}
+
+func TestPool_Detach(t *testing.T) {
+	p := New(func() *int { return new(int) }, nil)
+
+	object := p.Get()
+	p.Detach(object)
+	assert.Empty(t, p.Outstanding())
+	assert.Panics(t, func() { p.Put(object) })
+}
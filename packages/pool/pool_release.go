@@ -0,0 +1,16 @@
+//go:build !pooldebug
+
+package pool
+
+// Debug is true if the package was built with the pooldebug tag.
+const Debug = false
+
+// Outstanding returns the call sites that obtained objects which were not returned yet. It always returns nil unless
+// the package was built with the pooldebug tag.
+func (p *Pool[T]) Outstanding() []string {
+	return nil
+}
+
+func (p *Pool[T]) track(*T) {}
+
+func (p *Pool[T]) untrack(*T) {}
@@ -0,0 +1,44 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPool(t *testing.T) {
+	created := 0
+	p := New(func() *[]byte {
+		created++
+		buffer := make([]byte, 0, 16)
+		return &buffer
+	}, func(buffer *[]byte) {
+		*buffer = (*buffer)[:0]
+	})
+
+	buffer := p.Get()
+	*buffer = append(*buffer, 1, 2, 3)
+	if Debug {
+		assert.Len(t, p.Outstanding(), 1)
+	}
+	p.Put(buffer)
+
+	assert.Empty(t, p.Outstanding())
+	assert.Empty(t, *p.Get())
+	assert.GreaterOrEqual(t, created, 1)
+}
+
+func TestMarshal(t *testing.T) {
+	bytes := Marshal(func(marshalUtil *marshalutil.MarshalUtil) {
+		marshalUtil.WriteUint64(1).WriteBytes(make([]byte, 2048))
+	})
+	assert.Len(t, bytes, marshalutil.Uint64Size+2048)
+	assert.Equal(t, len(bytes), cap(bytes))
+	assert.Equal(t, byte(1), bytes[0])
+
+	assert.Equal(t, []byte{2}, Marshal(func(marshalUtil *marshalutil.MarshalUtil) {
+		marshalUtil.WriteByte(2)
+	}))
+	assert.Empty(t, marshalBufferPool.Outstanding())
+}
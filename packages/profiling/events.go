@@ -0,0 +1,25 @@
+package profiling
+
+import (
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events represents events happening in the Recorder.
+type Events struct {
+	// CaptureStored is triggered when the profiles of a Capture were stored.
+	CaptureStored *event.Event[*Capture]
+
+	// CaptureFailed is triggered when a triggered Capture could not be stored.
+	CaptureFailed *event.Event[*CaptureFailedEvent]
+}
+
+// CaptureFailedEvent holds information about a failed Capture.
+type CaptureFailedEvent struct {
+	Trigger string
+	Reason  string
+	Error   error
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
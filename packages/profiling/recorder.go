@@ -0,0 +1,362 @@
+package profiling
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"runtime/pprof"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// captureMetadataFileName is the name of the file that contains the metadata of a Capture.
+const captureMetadataFileName = "capture.json"
+
+// ErrCaptureNotFound is returned when a requested Capture or profile does not exist.
+var ErrCaptureNotFound = errors.New("capture not found")
+
+// region Recorder /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Recorder captures the CPU, heap and goroutine profiles of the node when an anomaly was detected and stores them in a
+// directory, so that the moment a node degraded can be analyzed later.
+type Recorder struct {
+	// Events contains the events that are triggered by the Recorder.
+	Events *Events
+
+	directory          string
+	cpuProfileDuration time.Duration
+	cooldown           time.Duration
+	maxCaptures        int
+
+	lastCapture time.Time
+	capturing   bool
+	mutex       sync.Mutex
+}
+
+// NewRecorder is the constructor of the Recorder that stores its Captures in the given directory.
+func NewRecorder(directory string, opts ...Option) (recorder *Recorder, err error) {
+	if err = os.MkdirAll(directory, 0o700); err != nil {
+		return nil, errors.Errorf("failed to create profile directory %s: %w", directory, err)
+	}
+
+	recorder = &Recorder{
+		Events: &Events{
+			CaptureStored: event.New[*Capture]("Recorder.CaptureStored"),
+			CaptureFailed: event.New[*CaptureFailedEvent]("Recorder.CaptureFailed"),
+		},
+		directory:          directory,
+		cpuProfileDuration: 10 * time.Second,
+		cooldown:           10 * time.Minute,
+		maxCaptures:        10,
+	}
+
+	for _, opt := range opts {
+		opt(recorder)
+	}
+
+	return recorder, nil
+}
+
+// Trigger starts a Capture in the background unless another Capture is running or the cooldown since the last Capture
+// has not passed yet. It returns true if a Capture was started.
+func (r *Recorder) Trigger(trigger, reason string) (started bool) {
+	if !r.startCapture(false) {
+		return false
+	}
+
+	go func() {
+		capture, err := r.capture(trigger, reason)
+		if err != nil {
+			r.Events.CaptureFailed.Trigger(&CaptureFailedEvent{Trigger: trigger, Reason: reason, Error: err})
+			return
+		}
+		r.Events.CaptureStored.Trigger(capture)
+	}()
+
+	return true
+}
+
+// Capture synchronously captures the profiles of the node regardless of the cooldown and returns the stored Capture.
+func (r *Recorder) Capture(trigger, reason string) (capture *Capture, err error) {
+	if !r.startCapture(true) {
+		return nil, errors.New("another capture is already running")
+	}
+
+	if capture, err = r.capture(trigger, reason); err != nil {
+		return nil, err
+	}
+	r.Events.CaptureStored.Trigger(capture)
+
+	return capture, nil
+}
+
+// Captures returns all stored Captures ordered by their time (newest first).
+func (r *Recorder) Captures() (captures []*Capture, err error) {
+	entries, err := os.ReadDir(r.directory)
+	if err != nil {
+		return nil, errors.Errorf("failed to read profile directory %s: %w", r.directory, err)
+	}
+
+	captures = make([]*Capture, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		capture, loadErr := r.loadCapture(entry.Name())
+		if loadErr != nil {
+			continue
+		}
+		captures = append(captures, capture)
+	}
+
+	sort.Slice(captures, func(i, j int) bool {
+		return captures[i].Time.After(captures[j].Time)
+	})
+
+	return captures, nil
+}
+
+// ProfilePath returns the path of the profile of the given Kind that belongs to the Capture with the given ID.
+func (r *Recorder) ProfilePath(captureID string, kind Kind) (path string, err error) {
+	capture, err := r.loadCapture(captureID)
+	if err != nil {
+		return "", err
+	}
+
+	fileName, exists := capture.Profiles[kind]
+	if !exists {
+		return "", errors.Errorf("capture %s does not contain a %s profile: %w", captureID, kind, ErrCaptureNotFound)
+	}
+
+	return filepath.Join(r.directory, capture.ID, fileName), nil
+}
+
+// startCapture marks the Recorder as capturing if no other Capture is running and the cooldown has passed (or is
+// ignored).
+func (r *Recorder) startCapture(ignoreCooldown bool) (started bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.capturing || !ignoreCooldown && time.Since(r.lastCapture) < r.cooldown {
+		return false
+	}
+	r.capturing = true
+
+	return true
+}
+
+// capture writes the profiles of the node to a new Capture directory.
+func (r *Recorder) capture(trigger, reason string) (capture *Capture, err error) {
+	defer func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		r.capturing = false
+		r.lastCapture = time.Now()
+	}()
+
+	now := time.Now()
+	capture = &Capture{
+		ID:       captureID(now, trigger),
+		Trigger:  trigger,
+		Reason:   reason,
+		Time:     now,
+		Profiles: make(map[Kind]string),
+	}
+
+	captureDirectory := filepath.Join(r.directory, capture.ID)
+	if err = os.MkdirAll(captureDirectory, 0o700); err != nil {
+		return nil, errors.Errorf("failed to create capture directory %s: %w", captureDirectory, err)
+	}
+
+	for _, kind := range []Kind{KindGoroutine, KindHeap, KindCPU} {
+		fileName := string(kind) + ".pprof"
+		if writeErr := r.writeProfile(filepath.Join(captureDirectory, fileName), kind); writeErr != nil {
+			// the CPU profile can not be captured while another CPU profile is running, so we keep the other profiles
+			capture.Errors = append(capture.Errors, writeErr.Error())
+			continue
+		}
+		capture.Profiles[kind] = fileName
+	}
+
+	if err = r.storeCapture(capture); err != nil {
+		return nil, err
+	}
+	r.pruneCaptures()
+
+	return capture, nil
+}
+
+// writeProfile writes the profile of the given Kind to the file with the given path.
+func (r *Recorder) writeProfile(path string, kind Kind) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return errors.Errorf("failed to create %s profile file: %w", kind, err)
+	}
+	defer func() {
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			err = errors.Errorf("failed to close %s profile file: %w", kind, closeErr)
+		}
+		if err != nil {
+			_ = os.Remove(path)
+		}
+	}()
+
+	switch kind {
+	case KindCPU:
+		if err = pprof.StartCPUProfile(file); err != nil {
+			return errors.Errorf("failed to start CPU profile: %w", err)
+		}
+		time.Sleep(r.cpuProfileDuration)
+		pprof.StopCPUProfile()
+	default:
+		if err = pprof.Lookup(string(kind)).WriteTo(file, 0); err != nil {
+			return errors.Errorf("failed to write %s profile: %w", kind, err)
+		}
+	}
+
+	return nil
+}
+
+// storeCapture writes the metadata of the given Capture to its directory.
+func (r *Recorder) storeCapture(capture *Capture) (err error) {
+	metadata, err := json.Marshal(capture)
+	if err != nil {
+		return errors.Errorf("failed to marshal capture %s: %w", capture.ID, err)
+	}
+
+	if err = os.WriteFile(filepath.Join(r.directory, capture.ID, captureMetadataFileName), metadata, 0o600); err != nil {
+		return errors.Errorf("failed to store capture %s: %w", capture.ID, err)
+	}
+
+	return nil
+}
+
+// loadCapture reads the metadata of the Capture with the given ID.
+func (r *Recorder) loadCapture(captureID string) (capture *Capture, err error) {
+	if captureID != filepath.Base(captureID) || strings.HasPrefix(captureID, ".") {
+		return nil, errors.Errorf("invalid capture id %s: %w", captureID, ErrCaptureNotFound)
+	}
+
+	metadata, err := os.ReadFile(filepath.Join(r.directory, captureID, captureMetadataFileName))
+	if err != nil {
+		return nil, errors.Errorf("failed to read capture %s: %w", captureID, ErrCaptureNotFound)
+	}
+
+	capture = new(Capture)
+	if err = json.Unmarshal(metadata, capture); err != nil {
+		return nil, errors.Errorf("failed to unmarshal capture %s: %w", captureID, err)
+	}
+
+	return capture, nil
+}
+
+// pruneCaptures removes the oldest Captures that exceed the maximum number of stored Captures.
+func (r *Recorder) pruneCaptures() {
+	if r.maxCaptures <= 0 {
+		return
+	}
+
+	captures, err := r.Captures()
+	if err != nil {
+		return
+	}
+
+	for _, capture := range captures[min(len(captures), r.maxCaptures):] {
+		_ = os.RemoveAll(filepath.Join(r.directory, capture.ID))
+	}
+}
+
+// captureID returns the ID of a Capture that was triggered at the given time.
+func captureID(captureTime time.Time, trigger string) string {
+	return captureTime.UTC().Format("20060102T150405.000Z") + "-" + strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, trigger)
+}
+
+// min returns the smaller of the given integers.
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option represents the return type of optional parameters that can be handed into the constructor of the Recorder.
+type Option func(recorder *Recorder)
+
+// WithCPUProfileDuration is an Option for the Recorder that defines for how long the CPU profile is recorded.
+func WithCPUProfileDuration(duration time.Duration) Option {
+	return func(recorder *Recorder) {
+		recorder.cpuProfileDuration = duration
+	}
+}
+
+// WithCooldown is an Option for the Recorder that defines the minimum time between two triggered Captures.
+func WithCooldown(cooldown time.Duration) Option {
+	return func(recorder *Recorder) {
+		recorder.cooldown = cooldown
+	}
+}
+
+// WithMaxCaptures is an Option for the Recorder that defines how many Captures are kept (<= 0 keeps all Captures).
+func WithMaxCaptures(maxCaptures int) Option {
+	return func(recorder *Recorder) {
+		recorder.maxCaptures = maxCaptures
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Capture //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Capture contains the metadata of the profiles that were captured at the same time.
+type Capture struct {
+	// ID is the unique identifier of the Capture, which is also the name of its directory.
+	ID string `json:"id"`
+	// Trigger is the name of the trigger that started the Capture.
+	Trigger string `json:"trigger"`
+	// Reason describes the anomaly that was detected by the trigger.
+	Reason string `json:"reason"`
+	// Time is the time when the Capture was started.
+	Time time.Time `json:"time"`
+	// Profiles contains the file names of the captured profiles.
+	Profiles map[Kind]string `json:"profiles"`
+	// Errors contains the errors of the profiles that could not be captured.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Kind /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Kind is the type of a captured profile.
+type Kind string
+
+const (
+	// KindCPU is the Kind of the CPU profile.
+	KindCPU Kind = "cpu"
+
+	// KindHeap is the Kind of the heap profile.
+	KindHeap Kind = "heap"
+
+	// KindGoroutine is the Kind of the goroutine profile.
+	KindGoroutine Kind = "goroutine"
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,68 @@
+package profiling
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestRecorder_Capture(t *testing.T) {
+	recorder, err := NewRecorder(t.TempDir(), WithCPUProfileDuration(10*time.Millisecond), WithMaxCaptures(2))
+	require.NoError(t, err)
+
+	var storedCaptures []*Capture
+	recorder.Events.CaptureStored.Attach(event.NewClosure(func(capture *Capture) {
+		storedCaptures = append(storedCaptures, capture)
+	}))
+
+	for i := 0; i < 3; i++ {
+		capture, captureErr := recorder.Capture("manual", "test")
+		require.NoError(t, captureErr)
+		assert.Len(t, capture.Profiles, 3)
+		time.Sleep(time.Millisecond)
+	}
+	require.Len(t, storedCaptures, 3)
+
+	// only the newest captures are kept
+	captures, err := recorder.Captures()
+	require.NoError(t, err)
+	require.Len(t, captures, 2)
+	assert.Equal(t, storedCaptures[2].ID, captures[0].ID)
+	assert.Equal(t, storedCaptures[1].ID, captures[1].ID)
+
+	path, err := recorder.ProfilePath(captures[0].ID, KindHeap)
+	require.NoError(t, err)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Size())
+
+	_, err = recorder.ProfilePath(storedCaptures[0].ID, KindHeap)
+	assert.ErrorIs(t, err, ErrCaptureNotFound)
+	_, err = recorder.ProfilePath("../"+captures[0].ID, KindHeap)
+	assert.ErrorIs(t, err, ErrCaptureNotFound)
+}
+
+func TestRecorder_TriggerCooldown(t *testing.T) {
+	recorder, err := NewRecorder(t.TempDir(), WithCPUProfileDuration(10*time.Millisecond), WithCooldown(time.Hour))
+	require.NoError(t, err)
+
+	stored := make(chan *Capture, 1)
+	recorder.Events.CaptureStored.Attach(event.NewClosure(func(capture *Capture) {
+		stored <- capture
+	}))
+
+	assert.True(t, recorder.Trigger("heapUsage", "heap usage exceeded"))
+	assert.False(t, recorder.Trigger("heapUsage", "heap usage exceeded"))
+
+	capture := <-stored
+	assert.Equal(t, "heapUsage", capture.Trigger)
+	assert.Equal(t, "heap usage exceeded", capture.Reason)
+
+	// the cooldown suppresses further triggers
+	assert.False(t, recorder.Trigger("goroutines", "goroutines exceeded"))
+}
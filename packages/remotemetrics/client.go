@@ -0,0 +1,141 @@
+package remotemetrics
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/libp2putil"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
+)
+
+// ProtocolVersion is the version of the remote metrics protocol that is announced in every Envelope. It has to be
+// increased whenever a field of the schema is removed or reinterpreted.
+const ProtocolVersion uint32 = 2
+
+const (
+	dialTimeout       = 5 * time.Second
+	writeTimeout      = 5 * time.Second
+	minReconnectDelay = time.Second
+	maxReconnectDelay = time.Minute
+)
+
+// region Client ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Client sends Envelopes to a remote metrics collector over TCP. The Envelopes are queued and written in the
+// background, so that sending metrics never blocks the node: if the queue is full or the collector is unreachable, the
+// Envelopes are dropped.
+type Client struct {
+	address      string
+	nodeID       string
+	metricsLevel uint8
+	queue        chan *remotemetricsproto.Envelope
+
+	sentEnvelopes    atomic.Uint64
+	droppedEnvelopes atomic.Uint64
+}
+
+// NewClient is the constructor of a Client that sends the metrics of the given node to the collector at the given
+// address.
+func NewClient(address, nodeID string, metricsLevel uint8, opts ...ClientOption) (client *Client) {
+	client = &Client{
+		address:      address,
+		nodeID:       nodeID,
+		metricsLevel: metricsLevel,
+		queue:        make(chan *remotemetricsproto.Envelope, 1000),
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client
+}
+
+// Send queues the given Envelope after setting its version, its node and its timestamp.
+func (c *Client) Send(envelope *remotemetricsproto.Envelope) {
+	envelope.Version = ProtocolVersion
+	envelope.NodeID = c.nodeID
+	envelope.MetricsLevel = uint32(c.metricsLevel)
+	envelope.Timestamp = time.Now().UnixNano()
+
+	select {
+	case c.queue <- envelope:
+	default:
+		c.droppedEnvelopes.Inc()
+	}
+}
+
+// Run connects to the collector and writes the queued Envelopes until the given context is done. The connection is
+// re-established with an increasing delay whenever it fails.
+func (c *Client) Run(ctx context.Context) {
+	reconnectDelay := minReconnectDelay
+	for {
+		if conn, err := (&net.Dialer{Timeout: dialTimeout}).DialContext(ctx, "tcp", c.address); err == nil {
+			reconnectDelay = minReconnectDelay
+			c.writeEnvelopes(ctx, conn)
+			_ = conn.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+
+		if reconnectDelay *= 2; reconnectDelay > maxReconnectDelay {
+			reconnectDelay = maxReconnectDelay
+		}
+	}
+}
+
+// SentEnvelopes returns the number of Envelopes that were written to the collector.
+func (c *Client) SentEnvelopes() uint64 {
+	return c.sentEnvelopes.Load()
+}
+
+// DroppedEnvelopes returns the number of Envelopes that were dropped because the queue was full or the connection
+// failed.
+func (c *Client) DroppedEnvelopes() uint64 {
+	return c.droppedEnvelopes.Load()
+}
+
+// writeEnvelopes writes the queued Envelopes to the given connection until the context is done or a write fails.
+func (c *Client) writeEnvelopes(ctx context.Context, conn net.Conn) {
+	writer := libp2putil.NewDelimitedWriter(conn)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case envelope := <-c.queue:
+			if err := conn.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+				c.droppedEnvelopes.Inc()
+				return
+			}
+			if err := writer.WriteMsg(envelope); err != nil {
+				c.droppedEnvelopes.Inc()
+				return
+			}
+			c.sentEnvelopes.Inc()
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ClientOption /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ClientOption is the type of the optional parameters of the Client.
+type ClientOption func(client *Client)
+
+// WithQueueSize is a ClientOption that sets the maximum number of Envelopes that are queued while the collector is
+// unreachable.
+func WithQueueSize(queueSize int) ClientOption {
+	return func(client *Client) {
+		client.queue = make(chan *remotemetricsproto.Envelope, queueSize)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
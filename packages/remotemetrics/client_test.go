@@ -0,0 +1,127 @@
+package remotemetrics
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/libp2putil"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
+)
+
+func TestClient_Collector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	received := make(chan *remotemetricsproto.Envelope, 10)
+	collector := NewCollector()
+	collector.Events.EnvelopeReceived.Attach(event.NewClosure(func(event *EnvelopeReceivedEvent) {
+		received <- event.Envelope
+	}))
+	go func() {
+		assert.NoError(t, collector.Serve(ctx, listener))
+	}()
+
+	client := NewClient(listener.Addr().String(), "node", 1)
+	go client.Run(ctx)
+
+	client.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Heartbeat{Heartbeat: &remotemetricsproto.Heartbeat{
+			AppVersion: "v0.0.1",
+			Interval:   time.Second.Nanoseconds(),
+		}},
+	})
+	client.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Sync{Sync: &remotemetricsproto.SyncMetrics{CurrentStatus: true}},
+	})
+
+	heartbeat := receiveEnvelope(t, received)
+	assert.Equal(t, ProtocolVersion, heartbeat.Version)
+	assert.Equal(t, "node", heartbeat.NodeID)
+	assert.EqualValues(t, 1, heartbeat.MetricsLevel)
+	assert.Equal(t, "v0.0.1", heartbeat.GetHeartbeat().AppVersion)
+	assert.True(t, receiveEnvelope(t, received).GetSync().CurrentStatus)
+	assert.EqualValues(t, 2, client.SentEnvelopes())
+
+	nodes := collector.Nodes()
+	require.Len(t, nodes, 1)
+	assert.Equal(t, "v0.0.1", nodes[0].AppVersion)
+	assert.EqualValues(t, 2, nodes[0].ReceivedEnvelopes)
+
+	// the node is reported once after it missed several heartbeats
+	silentNodes := make([]*NodeStatus, 0)
+	collector.Events.NodeSilent.Attach(event.NewClosure(func(node *NodeStatus) {
+		silentNodes = append(silentNodes, node)
+	}))
+	collector.CheckHeartbeats(nodes[0].LastHeartbeat.Add(2 * time.Second))
+	assert.Empty(t, silentNodes)
+	collector.CheckHeartbeats(nodes[0].LastHeartbeat.Add(4 * time.Second))
+	collector.CheckHeartbeats(nodes[0].LastHeartbeat.Add(5 * time.Second))
+	require.Len(t, silentNodes, 1)
+	assert.Equal(t, "node", silentNodes[0].NodeID)
+}
+
+func TestCollector_UnsupportedVersion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	rejected := make(chan *EnvelopeRejectedEvent, 1)
+	received := make(chan *remotemetricsproto.Envelope, 1)
+	collector := NewCollector()
+	collector.Events.EnvelopeRejected.Attach(event.NewClosure(func(event *EnvelopeRejectedEvent) {
+		rejected <- event
+	}))
+	collector.Events.EnvelopeReceived.Attach(event.NewClosure(func(event *EnvelopeReceivedEvent) {
+		received <- event.Envelope
+	}))
+	go func() {
+		assert.NoError(t, collector.Serve(ctx, listener))
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	writer := libp2putil.NewDelimitedWriter(conn)
+	require.NoError(t, writer.WriteMsg(&remotemetricsproto.Envelope{Version: ProtocolVersion + 1, NodeID: "node"}))
+	require.NoError(t, writer.WriteMsg(&remotemetricsproto.Envelope{Version: ProtocolVersion, NodeID: "node"}))
+
+	select {
+	case event := <-rejected:
+		assert.ErrorIs(t, event.Error, ErrUnsupportedVersion)
+		assert.Equal(t, ProtocolVersion+1, event.Envelope.Version)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "envelope was not rejected")
+	}
+	assert.Equal(t, ProtocolVersion, receiveEnvelope(t, received).Version)
+}
+
+func TestClient_QueueSize(t *testing.T) {
+	client := NewClient("127.0.0.1:0", "node", 0, WithQueueSize(1))
+	client.Send(&remotemetricsproto.Envelope{})
+	client.Send(&remotemetricsproto.Envelope{})
+
+	assert.EqualValues(t, 0, client.SentEnvelopes())
+	assert.EqualValues(t, 1, client.DroppedEnvelopes())
+}
+
+func receiveEnvelope(t *testing.T, received chan *remotemetricsproto.Envelope) *remotemetricsproto.Envelope {
+	select {
+	case envelope := <-received:
+		return envelope
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "envelope was not received")
+		return nil
+	}
+}
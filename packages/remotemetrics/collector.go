@@ -0,0 +1,240 @@
+package remotemetrics
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/multiformats/go-varint"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
+)
+
+const (
+	// maxEnvelopeSize defines the maximum size of an Envelope that is accepted by the Collector.
+	maxEnvelopeSize = 1 << 20
+
+	// missedHeartbeats defines the number of Heartbeats that a node can miss before it is considered silent.
+	missedHeartbeats = 3
+)
+
+// ErrUnsupportedVersion is returned when an Envelope was encoded with a version of the protocol that is not supported.
+var ErrUnsupportedVersion = errors.New("unsupported protocol version")
+
+// region Collector ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Collector is the reference implementation of the receiving side of the remote metrics protocol. It accepts the
+// connections of the nodes, rejects Envelopes of unsupported versions and keeps track of the Heartbeats of the nodes.
+type Collector struct {
+	// Events contains the events that are triggered by the Collector.
+	Events *CollectorEvents
+
+	nodes      map[string]*NodeStatus
+	nodesMutex sync.RWMutex
+}
+
+// NewCollector is the constructor of the Collector.
+func NewCollector() *Collector {
+	return &Collector{
+		Events: &CollectorEvents{
+			EnvelopeReceived: event.New[*EnvelopeReceivedEvent]("Collector.EnvelopeReceived"),
+			EnvelopeRejected: event.New[*EnvelopeRejectedEvent]("Collector.EnvelopeRejected"),
+			NodeSilent:       event.New[*NodeStatus]("Collector.NodeSilent"),
+		},
+		nodes: make(map[string]*NodeStatus),
+	}
+}
+
+// Serve accepts the connections of the given listener and reads their Envelopes until the context is done.
+func (c *Collector) Serve(ctx context.Context, listener net.Listener) error {
+	go func() {
+		<-ctx.Done()
+		_ = listener.Close()
+	}()
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errors.Errorf("failed to accept connection: %w", err)
+		}
+
+		go c.readEnvelopes(ctx, conn)
+	}
+}
+
+// CheckHeartbeats triggers the NodeSilent event for every node that missed several Heartbeats. Every node is reported
+// once until it sends a Heartbeat again.
+func (c *Collector) CheckHeartbeats(now time.Time) {
+	c.nodesMutex.Lock()
+	silentNodes := make([]*NodeStatus, 0)
+	for _, node := range c.nodes {
+		if !node.Silent && node.HeartbeatInterval > 0 && now.Sub(node.LastHeartbeat) > missedHeartbeats*node.HeartbeatInterval {
+			node.Silent = true
+			silentNodes = append(silentNodes, node.clone())
+		}
+	}
+	c.nodesMutex.Unlock()
+
+	for _, node := range silentNodes {
+		c.Events.NodeSilent.Trigger(node)
+	}
+}
+
+// Nodes returns the status of all nodes that sent an Envelope, ordered by their ID.
+func (c *Collector) Nodes() (nodes []*NodeStatus) {
+	c.nodesMutex.RLock()
+	defer c.nodesMutex.RUnlock()
+
+	nodes = make([]*NodeStatus, 0, len(c.nodes))
+	for _, node := range c.nodes {
+		nodes = append(nodes, node.clone())
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		return nodes[i].NodeID < nodes[j].NodeID
+	})
+
+	return nodes
+}
+
+// readEnvelopes reads the Envelopes of the given connection until it is closed.
+func (c *Collector) readEnvelopes(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	reader := bufio.NewReader(conn)
+	for {
+		envelope, err := readEnvelope(reader)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && ctx.Err() == nil {
+				c.Events.EnvelopeRejected.Trigger(&EnvelopeRejectedEvent{Address: conn.RemoteAddr(), Error: err})
+			}
+			return
+		}
+
+		if envelope.Version != ProtocolVersion {
+			c.Events.EnvelopeRejected.Trigger(&EnvelopeRejectedEvent{
+				Address:  conn.RemoteAddr(),
+				Envelope: envelope,
+				Error:    errors.Errorf("%w: %d", ErrUnsupportedVersion, envelope.Version),
+			})
+			continue
+		}
+
+		c.updateNodeStatus(conn.RemoteAddr(), envelope)
+		c.Events.EnvelopeReceived.Trigger(&EnvelopeReceivedEvent{Address: conn.RemoteAddr(), Envelope: envelope})
+	}
+}
+
+// updateNodeStatus updates the NodeStatus of the sender of the given Envelope.
+func (c *Collector) updateNodeStatus(address net.Addr, envelope *remotemetricsproto.Envelope) {
+	c.nodesMutex.Lock()
+	defer c.nodesMutex.Unlock()
+
+	node, exists := c.nodes[envelope.NodeID]
+	if !exists {
+		node = &NodeStatus{NodeID: envelope.NodeID}
+		c.nodes[envelope.NodeID] = node
+	}
+	node.Address = address.String()
+	node.ReceivedEnvelopes++
+
+	if heartbeat := envelope.GetHeartbeat(); heartbeat != nil {
+		node.AppVersion = heartbeat.AppVersion
+		node.Synced = heartbeat.Synced
+		node.HeartbeatInterval = time.Duration(heartbeat.Interval)
+		node.LastHeartbeat = time.Unix(0, envelope.Timestamp)
+		node.Silent = false
+	}
+}
+
+// readEnvelope reads a single length-prefixed Envelope.
+func readEnvelope(reader *bufio.Reader) (envelope *remotemetricsproto.Envelope, err error) {
+	length, err := varint.ReadUvarint(reader)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxEnvelopeSize {
+		return nil, errors.Errorf("envelope size %d exceeds the maximum of %d bytes", length, maxEnvelopeSize)
+	}
+
+	envelopeBytes := make([]byte, length)
+	if _, err = io.ReadFull(reader, envelopeBytes); err != nil {
+		return nil, err
+	}
+
+	envelope = &remotemetricsproto.Envelope{}
+	if err = proto.Unmarshal(envelopeBytes, envelope); err != nil {
+		return nil, errors.Errorf("failed to parse envelope: %w", err)
+	}
+
+	return envelope, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region NodeStatus ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodeStatus contains what the Collector knows about a node.
+type NodeStatus struct {
+	NodeID            string
+	Address           string
+	AppVersion        string
+	Synced            bool
+	ReceivedEnvelopes uint64
+	LastHeartbeat     time.Time
+	HeartbeatInterval time.Duration
+	Silent            bool
+}
+
+// clone returns a copy of the NodeStatus.
+func (n *NodeStatus) clone() *NodeStatus {
+	clonedStatus := *n
+
+	return &clonedStatus
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region CollectorEvents //////////////////////////////////////////////////////////////////////////////////////////////
+
+// CollectorEvents represents events happening in the Collector.
+type CollectorEvents struct {
+	// EnvelopeReceived is triggered when a valid Envelope was received.
+	EnvelopeReceived *event.Event[*EnvelopeReceivedEvent]
+
+	// EnvelopeRejected is triggered when an Envelope could not be read or has an unsupported version.
+	EnvelopeRejected *event.Event[*EnvelopeRejectedEvent]
+
+	// NodeSilent is triggered when a node stopped sending Heartbeats.
+	NodeSilent *event.Event[*NodeStatus]
+}
+
+// EnvelopeReceivedEvent contains the information about a received Envelope.
+type EnvelopeReceivedEvent struct {
+	Address  net.Addr
+	Envelope *remotemetricsproto.Envelope
+}
+
+// EnvelopeRejectedEvent contains the information about a rejected Envelope. The Envelope is nil if it could not be
+// read.
+type EnvelopeRejectedEvent struct {
+	Address  net.Addr
+	Envelope *remotemetricsproto.Envelope
+	Error    error
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
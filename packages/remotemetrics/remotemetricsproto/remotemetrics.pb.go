@@ -0,0 +1,2066 @@
+// Schema of the remote metrics protocol. Every Envelope carries the version of the protocol it was encoded with, so that
+// the collector can detect incompatible senders instead of silently misinterpreting their metrics.
+//
+// Compatibility rules:
+//  - fields and groups may be added with new field numbers without changing the version,
+//  - field numbers must never be reused or change their type,
+//  - removing or reinterpreting a field requires a new version.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.18.0
+// source: remotemetrics.proto
+
+package remotemetricsproto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Envelope is the frame that is sent to the collector. It contains exactly one metric group.
+type Envelope struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version      uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	NodeID       string `protobuf:"bytes,2,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	MetricsLevel uint32 `protobuf:"varint,3,opt,name=metricsLevel,proto3" json:"metricsLevel,omitempty"`
+	// Unix time in nanoseconds at which the Envelope was created.
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Types that are assignable to Group:
+	//	*Envelope_Heartbeat
+	//	*Envelope_Sync
+	//	*Envelope_Message
+	//	*Envelope_Branch
+	//	*Envelope_Scheduler
+	//	*Envelope_Drng
+	//	*Envelope_NetworkDelay
+	Group isEnvelope_Group `protobuf_oneof:"group"`
+}
+
+func (x *Envelope) Reset() {
+	*x = Envelope{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Envelope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Envelope) ProtoMessage() {}
+
+func (x *Envelope) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Envelope.ProtoReflect.Descriptor instead.
+func (*Envelope) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Envelope) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Envelope) GetNodeID() string {
+	if x != nil {
+		return x.NodeID
+	}
+	return ""
+}
+
+func (x *Envelope) GetMetricsLevel() uint32 {
+	if x != nil {
+		return x.MetricsLevel
+	}
+	return 0
+}
+
+func (x *Envelope) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (m *Envelope) GetGroup() isEnvelope_Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+func (x *Envelope) GetHeartbeat() *Heartbeat {
+	if x, ok := x.GetGroup().(*Envelope_Heartbeat); ok {
+		return x.Heartbeat
+	}
+	return nil
+}
+
+func (x *Envelope) GetSync() *SyncMetrics {
+	if x, ok := x.GetGroup().(*Envelope_Sync); ok {
+		return x.Sync
+	}
+	return nil
+}
+
+func (x *Envelope) GetMessage() *MessageMetrics {
+	if x, ok := x.GetGroup().(*Envelope_Message); ok {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *Envelope) GetBranch() *BranchMetrics {
+	if x, ok := x.GetGroup().(*Envelope_Branch); ok {
+		return x.Branch
+	}
+	return nil
+}
+
+func (x *Envelope) GetScheduler() *SchedulerMetrics {
+	if x, ok := x.GetGroup().(*Envelope_Scheduler); ok {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *Envelope) GetDrng() *DRNGMetrics {
+	if x, ok := x.GetGroup().(*Envelope_Drng); ok {
+		return x.Drng
+	}
+	return nil
+}
+
+func (x *Envelope) GetNetworkDelay() *NetworkDelayMetrics {
+	if x, ok := x.GetGroup().(*Envelope_NetworkDelay); ok {
+		return x.NetworkDelay
+	}
+	return nil
+}
+
+type isEnvelope_Group interface {
+	isEnvelope_Group()
+}
+
+type Envelope_Heartbeat struct {
+	Heartbeat *Heartbeat `protobuf:"bytes,5,opt,name=heartbeat,proto3,oneof"`
+}
+
+type Envelope_Sync struct {
+	Sync *SyncMetrics `protobuf:"bytes,6,opt,name=sync,proto3,oneof"`
+}
+
+type Envelope_Message struct {
+	Message *MessageMetrics `protobuf:"bytes,7,opt,name=message,proto3,oneof"`
+}
+
+type Envelope_Branch struct {
+	Branch *BranchMetrics `protobuf:"bytes,8,opt,name=branch,proto3,oneof"`
+}
+
+type Envelope_Scheduler struct {
+	Scheduler *SchedulerMetrics `protobuf:"bytes,9,opt,name=scheduler,proto3,oneof"`
+}
+
+type Envelope_Drng struct {
+	Drng *DRNGMetrics `protobuf:"bytes,10,opt,name=drng,proto3,oneof"`
+}
+
+type Envelope_NetworkDelay struct {
+	NetworkDelay *NetworkDelayMetrics `protobuf:"bytes,11,opt,name=networkDelay,proto3,oneof"`
+}
+
+func (*Envelope_Heartbeat) isEnvelope_Group() {}
+
+func (*Envelope_Sync) isEnvelope_Group() {}
+
+func (*Envelope_Message) isEnvelope_Group() {}
+
+func (*Envelope_Branch) isEnvelope_Group() {}
+
+func (*Envelope_Scheduler) isEnvelope_Group() {}
+
+func (*Envelope_Drng) isEnvelope_Group() {}
+
+func (*Envelope_NetworkDelay) isEnvelope_Group() {}
+
+// Heartbeat is sent periodically so that the collector can tell silent nodes apart from nodes without metrics.
+type Heartbeat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppVersion string `protobuf:"bytes,1,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	Synced     bool   `protobuf:"varint,2,opt,name=synced,proto3" json:"synced,omitempty"`
+	// Time since the start of the node in nanoseconds.
+	Uptime int64 `protobuf:"varint,3,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// Interval in nanoseconds after which the next Heartbeat is sent.
+	Interval         int64  `protobuf:"varint,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	SentEnvelopes    uint64 `protobuf:"varint,5,opt,name=sentEnvelopes,proto3" json:"sentEnvelopes,omitempty"`
+	DroppedEnvelopes uint64 `protobuf:"varint,6,opt,name=droppedEnvelopes,proto3" json:"droppedEnvelopes,omitempty"`
+}
+
+func (x *Heartbeat) Reset() {
+	*x = Heartbeat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Heartbeat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heartbeat) ProtoMessage() {}
+
+func (x *Heartbeat) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heartbeat.ProtoReflect.Descriptor instead.
+func (*Heartbeat) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Heartbeat) GetAppVersion() string {
+	if x != nil {
+		return x.AppVersion
+	}
+	return ""
+}
+
+func (x *Heartbeat) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *Heartbeat) GetUptime() int64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *Heartbeat) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *Heartbeat) GetSentEnvelopes() uint64 {
+	if x != nil {
+		return x.SentEnvelopes
+	}
+	return 0
+}
+
+func (x *Heartbeat) GetDroppedEnvelopes() uint64 {
+	if x != nil {
+		return x.DroppedEnvelopes
+	}
+	return 0
+}
+
+// SyncMetrics is sent when the sync status of the node changes.
+type SyncMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CurrentStatus            bool  `protobuf:"varint,1,opt,name=currentStatus,proto3" json:"currentStatus,omitempty"`
+	PreviousStatus           bool  `protobuf:"varint,2,opt,name=previousStatus,proto3" json:"previousStatus,omitempty"`
+	LastConfirmedMessageTime int64 `protobuf:"varint,3,opt,name=lastConfirmedMessageTime,proto3" json:"lastConfirmedMessageTime,omitempty"`
+}
+
+func (x *SyncMetrics) Reset() {
+	*x = SyncMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SyncMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMetrics) ProtoMessage() {}
+
+func (x *SyncMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMetrics.ProtoReflect.Descriptor instead.
+func (*SyncMetrics) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SyncMetrics) GetCurrentStatus() bool {
+	if x != nil {
+		return x.CurrentStatus
+	}
+	return false
+}
+
+func (x *SyncMetrics) GetPreviousStatus() bool {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return false
+}
+
+func (x *SyncMetrics) GetLastConfirmedMessageTime() int64 {
+	if x != nil {
+		return x.LastConfirmedMessageTime
+	}
+	return 0
+}
+
+// MessageMetrics contains the metrics of a single message.
+type MessageMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Metric:
+	//	*MessageMetrics_Scheduled
+	//	*MessageMetrics_Discarded
+	//	*MessageMetrics_Finalized
+	//	*MessageMetrics_Missing
+	//	*MessageMetrics_MissingStored
+	Metric isMessageMetrics_Metric `protobuf_oneof:"metric"`
+}
+
+func (x *MessageMetrics) Reset() {
+	*x = MessageMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageMetrics) ProtoMessage() {}
+
+func (x *MessageMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageMetrics.ProtoReflect.Descriptor instead.
+func (*MessageMetrics) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *MessageMetrics) GetMetric() isMessageMetrics_Metric {
+	if m != nil {
+		return m.Metric
+	}
+	return nil
+}
+
+func (x *MessageMetrics) GetScheduled() *MessageScheduled {
+	if x, ok := x.GetMetric().(*MessageMetrics_Scheduled); ok {
+		return x.Scheduled
+	}
+	return nil
+}
+
+func (x *MessageMetrics) GetDiscarded() *MessageScheduled {
+	if x, ok := x.GetMetric().(*MessageMetrics_Discarded); ok {
+		return x.Discarded
+	}
+	return nil
+}
+
+func (x *MessageMetrics) GetFinalized() *MessageFinalized {
+	if x, ok := x.GetMetric().(*MessageMetrics_Finalized); ok {
+		return x.Finalized
+	}
+	return nil
+}
+
+func (x *MessageMetrics) GetMissing() *MissingMessage {
+	if x, ok := x.GetMetric().(*MessageMetrics_Missing); ok {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *MessageMetrics) GetMissingStored() *MissingMessage {
+	if x, ok := x.GetMetric().(*MessageMetrics_MissingStored); ok {
+		return x.MissingStored
+	}
+	return nil
+}
+
+type isMessageMetrics_Metric interface {
+	isMessageMetrics_Metric()
+}
+
+type MessageMetrics_Scheduled struct {
+	Scheduled *MessageScheduled `protobuf:"bytes,1,opt,name=scheduled,proto3,oneof"`
+}
+
+type MessageMetrics_Discarded struct {
+	Discarded *MessageScheduled `protobuf:"bytes,2,opt,name=discarded,proto3,oneof"`
+}
+
+type MessageMetrics_Finalized struct {
+	Finalized *MessageFinalized `protobuf:"bytes,3,opt,name=finalized,proto3,oneof"`
+}
+
+type MessageMetrics_Missing struct {
+	Missing *MissingMessage `protobuf:"bytes,4,opt,name=missing,proto3,oneof"`
+}
+
+type MessageMetrics_MissingStored struct {
+	MissingStored *MissingMessage `protobuf:"bytes,5,opt,name=missingStored,proto3,oneof"`
+}
+
+func (*MessageMetrics_Scheduled) isMessageMetrics_Metric() {}
+
+func (*MessageMetrics_Discarded) isMessageMetrics_Metric() {}
+
+func (*MessageMetrics_Finalized) isMessageMetrics_Metric() {}
+
+func (*MessageMetrics_Missing) isMessageMetrics_Metric() {}
+
+func (*MessageMetrics_MissingStored) isMessageMetrics_Metric() {}
+
+// MessageScheduled contains the timestamps (unix nanoseconds) and deltas (nanoseconds) of a scheduled or discarded message.
+type MessageScheduled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageID                string  `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	TransactionID            string  `protobuf:"bytes,2,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	IssuerID                 string  `protobuf:"bytes,3,opt,name=issuerID,proto3" json:"issuerID,omitempty"`
+	IssuedTimestamp          int64   `protobuf:"varint,4,opt,name=issuedTimestamp,proto3" json:"issuedTimestamp,omitempty"`
+	ReceivedTimestamp        int64   `protobuf:"varint,5,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
+	SolidTimestamp           int64   `protobuf:"varint,6,opt,name=solidTimestamp,proto3" json:"solidTimestamp,omitempty"`
+	ScheduledTimestamp       int64   `protobuf:"varint,7,opt,name=scheduledTimestamp,proto3" json:"scheduledTimestamp,omitempty"`
+	BookedTimestamp          int64   `protobuf:"varint,8,opt,name=bookedTimestamp,proto3" json:"bookedTimestamp,omitempty"`
+	QueuedTimestamp          int64   `protobuf:"varint,9,opt,name=queuedTimestamp,proto3" json:"queuedTimestamp,omitempty"`
+	DroppedTimestamp         int64   `protobuf:"varint,10,opt,name=droppedTimestamp,proto3" json:"droppedTimestamp,omitempty"`
+	GradeOfFinalityTimestamp int64   `protobuf:"varint,11,opt,name=gradeOfFinalityTimestamp,proto3" json:"gradeOfFinalityTimestamp,omitempty"`
+	GradeOfFinality          uint32  `protobuf:"varint,12,opt,name=gradeOfFinality,proto3" json:"gradeOfFinality,omitempty"`
+	DeltaGradeOfFinalityTime int64   `protobuf:"varint,13,opt,name=deltaGradeOfFinalityTime,proto3" json:"deltaGradeOfFinalityTime,omitempty"`
+	DeltaSolid               int64   `protobuf:"varint,14,opt,name=deltaSolid,proto3" json:"deltaSolid,omitempty"`
+	DeltaScheduledIssued     int64   `protobuf:"varint,15,opt,name=deltaScheduledIssued,proto3" json:"deltaScheduledIssued,omitempty"`
+	DeltaBooked              int64   `protobuf:"varint,16,opt,name=deltaBooked,proto3" json:"deltaBooked,omitempty"`
+	DeltaScheduledReceived   int64   `protobuf:"varint,17,opt,name=deltaScheduledReceived,proto3" json:"deltaScheduledReceived,omitempty"`
+	DeltaReceivedIssued      int64   `protobuf:"varint,18,opt,name=deltaReceivedIssued,proto3" json:"deltaReceivedIssued,omitempty"`
+	SchedulingTime           int64   `protobuf:"varint,19,opt,name=schedulingTime,proto3" json:"schedulingTime,omitempty"`
+	AccessMana               float64 `protobuf:"fixed64,20,opt,name=accessMana,proto3" json:"accessMana,omitempty"`
+	StrongEdgeCount          uint32  `protobuf:"varint,21,opt,name=strongEdgeCount,proto3" json:"strongEdgeCount,omitempty"`
+	WeakEdgeCount            uint32  `protobuf:"varint,22,opt,name=weakEdgeCount,proto3" json:"weakEdgeCount,omitempty"`
+	LikeEdgeCount            uint32  `protobuf:"varint,23,opt,name=likeEdgeCount,proto3" json:"likeEdgeCount,omitempty"`
+}
+
+func (x *MessageScheduled) Reset() {
+	*x = MessageScheduled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageScheduled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageScheduled) ProtoMessage() {}
+
+func (x *MessageScheduled) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageScheduled.ProtoReflect.Descriptor instead.
+func (*MessageScheduled) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MessageScheduled) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *MessageScheduled) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *MessageScheduled) GetIssuerID() string {
+	if x != nil {
+		return x.IssuerID
+	}
+	return ""
+}
+
+func (x *MessageScheduled) GetIssuedTimestamp() int64 {
+	if x != nil {
+		return x.IssuedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetReceivedTimestamp() int64 {
+	if x != nil {
+		return x.ReceivedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetSolidTimestamp() int64 {
+	if x != nil {
+		return x.SolidTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetScheduledTimestamp() int64 {
+	if x != nil {
+		return x.ScheduledTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetBookedTimestamp() int64 {
+	if x != nil {
+		return x.BookedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetQueuedTimestamp() int64 {
+	if x != nil {
+		return x.QueuedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDroppedTimestamp() int64 {
+	if x != nil {
+		return x.DroppedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetGradeOfFinalityTimestamp() int64 {
+	if x != nil {
+		return x.GradeOfFinalityTimestamp
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetGradeOfFinality() uint32 {
+	if x != nil {
+		return x.GradeOfFinality
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDeltaGradeOfFinalityTime() int64 {
+	if x != nil {
+		return x.DeltaGradeOfFinalityTime
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDeltaSolid() int64 {
+	if x != nil {
+		return x.DeltaSolid
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDeltaScheduledIssued() int64 {
+	if x != nil {
+		return x.DeltaScheduledIssued
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDeltaBooked() int64 {
+	if x != nil {
+		return x.DeltaBooked
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDeltaScheduledReceived() int64 {
+	if x != nil {
+		return x.DeltaScheduledReceived
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetDeltaReceivedIssued() int64 {
+	if x != nil {
+		return x.DeltaReceivedIssued
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetSchedulingTime() int64 {
+	if x != nil {
+		return x.SchedulingTime
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetAccessMana() float64 {
+	if x != nil {
+		return x.AccessMana
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetStrongEdgeCount() uint32 {
+	if x != nil {
+		return x.StrongEdgeCount
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetWeakEdgeCount() uint32 {
+	if x != nil {
+		return x.WeakEdgeCount
+	}
+	return 0
+}
+
+func (x *MessageScheduled) GetLikeEdgeCount() uint32 {
+	if x != nil {
+		return x.LikeEdgeCount
+	}
+	return 0
+}
+
+// MessageFinalized contains the timestamps (unix nanoseconds) and deltas (nanoseconds) of a confirmed message.
+type MessageFinalized struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageID               string `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	TransactionID           string `protobuf:"bytes,2,opt,name=transactionID,proto3" json:"transactionID,omitempty"`
+	IssuerID                string `protobuf:"bytes,3,opt,name=issuerID,proto3" json:"issuerID,omitempty"`
+	IssuedTimestamp         int64  `protobuf:"varint,4,opt,name=issuedTimestamp,proto3" json:"issuedTimestamp,omitempty"`
+	SolidTimestamp          int64  `protobuf:"varint,5,opt,name=solidTimestamp,proto3" json:"solidTimestamp,omitempty"`
+	ScheduledTimestamp      int64  `protobuf:"varint,6,opt,name=scheduledTimestamp,proto3" json:"scheduledTimestamp,omitempty"`
+	BookedTimestamp         int64  `protobuf:"varint,7,opt,name=bookedTimestamp,proto3" json:"bookedTimestamp,omitempty"`
+	ConfirmedTimestamp      int64  `protobuf:"varint,8,opt,name=confirmedTimestamp,proto3" json:"confirmedTimestamp,omitempty"`
+	DeltaSolid              int64  `protobuf:"varint,9,opt,name=deltaSolid,proto3" json:"deltaSolid,omitempty"`
+	DeltaScheduled          int64  `protobuf:"varint,10,opt,name=deltaScheduled,proto3" json:"deltaScheduled,omitempty"`
+	DeltaBooked             int64  `protobuf:"varint,11,opt,name=deltaBooked,proto3" json:"deltaBooked,omitempty"`
+	DeltaConfirmed          int64  `protobuf:"varint,12,opt,name=deltaConfirmed,proto3" json:"deltaConfirmed,omitempty"`
+	StrongEdgeCount         uint32 `protobuf:"varint,13,opt,name=strongEdgeCount,proto3" json:"strongEdgeCount,omitempty"`
+	WeakEdgeCount           uint32 `protobuf:"varint,14,opt,name=weakEdgeCount,proto3" json:"weakEdgeCount,omitempty"`
+	ShallowLikeEdgeCount    uint32 `protobuf:"varint,15,opt,name=shallowLikeEdgeCount,proto3" json:"shallowLikeEdgeCount,omitempty"`
+	ShallowDislikeEdgeCount uint32 `protobuf:"varint,16,opt,name=shallowDislikeEdgeCount,proto3" json:"shallowDislikeEdgeCount,omitempty"`
+}
+
+func (x *MessageFinalized) Reset() {
+	*x = MessageFinalized{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageFinalized) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageFinalized) ProtoMessage() {}
+
+func (x *MessageFinalized) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageFinalized.ProtoReflect.Descriptor instead.
+func (*MessageFinalized) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MessageFinalized) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *MessageFinalized) GetTransactionID() string {
+	if x != nil {
+		return x.TransactionID
+	}
+	return ""
+}
+
+func (x *MessageFinalized) GetIssuerID() string {
+	if x != nil {
+		return x.IssuerID
+	}
+	return ""
+}
+
+func (x *MessageFinalized) GetIssuedTimestamp() int64 {
+	if x != nil {
+		return x.IssuedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetSolidTimestamp() int64 {
+	if x != nil {
+		return x.SolidTimestamp
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetScheduledTimestamp() int64 {
+	if x != nil {
+		return x.ScheduledTimestamp
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetBookedTimestamp() int64 {
+	if x != nil {
+		return x.BookedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetConfirmedTimestamp() int64 {
+	if x != nil {
+		return x.ConfirmedTimestamp
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetDeltaSolid() int64 {
+	if x != nil {
+		return x.DeltaSolid
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetDeltaScheduled() int64 {
+	if x != nil {
+		return x.DeltaScheduled
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetDeltaBooked() int64 {
+	if x != nil {
+		return x.DeltaBooked
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetDeltaConfirmed() int64 {
+	if x != nil {
+		return x.DeltaConfirmed
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetStrongEdgeCount() uint32 {
+	if x != nil {
+		return x.StrongEdgeCount
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetWeakEdgeCount() uint32 {
+	if x != nil {
+		return x.WeakEdgeCount
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetShallowLikeEdgeCount() uint32 {
+	if x != nil {
+		return x.ShallowLikeEdgeCount
+	}
+	return 0
+}
+
+func (x *MessageFinalized) GetShallowDislikeEdgeCount() uint32 {
+	if x != nil {
+		return x.ShallowDislikeEdgeCount
+	}
+	return 0
+}
+
+// MissingMessage is sent when a message is requested from the neighbors and when a requested message is stored.
+type MissingMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageID string `protobuf:"bytes,1,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	IssuerID  string `protobuf:"bytes,2,opt,name=issuerID,proto3" json:"issuerID,omitempty"`
+}
+
+func (x *MissingMessage) Reset() {
+	*x = MissingMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MissingMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingMessage) ProtoMessage() {}
+
+func (x *MissingMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingMessage.ProtoReflect.Descriptor instead.
+func (*MissingMessage) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MissingMessage) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *MissingMessage) GetIssuerID() string {
+	if x != nil {
+		return x.IssuerID
+	}
+	return ""
+}
+
+// BranchMetrics contains the metrics of the BranchDAG.
+type BranchMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Metric:
+	//	*BranchMetrics_Confirmation
+	//	*BranchMetrics_Counts
+	Metric isBranchMetrics_Metric `protobuf_oneof:"metric"`
+}
+
+func (x *BranchMetrics) Reset() {
+	*x = BranchMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchMetrics) ProtoMessage() {}
+
+func (x *BranchMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchMetrics.ProtoReflect.Descriptor instead.
+func (*BranchMetrics) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *BranchMetrics) GetMetric() isBranchMetrics_Metric {
+	if m != nil {
+		return m.Metric
+	}
+	return nil
+}
+
+func (x *BranchMetrics) GetConfirmation() *BranchConfirmation {
+	if x, ok := x.GetMetric().(*BranchMetrics_Confirmation); ok {
+		return x.Confirmation
+	}
+	return nil
+}
+
+func (x *BranchMetrics) GetCounts() *BranchCounts {
+	if x, ok := x.GetMetric().(*BranchMetrics_Counts); ok {
+		return x.Counts
+	}
+	return nil
+}
+
+type isBranchMetrics_Metric interface {
+	isBranchMetrics_Metric()
+}
+
+type BranchMetrics_Confirmation struct {
+	Confirmation *BranchConfirmation `protobuf:"bytes,1,opt,name=confirmation,proto3,oneof"`
+}
+
+type BranchMetrics_Counts struct {
+	Counts *BranchCounts `protobuf:"bytes,2,opt,name=counts,proto3,oneof"`
+}
+
+func (*BranchMetrics_Confirmation) isBranchMetrics_Metric() {}
+
+func (*BranchMetrics_Counts) isBranchMetrics_Metric() {}
+
+// BranchConfirmation is sent when a branch is confirmed.
+type BranchConfirmation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BranchID           string `protobuf:"bytes,1,opt,name=branchID,proto3" json:"branchID,omitempty"`
+	MessageID          string `protobuf:"bytes,2,opt,name=messageID,proto3" json:"messageID,omitempty"`
+	IssuerID           string `protobuf:"bytes,3,opt,name=issuerID,proto3" json:"issuerID,omitempty"`
+	CreatedTimestamp   int64  `protobuf:"varint,4,opt,name=createdTimestamp,proto3" json:"createdTimestamp,omitempty"`
+	ConfirmedTimestamp int64  `protobuf:"varint,5,opt,name=confirmedTimestamp,proto3" json:"confirmedTimestamp,omitempty"`
+	DeltaConfirmed     int64  `protobuf:"varint,6,opt,name=deltaConfirmed,proto3" json:"deltaConfirmed,omitempty"`
+}
+
+func (x *BranchConfirmation) Reset() {
+	*x = BranchConfirmation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchConfirmation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchConfirmation) ProtoMessage() {}
+
+func (x *BranchConfirmation) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchConfirmation.ProtoReflect.Descriptor instead.
+func (*BranchConfirmation) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BranchConfirmation) GetBranchID() string {
+	if x != nil {
+		return x.BranchID
+	}
+	return ""
+}
+
+func (x *BranchConfirmation) GetMessageID() string {
+	if x != nil {
+		return x.MessageID
+	}
+	return ""
+}
+
+func (x *BranchConfirmation) GetIssuerID() string {
+	if x != nil {
+		return x.IssuerID
+	}
+	return ""
+}
+
+func (x *BranchConfirmation) GetCreatedTimestamp() int64 {
+	if x != nil {
+		return x.CreatedTimestamp
+	}
+	return 0
+}
+
+func (x *BranchConfirmation) GetConfirmedTimestamp() int64 {
+	if x != nil {
+		return x.ConfirmedTimestamp
+	}
+	return 0
+}
+
+func (x *BranchConfirmation) GetDeltaConfirmed() int64 {
+	if x != nil {
+		return x.DeltaConfirmed
+	}
+	return 0
+}
+
+// BranchCounts contains the number of branches in the database.
+type BranchCounts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalBranchCount               uint64 `protobuf:"varint,1,opt,name=totalBranchCount,proto3" json:"totalBranchCount,omitempty"`
+	FinalizedBranchCount           uint64 `protobuf:"varint,2,opt,name=finalizedBranchCount,proto3" json:"finalizedBranchCount,omitempty"`
+	ConfirmedBranchCount           uint64 `protobuf:"varint,3,opt,name=confirmedBranchCount,proto3" json:"confirmedBranchCount,omitempty"`
+	InitialTotalBranchCount        uint64 `protobuf:"varint,4,opt,name=initialTotalBranchCount,proto3" json:"initialTotalBranchCount,omitempty"`
+	TotalBranchCountSinceStart     uint64 `protobuf:"varint,5,opt,name=totalBranchCountSinceStart,proto3" json:"totalBranchCountSinceStart,omitempty"`
+	InitialConfirmedBranchCount    uint64 `protobuf:"varint,6,opt,name=initialConfirmedBranchCount,proto3" json:"initialConfirmedBranchCount,omitempty"`
+	ConfirmedBranchCountSinceStart uint64 `protobuf:"varint,7,opt,name=confirmedBranchCountSinceStart,proto3" json:"confirmedBranchCountSinceStart,omitempty"`
+	InitialFinalizedBranchCount    uint64 `protobuf:"varint,8,opt,name=initialFinalizedBranchCount,proto3" json:"initialFinalizedBranchCount,omitempty"`
+	FinalizedBranchCountSinceStart uint64 `protobuf:"varint,9,opt,name=finalizedBranchCountSinceStart,proto3" json:"finalizedBranchCountSinceStart,omitempty"`
+}
+
+func (x *BranchCounts) Reset() {
+	*x = BranchCounts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BranchCounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BranchCounts) ProtoMessage() {}
+
+func (x *BranchCounts) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BranchCounts.ProtoReflect.Descriptor instead.
+func (*BranchCounts) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BranchCounts) GetTotalBranchCount() uint64 {
+	if x != nil {
+		return x.TotalBranchCount
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetFinalizedBranchCount() uint64 {
+	if x != nil {
+		return x.FinalizedBranchCount
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetConfirmedBranchCount() uint64 {
+	if x != nil {
+		return x.ConfirmedBranchCount
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetInitialTotalBranchCount() uint64 {
+	if x != nil {
+		return x.InitialTotalBranchCount
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetTotalBranchCountSinceStart() uint64 {
+	if x != nil {
+		return x.TotalBranchCountSinceStart
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetInitialConfirmedBranchCount() uint64 {
+	if x != nil {
+		return x.InitialConfirmedBranchCount
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetConfirmedBranchCountSinceStart() uint64 {
+	if x != nil {
+		return x.ConfirmedBranchCountSinceStart
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetInitialFinalizedBranchCount() uint64 {
+	if x != nil {
+		return x.InitialFinalizedBranchCount
+	}
+	return 0
+}
+
+func (x *BranchCounts) GetFinalizedBranchCountSinceStart() uint64 {
+	if x != nil {
+		return x.FinalizedBranchCountSinceStart
+	}
+	return 0
+}
+
+// SchedulerMetrics contains a sample of the state of the scheduler.
+type SchedulerMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Synced                       bool               `protobuf:"varint,1,opt,name=synced,proto3" json:"synced,omitempty"`
+	QueueLengthPerNode           map[string]uint32  `protobuf:"bytes,2,rep,name=queueLengthPerNode,proto3" json:"queueLengthPerNode,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	AManaNormalizedLengthPerNode map[string]float64 `protobuf:"bytes,3,rep,name=aManaNormalizedLengthPerNode,proto3" json:"aManaNormalizedLengthPerNode,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	BufferSize                   uint32             `protobuf:"varint,4,opt,name=bufferSize,proto3" json:"bufferSize,omitempty"`
+	BufferLength                 uint32             `protobuf:"varint,5,opt,name=bufferLength,proto3" json:"bufferLength,omitempty"`
+	ReadyMessagesInBuffer        uint32             `protobuf:"varint,6,opt,name=readyMessagesInBuffer,proto3" json:"readyMessagesInBuffer,omitempty"`
+}
+
+func (x *SchedulerMetrics) Reset() {
+	*x = SchedulerMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SchedulerMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerMetrics) ProtoMessage() {}
+
+func (x *SchedulerMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerMetrics.ProtoReflect.Descriptor instead.
+func (*SchedulerMetrics) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SchedulerMetrics) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *SchedulerMetrics) GetQueueLengthPerNode() map[string]uint32 {
+	if x != nil {
+		return x.QueueLengthPerNode
+	}
+	return nil
+}
+
+func (x *SchedulerMetrics) GetAManaNormalizedLengthPerNode() map[string]float64 {
+	if x != nil {
+		return x.AManaNormalizedLengthPerNode
+	}
+	return nil
+}
+
+func (x *SchedulerMetrics) GetBufferSize() uint32 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+func (x *SchedulerMetrics) GetBufferLength() uint32 {
+	if x != nil {
+		return x.BufferLength
+	}
+	return 0
+}
+
+func (x *SchedulerMetrics) GetReadyMessagesInBuffer() uint32 {
+	if x != nil {
+		return x.ReadyMessagesInBuffer
+	}
+	return 0
+}
+
+// DRNGMetrics is sent when a new randomness is received.
+type DRNGMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InstanceID        uint32 `protobuf:"varint,1,opt,name=instanceID,proto3" json:"instanceID,omitempty"`
+	Round             uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	IssuedTimestamp   int64  `protobuf:"varint,3,opt,name=issuedTimestamp,proto3" json:"issuedTimestamp,omitempty"`
+	ReceivedTimestamp int64  `protobuf:"varint,4,opt,name=receivedTimestamp,proto3" json:"receivedTimestamp,omitempty"`
+	DeltaReceived     int64  `protobuf:"varint,5,opt,name=deltaReceived,proto3" json:"deltaReceived,omitempty"`
+}
+
+func (x *DRNGMetrics) Reset() {
+	*x = DRNGMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DRNGMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DRNGMetrics) ProtoMessage() {}
+
+func (x *DRNGMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DRNGMetrics.ProtoReflect.Descriptor instead.
+func (*DRNGMetrics) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DRNGMetrics) GetInstanceID() uint32 {
+	if x != nil {
+		return x.InstanceID
+	}
+	return 0
+}
+
+func (x *DRNGMetrics) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *DRNGMetrics) GetIssuedTimestamp() int64 {
+	if x != nil {
+		return x.IssuedTimestamp
+	}
+	return 0
+}
+
+func (x *DRNGMetrics) GetReceivedTimestamp() int64 {
+	if x != nil {
+		return x.ReceivedTimestamp
+	}
+	return 0
+}
+
+func (x *DRNGMetrics) GetDeltaReceived() int64 {
+	if x != nil {
+		return x.DeltaReceived
+	}
+	return 0
+}
+
+// NetworkDelayMetrics contains the delay of a network delay message or, if pow is set, the duration of its PoW.
+type NetworkDelayMetrics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SentTime    int64  `protobuf:"varint,2,opt,name=sentTime,proto3" json:"sentTime,omitempty"`
+	ReceiveTime int64  `protobuf:"varint,3,opt,name=receiveTime,proto3" json:"receiveTime,omitempty"`
+	Delta       int64  `protobuf:"varint,4,opt,name=delta,proto3" json:"delta,omitempty"`
+	Clock       bool   `protobuf:"varint,5,opt,name=clock,proto3" json:"clock,omitempty"`
+	Synced      bool   `protobuf:"varint,6,opt,name=synced,proto3" json:"synced,omitempty"`
+	Pow         bool   `protobuf:"varint,7,opt,name=pow,proto3" json:"pow,omitempty"`
+}
+
+func (x *NetworkDelayMetrics) Reset() {
+	*x = NetworkDelayMetrics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_remotemetrics_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkDelayMetrics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkDelayMetrics) ProtoMessage() {}
+
+func (x *NetworkDelayMetrics) ProtoReflect() protoreflect.Message {
+	mi := &file_remotemetrics_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkDelayMetrics.ProtoReflect.Descriptor instead.
+func (*NetworkDelayMetrics) Descriptor() ([]byte, []int) {
+	return file_remotemetrics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NetworkDelayMetrics) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NetworkDelayMetrics) GetSentTime() int64 {
+	if x != nil {
+		return x.SentTime
+	}
+	return 0
+}
+
+func (x *NetworkDelayMetrics) GetReceiveTime() int64 {
+	if x != nil {
+		return x.ReceiveTime
+	}
+	return 0
+}
+
+func (x *NetworkDelayMetrics) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *NetworkDelayMetrics) GetClock() bool {
+	if x != nil {
+		return x.Clock
+	}
+	return false
+}
+
+func (x *NetworkDelayMetrics) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *NetworkDelayMetrics) GetPow() bool {
+	if x != nil {
+		return x.Pow
+	}
+	return false
+}
+
+var File_remotemetrics_proto protoreflect.FileDescriptor
+
+var file_remotemetrics_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x12, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x04, 0x0a, 0x08, 0x45, 0x6e,
+	0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x09, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09,
+	0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x04, 0x73, 0x79, 0x6e,
+	0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x79, 0x6e,
+	0x63, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63,
+	0x12, 0x3e, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x3b, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x44, 0x0a,
+	0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x24, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x04, 0x64, 0x72, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x52, 0x4e, 0x47, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x48, 0x00, 0x52, 0x04, 0x64, 0x72, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0c, 0x6e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x48, 0x00, 0x52, 0x0c, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x22, 0xc9, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70,
+	0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x76,
+	0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x45, 0x6e, 0x76, 0x65, 0x6c, 0x6f, 0x70, 0x65, 0x73, 0x22, 0x97,
+	0x01, 0x0a, 0x0b, 0x53, 0x79, 0x6e, 0x63, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x18,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xf8, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x44, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x48, 0x00, 0x52, 0x09, 0x64, 0x69,
+	0x73, 0x63, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x48, 0x00, 0x52, 0x09, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x3e, 0x0a,
+	0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x4a, 0x0a,
+	0x0d, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e,
+	0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x0d, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x22, 0xe2, 0x07, 0x0a, 0x10, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x6f, 0x6f, 0x6b,
+	0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x71, 0x75, 0x65,
+	0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2a, 0x0a, 0x10,
+	0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3a, 0x0a, 0x18, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x4f, 0x66, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x4f, 0x66, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x66, 0x46,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x4f, 0x66, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x3a,
+	0x0a, 0x18, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x66, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x18, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x47, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x66, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x53, 0x6f, 0x6c, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x6f, 0x6c, 0x69, 0x64, 0x12, 0x32, 0x0a, 0x14, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75,
+	0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x42, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x42, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x12, 0x36, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x16, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x69, 0x6e, 0x67, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x61, 0x6e, 0x61,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x61,
+	0x6e, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x45, 0x64, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x74, 0x72,
+	0x6f, 0x6e, 0x67, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d,
+	0x77, 0x65, 0x61, 0x6b, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x65, 0x61, 0x6b, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x6b, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6c, 0x69, 0x6b, 0x65, 0x45,
+	0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x05, 0x0a, 0x10, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a,
+	0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x6c, 0x69, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x2e, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x28, 0x0a, 0x0f, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x62, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x53, 0x6f, 0x6c, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x53, 0x6f, 0x6c, 0x69, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x42, 0x6f, 0x6f, 0x6b, 0x65, 0x64,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x42, 0x6f, 0x6f,
+	0x6b, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x6c,
+	0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x73,
+	0x74, 0x72, 0x6f, 0x6e, 0x67, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x74, 0x72, 0x6f, 0x6e, 0x67, 0x45, 0x64, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x77, 0x65, 0x61, 0x6b, 0x45, 0x64, 0x67,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x77, 0x65,
+	0x61, 0x6b, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x73,
+	0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73, 0x68, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4c, 0x69, 0x6b, 0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x38, 0x0a, 0x17, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b,
+	0x65, 0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x17, 0x73, 0x68, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x69, 0x73, 0x6c, 0x69, 0x6b, 0x65,
+	0x45, 0x64, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4a, 0x0a, 0x0e, 0x4d, 0x69, 0x73,
+	0x73, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x49, 0x44, 0x22, 0xa3, 0x01, 0x0a, 0x0d, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x4c, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x72, 0x61, 0x6e, 0x63,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x73, 0x42, 0x08, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x22, 0xee, 0x01, 0x0a, 0x12,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x64, 0x65,
+	0x6c, 0x74, 0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x22, 0xb0, 0x04, 0x0a,
+	0x0c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x2a, 0x0a,
+	0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72,
+	0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x66, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a,
+	0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x38, 0x0a, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x17, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x1a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42,
+	0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x1b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a,
+	0x1e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x1b, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x1b, 0x69, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e,
+	0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x1e, 0x66, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x1e, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x42, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22,
+	0xb7, 0x04, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x6c, 0x0a, 0x12,
+	0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x4e, 0x6f,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x12, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x1c, 0x61,
+	0x4d, 0x61, 0x6e, 0x61, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x46, 0x2e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x41, 0x4d, 0x61, 0x6e, 0x61, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x50, 0x65, 0x72,
+	0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x1c, 0x61, 0x4d, 0x61, 0x6e, 0x61,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x15, 0x72,
+	0x65, 0x61, 0x64, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x42, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x72, 0x65, 0x61, 0x64,
+	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x49, 0x6e, 0x42, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x1a, 0x45, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4f, 0x0a, 0x21, 0x41, 0x4d, 0x61, 0x6e,
+	0x61, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x50, 0x65, 0x72, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc1, 0x01, 0x0a, 0x0b, 0x44, 0x52,
+	0x4e, 0x47, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x74, 0x61,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x22, 0xb9, 0x01,
+	0x0a, 0x13, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x6f, 0x77, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x70, 0x6f, 0x77, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x61, 0x6c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x2f, 0x67, 0x6f, 0x73, 0x68, 0x69, 0x6d, 0x6d, 0x65, 0x72, 0x2f, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_remotemetrics_proto_rawDescOnce sync.Once
+	file_remotemetrics_proto_rawDescData = file_remotemetrics_proto_rawDesc
+)
+
+func file_remotemetrics_proto_rawDescGZIP() []byte {
+	file_remotemetrics_proto_rawDescOnce.Do(func() {
+		file_remotemetrics_proto_rawDescData = protoimpl.X.CompressGZIP(file_remotemetrics_proto_rawDescData)
+	})
+	return file_remotemetrics_proto_rawDescData
+}
+
+var file_remotemetrics_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_remotemetrics_proto_goTypes = []interface{}{
+	(*Envelope)(nil),            // 0: remotemetricsproto.Envelope
+	(*Heartbeat)(nil),           // 1: remotemetricsproto.Heartbeat
+	(*SyncMetrics)(nil),         // 2: remotemetricsproto.SyncMetrics
+	(*MessageMetrics)(nil),      // 3: remotemetricsproto.MessageMetrics
+	(*MessageScheduled)(nil),    // 4: remotemetricsproto.MessageScheduled
+	(*MessageFinalized)(nil),    // 5: remotemetricsproto.MessageFinalized
+	(*MissingMessage)(nil),      // 6: remotemetricsproto.MissingMessage
+	(*BranchMetrics)(nil),       // 7: remotemetricsproto.BranchMetrics
+	(*BranchConfirmation)(nil),  // 8: remotemetricsproto.BranchConfirmation
+	(*BranchCounts)(nil),        // 9: remotemetricsproto.BranchCounts
+	(*SchedulerMetrics)(nil),    // 10: remotemetricsproto.SchedulerMetrics
+	(*DRNGMetrics)(nil),         // 11: remotemetricsproto.DRNGMetrics
+	(*NetworkDelayMetrics)(nil), // 12: remotemetricsproto.NetworkDelayMetrics
+	nil,                         // 13: remotemetricsproto.SchedulerMetrics.QueueLengthPerNodeEntry
+	nil,                         // 14: remotemetricsproto.SchedulerMetrics.AManaNormalizedLengthPerNodeEntry
+}
+var file_remotemetrics_proto_depIdxs = []int32{
+	1,  // 0: remotemetricsproto.Envelope.heartbeat:type_name -> remotemetricsproto.Heartbeat
+	2,  // 1: remotemetricsproto.Envelope.sync:type_name -> remotemetricsproto.SyncMetrics
+	3,  // 2: remotemetricsproto.Envelope.message:type_name -> remotemetricsproto.MessageMetrics
+	7,  // 3: remotemetricsproto.Envelope.branch:type_name -> remotemetricsproto.BranchMetrics
+	10, // 4: remotemetricsproto.Envelope.scheduler:type_name -> remotemetricsproto.SchedulerMetrics
+	11, // 5: remotemetricsproto.Envelope.drng:type_name -> remotemetricsproto.DRNGMetrics
+	12, // 6: remotemetricsproto.Envelope.networkDelay:type_name -> remotemetricsproto.NetworkDelayMetrics
+	4,  // 7: remotemetricsproto.MessageMetrics.scheduled:type_name -> remotemetricsproto.MessageScheduled
+	4,  // 8: remotemetricsproto.MessageMetrics.discarded:type_name -> remotemetricsproto.MessageScheduled
+	5,  // 9: remotemetricsproto.MessageMetrics.finalized:type_name -> remotemetricsproto.MessageFinalized
+	6,  // 10: remotemetricsproto.MessageMetrics.missing:type_name -> remotemetricsproto.MissingMessage
+	6,  // 11: remotemetricsproto.MessageMetrics.missingStored:type_name -> remotemetricsproto.MissingMessage
+	8,  // 12: remotemetricsproto.BranchMetrics.confirmation:type_name -> remotemetricsproto.BranchConfirmation
+	9,  // 13: remotemetricsproto.BranchMetrics.counts:type_name -> remotemetricsproto.BranchCounts
+	13, // 14: remotemetricsproto.SchedulerMetrics.queueLengthPerNode:type_name -> remotemetricsproto.SchedulerMetrics.QueueLengthPerNodeEntry
+	14, // 15: remotemetricsproto.SchedulerMetrics.aManaNormalizedLengthPerNode:type_name -> remotemetricsproto.SchedulerMetrics.AManaNormalizedLengthPerNodeEntry
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_remotemetrics_proto_init() }
+func file_remotemetrics_proto_init() {
+	if File_remotemetrics_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_remotemetrics_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Envelope); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Heartbeat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageScheduled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageFinalized); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MissingMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchConfirmation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BranchCounts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SchedulerMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DRNGMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_remotemetrics_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkDelayMetrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_remotemetrics_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*Envelope_Heartbeat)(nil),
+		(*Envelope_Sync)(nil),
+		(*Envelope_Message)(nil),
+		(*Envelope_Branch)(nil),
+		(*Envelope_Scheduler)(nil),
+		(*Envelope_Drng)(nil),
+		(*Envelope_NetworkDelay)(nil),
+	}
+	file_remotemetrics_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*MessageMetrics_Scheduled)(nil),
+		(*MessageMetrics_Discarded)(nil),
+		(*MessageMetrics_Finalized)(nil),
+		(*MessageMetrics_Missing)(nil),
+		(*MessageMetrics_MissingStored)(nil),
+	}
+	file_remotemetrics_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*BranchMetrics_Confirmation)(nil),
+		(*BranchMetrics_Counts)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_remotemetrics_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   15,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_remotemetrics_proto_goTypes,
+		DependencyIndexes: file_remotemetrics_proto_depIdxs,
+		MessageInfos:      file_remotemetrics_proto_msgTypes,
+	}.Build()
+	File_remotemetrics_proto = out.File
+	file_remotemetrics_proto_rawDesc = nil
+	file_remotemetrics_proto_goTypes = nil
+	file_remotemetrics_proto_depIdxs = nil
+}
@@ -0,0 +1,31 @@
+package resourcemanager
+
+import (
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events represents events happening in the Manager.
+type Events struct {
+	// LevelChanged is triggered when the memory pressure of the node changes its Level.
+	LevelChanged *event.Event[*LevelChangedEvent]
+
+	// CachesFlushed is triggered when the registered caches were flushed.
+	CachesFlushed *event.Event[*CachesFlushedEvent]
+}
+
+// LevelChangedEvent holds information about a changed Level.
+type LevelChangedEvent struct {
+	OldLevel  Level
+	NewLevel  Level
+	HeapUsage uint64
+}
+
+// CachesFlushedEvent holds information about flushed caches.
+type CachesFlushedEvent struct {
+	CacheSize int
+	HeapUsage uint64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,232 @@
+package resourcemanager
+
+import (
+	"runtime"
+	"sync"
+
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Manager monitors the heap usage of the node and the size of the registered caches. Whenever the configured thresholds
+// are exceeded, it flushes the caches and raises its Level, so that the other components can shed load until the
+// memory pressure is relieved.
+type Manager struct {
+	// Events contains the events that are triggered by the Manager.
+	Events *Events
+
+	params        Params
+	heapUsageFunc func() uint64
+	level         *atomic.Uint32
+	caches        map[string]Cache
+	cachesMutex   sync.RWMutex
+	checkMutex    sync.Mutex
+}
+
+// NewManager is the constructor of the Manager.
+func NewManager(params Params, opts ...Option) (manager *Manager) {
+	manager = &Manager{
+		Events: &Events{
+			LevelChanged:  event.New[*LevelChangedEvent]("ResourceManager.LevelChanged"),
+			CachesFlushed: event.New[*CachesFlushedEvent]("ResourceManager.CachesFlushed"),
+		},
+		params:        params,
+		heapUsageFunc: heapUsage,
+		level:         atomic.NewUint32(uint32(LevelNormal)),
+		caches:        make(map[string]Cache),
+	}
+
+	for _, opt := range opts {
+		opt(manager)
+	}
+
+	return manager
+}
+
+// RegisterCache registers a Cache under the given name, so that it is considered by the checks of the Manager.
+func (m *Manager) RegisterCache(name string, cache Cache) {
+	m.cachesMutex.Lock()
+	defer m.cachesMutex.Unlock()
+
+	m.caches[name] = cache
+}
+
+// Level returns the Level that was determined by the last check of the Manager.
+func (m *Manager) Level() Level {
+	return Level(m.level.Load())
+}
+
+// Check determines the current resource usage of the node, flushes the caches if they exceed their limit or if the heap
+// usage is critical and updates the Level of the Manager.
+func (m *Manager) Check() (status *Status) {
+	m.checkMutex.Lock()
+	defer m.checkMutex.Unlock()
+
+	status = &Status{
+		HeapUsage:  m.heapUsageFunc(),
+		CacheSizes: m.cacheSizes(),
+	}
+	status.Level = m.params.level(status.HeapUsage)
+
+	if status.Level == LevelCritical || m.params.CacheSizeLimit > 0 && status.CacheSize() > m.params.CacheSizeLimit {
+		m.flushCaches()
+		m.Events.CachesFlushed.Trigger(&CachesFlushedEvent{
+			CacheSize: status.CacheSize(),
+			HeapUsage: status.HeapUsage,
+		})
+	}
+
+	if oldLevel := Level(m.level.Swap(uint32(status.Level))); oldLevel != status.Level {
+		m.Events.LevelChanged.Trigger(&LevelChangedEvent{
+			OldLevel:  oldLevel,
+			NewLevel:  status.Level,
+			HeapUsage: status.HeapUsage,
+		})
+	}
+
+	return status
+}
+
+// cacheSizes returns the sizes of all registered caches.
+func (m *Manager) cacheSizes() (cacheSizes map[string]int) {
+	m.cachesMutex.RLock()
+	defer m.cachesMutex.RUnlock()
+
+	cacheSizes = make(map[string]int, len(m.caches))
+	for name, cache := range m.caches {
+		cacheSizes[name] = cache.CacheSize()
+	}
+
+	return cacheSizes
+}
+
+// flushCaches flushes all registered caches.
+func (m *Manager) flushCaches() {
+	m.cachesMutex.RLock()
+	defer m.cachesMutex.RUnlock()
+
+	for _, cache := range m.caches {
+		cache.FlushCache()
+	}
+}
+
+// heapUsage returns the number of bytes of allocated heap objects.
+func heapUsage() uint64 {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	return memStats.HeapAlloc
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option represents the return type of optional parameters that can be handed into the constructor of the Manager.
+type Option func(manager *Manager)
+
+// WithHeapUsageFunc is an Option for the Manager that overrides the function that determines the heap usage.
+func WithHeapUsageFunc(heapUsageFunc func() uint64) Option {
+	return func(manager *Manager) {
+		manager.heapUsageFunc = heapUsageFunc
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Params ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Params defines the thresholds of the Manager. A threshold of 0 disables the corresponding check.
+type Params struct {
+	// HeapSoftLimit defines the heap usage in bytes above which the Manager switches to LevelElevated.
+	HeapSoftLimit uint64
+	// HeapHardLimit defines the heap usage in bytes above which the Manager switches to LevelCritical.
+	HeapHardLimit uint64
+	// CacheSizeLimit defines the total number of cached objects above which the caches are flushed.
+	CacheSizeLimit int
+}
+
+// level returns the Level that corresponds to the given heap usage.
+func (p Params) level(heapUsage uint64) Level {
+	switch {
+	case p.HeapHardLimit > 0 && heapUsage >= p.HeapHardLimit:
+		return LevelCritical
+	case p.HeapSoftLimit > 0 && heapUsage >= p.HeapSoftLimit:
+		return LevelElevated
+	default:
+		return LevelNormal
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Level ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Level defines how much memory pressure the node is under.
+type Level uint8
+
+const (
+	// LevelNormal is the Level of a node whose heap usage is below the soft limit.
+	LevelNormal Level = iota
+
+	// LevelElevated is the Level of a node whose heap usage exceeds the soft limit.
+	LevelElevated
+
+	// LevelCritical is the Level of a node whose heap usage exceeds the hard limit.
+	LevelCritical
+)
+
+// String returns a human-readable version of the Level.
+func (l Level) String() string {
+	switch l {
+	case LevelNormal:
+		return "Normal"
+	case LevelElevated:
+		return "Elevated"
+	case LevelCritical:
+		return "Critical"
+	default:
+		return "Unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Cache ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Cache is the interface of the components whose cached objects are considered by the Manager.
+type Cache interface {
+	// CacheSize returns the number of objects that are currently cached.
+	CacheSize() int
+
+	// FlushCache persists the cached objects and releases the memory they occupied.
+	FlushCache()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Status ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Status contains the resource usage that was determined by a check of the Manager.
+type Status struct {
+	// Level is the Level that corresponds to the resource usage.
+	Level Level
+	// HeapUsage is the number of bytes of allocated heap objects.
+	HeapUsage uint64
+	// CacheSizes contains the number of cached objects per registered Cache.
+	CacheSizes map[string]int
+}
+
+// CacheSize returns the total number of cached objects of all registered caches.
+func (s *Status) CacheSize() (cacheSize int) {
+	for _, size := range s.CacheSizes {
+		cacheSize += size
+	}
+
+	return cacheSize
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
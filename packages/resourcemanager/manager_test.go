@@ -0,0 +1,71 @@
+package resourcemanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestManager_Check(t *testing.T) {
+	heapUsage := atomic.NewUint64(0)
+	manager := NewManager(Params{
+		HeapSoftLimit:  100,
+		HeapHardLimit:  200,
+		CacheSizeLimit: 10,
+	}, WithHeapUsageFunc(heapUsage.Load))
+
+	cache := &mockedCache{size: 5}
+	manager.RegisterCache("cache", cache)
+
+	var levelChanges []*LevelChangedEvent
+	manager.Events.LevelChanged.Attach(event.NewClosure(func(ev *LevelChangedEvent) {
+		levelChanges = append(levelChanges, ev)
+	}))
+
+	status := manager.Check()
+	assert.Equal(t, LevelNormal, status.Level)
+	assert.Equal(t, 5, status.CacheSize())
+	assert.Zero(t, cache.flushes)
+	assert.Empty(t, levelChanges)
+
+	// exceeding the cache size limit flushes the caches without changing the level
+	cache.size = 11
+	assert.Equal(t, LevelNormal, manager.Check().Level)
+	assert.Equal(t, 1, cache.flushes)
+
+	heapUsage.Store(150)
+	assert.Equal(t, LevelElevated, manager.Check().Level)
+	assert.Equal(t, 1, cache.flushes)
+
+	// a critical heap usage always flushes the caches
+	heapUsage.Store(250)
+	assert.Equal(t, LevelCritical, manager.Check().Level)
+	assert.Equal(t, LevelCritical, manager.Level())
+	assert.Equal(t, 2, cache.flushes)
+
+	heapUsage.Store(50)
+	assert.Equal(t, LevelNormal, manager.Check().Level)
+
+	assert.Equal(t, []*LevelChangedEvent{
+		{OldLevel: LevelNormal, NewLevel: LevelElevated, HeapUsage: 150},
+		{OldLevel: LevelElevated, NewLevel: LevelCritical, HeapUsage: 250},
+		{OldLevel: LevelCritical, NewLevel: LevelNormal, HeapUsage: 50},
+	}, levelChanges)
+}
+
+type mockedCache struct {
+	size    int
+	flushes int
+}
+
+func (m *mockedCache) CacheSize() int {
+	return m.size
+}
+
+func (m *mockedCache) FlushCache() {
+	m.size = 0
+	m.flushes++
+}
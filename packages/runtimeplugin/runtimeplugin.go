@@ -0,0 +1,214 @@
+// Package runtimeplugin allows to start and stop selected plugins while the node is running instead of only enabling
+// or disabling them at boot.
+package runtimeplugin
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/node"
+)
+
+var (
+	// ErrPluginRunning is returned when a Plugin is started that is already running.
+	ErrPluginRunning = errors.New("plugin is already running")
+
+	// ErrPluginStopped is returned when a Plugin is stopped that is not running.
+	ErrPluginStopped = errors.New("plugin is not running")
+
+	// ErrPluginShutdown is returned when a Plugin is started after the node was shut down.
+	ErrPluginShutdown = errors.New("plugin was shut down")
+)
+
+// region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Plugin is a plugin that can be started and stopped while the node is running. It is always loaded by the node, so
+// that its dependencies are available, while the node.enablePlugins and node.disablePlugins flags only determine
+// whether it is started at boot.
+type Plugin struct {
+	name          string
+	enabledAtBoot bool
+	start         func() error
+	stop          func()
+	running       bool
+	shutdown      bool
+	mutex         sync.RWMutex
+}
+
+// New creates a new Plugin with the given name and registers it. The start function acquires the resources of the
+// Plugin and the stop function releases them again, so that the Plugin can be started and stopped repeatedly. The
+// status (node.Enabled or node.Disabled) determines whether the Plugin is started at boot by default.
+func New(name string, status int, start func() error, stop func()) (plugin *Plugin) {
+	plugin = &Plugin{
+		name:          name,
+		enabledAtBoot: status == node.Enabled,
+		start:         start,
+		stop:          stop,
+	}
+	registry.register(plugin)
+
+	return plugin
+}
+
+// Name returns the name of the Plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetEnabledAtBoot overrides whether the Plugin is started at boot.
+func (p *Plugin) SetEnabledAtBoot(enabled bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.enabledAtBoot = enabled
+}
+
+// EnabledAtBoot returns true if the Plugin is started at boot.
+func (p *Plugin) EnabledAtBoot() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.enabledAtBoot
+}
+
+// Boot starts the Plugin if it is enabled at boot.
+func (p *Plugin) Boot() error {
+	if !p.EnabledAtBoot() {
+		return nil
+	}
+
+	return p.Start()
+}
+
+// Start starts the Plugin. The Plugin remains stopped if its start function fails.
+func (p *Plugin) Start() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.shutdown {
+		return errors.Errorf("failed to start %s: %w", p.name, ErrPluginShutdown)
+	}
+	if p.running {
+		return errors.Errorf("failed to start %s: %w", p.name, ErrPluginRunning)
+	}
+
+	if err := p.start(); err != nil {
+		return errors.Errorf("failed to start %s: %w", p.name, err)
+	}
+	p.running = true
+
+	return nil
+}
+
+// Stop stops the Plugin and releases its resources.
+func (p *Plugin) Stop() error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if !p.running {
+		return errors.Errorf("failed to stop %s: %w", p.name, ErrPluginStopped)
+	}
+
+	p.stop()
+	p.running = false
+
+	return nil
+}
+
+// Running returns true if the Plugin is running.
+func (p *Plugin) Running() bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.running
+}
+
+// WhileRunning executes the given callback if the Plugin is running and prevents the Plugin from being stopped until
+// the callback returns. It returns false if the Plugin is not running.
+func (p *Plugin) WhileRunning(callback func()) (running bool) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if !p.running {
+		return false
+	}
+	callback()
+
+	return true
+}
+
+// Shutdown stops the Plugin if it is running and prevents it from being started again. It is called when the node
+// shuts down.
+func (p *Plugin) Shutdown() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.running {
+		p.stop()
+		p.running = false
+	}
+	p.shutdown = true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region registry /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Get returns the registered Plugin with the given name. The name is matched like the names of the node.enablePlugins
+// and node.disablePlugins flags (case-insensitive and ignoring spaces).
+func Get(name string) (plugin *Plugin, exists bool) {
+	return registry.get(name)
+}
+
+// Plugins returns all registered Plugins sorted by their name.
+func Plugins() []*Plugin {
+	return registry.plugins()
+}
+
+var registry = &pluginRegistry{
+	pluginsByIdentifier: make(map[string]*Plugin),
+}
+
+// pluginRegistry keeps track of the created Plugins.
+type pluginRegistry struct {
+	pluginsByIdentifier map[string]*Plugin
+	mutex               sync.RWMutex
+}
+
+func (r *pluginRegistry) register(plugin *Plugin) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	identifier := node.GetPluginIdentifier(plugin.name)
+	if _, exists := r.pluginsByIdentifier[identifier]; exists {
+		panic("duplicate runtime plugin - \"" + plugin.name + "\" was defined already")
+	}
+	r.pluginsByIdentifier[identifier] = plugin
+}
+
+func (r *pluginRegistry) get(name string) (plugin *Plugin, exists bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	plugin, exists = r.pluginsByIdentifier[node.GetPluginIdentifier(name)]
+
+	return plugin, exists
+}
+
+func (r *pluginRegistry) plugins() (plugins []*Plugin) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	plugins = make([]*Plugin, 0, len(r.pluginsByIdentifier))
+	for _, plugin := range r.pluginsByIdentifier {
+		plugins = append(plugins, plugin)
+	}
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].name < plugins[j].name
+	})
+
+	return plugins
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
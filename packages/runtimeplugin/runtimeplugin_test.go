@@ -0,0 +1,55 @@
+package runtimeplugin
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlugin(t *testing.T) {
+	var starts, stops int
+	var startErr error
+	plugin := New("Test Plugin", node.Disabled, func() error {
+		starts++
+		return startErr
+	}, func() {
+		stops++
+	})
+
+	registeredPlugin, exists := Get("testplugin")
+	require.True(t, exists)
+	assert.Equal(t, plugin, registeredPlugin)
+	assert.Contains(t, Plugins(), plugin)
+
+	// the plugin is not started at boot unless it is enabled
+	require.NoError(t, plugin.Boot())
+	assert.False(t, plugin.Running())
+	plugin.SetEnabledAtBoot(true)
+	require.NoError(t, plugin.Boot())
+	assert.True(t, plugin.Running())
+	assert.True(t, errors.Is(plugin.Start(), ErrPluginRunning))
+	assert.Equal(t, 1, starts)
+
+	assert.True(t, plugin.WhileRunning(func() {}))
+	require.NoError(t, plugin.Stop())
+	assert.False(t, plugin.WhileRunning(func() {
+		t.Fatal("callback must not be executed while the plugin is stopped")
+	}))
+	assert.True(t, errors.Is(plugin.Stop(), ErrPluginStopped))
+	assert.Equal(t, 1, stops)
+
+	// the plugin remains stopped if it fails to start
+	startErr = errors.New("start failed")
+	assert.True(t, errors.Is(plugin.Start(), startErr))
+	assert.False(t, plugin.Running())
+
+	startErr = nil
+	require.NoError(t, plugin.Start())
+	plugin.Shutdown()
+	assert.False(t, plugin.Running())
+	assert.Equal(t, 2, stops)
+	assert.True(t, errors.Is(plugin.Start(), ErrPluginShutdown))
+}
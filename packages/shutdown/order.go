@@ -9,12 +9,20 @@ const (
 	PriorityMana
 	// PriorityTangle defines the shutdown priority for the tangle.
 	PriorityTangle
+	// PriorityArchive defines the shutdown priority for the archive plugin.
+	PriorityArchive
+	// PriorityWatchList defines the shutdown priority for the watch list plugin.
+	PriorityWatchList
+	// PriorityNameRegistry defines the shutdown priority for the name registry plugin.
+	PriorityNameRegistry
 	// PriorityDRNG defines the shutdown priority for dRNG.
 	PriorityDRNG
 	// PriorityFaucet defines the shutdown priority for the faucet.
 	PriorityFaucet
 	// PriorityRemoteLog defines the shutdown priority for remote log.
 	PriorityRemoteLog
+	// PriorityNetworkDelay defines the shutdown priority for the network delay plugin.
+	PriorityNetworkDelay
 	// PriorityAnalysis defines the shutdown priority for analysis server.
 	PriorityAnalysis
 	// PriorityPrometheus defines the shutdown priority for prometheus.
@@ -47,6 +55,20 @@ const (
 	PriorityBootstrap
 	// PriorityTXStream defines the shutdown priority for realtime.
 	PriorityTXStream
+	// PriorityStateSync defines the shutdown priority for the state sync plugin.
+	PriorityStateSync
+	// PriorityDoubleSpendAlert defines the shutdown priority for the double spend alert plugin.
+	PriorityDoubleSpendAlert
+	// PriorityWebhooks defines the shutdown priority for the webhooks plugin.
+	PriorityWebhooks
+	// PriorityResourceManager defines the shutdown priority for the resourcemanager plugin.
+	PriorityResourceManager
+	// PriorityFragmentation defines the shutdown priority for the fragmentation plugin.
+	PriorityFragmentation
+	// PriorityAnchor defines the shutdown priority for the anchor plugin.
+	PriorityAnchor
+	// PriorityProfiling defines the shutdown priority for the profiling plugin.
+	PriorityProfiling
 	// PriorityHealthz defines the shutdown priority of the healthz endpoint. It should always be last.
 	PriorityHealthz
 )
@@ -0,0 +1,169 @@
+package shutdown
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+)
+
+// ErrTimeout is returned if the steps of a Sequence did not finish within its timeout.
+var ErrTimeout = errors.New("shutdown timed out")
+
+// region Sequence /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Sequence is an ordered list of shutdown steps of a component. The steps are executed one after the other, so that a
+// component can stop its ingress, drain its queues and flush its storages in the order that keeps its persisted state
+// consistent.
+type Sequence struct {
+	name    string
+	steps   []*step
+	options *SequenceOptions
+}
+
+// NewSequence creates a new Sequence with the given name, which is used in the log messages.
+func NewSequence(name string, opts ...SequenceOption) (sequence *Sequence) {
+	sequence = &Sequence{
+		name: name,
+		options: &SequenceOptions{
+			ProgressInterval: 5 * time.Second,
+		},
+	}
+	for _, opt := range opts {
+		opt(sequence.options)
+	}
+
+	return sequence
+}
+
+// Add appends a step to the Sequence.
+func (s *Sequence) Add(name string, run func()) *Sequence {
+	s.steps = append(s.steps, &step{name: name, run: run})
+
+	return s
+}
+
+// Steps returns the names of the steps of the Sequence in the order of their execution.
+func (s *Sequence) Steps() (names []string) {
+	names = make([]string, 0, len(s.steps))
+	for _, step := range s.steps {
+		names = append(names, step.name)
+	}
+
+	return names
+}
+
+// Run executes the steps of the Sequence. It logs the progress of the steps and returns an ErrTimeout if the steps did
+// not finish within the timeout of the Sequence. The remaining steps are skipped in that case, but Run still waits for
+// the running step to finish, so that the resources it uses (e.g. the database) are not released while it is running.
+func (s *Sequence) Run() (err error) {
+	var deadline <-chan time.Time
+	if s.options.Timeout > 0 {
+		timer := time.NewTimer(s.options.Timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	start := time.Now()
+	for i, step := range s.steps {
+		s.logInfof("shutting down %s: %s (%d/%d) ...", s.name, step.name, i+1, len(s.steps))
+
+		if err = s.runStep(step, deadline, start); err != nil {
+			s.logWarnf("shutting down %s: %s", s.name, err)
+			return err
+		}
+	}
+	s.logInfof("shutting down %s ... done (took %s)", s.name, time.Since(start).Truncate(time.Millisecond))
+
+	return nil
+}
+
+// runStep executes the given step and waits until it finished. It returns an ErrTimeout if the deadline expired while the
+// step was running.
+func (s *Sequence) runStep(step *step, deadline <-chan time.Time, start time.Time) (err error) {
+	stepStart := time.Now()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		step.run()
+	}()
+
+	progressTicker := time.NewTicker(s.options.ProgressInterval)
+	defer progressTicker.Stop()
+
+	for {
+		select {
+		case <-done:
+			s.logInfof("shutting down %s: %s ... done (took %s)", s.name, step.name, time.Since(stepStart).Truncate(time.Millisecond))
+			return err
+		case <-progressTicker.C:
+			s.logInfof("shutting down %s: %s ... still running (%s)", s.name, step.name, time.Since(stepStart).Truncate(time.Second))
+		case <-deadline:
+			err = errors.Errorf("step %s did not finish within %s: %w", step.name, time.Since(start).Truncate(time.Millisecond), ErrTimeout)
+			s.logWarnf("shutting down %s: %s ... timed out, waiting for it to finish before skipping the remaining steps", s.name, step.name)
+			deadline = nil
+		}
+	}
+}
+
+func (s *Sequence) logInfof(template string, args ...interface{}) {
+	if s.options.Logger != nil {
+		s.options.Logger.Infof(template, args...)
+	}
+}
+
+func (s *Sequence) logWarnf(template string, args ...interface{}) {
+	if s.options.Logger != nil {
+		s.options.Logger.Warnf(template, args...)
+	}
+}
+
+// step is a named step of a Sequence.
+type step struct {
+	name string
+	run  func()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SequenceOptions //////////////////////////////////////////////////////////////////////////////////////////////
+
+// Logger is the interface of the loggers that report the progress of a Sequence.
+type Logger interface {
+	Infof(template string, args ...interface{})
+	Warnf(template string, args ...interface{})
+}
+
+// SequenceOptions is a container for the options of a Sequence.
+type SequenceOptions struct {
+	Timeout          time.Duration
+	ProgressInterval time.Duration
+	Logger           Logger
+}
+
+// SequenceOption is the type of the functional options of a Sequence.
+type SequenceOption func(*SequenceOptions)
+
+// WithTimeout returns a SequenceOption that limits the time that the steps of a Sequence may take (0 disables the
+// limit).
+func WithTimeout(timeout time.Duration) SequenceOption {
+	return func(options *SequenceOptions) {
+		options.Timeout = timeout
+	}
+}
+
+// WithProgressInterval returns a SequenceOption that sets the interval in which the progress of a long-running step is
+// logged.
+func WithProgressInterval(progressInterval time.Duration) SequenceOption {
+	return func(options *SequenceOptions) {
+		options.ProgressInterval = progressInterval
+	}
+}
+
+// WithLogger returns a SequenceOption that sets the Logger that reports the progress of a Sequence.
+func WithLogger(logger Logger) SequenceOption {
+	return func(options *SequenceOptions) {
+		options.Logger = logger
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
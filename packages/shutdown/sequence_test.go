@@ -0,0 +1,87 @@
+package shutdown
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSequence_Run(t *testing.T) {
+	logger := &testLogger{}
+	var executed []string
+	sequence := NewSequence("component", WithLogger(logger), WithTimeout(time.Second)).
+		Add("stop ingress", func() { executed = append(executed, "stop ingress") }).
+		Add("drain queues", func() { executed = append(executed, "drain queues") }).
+		Add("flush storages", func() { executed = append(executed, "flush storages") })
+
+	assert.Equal(t, []string{"stop ingress", "drain queues", "flush storages"}, sequence.Steps())
+	require.NoError(t, sequence.Run())
+	assert.Equal(t, sequence.Steps(), executed)
+
+	assert.Contains(t, logger.messages(), "shutting down component: drain queues (2/3) ...")
+	assert.Empty(t, logger.warnings)
+}
+
+func TestSequence_Timeout(t *testing.T) {
+	logger := &testLogger{}
+	blockingStep := make(chan struct{})
+	time.AfterFunc(200*time.Millisecond, func() { close(blockingStep) })
+
+	var blockingStepFinished, lastStepExecuted bool
+	sequence := NewSequence("component", WithLogger(logger), WithTimeout(50*time.Millisecond), WithProgressInterval(10*time.Millisecond)).
+		Add("drain queues", func() {
+			<-blockingStep
+			blockingStepFinished = true
+		}).
+		Add("flush storages", func() { lastStepExecuted = true })
+
+	err := sequence.Run()
+	assert.True(t, errors.Is(err, ErrTimeout))
+	assert.Contains(t, err.Error(), "drain queues")
+	assert.False(t, lastStepExecuted)
+
+	// the running step is not abandoned
+	assert.True(t, blockingStepFinished)
+
+	assert.Len(t, logger.warnings, 2)
+	var progressLogged bool
+	for _, message := range logger.messages() {
+		if message == "shutting down component: drain queues ... still running (0s)" {
+			progressLogged = true
+		}
+	}
+	assert.True(t, progressLogged)
+}
+
+// testLogger is a Logger that records the logged messages.
+type testLogger struct {
+	infos    []string
+	warnings []string
+	mutex    sync.Mutex
+}
+
+func (t *testLogger) Infof(template string, args ...interface{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.infos = append(t.infos, fmt.Sprintf(template, args...))
+}
+
+func (t *testLogger) Warnf(template string, args ...interface{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.warnings = append(t.warnings, fmt.Sprintf(template, args...))
+}
+
+func (t *testLogger) messages() []string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	return append([]string{}, t.infos...)
+}
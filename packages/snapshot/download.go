@@ -0,0 +1,131 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/cockroachdb/errors"
+)
+
+// partialFileSuffix is appended to the target path while a snapshot is being downloaded.
+const partialFileSuffix = ".part"
+
+// ErrUnexpectedStatus is returned if the server answers a snapshot request with an unexpected status code.
+var ErrUnexpectedStatus = errors.New("unexpected response status")
+
+// Download fetches the chunked snapshot from the given url and stores it at the given path. Data is first written to
+// a partial file next to the target, so that interrupted downloads continue from the last verified chunk instead of
+// starting from zero. The target file is only created once the whole snapshot was received and verified. The optional
+// request modifiers can be used to add things like authentication to the request.
+func Download(ctx context.Context, httpClient *http.Client, url, path string, requestModifiers ...func(*http.Request)) (err error) {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	partialPath := path + partialFileSuffix
+	offset, eTag, complete, err := resumeOffset(partialPath)
+	if err != nil {
+		return err
+	}
+	if complete {
+		return os.Rename(partialPath, path)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return errors.Errorf("failed to create snapshot request: %w", err)
+	}
+	if offset > 0 {
+		request.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+		request.Header.Set("If-Range", eTag)
+	}
+	for _, modifyRequest := range requestModifiers {
+		modifyRequest(request)
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return errors.Errorf("failed to request snapshot from %s: %w", url, err)
+	}
+	defer response.Body.Close()
+
+	flags := os.O_CREATE | os.O_WRONLY
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+		flags |= os.O_APPEND
+	case http.StatusOK:
+		// the server sent the full (possibly changed) snapshot, so we have to start from scratch
+		flags |= os.O_TRUNC
+	default:
+		return errors.Errorf("failed to download snapshot from %s (%s): %w", url, response.Status, ErrUnexpectedStatus)
+	}
+
+	file, err := os.OpenFile(partialPath, flags, 0o644)
+	if err != nil {
+		return errors.Errorf("failed to open partial snapshot file %s: %w", partialPath, err)
+	}
+	if response.StatusCode == http.StatusPartialContent {
+		if err = file.Truncate(offset); err != nil {
+			_ = file.Close()
+			return errors.Errorf("failed to truncate partial snapshot file %s: %w", partialPath, err)
+		}
+	}
+	if _, err = io.Copy(file, response.Body); err != nil {
+		_ = file.Close()
+		return errors.Errorf("snapshot download interrupted: %w", err)
+	}
+	if err = file.Close(); err != nil {
+		return errors.Errorf("failed to close partial snapshot file %s: %w", partialPath, err)
+	}
+
+	if err = Verify(partialPath); err != nil {
+		return err
+	}
+
+	return os.Rename(partialPath, path)
+}
+
+// Verify checks that the chunked snapshot file at the given path is complete and that all of its chunks are valid.
+func Verify(path string) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return errors.Errorf("failed to open snapshot file %s: %w", path, err)
+	}
+	defer file.Close()
+
+	header, verifiedLength, err := VerifiedLength(file)
+	if err != nil {
+		return err
+	}
+	if verifiedLength != header.FileSize() {
+		return errors.Errorf("snapshot file %s is only valid up to byte %d of %d: %w", path, verifiedLength, header.FileSize(), ErrChecksumMismatch)
+	}
+
+	return nil
+}
+
+// resumeOffset returns the offset that a download into the given partial file can be resumed from together with the
+// entity tag of the snapshot that is being downloaded. It returns 0 if the download needs to start from scratch and
+// reports if the partial file already contains the complete snapshot.
+func resumeOffset(partialPath string) (offset int64, eTag string, complete bool, err error) {
+	file, err := os.Open(partialPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, "", false, nil
+		}
+
+		return 0, "", false, errors.Errorf("failed to open partial snapshot file %s: %w", partialPath, err)
+	}
+	defer file.Close()
+
+	header, verifiedLength, err := VerifiedLength(file)
+	if err != nil {
+		// the header itself is incomplete or broken - start from scratch
+		return 0, "", false, nil
+	}
+
+	return verifiedLength, header.ETag(), verifiedLength == header.FileSize(), nil
+}
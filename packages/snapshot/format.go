@@ -0,0 +1,464 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+
+	"github.com/cockroachdb/errors"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region Format ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+const (
+	// Version is the version of the chunked snapshot format.
+	Version byte = 3
+
+	// versionWithoutNetworkID is the version of the chunked snapshot format whose header does not contain a network ID.
+	versionWithoutNetworkID byte = 2
+
+	// DefaultChunkSize is the default amount of payload bytes that are covered by a single checksum.
+	DefaultChunkSize = 1 << 20
+
+	// MaxChunkSize is the maximum amount of payload bytes that are covered by a single checksum, which limits the size
+	// of the buffers that are allocated to verify a chunk.
+	MaxChunkSize = 16 * DefaultChunkSize
+
+	// ChecksumLength contains the amount of bytes of a chunk checksum.
+	ChecksumLength = blake2b.Size256
+
+	// MaxChunkCount is the maximum amount of chunks of a snapshot, which limits the size of its header.
+	MaxChunkCount = 1 << 16
+
+	// MaxPayloadSize is the maximum size of the payload of a snapshot.
+	MaxPayloadSize = MaxChunkCount * DefaultChunkSize
+
+	// fixedHeaderLength contains the length of the header fields that precede the checksums (magic, version,
+	// chunk size, payload size and chunk count).
+	fixedHeaderLength = len(magicString) + 1 + 4 + 8 + 4
+
+	// networkIDLength contains the length of the network ID that follows the version since Version 3.
+	networkIDLength = 4
+
+	// magicString contains the byte sequence that every chunked snapshot file starts with.
+	magicString = "GSS2"
+)
+
+var magic = []byte(magicString)
+
+var (
+	// ErrInvalidFormat is returned if the data does not contain a chunked snapshot.
+	ErrInvalidFormat = errors.New("invalid snapshot format")
+	// ErrChecksumMismatch is returned if the content of a chunk does not match its checksum.
+	ErrChecksumMismatch = errors.New("snapshot chunk checksum mismatch")
+	// ErrNetworkMismatch is returned if the snapshot belongs to a different network.
+	ErrNetworkMismatch = errors.New("snapshot belongs to a different network")
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Header ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Header contains the metadata that precedes the payload of a chunked snapshot. The payload is split into chunks of
+// ChunkSize bytes (the last chunk may be shorter) and every chunk is secured by its own checksum, which allows to
+// verify (and resume) partially downloaded snapshot files at chunk granularity. Snapshots that were written before the
+// network ID was added to the header belong to network 0.
+type Header struct {
+	Version     byte
+	NetworkID   uint32
+	ChunkSize   uint32
+	PayloadSize uint64
+	Checksums   [][ChecksumLength]byte
+}
+
+// NewHeader creates the Header for the given payload of a snapshot of the network with the given ID.
+func NewHeader(payload []byte, chunkSize int, networkID uint32) (header *Header, err error) {
+	checksumWriter, err := newChecksumWriter(chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = checksumWriter.Write(payload); err != nil {
+		return nil, err
+	}
+
+	return checksumWriter.Header(networkID)
+}
+
+// ReadHeader reads the Header of a chunked snapshot from the given reader.
+func ReadHeader(reader io.Reader) (header *Header, err error) {
+	fixed := make([]byte, fixedHeaderLength)
+	if _, err = io.ReadFull(reader, fixed[:len(magic)+1]); err != nil {
+		return nil, errors.Errorf("failed to read snapshot header: %w", err)
+	}
+	if !bytes.Equal(fixed[:len(magic)], magic) {
+		return nil, errors.Errorf("unknown magic bytes %X: %w", fixed[:len(magic)], ErrInvalidFormat)
+	}
+
+	header = &Header{Version: fixed[len(magic)]}
+	switch header.Version {
+	case Version:
+		networkID := make([]byte, networkIDLength)
+		if _, err = io.ReadFull(reader, networkID); err != nil {
+			return nil, errors.Errorf("failed to read network ID: %w", err)
+		}
+		header.NetworkID = binary.LittleEndian.Uint32(networkID)
+	case versionWithoutNetworkID:
+	default:
+		return nil, errors.Errorf("unsupported snapshot version %d: %w", header.Version, ErrInvalidFormat)
+	}
+
+	offset := len(magic) + 1
+	if _, err = io.ReadFull(reader, fixed[offset:]); err != nil {
+		return nil, errors.Errorf("failed to read snapshot header: %w", err)
+	}
+	header.ChunkSize = binary.LittleEndian.Uint32(fixed[offset:])
+	header.PayloadSize = binary.LittleEndian.Uint64(fixed[offset+4:])
+	chunkCount := binary.LittleEndian.Uint32(fixed[offset+12:])
+	if header.PayloadSize > MaxPayloadSize || chunkCount > MaxChunkCount {
+		return nil, errors.Errorf("payload size %d with %d chunks exceeds the limits of %d bytes and %d chunks: %w", header.PayloadSize, chunkCount, uint64(MaxPayloadSize), MaxChunkCount, ErrInvalidFormat)
+	}
+	if header.ChunkSize == 0 || header.ChunkSize > MaxChunkSize {
+		return nil, errors.Errorf("chunk size %d is not within the limits of 1 and %d bytes: %w", header.ChunkSize, MaxChunkSize, ErrInvalidFormat)
+	}
+	if uint64(chunkCount) != header.expectedChunkCount() {
+		return nil, errors.Errorf("chunk count %d does not match payload size %d and chunk size %d: %w", chunkCount, header.PayloadSize, header.ChunkSize, ErrInvalidFormat)
+	}
+
+	header.Checksums = make([][ChecksumLength]byte, chunkCount)
+	for i := range header.Checksums {
+		if _, err = io.ReadFull(reader, header.Checksums[i][:]); err != nil {
+			return nil, errors.Errorf("failed to read checksum of chunk %d: %w", i, err)
+		}
+	}
+
+	return header, nil
+}
+
+// Bytes returns a marshaled version of the Header.
+func (h *Header) Bytes() []byte {
+	buffer := make([]byte, h.Length())
+	copy(buffer, magic)
+	offset := len(magic)
+	buffer[offset] = h.Version
+	offset++
+	if h.Version != versionWithoutNetworkID {
+		binary.LittleEndian.PutUint32(buffer[offset:], h.NetworkID)
+		offset += networkIDLength
+	}
+	binary.LittleEndian.PutUint32(buffer[offset:], h.ChunkSize)
+	binary.LittleEndian.PutUint64(buffer[offset+4:], h.PayloadSize)
+	binary.LittleEndian.PutUint32(buffer[offset+12:], uint32(len(h.Checksums)))
+	offset += 16
+	for _, checksum := range h.Checksums {
+		offset += copy(buffer[offset:], checksum[:])
+	}
+
+	return buffer
+}
+
+// Length returns the amount of bytes that the marshaled Header occupies.
+func (h *Header) Length() int {
+	if h.Version == versionWithoutNetworkID {
+		return fixedHeaderLength + len(h.Checksums)*ChecksumLength
+	}
+
+	return fixedHeaderLength + networkIDLength + len(h.Checksums)*ChecksumLength
+}
+
+// CheckNetworkID returns an ErrNetworkMismatch if the snapshot does not belong to the network with the given ID.
+func (h *Header) CheckNetworkID(networkID uint32) error {
+	if h.NetworkID != networkID {
+		return errors.Errorf("snapshot of network %d can not be used in network %d: %w", h.NetworkID, networkID, ErrNetworkMismatch)
+	}
+
+	return nil
+}
+
+// FileSize returns the total size of a complete snapshot file with this Header.
+func (h *Header) FileSize() int64 {
+	return int64(h.Length()) + int64(h.PayloadSize)
+}
+
+// ChunkLength returns the length of the chunk with the given index.
+func (h *Header) ChunkLength(index int) int {
+	if remainder := h.PayloadSize - uint64(index)*uint64(h.ChunkSize); remainder < uint64(h.ChunkSize) {
+		return int(remainder)
+	}
+
+	return int(h.ChunkSize)
+}
+
+// VerifyChunk checks if the given data matches the checksum of the chunk with the given index.
+func (h *Header) VerifyChunk(index int, data []byte) error {
+	if index >= len(h.Checksums) || len(data) != h.ChunkLength(index) {
+		return errors.Errorf("chunk %d has unexpected length %d: %w", index, len(data), ErrChecksumMismatch)
+	}
+	if blake2b.Sum256(data) != h.Checksums[index] {
+		return errors.Errorf("chunk %d is corrupted: %w", index, ErrChecksumMismatch)
+	}
+
+	return nil
+}
+
+// ETag returns an entity tag that uniquely identifies the snapshot described by this Header. It is used to make sure
+// that resumed downloads continue to fetch the same snapshot.
+func (h *Header) ETag() string {
+	checksum := blake2b.Sum256(h.Bytes())
+	return `"` + hex.EncodeToString(checksum[:]) + `"`
+}
+
+// String returns a human-readable version of the Header.
+func (h *Header) String() string {
+	return fmt.Sprintf("Header{Version: %d, NetworkID: %d, ChunkSize: %d, PayloadSize: %d, Chunks: %d}", h.Version, h.NetworkID, h.ChunkSize, h.PayloadSize, len(h.Checksums))
+}
+
+func (h *Header) expectedChunkCount() uint64 {
+	return (h.PayloadSize + uint64(h.ChunkSize) - 1) / uint64(h.ChunkSize)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Write/Read ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Write writes the given ledger snapshot of the network with the given ID in the chunked format to the writer. The
+// ledger snapshot is serialized twice, once to compute the checksums of the header and once to stream the payload, so
+// that the serialized snapshot never has to be held in memory.
+func Write(writer io.Writer, ledgerSnapshot *ledgerstate.Snapshot, chunkSize int, networkID uint32) (int64, error) {
+	checksumWriter, err := newChecksumWriter(chunkSize)
+	if err != nil {
+		return 0, err
+	}
+	if _, err = ledgerSnapshot.WriteTo(checksumWriter); err != nil {
+		return 0, errors.Errorf("failed to serialize ledger snapshot: %w", err)
+	}
+	header, err := checksumWriter.Header(networkID)
+	if err != nil {
+		return 0, err
+	}
+
+	headerBytesWritten, err := writer.Write(header.Bytes())
+	if err != nil {
+		return int64(headerBytesWritten), errors.Errorf("failed to write snapshot header: %w", err)
+	}
+
+	// the streamed payload is checksummed again, so that a payload that differs from the header is detected
+	verificationWriter, _ := newChecksumWriter(chunkSize)
+	bufferedWriter := bufio.NewWriter(io.MultiWriter(writer, verificationWriter))
+	if _, err = ledgerSnapshot.WriteTo(bufferedWriter); err == nil {
+		err = bufferedWriter.Flush()
+	}
+	payloadBytesWritten := int64(verificationWriter.size)
+	if err != nil {
+		return int64(headerBytesWritten) + payloadBytesWritten, errors.Errorf("failed to write snapshot payload: %w", err)
+	}
+	if verificationHeader, verificationErr := verificationWriter.Header(networkID); verificationErr != nil || !bytes.Equal(verificationHeader.Bytes(), header.Bytes()) {
+		return int64(headerBytesWritten) + payloadBytesWritten, errors.Errorf("written snapshot payload does not match its header: %w", ErrChecksumMismatch)
+	}
+
+	return int64(headerBytesWritten) + payloadBytesWritten, nil
+}
+
+// Read reads a chunked snapshot from the reader and verifies every chunk before it is handed to the ledger snapshot
+// parser. It returns an ErrNetworkMismatch if the snapshot does not belong to the network with the given ID.
+func Read(reader io.Reader, networkID uint32) (ledgerSnapshot *ledgerstate.Snapshot, err error) {
+	header, err := ReadHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+	if err = header.CheckNetworkID(networkID); err != nil {
+		return nil, err
+	}
+
+	verifiedReader := NewVerifyingReader(header, reader)
+	ledgerSnapshot = &ledgerstate.Snapshot{}
+	if _, err = ledgerSnapshot.ReadFrom(verifiedReader); err != nil {
+		return nil, errors.Errorf("failed to parse ledger snapshot: %w", err)
+	}
+	if _, err = io.Copy(io.Discard, verifiedReader); err != nil {
+		return nil, err
+	}
+
+	return ledgerSnapshot, nil
+}
+
+// ReadAny reads a snapshot of the network with the given ID from the given reader, no matter if it is stored in the
+// chunked or in the legacy format. Snapshots in the legacy format belong to network 0.
+func ReadAny(reader io.Reader, networkID uint32) (ledgerSnapshot *ledgerstate.Snapshot, err error) {
+	bufferedReader := bufio.NewReader(reader)
+	prefix, err := bufferedReader.Peek(len(magic))
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errors.Errorf("failed to read snapshot: %w", err)
+	}
+
+	if bytes.Equal(prefix, magic) {
+		return Read(bufferedReader, networkID)
+	}
+	if networkID != 0 {
+		return nil, errors.Errorf("legacy snapshot can not be used in network %d: %w", networkID, ErrNetworkMismatch)
+	}
+
+	ledgerSnapshot = &ledgerstate.Snapshot{}
+	if _, err = ledgerSnapshot.ReadFrom(bufferedReader); err != nil {
+		return nil, errors.Errorf("failed to parse legacy ledger snapshot: %w", err)
+	}
+
+	return ledgerSnapshot, nil
+}
+
+// VerifiedLength returns the length of the longest prefix of the given (potentially incomplete) snapshot file that
+// consists of the header and fully downloaded chunks with valid checksums. Downloads can safely be resumed from this
+// offset.
+func VerifiedLength(reader io.Reader) (header *Header, verifiedLength int64, err error) {
+	if header, err = ReadHeader(reader); err != nil {
+		return nil, 0, err
+	}
+
+	verifiedLength = int64(header.Length())
+	for i := range header.Checksums {
+		chunk := make([]byte, header.ChunkLength(i))
+		if _, err = io.ReadFull(reader, chunk); err != nil {
+			return header, verifiedLength, nil
+		}
+		if header.VerifyChunk(i, chunk) != nil {
+			return header, verifiedLength, nil
+		}
+		verifiedLength += int64(len(chunk))
+	}
+
+	return header, verifiedLength, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region checksumWriter //////////////////////////////////////////////////////////////////////////////////////////////
+
+// checksumWriter is an io.Writer that computes the checksums of the chunks of the payload that is written to it.
+type checksumWriter struct {
+	chunkSize   int
+	chunk       hash.Hash
+	chunkLength int
+	size        uint64
+	checksums   [][ChecksumLength]byte
+}
+
+// newChecksumWriter creates a checksumWriter for chunks of the given size.
+func newChecksumWriter(chunkSize int) (*checksumWriter, error) {
+	if chunkSize <= 0 || chunkSize > MaxChunkSize {
+		return nil, errors.Errorf("chunk size must be positive and at most %d, got %d: %w", MaxChunkSize, chunkSize, ErrInvalidFormat)
+	}
+
+	chunk, err := blake2b.New256(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &checksumWriter{
+		chunkSize: chunkSize,
+		chunk:     chunk,
+	}, nil
+}
+
+// Write implements the io.Writer interface.
+func (c *checksumWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		length := c.chunkSize - c.chunkLength
+		if length > len(p) {
+			length = len(p)
+		}
+
+		_, _ = c.chunk.Write(p[:length])
+		c.chunkLength += length
+		c.size += uint64(length)
+		n += length
+		p = p[length:]
+
+		if c.chunkLength == c.chunkSize {
+			c.completeChunk()
+		}
+	}
+
+	return n, nil
+}
+
+// Header returns the Header of the payload that was written so far.
+func (c *checksumWriter) Header(networkID uint32) (header *Header, err error) {
+	if c.chunkLength != 0 {
+		c.completeChunk()
+	}
+	if c.size > MaxPayloadSize || len(c.checksums) > MaxChunkCount {
+		return nil, errors.Errorf("payload size %d with %d chunks exceeds the limits of %d bytes and %d chunks: %w", c.size, len(c.checksums), uint64(MaxPayloadSize), MaxChunkCount, ErrInvalidFormat)
+	}
+
+	return &Header{
+		Version:     Version,
+		NetworkID:   networkID,
+		ChunkSize:   uint32(c.chunkSize),
+		PayloadSize: c.size,
+		Checksums:   c.checksums,
+	}, nil
+}
+
+// completeChunk stores the checksum of the current chunk and starts the next one.
+func (c *checksumWriter) completeChunk() {
+	var checksum [ChecksumLength]byte
+	copy(checksum[:], c.chunk.Sum(nil))
+	c.checksums = append(c.checksums, checksum)
+
+	c.chunk.Reset()
+	c.chunkLength = 0
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region VerifyingReader //////////////////////////////////////////////////////////////////////////////////////////////
+
+// VerifyingReader is an io.Reader that returns the payload of a chunked snapshot and verifies every chunk before
+// handing out its bytes.
+type VerifyingReader struct {
+	header     *Header
+	source     io.Reader
+	chunkIndex int
+	chunk      []byte
+}
+
+// NewVerifyingReader creates a reader that returns the verified payload that follows the given header.
+func NewVerifyingReader(header *Header, source io.Reader) *VerifyingReader {
+	return &VerifyingReader{
+		header: header,
+		source: source,
+	}
+}
+
+// Read implements the io.Reader interface.
+func (v *VerifyingReader) Read(p []byte) (n int, err error) {
+	if len(v.chunk) == 0 {
+		if v.chunkIndex >= len(v.header.Checksums) {
+			return 0, io.EOF
+		}
+
+		chunk := make([]byte, v.header.ChunkLength(v.chunkIndex))
+		if _, err = io.ReadFull(v.source, chunk); err != nil {
+			return 0, errors.Errorf("failed to read chunk %d: %w", v.chunkIndex, err)
+		}
+		if err = v.header.VerifyChunk(v.chunkIndex, chunk); err != nil {
+			return 0, err
+		}
+
+		v.chunk = chunk
+		v.chunkIndex++
+	}
+
+	n = copy(p, v.chunk)
+	v.chunk = v.chunk[n:]
+
+	return n, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
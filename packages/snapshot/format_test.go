@@ -0,0 +1,240 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const testChunkSize = 16
+
+func TestWriteRead(t *testing.T) {
+	ledgerSnapshot := newTestSnapshot(10)
+
+	var buffer bytes.Buffer
+	n, err := Write(&buffer, ledgerSnapshot, testChunkSize, 0)
+	require.NoError(t, err)
+	assert.EqualValues(t, buffer.Len(), n)
+
+	readSnapshot, err := Read(bytes.NewReader(buffer.Bytes()), 0)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+
+	readSnapshot, err = ReadAny(bytes.NewReader(buffer.Bytes()), 0)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+}
+
+func TestReadAny_Legacy(t *testing.T) {
+	ledgerSnapshot := newTestSnapshot(3)
+
+	var buffer bytes.Buffer
+	_, err := ledgerSnapshot.WriteTo(&buffer)
+	require.NoError(t, err)
+
+	_, err = ReadAny(bytes.NewReader(buffer.Bytes()), 1)
+	assert.ErrorIs(t, err, ErrNetworkMismatch)
+
+	readSnapshot, err := ReadAny(&buffer, 0)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+}
+
+func TestRead_NetworkID(t *testing.T) {
+	ledgerSnapshot := newTestSnapshot(3)
+
+	var buffer bytes.Buffer
+	_, err := Write(&buffer, ledgerSnapshot, testChunkSize, 42)
+	require.NoError(t, err)
+
+	header, err := ReadHeader(bytes.NewReader(buffer.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, Version, header.Version)
+	assert.EqualValues(t, 42, header.NetworkID)
+
+	_, err = Read(bytes.NewReader(buffer.Bytes()), 0)
+	assert.ErrorIs(t, err, ErrNetworkMismatch)
+
+	readSnapshot, err := Read(bytes.NewReader(buffer.Bytes()), 42)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+
+	// snapshots without a network ID in their header belong to network 0
+	header.Version = versionWithoutNetworkID
+	header.NetworkID = 0
+	legacyData := append(header.Bytes(), buffer.Bytes()[header.Length()+networkIDLength:]...)
+	_, verifiedLength, err := VerifiedLength(bytes.NewReader(legacyData))
+	require.NoError(t, err)
+	assert.EqualValues(t, len(legacyData), verifiedLength)
+
+	_, err = Read(bytes.NewReader(legacyData), 42)
+	assert.ErrorIs(t, err, ErrNetworkMismatch)
+
+	readSnapshot, err = Read(bytes.NewReader(legacyData), 0)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+}
+
+func TestRead_Corrupted(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := Write(&buffer, newTestSnapshot(10), testChunkSize, 0)
+	require.NoError(t, err)
+
+	data := buffer.Bytes()
+	data[len(data)-1] ^= 0xFF
+
+	_, err = Read(bytes.NewReader(data), 0)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestReadHeader_Limits(t *testing.T) {
+	header, err := NewHeader(make([]byte, 3*testChunkSize), testChunkSize, 0)
+	require.NoError(t, err)
+
+	// headers that announce more chunks than allowed are rejected before their checksums are allocated
+	oversized := header.Bytes()
+	offset := len(magic) + 1 + networkIDLength
+	binary.LittleEndian.PutUint64(oversized[offset+4:], MaxPayloadSize+1)
+	_, err = ReadHeader(bytes.NewReader(oversized))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	oversized = header.Bytes()
+	binary.LittleEndian.PutUint32(oversized[offset+12:], MaxChunkCount+1)
+	_, err = ReadHeader(bytes.NewReader(oversized))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	// chunk sizes above the limit are rejected before the buffers of the chunks are allocated
+	oversized = header.Bytes()
+	binary.LittleEndian.PutUint32(oversized[offset:], MaxChunkSize+1)
+	binary.LittleEndian.PutUint32(oversized[offset+12:], 1)
+	_, err = ReadHeader(bytes.NewReader(oversized))
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	_, err = NewHeader(make([]byte, MaxChunkCount+1), 1, 0)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+
+	_, err = NewHeader(make([]byte, 1), MaxChunkSize+1, 0)
+	assert.ErrorIs(t, err, ErrInvalidFormat)
+}
+
+func TestVerifiedLength(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := Write(&buffer, newTestSnapshot(10), testChunkSize, 0)
+	require.NoError(t, err)
+	data := buffer.Bytes()
+
+	header, verifiedLength, err := VerifiedLength(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, header.FileSize(), verifiedLength)
+	assert.EqualValues(t, len(data), verifiedLength)
+
+	// a truncated file is only valid up to the last complete chunk
+	truncated := data[:header.Length()+2*testChunkSize+5]
+	_, verifiedLength, err = VerifiedLength(bytes.NewReader(truncated))
+	require.NoError(t, err)
+	assert.EqualValues(t, header.Length()+2*testChunkSize, verifiedLength)
+
+	// a corrupted chunk invalidates everything that follows it
+	corrupted := append([]byte{}, data...)
+	corrupted[header.Length()+testChunkSize] ^= 0xFF
+	_, verifiedLength, err = VerifiedLength(bytes.NewReader(corrupted))
+	require.NoError(t, err)
+	assert.EqualValues(t, header.Length()+testChunkSize, verifiedLength)
+
+	_, _, err = VerifiedLength(bytes.NewReader(data[:fixedHeaderLength-1]))
+	assert.Error(t, err)
+}
+
+func TestDownload_Resume(t *testing.T) {
+	var buffer bytes.Buffer
+	_, err := Write(&buffer, newTestSnapshot(10), testChunkSize, 0)
+	require.NoError(t, err)
+	data := buffer.Bytes()
+	header, err := ReadHeader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	var requestedRange string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedRange = r.Header.Get("Range")
+		w.Header().Set("ETag", header.ETag())
+		http.ServeContent(w, r, "snapshot.bin", time.Time{}, bytes.NewReader(data))
+	}))
+	defer server.Close()
+
+	// simulate an interrupted download that stopped in the middle of the third chunk
+	target := filepath.Join(t.TempDir(), "snapshot.bin")
+	interruptedAt := header.Length() + 2*testChunkSize + 5
+	require.NoError(t, os.WriteFile(target+partialFileSuffix, data[:interruptedAt], 0o600))
+
+	require.NoError(t, Download(context.Background(), server.Client(), server.URL, target))
+	assert.Equal(t, "bytes="+strconv.Itoa(header.Length()+2*testChunkSize)+"-", requestedRange)
+
+	downloaded, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, data, downloaded)
+	assert.NoFileExists(t, target+partialFileSuffix)
+}
+
+func TestDownload_ChangedSnapshot(t *testing.T) {
+	var oldBuffer, newBuffer bytes.Buffer
+	_, err := Write(&oldBuffer, newTestSnapshot(10), testChunkSize, 0)
+	require.NoError(t, err)
+	_, err = Write(&newBuffer, newTestSnapshot(12), testChunkSize, 0)
+	require.NoError(t, err)
+	newData := newBuffer.Bytes()
+	newHeader, err := ReadHeader(bytes.NewReader(newData))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", newHeader.ETag())
+		http.ServeContent(w, r, "snapshot.bin", time.Time{}, bytes.NewReader(newData))
+	}))
+	defer server.Close()
+
+	// the partial file belongs to an outdated snapshot, so the download has to start from scratch
+	target := filepath.Join(t.TempDir(), "snapshot.bin")
+	require.NoError(t, os.WriteFile(target+partialFileSuffix, oldBuffer.Bytes()[:oldBuffer.Len()/2], 0o600))
+
+	require.NoError(t, Download(context.Background(), server.Client(), server.URL, target))
+
+	downloaded, err := os.ReadFile(target)
+	require.NoError(t, err)
+	assert.Equal(t, newData, downloaded)
+}
+
+func newTestSnapshot(accessManaEntries int) *ledgerstate.Snapshot {
+	ledgerSnapshot := &ledgerstate.Snapshot{
+		Transactions:     make(map[ledgerstate.TransactionID]ledgerstate.Record),
+		AccessManaByNode: make(map[identity.ID]ledgerstate.AccessMana),
+	}
+	for i := 0; i < accessManaEntries; i++ {
+		ledgerSnapshot.AccessManaByNode[identity.GenerateIdentity().ID()] = ledgerstate.AccessMana{
+			Value:     float64(i),
+			Timestamp: time.Unix(int64(i), 0),
+		}
+	}
+
+	return ledgerSnapshot
+}
+
+func assertSnapshotEqual(t *testing.T, expected, actual *ledgerstate.Snapshot) {
+	assert.Equal(t, len(expected.Transactions), len(actual.Transactions))
+	assert.Equal(t, len(expected.AccessManaByNode), len(actual.AccessManaByNode))
+	for nodeID, accessMana := range expected.AccessManaByNode {
+		assert.Equal(t, accessMana.Value, actual.AccessManaByNode[nodeID].Value)
+		assert.True(t, accessMana.Timestamp.Equal(actual.AccessManaByNode[nodeID].Timestamp))
+	}
+}
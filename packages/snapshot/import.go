@@ -0,0 +1,270 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// DefaultProgressInterval is the default minimum time between two progress events of the Importer.
+const DefaultProgressInterval = 5 * time.Second
+
+// region Importer /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Importer reads snapshot files (in the chunked or in the legacy format) and reports its progress while doing so. The
+// network ID and all chunk checksums of a chunked snapshot are verified before its content is parsed, so that a
+// corrupted or foreign snapshot is refused before anything of it is applied.
+type Importer struct {
+	Events *ImporterEvents
+
+	networkID        uint32
+	progressInterval time.Duration
+	lastProgress     time.Time
+}
+
+// NewImporter creates an Importer for the snapshots of the network with the given ID.
+func NewImporter(networkID uint32, options ...ImporterOption) (importer *Importer) {
+	importer = &Importer{
+		Events: &ImporterEvents{
+			Progress: event.New[*ImportProgress]("Importer.Progress"),
+		},
+		networkID:        networkID,
+		progressInterval: DefaultProgressInterval,
+	}
+
+	for _, option := range options {
+		option(importer)
+	}
+
+	return importer
+}
+
+// Import reads the snapshot file at the given path. The import is aborted with the error of the context if the context
+// is cancelled.
+func (i *Importer) Import(ctx context.Context, path string) (ledgerSnapshot *ledgerstate.Snapshot, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Errorf("can not open snapshot file: %w", err)
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, errors.Errorf("can not read size of snapshot file: %w", err)
+	}
+
+	progress := &ImportProgress{TotalBytes: fileInfo.Size()}
+	source := &progressReader{ctx: ctx, source: file, progress: progress}
+
+	bufferedReader := bufio.NewReader(source)
+	prefix, err := bufferedReader.Peek(len(magic))
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return nil, errors.Errorf("failed to read snapshot: %w", err)
+	}
+
+	var payload io.Reader
+	if bytes.Equal(prefix, magic) {
+		if payload, err = i.verify(bufferedReader, file, source, progress); err != nil {
+			return nil, err
+		}
+	} else {
+		if i.networkID != 0 {
+			return nil, errors.Errorf("legacy snapshot can not be used in network %d: %w", i.networkID, ErrNetworkMismatch)
+		}
+		if _, err = file.Seek(0, io.SeekStart); err != nil {
+			return nil, errors.Errorf("failed to rewind snapshot file: %w", err)
+		}
+		progress.BytesProcessed = 0
+		payload = source
+	}
+
+	progress.Phase = ImportPhaseReading
+	i.reportProgress(progress, true)
+
+	ledgerSnapshot = &ledgerstate.Snapshot{}
+	if _, err = ledgerSnapshot.ReadFromWithProgress(bufio.NewReader(payload), func(transactions, unspentOutputs, accessManaEntries int) {
+		progress.Transactions = transactions
+		progress.Outputs = unspentOutputs
+		progress.ManaEntries = accessManaEntries
+		i.reportProgress(progress, false)
+	}); err != nil {
+		return nil, errors.Errorf("failed to parse ledger snapshot: %w", err)
+	}
+
+	progress.Phase = ImportPhaseDone
+	i.reportProgress(progress, true)
+
+	return ledgerSnapshot, nil
+}
+
+// verify reads the header of a chunked snapshot, checks its network ID and verifies the checksums of all chunks. It
+// returns a reader for the payload of the snapshot that starts right after the header.
+func (i *Importer) verify(headerReader io.Reader, file *os.File, source *progressReader, progress *ImportProgress) (payload io.Reader, err error) {
+	header, err := ReadHeader(headerReader)
+	if err != nil {
+		return nil, err
+	}
+	if err = header.CheckNetworkID(i.networkID); err != nil {
+		return nil, err
+	}
+
+	if _, err = file.Seek(int64(header.Length()), io.SeekStart); err != nil {
+		return nil, errors.Errorf("failed to seek to the payload of the snapshot: %w", err)
+	}
+	progress.BytesProcessed = int64(header.Length())
+	i.reportProgress(progress, true)
+
+	chunk := make([]byte, header.ChunkSize)
+	for index := range header.Checksums {
+		chunkData := chunk[:header.ChunkLength(index)]
+		if _, err = io.ReadFull(source, chunkData); err != nil {
+			return nil, errors.Errorf("failed to read chunk %d: %w", index, err)
+		}
+		if err = header.VerifyChunk(index, chunkData); err != nil {
+			return nil, err
+		}
+		i.reportProgress(progress, false)
+	}
+
+	if _, err = file.Seek(int64(header.Length()), io.SeekStart); err != nil {
+		return nil, errors.Errorf("failed to seek to the payload of the snapshot: %w", err)
+	}
+	progress.BytesProcessed = int64(header.Length())
+
+	return NewVerifyingReader(header, source), nil
+}
+
+// reportProgress triggers the Progress event if the progress interval has passed since the last event or if force is
+// set.
+func (i *Importer) reportProgress(progress *ImportProgress, force bool) {
+	if now := time.Now(); force || now.Sub(i.lastProgress) >= i.progressInterval {
+		i.lastProgress = now
+
+		progressCopy := *progress
+		i.Events.Progress.Trigger(&progressCopy)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ImporterOption ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// ImporterOption is the type of the optional parameters of the Importer.
+type ImporterOption func(*Importer)
+
+// ProgressInterval is an ImporterOption that defines the minimum time between two progress events (0 reports every
+// step).
+func ProgressInterval(interval time.Duration) ImporterOption {
+	return func(importer *Importer) {
+		importer.progressInterval = interval
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ImporterEvents ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// ImporterEvents represents events happening in the Importer.
+type ImporterEvents struct {
+	// Progress is triggered periodically while a snapshot is imported and whenever the import enters a new phase.
+	Progress *event.Event[*ImportProgress]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ImportProgress ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// ImportProgress holds the progress of a snapshot import.
+type ImportProgress struct {
+	// Phase is the phase that the import is in.
+	Phase ImportPhase
+	// BytesProcessed is the number of bytes of the snapshot file that were processed in the current phase.
+	BytesProcessed int64
+	// TotalBytes is the size of the snapshot file.
+	TotalBytes int64
+	// Transactions is the number of transactions that were loaded.
+	Transactions int
+	// Outputs is the number of unspent outputs that were loaded.
+	Outputs int
+	// ManaEntries is the number of access mana entries that were loaded.
+	ManaEntries int
+}
+
+// Percent returns how much of the snapshot file was processed in the current phase (0-100).
+func (i *ImportProgress) Percent() float64 {
+	if i.Phase == ImportPhaseDone || i.TotalBytes == 0 {
+		return 100
+	}
+
+	return float64(i.BytesProcessed) * 100 / float64(i.TotalBytes)
+}
+
+// String returns a human-readable version of the ImportProgress.
+func (i *ImportProgress) String() string {
+	return fmt.Sprintf("%s %.1f%% (%d/%d bytes, %d transactions, %d outputs, %d mana entries)", i.Phase, i.Percent(), i.BytesProcessed, i.TotalBytes, i.Transactions, i.Outputs, i.ManaEntries)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ImportPhase //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ImportPhase describes what the Importer is currently doing.
+type ImportPhase uint8
+
+const (
+	// ImportPhaseVerifying is the phase in which the checksums of the snapshot are verified.
+	ImportPhaseVerifying ImportPhase = iota
+	// ImportPhaseReading is the phase in which the content of the snapshot is parsed.
+	ImportPhaseReading
+	// ImportPhaseDone is reported once the snapshot was read completely.
+	ImportPhaseDone
+)
+
+// String returns a human-readable version of the ImportPhase.
+func (i ImportPhase) String() string {
+	switch i {
+	case ImportPhaseVerifying:
+		return "verifying"
+	case ImportPhaseReading:
+		return "reading"
+	case ImportPhaseDone:
+		return "done"
+	default:
+		return fmt.Sprintf("ImportPhase(%d)", uint8(i))
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region progressReader ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// progressReader is an io.Reader that counts the bytes read from its source and that fails as soon as its context is
+// cancelled.
+type progressReader struct {
+	ctx      context.Context
+	source   io.Reader
+	progress *ImportProgress
+}
+
+// Read implements the io.Reader interface.
+func (p *progressReader) Read(buffer []byte) (n int, err error) {
+	if err = p.ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	n, err = p.source.Read(buffer)
+	p.progress.BytesProcessed += int64(n)
+
+	return n, err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,104 @@
+package snapshot
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestImporter(t *testing.T) {
+	ledgerSnapshot := newTestSnapshot(10)
+	path := writeTestSnapshotFile(t, func(file *os.File) error {
+		_, err := Write(file, ledgerSnapshot, testChunkSize, 42)
+		return err
+	})
+
+	_, err := NewImporter(0).Import(context.Background(), path)
+	assert.ErrorIs(t, err, ErrNetworkMismatch)
+
+	importer := NewImporter(42, ProgressInterval(0))
+	var progress []*ImportProgress
+	importer.Events.Progress.Attach(event.NewClosure(func(importProgress *ImportProgress) {
+		progress = append(progress, importProgress)
+	}))
+
+	readSnapshot, err := importer.Import(context.Background(), path)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+
+	require.NotEmpty(t, progress)
+	assert.Equal(t, ImportPhaseVerifying, progress[0].Phase)
+	last := progress[len(progress)-1]
+	assert.Equal(t, ImportPhaseDone, last.Phase)
+	assert.Equal(t, 10, last.ManaEntries)
+	assert.Equal(t, last.TotalBytes, last.BytesProcessed)
+	assert.Equal(t, float64(100), last.Percent())
+}
+
+func TestImporter_Legacy(t *testing.T) {
+	ledgerSnapshot := newTestSnapshot(3)
+	path := writeTestSnapshotFile(t, func(file *os.File) error {
+		_, err := ledgerSnapshot.WriteTo(file)
+		return err
+	})
+
+	_, err := NewImporter(1).Import(context.Background(), path)
+	assert.ErrorIs(t, err, ErrNetworkMismatch)
+
+	readSnapshot, err := NewImporter(0).Import(context.Background(), path)
+	require.NoError(t, err)
+	assertSnapshotEqual(t, ledgerSnapshot, readSnapshot)
+}
+
+func TestImporter_Corrupted(t *testing.T) {
+	path := writeTestSnapshotFile(t, func(file *os.File) error {
+		_, err := Write(file, newTestSnapshot(10), testChunkSize, 0)
+		return err
+	})
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	data[len(data)-1] ^= 0xFF
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	// the corruption in the last chunk is detected before anything is parsed
+	importer := NewImporter(0, ProgressInterval(0))
+	importer.Events.Progress.Attach(event.NewClosure(func(importProgress *ImportProgress) {
+		assert.Equal(t, ImportPhaseVerifying, importProgress.Phase)
+	}))
+	_, err = importer.Import(context.Background(), path)
+	assert.ErrorIs(t, err, ErrChecksumMismatch)
+}
+
+func TestImporter_Cancel(t *testing.T) {
+	path := writeTestSnapshotFile(t, func(file *os.File) error {
+		_, err := Write(file, newTestSnapshot(1000), testChunkSize, 0)
+		return err
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	importer := NewImporter(0, ProgressInterval(0))
+	importer.Events.Progress.Attach(event.NewClosure(func(importProgress *ImportProgress) {
+		if importProgress.Phase == ImportPhaseReading && importProgress.ManaEntries == 10 {
+			cancel()
+		}
+	}))
+
+	_, err := importer.Import(ctx, path)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func writeTestSnapshotFile(t *testing.T, write func(file *os.File) error) (path string) {
+	path = filepath.Join(t.TempDir(), "snapshot.bin")
+	file, err := os.Create(path)
+	require.NoError(t, err)
+	require.NoError(t, write(file))
+	require.NoError(t, file.Close())
+
+	return path
+}
@@ -18,37 +18,52 @@ import (
 const (
 	// limit the number of max allowed go routines created during spam.
 	maxGoroutines = 2
+
+	// pacingInterval is the interval in which a paced spammer adjusts its rate.
+	pacingInterval = 10 * time.Second
 )
 
 // IssuePayloadFunc is a function which issues a payload.
 type IssuePayloadFunc = func(payload payload.Payload, parentsCount ...int) (*tangle.Message, error)
 
+// IssuableMessagesFunc is a function which returns the number of messages of the given size that the node can issue
+// during the given interval.
+type IssuableMessagesFunc = func(messageSize int, interval time.Duration) (issuableMessages int, err error)
+
 // Spammer spams messages with a static data payload.
 type Spammer struct {
-	issuePayloadFunc IssuePayloadFunc
-	log              *logger.Logger
-	running          typeutils.AtomicBool
-	shutdown         chan struct{}
-	wg               sync.WaitGroup
-	goroutinesCount  *atomic.Int32
+	issuePayloadFunc     IssuePayloadFunc
+	issuableMessagesFunc IssuableMessagesFunc
+	log                  *logger.Logger
+	running              typeutils.AtomicBool
+	shutdown             chan struct{}
+	wg                   sync.WaitGroup
+	goroutinesCount      *atomic.Int32
+	messageSize          atomic.Int64
 }
 
 // New creates a new spammer.
-func New(issuePayloadFunc IssuePayloadFunc, log *logger.Logger) *Spammer {
-	return &Spammer{
+func New(issuePayloadFunc IssuePayloadFunc, log *logger.Logger, options ...Option) *Spammer {
+	spammer := &Spammer{
 		issuePayloadFunc: issuePayloadFunc,
 		shutdown:         make(chan struct{}),
 		log:              log,
 	}
+	for _, option := range options {
+		option(spammer)
+	}
+
+	return spammer
 }
 
 // Start starts the spammer to spam with the given messages per time unit,
-// according to a inter message issuing function (IMIF)
-func (s *Spammer) Start(rate int, timeUnit time.Duration, imif string) {
+// according to a inter message issuing function (IMIF). A paced spammer lowers its rate to the number of messages that
+// the node can issue according to its projected access mana.
+func (s *Spammer) Start(rate int, timeUnit time.Duration, imif string, paced bool) {
 	// only start if not yet running
 	if s.running.SetToIf(false, true) {
 		s.wg.Add(1)
-		go s.run(rate, timeUnit, imif)
+		go s.run(rate, timeUnit, imif, paced && s.issuableMessagesFunc != nil)
 	}
 }
 
@@ -64,8 +79,17 @@ func (s *Spammer) signalShutdown() {
 	}
 }
 
-func (s *Spammer) run(rate int, timeUnit time.Duration, imif string) {
+func (s *Spammer) run(rate int, timeUnit time.Duration, imif string, paced bool) {
 	defer s.wg.Done()
+
+	// the paced rate is adjusted periodically and starts with the requested rate
+	pacedRate := rate
+	pacingTicker := time.NewTicker(pacingInterval)
+	defer pacingTicker.Stop()
+	if !paced {
+		pacingTicker.Stop()
+	}
+
 	// create ticker with interval for default imif
 	ticker := time.NewTicker(timeUnit / time.Duration(rate))
 	defer ticker.Stop()
@@ -74,10 +98,18 @@ func (s *Spammer) run(rate int, timeUnit time.Duration, imif string) {
 		select {
 		case <-s.shutdown:
 			return
+		case <-pacingTicker.C:
+			if pacedRate = s.pacedRate(rate, timeUnit); pacedRate > 0 {
+				ticker.Reset(timeUnit / time.Duration(pacedRate))
+			}
 		case <-ticker.C:
+			// a paced spammer does not issue messages if the node can not issue any
+			if pacedRate == 0 {
+				break
+			}
 			// adjust the ticker interval for the poisson imif
 			if imif == "poisson" {
-				ticker = time.NewTicker(time.Duration(float64(timeUnit.Nanoseconds()) * rand.ExpFloat64() / float64(rate)))
+				ticker.Reset(time.Duration(float64(timeUnit.Nanoseconds()) * rand.ExpFloat64() / float64(pacedRate)))
 			}
 			// start only if at most maxGoroutines not finished their work
 			if s.goroutinesCount.Load() >= maxGoroutines {
@@ -87,7 +119,7 @@ func (s *Spammer) run(rate int, timeUnit time.Duration, imif string) {
 				s.goroutinesCount.Add(1)
 				defer s.goroutinesCount.Add(-1)
 				// we don't care about errors or the actual issued message
-				_, err := s.issuePayloadFunc(payload.NewGenericDataPayload([]byte("SPAM")))
+				msg, err := s.issuePayloadFunc(payload.NewGenericDataPayload([]byte("SPAM")))
 				if errors.Is(err, tangle.ErrNotSynced) {
 					s.log.Info("Stopped spamming messages because node lost sync")
 					s.signalShutdown()
@@ -95,8 +127,40 @@ func (s *Spammer) run(rate int, timeUnit time.Duration, imif string) {
 				}
 				if err != nil {
 					s.log.Warnf("could not issue spam payload: %s", err)
+					return
 				}
+				s.messageSize.Store(int64(msg.Size()))
 			}()
 		}
 	}
 }
+
+// pacedRate returns the requested rate, lowered to the number of spam messages that the node can issue per time unit.
+func (s *Spammer) pacedRate(rate int, timeUnit time.Duration) int {
+	messageSize := int(s.messageSize.Load())
+	if messageSize == 0 {
+		return rate
+	}
+
+	issuableMessages, err := s.issuableMessagesFunc(messageSize, timeUnit)
+	if err != nil {
+		s.log.Warnf("could not determine the issuable messages: %s", err)
+		return rate
+	}
+	if issuableMessages < rate {
+		s.log.Debugf("Pacing the spammer to %d messages instead of %d", issuableMessages, rate)
+		return issuableMessages
+	}
+
+	return rate
+}
+
+// Option represents the return type of optional parameters that can be handed into the constructor of the Spammer.
+type Option func(spammer *Spammer)
+
+// WithPacing is an Option for the Spammer that allows to pace it to the number of messages that the node can issue.
+func WithPacing(issuableMessagesFunc IssuableMessagesFunc) Option {
+	return func(spammer *Spammer) {
+		spammer.issuableMessagesFunc = issuableMessagesFunc
+	}
+}
@@ -0,0 +1,171 @@
+package statesync
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region EpochIndex ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EpochDuration defines the duration of an epoch.
+const EpochDuration = epochs.Duration
+
+// EpochIndex is the index of an epoch, counted from the genesis time of the network.
+type EpochIndex = epochs.Index
+
+// FinalizationDelay defines the time after the end of an epoch after which the confirmed ledger state of the epoch is
+// considered final. It needs to be larger than the max allowed timestamp variation and the time that is required for
+// confirmation.
+const FinalizationDelay = 2 * time.Minute
+
+// EpochIndexFromTime returns the index of the epoch that contains the given time.
+func EpochIndexFromTime(t time.Time) EpochIndex {
+	return epochs.IndexFromTime(t)
+}
+
+// LatestFinalizedEpoch returns the index of the latest epoch whose confirmed ledger state is final at the given time.
+func LatestFinalizedEpoch(t time.Time) EpochIndex {
+	index := EpochIndexFromTime(t.Add(-FinalizationDelay))
+	if index == 0 {
+		return 0
+	}
+
+	return index - 1
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region EpochCommitment //////////////////////////////////////////////////////////////////////////////////////////////
+
+// EpochCommitmentLength contains the amount of bytes of a marshaled EpochCommitment.
+const EpochCommitmentLength = marshalutil.Uint64Size + blake2b.Size256
+
+// EpochCommitment commits to the confirmed ledger state of a node at the end of a finalized epoch. Two nodes that agree
+// on the confirmed ledger state produce the same StateRoot for the same epoch, which allows a node that synchronizes its
+// state from a trusted peer to verify the received state against a commitment that was obtained out-of-band (i.e. from
+// another operator).
+type EpochCommitment struct {
+	EpochIndex EpochIndex
+	StateRoot  [blake2b.Size256]byte
+}
+
+// NewEpochCommitment creates the EpochCommitment for the ledger state contained in the given snapshot.
+func NewEpochCommitment(epochIndex EpochIndex, ledgerSnapshot *ledgerstate.Snapshot) (commitment *EpochCommitment) {
+	return &EpochCommitment{
+		EpochIndex: epochIndex,
+		StateRoot:  StateRoot(ledgerSnapshot),
+	}
+}
+
+// EpochCommitmentFromBytes unmarshals an EpochCommitment from a sequence of bytes.
+func EpochCommitmentFromBytes(data []byte) (commitment *EpochCommitment, consumedBytes int, err error) {
+	marshalUtil := marshalutil.New(data)
+	epochIndex, err := marshalUtil.ReadUint64()
+	if err != nil {
+		return nil, 0, errors.Errorf("failed to parse epoch index (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	stateRootBytes, err := marshalUtil.ReadBytes(blake2b.Size256)
+	if err != nil {
+		return nil, 0, errors.Errorf("failed to parse state root (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+
+	commitment = &EpochCommitment{EpochIndex: EpochIndex(epochIndex)}
+	copy(commitment.StateRoot[:], stateRootBytes)
+
+	return commitment, marshalUtil.ReadOffset(), nil
+}
+
+// EpochCommitmentFromBase58 creates an EpochCommitment from its base58 encoded representation.
+func EpochCommitmentFromBase58(base58String string) (commitment *EpochCommitment, err error) {
+	data, err := base58.Decode(base58String)
+	if err != nil {
+		return nil, errors.Errorf("error while decoding base58 encoded EpochCommitment (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+	}
+
+	if commitment, _, err = EpochCommitmentFromBytes(data); err != nil {
+		return nil, err
+	}
+
+	return commitment, nil
+}
+
+// Bytes returns a marshaled version of the EpochCommitment.
+func (e *EpochCommitment) Bytes() []byte {
+	return marshalutil.New(EpochCommitmentLength).
+		WriteUint64(uint64(e.EpochIndex)).
+		WriteBytes(e.StateRoot[:]).
+		Bytes()
+}
+
+// Base58 returns a base58 encoded version of the EpochCommitment.
+func (e *EpochCommitment) Base58() string {
+	return base58.Encode(e.Bytes())
+}
+
+// Equal returns true if both EpochCommitments commit to the same state at the same epoch.
+func (e *EpochCommitment) Equal(other *EpochCommitment) bool {
+	return e.EpochIndex == other.EpochIndex && e.StateRoot == other.StateRoot
+}
+
+// String returns a human-readable version of the EpochCommitment.
+func (e *EpochCommitment) String() string {
+	return fmt.Sprintf("EpochCommitment{EpochIndex: %d, StateRoot: %s}", e.EpochIndex, base58.Encode(e.StateRoot[:]))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region StateRoot ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// StateRoot returns a deterministic hash over the ledger state of the given snapshot. The records are hashed in the
+// order of their TransactionIDs, so the result does not depend on the order in which they were collected. Access mana
+// is not part of the ledger state and is therefore not committed to.
+func StateRoot(ledgerSnapshot *ledgerstate.Snapshot) (stateRoot [blake2b.Size256]byte) {
+	transactionIDs := make([]ledgerstate.TransactionID, 0, len(ledgerSnapshot.Transactions))
+	for transactionID := range ledgerSnapshot.Transactions {
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+	sort.Slice(transactionIDs, func(i, j int) bool {
+		return bytes.Compare(transactionIDs[i][:], transactionIDs[j][:]) < 0
+	})
+
+	hash, _ := blake2b.New256(nil)
+	lengthBuffer := make([]byte, marshalutil.Uint32Size)
+	writeWithLength := func(data []byte) {
+		binary.LittleEndian.PutUint32(lengthBuffer, uint32(len(data)))
+		_, _ = hash.Write(lengthBuffer)
+		_, _ = hash.Write(data)
+	}
+
+	for _, transactionID := range transactionIDs {
+		record := ledgerSnapshot.Transactions[transactionID]
+
+		_, _ = hash.Write(transactionID.Bytes())
+		writeWithLength(record.Essence.Bytes())
+		writeWithLength(record.UnlockBlocks.Bytes())
+
+		unspentOutputs := make([]byte, len(record.UnspentOutputs))
+		for i, unspent := range record.UnspentOutputs {
+			if unspent {
+				unspentOutputs[i] = 1
+			}
+		}
+		writeWithLength(unspentOutputs)
+	}
+	copy(stateRoot[:], hash.Sum(nil))
+
+	return stateRoot
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
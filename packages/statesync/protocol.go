@@ -0,0 +1,179 @@
+package statesync
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	// ProtocolID is the libp2p protocol identifier of the state sync protocol.
+	ProtocolID = "statesync/0.0.1"
+
+	// protocolVersion is the version byte that is sent with every request.
+	protocolVersion byte = 2
+
+	// requestLength contains the length of a request (version, epoch index and amount of recent messages).
+	requestLength = 1 + 8 + 4
+
+	// maxRecentMessages limits the amount of recent messages that a client can request.
+	maxRecentMessages = 1000
+)
+
+var (
+	// ErrCommitmentMismatch is returned if the received state does not match the expected EpochCommitment.
+	ErrCommitmentMismatch = errors.New("state does not match epoch commitment")
+	// ErrUnsupportedVersion is returned if a request uses an unknown protocol version.
+	ErrUnsupportedVersion = errors.New("unsupported state sync protocol version")
+	// ErrEpochNotFinalized is returned if the state of an epoch is requested whose confirmed ledger state is not final.
+	ErrEpochNotFinalized = errors.New("epoch is not finalized")
+)
+
+// region State ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// State contains the data that is transferred by the state sync protocol.
+type State struct {
+	// Commitment is the EpochCommitment of the confirmed ledger state at the end of a finalized epoch.
+	Commitment *EpochCommitment
+	// Snapshot contains the committed ledger state and the access mana of the serving node.
+	Snapshot *ledgerstate.Snapshot
+	// RecentMessages contains the bytes of the recent messages of the Tangle (ordered from old to new).
+	RecentMessages [][]byte
+	// NetworkID is the ID of the network that the State belongs to.
+	NetworkID uint32
+}
+
+// StateProvider returns the State of a node at the end of the given epoch (0 requests the latest finalized epoch),
+// containing at most the given amount of recent messages.
+type StateProvider func(epochIndex EpochIndex, maxMessages int) (state *State, err error)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Server ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Serve answers a single state sync request that is read from the given stream.
+func Serve(stream io.ReadWriter, stateProvider StateProvider) (err error) {
+	request := make([]byte, requestLength)
+	if _, err = io.ReadFull(stream, request[:1]); err != nil {
+		return errors.Errorf("failed to read state sync request: %w", err)
+	}
+	if request[0] != protocolVersion {
+		return errors.Errorf("request uses version %d: %w", request[0], ErrUnsupportedVersion)
+	}
+	if _, err = io.ReadFull(stream, request[1:]); err != nil {
+		return errors.Errorf("failed to read state sync request: %w", err)
+	}
+	epochIndex := EpochIndex(binary.LittleEndian.Uint64(request[1:]))
+	maxMessages := int(binary.LittleEndian.Uint32(request[9:]))
+	if maxMessages > maxRecentMessages {
+		maxMessages = maxRecentMessages
+	}
+
+	state, err := stateProvider(epochIndex, maxMessages)
+	if err != nil {
+		return errors.Errorf("failed to retrieve state: %w", err)
+	}
+
+	if _, err = stream.Write(state.Commitment.Bytes()); err != nil {
+		return errors.Errorf("failed to write epoch commitment: %w", err)
+	}
+	if _, err = snapshot.Write(stream, state.Snapshot, snapshot.DefaultChunkSize, state.NetworkID); err != nil {
+		return errors.Errorf("failed to write ledger snapshot: %w", err)
+	}
+	if err = binary.Write(stream, binary.LittleEndian, uint32(len(state.RecentMessages))); err != nil {
+		return errors.Errorf("failed to write message count: %w", err)
+	}
+	for _, messageBytes := range state.RecentMessages {
+		if err = binary.Write(stream, binary.LittleEndian, uint32(len(messageBytes))); err != nil {
+			return errors.Errorf("failed to write message length: %w", err)
+		}
+		if _, err = stream.Write(messageBytes); err != nil {
+			return errors.Errorf("failed to write message: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Client ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Fetch requests the State from the node at the other end of the stream. Every chunk of the received snapshot is
+// verified while it is read, and the ledger state is checked against the EpochCommitment sent by the peer. If an
+// expected commitment is given, the state of its epoch is requested and the received state additionally has to match
+// it, otherwise the state of the latest finalized epoch of the peer is requested. States of networks other than the one
+// with the given ID are rejected.
+func Fetch(stream io.ReadWriter, maxMessages int, expectedCommitment *EpochCommitment, networkID uint32) (state *State, err error) {
+	request := make([]byte, requestLength)
+	request[0] = protocolVersion
+	if expectedCommitment != nil {
+		binary.LittleEndian.PutUint64(request[1:], uint64(expectedCommitment.EpochIndex))
+	}
+	binary.LittleEndian.PutUint32(request[9:], uint32(maxMessages))
+	if _, err = stream.Write(request); err != nil {
+		return nil, errors.Errorf("failed to write state sync request: %w", err)
+	}
+
+	commitmentBytes := make([]byte, EpochCommitmentLength)
+	if _, err = io.ReadFull(stream, commitmentBytes); err != nil {
+		return nil, errors.Errorf("failed to read epoch commitment: %w", err)
+	}
+
+	state = &State{NetworkID: networkID}
+	if state.Commitment, _, err = EpochCommitmentFromBytes(commitmentBytes); err != nil {
+		return nil, err
+	}
+	if expectedCommitment != nil && !expectedCommitment.Equal(state.Commitment) {
+		return nil, errors.Errorf("peer sent %s but expected %s: %w", state.Commitment, expectedCommitment, ErrCommitmentMismatch)
+	}
+
+	if state.Snapshot, err = snapshot.Read(stream, networkID); err != nil {
+		return nil, err
+	}
+	if StateRoot(state.Snapshot) != state.Commitment.StateRoot {
+		return nil, errors.Errorf("received ledger state does not match %s: %w", state.Commitment, ErrCommitmentMismatch)
+	}
+
+	if state.RecentMessages, err = readMessages(stream); err != nil {
+		return nil, err
+	}
+
+	return state, nil
+}
+
+// readMessages reads the length-prefixed recent messages from the stream.
+func readMessages(stream io.Reader) (messages [][]byte, err error) {
+	var messageCount uint32
+	if err = binary.Read(stream, binary.LittleEndian, &messageCount); err != nil {
+		return nil, errors.Errorf("failed to read message count: %w", err)
+	}
+	if messageCount > maxRecentMessages {
+		return nil, errors.Errorf("peer sent too many messages (%d)", messageCount)
+	}
+
+	messages = make([][]byte, messageCount)
+	for i := range messages {
+		var messageLength uint32
+		if err = binary.Read(stream, binary.LittleEndian, &messageLength); err != nil {
+			return nil, errors.Errorf("failed to read length of message %d: %w", i, err)
+		}
+		if messageLength > tangle.MaxMessageSize {
+			return nil, errors.Errorf("message %d exceeds the maximum message size (%d)", i, messageLength)
+		}
+
+		messages[i] = make([]byte, messageLength)
+		if _, err = io.ReadFull(stream, messages[i]); err != nil {
+			return nil, errors.Errorf("failed to read message %d: %w", i, err)
+		}
+	}
+
+	return messages, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
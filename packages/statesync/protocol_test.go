@@ -0,0 +1,120 @@
+package statesync
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+)
+
+func TestEpochCommitment_Bytes(t *testing.T) {
+	commitment := NewEpochCommitment(42, newTestSnapshot())
+
+	restored, consumedBytes, err := EpochCommitmentFromBytes(commitment.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, EpochCommitmentLength, consumedBytes)
+	assert.True(t, commitment.Equal(restored))
+
+	restored, err = EpochCommitmentFromBase58(commitment.Base58())
+	require.NoError(t, err)
+	assert.True(t, commitment.Equal(restored))
+}
+
+func TestFetch(t *testing.T) {
+	serverState := &State{
+		Snapshot:       newTestSnapshot(),
+		RecentMessages: [][]byte{{1, 2, 3}, {4, 5}},
+	}
+	serverState.Commitment = NewEpochCommitment(7, serverState.Snapshot)
+
+	state, err := fetchFromPipe(t, serverState, 10, serverState.Commitment)
+	require.NoError(t, err)
+	assert.True(t, serverState.Commitment.Equal(state.Commitment))
+	assert.Equal(t, serverState.RecentMessages, state.RecentMessages)
+	assert.Equal(t, len(serverState.Snapshot.AccessManaByNode), len(state.Snapshot.AccessManaByNode))
+}
+
+func TestFetch_CommitmentMismatch(t *testing.T) {
+	serverState := &State{Snapshot: newTestSnapshot()}
+	serverState.Commitment = NewEpochCommitment(7, serverState.Snapshot)
+
+	expectedCommitment := NewEpochCommitment(7, serverState.Snapshot)
+	expectedCommitment.StateRoot[0] ^= 0xFF
+
+	_, err := fetchFromPipe(t, serverState, 0, expectedCommitment)
+	assert.ErrorIs(t, err, ErrCommitmentMismatch)
+}
+
+func TestFetch_ForgedCommitment(t *testing.T) {
+	// the peer claims a commitment that does not belong to the state it sends
+	serverState := &State{Snapshot: newTestSnapshot()}
+	serverState.Commitment = NewEpochCommitment(7, serverState.Snapshot)
+	serverState.Commitment.StateRoot[0] ^= 0xFF
+
+	_, err := fetchFromPipe(t, serverState, 0, nil)
+	assert.ErrorIs(t, err, ErrCommitmentMismatch)
+}
+
+func TestFetch_NetworkMismatch(t *testing.T) {
+	serverState := &State{Snapshot: newTestSnapshot(), NetworkID: 1}
+	serverState.Commitment = NewEpochCommitment(7, serverState.Snapshot)
+
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+	go func() {
+		defer serverConn.Close()
+		_ = Serve(serverConn, func(EpochIndex, int) (*State, error) { return serverState, nil })
+	}()
+
+	_, err := Fetch(clientConn, 0, nil, 2)
+	assert.ErrorIs(t, err, snapshot.ErrNetworkMismatch)
+}
+
+func TestLatestFinalizedEpoch(t *testing.T) {
+	epochIndex := EpochIndex(100)
+
+	// the previous epoch is only final once the finalization delay passed after its end
+	assert.Equal(t, epochIndex-2, LatestFinalizedEpoch(epochIndex.StartTime().Add(FinalizationDelay).Add(-time.Second)))
+	assert.Equal(t, epochIndex-1, LatestFinalizedEpoch(epochIndex.StartTime().Add(FinalizationDelay)))
+}
+
+func fetchFromPipe(t *testing.T, serverState *State, maxMessages int, expectedCommitment *EpochCommitment) (*State, error) {
+	clientConn, serverConn := net.Pipe()
+	defer clientConn.Close()
+
+	go func() {
+		defer serverConn.Close()
+		_ = Serve(serverConn, func(requestedEpoch EpochIndex, requestedMessages int) (*State, error) {
+			if expectedCommitment != nil {
+				assert.Equal(t, expectedCommitment.EpochIndex, requestedEpoch)
+			} else {
+				assert.Zero(t, requestedEpoch)
+			}
+			assert.Equal(t, maxMessages, requestedMessages)
+			return serverState, nil
+		})
+	}()
+
+	return Fetch(clientConn, maxMessages, expectedCommitment, serverState.NetworkID)
+}
+
+func newTestSnapshot() *ledgerstate.Snapshot {
+	ledgerSnapshot := &ledgerstate.Snapshot{
+		Transactions:     make(map[ledgerstate.TransactionID]ledgerstate.Record),
+		AccessManaByNode: make(map[identity.ID]ledgerstate.AccessMana),
+	}
+	for i := 0; i < 5; i++ {
+		ledgerSnapshot.AccessManaByNode[identity.GenerateIdentity().ID()] = ledgerstate.AccessMana{
+			Value:     float64(i),
+			Timestamp: time.Unix(int64(i), 0),
+		}
+	}
+
+	return ledgerSnapshot
+}
@@ -0,0 +1,151 @@
+// Package stringcache provides bounded caches for the string representations of frequently encoded values (i.e. the
+// Base58 encodings of the identifiers that are used in logs, events and the visualizers).
+package stringcache
+
+import (
+	"sort"
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// region Cache ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Cache is a bounded cache of the string representations of keys. It keeps two generations of entries: once the
+// current generation is full, it replaces the previous one, so that the entries that were not used during a whole
+// generation are evicted. Entries of the previous generation are promoted to the current one when they are used.
+type Cache[K comparable] struct {
+	name               string
+	generationSize     int
+	encode             func(key K) string
+	currentGeneration  map[K]string
+	previousGeneration map[K]string
+	mutex              sync.RWMutex
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+// New creates a new Cache with the given name that holds up to capacity strings that are created with the given
+// encode function. The Cache is registered under the given name, so that its metrics can be retrieved with AllMetrics.
+func New[K comparable](name string, capacity int, encode func(key K) string) (cache *Cache[K]) {
+	generationSize := capacity / 2
+	if generationSize < 1 {
+		generationSize = 1
+	}
+
+	cache = &Cache[K]{
+		name:               name,
+		generationSize:     generationSize,
+		encode:             encode,
+		currentGeneration:  make(map[K]string, generationSize),
+		previousGeneration: make(map[K]string),
+	}
+	registry.register(cache)
+
+	return cache
+}
+
+// Get returns the string representation of the given key and creates it if it is not cached yet.
+func (c *Cache[K]) Get(key K) (encoded string) {
+	c.mutex.RLock()
+	encoded, exists := c.currentGeneration[key]
+	c.mutex.RUnlock()
+	if exists {
+		c.hits.Inc()
+		return encoded
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if encoded, exists = c.currentGeneration[key]; exists {
+		c.hits.Inc()
+		return encoded
+	}
+
+	if encoded, exists = c.previousGeneration[key]; exists {
+		c.hits.Inc()
+	} else {
+		c.misses.Inc()
+		encoded = c.encode(key)
+	}
+
+	if len(c.currentGeneration) >= c.generationSize {
+		c.previousGeneration = c.currentGeneration
+		c.currentGeneration = make(map[K]string, c.generationSize)
+	}
+	c.currentGeneration[key] = encoded
+
+	return encoded
+}
+
+// Metrics returns the Metrics of the Cache.
+func (c *Cache[K]) Metrics() *Metrics {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return &Metrics{
+		Name:   c.name,
+		Size:   len(c.currentGeneration) + len(c.previousGeneration),
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Metrics //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Metrics contains the metrics of a Cache.
+type Metrics struct {
+	// Name is the name of the Cache.
+	Name string
+	// Size is the number of cached strings (including the ones that are about to be evicted).
+	Size int
+	// Hits is the number of lookups that were answered from the Cache.
+	Hits uint64
+	// Misses is the number of lookups that required a string to be encoded.
+	Misses uint64
+}
+
+// AllMetrics returns the Metrics of all Caches sorted by their name.
+func AllMetrics() []*Metrics {
+	return registry.metrics()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region cacheRegistry ////////////////////////////////////////////////////////////////////////////////////////////////
+
+var registry = &cacheRegistry{}
+
+// cacheRegistry keeps track of the created Caches.
+type cacheRegistry struct {
+	caches []interface{ Metrics() *Metrics }
+	mutex  sync.RWMutex
+}
+
+func (r *cacheRegistry) register(cache interface{ Metrics() *Metrics }) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.caches = append(r.caches, cache)
+}
+
+func (r *cacheRegistry) metrics() (metrics []*Metrics) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	metrics = make([]*Metrics, 0, len(r.caches))
+	for _, cache := range r.caches {
+		metrics = append(metrics, cache.Metrics())
+	}
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Name < metrics[j].Name
+	})
+
+	return metrics
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,36 @@
+package stringcache
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	encodings := 0
+	cache := New("TestCache", 4, func(key int) string {
+		encodings++
+		return strconv.Itoa(key)
+	})
+
+	assert.Equal(t, "1", cache.Get(1))
+	assert.Equal(t, "1", cache.Get(1))
+	assert.Equal(t, 1, encodings)
+
+	// 1 is promoted from the previous generation while 2 is evicted
+	assert.Equal(t, "2", cache.Get(2))
+	assert.Equal(t, "3", cache.Get(3))
+	assert.Equal(t, "1", cache.Get(1))
+	assert.Equal(t, "4", cache.Get(4))
+	assert.Equal(t, "2", cache.Get(2))
+	assert.Equal(t, "1", cache.Get(1))
+	assert.Equal(t, 5, encodings)
+
+	metrics := cache.Metrics()
+	assert.Equal(t, "TestCache", metrics.Name)
+	assert.LessOrEqual(t, metrics.Size, 4)
+	assert.Equal(t, uint64(3), metrics.Hits)
+	assert.Equal(t, uint64(5), metrics.Misses)
+	assert.Contains(t, AllMetrics(), metrics)
+}
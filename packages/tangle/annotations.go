@@ -0,0 +1,255 @@
+package tangle
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/generics/objectstorage"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+)
+
+const (
+	// MaxAnnotationNamespaceLength defines the maximum length of the namespace of a MessageAnnotation.
+	MaxAnnotationNamespaceLength = 32
+
+	// MaxAnnotationKeyLength defines the maximum length of the key of a MessageAnnotation.
+	MaxAnnotationKeyLength = 64
+
+	// MaxAnnotationValueSize defines the maximum size of the value of a MessageAnnotation.
+	MaxAnnotationValueSize = 1024
+)
+
+var (
+	// ErrAnnotationNamespaceNotRegistered is returned if a MessageAnnotation is modified in a namespace that was not
+	// registered.
+	ErrAnnotationNamespaceNotRegistered = errors.New("annotation namespace not registered")
+
+	// ErrAnnotationNamespaceRegistered is returned if a namespace is registered more than once.
+	ErrAnnotationNamespaceRegistered = errors.New("annotation namespace registered already")
+
+	// ErrInvalidAnnotation is returned if the namespace, the key or the value of a MessageAnnotation exceed their limits.
+	ErrInvalidAnnotation = errors.New("invalid annotation")
+
+	// ErrUnknownMessage is returned if a MessageAnnotation is set for a Message that is not stored.
+	ErrUnknownMessage = errors.New("unknown message")
+
+	// MessageAnnotationPartitionKeys defines the partition of the storage key of the MessageAnnotation model.
+	MessageAnnotationPartitionKeys = objectstorage.PartitionKey(MessageIDLength, MaxAnnotationNamespaceLength, MaxAnnotationKeyLength)
+)
+
+// region AnnotationNamespaces /////////////////////////////////////////////////////////////////////////////////////////
+
+// AnnotationNamespaces contains the namespaces in which plugins are allowed to modify MessageAnnotations. Every plugin
+// registers its own namespace, so that the annotations of different plugins can not collide.
+type AnnotationNamespaces struct {
+	namespaces map[string]struct{}
+	mutex      sync.RWMutex
+}
+
+// NewAnnotationNamespaces creates an empty AnnotationNamespaces instance.
+func NewAnnotationNamespaces() *AnnotationNamespaces {
+	return &AnnotationNamespaces{
+		namespaces: make(map[string]struct{}),
+	}
+}
+
+// Register registers the given namespace. It returns an error if the namespace is invalid or registered already.
+func (a *AnnotationNamespaces) Register(namespace string) error {
+	if err := validateAnnotationField("namespace", namespace, MaxAnnotationNamespaceLength); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if _, exists := a.namespaces[namespace]; exists {
+		return errors.Errorf("failed to register %q: %w", namespace, ErrAnnotationNamespaceRegistered)
+	}
+	a.namespaces[namespace] = struct{}{}
+
+	return nil
+}
+
+// IsRegistered returns true if the given namespace was registered.
+func (a *AnnotationNamespaces) IsRegistered(namespace string) bool {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	_, exists := a.namespaces[namespace]
+	return exists
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MessageAnnotation ////////////////////////////////////////////////////////////////////////////////////////////
+
+// MessageAnnotation is a small key/value pair that a plugin attaches to a Message in its own namespace. The annotations
+// are stored separately from the MessageMetadata, so that plugins can persist their own information about Messages
+// without changing the format of the MessageMetadata.
+type MessageAnnotation struct {
+	messageID MessageID
+	namespace string
+	key       string
+	value     []byte
+
+	objectstorage.StorableObjectFlags
+}
+
+// NewMessageAnnotation creates a new MessageAnnotation.
+func NewMessageAnnotation(messageID MessageID, namespace, key string, value []byte) *MessageAnnotation {
+	return &MessageAnnotation{
+		messageID: messageID,
+		namespace: namespace,
+		key:       key,
+		value:     value,
+	}
+}
+
+// FromObjectStorage creates a MessageAnnotation from sequences of key and bytes.
+func (m *MessageAnnotation) FromObjectStorage(key, value []byte) (objectstorage.StorableObject, error) {
+	marshalUtil := marshalutil.New(key)
+	messageAnnotation := &MessageAnnotation{value: value}
+
+	var err error
+	if messageAnnotation.messageID, err = ReferenceFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse MessageID of MessageAnnotation: %w", err)
+	}
+	namespaceBytes, err := marshalUtil.ReadBytes(MaxAnnotationNamespaceLength)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse namespace of MessageAnnotation (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	messageAnnotation.namespace = unpadAnnotationField(namespaceBytes)
+	keyBytes, err := marshalUtil.ReadBytes(MaxAnnotationKeyLength)
+	if err != nil {
+		return nil, errors.Errorf("failed to parse key of MessageAnnotation (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+	messageAnnotation.key = unpadAnnotationField(keyBytes)
+
+	return messageAnnotation, nil
+}
+
+// MessageID returns the MessageID of the annotated Message.
+func (m *MessageAnnotation) MessageID() MessageID {
+	return m.messageID
+}
+
+// Namespace returns the namespace of the MessageAnnotation.
+func (m *MessageAnnotation) Namespace() string {
+	return m.namespace
+}
+
+// Key returns the key of the MessageAnnotation.
+func (m *MessageAnnotation) Key() string {
+	return m.key
+}
+
+// Value returns the value of the MessageAnnotation.
+func (m *MessageAnnotation) Value() []byte {
+	return m.value
+}
+
+// String returns a human-readable version of the MessageAnnotation.
+func (m *MessageAnnotation) String() string {
+	return stringify.Struct("MessageAnnotation",
+		stringify.StructField("messageID", m.messageID),
+		stringify.StructField("namespace", m.namespace),
+		stringify.StructField("key", m.key),
+		stringify.StructField("value", m.value),
+	)
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. It is required to match the
+// MessageAnnotationPartitionKeys, so the namespace and the key are padded to their maximum length. The MessageID is the
+// prefix of the key, so that all annotations of a Message can be iterated and removed together with the Message.
+func (m *MessageAnnotation) ObjectStorageKey() []byte {
+	return messageAnnotationKey(m.messageID, m.namespace, m.key)
+}
+
+// ObjectStorageValue marshals the MessageAnnotation into a sequence of bytes that are used as the value part in the
+// object storage.
+func (m *MessageAnnotation) ObjectStorageValue() []byte {
+	return m.value
+}
+
+// Interface contract: make compiler warn if the interface is not implemented correctly.
+var _ objectstorage.StorableObject = new(MessageAnnotation)
+
+// messageAnnotationKey returns the storage key of the MessageAnnotation with the given details.
+func messageAnnotationKey(messageID MessageID, namespace, key string) []byte {
+	return byteutils.ConcatBytes(messageAnnotationNamespacePrefix(messageID, namespace), padAnnotationField(key, MaxAnnotationKeyLength))
+}
+
+// messageAnnotationNamespacePrefix returns the prefix of the storage keys of the MessageAnnotations of the given Message
+// in the given namespace.
+func messageAnnotationNamespacePrefix(messageID MessageID, namespace string) []byte {
+	return byteutils.ConcatBytes(messageID.Bytes(), padAnnotationField(namespace, MaxAnnotationNamespaceLength))
+}
+
+// padAnnotationField pads the given namespace or key with zero bytes to the given length.
+func padAnnotationField(field string, length int) (padded []byte) {
+	padded = make([]byte, length)
+	copy(padded, field)
+
+	return padded
+}
+
+// unpadAnnotationField removes the zero bytes that were added by padAnnotationField.
+func unpadAnnotationField(padded []byte) string {
+	return string(bytes.TrimRight(padded, "\x00"))
+}
+
+// validateMessageAnnotation checks that the namespace, the key and the value of a MessageAnnotation are within their
+// limits.
+func validateMessageAnnotation(namespace, key string, value []byte) error {
+	if err := validateAnnotationField("namespace", namespace, MaxAnnotationNamespaceLength); err != nil {
+		return err
+	}
+	if err := validateAnnotationField("key", key, MaxAnnotationKeyLength); err != nil {
+		return err
+	}
+	if len(value) > MaxAnnotationValueSize {
+		return errors.Errorf("value of %q must not exceed %d bytes: %w", key, MaxAnnotationValueSize, ErrInvalidAnnotation)
+	}
+
+	return nil
+}
+
+// validateAnnotationField checks that the given namespace or key is not empty, does not exceed the given length and
+// does not contain zero bytes (which are used for padding in the storage key).
+func validateAnnotationField(name, field string, maxLength int) error {
+	if len(field) == 0 || len(field) > maxLength {
+		return errors.Errorf("%s %q must have between 1 and %d characters: %w", name, field, maxLength, ErrInvalidAnnotation)
+	}
+	if strings.ContainsRune(field, 0) {
+		return errors.Errorf("%s %q must not contain zero bytes: %w", name, field, ErrInvalidAnnotation)
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MessageAnnotationEvent ///////////////////////////////////////////////////////////////////////////////////////
+
+// MessageAnnotationEvent holds the information provided by the events that are triggered when a MessageAnnotation is
+// set or deleted.
+type MessageAnnotationEvent struct {
+	// MessageID contains the identifier of the annotated Message.
+	MessageID MessageID
+
+	// Namespace contains the namespace of the MessageAnnotation.
+	Namespace string
+
+	// Key contains the key of the MessageAnnotation.
+	Key string
+
+	// Value contains the new value of the MessageAnnotation (nil if it was deleted).
+	Value []byte
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,84 @@
+package tangle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestStorage_MessageAnnotations(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	message := newTestDataMessage("annotated")
+	tangle.Storage.StoreMessage(message)
+
+	var setEvents, deletedEvents []*MessageAnnotationEvent
+	tangle.Storage.Events.MessageAnnotationSet.Attach(event.NewClosure(func(event *MessageAnnotationEvent) {
+		setEvents = append(setEvents, event)
+	}))
+	tangle.Storage.Events.MessageAnnotationDeleted.Attach(event.NewClosure(func(event *MessageAnnotationEvent) {
+		deletedEvents = append(deletedEvents, event)
+	}))
+
+	// annotations can only be modified in registered namespaces
+	_, err := tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "key", []byte("value"))
+	assert.ErrorIs(t, err, ErrAnnotationNamespaceNotRegistered)
+	require.NoError(t, tangle.Storage.AnnotationNamespaces.Register("plugin"))
+	require.NoError(t, tangle.Storage.AnnotationNamespaces.Register("other"))
+	assert.ErrorIs(t, tangle.Storage.AnnotationNamespaces.Register("plugin"), ErrAnnotationNamespaceRegistered)
+
+	// annotations are validated and can only be attached to stored messages
+	_, err = tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "", []byte("value"))
+	assert.ErrorIs(t, err, ErrInvalidAnnotation)
+	_, err = tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "key", make([]byte, MaxAnnotationValueSize+1))
+	assert.ErrorIs(t, err, ErrInvalidAnnotation)
+	_, err = tangle.Storage.SetMessageAnnotation(randomMessageID(), "plugin", "key", []byte("value"))
+	assert.ErrorIs(t, err, ErrUnknownMessage)
+
+	modified, err := tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "key", []byte("value"))
+	require.NoError(t, err)
+	assert.True(t, modified)
+	modified, err = tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "key", []byte("value"))
+	require.NoError(t, err)
+	assert.False(t, modified)
+	modified, err = tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "key2", []byte("value2"))
+	require.NoError(t, err)
+	assert.True(t, modified)
+	modified, err = tangle.Storage.SetMessageAnnotation(message.ID(), "other", "key", []byte("other"))
+	require.NoError(t, err)
+	assert.True(t, modified)
+	assert.Len(t, setEvents, 3)
+
+	// annotations survive the eviction from the cache
+	tangle.Storage.FlushCache()
+
+	value, exists := tangle.Storage.MessageAnnotation(message.ID(), "plugin", "key")
+	assert.True(t, exists)
+	assert.Equal(t, []byte("value"), value)
+	assert.Equal(t, map[string][]byte{"key": []byte("value"), "key2": []byte("value2")}, tangle.Storage.MessageAnnotations(message.ID(), "plugin"))
+	assert.Equal(t, map[string][]byte{"key": []byte("other")}, tangle.Storage.MessageAnnotations(message.ID(), "other"))
+
+	modified, err = tangle.Storage.SetMessageAnnotation(message.ID(), "plugin", "key", []byte("updated"))
+	require.NoError(t, err)
+	assert.True(t, modified)
+	value, _ = tangle.Storage.MessageAnnotation(message.ID(), "plugin", "key")
+	assert.Equal(t, []byte("updated"), value)
+
+	deleted, err := tangle.Storage.DeleteMessageAnnotation(message.ID(), "plugin", "key2")
+	require.NoError(t, err)
+	assert.True(t, deleted)
+	deleted, err = tangle.Storage.DeleteMessageAnnotation(message.ID(), "plugin", "key2")
+	require.NoError(t, err)
+	assert.False(t, deleted)
+	assert.Len(t, deletedEvents, 1)
+	assert.Equal(t, map[string][]byte{"key": []byte("updated")}, tangle.Storage.MessageAnnotations(message.ID(), "plugin"))
+
+	// the annotations are removed together with the message
+	tangle.Storage.DeleteMessage(message.ID())
+	assert.Empty(t, tangle.Storage.MessageAnnotations(message.ID(), "plugin"))
+	assert.Empty(t, tangle.Storage.MessageAnnotations(message.ID(), "other"))
+}
@@ -3,12 +3,13 @@ package tangle
 import (
 	"time"
 
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/walker"
 	"github.com/iotaledger/hive.go/identity"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
 )
 
 const (
@@ -20,19 +21,24 @@ const (
 // ApprovalWeightManager is a Tangle component to keep track of relative weights of branches and markers so that
 // consensus can be based on the heaviest perception on the tangle as a data structure.
 type ApprovalWeightManager struct {
-	Events *ApprovalWeightManagerEvents
-	tangle *Tangle
+	Events     *ApprovalWeightManagerEvents
+	tangle     *Tangle
+	workerPool *workerpools.Pool
 }
 
 // NewApprovalWeightManager is the constructor for ApprovalWeightManager.
 func NewApprovalWeightManager(tangle *Tangle) (approvalWeightManager *ApprovalWeightManager) {
 	approvalWeightManager = &ApprovalWeightManager{
 		Events: &ApprovalWeightManagerEvents{
-			MessageProcessed:    events.NewEvent(MessageIDCaller),
-			MarkerWeightChanged: events.NewEvent(markerWeightChangedEventHandler),
-			BranchWeightChanged: events.NewEvent(branchWeightChangedEventHandler),
+			MessageProcessed:    event.New[MessageID]("ApprovalWeightManager.MessageProcessed"),
+			MarkerWeightChanged: event.New[*MarkerWeightChangedEvent]("ApprovalWeightManager.MarkerWeightChanged"),
+			BranchWeightChanged: event.New[*BranchWeightChangedEvent]("ApprovalWeightManager.BranchWeightChanged"),
 		},
 		tangle: tangle,
+		workerPool: tangle.Options.WorkerPools.CreatePool(ApprovalWeightWorkerPoolName, workerpools.PriorityCritical, workerpools.PoolParams{
+			WorkerCount: 1,
+			QueueSize:   1024,
+		}),
 	}
 
 	return
@@ -40,9 +46,15 @@ func NewApprovalWeightManager(tangle *Tangle) (approvalWeightManager *ApprovalWe
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (a *ApprovalWeightManager) Setup() {
-	a.tangle.Booker.Events.MessageBooked.Attach(events.NewClosure(a.processBookedMessage))
-	a.tangle.Booker.Events.MessageBranchUpdated.Attach(events.NewClosure(a.processForkedMessage))
-	a.tangle.Booker.Events.MarkerBranchAdded.Attach(events.NewClosure(a.processForkedMarker))
+	a.tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(func(messageID MessageID) {
+		a.workerPool.Submit(func() { a.processBookedMessage(messageID) })
+	}))
+	a.tangle.Booker.Events.MessageBranchUpdated.Attach(event.NewClosure(func(event *MessageBranchUpdatedEvent) {
+		a.workerPool.Submit(func() { a.processForkedMessage(event) })
+	}))
+	a.tangle.Booker.Events.MarkerBranchAdded.Attach(event.NewClosure(func(event *MarkerBranchAddedEvent) {
+		a.workerPool.Submit(func() { a.processForkedMarker(event) })
+	}))
 }
 
 // processBookedMessage is the main entry point for the ApprovalWeightManager. It takes the Message's issuer, adds it to the
@@ -79,7 +91,9 @@ func (a *ApprovalWeightManager) WeightOfMarker(marker *markers.Marker, anchorTim
 }
 
 // Shutdown shuts down the ApprovalWeightManager and persists its state.
-func (a *ApprovalWeightManager) Shutdown() {}
+func (a *ApprovalWeightManager) Shutdown() {
+	a.workerPool.Shutdown()
+}
 
 func (a *ApprovalWeightManager) isRelevantVoter(message *Message) bool {
 	voterWeight, totalWeight := a.tangle.WeightProvider.Weight(message)
@@ -364,7 +378,8 @@ func (a *ApprovalWeightManager) updateBranchWeight(branchID ledgerstate.BranchID
 }
 
 // processForkedMessage updates the Branch weight after an individually mapped Message was forked into a new Branch.
-func (a *ApprovalWeightManager) processForkedMessage(messageID MessageID, forkedBranchID ledgerstate.BranchID) {
+func (a *ApprovalWeightManager) processForkedMessage(event *MessageBranchUpdatedEvent) {
+	messageID, forkedBranchID := event.MessageID, event.NewBranchID
 	a.tangle.Storage.Message(messageID).Consume(func(message *Message) {
 		a.tangle.Storage.BranchVoters(forkedBranchID, NewBranchVoters).Consume(func(forkedBranchVoters *BranchVoters) {
 			a.tangle.LedgerState.Branch(forkedBranchID).Consume(func(forkedBranch *ledgerstate.Branch) {
@@ -379,7 +394,8 @@ func (a *ApprovalWeightManager) processForkedMessage(messageID MessageID, forked
 }
 
 // take everything in future cone because it was not conflicting before and move to new branch.
-func (a *ApprovalWeightManager) processForkedMarker(marker *markers.Marker, oldBranchIDs ledgerstate.BranchIDs, forkedBranchID ledgerstate.BranchID) {
+func (a *ApprovalWeightManager) processForkedMarker(event *MarkerBranchAddedEvent) {
+	marker, forkedBranchID := event.Marker, event.NewBranchID
 	branchVotesUpdated := false
 	a.tangle.Storage.BranchVoters(forkedBranchID, NewBranchVoters).Consume(func(branchVoters *BranchVoters) {
 		a.tangle.LedgerState.Branch(forkedBranchID).Consume(func(forkedBranch *ledgerstate.Branch) {
@@ -450,11 +466,11 @@ func (a *ApprovalWeightManager) voterSupportsAllBranches(voter Voter, branchIDs
 // ApprovalWeightManagerEvents represents events happening in the ApprovalWeightManager.
 type ApprovalWeightManagerEvents struct {
 	// MessageProcessed is triggered once a message is finished being processed by the ApprovalWeightManager.
-	MessageProcessed *events.Event
+	MessageProcessed *event.Event[MessageID]
 	// BranchWeightChanged is triggered when a branch's weight changed.
-	BranchWeightChanged *events.Event
+	BranchWeightChanged *event.Event[*BranchWeightChangedEvent]
 	// MarkerWeightChanged is triggered when a marker's weight changed.
-	MarkerWeightChanged *events.Event
+	MarkerWeightChanged *event.Event[*MarkerWeightChangedEvent]
 }
 
 // MarkerWeightChangedEvent holds information about a marker and its updated weight.
@@ -463,20 +479,10 @@ type MarkerWeightChangedEvent struct {
 	Weight float64
 }
 
-// markerWeightChangedEventHandler is the caller function for events that hand over a MarkerWeightChangedEvent.
-func markerWeightChangedEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*MarkerWeightChangedEvent))(params[0].(*MarkerWeightChangedEvent))
-}
-
 // BranchWeightChangedEvent holds information about a branch and its updated weight.
 type BranchWeightChangedEvent struct {
 	BranchID ledgerstate.BranchID
 	Weight   float64
 }
 
-// branchWeightChangedEventHandler is the caller function for events that hand over a BranchWeightChangedEvent.
-func branchWeightChangedEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*BranchWeightChangedEvent))(params[0].(*BranchWeightChangedEvent))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
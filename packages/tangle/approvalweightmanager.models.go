@@ -1,6 +1,8 @@
 package tangle
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 
 	"github.com/cockroachdb/errors"
@@ -729,9 +731,17 @@ func (l *LatestBranchVotes) ObjectStorageValue() []byte {
 
 	marshalUtil.WriteUint64(uint64(len(l.latestBranchVotes)))
 
-	for branchID, vote := range l.latestBranchVotes {
+	// the votes are written in the order of their BranchIDs, so that the same votes always result in the same bytes
+	branchIDs := make([]ledgerstate.BranchID, 0, len(l.latestBranchVotes))
+	for branchID := range l.latestBranchVotes {
+		branchIDs = append(branchIDs, branchID)
+	}
+	sort.Slice(branchIDs, func(i, j int) bool {
+		return bytes.Compare(branchIDs[i].Bytes(), branchIDs[j].Bytes()) < 0
+	})
+	for _, branchID := range branchIDs {
 		marshalUtil.Write(branchID)
-		marshalUtil.Write(vote)
+		marshalUtil.Write(l.latestBranchVotes[branchID])
 	}
 
 	return marshalUtil.Bytes()
@@ -0,0 +1,387 @@
+// Package benchmark drives synthetic message streams through a standalone Tangle and measures its throughput, the
+// latency of the booking and the allocations per message, so that performance regressions can be detected.
+package benchmark
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"golang.org/x/crypto/blake2b"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+var (
+	// ErrInvalidOptions is returned if a Harness is configured with Options that can not be used to generate a message
+	// stream.
+	ErrInvalidOptions = errors.New("invalid benchmark options")
+
+	// ErrTimeout is returned if the Messages of a run were not processed within the configured timeout.
+	ErrTimeout = errors.New("benchmark timed out")
+)
+
+// region Harness //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Harness generates a synthetic message stream and issues it into a standalone Tangle. The stream is generated when
+// the Harness is created, so that the creation and the signing of the Messages is not part of the measurements.
+type Harness struct {
+	options *Options
+
+	genesisSnapshot *ledgerstate.Snapshot
+	messages        []*tangle.Message
+	messageIndexes  map[tangle.MessageID]int
+	transactions    int
+	conflicts       int
+}
+
+// New creates a Harness with a message stream that is generated according to the given Options.
+func New(opts ...Option) (harness *Harness, err error) {
+	harness = &Harness{
+		options: defaultOptions(),
+	}
+	for _, opt := range opts {
+		opt(harness.options)
+	}
+	if err = harness.options.validate(); err != nil {
+		return nil, err
+	}
+
+	newGenerator(harness).generate()
+
+	return harness, nil
+}
+
+// Run issues the message stream into a new Tangle and returns the measurements once every Message was processed.
+func (h *Harness) Run() (result *Result, err error) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+	// the Scheduler is paused, so that the measurements only cover the processing up to the booking
+	testTangle.Scheduler.Pause()
+	testTangle.Setup()
+	testTangle.LedgerState.LoadSnapshot(h.genesisSnapshot)
+
+	run := newRun(h, testTangle)
+	defer run.detach()
+
+	runtime.GC()
+	var memStatsBefore runtime.MemStats
+	runtime.ReadMemStats(&memStatsBefore)
+
+	start := time.Now()
+	for i, message := range h.messages {
+		atomic.StoreInt64(&run.issuingTimes[i], time.Now().UnixNano())
+		testTangle.Storage.StoreMessage(message)
+	}
+	if !run.wait(h.options.Timeout) {
+		return nil, errors.Errorf("%d of %d messages were processed after %s: %w", run.processedCount(), len(h.messages), h.options.Timeout, ErrTimeout)
+	}
+	duration := time.Since(start)
+
+	var memStatsAfter runtime.MemStats
+	runtime.ReadMemStats(&memStatsAfter)
+
+	return run.result(duration, &memStatsBefore, &memStatsAfter), nil
+}
+
+// Messages returns the number of Messages of the message stream.
+func (h *Harness) Messages() int {
+	return len(h.messages)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region generator ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// generator generates the message stream of a Harness. Every Transaction moves the funds of an unspent Output to a new
+// Output of the same wallet, while double spends reuse an Output that was already spent by an earlier Transaction.
+type generator struct {
+	harness *Harness
+	random  *rand.Rand
+	keyPair ed25519.KeyPair
+	address *ledgerstate.ED25519Address
+	start   time.Time
+
+	unspentOutputs []ledgerstate.Output
+	spentOutputs   []ledgerstate.Output
+}
+
+// newGenerator creates a generator for the given Harness.
+func newGenerator(harness *Harness) *generator {
+	seed := make([]byte, 8)
+	binary.LittleEndian.PutUint64(seed, uint64(harness.options.Seed))
+	walletSeed := blake2b.Sum256(seed)
+	keyPair := ed25519.NewSeed(walletSeed[:]).KeyPair(0)
+
+	return &generator{
+		harness: harness,
+		random:  rand.New(rand.NewSource(harness.options.Seed)),
+		keyPair: *keyPair,
+		address: ledgerstate.NewED25519Address(keyPair.PublicKey),
+		// the Messages are issued one microsecond apart and the last one is issued now
+		start: time.Now().Add(-time.Duration(harness.options.Messages) * time.Microsecond),
+	}
+}
+
+// generate creates the genesis snapshot and the Messages of the message stream.
+func (g *generator) generate() {
+	g.generateGenesis()
+
+	g.harness.messages = make([]*tangle.Message, 0, g.harness.options.Messages)
+	g.harness.messageIndexes = make(map[tangle.MessageID]int, g.harness.options.Messages)
+	for i := 0; i < g.harness.options.Messages; i++ {
+		message := g.generateMessage(i)
+
+		g.harness.messageIndexes[message.ID()] = i
+		g.harness.messages = append(g.harness.messages, message)
+	}
+}
+
+// generateGenesis creates the genesis snapshot that holds the Outputs that the first Transactions spend.
+func (g *generator) generateGenesis() {
+	outputCount := int(float64(g.harness.options.Messages)*g.harness.options.TransactionRate) + 1
+	if outputCount > ledgerstate.MaxOutputCount {
+		outputCount = ledgerstate.MaxOutputCount
+	}
+
+	outputs := make([]ledgerstate.Output, 0, outputCount)
+	unspentOutputs := make([]bool, 0, outputCount)
+	for i := 0; i < outputCount; i++ {
+		// the balances differ, so that the Outputs are unique
+		outputs = append(outputs, ledgerstate.NewSigLockedSingleOutput(uint64(1000+i), g.address))
+		unspentOutputs = append(unspentOutputs, true)
+	}
+
+	essence := ledgerstate.NewTransactionEssence(0, g.start, identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))),
+		ledgerstate.NewOutputs(outputs...),
+	)
+	unlockBlocks := ledgerstate.UnlockBlocks{ledgerstate.NewReferenceUnlockBlock(0)}
+	genesisTransaction := ledgerstate.NewTransaction(essence, unlockBlocks)
+
+	g.harness.genesisSnapshot = &ledgerstate.Snapshot{
+		Transactions: map[ledgerstate.TransactionID]ledgerstate.Record{
+			genesisTransaction.ID(): {
+				Essence:        essence,
+				UnlockBlocks:   unlockBlocks,
+				UnspentOutputs: unspentOutputs,
+			},
+		},
+	}
+	g.unspentOutputs = append(g.unspentOutputs, genesisTransaction.Essence().Outputs()...)
+}
+
+// generateMessage creates the Message with the given index, which references the Messages that were issued before it.
+func (g *generator) generateMessage(index int) *tangle.Message {
+	parents := tangle.NewMessageIDs()
+	for i := index - g.harness.options.Parents; i < index; i++ {
+		if i >= 0 {
+			parents.Add(g.harness.messages[i].ID())
+		}
+	}
+	if len(parents) == 0 {
+		parents.Add(tangle.EmptyMessageID)
+	}
+
+	issuingTime := g.start.Add(time.Duration(index) * time.Microsecond)
+
+	var messagePayload payload.Payload
+	if g.random.Float64() < g.harness.options.TransactionRate {
+		messagePayload = g.generateTransaction(issuingTime)
+	} else {
+		data := make([]byte, 8)
+		binary.LittleEndian.PutUint64(data, uint64(index))
+		messagePayload = payload.NewGenericDataPayload(data)
+	}
+
+	message, err := tangle.NewMessage(tangle.NewParentMessageIDs().AddAll(tangle.StrongParentType, parents), issuingTime, g.keyPair.PublicKey, uint64(index), messagePayload, 0, ed25519.Signature{})
+	if err != nil {
+		panic(err)
+	}
+
+	return message
+}
+
+// generateTransaction creates a Transaction that either spends an unspent Output or double spends an Output that was
+// spent before.
+func (g *generator) generateTransaction(timestamp time.Time) *ledgerstate.Transaction {
+	g.harness.transactions++
+
+	if len(g.spentOutputs) > 0 && g.random.Float64() < g.harness.options.ConflictRate {
+		g.harness.conflicts++
+
+		// the double spend is the last consumer of the Output, so that every conflict set has two members
+		index := g.random.Intn(len(g.spentOutputs))
+		input := g.spentOutputs[index]
+		g.spentOutputs[index] = g.spentOutputs[len(g.spentOutputs)-1]
+		g.spentOutputs = g.spentOutputs[:len(g.spentOutputs)-1]
+
+		return g.transfer(input, timestamp)
+	}
+
+	index := g.random.Intn(len(g.unspentOutputs))
+	input := g.unspentOutputs[index]
+	transaction := g.transfer(input, timestamp)
+	g.unspentOutputs[index] = transaction.Essence().Outputs()[0]
+	g.spentOutputs = append(g.spentOutputs, input)
+
+	return transaction
+}
+
+// transfer creates a signed Transaction that moves the balance of the given Output to a new Output.
+func (g *generator) transfer(input ledgerstate.Output, timestamp time.Time) *ledgerstate.Transaction {
+	balance, _ := input.Balances().Get(ledgerstate.ColorIOTA)
+	essence := ledgerstate.NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{},
+		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(input.ID())),
+		ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(balance, g.address)),
+	)
+	signature := ledgerstate.NewED25519Signature(g.keyPair.PublicKey, g.keyPair.PrivateKey.Sign(essence.Bytes()))
+
+	return ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(signature)})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region run //////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// run tracks the processing of the message stream of a Harness by a Tangle.
+type run struct {
+	harness *Harness
+	tangle  *tangle.Tangle
+
+	issuingTimes      []int64
+	bookingLatency    []int64
+	processed         []uint32
+	processedWG       sync.WaitGroup
+	processedMessages int64
+	booked            int64
+	invalid           int64
+	parked            int64
+
+	bookedClosure    *event.Closure[tangle.MessageID]
+	processedClosure *event.Closure[tangle.MessageID]
+	invalidClosure   *event.Closure[*tangle.MessageInvalidEvent]
+	parkedClosure    *event.Closure[tangle.MessageID]
+}
+
+// newRun creates a run for the given Harness and attaches it to the events of the Tangle.
+func newRun(harness *Harness, testTangle *tangle.Tangle) (r *run) {
+	r = &run{
+		harness:        harness,
+		tangle:         testTangle,
+		issuingTimes:   make([]int64, len(harness.messages)),
+		bookingLatency: make([]int64, len(harness.messages)),
+		processed:      make([]uint32, len(harness.messages)),
+	}
+	r.processedWG.Add(len(harness.messages))
+
+	r.bookedClosure = event.NewClosure(func(messageID tangle.MessageID) {
+		if index, exists := harness.messageIndexes[messageID]; exists {
+			atomic.StoreInt64(&r.bookingLatency[index], time.Now().UnixNano()-atomic.LoadInt64(&r.issuingTimes[index]))
+		}
+	})
+	// booked Messages are only processed once they passed the ApprovalWeightManager, which is the last step before the
+	// Scheduler
+	r.processedClosure = event.NewClosure(func(messageID tangle.MessageID) {
+		if _, exists := r.markProcessed(messageID); exists {
+			atomic.AddInt64(&r.booked, 1)
+		}
+	})
+	r.invalidClosure = event.NewClosure(func(event *tangle.MessageInvalidEvent) {
+		if _, exists := r.markProcessed(event.MessageID); exists {
+			atomic.AddInt64(&r.invalid, 1)
+		}
+	})
+	r.parkedClosure = event.NewClosure(func(messageID tangle.MessageID) {
+		if _, exists := r.markProcessed(messageID); exists {
+			atomic.AddInt64(&r.parked, 1)
+		}
+	})
+
+	testTangle.Booker.Events.MessageBooked.Attach(r.bookedClosure)
+	testTangle.ApprovalWeightManager.Events.MessageProcessed.Attach(r.processedClosure)
+	testTangle.Events.MessageInvalid.Attach(r.invalidClosure)
+	testTangle.Booker.Events.MessageParked.Attach(r.parkedClosure)
+
+	return r
+}
+
+// markProcessed marks the Message with the given MessageID as processed. It returns the index of the Message and false
+// if the Message is not part of the message stream or was processed before.
+func (r *run) markProcessed(messageID tangle.MessageID) (index int, firstTime bool) {
+	index, exists := r.harness.messageIndexes[messageID]
+	if !exists || !atomic.CompareAndSwapUint32(&r.processed[index], 0, 1) {
+		return index, false
+	}
+
+	atomic.AddInt64(&r.processedMessages, 1)
+	r.processedWG.Done()
+
+	return index, true
+}
+
+// wait waits until all Messages were processed or the timeout expired. It returns false if the timeout expired.
+func (r *run) wait(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		r.processedWG.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+// processedCount returns the number of Messages that were processed so far.
+func (r *run) processedCount() int64 {
+	return atomic.LoadInt64(&r.processedMessages)
+}
+
+// detach detaches the run from the events of the Tangle.
+func (r *run) detach() {
+	r.tangle.Booker.Events.MessageBooked.Detach(r.bookedClosure)
+	r.tangle.ApprovalWeightManager.Events.MessageProcessed.Detach(r.processedClosure)
+	r.tangle.Events.MessageInvalid.Detach(r.invalidClosure)
+	r.tangle.Booker.Events.MessageParked.Detach(r.parkedClosure)
+}
+
+// result returns the Result of the run.
+func (r *run) result(duration time.Duration, memStatsBefore, memStatsAfter *runtime.MemStats) *Result {
+	messages := len(r.harness.messages)
+
+	latencies := make([]time.Duration, 0, atomic.LoadInt64(&r.booked))
+	for i := range r.bookingLatency {
+		if latency := atomic.LoadInt64(&r.bookingLatency[i]); latency > 0 {
+			latencies = append(latencies, time.Duration(latency))
+		}
+	}
+
+	return &Result{
+		Messages:              messages,
+		Transactions:          r.harness.transactions,
+		Conflicts:             r.harness.conflicts,
+		Booked:                int(atomic.LoadInt64(&r.booked)),
+		Invalid:               int(atomic.LoadInt64(&r.invalid)),
+		Parked:                int(atomic.LoadInt64(&r.parked)),
+		Duration:              duration,
+		MessagesPerSecond:     float64(messages) / duration.Seconds(),
+		BookingLatency:        newLatencyStats(latencies),
+		AllocationsPerMessage: float64(memStatsAfter.Mallocs-memStatsBefore.Mallocs) / float64(messages),
+		BytesPerMessage:       float64(memStatsAfter.TotalAlloc-memStatsBefore.TotalAlloc) / float64(messages),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,99 @@
+package benchmark
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHarness_Run(t *testing.T) {
+	harness, err := New(Messages(500), TransactionRate(0.5), ConflictRate(0.2), Seed(42), Timeout(30*time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, 500, harness.Messages())
+
+	result, err := harness.Run()
+	require.NoError(t, err)
+
+	assert.Equal(t, 500, result.Messages)
+	assert.Equal(t, harness.transactions, result.Transactions)
+	assert.Equal(t, harness.conflicts, result.Conflicts)
+	assert.Greater(t, result.Transactions, 0)
+	assert.Greater(t, result.Conflicts, 0)
+	assert.Equal(t, result.Messages, result.Booked+result.Invalid+result.Parked)
+	assert.Zero(t, result.Invalid)
+	assert.Greater(t, result.MessagesPerSecond, 0.0)
+	assert.LessOrEqual(t, result.BookingLatency.P50, result.BookingLatency.P99)
+	assert.LessOrEqual(t, result.BookingLatency.P99, result.BookingLatency.Max)
+}
+
+func TestNew_Deterministic(t *testing.T) {
+	harness1, err := New(Messages(200), ConflictRate(0.3), Seed(7))
+	require.NoError(t, err)
+	harness2, err := New(Messages(200), ConflictRate(0.3), Seed(7))
+	require.NoError(t, err)
+
+	assert.Equal(t, harness1.transactions, harness2.transactions)
+	assert.Equal(t, harness1.conflicts, harness2.conflicts)
+	for i := range harness1.messages {
+		assert.Equal(t, harness1.messages[i].Payload().Type(), harness2.messages[i].Payload().Type())
+	}
+}
+
+func TestNew_InvalidOptions(t *testing.T) {
+	for name, option := range map[string]Option{
+		"messages":        Messages(0),
+		"transactionRate": TransactionRate(1.5),
+		"conflictRate":    ConflictRate(-0.1),
+		"parents":         Parents(9),
+		"timeout":         Timeout(0),
+	} {
+		_, err := New(option)
+		assert.True(t, errors.Is(err, ErrInvalidOptions), name)
+	}
+}
+
+func TestNewLatencyStats(t *testing.T) {
+	latencies := make([]time.Duration, 0, 100)
+	for i := 100; i > 0; i-- {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+
+	latencyStats := newLatencyStats(latencies)
+	assert.Equal(t, 50500*time.Microsecond, latencyStats.Mean)
+	assert.Equal(t, 50*time.Millisecond, latencyStats.P50)
+	assert.Equal(t, 95*time.Millisecond, latencyStats.P95)
+	assert.Equal(t, 99*time.Millisecond, latencyStats.P99)
+	assert.Equal(t, 100*time.Millisecond, latencyStats.Max)
+
+	assert.Equal(t, LatencyStats{}, newLatencyStats(nil))
+}
+
+// BenchmarkTangle_Throughput issues the same stream of 1000 Messages into a new Tangle in every iteration. The stream
+// size is fixed, because the cost of booking grows with the number of unconfirmed conflicts in the Tangle.
+func BenchmarkTangle_Throughput(b *testing.B) {
+	for _, conflictRate := range []float64{0, 0.01, 0.05} {
+		b.Run(fmt.Sprintf("conflictRate=%.2f", conflictRate), func(b *testing.B) {
+			harness, err := New(Messages(1000), ConflictRate(conflictRate))
+			require.NoError(b, err)
+
+			var messagesPerSecond float64
+			var p99Latency time.Duration
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				result, runErr := harness.Run()
+				require.NoError(b, runErr)
+
+				messagesPerSecond += result.MessagesPerSecond
+				p99Latency += result.BookingLatency.P99
+			}
+
+			b.ReportMetric(messagesPerSecond/float64(b.N), "msg/s")
+			b.ReportMetric(float64(p99Latency.Microseconds())/float64(b.N), "p99-µs")
+		})
+	}
+}
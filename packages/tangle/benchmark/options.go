@@ -0,0 +1,101 @@
+package benchmark
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Options is a container for the configuration of the synthetic message stream of a Harness.
+type Options struct {
+	Messages        int
+	TransactionRate float64
+	ConflictRate    float64
+	Parents         int
+	Seed            int64
+	Timeout         time.Duration
+}
+
+// defaultOptions returns the Options of a Harness that are used if they are not overridden.
+func defaultOptions() *Options {
+	return &Options{
+		Messages:        10000,
+		TransactionRate: 0.5,
+		ConflictRate:    0,
+		Parents:         2,
+		Seed:            1,
+		Timeout:         time.Minute,
+	}
+}
+
+// validate checks that the Options describe a message stream that can be generated.
+func (o *Options) validate() error {
+	switch {
+	case o.Messages <= 0:
+		return errors.Errorf("the number of messages must be positive: %w", ErrInvalidOptions)
+	case o.TransactionRate < 0 || o.TransactionRate > 1:
+		return errors.Errorf("the transaction rate must be between 0 and 1: %w", ErrInvalidOptions)
+	case o.ConflictRate < 0 || o.ConflictRate > 1:
+		return errors.Errorf("the conflict rate must be between 0 and 1: %w", ErrInvalidOptions)
+	case o.Parents < 1 || o.Parents > tangle.MaxParentsCount:
+		return errors.Errorf("the number of parents must be between 1 and %d: %w", tangle.MaxParentsCount, ErrInvalidOptions)
+	case o.Timeout <= 0:
+		return errors.Errorf("the timeout must be positive: %w", ErrInvalidOptions)
+	default:
+		return nil
+	}
+}
+
+// Option is the type of the functional options that configure a Harness.
+type Option func(*Options)
+
+// Messages returns an Option that sets the number of Messages that are issued.
+func Messages(messages int) Option {
+	return func(options *Options) {
+		options.Messages = messages
+	}
+}
+
+// TransactionRate returns an Option that sets the fraction of the Messages that contain a Transaction.
+func TransactionRate(transactionRate float64) Option {
+	return func(options *Options) {
+		options.TransactionRate = transactionRate
+	}
+}
+
+// ConflictRate returns an Option that sets the fraction of the Transactions that double spend an Output which was
+// already spent by an earlier Transaction, so that a conflict is created.
+func ConflictRate(conflictRate float64) Option {
+	return func(options *Options) {
+		options.ConflictRate = conflictRate
+	}
+}
+
+// Parents returns an Option that sets the number of strong parents of every Message, which reference the Messages that
+// were issued directly before.
+func Parents(parents int) Option {
+	return func(options *Options) {
+		options.Parents = parents
+	}
+}
+
+// Seed returns an Option that sets the seed of the random source that generates the message stream, so that runs with
+// the same seed process the same Messages.
+func Seed(seed int64) Option {
+	return func(options *Options) {
+		options.Seed = seed
+	}
+}
+
+// Timeout returns an Option that sets the maximum time that a run waits for the Messages to be processed.
+func Timeout(timeout time.Duration) Option {
+	return func(options *Options) {
+		options.Timeout = timeout
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,95 @@
+package benchmark
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// region Result ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Result contains the measurements of a run of a Harness.
+type Result struct {
+	Messages              int           `json:"messages"`
+	Transactions          int           `json:"transactions"`
+	Conflicts             int           `json:"conflicts"`
+	Booked                int           `json:"booked"`
+	Invalid               int           `json:"invalid"`
+	Parked                int           `json:"parked"`
+	Duration              time.Duration `json:"duration"`
+	MessagesPerSecond     float64       `json:"messagesPerSecond"`
+	BookingLatency        LatencyStats  `json:"bookingLatency"`
+	AllocationsPerMessage float64       `json:"allocationsPerMessage"`
+	BytesPerMessage       float64       `json:"bytesPerMessage"`
+}
+
+// String returns a human-readable version of the Result.
+func (r *Result) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Messages:       %d (%d transactions, %d conflicts)\n", r.Messages, r.Transactions, r.Conflicts)
+	fmt.Fprintf(&builder, "Processed:      %d booked, %d invalid, %d parked\n", r.Booked, r.Invalid, r.Parked)
+	fmt.Fprintf(&builder, "Duration:       %s\n", r.Duration)
+	fmt.Fprintf(&builder, "Throughput:     %.2f MPS\n", r.MessagesPerSecond)
+	fmt.Fprintf(&builder, "Booking:        %s\n", r.BookingLatency)
+	fmt.Fprintf(&builder, "Allocations:    %.2f allocs/msg, %.2f B/msg\n", r.AllocationsPerMessage, r.BytesPerMessage)
+
+	return builder.String()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region LatencyStats /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// LatencyStats summarizes the distribution of the latencies of the Messages of a run.
+type LatencyStats struct {
+	Mean time.Duration `json:"mean"`
+	P50  time.Duration `json:"p50"`
+	P95  time.Duration `json:"p95"`
+	P99  time.Duration `json:"p99"`
+	Max  time.Duration `json:"max"`
+}
+
+// newLatencyStats creates the LatencyStats of the given latencies. The slice is sorted in place.
+func newLatencyStats(latencies []time.Duration) (latencyStats LatencyStats) {
+	if len(latencies) == 0 {
+		return latencyStats
+	}
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	var sum time.Duration
+	for _, latency := range latencies {
+		sum += latency
+	}
+
+	return LatencyStats{
+		Mean: sum / time.Duration(len(latencies)),
+		P50:  percentile(latencies, 0.50),
+		P95:  percentile(latencies, 0.95),
+		P99:  percentile(latencies, 0.99),
+		Max:  latencies[len(latencies)-1],
+	}
+}
+
+// String returns a human-readable version of the LatencyStats.
+func (l LatencyStats) String() string {
+	return fmt.Sprintf("mean %s, p50 %s, p95 %s, p99 %s, max %s", l.Mean, l.P50, l.P95, l.P99, l.Max)
+}
+
+// percentile returns the latency below which the given fraction of the sorted latencies lies (nearest-rank method).
+func percentile(sortedLatencies []time.Duration, fraction float64) time.Duration {
+	rank := int(fraction*float64(len(sortedLatencies))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sortedLatencies) {
+		rank = len(sortedLatencies) - 1
+	}
+
+	return sortedLatencies[rank]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,315 @@
+package tangle
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// ErrIssuerBlacklisted is returned when a message of a blacklisted issuer is rejected.
+var ErrIssuerBlacklisted = errors.New("issuer is blacklisted")
+
+// region BlacklistParams //////////////////////////////////////////////////////////////////////////////////////////////
+
+// BlacklistParams defines the configuration parameters of the Blacklist.
+type BlacklistParams struct {
+	// CoolDownPeriod defines how long an issuer stays blacklisted after a violation was detected (0 disables the
+	// automatic detection of violations).
+	CoolDownPeriod time.Duration
+	// ViolationThreshold defines how many rate violations within the ViolationWindow lead to the issuer getting
+	// blacklisted (0 disables the detection of rate violations).
+	ViolationThreshold int
+	// ViolationWindow defines the time window in which rate violations of an issuer are counted.
+	ViolationWindow time.Duration
+	// RejectMessages defines if the messages of blacklisted issuers are rejected instead of being deprioritized.
+	RejectMessages bool
+	// DetectEquivocations defines if issuers that reuse a sequence number for different messages are blacklisted.
+	DetectEquivocations bool
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Blacklist ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Blacklist is a Tangle component that keeps track of issuers that violated the protocol. The messages of blacklisted
+// issuers are deprioritized by the Scheduler or rejected by the Parser until their cool-down period has passed.
+type Blacklist struct {
+	Events *BlacklistEvents
+
+	tangle      *Tangle
+	entries     map[identity.ID]*BlacklistEntry
+	violations  map[identity.ID]*violationCounter
+	expiryTasks *TimedTaskExecutor
+	mutex       sync.RWMutex
+}
+
+// NewBlacklist is the constructor of the Blacklist.
+func NewBlacklist(tangle *Tangle) *Blacklist {
+	return &Blacklist{
+		Events: &BlacklistEvents{
+			IssuerBlacklisted: event.New[*BlacklistEntry]("Blacklist.IssuerBlacklisted"),
+			IssuerRemoved:     event.New[identity.ID]("Blacklist.IssuerRemoved"),
+		},
+		tangle:      tangle,
+		entries:     make(map[identity.ID]*BlacklistEntry),
+		violations:  make(map[identity.ID]*violationCounter),
+		expiryTasks: NewTimedTaskExecutor(tangle.Options.Clock),
+	}
+}
+
+// Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
+func (b *Blacklist) Setup() {
+	b.tangle.SequenceTracker.Events.SequenceNumberReused.Attach(event.NewClosure(b.blacklistEquivocation))
+
+	b.tangle.Parser.AddMessageFilter(NewIssuerBlacklistFilter(b))
+}
+
+// Add blacklists the given issuer for the given duration. Adding an issuer that is already blacklisted replaces its
+// previous entry.
+func (b *Blacklist) Add(issuerID identity.ID, reason ViolationReason, duration time.Duration) {
+	entry := &BlacklistEntry{
+		IssuerID: issuerID,
+		Reason:   reason,
+		Until:    b.tangle.Options.Clock.Now().Add(duration),
+	}
+
+	b.mutex.Lock()
+	b.entries[issuerID] = entry
+	delete(b.violations, issuerID)
+	b.mutex.Unlock()
+
+	b.expiryTasks.ExecuteAfter(issuerID, func() { b.Remove(issuerID) }, duration)
+
+	b.Events.IssuerBlacklisted.Trigger(entry)
+}
+
+// Remove removes the given issuer from the Blacklist and returns true if it was blacklisted.
+func (b *Blacklist) Remove(issuerID identity.ID) (removed bool) {
+	b.mutex.Lock()
+	if _, removed = b.entries[issuerID]; removed {
+		delete(b.entries, issuerID)
+	}
+	b.mutex.Unlock()
+
+	if !removed {
+		return false
+	}
+
+	b.expiryTasks.Cancel(issuerID)
+	b.Events.IssuerRemoved.Trigger(issuerID)
+
+	return true
+}
+
+// IsBlacklisted returns true if the given issuer is currently blacklisted.
+func (b *Blacklist) IsBlacklisted(issuerID identity.ID) bool {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	_, exists := b.entries[issuerID]
+	return exists
+}
+
+// Entries returns the entries of all currently blacklisted issuers.
+func (b *Blacklist) Entries() (entries []*BlacklistEntry) {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	entries = make([]*BlacklistEntry, 0, len(b.entries))
+	for _, entry := range b.entries {
+		entries = append(entries, entry)
+	}
+
+	return entries
+}
+
+// RejectMessages returns true if the messages of blacklisted issuers are rejected instead of being deprioritized.
+func (b *Blacklist) RejectMessages() bool {
+	return b.tangle.Options.BlacklistParams.RejectMessages
+}
+
+// RecordRateViolation records that the given issuer exceeded its mana-based rate and blacklists the issuer if it
+// does so persistently.
+func (b *Blacklist) RecordRateViolation(issuerID identity.ID) {
+	params := b.tangle.Options.BlacklistParams
+	if params.CoolDownPeriod <= 0 || params.ViolationThreshold <= 0 {
+		return
+	}
+
+	now := b.tangle.Options.Clock.Now()
+
+	b.mutex.Lock()
+	if _, blacklisted := b.entries[issuerID]; blacklisted {
+		b.mutex.Unlock()
+		return
+	}
+	counter, exists := b.violations[issuerID]
+	if !exists || now.Sub(counter.windowStart) > params.ViolationWindow {
+		counter = &violationCounter{windowStart: now}
+		b.violations[issuerID] = counter
+	}
+	counter.count++
+	thresholdReached := counter.count >= params.ViolationThreshold
+	b.mutex.Unlock()
+
+	if thresholdReached {
+		b.Add(issuerID, ViolationReasonRate, params.CoolDownPeriod)
+	}
+}
+
+// Shutdown shuts down the Blacklist and cancels the pending expiry of its entries.
+func (b *Blacklist) Shutdown() {
+	b.expiryTasks.Shutdown()
+}
+
+// blacklistEquivocation blacklists the issuer of a message that reuses the sequence number of another message of the
+// same issuer. The order of the issuing times is not checked, as it can not be distinguished from messages that are
+// issued concurrently.
+func (b *Blacklist) blacklistEquivocation(reusedEvent *SequenceNumberReusedEvent) {
+	params := b.tangle.Options.BlacklistParams
+	if !params.DetectEquivocations || params.CoolDownPeriod <= 0 {
+		return
+	}
+
+	b.Add(reusedEvent.IssuerID, ViolationReasonSequenceEquivocation, params.CoolDownPeriod)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BlacklistEntry ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// BlacklistEntry represents an issuer that is blacklisted until the given time.
+type BlacklistEntry struct {
+	IssuerID identity.ID
+	Reason   ViolationReason
+	Until    time.Time
+}
+
+// String returns a human-readable version of the BlacklistEntry.
+func (b *BlacklistEntry) String() string {
+	return fmt.Sprintf("BlacklistEntry(%s, %s, %s)", b.IssuerID, b.Reason, b.Until)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ViolationReason //////////////////////////////////////////////////////////////////////////////////////////////
+
+// ViolationReason describes why an issuer was blacklisted.
+type ViolationReason uint8
+
+const (
+	// ViolationReasonManual is used for issuers that were added to the Blacklist by the node operator.
+	ViolationReasonManual ViolationReason = iota
+	// ViolationReasonRate is used for issuers that persistently exceeded their mana-based rate.
+	ViolationReasonRate
+	// ViolationReasonSequenceEquivocation is used for issuers that reused a sequence number for different messages.
+	ViolationReasonSequenceEquivocation
+)
+
+// String returns a human-readable version of the ViolationReason.
+func (v ViolationReason) String() string {
+	switch v {
+	case ViolationReasonManual:
+		return "manual"
+	case ViolationReasonRate:
+		return "rate"
+	case ViolationReasonSequenceEquivocation:
+		return "sequenceEquivocation"
+	default:
+		return fmt.Sprintf("ViolationReason(%d)", uint8(v))
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BlacklistEvents //////////////////////////////////////////////////////////////////////////////////////////////
+
+// BlacklistEvents represents events happening in the Blacklist.
+type BlacklistEvents struct {
+	// IssuerBlacklisted is triggered when an issuer is added to the Blacklist.
+	IssuerBlacklisted *event.Event[*BlacklistEntry]
+
+	// IssuerRemoved is triggered when an issuer is removed from the Blacklist or its cool-down period has passed.
+	IssuerRemoved *event.Event[identity.ID]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IssuerBlacklistFilter ////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerBlacklistFilter filters messages of blacklisted issuers if the Blacklist is configured to reject them.
+type IssuerBlacklistFilter struct {
+	blacklist *Blacklist
+
+	onAcceptCallback func(msg *Message, peer *peer.Peer)
+	onRejectCallback func(msg *Message, err error, peer *peer.Peer)
+
+	onAcceptCallbackMutex sync.RWMutex
+	onRejectCallbackMutex sync.RWMutex
+}
+
+// NewIssuerBlacklistFilter creates a new issuer blacklist filter.
+func NewIssuerBlacklistFilter(blacklist *Blacklist) *IssuerBlacklistFilter {
+	return &IssuerBlacklistFilter{
+		blacklist: blacklist,
+	}
+}
+
+// Filter filters up on the given message and peer and calls the acceptance callback
+// if the input passes or the rejection callback if the input is rejected.
+func (f *IssuerBlacklistFilter) Filter(msg *Message, peer *peer.Peer) {
+	if issuerID := identity.NewID(msg.IssuerPublicKey()); f.blacklist.RejectMessages() && f.blacklist.IsBlacklisted(issuerID) {
+		f.getRejectCallback()(msg, errors.Errorf("message from %s rejected: %w", issuerID, ErrIssuerBlacklisted), peer)
+		return
+	}
+	f.getAcceptCallback()(msg, peer)
+}
+
+// OnAccept registers the given callback as the acceptance function of the filter.
+func (f *IssuerBlacklistFilter) OnAccept(callback func(msg *Message, peer *peer.Peer)) {
+	f.onAcceptCallbackMutex.Lock()
+	f.onAcceptCallback = callback
+	f.onAcceptCallbackMutex.Unlock()
+}
+
+// OnReject registers the given callback as the rejection function of the filter.
+func (f *IssuerBlacklistFilter) OnReject(callback func(msg *Message, err error, peer *peer.Peer)) {
+	f.onRejectCallbackMutex.Lock()
+	f.onRejectCallback = callback
+	f.onRejectCallbackMutex.Unlock()
+}
+
+func (f *IssuerBlacklistFilter) getAcceptCallback() (result func(msg *Message, peer *peer.Peer)) {
+	f.onAcceptCallbackMutex.RLock()
+	result = f.onAcceptCallback
+	f.onAcceptCallbackMutex.RUnlock()
+	return
+}
+
+func (f *IssuerBlacklistFilter) getRejectCallback() (result func(msg *Message, err error, peer *peer.Peer)) {
+	f.onRejectCallbackMutex.RLock()
+	result = f.onRejectCallback
+	f.onRejectCallbackMutex.RUnlock()
+	return
+}
+
+// Close closes the filter.
+func (f *IssuerBlacklistFilter) Close() error { return nil }
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region violationCounter /////////////////////////////////////////////////////////////////////////////////////////////
+
+// violationCounter counts the rate violations of an issuer within the current violation window.
+type violationCounter struct {
+	count       int
+	windowStart time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
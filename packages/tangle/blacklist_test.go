@@ -0,0 +1,143 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestBlacklist_AddRemove(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+	blacklist := tangle.Blacklist
+
+	var blacklisted []*BlacklistEntry
+	var removed []identity.ID
+	blacklist.Events.IssuerBlacklisted.Attach(event.NewClosure(func(entry *BlacklistEntry) {
+		blacklisted = append(blacklisted, entry)
+	}))
+	blacklist.Events.IssuerRemoved.Attach(event.NewClosure(func(issuerID identity.ID) {
+		removed = append(removed, issuerID)
+	}))
+
+	issuerID := identity.GenerateIdentity().ID()
+	blacklist.Add(issuerID, ViolationReasonManual, time.Hour)
+	assert.True(t, blacklist.IsBlacklisted(issuerID))
+	require.Len(t, blacklist.Entries(), 1)
+	assert.Equal(t, ViolationReasonManual, blacklist.Entries()[0].Reason)
+	require.Len(t, blacklisted, 1)
+	assert.Equal(t, issuerID, blacklisted[0].IssuerID)
+
+	assert.True(t, blacklist.Remove(issuerID))
+	assert.False(t, blacklist.Remove(issuerID))
+	assert.False(t, blacklist.IsBlacklisted(issuerID))
+	assert.Equal(t, []identity.ID{issuerID}, removed)
+
+	// entries expire after their cool-down period
+	blacklist.Add(issuerID, ViolationReasonManual, 100*time.Millisecond)
+	assert.Eventually(t, func() bool { return !blacklist.IsBlacklisted(issuerID) }, time.Second, 10*time.Millisecond)
+}
+
+func TestBlacklist_VirtualClock(t *testing.T) {
+	virtualClock := clock.NewVirtualClock(time.Now())
+	tangle := NewTestTangle(Clock(virtualClock))
+	defer tangle.Shutdown()
+	blacklist := tangle.Blacklist
+
+	// the cool-down period is measured in the time of the clock of the Tangle
+	issuerID := identity.GenerateIdentity().ID()
+	blacklist.Add(issuerID, ViolationReasonManual, time.Hour)
+	virtualClock.Advance(59 * time.Minute)
+	assert.True(t, blacklist.IsBlacklisted(issuerID))
+	virtualClock.Advance(time.Minute)
+	assert.False(t, blacklist.IsBlacklisted(issuerID))
+}
+
+func TestBlacklist_RecordRateViolation(t *testing.T) {
+	tangle := NewTestTangle(BlacklistConfig(BlacklistParams{
+		CoolDownPeriod:     time.Hour,
+		ViolationThreshold: 3,
+		ViolationWindow:    time.Minute,
+	}))
+	defer tangle.Shutdown()
+	blacklist := tangle.Blacklist
+
+	issuerID := identity.GenerateIdentity().ID()
+	for i := 0; i < 2; i++ {
+		blacklist.RecordRateViolation(issuerID)
+		assert.False(t, blacklist.IsBlacklisted(issuerID))
+	}
+	blacklist.RecordRateViolation(issuerID)
+	assert.True(t, blacklist.IsBlacklisted(issuerID))
+	require.Len(t, blacklist.Entries(), 1)
+	assert.Equal(t, ViolationReasonRate, blacklist.Entries()[0].Reason)
+}
+
+func TestBlacklist_SequenceEquivocation(t *testing.T) {
+	tangle := NewTestTangle(BlacklistConfig(BlacklistParams{
+		CoolDownPeriod:      time.Hour,
+		RejectMessages:      true,
+		DetectEquivocations: true,
+	}))
+	defer tangle.Shutdown()
+	tangle.Storage.Setup()
+	tangle.Blacklist.Setup()
+	tangle.SequenceTracker.Setup()
+
+	honestIssuer := ed25519.GenerateKeyPair().PublicKey
+	faultyIssuer := ed25519.GenerateKeyPair().PublicKey
+	lateFaultyIssuer := ed25519.GenerateKeyPair().PublicKey
+	now := time.Now()
+
+	// issuing times that do not follow the sequence numbers are not a violation
+	tangle.Storage.StoreMessage(newSequencedMessage(honestIssuer, 2, now))
+	tangle.Storage.StoreMessage(newSequencedMessage(honestIssuer, 1, now.Add(time.Second)))
+	assert.False(t, tangle.Blacklist.IsBlacklisted(identity.NewID(honestIssuer)))
+
+	tangle.Storage.StoreMessage(newSequencedMessage(faultyIssuer, 2, now))
+	tangle.Storage.StoreMessage(newSequencedMessage(faultyIssuer, 2, now.Add(time.Second)))
+	assert.True(t, tangle.Blacklist.IsBlacklisted(identity.NewID(faultyIssuer)))
+	require.Len(t, tangle.Blacklist.Entries(), 1)
+	assert.Equal(t, ViolationReasonSequenceEquivocation, tangle.Blacklist.Entries()[0].Reason)
+
+	// reusing a sequence number below the highest one is detected as well
+	tangle.Storage.StoreMessage(newSequencedMessage(lateFaultyIssuer, 1, now))
+	tangle.Storage.StoreMessage(newSequencedMessage(lateFaultyIssuer, 2, now))
+	tangle.Storage.StoreMessage(newSequencedMessage(lateFaultyIssuer, 1, now.Add(time.Second)))
+	assert.True(t, tangle.Blacklist.IsBlacklisted(identity.NewID(lateFaultyIssuer)))
+
+	// messages of the blacklisted issuer are rejected by the filter
+	filter := NewIssuerBlacklistFilter(tangle.Blacklist)
+	var accepted, rejected int
+	filter.OnAccept(func(*Message, *peer.Peer) { accepted++ })
+	filter.OnReject(func(_ *Message, err error, _ *peer.Peer) {
+		assert.ErrorIs(t, err, ErrIssuerBlacklisted)
+		rejected++
+	})
+	filter.Filter(newSequencedMessage(honestIssuer, 3, now), nil)
+	filter.Filter(newSequencedMessage(faultyIssuer, 3, now), nil)
+	assert.Equal(t, 1, accepted)
+	assert.Equal(t, 1, rejected)
+}
+
+func newSequencedMessage(issuerPublicKey ed25519.PublicKey, sequenceNumber uint64, issuingTime time.Time) *Message {
+	message, _ := NewMessage(
+		emptyLikeReferencesFromStrongParents(NewMessageIDs(EmptyMessageID)),
+		issuingTime,
+		issuerPublicKey,
+		sequenceNumber,
+		payload.NewGenericDataPayload([]byte("")),
+		0,
+		ed25519.Signature{},
+	)
+	return message
+}
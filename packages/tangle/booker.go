@@ -6,16 +6,20 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/cerrors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/walker"
 	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/marshalutil"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/pool"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
 )
 
-const bookerQueueSize = 1024
-
 // region Booker ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // Booker is a Tangle component that takes care of booking Messages and Transactions by assigning them to the
@@ -27,72 +31,83 @@ type Booker struct {
 	tangle         *Tangle
 	MarkersManager *BranchMarkersMapper
 
-	bookerQueue chan MessageID
-	shutdown    chan struct{}
-	shutdownWG  sync.WaitGroup
+	workerPool *workerpools.Pool
+
+	// parkedMessages contains the Messages that can not be booked, yet. They are persisted in the parkedMessageStorage,
+	// so that they (and the children that wait for them) are booked again after a restart.
+	parkedMessages       map[MessageID]*parkedMessage
+	parkedMessageStorage kvstore.KVStore
+	parkedMessagesMutex  sync.Mutex
+
+	// unparkedMessages and expiredMessages are handed over to the worker of the Booker without blocking, as they are
+	// triggered by the confirmation of Branches, which must not wait for the queue of the Booker.
+	unparkedMessages         []MessageID
+	expiredMessages          []MessageID
+	pendingMessagesScheduled bool
+	pendingMessagesRetry     clock.Timer
+	shutdown                 bool
+	pendingMessagesMutex     sync.Mutex
 }
 
+// pendingMessagesRetryInterval defines how long the Booker waits before it tries again to hand the pending Messages to
+// its worker if its queue was full.
+const pendingMessagesRetryInterval = 100 * time.Millisecond
+
 // NewBooker is the constructor of a Booker.
 func NewBooker(tangle *Tangle) (messageBooker *Booker) {
 	messageBooker = &Booker{
 		Events: &BookerEvents{
-			MessageBooked:        events.NewEvent(MessageIDCaller),
-			MarkerBranchAdded:    events.NewEvent(markerBranchUpdatedCaller),
-			MessageBranchUpdated: events.NewEvent(messageBranchUpdatedCaller),
-			Error:                events.NewEvent(events.ErrorCaller),
+			MessageBooked:         event.New[MessageID]("Booker.MessageBooked"),
+			MessageParked:         event.New[MessageID]("Booker.MessageParked"),
+			ParkedMessageExpired:  event.New[MessageID]("Booker.ParkedMessageExpired"),
+			MessageBelowMaxDepth:  event.New[MessageID]("Booker.MessageBelowMaxDepth"),
+			TransactionReattached: event.New[*TransactionReattachedEvent]("Booker.TransactionReattached"),
+			MarkerBranchAdded:     event.New[*MarkerBranchAddedEvent]("Booker.MarkerBranchAdded"),
+			MessageBranchUpdated:  event.New[*MessageBranchUpdatedEvent]("Booker.MessageBranchUpdated"),
+			Error:                 event.New[error]("Booker.Error"),
 		},
 		tangle:         tangle,
 		MarkersManager: NewBranchMarkersMapper(tangle),
-		bookerQueue:    make(chan MessageID, bookerQueueSize),
-		shutdown:       make(chan struct{}),
+		workerPool: tangle.Options.WorkerPools.CreatePool(BookerWorkerPoolName, workerpools.PriorityCritical, workerpools.PoolParams{
+			WorkerCount: 1,
+			QueueSize:   1024,
+		}),
+		parkedMessages:       make(map[MessageID]*parkedMessage),
+		parkedMessageStorage: tangle.Options.Store.WithRealm([]byte{database.PrefixTangle, PrefixParkedMessages}),
 	}
 
-	messageBooker.run()
-
 	return
 }
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (b *Booker) Setup() {
-	b.tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(messageID MessageID) {
-		b.bookerQueue <- messageID
+	b.tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(messageID MessageID) {
+		b.workerPool.Submit(func() {
+			b.book(messageID)
+			b.processPendingMessages()
+		})
+	}))
+
+	b.tangle.LedgerState.UTXODAG.Events().TransactionUnparked.Attach(event.NewClosure(func(transactionID ledgerstate.TransactionID) {
+		b.tangle.Storage.Attachments(transactionID).Consume(func(attachment *Attachment) {
+			b.queuePendingMessage(&b.unparkedMessages, attachment.MessageID())
+		})
 	}))
 
-	b.tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(messageID MessageID) {
+	b.tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(func(messageID MessageID) {
 		b.tangle.Storage.Message(messageID).Consume(func(message *Message) {
 			nodeID := identity.NewID(message.IssuerPublicKey())
-			b.MarkersManager.discardedNodes[nodeID] = time.Now()
+			b.MarkersManager.discardedNodes[nodeID] = b.tangle.Options.Clock.Now()
 		})
 	}))
 
-	b.tangle.LedgerState.UTXODAG.Events().TransactionBranchIDUpdatedByFork.Attach(events.NewClosure(func(event *ledgerstate.TransactionBranchIDUpdatedByForkEvent) {
+	b.tangle.LedgerState.UTXODAG.Events().TransactionBranchIDUpdatedByFork.Attach(event.NewClosure(func(event *ledgerstate.TransactionBranchIDUpdatedByForkEvent) {
 		if err := b.PropagateForkedBranch(event.TransactionID, event.ForkedBranchID); err != nil {
 			b.Events.Error.Trigger(errors.Errorf("failed to propagate Branch update of %s to tangle: %w", event.TransactionID, err))
 		}
 	}))
 }
 
-func (b *Booker) run() {
-	b.shutdownWG.Add(1)
-
-	go func() {
-		defer b.shutdownWG.Done()
-		for {
-			select {
-			case messageID := <-b.bookerQueue:
-				if err := b.BookMessage(messageID); err != nil {
-					b.Events.Error.Trigger(errors.Errorf("failed to book message with %s: %w", messageID, err))
-				}
-			case <-b.shutdown:
-				// wait until all messages are booked
-				if len(b.bookerQueue) == 0 {
-					return
-				}
-			}
-		}
-	}()
-}
-
 // MessageBranchIDs returns the BranchIDs of the given Message.
 func (b *Booker) MessageBranchIDs(messageID MessageID) (branchIDs ledgerstate.BranchIDs, err error) {
 	if messageID == EmptyMessageID {
@@ -138,12 +153,35 @@ func (b *Booker) PayloadBranchIDs(messageID MessageID) (branchIDs ledgerstate.Br
 	return
 }
 
-// Shutdown shuts down the Booker and persists its state.
+// ParkedMessagesCount returns the number of Messages that can not be booked until the conflicts of their (or their
+// parents') Transactions are resolved.
+func (b *Booker) ParkedMessagesCount() int {
+	b.parkedMessagesMutex.Lock()
+	defer b.parkedMessagesMutex.Unlock()
+
+	return len(b.parkedMessages)
+}
+
+// Shutdown shuts down the Booker after the pending Messages were booked. The parked Messages stay persisted, so that
+// they are restored after a restart.
 func (b *Booker) Shutdown() {
-	close(b.shutdown)
-	b.shutdownWG.Wait()
+	b.workerPool.Shutdown()
+
+	b.pendingMessagesMutex.Lock()
+	b.shutdown = true
+	if b.pendingMessagesRetry != nil {
+		b.pendingMessagesRetry.Stop()
+	}
+	b.pendingMessagesMutex.Unlock()
+
+	b.parkedMessagesMutex.Lock()
+	defer b.parkedMessagesMutex.Unlock()
 
-	b.MarkersManager.Shutdown()
+	for _, parked := range b.parkedMessages {
+		if parked.expiry != nil {
+			parked.expiry.Stop()
+		}
+	}
 }
 
 // region BOOK LOGIC ///////////////////////////////////////////////////////////////////////////////////////////////////
@@ -157,6 +195,16 @@ func (b *Booker) Shutdown() {
 func (b *Booker) BookMessage(messageID MessageID) (err error) {
 	b.tangle.Storage.Message(messageID).Consume(func(message *Message) {
 		b.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			if messageMetadata.IsBooked() || messageMetadata.IsOrphaned() || b.parkBehindParents(message) {
+				return
+			}
+
+			// the children of expired parked Messages can never be booked
+			if b.hasExpiredParent(message) {
+				messageMetadata.SetOrphaned(true, b.tangle.Options.Clock.Now())
+				return
+			}
+
 			// TODO: we need to enforce that the dislike references contain "the other" branch with respect to the strong references
 			// it should be done as part of the solidification refactor, as a payload can only be solid if all its inputs are solid,
 			// therefore we would know the payload branch from the solidifier and we could check for this
@@ -171,12 +219,30 @@ func (b *Booker) BookMessage(messageID MessageID) (err error) {
 				}
 			}
 
+			if !b.tangle.PayloadValidator.PayloadValid(message, messageMetadata) {
+				return
+			}
+
+			// the max depth depends on the local confirmation progress, so the message is only subjectively invalid: it
+			// is still booked (so that its future cone is not blocked) but never scheduled, gossiped or selected as a tip.
+			// The decision is stored in the metadata, so that these components agree while the confirmation moves on.
+			if b.isBelowMaxDepth(message) && messageMetadata.SetBelowMaxDepth(true) {
+				messageMetadata.SetSubjectivelyInvalid(true)
+				b.Events.MessageBelowMaxDepth.Trigger(messageID)
+			}
+
 			if err = b.inheritBranchIDs(message, messageMetadata); err != nil {
+				if errors.Is(err, ledgerstate.ErrTransactionParked) {
+					b.park(messageID)
+					err = nil
+					return
+				}
+
 				err = errors.Errorf("failed to inherit BranchIDs of Message with %s: %w", messageID, err)
 				return
 			}
 
-			messageMetadata.SetBooked(true)
+			messageMetadata.SetBooked(true, b.tangle.Options.Clock.Now())
 
 			b.Events.MessageBooked.Trigger(message.ID())
 		})
@@ -185,6 +251,239 @@ func (b *Booker) BookMessage(messageID MessageID) (err error) {
 	return
 }
 
+// book books the given Message and the Messages that were parked behind it and reports the occurring errors.
+func (b *Booker) book(messageID MessageID) {
+	for bookingQueue := []MessageID{messageID}; len(bookingQueue) != 0; bookingQueue = bookingQueue[1:] {
+		currentMessageID := bookingQueue[0]
+		if err := b.BookMessage(currentMessageID); err != nil {
+			b.Events.Error.Trigger(errors.Errorf("failed to book message with %s: %w", currentMessageID, err))
+			continue
+		}
+
+		bookingQueue = append(bookingQueue, b.unparkChildren(currentMessageID)...)
+	}
+}
+
+// park marks the given Message as parked, so that its children wait until it was booked.
+func (b *Booker) park(messageID MessageID) {
+	parkedTime := b.tangle.Options.Clock.Now()
+
+	b.parkedMessagesMutex.Lock()
+	newlyParked := b.addParkedMessage(messageID, parkedTime)
+	if newlyParked {
+		if err := b.parkedMessageStorage.Set(messageID.Bytes(), marshalutil.New(marshalutil.TimeSize).WriteTime(parkedTime).Bytes()); err != nil {
+			b.Events.Error.Trigger(errors.Errorf("failed to persist parked Message with %s: %w", messageID, err))
+		}
+	}
+	b.parkedMessagesMutex.Unlock()
+
+	if newlyParked {
+		b.Events.MessageParked.Trigger(messageID)
+	}
+}
+
+// addParkedMessage adds the given Message to the parked Messages and schedules its expiry at the end of the parking
+// timeout. It expects the parkedMessagesMutex to be locked.
+func (b *Booker) addParkedMessage(messageID MessageID, parkedTime time.Time) (added bool) {
+	if _, exists := b.parkedMessages[messageID]; exists {
+		return false
+	}
+
+	parked := &parkedMessage{waitingChildren: NewMessageIDs()}
+	if parkingTimeout := b.tangle.Options.LedgerState.ParkingTimeout; parkingTimeout > 0 {
+		parked.expiry = b.tangle.Options.Clock.AfterFunc(parkedTime.Add(parkingTimeout).Sub(b.tangle.Options.Clock.Now()), func() {
+			b.queuePendingMessage(&b.expiredMessages, messageID)
+		})
+	}
+	b.parkedMessages[messageID] = parked
+
+	return true
+}
+
+// removeParkedMessage removes the given Message from the parked Messages and returns the children that were waiting
+// for it. It expects the parkedMessagesMutex to be locked.
+func (b *Booker) removeParkedMessage(messageID MessageID) (waitingChildren MessageIDs, removed bool) {
+	parked, exists := b.parkedMessages[messageID]
+	if !exists {
+		return nil, false
+	}
+
+	if parked.expiry != nil {
+		parked.expiry.Stop()
+	}
+	delete(b.parkedMessages, messageID)
+	if err := b.parkedMessageStorage.Delete(messageID.Bytes()); err != nil {
+		b.Events.Error.Trigger(errors.Errorf("failed to delete parked Message with %s: %w", messageID, err))
+	}
+
+	return parked.waitingChildren, true
+}
+
+// parkBehindParents parks the given Message if any of its parents is parked and returns true if it was parked.
+func (b *Booker) parkBehindParents(message *Message) (parked bool) {
+	b.parkedMessagesMutex.Lock()
+	message.ForEachParent(func(parent Parent) {
+		if parkedParent, exists := b.parkedMessages[parent.ID]; exists {
+			parkedParent.waitingChildren.Add(message.ID())
+			parked = true
+		}
+	})
+	b.parkedMessagesMutex.Unlock()
+
+	if parked {
+		b.park(message.ID())
+	}
+
+	return parked
+}
+
+// unparkChildren releases the given Message if it was booked and returns the children that were waiting for it.
+func (b *Booker) unparkChildren(messageID MessageID) (children []MessageID) {
+	b.parkedMessagesMutex.Lock()
+	defer b.parkedMessagesMutex.Unlock()
+
+	if _, exists := b.parkedMessages[messageID]; !exists {
+		return nil
+	}
+
+	booked := false
+	b.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		booked = messageMetadata.IsBooked()
+	})
+	if !booked {
+		return nil
+	}
+
+	waitingChildren, _ := b.removeParkedMessage(messageID)
+
+	return waitingChildren.Slice()
+}
+
+// hasExpiredParent checks whether one of the parents of the given Message is a parked Message that expired.
+func (b *Booker) hasExpiredParent(message *Message) (expiredParent bool) {
+	message.ForEachParent(func(parent Parent) {
+		b.tangle.Storage.MessageMetadata(parent.ID).Consume(func(messageMetadata *MessageMetadata) {
+			expiredParent = expiredParent || (messageMetadata.IsOrphaned() && !messageMetadata.IsBooked())
+		})
+	})
+
+	return expiredParent
+}
+
+// expireParkedMessage marks the given parked Message and the Messages that wait for it as orphaned, as the conflict of
+// its Transaction was not resolved within the parking timeout. The parked Transactions of the Messages are discarded.
+func (b *Booker) expireParkedMessage(messageID MessageID) {
+	for expiryQueue := []MessageID{messageID}; len(expiryQueue) != 0; expiryQueue = expiryQueue[1:] {
+		currentMessageID := expiryQueue[0]
+
+		b.parkedMessagesMutex.Lock()
+		waitingChildren, removed := b.removeParkedMessage(currentMessageID)
+		b.parkedMessagesMutex.Unlock()
+		if !removed {
+			continue
+		}
+
+		b.tangle.Storage.Message(currentMessageID).Consume(func(message *Message) {
+			if transaction, isTransaction := message.Payload().(*ledgerstate.Transaction); isTransaction {
+				b.tangle.LedgerState.UTXODAG.DiscardParkedTransaction(transaction)
+			}
+		})
+		b.tangle.Storage.MessageMetadata(currentMessageID).Consume(func(messageMetadata *MessageMetadata) {
+			messageMetadata.SetOrphaned(true, b.tangle.Options.Clock.Now())
+		})
+		b.Events.ParkedMessageExpired.Trigger(currentMessageID)
+
+		expiryQueue = append(expiryQueue, waitingChildren.Slice()...)
+	}
+}
+
+// restoreParkedMessages restores the parked Messages of the previous run and books them again, so that the children
+// that wait for them are parked again (or booked if the conflicts were resolved in the meantime).
+func (b *Booker) restoreParkedMessages() {
+	restoredMessageIDs := make([]MessageID, 0)
+
+	b.parkedMessagesMutex.Lock()
+	if err := b.parkedMessageStorage.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		messageID, _, err := MessageIDFromBytes(key)
+		if err != nil {
+			b.Events.Error.Trigger(errors.Errorf("failed to parse parked Message: %w", err))
+			return true
+		}
+		parkedTime, err := marshalutil.New(value).ReadTime()
+		if err != nil {
+			b.Events.Error.Trigger(errors.Errorf("failed to parse parking time of Message with %s: %w", messageID, err))
+			return true
+		}
+
+		b.addParkedMessage(messageID, parkedTime)
+		restoredMessageIDs = append(restoredMessageIDs, messageID)
+
+		return true
+	}); err != nil {
+		b.Events.Error.Trigger(errors.Errorf("failed to restore parked Messages: %w", err))
+	}
+	b.parkedMessagesMutex.Unlock()
+
+	for _, messageID := range restoredMessageIDs {
+		b.queuePendingMessage(&b.unparkedMessages, messageID)
+	}
+}
+
+// queuePendingMessage adds the given Message to the given list of pending Messages and makes sure that the worker of
+// the Booker processes them. It never blocks: if the queue of the Booker is full, the submission is retried after the
+// pendingMessagesRetryInterval.
+func (b *Booker) queuePendingMessage(pendingMessages *[]MessageID, messageID MessageID) {
+	b.pendingMessagesMutex.Lock()
+	defer b.pendingMessagesMutex.Unlock()
+
+	*pendingMessages = append(*pendingMessages, messageID)
+	b.schedulePendingMessages()
+}
+
+// schedulePendingMessages hands the pending Messages to the worker of the Booker, or retries it through the clock if
+// the queue of the Booker is full. It expects the pendingMessagesMutex to be locked.
+func (b *Booker) schedulePendingMessages() {
+	if b.pendingMessagesScheduled || b.shutdown {
+		return
+	}
+
+	b.pendingMessagesScheduled = true
+	if b.workerPool.TrySubmit(b.processPendingMessages) {
+		return
+	}
+
+	b.pendingMessagesRetry = b.tangle.Options.Clock.AfterFunc(pendingMessagesRetryInterval, func() {
+		b.pendingMessagesMutex.Lock()
+		defer b.pendingMessagesMutex.Unlock()
+
+		b.pendingMessagesScheduled = false
+		b.pendingMessagesRetry = nil
+		b.schedulePendingMessages()
+	})
+}
+
+// processPendingMessages expires the expired parked Messages and books the Messages whose Transactions were unparked.
+func (b *Booker) processPendingMessages() {
+	for {
+		b.pendingMessagesMutex.Lock()
+		unparkedMessages, expiredMessages := b.unparkedMessages, b.expiredMessages
+		b.unparkedMessages, b.expiredMessages = nil, nil
+		b.pendingMessagesScheduled = false
+		b.pendingMessagesMutex.Unlock()
+
+		if len(unparkedMessages) == 0 && len(expiredMessages) == 0 {
+			return
+		}
+
+		for _, messageID := range expiredMessages {
+			b.expireParkedMessage(messageID)
+		}
+		for _, messageID := range unparkedMessages {
+			b.book(messageID)
+		}
+	}
+}
+
 func (b *Booker) inheritBranchIDs(message *Message, messageMetadata *MessageMetadata) (err error) {
 	structureDetails, _, inheritedBranchIDs, bookingDetailsErr := b.determineBookingDetails(message)
 	if bookingDetailsErr != nil {
@@ -228,6 +527,37 @@ func (b *Booker) inheritBranchIDs(message *Message, messageMetadata *MessageMeta
 	return nil
 }
 
+// isBelowMaxDepth checks whether all Markers that the given Message inherits from its strong parents lie more than
+// MaxDepth Markers behind the first unconfirmed Marker of their Sequence, i.e. whether the Message attaches deep inside
+// the confirmed part of the Tangle. It only looks at the past Markers of the parents, so that the check doesn't need to
+// walk the past cone of the Message.
+func (b *Booker) isBelowMaxDepth(message *Message) (belowMaxDepth bool) {
+	maxDepth := markers.Index(b.tangle.Options.MaxDepth)
+	if maxDepth == 0 {
+		return false
+	}
+
+	message.ForEachParentByType(StrongParentType, func(parentMessageID MessageID) bool {
+		// parents without past Markers (like the genesis) are never below max depth
+		belowMaxDepth = false
+		b.tangle.Storage.MessageMetadata(parentMessageID).Consume(func(messageMetadata *MessageMetadata) {
+			structureDetails := messageMetadata.StructureDetails()
+			if structureDetails == nil {
+				return
+			}
+
+			structureDetails.PastMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+				belowMaxDepth = index+maxDepth < b.tangle.ConfirmationOracle.FirstUnconfirmedMarkerIndex(sequenceID)
+				return belowMaxDepth
+			})
+		})
+
+		return belowMaxDepth
+	})
+
+	return belowMaxDepth
+}
+
 // determineBookingDetails determines the booking details of an unbooked Message.
 func (b *Booker) determineBookingDetails(message *Message) (parentsStructureDetails []*markers.StructureDetails, parentsPastMarkersBranchIDs, inheritedBranchIDs ledgerstate.BranchIDs, err error) {
 	branchIDsOfPayload, bookingErr := b.bookPayload(message)
@@ -237,7 +567,7 @@ func (b *Booker) determineBookingDetails(message *Message) (parentsStructureDeta
 
 	parentsStructureDetails, parentsPastMarkersBranchIDs, strongParentsBranchIDs, bookingDetailsErr := b.collectStrongParentsBookingDetails(message)
 	if bookingDetailsErr != nil {
-		err = errors.Errorf("failed to retrieve booking details of parents of Message with %s: %w", message.ID(), bookingErr)
+		err = errors.Errorf("failed to retrieve booking details of parents of Message with %s: %w", message.ID(), bookingDetailsErr)
 		return
 	}
 
@@ -291,7 +621,14 @@ func (b *Booker) allMessagesContainTransactions(messageIDs MessageIDs) (areAllTr
 func (b *Booker) messageBookingDetails(messageID MessageID) (structureDetails *markers.StructureDetails, pastMarkersBranchIDs, messageBranchIDs ledgerstate.BranchIDs, err error) {
 	pastMarkersBranchIDs = ledgerstate.NewBranchIDs()
 	messageBranchIDs = ledgerstate.NewBranchIDs()
+	structureDetails, err = b.collectMessageBookingDetails(messageID, pastMarkersBranchIDs, messageBranchIDs)
+
+	return structureDetails, pastMarkersBranchIDs, messageBranchIDs, err
+}
 
+// collectMessageBookingDetails returns the StructureDetails of the given Message and adds the BranchIDs of its past
+// Markers and of the Message itself to the given (empty) BranchIDs.
+func (b *Booker) collectMessageBookingDetails(messageID MessageID, pastMarkersBranchIDs, messageBranchIDs ledgerstate.BranchIDs) (structureDetails *markers.StructureDetails, err error) {
 	if !b.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
 		structureDetails = messageMetadata.StructureDetails()
 		if structureDetails == nil {
@@ -299,13 +636,11 @@ func (b *Booker) messageBookingDetails(messageID MessageID) (structureDetails *m
 			return
 		}
 
-		structureDetailsBranchIDs, structureDetailsBranchIDsErr := b.branchIDsFromStructureDetails(structureDetails)
-		if structureDetailsBranchIDsErr != nil {
+		if structureDetailsBranchIDsErr := b.collectBranchIDsFromStructureDetails(structureDetails, pastMarkersBranchIDs); structureDetailsBranchIDsErr != nil {
 			err = errors.Errorf("failed to retrieve BranchIDs from Structure Details %s: %w", structureDetails, structureDetailsBranchIDsErr)
 			return
 		}
-		pastMarkersBranchIDs.AddAll(structureDetailsBranchIDs)
-		messageBranchIDs.AddAll(structureDetailsBranchIDs)
+		messageBranchIDs.AddAll(pastMarkersBranchIDs)
 
 		if addedBranchIDs := messageMetadata.AddedBranchIDs(); len(addedBranchIDs) > 0 {
 			messageBranchIDs.AddAll(addedBranchIDs)
@@ -318,12 +653,20 @@ func (b *Booker) messageBookingDetails(messageID MessageID) (structureDetails *m
 		err = errors.Errorf("failed to retrieve MessageMetadata with %s: %w", messageID, cerrors.ErrFatal)
 	}
 
-	return structureDetails, pastMarkersBranchIDs, messageBranchIDs, err
+	return structureDetails, err
 }
 
 // branchIDsFromStructureDetails returns the BranchIDs from StructureDetails.
 func (b *Booker) branchIDsFromStructureDetails(structureDetails *markers.StructureDetails) (structureDetailsBranchIDs ledgerstate.BranchIDs, err error) {
 	structureDetailsBranchIDs = ledgerstate.NewBranchIDs()
+	err = b.collectBranchIDsFromStructureDetails(structureDetails, structureDetailsBranchIDs)
+
+	return
+}
+
+// collectBranchIDsFromStructureDetails adds the pending BranchIDs of the past Markers of the given StructureDetails to
+// the given BranchIDs.
+func (b *Booker) collectBranchIDsFromStructureDetails(structureDetails *markers.StructureDetails, structureDetailsBranchIDs ledgerstate.BranchIDs) (err error) {
 	// obtain all the Markers
 	structureDetails.PastMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
 		branchIDs, branchIDsErr := b.MarkersManager.PendingBranchIDs(markers.NewMarker(sequenceID, index))
@@ -340,22 +683,31 @@ func (b *Booker) branchIDsFromStructureDetails(structureDetails *markers.Structu
 	return
 }
 
-// collectStrongParentsBookingDetails returns the booking details of a Message's strong parents.
+// collectStrongParentsBookingDetails returns the booking details of a Message's strong parents. The BranchIDs of the
+// single parents are only needed until they were added to the collected BranchIDs, so they are taken from a Pool.
 func (b *Booker) collectStrongParentsBookingDetails(message *Message) (parentsStructureDetails []*markers.StructureDetails, parentsPastMarkersBranchIDs, parentsBranchIDs ledgerstate.BranchIDs, err error) {
 	parentsStructureDetails = make([]*markers.StructureDetails, 0)
 	parentsPastMarkersBranchIDs = ledgerstate.NewBranchIDs()
 	parentsBranchIDs = ledgerstate.NewBranchIDs()
 
+	parentPastMarkersBranchIDs := parentBranchIDsPool.Get()
+	defer parentBranchIDsPool.Put(parentPastMarkersBranchIDs)
+	parentBranchIDs := parentBranchIDsPool.Get()
+	defer parentBranchIDsPool.Put(parentBranchIDs)
+
 	message.ForEachParentByType(StrongParentType, func(parentMessageID MessageID) bool {
-		parentStructureDetails, parentPastMarkersBranchIDs, parentBranchIDs, parentErr := b.messageBookingDetails(parentMessageID)
+		resetBranchIDs(parentPastMarkersBranchIDs)
+		resetBranchIDs(parentBranchIDs)
+
+		parentStructureDetails, parentErr := b.collectMessageBookingDetails(parentMessageID, *parentPastMarkersBranchIDs, *parentBranchIDs)
 		if parentErr != nil {
 			err = errors.Errorf("failed to retrieve booking details of Message with %s: %w", parentMessageID, parentErr)
 			return false
 		}
 
 		parentsStructureDetails = append(parentsStructureDetails, parentStructureDetails)
-		parentsPastMarkersBranchIDs.AddAll(parentPastMarkersBranchIDs)
-		parentsBranchIDs.AddAll(parentBranchIDs)
+		parentsPastMarkersBranchIDs.AddAll(*parentPastMarkersBranchIDs)
+		parentsBranchIDs.AddAll(*parentBranchIDs)
 
 		return true
 	})
@@ -476,13 +828,12 @@ func (b *Booker) bookPayload(message *Message) (branchIDs ledgerstate.BranchIDs,
 
 	transaction := payload.(*ledgerstate.Transaction)
 
-	if transactionErr := b.tangle.LedgerState.TransactionValid(transaction, message.ID()); transactionErr != nil {
-		return nil, errors.Errorf("invalid transaction in message with %s: %w", message.ID(), transactionErr)
-	}
-
-	transactionBranchIDs, err := b.tangle.LedgerState.BookTransaction(transaction, message.ID())
-	if err != nil {
-		return nil, errors.Errorf("failed to book Transaction of Message with %s: %w", message.ID(), err)
+	// the identical Transaction of a reattachment was already validated and booked by an earlier attachment
+	transactionBranchIDs, reattached := b.bookedTransactionBranchIDs(transaction.ID())
+	if !reattached {
+		if transactionBranchIDs, err = b.bookTransaction(transaction, message.ID()); err != nil {
+			return nil, err
+		}
 	}
 
 	branchIDs, err = b.tangle.LedgerState.ResolvePendingBranchIDs(transactionBranchIDs)
@@ -490,17 +841,54 @@ func (b *Booker) bookPayload(message *Message) (branchIDs ledgerstate.BranchIDs,
 		return nil, errors.Errorf("failed to resolve pending Branches of aggregated %s: %w", transactionBranchIDs, err)
 	}
 
-	for _, output := range transaction.Essence().Outputs() {
-		b.tangle.LedgerState.UTXODAG.ManageStoreAddressOutputMapping(output)
-	}
-
 	if attachment, stored := b.tangle.Storage.StoreAttachment(transaction.ID(), message.ID()); stored {
 		attachment.Release()
 	}
 
+	if reattached {
+		b.Events.TransactionReattached.Trigger(&TransactionReattachedEvent{
+			TransactionID: transaction.ID(),
+			MessageID:     message.ID(),
+		})
+	}
+
 	return branchIDs, nil
 }
 
+// bookTransaction validates the given Transaction and books it into the ledger state.
+func (b *Booker) bookTransaction(transaction *ledgerstate.Transaction, messageID MessageID) (transactionBranchIDs ledgerstate.BranchIDs, err error) {
+	if transactionErr := b.tangle.LedgerState.TransactionValid(transaction, messageID); transactionErr != nil {
+		return nil, errors.Errorf("invalid transaction in message with %s: %w", messageID, transactionErr)
+	}
+
+	if transactionBranchIDs, err = b.tangle.LedgerState.BookTransaction(transaction, messageID); err != nil {
+		// the attachments of parked Transactions are booked again once the Transaction is released
+		if errors.Is(err, ledgerstate.ErrTransactionParked) {
+			if attachment, stored := b.tangle.Storage.StoreAttachment(transaction.ID(), messageID); stored {
+				attachment.Release()
+			}
+		}
+
+		return nil, errors.Errorf("failed to book Transaction of Message with %s: %w", messageID, err)
+	}
+
+	for _, output := range transaction.Essence().Outputs() {
+		b.tangle.LedgerState.UTXODAG.ManageStoreAddressOutputMapping(output)
+	}
+
+	return transactionBranchIDs, nil
+}
+
+// bookedTransactionBranchIDs returns the BranchIDs of the given Transaction if it was already booked into the ledger
+// state.
+func (b *Booker) bookedTransactionBranchIDs(transactionID ledgerstate.TransactionID) (branchIDs ledgerstate.BranchIDs, booked bool) {
+	b.tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		branchIDs = transactionMetadata.BranchIDs()
+	})
+
+	return branchIDs, len(branchIDs) != 0
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region FORK LOGIC ///////////////////////////////////////////////////////////////////////////////////////////////////
@@ -524,7 +912,10 @@ func (b *Booker) PropagateForkedBranch(transactionID ledgerstate.TransactionID,
 			return
 		}
 
-		b.Events.MessageBranchUpdated.Trigger(messageMetadata.ID(), forkedBranchID)
+		b.Events.MessageBranchUpdated.Trigger(&MessageBranchUpdatedEvent{
+			MessageID:   messageMetadata.ID(),
+			NewBranchID: forkedBranchID,
+		})
 
 		for approvingMessageID := range b.tangle.Utils.ApprovingMessageIDs(messageMetadata.ID(), StrongApprover) {
 			messageWalker.Push(approvingMessageID)
@@ -569,7 +960,11 @@ func (b *Booker) forkSingleMarker(currentMarker *markers.Marker, newBranchID led
 	}
 
 	// trigger event
-	b.Events.MarkerBranchAdded.Trigger(currentMarker, oldBranchIDs, newBranchID)
+	b.Events.MarkerBranchAdded.Trigger(&MarkerBranchAddedEvent{
+		Marker:       currentMarker,
+		OldBranchIDs: oldBranchIDs,
+		NewBranchID:  newBranchID,
+	})
 
 	// propagate updates to later BranchID mappings of the same sequence.
 	b.MarkersManager.ForEachBranchIDMapping(currentMarker.SequenceID(), currentMarker.Index(), func(mappedMarker *markers.Marker, _ ledgerstate.BranchIDs) {
@@ -588,29 +983,91 @@ func (b *Booker) forkSingleMarker(currentMarker *markers.Marker, newBranchID led
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region parkedMessage ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// parkedMessage contains the children that wait for a parked Message and the scheduled expiry of the Message.
+type parkedMessage struct {
+	waitingChildren MessageIDs
+	expiry          clock.Timer
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region BookerEvents /////////////////////////////////////////////////////////////////////////////////////////////////
 
 // BookerEvents represents events happening in the Booker.
 type BookerEvents struct {
 	// MessageBooked is triggered when a Message was booked (it's Branch, and it's Payload's Branch were determined).
-	MessageBooked *events.Event
+	MessageBooked *event.Event[MessageID]
+
+	// MessageParked is triggered when a Message can not be booked until the conflict of its (or its parents')
+	// Transaction is resolved.
+	MessageParked *event.Event[MessageID]
+
+	// ParkedMessageExpired is triggered when a parked Message is marked as orphaned because the conflict of its (or its
+	// parents') Transaction was not resolved within the parking timeout.
+	ParkedMessageExpired *event.Event[MessageID]
+
+	// MessageBelowMaxDepth is triggered when a Message is subjectively invalid because it attaches too deep inside the
+	// confirmed part of the Tangle.
+	MessageBelowMaxDepth *event.Event[MessageID]
+
+	// TransactionReattached is triggered when a Message was booked whose Transaction was already booked by another
+	// Message.
+	TransactionReattached *event.Event[*TransactionReattachedEvent]
 
 	// MessageBranchUpdated is triggered when the BranchID of a Message is changed in its MessageMetadata.
-	MessageBranchUpdated *events.Event
+	MessageBranchUpdated *event.Event[*MessageBranchUpdatedEvent]
 
 	// MarkerBranchAdded is triggered when a Marker is mapped to a new BranchID.
-	MarkerBranchAdded *events.Event
+	MarkerBranchAdded *event.Event[*MarkerBranchAddedEvent]
 
 	// Error gets triggered when the Booker faces an unexpected error.
-	Error *events.Event
+	Error *event.Event[error]
+}
+
+// TransactionReattachedEvent holds the information provided by the TransactionReattached event.
+type TransactionReattachedEvent struct {
+	// TransactionID contains the identifier of the Transaction that was reattached.
+	TransactionID ledgerstate.TransactionID
+	// MessageID contains the identifier of the Message that reattached the Transaction.
+	MessageID MessageID
+}
+
+// MessageBranchUpdatedEvent holds the information provided by the MessageBranchUpdated event.
+type MessageBranchUpdatedEvent struct {
+	// MessageID contains the identifier of the Message whose BranchID was updated.
+	MessageID MessageID
+	// NewBranchID contains the BranchID that was added to the Message.
+	NewBranchID ledgerstate.BranchID
 }
 
-func markerBranchUpdatedCaller(handler interface{}, params ...interface{}) {
-	handler.(func(marker *markers.Marker, oldBranchID ledgerstate.BranchIDs, newBranchID ledgerstate.BranchID))(params[0].(*markers.Marker), params[1].(ledgerstate.BranchIDs), params[2].(ledgerstate.BranchID))
+// MarkerBranchAddedEvent holds the information provided by the MarkerBranchAdded event.
+type MarkerBranchAddedEvent struct {
+	// Marker contains the Marker that was mapped to a new BranchID.
+	Marker *markers.Marker
+	// OldBranchIDs contains the BranchIDs that the Marker was mapped to before.
+	OldBranchIDs ledgerstate.BranchIDs
+	// NewBranchID contains the BranchID that was added to the Marker.
+	NewBranchID ledgerstate.BranchID
 }
 
-func messageBranchUpdatedCaller(handler interface{}, params ...interface{}) {
-	handler.(func(messageID MessageID, newBranchID ledgerstate.BranchID))(params[0].(MessageID), params[1].(ledgerstate.BranchID))
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region parentBranchIDsPool //////////////////////////////////////////////////////////////////////////////////////////
+
+// parentBranchIDsPool contains the BranchIDs that are used to collect the booking details of the single parents of a
+// Message.
+var parentBranchIDsPool = pool.New(func() *ledgerstate.BranchIDs {
+	branchIDs := ledgerstate.NewBranchIDs()
+	return &branchIDs
+}, resetBranchIDs)
+
+// resetBranchIDs removes all BranchIDs from the given BranchIDs while keeping the allocated map.
+func resetBranchIDs(branchIDs *ledgerstate.BranchIDs) {
+	for branchID := range *branchIDs {
+		delete(*branchIDs, branchID)
+	}
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
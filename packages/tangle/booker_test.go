@@ -4,11 +4,17 @@ package tangle
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
 )
 
 func TestScenario_1(t *testing.T) {
@@ -41,7 +47,7 @@ func TestScenario_1(t *testing.T) {
 
 	testFramework.IssueMessages("Message1", "Message2", "Message3", "Message4", "Message5", "Message6").WaitMessagesBooked()
 	testFramework.IssueMessages("Message8").WaitMessagesBooked()
-	testFramework.IssueMessages("Message7", "Message9").WaitMessagesBooked()
+	testFramework.IssueMessages("Message7", "Message9").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 	// Message8 combines conflicting branches on UTXO level
 	for _, messageAlias := range []string{"Message8"} {
@@ -67,6 +73,43 @@ func TestScenario_1(t *testing.T) {
 	})
 }
 
+func TestBooker_Reattachment(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	testFramework := NewMessageTestFramework(tangle, WithGenesisOutput("G", 3))
+	tangle.Setup()
+
+	var reattachedEvents []*TransactionReattachedEvent
+	tangle.Booker.Events.TransactionReattached.Attach(event.NewClosure(func(event *TransactionReattachedEvent) {
+		reattachedEvents = append(reattachedEvents, event)
+	}))
+
+	testFramework.CreateMessage("Message1", WithStrongParents("Genesis"), WithInputs("G"), WithOutput("A", 3))
+	testFramework.CreateMessage("Message2", WithStrongParents("Message1"), WithReattachment("Message1"))
+	testFramework.CreateMessage("Message3", WithStrongParents("Genesis"), WithReattachment("Message1"))
+	testFramework.IssueMessages("Message1").WaitMessagesBooked()
+	testFramework.IssueMessages("Message2", "Message3").WaitMessagesBooked()
+
+	transactionID := testFramework.Transaction("Message1").ID()
+	require.Len(t, reattachedEvents, 2)
+	for _, reattachedEvent := range reattachedEvents {
+		assert.Equal(t, transactionID, reattachedEvent.TransactionID)
+	}
+	assert.ElementsMatch(t, []MessageID{testFramework.Message("Message2").ID(), testFramework.Message("Message3").ID()}, []MessageID{reattachedEvents[0].MessageID, reattachedEvents[1].MessageID})
+
+	checkBranchIDs(t, testFramework, map[string]ledgerstate.BranchIDs{
+		"Message1": ledgerstate.NewBranchIDs(ledgerstate.MasterBranchID),
+		"Message2": ledgerstate.NewBranchIDs(ledgerstate.MasterBranchID),
+		"Message3": ledgerstate.NewBranchIDs(ledgerstate.MasterBranchID),
+	})
+	assert.Equal(t, NewMessageIDs(testFramework.Message("Message1").ID(), testFramework.Message("Message2").ID(), testFramework.Message("Message3").ID()), tangle.Storage.AttachmentMessageIDs(transactionID))
+
+	canonicalAttachmentID, err := tangle.Utils.CanonicalAttachment(transactionID)
+	require.NoError(t, err)
+	assert.Equal(t, testFramework.Message("Message1").ID(), canonicalAttachmentID)
+}
+
 func TestScenario_2(t *testing.T) {
 	tangle := NewTestTangle(MergeBranches(false))
 	defer tangle.Shutdown()
@@ -1016,7 +1059,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message1
 	{
 		testFramework.CreateMessage("Message1", WithStrongParents("Genesis"), WithInputs("A"), WithOutput("G", 500))
-		testFramework.IssueMessages("Message1").WaitMessagesBooked()
+		testFramework.IssueMessages("Message1").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1036,7 +1079,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 		testFramework.RegisterBranchID("A", "Message1")
 		testFramework.RegisterBranchID("B", "Message2")
 
-		testFramework.IssueMessages("Message2").WaitMessagesBooked()
+		testFramework.IssueMessages("Message2").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1058,7 +1101,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 
 		testFramework.RegisterBranchID("C", "Message3")
 
-		testFramework.IssueMessages("Message3").WaitMessagesBooked()
+		testFramework.IssueMessages("Message3").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1080,7 +1123,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message4
 	{
 		testFramework.CreateMessage("Message4", WithStrongParents("Genesis"), WithInputs("L"), WithOutput("K", 500))
-		testFramework.IssueMessages("Message4").WaitMessagesBooked()
+		testFramework.IssueMessages("Message4").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1105,7 +1148,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message5
 	{
 		testFramework.CreateMessage("Message5", WithStrongParents("Message4"), WithInputs("C"), WithOutput("D", 500))
-		testFramework.IssueMessages("Message5").WaitMessagesBooked()
+		testFramework.IssueMessages("Message5").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1133,7 +1176,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message6
 	{
 		testFramework.CreateMessage("Message6", WithStrongParents("Message1", "Message2"), WithShallowLikeParents("Message2"))
-		testFramework.IssueMessages("Message6").WaitMessagesBooked()
+		testFramework.IssueMessages("Message6").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1164,7 +1207,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message7
 	{
 		testFramework.CreateMessage("Message7", WithStrongParents("Message6", "Message5"))
-		testFramework.IssueMessages("Message7").WaitMessagesBooked()
+		testFramework.IssueMessages("Message7").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1199,7 +1242,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message8", WithStrongParents("Message5", "Message7", "Message3"), WithShallowLikeParents("Message1", "Message3"))
 
-		testFramework.IssueMessages("Message8").WaitMessagesBooked()
+		testFramework.IssueMessages("Message8").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1236,7 +1279,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message9
 	{
 		testFramework.CreateMessage("Message9", WithStrongParents("Message1", "Message7", "Message3"), WithShallowLikeParents("Message1", "Message3"), WithInputs("F"), WithOutput("N", 500))
-		testFramework.IssueMessages("Message9").WaitMessagesBooked()
+		testFramework.IssueMessages("Message9").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1": markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1276,7 +1319,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message10
 	{
 		testFramework.CreateMessage("Message10", WithStrongParents("Message9"), WithShallowLikeParents("Message2"))
-		testFramework.IssueMessages("Message10").WaitMessagesBooked()
+		testFramework.IssueMessages("Message10").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":  markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1320,7 +1363,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message11", WithStrongParents("Message8", "Message9"))
 		testFramework.CreateMessage("Message11.5", WithStrongParents("Message9"))
-		testFramework.IssueMessages("Message11", "Message11.5").WaitMessagesBooked()
+		testFramework.IssueMessages("Message11", "Message11.5").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1373,7 +1416,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 		testFramework.RegisterBranchID("D", "Message5")
 		testFramework.RegisterBranchID("E", "Message12")
 
-		testFramework.IssueMessages("Message12").WaitMessagesBooked()
+		testFramework.IssueMessages("Message12").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1426,7 +1469,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message13", WithStrongParents("Message9"), WithShallowLikeParents("Message2", "Message12"))
 
-		testFramework.IssueMessages("Message13").WaitMessagesBooked()
+		testFramework.IssueMessages("Message13").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1482,7 +1525,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message13.1", WithStrongParents("Message9"), WithShallowLikeParents("Message2", "Message12"))
 
-		testFramework.IssueMessages("Message13.1").WaitMessagesBooked()
+		testFramework.IssueMessages("Message13.1").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1540,7 +1583,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	// ISSUE Message14
 	{
 		testFramework.CreateMessage("Message14", WithStrongParents("Message10"), WithShallowLikeParents("Message12"))
-		testFramework.IssueMessages("Message14").WaitMessagesBooked()
+		testFramework.IssueMessages("Message14").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1602,7 +1645,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message15", WithStrongParents("Message9"), WithShallowDislikeParents("Message2", "Message5"))
 
-		testFramework.IssueMessages("Message15").WaitMessagesBooked()
+		testFramework.IssueMessages("Message15").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1667,7 +1710,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message16", WithStrongParents("Message12"), WithInputs("H"), WithOutput("Z", 500))
 
-		testFramework.IssueMessages("Message16").WaitMessagesBooked()
+		testFramework.IssueMessages("Message16").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1738,7 +1781,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 		testFramework.RegisterBranchID("Z", "Message16")
 		testFramework.RegisterBranchID("Y", "Message17")
 
-		testFramework.IssueMessages("Message17").WaitMessagesBooked()
+		testFramework.IssueMessages("Message17").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -1809,7 +1852,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message18", WithStrongParents("Message17", "Message7"))
 
-		testFramework.IssueMessages("Message18").WaitMessagesBooked()
+		testFramework.IssueMessages("Message18").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.True(t, messageMetadata.subjectivelyInvalid)
@@ -1887,7 +1930,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message19", WithStrongParents("Message17"), WithWeakParents("Message7"))
 
-		testFramework.IssueMessages("Message19").WaitMessagesBooked()
+		testFramework.IssueMessages("Message19").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.False(t, messageMetadata.subjectivelyInvalid)
@@ -1968,7 +2011,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message20", WithStrongParents("Message17"), WithWeakParents("Message2"))
 
-		testFramework.IssueMessages("Message20").WaitMessagesBooked()
+		testFramework.IssueMessages("Message20").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.True(t, messageMetadata.subjectivelyInvalid)
@@ -2051,7 +2094,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message21", WithStrongParents("Message17"), WithWeakParents("Message2"), WithShallowDislikeParents("Message12"))
 
-		testFramework.IssueMessages("Message21").WaitMessagesBooked()
+		testFramework.IssueMessages("Message21").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.True(t, messageMetadata.subjectivelyInvalid)
@@ -2138,7 +2181,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message22", WithStrongParents("Message17"), WithWeakParents("Message2"), WithShallowDislikeParents("Message12", "Message1"))
 
-		testFramework.IssueMessages("Message22").WaitMessagesBooked()
+		testFramework.IssueMessages("Message22").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.False(t, messageMetadata.subjectivelyInvalid)
@@ -2228,7 +2271,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message23", WithStrongParents("Message22"), WithShallowLikeParents("Message2"))
 
-		testFramework.IssueMessages("Message23").WaitMessagesBooked()
+		testFramework.IssueMessages("Message23").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.False(t, messageMetadata.subjectivelyInvalid)
@@ -2321,7 +2364,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message24", WithStrongParents("Message23"), WithShallowLikeParents("Message5"))
 
-		testFramework.IssueMessages("Message24").WaitMessagesBooked()
+		testFramework.IssueMessages("Message24").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.True(t, messageMetadata.subjectivelyInvalid)
@@ -2417,7 +2460,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message25", WithStrongParents("Message22"), WithWeakParents("Message5"))
 
-		testFramework.IssueMessages("Message25").WaitMessagesBooked()
+		testFramework.IssueMessages("Message25").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.True(t, messageMetadata.subjectivelyInvalid)
@@ -2516,7 +2559,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message26", WithStrongParents("Message19"))
 
-		testFramework.IssueMessages("Message26").WaitMessagesBooked()
+		testFramework.IssueMessages("Message26").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.False(t, messageMetadata.subjectivelyInvalid)
@@ -2628,7 +2671,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 			assert.Equal(t, branch.InclusionState(), ledgerstate.Rejected)
 		})
 
-		testFramework.IssueMessages("Message27").WaitMessagesBooked()
+		testFramework.IssueMessages("Message27").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.False(t, messageMetadata.IsSubjectivelyInvalid())
@@ -2733,7 +2776,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		msg := testFramework.CreateMessage("Message28", WithStrongParents("Message13.1"))
 
-		testFramework.IssueMessages("Message28").WaitMessagesBooked()
+		testFramework.IssueMessages("Message28").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
 			assert.False(t, messageMetadata.subjectivelyInvalid)
@@ -2841,7 +2884,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 
 		testFramework.CreateMessage("Message29", WithStrongParents("Message5"), WithInputs("D"), WithOutput("H", 500))
-		testFramework.IssueMessages("Message29").WaitMessagesBooked()
+		testFramework.IssueMessages("Message29").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -2960,7 +3003,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 			assert.Equal(t, branch.InclusionState(), ledgerstate.Rejected)
 		})
 
-		testFramework.IssueMessages("Message30").WaitMessagesBooked()
+		testFramework.IssueMessages("Message30").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -3071,7 +3114,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 
 		testFramework.CreateMessage("Message31", WithStrongParents("Message5"), WithShallowLikeParents("Message1", "Message3"), WithInputs("L2"), WithOutput("M", 500))
 
-		testFramework.IssueMessages("Message31").WaitMessagesBooked()
+		testFramework.IssueMessages("Message31").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -3184,7 +3227,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message32", WithStrongParents("Message31"), WithShallowLikeParents("Message16"))
 
-		testFramework.IssueMessages("Message32").WaitMessagesBooked()
+		testFramework.IssueMessages("Message32").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -3300,7 +3343,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 	{
 		testFramework.CreateMessage("Message33", WithStrongParents("Message31"), WithShallowLikeParents("Message16"))
 
-		testFramework.IssueMessages("Message33").WaitMessagesBooked()
+		testFramework.IssueMessages("Message33").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -3426,7 +3469,7 @@ func TestBookerMarkerMappings(t *testing.T) {
 		testFramework.RegisterBranchID("M", "Message31")
 		testFramework.RegisterBranchID("N", "Message34")
 
-		testFramework.IssueMessages("Message34").WaitMessagesBooked()
+		testFramework.IssueMessages("Message34").WaitMessagesBooked().WaitApprovalWeightProcessed()
 
 		checkMarkers(t, testFramework, map[string]*markers.Markers{
 			"Message1":    markers.NewMarkers(markers.NewMarker(0, 1)),
@@ -3932,3 +3975,145 @@ func checkMessageMetadataDiffBranchIDs(t *testing.T, testFramework *MessageTestF
 		}))
 	}
 }
+
+func TestBooker_BelowMaxDepth(t *testing.T) {
+	tangle := NewTestTangle(MaxDepth(2))
+	defer tangle.Shutdown()
+
+	confirmationOracle := &maxDepthConfirmationOracle{}
+	tangle.ConfirmationOracle = confirmationOracle
+
+	testFramework := NewMessageTestFramework(tangle)
+	tangle.Setup()
+
+	var belowMaxDepthMessages []MessageID
+	tangle.Booker.Events.MessageBelowMaxDepth.Attach(event.NewClosure(func(messageID MessageID) {
+		belowMaxDepthMessages = append(belowMaxDepthMessages, messageID)
+	}))
+
+	testFramework.CreateMessage("Message1", WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message2", WithStrongParents("Message1"))
+	testFramework.CreateMessage("Message3", WithStrongParents("Message2"))
+	testFramework.CreateMessage("Message4", WithStrongParents("Message3"))
+	testFramework.IssueMessages("Message1", "Message2", "Message3", "Message4").WaitMessagesBooked()
+
+	for alias, index := range map[string]markers.Index{"Message1": 1, "Message2": 2, "Message3": 3, "Message4": 4} {
+		assert.Equal(t, index, testFramework.MessageMetadata(alias).StructureDetails().PastMarkers.Marker().Index())
+	}
+
+	// the markers up to Message4 are confirmed
+	confirmationOracle.firstUnconfirmedMarkerIndex = 5
+
+	testFramework.CreateMessage("Message5", WithStrongParents("Message1"))
+	testFramework.CreateMessage("Message6", WithStrongParents("Message3"))
+	testFramework.CreateMessage("Message7", WithStrongParents("Message1", "Message4"))
+	testFramework.CreateMessage("Message8", WithStrongParents("Message5", "Message7"))
+	testFramework.IssueMessages("Message5", "Message6", "Message7", "Message8").WaitMessagesBooked()
+
+	// the max depth depends on the local confirmation progress, so the message is only subjectively invalid and its
+	// future cone is still booked
+	assert.Equal(t, []MessageID{testFramework.Message("Message5").ID()}, belowMaxDepthMessages)
+	assert.True(t, testFramework.MessageMetadata("Message5").IsSubjectivelyInvalid())
+	assert.False(t, testFramework.MessageMetadata("Message5").IsObjectivelyInvalid())
+	assert.True(t, testFramework.MessageMetadata("Message5").IsBooked())
+	assert.Zero(t, tangle.Booker.ParkedMessagesCount())
+
+	// messages below max depth are never selected as tips
+	tangle.TipManager.AddTip(testFramework.Message("Message5"))
+	_, isTip := tangle.TipManager.tips.Get(testFramework.Message("Message5").ID())
+	assert.False(t, isTip)
+
+	assert.False(t, testFramework.MessageMetadata("Message8").IsSubjectivelyInvalid())
+	assert.True(t, testFramework.MessageMetadata("Message8").IsBooked())
+	assert.True(t, testFramework.MessageMetadata("Message6").IsBooked())
+	assert.True(t, testFramework.MessageMetadata("Message7").IsBooked())
+
+	// messages below max depth are skipped by the Scheduler without blocking the children that attach to them
+	assert.True(t, testFramework.MessageMetadata("Message5").IsBelowMaxDepth())
+	assert.Eventually(t, func() bool {
+		return testFramework.MessageMetadata("Message8").Scheduled()
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.False(t, testFramework.MessageMetadata("Message5").Scheduled())
+}
+
+func TestBooker_ParkedMessages(t *testing.T) {
+	store := mapdb.NewMapDB()
+	virtualClock := clock.NewVirtualClock(time.Now())
+	tangle := NewTestTangle(Store(store), Clock(virtualClock), MaxConflictingConsumers(1), ParkingTimeout(time.Minute))
+
+	testFramework := NewMessageTestFramework(tangle, WithGenesisOutput("G", 3))
+	tangle.Setup()
+
+	testFramework.CreateMessage("Message1", WithStrongParents("Genesis"), WithInputs("G"), WithOutput("A", 3))
+	testFramework.CreateMessage("Message2", WithStrongParents("Genesis"), WithInputs("G"), WithOutput("B", 3))
+	testFramework.CreateMessage("Message3", WithStrongParents("Message2"))
+	testFramework.IssueMessages("Message1").WaitMessagesBooked()
+
+	// the conflicting Transaction exceeds the limit of conflicting consumers, so it is parked with its child
+	tangle.Storage.StoreMessage(testFramework.Message("Message2"))
+	assert.Eventually(t, func() bool { return tangle.Booker.ParkedMessagesCount() == 1 }, time.Second, 10*time.Millisecond)
+	tangle.Storage.StoreMessage(testFramework.Message("Message3"))
+	assert.Eventually(t, func() bool { return tangle.Booker.ParkedMessagesCount() == 2 }, time.Second, 10*time.Millisecond)
+	tangle.Shutdown()
+
+	// the parked Messages are restored after a restart
+	restartedTangle := NewTestTangle(Store(store), Clock(virtualClock), MaxConflictingConsumers(1), ParkingTimeout(time.Minute))
+	defer restartedTangle.Shutdown()
+	restartedTangle.Setup()
+	assert.Eventually(t, func() bool { return restartedTangle.Booker.ParkedMessagesCount() == 2 }, time.Second, 10*time.Millisecond)
+
+	// the parked Messages expire together with the Messages that wait for them if the conflict is not resolved
+	virtualClock.Advance(time.Minute)
+	assert.Eventually(t, func() bool { return restartedTangle.Booker.ParkedMessagesCount() == 0 }, time.Second, 10*time.Millisecond)
+	for _, alias := range []string{"Message2", "Message3"} {
+		assert.True(t, restartedTangle.Storage.MessageMetadata(testFramework.Message(alias).ID()).Consume(func(messageMetadata *MessageMetadata) {
+			assert.True(t, messageMetadata.IsOrphaned())
+			assert.False(t, messageMetadata.IsBooked())
+		}))
+	}
+	assert.Empty(t, restartedTangle.LedgerState.UTXODAG.UnparkTransactions(testFramework.TransactionID("Message1")))
+}
+
+func TestBooker_PendingMessagesRetry(t *testing.T) {
+	virtualClock := clock.NewVirtualClock(time.Now())
+	workerPools := workerpools.NewManager(0, map[string]workerpools.PoolParams{
+		BookerWorkerPoolName: {WorkerCount: 1, QueueSize: 1},
+	})
+	tangle := NewTestTangle(Clock(virtualClock), WorkerPools(workerPools))
+	defer tangle.Shutdown()
+
+	parkedMessageID := randomMessageID()
+	tangle.Booker.park(parkedMessageID)
+	require.Equal(t, 1, tangle.Booker.ParkedMessagesCount())
+
+	// block the worker of the Booker and fill its queue, so that the pending Messages can not be submitted
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.True(t, tangle.Booker.workerPool.TrySubmit(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+	require.True(t, tangle.Booker.workerPool.TrySubmit(func() {}))
+
+	tangle.Booker.queuePendingMessage(&tangle.Booker.expiredMessages, parkedMessageID)
+	close(release)
+	assert.Eventually(t, func() bool { return tangle.Booker.workerPool.PendingTasks() == 0 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, tangle.Booker.ParkedMessagesCount())
+
+	// the submission is retried through the clock instead of being dropped
+	virtualClock.Advance(pendingMessagesRetryInterval)
+	assert.Eventually(t, func() bool { return tangle.Booker.ParkedMessagesCount() == 0 }, time.Second, 10*time.Millisecond)
+}
+
+// maxDepthConfirmationOracle is a ConfirmationOracle whose first unconfirmed Marker can be set by the test.
+type maxDepthConfirmationOracle struct {
+	MockConfirmationOracle
+
+	firstUnconfirmedMarkerIndex markers.Index
+}
+
+// FirstUnconfirmedMarkerIndex returns the configured Index for every Sequence.
+func (m *maxDepthConfirmationOracle) FirstUnconfirmedMarkerIndex(markers.SequenceID) markers.Index {
+	return m.firstUnconfirmedMarkerIndex
+}
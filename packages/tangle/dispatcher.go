@@ -3,8 +3,9 @@ package tangle
 import (
 	"sync"
 
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/workerpool"
+
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 const (
@@ -27,7 +28,7 @@ type Dispatcher struct {
 func NewDispatcher(tangle *Tangle) (dispatcher *Dispatcher) {
 	dispatcher = &Dispatcher{
 		Events: &DispatcherEvents{
-			MessageDispatched: events.NewEvent(MessageIDCaller),
+			MessageDispatched: event.New[MessageID]("Dispatcher.MessageDispatched"),
 		},
 		tangle: tangle,
 	}
@@ -44,7 +45,7 @@ func NewDispatcher(tangle *Tangle) (dispatcher *Dispatcher) {
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (d *Dispatcher) Setup() {
-	d.tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(d.onMessageScheduled))
+	d.tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(d.onMessageScheduled))
 }
 
 // Shutdown shuts down the Dispatcher.
@@ -65,7 +66,7 @@ func (d *Dispatcher) onMessageScheduled(messageID MessageID) {
 // DispatcherEvents represents events happening in the Dispatcher.
 type DispatcherEvents struct {
 	// MessageDispatched is triggered when a message is already scheduled and thus ready to be dispatched.
-	MessageDispatched *events.Event
+	MessageDispatched *event.Event[MessageID]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -7,4 +7,11 @@ var (
 	ErrNotSynced = errors.New("tangle not synced")
 	// ErrParentsInvalid is returned when one or more parents of a message is invalid.
 	ErrParentsInvalid = errors.New("one or more parents is invalid")
+	// ErrParentTooOld is returned when a parent of a message was issued longer than the max parent age before the
+	// message.
+	ErrParentTooOld = errors.Errorf("parent too old: %w", ErrParentsInvalid)
+	// ErrParentIssuedAfterChild is returned when a parent of a message was issued after the message.
+	ErrParentIssuedAfterChild = errors.Errorf("parent issued after child: %w", ErrParentsInvalid)
+	// ErrPayloadInvalid is returned when the payload of a message is rejected by a validator of the PayloadValidator.
+	ErrPayloadInvalid = errors.New("payload invalid")
 )
@@ -0,0 +1,217 @@
+package tangle
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// region IssuerIndexParams ////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerIndexParams defines the configuration parameters of the IssuerIndex.
+type IssuerIndexParams struct {
+	// MaxMessagesPerIssuer defines how many of the most recent messages of every issuer are indexed (0 disables the
+	// index).
+	MaxMessagesPerIssuer int
+	// MaxIssuers defines how many issuers are indexed. If a message of a new issuer arrives while the limit is reached,
+	// the issuer that was inactive for the longest time is dropped (0 disables the limit).
+	MaxIssuers int
+	// Retention defines the age of the issuing time of a message after which it is dropped from the index (0 disables
+	// the limit).
+	Retention time.Duration
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IssuerIndex //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerIndex is a Tangle component that keeps the IDs of the recent messages of every issuer ordered by their issuing
+// time, so that the issuance history of an identity can be inspected. The index is kept in memory and is bounded by the
+// IssuerIndexParams of the Tangle.
+type IssuerIndex struct {
+	tangle  *Tangle
+	issuers map[ed25519.PublicKey]*issuerHistory
+	mutex   sync.RWMutex
+}
+
+// NewIssuerIndex is the constructor of the IssuerIndex.
+func NewIssuerIndex(tangle *Tangle) *IssuerIndex {
+	return &IssuerIndex{
+		tangle:  tangle,
+		issuers: make(map[ed25519.PublicKey]*issuerHistory),
+	}
+}
+
+// Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
+func (i *IssuerIndex) Setup() {
+	if i.tangle.Options.IssuerIndexParams.MaxMessagesPerIssuer <= 0 {
+		return
+	}
+
+	i.tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID MessageID) {
+		i.tangle.Storage.Message(messageID).Consume(i.Add)
+	}))
+}
+
+// Add adds the given message to the history of its issuer. Messages whose issuing time lies outside the retention
+// period are ignored.
+func (i *IssuerIndex) Add(message *Message) {
+	params := i.tangle.Options.IssuerIndexParams
+	if params.MaxMessagesPerIssuer <= 0 {
+		return
+	}
+
+	retentionStart := i.retentionStart()
+	if message.IssuingTime().Before(retentionStart) {
+		return
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	history, exists := i.issuers[message.IssuerPublicKey()]
+	if !exists {
+		if params.MaxIssuers > 0 && len(i.issuers) >= params.MaxIssuers {
+			i.evictLeastActiveIssuer()
+		}
+
+		history = &issuerHistory{}
+		i.issuers[message.IssuerPublicKey()] = history
+	}
+
+	history.add(&IssuerIndexEntry{
+		MessageID:   message.ID(),
+		IssuingTime: message.IssuingTime(),
+	})
+	history.prune(retentionStart, params.MaxMessagesPerIssuer)
+}
+
+// Messages returns the indexed messages of the given issuer whose issuing time lies within [from, to), ordered by their
+// issuing time. A zero time leaves the corresponding end of the range open. Truncated is true if older messages of the
+// issuer were dropped from the index because of the retention limits.
+func (i *IssuerIndex) Messages(issuer ed25519.PublicKey, from, to time.Time) (entries []*IssuerIndexEntry, truncated bool, exists bool) {
+	retentionStart := i.retentionStart()
+	if from.Before(retentionStart) {
+		from = retentionStart
+	}
+
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	history, exists := i.issuers[issuer]
+	if !exists {
+		return nil, false, false
+	}
+
+	start := sort.Search(len(history.entries), func(index int) bool {
+		return !history.entries[index].IssuingTime.Before(from)
+	})
+	end := len(history.entries)
+	if !to.IsZero() {
+		end = sort.Search(len(history.entries), func(index int) bool {
+			return !history.entries[index].IssuingTime.Before(to)
+		})
+	}
+
+	entries = make([]*IssuerIndexEntry, 0)
+	if start < end {
+		entries = append(entries, history.entries[start:end]...)
+	}
+
+	return entries, history.truncated || (len(history.entries) != 0 && history.entries[0].IssuingTime.Before(retentionStart)), true
+}
+
+// retentionStart returns the issuing time before which messages are dropped from the index.
+func (i *IssuerIndex) retentionStart() time.Time {
+	if retention := i.tangle.Options.IssuerIndexParams.Retention; retention > 0 {
+		return i.tangle.Options.Clock.Now().Add(-retention)
+	}
+
+	return time.Time{}
+}
+
+// evictLeastActiveIssuer drops the history of the issuer with the oldest latest message (the mutex needs to be held by
+// the caller).
+func (i *IssuerIndex) evictLeastActiveIssuer() {
+	var leastActiveIssuer ed25519.PublicKey
+	var leastActiveTime time.Time
+	for issuer, history := range i.issuers {
+		if latestIssuingTime := history.latestIssuingTime(); leastActiveTime.IsZero() || latestIssuingTime.Before(leastActiveTime) {
+			leastActiveIssuer, leastActiveTime = issuer, latestIssuingTime
+		}
+	}
+
+	delete(i.issuers, leastActiveIssuer)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region IssuerIndexEntry /////////////////////////////////////////////////////////////////////////////////////////////
+
+// IssuerIndexEntry is a message in the history of an issuer.
+type IssuerIndexEntry struct {
+	MessageID   MessageID
+	IssuingTime time.Time
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region issuerHistory ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// issuerHistory holds the indexed messages of a single issuer ordered by their issuing time.
+type issuerHistory struct {
+	entries   []*IssuerIndexEntry
+	truncated bool
+}
+
+// add inserts the given entry at its position in the history. Entries with the same issuing time are ordered by their
+// MessageID, so that the order does not depend on the order in which the messages arrived.
+func (h *issuerHistory) add(entry *IssuerIndexEntry) {
+	index := sort.Search(len(h.entries), func(index int) bool {
+		if !h.entries[index].IssuingTime.Equal(entry.IssuingTime) {
+			return h.entries[index].IssuingTime.After(entry.IssuingTime)
+		}
+
+		return bytes.Compare(h.entries[index].MessageID.Bytes(), entry.MessageID.Bytes()) >= 0
+	})
+	if index < len(h.entries) && h.entries[index].MessageID == entry.MessageID {
+		return
+	}
+
+	h.entries = append(h.entries, nil)
+	copy(h.entries[index+1:], h.entries[index:])
+	h.entries[index] = entry
+}
+
+// prune drops the entries that were issued before the retention start and the oldest entries that exceed the maximum
+// number of messages.
+func (h *issuerHistory) prune(retentionStart time.Time, maxMessages int) {
+	dropped := sort.Search(len(h.entries), func(index int) bool {
+		return !h.entries[index].IssuingTime.Before(retentionStart)
+	})
+	if excess := len(h.entries) - dropped - maxMessages; excess > 0 {
+		dropped += excess
+	}
+	if dropped == 0 {
+		return
+	}
+
+	h.entries = append(make([]*IssuerIndexEntry, 0, len(h.entries)-dropped), h.entries[dropped:]...)
+	h.truncated = true
+}
+
+// latestIssuingTime returns the issuing time of the latest entry.
+func (h *issuerHistory) latestIssuingTime() time.Time {
+	if len(h.entries) == 0 {
+		return time.Time{}
+	}
+
+	return h.entries[len(h.entries)-1].IssuingTime
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,99 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+func TestIssuerIndex(t *testing.T) {
+	tangle := NewTestTangle(IssuerIndexConfig(IssuerIndexParams{MaxMessagesPerIssuer: 3, MaxIssuers: 2, Retention: time.Hour}))
+	defer tangle.Shutdown()
+	tangle.Storage.Setup()
+	tangle.IssuerIndex.Setup()
+
+	issuer := ed25519.GenerateKeyPair().PublicKey
+	now := time.Now()
+
+	// messages are ordered by their issuing time regardless of their arrival
+	second := newSequencedMessage(issuer, 1, now.Add(-2*time.Minute))
+	first := newSequencedMessage(issuer, 0, now.Add(-3*time.Minute))
+	third := newSequencedMessage(issuer, 2, now.Add(-time.Minute))
+	for _, message := range []*Message{second, first, third, first} {
+		tangle.Storage.StoreMessage(message)
+	}
+
+	entries, truncated, exists := tangle.IssuerIndex.Messages(issuer, time.Time{}, time.Time{})
+	require.True(t, exists)
+	assert.False(t, truncated)
+	assert.Equal(t, []MessageID{first.ID(), second.ID(), third.ID()}, issuerIndexMessageIDs(entries))
+
+	// the range includes from and excludes to
+	entries, _, _ = tangle.IssuerIndex.Messages(issuer, second.IssuingTime(), third.IssuingTime())
+	assert.Equal(t, []MessageID{second.ID()}, issuerIndexMessageIDs(entries))
+
+	// the oldest messages are dropped if the limit is exceeded and messages outside the retention are ignored
+	fourth := newSequencedMessage(issuer, 3, now)
+	tangle.Storage.StoreMessage(fourth)
+	tangle.Storage.StoreMessage(newSequencedMessage(issuer, 4, now.Add(-2*time.Hour)))
+	entries, truncated, _ = tangle.IssuerIndex.Messages(issuer, time.Time{}, time.Time{})
+	assert.True(t, truncated)
+	assert.Equal(t, []MessageID{second.ID(), third.ID(), fourth.ID()}, issuerIndexMessageIDs(entries))
+
+	// the least active issuer is dropped if the number of issuers is exceeded
+	otherIssuer := ed25519.GenerateKeyPair().PublicKey
+	tangle.Storage.StoreMessage(newSequencedMessage(otherIssuer, 0, now.Add(-30*time.Minute)))
+	tangle.Storage.StoreMessage(newSequencedMessage(ed25519.GenerateKeyPair().PublicKey, 0, now))
+	_, _, exists = tangle.IssuerIndex.Messages(otherIssuer, time.Time{}, time.Time{})
+	assert.False(t, exists)
+	_, _, exists = tangle.IssuerIndex.Messages(issuer, time.Time{}, time.Time{})
+	assert.True(t, exists)
+}
+
+func TestIssuerIndex_VirtualClock(t *testing.T) {
+	virtualClock := clock.NewVirtualClock(time.Now())
+	tangle := NewTestTangle(Clock(virtualClock), IssuerIndexConfig(IssuerIndexParams{MaxMessagesPerIssuer: 3, Retention: time.Hour}))
+	defer tangle.Shutdown()
+	tangle.Storage.Setup()
+	tangle.IssuerIndex.Setup()
+
+	issuer := ed25519.GenerateKeyPair().PublicKey
+	message := newSequencedMessage(issuer, 0, virtualClock.Now())
+	tangle.Storage.StoreMessage(message)
+
+	entries, truncated, _ := tangle.IssuerIndex.Messages(issuer, time.Time{}, time.Time{})
+	assert.False(t, truncated)
+	assert.Equal(t, []MessageID{message.ID()}, issuerIndexMessageIDs(entries))
+
+	// the message leaves the retention period once the virtual time passes it
+	virtualClock.Advance(2 * time.Hour)
+	entries, truncated, _ = tangle.IssuerIndex.Messages(issuer, time.Time{}, time.Time{})
+	assert.True(t, truncated)
+	assert.Empty(t, entries)
+}
+
+func TestIssuerIndex_Disabled(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+	tangle.Storage.Setup()
+	tangle.IssuerIndex.Setup()
+
+	issuer := ed25519.GenerateKeyPair().PublicKey
+	tangle.Storage.StoreMessage(newSequencedMessage(issuer, 0, time.Now()))
+
+	_, _, exists := tangle.IssuerIndex.Messages(issuer, time.Time{}, time.Time{})
+	assert.False(t, exists)
+}
+
+func issuerIndexMessageIDs(entries []*IssuerIndexEntry) (messageIDs []MessageID) {
+	for _, entry := range entries {
+		messageIDs = append(messageIDs, entry.MessageID)
+	}
+
+	return messageIDs
+}
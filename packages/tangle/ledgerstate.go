@@ -4,10 +4,10 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/objectstorage"
 	"github.com/iotaledger/hive.go/types"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
 
@@ -29,16 +29,21 @@ func NewLedgerState(tangle *Tangle) (ledgerState *LedgerState) {
 		Ledgerstate: ledgerstate.New(
 			ledgerstate.Store(tangle.Options.Store),
 			ledgerstate.CacheTimeProvider(tangle.Options.CacheTimeProvider),
+			ledgerstate.MaxConflictingConsumers(tangle.Options.LedgerState.MaxConflictingConsumers),
+			ledgerstate.Clock(tangle.Options.Clock),
 		),
 	}
 }
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (l *LedgerState) Setup() {
-	l.tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(events.NewClosure(func(branchID ledgerstate.BranchID) {
+	l.tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(func(branchID ledgerstate.BranchID) {
 		if l.tangle.Options.LedgerState.MergeBranches {
 			l.SetBranchConfirmed(branchID)
 		}
+
+		// the conflict is resolved, so the Transactions that were parked because of it can be booked (and rejected)
+		l.UnparkTransactions(branchID.TransactionID())
 	}))
 }
 
@@ -83,6 +88,9 @@ func (l *LedgerState) Transaction(transactionID ledgerstate.TransactionID) *obje
 // eventual error.
 func (l *LedgerState) BookTransaction(transaction *ledgerstate.Transaction, messageID MessageID) (targetBranches ledgerstate.BranchIDs, err error) {
 	targetBranches, err = l.UTXODAG.BookTransaction(transaction)
+	if errors.Is(err, ledgerstate.ErrTransactionParked) {
+		return nil, err
+	}
 	if err != nil {
 		err = errors.Errorf("failed to book Transaction: %w", err)
 
@@ -154,48 +162,56 @@ func (l *LedgerState) SnapshotUTXO() (snapshot *ledgerstate.Snapshot) {
 	// The following parameter should be larger than the max allowed timestamp variation, and the required time for confirmation.
 	// We can snapshot this far in the past, since global snapshots don't occur frequent, and it is ok to ignore the last few minutes.
 	minAge := 120 * time.Second
+
+	return l.ConfirmedSnapshotUTXO(l.tangle.Options.Clock.Now().Add(-minAge))
+}
+
+// ConfirmedSnapshotUTXO returns the UTXO snapshot of the confirmed ledger state at the given time. It contains the
+// confirmed transactions that were issued before the given time, and their outputs are considered spent if they were
+// consumed by a confirmed transaction that was issued before the given time. The result therefore only depends on the
+// given time, as long as the confirmation of the transactions before it is final.
+func (l *LedgerState) ConfirmedSnapshotUTXO(before time.Time) (snapshot *ledgerstate.Snapshot) {
 	snapshot = &ledgerstate.Snapshot{
 		Transactions: make(map[ledgerstate.TransactionID]ledgerstate.Record),
 	}
 
-	startSnapshot := time.Now()
 	copyLedgerState := l.Transactions() // consider that this may take quite some time
+	isConfirmedBefore := func(transaction *ledgerstate.Transaction) (confirmed bool) {
+		if !transaction.Essence().Timestamp().Before(before) {
+			return false
+		}
 
-	for _, transaction := range copyLedgerState {
-		// skip transactions that are not confirmed
-		var isUnconfirmed bool
+		confirmed = true
 		l.TransactionMetadata(transaction.ID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
 			for branchID := range transactionMetadata.BranchIDs() {
 				if !l.tangle.ConfirmationOracle.IsBranchConfirmed(branchID) {
-					isUnconfirmed = true
+					confirmed = false
 					break
 				}
 			}
 		})
 
-		if isUnconfirmed {
-			continue
-		}
+		return confirmed
+	}
 
-		// skip transactions that are too recent before startSnapshot
-		if startSnapshot.Sub(transaction.Essence().Timestamp()) < minAge {
+	for _, transaction := range copyLedgerState {
+		if !isConfirmedBefore(transaction) {
 			continue
 		}
+
 		unspentOutputs := make([]bool, len(transaction.Essence().Outputs()))
 		includeTransaction := false
 		for i, output := range transaction.Essence().Outputs() {
-			unspentOutputs[i] = true
-			includeTransaction = true
-
 			confirmedConsumerID := l.ConfirmedConsumer(output.ID())
 			if confirmedConsumerID != ledgerstate.GenesisTransactionID {
-				tx, exists := copyLedgerState[confirmedConsumerID]
-				// If the Confirmed Consumer is old enough we consider the output spent
-				if exists && startSnapshot.Sub(tx.Essence().Timestamp()) >= minAge {
-					unspentOutputs[i] = false
-					includeTransaction = false
+				// the output is only spent if its confirmed consumer was issued before the given time as well
+				if consumer, exists := copyLedgerState[confirmedConsumerID]; exists && isConfirmedBefore(consumer) {
+					continue
 				}
 			}
+
+			unspentOutputs[i] = true
+			includeTransaction = true
 		}
 		// include only transactions with at least one unspent output
 		if includeTransaction {
@@ -207,8 +223,6 @@ func (l *LedgerState) SnapshotUTXO() (snapshot *ledgerstate.Snapshot) {
 		}
 	}
 
-	// TODO ??? due to possible race conditions we could add a check for the consistency of the UTXO snapshot
-
 	return snapshot
 }
 
@@ -4,10 +4,10 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/walker"
 	"github.com/iotaledger/hive.go/identity"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
 )
@@ -31,7 +31,7 @@ func NewBranchMarkersMapper(tangle *Tangle) (b *BranchMarkersMapper) {
 		discardedNodes: make(map[identity.ID]time.Time),
 		Manager:        markers.NewManager(markers.WithStore(tangle.Options.Store)),
 		Events: &BranchMarkersMapperEvents{
-			FutureMarkerUpdated: events.NewEvent(futureMarkerUpdateEventCaller),
+			FutureMarkerUpdated: event.New[*FutureMarkerUpdate]("BranchMarkersMapper.FutureMarkerUpdated"),
 		},
 	}
 
@@ -206,7 +206,7 @@ func increaseMarkersIndexCallbackStrategy(markers.SequenceID, markers.Index) boo
 // BranchMarkersMapperEvents represents events happening in the BranchMarkersMapper.
 type BranchMarkersMapperEvents struct {
 	// FutureMarkerUpdated is triggered when a message's future marker is updated.
-	FutureMarkerUpdated *events.Event
+	FutureMarkerUpdated *event.Event[*FutureMarkerUpdate]
 }
 
 // FutureMarkerUpdate contains the messageID of the future marker of a message.
@@ -215,8 +215,4 @@ type FutureMarkerUpdate struct {
 	FutureMarker MessageID
 }
 
-func futureMarkerUpdateEventCaller(handler interface{}, params ...interface{}) {
-	handler.(func(fmUpdate *FutureMarkerUpdate))(params[0].(*FutureMarkerUpdate))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,6 +2,7 @@ package tangle
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"sort"
 	"strings"
@@ -19,10 +20,11 @@ import (
 	"github.com/mr-tron/base58"
 	"golang.org/x/crypto/blake2b"
 
-	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/pool"
+	"github.com/iotaledger/goshimmer/packages/stringcache"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 )
 
@@ -36,6 +38,9 @@ const (
 	// MessageIDLength defines the length of an MessageID.
 	MessageIDLength = 32
 
+	// MessageIDBase58CacheSize defines the number of base58 encoded MessageIDs that are cached.
+	MessageIDBase58CacheSize = 10000
+
 	// MinParentsCount defines the minimum number of parents each parents block must have.
 	MinParentsCount = 1
 
@@ -98,13 +103,15 @@ func MessageIDFromBytes(bytes []byte) (result MessageID, consumedBytes int, err
 }
 
 // ReferenceFromMarshalUtil is a wrapper for simplified unmarshaling in a byte stream using the marshalUtil package.
-func ReferenceFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (MessageID, error) {
-	id, err := marshalUtil.Parse(func(data []byte) (interface{}, int, error) { return MessageIDFromBytes(data) })
+func ReferenceFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (id MessageID, err error) {
+	// the ID is copied from the source bytes directly, as parsing it through an interface would allocate
+	idBytes, err := marshalUtil.ReadBytes(MessageIDLength)
 	if err != nil {
-		err = fmt.Errorf("failed to parse message ID: %w", err)
-		return MessageID{}, err
+		return MessageID{}, fmt.Errorf("failed to parse message ID: %w", err)
 	}
-	return id.(MessageID), nil
+	copy(id[:], idBytes)
+
+	return id, nil
 }
 
 // MarshalBinary marshals the MessageID into bytes.
@@ -130,7 +137,7 @@ func (id MessageID) Bytes() []byte {
 
 // Base58 returns a base58 encoded version of the MessageID.
 func (id MessageID) Base58() string {
-	return base58.Encode(id[:])
+	return messageIDBase58Cache.Get(id)
 }
 
 // CompareTo does a lexicographical comparison to another messageID.
@@ -150,9 +157,14 @@ func (id MessageID) String() string {
 		return "MessageID(" + messageIDAlias + ")"
 	}
 
-	return "MessageID(" + base58.Encode(id[:]) + ")"
+	return "MessageID(" + id.Base58() + ")"
 }
 
+// messageIDBase58Cache contains the base58 encodings of recently used MessageIDs.
+var messageIDBase58Cache = stringcache.New("MessageID", MessageIDBase58CacheSize, func(id MessageID) string {
+	return base58.Encode(id[:])
+})
+
 // messageIDAliases contains a list of aliases registered for a set of MessageIDs.
 var messageIDAliases = make(map[MessageID]string)
 
@@ -473,7 +485,8 @@ func (m *Message) FromObjectStorage(key, data []byte) (result objectstorage.Stor
 	return
 }
 
-// FromBytes parses the given bytes into a message.
+// FromBytes parses the given bytes into a message. The message and its payload reference the given bytes, which must
+// therefore not be modified afterwards.
 func (m *Message) FromBytes(bytes []byte) (message *Message, err error) {
 	marshalUtil := marshalutil.New(bytes)
 	message, err = m.FromMarshalUtil(marshalUtil)
@@ -488,7 +501,10 @@ func (m *Message) FromBytes(bytes []byte) (message *Message, err error) {
 	return
 }
 
-// FromMarshalUtil parses a message from the given marshal util.
+// FromMarshalUtil parses a message from the given marshal util. The message and its payload reference the bytes of the
+// marshal util, which must therefore not be modified afterwards. The fixed-size fields (i.e. the parents, the issuer
+// public key and the signature) are arrays that are stored by value, so they are copied from the source bytes, but
+// without allocating intermediate buffers.
 func (m *Message) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*Message, error) {
 	// determine read offset before starting to parse
 	readOffsetStart := marshalUtil.ReadOffset()
@@ -531,7 +547,7 @@ func (m *Message) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*Messag
 		}
 	}
 
-	issuerPublicKey, err := ed25519.ParsePublicKey(marshalUtil)
+	issuerPublicKey, err := parsePublicKey(marshalUtil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse issuer public key of the message: %w", err)
 	}
@@ -553,7 +569,7 @@ func (m *Message) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*Messag
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse nonce of the message: %w", err)
 	}
-	signature, err := ed25519.ParseSignature(marshalUtil)
+	signature, err := parseSignature(marshalUtil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse signature of the message: %w", err)
 	}
@@ -561,11 +577,8 @@ func (m *Message) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*Messag
 	// retrieve the number of bytes we processed
 	readOffsetEnd := marshalUtil.ReadOffset()
 
-	// store marshaled version as a copy
-	msgBytes, err := marshalUtil.ReadBytes(readOffsetEnd-readOffsetStart, readOffsetStart)
-	if err != nil {
-		return nil, fmt.Errorf("error trying to copy raw source bytes: %w", err)
-	}
+	// reference the source bytes instead of copying them (the capacity is limited, so that appending copies them)
+	msgBytes := marshalUtil.Bytes()[readOffsetStart:readOffsetEnd:readOffsetEnd]
 
 	msg, err := newMessageWithValidation(version, parentsBlocks, issuingTime, issuerPublicKey, msgPayload, nonce, signature, msgSequenceNumber)
 	if err != nil {
@@ -577,15 +590,68 @@ func (m *Message) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (*Messag
 	return msg, nil
 }
 
-// VerifySignature verifies the signature of the message.
+// parsePublicKey copies a public key from the source bytes of the marshal util (ed25519.ParsePublicKey allocates).
+func parsePublicKey(marshalUtil *marshalutil.MarshalUtil) (publicKey ed25519.PublicKey, err error) {
+	publicKeyBytes, err := marshalUtil.ReadBytes(ed25519.PublicKeySize)
+	if err != nil {
+		return publicKey, err
+	}
+	copy(publicKey[:], publicKeyBytes)
+
+	return publicKey, nil
+}
+
+// parseSignature copies a signature from the source bytes of the marshal util (ed25519.ParseSignature allocates).
+func parseSignature(marshalUtil *marshalutil.MarshalUtil) (signature ed25519.Signature, err error) {
+	signatureBytes, err := marshalUtil.ReadBytes(ed25519.SignatureSize)
+	if err != nil {
+		return signature, err
+	}
+	copy(signature[:], signatureBytes)
+
+	return signature, nil
+}
+
+// VerifySignature verifies the signature of the message in a network without ID.
 func (m *Message) VerifySignature() bool {
+	return m.VerifySignatureInNetwork(0)
+}
+
+// VerifySignatureInNetwork verifies the signature of the message in the network with the given ID.
+func (m *Message) VerifySignatureInNetwork(networkID uint32) bool {
+	return m.issuerPublicKey.VerifySignature(m.signedContent(networkID), m.Signature())
+}
+
+// signedContent returns the bytes that are signed by the issuer of the message in the network with the given ID.
+func (m *Message) signedContent(networkID uint32) []byte {
 	msgBytes := m.Bytes()
-	signature := m.Signature()
 
-	contentLength := len(msgBytes) - len(signature)
-	content := msgBytes[:contentLength]
+	return SignatureContent(networkID, msgBytes[:len(msgBytes)-len(m.Signature())])
+}
 
-	return m.issuerPublicKey.VerifySignature(content, signature)
+// appendSignedContent appends the bytes that are signed by the issuer of the message in the network with the given ID
+// to the given buffer.
+func (m *Message) appendSignedContent(buffer []byte, networkID uint32) []byte {
+	msgBytes := m.Bytes()
+	if networkID != 0 {
+		buffer = append(buffer, make([]byte, marshalutil.Uint32Size)...)
+		binary.LittleEndian.PutUint32(buffer[len(buffer)-marshalutil.Uint32Size:], networkID)
+	}
+
+	return append(buffer, msgBytes[:len(msgBytes)-len(m.Signature())]...)
+}
+
+// SignatureContent returns the bytes that are signed by the issuer of a message with the given content. Messages of a
+// network with an ID other than 0 commit to that ID, so that their signatures are invalid in any other network.
+func SignatureContent(networkID uint32, content []byte) []byte {
+	if networkID == 0 {
+		return content
+	}
+
+	return marshalutil.New(marshalutil.Uint32Size + len(content)).
+		WriteUint32(networkID).
+		WriteBytes(content).
+		Bytes()
 }
 
 // ID returns the id of the message which is made up of the content id and parent1/parent2 ids.
@@ -701,29 +767,28 @@ func (m *Message) Bytes() []byte {
 		return m.bytes
 	}
 
-	// marshal result
-	marshalUtil := marshalutil.New()
-	marshalUtil.WriteByte(m.version)
-	marshalUtil.WriteByte(byte(len(m.parentsBlocks)))
+	// marshal result into a pooled buffer, so that the retained bytes are sized exactly
+	m.bytes = pool.Marshal(func(marshalUtil *marshalutil.MarshalUtil) {
+		marshalUtil.WriteByte(m.version)
+		marshalUtil.WriteByte(byte(len(m.parentsBlocks)))
 
-	for x := 0; x < len(m.parentsBlocks); x++ {
-		parentBlock := m.parentsBlocks[x]
-		marshalUtil.WriteByte(byte(parentBlock.ParentsType))
-		marshalUtil.WriteByte(byte(len(parentBlock.References)))
-		sortedParents := sortParents(NewMessageIDs(parentBlock.References...))
-		for _, parent := range sortedParents {
-			marshalUtil.Write(parent)
+		for x := 0; x < len(m.parentsBlocks); x++ {
+			parentBlock := m.parentsBlocks[x]
+			marshalUtil.WriteByte(byte(parentBlock.ParentsType))
+			marshalUtil.WriteByte(byte(len(parentBlock.References)))
+			sortedParents := sortParents(NewMessageIDs(parentBlock.References...))
+			for _, parent := range sortedParents {
+				marshalUtil.Write(parent)
+			}
 		}
-	}
-
-	marshalUtil.Write(m.issuerPublicKey)
-	marshalUtil.WriteTime(m.issuingTime)
-	marshalUtil.WriteUint64(m.sequenceNumber)
-	marshalUtil.Write(m.payload)
-	marshalUtil.WriteUint64(m.nonce)
-	marshalUtil.Write(m.signature)
 
-	m.bytes = marshalUtil.Bytes()
+		marshalUtil.Write(m.issuerPublicKey)
+		marshalUtil.WriteTime(m.issuingTime)
+		marshalUtil.WriteUint64(m.sequenceNumber)
+		marshalUtil.Write(m.payload)
+		marshalUtil.WriteUint64(m.nonce)
+		marshalUtil.Write(m.signature)
+	})
 
 	return m.bytes
 }
@@ -881,8 +946,11 @@ type MessageMetadata struct {
 	bookedTime          time.Time
 	objectivelyInvalid  bool
 	subjectivelyInvalid bool
+	belowMaxDepth       bool
 	gradeOfFinality     gof.GradeOfFinality
 	gradeOfFinalityTime time.Time
+	orphaned            bool
+	orphanedTime        time.Time
 
 	solidMutex               sync.RWMutex
 	solidificationTimeMutex  sync.RWMutex
@@ -897,16 +965,42 @@ type MessageMetadata struct {
 	bookedTimeMutex          sync.RWMutex
 	invalidMutex             sync.RWMutex
 	gradeOfFinalityMutex     sync.RWMutex
+	orphanedMutex            sync.RWMutex
 }
 
-// NewMessageMetadata creates a new MessageMetadata from the specified messageID.
-func NewMessageMetadata(messageID MessageID) *MessageMetadata {
+// NewMessageMetadata creates a new MessageMetadata from the specified messageID and the time it was received.
+func NewMessageMetadata(messageID MessageID, receivedTime time.Time) *MessageMetadata {
 	return &MessageMetadata{
 		messageID:           messageID,
-		receivedTime:        clock.SyncedTime(),
+		receivedTime:        receivedTime,
+		addedBranchIDs:      ledgerstate.NewBranchIDs(),
+		subtractedBranchIDs: ledgerstate.NewBranchIDs(),
+	}
+}
+
+// messageMetadataPool contains the MessageMetadata that guard the storage of Messages. A MessageMetadata is only
+// returned to the Pool if its Message was stored already, as it was never handed out to the object storage then.
+var messageMetadataPool = pool.New(func() *MessageMetadata {
+	return &MessageMetadata{
 		addedBranchIDs:      ledgerstate.NewBranchIDs(),
 		subtractedBranchIDs: ledgerstate.NewBranchIDs(),
 	}
+}, func(messageMetadata *MessageMetadata) {
+	// the BranchIDs of a returned MessageMetadata were never modified and can be reused
+	*messageMetadata = MessageMetadata{
+		addedBranchIDs:      messageMetadata.addedBranchIDs,
+		subtractedBranchIDs: messageMetadata.subtractedBranchIDs,
+	}
+})
+
+// newPooledMessageMetadata returns a MessageMetadata from the messageMetadataPool for the specified messageID and the
+// time it was received. It has to be either returned to or detached from the messageMetadataPool.
+func newPooledMessageMetadata(messageID MessageID, receivedTime time.Time) (messageMetadata *MessageMetadata) {
+	messageMetadata = messageMetadataPool.Get()
+	messageMetadata.messageID = messageID
+	messageMetadata.receivedTime = receivedTime
+
+	return messageMetadata
 }
 
 // FromObjectStorage creates an MessageMetadata from sequences of key and bytes.
@@ -990,6 +1084,18 @@ func (m *MessageMetadata) FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil)
 		err = fmt.Errorf("failed to parse gradeOfFinality time of message metadata: %w", err)
 		return
 	}
+	if messageMetadata.orphaned, err = marshalUtil.ReadBool(); err != nil {
+		err = fmt.Errorf("failed to parse orphaned flag of message metadata: %w", err)
+		return
+	}
+	if messageMetadata.orphanedTime, err = marshalUtil.ReadTime(); err != nil {
+		err = fmt.Errorf("failed to parse orphaned time of message metadata: %w", err)
+		return
+	}
+	if messageMetadata.belowMaxDepth, err = marshalUtil.ReadBool(); err != nil {
+		err = fmt.Errorf("failed to parse below max depth flag of message metadata: %w", err)
+		return
+	}
 
 	return
 }
@@ -1013,9 +1119,9 @@ func (m *MessageMetadata) IsSolid() (result bool) {
 	return
 }
 
-// SetSolid sets the message associated with this metadata as solid.
+// SetSolid sets the message associated with this metadata as solid at the given time.
 // It returns true if the solid status is modified. False otherwise.
-func (m *MessageMetadata) SetSolid(solid bool) (modified bool) {
+func (m *MessageMetadata) SetSolid(solid bool, solidificationTime time.Time) (modified bool) {
 	m.solidMutex.RLock()
 	if m.solid != solid {
 		m.solidMutex.RUnlock()
@@ -1025,7 +1131,7 @@ func (m *MessageMetadata) SetSolid(solid bool) (modified bool) {
 			m.solid = solid
 			if solid {
 				m.solidificationTimeMutex.Lock()
-				m.solidificationTime = clock.SyncedTime()
+				m.solidificationTime = solidificationTime
 				m.solidificationTimeMutex.Unlock()
 			}
 
@@ -1134,9 +1240,9 @@ func (m *MessageMetadata) SubtractedBranchIDs() ledgerstate.BranchIDs {
 	return m.subtractedBranchIDs.Clone()
 }
 
-// SetScheduled sets the message associated with this metadata as scheduled.
+// SetScheduled sets the message associated with this metadata as scheduled at the given time.
 // It returns true if the scheduled status is modified. False otherwise.
-func (m *MessageMetadata) SetScheduled(scheduled bool) (modified bool) {
+func (m *MessageMetadata) SetScheduled(scheduled bool, scheduledTime time.Time) (modified bool) {
 	m.scheduledMutex.Lock()
 	defer m.scheduledMutex.Unlock()
 	m.scheduledTimeMutex.Lock()
@@ -1147,7 +1253,7 @@ func (m *MessageMetadata) SetScheduled(scheduled bool) (modified bool) {
 	}
 
 	m.scheduled = scheduled
-	m.scheduledTime = clock.SyncedTime()
+	m.scheduledTime = scheduledTime
 	m.SetModified()
 	modified = true
 
@@ -1202,9 +1308,9 @@ func (m *MessageMetadata) SetQueuedTime(queuedTime time.Time) {
 	m.queuedTime = queuedTime
 }
 
-// SetBooked sets the message associated with this metadata as booked.
+// SetBooked sets the message associated with this metadata as booked at the given time.
 // It returns true if the booked status is modified. False otherwise.
-func (m *MessageMetadata) SetBooked(booked bool) (modified bool) {
+func (m *MessageMetadata) SetBooked(booked bool, bookedTime time.Time) (modified bool) {
 	m.bookedMutex.Lock()
 	defer m.bookedMutex.Unlock()
 	m.bookedTimeMutex.Lock()
@@ -1215,7 +1321,7 @@ func (m *MessageMetadata) SetBooked(booked bool) (modified bool) {
 	}
 
 	m.booked = booked
-	m.bookedTime = clock.SyncedTime()
+	m.bookedTime = bookedTime
 	m.SetModified()
 	modified = true
 
@@ -1291,9 +1397,35 @@ func (m *MessageMetadata) SetSubjectivelyInvalid(invalid bool) (modified bool) {
 	return
 }
 
-// SetGradeOfFinality sets the grade of finality associated with this metadata.
+// IsBelowMaxDepth returns true if the message represented by this metadata attached below max depth when it was booked.
+func (m *MessageMetadata) IsBelowMaxDepth() (result bool) {
+	m.invalidMutex.RLock()
+	defer m.invalidMutex.RUnlock()
+	result = m.belowMaxDepth
+
+	return
+}
+
+// SetBelowMaxDepth sets the message associated with this metadata as below max depth - it returns true if the status
+// was changed.
+func (m *MessageMetadata) SetBelowMaxDepth(belowMaxDepth bool) (modified bool) {
+	m.invalidMutex.Lock()
+	defer m.invalidMutex.Unlock()
+
+	if m.belowMaxDepth == belowMaxDepth {
+		return false
+	}
+
+	m.belowMaxDepth = belowMaxDepth
+	m.SetModified()
+	modified = true
+
+	return
+}
+
+// SetGradeOfFinality sets the grade of finality associated with this metadata, which was reached at the given time.
 // It returns true if the grade of finality is modified. False otherwise.
-func (m *MessageMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality) (modified bool) {
+func (m *MessageMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality, gradeOfFinalityTime time.Time) (modified bool) {
 	m.gradeOfFinalityMutex.Lock()
 	defer m.gradeOfFinalityMutex.Unlock()
 
@@ -1302,7 +1434,7 @@ func (m *MessageMetadata) SetGradeOfFinality(gradeOfFinality gof.GradeOfFinality
 	}
 
 	m.gradeOfFinality = gradeOfFinality
-	m.gradeOfFinalityTime = clock.SyncedTime()
+	m.gradeOfFinalityTime = gradeOfFinalityTime
 	m.SetModified()
 	modified = true
 
@@ -1325,6 +1457,40 @@ func (m *MessageMetadata) GradeOfFinalityTime() time.Time {
 	return m.gradeOfFinalityTime
 }
 
+// SetOrphaned sets the message associated with this metadata as orphaned, i.e. its past cone could not be solidified
+// within the solidification timeout, at the given time. It returns true if the status was changed.
+func (m *MessageMetadata) SetOrphaned(orphaned bool, orphanedTime time.Time) (modified bool) {
+	m.orphanedMutex.Lock()
+	defer m.orphanedMutex.Unlock()
+
+	if m.orphaned == orphaned {
+		return false
+	}
+
+	m.orphaned = orphaned
+	m.orphanedTime = orphanedTime
+	m.SetModified()
+	modified = true
+
+	return
+}
+
+// IsOrphaned returns true if the message represented by this metadata is orphaned.
+func (m *MessageMetadata) IsOrphaned() (result bool) {
+	m.orphanedMutex.RLock()
+	defer m.orphanedMutex.RUnlock()
+
+	return m.orphaned
+}
+
+// OrphanedTime returns the time when the message was marked as orphaned.
+func (m *MessageMetadata) OrphanedTime() time.Time {
+	m.orphanedMutex.RLock()
+	defer m.orphanedMutex.RUnlock()
+
+	return m.orphanedTime
+}
+
 // Bytes returns a marshaled version of the whole MessageMetadata object.
 func (m *MessageMetadata) Bytes() []byte {
 	return byteutils.ConcatBytes(m.ObjectStorageKey(), m.ObjectStorageValue())
@@ -1339,21 +1505,25 @@ func (m *MessageMetadata) ObjectStorageKey() []byte {
 // ObjectStorageValue returns the value of the stored message metadata object.
 // This includes the receivedTime, solidificationTime and solid status.
 func (m *MessageMetadata) ObjectStorageValue() []byte {
-	return marshalutil.New().
-		WriteTime(m.ReceivedTime()).
-		WriteTime(m.SolidificationTime()).
-		WriteBool(m.IsSolid()).
-		Write(m.StructureDetails()).
-		Write(m.AddedBranchIDs()).
-		Write(m.SubtractedBranchIDs()).
-		WriteBool(m.Scheduled()).
-		WriteTime(m.ScheduledTime()).
-		WriteBool(m.IsBooked()).
-		WriteTime(m.BookedTime()).
-		WriteBool(m.IsObjectivelyInvalid()).
-		WriteUint8(uint8(m.GradeOfFinality())).
-		WriteTime(m.GradeOfFinalityTime()).
-		Bytes()
+	return pool.Marshal(func(marshalUtil *marshalutil.MarshalUtil) {
+		marshalUtil.
+			WriteTime(m.ReceivedTime()).
+			WriteTime(m.SolidificationTime()).
+			WriteBool(m.IsSolid()).
+			Write(m.StructureDetails()).
+			Write(m.AddedBranchIDs()).
+			Write(m.SubtractedBranchIDs()).
+			WriteBool(m.Scheduled()).
+			WriteTime(m.ScheduledTime()).
+			WriteBool(m.IsBooked()).
+			WriteTime(m.BookedTime()).
+			WriteBool(m.IsObjectivelyInvalid()).
+			WriteUint8(uint8(m.GradeOfFinality())).
+			WriteTime(m.GradeOfFinalityTime()).
+			WriteBool(m.IsOrphaned()).
+			WriteTime(m.OrphanedTime()).
+			WriteBool(m.IsBelowMaxDepth())
+	})
 }
 
 // String returns a human readable version of the MessageMetadata.
@@ -1374,8 +1544,11 @@ func (m *MessageMetadata) String() string {
 		stringify.StructField("bookedTime", m.BookedTime()),
 		stringify.StructField("objectivelyInvalid", m.IsObjectivelyInvalid()),
 		stringify.StructField("subjectivelyInvalid", m.IsSubjectivelyInvalid()),
+		stringify.StructField("belowMaxDepth", m.IsBelowMaxDepth()),
 		stringify.StructField("gradeOfFinality", m.GradeOfFinality()),
 		stringify.StructField("gradeOfFinalityTime", m.GradeOfFinalityTime()),
+		stringify.StructField("orphaned", m.IsOrphaned()),
+		stringify.StructField("orphanedTime", m.OrphanedTime()),
 	)
 }
 
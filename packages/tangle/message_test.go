@@ -187,6 +187,15 @@ func TestMessage_VerifySignature(t *testing.T) {
 	assert.True(t, signed.VerifySignature())
 }
 
+func TestMessage_AppendSignedContent(t *testing.T) {
+	msg, _ := NewMessage(NewParentMessageIDs().AddStrong(EmptyMessageID), time.Time{}, ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte("test")), 0, ed25519.Signature{})
+
+	for _, networkID := range []uint32{0, 42} {
+		assert.Equal(t, msg.signedContent(networkID), msg.appendSignedContent(nil, networkID))
+		assert.Equal(t, append([]byte{1}, msg.signedContent(networkID)...), msg.appendSignedContent([]byte{1}, networkID))
+	}
+}
+
 func TestMessage_UnmarshalTransaction(t *testing.T) {
 	tangle := NewTestTangle()
 	defer tangle.Shutdown()
@@ -234,6 +243,23 @@ func TestMessage_MarshalUnmarshal(t *testing.T) {
 		assert.Equal(t, testMessage.Signature(), restoredMessage.Signature())
 		assert.Equal(t, true, restoredMessage.VerifySignature())
 	}
+
+	// the restored message references the parsed bytes
+	messageBytes := testMessage.Bytes()
+	restoredMessage, err = new(Message).FromBytes(messageBytes)
+	require.NoError(t, err)
+	assert.Equal(t, &messageBytes[0], &restoredMessage.Bytes()[0])
+	assert.Equal(t, len(messageBytes), cap(restoredMessage.Bytes()))
+
+	// the payload references the parsed bytes as well, but appending to it does not overwrite the following bytes
+	data := restoredMessage.Payload().(*payload.GenericDataPayload).Blob()
+	assert.Equal(t, []byte("test"), data)
+	dataOffset := bytes.Index(messageBytes, []byte("test"))
+	require.NotEqual(t, -1, dataOffset)
+	assert.Equal(t, &messageBytes[dataOffset], &data[0])
+	parsedBytes := append([]byte(nil), messageBytes...)
+	_ = append(data, 0xff)
+	assert.Equal(t, parsedBytes, messageBytes)
 }
 
 func TestNewMessageWithValidation(t *testing.T) {
@@ -695,6 +721,8 @@ func TestMessage_Bytes(t *testing.T) {
 		msgBytes := msg.Bytes()
 		// 4 full parents blocks - 1 parent block with 1 parent
 		assert.Equal(t, MaxMessageSize-payload.MaxSize+4-(3*(1+1+8*32)+(7*32)), len(msgBytes))
+		// the retained bytes do not keep the marshal buffer alive
+		assert.Equal(t, len(msgBytes), cap(msgBytes))
 	})
 }
 
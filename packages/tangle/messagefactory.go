@@ -7,11 +7,10 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/kvstore"
 
-	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 )
@@ -30,8 +29,16 @@ type MessageFactory struct {
 	selector       TipSelector
 	referencesFunc ReferencesFunc
 
+	issuanceFilter      TransactionIssuanceFilter
+	issuanceFilterMutex sync.RWMutex
+
 	powTimeout time.Duration
 
+	// issuanceMutex is held while the sequence number and the issuing time of a message are assigned, so that the
+	// sequence numbers and issuing times of the messages of the node are ordered in the same way.
+	issuanceMutex   sync.Mutex
+	lastIssuingTime time.Time
+
 	worker      Worker
 	workerMutex sync.RWMutex
 }
@@ -45,9 +52,9 @@ func NewMessageFactory(tangle *Tangle, selector TipSelector, referencesFunc Refe
 
 	return &MessageFactory{
 		Events: &MessageFactoryEvents{
-			MessageConstructed:         events.NewEvent(messageEventHandler),
-			MessageReferenceImpossible: events.NewEvent(MessageIDCaller),
-			Error:                      events.NewEvent(events.ErrorCaller),
+			MessageConstructed:         event.New[*Message]("MessageFactory.MessageConstructed"),
+			MessageReferenceImpossible: event.New[MessageID]("MessageFactory.MessageReferenceImpossible"),
+			Error:                      event.New[error]("MessageFactory.Error"),
 		},
 
 		tangle:         tangle,
@@ -67,6 +74,14 @@ func (f *MessageFactory) SetWorker(worker Worker) {
 	f.worker = worker
 }
 
+// SetTransactionIssuanceFilter sets the TransactionIssuanceFilter that decides which transactions the node is willing
+// to issue.
+func (f *MessageFactory) SetTransactionIssuanceFilter(filter TransactionIssuanceFilter) {
+	f.issuanceFilterMutex.Lock()
+	defer f.issuanceFilterMutex.Unlock()
+	f.issuanceFilter = filter
+}
+
 // SetTimeout sets the timeout for PoW.
 func (f *MessageFactory) SetTimeout(timeout time.Duration) {
 	f.powTimeout = timeout
@@ -92,19 +107,29 @@ func (f *MessageFactory) issuePayload(p payload.Payload, references ParentMessag
 		f.Events.Error.Trigger(err)
 		return nil, err
 	}
-	sequenceNumber, err := f.sequence.Next()
+	if err := f.checkIssuanceFilter(p); err != nil {
+		return nil, err
+	}
+	msg, err := f.createMessage(p, references, parentsCount...)
 	if err != nil {
-		err = errors.Errorf("could not create sequence number: %w", err)
-		f.Events.Error.Trigger(err)
 		return nil, err
 	}
 
+	f.Events.MessageConstructed.Trigger(msg)
+	return msg, nil
+}
+
+// createMessage selects the parents of a new message, performs the PoW and signs it. Only the sequence number and the
+// issuing time are assigned under the issuanceMutex, so that messages that are issued concurrently are ordered
+// consistently without waiting for each other's PoW.
+func (f *MessageFactory) createMessage(p payload.Payload, references ParentMessageIDs, parentsCount ...int) (msg *Message, err error) {
 	issuerPublicKey := f.localIdentity.PublicKey()
 
 	// do the PoW
 	startTime := time.Now()
 	var errPoW error
 	var nonce uint64
+	var sequenceNumber uint64
 	var issuingTime time.Time
 
 	strongParents := references[StrongParentType]
@@ -122,7 +147,11 @@ func (f *MessageFactory) issuePayload(p payload.Payload, references ParentMessag
 				return nil, err
 			}
 		}
-		issuingTime = f.getIssuingTime(strongParents)
+		// every attempt takes a new sequence number, as the issuing time of a retry is later than the ones of the
+		// messages that were issued in the meantime
+		if sequenceNumber, issuingTime, err = f.reserveIssuance(strongParents); err != nil {
+			return nil, err
+		}
 		if len(references) == 0 {
 			var referenceNotPossible MessageIDs
 			references, referenceNotPossible, err = f.referencesFunc(strongParents, issuingTime, f.tangle)
@@ -153,7 +182,7 @@ func (f *MessageFactory) issuePayload(p payload.Payload, references ParentMessag
 		return nil, err
 	}
 
-	msg, err := NewMessage(
+	msg, err = NewMessage(
 		references,
 		issuingTime,
 		issuerPublicKey,
@@ -168,12 +197,36 @@ func (f *MessageFactory) issuePayload(p payload.Payload, references ParentMessag
 		return nil, err
 	}
 
-	f.Events.MessageConstructed.Trigger(msg)
 	return msg, nil
 }
 
+// reserveIssuance takes the next sequence number and the issuing time of a message with the given parents. Both are
+// assigned under the issuanceMutex, so that the issuing times of the messages of the node never decrease with their
+// sequence numbers.
+func (f *MessageFactory) reserveIssuance(parents MessageIDs) (sequenceNumber uint64, issuingTime time.Time, err error) {
+	f.issuanceMutex.Lock()
+	defer f.issuanceMutex.Unlock()
+
+	if sequenceNumber, err = f.sequence.Next(); err != nil {
+		err = errors.Errorf("could not create sequence number: %w", err)
+		f.Events.Error.Trigger(err)
+		return 0, time.Time{}, err
+	}
+	issuingTime = f.getIssuingTime(parents)
+	f.lastIssuingTime = issuingTime
+
+	return sequenceNumber, issuingTime, nil
+}
+
+// getIssuingTime returns the issuing time of a message with the given parents. It expects the issuanceMutex to be
+// locked.
 func (f *MessageFactory) getIssuingTime(parents MessageIDs) time.Time {
-	issuingTime := clock.SyncedTime()
+	issuingTime := f.tangle.Options.Clock.Now()
+
+	// the issuing time must not be before the issuing time of the previous message of the node.
+	if issuingTime.Before(f.lastIssuingTime) {
+		issuingTime = f.lastIssuingTime
+	}
 
 	// due to the ParentAge check we must ensure that we set the right issuing time.
 
@@ -207,7 +260,7 @@ func (f *MessageFactory) tips(p payload.Payload, parentsCount int) (parents Mess
 }
 
 func (f *MessageFactory) earliestAttachment(transactionIDs ledgerstate.TransactionIDs) (earliestAttachment *Message) {
-	earliestIssuingTime := time.Now()
+	earliestIssuingTime := f.tangle.Options.Clock.Now()
 	for transactionID := range transactionIDs {
 		f.tangle.Storage.Attachments(transactionID).Consume(func(attachment *Attachment) {
 			f.tangle.Storage.Message(attachment.MessageID()).Consume(func(message *Message) {
@@ -223,6 +276,27 @@ func (f *MessageFactory) earliestAttachment(transactionIDs ledgerstate.Transacti
 	return earliestAttachment
 }
 
+// checkIssuanceFilter returns an error if the given payload is a transaction that is rejected by the
+// TransactionIssuanceFilter.
+func (f *MessageFactory) checkIssuanceFilter(p payload.Payload) error {
+	tx, isTransaction := p.(*ledgerstate.Transaction)
+	if !isTransaction {
+		return nil
+	}
+
+	f.issuanceFilterMutex.RLock()
+	defer f.issuanceFilterMutex.RUnlock()
+
+	if f.issuanceFilter == nil {
+		return nil
+	}
+	if err := f.issuanceFilter(tx); err != nil {
+		return errors.Errorf("transaction %s is not allowed to be issued: %w", tx.ID().Base58(), err)
+	}
+
+	return nil
+}
+
 // Shutdown closes the MessageFactory and persists the sequence number.
 func (f *MessageFactory) Shutdown() {
 	if err := f.sequence.Release(); err != nil {
@@ -254,7 +328,7 @@ func (f *MessageFactory) sign(references ParentMessageIDs, issuingTime time.Time
 	dummyBytes := dummy.Bytes()
 
 	contentLength := len(dummyBytes) - len(dummy.Signature())
-	return f.localIdentity.Sign(dummyBytes[:contentLength]), nil
+	return f.localIdentity.Sign(SignatureContent(f.tangle.Options.NetworkID, dummyBytes[:contentLength])), nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -264,17 +338,13 @@ func (f *MessageFactory) sign(references ParentMessageIDs, issuingTime time.Time
 // MessageFactoryEvents represents events happening on a message factory.
 type MessageFactoryEvents struct {
 	// Fired when a message is built including tips, sequence number and other metadata.
-	MessageConstructed *events.Event
+	MessageConstructed *event.Event[*Message]
 
 	// MessageReferenceImpossible is fired when references for a message can't be constructed and the message can never become a parent.
-	MessageReferenceImpossible *events.Event
+	MessageReferenceImpossible *event.Event[MessageID]
 
 	// Fired when an error occurred.
-	Error *events.Event
-}
-
-func messageEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*Message))(params[0].(*Message))
+	Error *event.Event[error]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -300,6 +370,14 @@ func (f TipSelectorFunc) Tips(p payload.Payload, countParents int) (parents Mess
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region TransactionIssuanceFilter ////////////////////////////////////////////////////////////////////////////////////
+
+// TransactionIssuanceFilter is a function type that checks if the node is willing to issue a transaction. It returns an
+// error that explains why the transaction is rejected.
+type TransactionIssuanceFilter func(tx *ledgerstate.Transaction) error
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region Worker ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // A Worker performs the PoW for the provided message in serialized byte form.
@@ -414,7 +492,7 @@ func referenceFromStrongParent(tangle *Tangle, strongParentBranchID ledgerstate.
 		if err != nil {
 			return UndefinedParentType, EmptyMessageID, errors.Errorf("failed to find first attachment of Transaction with %s: %w", likedBranchID.TransactionID(), err)
 		}
-		if issuingTime.Sub(oldestAttachmentTime) >= maxParentsTimeDifference {
+		if issuingTime.Sub(oldestAttachmentTime) >= tangle.Options.MaxParentAge {
 			return UndefinedParentType, EmptyMessageID, errors.Errorf("shallow like reference needed for Transaction with %s is too far in the past", likedBranchID.TransactionID())
 		}
 
@@ -432,7 +510,7 @@ func referenceFromStrongParent(tangle *Tangle, strongParentBranchID ledgerstate.
 			return UndefinedParentType, EmptyMessageID, errors.Errorf("failed to find first attachment of Transaction with %s: %w", conflictMember.TransactionID(), err)
 		}
 
-		if issuingTime.Sub(oldestAttachmentTime) < maxParentsTimeDifference {
+		if issuingTime.Sub(oldestAttachmentTime) < tangle.Options.MaxParentAge {
 			return ShallowDislikeParentType, oldestAttachmentMessageID, nil
 		}
 	}
@@ -8,7 +8,7 @@ import (
 	"testing"
 	"time"
 
-	"github.com/iotaledger/hive.go/events"
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/types"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +16,7 @@ import (
 	_ "golang.org/x/crypto/blake2b"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/pow"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
@@ -44,12 +45,12 @@ func TestMessageFactory_BuildMessage(t *testing.T) {
 	tangle.MessageFactory.SetTimeout(powTimeout)
 	defer tangle.MessageFactory.Shutdown()
 
-	// keep track of sequence numbers
+	// keep track of sequence numbers and their issuing times
 	sequenceNumbers := sync.Map{}
 
 	// attach to event and count
 	countEvents := uint64(0)
-	tangle.MessageFactory.Events.MessageConstructed.Attach(events.NewClosure(func(msg *Message) {
+	tangle.MessageFactory.Events.MessageConstructed.Attach(event.NewClosure(func(msg *Message) {
 		atomic.AddUint64(&countEvents, 1)
 	}))
 
@@ -71,7 +72,7 @@ func TestMessageFactory_BuildMessage(t *testing.T) {
 		assert.EqualValues(t, 1, countEvents)
 		assert.EqualValues(t, 0, msg.SequenceNumber())
 
-		sequenceNumbers.Store(msg.SequenceNumber(), true)
+		sequenceNumbers.Store(msg.SequenceNumber(), msg.IssuingTime())
 	})
 
 	// create messages in parallel
@@ -93,7 +94,7 @@ func TestMessageFactory_BuildMessage(t *testing.T) {
 				// check payload
 				assert.Equal(t, p, msg.Payload())
 
-				sequenceNumbers.Store(msg.SequenceNumber(), true)
+				sequenceNumbers.Store(msg.SequenceNumber(), msg.IssuingTime())
 			})
 		}
 	})
@@ -105,10 +106,6 @@ func TestMessageFactory_BuildMessage(t *testing.T) {
 	countSequence := 0
 	sequenceNumbers.Range(func(key, value interface{}) bool {
 		seq := key.(uint64)
-		val := value.(bool)
-		if val != true {
-			return false
-		}
 
 		// check for max sequence number
 		if seq > max {
@@ -119,6 +116,13 @@ func TestMessageFactory_BuildMessage(t *testing.T) {
 	})
 	assert.EqualValues(t, totalMessages-1, max)
 	assert.EqualValues(t, totalMessages, countSequence)
+
+	// the issuing times are ordered in the same way as the sequence numbers
+	for seq := uint64(1); seq <= max; seq++ {
+		previousTime, _ := sequenceNumbers.Load(seq - 1)
+		currentTime, _ := sequenceNumbers.Load(seq)
+		assert.False(t, currentTime.(time.Time).Before(previousTime.(time.Time)))
+	}
 }
 
 func TestMessageFactory_POW(t *testing.T) {
@@ -155,6 +159,79 @@ func TestMessageFactory_POW(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestMessageFactory_ConcurrentPOW(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+	tangle.OTVConsensusManager = NewOTVConsensusManager(&SimpleMockOnTangleVoting{})
+
+	msgFactory := NewMessageFactory(
+		tangle,
+		TipSelectorFunc(func(p payload.Payload, countParents int) (parentsMessageIDs MessageIDs, err error) {
+			return NewMessageIDs(EmptyMessageID), nil
+		}),
+		emptyLikeReferences,
+	)
+	defer msgFactory.Shutdown()
+
+	// the PoW of the first message blocks until the second message was issued
+	releaseFirstPOW := make(chan struct{})
+	powCount := uint32(0)
+	msgFactory.SetWorker(WorkerFunc(func([]byte) (uint64, error) {
+		if atomic.AddUint32(&powCount, 1) == 1 {
+			<-releaseFirstPOW
+		}
+		return 0, nil
+	}))
+
+	firstMessage := make(chan *Message, 1)
+	go func() {
+		msg, err := msgFactory.IssuePayload(payload.NewGenericDataPayload([]byte("first")))
+		assert.NoError(t, err)
+		firstMessage <- msg
+	}()
+	require.Eventually(t, func() bool { return atomic.LoadUint32(&powCount) == 1 }, time.Second, time.Millisecond)
+
+	secondMessage, err := msgFactory.IssuePayload(payload.NewGenericDataPayload([]byte("second")))
+	require.NoError(t, err)
+	close(releaseFirstPOW)
+
+	// the sequence numbers and issuing times are still ordered in the same way
+	msg := <-firstMessage
+	assert.Less(t, msg.SequenceNumber(), secondMessage.SequenceNumber())
+	assert.False(t, secondMessage.IssuingTime().Before(msg.IssuingTime()))
+}
+
+func TestMessageFactory_TransactionIssuanceFilter(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	msgFactory := NewMessageFactory(
+		tangle,
+		TipSelectorFunc(func(p payload.Payload, countParents int) (parentsMessageIDs MessageIDs, err error) {
+			return NewMessageIDs(EmptyMessageID), nil
+		}),
+		emptyLikeReferences,
+	)
+	defer msgFactory.Shutdown()
+
+	errNotAllowed := errors.New("not allowed")
+	msgFactory.SetTransactionIssuanceFilter(func(tx *ledgerstate.Transaction) error {
+		return errNotAllowed
+	})
+
+	// transactions are rejected by the filter
+	_, err := msgFactory.IssuePayload(randomTransaction())
+	assert.ErrorIs(t, err, errNotAllowed)
+
+	// other payloads are not affected by the filter
+	_, err = msgFactory.IssuePayload(payload.NewGenericDataPayload([]byte("test")))
+	assert.NoError(t, err)
+
+	msgFactory.SetTransactionIssuanceFilter(nil)
+	_, err = msgFactory.IssuePayload(randomTransaction())
+	assert.NoError(t, err)
+}
+
 func TestMessageFactory_PrepareLikedReferences_1(t *testing.T) {
 	tangle := NewTestTangle()
 	defer tangle.Shutdown()
@@ -167,7 +244,7 @@ func TestMessageFactory_PrepareLikedReferences_1(t *testing.T) {
 
 	tangle.Setup()
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) {
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Logf("Error fired: %v", err)
 	}))
 
@@ -220,7 +297,7 @@ func TestMessageFactory_PrepareLikedReferences_2(t *testing.T) {
 
 	tangle.Setup()
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) {
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Logf("Error fired: %v", err)
 	}))
 
@@ -306,12 +383,12 @@ func TestMessageFactory_PrepareLikedReferences_2(t *testing.T) {
 	checkReferences(t, tangle, NewMessageIDs(testFramework.Message("3").ID(), testFramework.Message("4").ID()), map[ParentsType]MessageIDs{
 		StrongParentType:      NewMessageIDs(testFramework.Message("3").ID()),
 		ShallowLikeParentType: NewMessageIDs(testFramework.Message("2").ID()),
-	}, time.Now().Add(maxParentsTimeDifference))
+	}, time.Now().Add(DefaultMaxParentAge))
 
 	// Do not return too old like reference: if there's no other strong parent left, an error should be returned.
 	checkReferences(t, tangle, NewMessageIDs(testFramework.Message("4").ID()), map[ParentsType]MessageIDs{
 		StrongParentType: NewMessageIDs(),
-	}, time.Now().Add(maxParentsTimeDifference), true)
+	}, time.Now().Add(DefaultMaxParentAge), true)
 }
 
 // Tests if error is returned when non-existing transaction is tried to be liked.
@@ -327,7 +404,7 @@ func TestMessageFactory_PrepareLikedReferences_3(t *testing.T) {
 
 	tangle.Setup()
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) {
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Logf("Error fired: %v", err)
 	}))
 
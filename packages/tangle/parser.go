@@ -3,17 +3,22 @@ package tangle
 import (
 	"fmt"
 	"io"
+	"runtime"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
-	"github.com/iotaledger/hive.go/bytesfilter"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/typeutils"
+	"go.uber.org/atomic"
+	"golang.org/x/crypto/blake2b"
 
+	"github.com/iotaledger/goshimmer/packages/batchverifier"
+	"github.com/iotaledger/goshimmer/packages/bloom"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/pool"
 	"github.com/iotaledger/goshimmer/packages/pow"
 )
 
@@ -26,9 +31,10 @@ const (
 
 // Parser parses messages and bytes and emits corresponding events for parsed and rejected messages.
 type Parser struct {
-	bytesFilters   []BytesFilter
-	messageFilters []MessageFilter
-	Events         *ParserEvents
+	bytesFilters            []BytesFilter
+	messageFilters          []MessageFilter
+	recentlySeenBytesFilter *RecentlySeenBytesFilter
+	Events                  *ParserEvents
 
 	byteFiltersModified    typeutils.AtomicBool
 	messageFiltersModified typeutils.AtomicBool
@@ -37,20 +43,21 @@ type Parser struct {
 }
 
 // NewParser creates a new Message parser.
-func NewParser() (result *Parser) {
+func NewParser(networkID uint32) (result *Parser) {
 	result = &Parser{
-		bytesFilters:   make([]BytesFilter, 0),
-		messageFilters: make([]MessageFilter, 0),
+		bytesFilters:            make([]BytesFilter, 0),
+		messageFilters:          make([]MessageFilter, 0),
+		recentlySeenBytesFilter: NewRecentlySeenBytesFilter(),
 		Events: &ParserEvents{
-			MessageParsed:   events.NewEvent(messageParsedEventHandler),
-			BytesRejected:   events.NewEvent(bytesRejectedEventHandler),
-			MessageRejected: events.NewEvent(messageRejectedEventHandler),
+			MessageParsed:   event.New[*MessageParsedEvent]("Parser.MessageParsed"),
+			BytesRejected:   event.New[*BytesRejectedEvent]("Parser.BytesRejected"),
+			MessageRejected: event.New[*MessageRejectedEvent]("Parser.MessageRejected"),
 		},
 	}
 
 	// add builtin filters
-	result.AddBytesFilter(NewRecentlySeenBytesFilter())
-	result.AddMessageFilter(NewMessageSignatureFilter())
+	result.AddBytesFilter(result.recentlySeenBytesFilter)
+	result.AddMessageFilter(NewMessageSignatureFilter(networkID))
 	result.AddMessageFilter(NewTransactionFilter())
 	return
 }
@@ -66,6 +73,11 @@ func (p *Parser) Parse(messageBytes []byte, peer *peer.Peer) {
 	p.bytesFilters[0].Filter(messageBytes, peer)
 }
 
+// RecentlySeenBytesFilter returns the builtin filter that drops duplicate bytes.
+func (p *Parser) RecentlySeenBytesFilter() *RecentlySeenBytesFilter {
+	return p.recentlySeenBytesFilter
+}
+
 // AddBytesFilter adds the given bytes filter to the parser.
 func (p *Parser) AddBytesFilter(filter BytesFilter) {
 	p.bytesFiltersMutex.Lock()
@@ -103,7 +115,8 @@ func (p *Parser) setupBytesFilterDataFlow() {
 				p.Events.BytesRejected.Trigger(&BytesRejectedEvent{
 					Bytes: bytes,
 					Peer:  peer,
-				}, err)
+					Error: err,
+				})
 			})
 		}
 	}
@@ -136,7 +149,8 @@ func (p *Parser) setupMessageFilterDataFlow() {
 				p.Events.MessageRejected.Trigger(&MessageRejectedEvent{
 					Message: msg,
 					Peer:    peer,
-				}, err)
+					Error:   err,
+				})
 			})
 		}
 	}
@@ -149,7 +163,8 @@ func (p *Parser) parseMessage(bytes []byte, peer *peer.Peer) {
 		p.Events.BytesRejected.Trigger(&BytesRejectedEvent{
 			Bytes: bytes,
 			Peer:  peer,
-		}, err)
+			Error: err,
+		})
 	} else {
 		p.messageFilters[0].Filter(parsedMessage, peer)
 	}
@@ -169,13 +184,13 @@ func (p *Parser) Shutdown() {
 // ParserEvents represents events happening in the Parser.
 type ParserEvents struct {
 	// Fired when a message was parsed.
-	MessageParsed *events.Event
+	MessageParsed *event.Event[*MessageParsedEvent]
 
 	// Fired when submitted bytes are rejected by a filter.
-	BytesRejected *events.Event
+	BytesRejected *event.Event[*BytesRejectedEvent]
 
 	// Fired when a message got rejected by a filter.
-	MessageRejected *events.Event
+	MessageRejected *event.Event[*MessageRejectedEvent]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -187,10 +202,7 @@ type ParserEvents struct {
 type BytesRejectedEvent struct {
 	Bytes []byte
 	Peer  *peer.Peer
-}
-
-func bytesRejectedEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*BytesRejectedEvent, error))(params[0].(*BytesRejectedEvent), params[1].(error))
+	Error error
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -202,10 +214,7 @@ func bytesRejectedEventHandler(handler interface{}, params ...interface{}) {
 type MessageRejectedEvent struct {
 	Message *Message
 	Peer    *peer.Peer
-}
-
-func messageRejectedEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*MessageRejectedEvent, error))(params[0].(*MessageRejectedEvent), params[1].(error))
+	Error   error
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -222,10 +231,6 @@ type MessageParsedEvent struct {
 	Peer *peer.Peer
 }
 
-func messageParsedEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*MessageParsedEvent))(params[0].(*MessageParsedEvent))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region BytesFilter //////////////////////////////////////////////////////////////////////////////////////////////////
@@ -262,8 +267,19 @@ type MessageFilter interface {
 
 // region MessageSignatureFilter ///////////////////////////////////////////////////////////////////////////////////////
 
-// MessageSignatureFilter filters messages based on whether their signatures are valid.
+// signedContentPool contains the buffers that are used to assemble the signed content of messages.
+var signedContentPool = pool.New(func() *[]byte {
+	buffer := make([]byte, 0, 1024)
+	return &buffer
+}, func(buffer *[]byte) {
+	*buffer = (*buffer)[:0]
+})
+
+// MessageSignatureFilter filters messages based on whether their signatures are valid. The signatures are verified
+// asynchronously in batches, so that the cost of the verification is shared among concurrently received messages.
 type MessageSignatureFilter struct {
+	networkID        uint32
+	verifier         *batchverifier.BatchVerifier
 	onAcceptCallback func(msg *Message, peer *peer.Peer)
 	onRejectCallback func(msg *Message, err error, peer *peer.Peer)
 
@@ -271,19 +287,31 @@ type MessageSignatureFilter struct {
 	onRejectCallbackMutex sync.RWMutex
 }
 
-// NewMessageSignatureFilter creates a new message signature filter.
-func NewMessageSignatureFilter() *MessageSignatureFilter {
-	return new(MessageSignatureFilter)
+// NewMessageSignatureFilter creates a new message signature filter that only accepts messages that were signed in the
+// network with the given ID.
+func NewMessageSignatureFilter(networkID uint32) *MessageSignatureFilter {
+	return &MessageSignatureFilter{
+		networkID: networkID,
+		verifier:  batchverifier.New(runtime.NumCPU(), batchverifier.DefaultMaxBatchSize),
+	}
 }
 
 // Filter filters up on the given bytes and peer and calls the acceptance callback
 // if the input passes or the rejection callback if the input is rejected.
 func (f *MessageSignatureFilter) Filter(msg *Message, peer *peer.Peer) {
-	if msg.VerifySignature() {
-		f.getAcceptCallback()(msg, peer)
-		return
-	}
-	f.getRejectCallback()(msg, ErrInvalidSignature, peer)
+	// the signed content is assembled in a pooled buffer that is released once the signature was verified
+	signedContent := signedContentPool.Get()
+	*signedContent = msg.appendSignedContent(*signedContent, f.networkID)
+
+	f.verifier.Verify(msg.IssuerPublicKey(), *signedContent, msg.Signature(), func(valid bool) {
+		signedContentPool.Put(signedContent)
+
+		if valid {
+			f.getAcceptCallback()(msg, peer)
+			return
+		}
+		f.getRejectCallback()(msg, errors.Errorf("message is not signed for network %d: %w", f.networkID, ErrInvalidSignature), peer)
+	})
 }
 
 // OnAccept registers the given callback as the acceptance function of the filter.
@@ -314,8 +342,11 @@ func (f *MessageSignatureFilter) getRejectCallback() (result func(msg *Message,
 	return
 }
 
-// Close closes the filter.
-func (f *MessageSignatureFilter) Close() error { return nil }
+// Close verifies the pending signatures and closes the filter.
+func (f *MessageSignatureFilter) Close() error {
+	f.verifier.Shutdown()
+	return nil
+}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -404,12 +435,30 @@ func powData(msgBytes []byte) ([]byte, error) {
 
 // region RecentlySeenBytesFilter //////////////////////////////////////////////////////////////////////////////////////
 
-// RecentlySeenBytesFilter filters so that bytes which were recently seen don't pass the filter.
+const (
+	// RecentlySeenBytesFilterCapacity defines the minimum number of most recently received messages that are remembered
+	// by the RecentlySeenBytesFilter.
+	RecentlySeenBytesFilterCapacity = 100000
+
+	// RecentlySeenBytesFilterFalsePositiveRate defines the probability that the RecentlySeenBytesFilter rejects bytes
+	// that were not seen before. Such bytes are only accepted again once the filter has forgotten the colliding entries,
+	// which may delay the solidification of the corresponding message.
+	RecentlySeenBytesFilterFalsePositiveRate = 0.000001
+)
+
+// RecentlySeenBytesFilter filters so that bytes which were recently seen don't pass the filter. It is the first filter
+// of the Parser, so that duplicates received from multiple neighbors are dropped before they are parsed and their
+// signature is verified. It uses a bloom filter that only stores a few bytes per message. Messages that were requested
+// by the node always pass the filter, so that a false positive can not prevent a missing message from being solidified.
 type RecentlySeenBytesFilter struct {
-	bytesFilter      *bytesfilter.BytesFilter
+	bloomFilter      *bloom.RotatingFilter
+	acceptedCount    atomic.Uint64
+	duplicateCount   atomic.Uint64
+	isRequested      func(messageID MessageID) bool
 	onAcceptCallback func(bytes []byte, peer *peer.Peer)
 	onRejectCallback func(bytes []byte, err error, peer *peer.Peer)
 
+	isRequestedMutex      sync.RWMutex
 	onAcceptCallbackMutex sync.RWMutex
 	onRejectCallbackMutex sync.RWMutex
 }
@@ -417,20 +466,49 @@ type RecentlySeenBytesFilter struct {
 // NewRecentlySeenBytesFilter creates a new recently seen bytes filter.
 func NewRecentlySeenBytesFilter() *RecentlySeenBytesFilter {
 	return &RecentlySeenBytesFilter{
-		bytesFilter: bytesfilter.New(100000),
+		bloomFilter: bloom.NewRotatingFilter(RecentlySeenBytesFilterCapacity, RecentlySeenBytesFilterFalsePositiveRate),
 	}
 }
 
 // Filter filters up on the given bytes and peer and calls the acceptance callback
 // if the input passes or the rejection callback if the input is rejected.
 func (r *RecentlySeenBytesFilter) Filter(bytes []byte, peer *peer.Peer) {
-	if r.bytesFilter.Add(bytes) {
+	if r.bloomFilter.Add(bytes) || r.requested(bytes) {
+		r.acceptedCount.Inc()
 		r.getAcceptCallback()(bytes, peer)
 		return
 	}
+	r.duplicateCount.Inc()
 	r.getRejectCallback()(bytes, ErrReceivedDuplicateBytes, peer)
 }
 
+// OnRequested registers the function that tells the filter if a message is currently requested by the node.
+func (r *RecentlySeenBytesFilter) OnRequested(isRequested func(messageID MessageID) bool) {
+	r.isRequestedMutex.Lock()
+	r.isRequested = isRequested
+	r.isRequestedMutex.Unlock()
+}
+
+// requested returns true if the message with the given bytes is currently requested by the node. The MessageID is
+// only calculated for bytes that are rejected by the bloom filter.
+func (r *RecentlySeenBytesFilter) requested(bytes []byte) bool {
+	r.isRequestedMutex.RLock()
+	isRequested := r.isRequested
+	r.isRequestedMutex.RUnlock()
+
+	return isRequested != nil && isRequested(blake2b.Sum256(bytes))
+}
+
+// AcceptedCount returns the number of bytes that passed the filter.
+func (r *RecentlySeenBytesFilter) AcceptedCount() uint64 {
+	return r.acceptedCount.Load()
+}
+
+// DuplicateCount returns the number of bytes that were rejected as duplicates.
+func (r *RecentlySeenBytesFilter) DuplicateCount() uint64 {
+	return r.duplicateCount.Load()
+}
+
 // OnAccept registers the given callback as the acceptance function of the filter.
 func (r *RecentlySeenBytesFilter) OnAccept(callback func(bytes []byte, peer *peer.Peer)) {
 	r.onAcceptCallbackMutex.Lock()
@@ -465,11 +543,16 @@ func (r *RecentlySeenBytesFilter) getRejectCallback() (result func(bytes []byte,
 
 // NewTransactionFilter creates a new transaction filter.
 func NewTransactionFilter() *TransactionFilter {
-	return new(TransactionFilter)
+	return &TransactionFilter{
+		verifier: batchverifier.New(runtime.NumCPU(), batchverifier.DefaultMaxBatchSize),
+	}
 }
 
-// TransactionFilter filters messages based on their timestamps and transaction timestamp.
+// TransactionFilter filters messages based on their timestamps and transaction timestamp, and on whether the ED25519
+// signatures of the unlock blocks of their transaction are valid. The signatures are verified asynchronously in batches,
+// like the signatures of the messages.
 type TransactionFilter struct {
+	verifier         *batchverifier.BatchVerifier
 	onAcceptCallback func(msg *Message, peer *peer.Peer)
 	onRejectCallback func(msg *Message, err error, peer *peer.Peer)
 
@@ -477,20 +560,36 @@ type TransactionFilter struct {
 	onRejectCallbackMutex sync.RWMutex
 }
 
-// Filter compares the timestamps between the message, and it's transaction payload and calls the corresponding callback.
+// Filter compares the timestamps between the message, and it's transaction payload and verifies the signatures of the
+// transaction before it calls the corresponding callback.
 func (f *TransactionFilter) Filter(msg *Message, peer *peer.Peer) {
-	if payload := msg.Payload(); payload.Type() == ledgerstate.TransactionType {
-		transaction, err := new(ledgerstate.Transaction).FromBytes(payload.Bytes())
-		if err != nil {
+	payload := msg.Payload()
+	if payload.Type() != ledgerstate.TransactionType {
+		f.getAcceptCallback()(msg, peer)
+		return
+	}
+
+	// the signatures are verified on the transaction of the message, so that they are not verified again when it is booked
+	transaction, isTransaction := payload.(*ledgerstate.Transaction)
+	if !isTransaction {
+		var err error
+		if transaction, err = new(ledgerstate.Transaction).FromBytes(payload.Bytes()); err != nil {
 			f.getRejectCallback()(msg, err, peer)
 			return
 		}
-		if !isMessageAndTransactionTimestampsValid(transaction, msg) {
-			f.getRejectCallback()(msg, ErrInvalidMessageAndTransactionTimestamp, peer)
+	}
+	if !isMessageAndTransactionTimestampsValid(transaction, msg) {
+		f.getRejectCallback()(msg, ErrInvalidMessageAndTransactionTimestamp, peer)
+		return
+	}
+
+	transaction.VerifyUnlockSignatures(f.verifier, func(valid bool) {
+		if !valid {
+			f.getRejectCallback()(msg, errors.Errorf("transaction %s contains an invalid unlock block signature: %w", transaction.ID(), ErrInvalidSignature), peer)
 			return
 		}
-	}
-	f.getAcceptCallback()(msg, peer)
+		f.getAcceptCallback()(msg, peer)
+	})
 }
 
 func isMessageAndTransactionTimestampsValid(transaction *ledgerstate.Transaction, message *Message) bool {
@@ -527,8 +626,11 @@ func (f *TransactionFilter) getRejectCallback() (result func(msg *Message, err e
 	return
 }
 
-// Close closes the filter.
-func (f *TransactionFilter) Close() error { return nil }
+// Close verifies the pending signatures and closes the filter.
+func (f *TransactionFilter) Close() error {
+	f.verifier.Shutdown()
+	return nil
+}
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
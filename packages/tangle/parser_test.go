@@ -8,7 +8,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
-	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/labstack/gommon/log"
@@ -16,6 +16,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/pow"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
@@ -23,7 +24,7 @@ import (
 
 func BenchmarkMessageParser_ParseBytesSame(b *testing.B) {
 	msgBytes := newTestDataMessage("Test").Bytes()
-	msgParser := NewParser()
+	msgParser := NewParser(0)
 	msgParser.Setup()
 
 	b.ResetTimer()
@@ -39,7 +40,7 @@ func BenchmarkMessageParser_ParseBytesDifferent(b *testing.B) {
 		messageBytes[i] = newTestDataMessage("Test" + strconv.Itoa(i)).Bytes()
 	}
 
-	msgParser := NewParser()
+	msgParser := NewParser(0)
 	msgParser.Setup()
 
 	b.ResetTimer()
@@ -52,11 +53,11 @@ func BenchmarkMessageParser_ParseBytesDifferent(b *testing.B) {
 func TestMessageParser_ParseMessage(t *testing.T) {
 	msg := newTestDataMessage("Test")
 
-	msgParser := NewParser()
+	msgParser := NewParser(0)
 	msgParser.Setup()
 	msgParser.Parse(msg.Bytes(), nil)
 
-	msgParser.Events.MessageParsed.Attach(events.NewClosure(func(msgParsedEvent *MessageParsedEvent) {
+	msgParser.Events.MessageParsed.Attach(event.NewClosure(func(msgParsedEvent *MessageParsedEvent) {
 		log.Infof("parsed message")
 	}))
 }
@@ -88,6 +89,64 @@ func TestTransactionFilter_Filter(t *testing.T) {
 	})
 }
 
+func TestTransactionFilter_UnlockSignatures(t *testing.T) {
+	filter := NewTransactionFilter()
+	defer filter.Close()
+
+	results := make(chan error, 1)
+	filter.OnAccept(func(*Message, *peer.Peer) { results <- nil })
+	filter.OnReject(func(_ *Message, err error, _ *peer.Peer) { results <- err })
+
+	keyPair := ed25519.GenerateKeyPair()
+	nodeID, _ := identity.RandomID()
+	input := ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))
+	essence := ledgerstate.NewTransactionEssence(1, time.Now(), nodeID, nodeID, ledgerstate.NewInputs(input), ledgerstate.NewOutputs(ledgerstate.NewSigLockedSingleOutput(1, ledgerstate.NewED25519Address(keyPair.PublicKey))))
+	validSignature := ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign(essence.Bytes()))
+	invalidSignature := ledgerstate.NewED25519Signature(keyPair.PublicKey, keyPair.PrivateKey.Sign([]byte("other")))
+
+	validTransaction := ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(validSignature)})
+	filter.Filter(&Message{payload: validTransaction, issuingTime: essence.Timestamp()}, testPeer)
+	assert.NoError(t, <-results)
+
+	// the verified signature is not verified again when the unlock blocks are validated
+	validSignature.PublicKey = ed25519.PublicKey{}
+	assert.True(t, validSignature.SignatureValid(essence.Bytes()))
+
+	invalidTransaction := ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewSignatureUnlockBlock(invalidSignature)})
+	filter.Filter(&Message{payload: invalidTransaction, issuingTime: essence.Timestamp()}, testPeer)
+	assert.ErrorIs(t, <-results, ErrInvalidSignature)
+	assert.False(t, invalidSignature.SignatureValid(essence.Bytes()))
+}
+
+func TestMessageSignatureFilter_NetworkID(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+	references := NewParentMessageIDs().AddStrong(EmptyMessageID)
+	issuingTime := time.Now()
+	unsignedMessage, err := NewMessage(references, issuingTime, localIdentity.PublicKey(), 0, payload.NewGenericDataPayload([]byte("test")), 0, ed25519.EmptySignature)
+	require.NoError(t, err)
+	content := unsignedMessage.Bytes()[:len(unsignedMessage.Bytes())-len(unsignedMessage.Signature())]
+	msg, err := NewMessage(references, issuingTime, localIdentity.PublicKey(), 0, payload.NewGenericDataPayload([]byte("test")), 0, localIdentity.Sign(SignatureContent(42, content)))
+	require.NoError(t, err)
+
+	assert.True(t, msg.VerifySignatureInNetwork(42))
+	assert.False(t, msg.VerifySignature())
+
+	for networkID, expectedAccepted := range map[uint32]bool{0: false, 42: true, 43: false} {
+		var accepted bool
+		var rejectErr error
+		filter := NewMessageSignatureFilter(networkID)
+		filter.OnAccept(func(*Message, *peer.Peer) { accepted = true })
+		filter.OnReject(func(_ *Message, err error, _ *peer.Peer) { rejectErr = err })
+		filter.Filter(msg, testPeer)
+		require.NoError(t, filter.Close())
+
+		assert.Equal(t, expectedAccepted, accepted)
+		if !expectedAccepted {
+			assert.ErrorIs(t, rejectErr, ErrInvalidSignature)
+		}
+	}
+}
+
 func Test_isMessageAndTransactionTimestampsValid(t *testing.T) {
 	msg := &Message{}
 	t.Run("older tx timestamp within limit", func(t *testing.T) {
@@ -151,6 +210,47 @@ func TestPowFilter_Filter(t *testing.T) {
 	m.AssertExpectations(t)
 }
 
+func TestRecentlySeenBytesFilter_Filter(t *testing.T) {
+	filter := NewRecentlySeenBytesFilter()
+
+	m := &bytesCallbackMock{}
+	filter.OnAccept(m.Accept)
+	filter.OnReject(m.Reject)
+
+	msgBytes := newTestDataMessage("Test").Bytes()
+	m.On("Accept", msgBytes, testPeer).Once()
+	filter.Filter(msgBytes, testPeer)
+
+	m.On("Reject", msgBytes, mock.MatchedBy(func(err error) bool { return errors.Is(err, ErrReceivedDuplicateBytes) }), testPeer).Twice()
+	filter.Filter(msgBytes, testPeer)
+	filter.Filter(msgBytes, testPeer)
+
+	m.AssertExpectations(t)
+	assert.EqualValues(t, 1, filter.AcceptedCount())
+	assert.EqualValues(t, 2, filter.DuplicateCount())
+}
+
+func TestRecentlySeenBytesFilter_Requested(t *testing.T) {
+	filter := NewRecentlySeenBytesFilter()
+
+	m := &bytesCallbackMock{}
+	filter.OnAccept(m.Accept)
+	filter.OnReject(m.Reject)
+
+	msg := newTestDataMessage("Test")
+	msgBytes := msg.Bytes()
+	filter.OnRequested(func(messageID MessageID) bool { return messageID == msg.ID() })
+
+	// requested messages pass the filter, even if the bloom filter reports them as seen
+	m.On("Accept", msgBytes, testPeer).Twice()
+	filter.Filter(msgBytes, testPeer)
+	filter.Filter(msgBytes, testPeer)
+
+	m.AssertExpectations(t)
+	assert.EqualValues(t, 2, filter.AcceptedCount())
+	assert.EqualValues(t, 0, filter.DuplicateCount())
+}
+
 type bytesCallbackMock struct{ mock.Mock }
 
 func (m *bytesCallbackMock) Accept(msg []byte, p *peer.Peer)            { m.Called(msg, p) }
@@ -65,6 +65,8 @@ func GenericDataPayloadFromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (ge
 		err = errors.Errorf("failed to parse data (%v): %w", err, cerrors.ErrParseBytesFailed)
 		return
 	}
+	// the data references the source bytes (appending to it copies it instead of overwriting the following bytes)
+	genericDataPayload.data = genericDataPayload.data[:len(genericDataPayload.data):len(genericDataPayload.data)]
 
 	return
 }
@@ -66,6 +66,9 @@ func FromMarshalUtil(marshalUtil *marshalutil.MarshalUtil) (payload Payload, err
 		err = errors.Errorf("failed to unmarshal payload bytes (%v): %w", err, cerrors.ErrParseBytesFailed)
 		return
 	}
+	// the payload references the source bytes, so its capacity is limited to make appends copy instead of overwriting
+	// the bytes that follow it
+	payloadBytes = payloadBytes[:len(payloadBytes):len(payloadBytes)]
 
 	readOffset := marshalUtil.ReadOffset()
 	if payload, err = Unmarshaler(payloadType)(payloadBytes); err != nil {
@@ -0,0 +1,117 @@
+package tangle
+
+import (
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+// region PayloadValidator /////////////////////////////////////////////////////////////////////////////////////////////
+
+// PayloadValidatorFunc is the type of the semantic validators of a payload type. It receives the Message and its
+// (already parsed) Payload and returns an error if the Payload violates the rules of the application.
+type PayloadValidatorFunc func(message *Message, messagePayload payload.Payload) error
+
+// PayloadValidator is a Tangle component that runs the semantic validators that applications registered for the
+// different payload types. The validators run before a Message is booked, and a Message whose Payload is rejected by a
+// validator is marked as objectively invalid.
+//
+// The validators are part of the validation rules of the network, so every node of a network needs to register the
+// same validators. They need to be deterministic and must only depend on the Message itself.
+type PayloadValidator struct {
+	Events *PayloadValidatorEvents
+
+	tangle     *Tangle
+	validators map[payload.Type][]PayloadValidatorFunc
+	mutex      sync.RWMutex
+}
+
+// NewPayloadValidator is the constructor of the PayloadValidator.
+func NewPayloadValidator(tangle *Tangle) *PayloadValidator {
+	return &PayloadValidator{
+		Events: &PayloadValidatorEvents{
+			PayloadInvalid: event.New[*PayloadInvalidEvent]("PayloadValidator.PayloadInvalid"),
+		},
+		tangle:     tangle,
+		validators: make(map[payload.Type][]PayloadValidatorFunc),
+	}
+}
+
+// RegisterValidator registers a validator for the given payload type. The validators of a payload type run in the order
+// in which they were registered.
+func (p *PayloadValidator) RegisterValidator(payloadType payload.Type, validator PayloadValidatorFunc) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.validators[payloadType] = append(p.validators[payloadType], validator)
+}
+
+// HasValidators returns true if at least one validator is registered for the given payload type.
+func (p *PayloadValidator) HasValidators(payloadType payload.Type) bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return len(p.validators[payloadType]) != 0
+}
+
+// Validate runs the validators of the payload type of the given Message and returns the error of the first validator
+// that rejects the Payload.
+func (p *PayloadValidator) Validate(message *Message) (err error) {
+	messagePayload := message.Payload()
+
+	p.mutex.RLock()
+	validators := p.validators[messagePayload.Type()]
+	p.mutex.RUnlock()
+
+	for _, validator := range validators {
+		if err = validator(message, messagePayload); err != nil {
+			return errors.Errorf("payload of type %s in message with %s is invalid: %s: %w", messagePayload.Type(), message.ID(), err.Error(), ErrPayloadInvalid)
+		}
+	}
+
+	return nil
+}
+
+// PayloadValid validates the Payload of the given Message. If the Payload is invalid, it marks the Message as
+// objectively invalid, triggers the corresponding events and returns false.
+func (p *PayloadValidator) PayloadValid(message *Message, messageMetadata *MessageMetadata) (valid bool) {
+	err := p.Validate(message)
+	if err == nil {
+		return true
+	}
+
+	if !messageMetadata.SetObjectivelyInvalid(true) {
+		return false
+	}
+
+	p.Events.PayloadInvalid.Trigger(&PayloadInvalidEvent{
+		MessageID:   message.ID(),
+		PayloadType: message.Payload().Type(),
+		Error:       err,
+	})
+	p.tangle.Events.MessageInvalid.Trigger(&MessageInvalidEvent{MessageID: message.ID(), Error: err})
+
+	return false
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PayloadValidatorEvents ///////////////////////////////////////////////////////////////////////////////////////
+
+// PayloadValidatorEvents represents events happening in the PayloadValidator.
+type PayloadValidatorEvents struct {
+	// PayloadInvalid is triggered when a validator rejected the Payload of a Message.
+	PayloadInvalid *event.Event[*PayloadInvalidEvent]
+}
+
+// PayloadInvalidEvent holds information about a Message whose Payload was rejected by a validator.
+type PayloadInvalidEvent struct {
+	MessageID   MessageID
+	PayloadType payload.Type
+	Error       error
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,85 @@
+package tangle
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestPayloadValidator(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	testFramework := NewMessageTestFramework(tangle, WithGenesisOutput("G", 3))
+	tangle.Setup()
+
+	// the data payloads of the MessageTestFramework contain the alias of their Message
+	errForbiddenData := errors.New("forbidden data")
+	tangle.PayloadValidator.RegisterValidator(payload.GenericDataPayloadType, func(_ *Message, messagePayload payload.Payload) error {
+		if bytes.HasPrefix(messagePayload.(*payload.GenericDataPayload).Blob(), []byte("Forbidden")) {
+			return errForbiddenData
+		}
+		return nil
+	})
+	assert.True(t, tangle.PayloadValidator.HasValidators(payload.GenericDataPayloadType))
+	assert.False(t, tangle.PayloadValidator.HasValidators(ledgerstate.TransactionType))
+
+	var invalidPayloadEvents []*PayloadInvalidEvent
+	tangle.PayloadValidator.Events.PayloadInvalid.Attach(event.NewClosure(func(event *PayloadInvalidEvent) {
+		invalidPayloadEvents = append(invalidPayloadEvents, event)
+	}))
+	var invalidMessageEvents []*MessageInvalidEvent
+	tangle.Events.MessageInvalid.Attach(event.NewClosure(func(event *MessageInvalidEvent) {
+		invalidMessageEvents = append(invalidMessageEvents, event)
+	}))
+
+	testFramework.CreateMessage("Message1", WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Forbidden1", WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message2", WithStrongParents("Message1"), WithInputs("G"), WithOutput("A", 3))
+	testFramework.IssueMessages("Message1", "Forbidden1", "Message2").WaitMessagesBooked()
+
+	assert.True(t, testFramework.MessageMetadata("Message1").IsBooked())
+	assert.True(t, testFramework.MessageMetadata("Message2").IsBooked())
+	assert.False(t, testFramework.MessageMetadata("Forbidden1").IsBooked())
+	assert.True(t, testFramework.MessageMetadata("Forbidden1").IsObjectivelyInvalid())
+
+	require.Len(t, invalidPayloadEvents, 1)
+	assert.Equal(t, testFramework.Message("Forbidden1").ID(), invalidPayloadEvents[0].MessageID)
+	assert.Equal(t, payload.GenericDataPayloadType, invalidPayloadEvents[0].PayloadType)
+	assert.True(t, errors.Is(invalidPayloadEvents[0].Error, ErrPayloadInvalid))
+
+	require.Len(t, invalidMessageEvents, 1)
+	assert.Equal(t, testFramework.Message("Forbidden1").ID(), invalidMessageEvents[0].MessageID)
+	assert.True(t, errors.Is(invalidMessageEvents[0].Error, ErrPayloadInvalid))
+}
+
+func TestPayloadValidator_Validate(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	var calls []string
+	tangle.PayloadValidator.RegisterValidator(payload.GenericDataPayloadType, func(*Message, payload.Payload) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	tangle.PayloadValidator.RegisterValidator(payload.GenericDataPayloadType, func(*Message, payload.Payload) error {
+		calls = append(calls, "second")
+		return errors.New("rejected")
+	})
+	tangle.PayloadValidator.RegisterValidator(payload.GenericDataPayloadType, func(*Message, payload.Payload) error {
+		calls = append(calls, "third")
+		return nil
+	})
+
+	err := tangle.PayloadValidator.Validate(newTestDataMessage("data"))
+	assert.True(t, errors.Is(err, ErrPayloadInvalid))
+	assert.Contains(t, err.Error(), "rejected")
+	assert.Equal(t, []string{"first", "second"}, calls)
+}
@@ -5,10 +5,10 @@ import (
 	"sync"
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/tangle/schedulerutils"
 
 	"github.com/cockroachdb/errors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"go.uber.org/atomic"
 )
@@ -65,7 +65,7 @@ func NewRateSetter(tangle *Tangle) *RateSetter {
 	rateSetter := &RateSetter{
 		tangle: tangle,
 		Events: &RateSetterEvents{
-			MessageDiscarded: events.NewEvent(MessageIDCaller),
+			MessageDiscarded: event.New[MessageID]("RateSetter.MessageDiscarded"),
 		},
 		self:           tangle.Options.Identity.ID(),
 		issuingQueue:   schedulerutils.NewNodeQueue(tangle.Options.Identity.ID()),
@@ -86,7 +86,7 @@ func NewRateSetter(tangle *Tangle) *RateSetter {
 // Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
 func (r *RateSetter) Setup() {
 	// update own rate setting
-	r.tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(MessageID) {
+	r.tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(MessageID) {
 		if r.pauseUpdates > 0 {
 			r.pauseUpdates--
 			return
@@ -145,17 +145,29 @@ func (r *RateSetter) rateSetting() {
 
 func (r *RateSetter) issuerLoop() {
 	var (
-		issueTimer    = time.NewTimer(0) // setting this to 0 will cause a trigger right away
+		issueSignal = make(chan struct{}, 1)
+		signalIssue = func() {
+			select {
+			case issueSignal <- struct{}{}:
+			default:
+			}
+		}
+		issueTimer    = r.tangle.Options.Clock.AfterFunc(0, signalIssue) // setting this to 0 will cause a trigger right away
 		timerStopped  = false
-		lastIssueTime = time.Now()
+		lastIssueTime = r.tangle.Options.Clock.Now()
 	)
-	defer issueTimer.Stop()
+	// the timer is replaced whenever it is reset, so the deferred call must not bind the first one
+	defer func() { issueTimer.Stop() }()
+	resetIssueTimer := func(next *Message) {
+		issueTimer.Stop()
+		issueTimer = r.tangle.Options.Clock.AfterFunc(lastIssueTime.Add(r.issueInterval(next)).Sub(r.tangle.Options.Clock.Now()), signalIssue)
+	}
 
 loop:
 	for {
 		select {
 		// a new message can be submitted to the scheduler
-		case <-issueTimer.C:
+		case <-issueSignal:
 			timerStopped = true
 			if r.issuingQueue.Front() == nil {
 				continue
@@ -165,10 +177,10 @@ loop:
 			if err := r.tangle.Scheduler.SubmitAndReady(msg.ID()); err != nil {
 				r.Events.MessageDiscarded.Trigger(msg.ID())
 			}
-			lastIssueTime = time.Now()
+			lastIssueTime = r.tangle.Options.Clock.Now()
 
 			if next := r.issuingQueue.Front(); next != nil {
-				issueTimer.Reset(time.Until(lastIssueTime.Add(r.issueInterval(next.(*Message)))))
+				resetIssueTimer(next.(*Message))
 				timerStopped = false
 			}
 
@@ -188,7 +200,8 @@ loop:
 				break
 			}
 			if next := r.issuingQueue.Front(); next != nil {
-				issueTimer.Reset(time.Until(lastIssueTime.Add(r.issueInterval(next.(*Message)))))
+				resetIssueTimer(next.(*Message))
+				timerStopped = false
 			}
 
 		// on close, exit the loop
@@ -199,7 +212,7 @@ loop:
 
 	// discard all remaining messages at shutdown
 	for _, id := range r.issuingQueue.IDs() {
-		r.Events.MessageDiscarded.Trigger(id)
+		r.Events.MessageDiscarded.Trigger(MessageID(id))
 	}
 }
 
@@ -214,7 +227,7 @@ func (r *RateSetter) issueInterval(msg *Message) time.Duration {
 
 // RateSetterEvents represents events happening in the rate setter.
 type RateSetterEvents struct {
-	MessageDiscarded *events.Event
+	MessageDiscarded *event.Event[MessageID]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
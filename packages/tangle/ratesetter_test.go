@@ -4,10 +4,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/stretchr/testify/assert"
 )
@@ -51,7 +51,7 @@ func TestRateSetter_ErrorHandling(t *testing.T) {
 	defer rateSetter.Shutdown()
 
 	messageDiscarded := make(chan MessageID, 1)
-	discardedCounter := events.NewClosure(func(id MessageID) { messageDiscarded <- id })
+	discardedCounter := event.NewClosure(func(id MessageID) { messageDiscarded <- id })
 	rateSetter.Events.MessageDiscarded.Attach(discardedCounter)
 
 	msg, _ := NewMessage(
@@ -5,8 +5,11 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/crypto"
-	"github.com/iotaledger/hive.go/events"
-	"github.com/iotaledger/hive.go/timedexecutor"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/types"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 // region Requester ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -14,8 +17,8 @@ import (
 // Requester takes care of requesting messages.
 type Requester struct {
 	tangle            *Tangle
-	timedExecutor     *timedexecutor.TimedExecutor
-	scheduledRequests map[MessageID]*timedexecutor.ScheduledTask
+	scheduledRequests map[MessageID]clock.Timer
+	requestStatuses   map[MessageID]*RequestStatus
 	options           RequesterOptions
 	Events            RequesterEvents
 
@@ -26,14 +29,14 @@ type Requester struct {
 func NewRequester(tangle *Tangle, optionalOptions ...RequesterOption) *Requester {
 	requester := &Requester{
 		tangle:            tangle,
-		timedExecutor:     timedexecutor.New(1),
-		scheduledRequests: make(map[MessageID]*timedexecutor.ScheduledTask),
+		scheduledRequests: make(map[MessageID]clock.Timer),
+		requestStatuses:   make(map[MessageID]*RequestStatus),
 		options:           DefaultRequesterOptions.Apply(optionalOptions...),
 		Events: RequesterEvents{
-			RequestIssued:  events.NewEvent(sendRequestEventHandler),
-			RequestStarted: events.NewEvent(MessageIDCaller),
-			RequestStopped: events.NewEvent(MessageIDCaller),
-			RequestFailed:  events.NewEvent(MessageIDCaller),
+			RequestIssued:  event.New[*SendRequestEvent]("Requester.RequestIssued"),
+			RequestStarted: event.New[MessageID]("Requester.RequestStarted"),
+			RequestStopped: event.New[MessageID]("Requester.RequestStopped"),
+			RequestFailed:  event.New[MessageID]("Requester.RequestFailed"),
 		},
 	}
 
@@ -42,7 +45,8 @@ func NewRequester(tangle *Tangle, optionalOptions ...RequesterOption) *Requester
 	defer requester.scheduledRequestsMutex.Unlock()
 
 	for _, id := range tangle.Storage.MissingMessages() {
-		requester.scheduledRequests[id] = requester.timedExecutor.ExecuteAfter(requester.createReRequest(id, 0), requester.options.RetryInterval+time.Duration(crypto.Randomness.Float64()*float64(requester.options.RetryJitter)))
+		requester.requestStatuses[id] = newRequestStatus(id, tangle.Options.Clock.Now())
+		requester.scheduledRequests[id] = requester.scheduleReRequest(id, 0)
 	}
 
 	return requester
@@ -50,13 +54,21 @@ func NewRequester(tangle *Tangle, optionalOptions ...RequesterOption) *Requester
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (r *Requester) Setup() {
-	r.tangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(r.StartRequest))
-	r.tangle.Storage.Events.MissingMessageStored.Attach(events.NewClosure(r.StopRequest))
+	r.tangle.Solidifier.Events.MessageMissing.Attach(event.NewClosure(r.StartRequest))
+	r.tangle.Storage.Events.MissingMessageStored.Attach(event.NewClosure(r.StopRequest))
+	r.tangle.Parser.RecentlySeenBytesFilter().OnRequested(r.IsRequested)
 }
 
-// Shutdown shuts down the Requester.
+// Shutdown shuts down the Requester and cancels the scheduled requests.
 func (r *Requester) Shutdown() {
-	r.timedExecutor.Shutdown(timedexecutor.CancelPendingTasks)
+	r.scheduledRequestsMutex.Lock()
+	defer r.scheduledRequestsMutex.Unlock()
+
+	for id, scheduledRequest := range r.scheduledRequests {
+		scheduledRequest.Stop()
+		delete(r.scheduledRequests, id)
+		delete(r.requestStatuses, id)
+	}
 }
 
 // StartRequest initiates a regular triggering of the StartRequest event until it has been stopped using StopRequest.
@@ -70,13 +82,23 @@ func (r *Requester) StartRequest(id MessageID) {
 	}
 
 	// schedule the next request and trigger the event
-	r.scheduledRequests[id] = r.timedExecutor.ExecuteAfter(r.createReRequest(id, 0), r.options.RetryInterval+time.Duration(crypto.Randomness.Float64()*float64(r.options.RetryJitter)))
+	r.requestStatuses[id] = newRequestStatus(id, r.tangle.Options.Clock.Now())
+	r.scheduledRequests[id] = r.scheduleReRequest(id, 0)
 	r.scheduledRequestsMutex.Unlock()
 
 	r.Events.RequestStarted.Trigger(id)
 	r.Events.RequestIssued.Trigger(&SendRequestEvent{ID: id})
 }
 
+// IsRequested returns true if the message with the given ID is currently requested.
+func (r *Requester) IsRequested(id MessageID) bool {
+	r.scheduledRequestsMutex.RLock()
+	defer r.scheduledRequestsMutex.RUnlock()
+
+	_, requested := r.scheduledRequests[id]
+	return requested
+}
+
 // StopRequest stops requests for the given message to further happen.
 func (r *Requester) StopRequest(id MessageID) {
 	r.scheduledRequestsMutex.Lock()
@@ -87,8 +109,9 @@ func (r *Requester) StopRequest(id MessageID) {
 		return
 	}
 
-	timer.Cancel()
+	timer.Stop()
 	delete(r.scheduledRequests, id)
+	delete(r.requestStatuses, id)
 	r.scheduledRequestsMutex.Unlock()
 
 	r.Events.RequestStopped.Trigger(id)
@@ -105,10 +128,15 @@ func (r *Requester) reRequest(id MessageID, count int) {
 	if _, exists := r.scheduledRequests[id]; exists {
 		// increase the request counter
 		count++
+		if requestStatus, exists := r.requestStatuses[id]; exists {
+			requestStatus.RequestCount = count
+			requestStatus.LastRequestTime = r.tangle.Options.Clock.Now()
+		}
 
 		// if we have requested too often => stop the requests
 		if count > r.options.MaxRequestThreshold {
 			delete(r.scheduledRequests, id)
+			delete(r.requestStatuses, id)
 
 			r.Events.RequestFailed.Trigger(id)
 			r.tangle.Storage.DeleteMissingMessage(id)
@@ -116,7 +144,7 @@ func (r *Requester) reRequest(id MessageID, count int) {
 			return
 		}
 
-		r.scheduledRequests[id] = r.timedExecutor.ExecuteAfter(r.createReRequest(id, count), r.options.RetryInterval+time.Duration(crypto.Randomness.Float64()*float64(r.options.RetryJitter)))
+		r.scheduledRequests[id] = r.scheduleReRequest(id, count)
 		return
 	}
 }
@@ -128,8 +156,91 @@ func (r *Requester) RequestQueueSize() int {
 	return len(r.scheduledRequests)
 }
 
-func (r *Requester) createReRequest(msgID MessageID, count int) func() {
-	return func() { r.reRequest(msgID, count) }
+// AddRequestingPeer records that the peer with the given ID requested the given Message, which is requested by the
+// node itself. Peers that request the same missing Message indicate that it is missing in other parts of the network as
+// well. It returns false if the Message is not requested by the node.
+func (r *Requester) AddRequestingPeer(id MessageID, peerID identity.ID) (added bool) {
+	r.scheduledRequestsMutex.Lock()
+	defer r.scheduledRequestsMutex.Unlock()
+
+	requestStatus, exists := r.requestStatuses[id]
+	if !exists {
+		return false
+	}
+	requestStatus.requestingPeers[peerID] = types.Void
+
+	return true
+}
+
+// RequestStatuses returns the state of all requests of missing Messages.
+func (r *Requester) RequestStatuses() (requestStatuses []*RequestStatus) {
+	r.scheduledRequestsMutex.RLock()
+	defer r.scheduledRequestsMutex.RUnlock()
+
+	requestStatuses = make([]*RequestStatus, 0, len(r.requestStatuses))
+	for _, requestStatus := range r.requestStatuses {
+		requestStatuses = append(requestStatuses, requestStatus.clone())
+	}
+
+	return requestStatuses
+}
+
+// scheduleReRequest schedules the next request of the given Message on the Clock of the Tangle after the retry interval
+// and a random jitter.
+func (r *Requester) scheduleReRequest(msgID MessageID, count int) clock.Timer {
+	return r.tangle.Options.Clock.AfterFunc(r.options.RetryInterval+time.Duration(crypto.Randomness.Float64()*float64(r.options.RetryJitter)), func() {
+		r.reRequest(msgID, count)
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region RequestStatus ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// RequestStatus contains the state of the request of a missing Message.
+type RequestStatus struct {
+	// MessageID is the ID of the requested Message.
+	MessageID MessageID
+	// StartTime is the time at which the Message was requested for the first time.
+	StartTime time.Time
+	// LastRequestTime is the time at which the Message was requested for the last time.
+	LastRequestTime time.Time
+	// RequestCount is the number of times that the Message was requested again after the first request.
+	RequestCount int
+
+	requestingPeers map[identity.ID]types.Empty
+}
+
+// newRequestStatus creates the RequestStatus of a request of the given Message that started at the given time.
+func newRequestStatus(id MessageID, startTime time.Time) *RequestStatus {
+	return &RequestStatus{
+		MessageID:       id,
+		StartTime:       startTime,
+		LastRequestTime: startTime,
+		requestingPeers: make(map[identity.ID]types.Empty),
+	}
+}
+
+// RequestingPeers returns the IDs of the peers that requested the Message from the node as well.
+func (r *RequestStatus) RequestingPeers() (peerIDs []identity.ID) {
+	peerIDs = make([]identity.ID, 0, len(r.requestingPeers))
+	for peerID := range r.requestingPeers {
+		peerIDs = append(peerIDs, peerID)
+	}
+
+	return peerIDs
+}
+
+// clone creates a copy of the RequestStatus.
+func (r *RequestStatus) clone() (clone *RequestStatus) {
+	clone = newRequestStatus(r.MessageID, r.StartTime)
+	clone.LastRequestTime = r.LastRequestTime
+	clone.RequestCount = r.RequestCount
+	for peerID := range r.requestingPeers {
+		clone.requestingPeers[peerID] = types.Void
+	}
+
+	return clone
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -201,29 +312,25 @@ func MaxRequestThreshold(maxRequestThreshold int) RequesterOption {
 type RequesterEvents struct {
 	// RequestIssued is an event that is triggered when the requester wants to request the given Message from its
 	// neighbors.
-	RequestIssued *events.Event
+	RequestIssued *event.Event[*SendRequestEvent]
 
 	// RequestStarted is an event that is triggered when a new request is started.
-	RequestStarted *events.Event
+	RequestStarted *event.Event[MessageID]
 
 	// RequestStopped is an event that is triggered when a request is stopped.
-	RequestStopped *events.Event
+	RequestStopped *event.Event[MessageID]
 
 	// RequestFailed is an event that is triggered when a request is stopped after too many attempts.
-	RequestFailed *events.Event
+	RequestFailed *event.Event[MessageID]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region SendRequestEvent /////////////////////////////////////////////////////////////////////////////////////////////
 
-// SendRequestEvent represents the parameters of sendRequestEventHandler
+// SendRequestEvent represents the parameters of the RequestIssued event.
 type SendRequestEvent struct {
 	ID MessageID
 }
 
-func sendRequestEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(*SendRequestEvent))(params[0].(*SendRequestEvent))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,259 @@
+package tangle
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region ResettableTestTangle /////////////////////////////////////////////////////////////////////////////////////////
+
+// ResettableTestTangle is a test Tangle whose full persisted state (the storages of the Tangle, the Markers and the
+// ledger state including the BranchDAG) can be captured in a TangleSnapshot, restored from a TangleSnapshot and compared
+// against golden files. It allows to verify refactors of the consensus components byte-for-byte against recorded
+// behavior.
+//
+// To produce reproducible snapshots, the Tangle should be created with a clock.VirtualClock and the
+// MessageTestFrameworks with a wallet seed (see WithWalletSeed). The Scheduler is paused, so that Messages are only
+// scheduled when the test calls Scheduler.Step.
+type ResettableTestTangle struct {
+	*Tangle
+
+	store      kvstore.KVStore
+	options    []Option
+	frameworks []*MessageTestFramework
+}
+
+// NewResettableTestTangle is the constructor of the ResettableTestTangle. The options are passed to NewTestTangle
+// whenever the Tangle is (re)started.
+func NewResettableTestTangle(options ...Option) (resettableTestTangle *ResettableTestTangle) {
+	resettableTestTangle = &ResettableTestTangle{
+		store:   mapdb.NewMapDB(),
+		options: options,
+	}
+	resettableTestTangle.start()
+
+	return resettableTestTangle
+}
+
+// NewMessageTestFramework creates a MessageTestFramework for the Tangle that stays attached to the Tangle when it is
+// restarted to take or to restore a TangleSnapshot.
+func (r *ResettableTestTangle) NewMessageTestFramework(options ...MessageTestFrameworkOption) (messageTestFramework *MessageTestFramework) {
+	messageTestFramework = NewMessageTestFramework(r.Tangle, options...)
+	r.frameworks = append(r.frameworks, messageTestFramework)
+
+	return messageTestFramework
+}
+
+// Snapshot persists the state of the Tangle and returns it as a TangleSnapshot. The Tangle is restarted on the persisted
+// state, so it needs to be idle (all issued Messages need to be processed) when it is called. Messages that are waiting
+// in the buffer of the Scheduler are not part of the persisted state.
+func (r *ResettableTestTangle) Snapshot() (snapshot *TangleSnapshot) {
+	snapshot = &TangleSnapshot{
+		entries: make(map[string][]byte),
+		tips:    r.TipManager.AllTips(),
+		time:    r.Options.Clock.Now(),
+	}
+	for _, messageTestFramework := range r.frameworks {
+		snapshot.frameworks = append(snapshot.frameworks, messageTestFramework.state())
+	}
+
+	r.Tangle.Shutdown()
+	if err := r.store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		snapshot.entries[string(key)] = append([]byte{}, value...)
+		return true
+	}); err != nil {
+		panic(fmt.Sprintf("failed to read the persisted state of the Tangle: %s", err))
+	}
+	r.restart(snapshot)
+
+	return snapshot
+}
+
+// Restore resets the Tangle (and the attached MessageTestFrameworks) to the state of the given TangleSnapshot.
+func (r *ResettableTestTangle) Restore(snapshot *TangleSnapshot) {
+	r.Tangle.Shutdown()
+
+	if err := r.store.Clear(); err != nil {
+		panic(fmt.Sprintf("failed to clear the persisted state of the Tangle: %s", err))
+	}
+	for key, value := range snapshot.entries {
+		if err := r.store.Set([]byte(key), value); err != nil {
+			panic(fmt.Sprintf("failed to restore the persisted state of the Tangle: %s", err))
+		}
+	}
+
+	if virtualClock, isVirtualClock := r.Options.Clock.(*clock.VirtualClock); isVirtualClock {
+		virtualClock.Set(snapshot.time)
+	}
+	for i, frameworkState := range snapshot.frameworks {
+		r.frameworks[i].restoreState(frameworkState)
+	}
+
+	r.restart(snapshot)
+}
+
+// Shutdown shuts down the Tangle that is currently running.
+func (r *ResettableTestTangle) Shutdown() {
+	r.Tangle.Shutdown()
+}
+
+// start creates a Tangle on the store of the ResettableTestTangle and sets it up with a paused Scheduler.
+func (r *ResettableTestTangle) start() {
+	r.Tangle = NewTestTangle(append(append([]Option{}, r.options...), Store(r.store))...)
+	r.Scheduler.Pause()
+	r.Tangle.Setup()
+}
+
+// restart starts a new Tangle on the persisted state, re-attaches the MessageTestFrameworks and restores the tips of the
+// given TangleSnapshot, which are not persisted by the TipManager.
+func (r *ResettableTestTangle) restart(snapshot *TangleSnapshot) {
+	r.start()
+
+	for _, messageTestFramework := range r.frameworks {
+		messageTestFramework.attachTangle(r.Tangle)
+	}
+	for tip := range snapshot.tips {
+		r.Storage.Message(tip).Consume(r.TipManager.addTip)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TangleSnapshot ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// TangleSnapshot is the persisted state of a ResettableTestTangle at a given point in time.
+type TangleSnapshot struct {
+	entries    map[string][]byte
+	tips       MessageIDs
+	time       time.Time
+	frameworks []*messageTestFrameworkState
+}
+
+// Bytes returns a textual representation of the persisted state that lists the hex encoded key and value of every
+// entry in the order of the keys, grouped by the component that stored them.
+func (t *TangleSnapshot) Bytes() []byte {
+	keys := make([]string, 0, len(t.entries))
+	for key := range t.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buffer bytes.Buffer
+	var currentSection string
+	for _, key := range keys {
+		if section := snapshotSectionName(key[0]); section != currentSection {
+			currentSection = section
+			fmt.Fprintf(&buffer, "# %s\n", section)
+		}
+		fmt.Fprintf(&buffer, "%s %s\n", hex.EncodeToString([]byte(key)), hex.EncodeToString(t.entries[key]))
+	}
+
+	return buffer.Bytes()
+}
+
+// AssertGolden asserts that the persisted state equals the one recorded in the golden file at the given path. If
+// update is set, the golden file is (re)written with the current state instead.
+func (t *TangleSnapshot) AssertGolden(test *testing.T, goldenFilePath string, update bool) bool {
+	test.Helper()
+
+	if update {
+		if err := os.MkdirAll(filepath.Dir(goldenFilePath), 0o755); err != nil {
+			test.Fatalf("failed to create the directory of the golden file: %s", err)
+		}
+		if err := os.WriteFile(goldenFilePath, t.Bytes(), 0o644); err != nil {
+			test.Fatalf("failed to write golden file: %s", err)
+		}
+	}
+
+	golden, err := os.ReadFile(goldenFilePath)
+	if err != nil {
+		test.Fatalf("failed to read golden file (run the test with -update to record it): %s", err)
+	}
+
+	return assert.Equal(test, string(golden), string(t.Bytes()), "the state of the Tangle differs from %s", goldenFilePath)
+}
+
+// snapshotSectionName returns the name of the component that uses the given storage prefix. Entries that are stored
+// without a prefix (like the counters of the markers and the TimeManager) are grouped as "other".
+func snapshotSectionName(prefix byte) string {
+	switch prefix {
+	case database.PrefixTangle:
+		return "tangle"
+	case database.PrefixMarkers:
+		return "markers"
+	case database.PrefixLedgerState:
+		return "ledgerstate"
+	default:
+		return "other"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region messageTestFrameworkState ////////////////////////////////////////////////////////////////////////////////////
+
+// messageTestFrameworkState is the state of a MessageTestFramework that is captured by a TangleSnapshot, so that
+// Messages and Transactions created after a restore reference the aliases and outputs of the restored state.
+type messageTestFrameworkState struct {
+	branchIDs       map[string]ledgerstate.BranchID
+	messagesByAlias map[string]*Message
+	walletsByAlias  map[string]wallet
+	inputsByAlias   map[string]ledgerstate.Input
+	outputsByAlias  map[string]ledgerstate.Output
+	outputsByID     map[ledgerstate.OutputID]ledgerstate.Output
+	sequenceNumber  uint64
+}
+
+// state returns a copy of the state of the MessageTestFramework.
+func (m *MessageTestFramework) state() *messageTestFrameworkState {
+	return &messageTestFrameworkState{
+		branchIDs:       copyMap(m.branchIDs),
+		messagesByAlias: copyMap(m.messagesByAlias),
+		walletsByAlias:  copyMap(m.walletsByAlias),
+		inputsByAlias:   copyMap(m.inputsByAlias),
+		outputsByAlias:  copyMap(m.outputsByAlias),
+		outputsByID:     copyMap(m.outputsByID),
+		sequenceNumber:  m.sequenceNumber,
+	}
+}
+
+// restoreState resets the MessageTestFramework to the given state.
+func (m *MessageTestFramework) restoreState(state *messageTestFrameworkState) {
+	m.branchIDs = copyMap(state.branchIDs)
+	m.messagesByAlias = copyMap(state.messagesByAlias)
+	m.walletsByAlias = copyMap(state.walletsByAlias)
+	m.walletsByAddress = make(map[ledgerstate.Address]wallet, len(state.walletsByAlias))
+	for _, aliasWallet := range state.walletsByAlias {
+		m.walletsByAddress[aliasWallet.address] = aliasWallet
+	}
+	m.inputsByAlias = copyMap(state.inputsByAlias)
+	m.outputsByAlias = copyMap(state.outputsByAlias)
+	m.outputsByID = copyMap(state.outputsByID)
+	m.sequenceNumber = state.sequenceNumber
+}
+
+// copyMap returns a shallow copy of the given map.
+func copyMap[K comparable, V any](source map[K]V) (target map[K]V) {
+	target = make(map[K]V, len(source))
+	for key, value := range source {
+		target[key] = value
+	}
+
+	return target
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
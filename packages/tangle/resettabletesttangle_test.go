@@ -0,0 +1,67 @@
+package tangle
+
+import (
+	"flag"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestResettableTestTangle(t *testing.T) {
+	// the TipManager evicts tips according to the wall clock, so the virtual time lies in the future to keep them
+	virtualClock := clock.NewVirtualClock(time.Date(2100, time.January, 1, 0, 0, 0, 0, time.UTC))
+	testTangle := NewResettableTestTangle(Clock(virtualClock))
+	defer testTangle.Shutdown()
+
+	testFramework := testTangle.NewMessageTestFramework(WithGenesisOutput("G", 3), WithWalletSeed([]byte("golden")))
+	issueMessage := func(messageAlias string, messageOptions ...MessageOption) {
+		virtualClock.Advance(time.Second)
+		testFramework.CreateMessage(messageAlias, messageOptions...)
+		testFramework.IssueMessages(messageAlias).WaitApprovalWeightProcessed()
+
+		scheduledMessageID, scheduled, err := testTangle.Scheduler.Step()
+		require.NoError(t, err)
+		require.True(t, scheduled)
+		require.Equal(t, testFramework.Message(messageAlias).ID(), scheduledMessageID)
+
+		// scheduled Messages are dispatched to the TipManager asynchronously
+		require.Eventually(t, func() bool {
+			return testTangle.TipManager.AllTips().Contains(scheduledMessageID)
+		}, time.Second, time.Millisecond)
+	}
+
+	// Message2 and Message3 double spend the genesis output and create two conflict branches
+	issueMessage("Message1", WithStrongParents("Genesis"))
+	issueMessage("Message2", WithStrongParents("Message1"), WithInputs("G"), WithOutput("A", 3))
+	issueMessage("Message3", WithStrongParents("Message1"), WithInputs("G"), WithOutput("B", 3))
+
+	conflictSnapshot := testTangle.Snapshot()
+	conflictSnapshot.AssertGolden(t, filepath.Join("testdata", "resettabletesttangle_conflict.golden"), *update)
+	conflictTips := NewMessageIDs(testFramework.Message("Message2").ID(), testFramework.Message("Message3").ID())
+	assert.Equal(t, conflictTips, testTangle.TipManager.AllTips())
+
+	// the restarted Tangle continues with the persisted state
+	issueMessage("Message4", WithStrongParents("Message2", "Message3"), WithInputs("A"), WithOutput("C", 3))
+	assert.True(t, testFramework.MessageMetadata("Message4").IsBooked())
+	assert.Equal(t, NewMessageIDs(testFramework.Message("Message4").ID()), testTangle.TipManager.AllTips())
+
+	extendedSnapshot := testTangle.Snapshot()
+	assert.NotEqual(t, conflictSnapshot.Bytes(), extendedSnapshot.Bytes())
+
+	// restoring the state rolls back the Tangle, the tips, the clock and the MessageTestFramework
+	testTangle.Restore(conflictSnapshot)
+	assert.Equal(t, string(conflictSnapshot.Bytes()), string(testTangle.Snapshot().Bytes()))
+	assert.Equal(t, conflictTips, testTangle.TipManager.AllTips())
+	require.Nil(t, testFramework.Message("Message4"))
+
+	// replaying the same Messages reproduces the same state byte-for-byte
+	issueMessage("Message4", WithStrongParents("Message2", "Message3"), WithInputs("A"), WithOutput("C", 3))
+	assert.Equal(t, string(extendedSnapshot.Bytes()), string(testTangle.Snapshot().Bytes()))
+}
@@ -6,12 +6,12 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/typeutils"
 	"go.uber.org/atomic"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/tangle/schedulerutils"
 )
 
@@ -24,8 +24,13 @@ const (
 	MinMana float64 = 1.0
 )
 
-// ErrNotRunning is returned when a message is submitted when the scheduler has been stopped.
-var ErrNotRunning = errors.New("scheduler stopped")
+var (
+	// ErrNotRunning is returned when a message is submitted when the scheduler has been stopped.
+	ErrNotRunning = errors.New("scheduler stopped")
+
+	// ErrNotPaused is returned when the scheduler is stepped while it is not paused.
+	ErrNotPaused = errors.New("scheduler not paused")
+)
 
 // SchedulerParams defines the scheduler config parameters.
 type SchedulerParams struct {
@@ -35,6 +40,10 @@ type SchedulerParams struct {
 	TotalAccessManaRetrieveFunc       func() float64
 	AccessManaMapRetrieverFunc        func() map[identity.ID]float64
 	ConfirmedMessageScheduleThreshold time.Duration
+	DropPolicy                        schedulerutils.DropPolicy
+	// PriorityLaneShare is the share of the bandwidth that the priority lane can claim while other messages are ready
+	// (0 disables the priority lane).
+	PriorityLaneShare float64
 }
 
 // Scheduler is a Tangle component that takes care of scheduling the messages that shall be booked.
@@ -42,17 +51,25 @@ type Scheduler struct {
 	Events *SchedulerEvents
 
 	tangle                *Tangle
-	ticker                *time.Ticker
+	ticker                clock.Ticker
 	started               typeutils.AtomicBool
 	stopped               typeutils.AtomicBool
+	paused                bool
 	accessManaCache       *schedulerutils.AccessManaCache
 	mu                    sync.RWMutex
 	buffer                *schedulerutils.BufferQueue
 	deficits              map[identity.ID]float64
+	droppedMessagesCount  map[string]uint64
 	rate                  *atomic.Duration
 	confirmedMsgThreshold time.Duration
+	priorityLane          *schedulerutils.NodeQueue
+	priorityLaneShare     float64
+	priorityClassifiers   []func(message *Message) bool
+	priorityCredit        float64
+	priorityLaneStats     PriorityLaneStats
 	shutdownSignal        chan struct{}
 	shutdownOnce          sync.Once
+	shutdownWG            sync.WaitGroup
 }
 
 // NewScheduler returns a new Scheduler.
@@ -72,21 +89,31 @@ func NewScheduler(tangle *Tangle) *Scheduler {
 
 	accessManaCache := schedulerutils.NewAccessManaCache(tangle.Options.SchedulerParams.AccessManaMapRetrieverFunc, MinMana)
 
+	// share of the bandwidth that can be claimed by the priority lane
+	priorityLaneShare := tangle.Options.SchedulerParams.PriorityLaneShare
+	if priorityLaneShare < 0 || priorityLaneShare >= 1 {
+		panic("scheduler: the option PriorityLaneShare must be at least 0 and less than 1")
+	}
+
 	return &Scheduler{
 		Events: &SchedulerEvents{
-			MessageScheduled: events.NewEvent(MessageIDCaller),
-			MessageDiscarded: events.NewEvent(MessageIDCaller),
-			MessageSkipped:   events.NewEvent(MessageIDCaller),
-			NodeBlacklisted:  events.NewEvent(NodeIDCaller),
-			Error:            events.NewEvent(events.ErrorCaller),
+			MessageScheduled: event.New[MessageID]("Scheduler.MessageScheduled"),
+			MessageDiscarded: event.New[MessageID]("Scheduler.MessageDiscarded"),
+			MessageSkipped:   event.New[MessageID]("Scheduler.MessageSkipped"),
+			NodeBlacklisted:  event.New[identity.ID]("Scheduler.NodeBlacklisted"),
+			Error:            event.New[error]("Scheduler.Error"),
 		},
 		tangle:                tangle,
 		accessManaCache:       accessManaCache,
 		rate:                  atomic.NewDuration(tangle.Options.SchedulerParams.Rate),
-		ticker:                time.NewTicker(tangle.Options.SchedulerParams.Rate),
-		buffer:                schedulerutils.NewBufferQueue(maxBuffer, maxQueue),
+		ticker:                tangle.Options.Clock.NewTicker(tangle.Options.SchedulerParams.Rate),
+		buffer:                schedulerutils.NewBufferQueue(maxBuffer, maxQueue, tangle.Options.SchedulerParams.DropPolicy),
 		confirmedMsgThreshold: confirmedMessageScheduleThreshold,
+		priorityLane:          schedulerutils.NewNodeQueue(tangle.Options.Identity.ID()),
+		priorityLaneShare:     priorityLaneShare,
+		priorityCredit:        MaxDeficit,
 		deficits:              make(map[identity.ID]float64),
+		droppedMessagesCount:  make(map[string]uint64),
 		shutdownSignal:        make(chan struct{}),
 	}
 }
@@ -95,6 +122,7 @@ func NewScheduler(tangle *Tangle) *Scheduler {
 func (s *Scheduler) Start() {
 	s.started.Set()
 	// start the main loop
+	s.shutdownWG.Add(1)
 	go s.mainLoop()
 }
 
@@ -104,7 +132,8 @@ func (s *Scheduler) Running() bool {
 }
 
 // Shutdown shuts down the Scheduler.
-// Shutdown blocks until the scheduler has been shutdown successfully.
+// Shutdown blocks until the scheduler has been shutdown successfully, i.e. until the main loop finished scheduling the
+// current message.
 func (s *Scheduler) Shutdown() {
 	s.shutdownOnce.Do(func() {
 		// lock the scheduler to make sure that any Submit() has been finished
@@ -113,12 +142,25 @@ func (s *Scheduler) Shutdown() {
 		s.stopped.Set()
 		close(s.shutdownSignal)
 	})
+
+	s.shutdownWG.Wait()
 }
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
 func (s *Scheduler) Setup() {
 	// pass booked messages to the scheduler
-	s.tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(events.NewClosure(func(messageID MessageID) {
+	s.tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(event.NewClosure(func(messageID MessageID) {
+		// messages that were booked below max depth are never scheduled, but they don't block their children either
+		belowMaxDepth := false
+		s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			belowMaxDepth = messageMetadata.IsBelowMaxDepth()
+		})
+		if belowMaxDepth {
+			s.Events.MessageSkipped.Trigger(messageID)
+			s.updateApprovers(messageID)
+			return
+		}
+
 		if err := s.Submit(messageID); err != nil {
 			if !errors.Is(err, schedulerutils.ErrInsufficientMana) {
 				s.Events.Error.Trigger(errors.Errorf("failed to submit to scheduler: %w", err))
@@ -127,7 +169,11 @@ func (s *Scheduler) Setup() {
 		s.tryReady(messageID)
 	}))
 
-	s.tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(s.updateApprovers))
+	s.tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(s.updateApprovers))
+
+	s.tangle.Blacklist.Events.IssuerBlacklisted.Attach(event.NewClosure(func(entry *BlacklistEntry) {
+		s.Events.NodeBlacklisted.Trigger(entry.IssuerID)
+	}))
 
 	onMessageConfirmed := func(messageID MessageID) {
 		var scheduled bool
@@ -138,7 +184,7 @@ func (s *Scheduler) Setup() {
 			return
 		}
 		s.tangle.Storage.Message(messageID).Consume(func(message *Message) {
-			if clock.Since(message.IssuingTime()) > s.confirmedMsgThreshold {
+			if s.tangle.Options.Clock.Since(message.IssuingTime()) > s.confirmedMsgThreshold {
 				err := s.Unsubmit(messageID)
 				if err != nil {
 					s.Events.Error.Trigger(errors.Errorf("failed to unsubmit confirmed message from scheduler: %w", err))
@@ -148,11 +194,89 @@ func (s *Scheduler) Setup() {
 		})
 		s.updateApprovers(messageID)
 	}
-	s.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(events.NewClosure(onMessageConfirmed))
+	s.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(event.NewClosure(onMessageConfirmed))
 
 	s.Start()
 }
 
+// Pause stops the scheduling of messages until Resume is called. Messages are still submitted to the buffer while the
+// scheduler is paused and can be scheduled one by one with Step. This is meant for debugging the congestion control.
+func (s *Scheduler) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paused = true
+}
+
+// Resume continues the scheduling of messages after the scheduler was paused.
+func (s *Scheduler) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paused = false
+}
+
+// Paused returns true if the scheduler is paused.
+func (s *Scheduler) Paused() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.paused
+}
+
+// Step schedules the next message while the scheduler is paused, regardless of the rate. It returns false if no
+// message is ready to be scheduled.
+func (s *Scheduler) Step() (messageID MessageID, scheduled bool, err error) {
+	if s.stopped.IsSet() {
+		return EmptyMessageID, false, ErrNotRunning
+	}
+	if !s.Paused() {
+		return EmptyMessageID, false, ErrNotPaused
+	}
+
+	msg := s.schedule(true)
+	if msg == nil {
+		return EmptyMessageID, false, nil
+	}
+	s.markScheduled(msg)
+
+	return msg.ID(), true, nil
+}
+
+// BufferSnapshot returns the contents of the buffer, i.e. the queues of all active nodes in the round-robin order in
+// which they are visited by the scheduler, starting with the queue that is visited next.
+func (s *Scheduler) BufferSnapshot() (snapshot []*NodeQueueSnapshot) {
+	// iterating the buffer moves its current position, so we need the write lock even though the buffer is not changed
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := s.buffer.Current()
+	if start == nil {
+		return nil
+	}
+	for q := start; ; {
+		nodeQueueSnapshot := &NodeQueueSnapshot{
+			NodeID:  q.NodeID(),
+			Mana:    s.nodeMana(q.NodeID()),
+			Deficit: s.getDeficit(q.NodeID()),
+			Size:    q.Size(),
+		}
+		for _, element := range q.ReadyElements() {
+			nodeQueueSnapshot.ReadyMessages = append(nodeQueueSnapshot.ReadyMessages, MessageID(schedulerutils.ElementIDFromBytes(element.IDBytes())))
+		}
+		for _, element := range q.SubmittedElements() {
+			nodeQueueSnapshot.SubmittedMessages = append(nodeQueueSnapshot.SubmittedMessages, MessageID(schedulerutils.ElementIDFromBytes(element.IDBytes())))
+		}
+		snapshot = append(snapshot, nodeQueueSnapshot)
+
+		if q = s.buffer.Next(); q == start {
+			break
+		}
+	}
+
+	return snapshot
+}
+
 // SetRate sets the rate of the scheduler.
 func (s *Scheduler) SetRate(rate time.Duration) {
 	// only update the ticker when the scheduler is running
@@ -220,6 +344,53 @@ func (s *Scheduler) TotalMessagesCount() int {
 	return s.buffer.TotalMessagesCount()
 }
 
+// DropPolicy returns the policy that decides which messages are dropped when the buffer is full.
+func (s *Scheduler) DropPolicy() schedulerutils.DropPolicy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.buffer.DropPolicy()
+}
+
+// SetDropPolicy sets the policy that decides which messages are dropped when the buffer is full.
+func (s *Scheduler) SetDropPolicy(dropPolicy schedulerutils.DropPolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.buffer.SetDropPolicy(dropPolicy)
+}
+
+// DroppedMessagesCount returns the number of messages dropped because of a full buffer per name of the DropPolicy.
+func (s *Scheduler) DroppedMessagesCount() map[string]uint64 {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	droppedMessagesCount := make(map[string]uint64, len(s.droppedMessagesCount))
+	for dropPolicyName, count := range s.droppedMessagesCount {
+		droppedMessagesCount[dropPolicyName] = count
+	}
+	return droppedMessagesCount
+}
+
+// RegisterPriorityClassifier registers a function that identifies the consensus-critical messages (like activity or
+// anchor messages) that are scheduled in the priority lane. Only the messages that are issued by the node itself are
+// classified, so that other nodes can not bypass the fair scheduling of the buffer.
+func (s *Scheduler) RegisterPriorityClassifier(classifier func(message *Message) bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.priorityClassifiers = append(s.priorityClassifiers, classifier)
+}
+
+// PriorityLaneStats returns the statistics of the priority lane.
+func (s *Scheduler) PriorityLaneStats() (stats PriorityLaneStats) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	stats = s.priorityLaneStats
+	stats.Size = s.priorityLane.Size()
+
+	return stats
+}
+
 // AccessManaCache returns the object which caches access mana values.
 func (s *Scheduler) AccessManaCache() *schedulerutils.AccessManaCache {
 	return s.accessManaCache
@@ -296,20 +467,30 @@ func (s *Scheduler) Clear() {
 
 	for q := s.buffer.Current(); q != nil; q = s.buffer.Next() {
 		s.buffer.RemoveNode(q.NodeID())
-		for _, id := range q.IDs() {
-			messageID := MessageID(id)
-			s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
-				messageMetadata.SetDiscardedTime(clock.SyncedTime())
-			})
-			s.Events.MessageDiscarded.Trigger(messageID)
-		}
+		s.discard(q.IDs())
+	}
+
+	s.discard(s.priorityLane.IDs())
+	s.priorityLane = schedulerutils.NewNodeQueue(s.priorityLane.NodeID())
+}
+
+// discard marks the given messages as discarded and triggers the MessageDiscarded event.
+func (s *Scheduler) discard(ids []schedulerutils.ElementID) {
+	for _, id := range ids {
+		messageID := MessageID(id)
+		s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			messageMetadata.SetDiscardedTime(s.tangle.Options.Clock.Now())
+		})
+		s.Events.MessageDiscarded.Trigger(messageID)
 	}
 }
 
-// isEligible returns true if the given messageID has either been scheduled or confirmed.
+// isEligible returns true if the given messageID has either been scheduled, confirmed or skipped because it was booked
+// below max depth.
 func (s *Scheduler) isEligible(messageID MessageID) (eligible bool) {
 	s.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
 		eligible = messageMetadata.Scheduled() ||
+			messageMetadata.IsBelowMaxDepth() ||
 			s.tangle.ConfirmationOracle.IsMessageConfirmed(messageID)
 	})
 	return
@@ -354,13 +535,25 @@ func (s *Scheduler) submit(message *Message) error {
 
 	s.tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
 		// shortly before submitting we set the queued time
-		messageMetadata.SetQueuedTime(clock.SyncedTime())
+		messageMetadata.SetQueuedTime(s.tangle.Options.Clock.Now())
 	})
+
+	// consensus-critical messages of the node itself bypass the buffer
+	if s.isPriority(message) {
+		s.priorityLane.Submit(message)
+		return nil
+	}
+
 	// when removing the zero mana node solution, check if nodes have MinMana here
-	droppedMessageIDs := s.buffer.Submit(message, s.accessManaCache.GetCachedMana)
+	droppedMessageIDs := s.buffer.Submit(message, s.nodeMana)
+	s.droppedMessagesCount[s.buffer.DropPolicy().Name()] += uint64(len(droppedMessageIDs))
 	for _, droppedMsgID := range droppedMessageIDs {
 		s.tangle.Storage.MessageMetadata(MessageID(droppedMsgID)).Consume(func(messageMetadata *MessageMetadata) {
-			messageMetadata.SetDiscardedTime(clock.SyncedTime())
+			messageMetadata.SetDiscardedTime(s.tangle.Options.Clock.Now())
+		})
+		// messages are dropped from the queues that exceed their mana-based share of the buffer the most
+		s.tangle.Storage.Message(MessageID(droppedMsgID)).Consume(func(droppedMessage *Message) {
+			s.tangle.Blacklist.RecordRateViolation(identity.NewID(droppedMessage.IssuerPublicKey()))
 		})
 		s.Events.MessageDiscarded.Trigger(MessageID(droppedMsgID))
 	}
@@ -368,23 +561,85 @@ func (s *Scheduler) submit(message *Message) error {
 }
 
 func (s *Scheduler) unsubmit(message *Message) {
-	s.buffer.Unsubmit(message)
+	if !s.priorityLane.Unsubmit(message) {
+		s.buffer.Unsubmit(message)
+	}
 }
 
 func (s *Scheduler) ready(message *Message) {
-	s.buffer.Ready(message)
+	if !s.priorityLane.Ready(message) {
+		s.buffer.Ready(message)
+	}
+}
+
+// isPriority returns true if the given message is a consensus-critical message of the node itself that is scheduled in
+// the priority lane.
+func (s *Scheduler) isPriority(message *Message) bool {
+	if s.priorityLaneShare == 0 || message.IssuerPublicKey() != s.tangle.Options.Identity.PublicKey() {
+		return false
+	}
+
+	for _, classifier := range s.priorityClassifiers {
+		if classifier(message) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// schedulePriority removes the next ready message from the priority lane. Unless ignoreShare is set, the message is only
+// scheduled if the priority lane has enough credit, i.e. if it did not exceed its share of the bandwidth.
+func (s *Scheduler) schedulePriority(ignoreShare bool) *Message {
+	msg := s.priorityLane.Front()
+	if msg == nil || s.tangle.Options.Clock.Now().Before(msg.IssuingTime()) {
+		return nil
+	}
+	if !ignoreShare && s.priorityCredit < float64(msg.Size()) {
+		return nil
+	}
+
+	s.priorityLane.PopFront()
+	s.priorityCredit = math.Max(s.priorityCredit-float64(msg.Size()), 0)
+	s.priorityLaneStats.ScheduledMessages++
+	s.priorityLaneStats.ScheduledBytes += uint64(msg.Size())
+	s.priorityLaneStats.TotalScheduledBytes += uint64(msg.Size())
+
+	return msg.(*Message)
 }
 
-func (s *Scheduler) schedule() *Message {
+// grantPriorityCredit grants credit to the priority lane for a message that was scheduled from the buffer, so that the
+// priority lane can claim its share of the bandwidth.
+func (s *Scheduler) grantPriorityCredit(size int) {
+	s.priorityLaneStats.TotalScheduledBytes += uint64(size)
+	if s.priorityLaneShare == 0 {
+		return
+	}
+
+	s.priorityCredit = math.Min(s.priorityCredit+float64(size)*s.priorityLaneShare/(1-s.priorityLaneShare), MaxDeficit)
+}
+
+// schedule removes the next message that is allowed to be scheduled from the buffer. While the scheduler is paused,
+// messages are only scheduled if step is set.
+func (s *Scheduler) schedule(step bool) *Message {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	if s.paused && !step {
+		return nil
+	}
+
 	s.updateActiveNodesList(s.accessManaCache.RawAccessManaVector())
 
+	// the priority lane is served first as long as it does not exceed its share of the bandwidth
+	if msg := s.schedulePriority(false); msg != nil {
+		return msg
+	}
+
 	start := s.buffer.Current()
 	// no messages submitted
 	if start == nil {
-		return nil
+		return s.schedulePriority(true)
 	}
 
 	var schedulingNode *schedulerutils.NodeQueue
@@ -394,12 +649,12 @@ func (s *Scheduler) schedule() *Message {
 		// a message can be scheduled, if it is ready
 		// (its issuing time is not in the future and all of its parents are eligible).
 		// while loop to skip all the confirmed messages
-		for msg != nil && !clock.SyncedTime().Before(msg.IssuingTime()) {
+		for msg != nil && !s.tangle.Options.Clock.Now().Before(msg.IssuingTime()) {
 			msgID, _, err := MessageIDFromBytes(msg.IDBytes())
 			if err != nil {
 				panic("MessageID could not be parsed!")
 			}
-			if s.tangle.ConfirmationOracle.IsMessageConfirmed(msgID) && clock.Since(msg.IssuingTime()) > s.confirmedMsgThreshold {
+			if s.tangle.ConfirmationOracle.IsMessageConfirmed(msgID) && s.tangle.Options.Clock.Since(msg.IssuingTime()) > s.confirmedMsgThreshold {
 				// if a message is confirmed, and issued some time ago, don't schedule it and take the next one from the queue
 				// do we want to mark those messages somehow for debugging?
 				s.Events.MessageSkipped.Trigger(msgID)
@@ -408,7 +663,7 @@ func (s *Scheduler) schedule() *Message {
 			} else {
 				// compute how often the deficit needs to be incremented until the message can be scheduled
 				remainingDeficit := math.Dim(float64(msg.Size()), s.getDeficit(q.NodeID()))
-				nodeMana := s.accessManaCache.GetCachedMana(q.NodeID())
+				nodeMana := s.nodeMana(q.NodeID())
 				// find the first node that will be allowed to schedule a message
 				if r := int(math.Ceil(remainingDeficit / nodeMana)); r < rounds {
 					rounds = r
@@ -424,15 +679,15 @@ func (s *Scheduler) schedule() *Message {
 		}
 	}
 
-	// if there is no node with a ready message, we cannot schedule anything
+	// if there is no node with a ready message, the priority lane can exceed its share
 	if schedulingNode == nil {
-		return nil
+		return s.schedulePriority(true)
 	}
 
 	if rounds > 0 {
 		// increment every node's deficit for the required number of rounds
 		for q := start; ; {
-			s.updateDeficit(q.NodeID(), float64(rounds)*s.accessManaCache.GetCachedMana(q.NodeID()))
+			s.updateDeficit(q.NodeID(), float64(rounds)*s.nodeMana(q.NodeID()))
 
 			q = s.buffer.Next()
 			if q == start {
@@ -443,13 +698,14 @@ func (s *Scheduler) schedule() *Message {
 
 	// increment the deficit for all nodes before schedulingNode one more time
 	for q := start; q != schedulingNode; q = s.buffer.Next() {
-		s.updateDeficit(q.NodeID(), s.accessManaCache.GetCachedMana(q.NodeID()))
+		s.updateDeficit(q.NodeID(), s.nodeMana(q.NodeID()))
 	}
 
 	// remove the message from the buffer and adjust node's deficit
 	msg := s.buffer.PopFront()
 	nodeID := identity.NewID(msg.IssuerPublicKey())
 	s.updateDeficit(nodeID, -float64(msg.Size()))
+	s.grantPriorityCredit(msg.Size())
 
 	return msg.(*Message)
 }
@@ -485,20 +741,17 @@ func (s *Scheduler) updateActiveNodesList(manaCache map[identity.ID]float64) {
 
 // mainLoop periodically triggers the scheduling of ready messages.
 func (s *Scheduler) mainLoop() {
+	defer s.shutdownWG.Done()
 	defer s.ticker.Stop()
 
 loop:
 	for {
 		select {
 		// every rate time units
-		case <-s.ticker.C:
+		case <-s.ticker.C():
 			// TODO: pause the ticker, if there are no ready messages
-			if msg := s.schedule(); msg != nil {
-				s.tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
-					if messageMetadata.SetScheduled(true) {
-						s.Events.MessageScheduled.Trigger(msg.ID())
-					}
-				})
+			if msg := s.schedule(false); msg != nil {
+				s.markScheduled(msg)
 			}
 
 		// on close, exit the loop
@@ -511,6 +764,25 @@ loop:
 	s.Clear()
 }
 
+// markScheduled marks the given message as scheduled and triggers the MessageScheduled event.
+func (s *Scheduler) markScheduled(msg *Message) {
+	s.tangle.Storage.MessageMetadata(msg.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		if messageMetadata.SetScheduled(true, s.tangle.Options.Clock.Now()) {
+			s.Events.MessageScheduled.Trigger(msg.ID())
+		}
+	})
+}
+
+// nodeMana returns the access mana that is used to schedule the messages of the given node. Blacklisted nodes are
+// deprioritized by only being granted the minimum amount of mana.
+func (s *Scheduler) nodeMana(nodeID identity.ID) float64 {
+	if s.tangle.Blacklist.IsBlacklisted(nodeID) {
+		return MinMana
+	}
+
+	return s.accessManaCache.GetCachedMana(nodeID)
+}
+
 func (s *Scheduler) getDeficit(nodeID identity.ID) float64 {
 	return s.deficits[nodeID]
 }
@@ -526,23 +798,66 @@ func (s *Scheduler) updateDeficit(nodeID identity.ID, d float64) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region NodeQueueSnapshot ////////////////////////////////////////////////////////////////////////////////////////////
+
+// NodeQueueSnapshot contains the state of the queue of a node in the buffer of the Scheduler.
+type NodeQueueSnapshot struct {
+	// NodeID is the ID of the node that the queue belongs to.
+	NodeID identity.ID
+	// Mana is the access mana that is used to schedule the messages of the node.
+	Mana float64
+	// Deficit is the deficit that the node accumulated.
+	Deficit float64
+	// Size is the total size of the messages in the queue.
+	Size int
+	// ReadyMessages contains the messages that are ready to be scheduled, in the order in which they are scheduled.
+	ReadyMessages []MessageID
+	// SubmittedMessages contains the messages whose parents are not eligible, yet.
+	SubmittedMessages []MessageID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PriorityLaneStats ////////////////////////////////////////////////////////////////////////////////////////////
+
+// PriorityLaneStats contains the statistics of the priority lane of the Scheduler, which allow to monitor which share
+// of the bandwidth is claimed by the consensus-critical messages of the node itself.
+type PriorityLaneStats struct {
+	// Size is the total size of the messages that are waiting in the priority lane.
+	Size int
+	// ScheduledMessages is the number of messages that were scheduled from the priority lane.
+	ScheduledMessages uint64
+	// ScheduledBytes is the total size of the messages that were scheduled from the priority lane.
+	ScheduledBytes uint64
+	// TotalScheduledBytes is the total size of all messages that were scheduled (from the buffer and the priority lane).
+	TotalScheduledBytes uint64
+}
+
+// Share returns the share of the scheduled bytes that were scheduled from the priority lane.
+func (p PriorityLaneStats) Share() float64 {
+	if p.TotalScheduledBytes == 0 {
+		return 0
+	}
+
+	return float64(p.ScheduledBytes) / float64(p.TotalScheduledBytes)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region SchedulerEvents /////////////////////////////////////////////////////////////////////////////////////////////
 
 // SchedulerEvents represents events happening in the Scheduler.
 type SchedulerEvents struct {
 	// MessageScheduled is triggered when a message is ready to be scheduled.
-	MessageScheduled *events.Event
-	// MessageDiscarded is triggered when a message is removed from the longest mana-scaled queue when the buffer is full.
-	MessageDiscarded *events.Event
-	// MessageSkipped is triggered when a message is confirmed before it's scheduled, and is skipped by the scheduler.
-	MessageSkipped  *events.Event
-	NodeBlacklisted *events.Event
-	Error           *events.Event
-}
-
-// NodeIDCaller is the caller function for events that hand over a NodeID.
-func NodeIDCaller(handler interface{}, params ...interface{}) {
-	handler.(func(identity.ID))(params[0].(identity.ID))
+	MessageScheduled *event.Event[MessageID]
+	// MessageDiscarded is triggered when a message is removed by the DropPolicy when the buffer is full.
+	MessageDiscarded *event.Event[MessageID]
+	// MessageSkipped is triggered when a message is confirmed before it's scheduled or is below max depth, and is
+	// skipped by the scheduler.
+	MessageSkipped *event.Event[MessageID]
+	// NodeBlacklisted is triggered when a node is blacklisted and its messages are deprioritized.
+	NodeBlacklisted *event.Event[identity.ID]
+	Error           *event.Event[error]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
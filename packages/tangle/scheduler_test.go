@@ -8,11 +8,12 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/atomic"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 	"github.com/iotaledger/goshimmer/packages/tangle/schedulerutils"
 )
@@ -91,7 +92,7 @@ func TestScheduler_Discarded(t *testing.T) {
 	noAManaNode := identity.GenerateIdentity()
 
 	messageDiscarded := make(chan MessageID, 1)
-	tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(id MessageID) { messageDiscarded <- id }))
+	tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(func(id MessageID) { messageDiscarded <- id }))
 
 	tangle.Scheduler.Start()
 
@@ -116,7 +117,7 @@ func TestScheduler_DiscardedAtShutdown(t *testing.T) {
 	defer tangle.Shutdown()
 
 	messageDiscarded := make(chan MessageID, 1)
-	tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(id MessageID) { messageDiscarded <- id }))
+	tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(func(id MessageID) { messageDiscarded <- id }))
 
 	tangle.Scheduler.Start()
 
@@ -137,6 +138,38 @@ func TestScheduler_DiscardedAtShutdown(t *testing.T) {
 	}, 1*time.Second, 10*time.Millisecond)
 }
 
+func TestScheduler_DropPolicy(t *testing.T) {
+	tangle := NewTestTangle(Identity(selfLocalIdentity))
+	defer tangle.Shutdown()
+
+	dropPolicy := &mockDropPolicy{}
+	tangle.Scheduler.SetDropPolicy(dropPolicy)
+	assert.Equal(t, dropPolicy, tangle.Scheduler.DropPolicy())
+
+	var discardedCount atomic.Int32
+	tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(func(MessageID) { discardedCount.Inc() }))
+
+	// submit large messages without starting the scheduler until the buffer overflows
+	for i := 0; i <= testMaxBuffer/(MaxMessageSize/2); i++ {
+		msg, _ := NewMessage(
+			emptyLikeReferencesFromStrongParents(NewMessageIDs(EmptyMessageID)),
+			time.Now(),
+			selfNode.PublicKey(),
+			uint64(i),
+			payload.NewGenericDataPayload(make([]byte, MaxMessageSize/2)),
+			0,
+			ed25519.Signature{},
+		)
+		tangle.Storage.StoreMessage(msg)
+		assert.NoError(t, tangle.Scheduler.Submit(msg.ID()))
+	}
+
+	assert.Positive(t, dropPolicy.dropCount)
+	assert.EqualValues(t, dropPolicy.dropCount, discardedCount.Load())
+	assert.Equal(t, map[string]uint64{"mock": uint64(dropPolicy.dropCount)}, tangle.Scheduler.DroppedMessagesCount())
+	assert.LessOrEqual(t, tangle.Scheduler.BufferSize(), testMaxBuffer)
+}
+
 func TestScheduler_SetRateBeforeStart(t *testing.T) {
 	tangle := NewTestTangle(Identity(selfLocalIdentity))
 	defer tangle.Shutdown()
@@ -151,7 +184,7 @@ func TestScheduler_Schedule(t *testing.T) {
 	defer tangle.Shutdown()
 
 	messageScheduled := make(chan MessageID, 1)
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(id MessageID) { messageScheduled <- id }))
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
 
 	tangle.Scheduler.Start()
 
@@ -171,6 +204,142 @@ func TestScheduler_Schedule(t *testing.T) {
 	}, 1*time.Second, 10*time.Millisecond)
 }
 
+func TestScheduler_VirtualClock(t *testing.T) {
+	virtualClock := clock.NewVirtualClock(time.Now())
+	tangle := NewTestTangle(Identity(selfLocalIdentity), Clock(virtualClock))
+	defer tangle.Shutdown()
+
+	messageScheduled := make(chan MessageID, 1)
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
+
+	tangle.Scheduler.Start()
+
+	msg := newMessage(peerNode.PublicKey())
+	tangle.Storage.StoreMessage(msg)
+	assert.NoError(t, tangle.Scheduler.Submit(msg.ID()))
+	assert.NoError(t, tangle.Scheduler.Ready(msg.ID()))
+
+	// the scheduler only ticks when the virtual time advances
+	assert.Never(t, func() bool { return len(messageScheduled) != 0 }, 100*time.Millisecond, 10*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		virtualClock.Advance(tangle.Scheduler.Rate())
+
+		select {
+		case id := <-messageScheduled:
+			return assert.Equal(t, msg.ID(), id)
+		default:
+			return false
+		}
+	}, 1*time.Second, 10*time.Millisecond)
+}
+
+func TestScheduler_PauseStep(t *testing.T) {
+	tangle := NewTestTangle(Identity(selfLocalIdentity))
+	defer tangle.Shutdown()
+
+	messageScheduled := make(chan MessageID, 2)
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
+
+	_, _, err := tangle.Scheduler.Step()
+	assert.ErrorIs(t, err, ErrNotPaused)
+
+	tangle.Scheduler.Pause()
+	assert.True(t, tangle.Scheduler.Paused())
+	tangle.Scheduler.Start()
+
+	// the step is a no-op if no message is ready
+	_, scheduled, err := tangle.Scheduler.Step()
+	assert.NoError(t, err)
+	assert.False(t, scheduled)
+
+	// submit two messages of a different node and only mark the newer one as ready
+	msgSubmitted := newMessageWithTimestamp(peerNode.PublicKey(), time.Now().Add(-2*time.Second))
+	msgReady := newMessageWithTimestamp(peerNode.PublicKey(), time.Now().Add(-time.Second))
+	for _, msg := range []*Message{msgSubmitted, msgReady} {
+		tangle.Storage.StoreMessage(msg)
+		assert.NoError(t, tangle.Scheduler.Submit(msg.ID()))
+	}
+	assert.NoError(t, tangle.Scheduler.Ready(msgReady.ID()))
+
+	// the paused scheduler does not schedule any message
+	time.Sleep(100 * time.Millisecond)
+	assert.Empty(t, messageScheduled)
+
+	var peerQueue *NodeQueueSnapshot
+	for _, nodeQueue := range tangle.Scheduler.BufferSnapshot() {
+		if nodeQueue.NodeID == peerNode.ID() {
+			peerQueue = nodeQueue
+		}
+	}
+	if assert.NotNil(t, peerQueue) {
+		assert.Equal(t, []MessageID{msgReady.ID()}, peerQueue.ReadyMessages)
+		assert.Equal(t, []MessageID{msgSubmitted.ID()}, peerQueue.SubmittedMessages)
+		assert.Equal(t, msgSubmitted.Size()+msgReady.Size(), peerQueue.Size)
+	}
+
+	// a step schedules exactly one message
+	messageID, scheduled, err := tangle.Scheduler.Step()
+	assert.NoError(t, err)
+	assert.True(t, scheduled)
+	assert.Equal(t, msgReady.ID(), messageID)
+	assert.Equal(t, msgReady.ID(), <-messageScheduled)
+
+	// the remaining message is scheduled once the scheduler is resumed
+	tangle.Scheduler.Resume()
+	assert.False(t, tangle.Scheduler.Paused())
+	assert.NoError(t, tangle.Scheduler.Ready(msgSubmitted.ID()))
+	assert.Eventually(t, func() bool {
+		select {
+		case id := <-messageScheduled:
+			return assert.Equal(t, msgSubmitted.ID(), id)
+		default:
+			return false
+		}
+	}, 1*time.Second, 10*time.Millisecond)
+}
+
+func TestScheduler_PriorityLane(t *testing.T) {
+	tangle := NewTestTangle(Identity(selfLocalIdentity))
+	defer tangle.Shutdown()
+	tangle.Scheduler.priorityLaneShare = 0.5
+
+	messageScheduled := make(chan MessageID, 3)
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
+
+	tangle.Scheduler.Pause()
+	tangle.Scheduler.Start()
+
+	// only the classified messages of the node itself are scheduled in the priority lane
+	msgPeer := newMessageWithTimestamp(peerNode.PublicKey(), time.Now().Add(-3*time.Second))
+	msgPeerPriority := newMessageWithTimestamp(peerNode.PublicKey(), time.Now().Add(-2*time.Second))
+	msgPriority := newMessageWithTimestamp(selfNode.PublicKey(), time.Now().Add(-time.Second))
+	tangle.Scheduler.RegisterPriorityClassifier(func(message *Message) bool {
+		return message.ID() == msgPeerPriority.ID() || message.ID() == msgPriority.ID()
+	})
+
+	for _, msg := range []*Message{msgPeer, msgPeerPriority, msgPriority} {
+		tangle.Storage.StoreMessage(msg)
+		assert.NoError(t, tangle.Scheduler.Submit(msg.ID()))
+		assert.NoError(t, tangle.Scheduler.Ready(msg.ID()))
+	}
+	assert.Equal(t, msgPriority.Size(), tangle.Scheduler.PriorityLaneStats().Size)
+
+	// the priority message is scheduled first although it is the newest
+	for _, expectedID := range []MessageID{msgPriority.ID(), msgPeer.ID(), msgPeerPriority.ID()} {
+		messageID, scheduled, err := tangle.Scheduler.Step()
+		assert.NoError(t, err)
+		assert.True(t, scheduled)
+		assert.Equal(t, expectedID, messageID)
+		assert.Equal(t, expectedID, <-messageScheduled)
+	}
+
+	stats := tangle.Scheduler.PriorityLaneStats()
+	assert.Zero(t, stats.Size)
+	assert.EqualValues(t, 1, stats.ScheduledMessages)
+	assert.EqualValues(t, msgPriority.Size(), stats.ScheduledBytes)
+	assert.EqualValues(t, msgPeer.Size()+msgPeerPriority.Size()+msgPriority.Size(), stats.TotalScheduledBytes)
+}
+
 // MockConfirmationOracleConfirmed mocks ConfirmationOracle marking all messages as confirmed.
 type MockConfirmationOracleConfirmed struct {
 	ConfirmationOracle
@@ -191,18 +360,14 @@ func TestScheduler_SkipConfirmed(t *testing.T) {
 	defer tangle.Shutdown()
 	tangle.ConfirmationOracle = &MockConfirmationOracleConfirmed{
 		ConfirmationOracle: tangle.ConfirmationOracle,
-		events: &ConfirmationEvents{
-			MessageConfirmed:     events.NewEvent(MessageIDCaller),
-			TransactionConfirmed: events.NewEvent(nil),
-			BranchConfirmed:      events.NewEvent(nil),
-		},
+		events:             NewConfirmationEvents(),
 	}
 
 	messageScheduled := make(chan MessageID, 1)
 	messageSkipped := make(chan MessageID, 1)
 
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(id MessageID) { messageScheduled <- id }))
-	tangle.Scheduler.Events.MessageSkipped.Attach(events.NewClosure(func(id MessageID) { messageSkipped <- id }))
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
+	tangle.Scheduler.Events.MessageSkipped.Attach(event.NewClosure(func(id MessageID) { messageSkipped <- id }))
 
 	tangle.Scheduler.Setup()
 
@@ -272,7 +437,7 @@ func TestScheduler_SetRate(t *testing.T) {
 	defer tangle.Shutdown()
 
 	var scheduled atomic.Bool
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(MessageID) { scheduled.Store(true) }))
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(MessageID) { scheduled.Store(true) }))
 
 	tangle.Scheduler.Start()
 
@@ -301,7 +466,7 @@ func TestScheduler_Time(t *testing.T) {
 	defer tangle.Shutdown()
 
 	messageScheduled := make(chan MessageID, 1)
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(id MessageID) { messageScheduled <- id }))
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
 
 	tangle.Scheduler.Start()
 
@@ -343,20 +508,20 @@ func TestScheduler_Issue(t *testing.T) {
 	tangle := NewTestTangle(Identity(selfLocalIdentity))
 	defer tangle.Shutdown()
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) { assert.Failf(t, "unexpected error", "error event triggered: %v", err) }))
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) { assert.Failf(t, "unexpected error", "error event triggered: %v", err) }))
 
 	// setup tangle up till the Scheduler
 	tangle.Storage.Setup()
 	tangle.Solidifier.Setup()
 	tangle.Scheduler.Setup()
-	tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(id MessageID) {
+	tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(id MessageID) {
 		assert.NoError(t, tangle.Scheduler.SubmitAndReady(id))
 	}))
 	tangle.Scheduler.Start()
 
 	const numMessages = 5
 	messageScheduled := make(chan MessageID, numMessages)
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(id MessageID) { messageScheduled <- id }))
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
 
 	ids := NewMessageIDs()
 	for i := 0; i < numMessages; i++ {
@@ -384,13 +549,13 @@ func TestSchedulerFlow(t *testing.T) {
 	tangle := NewTestTangle(Identity(selfLocalIdentity))
 	defer tangle.Shutdown()
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) { assert.Failf(t, "unexpected error", "error event triggered: %v", err) }))
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) { assert.Failf(t, "unexpected error", "error event triggered: %v", err) }))
 
 	// setup tangle up till the Scheduler
 	tangle.Storage.Setup()
 	tangle.Solidifier.Setup()
 	tangle.Scheduler.Setup()
-	tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(id MessageID) {
+	tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(id MessageID) {
 		assert.NoError(t, tangle.Scheduler.SubmitAndReady(id))
 	}))
 	tangle.Scheduler.Start()
@@ -427,7 +592,7 @@ func TestSchedulerFlow(t *testing.T) {
 	messages["E"] = msgE
 
 	messageScheduled := make(chan MessageID, len(messages))
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(id MessageID) { messageScheduled <- id }))
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(id MessageID) { messageScheduled <- id }))
 
 	for _, message := range messages {
 		tangle.Storage.StoreMessage(message)
@@ -459,13 +624,13 @@ func TestSchedulerParallelSubmit(t *testing.T) {
 	tangle := NewTestTangle(Identity(selfLocalIdentity))
 	defer tangle.Shutdown()
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) { assert.Failf(t, "unexpected error", "error event triggered: %v", err) }))
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) { assert.Failf(t, "unexpected error", "error event triggered: %v", err) }))
 
 	// setup tangle up till the Scheduler
 	tangle.Storage.Setup()
 	tangle.Solidifier.Setup()
 	tangle.Scheduler.Setup()
-	tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(id MessageID) {
+	tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(id MessageID) {
 		assert.NoError(t, tangle.Scheduler.SubmitAndReady(id))
 	}))
 	tangle.Scheduler.Start()
@@ -482,11 +647,11 @@ func TestSchedulerParallelSubmit(t *testing.T) {
 		messages[msg.ID()] = msg
 	}
 
-	tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(messageID MessageID) {
+	tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(messageID MessageID) {
 		t.Logf(messageID.Base58(), " solid")
 	}))
 
-	tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(messageID MessageID) {
+	tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(messageID MessageID) {
 		n := totalScheduled.Add(1)
 		t.Logf("scheduled messages %d/%d", n, totalMsgCount)
 	}))
@@ -519,7 +684,7 @@ func BenchmarkScheduler(b *testing.B) {
 		if err := tangle.Scheduler.SubmitAndReady(msg.ID()); err != nil {
 			b.Fatal(err)
 		}
-		tangle.Scheduler.schedule()
+		tangle.Scheduler.schedule(false)
 	}
 	b.StopTimer()
 }
@@ -555,3 +720,17 @@ func newMessageWithTimestamp(issuerPublicKey ed25519.PublicKey, timestamp time.T
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// mockDropPolicy is a DropPolicy that counts the dropped messages and otherwise behaves like the DropOldestPolicy.
+type mockDropPolicy struct {
+	dropCount int
+}
+
+func (m *mockDropPolicy) Name() string {
+	return "mock"
+}
+
+func (m *mockDropPolicy) Drop(b *schedulerutils.BufferQueue, accessManaRetriever func(identity.ID) float64) (dropped schedulerutils.Element) {
+	m.dropCount++
+	return schedulerutils.DropOldestPolicy{}.Drop(b, accessManaRetriever)
+}
@@ -2,7 +2,6 @@ package schedulerutils
 
 import (
 	"container/ring"
-	"math"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/identity"
@@ -15,24 +14,42 @@ var ErrInsufficientMana = errors.New("insufficient node's mana to schedule the m
 
 // BufferQueue represents a buffer of NodeQueue.
 type BufferQueue struct {
-	maxBuffer int
-	maxQueue  float64
+	maxBuffer  int
+	maxQueue   float64
+	dropPolicy DropPolicy
 
 	activeNode map[identity.ID]*ring.Ring
 	ring       *ring.Ring
 	size       int
 }
 
-// NewBufferQueue returns a new BufferQueue.
-func NewBufferQueue(maxBuffer int, maxQueue float64) *BufferQueue {
+// NewBufferQueue returns a new BufferQueue. It accepts an optional DropPolicy that is used when the buffer is full
+// (defaults to the DropOldestPolicy).
+func NewBufferQueue(maxBuffer int, maxQueue float64, optionalDropPolicy ...DropPolicy) *BufferQueue {
+	var dropPolicy DropPolicy = DropOldestPolicy{}
+	if len(optionalDropPolicy) > 0 && optionalDropPolicy[0] != nil {
+		dropPolicy = optionalDropPolicy[0]
+	}
+
 	return &BufferQueue{
 		maxBuffer:  maxBuffer,
 		maxQueue:   maxQueue,
+		dropPolicy: dropPolicy,
 		activeNode: make(map[identity.ID]*ring.Ring),
 		ring:       nil,
 	}
 }
 
+// DropPolicy returns the DropPolicy that is used when the buffer is full.
+func (b *BufferQueue) DropPolicy() DropPolicy {
+	return b.dropPolicy
+}
+
+// SetDropPolicy sets the DropPolicy that is used when the buffer is full.
+func (b *BufferQueue) SetDropPolicy(dropPolicy DropPolicy) {
+	b.dropPolicy = dropPolicy
+}
+
 // NumActiveNodes returns the number of active nodes in b.
 func (b *BufferQueue) NumActiveNodes() int {
 	return len(b.activeNode)
@@ -79,7 +96,7 @@ func (b *BufferQueue) Submit(msg Element, accessManaRetriever func(identity.ID)
 	}
 	b.size += msg.Size()
 
-	// if max buffer size exceeded, drop from head of the longest mana-scaled queue
+	// if max buffer size exceeded, drop messages according to the DropPolicy
 	if b.size > b.maxBuffer {
 		return b.dropHead(accessManaRetriever)
 	}
@@ -88,52 +105,9 @@ func (b *BufferQueue) Submit(msg Element, accessManaRetriever func(identity.ID)
 }
 
 func (b *BufferQueue) dropHead(accessManaRetriever func(identity.ID) float64) (messagesDropped []ElementID) {
-	start := b.Current()
-
 	// remove as many messages as necessary to stay within max buffer size
 	for b.Size() > b.maxBuffer {
-		// find longest mana-scaled queue
-		maxScale := math.Inf(-1)
-		var maxNodeID identity.ID
-		for q := start; ; {
-			nodeMana := accessManaRetriever(q.NodeID())
-			if nodeMana > 0.0 {
-				if scale := float64(q.Size()) / nodeMana; scale > maxScale {
-					maxScale = scale
-					maxNodeID = q.NodeID()
-				}
-			} else if q.Size() > 0 {
-				maxScale = math.Inf(1)
-				maxNodeID = q.NodeID()
-			}
-			q = b.Next()
-			if q == start {
-				break
-			}
-		}
-		longestQueue := b.activeNode[maxNodeID].Value.(*NodeQueue)
-		// find oldest submitted and not-ready message in the longest queue
-		var oldestMessage Element
-
-		for _, v := range longestQueue.submitted {
-			if oldestMessage == nil || oldestMessage.IssuingTime().After((*v).IssuingTime()) {
-				oldestMessage = *v
-			}
-		}
-
-		// if the oldest not-ready message is older than the oldest ready message, drop the former otherwise the latter
-		readyQueueFront := longestQueue.Front()
-		if oldestMessage != nil && (readyQueueFront == nil || oldestMessage.IssuingTime().Before(readyQueueFront.IssuingTime())) {
-			messagesDropped = append(messagesDropped, ElementIDFromBytes(oldestMessage.IDBytes()))
-			// no need to check if Unsubmit call succeeded, as the mutex of the scheduler is locked to current context
-			b.Unsubmit(oldestMessage)
-		} else if readyQueueFront != nil {
-			msg := longestQueue.PopFront()
-			b.size -= msg.Size()
-			messagesDropped = append(messagesDropped, ElementIDFromBytes(msg.IDBytes()))
-		} else {
-			panic("scheduler buffer size exceeded and the longest scheduler queue is empty.")
-		}
+		messagesDropped = append(messagesDropped, ElementIDFromBytes(b.dropPolicy.Drop(b, accessManaRetriever).IDBytes()))
 	}
 	return messagesDropped
 }
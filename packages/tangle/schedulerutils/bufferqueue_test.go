@@ -150,6 +150,83 @@ func TestBufferQueue_SubmitWithDrop_Ready(t *testing.T) {
 	assert.EqualValues(t, maxBuffer, b.Size())
 }
 
+// Drop the newest message of the longest queue, even if it is older than the newly submitted one.
+func TestBufferQueue_SubmitWithDrop_NewestPolicy(t *testing.T) {
+	b := schedulerutils.NewBufferQueue(maxBuffer, maxQueue, schedulerutils.DropNewestPolicy{})
+	now := time.Now()
+	preparedMessages := make([]*testMessage, 0, numMessages)
+	for i := 0; i < numMessages; i++ {
+		msg := newTestMessageWithIndex(selfNode.PublicKey(), i)
+		msg.issuingTime = now.Add(time.Duration(i) * time.Millisecond)
+		preparedMessages = append(preparedMessages, msg)
+	}
+	for i, msg := range preparedMessages {
+		assert.Empty(t, b.Submit(msg, mockAccessManaRetriever))
+		if i%2 == 0 {
+			b.Ready(msg)
+		}
+	}
+	assert.EqualValues(t, maxBuffer, b.Size())
+
+	// drop the newest (unready) message
+	oldMessage := newTestMessageWithIndex(selfNode.PublicKey(), numMessages)
+	oldMessage.issuingTime = now.Add(-time.Second)
+	droppedMessages := b.Submit(oldMessage, mockAccessManaRetriever)
+	assert.Len(t, droppedMessages, 1)
+	assert.Equal(t, preparedMessages[numMessages-1].IDBytes(), droppedMessages[0][:])
+
+	// drop the newest (ready) message
+	oldMessage = newTestMessageWithIndex(selfNode.PublicKey(), numMessages+1)
+	oldMessage.issuingTime = now.Add(-time.Second)
+	droppedMessages = b.Submit(oldMessage, mockAccessManaRetriever)
+	assert.Len(t, droppedMessages, 1)
+	assert.Equal(t, preparedMessages[numMessages-2].IDBytes(), droppedMessages[0][:])
+	assert.EqualValues(t, maxBuffer, b.Size())
+}
+
+// Drop the oldest message of the node with the lowest mana, even if it does not have the longest mana-scaled queue.
+func TestBufferQueue_SubmitWithDrop_LowestManaPolicy(t *testing.T) {
+	richNode := identity.GenerateIdentity()
+	accessManaRetriever := func(id identity.ID) float64 {
+		if id == richNode.ID() {
+			return 2 * aMana
+		}
+		return mockAccessManaRetriever(id)
+	}
+
+	b := schedulerutils.NewBufferQueue(maxBuffer, maxQueue, schedulerutils.DropLowestManaPolicy{})
+	assert.Equal(t, schedulerutils.DropLowestManaPolicyName, b.DropPolicy().Name())
+
+	preparedMessages := make([]*testMessage, 0, numMessages)
+	for i := 0; i < numMessages/10; i++ {
+		preparedMessages = append(preparedMessages, newTestMessageWithIndex(selfNode.PublicKey(), i))
+	}
+	for i := numMessages / 10; i < numMessages; i++ {
+		preparedMessages = append(preparedMessages, newTestMessageWithIndex(richNode.PublicKey(), i))
+	}
+	for _, msg := range preparedMessages {
+		assert.Empty(t, b.Submit(msg, accessManaRetriever))
+	}
+	assert.Equal(t, richNode.ID(), b.LongestQueue(accessManaRetriever).NodeID())
+	assert.Equal(t, selfNode.ID(), b.LowestManaQueue(accessManaRetriever).NodeID())
+
+	droppedMessages := b.Submit(newTestMessageWithIndex(richNode.PublicKey(), numMessages), accessManaRetriever)
+	assert.Len(t, droppedMessages, 1)
+	assert.Equal(t, preparedMessages[0].IDBytes(), droppedMessages[0][:])
+	assert.EqualValues(t, maxBuffer, b.Size())
+}
+
+func TestDropPolicyByName(t *testing.T) {
+	for _, name := range []string{schedulerutils.DropOldestPolicyName, schedulerutils.DropNewestPolicyName, schedulerutils.DropLowestManaPolicyName} {
+		dropPolicy, err := schedulerutils.DropPolicyByName(name)
+		assert.NoError(t, err)
+		assert.Equal(t, name, dropPolicy.Name())
+	}
+
+	_, err := schedulerutils.DropPolicyByName("random")
+	assert.Error(t, err)
+}
+
 func TestBufferQueue_Ready(t *testing.T) {
 	b := schedulerutils.NewBufferQueue(maxBuffer, maxQueue)
 
@@ -187,6 +264,46 @@ func TestBufferQueue_Time(t *testing.T) {
 	assert.EqualValues(t, 0, b.Size())
 }
 
+func TestNodeQueue_Elements(t *testing.T) {
+	q := schedulerutils.NewNodeQueue(selfNode.ID())
+
+	messages := make([]*testMessage, 4)
+	for i := range messages {
+		messages[i] = newTestMessageWithIndex(selfNode.PublicKey(), i)
+		messages[i].issuingTime = time.Now().Add(-time.Duration(i) * time.Second)
+		assert.True(t, q.Submit(messages[i]))
+	}
+	assert.True(t, q.Ready(messages[0]))
+	assert.True(t, q.Ready(messages[2]))
+
+	assert.Equal(t, []schedulerutils.Element{messages[2], messages[0]}, q.ReadyElements())
+	assert.Equal(t, []schedulerutils.Element{messages[3], messages[1]}, q.SubmittedElements())
+}
+
+func TestNodeQueue_Resubmit(t *testing.T) {
+	q := schedulerutils.NewNodeQueue(selfNode.ID())
+
+	messages := make([]*testMessage, 3)
+	for i := range messages {
+		messages[i] = newTestMessageWithIndex(selfNode.PublicKey(), i)
+		messages[i].issuingTime = time.Now().Add(time.Duration(i) * time.Second)
+	}
+
+	// the pooled element of an unsubmitted message must not affect the elements that are submitted afterwards
+	assert.True(t, q.Submit(messages[0]))
+	assert.True(t, q.Unsubmit(messages[0]))
+	assert.False(t, q.Unsubmit(messages[0]))
+	assert.True(t, q.Submit(messages[1]))
+	assert.True(t, q.Submit(messages[2]))
+	assert.True(t, q.Ready(messages[1]))
+	assert.False(t, q.Ready(messages[1]))
+	assert.True(t, q.Submit(messages[0]))
+
+	assert.Equal(t, []schedulerutils.Element{messages[0], messages[2]}, q.SubmittedElements())
+	assert.Equal(t, []schedulerutils.Element{messages[1]}, q.ReadyElements())
+	assert.Equal(t, messages[0].Size()+messages[1].Size()+messages[2].Size(), q.Size())
+}
+
 func TestBufferQueue_Ring(t *testing.T) {
 	b := schedulerutils.NewBufferQueue(maxBuffer, maxQueue)
 
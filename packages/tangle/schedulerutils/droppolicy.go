@@ -0,0 +1,210 @@
+package schedulerutils
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+)
+
+const (
+	// DropOldestPolicyName is the name of the DropPolicy that drops the oldest message of the longest mana-scaled queue.
+	DropOldestPolicyName = "oldest"
+
+	// DropNewestPolicyName is the name of the DropPolicy that drops the newest message of the longest mana-scaled queue.
+	DropNewestPolicyName = "newest"
+
+	// DropLowestManaPolicyName is the name of the DropPolicy that drops the oldest message of the issuer with the
+	// lowest access mana.
+	DropLowestManaPolicyName = "lowestMana"
+)
+
+// region DropPolicy ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DropPolicy decides which message is dropped from the BufferQueue when its maximum size is exceeded.
+type DropPolicy interface {
+	// Name returns the name of the DropPolicy.
+	Name() string
+
+	// Drop removes a single message from the given BufferQueue and returns it.
+	Drop(b *BufferQueue, accessManaRetriever func(identity.ID) float64) (dropped Element)
+}
+
+// DropPolicyByName returns the builtin DropPolicy with the given name.
+func DropPolicyByName(name string) (dropPolicy DropPolicy, err error) {
+	switch name {
+	case DropOldestPolicyName:
+		return DropOldestPolicy{}, nil
+	case DropNewestPolicyName:
+		return DropNewestPolicy{}, nil
+	case DropLowestManaPolicyName:
+		return DropLowestManaPolicy{}, nil
+	default:
+		return nil, errors.Errorf("unknown drop policy '%s'", name)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DropOldestPolicy /////////////////////////////////////////////////////////////////////////////////////////////
+
+// DropOldestPolicy is the DropPolicy that drops the oldest message of the longest mana-scaled queue.
+type DropOldestPolicy struct{}
+
+// Name returns the name of the DropPolicy.
+func (DropOldestPolicy) Name() string {
+	return DropOldestPolicyName
+}
+
+// Drop removes a single message from the given BufferQueue and returns it.
+func (DropOldestPolicy) Drop(b *BufferQueue, accessManaRetriever func(identity.ID) float64) (dropped Element) {
+	return b.DropOldest(b.LongestQueue(accessManaRetriever))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DropNewestPolicy /////////////////////////////////////////////////////////////////////////////////////////////
+
+// DropNewestPolicy is the DropPolicy that drops the newest message of the longest mana-scaled queue.
+type DropNewestPolicy struct{}
+
+// Name returns the name of the DropPolicy.
+func (DropNewestPolicy) Name() string {
+	return DropNewestPolicyName
+}
+
+// Drop removes a single message from the given BufferQueue and returns it.
+func (DropNewestPolicy) Drop(b *BufferQueue, accessManaRetriever func(identity.ID) float64) (dropped Element) {
+	return b.DropNewest(b.LongestQueue(accessManaRetriever))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DropLowestManaPolicy /////////////////////////////////////////////////////////////////////////////////////////
+
+// DropLowestManaPolicy is the DropPolicy that drops the oldest message of the issuer with the lowest access mana.
+type DropLowestManaPolicy struct{}
+
+// Name returns the name of the DropPolicy.
+func (DropLowestManaPolicy) Name() string {
+	return DropLowestManaPolicyName
+}
+
+// Drop removes a single message from the given BufferQueue and returns it.
+func (DropLowestManaPolicy) Drop(b *BufferQueue, accessManaRetriever func(identity.ID) float64) (dropped Element) {
+	return b.DropOldest(b.LowestManaQueue(accessManaRetriever))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BufferQueue helpers //////////////////////////////////////////////////////////////////////////////////////////
+
+// LongestQueue returns the non-empty NodeQueue with the most messages relative to the access mana of its node.
+func (b *BufferQueue) LongestQueue(accessManaRetriever func(identity.ID) float64) (longestQueue *NodeQueue) {
+	maxScale := math.Inf(-1)
+	start := b.Current()
+	for q := start; q != nil; {
+		nodeMana := accessManaRetriever(q.NodeID())
+		if nodeMana > 0.0 {
+			if scale := float64(q.Size()) / nodeMana; q.Size() > 0 && scale > maxScale {
+				maxScale = scale
+				longestQueue = q
+			}
+		} else if q.Size() > 0 {
+			maxScale = math.Inf(1)
+			longestQueue = q
+		}
+		if q = b.Next(); q == start {
+			break
+		}
+	}
+
+	return longestQueue
+}
+
+// LowestManaQueue returns the non-empty NodeQueue whose node has the lowest access mana.
+func (b *BufferQueue) LowestManaQueue(accessManaRetriever func(identity.ID) float64) (lowestManaQueue *NodeQueue) {
+	minMana := math.Inf(1)
+	start := b.Current()
+	for q := start; q != nil; {
+		if nodeMana := accessManaRetriever(q.NodeID()); q.Size() > 0 && (lowestManaQueue == nil || nodeMana < minMana) {
+			minMana = nodeMana
+			lowestManaQueue = q
+		}
+		if q = b.Next(); q == start {
+			break
+		}
+	}
+
+	return lowestManaQueue
+}
+
+// DropOldest removes the oldest message (ready or not) from the given NodeQueue and returns it.
+func (b *BufferQueue) DropOldest(q *NodeQueue) (dropped Element) {
+	if q == nil {
+		panic("scheduler buffer size exceeded and there is no queue to drop from.")
+	}
+
+	// find oldest submitted and not-ready message in the queue
+	var oldestMessage Element
+	for _, v := range q.submitted {
+		if oldestMessage == nil || oldestMessage.IssuingTime().After((*v).IssuingTime()) {
+			oldestMessage = *v
+		}
+	}
+
+	// if the oldest not-ready message is older than the oldest ready message, drop the former otherwise the latter
+	readyQueueFront := q.Front()
+	if oldestMessage != nil && (readyQueueFront == nil || oldestMessage.IssuingTime().Before(readyQueueFront.IssuingTime())) {
+		// no need to check if Unsubmit call succeeded, as the mutex of the scheduler is locked to current context
+		b.Unsubmit(oldestMessage)
+		return oldestMessage
+	}
+	if readyQueueFront != nil {
+		dropped = q.PopFront()
+		b.size -= dropped.Size()
+		return dropped
+	}
+
+	panic("scheduler buffer size exceeded and the selected scheduler queue is empty.")
+}
+
+// DropNewest removes the newest message (ready or not) from the given NodeQueue and returns it.
+func (b *BufferQueue) DropNewest(q *NodeQueue) (dropped Element) {
+	if q == nil {
+		panic("scheduler buffer size exceeded and there is no queue to drop from.")
+	}
+
+	// find newest submitted and not-ready message in the queue
+	var newestMessage Element
+	for _, v := range q.submitted {
+		if newestMessage == nil || newestMessage.IssuingTime().Before((*v).IssuingTime()) {
+			newestMessage = *v
+		}
+	}
+
+	// find newest ready message in the queue
+	newestReadyIndex := -1
+	for i, element := range *q.inbox {
+		if newestReadyIndex == -1 || (*q.inbox)[newestReadyIndex].IssuingTime().Before(element.IssuingTime()) {
+			newestReadyIndex = i
+		}
+	}
+
+	// if the newest not-ready message is newer than the newest ready message, drop the former otherwise the latter
+	if newestMessage != nil && (newestReadyIndex == -1 || newestMessage.IssuingTime().After((*q.inbox)[newestReadyIndex].IssuingTime())) {
+		b.Unsubmit(newestMessage)
+		return newestMessage
+	}
+	if newestReadyIndex != -1 {
+		dropped = heap.Remove(q.inbox, newestReadyIndex).(Element)
+		q.size.Sub(int64(dropped.Size()))
+		b.size -= dropped.Size()
+		return dropped
+	}
+
+	panic("scheduler buffer size exceeded and the selected scheduler queue is empty.")
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -3,11 +3,14 @@ package schedulerutils
 import (
 	"container/heap"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/identity"
 	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/pool"
 )
 
 // ElementIDLength defines the length of an ElementID.
@@ -44,6 +47,13 @@ type Element interface {
 
 // region NodeQueue /////////////////////////////////////////////////////////////////////////////////////////////
 
+// elementPool contains the boxes of the submitted elements, which only live until the element is ready or unsubmitted.
+var elementPool = pool.New(func() *Element {
+	return new(Element)
+}, func(element *Element) {
+	*element = nil
+})
+
 // NodeQueue keeps the submitted messages of a node.
 type NodeQueue struct {
 	nodeID    identity.ID
@@ -87,7 +97,9 @@ func (q *NodeQueue) Submit(element Element) bool {
 		return false
 	}
 
-	q.submitted[id] = &element
+	boxedElement := elementPool.Get()
+	*boxedElement = element
+	q.submitted[id] = boxedElement
 	q.size.Add(int64(element.Size()))
 	return true
 }
@@ -95,11 +107,13 @@ func (q *NodeQueue) Submit(element Element) bool {
 // Unsubmit removes a previously submitted message from the queue.
 func (q *NodeQueue) Unsubmit(element Element) bool {
 	id := ElementIDFromBytes(element.IDBytes())
-	if _, submitted := q.submitted[id]; !submitted {
+	boxedElement, submitted := q.submitted[id]
+	if !submitted {
 		return false
 	}
 
 	delete(q.submitted, id)
+	elementPool.Put(boxedElement)
 	q.size.Sub(int64(element.Size()))
 	return true
 }
@@ -107,11 +121,13 @@ func (q *NodeQueue) Unsubmit(element Element) bool {
 // Ready marks a previously submitted message as ready to be scheduled.
 func (q *NodeQueue) Ready(element Element) bool {
 	id := ElementIDFromBytes(element.IDBytes())
-	if _, submitted := q.submitted[id]; !submitted {
+	boxedElement, submitted := q.submitted[id]
+	if !submitted {
 		return false
 	}
 
 	delete(q.submitted, id)
+	elementPool.Put(boxedElement)
 	heap.Push(q.inbox, element)
 	return true
 }
@@ -127,6 +143,24 @@ func (q *NodeQueue) IDs() (ids []ElementID) {
 	return ids
 }
 
+// SubmittedElements returns the submitted messages that are not ready, yet.
+func (q *NodeQueue) SubmittedElements() (elements []Element) {
+	for _, element := range q.submitted {
+		elements = append(elements, *element)
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		return elements[i].IssuingTime().Before(elements[j].IssuingTime())
+	})
+	return elements
+}
+
+// ReadyElements returns the ready messages in the order in which they are scheduled.
+func (q *NodeQueue) ReadyElements() (elements []Element) {
+	elements = append(elements, *q.inbox...)
+	sort.Slice(elements, ElementHeap(elements).Less)
+	return elements
+}
+
 // Front returns the first ready message in the queue.
 func (q *NodeQueue) Front() Element {
 	if q == nil || q.inbox.Len() == 0 {
@@ -0,0 +1,271 @@
+package tangle
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+// sequenceWindowSize defines how many of the most recent sequence numbers of an issuer are remembered to detect reused
+// and late sequence numbers.
+const sequenceWindowSize = 1024
+
+// region SequenceTracker //////////////////////////////////////////////////////////////////////////////////////////////
+
+// SequenceTracker is a Tangle component that keeps track of the sequence numbers of the messages of every issuer. It
+// detects gaps in the sequence numbers (messages that are missing or were never issued) and sequence numbers that are
+// used by more than one message.
+type SequenceTracker struct {
+	Events *SequenceTrackerEvents
+
+	tangle  *Tangle
+	issuers map[identity.ID]*issuerSequence
+	mutex   sync.RWMutex
+}
+
+// NewSequenceTracker is the constructor of the SequenceTracker.
+func NewSequenceTracker(tangle *Tangle) *SequenceTracker {
+	return &SequenceTracker{
+		Events: &SequenceTrackerEvents{
+			SequenceGapDetected:  event.New[*SequenceGapEvent]("SequenceTracker.SequenceGapDetected"),
+			SequenceNumberReused: event.New[*SequenceNumberReusedEvent]("SequenceTracker.SequenceNumberReused"),
+		},
+		tangle:  tangle,
+		issuers: make(map[identity.ID]*issuerSequence),
+	}
+}
+
+// Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
+func (s *SequenceTracker) Setup() {
+	s.tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID MessageID) {
+		s.tangle.Storage.Message(messageID).Consume(s.Track)
+	}))
+}
+
+// Track records the sequence number of the given message and triggers the corresponding events if it reveals a gap or
+// reuses the sequence number of another message of the same issuer.
+func (s *SequenceTracker) Track(message *Message) {
+	issuerID := identity.NewID(message.IssuerPublicKey())
+
+	s.mutex.Lock()
+	sequence, exists := s.issuers[issuerID]
+	if !exists {
+		sequence = newIssuerSequence(message.SequenceNumber())
+		s.issuers[issuerID] = sequence
+	}
+	gapEvent, reusedEvent := sequence.track(issuerID, message.SequenceNumber(), message.ID(), exists)
+	s.mutex.Unlock()
+
+	if gapEvent != nil {
+		s.Events.SequenceGapDetected.Trigger(gapEvent)
+	}
+	if reusedEvent != nil {
+		s.Events.SequenceNumberReused.Trigger(reusedEvent)
+	}
+}
+
+// Status returns the SequenceStatus of the given issuer.
+func (s *SequenceTracker) Status(issuerID identity.ID) (status *SequenceStatus, exists bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	sequence, exists := s.issuers[issuerID]
+	if !exists {
+		return nil, false
+	}
+
+	return sequence.status(issuerID), true
+}
+
+// Statuses returns the SequenceStatus of all issuers that were seen so far.
+func (s *SequenceTracker) Statuses() (statuses []*SequenceStatus) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	statuses = make([]*SequenceStatus, 0, len(s.issuers))
+	for issuerID, sequence := range s.issuers {
+		statuses = append(statuses, sequence.status(issuerID))
+	}
+
+	return statuses
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region issuerSequence ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// issuerSequence holds the sequence numbers seen of a single issuer within the window below its highest sequence
+// number.
+type issuerSequence struct {
+	highest uint64
+	seen    map[uint64]MessageID
+	missing map[uint64]struct{}
+
+	messages        uint64
+	gaps            uint64
+	missingNumbers  uint64
+	reuses          uint64
+	outOfOrder      uint64
+	outsideOfWindow uint64
+}
+
+func newIssuerSequence(sequenceNumber uint64) *issuerSequence {
+	return &issuerSequence{
+		highest: sequenceNumber,
+		seen:    make(map[uint64]MessageID),
+		missing: make(map[uint64]struct{}),
+	}
+}
+
+// track records the given sequence number. The first sequence number of an issuer never reveals a gap, since the
+// messages that were issued before the node started to track the issuer are unknown.
+func (i *issuerSequence) track(issuerID identity.ID, sequenceNumber uint64, messageID MessageID, known bool) (gapEvent *SequenceGapEvent, reusedEvent *SequenceNumberReusedEvent) {
+	i.messages++
+
+	switch {
+	case known && sequenceNumber > i.highest+1:
+		gapEvent = &SequenceGapEvent{
+			IssuerID: issuerID,
+			From:     i.highest + 1,
+			To:       sequenceNumber - 1,
+		}
+		i.gaps++
+		i.missingNumbers += sequenceNumber - 1 - i.highest
+		firstMissing := gapEvent.From
+		if sequenceNumber-firstMissing >= sequenceWindowSize {
+			firstMissing = sequenceNumber - sequenceWindowSize + 1
+		}
+		for missing := firstMissing; missing <= gapEvent.To; missing++ {
+			i.missing[missing] = struct{}{}
+		}
+		i.advance(sequenceNumber)
+	case sequenceNumber > i.highest:
+		i.advance(sequenceNumber)
+	case i.highest-sequenceNumber >= sequenceWindowSize:
+		i.outOfOrder++
+		i.outsideOfWindow++
+
+		return nil, nil
+	default:
+		if previousMessageID, seen := i.seen[sequenceNumber]; seen {
+			i.reuses++
+
+			return nil, &SequenceNumberReusedEvent{
+				IssuerID:          issuerID,
+				SequenceNumber:    sequenceNumber,
+				MessageID:         messageID,
+				PreviousMessageID: previousMessageID,
+			}
+		}
+
+		if sequenceNumber < i.highest {
+			delete(i.missing, sequenceNumber)
+			i.outOfOrder++
+		}
+	}
+
+	i.seen[sequenceNumber] = messageID
+
+	return gapEvent, nil
+}
+
+// advance moves the window to the given highest sequence number and forgets about the sequence numbers that drop out of
+// it.
+func (i *issuerSequence) advance(highest uint64) {
+	if highest-i.highest >= sequenceWindowSize {
+		i.seen = make(map[uint64]MessageID)
+		for missing := range i.missing {
+			if highest-missing >= sequenceWindowSize {
+				delete(i.missing, missing)
+			}
+		}
+	} else {
+		for dropped := i.highest + 1; dropped <= highest; dropped++ {
+			if dropped >= sequenceWindowSize {
+				delete(i.seen, dropped-sequenceWindowSize)
+				delete(i.missing, dropped-sequenceWindowSize)
+			}
+		}
+	}
+
+	i.highest = highest
+}
+
+func (i *issuerSequence) status(issuerID identity.ID) *SequenceStatus {
+	return &SequenceStatus{
+		IssuerID:               issuerID,
+		HighestSequenceNumber:  i.highest,
+		Messages:               i.messages,
+		Gaps:                   i.gaps,
+		MissingSequenceNumbers: i.missingNumbers,
+		OutstandingMissing:     uint64(len(i.missing)),
+		Reuses:                 i.reuses,
+		OutOfOrder:             i.outOfOrder,
+		OutsideOfWindow:        i.outsideOfWindow,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SequenceStatus ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// SequenceStatus holds the statistics about the sequence numbers of an issuer.
+type SequenceStatus struct {
+	// IssuerID is the identity of the issuer.
+	IssuerID identity.ID
+	// HighestSequenceNumber is the highest sequence number seen of the issuer.
+	HighestSequenceNumber uint64
+	// Messages is the number of tracked messages of the issuer.
+	Messages uint64
+	// Gaps is the number of detected gaps in the sequence numbers.
+	Gaps uint64
+	// MissingSequenceNumbers is the number of sequence numbers that were skipped by the detected gaps.
+	MissingSequenceNumbers uint64
+	// OutstandingMissing is the number of skipped sequence numbers within the window that are still missing.
+	OutstandingMissing uint64
+	// Reuses is the number of messages that reused the sequence number of another message.
+	Reuses uint64
+	// OutOfOrder is the number of messages that arrived after a message with a higher sequence number.
+	OutOfOrder uint64
+	// OutsideOfWindow is the number of messages that arrived too late to be checked for a reused sequence number.
+	OutsideOfWindow uint64
+}
+
+// String returns a human-readable version of the SequenceStatus.
+func (s *SequenceStatus) String() string {
+	return fmt.Sprintf("SequenceStatus(%s, highest=%d, messages=%d, gaps=%d, missing=%d, reuses=%d, outOfOrder=%d)",
+		s.IssuerID, s.HighestSequenceNumber, s.Messages, s.Gaps, s.OutstandingMissing, s.Reuses, s.OutOfOrder)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SequenceTrackerEvents ////////////////////////////////////////////////////////////////////////////////////////
+
+// SequenceTrackerEvents represents events happening in the SequenceTracker.
+type SequenceTrackerEvents struct {
+	// SequenceGapDetected is triggered when a message of an issuer skips one or more sequence numbers.
+	SequenceGapDetected *event.Event[*SequenceGapEvent]
+
+	// SequenceNumberReused is triggered when a message uses the sequence number of another message of the same issuer.
+	SequenceNumberReused *event.Event[*SequenceNumberReusedEvent]
+}
+
+// SequenceGapEvent holds the range of sequence numbers that were skipped by an issuer.
+type SequenceGapEvent struct {
+	IssuerID identity.ID
+	From     uint64
+	To       uint64
+}
+
+// SequenceNumberReusedEvent holds the messages of an issuer that share the same sequence number.
+type SequenceNumberReusedEvent struct {
+	IssuerID          identity.ID
+	SequenceNumber    uint64
+	MessageID         MessageID
+	PreviousMessageID MessageID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
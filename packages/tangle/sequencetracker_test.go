@@ -0,0 +1,97 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+func TestSequenceTracker(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+	tangle.Storage.Setup()
+	tangle.SequenceTracker.Setup()
+
+	var gaps []*SequenceGapEvent
+	var reuses []*SequenceNumberReusedEvent
+	tangle.SequenceTracker.Events.SequenceGapDetected.Attach(event.NewClosure(func(event *SequenceGapEvent) {
+		gaps = append(gaps, event)
+	}))
+	tangle.SequenceTracker.Events.SequenceNumberReused.Attach(event.NewClosure(func(event *SequenceNumberReusedEvent) {
+		reuses = append(reuses, event)
+	}))
+
+	issuer := ed25519.GenerateKeyPair().PublicKey
+	issuerID := identity.NewID(issuer)
+	now := time.Now()
+
+	// the first tracked message never reveals a gap
+	tangle.Storage.StoreMessage(newSequencedMessage(issuer, 10, now))
+	tangle.Storage.StoreMessage(newSequencedMessage(issuer, 11, now.Add(time.Second)))
+	assert.Empty(t, gaps)
+	status, exists := tangle.SequenceTracker.Status(issuerID)
+	require.True(t, exists)
+	assert.Zero(t, status.OutstandingMissing)
+
+	tangle.Storage.StoreMessage(newSequencedMessage(issuer, 15, now.Add(2*time.Second)))
+	require.Len(t, gaps, 1)
+	assert.Equal(t, &SequenceGapEvent{IssuerID: issuerID, From: 12, To: 14}, gaps[0])
+	status, _ = tangle.SequenceTracker.Status(issuerID)
+	assert.Equal(t, uint64(3), status.OutstandingMissing)
+
+	// late messages fill the gap
+	tangle.Storage.StoreMessage(newSequencedMessage(issuer, 13, now.Add(3*time.Second)))
+	status, _ = tangle.SequenceTracker.Status(issuerID)
+	assert.Equal(t, uint64(2), status.OutstandingMissing)
+	assert.Equal(t, uint64(3), status.MissingSequenceNumbers)
+	assert.Equal(t, uint64(1), status.OutOfOrder)
+
+	reusing := newSequencedMessage(issuer, 11, now.Add(4*time.Second))
+	tangle.Storage.StoreMessage(reusing)
+	require.Len(t, reuses, 1)
+	assert.Equal(t, uint64(11), reuses[0].SequenceNumber)
+	assert.Equal(t, reusing.ID(), reuses[0].MessageID)
+
+	status, _ = tangle.SequenceTracker.Status(issuerID)
+	assert.Equal(t, &SequenceStatus{
+		IssuerID:               issuerID,
+		HighestSequenceNumber:  15,
+		Messages:               5,
+		Gaps:                   1,
+		MissingSequenceNumbers: 3,
+		OutstandingMissing:     2,
+		Reuses:                 1,
+		OutOfOrder:             1,
+	}, status)
+	assert.Len(t, tangle.SequenceTracker.Statuses(), 1)
+}
+
+func TestSequenceTracker_Window(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	issuer := ed25519.GenerateKeyPair().PublicKey
+	issuerID := identity.NewID(issuer)
+	now := time.Now()
+
+	// only the missing sequence numbers within the window are remembered
+	tangle.SequenceTracker.Track(newSequencedMessage(issuer, 1, now))
+	tangle.SequenceTracker.Track(newSequencedMessage(issuer, 1+10*sequenceWindowSize, now))
+	status, _ := tangle.SequenceTracker.Status(issuerID)
+	assert.Equal(t, uint64(10*sequenceWindowSize-1), status.MissingSequenceNumbers)
+	assert.Equal(t, uint64(sequenceWindowSize-1), status.OutstandingMissing)
+
+	// sequence numbers that dropped out of the window can not be checked for reuses anymore
+	tangle.SequenceTracker.Track(newSequencedMessage(issuer, 1, now.Add(time.Second)))
+	tangle.SequenceTracker.Track(newSequencedMessage(issuer, 2+10*sequenceWindowSize, now))
+	status, _ = tangle.SequenceTracker.Status(issuerID)
+	assert.Equal(t, uint64(0), status.Reuses)
+	assert.Equal(t, uint64(1), status.OutsideOfWindow)
+	assert.Equal(t, uint64(sequenceWindowSize-2), status.OutstandingMissing)
+}
@@ -1,18 +1,27 @@
 package tangle
 
 import (
+	"runtime"
+	"sync"
 	"time"
 
-	"github.com/iotaledger/hive.go/events"
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/generics/walker"
+	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/syncutils"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
 )
 
-// maxParentsTimeDifference defines the smallest allowed time difference between a child Message and its parents.
+// minParentsTimeDifference defines the smallest allowed time difference between a child Message and its parents.
 const minParentsTimeDifference = 0 * time.Second
 
-// maxParentsTimeDifference defines the biggest allowed time difference between a child Message and its parents.
-const maxParentsTimeDifference = 30 * time.Minute
+// DefaultMaxParentAge defines the default of the biggest allowed time difference between a child Message and its
+// parents.
+const DefaultMaxParentAge = 30 * time.Minute
 
 // region Solidifier ///////////////////////////////////////////////////////////////////////////////////////////////////
 
@@ -21,19 +30,28 @@ type Solidifier struct {
 	// Events contains the Solidifier related events.
 	Events *SolidifierEvents
 
-	triggerMutex syncutils.MultiMutex
-	tangle       *Tangle
+	triggerMutex        syncutils.MultiMutex
+	tangle              *Tangle
+	workerPool          *workerpools.Pool
+	scheduledOrphanages map[MessageID]clock.Timer
+	orphanagesMutex     sync.Mutex
 }
 
 // NewSolidifier is the constructor of the Solidifier.
 func NewSolidifier(tangle *Tangle) (solidifier *Solidifier) {
 	solidifier = &Solidifier{
 		Events: &SolidifierEvents{
-			MessageSolid:   events.NewEvent(MessageIDCaller),
-			MessageMissing: events.NewEvent(MessageIDCaller),
+			MessageSolid:    event.New[MessageID]("Solidifier.MessageSolid"),
+			MessageMissing:  event.New[MessageID]("Solidifier.MessageMissing"),
+			MessageOrphaned: event.New[*MessageOrphanedEvent]("Solidifier.MessageOrphaned"),
 		},
 
 		tangle: tangle,
+		workerPool: tangle.Options.WorkerPools.CreatePool(SolidifierWorkerPoolName, workerpools.PriorityCritical, workerpools.PoolParams{
+			WorkerCount: runtime.GOMAXPROCS(0),
+			QueueSize:   1024,
+		}),
+		scheduledOrphanages: make(map[MessageID]clock.Timer),
 	}
 
 	return
@@ -41,7 +59,24 @@ func NewSolidifier(tangle *Tangle) (solidifier *Solidifier) {
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of the other components.
 func (s *Solidifier) Setup() {
-	s.tangle.Storage.Events.MessageStored.Attach(events.NewClosure(s.Solidify))
+	s.tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID MessageID) {
+		s.workerPool.Submit(func() { s.Solidify(messageID) })
+	}))
+
+	s.tangle.Parser.AddMessageFilter(NewParentAgeFilter(s.tangle))
+}
+
+// Shutdown shuts down the Solidifier after the pending Messages were solidified.
+func (s *Solidifier) Shutdown() {
+	s.workerPool.Shutdown()
+
+	s.orphanagesMutex.Lock()
+	defer s.orphanagesMutex.Unlock()
+
+	for messageID, scheduledOrphanage := range s.scheduledOrphanages {
+		scheduledOrphanage.Stop()
+		delete(s.scheduledOrphanages, messageID)
+	}
 }
 
 // Solidify solidifies the given Message.
@@ -52,7 +87,7 @@ func (s *Solidifier) Solidify(messageID MessageID) {
 // RetrieveMissingMessage checks if the message is missing and triggers the corresponding events to request it. It returns true if the message has been missing.
 func (s *Solidifier) RetrieveMissingMessage(messageID MessageID) (messageWasMissing bool) {
 	s.tangle.Storage.MessageMetadata(messageID, func() *MessageMetadata {
-		if cachedMissingMessage, stored := s.tangle.Storage.StoreMissingMessage(NewMissingMessage(messageID)); stored {
+		if cachedMissingMessage, stored := s.tangle.Storage.StoreMissingMessage(NewMissingMessage(messageID, s.tangle.Options.Clock.Now())); stored {
 			cachedMissingMessage.Release()
 
 			messageWasMissing = true
@@ -68,14 +103,15 @@ func (s *Solidifier) RetrieveMissingMessage(messageID MessageID) (messageWasMiss
 // checkMessageSolidity checks if the given Message is solid and eventually queues its Approvers to also be checked.
 func (s *Solidifier) checkMessageSolidity(message *Message, messageMetadata *MessageMetadata, walker *walker.Walker[MessageID]) {
 	if !s.isMessageSolid(message, messageMetadata) {
+		s.scheduleOrphanage(message, messageMetadata)
 		return
 	}
 
-	if !s.areParentMessagesValid(message) {
+	if err := s.checkParentMessages(message); err != nil {
 		if !messageMetadata.SetObjectivelyInvalid(true) {
 			return
 		}
-		s.tangle.Events.MessageInvalid.Trigger(&MessageInvalidEvent{MessageID: message.ID(), Error: ErrParentsInvalid})
+		s.tangle.Events.MessageInvalid.Trigger(&MessageInvalidEvent{MessageID: message.ID(), Error: err})
 		return
 	}
 
@@ -90,9 +126,12 @@ func (s *Solidifier) checkMessageSolidity(message *Message, messageMetadata *Mes
 	s.triggerMutex.Lock(lock...)
 	defer s.triggerMutex.Unlock(lock...)
 
-	if !messageMetadata.SetSolid(true) {
+	if !messageMetadata.SetSolid(true, s.tangle.Options.Clock.Now()) {
 		return
 	}
+	s.cancelOrphanage(message.ID())
+	// the missing ancestors of an orphaned message might still arrive (e.g. through the approvers of other messages)
+	messageMetadata.SetOrphaned(false, time.Time{})
 	s.Events.MessageSolid.Trigger(message.ID())
 
 	s.tangle.Storage.Approvers(message.ID()).Consume(func(approver *Approver) {
@@ -137,41 +176,220 @@ func (s *Solidifier) isMessageMarkedAsSolid(messageID MessageID) (solid bool) {
 	return
 }
 
-// areParentMessagesValid checks whether the parents of the given Message are valid.
-func (s *Solidifier) areParentMessagesValid(message *Message) (valid bool) {
-	valid = true
+// scheduleOrphanage marks the given unsolid Message as orphaned if one of its parents is orphaned already or schedules
+// the check at the end of its solidification timeout otherwise.
+func (s *Solidifier) scheduleOrphanage(message *Message, messageMetadata *MessageMetadata) {
+	if s.tangle.Options.SolidificationTimeout == 0 || message == nil || messageMetadata == nil || messageMetadata.IsOrphaned() {
+		return
+	}
+
+	if s.hasOrphanedParent(message) {
+		s.orphan(message.ID())
+		return
+	}
+
+	s.orphanagesMutex.Lock()
+	defer s.orphanagesMutex.Unlock()
+
+	messageID := message.ID()
+	if _, exists := s.scheduledOrphanages[messageID]; exists {
+		return
+	}
+
+	orphanageTime := messageMetadata.ReceivedTime().Add(s.tangle.Options.SolidificationTimeout)
+	s.scheduledOrphanages[messageID] = s.tangle.Options.Clock.AfterFunc(orphanageTime.Sub(s.tangle.Options.Clock.Now()), func() {
+		s.orphan(messageID)
+	})
+}
+
+// cancelOrphanage cancels the scheduled orphanage check of the given Message.
+func (s *Solidifier) cancelOrphanage(messageID MessageID) {
+	s.orphanagesMutex.Lock()
+	defer s.orphanagesMutex.Unlock()
+
+	if scheduledOrphanage, exists := s.scheduledOrphanages[messageID]; exists {
+		scheduledOrphanage.Stop()
+		delete(s.scheduledOrphanages, messageID)
+	}
+}
+
+// hasOrphanedParent checks whether one of the parents of the given Message is orphaned (and did not become solid since).
+func (s *Solidifier) hasOrphanedParent(message *Message) (orphanedParent bool) {
 	message.ForEachParent(func(parent Parent) {
-		valid = valid && s.isParentMessageValid(parent.ID, message)
+		s.tangle.Storage.MessageMetadata(parent.ID).Consume(func(messageMetadata *MessageMetadata) {
+			orphanedParent = orphanedParent || (messageMetadata.IsOrphaned() && !messageMetadata.IsSolid())
+		})
 	})
 
 	return
 }
 
-// isParentMessageValid checks whether the given parent Message is valid.
-func (s *Solidifier) isParentMessageValid(parentMessageID MessageID, childMessage *Message) (valid bool) {
+// orphan marks the given Message and its unsolid future cone as orphaned and stops requesting the missing ancestors
+// that prevented them from becoming solid.
+func (s *Solidifier) orphan(messageID MessageID) {
+	s.cancelOrphanage(messageID)
+
+	missingAncestors := s.missingAncestors(messageID)
+	for missingAncestorID := range missingAncestors {
+		s.tangle.Requester.StopRequest(missingAncestorID)
+		s.tangle.Storage.DeleteMissingMessage(missingAncestorID)
+	}
+
+	s.tangle.Utils.WalkMessageMetadata(func(messageMetadata *MessageMetadata, walker *walker.Walker[MessageID]) {
+		if messageMetadata.IsSolid() || !messageMetadata.SetOrphaned(true, s.tangle.Options.Clock.Now()) {
+			return
+		}
+		s.cancelOrphanage(messageMetadata.ID())
+
+		s.Events.MessageOrphaned.Trigger(&MessageOrphanedEvent{
+			MessageID:        messageMetadata.ID(),
+			MissingAncestors: missingAncestors.Clone(),
+		})
+
+		s.tangle.Storage.Approvers(messageMetadata.ID()).Consume(func(approver *Approver) {
+			walker.Push(approver.ApproverMessageID())
+		})
+	}, NewMessageIDs(messageID))
+}
+
+// missingAncestors returns the unknown Messages in the unsolid past cone of the given Message.
+func (s *Solidifier) missingAncestors(messageID MessageID) (missingAncestors MessageIDs) {
+	missingAncestors = NewMessageIDs()
+
+	s.tangle.Utils.WalkMessage(func(message *Message, walker *walker.Walker[MessageID]) {
+		message.ForEachParent(func(parent Parent) {
+			if parent.ID == EmptyMessageID {
+				return
+			}
+
+			if !s.tangle.Storage.MessageMetadata(parent.ID).Consume(func(messageMetadata *MessageMetadata) {
+				if !messageMetadata.IsSolid() {
+					walker.Push(parent.ID)
+				}
+			}) {
+				missingAncestors.Add(parent.ID)
+			}
+		})
+	}, NewMessageIDs(messageID))
+
+	return
+}
+
+// MissingDependencies returns the dependency graph of the solidification: every missing Message together with the
+// unsolid Messages in its future cone that wait for it and the state of its request. The walk through the future cone
+// of each missing Message stops after the given number of blocked descendants (0 disables the limit).
+func (s *Solidifier) MissingDependencies(maxBlockedDescendants int) (missingDependencies []*MissingDependency) {
+	requestStatuses := make(map[MessageID]*RequestStatus)
+	for _, requestStatus := range s.tangle.Requester.RequestStatuses() {
+		requestStatuses[requestStatus.MessageID] = requestStatus
+	}
+
+	for _, messageID := range s.tangle.Storage.MissingMessages() {
+		missingDependency := &MissingDependency{
+			MessageID:       messageID,
+			BlockedChildren: NewMessageIDs(),
+			RequestStatus:   requestStatuses[messageID],
+		}
+		s.tangle.Storage.MissingMessage(messageID).Consume(func(missingMessage *MissingMessage) {
+			missingDependency.MissingSince = missingMessage.MissingSince()
+		})
+		s.walkBlockedDescendants(missingDependency, maxBlockedDescendants)
+
+		missingDependencies = append(missingDependencies, missingDependency)
+	}
+
+	return missingDependencies
+}
+
+// walkBlockedDescendants collects the unsolid Messages in the future cone of the given missing Message.
+func (s *Solidifier) walkBlockedDescendants(missingDependency *MissingDependency, maxBlockedDescendants int) {
+	descendantWalker := walker.New[MessageID]()
+	s.tangle.Storage.Approvers(missingDependency.MessageID).Consume(func(approver *Approver) {
+		missingDependency.BlockedChildren.Add(approver.ApproverMessageID())
+		descendantWalker.Push(approver.ApproverMessageID())
+	})
+
+	for descendantWalker.HasNext() {
+		if maxBlockedDescendants > 0 && missingDependency.BlockedDescendants >= maxBlockedDescendants {
+			missingDependency.Truncated = true
+			return
+		}
+
+		descendantID := descendantWalker.Next()
+		s.tangle.Storage.MessageMetadata(descendantID).Consume(func(messageMetadata *MessageMetadata) {
+			if messageMetadata.IsSolid() {
+				return
+			}
+
+			missingDependency.BlockedDescendants++
+			if receivedTime := messageMetadata.ReceivedTime(); missingDependency.OldestBlockedDescendant.IsZero() || receivedTime.Before(missingDependency.OldestBlockedDescendant) {
+				missingDependency.OldestBlockedDescendant = receivedTime
+			}
+
+			s.tangle.Storage.Approvers(descendantID).Consume(func(approver *Approver) {
+				descendantWalker.Push(approver.ApproverMessageID())
+			})
+		})
+	}
+}
+
+// checkParentMessages checks whether the parents of the given Message are valid and returns an error wrapping
+// ErrParentsInvalid otherwise.
+func (s *Solidifier) checkParentMessages(message *Message) (err error) {
+	message.ForEachParent(func(parent Parent) {
+		if err == nil {
+			err = s.checkParentMessage(parent.ID, message)
+		}
+	})
+
+	return
+}
+
+// checkParentMessage checks whether the given parent Message is valid.
+func (s *Solidifier) checkParentMessage(parentMessageID MessageID, childMessage *Message) (err error) {
 	if parentMessageID == EmptyMessageID {
 		if s.tangle.Options.GenesisNode != nil {
-			return *s.tangle.Options.GenesisNode == childMessage.IssuerPublicKey()
+			if *s.tangle.Options.GenesisNode != childMessage.IssuerPublicKey() {
+				return errors.Errorf("issuer %s is not allowed to reference the genesis: %w", identity.NewID(childMessage.IssuerPublicKey()), ErrParentsInvalid)
+			}
+
+			return nil
 		}
 
 		s.tangle.Storage.MessageMetadata(parentMessageID).Consume(func(messageMetadata *MessageMetadata) {
-			timeDifference := childMessage.IssuingTime().Sub(messageMetadata.SolidificationTime())
-			valid = timeDifference >= minParentsTimeDifference && timeDifference <= maxParentsTimeDifference
+			err = checkParentAge(parentMessageID, messageMetadata.SolidificationTime(), childMessage.IssuingTime(), s.tangle.Options.MaxParentAge)
 		})
 		return
 	}
 
 	s.tangle.Storage.Message(parentMessageID).Consume(func(parentMessage *Message) {
-		timeDifference := childMessage.IssuingTime().Sub(parentMessage.IssuingTime())
-
-		valid = timeDifference >= minParentsTimeDifference && timeDifference <= maxParentsTimeDifference
+		err = checkParentAge(parentMessageID, parentMessage.IssuingTime(), childMessage.IssuingTime(), s.tangle.Options.MaxParentAge)
 	})
+	if err != nil {
+		return err
+	}
 
 	s.tangle.Storage.MessageMetadata(parentMessageID).Consume(func(messageMetadata *MessageMetadata) {
-		valid = valid && !messageMetadata.IsObjectivelyInvalid()
+		if messageMetadata.IsObjectivelyInvalid() {
+			err = errors.Errorf("parent %s is objectively invalid: %w", parentMessageID, ErrParentsInvalid)
+		}
 	})
 
-	return
+	return err
+}
+
+// checkParentAge checks whether the issuing time of a parent lies within the allowed time difference before the issuing
+// time of its child.
+func checkParentAge(parentMessageID MessageID, parentIssuingTime, childIssuingTime time.Time, maxParentAge time.Duration) error {
+	timeDifference := childIssuingTime.Sub(parentIssuingTime)
+	if timeDifference < minParentsTimeDifference {
+		return errors.Errorf("parent %s was issued %s after its child: %w", parentMessageID, -timeDifference, ErrParentIssuedAfterChild)
+	}
+	if timeDifference > maxParentAge {
+		return errors.Errorf("parent %s was issued %s before its child (max %s): %w", parentMessageID, timeDifference, maxParentAge, ErrParentTooOld)
+	}
+
+	return nil
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -181,10 +399,118 @@ func (s *Solidifier) isParentMessageValid(parentMessageID MessageID, childMessag
 // SolidifierEvents represents events happening in the Solidifier.
 type SolidifierEvents struct {
 	// MessageSolid is triggered when a message becomes solid, i.e. its past cone is known and solid.
-	MessageSolid *events.Event
+	MessageSolid *event.Event[MessageID]
 
 	// MessageMissing is triggered when a message references an unknown parent Message.
-	MessageMissing *events.Event
+	MessageMissing *event.Event[MessageID]
+
+	// MessageOrphaned is triggered when a message could not be solidified within the solidification timeout or
+	// references an orphaned parent.
+	MessageOrphaned *event.Event[*MessageOrphanedEvent]
+}
+
+// MessageOrphanedEvent is the struct that is passed along with triggering a MessageOrphaned event.
+type MessageOrphanedEvent struct {
+	MessageID        MessageID
+	MissingAncestors MessageIDs
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MissingDependency ////////////////////////////////////////////////////////////////////////////////////////////
+
+// MissingDependency is a node of the dependency graph of the solidification. It contains a missing Message, the unsolid
+// Messages that wait for it and the state of its request.
+type MissingDependency struct {
+	// MessageID is the ID of the missing Message.
+	MessageID MessageID
+	// MissingSince is the time at which the Message was marked as missing.
+	MissingSince time.Time
+	// BlockedChildren contains the known Messages that directly reference the missing Message.
+	BlockedChildren MessageIDs
+	// BlockedDescendants is the number of unsolid Messages in the future cone of the missing Message.
+	BlockedDescendants int
+	// OldestBlockedDescendant is the time at which the oldest of the blocked descendants was received.
+	OldestBlockedDescendant time.Time
+	// Truncated is true if the walk through the future cone stopped before all blocked descendants were counted.
+	Truncated bool
+	// RequestStatus contains the state of the request of the missing Message (nil if it is not requested).
+	RequestStatus *RequestStatus
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ParentAgeFilter //////////////////////////////////////////////////////////////////////////////////////////////
+
+// ParentAgeFilter filters messages that reference parents which are known already and were issued too long before or
+// after the message. Parents that are unknown when the message is parsed are checked by the Solidifier.
+type ParentAgeFilter struct {
+	tangle *Tangle
+
+	onAcceptCallback func(msg *Message, peer *peer.Peer)
+	onRejectCallback func(msg *Message, err error, peer *peer.Peer)
+
+	onAcceptCallbackMutex sync.RWMutex
+	onRejectCallbackMutex sync.RWMutex
+}
+
+// NewParentAgeFilter creates a new parent age filter.
+func NewParentAgeFilter(tangle *Tangle) *ParentAgeFilter {
+	return &ParentAgeFilter{
+		tangle: tangle,
+	}
 }
 
+// Filter filters up on the given message and peer and calls the acceptance callback
+// if the input passes or the rejection callback if the input is rejected.
+func (f *ParentAgeFilter) Filter(msg *Message, peer *peer.Peer) {
+	var err error
+	msg.ForEachParent(func(parent Parent) {
+		if err != nil || parent.ID == EmptyMessageID {
+			return
+		}
+
+		f.tangle.Storage.Message(parent.ID).Consume(func(parentMessage *Message) {
+			err = checkParentAge(parent.ID, parentMessage.IssuingTime(), msg.IssuingTime(), f.tangle.Options.MaxParentAge)
+		})
+	})
+
+	if err != nil {
+		f.getRejectCallback()(msg, err, peer)
+		return
+	}
+	f.getAcceptCallback()(msg, peer)
+}
+
+// OnAccept registers the given callback as the acceptance function of the filter.
+func (f *ParentAgeFilter) OnAccept(callback func(msg *Message, peer *peer.Peer)) {
+	f.onAcceptCallbackMutex.Lock()
+	f.onAcceptCallback = callback
+	f.onAcceptCallbackMutex.Unlock()
+}
+
+// OnReject registers the given callback as the rejection function of the filter.
+func (f *ParentAgeFilter) OnReject(callback func(msg *Message, err error, peer *peer.Peer)) {
+	f.onRejectCallbackMutex.Lock()
+	f.onRejectCallback = callback
+	f.onRejectCallbackMutex.Unlock()
+}
+
+func (f *ParentAgeFilter) getAcceptCallback() (result func(msg *Message, peer *peer.Peer)) {
+	f.onAcceptCallbackMutex.RLock()
+	result = f.onAcceptCallback
+	f.onAcceptCallbackMutex.RUnlock()
+	return
+}
+
+func (f *ParentAgeFilter) getRejectCallback() (result func(msg *Message, err error, peer *peer.Peer)) {
+	f.onRejectCallbackMutex.RLock()
+	result = f.onRejectCallback
+	f.onRejectCallbackMutex.RUnlock()
+	return
+}
+
+// Close closes the filter.
+func (f *ParentAgeFilter) Close() error { return nil }
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
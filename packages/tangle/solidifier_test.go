@@ -0,0 +1,112 @@
+package tangle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestParentAgeFilter(t *testing.T) {
+	tangle := NewTestTangle(MaxParentAge(time.Minute))
+	defer tangle.Shutdown()
+
+	now := time.Now()
+	parent := newParentAgeTestMessage(NewMessageIDs(EmptyMessageID), now)
+	tangle.Storage.StoreMessage(parent)
+
+	filter := NewParentAgeFilter(tangle)
+	var accepted int
+	var rejectErr error
+	filter.OnAccept(func(*Message, *peer.Peer) { accepted++ })
+	filter.OnReject(func(_ *Message, err error, _ *peer.Peer) { rejectErr = err })
+
+	filter.Filter(newParentAgeTestMessage(NewMessageIDs(parent.ID()), now.Add(30*time.Second)), nil)
+	assert.Equal(t, 1, accepted)
+
+	// unknown parents are checked by the Solidifier
+	filter.Filter(newParentAgeTestMessage(NewMessageIDs(randomMessageID()), now.Add(time.Hour)), nil)
+	assert.Equal(t, 2, accepted)
+
+	filter.Filter(newParentAgeTestMessage(NewMessageIDs(parent.ID()), now.Add(2*time.Minute)), nil)
+	assert.ErrorIs(t, rejectErr, ErrParentTooOld)
+	assert.ErrorIs(t, rejectErr, ErrParentsInvalid)
+
+	filter.Filter(newParentAgeTestMessage(NewMessageIDs(parent.ID()), now.Add(-time.Second)), nil)
+	assert.ErrorIs(t, rejectErr, ErrParentIssuedAfterChild)
+	assert.Equal(t, 2, accepted)
+}
+
+func TestTipManager_MaxTipAge(t *testing.T) {
+	tangle := NewTestTangle(MaxParentAge(10 * time.Minute))
+	defer tangle.Shutdown()
+	assert.Equal(t, 9*time.Minute, tangle.TipManager.maxTipAge())
+
+	// the configured max tip age is capped, so that tips can always be referenced
+	tangle.Configure(TipManagerConfig(TipManagerParams{MaxTipAge: 29 * time.Minute}))
+	assert.Equal(t, 9*time.Minute, tangle.TipManager.maxTipAge())
+
+	tangle.Configure(TipManagerConfig(TipManagerParams{MaxTipAge: 5 * time.Minute}))
+	assert.Equal(t, 5*time.Minute, tangle.TipManager.maxTipAge())
+}
+
+func TestSolidifier_MissingDependencies(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	// the missing message blocks its child and grandchild
+	now := time.Now()
+	missingMessageID := randomMessageID()
+	cachedMissingMessage, _ := tangle.Storage.StoreMissingMessage(NewMissingMessage(missingMessageID, time.Now()))
+	cachedMissingMessage.Release()
+	child := newParentAgeTestMessage(NewMessageIDs(missingMessageID), now)
+	tangle.Storage.StoreMessage(child)
+	grandchild := newParentAgeTestMessage(NewMessageIDs(child.ID()), now.Add(time.Second))
+	tangle.Storage.StoreMessage(grandchild)
+
+	tangle.Requester.StartRequest(missingMessageID)
+	requestingPeer := identity.GenerateIdentity().ID()
+	assert.True(t, tangle.Requester.AddRequestingPeer(missingMessageID, requestingPeer))
+	assert.False(t, tangle.Requester.AddRequestingPeer(child.ID(), requestingPeer))
+
+	missingDependencies := tangle.Solidifier.MissingDependencies(0)
+	require.Len(t, missingDependencies, 1)
+	missingDependency := missingDependencies[0]
+	assert.Equal(t, missingMessageID, missingDependency.MessageID)
+	assert.False(t, missingDependency.MissingSince.IsZero())
+	assert.Equal(t, NewMessageIDs(child.ID()), missingDependency.BlockedChildren)
+	assert.Equal(t, 2, missingDependency.BlockedDescendants)
+	assert.False(t, missingDependency.Truncated)
+	require.NotNil(t, missingDependency.RequestStatus)
+	assert.Equal(t, []identity.ID{requestingPeer}, missingDependency.RequestStatus.RequestingPeers())
+
+	// the walk through the future cone can be limited
+	missingDependency = tangle.Solidifier.MissingDependencies(1)[0]
+	assert.Equal(t, 1, missingDependency.BlockedDescendants)
+	assert.True(t, missingDependency.Truncated)
+
+	// the dependency is resolved once the missing message is stored
+	tangle.Requester.StopRequest(missingMessageID)
+	tangle.Storage.DeleteMissingMessage(missingMessageID)
+	assert.Empty(t, tangle.Solidifier.MissingDependencies(0))
+	assert.Empty(t, tangle.Requester.RequestStatuses())
+}
+
+func newParentAgeTestMessage(strongParents MessageIDs, issuingTime time.Time) *Message {
+	message, _ := NewMessage(
+		emptyLikeReferencesFromStrongParents(strongParents),
+		issuingTime,
+		ed25519.PublicKey{},
+		0,
+		payload.NewGenericDataPayload([]byte("")),
+		0,
+		ed25519.Signature{},
+	)
+	return message
+}
@@ -1,19 +1,20 @@
 package tangle
 
 import (
+	"bytes"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/byteutils"
 	"github.com/iotaledger/hive.go/cerrors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/objectstorage"
 	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/iotaledger/hive.go/stringify"
 
-	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
 )
@@ -52,6 +53,12 @@ const (
 	// PrefixMarkerMessageMapping defines the storage prefix for the MarkerMessageMapping.
 	PrefixMarkerMessageMapping
 
+	// PrefixMessageAnnotation defines the storage prefix for the MessageAnnotation.
+	PrefixMessageAnnotation
+
+	// PrefixParkedMessages defines the storage prefix for the parked Messages of the Booker.
+	PrefixParkedMessages
+
 	// DBSequenceNumber defines the db sequence number.
 	DBSequenceNumber = "seq"
 
@@ -78,9 +85,14 @@ type Storage struct {
 	latestMarkerVotesStorage          *objectstorage.ObjectStorage[*LatestMarkerVotes]
 	branchWeightStorage               *objectstorage.ObjectStorage[*BranchWeight]
 	markerMessageMappingStorage       *objectstorage.ObjectStorage[*MarkerMessageMapping]
+	messageAnnotationStorage          *objectstorage.ObjectStorage[*MessageAnnotation]
+
+	// AnnotationNamespaces contains the namespaces in which plugins are allowed to modify MessageAnnotations.
+	AnnotationNamespaces *AnnotationNamespaces
 
-	Events   *StorageEvents
-	shutdown chan struct{}
+	Events        *StorageEvents
+	shutdown      chan struct{}
+	shutdownMutex sync.RWMutex
 }
 
 // NewStorage creates a new Storage.
@@ -101,11 +113,15 @@ func NewStorage(tangle *Tangle) (storage *Storage) {
 		latestMarkerVotesStorage:          objectstorage.New[*LatestMarkerVotes](tangle.Options.Store.WithRealm([]byte{database.PrefixTangle, PrefixLatestMarkerVotes}), cacheProvider.CacheTime(approvalWeightCacheTime), LatestMarkerVotesKeyPartition, objectstorage.LeakDetectionEnabled(false)),
 		branchWeightStorage:               objectstorage.New[*BranchWeight](tangle.Options.Store.WithRealm([]byte{database.PrefixTangle, PrefixBranchWeight}), cacheProvider.CacheTime(approvalWeightCacheTime), objectstorage.LeakDetectionEnabled(false)),
 		markerMessageMappingStorage:       objectstorage.New[*MarkerMessageMapping](tangle.Options.Store.WithRealm([]byte{database.PrefixTangle, PrefixMarkerMessageMapping}), cacheProvider.CacheTime(cacheTime), MarkerMessageMappingPartitionKeys, objectstorage.StoreOnCreation(true)),
+		messageAnnotationStorage:          objectstorage.New[*MessageAnnotation](tangle.Options.Store.WithRealm([]byte{database.PrefixTangle, PrefixMessageAnnotation}), cacheProvider.CacheTime(cacheTime), MessageAnnotationPartitionKeys, objectstorage.LeakDetectionEnabled(false)),
+		AnnotationNamespaces:              NewAnnotationNamespaces(),
 
 		Events: &StorageEvents{
-			MessageStored:        events.NewEvent(MessageIDCaller),
-			MessageRemoved:       events.NewEvent(MessageIDCaller),
-			MissingMessageStored: events.NewEvent(MessageIDCaller),
+			MessageStored:            event.New[MessageID]("Storage.MessageStored"),
+			MessageRemoved:           event.New[MessageID]("Storage.MessageRemoved"),
+			MissingMessageStored:     event.New[MessageID]("Storage.MissingMessageStored"),
+			MessageAnnotationSet:     event.New[*MessageAnnotationEvent]("Storage.MessageAnnotationSet"),
+			MessageAnnotationDeleted: event.New[*MessageAnnotationEvent]("Storage.MessageAnnotationDeleted"),
 		},
 	}
 
@@ -116,7 +132,7 @@ func NewStorage(tangle *Tangle) (storage *Storage) {
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (s *Storage) Setup() {
-	s.tangle.Parser.Events.MessageParsed.Attach(events.NewClosure(func(msgParsedEvent *MessageParsedEvent) {
+	s.tangle.Parser.Events.MessageParsed.Attach(event.NewClosure(func(msgParsedEvent *MessageParsedEvent) {
 		s.tangle.Storage.StoreMessage(msgParsedEvent.Message)
 	}))
 }
@@ -127,10 +143,13 @@ func (s *Storage) StoreMessage(message *Message) {
 	messageID := message.ID()
 
 	// store Messages only once by using the existence of the Metadata as a guard
-	storedMetadata, stored := s.messageMetadataStorage.StoreIfAbsent(NewMessageMetadata(messageID))
+	messageMetadata := newPooledMessageMetadata(messageID, s.tangle.Options.Clock.Now())
+	storedMetadata, stored := s.messageMetadataStorage.StoreIfAbsent(messageMetadata)
 	if !stored {
+		messageMetadataPool.Put(messageMetadata)
 		return
 	}
+	messageMetadataPool.Detach(messageMetadata)
 
 	// create typed version of the stored MessageMetadata
 	cachedMsgMetadata := storedMetadata
@@ -188,6 +207,18 @@ func (s *Storage) Approvers(messageID MessageID, optionalApproverType ...Approve
 	return
 }
 
+// ForEachMessageMetadata iterates over all MessageMetadata in the object storage until the consumer returns false.
+func (s *Storage) ForEachMessageMetadata(consumer func(messageMetadata *MessageMetadata) bool) {
+	s.messageMetadataStorage.ForEach(func(key []byte, cachedObject *objectstorage.CachedObject[*MessageMetadata]) (next bool) {
+		next = true
+		cachedObject.Consume(func(messageMetadata *MessageMetadata) {
+			next = consumer(messageMetadata)
+		})
+
+		return next
+	})
+}
+
 // StoreMissingMessage stores a new MissingMessage entry in the object storage.
 func (s *Storage) StoreMissingMessage(missingMessage *MissingMessage) (cachedMissingMessage *objectstorage.CachedObject[*MissingMessage], stored bool) {
 	cachedObject, stored := s.missingMessageStorage.StoreIfAbsent(missingMessage)
@@ -250,11 +281,108 @@ func (s *Storage) DeleteMessage(messageID MessageID) {
 
 		s.messageMetadataStorage.Delete(messageID[:])
 		s.messageStorage.Delete(messageID[:])
+		s.deleteMessageAnnotations(messageID)
 
 		s.Events.MessageRemoved.Trigger(messageID)
 	})
 }
 
+// SetMessageAnnotation sets the value of the MessageAnnotation with the given key in the namespace of a plugin. The
+// namespace has to be registered with the AnnotationNamespaces and the Message has to be stored. It returns true if the
+// value was modified.
+func (s *Storage) SetMessageAnnotation(messageID MessageID, namespace, key string, value []byte) (modified bool, err error) {
+	if err = validateMessageAnnotation(namespace, key, value); err != nil {
+		return false, err
+	}
+	if !s.AnnotationNamespaces.IsRegistered(namespace) {
+		return false, errors.Errorf("failed to annotate %s with %s/%s: %w", messageID, namespace, key, ErrAnnotationNamespaceNotRegistered)
+	}
+	if !s.messageMetadataStorage.Load(messageID.Bytes()).Consume(func(*MessageMetadata) {}) {
+		return false, errors.Errorf("failed to annotate %s with %s/%s: %w", messageID, namespace, key, ErrUnknownMessage)
+	}
+
+	value = byteutils.ConcatBytes(value)
+	cachedMessageAnnotation, stored := s.messageAnnotationStorage.StoreIfAbsent(NewMessageAnnotation(messageID, namespace, key, value))
+	if !stored {
+		cachedMessageAnnotation = s.messageAnnotationStorage.Load(messageAnnotationKey(messageID, namespace, key))
+	}
+	cachedMessageAnnotation.Consume(func(messageAnnotation *MessageAnnotation) {
+		if modified = stored || !bytes.Equal(messageAnnotation.value, value); !modified {
+			return
+		}
+
+		messageAnnotation.value = value
+		messageAnnotation.SetModified()
+	})
+
+	if modified {
+		s.Events.MessageAnnotationSet.Trigger(&MessageAnnotationEvent{
+			MessageID: messageID,
+			Namespace: namespace,
+			Key:       key,
+			Value:     value,
+		})
+	}
+
+	return modified, nil
+}
+
+// MessageAnnotation returns the value of the MessageAnnotation with the given key in the given namespace.
+func (s *Storage) MessageAnnotation(messageID MessageID, namespace, key string) (value []byte, exists bool) {
+	if validateMessageAnnotation(namespace, key, nil) != nil {
+		return nil, false
+	}
+
+	exists = s.messageAnnotationStorage.Load(messageAnnotationKey(messageID, namespace, key)).Consume(func(messageAnnotation *MessageAnnotation) {
+		value = byteutils.ConcatBytes(messageAnnotation.value)
+	})
+
+	return value, exists
+}
+
+// MessageAnnotations returns the values of all MessageAnnotations of the Message in the given namespace by their keys.
+func (s *Storage) MessageAnnotations(messageID MessageID, namespace string) (values map[string][]byte) {
+	values = make(map[string][]byte)
+	if validateAnnotationField("namespace", namespace, MaxAnnotationNamespaceLength) != nil {
+		return values
+	}
+
+	s.messageAnnotationStorage.ForEach(func(key []byte, cachedMessageAnnotation *objectstorage.CachedObject[*MessageAnnotation]) bool {
+		cachedMessageAnnotation.Consume(func(messageAnnotation *MessageAnnotation) {
+			values[messageAnnotation.key] = byteutils.ConcatBytes(messageAnnotation.value)
+		})
+		return true
+	}, objectstorage.WithIteratorPrefix(messageAnnotationNamespacePrefix(messageID, namespace)))
+
+	return values
+}
+
+// DeleteMessageAnnotation deletes the MessageAnnotation with the given key in the namespace of a plugin. It returns true
+// if the MessageAnnotation existed.
+func (s *Storage) DeleteMessageAnnotation(messageID MessageID, namespace, key string) (deleted bool, err error) {
+	if err = validateMessageAnnotation(namespace, key, nil); err != nil {
+		return false, err
+	}
+	if !s.AnnotationNamespaces.IsRegistered(namespace) {
+		return false, errors.Errorf("failed to delete annotation %s/%s of %s: %w", namespace, key, messageID, ErrAnnotationNamespaceNotRegistered)
+	}
+
+	if deleted = s.messageAnnotationStorage.DeleteIfPresent(messageAnnotationKey(messageID, namespace, key)); deleted {
+		s.Events.MessageAnnotationDeleted.Trigger(&MessageAnnotationEvent{
+			MessageID: messageID,
+			Namespace: namespace,
+			Key:       key,
+		})
+	}
+
+	return deleted, nil
+}
+
+// MissingMessage retrieves the MissingMessage entry of the given Message from the object storage.
+func (s *Storage) MissingMessage(messageID MessageID) *objectstorage.CachedObject[*MissingMessage] {
+	return s.missingMessageStorage.Load(messageID[:])
+}
+
 // DeleteMissingMessage deletes a message from the missingMessageStorage.
 func (s *Storage) DeleteMissingMessage(messageID MessageID) {
 	s.missingMessageStorage.Delete(messageID[:])
@@ -359,7 +487,7 @@ func (s *Storage) BranchWeight(branchID ledgerstate.BranchID, computeIfAbsentCal
 func (s *Storage) storeGenesis() {
 	s.MessageMetadata(EmptyMessageID, func() *MessageMetadata {
 		genesisMetadata := &MessageMetadata{
-			solidificationTime: clock.SyncedTime().Add(time.Duration(-20) * time.Minute),
+			solidificationTime: s.tangle.Options.Clock.Now().Add(time.Duration(-20) * time.Minute),
 			messageID:          EmptyMessageID,
 			solid:              true,
 			structureDetails: &markers.StructureDetails{
@@ -379,13 +507,92 @@ func (s *Storage) storeGenesis() {
 	}).Release()
 }
 
+// deleteMessageAnnotations deletes all MessageAnnotations of the given Message.
+func (s *Storage) deleteMessageAnnotations(messageID MessageID) {
+	s.messageAnnotationStorage.ForEach(func(key []byte, cachedMessageAnnotation *objectstorage.CachedObject[*MessageAnnotation]) bool {
+		cachedMessageAnnotation.Consume(func(messageAnnotation *MessageAnnotation) {
+			messageAnnotation.Delete()
+		})
+		return true
+	}, objectstorage.WithIteratorPrefix(messageID.Bytes()))
+}
+
 // deleteApprover deletes the Approver from the object storage that was created by the specified parent.
 func (s *Storage) deleteApprover(parent Parent, approvingMessage MessageID) {
 	s.approverStorage.Delete(byteutils.ConcatBytes(parent.ID.Bytes(), ParentTypeToApproverType[parent.Type].Bytes(), approvingMessage.Bytes()))
 }
 
+// CacheSize returns the number of objects that are currently cached by the object storages of the Storage.
+func (s *Storage) CacheSize() (cacheSize int) {
+	s.shutdownMutex.RLock()
+	defer s.shutdownMutex.RUnlock()
+
+	if s.isShutdown() {
+		return 0
+	}
+
+	for _, storage := range s.cachedStorages() {
+		cacheSize += storage.GetSize()
+	}
+
+	return cacheSize
+}
+
+// FlushCache persists the objects that are cached by the object storages of the Storage and releases their memory.
+func (s *Storage) FlushCache() {
+	s.shutdownMutex.RLock()
+	defer s.shutdownMutex.RUnlock()
+
+	if s.isShutdown() {
+		return
+	}
+
+	for _, storage := range s.cachedStorages() {
+		storage.Flush()
+		storage.FreeMemory()
+	}
+}
+
+// cachedStorages returns all object storages of the Storage.
+func (s *Storage) cachedStorages() []cachedStorage {
+	return []cachedStorage{
+		s.messageStorage,
+		s.messageMetadataStorage,
+		s.approverStorage,
+		s.missingMessageStorage,
+		s.attachmentStorage,
+		s.markerIndexBranchIDMappingStorage,
+		s.branchVotersStorage,
+		s.latestBranchVotesStorage,
+		s.latestMarkerVotesStorage,
+		s.branchWeightStorage,
+		s.markerMessageMappingStorage,
+		s.messageAnnotationStorage,
+	}
+}
+
+// cachedStorage is the type agnostic interface of the object storages that is used to manage their caches.
+type cachedStorage interface {
+	GetSize() int
+	Flush()
+	FreeMemory()
+}
+
+// isShutdown returns true if the Storage was shut down.
+func (s *Storage) isShutdown() bool {
+	select {
+	case <-s.shutdown:
+		return true
+	default:
+		return false
+	}
+}
+
 // Shutdown marks the tangle as stopped, so it will not accept any new messages (waits for all backgroundTasks to finish).
 func (s *Storage) Shutdown() {
+	s.shutdownMutex.Lock()
+	defer s.shutdownMutex.Unlock()
+
 	s.messageStorage.Shutdown()
 	s.messageMetadataStorage.Shutdown()
 	s.approverStorage.Shutdown()
@@ -397,6 +604,7 @@ func (s *Storage) Shutdown() {
 	s.latestMarkerVotesStorage.Shutdown()
 	s.branchWeightStorage.Shutdown()
 	s.markerMessageMappingStorage.Shutdown()
+	s.messageAnnotationStorage.Shutdown()
 
 	close(s.shutdown)
 }
@@ -415,6 +623,7 @@ func (s *Storage) Prune() error {
 		s.latestMarkerVotesStorage.Prune,
 		s.branchWeightStorage.Prune,
 		s.markerMessageMappingStorage.Prune,
+		s.messageAnnotationStorage.Prune,
 	} {
 		if err := storagePrune(); err != nil {
 			err = fmt.Errorf("failed to prune storage: %w", err)
@@ -500,13 +709,19 @@ func (s *Storage) RetrieveAllTips() (tips []MessageID) {
 // StorageEvents represents events happening on the message store.
 type StorageEvents struct {
 	// Fired when a message has been stored.
-	MessageStored *events.Event
+	MessageStored *event.Event[MessageID]
 
 	// Fired when a message was removed from storage.
-	MessageRemoved *events.Event
+	MessageRemoved *event.Event[MessageID]
 
 	// Fired when a message which was previously marked as missing was received.
-	MissingMessageStored *events.Event
+	MissingMessageStored *event.Event[MessageID]
+
+	// Fired when the value of a MessageAnnotation was set.
+	MessageAnnotationSet *event.Event[*MessageAnnotationEvent]
+
+	// Fired when a MessageAnnotation was deleted.
+	MessageAnnotationDeleted *event.Event[*MessageAnnotationEvent]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -805,11 +1020,11 @@ type MissingMessage struct {
 	missingSince time.Time
 }
 
-// NewMissingMessage creates new missing message with the specified messageID.
-func NewMissingMessage(messageID MessageID) *MissingMessage {
+// NewMissingMessage creates new missing message with the specified messageID that is missing since the given time.
+func NewMissingMessage(messageID MessageID, missingSince time.Time) *MissingMessage {
 	return &MissingMessage{
 		messageID:    messageID,
-		missingSince: time.Now(),
+		missingSince: missingSince,
 	}
 }
 
@@ -3,12 +3,40 @@ package tangle
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 )
 
+func TestStorage_StoreMessage(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	storedMessages := 0
+	tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(MessageID) {
+		storedMessages++
+	}))
+
+	message := newTestDataMessage("message")
+	tangle.Storage.StoreMessage(message)
+
+	var receivedTime time.Time
+	assert.True(t, tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		receivedTime = messageMetadata.ReceivedTime()
+	}))
+
+	// the MessageMetadata of the duplicate is returned to the pool without replacing the stored one
+	tangle.Storage.StoreMessage(message)
+	assert.Equal(t, 1, storedMessages)
+	assert.Empty(t, messageMetadataPool.Outstanding())
+	assert.True(t, tangle.Storage.MessageMetadata(message.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		assert.Equal(t, receivedTime, messageMetadata.ReceivedTime())
+	}))
+}
+
 func TestStorage_StoreAttachment(t *testing.T) {
 	tangle := NewTestTangle()
 	defer tangle.Shutdown()
@@ -5,19 +5,23 @@ import (
 	"time"
 
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/mr-tron/base58"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
 )
 
 const (
@@ -25,6 +29,14 @@ const (
 	DefaultGenesisTime int64 = 1616144400
 	// DefaultSyncTimeWindow is the default sync time window.
 	DefaultSyncTimeWindow = 2 * time.Minute
+
+	// SolidifierWorkerPoolName is the name of the worker pool that solidifies the stored Messages.
+	SolidifierWorkerPoolName = "Solidifier"
+	// BookerWorkerPoolName is the name of the worker pool that books the solid Messages (it needs to use a single worker).
+	BookerWorkerPoolName = "Booker"
+	// ApprovalWeightWorkerPoolName is the name of the worker pool that processes the approval weight of the booked
+	// Messages (it needs to use a single worker).
+	ApprovalWeightWorkerPoolName = "ApprovalWeightManager"
 )
 
 // region Tangle ///////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -42,6 +54,10 @@ type Tangle struct {
 	TimeManager           *TimeManager
 	OTVConsensusManager   *OTVConsensusManager
 	TipManager            *TipManager
+	Blacklist             *Blacklist
+	SequenceTracker       *SequenceTracker
+	IssuerIndex           *IssuerIndex
+	PayloadValidator      *PayloadValidator
 	Requester             *Requester
 	MessageFactory        *MessageFactory
 	LedgerState           *LedgerState
@@ -66,26 +82,56 @@ type ConfirmationOracle interface {
 
 // ConfirmationEvents are events entailing confirmation.
 type ConfirmationEvents struct {
-	MessageConfirmed     *events.Event
-	BranchConfirmed      *events.Event
-	TransactionConfirmed *events.Event
+	MessageConfirmed      *event.Event[MessageID]
+	BranchConfirmed       *event.Event[ledgerstate.BranchID]
+	TransactionConfirmed  *event.Event[ledgerstate.TransactionID]
+	TransactionGoFChanged *event.Event[*TransactionGoFChangedEvent]
+	MessageGoFChanged     *event.Event[*MessageGoFChangedEvent]
+}
+
+// NewConfirmationEvents is the constructor of the ConfirmationEvents.
+func NewConfirmationEvents() *ConfirmationEvents {
+	return &ConfirmationEvents{
+		MessageConfirmed:      event.New[MessageID]("ConfirmationOracle.MessageConfirmed"),
+		BranchConfirmed:       event.New[ledgerstate.BranchID]("ConfirmationOracle.BranchConfirmed"),
+		TransactionConfirmed:  event.New[ledgerstate.TransactionID]("ConfirmationOracle.TransactionConfirmed"),
+		TransactionGoFChanged: event.New[*TransactionGoFChangedEvent]("ConfirmationOracle.TransactionGoFChanged"),
+		MessageGoFChanged:     event.New[*MessageGoFChangedEvent]("ConfirmationOracle.MessageGoFChanged"),
+	}
+}
+
+// TransactionGoFChangedEvent holds information about a transaction and its updated grade of finality.
+type TransactionGoFChangedEvent struct {
+	TransactionID   ledgerstate.TransactionID
+	GradeOfFinality gof.GradeOfFinality
+}
+
+// MessageGoFChangedEvent holds information about a message and its updated grade of finality.
+type MessageGoFChangedEvent struct {
+	MessageID               MessageID
+	GradeOfFinality         gof.GradeOfFinality
+	PreviousGradeOfFinality gof.GradeOfFinality
 }
 
 // New is the constructor for the Tangle.
 func New(options ...Option) (tangle *Tangle) {
 	tangle = &Tangle{
 		Events: &Events{
-			MessageInvalid: events.NewEvent(MessageInvalidCaller),
-			Error:          events.NewEvent(events.ErrorCaller),
+			MessageInvalid: event.New[*MessageInvalidEvent]("Tangle.MessageInvalid"),
+			Error:          event.New[error]("Tangle.Error"),
 		},
 	}
 
 	tangle.Configure(options...)
 
-	tangle.Parser = NewParser()
+	tangle.Parser = NewParser(tangle.Options.NetworkID)
 	tangle.Storage = NewStorage(tangle)
 	tangle.LedgerState = NewLedgerState(tangle)
 	tangle.Solidifier = NewSolidifier(tangle)
+	tangle.Blacklist = NewBlacklist(tangle)
+	tangle.SequenceTracker = NewSequenceTracker(tangle)
+	tangle.IssuerIndex = NewIssuerIndex(tangle)
+	tangle.PayloadValidator = NewPayloadValidator(tangle)
 	tangle.Scheduler = NewScheduler(tangle)
 	tangle.Booker = NewBooker(tangle)
 	tangle.ApprovalWeightManager = NewApprovalWeightManager(tangle)
@@ -108,7 +154,10 @@ func (t *Tangle) Configure(options ...Option) {
 			Store:                        mapdb.NewMapDB(),
 			Identity:                     identity.GenerateLocalIdentity(),
 			IncreaseMarkersIndexCallback: increaseMarkersIndexCallbackStrategy,
-			LedgerState:                  struct{ MergeBranches bool }{MergeBranches: true},
+			LedgerState:                  LedgerStateParams{MergeBranches: true},
+			WorkerPools:                  workerpools.NewManager(0, nil),
+			MaxParentAge:                 DefaultMaxParentAge,
+			Clock:                        clock.SyncedClock{},
 		}
 	}
 
@@ -122,6 +171,9 @@ func (t *Tangle) Setup() {
 	t.Storage.Setup()
 	t.Solidifier.Setup()
 	t.Requester.Setup()
+	t.Blacklist.Setup()
+	t.SequenceTracker.Setup()
+	t.IssuerIndex.Setup()
 	t.Scheduler.Setup()
 	t.Dispatcher.Setup()
 	t.Booker.Setup()
@@ -130,17 +182,20 @@ func (t *Tangle) Setup() {
 	t.TimeManager.Setup()
 	t.TipManager.Setup()
 
-	t.MessageFactory.Events.Error.Attach(events.NewClosure(func(err error) {
+	t.MessageFactory.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Events.Error.Trigger(errors.Errorf("error in MessageFactory: %w", err))
 	}))
 
-	t.Booker.Events.Error.Attach(events.NewClosure(func(err error) {
+	t.Booker.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Events.Error.Trigger(errors.Errorf("error in Booker: %w", err))
 	}))
 
-	t.Scheduler.Events.Error.Attach(events.NewClosure(func(err error) {
+	t.Scheduler.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Events.Error.Trigger(errors.Errorf("error in Scheduler: %w", err))
 	}))
+
+	// the parked Messages are booked again once all components are attached to the events of the Booker
+	t.Booker.restoreParkedMessages()
 }
 
 // ProcessGossipMessage is used to feed new Messages from the gossip layer into the Tangle.
@@ -172,22 +227,38 @@ func (t *Tangle) Prune() (err error) {
 
 // Shutdown marks the tangle as stopped, so it will not accept any new messages (waits for all backgroundTasks to finish).
 func (t *Tangle) Shutdown() {
-	t.Requester.Shutdown()
-	t.Parser.Shutdown()
-	t.MessageFactory.Shutdown()
-	t.Scheduler.Shutdown()
-	t.Dispatcher.Shutdown()
-	t.Booker.Shutdown()
-	t.ApprovalWeightManager.Shutdown()
-	t.Storage.Shutdown()
-	t.LedgerState.Shutdown()
-	t.TimeManager.Shutdown()
-	t.Options.Store.Shutdown()
-	t.TipManager.Shutdown()
-
-	if t.WeightProvider != nil {
-		t.WeightProvider.Shutdown()
-	}
+	// without a timeout the steps always finish
+	_ = t.ShutdownSequence().Run()
+}
+
+// ShutdownSequence returns the ordered steps that shut down the Tangle: it first stops accepting new Messages, then
+// drains the queues of the components in the order in which the Messages flow through them and finally flushes the
+// storages, so that no component writes to a storage that was already flushed.
+func (t *Tangle) ShutdownSequence(opts ...shutdown.SequenceOption) *shutdown.Sequence {
+	return shutdown.NewSequence("tangle", opts...).
+		Add("stop ingress", func() {
+			t.Requester.Shutdown()
+			t.Parser.Shutdown()
+			t.MessageFactory.Shutdown()
+		}).
+		Add("drain solidifier", t.Solidifier.Shutdown).
+		Add("drain booker", t.Booker.Shutdown).
+		Add("drain approval weight manager", t.ApprovalWeightManager.Shutdown).
+		Add("stop scheduler", t.Scheduler.Shutdown).
+		Add("drain dispatcher", t.Dispatcher.Shutdown).
+		Add("flush storages", func() {
+			t.Booker.MarkersManager.Shutdown()
+			t.Storage.Shutdown()
+			t.LedgerState.Shutdown()
+			t.TimeManager.Shutdown()
+			t.Options.Store.Shutdown()
+			t.TipManager.Shutdown()
+			t.Blacklist.Shutdown()
+
+			if t.WeightProvider != nil {
+				t.WeightProvider.Shutdown()
+			}
+		})
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -197,25 +268,10 @@ func (t *Tangle) Shutdown() {
 // Events represents events happening in the Tangle.
 type Events struct {
 	// MessageInvalid is triggered when a Message is detected to be objectively invalid.
-	MessageInvalid *events.Event
+	MessageInvalid *event.Event[*MessageInvalidEvent]
 
 	// Error is triggered when the Tangle faces an error from which it can not recover.
-	Error *events.Event
-}
-
-// MessageIDCaller is the caller function for events that hand over a MessageID.
-func MessageIDCaller(handler interface{}, params ...interface{}) {
-	handler.(func(MessageID))(params[0].(MessageID))
-}
-
-// MessageCaller is the caller function for events that hand over a Message.
-func MessageCaller(handler interface{}, params ...interface{}) {
-	handler.(func(*Message))(params[0].(*Message))
-}
-
-// MessageInvalidCaller is the caller function for events that had over an invalid message.
-func MessageInvalidCaller(handler interface{}, params ...interface{}) {
-	handler.(func(ev *MessageInvalidEvent))(params[0].(*MessageInvalidEvent))
+	Error *event.Event[error]
 }
 
 // MessageInvalidEvent is struct that is passed along with triggering a messageInvalidEvent.
@@ -239,14 +295,31 @@ type Options struct {
 	IncreaseMarkersIndexCallback   markers.IncreaseIndexCallback
 	TangleWidth                    int
 	GenesisNode                    *ed25519.PublicKey
+	NetworkID                      uint32
 	SchedulerParams                SchedulerParams
 	RateSetterParams               RateSetterParams
+	TipManagerParams               TipManagerParams
+	BlacklistParams                BlacklistParams
+	IssuerIndexParams              IssuerIndexParams
 	WeightProvider                 WeightProvider
 	SyncTimeWindow                 time.Duration
+	SolidificationTimeout          time.Duration
 	TimeSinceConfirmationThreshold time.Duration
 	StartSynced                    bool
 	CacheTimeProvider              *database.CacheTimeProvider
-	LedgerState                    struct{ MergeBranches bool }
+	LedgerState                    LedgerStateParams
+	WorkerPools                    *workerpools.Manager
+	MaxParentAge                   time.Duration
+	MaxDepth                       uint64
+	Clock                          clock.Clock
+}
+
+// WorkerPools is an Option for the Tangle that allows to specify the shared Manager that creates the worker pools of the
+// Tangle components.
+func WorkerPools(manager *workerpools.Manager) Option {
+	return func(options *Options) {
+		options.WorkerPools = manager
+	}
 }
 
 // Store is an Option for the Tangle that allows to specify which storage layer is supposed to be used to persist data.
@@ -314,6 +387,27 @@ func RateSetterConfig(params RateSetterParams) Option {
 	}
 }
 
+// TipManagerConfig is an Option for the Tangle that allows to set the eviction policy of the TipManager.
+func TipManagerConfig(params TipManagerParams) Option {
+	return func(options *Options) {
+		options.TipManagerParams = params
+	}
+}
+
+// BlacklistConfig is an Option for the Tangle that allows to set the detection and handling of protocol violators.
+func BlacklistConfig(params BlacklistParams) Option {
+	return func(options *Options) {
+		options.BlacklistParams = params
+	}
+}
+
+// IssuerIndexConfig is an Option for the Tangle that allows to set the retention limits of the IssuerIndex.
+func IssuerIndexConfig(params IssuerIndexParams) Option {
+	return func(options *Options) {
+		options.IssuerIndexParams = params
+	}
+}
+
 // ApprovalWeights is an Option for the Tangle that allows to define how the approval weights of Messages is determined.
 func ApprovalWeights(weightProvider WeightProvider) Option {
 	return func(options *Options) {
@@ -329,6 +423,14 @@ func SyncTimeWindow(syncTimeWindow time.Duration) Option {
 	}
 }
 
+// SolidificationTimeout is an Option for the Tangle that allows to define the time after which Messages that could not
+// be solidified are marked as orphaned (0 disables the timeout).
+func SolidificationTimeout(solidificationTimeout time.Duration) Option {
+	return func(options *Options) {
+		options.SolidificationTimeout = solidificationTimeout
+	}
+}
+
 // StartSynced is an Option for the Tangle that allows to define if the node starts as synced.
 func StartSynced(startSynced bool) Option {
 	return func(options *Options) {
@@ -336,6 +438,14 @@ func StartSynced(startSynced bool) Option {
 	}
 }
 
+// Clock is an Option for the Tangle that allows to replace the synchronized time of the node, which the components of
+// the Tangle use to make their time-dependent decisions, e.g. with a clock.VirtualClock in tests and simulations.
+func Clock(clock clock.Clock) Option {
+	return func(options *Options) {
+		options.Clock = clock
+	}
+}
+
 // CacheTimeProvider is an Option for the Tangle that allows to override hard coded cache time.
 func CacheTimeProvider(cacheTimeProvider *database.CacheTimeProvider) Option {
 	return func(options *Options) {
@@ -350,6 +460,62 @@ func MergeBranches(mergeBranches bool) Option {
 	}
 }
 
+// NetworkID is an Option for the Tangle that allows to specify the ID of the network the node belongs to. The ID is
+// part of the signed content of every issued Message, so that Messages of other networks are rejected by the Parser.
+func NetworkID(networkID uint32) Option {
+	return func(o *Options) {
+		o.NetworkID = networkID
+	}
+}
+
+// MaxConflictingConsumers is an Option for the Tangle that limits the number of conflicting consumers of an Output
+// after which the Messages containing further conflicting Transactions are parked until the conflict is resolved.
+func MaxConflictingConsumers(maxConflictingConsumers int) Option {
+	return func(o *Options) {
+		o.LedgerState.MaxConflictingConsumers = maxConflictingConsumers
+	}
+}
+
+// ParkingTimeout is an Option for the Tangle that defines the time after which parked Messages whose conflicts were not
+// resolved are marked as orphaned (together with the Messages that wait for them).
+func ParkingTimeout(parkingTimeout time.Duration) Option {
+	return func(o *Options) {
+		o.LedgerState.ParkingTimeout = parkingTimeout
+	}
+}
+
+// MaxParentAge is an Option for the Tangle that allows to define the biggest allowed time difference between the
+// issuing times of a Message and its parents. Messages referencing older parents are invalid.
+func MaxParentAge(maxParentAge time.Duration) Option {
+	return func(o *Options) {
+		o.MaxParentAge = maxParentAge
+	}
+}
+
+// MaxDepth is an Option for the Tangle that allows to define how many Markers the strong parents of a Message may lie
+// behind the first unconfirmed Marker of their Sequences before the Message is invalid (0 disables the check).
+func MaxDepth(maxDepth uint64) Option {
+	return func(o *Options) {
+		o.MaxDepth = maxDepth
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region LedgerStateParams ////////////////////////////////////////////////////////////////////////////////////////////
+
+// LedgerStateParams defines the configuration parameters of the LedgerState.
+type LedgerStateParams struct {
+	// MergeBranches defines if confirmed Branches are merged into their parents.
+	MergeBranches bool
+	// MaxConflictingConsumers defines the number of conflicting consumers of an Output after which further conflicting
+	// Transactions are parked until the conflict is resolved (0 disables the limit).
+	MaxConflictingConsumers int
+	// ParkingTimeout defines the time after which parked Messages whose conflicts were not resolved are marked as
+	// orphaned (0 disables the timeout).
+	ParkingTimeout time.Duration
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region WeightProvider //////////////////////////////////////////////////////////////////////////////////////////////////////
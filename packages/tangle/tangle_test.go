@@ -15,8 +15,8 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
 	"github.com/iotaledger/hive.go/generics/randommap"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
 
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/testutil"
 	"github.com/iotaledger/hive.go/workerpool"
 	"github.com/panjf2000/ants/v2"
@@ -24,7 +24,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/pow"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
 )
 
@@ -127,12 +129,12 @@ func TestTangle_InvalidParentsAgeMessage(t *testing.T) {
 	var storedMessages, solidMessages, invalidMessages int32
 
 	newOldParentsMessage := func(strongParents MessageIDs) *Message {
-		message, err := NewMessage(emptyLikeReferencesFromStrongParents(strongParents), time.Now().Add(maxParentsTimeDifference+5*time.Minute), ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte("Old")), 0, ed25519.Signature{})
+		message, err := NewMessage(emptyLikeReferencesFromStrongParents(strongParents), time.Now().Add(DefaultMaxParentAge+5*time.Minute), ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte("Old")), 0, ed25519.Signature{})
 		assert.NoError(t, err)
 		return message
 	}
 	newYoungParentsMessage := func(strongParents MessageIDs) *Message {
-		message, err := NewMessage(emptyLikeReferencesFromStrongParents(strongParents), time.Now().Add(-maxParentsTimeDifference-5*time.Minute), ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte("Young")), 0, ed25519.Signature{})
+		message, err := NewMessage(emptyLikeReferencesFromStrongParents(strongParents), time.Now().Add(-DefaultMaxParentAge-5*time.Minute), ed25519.PublicKey{}, 0, payload.NewGenericDataPayload([]byte("Young")), 0, ed25519.Signature{})
 		assert.NoError(t, err)
 		return message
 	}
@@ -143,18 +145,18 @@ func TestTangle_InvalidParentsAgeMessage(t *testing.T) {
 	}
 
 	var wg sync.WaitGroup
-	messageTangle.Storage.Events.MessageStored.Attach(events.NewClosure(func(messageID MessageID) {
+	messageTangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID MessageID) {
 		fmt.Println("STORED:", messageID)
 		atomic.AddInt32(&storedMessages, 1)
 		wg.Done()
 	}))
 
-	messageTangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(messageID MessageID) {
+	messageTangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(messageID MessageID) {
 		fmt.Println("SOLID:", messageID)
 		atomic.AddInt32(&solidMessages, 1)
 	}))
 
-	messageTangle.Events.MessageInvalid.Attach(events.NewClosure(func(messageInvalidEvent *MessageInvalidEvent) {
+	messageTangle.Events.MessageInvalid.Attach(event.NewClosure(func(messageInvalidEvent *MessageInvalidEvent) {
 		fmt.Println("INVALID:", messageInvalidEvent.MessageID)
 		atomic.AddInt32(&invalidMessages, 1)
 	}))
@@ -172,12 +174,14 @@ func TestTangle_InvalidParentsAgeMessage(t *testing.T) {
 	messageTangle.Storage.StoreMessage(messageOldParents)
 	messageTangle.Storage.StoreMessage(messageYoungParents)
 
-	// wait for all messages to become solid
+	// wait for all messages to be stored
 	wg.Wait()
-
 	assert.EqualValues(t, 5, atomic.LoadInt32(&storedMessages))
-	assert.EqualValues(t, 3, atomic.LoadInt32(&solidMessages))
-	assert.EqualValues(t, 2, atomic.LoadInt32(&invalidMessages))
+
+	// wait for all messages to be processed by the Solidifier
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&solidMessages) == 3 && atomic.LoadInt32(&invalidMessages) == 2
+	}, 5*time.Second, 10*time.Millisecond)
 }
 
 func TestTangle_StoreMessage(t *testing.T) {
@@ -189,19 +193,19 @@ func TestTangle_StoreMessage(t *testing.T) {
 		return
 	}
 
-	messageTangle.Storage.Events.MessageStored.Attach(events.NewClosure(func(messageID MessageID) {
+	messageTangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID MessageID) {
 		fmt.Println("STORED:", messageID)
 	}))
 
-	messageTangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(messageID MessageID) {
+	messageTangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(messageID MessageID) {
 		fmt.Println("SOLID:", messageID)
 	}))
 
-	messageTangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(func(messageId MessageID) {
+	messageTangle.Solidifier.Events.MessageMissing.Attach(event.NewClosure(func(messageId MessageID) {
 		fmt.Println("MISSING:", messageId)
 	}))
 
-	messageTangle.Storage.Events.MessageRemoved.Attach(events.NewClosure(func(messageId MessageID) {
+	messageTangle.Storage.Events.MessageRemoved.Attach(event.NewClosure(func(messageId MessageID) {
 		fmt.Println("REMOVED:", messageId)
 	}))
 
@@ -215,6 +219,109 @@ func TestTangle_StoreMessage(t *testing.T) {
 	messageTangle.Storage.StoreMessage(newMessageOne)
 }
 
+func TestTangle_ShutdownSequence(t *testing.T) {
+	store := mapdb.NewMapDB()
+	tangle := NewTestTangle(Store(store))
+	testFramework := NewMessageTestFramework(tangle)
+	tangle.Setup()
+
+	assert.Equal(t, []string{
+		"stop ingress",
+		"drain solidifier",
+		"drain booker",
+		"drain approval weight manager",
+		"stop scheduler",
+		"drain dispatcher",
+		"flush storages",
+	}, tangle.ShutdownSequence().Steps())
+
+	// the Messages are still in the queues of the components when the shutdown starts
+	testFramework.CreateMessage("Message1", WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message2", WithStrongParents("Message1"))
+	testFramework.CreateMessage("Message3", WithStrongParents("Message1", "Message2"))
+	testFramework.IssueMessages("Message1", "Message2", "Message3")
+	require.NoError(t, tangle.ShutdownSequence(shutdown.WithTimeout(10*time.Second)).Run())
+
+	restartedTangle := NewTestTangle(Store(store))
+	defer restartedTangle.Shutdown()
+	for _, alias := range []string{"Message1", "Message2", "Message3"} {
+		assert.True(t, restartedTangle.Storage.MessageMetadata(testFramework.Message(alias).ID()).Consume(func(messageMetadata *MessageMetadata) {
+			assert.True(t, messageMetadata.IsBooked(), alias)
+		}), alias)
+	}
+}
+
+func TestTangle_OrphanedMessages(t *testing.T) {
+	messageTangle := NewTestTangle(SolidificationTimeout(500 * time.Millisecond))
+	messageTangle.Storage.Setup()
+	messageTangle.Solidifier.Setup()
+	messageTangle.Requester.Setup()
+	defer messageTangle.Shutdown()
+
+	var orphanedMutex sync.Mutex
+	orphanedMessages := make(map[MessageID]MessageIDs)
+	messageTangle.Solidifier.Events.MessageOrphaned.Attach(event.NewClosure(func(orphanedEvent *MessageOrphanedEvent) {
+		orphanedMutex.Lock()
+		defer orphanedMutex.Unlock()
+
+		orphanedMessages[orphanedEvent.MessageID] = orphanedEvent.MissingAncestors
+	}))
+	orphanedCount := func() int {
+		orphanedMutex.Lock()
+		defer orphanedMutex.Unlock()
+
+		return len(orphanedMessages)
+	}
+
+	missingMessage := newTestDataMessage("missing")
+	unsolidMessage := newTestParentsDataMessage("unsolid", emptyLikeReferencesFromStrongParents(NewMessageIDs(missingMessage.ID())))
+	unsolidApprover := newTestParentsDataMessage("approver", emptyLikeReferencesFromStrongParents(NewMessageIDs(unsolidMessage.ID())))
+
+	messageTangle.Storage.StoreMessage(unsolidMessage)
+	messageTangle.Storage.StoreMessage(unsolidApprover)
+
+	assert.Eventually(t, func() bool { return orphanedCount() == 2 }, 5*time.Second, 10*time.Millisecond)
+
+	for _, messageID := range []MessageID{unsolidMessage.ID(), unsolidApprover.ID()} {
+		assert.Equal(t, NewMessageIDs(missingMessage.ID()), orphanedMessages[messageID])
+		assert.True(t, messageTangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+			assert.True(t, messageMetadata.IsOrphaned())
+			assert.False(t, messageMetadata.IsSolid())
+		}))
+	}
+	assert.Empty(t, messageTangle.Storage.MissingMessages())
+	assert.Zero(t, messageTangle.Requester.RequestQueueSize())
+
+	// messages referencing an orphaned parent are orphaned right away
+	lateApprover := newTestParentsDataMessage("late", emptyLikeReferencesFromStrongParents(NewMessageIDs(unsolidApprover.ID())))
+	messageTangle.Storage.StoreMessage(lateApprover)
+
+	assert.Eventually(t, func() bool { return orphanedCount() == 3 }, 200*time.Millisecond, 10*time.Millisecond)
+
+	// the orphaned messages are no longer orphaned once the missing message arrives and they become solid
+	messageTangle.Storage.StoreMessage(missingMessage)
+	for _, messageID := range []MessageID{unsolidMessage.ID(), unsolidApprover.ID(), lateApprover.ID()} {
+		messageID := messageID
+		assert.Eventually(t, func() (solid bool) {
+			messageTangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+				solid = messageMetadata.IsSolid() && !messageMetadata.IsOrphaned()
+			})
+			return solid
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	// their approvers are not orphaned anymore
+	solidApprover := newTestParentsDataMessage("solid", emptyLikeReferencesFromStrongParents(NewMessageIDs(lateApprover.ID())))
+	messageTangle.Storage.StoreMessage(solidApprover)
+	assert.Eventually(t, func() (solid bool) {
+		messageTangle.Storage.MessageMetadata(solidApprover.ID()).Consume(func(messageMetadata *MessageMetadata) {
+			solid = messageMetadata.IsSolid()
+		})
+		return solid
+	}, 5*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 3, orphanedCount())
+}
+
 func TestTangle_MissingMessages(t *testing.T) {
 	const (
 		messageCount = 2000
@@ -289,13 +396,13 @@ func TestTangle_MissingMessages(t *testing.T) {
 		missingMessages int32
 		solidMessages   int32
 	)
-	tangle.Storage.Events.MessageStored.Attach(events.NewClosure(func(MessageID) {
+	tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(MessageID) {
 		n := atomic.AddInt32(&storedMessages, 1)
 		t.Logf("stored messages %d/%d", n, messageCount)
 	}))
 
 	// increase the counter when a missing message was detected
-	tangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(func(messageId MessageID) {
+	tangle.Solidifier.Events.MessageMissing.Attach(event.NewClosure(func(messageId MessageID) {
 		atomic.AddInt32(&missingMessages, 1)
 		// store the message after it has been requested
 		go func() {
@@ -305,12 +412,12 @@ func TestTangle_MissingMessages(t *testing.T) {
 	}))
 
 	// decrease the counter when a missing message was received
-	tangle.Storage.Events.MissingMessageStored.Attach(events.NewClosure(func(MessageID) {
+	tangle.Storage.Events.MissingMessageStored.Attach(event.NewClosure(func(MessageID) {
 		n := atomic.AddInt32(&missingMessages, -1)
 		t.Logf("missing messages %d", n)
 	}))
 
-	tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(MessageID) {
+	tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(MessageID) {
 		n := atomic.AddInt32(&solidMessages, 1)
 		t.Logf("solid messages %d/%d", n, messageCount)
 	}))
@@ -343,7 +450,7 @@ func TestRetrieveAllTips(t *testing.T) {
 
 	var wg sync.WaitGroup
 
-	messageTangle.Dispatcher.Events.MessageDispatched.Attach(events.NewClosure(func(MessageID) {
+	messageTangle.Dispatcher.Events.MessageDispatched.Attach(event.NewClosure(func(MessageID) {
 		wg.Done()
 	}))
 
@@ -489,34 +596,34 @@ func TestTangle_Flow(t *testing.T) {
 		rejectedMessages   int32
 	)
 
-	tangle.Parser.Events.BytesRejected.AttachAfter(events.NewClosure(func(e *BytesRejectedEvent, err error) {
-		t.Logf("rejected bytes %v - %s", e.Bytes, err)
+	tangle.Parser.Events.BytesRejected.AttachAfter(event.NewClosure(func(e *BytesRejectedEvent) {
+		t.Logf("rejected bytes %v - %s", e.Bytes, e.Error)
 	}))
 
 	// filter rejected events
-	tangle.Parser.Events.MessageRejected.AttachAfter(events.NewClosure(func(msgRejectedEvent *MessageRejectedEvent, err error) {
+	tangle.Parser.Events.MessageRejected.AttachAfter(event.NewClosure(func(msgRejectedEvent *MessageRejectedEvent) {
 		n := atomic.AddInt32(&rejectedMessages, 1)
-		t.Logf("rejected by message filter messages %d/%d - %s %s", n, totalMsgCount, msgRejectedEvent.Message.ID(), err)
+		t.Logf("rejected by message filter messages %d/%d - %s %s", n, totalMsgCount, msgRejectedEvent.Message.ID(), msgRejectedEvent.Error)
 	}))
 
-	tangle.Parser.Events.MessageParsed.AttachAfter(events.NewClosure(func(msgParsedEvent *MessageParsedEvent) {
+	tangle.Parser.Events.MessageParsed.AttachAfter(event.NewClosure(func(msgParsedEvent *MessageParsedEvent) {
 		n := atomic.AddInt32(&parsedMessages, 1)
 		t.Logf("parsed messages %d/%d - %s", n, totalMsgCount, msgParsedEvent.Message.ID())
 	}))
 
 	// message invalid events
-	tangle.Events.MessageInvalid.AttachAfter(events.NewClosure(func(messageInvalidEvent *MessageInvalidEvent) {
+	tangle.Events.MessageInvalid.AttachAfter(event.NewClosure(func(messageInvalidEvent *MessageInvalidEvent) {
 		n := atomic.AddInt32(&invalidMessages, 1)
 		t.Logf("invalid messages %d/%d - %s", n, totalMsgCount, messageInvalidEvent.MessageID)
 	}))
 
-	tangle.Storage.Events.MessageStored.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.Storage.Events.MessageStored.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&storedMessages, 1)
 		t.Logf("stored messages %d/%d - %s", n, totalMsgCount, messageID)
 	}))
 
 	// increase the counter when a missing message was detected
-	tangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(func(messageId MessageID) {
+	tangle.Solidifier.Events.MessageMissing.Attach(event.NewClosure(func(messageId MessageID) {
 		atomic.AddInt32(&missingMessages, 1)
 
 		// push the message into the gossip inboxWP
@@ -524,36 +631,36 @@ func TestTangle_Flow(t *testing.T) {
 	}))
 
 	// decrease the counter when a missing message was received
-	tangle.Storage.Events.MissingMessageStored.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.Storage.Events.MissingMessageStored.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&missingMessages, -1)
 		t.Logf("missing messages %d - %s", n, messageID)
 	}))
 
-	tangle.Solidifier.Events.MessageSolid.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.Solidifier.Events.MessageSolid.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&solidMessages, 1)
 		t.Logf("solid messages %d/%d - %s", n, totalMsgCount, messageID)
 	}))
 
-	tangle.Scheduler.Events.MessageScheduled.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.Scheduler.Events.MessageScheduled.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&scheduledMessages, 1)
 		t.Logf("scheduled messages %d/%d - %s", n, totalMsgCount, messageID)
 	}))
 
-	tangle.Booker.Events.MessageBooked.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.Booker.Events.MessageBooked.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&bookedMessages, 1)
 		t.Logf("booked messages %d/%d - %s", n, totalMsgCount, messageID)
 	}))
 
-	tangle.Dispatcher.Events.MessageDispatched.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.Dispatcher.Events.MessageDispatched.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&dispatchedMessages, 1)
 		t.Logf("dispatched messages %d/%d", n, totalMsgCount)
 	}))
-	tangle.ApprovalWeightManager.Events.MessageProcessed.AttachAfter(events.NewClosure(func(messageID MessageID) {
+	tangle.ApprovalWeightManager.Events.MessageProcessed.AttachAfter(event.NewClosure(func(messageID MessageID) {
 		n := atomic.AddInt32(&awMessages, 1)
 		t.Logf("approval weight processed messages %d/%d", n, totalMsgCount)
 	}))
 
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) {
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) {
 		t.Logf("Error %s", err)
 	}))
 
@@ -611,7 +718,7 @@ func (f *MessageFactory) issueInvalidTsPayload(p payload.Payload, _ ...*Tangle)
 		return nil, err
 	}
 
-	issuingTime := time.Now().Add(maxParentsTimeDifference + 5*time.Minute)
+	issuingTime := time.Now().Add(DefaultMaxParentAge + 5*time.Minute)
 	issuerPublicKey := f.localIdentity.PublicKey()
 
 	// do the PoW
@@ -9,13 +9,14 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/blake2b"
 
 	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
@@ -36,6 +37,7 @@ type MessageTestFramework struct {
 	outputsByID              map[ledgerstate.OutputID]ledgerstate.Output
 	options                  *MessageTestFrameworkOptions
 	oldIncreaseIndexCallback markers.IncreaseIndexCallback
+	sequenceNumber           uint64
 	messagesBookedWG         sync.WaitGroup
 	approvalWeightProcessed  sync.WaitGroup
 }
@@ -55,19 +57,30 @@ func NewMessageTestFramework(tangle *Tangle, options ...MessageTestFrameworkOpti
 	}
 
 	messageTestFramework.createGenesisOutputs()
+	messageTestFramework.attachTangle(tangle)
+
+	return
+}
 
-	tangle.Booker.Events.MessageBooked.AttachAfter(events.NewClosure(func(messageID MessageID) {
-		messageTestFramework.messagesBookedWG.Done()
+// attachTangle makes the MessageTestFramework issue its Messages in the given Tangle and track their processing.
+func (m *MessageTestFramework) attachTangle(tangle *Tangle) {
+	m.tangle = tangle
+
+	tangle.Booker.Events.MessageBooked.AttachAfter(event.NewClosure(func(messageID MessageID) {
+		m.messagesBookedWG.Done()
 	}))
-	tangle.ApprovalWeightManager.Events.MessageProcessed.AttachAfter(events.NewClosure(func(messageID MessageID) {
-		messageTestFramework.approvalWeightProcessed.Done()
+	tangle.ApprovalWeightManager.Events.MessageProcessed.AttachAfter(event.NewClosure(func(messageID MessageID) {
+		m.approvalWeightProcessed.Done()
 	}))
-	tangle.Events.MessageInvalid.AttachAfter(events.NewClosure(func(_ *MessageInvalidEvent) {
-		messageTestFramework.messagesBookedWG.Done()
-		messageTestFramework.approvalWeightProcessed.Done()
+	tangle.Events.MessageInvalid.AttachAfter(event.NewClosure(func(_ *MessageInvalidEvent) {
+		m.messagesBookedWG.Done()
+		m.approvalWeightProcessed.Done()
 	}))
 
-	return
+	if m.oldIncreaseIndexCallback != nil {
+		m.oldIncreaseIndexCallback = tangle.Options.IncreaseMarkersIndexCallback
+		tangle.Options.IncreaseMarkersIndexCallback = m.IncreaseMarkersIndexCallback
+	}
 }
 
 // RegisterBranchID registers a BranchID from the given Messages' transactions with the MessageTestFramework and
@@ -106,6 +119,13 @@ func (m *MessageTestFramework) BranchIDs(aliases ...string) (branchIDs ledgersta
 // CreateMessage creates a Message with the given alias and MessageTestFrameworkMessageOptions.
 func (m *MessageTestFramework) CreateMessage(messageAlias string, messageOptions ...MessageOption) (message *Message) {
 	options := NewMessageTestFrameworkMessageOptions(messageOptions...)
+	if !options.overrideSequenceNumber {
+		options.sequenceNumber = m.nextSequenceNumber()
+		options.overrideSequenceNumber = true
+	}
+	if options.issuingTime.IsZero() {
+		options.issuingTime = m.tangle.Options.Clock.Now()
+	}
 
 	references := ParentMessageIDs{
 		StrongParentType:         m.strongParentIDs(options),
@@ -259,7 +279,7 @@ func (m *MessageTestFramework) createGenesisOutputs() {
 	genesisOutputs := make(map[ledgerstate.Address]*ledgerstate.ColoredBalances)
 
 	for alias, balance := range m.options.genesisOutputs {
-		addressWallet := createWallets(1)[0]
+		addressWallet := m.createWallet(alias)
 
 		m.walletsByAlias[alias] = addressWallet
 		m.walletsByAddress[addressWallet.address] = addressWallet
@@ -270,7 +290,7 @@ func (m *MessageTestFramework) createGenesisOutputs() {
 	}
 
 	for alias, coloredBalances := range m.options.coloredGenesisOutputs {
-		addressWallet := createWallets(1)[0]
+		addressWallet := m.createWallet(alias)
 		m.walletsByAlias[alias] = addressWallet
 		m.walletsByAddress[addressWallet.address] = addressWallet
 
@@ -287,7 +307,7 @@ func (m *MessageTestFramework) createGenesisOutputs() {
 
 	genesisEssence := ledgerstate.NewTransactionEssence(
 		0,
-		time.Now(),
+		m.tangle.Options.Clock.Now(),
 		identity.ID{},
 		identity.ID{},
 		ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))),
@@ -344,7 +364,7 @@ func (m *MessageTestFramework) buildTransaction(options *MessageTestFrameworkMes
 
 	outputs := make([]ledgerstate.Output, 0)
 	for alias, balance := range options.outputs {
-		addressWallet := createWallets(1)[0]
+		addressWallet := m.createWallet(alias)
 		m.walletsByAlias[alias] = addressWallet
 		m.walletsByAddress[addressWallet.address] = addressWallet
 
@@ -353,7 +373,7 @@ func (m *MessageTestFramework) buildTransaction(options *MessageTestFrameworkMes
 		outputs = append(outputs, m.outputsByAlias[alias])
 	}
 	for alias, balances := range options.coloredOutputs {
-		addressWallet := createWallets(1)[0]
+		addressWallet := m.createWallet(alias)
 		m.walletsByAlias[alias] = addressWallet
 		m.walletsByAddress[addressWallet.address] = addressWallet
 
@@ -362,7 +382,7 @@ func (m *MessageTestFramework) buildTransaction(options *MessageTestFrameworkMes
 		outputs = append(outputs, m.outputsByAlias[alias])
 	}
 
-	transaction = makeTransaction(ledgerstate.NewInputs(inputs...), ledgerstate.NewOutputs(outputs...), m.outputsByID, m.walletsByAddress)
+	transaction = makeTransactionWithTimestamp(m.tangle.Options.Clock.Now(), ledgerstate.NewInputs(inputs...), ledgerstate.NewOutputs(outputs...), m.outputsByID, m.walletsByAddress)
 	for outputIndex, output := range transaction.Essence().Outputs() {
 		for alias, aliasedOutput := range m.outputsByAlias {
 			if aliasedOutput == output {
@@ -379,6 +399,24 @@ func (m *MessageTestFramework) buildTransaction(options *MessageTestFrameworkMes
 	return
 }
 
+// createWallet creates the wallet of the Output with the given alias. The wallet is derived from the alias if the
+// MessageTestFramework was configured with a wallet seed.
+func (m *MessageTestFramework) createWallet(alias string) wallet {
+	if m.options.walletSeed == nil {
+		return createWallets(1)[0]
+	}
+
+	aliasSeed := blake2b.Sum256(append(append([]byte{}, m.options.walletSeed...), alias...))
+	keyPair := ed25519.NewSeed(aliasSeed[:]).KeyPair(0)
+
+	return wallet{*keyPair, ledgerstate.NewED25519Address(keyPair.PublicKey)}
+}
+
+// nextSequenceNumber returns the sequence number of the next Message that is created by the MessageTestFramework.
+func (m *MessageTestFramework) nextSequenceNumber() uint64 {
+	return atomic.AddUint64(&m.sequenceNumber, 1) - 1
+}
+
 // strongParentIDs returns the MessageIDs that were defined to be the strong parents of the
 // MessageTestFrameworkMessageOptions.
 func (m *MessageTestFramework) strongParentIDs(options *MessageTestFrameworkMessageOptions) MessageIDs {
@@ -426,6 +464,7 @@ func (m *MessageTestFramework) parentIDsByMessageAlias(parentAliases map[string]
 type MessageTestFrameworkOptions struct {
 	genesisOutputs        map[string]uint64
 	coloredGenesisOutputs map[string]map[ledgerstate.Color]uint64
+	walletSeed            []byte
 }
 
 // NewMessageTestFrameworkOptions is the constructor for the MessageTestFrameworkOptions.
@@ -476,6 +515,14 @@ func WithColoredGenesisOutput(alias string, balances map[ledgerstate.Color]uint6
 	}
 }
 
+// WithWalletSeed returns a MessageTestFrameworkOption that derives the wallets of the Outputs from the given seed and
+// their alias instead of generating random ones, so that the created Transactions and Messages are reproducible.
+func WithWalletSeed(seed []byte) MessageTestFrameworkOption {
+	return func(options *MessageTestFrameworkOptions) {
+		options.walletSeed = seed
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region MessageTestFrameworkMessageOptions ///////////////////////////////////////////////////////////////////////////
@@ -737,7 +784,11 @@ func addressFromInput(input ledgerstate.Input, outputsByID ledgerstate.OutputsBy
 }
 
 func makeTransaction(inputs ledgerstate.Inputs, outputs ledgerstate.Outputs, outputsByID map[ledgerstate.OutputID]ledgerstate.Output, walletsByAddress map[ledgerstate.Address]wallet, genesisWallet ...wallet) *ledgerstate.Transaction {
-	txEssence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, inputs, outputs)
+	return makeTransactionWithTimestamp(time.Now(), inputs, outputs, outputsByID, walletsByAddress, genesisWallet...)
+}
+
+func makeTransactionWithTimestamp(timestamp time.Time, inputs ledgerstate.Inputs, outputs ledgerstate.Outputs, outputsByID map[ledgerstate.OutputID]ledgerstate.Output, walletsByAddress map[ledgerstate.Address]wallet, genesisWallet ...wallet) *ledgerstate.Transaction {
+	txEssence := ledgerstate.NewTransactionEssence(0, timestamp, identity.ID{}, identity.ID{}, inputs, outputs)
 	unlockBlocks := make([]ledgerstate.UnlockBlock, len(txEssence.Inputs()))
 	for i, input := range txEssence.Inputs() {
 		w := wallet{}
@@ -814,7 +865,7 @@ func NewTestTangle(options ...Option) *Tangle {
 		t.WeightProvider = &MockWeightProvider{}
 	}
 
-	t.Events.Error.Attach(events.NewClosure(func(e error) {
+	t.Events.Error.Attach(event.NewClosure(func(e error) {
 		fmt.Println(e)
 	}))
 
@@ -858,11 +909,7 @@ func (m *MockConfirmationOracle) IsOutputConfirmed(outputID ledgerstate.OutputID
 
 // Events mocks its interface function.
 func (m *MockConfirmationOracle) Events() *ConfirmationEvents {
-	return &ConfirmationEvents{
-		MessageConfirmed:     events.NewEvent(nil),
-		TransactionConfirmed: events.NewEvent(nil),
-		BranchConfirmed:      events.NewEvent(nil),
-	}
+	return NewConfirmationEvents()
 }
 
 // MockWeightProvider is a mock of a WeightProvider.
@@ -930,10 +977,7 @@ type EventMock struct {
 	calledEvents   uint64
 	test           *testing.T
 
-	attached []struct {
-		*events.Event
-		*events.Closure
-	}
+	attached []func()
 }
 
 // NewEventMock creates a new EventMock.
@@ -943,11 +987,11 @@ func NewEventMock(t *testing.T, approvalWeightManager *ApprovalWeightManager) *E
 	}
 	e.Test(t)
 
-	approvalWeightManager.Events.BranchWeightChanged.Attach(events.NewClosure(e.BranchWeightChanged))
-	approvalWeightManager.Events.MarkerWeightChanged.Attach(events.NewClosure(e.MarkerWeightChanged))
+	approvalWeightManager.Events.BranchWeightChanged.Attach(event.NewClosure(e.BranchWeightChanged))
+	approvalWeightManager.Events.MarkerWeightChanged.Attach(event.NewClosure(e.MarkerWeightChanged))
 
 	// attach all events
-	e.attach(approvalWeightManager.Events.MessageProcessed, e.MessageProcessed)
+	attachEventMock(e, approvalWeightManager.Events.MessageProcessed, e.MessageProcessed)
 
 	// assure that all available events are mocked
 	numEvents := reflect.ValueOf(approvalWeightManager.Events).Elem().NumField()
@@ -958,8 +1002,8 @@ func NewEventMock(t *testing.T, approvalWeightManager *ApprovalWeightManager) *E
 
 // DetachAll detaches all event handlers.
 func (e *EventMock) DetachAll() {
-	for _, a := range e.attached {
-		a.Event.Detach(a.Closure)
+	for _, detach := range e.attached {
+		detach()
 	}
 }
 
@@ -969,13 +1013,11 @@ func (e *EventMock) Expect(eventName string, arguments ...interface{}) {
 	atomic.AddUint64(&e.expectedEvents, 1)
 }
 
-func (e *EventMock) attach(event *events.Event, f interface{}) {
-	closure := events.NewClosure(f)
-	event.Attach(closure)
-	e.attached = append(e.attached, struct {
-		*events.Event
-		*events.Closure
-	}{event, closure})
+// attachEventMock attaches the given handler of the EventMock to the given Event.
+func attachEventMock[T any](e *EventMock, mockedEvent *event.Event[T], handler func(T)) {
+	closure := event.NewClosure(handler)
+	mockedEvent.Attach(closure)
+	e.attached = append(e.attached, func() { mockedEvent.Detach(closure) })
 }
 
 // AssertExpectations asserts expectations.
@@ -7,13 +7,12 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/cerrors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/marshalutil"
 	"github.com/iotaledger/hive.go/stringify"
 	"github.com/iotaledger/hive.go/timeutil"
 
-	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 )
 
 const (
@@ -44,7 +43,7 @@ type TimeManager struct {
 func NewTimeManager(tangle *Tangle) *TimeManager {
 	t := &TimeManager{
 		Events: &TimeManagerEvents{
-			SyncChanged: events.NewEvent(SyncChangedCaller),
+			SyncChanged: event.New[*SyncChangedEvent]("TimeManager.SyncChanged"),
 		},
 		tangle:      tangle,
 		startSynced: tangle.Options.StartSynced,
@@ -81,7 +80,7 @@ func (t *TimeManager) Start() {
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (t *TimeManager) Setup() {
-	t.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(events.NewClosure(t.updateTime))
+	t.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(event.NewClosure(t.updateTime))
 	t.Start()
 }
 
@@ -128,7 +127,7 @@ func (t *TimeManager) synced() bool {
 		return true
 	}
 
-	return clock.Since(t.lastConfirmedMessage.Time) < t.tangle.Options.SyncTimeWindow
+	return t.tangle.Options.Clock.Since(t.lastConfirmedMessage.Time) < t.tangle.Options.SyncTimeWindow
 }
 
 // checks whether the synced state needs to be updated and if so,
@@ -234,7 +233,7 @@ func (l LastConfirmedMessage) String() string {
 // TimeManagerEvents represents events happening in the TimeManager.
 type TimeManagerEvents struct {
 	// Fired when the nodes sync status changes.
-	SyncChanged *events.Event
+	SyncChanged *event.Event[*SyncChangedEvent]
 }
 
 // SyncChangedEvent represents a sync changed event.
@@ -242,9 +241,4 @@ type SyncChangedEvent struct {
 	Synced bool
 }
 
-// SyncChangedCaller is the caller function for sync changed event.
-func SyncChangedCaller(handler interface{}, params ...interface{}) {
-	handler.(func(ev *SyncChangedEvent))(params[0].(*SyncChangedEvent))
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -6,13 +6,12 @@ import (
 	"time"
 
 	"github.com/cockroachdb/errors"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/randommap"
 	"github.com/iotaledger/hive.go/generics/walker"
-	"github.com/iotaledger/hive.go/timedexecutor"
-	"github.com/iotaledger/hive.go/timedqueue"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
@@ -20,87 +19,114 @@ import (
 
 // region TimedTaskExecutor ////////////////////////////////////////////////////////////////////////////////////////////
 
-// TimedTaskExecutor is a TimedExecutor that internally manages the scheduled callbacks as tasks with a unique
-// identifier. It allows to replace existing scheduled tasks and cancel them using the same identifier.
+// TimedTaskExecutor schedules callbacks on a Clock and manages them as tasks with a unique identifier. It allows to
+// replace existing scheduled tasks and cancel them using the same identifier.
 type TimedTaskExecutor struct {
-	*timedexecutor.TimedExecutor
-	queuedElements      map[interface{}]*timedqueue.QueueElement
-	queuedElementsMutex sync.Mutex
+	clock          clock.Clock
+	scheduledTasks map[interface{}]clock.Timer
+	shutdown       bool
+	mutex          sync.Mutex
 }
 
 // NewTimedTaskExecutor is the constructor of the TimedTaskExecutor.
-func NewTimedTaskExecutor(workerCount int) *TimedTaskExecutor {
+func NewTimedTaskExecutor(executionClock clock.Clock) *TimedTaskExecutor {
 	return &TimedTaskExecutor{
-		TimedExecutor:  timedexecutor.New(workerCount),
-		queuedElements: make(map[interface{}]*timedqueue.QueueElement),
+		clock:          executionClock,
+		scheduledTasks: make(map[interface{}]clock.Timer),
 	}
 }
 
 // ExecuteAfter executes the given function after the given delay.
-func (t *TimedTaskExecutor) ExecuteAfter(identifier interface{}, callback func(), delay time.Duration) *timedexecutor.ScheduledTask {
-	t.queuedElementsMutex.Lock()
-	defer t.queuedElementsMutex.Unlock()
+func (t *TimedTaskExecutor) ExecuteAfter(identifier interface{}, callback func(), delay time.Duration) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 
-	queuedElement, queuedElementExists := t.queuedElements[identifier]
-	if queuedElementExists {
-		queuedElement.Cancel()
+	if t.shutdown {
+		return
 	}
 
-	t.queuedElements[identifier] = t.TimedExecutor.ExecuteAfter(func() {
-		callback()
-
-		t.queuedElementsMutex.Lock()
-		defer t.queuedElementsMutex.Unlock()
-
-		delete(t.queuedElements, identifier)
-	}, delay)
-
-	return t.queuedElements[identifier]
-}
-
-// ExecuteAt executes the given function at the given time.
-func (t *TimedTaskExecutor) ExecuteAt(identifier interface{}, callback func(), executionTime time.Time) *timedexecutor.ScheduledTask {
-	t.queuedElementsMutex.Lock()
-	defer t.queuedElementsMutex.Unlock()
-
-	queuedElement, queuedElementExists := t.queuedElements[identifier]
-	if queuedElementExists {
-		queuedElement.Cancel()
+	if scheduledTask, exists := t.scheduledTasks[identifier]; exists {
+		scheduledTask.Stop()
 	}
 
-	t.queuedElements[identifier] = t.TimedExecutor.ExecuteAt(func() {
-		callback()
-
-		t.queuedElementsMutex.Lock()
-		defer t.queuedElementsMutex.Unlock()
+	var scheduledTask clock.Timer
+	scheduledTask = t.clock.AfterFunc(delay, func() {
+		t.mutex.Lock()
+		// the task was replaced or canceled in the meantime
+		if t.scheduledTasks[identifier] != scheduledTask {
+			t.mutex.Unlock()
+			return
+		}
+		delete(t.scheduledTasks, identifier)
+		t.mutex.Unlock()
 
-		delete(t.queuedElements, identifier)
-	}, executionTime)
+		callback()
+	})
+	t.scheduledTasks[identifier] = scheduledTask
+}
 
-	return t.queuedElements[identifier]
+// ExecuteAt executes the given function at the given time of the Clock.
+func (t *TimedTaskExecutor) ExecuteAt(identifier interface{}, callback func(), executionTime time.Time) {
+	t.ExecuteAfter(identifier, callback, executionTime.Sub(t.clock.Now()))
 }
 
 // Cancel cancels a queued task.
 func (t *TimedTaskExecutor) Cancel(identifier interface{}) (canceled bool) {
-	t.queuedElementsMutex.Lock()
-	defer t.queuedElementsMutex.Unlock()
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
 
-	queuedElement, queuedElementExists := t.queuedElements[identifier]
-	if !queuedElementExists {
-		return
+	scheduledTask, exists := t.scheduledTasks[identifier]
+	if !exists {
+		return false
 	}
 
-	queuedElement.Cancel()
-	delete(t.queuedElements, identifier)
+	scheduledTask.Stop()
+	delete(t.scheduledTasks, identifier)
 
 	return true
 }
 
+// Shutdown cancels all queued tasks and prevents new tasks from being scheduled.
+func (t *TimedTaskExecutor) Shutdown() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.shutdown = true
+	for identifier, scheduledTask := range t.scheduledTasks {
+		scheduledTask.Stop()
+		delete(t.scheduledTasks, identifier)
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region TipManager ///////////////////////////////////////////////////////////////////////////////////////////////////
 
-const tipLifeGracePeriod = maxParentsTimeDifference - 1*time.Minute
+// tipLifeGracePeriod is the time before reaching the max parent age at which tips get evicted from the tip pool, so
+// that they are not selected shortly before they become too old to be referenced.
+const tipLifeGracePeriod = 1 * time.Minute
+
+// TipManagerParams defines the eviction policy of the TipManager.
+type TipManagerParams struct {
+	// MaxTipAge is the age of a tip after which it gets evicted from the tip pool (defaults to and is capped at the
+	// max parent age minus the tipLifeGracePeriod).
+	MaxTipAge time.Duration
+
+	// MinGradeOfFinality is the GradeOfFinality that a tip needs to reach within the GradeOfFinalityGracePeriod to not
+	// get evicted (gof.None disables the check).
+	MinGradeOfFinality gof.GradeOfFinality
+
+	// GradeOfFinalityGracePeriod is the age of a tip at which its GradeOfFinality is checked.
+	GradeOfFinalityGracePeriod time.Duration
+
+	// MaxUnconfirmedPastMarkers is the estimated number of unconfirmed Markers in the past cone of a tip above which the
+	// tip is avoided by the tip selection (0 disables the check).
+	MaxUnconfirmedPastMarkers uint64
+
+	// MaxUnscheduledPastMarkers is the estimated number of unscheduled Markers in the past cone of a tip above which the
+	// tip is avoided by the tip selection (0 disables the check).
+	MaxUnscheduledPastMarkers uint64
+}
 
 // TipManager manages a map of tips and emits events for their removal and addition.
 type TipManager struct {
@@ -117,11 +143,12 @@ func NewTipManager(tangle *Tangle, tips ...MessageID) *TipManager {
 	tipSelector := &TipManager{
 		tangle:          tangle,
 		tips:            randommap.New[MessageID, MessageID](),
-		tipsCleaner:     NewTimedTaskExecutor(1),
+		tipsCleaner:     NewTimedTaskExecutor(tangle.Options.Clock),
 		tipsBranchCount: make(map[ledgerstate.BranchID]uint),
 		Events: &TipManagerEvents{
-			TipAdded:   events.NewEvent(tipEventHandler),
-			TipRemoved: events.NewEvent(tipEventHandler),
+			TipAdded:   event.New[*TipEvent]("TipManager.TipAdded"),
+			TipRemoved: event.New[*TipEvent]("TipManager.TipRemoved"),
+			TipEvicted: event.New[*TipEvictedEvent]("TipManager.TipEvicted"),
 		},
 	}
 
@@ -134,21 +161,22 @@ func NewTipManager(tangle *Tangle, tips ...MessageID) *TipManager {
 
 // Setup sets up the behavior of the component by making it attach to the relevant events of other components.
 func (t *TipManager) Setup() {
-	t.tangle.Dispatcher.Events.MessageDispatched.Attach(events.NewClosure(func(messageID MessageID) {
+	t.tangle.Dispatcher.Events.MessageDispatched.Attach(event.NewClosure(func(messageID MessageID) {
 		t.tangle.Storage.Message(messageID).Consume(t.AddTip)
 	}))
 
-	t.Events.TipRemoved.Attach(events.NewClosure(func(tipEvent *TipEvent) {
+	t.Events.TipRemoved.Attach(event.NewClosure(func(tipEvent *TipEvent) {
 		t.tipsCleaner.Cancel(tipEvent.MessageID)
+		t.tipsCleaner.Cancel(gradeOfFinalityEvictionTask(tipEvent.MessageID))
 	}))
 
-	t.tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(events.NewClosure(t.deleteConfirmedBranchCount))
+	t.tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(t.deleteConfirmedBranchCount))
 
-	t.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(events.NewClosure(func(messageID MessageID) {
+	t.tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(event.NewClosure(func(messageID MessageID) {
 		t.tangle.Storage.Message(messageID).Consume(t.removeStrongParents)
 	}))
 
-	t.tangle.MessageFactory.Events.MessageReferenceImpossible.Attach(events.NewClosure(func(messageID MessageID) {
+	t.tangle.MessageFactory.Events.MessageReferenceImpossible.Attach(event.NewClosure(func(messageID MessageID) {
 		t.tangle.Storage.Message(messageID).Consume(t.reAddParents)
 	}))
 }
@@ -160,12 +188,12 @@ func (t *TipManager) set(tips ...MessageID) {
 	}
 }
 
-// AddTip adds the message to the tip pool if its issuing time is within the tipLifeGracePeriod.
+// AddTip adds the message to the tip pool if its issuing time is within the MaxTipAge.
 // Parents of a message that are currently tip lose the tip status and are removed.
 func (t *TipManager) AddTip(message *Message) {
 	messageID := message.ID()
 
-	if clock.Since(message.IssuingTime()) > tipLifeGracePeriod {
+	if t.tangle.Options.Clock.Since(message.IssuingTime()) > t.maxTipAge() {
 		return
 	}
 
@@ -192,7 +220,7 @@ func (t *TipManager) reAddParents(message *Message) {
 
 	message.ForEachParentByType(StrongParentType, func(parentMessageID MessageID) bool {
 		t.tangle.Storage.Message(parentMessageID).Consume(func(parentMessage *Message) {
-			if clock.Since(message.IssuingTime()) > tipLifeGracePeriod {
+			if t.tangle.Options.Clock.Since(message.IssuingTime()) > t.maxTipAge() {
 				return
 			}
 
@@ -204,6 +232,16 @@ func (t *TipManager) reAddParents(message *Message) {
 
 func (t *TipManager) addTip(message *Message) {
 	messageID := message.ID()
+
+	// messages that were booked below max depth are never selected as tips
+	belowMaxDepth := false
+	t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		belowMaxDepth = messageMetadata.IsBelowMaxDepth()
+	})
+	if belowMaxDepth {
+		return
+	}
+
 	if t.tips.Set(messageID, messageID) {
 		t.increaseTipBranchesCount(messageID)
 		t.Events.TipAdded.Trigger(&TipEvent{
@@ -211,9 +249,52 @@ func (t *TipManager) addTip(message *Message) {
 		})
 
 		t.tipsCleaner.ExecuteAt(messageID, func() {
-			t.deleteTip(messageID)
-		}, message.IssuingTime().Add(tipLifeGracePeriod))
+			t.evictTip(messageID, TipEvictionReasonAge)
+		}, message.IssuingTime().Add(t.maxTipAge()))
+
+		if params := t.tangle.Options.TipManagerParams; params.MinGradeOfFinality != gof.None {
+			t.tipsCleaner.ExecuteAt(gradeOfFinalityEvictionTask(messageID), func() {
+				t.evictTipBelowGradeOfFinality(messageID)
+			}, message.IssuingTime().Add(params.GradeOfFinalityGracePeriod))
+		}
+	}
+}
+
+// maxTipAge returns the age after which tips are evicted from the tip pool.
+func (t *TipManager) maxTipAge() (maxTipAge time.Duration) {
+	if maxTipAge = t.tangle.Options.MaxParentAge - tipLifeGracePeriod; maxTipAge <= 0 {
+		maxTipAge = t.tangle.Options.MaxParentAge
+	}
+
+	if configuredMaxTipAge := t.tangle.Options.TipManagerParams.MaxTipAge; configuredMaxTipAge > 0 && configuredMaxTipAge < maxTipAge {
+		return configuredMaxTipAge
+	}
+
+	return maxTipAge
+}
+
+// evictTipBelowGradeOfFinality evicts the given tip if it did not reach the MinGradeOfFinality.
+func (t *TipManager) evictTipBelowGradeOfFinality(messageID MessageID) {
+	belowGradeOfFinality := true
+	t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		belowGradeOfFinality = messageMetadata.GradeOfFinality() < t.tangle.Options.TipManagerParams.MinGradeOfFinality
+	})
+
+	if belowGradeOfFinality {
+		t.evictTip(messageID, TipEvictionReasonGradeOfFinality)
+	}
+}
+
+// evictTip removes the given tip from the tip pool and triggers the TipEvicted event.
+func (t *TipManager) evictTip(messageID MessageID, reason TipEvictionReason) {
+	if !t.deleteTip(messageID) {
+		return
 	}
+
+	t.Events.TipEvicted.Trigger(&TipEvictedEvent{
+		MessageID: messageID,
+		Reason:    reason,
+	})
 }
 
 func (t *TipManager) deleteTip(msgID MessageID) (deleted bool) {
@@ -362,8 +443,21 @@ func (t *TipManager) Tips(p payload.Payload, countParents int) (parents MessageI
 	return parents, nil
 }
 
+// isParentAgeCorrect checks whether the given tip is young enough to be referenced by a Message that is issued now.
+func (t *TipManager) isParentAgeCorrect(messageID MessageID) (ageValid bool) {
+	if messageID == EmptyMessageID {
+		return true
+	}
+
+	t.tangle.Storage.Message(messageID).Consume(func(message *Message) {
+		ageValid = t.tangle.Options.Clock.Since(message.IssuingTime()) <= t.tangle.Options.MaxParentAge
+	})
+
+	return ageValid
+}
+
 func (t *TipManager) isPastConeTimestampCorrect(messageID MessageID) (timestampValid bool) {
-	now := clock.SyncedTime()
+	now := t.tangle.Options.Clock.Now()
 	minSupportedTimestamp := now.Add(-t.tangle.Options.TimeSinceConfirmationThreshold)
 	timestampValid = true
 
@@ -586,8 +680,8 @@ func (t *TipManager) selectTips(p payload.Payload, count int) (parents MessageID
 				for attachmentMessageID := range t.tangle.Storage.AttachmentMessageIDs(transactionID) {
 					t.tangle.Storage.Message(attachmentMessageID).Consume(func(message *Message) {
 						// check if message is too old
-						timeDifference := clock.SyncedTime().Sub(message.IssuingTime())
-						if timeDifference <= maxParentsTimeDifference && t.isPastConeTimestampCorrect(attachmentMessageID) {
+						timeDifference := t.tangle.Options.Clock.Now().Sub(message.IssuingTime())
+						if timeDifference <= t.tangle.Options.MaxParentAge && t.isPastConeTimestampCorrect(attachmentMessageID) {
 							parents.Add(attachmentMessageID)
 							added = true
 						}
@@ -624,15 +718,100 @@ func (t *TipManager) selectTips(p payload.Payload, count int) (parents MessageID
 	}
 
 	// at least one tip is returned
+	unhealthyTips := NewMessageIDs()
 	for _, tip := range tips {
 		messageID := tip
-		if !parents.Contains(messageID) && t.isPastConeTimestampCorrect(messageID) {
+		if !parents.Contains(messageID) && t.isParentAgeCorrect(messageID) && t.isPastConeTimestampCorrect(messageID) {
+			if !t.isTipHealthy(messageID) {
+				unhealthyTips.Add(messageID)
+				continue
+			}
+
 			parents.Add(messageID)
 		}
 	}
+
+	// fall back to the unhealthy tips if no other parents are available, so that messages can still be issued when the
+	// whole tip pool is affected by congestion
+	if len(parents) == 0 {
+		parents.AddAll(unhealthyTips)
+	}
 	return
 }
 
+// TipHealth returns the marker-based estimate of the unconfirmed and unscheduled regions in the past cone of the given
+// Message.
+func (t *TipManager) TipHealth(messageID MessageID) (tipHealth *TipHealth) {
+	tipHealth = &TipHealth{}
+	t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		// messages without past Markers (like the genesis) are always healthy
+		structureDetails := messageMetadata.StructureDetails()
+		if structureDetails == nil {
+			return
+		}
+
+		structureDetails.PastMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+			firstUnconfirmedMarkerIndex := t.tangle.ConfirmationOracle.FirstUnconfirmedMarkerIndex(sequenceID)
+			if index < firstUnconfirmedMarkerIndex {
+				return true
+			}
+			tipHealth.UnconfirmedMarkers += uint64(index-firstUnconfirmedMarkerIndex) + 1
+			tipHealth.UnscheduledMarkers += t.unscheduledMarkers(sequenceID, firstUnconfirmedMarkerIndex, index)
+
+			return true
+		})
+	})
+
+	return tipHealth
+}
+
+// unscheduledMarkers counts the unconfirmed Markers of the given Sequence up to the given Index whose Messages were not
+// scheduled. Messages are only scheduled after their parents, so the walk stops at the first scheduled Marker.
+func (t *TipManager) unscheduledMarkers(sequenceID markers.SequenceID, firstUnconfirmedMarkerIndex, index markers.Index) (unscheduledMarkers uint64) {
+	for ; index >= firstUnconfirmedMarkerIndex && index > 0; index-- {
+		// skip gaps in the Sequence
+		markerMessageID := t.tangle.Booker.MarkersManager.MessageID(markers.NewMarker(sequenceID, index))
+		if markerMessageID == EmptyMessageID {
+			continue
+		}
+
+		if t.isScheduledOrConfirmed(markerMessageID) {
+			break
+		}
+		unscheduledMarkers++
+	}
+
+	return unscheduledMarkers
+}
+
+// isTipHealthy checks whether the past cone of the given tip is within the configured health limits.
+func (t *TipManager) isTipHealthy(messageID MessageID) (healthy bool) {
+	params := t.tangle.Options.TipManagerParams
+	if params.MaxUnconfirmedPastMarkers == 0 && params.MaxUnscheduledPastMarkers == 0 {
+		return true
+	}
+
+	tipHealth := t.TipHealth(messageID)
+	if params.MaxUnconfirmedPastMarkers != 0 && tipHealth.UnconfirmedMarkers > params.MaxUnconfirmedPastMarkers {
+		return false
+	}
+
+	return params.MaxUnscheduledPastMarkers == 0 || tipHealth.UnscheduledMarkers <= params.MaxUnscheduledPastMarkers
+}
+
+// isScheduledOrConfirmed returns true if the given Message was scheduled or confirmed.
+func (t *TipManager) isScheduledOrConfirmed(messageID MessageID) (scheduledOrConfirmed bool) {
+	if t.tangle.ConfirmationOracle.IsMessageConfirmed(messageID) {
+		return true
+	}
+
+	t.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		scheduledOrConfirmed = messageMetadata.Scheduled()
+	})
+
+	return scheduledOrConfirmed
+}
+
 // AllTips returns a list of all tips that are stored in the TipManger.
 func (t *TipManager) AllTips() MessageIDs {
 	return retrieveAllTips(t.tips)
@@ -648,6 +827,18 @@ func retrieveAllTips(tipsMap *randommap.RandomMap[MessageID, MessageID]) Message
 	return tips
 }
 
+// TipAges returns the age of all tips that are stored in the TipManager.
+func (t *TipManager) TipAges() (tipAges []time.Duration) {
+	tipAges = make([]time.Duration, 0, t.tips.Size())
+	for _, messageID := range t.tips.Keys() {
+		t.tangle.Storage.Message(messageID).Consume(func(message *Message) {
+			tipAges = append(tipAges, t.tangle.Options.Clock.Since(message.IssuingTime()))
+		})
+	}
+
+	return tipAges
+}
+
 // TipCount the amount of strong tips.
 func (t *TipManager) TipCount() int {
 	return t.tips.Size()
@@ -655,7 +846,24 @@ func (t *TipManager) TipCount() int {
 
 // Shutdown stops the TipManager.
 func (t *TipManager) Shutdown() {
-	t.tipsCleaner.Shutdown(timedexecutor.CancelPendingTasks)
+	t.tipsCleaner.Shutdown()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TipHealth ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// TipHealth is a marker-based estimate of the regions in the past cone of a tip that are not yet confirmed or scheduled.
+// Tips whose past cones contain large regions like this are likely to end up in orphaned cones during congestion, so
+// the TipManager avoids them if healthier tips are available.
+type TipHealth struct {
+	// UnconfirmedMarkers is the number of Markers between the past Markers of the tip and the first unconfirmed Markers
+	// of their Sequences.
+	UnconfirmedMarkers uint64
+
+	// UnscheduledMarkers is the number of unconfirmed Markers up to the past Markers of the tip whose Messages were not
+	// scheduled, yet.
+	UnscheduledMarkers uint64
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -665,10 +873,13 @@ func (t *TipManager) Shutdown() {
 // TipManagerEvents represents events happening on the TipManager.
 type TipManagerEvents struct {
 	// Fired when a tip is added.
-	TipAdded *events.Event
+	TipAdded *event.Event[*TipEvent]
 
 	// Fired when a tip is removed.
-	TipRemoved *events.Event
+	TipRemoved *event.Event[*TipEvent]
+
+	// Fired when a tip is evicted by the eviction policy (it is removed without being referenced).
+	TipEvicted *event.Event[*TipEvictedEvent]
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -682,8 +893,44 @@ type TipEvent struct {
 	MessageID MessageID
 }
 
-func tipEventHandler(handler interface{}, params ...interface{}) {
-	handler.(func(event *TipEvent))(params[0].(*TipEvent))
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TipEvictedEvent //////////////////////////////////////////////////////////////////////////////////////////////
+
+// TipEvictionReason is the reason why a tip was evicted from the tip pool.
+type TipEvictionReason uint8
+
+const (
+	// TipEvictionReasonAge denotes tips that were evicted because they exceeded the MaxTipAge.
+	TipEvictionReasonAge TipEvictionReason = iota
+
+	// TipEvictionReasonGradeOfFinality denotes tips that were evicted because they did not reach the
+	// MinGradeOfFinality within the GradeOfFinalityGracePeriod.
+	TipEvictionReasonGradeOfFinality
+)
+
+// String returns a human-readable representation of the TipEvictionReason.
+func (t TipEvictionReason) String() string {
+	switch t {
+	case TipEvictionReasonAge:
+		return "Age"
+	case TipEvictionReasonGradeOfFinality:
+		return "GradeOfFinality"
+	default:
+		return fmt.Sprintf("TipEvictionReason(%d)", uint8(t))
+	}
+}
+
+// TipEvictedEvent holds the information provided by the TipEvicted event.
+type TipEvictedEvent struct {
+	// MessageID of the evicted tip.
+	MessageID MessageID
+
+	// Reason of the eviction.
+	Reason TipEvictionReason
 }
 
+// gradeOfFinalityEvictionTask is the identifier of the scheduled GradeOfFinality check of a tip.
+type gradeOfFinalityEvictionTask MessageID
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -3,6 +3,7 @@ package tangle
 import (
 	"fmt"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
 )
@@ -87,6 +90,115 @@ func TestTipManager_AddTip(t *testing.T) {
 	}
 }
 
+func TestTipManager_EvictTips(t *testing.T) {
+	tangle := NewTestTangle(TipManagerConfig(TipManagerParams{
+		MaxTipAge:                  2 * time.Second,
+		MinGradeOfFinality:         gof.Low,
+		GradeOfFinalityGracePeriod: 500 * time.Millisecond,
+	}))
+	defer tangle.Shutdown()
+	tangle.Storage.Setup()
+	tipManager := tangle.TipManager
+
+	evictedTips := make(map[MessageID]TipEvictionReason)
+	var evictedTipsMutex sync.Mutex
+	tipManager.Events.TipEvicted.Attach(event.NewClosure(func(event *TipEvictedEvent) {
+		evictedTipsMutex.Lock()
+		defer evictedTipsMutex.Unlock()
+
+		evictedTips[event.MessageID] = event.Reason
+	}))
+	evictionReason := func(messageID MessageID) (reason TipEvictionReason, evicted bool) {
+		evictedTipsMutex.Lock()
+		defer evictedTipsMutex.Unlock()
+
+		reason, evicted = evictedTips[messageID]
+		return reason, evicted
+	}
+
+	unconfirmedMessage := createAndStoreParentsDataMessageInMasterBranch(tangle, NewMessageIDs(EmptyMessageID), NewMessageIDs())
+	confirmedMessage := createAndStoreParentsDataMessageInMasterBranch(tangle, NewMessageIDs(EmptyMessageID), NewMessageIDs())
+	tangle.Storage.MessageMetadata(confirmedMessage.ID()).Consume(func(messageMetadata *MessageMetadata) {
+		messageMetadata.SetGradeOfFinality(gof.High, time.Now())
+	})
+
+	tipManager.AddTip(unconfirmedMessage)
+	tipManager.AddTip(confirmedMessage)
+	assert.Equal(t, 2, tipManager.TipCount())
+	assert.Len(t, tipManager.TipAges(), 2)
+
+	assert.Eventually(t, func() bool {
+		reason, evicted := evictionReason(unconfirmedMessage.ID())
+		return evicted && reason == TipEvictionReasonGradeOfFinality
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, 1, tipManager.TipCount())
+	assert.Contains(t, tipManager.tips.Keys(), confirmedMessage.ID())
+
+	assert.Eventually(t, func() bool {
+		reason, evicted := evictionReason(confirmedMessage.ID())
+		return evicted && reason == TipEvictionReasonAge
+	}, 3*time.Second, 10*time.Millisecond)
+	assert.Equal(t, 0, tipManager.TipCount())
+}
+
+func TestTipManager_TipHealth(t *testing.T) {
+	tangle := NewTestTangle(TipManagerConfig(TipManagerParams{
+		MaxUnconfirmedPastMarkers: 3,
+		MaxUnscheduledPastMarkers: 1,
+	}))
+	defer tangle.Shutdown()
+
+	confirmationOracle := &maxDepthConfirmationOracle{firstUnconfirmedMarkerIndex: 1}
+	tangle.ConfirmationOracle = confirmationOracle
+
+	testFramework := NewMessageTestFramework(tangle)
+	tangle.Setup()
+
+	testFramework.CreateMessage("Message1", WithStrongParents("Genesis"))
+	testFramework.CreateMessage("Message2", WithStrongParents("Message1"))
+	testFramework.CreateMessage("Message3", WithStrongParents("Message2"))
+	testFramework.CreateMessage("Message4", WithStrongParents("Message3"))
+	testFramework.IssueMessages("Message1", "Message2", "Message3", "Message4").WaitMessagesBooked()
+	assert.Eventually(t, func() bool {
+		return testFramework.MessageMetadata("Message4").Scheduled()
+	}, time.Second, 10*time.Millisecond)
+
+	tipHealth := func(alias string) TipHealth {
+		return *tangle.TipManager.TipHealth(testFramework.Message(alias).ID())
+	}
+	assert.Equal(t, TipHealth{UnconfirmedMarkers: 2}, tipHealth("Message2"))
+	assert.Equal(t, TipHealth{UnconfirmedMarkers: 4}, tipHealth("Message4"))
+	assert.True(t, tangle.TipManager.isTipHealthy(testFramework.Message("Message3").ID()))
+	assert.False(t, tangle.TipManager.isTipHealthy(testFramework.Message("Message4").ID()))
+
+	// the unscheduled Markers are counted up to the first scheduled one
+	for _, alias := range []string{"Message2", "Message3"} {
+		testFramework.MessageMetadata(alias).SetScheduled(false, time.Time{})
+	}
+	assert.Equal(t, TipHealth{UnconfirmedMarkers: 3, UnscheduledMarkers: 2}, tipHealth("Message3"))
+	assert.Equal(t, TipHealth{UnconfirmedMarkers: 2, UnscheduledMarkers: 1}, tipHealth("Message2"))
+	assert.False(t, tangle.TipManager.isTipHealthy(testFramework.Message("Message3").ID()))
+	assert.True(t, tangle.TipManager.isTipHealthy(testFramework.Message("Message2").ID()))
+
+	// the confirmed Markers do not count
+	confirmationOracle.firstUnconfirmedMarkerIndex = 3
+	assert.Equal(t, TipHealth{UnconfirmedMarkers: 2}, tipHealth("Message4"))
+	assert.Equal(t, TipHealth{UnconfirmedMarkers: 1, UnscheduledMarkers: 1}, tipHealth("Message3"))
+	assert.Equal(t, TipHealth{}, tipHealth("Message2"))
+	confirmationOracle.firstUnconfirmedMarkerIndex = 1
+
+	// the unhealthy tips are avoided if healthier tips are available
+	for tipID := range tangle.TipManager.AllTips() {
+		tangle.TipManager.deleteTip(tipID)
+	}
+	tangle.TipManager.set(testFramework.Message("Message1").ID(), testFramework.Message("Message3").ID(), testFramework.Message("Message4").ID())
+	assert.Equal(t, NewMessageIDs(testFramework.Message("Message1").ID()), tangle.TipManager.selectTips(nil, 8))
+
+	// the unhealthy tips are selected if no other tips are available
+	tangle.TipManager.deleteTip(testFramework.Message("Message1").ID())
+	assert.Equal(t, NewMessageIDs(testFramework.Message("Message3").ID(), testFramework.Message("Message4").ID()), tangle.TipManager.selectTips(nil, 8))
+}
+
 func TestTipManager_DataMessageTips(t *testing.T) {
 	tangle := NewTestTangle()
 	defer func(tangle *Tangle) {
@@ -208,7 +320,7 @@ func TestTipManager_TransactionTips(t *testing.T) {
 
 	// Message 1
 	{
-		issueTime := time.Now().Add(-maxParentsTimeDifference - 5*time.Minute)
+		issueTime := time.Now().Add(-DefaultMaxParentAge - 5*time.Minute)
 
 		testFramework.CreateMessage(
 			"Message1",
@@ -1,6 +1,7 @@
 package tangle
 
 import (
+	"bytes"
 	"fmt"
 	"time"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/iotaledger/hive.go/types"
 
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
 )
@@ -222,6 +224,41 @@ func (u *Utils) MessageApprovedBy(approvedMessageID MessageID, approvingMessageI
 	return false
 }
 
+// ApprovedByConfirmed checks if the Message given by messageID is confirmed or contained in the past cone of a
+// confirmed Message. It uses the Markers of the Message to avoid walking its future cone.
+func (u *Utils) ApprovedByConfirmed(messageID MessageID) (approved bool) {
+	if messageID == EmptyMessageID || u.tangle.ConfirmationOracle.IsMessageConfirmed(messageID) {
+		return true
+	}
+
+	var structureDetails *markers.StructureDetails
+	u.tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *MessageMetadata) {
+		structureDetails = messageMetadata.StructureDetails()
+	})
+	if structureDetails == nil {
+		return false
+	}
+
+	// a Marker is approved by a confirmed Message if it or any later Marker of its Sequence is confirmed
+	markerConfirmed := func(sequenceID markers.SequenceID, index markers.Index) bool {
+		return u.tangle.ConfirmationOracle.FirstUnconfirmedMarkerIndex(sequenceID) > index
+	}
+
+	if structureDetails.IsPastMarker {
+		if marker := structureDetails.PastMarkers.Marker(); markerConfirmed(marker.SequenceID(), marker.Index()) {
+			return true
+		}
+	}
+
+	structureDetails.FutureMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+		approved = markerConfirmed(sequenceID, index)
+
+		return !approved
+	})
+
+	return approved
+}
+
 // ApprovingMessageIDs returns the MessageIDs that approve a given Message. It accepts an optional ApproverType to
 // filter the Approvers.
 func (u *Utils) ApprovingMessageIDs(messageID MessageID, optionalApproverType ...ApproverType) (approvingMessageIDs MessageIDs) {
@@ -328,4 +365,92 @@ func (u *Utils) FirstAttachment(transactionID ledgerstate.TransactionID) (oldest
 	return
 }
 
+// BestAttachment returns the MessageID of the attachment of the given transaction that inclusion decisions are based
+// on. It is the attachment with the highest grade of finality. Ties are broken by preferring attachments that are not
+// orphaned, that are booked and that were issued earlier, and finally by the MessageID.
+func (u *Utils) BestAttachment(transactionID ledgerstate.TransactionID) (bestAttachmentID MessageID, err error) {
+	var bestAttachment *attachmentCandidate
+	if !u.tangle.Storage.Attachments(transactionID).Consume(func(attachment *Attachment) {
+		u.tangle.Storage.Message(attachment.MessageID()).Consume(func(message *Message) {
+			u.tangle.Storage.MessageMetadata(attachment.MessageID()).Consume(func(messageMetadata *MessageMetadata) {
+				candidate := newAttachmentCandidate(message, messageMetadata)
+				if bestAttachment == nil || candidate.isBetterThan(bestAttachment) {
+					bestAttachment = candidate
+				}
+			})
+		})
+	}) || bestAttachment == nil {
+		return EmptyMessageID, errors.Errorf("could not find any attachments of transaction: %s", transactionID.String())
+	}
+
+	return bestAttachment.messageID, nil
+}
+
+// CanonicalAttachment returns the MessageID of the attachment of the given transaction that booked it into the ledger
+// state. It is the attachment that was booked first, while the later booked attachments are reattachments whose
+// identical transaction is not validated and booked again. Ties are broken by the MessageID.
+func (u *Utils) CanonicalAttachment(transactionID ledgerstate.TransactionID) (canonicalAttachmentID MessageID, err error) {
+	var canonicalAttachmentBookedTime time.Time
+	var found bool
+	u.tangle.Storage.Attachments(transactionID).Consume(func(attachment *Attachment) {
+		u.tangle.Storage.MessageMetadata(attachment.MessageID()).Consume(func(messageMetadata *MessageMetadata) {
+			if !messageMetadata.IsBooked() {
+				return
+			}
+
+			bookedTime := messageMetadata.BookedTime()
+			if !found || bookedTime.Before(canonicalAttachmentBookedTime) ||
+				(bookedTime.Equal(canonicalAttachmentBookedTime) && bytes.Compare(attachment.MessageID().Bytes(), canonicalAttachmentID.Bytes()) < 0) {
+				canonicalAttachmentID, canonicalAttachmentBookedTime, found = attachment.MessageID(), bookedTime, true
+			}
+		})
+	})
+	if !found {
+		return EmptyMessageID, errors.Errorf("could not find any booked attachments of transaction: %s", transactionID.String())
+	}
+
+	return canonicalAttachmentID, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region attachmentCandidate //////////////////////////////////////////////////////////////////////////////////////////
+
+// attachmentCandidate holds the properties of an attachment that are used to determine the best attachment of a
+// transaction.
+type attachmentCandidate struct {
+	messageID       MessageID
+	gradeOfFinality gof.GradeOfFinality
+	orphaned        bool
+	booked          bool
+	issuingTime     time.Time
+}
+
+// newAttachmentCandidate creates an attachmentCandidate from the given Message and its MessageMetadata.
+func newAttachmentCandidate(message *Message, messageMetadata *MessageMetadata) *attachmentCandidate {
+	return &attachmentCandidate{
+		messageID:       message.ID(),
+		gradeOfFinality: messageMetadata.GradeOfFinality(),
+		orphaned:        messageMetadata.IsOrphaned(),
+		booked:          messageMetadata.IsBooked(),
+		issuingTime:     message.IssuingTime(),
+	}
+}
+
+// isBetterThan checks whether the attachmentCandidate is preferred over the other attachmentCandidate.
+func (a *attachmentCandidate) isBetterThan(other *attachmentCandidate) bool {
+	switch {
+	case a.gradeOfFinality != other.gradeOfFinality:
+		return a.gradeOfFinality > other.gradeOfFinality
+	case a.orphaned != other.orphaned:
+		return !a.orphaned
+	case a.booked != other.booked:
+		return a.booked
+	case !a.issuingTime.Equal(other.issuingTime):
+		return a.issuingTime.Before(other.issuingTime)
+	default:
+		return bytes.Compare(a.messageID.Bytes(), other.messageID.Bytes()) < 0
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -2,10 +2,11 @@ package tangle
 
 import (
 	"testing"
+	"time"
 
-	"github.com/iotaledger/hive.go/events"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/markers"
 )
 
@@ -15,7 +16,7 @@ func TestUtils_AllTransactionsApprovedByMessages(t *testing.T) {
 	defer tangle.Shutdown()
 
 	tangle.Setup()
-	tangle.Events.Error.Attach(events.NewClosure(func(err error) {
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) {
 		panic(err)
 	}))
 
@@ -45,3 +46,70 @@ func TestUtils_AllTransactionsApprovedByMessages(t *testing.T) {
 		})
 	}
 }
+
+func TestUtils_ApprovedByConfirmed(t *testing.T) {
+	tangle := NewTestTangle()
+	defer tangle.Shutdown()
+
+	tangle.Setup()
+	tangle.Events.Error.Attach(event.NewClosure(func(err error) {
+		panic(err)
+	}))
+
+	mtf := NewMessageTestFramework(tangle)
+
+	mtf.CreateMessage("Message1", WithStrongParents("Genesis"))
+	mtf.CreateMessage("Message2", WithStrongParents("Message1"))
+	mtf.CreateMessage("Message3", WithStrongParents("Message2"))
+	mtf.CreateMessage("Message4", WithStrongParents("Genesis"))
+	mtf.CreateMessage("Message5", WithStrongParents("Message3", "Message4"))
+
+	mtf.IssueMessages("Message1", "Message2", "Message3", "Message4", "Message5").WaitMessagesBooked()
+
+	for confirmedMarker, expectedApprovals := range map[markers.Marker]map[string]bool{
+		*markers.NewMarker(0, 2): {
+			"Message1": true,
+			"Message2": true,
+			"Message3": false,
+			"Message4": false,
+			"Message5": false,
+		},
+		*markers.NewMarker(0, 4): {
+			"Message1": true,
+			"Message2": true,
+			"Message3": true,
+			"Message4": true,
+			"Message5": true,
+		},
+	} {
+		tangle.ConfirmationOracle = &MockConfirmationOracleTipManagerTest{
+			confirmedMessageIDs: NewMessageIDs(),
+			confirmedMarkers:    markers.NewMarkers(&confirmedMarker),
+		}
+
+		for messageAlias, expectedApproval := range expectedApprovals {
+			assert.Equal(t, expectedApproval, tangle.Utils.ApprovedByConfirmed(mtf.Message(messageAlias).ID()), "%s approved by %s", messageAlias, confirmedMarker)
+		}
+	}
+}
+
+func TestAttachmentCandidate_IsBetterThan(t *testing.T) {
+	now := time.Now()
+	base := &attachmentCandidate{messageID: MessageID{2}, gradeOfFinality: 1, booked: true, issuingTime: now}
+
+	for name, testCase := range map[string]struct {
+		candidate *attachmentCandidate
+		better    bool
+	}{
+		"higher GoF": {&attachmentCandidate{messageID: MessageID{3}, gradeOfFinality: 2, orphaned: true, issuingTime: now.Add(time.Second)}, true},
+		"lower GoF":  {&attachmentCandidate{messageID: MessageID{1}, gradeOfFinality: 0, booked: true, issuingTime: now.Add(-time.Second)}, false},
+		"orphaned":   {&attachmentCandidate{messageID: MessageID{1}, gradeOfFinality: 1, orphaned: true, booked: true, issuingTime: now.Add(-time.Second)}, false},
+		"not booked": {&attachmentCandidate{messageID: MessageID{1}, gradeOfFinality: 1, issuingTime: now.Add(-time.Second)}, false},
+		"earlier":    {&attachmentCandidate{messageID: MessageID{3}, gradeOfFinality: 1, booked: true, issuingTime: now.Add(-time.Second)}, true},
+		"later":      {&attachmentCandidate{messageID: MessageID{1}, gradeOfFinality: 1, booked: true, issuingTime: now.Add(time.Second)}, false},
+		"lower ID":   {&attachmentCandidate{messageID: MessageID{1}, gradeOfFinality: 1, booked: true, issuingTime: now}, true},
+		"higher ID":  {&attachmentCandidate{messageID: MessageID{3}, gradeOfFinality: 1, booked: true, issuingTime: now}, false},
+	} {
+		assert.Equal(t, testCase.better, testCase.candidate.isBetterThan(base), name)
+	}
+}
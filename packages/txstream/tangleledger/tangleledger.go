@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/events"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/packages/txstream"
@@ -13,7 +14,7 @@ import (
 // TangleLedger imlpements txstream.TangleLedger with the GoShimmer tangle as backend.
 type TangleLedger struct {
 	tangleInstance  *tangle.Tangle
-	txBookedClosure *events.Closure
+	txBookedClosure *event.Closure[tangle.MessageID]
 	txBookedEvent   *events.Event
 }
 
@@ -31,7 +32,7 @@ func New(tangleInstance *tangle.Tangle) *TangleLedger {
 		txBookedEvent:  events.NewEvent(txEventHandler),
 	}
 
-	t.txBookedClosure = events.NewClosure(func(id tangle.MessageID) {
+	t.txBookedClosure = event.NewClosure(func(id tangle.MessageID) {
 		t.tangleInstance.Storage.Message(id).Consume(func(msg *tangle.Message) {
 			if payload := msg.Payload(); payload != nil && payload.Type() == ledgerstate.TransactionType {
 				go t.txBookedEvent.Trigger(payload)
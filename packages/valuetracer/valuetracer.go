@@ -0,0 +1,325 @@
+package valuetracer
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/analytics"
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region Tracer ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Tracer measures the end-to-end latency of value transfers, i.e. the time from the submission of a transaction by the
+// wallet until the transaction reaches a high grade of finality on this node. The latency is recorded for every Stage
+// that the transaction passes, so that slow stages can be told apart, and aggregated over the configured window.
+type Tracer struct {
+	params   Params
+	timeFunc func() time.Time
+
+	pendingTraces   map[ledgerstate.TransactionID]*Trace
+	completedTraces []*Trace
+	expiredTimes    []time.Time
+	mutex           sync.Mutex
+}
+
+// New is the constructor of the Tracer.
+func New(params Params, opts ...Option) (tracer *Tracer) {
+	tracer = &Tracer{
+		params:          params,
+		timeFunc:        clock.SyncedTime,
+		pendingTraces:   make(map[ledgerstate.TransactionID]*Trace),
+		completedTraces: make([]*Trace, 0),
+		expiredTimes:    make([]time.Time, 0),
+	}
+
+	for _, opt := range opts {
+		opt(tracer)
+	}
+
+	return tracer
+}
+
+// StartTrace starts tracing the given transaction that was submitted at the given time and attached in the given
+// message. It returns false if the transaction is traced already, e.g. because it was reattached, or if the maximum
+// number of pending traces is reached.
+func (t *Tracer) StartTrace(transactionID ledgerstate.TransactionID, messageID tangle.MessageID, submissionTime time.Time) (started bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, exists := t.pendingTraces[transactionID]; exists {
+		return false
+	}
+	if t.params.MaxPendingTraces > 0 && len(t.pendingTraces) >= t.params.MaxPendingTraces {
+		return false
+	}
+
+	t.pendingTraces[transactionID] = newTrace(transactionID, messageID, submissionTime)
+
+	return true
+}
+
+// RecordStage records the time at which the given transaction reached the given Stage. Stages of transactions that are
+// not traced and stages that were recorded already are ignored.
+func (t *Tracer) RecordStage(transactionID ledgerstate.TransactionID, stage Stage, stageTime time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if trace, exists := t.pendingTraces[transactionID]; exists {
+		trace.recordStage(stage, stageTime)
+	}
+}
+
+// TransactionConfirmed completes the trace of the given transaction, which reached a high grade of finality.
+func (t *Tracer) TransactionConfirmed(transactionID ledgerstate.TransactionID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	trace, exists := t.pendingTraces[transactionID]
+	if !exists {
+		return
+	}
+	delete(t.pendingTraces, transactionID)
+
+	trace.recordStage(StageConfirmed, t.timeFunc())
+	t.completedTraces = append(t.completedTraces, trace)
+	if t.params.MaxCompletedTraces > 0 && len(t.completedTraces) > t.params.MaxCompletedTraces {
+		t.completedTraces = append(t.completedTraces[:0], t.completedTraces[len(t.completedTraces)-t.params.MaxCompletedTraces:]...)
+	}
+}
+
+// Prune drops the traces of transactions that were not confirmed within the TraceTimeout and removes the traces that
+// left the window, so it should be called periodically.
+func (t *Tracer) Prune() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.prune(t.timeFunc())
+}
+
+// Trace returns a copy of the trace of the given transaction, which is either pending or was completed within the
+// window.
+func (t *Tracer) Trace(transactionID ledgerstate.TransactionID) (trace *Trace, exists bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if trace, exists = t.pendingTraces[transactionID]; exists {
+		return trace.clone(), true
+	}
+
+	for i := len(t.completedTraces) - 1; i >= 0; i-- {
+		if t.completedTraces[i].TransactionID == transactionID {
+			return t.completedTraces[i].clone(), true
+		}
+	}
+
+	return nil, false
+}
+
+// Statistics returns the statistics of the transactions that were confirmed within the current window.
+func (t *Tracer) Statistics() (statistics *Statistics) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.prune(t.timeFunc())
+
+	stageSamples := make(map[Stage][]float64)
+	for _, trace := range t.completedTraces {
+		for _, stage := range Stages {
+			if latency, reached := trace.Latency(stage); reached {
+				stageSamples[stage] = append(stageSamples[stage], float64(latency))
+			}
+		}
+	}
+
+	statistics = &Statistics{
+		Window:                t.params.Window,
+		StageLatencies:        make(map[Stage]*analytics.DurationStatistics),
+		ConfirmedTransactions: len(t.completedTraces),
+		PendingTransactions:   len(t.pendingTraces),
+		ExpiredTransactions:   len(t.expiredTimes),
+	}
+	for _, stage := range Stages {
+		statistics.StageLatencies[stage] = analytics.NewDurationStatistics(stageSamples[stage])
+	}
+
+	return statistics
+}
+
+// prune drops the expired pending traces and removes the traces that left the window.
+func (t *Tracer) prune(now time.Time) {
+	for transactionID, trace := range t.pendingTraces {
+		if now.Sub(trace.SubmissionTime) > t.params.TraceTimeout {
+			delete(t.pendingTraces, transactionID)
+			t.expiredTimes = append(t.expiredTimes, now)
+		}
+	}
+
+	windowStart := now.Add(-t.params.Window)
+
+	expiredTraces := 0
+	for expiredTraces < len(t.completedTraces) && t.completedTraces[expiredTraces].StageTimes[StageConfirmed].Before(windowStart) {
+		expiredTraces++
+	}
+	t.completedTraces = append(t.completedTraces[:0], t.completedTraces[expiredTraces:]...)
+
+	expiredTimes := 0
+	for expiredTimes < len(t.expiredTimes) && t.expiredTimes[expiredTimes].Before(windowStart) {
+		expiredTimes++
+	}
+	t.expiredTimes = append(t.expiredTimes[:0], t.expiredTimes[expiredTimes:]...)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Params ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Params contains the parameters of the Tracer.
+type Params struct {
+	// Window defines the time span that is covered by the statistics.
+	Window time.Duration
+	// TraceTimeout defines the time after the submission after which a transaction that was not confirmed is no longer
+	// traced.
+	TraceTimeout time.Duration
+	// MaxPendingTraces defines the maximum number of transactions that are traced at the same time.
+	MaxPendingTraces int
+	// MaxCompletedTraces defines the maximum number of completed traces that are kept within the window.
+	MaxCompletedTraces int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Option is the type of the optional parameters of the Tracer.
+type Option func(tracer *Tracer)
+
+// WithTimeFunc is an Option for the Tracer that overrides the function that determines the current time.
+func WithTimeFunc(timeFunc func() time.Time) Option {
+	return func(tracer *Tracer) {
+		tracer.timeFunc = timeFunc
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Stage ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Stage is a step in the processing of a transaction from its submission by the wallet until its confirmation.
+type Stage uint8
+
+const (
+	// StageIssued is reached when the transaction is attached in a message by the issuing node.
+	StageIssued Stage = iota
+	// StageReceived is reached when the message containing the transaction is received by this node.
+	StageReceived
+	// StageSolid is reached when the message containing the transaction becomes solid on this node.
+	StageSolid
+	// StageBooked is reached when the transaction is booked into the ledger of this node.
+	StageBooked
+	// StageScheduled is reached when the message containing the transaction is scheduled by this node.
+	StageScheduled
+	// StageConfirmed is reached when the transaction reaches a high grade of finality on this node.
+	StageConfirmed
+)
+
+// Stages contains all Stages in the order in which a transaction passes them.
+var Stages = []Stage{StageIssued, StageReceived, StageSolid, StageBooked, StageScheduled, StageConfirmed}
+
+// String returns a human-readable version of the Stage.
+func (s Stage) String() string {
+	switch s {
+	case StageIssued:
+		return "issued"
+	case StageReceived:
+		return "received"
+	case StageSolid:
+		return "solid"
+	case StageBooked:
+		return "booked"
+	case StageScheduled:
+		return "scheduled"
+	case StageConfirmed:
+		return "confirmed"
+	default:
+		return "unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Trace ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Trace contains the times at which a transaction reached the different Stages.
+type Trace struct {
+	// TransactionID is the ID of the traced transaction.
+	TransactionID ledgerstate.TransactionID
+	// MessageID is the ID of the first message that attached the transaction.
+	MessageID tangle.MessageID
+	// SubmissionTime is the time at which the wallet created the transaction, i.e. the timestamp of its essence.
+	SubmissionTime time.Time
+	// StageTimes contains the times at which the transaction reached the Stages.
+	StageTimes map[Stage]time.Time
+}
+
+// newTrace creates a new Trace of the given transaction.
+func newTrace(transactionID ledgerstate.TransactionID, messageID tangle.MessageID, submissionTime time.Time) *Trace {
+	return &Trace{
+		TransactionID:  transactionID,
+		MessageID:      messageID,
+		SubmissionTime: submissionTime,
+		StageTimes:     make(map[Stage]time.Time),
+	}
+}
+
+// Latency returns the time between the submission of the transaction and the time it reached the given Stage.
+func (t *Trace) Latency(stage Stage) (latency time.Duration, reached bool) {
+	stageTime, reached := t.StageTimes[stage]
+	if !reached {
+		return 0, false
+	}
+
+	return stageTime.Sub(t.SubmissionTime), true
+}
+
+// recordStage records the time at which the given Stage was reached unless it was recorded already.
+func (t *Trace) recordStage(stage Stage, stageTime time.Time) {
+	if _, exists := t.StageTimes[stage]; exists || stageTime.IsZero() {
+		return
+	}
+
+	t.StageTimes[stage] = stageTime
+}
+
+// clone returns a copy of the Trace.
+func (t *Trace) clone() *Trace {
+	clonedTrace := newTrace(t.TransactionID, t.MessageID, t.SubmissionTime)
+	for stage, stageTime := range t.StageTimes {
+		clonedTrace.StageTimes[stage] = stageTime
+	}
+
+	return clonedTrace
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Statistics ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Statistics contains the statistics about the value transfers within the window of the Tracer.
+type Statistics struct {
+	// Window is the time span that is covered by the statistics.
+	Window time.Duration
+	// StageLatencies contains the statistics about the time between the submission of the transactions and the time
+	// they reached the Stages.
+	StageLatencies map[Stage]*analytics.DurationStatistics
+	// ConfirmedTransactions is the number of traced transactions that were confirmed.
+	ConfirmedTransactions int
+	// PendingTransactions is the number of traced transactions that were not confirmed, yet.
+	PendingTransactions int
+	// ExpiredTransactions is the number of traced transactions that were not confirmed within the TraceTimeout.
+	ExpiredTransactions int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
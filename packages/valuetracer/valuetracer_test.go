@@ -0,0 +1,108 @@
+package valuetracer
+
+import (
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestTracer(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tracer := New(Params{
+		Window:       time.Minute,
+		TraceTimeout: 30 * time.Second,
+	}, WithTimeFunc(func() time.Time { return now }))
+
+	// two transactions are submitted and pass the stages, but only the first one is confirmed
+	transactionIDs := []ledgerstate.TransactionID{randomTransactionID(), randomTransactionID()}
+	for _, transactionID := range transactionIDs {
+		require.True(t, tracer.StartTrace(transactionID, tangle.EmptyMessageID, now))
+		tracer.RecordStage(transactionID, StageIssued, now.Add(100*time.Millisecond))
+		tracer.RecordStage(transactionID, StageBooked, now.Add(time.Second))
+	}
+
+	// reattachments and repeated stages don't change the trace
+	assert.False(t, tracer.StartTrace(transactionIDs[0], tangle.EmptyMessageID, now.Add(time.Second)))
+	tracer.RecordStage(transactionIDs[0], StageIssued, now.Add(time.Second))
+
+	now = now.Add(3 * time.Second)
+	tracer.TransactionConfirmed(transactionIDs[0])
+
+	trace, exists := tracer.Trace(transactionIDs[0])
+	require.True(t, exists)
+	latency, reached := trace.Latency(StageIssued)
+	assert.True(t, reached)
+	assert.Equal(t, 100*time.Millisecond, latency)
+	latency, reached = trace.Latency(StageConfirmed)
+	assert.True(t, reached)
+	assert.Equal(t, 3*time.Second, latency)
+	_, reached = trace.Latency(StageScheduled)
+	assert.False(t, reached)
+
+	statistics := tracer.Statistics()
+	assert.Equal(t, 1, statistics.ConfirmedTransactions)
+	assert.Equal(t, 1, statistics.PendingTransactions)
+	assert.Equal(t, 0, statistics.ExpiredTransactions)
+	assert.Equal(t, 100*time.Millisecond, statistics.StageLatencies[StageIssued].P50)
+	assert.Equal(t, time.Second, statistics.StageLatencies[StageBooked].P50)
+	assert.Equal(t, 3*time.Second, statistics.StageLatencies[StageConfirmed].P99)
+	assert.Equal(t, time.Duration(0), statistics.StageLatencies[StageScheduled].P50)
+
+	// the unconfirmed transaction expires after the timeout
+	now = now.Add(30 * time.Second)
+	tracer.Prune()
+	tracer.TransactionConfirmed(transactionIDs[1])
+
+	statistics = tracer.Statistics()
+	assert.Equal(t, 1, statistics.ConfirmedTransactions)
+	assert.Equal(t, 0, statistics.PendingTransactions)
+	assert.Equal(t, 1, statistics.ExpiredTransactions)
+	_, exists = tracer.Trace(transactionIDs[1])
+	assert.False(t, exists)
+
+	// the statistics only cover the window
+	now = now.Add(time.Minute + time.Second)
+	statistics = tracer.Statistics()
+	assert.Equal(t, 0, statistics.ConfirmedTransactions)
+	assert.Equal(t, 0, statistics.ExpiredTransactions)
+	_, exists = tracer.Trace(transactionIDs[0])
+	assert.False(t, exists)
+}
+
+func TestTracer_MaxTraces(t *testing.T) {
+	now := time.Unix(1000, 0)
+	tracer := New(Params{
+		Window:             time.Minute,
+		TraceTimeout:       30 * time.Second,
+		MaxPendingTraces:   2,
+		MaxCompletedTraces: 1,
+	}, WithTimeFunc(func() time.Time { return now }))
+
+	transactionIDs := []ledgerstate.TransactionID{randomTransactionID(), randomTransactionID(), randomTransactionID()}
+	assert.True(t, tracer.StartTrace(transactionIDs[0], tangle.EmptyMessageID, now))
+	assert.True(t, tracer.StartTrace(transactionIDs[1], tangle.EmptyMessageID, now))
+	assert.False(t, tracer.StartTrace(transactionIDs[2], tangle.EmptyMessageID, now))
+
+	tracer.TransactionConfirmed(transactionIDs[0])
+	tracer.TransactionConfirmed(transactionIDs[1])
+
+	_, exists := tracer.Trace(transactionIDs[0])
+	assert.False(t, exists)
+	_, exists = tracer.Trace(transactionIDs[1])
+	assert.True(t, exists)
+	assert.Equal(t, 1, tracer.Statistics().ConfirmedTransactions)
+}
+
+func randomTransactionID() (transactionID ledgerstate.TransactionID) {
+	if _, err := rand.Read(transactionID[:]); err != nil {
+		panic(err)
+	}
+
+	return transactionID
+}
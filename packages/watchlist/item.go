@@ -0,0 +1,336 @@
+package watchlist
+
+import (
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/cerrors"
+	"github.com/iotaledger/hive.go/generics/objectstorage"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/iotaledger/hive.go/stringify"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region ItemType /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ItemType represents the type of a watched Item.
+type ItemType uint8
+
+const (
+	// AddressItem is the type of watched addresses.
+	AddressItem ItemType = iota
+
+	// OutputItem is the type of watched outputs.
+	OutputItem
+
+	// TransactionItem is the type of watched transactions.
+	TransactionItem
+)
+
+// String returns a human-readable version of the ItemType.
+func (i ItemType) String() string {
+	switch i {
+	case AddressItem:
+		return "address"
+	case OutputItem:
+		return "output"
+	case TransactionItem:
+		return "transaction"
+	default:
+		return "unknown"
+	}
+}
+
+// IDLength returns the length of the identifiers of Items of the ItemType.
+func (i ItemType) IDLength() int {
+	switch i {
+	case AddressItem:
+		return ledgerstate.AddressLength
+	case OutputItem:
+		return ledgerstate.OutputIDLength
+	case TransactionItem:
+		return ledgerstate.TransactionIDLength
+	default:
+		return 0
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Item /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Item is an address, an output or a transaction that is watched by the WatchList.
+type Item struct {
+	objectstorage.StorableObjectFlags
+
+	itemType  ItemType
+	id        []byte
+	addedTime time.Time
+}
+
+// NewAddressItem creates an Item that watches the given address.
+func NewAddressItem(address ledgerstate.Address) *Item {
+	return &Item{itemType: AddressItem, id: address.Bytes()}
+}
+
+// NewOutputItem creates an Item that watches the output with the given ID.
+func NewOutputItem(outputID ledgerstate.OutputID) *Item {
+	return &Item{itemType: OutputItem, id: outputID.Bytes()}
+}
+
+// NewTransactionItem creates an Item that watches the transaction with the given ID.
+func NewTransactionItem(transactionID ledgerstate.TransactionID) *Item {
+	return &Item{itemType: TransactionItem, id: transactionID.Bytes()}
+}
+
+// ItemFromBase58 creates an Item from a base58 encoded address, OutputID or TransactionID. The type of the Item is
+// derived from the length of the decoded identifier.
+func ItemFromBase58(base58String string) (item *Item, err error) {
+	bytes, err := base58.Decode(base58String)
+	if err != nil {
+		return nil, errors.Errorf("error while decoding base58 encoded item (%v): %w", err, cerrors.ErrBase58DecodeFailed)
+	}
+
+	switch len(bytes) {
+	case ledgerstate.AddressLength:
+		address, _, addressErr := ledgerstate.AddressFromBytes(bytes)
+		if addressErr != nil {
+			return nil, errors.Errorf("failed to parse address: %w", addressErr)
+		}
+		return NewAddressItem(address), nil
+	case ledgerstate.OutputIDLength:
+		outputID, _, outputIDErr := ledgerstate.OutputIDFromBytes(bytes)
+		if outputIDErr != nil {
+			return nil, errors.Errorf("failed to parse OutputID: %w", outputIDErr)
+		}
+		return NewOutputItem(outputID), nil
+	case ledgerstate.TransactionIDLength:
+		transactionID, _, transactionIDErr := ledgerstate.TransactionIDFromBytes(bytes)
+		if transactionIDErr != nil {
+			return nil, errors.Errorf("failed to parse TransactionID: %w", transactionIDErr)
+		}
+		return NewTransactionItem(transactionID), nil
+	default:
+		return nil, errors.Errorf("%s is neither an address, an OutputID nor a TransactionID: %w", base58String, cerrors.ErrParseBytesFailed)
+	}
+}
+
+// Type returns the type of the Item.
+func (i *Item) Type() ItemType {
+	return i.itemType
+}
+
+// Base58 returns the base58 encoded identifier of the watched address, output or transaction.
+func (i *Item) Base58() string {
+	return base58.Encode(i.id)
+}
+
+// AddedTime returns the time at which the Item was added to the WatchList.
+func (i *Item) AddedTime() time.Time {
+	return i.addedTime
+}
+
+// FromObjectStorage creates an Item from sequences of key and bytes.
+func (i *Item) FromObjectStorage(key, bytes []byte) (objectstorage.StorableObject, error) {
+	if len(key) < 2 {
+		return nil, errors.Errorf("failed to parse item from object storage: key of %d bytes is too short", len(key))
+	}
+
+	item := &Item{
+		itemType: ItemType(key[0]),
+		id:       byteutils.ConcatBytes(key[1:]),
+	}
+
+	var err error
+	if item.addedTime, err = marshalutil.New(bytes).ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse added time of item (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+
+	return item, nil
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database.
+func (i *Item) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes([]byte{byte(i.itemType)}, i.id)
+}
+
+// ObjectStorageValue marshals the Item into a sequence of bytes.
+func (i *Item) ObjectStorageValue() []byte {
+	return marshalutil.New(marshalutil.TimeSize).WriteTime(i.addedTime).Bytes()
+}
+
+// String returns a human-readable version of the Item.
+func (i *Item) String() string {
+	return stringify.Struct("Item",
+		stringify.StructField("type", i.itemType.String()),
+		stringify.StructField("id", i.Base58()),
+		stringify.StructField("addedTime", i.addedTime),
+	)
+}
+
+// Interface contract: make compiler warn if the interface is not implemented correctly.
+var _ objectstorage.StorableObject = new(Item)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region EventType ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// EventType represents the type of an Event that happened to a watched Item.
+type EventType uint8
+
+const (
+	// TransactionBooked is the type of events of watched transactions that were booked in a message. The event is
+	// recorded for every attachment of the transaction.
+	TransactionBooked EventType = iota
+
+	// OutputCreated is the type of events of watched addresses and outputs whose output was created by a booked
+	// transaction.
+	OutputCreated
+
+	// OutputSpent is the type of events of watched addresses and outputs whose output was spent by a booked transaction.
+	OutputSpent
+
+	// TransactionConfirmed is the type of events of watched items whose transaction was confirmed.
+	TransactionConfirmed
+
+	// TransactionRejected is the type of events of watched items whose transaction was rejected.
+	TransactionRejected
+)
+
+// String returns a human-readable version of the EventType.
+func (e EventType) String() string {
+	switch e {
+	case TransactionBooked:
+		return "transactionBooked"
+	case OutputCreated:
+		return "outputCreated"
+	case OutputSpent:
+		return "outputSpent"
+	case TransactionConfirmed:
+		return "transactionConfirmed"
+	case TransactionRejected:
+		return "transactionRejected"
+	default:
+		return "unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Event ////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Event is something that happened to a watched Item. Events contain all of their information themselves, so that the
+// history of an Item stays available after the messages and transactions were pruned from the Tangle.
+type Event struct {
+	objectstorage.StorableObjectFlags
+
+	itemKey       []byte
+	eventType     EventType
+	transactionID ledgerstate.TransactionID
+	outputID      ledgerstate.OutputID
+	messageID     tangle.MessageID
+	time          time.Time
+}
+
+// eventKeySuffixLength contains the length of the part of the storage key of an Event that follows the key of its Item.
+const eventKeySuffixLength = 1 + ledgerstate.TransactionIDLength + ledgerstate.OutputIDLength + tangle.MessageIDLength
+
+// NewEvent creates a new Event of the given Item. The OutputID and the MessageID are empty if they don't apply to the
+// type of the Event.
+func NewEvent(item *Item, eventType EventType, transactionID ledgerstate.TransactionID, outputID ledgerstate.OutputID, messageID tangle.MessageID, eventTime time.Time) *Event {
+	return &Event{
+		itemKey:       item.ObjectStorageKey(),
+		eventType:     eventType,
+		transactionID: transactionID,
+		outputID:      outputID,
+		messageID:     messageID,
+		time:          eventTime,
+	}
+}
+
+// Type returns the type of the Event.
+func (e *Event) Type() EventType {
+	return e.eventType
+}
+
+// TransactionID returns the ID of the transaction that caused the Event.
+func (e *Event) TransactionID() ledgerstate.TransactionID {
+	return e.transactionID
+}
+
+// OutputID returns the ID of the output that was created or spent (EmptyOutputID for other types of events).
+func (e *Event) OutputID() ledgerstate.OutputID {
+	return e.outputID
+}
+
+// MessageID returns the ID of the message that the transaction was booked in (EmptyMessageID for other types of
+// events).
+func (e *Event) MessageID() tangle.MessageID {
+	return e.messageID
+}
+
+// Time returns the time at which the Event was recorded.
+func (e *Event) Time() time.Time {
+	return e.time
+}
+
+// FromObjectStorage creates an Event from sequences of key and bytes.
+func (e *Event) FromObjectStorage(key, bytes []byte) (objectstorage.StorableObject, error) {
+	if len(key) <= eventKeySuffixLength {
+		return nil, errors.Errorf("failed to parse event from object storage: key of %d bytes is too short", len(key))
+	}
+
+	itemKeyLength := len(key) - eventKeySuffixLength
+	event := &Event{
+		itemKey:   byteutils.ConcatBytes(key[:itemKeyLength]),
+		eventType: EventType(key[itemKeyLength]),
+	}
+
+	marshalUtil := marshalutil.New(key[itemKeyLength+1:])
+	var err error
+	if event.transactionID, err = ledgerstate.TransactionIDFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse TransactionID of event: %w", err)
+	}
+	if event.outputID, err = ledgerstate.OutputIDFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse OutputID of event: %w", err)
+	}
+	if event.messageID, err = tangle.ReferenceFromMarshalUtil(marshalUtil); err != nil {
+		return nil, errors.Errorf("failed to parse MessageID of event: %w", err)
+	}
+	if event.time, err = marshalutil.New(bytes).ReadTime(); err != nil {
+		return nil, errors.Errorf("failed to parse time of event (%v): %w", err, cerrors.ErrParseBytesFailed)
+	}
+
+	return event, nil
+}
+
+// ObjectStorageKey returns the key that is used to store the object in the database. Since the key contains all of
+// the identifiers of the Event, the same Event is only stored once.
+func (e *Event) ObjectStorageKey() []byte {
+	return byteutils.ConcatBytes(e.itemKey, []byte{byte(e.eventType)}, e.transactionID.Bytes(), e.outputID.Bytes(), e.messageID.Bytes())
+}
+
+// ObjectStorageValue marshals the Event into a sequence of bytes.
+func (e *Event) ObjectStorageValue() []byte {
+	return marshalutil.New(marshalutil.TimeSize).WriteTime(e.time).Bytes()
+}
+
+// String returns a human-readable version of the Event.
+func (e *Event) String() string {
+	return stringify.Struct("Event",
+		stringify.StructField("type", e.eventType.String()),
+		stringify.StructField("transactionID", e.transactionID),
+		stringify.StructField("outputID", e.outputID),
+		stringify.StructField("messageID", e.messageID),
+		stringify.StructField("time", e.time),
+	)
+}
+
+// Interface contract: make compiler warn if the interface is not implemented correctly.
+var _ objectstorage.StorableObject = new(Event)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
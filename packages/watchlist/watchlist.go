@@ -0,0 +1,289 @@
+// Package watchlist maintains a persistent list of watched addresses, outputs and transactions together with the
+// history of the events that happened to them, so that integrators can follow their funds without an external indexer.
+package watchlist
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/generics/objectstorage"
+	"github.com/iotaledger/hive.go/kvstore"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	// PrefixItemStorage defines the storage prefix of the watched items.
+	PrefixItemStorage byte = iota
+
+	// PrefixEventStorage defines the storage prefix of the events of the watched items.
+	PrefixEventStorage
+)
+
+// ErrTooManyItems is returned if an item is added to a WatchList that contains the maximum number of items already.
+var ErrTooManyItems = errors.New("watch list contains too many items")
+
+// region WatchList ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// WatchList keeps track of the events of the watched addresses, outputs and transactions. Both the items and their
+// events are persisted in their own realm of the database, so they are retained when the Tangle is pruned. Events are
+// only recorded while an item is watched, i.e. the history of an item starts when it is added.
+type WatchList struct {
+	tangle       *tangle.Tangle
+	maxItems     int
+	itemStorage  *objectstorage.ObjectStorage[*Item]
+	eventStorage map[ItemType]*objectstorage.ObjectStorage[*Event]
+	items        map[string]*Item
+	mutex        sync.RWMutex
+
+	messageBookedClosure        *event.Closure[tangle.MessageID]
+	transactionConfirmedClosure *event.Closure[ledgerstate.TransactionID]
+	branchRejectedClosure       *event.Closure[ledgerstate.BranchID]
+}
+
+// New creates a WatchList that records the events of the given Tangle in the given store and that holds at most the
+// given number of items (0 disables the limit). The items that were watched before are loaded from the store.
+func New(t *tangle.Tangle, store kvstore.KVStore, maxItems int) (watchList *WatchList) {
+	watchList = &WatchList{
+		tangle:       t,
+		maxItems:     maxItems,
+		itemStorage:  objectstorage.New[*Item](store.WithRealm([]byte{database.PrefixWatchList, PrefixItemStorage}), objectstorage.LeakDetectionEnabled(false), objectstorage.StoreOnCreation(true)),
+		eventStorage: make(map[ItemType]*objectstorage.ObjectStorage[*Event]),
+		items:        make(map[string]*Item),
+	}
+	for _, itemType := range []ItemType{AddressItem, OutputItem, TransactionItem} {
+		watchList.eventStorage[itemType] = objectstorage.New[*Event](store.WithRealm([]byte{database.PrefixWatchList, PrefixEventStorage, byte(itemType)}), objectstorage.PartitionKey(1+itemType.IDLength(), eventKeySuffixLength), objectstorage.LeakDetectionEnabled(false), objectstorage.StoreOnCreation(true))
+	}
+	watchList.messageBookedClosure = event.NewClosure(watchList.recordBooking)
+	watchList.transactionConfirmedClosure = event.NewClosure(func(transactionID ledgerstate.TransactionID) {
+		watchList.recordDecision(transactionID, TransactionConfirmed)
+	})
+	watchList.branchRejectedClosure = event.NewClosure(func(branchID ledgerstate.BranchID) {
+		watchList.recordDecision(branchID.TransactionID(), TransactionRejected)
+	})
+
+	watchList.itemStorage.ForEach(func(_ []byte, cachedItem *objectstorage.CachedObject[*Item]) bool {
+		cachedItem.Consume(func(item *Item) {
+			watchList.items[string(item.ObjectStorageKey())] = item
+		})
+
+		return true
+	})
+
+	return watchList
+}
+
+// Setup attaches the WatchList to the events of the Tangle.
+func (w *WatchList) Setup() {
+	w.tangle.Booker.Events.MessageBooked.Attach(w.messageBookedClosure)
+	w.tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(w.transactionConfirmedClosure)
+	w.tangle.LedgerState.BranchDAG.Events.BranchRejected.Attach(w.branchRejectedClosure)
+}
+
+// Add adds the given Item to the WatchList. It returns the Item that is watched, which is the existing Item if it was
+// watched already.
+func (w *WatchList) Add(item *Item) (watchedItem *Item, err error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if watchedItem, exists := w.items[string(item.ObjectStorageKey())]; exists {
+		return watchedItem, nil
+	}
+	if w.maxItems > 0 && len(w.items) >= w.maxItems {
+		return nil, errors.Errorf("maximum of %d items reached: %w", w.maxItems, ErrTooManyItems)
+	}
+
+	watchedItem = &Item{
+		itemType:  item.itemType,
+		id:        item.id,
+		addedTime: w.tangle.Options.Clock.Now(),
+	}
+	w.itemStorage.Store(watchedItem).Release()
+	w.items[string(watchedItem.ObjectStorageKey())] = watchedItem
+
+	return watchedItem, nil
+}
+
+// Remove removes the given Item and its events from the WatchList. It returns false if the Item was not watched.
+func (w *WatchList) Remove(item *Item) (removed bool) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	itemKey := item.ObjectStorageKey()
+	if _, removed = w.items[string(itemKey)]; !removed {
+		return false
+	}
+
+	delete(w.items, string(itemKey))
+	w.itemStorage.Delete(itemKey)
+	w.eventStorage[item.itemType].ForEach(func(_ []byte, cachedEvent *objectstorage.CachedObject[*Event]) bool {
+		cachedEvent.Consume(func(event *Event) {
+			event.Delete()
+		})
+
+		return true
+	}, objectstorage.WithIteratorPrefix(itemKey))
+
+	return true
+}
+
+// Item returns the watched Item that corresponds to the given Item.
+func (w *WatchList) Item(item *Item) (watchedItem *Item, exists bool) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	watchedItem, exists = w.items[string(item.ObjectStorageKey())]
+
+	return watchedItem, exists
+}
+
+// Items returns the watched Items in the order in which they were added.
+func (w *WatchList) Items() (items []*Item) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	items = make([]*Item, 0, len(w.items))
+	for _, item := range w.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if !items[i].addedTime.Equal(items[j].addedTime) {
+			return items[i].addedTime.Before(items[j].addedTime)
+		}
+
+		return string(items[i].ObjectStorageKey()) < string(items[j].ObjectStorageKey())
+	})
+
+	return items
+}
+
+// Events returns the events of the given Item in the order in which they were recorded. It returns false if the Item
+// is not watched.
+func (w *WatchList) Events(item *Item) (events []*Event, exists bool) {
+	if _, exists = w.Item(item); !exists {
+		return nil, false
+	}
+
+	events = make([]*Event, 0)
+	w.eventStorage[item.itemType].ForEach(func(_ []byte, cachedEvent *objectstorage.CachedObject[*Event]) bool {
+		cachedEvent.Consume(func(event *Event) {
+			events = append(events, event)
+		})
+
+		return true
+	}, objectstorage.WithIteratorPrefix(item.ObjectStorageKey()))
+
+	sort.SliceStable(events, func(i, j int) bool {
+		if !events[i].time.Equal(events[j].time) {
+			return events[i].time.Before(events[j].time)
+		}
+
+		return events[i].eventType < events[j].eventType
+	})
+
+	return events, true
+}
+
+// Shutdown detaches the WatchList from the Tangle and persists its items and events.
+func (w *WatchList) Shutdown() {
+	w.tangle.Booker.Events.MessageBooked.Detach(w.messageBookedClosure)
+	w.tangle.ConfirmationOracle.Events().TransactionConfirmed.Detach(w.transactionConfirmedClosure)
+	w.tangle.LedgerState.BranchDAG.Events.BranchRejected.Detach(w.branchRejectedClosure)
+
+	w.itemStorage.Shutdown()
+	for _, eventStorage := range w.eventStorage {
+		eventStorage.Shutdown()
+	}
+}
+
+// recordBooking records the events of the transaction of the booked message: the attachment of the transaction and
+// the outputs that it creates and spends.
+func (w *WatchList) recordBooking(messageID tangle.MessageID) {
+	if w.isEmpty() {
+		return
+	}
+
+	w.tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		transaction, isTransaction := message.Payload().(*ledgerstate.Transaction)
+		if !isTransaction {
+			return
+		}
+
+		now := w.tangle.Options.Clock.Now()
+		w.record(NewEvent(NewTransactionItem(transaction.ID()), TransactionBooked, transaction.ID(), ledgerstate.EmptyOutputID, messageID, now))
+		w.forEachRelatedItem(transaction, func(item *Item, outputID ledgerstate.OutputID, spent bool) {
+			eventType := OutputCreated
+			if spent {
+				eventType = OutputSpent
+			}
+			w.record(NewEvent(item, eventType, transaction.ID(), outputID, tangle.EmptyMessageID, now))
+		})
+	})
+}
+
+// recordDecision records the confirmation or the rejection of the given transaction for all watched items that are
+// related to it.
+func (w *WatchList) recordDecision(transactionID ledgerstate.TransactionID, eventType EventType) {
+	if w.isEmpty() {
+		return
+	}
+
+	w.tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		now := w.tangle.Options.Clock.Now()
+		w.record(NewEvent(NewTransactionItem(transactionID), eventType, transactionID, ledgerstate.EmptyOutputID, tangle.EmptyMessageID, now))
+		w.forEachRelatedItem(transaction, func(item *Item, _ ledgerstate.OutputID, _ bool) {
+			w.record(NewEvent(item, eventType, transactionID, ledgerstate.EmptyOutputID, tangle.EmptyMessageID, now))
+		})
+	})
+}
+
+// forEachRelatedItem calls the callback for the outputs that are created and spent by the given transaction and for
+// the addresses that they belong to.
+func (w *WatchList) forEachRelatedItem(transaction *ledgerstate.Transaction, callback func(item *Item, outputID ledgerstate.OutputID, spent bool)) {
+	for index, output := range transaction.Essence().Outputs() {
+		outputID := ledgerstate.NewOutputID(transaction.ID(), uint16(index))
+		callback(NewOutputItem(outputID), outputID, false)
+		callback(NewAddressItem(output.Address()), outputID, false)
+	}
+
+	for _, input := range transaction.Essence().Inputs() {
+		utxoInput, isUTXOInput := input.(*ledgerstate.UTXOInput)
+		if !isUTXOInput {
+			continue
+		}
+
+		outputID := utxoInput.ReferencedOutputID()
+		callback(NewOutputItem(outputID), outputID, true)
+		w.tangle.LedgerState.CachedOutput(outputID).Consume(func(output ledgerstate.Output) {
+			callback(NewAddressItem(output.Address()), outputID, true)
+		})
+	}
+}
+
+// record stores the given Event if its Item is watched.
+func (w *WatchList) record(event *Event) {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	if _, watched := w.items[string(event.itemKey)]; !watched {
+		return
+	}
+
+	if cachedEvent, stored := w.eventStorage[ItemType(event.itemKey[0])].StoreIfAbsent(event); stored {
+		cachedEvent.Release()
+	}
+}
+
+// isEmpty returns true if no items are watched.
+func (w *WatchList) isEmpty() bool {
+	w.mutex.RLock()
+	defer w.mutex.RUnlock()
+
+	return len(w.items) == 0
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,117 @@
+package watchlist
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestWatchList(t *testing.T) {
+	store := mapdb.NewMapDB()
+	testTangle := tangle.NewTestTangle(tangle.Store(store))
+	defer testTangle.Shutdown()
+
+	watchList := New(testTangle, store, 3)
+	defer func() { watchList.Shutdown() }()
+
+	testFramework := tangle.NewMessageTestFramework(
+		testTangle,
+		tangle.WithGenesisOutput("G", 3),
+	)
+
+	testTangle.Setup()
+	watchList.Setup()
+
+	testFramework.CreateMessage("Message1", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("A", 1), tangle.WithOutput("B", 2))
+	testFramework.CreateMessage("Message2", tangle.WithStrongParents("Message1"), tangle.WithInputs("A"), tangle.WithOutput("C", 1))
+
+	transaction1 := testFramework.Message("Message1").Payload().(*ledgerstate.Transaction)
+	transaction2 := testFramework.Message("Message2").Payload().(*ledgerstate.Transaction)
+	outputA := ledgerstate.NewOutputID(transaction1.ID(), 0)
+	addressC := transaction2.Essence().Outputs()[0].Address()
+
+	// the items are watched before the transactions are booked
+	_, err := watchList.Add(NewTransactionItem(transaction1.ID()))
+	require.NoError(t, err)
+	_, err = watchList.Add(NewOutputItem(outputA))
+	require.NoError(t, err)
+	_, err = watchList.Add(NewAddressItem(addressC))
+	require.NoError(t, err)
+	_, err = watchList.Add(NewAddressItem(ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)))
+	assert.ErrorIs(t, err, ErrTooManyItems)
+	_, err = watchList.Add(NewOutputItem(outputA))
+	assert.NoError(t, err)
+	assert.Len(t, watchList.Items(), 3)
+
+	testFramework.IssueMessages("Message1").WaitMessagesBooked()
+	testFramework.IssueMessages("Message2").WaitMessagesBooked()
+
+	events, exists := watchList.Events(NewTransactionItem(transaction1.ID()))
+	require.True(t, exists)
+	require.Len(t, events, 1)
+	assert.Equal(t, TransactionBooked, events[0].Type())
+	assert.Equal(t, testFramework.Message("Message1").ID(), events[0].MessageID())
+
+	events, exists = watchList.Events(NewOutputItem(outputA))
+	require.True(t, exists)
+	assert.Equal(t, []EventType{OutputCreated, OutputSpent}, eventTypes(events))
+	assert.Equal(t, transaction2.ID(), events[1].TransactionID())
+
+	events, exists = watchList.Events(NewAddressItem(addressC))
+	require.True(t, exists)
+	require.Equal(t, []EventType{OutputCreated}, eventTypes(events))
+	assert.Equal(t, ledgerstate.NewOutputID(transaction2.ID(), 0), events[0].OutputID())
+
+	// the items and their events are retained after a restart of the node
+	watchList.Shutdown()
+	watchList = New(testTangle, store, 3)
+	watchList.Setup()
+	assert.Len(t, watchList.Items(), 3)
+	events, _ = watchList.Events(NewOutputItem(outputA))
+	assert.Len(t, events, 2)
+
+	// removing an item removes its events
+	assert.True(t, watchList.Remove(NewOutputItem(outputA)))
+	assert.False(t, watchList.Remove(NewOutputItem(outputA)))
+	_, exists = watchList.Events(NewOutputItem(outputA))
+	assert.False(t, exists)
+	_, err = watchList.Add(NewOutputItem(outputA))
+	require.NoError(t, err)
+	events, _ = watchList.Events(NewOutputItem(outputA))
+	assert.Empty(t, events)
+}
+
+func TestItemFromBase58(t *testing.T) {
+	address := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	transactionID := ledgerstate.TransactionID{1, 2, 3}
+	outputID := ledgerstate.NewOutputID(transactionID, 1)
+
+	for expectedType, base58String := range map[ItemType]string{
+		AddressItem:     address.Base58(),
+		OutputItem:      outputID.Base58(),
+		TransactionItem: transactionID.Base58(),
+	} {
+		item, err := ItemFromBase58(base58String)
+		require.NoError(t, err)
+		assert.Equal(t, expectedType, item.Type())
+		assert.Equal(t, base58String, item.Base58())
+	}
+
+	_, err := ItemFromBase58("invalid")
+	assert.Error(t, err)
+}
+
+// eventTypes returns the types of the given events.
+func eventTypes(events []*Event) (types []EventType) {
+	for _, event := range events {
+		types = append(types, event.Type())
+	}
+
+	return types
+}
@@ -0,0 +1,661 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        (unknown)
+// source: webapi.proto
+
+package webapiproto
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PostPayloadRequest is the request of the /messages/payload endpoint.
+type PostPayloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *PostPayloadRequest) Reset() {
+	*x = PostPayloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostPayloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostPayloadRequest) ProtoMessage() {}
+
+func (x *PostPayloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostPayloadRequest.ProtoReflect.Descriptor instead.
+func (*PostPayloadRequest) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PostPayloadRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// PostPayloadResponse is the response of the /messages/payload endpoint.
+type PostPayloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PostPayloadResponse) Reset() {
+	*x = PostPayloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostPayloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostPayloadResponse) ProtoMessage() {}
+
+func (x *PostPayloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostPayloadResponse.ProtoReflect.Descriptor instead.
+func (*PostPayloadResponse) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostPayloadResponse) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+// Message is the response of the /messages/:messageID endpoint.
+type Message struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bytes []byte `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *Message) Reset() {
+	*x = Message{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Message) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Message) ProtoMessage() {}
+
+func (x *Message) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Message) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Message) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+// Output is the response of the /ledgerstate/outputs/:outputID endpoint.
+type Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OutputID []byte `protobuf:"bytes,1,opt,name=outputID,proto3" json:"outputID,omitempty"`
+	Bytes    []byte `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+}
+
+func (x *Output) Reset() {
+	*x = Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Output) ProtoMessage() {}
+
+func (x *Output) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Output.ProtoReflect.Descriptor instead.
+func (*Output) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Output) GetOutputID() []byte {
+	if x != nil {
+		return x.OutputID
+	}
+	return nil
+}
+
+func (x *Output) GetBytes() []byte {
+	if x != nil {
+		return x.Bytes
+	}
+	return nil
+}
+
+// PostAddressesUnspentOutputsRequest is the request of the /ledgerstate/addresses/unspentOutputs endpoint.
+type PostAddressesUnspentOutputsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *PostAddressesUnspentOutputsRequest) Reset() {
+	*x = PostAddressesUnspentOutputsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostAddressesUnspentOutputsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAddressesUnspentOutputsRequest) ProtoMessage() {}
+
+func (x *PostAddressesUnspentOutputsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAddressesUnspentOutputsRequest.ProtoReflect.Descriptor instead.
+func (*PostAddressesUnspentOutputsRequest) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PostAddressesUnspentOutputsRequest) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+// PostAddressesUnspentOutputsResponse is the response of the /ledgerstate/addresses/unspentOutputs endpoint.
+type PostAddressesUnspentOutputsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnspentOutputs []*WalletOutputsOnAddress `protobuf:"bytes,1,rep,name=unspentOutputs,proto3" json:"unspentOutputs,omitempty"`
+}
+
+func (x *PostAddressesUnspentOutputsResponse) Reset() {
+	*x = PostAddressesUnspentOutputsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostAddressesUnspentOutputsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostAddressesUnspentOutputsResponse) ProtoMessage() {}
+
+func (x *PostAddressesUnspentOutputsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostAddressesUnspentOutputsResponse.ProtoReflect.Descriptor instead.
+func (*PostAddressesUnspentOutputsResponse) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PostAddressesUnspentOutputsResponse) GetUnspentOutputs() []*WalletOutputsOnAddress {
+	if x != nil {
+		return x.UnspentOutputs
+	}
+	return nil
+}
+
+type WalletOutputsOnAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []byte          `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Outputs []*WalletOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *WalletOutputsOnAddress) Reset() {
+	*x = WalletOutputsOnAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletOutputsOnAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletOutputsOnAddress) ProtoMessage() {}
+
+func (x *WalletOutputsOnAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletOutputsOnAddress.ProtoReflect.Descriptor instead.
+func (*WalletOutputsOnAddress) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WalletOutputsOnAddress) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *WalletOutputsOnAddress) GetOutputs() []*WalletOutput {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+type WalletOutput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output          *Output `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	GradeOfFinality uint32  `protobuf:"varint,2,opt,name=gradeOfFinality,proto3" json:"gradeOfFinality,omitempty"`
+	Timestamp       int64   `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Conflicting     bool    `protobuf:"varint,4,opt,name=conflicting,proto3" json:"conflicting,omitempty"`
+}
+
+func (x *WalletOutput) Reset() {
+	*x = WalletOutput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_webapi_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WalletOutput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WalletOutput) ProtoMessage() {}
+
+func (x *WalletOutput) ProtoReflect() protoreflect.Message {
+	mi := &file_webapi_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WalletOutput.ProtoReflect.Descriptor instead.
+func (*WalletOutput) Descriptor() ([]byte, []int) {
+	return file_webapi_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *WalletOutput) GetOutput() *Output {
+	if x != nil {
+		return x.Output
+	}
+	return nil
+}
+
+func (x *WalletOutput) GetGradeOfFinality() uint32 {
+	if x != nil {
+		return x.GradeOfFinality
+	}
+	return 0
+}
+
+func (x *WalletOutput) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *WalletOutput) GetConflicting() bool {
+	if x != nil {
+		return x.Conflicting
+	}
+	return false
+}
+
+var File_webapi_proto protoreflect.FileDescriptor
+
+var file_webapi_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x77, 0x65, 0x62, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b,
+	0x77, 0x65, 0x62, 0x61, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2e, 0x0a, 0x12, 0x50,
+	0x6f, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x25, 0x0a, 0x13, 0x50,
+	0x6f, 0x73, 0x74, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x2f, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x3a, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22,
+	0x42, 0x0a, 0x22, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x23, 0x50, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0e, 0x75, 0x6e,
+	0x73, 0x70, 0x65, 0x6e, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x77, 0x65, 0x62, 0x61, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x4f, 0x6e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0e, 0x75, 0x6e, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x22, 0x67, 0x0a, 0x16, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x4f, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x33, 0x0a, 0x07, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x65, 0x62, 0x61, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x61, 0x6c, 0x6c, 0x65,
+	0x74, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
+	0x22, 0xa5, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x2b, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x77, 0x65, 0x62, 0x61, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x28,
+	0x0a, 0x0f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x66, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x67, 0x72, 0x61, 0x64, 0x65, 0x4f, 0x66,
+	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6f, 0x74, 0x61, 0x6c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x2f, 0x67, 0x6f, 0x73, 0x68, 0x69, 0x6d, 0x6d, 0x65, 0x72, 0x2f, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x73, 0x2f, 0x77, 0x65, 0x62, 0x61, 0x70, 0x69, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_webapi_proto_rawDescOnce sync.Once
+	file_webapi_proto_rawDescData = file_webapi_proto_rawDesc
+)
+
+func file_webapi_proto_rawDescGZIP() []byte {
+	file_webapi_proto_rawDescOnce.Do(func() {
+		file_webapi_proto_rawDescData = protoimpl.X.CompressGZIP(file_webapi_proto_rawDescData)
+	})
+	return file_webapi_proto_rawDescData
+}
+
+var file_webapi_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_webapi_proto_goTypes = []interface{}{
+	(*PostPayloadRequest)(nil),                  // 0: webapiproto.PostPayloadRequest
+	(*PostPayloadResponse)(nil),                 // 1: webapiproto.PostPayloadResponse
+	(*Message)(nil),                             // 2: webapiproto.Message
+	(*Output)(nil),                              // 3: webapiproto.Output
+	(*PostAddressesUnspentOutputsRequest)(nil),  // 4: webapiproto.PostAddressesUnspentOutputsRequest
+	(*PostAddressesUnspentOutputsResponse)(nil), // 5: webapiproto.PostAddressesUnspentOutputsResponse
+	(*WalletOutputsOnAddress)(nil),              // 6: webapiproto.WalletOutputsOnAddress
+	(*WalletOutput)(nil),                        // 7: webapiproto.WalletOutput
+}
+var file_webapi_proto_depIdxs = []int32{
+	6, // 0: webapiproto.PostAddressesUnspentOutputsResponse.unspentOutputs:type_name -> webapiproto.WalletOutputsOnAddress
+	7, // 1: webapiproto.WalletOutputsOnAddress.outputs:type_name -> webapiproto.WalletOutput
+	3, // 2: webapiproto.WalletOutput.output:type_name -> webapiproto.Output
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_webapi_proto_init() }
+func file_webapi_proto_init() {
+	if File_webapi_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_webapi_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostPayloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostPayloadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostAddressesUnspentOutputsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PostAddressesUnspentOutputsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletOutputsOnAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_webapi_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WalletOutput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_webapi_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_webapi_proto_goTypes,
+		DependencyIndexes: file_webapi_proto_depIdxs,
+		MessageInfos:      file_webapi_proto_msgTypes,
+	}.Build()
+	File_webapi_proto = out.File
+	file_webapi_proto_rawDesc = nil
+	file_webapi_proto_goTypes = nil
+	file_webapi_proto_depIdxs = nil
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+)
+
+// DeadLetter contains the details of a Delivery that could not be sent to a webhook.
+type DeadLetter struct {
+	// URL is the webhook that the Delivery was meant for.
+	URL string `json:"url"`
+	// Attempts is the number of calls that were made to the webhook.
+	Attempts int `json:"attempts"`
+	// Error is the reason of the last failure.
+	Error string `json:"error"`
+	// Time is the unix time at which the Delivery was given up.
+	Time int64 `json:"time"`
+	// Delivery is the Delivery that could not be sent.
+	Delivery *Delivery `json:"delivery"`
+}
+
+// DeadLetterLog writes DeadLetters as JSON lines, so that operators can inspect and replay failed Deliveries.
+type DeadLetterLog struct {
+	writer io.Writer
+	mutex  sync.Mutex
+}
+
+// NewDeadLetterLog creates a DeadLetterLog that writes to the given writer.
+func NewDeadLetterLog(writer io.Writer) *DeadLetterLog {
+	return &DeadLetterLog{
+		writer: writer,
+	}
+}
+
+// Write appends the given DeadLetter to the log.
+func (d *DeadLetterLog) Write(deadLetter *DeadLetter) error {
+	line, err := json.Marshal(deadLetter)
+	if err != nil {
+		return errors.Errorf("failed to marshal dead letter: %w", err)
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if _, err = d.writer.Write(append(line, '\n')); err != nil {
+		return errors.Errorf("failed to write dead letter: %w", err)
+	}
+
+	return nil
+}
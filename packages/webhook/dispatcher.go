@@ -0,0 +1,277 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+)
+
+const (
+	// EventHeader is the header that contains the type of the event of a Delivery.
+	EventHeader = "X-GoShimmer-Event"
+
+	// DeliveryHeader is the header that contains the ID of a Delivery.
+	DeliveryHeader = "X-GoShimmer-Delivery"
+)
+
+// ErrQueueFull is returned if a Delivery could not be queued because the workers can't keep up.
+var ErrQueueFull = errors.New("webhook queue is full")
+
+// region Dispatcher ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Dispatcher posts events as signed JSON Deliveries to the webhooks that subscribed to them. Failed calls are retried
+// with an exponential backoff and announced through the DeliveryFailed event once all attempts are exhausted.
+type Dispatcher struct {
+	Events *Events
+
+	subscriptions      map[string][]string
+	subscriptionsMutex sync.RWMutex
+	options            *Options
+	client             *http.Client
+	queue              chan *job
+}
+
+// NewDispatcher creates a new Dispatcher with the given options.
+func NewDispatcher(opts ...Option) *Dispatcher {
+	options := DefaultOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	return &Dispatcher{
+		Events: &Events{
+			DeliveryFailed: events.NewEvent(deadLetterEventCaller),
+		},
+		subscriptions: make(map[string][]string),
+		options:       options,
+		client:        &http.Client{Timeout: options.Timeout},
+		queue:         make(chan *job, options.QueueSize),
+	}
+}
+
+// Subscribe registers the given URLs as webhooks of the given event type.
+func (d *Dispatcher) Subscribe(event string, urls ...string) {
+	d.subscriptionsMutex.Lock()
+	defer d.subscriptionsMutex.Unlock()
+
+	d.subscriptions[event] = append(d.subscriptions[event], urls...)
+}
+
+// Subscribed returns true if there are webhooks for the given event type.
+func (d *Dispatcher) Subscribed(event string) bool {
+	d.subscriptionsMutex.RLock()
+	defer d.subscriptionsMutex.RUnlock()
+
+	return len(d.subscriptions[event]) != 0
+}
+
+// Dispatch queues a Delivery of the given event to all of its webhooks. It never blocks - Deliveries that can't be
+// queued are announced through the DeliveryFailed event.
+func (d *Dispatcher) Dispatch(event string, data interface{}) (err error) {
+	d.subscriptionsMutex.RLock()
+	urls := d.subscriptions[event]
+	d.subscriptionsMutex.RUnlock()
+
+	if len(urls) == 0 {
+		return nil
+	}
+
+	delivery, err := newDelivery(event, data)
+	if err != nil {
+		return err
+	}
+	body, err := json.Marshal(delivery)
+	if err != nil {
+		return errors.Errorf("failed to marshal delivery of %s event: %w", event, err)
+	}
+
+	for _, url := range urls {
+		job := &job{url: url, delivery: delivery, body: body}
+		select {
+		case d.queue <- job:
+		default:
+			d.fail(job, 0, ErrQueueFull)
+		}
+	}
+
+	return nil
+}
+
+// Run processes the queued Deliveries until the given context is done. Deliveries that are still queued afterwards
+// are announced through the DeliveryFailed event, so that they are not lost silently.
+func (d *Dispatcher) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for i := 0; i < d.options.WorkerCount; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case job := <-d.queue:
+					d.deliver(ctx, job)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	for {
+		select {
+		case job := <-d.queue:
+			d.fail(job, 0, ctx.Err())
+		default:
+			return
+		}
+	}
+}
+
+// deliver posts the given job and retries it until it succeeds or all attempts are exhausted.
+func (d *Dispatcher) deliver(ctx context.Context, job *job) {
+	var err error
+	for attempt := 1; ; attempt++ {
+		if err = d.post(ctx, job); err == nil {
+			return
+		}
+
+		if attempt >= d.options.MaxAttempts || !isRetryable(err) {
+			d.fail(job, attempt, err)
+			return
+		}
+
+		select {
+		case <-time.After(d.options.RetryBackoff << (attempt - 1)):
+		case <-ctx.Done():
+			d.fail(job, attempt, err)
+			return
+		}
+	}
+}
+
+// post sends the given job to its webhook.
+func (d *Dispatcher) post(ctx context.Context, job *job) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, job.url, bytes.NewReader(job.body))
+	if err != nil {
+		return errors.Errorf("failed to create request for webhook %s: %w", job.url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, job.delivery.Event)
+	req.Header.Set(DeliveryHeader, job.delivery.ID)
+	if len(d.options.Secret) != 0 {
+		req.Header.Set(SignatureHeader, Sign(d.options.Secret, job.body))
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return errors.Errorf("failed to call webhook %s: %w", job.url, err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return &statusError{url: job.url, statusCode: res.StatusCode}
+	}
+
+	return nil
+}
+
+// fail announces the failed delivery of the given job.
+func (d *Dispatcher) fail(job *job, attempts int, err error) {
+	d.Events.DeliveryFailed.Trigger(&DeadLetter{
+		URL:      job.url,
+		Attempts: attempts,
+		Error:    err.Error(),
+		Time:     clock.SyncedTime().Unix(),
+		Delivery: job.delivery,
+	})
+}
+
+// job is a Delivery to a single webhook.
+type job struct {
+	url      string
+	delivery *Delivery
+	body     []byte
+}
+
+// statusError is returned if a webhook responded with a status code that indicates a failure.
+type statusError struct {
+	url        string
+	statusCode int
+}
+
+// Error returns a human-readable version of the error.
+func (s *statusError) Error() string {
+	return fmt.Sprintf("webhook %s responded with status %d", s.url, s.statusCode)
+}
+
+// isRetryable returns true if a failed call might succeed when being retried.
+func isRetryable(err error) bool {
+	var statusErr *statusError
+	if !errors.As(err, &statusErr) {
+		// the webhook could not be reached
+		return true
+	}
+
+	return statusErr.statusCode == http.StatusTooManyRequests || statusErr.statusCode >= http.StatusInternalServerError
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Delivery /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Delivery is the JSON body that is posted to the webhooks.
+type Delivery struct {
+	// ID is the unique identifier of the Delivery that allows webhooks to detect retried Deliveries.
+	ID string `json:"id"`
+	// Event is the type of the event.
+	Event string `json:"event"`
+	// Time is the unix time at which the event occurred.
+	Time int64 `json:"time"`
+	// Data contains the event specific details.
+	Data interface{} `json:"data"`
+}
+
+// newDelivery creates a new Delivery of the given event.
+func newDelivery(event string, data interface{}) (*Delivery, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errors.Errorf("failed to generate delivery ID: %w", err)
+	}
+
+	return &Delivery{
+		ID:    base58.Encode(id),
+		Event: event,
+		Time:  clock.SyncedTime().Unix(),
+		Data:  data,
+	}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Events ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Events contains the events of the Dispatcher.
+type Events struct {
+	// DeliveryFailed is triggered when a Delivery could not be sent to a webhook.
+	DeliveryFailed *events.Event
+}
+
+func deadLetterEventCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*DeadLetter))(params[0].(*DeadLetter))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
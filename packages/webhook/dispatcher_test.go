@@ -0,0 +1,151 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestDispatcher(t *testing.T) {
+	secret := []byte("secret")
+
+	var calls atomic.Int32
+	received := make(chan *Delivery, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// fail the first call to test the retries
+		if calls.Inc() == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.True(t, VerifySignature(secret, body, r.Header.Get(SignatureHeader)))
+		assert.Equal(t, "syncChanged", r.Header.Get(EventHeader))
+
+		delivery := &Delivery{}
+		require.NoError(t, json.Unmarshal(body, delivery))
+		assert.Equal(t, delivery.ID, r.Header.Get(DeliveryHeader))
+		received <- delivery
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher(WithSecret(secret), WithRetryBackoff(10*time.Millisecond))
+	dispatcher.Subscribe("syncChanged", server.URL)
+	var failures atomic.Int32
+	dispatcher.Events.DeliveryFailed.Attach(events.NewClosure(func(deadLetter *DeadLetter) {
+		failures.Inc()
+	}))
+	assert.True(t, dispatcher.Subscribed("syncChanged"))
+	assert.False(t, dispatcher.Subscribed("branchRejected"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dispatcher.Run(ctx)
+
+	require.NoError(t, dispatcher.Dispatch("branchRejected", "ignored"))
+	require.NoError(t, dispatcher.Dispatch("syncChanged", map[string]bool{"synced": true}))
+
+	select {
+	case delivery := <-received:
+		assert.Equal(t, "syncChanged", delivery.Event)
+		assert.Equal(t, map[string]interface{}{"synced": true}, delivery.Data)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "delivery was not received")
+	}
+	assert.EqualValues(t, 2, calls.Load())
+	assert.Zero(t, failures.Load())
+}
+
+func TestDispatcher_DeadLetter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Inc()
+		if strings.HasSuffix(r.URL.Path, "/invalid") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	dispatcher := NewDispatcher(WithMaxAttempts(3), WithRetryBackoff(time.Millisecond))
+	dispatcher.Subscribe("faucetPayout", server.URL+"/failing")
+	dispatcher.Subscribe("branchRejected", server.URL+"/invalid")
+
+	var logBuffer bytes.Buffer
+	deadLetterLog := NewDeadLetterLog(&logBuffer)
+	deadLetters := make(chan *DeadLetter, 2)
+	dispatcher.Events.DeliveryFailed.Attach(events.NewClosure(func(deadLetter *DeadLetter) {
+		assert.NoError(t, deadLetterLog.Write(deadLetter))
+		deadLetters <- deadLetter
+	}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go dispatcher.Run(ctx)
+
+	require.NoError(t, dispatcher.Dispatch("faucetPayout", "payout"))
+	require.NoError(t, dispatcher.Dispatch("branchRejected", "branch"))
+
+	attempts := make(map[string]int)
+	for i := 0; i < 2; i++ {
+		select {
+		case deadLetter := <-deadLetters:
+			attempts[deadLetter.Delivery.Event] = deadLetter.Attempts
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "delivery was not given up")
+		}
+	}
+
+	// server errors are retried, client errors are not
+	assert.Equal(t, map[string]int{"faucetPayout": 3, "branchRejected": 1}, attempts)
+	assert.EqualValues(t, 4, calls.Load())
+
+	lines := strings.Split(strings.TrimSpace(logBuffer.String()), "\n")
+	require.Len(t, lines, 2)
+	deadLetter := &DeadLetter{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), deadLetter))
+	assert.NotEmpty(t, deadLetter.Error)
+	assert.NotEmpty(t, deadLetter.Delivery.ID)
+}
+
+func TestDispatcher_QueueFull(t *testing.T) {
+	dispatcher := NewDispatcher(WithQueueSize(1))
+	dispatcher.Subscribe("syncChanged", "http://localhost")
+
+	deadLetters := make([]*DeadLetter, 0)
+	dispatcher.Events.DeliveryFailed.Attach(events.NewClosure(func(deadLetter *DeadLetter) {
+		deadLetters = append(deadLetters, deadLetter)
+	}))
+
+	require.NoError(t, dispatcher.Dispatch("syncChanged", true))
+	require.NoError(t, dispatcher.Dispatch("syncChanged", false))
+	require.Len(t, deadLetters, 1)
+	assert.Equal(t, ErrQueueFull.Error(), deadLetters[0].Error)
+
+	// queued deliveries are given up on shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	dispatcher.Run(ctx)
+	assert.Len(t, deadLetters, 2)
+}
+
+func TestSign(t *testing.T) {
+	signature := Sign([]byte("secret"), []byte("body"))
+	assert.True(t, strings.HasPrefix(signature, "sha256="))
+	assert.True(t, VerifySignature([]byte("secret"), []byte("body"), signature))
+	assert.False(t, VerifySignature([]byte("other"), []byte("body"), signature))
+	assert.False(t, VerifySignature([]byte("secret"), []byte("tampered"), signature))
+}
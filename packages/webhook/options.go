@@ -0,0 +1,77 @@
+package webhook
+
+import (
+	"time"
+)
+
+// Option is a function that configures the Dispatcher.
+type Option func(*Options)
+
+// Options contains the configurable properties of the Dispatcher.
+type Options struct {
+	// Secret is the key that is used to sign the Deliveries. Deliveries are not signed if it is empty.
+	Secret []byte
+	// MaxAttempts is the number of times a Delivery is attempted before it is given up.
+	MaxAttempts int
+	// RetryBackoff is the delay before the first retry. It doubles with every further attempt.
+	RetryBackoff time.Duration
+	// Timeout is the timeout of a single webhook call.
+	Timeout time.Duration
+	// QueueSize is the number of Deliveries that are buffered while the webhooks are being called.
+	QueueSize int
+	// WorkerCount is the number of webhook calls that are performed in parallel.
+	WorkerCount int
+}
+
+// DefaultOptions returns the default options of the Dispatcher.
+func DefaultOptions() *Options {
+	return &Options{
+		MaxAttempts:  5,
+		RetryBackoff: time.Second,
+		Timeout:      5 * time.Second,
+		QueueSize:    1000,
+		WorkerCount:  1,
+	}
+}
+
+// WithSecret is an Option that sets the key that is used to sign the Deliveries.
+func WithSecret(secret []byte) Option {
+	return func(options *Options) {
+		options.Secret = secret
+	}
+}
+
+// WithMaxAttempts is an Option that sets the number of times a Delivery is attempted.
+func WithMaxAttempts(maxAttempts int) Option {
+	return func(options *Options) {
+		options.MaxAttempts = maxAttempts
+	}
+}
+
+// WithRetryBackoff is an Option that sets the delay before the first retry.
+func WithRetryBackoff(retryBackoff time.Duration) Option {
+	return func(options *Options) {
+		options.RetryBackoff = retryBackoff
+	}
+}
+
+// WithTimeout is an Option that sets the timeout of a single webhook call.
+func WithTimeout(timeout time.Duration) Option {
+	return func(options *Options) {
+		options.Timeout = timeout
+	}
+}
+
+// WithQueueSize is an Option that sets the number of Deliveries that are buffered.
+func WithQueueSize(queueSize int) Option {
+	return func(options *Options) {
+		options.QueueSize = queueSize
+	}
+}
+
+// WithWorkerCount is an Option that sets the number of webhook calls that are performed in parallel.
+func WithWorkerCount(workerCount int) Option {
+	return func(options *Options) {
+		options.WorkerCount = workerCount
+	}
+}
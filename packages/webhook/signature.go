@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+const (
+	// SignatureHeader is the header that contains the HMAC signature of a Delivery.
+	SignatureHeader = "X-GoShimmer-Signature"
+
+	// signaturePrefix identifies the hash function that is used for the signature.
+	signaturePrefix = "sha256="
+)
+
+// Sign returns the HMAC-SHA256 signature of the given body in the format of the SignatureHeader.
+func Sign(secret, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if the given signature of the body was created with the given secret. Receivers of
+// webhooks can use it to verify that a Delivery originates from the node.
+func VerifySignature(secret, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
@@ -0,0 +1,180 @@
+package workerpools
+
+import (
+	"runtime"
+	"sync"
+)
+
+// region Manager //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Manager is the shared subsystem that creates the worker pools of the node and budgets the CPU between them. Tasks of
+// critical Pools are always executed right away, while tasks of best-effort Pools are only executed as long as the
+// total number of running tasks is below the worker budget of the Manager.
+type Manager struct {
+	workerBudget int
+	poolParams   map[string]PoolParams
+	pools        []*Pool
+	poolsMutex   sync.RWMutex
+
+	runningTasks      int
+	runningTasksMutex sync.Mutex
+	runningTasksCond  *sync.Cond
+}
+
+// NewManager is the constructor of the Manager. The workerBudget defines how many tasks are executed concurrently before
+// best-effort tasks have to wait (a value <= 0 sets it to the number of CPUs). The given poolParams override the
+// default sizing of the Pools with the corresponding name.
+func NewManager(workerBudget int, poolParams map[string]PoolParams) (manager *Manager) {
+	if workerBudget <= 0 {
+		workerBudget = runtime.GOMAXPROCS(0)
+	}
+	if poolParams == nil {
+		poolParams = make(map[string]PoolParams)
+	}
+
+	manager = &Manager{
+		workerBudget: workerBudget,
+		poolParams:   poolParams,
+	}
+	manager.runningTasksCond = sync.NewCond(&manager.runningTasksMutex)
+
+	return manager
+}
+
+// CreatePool creates a new Pool with the given name and Priority. The defaultParams are used for the sizing of the
+// Pool unless they were overridden in the configuration of the Manager.
+func (m *Manager) CreatePool(name string, priority Priority, defaultParams PoolParams) (pool *Pool) {
+	params := defaultParams
+	if configuredParams, exists := m.poolParams[name]; exists {
+		if configuredParams.WorkerCount > 0 {
+			params.WorkerCount = configuredParams.WorkerCount
+		}
+		if configuredParams.QueueSize > 0 {
+			params.QueueSize = configuredParams.QueueSize
+		}
+	}
+
+	pool = newPool(m, name, priority, params)
+
+	m.poolsMutex.Lock()
+	m.pools = append(m.pools, pool)
+	m.poolsMutex.Unlock()
+
+	return pool
+}
+
+// WorkerBudget returns the number of tasks that are executed concurrently before best-effort tasks have to wait.
+func (m *Manager) WorkerBudget() int {
+	return m.workerBudget
+}
+
+// RunningTasks returns the number of tasks that are currently executed by all Pools.
+func (m *Manager) RunningTasks() int {
+	m.runningTasksMutex.Lock()
+	defer m.runningTasksMutex.Unlock()
+
+	return m.runningTasks
+}
+
+// Metrics returns the runtime metrics of all Pools that were created by the Manager.
+func (m *Manager) Metrics() (metrics []*PoolMetrics) {
+	m.poolsMutex.RLock()
+	defer m.poolsMutex.RUnlock()
+
+	metrics = make([]*PoolMetrics, 0, len(m.pools))
+	for _, pool := range m.pools {
+		metrics = append(metrics, pool.Metrics())
+	}
+
+	return metrics
+}
+
+// Shutdown shuts down all Pools of the Manager after they executed their pending tasks.
+func (m *Manager) Shutdown() {
+	m.poolsMutex.RLock()
+	pools := make([]*Pool, len(m.pools))
+	copy(pools, m.pools)
+	m.poolsMutex.RUnlock()
+
+	for _, pool := range pools {
+		pool.Shutdown()
+	}
+}
+
+// acquire reserves a slot of the worker budget for a task of the given Priority. Critical tasks never wait, so that
+// critical Pools can not be blocked by other work, but they still count towards the budget.
+func (m *Manager) acquire(priority Priority) {
+	m.runningTasksMutex.Lock()
+	defer m.runningTasksMutex.Unlock()
+
+	if priority != PriorityCritical {
+		for m.runningTasks >= m.workerBudget {
+			m.runningTasksCond.Wait()
+		}
+	}
+
+	m.runningTasks++
+}
+
+// release frees the slot of the worker budget that was reserved by acquire.
+func (m *Manager) release() {
+	m.runningTasksMutex.Lock()
+	defer m.runningTasksMutex.Unlock()
+
+	m.runningTasks--
+	m.runningTasksCond.Signal()
+}
+
+// removePool removes the given Pool from the list of Pools of the Manager.
+func (m *Manager) removePool(pool *Pool) {
+	m.poolsMutex.Lock()
+	defer m.poolsMutex.Unlock()
+
+	for i, existingPool := range m.pools {
+		if existingPool == pool {
+			m.pools = append(m.pools[:i], m.pools[i+1:]...)
+			return
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Priority /////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Priority defines how the tasks of a Pool are treated when the worker budget of the Manager is exhausted.
+type Priority uint8
+
+const (
+	// PriorityCritical is the Priority of Pools whose tasks are executed regardless of the worker budget.
+	PriorityCritical Priority = iota
+
+	// PriorityBestEffort is the Priority of Pools whose tasks wait until the worker budget allows their execution.
+	PriorityBestEffort
+)
+
+// String returns a human-readable version of the Priority.
+func (p Priority) String() string {
+	switch p {
+	case PriorityCritical:
+		return "Critical"
+	case PriorityBestEffort:
+		return "BestEffort"
+	default:
+		return "Unknown"
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PoolParams ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PoolParams defines the sizing of a Pool.
+type PoolParams struct {
+	// WorkerCount defines the number of tasks of the Pool that are executed concurrently.
+	WorkerCount int
+	// QueueSize defines the number of tasks that can be queued before the submission of new tasks blocks or fails.
+	QueueSize int
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,83 @@
+package workerpools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+)
+
+func TestPool_SubmitAndShutdown(t *testing.T) {
+	manager := NewManager(2, map[string]PoolParams{
+		"pool": {QueueSize: 100},
+	})
+	pool := manager.CreatePool("pool", PriorityCritical, PoolParams{WorkerCount: 2, QueueSize: 1})
+
+	executed := atomic.NewInt32(0)
+	for i := 0; i < 50; i++ {
+		assert.True(t, pool.Submit(func() { executed.Inc() }))
+	}
+	pool.Shutdown()
+
+	assert.EqualValues(t, 50, executed.Load())
+	assert.False(t, pool.Submit(func() {}))
+	assert.Empty(t, manager.Metrics())
+
+	metrics := pool.Metrics()
+	assert.Equal(t, 2, metrics.WorkerCount)
+	assert.Equal(t, 100, metrics.QueueSize)
+	assert.EqualValues(t, 50, metrics.ExecutedTasks)
+	assert.EqualValues(t, 1, metrics.DroppedTasks)
+}
+
+func TestPool_TrySubmit(t *testing.T) {
+	manager := NewManager(1, nil)
+	pool := manager.CreatePool("pool", PriorityBestEffort, PoolParams{WorkerCount: 1, QueueSize: 1})
+	defer manager.Shutdown()
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	require.True(t, pool.TrySubmit(func() {
+		close(started)
+		<-release
+	}))
+	<-started
+
+	assert.True(t, pool.TrySubmit(func() {}))
+	assert.False(t, pool.TrySubmit(func() {}))
+	assert.EqualValues(t, 1, pool.Metrics().DroppedTasks)
+
+	close(release)
+}
+
+func TestManager_WorkerBudget(t *testing.T) {
+	manager := NewManager(1, nil)
+	criticalPool := manager.CreatePool("critical", PriorityCritical, PoolParams{WorkerCount: 1, QueueSize: 10})
+	bestEffortPool := manager.CreatePool("bestEffort", PriorityBestEffort, PoolParams{WorkerCount: 1, QueueSize: 10})
+	defer manager.Shutdown()
+
+	release := make(chan struct{})
+	criticalStarted := make(chan struct{})
+	require.True(t, criticalPool.Submit(func() {
+		close(criticalStarted)
+		<-release
+	}))
+	<-criticalStarted
+
+	// the best-effort task has to wait for the budget that is used by the critical task
+	bestEffortExecuted := atomic.NewBool(false)
+	require.True(t, bestEffortPool.Submit(func() { bestEffortExecuted.Store(true) }))
+	assert.Never(t, bestEffortExecuted.Load, 100*time.Millisecond, 10*time.Millisecond)
+
+	// critical tasks are executed regardless of the exhausted budget
+	secondCriticalPool := manager.CreatePool("critical2", PriorityCritical, PoolParams{WorkerCount: 1, QueueSize: 10})
+	criticalExecuted := atomic.NewBool(false)
+	require.True(t, secondCriticalPool.Submit(func() { criticalExecuted.Store(true) }))
+	assert.Eventually(t, criticalExecuted.Load, time.Second, 10*time.Millisecond)
+
+	close(release)
+	assert.Eventually(t, bestEffortExecuted.Load, time.Second, 10*time.Millisecond)
+	assert.Len(t, manager.Metrics(), 3)
+}
@@ -0,0 +1,202 @@
+package workerpools
+
+import (
+	"sync"
+
+	"go.uber.org/atomic"
+)
+
+// region Pool /////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// Pool is a named worker pool that executes the submitted tasks with a fixed number of workers. It is created by the
+// Manager, which decides when the tasks of the Pool are allowed to run depending on its Priority.
+type Pool struct {
+	name     string
+	priority Priority
+	params   PoolParams
+	manager  *Manager
+
+	queue          chan func()
+	shutdownSignal chan struct{}
+	shutdownOnce   sync.Once
+	shutdownWG     sync.WaitGroup
+
+	runningTasks  *atomic.Int64
+	executedTasks *atomic.Uint64
+	droppedTasks  *atomic.Uint64
+}
+
+// newPool creates a new Pool and starts its workers.
+func newPool(manager *Manager, name string, priority Priority, params PoolParams) (pool *Pool) {
+	if params.WorkerCount <= 0 {
+		params.WorkerCount = 1
+	}
+	if params.QueueSize < 0 {
+		params.QueueSize = 0
+	}
+
+	pool = &Pool{
+		name:           name,
+		priority:       priority,
+		params:         params,
+		manager:        manager,
+		queue:          make(chan func(), params.QueueSize),
+		shutdownSignal: make(chan struct{}),
+		runningTasks:   atomic.NewInt64(0),
+		executedTasks:  atomic.NewUint64(0),
+		droppedTasks:   atomic.NewUint64(0),
+	}
+
+	pool.shutdownWG.Add(params.WorkerCount)
+	for i := 0; i < params.WorkerCount; i++ {
+		go pool.worker()
+	}
+
+	return pool
+}
+
+// Name returns the name of the Pool.
+func (p *Pool) Name() string {
+	return p.name
+}
+
+// Priority returns the Priority of the Pool.
+func (p *Pool) Priority() Priority {
+	return p.priority
+}
+
+// Submit queues the given task for execution and blocks while the queue of the Pool is full. It returns false if the
+// Pool was shut down before the task could be queued.
+func (p *Pool) Submit(task func()) (submitted bool) {
+	select {
+	case <-p.shutdownSignal:
+		p.droppedTasks.Inc()
+		return false
+	default:
+	}
+
+	select {
+	case p.queue <- task:
+		return true
+	case <-p.shutdownSignal:
+		p.droppedTasks.Inc()
+		return false
+	}
+}
+
+// TrySubmit queues the given task for execution if the queue of the Pool is not full and returns false if the task was
+// dropped.
+func (p *Pool) TrySubmit(task func()) (submitted bool) {
+	select {
+	case <-p.shutdownSignal:
+		p.droppedTasks.Inc()
+		return false
+	default:
+	}
+
+	select {
+	case p.queue <- task:
+		return true
+	default:
+		p.droppedTasks.Inc()
+		return false
+	}
+}
+
+// PendingTasks returns the number of tasks that are waiting in the queue of the Pool.
+func (p *Pool) PendingTasks() int {
+	return len(p.queue)
+}
+
+// Metrics returns the runtime metrics of the Pool.
+func (p *Pool) Metrics() *PoolMetrics {
+	return &PoolMetrics{
+		Name:          p.name,
+		Priority:      p.priority,
+		WorkerCount:   p.params.WorkerCount,
+		QueueSize:     p.params.QueueSize,
+		PendingTasks:  len(p.queue),
+		RunningTasks:  p.runningTasks.Load(),
+		ExecutedTasks: p.executedTasks.Load(),
+		DroppedTasks:  p.droppedTasks.Load(),
+	}
+}
+
+// Shutdown stops the Pool from accepting new tasks and waits until the already queued tasks were executed.
+func (p *Pool) Shutdown() {
+	p.shutdownOnce.Do(func() {
+		close(p.shutdownSignal)
+		p.shutdownWG.Wait()
+
+		// tasks that raced the shutdown signal into the queue are still executed
+		for {
+			select {
+			case task := <-p.queue:
+				p.execute(task)
+			default:
+				p.manager.removePool(p)
+				return
+			}
+		}
+	})
+}
+
+// worker executes the queued tasks until the Pool is shut down and its queue is empty.
+func (p *Pool) worker() {
+	defer p.shutdownWG.Done()
+
+	for {
+		select {
+		case task := <-p.queue:
+			p.execute(task)
+		case <-p.shutdownSignal:
+			for {
+				select {
+				case task := <-p.queue:
+					p.execute(task)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+// execute executes the given task within the worker budget of the Manager.
+func (p *Pool) execute(task func()) {
+	p.manager.acquire(p.priority)
+	p.runningTasks.Inc()
+	defer func() {
+		p.runningTasks.Dec()
+		p.executedTasks.Inc()
+		p.manager.release()
+	}()
+
+	task()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region PoolMetrics //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PoolMetrics contains the runtime metrics of a Pool.
+type PoolMetrics struct {
+	// Name is the name of the Pool.
+	Name string
+	// Priority is the Priority of the Pool.
+	Priority Priority
+	// WorkerCount is the number of workers of the Pool.
+	WorkerCount int
+	// QueueSize is the capacity of the queue of the Pool.
+	QueueSize int
+	// PendingTasks is the number of tasks that are waiting in the queue.
+	PendingTasks int
+	// RunningTasks is the number of tasks that are currently executed.
+	RunningTasks int64
+	// ExecutedTasks is the number of tasks that were executed since the Pool was created.
+	ExecutedTasks uint64
+	// DroppedTasks is the number of tasks that were rejected because the Pool was full or shut down.
+	DroppedTasks uint64
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
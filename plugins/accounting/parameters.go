@@ -0,0 +1,24 @@
+package accounting
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the accounting plugin.
+type ParametersDefinition struct {
+	// Window defines the maximum time span that is covered by the usage.
+	Window time.Duration `default:"1h" usage:"the maximum time span that is covered by the usage"`
+	// Resolution defines the granularity of the window.
+	Resolution time.Duration `default:"1m" usage:"the granularity of the window"`
+	// MetricsIssuers defines the number of issuers with the most issued bytes that are exposed as metrics.
+	MetricsIssuers int `default:"10" usage:"the number of issuers with the most issued bytes that are exposed as metrics"`
+}
+
+// Parameters contains the configuration used by the accounting plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "accounting")
+}
@@ -0,0 +1,58 @@
+package accounting
+
+import (
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/accounting"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the accounting plugin.
+const PluginName = "Accounting"
+
+var (
+	// Plugin is the plugin instance of the accounting plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle     *tangle.Tangle
+	Server     *echo.Echo
+	Accountant *accounting.Accountant
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newAccountant); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newAccountant creates the Accountant that keeps track of the messages and bytes issued per issuer and payload type.
+func newAccountant() *accounting.Accountant {
+	return accounting.New(accounting.Params{
+		Window:     Parameters.Window,
+		Resolution: Parameters.Resolution,
+	})
+}
+
+func configure(_ *node.Plugin) {
+	deps.Tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+			deps.Accountant.RecordMessage(message.IssuerPublicKey(), message.Payload().Type(), message.Size())
+		})
+	}))
+
+	configureWebAPI()
+}
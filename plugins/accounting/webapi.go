@@ -0,0 +1,85 @@
+package accounting
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	// RouteAccounting defines the HTTP path for the accounting endpoint.
+	RouteAccounting = "accounting"
+	// RouteAccountingIssuer defines the HTTP path for the accounting of a single issuer.
+	RouteAccountingIssuer = "accounting/issuers/:publicKey"
+
+	// defaultTopIssuers is the number of issuers that are returned if the request doesn't specify it.
+	defaultTopIssuers = 10
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteAccounting, getAccountingHandler)
+	deps.Server.GET(RouteAccountingIssuer, getAccountingIssuerHandler)
+}
+
+// getAccountingHandler returns the messages and bytes that were issued per payload type and by the issuers that issued
+// the most bytes within the requested window.
+func getAccountingHandler(c echo.Context) error {
+	window, err := windowParameter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	topIssuers := defaultTopIssuers
+	if topParam := c.QueryParam("top"); topParam != "" {
+		if topIssuers, err = strconv.Atoi(topParam); err != nil || topIssuers < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid number of issuers: %s", topParam)))
+		}
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewAccountingResponse(deps.Accountant.Report(window), topIssuers))
+}
+
+// getAccountingIssuerHandler returns the messages and bytes that were issued by the given issuer within the requested
+// window.
+func getAccountingIssuerHandler(c echo.Context) error {
+	issuer, err := ed25519.PublicKeyFromString(c.Param("publicKey"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid issuer public key: %w", err)))
+	}
+
+	window, err := windowParameter(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	report := deps.Accountant.Report(window)
+	issuerUsage, exists := report.Issuers[issuer]
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("issuer %s did not issue messages within the window", issuer)))
+	}
+
+	return c.JSON(http.StatusOK, &jsonmodels.AccountingIssuerResponse{
+		Window: report.Window.Milliseconds(),
+		Issuer: jsonmodels.NewAccountingIssuer(issuerUsage),
+	})
+}
+
+// windowParameter returns the window of the request, which defaults to the window of the Accountant.
+func windowParameter(c echo.Context) (window time.Duration, err error) {
+	windowParam := c.QueryParam("window")
+	if windowParam == "" {
+		return 0, nil
+	}
+
+	if window, err = time.ParseDuration(windowParam); err != nil || window <= 0 {
+		return 0, errors.Errorf("invalid window: %s", windowParam)
+	}
+
+	return window, nil
+}
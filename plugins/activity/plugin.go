@@ -1,6 +1,7 @@
 package activity
 
 import (
+	"bytes"
 	"context"
 	"math/rand"
 	"time"
@@ -19,6 +20,9 @@ var (
 	// Plugin is the plugin instance of the activity plugin.
 	Plugin *node.Plugin
 	deps   = new(dependencies)
+
+	// activityData is the data contained in the payload of an activity message.
+	activityData = []byte("activity")
 )
 
 type dependencies struct {
@@ -32,11 +36,20 @@ func init() {
 
 func configure(plugin *node.Plugin) {
 	plugin.LogInfof("starting node with activity plugin")
+
+	deps.Tangle.Scheduler.RegisterPriorityClassifier(isActivityMessage)
+}
+
+// isActivityMessage returns true if the given message is an activity message.
+func isActivityMessage(message *tangle.Message) bool {
+	dataPayload, isData := message.Payload().(*payload.GenericDataPayload)
+
+	return isData && bytes.Equal(dataPayload.Blob(), activityData)
 }
 
 // broadcastActivityMessage broadcasts a sync beacon via communication layer.
 func broadcastActivityMessage() {
-	activityPayload := payload.NewGenericDataPayload([]byte("activity"))
+	activityPayload := payload.NewGenericDataPayload(activityData)
 	msg, err := deps.Tangle.IssuePayload(activityPayload, Parameters.ParentsCount)
 	if err != nil {
 		Plugin.LogWarnf("error issuing activity message: %s", err)
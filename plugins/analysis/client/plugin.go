@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/autopeering/peer"
-	"github.com/iotaledger/hive.go/autopeering/selection"
 	"github.com/iotaledger/hive.go/configuration"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/logger"
@@ -13,6 +12,7 @@ import (
 	flag "github.com/spf13/pflag"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 )
 
@@ -30,7 +30,7 @@ type dependencies struct {
 
 	Local     *peer.Local
 	Config    *configuration.Configuration
-	Selection *selection.Protocol `optional:"true"`
+	Selection *neighborselection.Protocol `optional:"true"`
 }
 
 func init() {
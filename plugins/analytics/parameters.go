@@ -0,0 +1,26 @@
+package analytics
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the analytics plugin.
+type ParametersDefinition struct {
+	// Window defines the time span that is covered by the statistics.
+	Window time.Duration `default:"10m" usage:"the time span that is covered by the statistics"`
+	// SampleInterval defines the interval in which the width of the tangle is sampled.
+	SampleInterval time.Duration `default:"1s" usage:"the interval in which the width of the tangle is sampled"`
+	// OrphanageThreshold defines the time after which a booked message that was not confirmed is considered orphaned.
+	OrphanageThreshold time.Duration `default:"2m" usage:"the time after which a booked message that was not confirmed is considered orphaned"`
+	// MaxSamples defines the maximum number of samples per statistic that are kept within the window.
+	MaxSamples int `default:"100000" usage:"the maximum number of samples per statistic that are kept within the window (0 disables the limit)"`
+}
+
+// Parameters contains the configuration used by the analytics plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "analytics")
+}
@@ -0,0 +1,84 @@
+package analytics
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/timeutil"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/analytics"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the analytics plugin.
+const PluginName = "Analytics"
+
+var (
+	// Plugin is the plugin instance of the analytics plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle    *tangle.Tangle
+	Server    *echo.Echo
+	Analytics *analytics.Analytics
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newAnalytics); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newAnalytics creates the Analytics that compute the rolling statistics about the Tangle.
+func newAnalytics() *analytics.Analytics {
+	return analytics.New(analytics.Params{
+		Window:             Parameters.Window,
+		OrphanageThreshold: Parameters.OrphanageThreshold,
+		MaxSamples:         Parameters.MaxSamples,
+	})
+}
+
+func configure(_ *node.Plugin) {
+	deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+			deps.Analytics.MessageBooked(messageID, message.IssuingTime())
+		})
+	}))
+	deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(event.NewClosure(deps.Analytics.MessageConfirmed))
+	deps.Tangle.Solidifier.Events.MessageOrphaned.Attach(event.NewClosure(func(event *tangle.MessageOrphanedEvent) {
+		deps.Analytics.MessageOrphaned(event.MessageID)
+	}))
+
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(event.NewClosure(deps.Analytics.BranchCreated))
+	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(deps.Analytics.BranchResolved))
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchRejected.Attach(event.NewClosure(deps.Analytics.BranchResolved))
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		timeutil.NewTicker(func() {
+			deps.Analytics.SampleWidth(deps.Tangle.TipManager.TipCount())
+		}, Parameters.SampleInterval, ctx).WaitForGracefulShutdown()
+
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityMetrics); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
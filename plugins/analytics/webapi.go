@@ -0,0 +1,21 @@
+package analytics
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// RouteAnalytics defines the HTTP path for the metrics/analytics endpoint.
+const RouteAnalytics = "metrics/analytics"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteAnalytics, getAnalyticsHandler)
+}
+
+// getAnalyticsHandler returns the rolling statistics about the Tangle.
+func getAnalyticsHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.NewAnalyticsResponse(deps.Analytics.Statistics()))
+}
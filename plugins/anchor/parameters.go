@@ -0,0 +1,24 @@
+package anchor
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the anchor plugin.
+type ParametersDefinition struct {
+	// Issuers defines the base58 encoded public keys of the designated nodes whose anchors are accepted.
+	Issuers []string `usage:"the base58 encoded public keys of the designated nodes whose anchors are accepted"`
+	// Issue defines whether the node issues anchors itself.
+	Issue bool `default:"false" usage:"whether the node issues anchors (only the anchors of designated issuers are accepted)"`
+	// Interval defines the interval at which the node issues anchors.
+	Interval time.Duration `default:"1m" usage:"the interval at which the node issues anchors"`
+}
+
+// Parameters contains the configuration used by the anchor plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "anchor")
+}
@@ -0,0 +1,123 @@
+package anchor
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/timeutil"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/anchor"
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the anchor plugin.
+const PluginName = "Anchor"
+
+var (
+	// Plugin is the plugin instance of the anchor plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle  *tangle.Tangle
+	Server  *echo.Echo
+	Local   *peer.Local
+	Tracker *anchor.Tracker
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newTracker); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newTracker creates the Tracker that accepts the anchors of the configured issuers.
+func newTracker() *anchor.Tracker {
+	issuers := make([]ed25519.PublicKey, len(Parameters.Issuers))
+	for i, issuer := range Parameters.Issuers {
+		publicKey, err := ed25519.PublicKeyFromString(issuer)
+		if err != nil {
+			Plugin.Panicf("invalid anchor issuer %s: %s", issuer, err)
+		}
+		issuers[i] = publicKey
+	}
+
+	return anchor.NewTracker(issuers)
+}
+
+func configure(plugin *node.Plugin) {
+	if len(Parameters.Issuers) == 0 {
+		plugin.LogWarn("no anchor issuers are configured, all anchors will be rejected")
+	}
+
+	deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(onMessageBooked))
+	deps.Tangle.Scheduler.RegisterPriorityClassifier(isAnchorMessage)
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if !Parameters.Issue {
+		return
+	}
+
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		timeutil.NewTicker(issueAnchor, Parameters.Interval, ctx).WaitForGracefulShutdown()
+
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityAnchor); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// issueAnchor issues an anchor of the last confirmed message of the node.
+func issueAnchor() {
+	localIdentity := deps.Local.LocalIdentity()
+	anchorPayload := anchor.NewPayload(deps.Tangle.TimeManager.LastConfirmedMessage(), clock.SyncedTime(), localIdentity.PublicKey(), localIdentity.Sign)
+
+	msg, err := deps.Tangle.IssuePayload(anchorPayload)
+	if err != nil {
+		Plugin.LogWarnf("error issuing anchor: %s", err)
+		return
+	}
+
+	Plugin.LogDebugf("issued anchor of %s in message %s", anchorPayload.ConfirmedRoot, msg.ID())
+}
+
+// isAnchorMessage returns true if the given message contains an anchor.
+func isAnchorMessage(message *tangle.Message) bool {
+	_, isAnchor := message.Payload().(*anchor.Payload)
+
+	return isAnchor
+}
+
+// onMessageBooked adds the anchor that is contained in the given message to the Tracker.
+func onMessageBooked(messageID tangle.MessageID) {
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		anchorPayload, isAnchor := message.Payload().(*anchor.Payload)
+		if !isAnchor {
+			return
+		}
+
+		if err := deps.Tracker.Add(anchorPayload, messageID); err != nil {
+			Plugin.LogDebugf("rejected anchor in message %s: %s", messageID, err)
+		}
+	})
+}
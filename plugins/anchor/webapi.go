@@ -0,0 +1,24 @@
+package anchor
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// RouteAnchors defines the HTTP path for the anchors endpoint.
+const RouteAnchors = "anchors"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteAnchors, getAnchorsHandler)
+}
+
+// getAnchorsHandler returns the latest anchors of the designated issuers and the sync status of the node that is
+// estimated from them.
+func getAnchorsHandler(c echo.Context) error {
+	tangleTime := deps.Tangle.TimeManager.Time()
+
+	return c.JSON(http.StatusOK, jsonmodels.NewAnchorsResponse(deps.Tracker.Anchors(), tangleTime, deps.Tracker.SyncStatus(tangleTime)))
+}
@@ -0,0 +1,69 @@
+// Package archive is a plugin that runs the node in archive mode, in which it maintains additional history indexes
+// that allow to look up all messages that ever touched an address or that were issued by a node.
+package archive
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/archive"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the archive plugin.
+const PluginName = "Archive"
+
+var (
+	// Plugin is the plugin instance of the archive plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server  *echo.Echo
+	Archive *archive.Archive
+}
+
+type archiveDeps struct {
+	dig.In
+
+	Tangle  *tangle.Tangle
+	Storage kvstore.KVStore
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(archiveDeps archiveDeps) *archive.Archive {
+			return archive.New(archiveDeps.Tangle, archiveDeps.Storage)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(_ *node.Plugin) {
+	deps.Archive.Setup()
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+		deps.Archive.Shutdown()
+	}, shutdown.PriorityArchive); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
@@ -0,0 +1,46 @@
+package archive
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	// RouteAddressHistory defines the HTTP path for the archive/addresses/:address endpoint.
+	RouteAddressHistory = "archive/addresses/:address"
+
+	// RouteIssuerHistory defines the HTTP path for the archive/issuers/:issuer endpoint.
+	RouteIssuerHistory = "archive/issuers/:issuer"
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteAddressHistory, getAddressHistoryHandler)
+	deps.Server.GET(RouteIssuerHistory, getIssuerHistoryHandler)
+}
+
+// getAddressHistoryHandler returns the messages that contain a transaction which spends from or sends to the requested
+// address.
+func getAddressHistoryHandler(c echo.Context) error {
+	address, err := ledgerstate.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid address in the URL")))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewArchiveHistory(deps.Archive.MessageIDsByAddress(address)))
+}
+
+// getIssuerHistoryHandler returns the messages that were issued by the node with the requested public key.
+func getIssuerHistoryHandler(c echo.Context) error {
+	issuerPublicKey, err := ed25519.PublicKeyFromString(c.Param("issuer"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid issuer public key in the URL")))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewArchiveHistory(deps.Archive.MessageIDsByIssuer(issuerPublicKey)))
+}
@@ -33,6 +33,7 @@ func CreatePeerDisc(localID *peer.Local) *discover.Protocol {
 	if err != nil {
 		log.Errorf("Invalid entry nodes; ignoring: %v", err)
 	}
+	entryNodes = append(entryNodes, dnsSeedEntryNodes(log)...)
 	log.Debugf("Entry nodes: %v", entryNodes)
 
 	return discover.New(localID, ProtocolVersion, Parameters.NetworkVersion,
@@ -47,28 +48,57 @@ func parseEntryNodes() (result []*peer.Peer, err error) {
 			continue
 		}
 
-		parts := strings.Split(entryNodeDefinition, "@")
-		if len(parts) != entryNodeParts {
-			return nil, fmt.Errorf("%w: entry node information must contains %d parts, is %d", ErrParsingEntryNode, entryNodeParts, len(parts))
-		}
-		pubKey, err := base58.Decode(parts[0])
-		if err != nil {
-			return nil, fmt.Errorf("%w: invalid public key: %s", ErrParsingEntryNode, err)
-		}
-		addr, err := net.ResolveUDPAddr("udp", parts[1])
+		entryNode, err := parseEntryNode(entryNodeDefinition)
 		if err != nil {
-			return nil, fmt.Errorf("%w: host cannot be resolved: %s", ErrParsingEntryNode, err)
+			return nil, err
 		}
-		publicKey, _, err := ed25519.PublicKeyFromBytes(pubKey)
+		result = append(result, entryNode)
+	}
+
+	return result, nil
+}
+
+// dnsSeedEntryNodes returns the entry nodes that are announced by the configured DNS seeds. Unlike the configured entry
+// nodes, announced entry nodes that can't be parsed are skipped individually.
+func dnsSeedEntryNodes(log *logger.Logger) (result []*peer.Peer) {
+	entryNodeDefinitions, err := resolveConfiguredDNSSeeds(log)
+	if err != nil {
+		log.Errorf("Invalid DNS seeds; ignoring: %v", err)
+		return nil
+	}
+
+	for _, entryNodeDefinition := range entryNodeDefinitions {
+		entryNode, err := parseEntryNode(entryNodeDefinition)
 		if err != nil {
-			return nil, err
+			log.Warnf("Invalid entry node %s announced by DNS seed; ignoring: %v", entryNodeDefinition, err)
+			continue
 		}
+		result = append(result, entryNode)
+	}
 
-		services := service.New()
-		services.Update(service.PeeringKey, addr.Network(), addr.Port)
+	return result
+}
 
-		result = append(result, peer.NewPeer(identity.New(publicKey), addr.IP, services))
+func parseEntryNode(entryNodeDefinition string) (*peer.Peer, error) {
+	parts := strings.Split(entryNodeDefinition, "@")
+	if len(parts) != entryNodeParts {
+		return nil, fmt.Errorf("%w: entry node information must contains %d parts, is %d", ErrParsingEntryNode, entryNodeParts, len(parts))
+	}
+	pubKey, err := base58.Decode(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid public key: %s", ErrParsingEntryNode, err)
+	}
+	addr, err := net.ResolveUDPAddr("udp", parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("%w: host cannot be resolved: %s", ErrParsingEntryNode, err)
+	}
+	publicKey, _, err := ed25519.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	services := service.New()
+	services.Update(service.PeeringKey, addr.Network(), addr.Port)
+
+	return peer.NewPeer(identity.New(publicKey), addr.IP, services), nil
 }
@@ -0,0 +1,155 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/mr-tron/base58"
+)
+
+const (
+	// dnsSeedTimeout defines the maximum time that the resolution of all DNS seeds can take.
+	dnsSeedTimeout = 10 * time.Second
+
+	// dnsSeedService and dnsSeedProto define the SRV records of a DNS seed (_autopeering._udp.<seed>).
+	dnsSeedService = "autopeering"
+	dnsSeedProto   = "udp"
+
+	dnsSeedRecordSeparator = ";"
+	dnsSeedRecordParts     = 3
+)
+
+var (
+	// ErrInvalidDNSSeedRecord is returned for a DNS seed record that can't be parsed.
+	ErrInvalidDNSSeedRecord = errors.New("invalid DNS seed record")
+
+	// ErrDNSSeedRecordExpired is returned for a DNS seed record whose expiry time has passed.
+	ErrDNSSeedRecordExpired = errors.New("DNS seed record expired")
+
+	// ErrInvalidDNSSeedSignature is returned for a DNS seed record that was not signed by the seed key.
+	ErrInvalidDNSSeedSignature = errors.New("invalid DNS seed record signature")
+)
+
+// dnsResolver is the part of the net.Resolver that is used to resolve DNS seeds.
+type dnsResolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupSRV(ctx context.Context, service, proto, name string) (string, []*net.SRV, error)
+}
+
+// SignDNSSeedRecord returns the TXT record that announces the given entry node (publicKey@host:port) until the given
+// expiry time, signed with the key of the DNS seed.
+func SignDNSSeedRecord(entryNode string, expiry time.Time, sign func(message []byte) ed25519.Signature) string {
+	expiryString := strconv.FormatInt(expiry.Unix(), 10)
+
+	return strings.Join([]string{entryNode, expiryString, sign(dnsSeedMessage(entryNode, expiryString)).String()}, dnsSeedRecordSeparator)
+}
+
+// resolveDNSSeeds returns the entry nodes that are announced by the given DNS seeds. Every seed announces entry nodes
+// in two ways, which can be combined:
+//   - TXT records of the seed that contain signed entry nodes (publicKey@host:port;expiry;signature),
+//   - SRV records of _autopeering._udp.<seed> whose targets carry a TXT record with the public key of the entry node
+//     (publicKey;expiry;signature), where the signature covers publicKey@target:port;expiry.
+//
+// Records that are expired or that were not signed by the given public key are skipped.
+func resolveDNSSeeds(ctx context.Context, resolver dnsResolver, seeds []string, publicKey ed25519.PublicKey, now time.Time, log *logger.Logger) (entryNodes []string) {
+	seen := make(map[string]bool)
+	addEntryNode := func(record, entryNode string) {
+		if err := verifyDNSSeedRecord(record, entryNode, publicKey, now); err != nil {
+			log.Warnf("Skipping DNS seed record %s: %s", record, err)
+			return
+		}
+		if !seen[entryNode] {
+			seen[entryNode] = true
+			entryNodes = append(entryNodes, entryNode)
+		}
+	}
+
+	for _, seed := range seeds {
+		records, err := resolver.LookupTXT(ctx, seed)
+		if err != nil {
+			log.Warnf("Failed to look up TXT records of DNS seed %s: %s", seed, err)
+		}
+		for _, record := range records {
+			addEntryNode(record, strings.SplitN(record, dnsSeedRecordSeparator, dnsSeedRecordParts)[0])
+		}
+
+		_, srvRecords, err := resolver.LookupSRV(ctx, dnsSeedService, dnsSeedProto, seed)
+		if err != nil {
+			log.Debugf("Failed to look up SRV records of DNS seed %s: %s", seed, err)
+		}
+		for _, srvRecord := range srvRecords {
+			target := strings.TrimSuffix(srvRecord.Target, ".")
+			targetRecords, err := resolver.LookupTXT(ctx, target)
+			if err != nil {
+				log.Warnf("Failed to look up TXT records of %s of DNS seed %s: %s", target, seed, err)
+				continue
+			}
+			for _, record := range targetRecords {
+				publicKeyString := strings.SplitN(record, dnsSeedRecordSeparator, dnsSeedRecordParts)[0]
+				addEntryNode(record, publicKeyString+"@"+net.JoinHostPort(target, strconv.Itoa(int(srvRecord.Port))))
+			}
+		}
+	}
+
+	return entryNodes
+}
+
+// resolveConfiguredDNSSeeds returns the entry nodes that are announced by the configured DNS seeds.
+func resolveConfiguredDNSSeeds(log *logger.Logger) (entryNodes []string, err error) {
+	if len(Parameters.DNSSeeds) == 0 {
+		return nil, nil
+	}
+
+	publicKey, err := ed25519.PublicKeyFromString(Parameters.DNSSeedPublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid DNS seed public key: %s", ErrParsingEntryNode, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), dnsSeedTimeout)
+	defer cancel()
+
+	return resolveDNSSeeds(ctx, net.DefaultResolver, Parameters.DNSSeeds, publicKey, time.Now(), log), nil
+}
+
+// verifyDNSSeedRecord checks that the given record announces the given entry node, is not expired and was signed with
+// the given public key.
+func verifyDNSSeedRecord(record, entryNode string, publicKey ed25519.PublicKey, now time.Time) error {
+	parts := strings.Split(record, dnsSeedRecordSeparator)
+	if len(parts) != dnsSeedRecordParts {
+		return errors.Errorf("record must contain %d parts, is %d: %w", dnsSeedRecordParts, len(parts), ErrInvalidDNSSeedRecord)
+	}
+
+	expiry, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return errors.Errorf("invalid expiry time %s: %w", parts[1], ErrInvalidDNSSeedRecord)
+	}
+	if now.Unix() > expiry {
+		return errors.Errorf("record expired at %s: %w", time.Unix(expiry, 0), ErrDNSSeedRecordExpired)
+	}
+
+	signatureBytes, err := base58.Decode(parts[2])
+	if err != nil {
+		return errors.Errorf("invalid signature encoding: %w", ErrInvalidDNSSeedRecord)
+	}
+	signature, _, err := ed25519.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return errors.Errorf("invalid signature: %w", ErrInvalidDNSSeedRecord)
+	}
+	if !publicKey.VerifySignature(dnsSeedMessage(entryNode, parts[1]), signature) {
+		return errors.Errorf("%s was not announced by %s: %w", entryNode, publicKey, ErrInvalidDNSSeedSignature)
+	}
+
+	return nil
+}
+
+// dnsSeedMessage returns the message that is signed by the DNS seed key to announce an entry node.
+func dnsSeedMessage(entryNode, expiry string) []byte {
+	return []byte(entryNode + dnsSeedRecordSeparator + expiry)
+}
@@ -0,0 +1,82 @@
+package discovery
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveDNSSeeds(t *testing.T) {
+	seedIdentity := identity.GenerateLocalIdentity()
+	entryNode := identity.GenerateLocalIdentity().PublicKey().String() + "@entry-0.example.org:14626"
+	srvEntryNodeKey := identity.GenerateLocalIdentity().PublicKey().String()
+	now := time.Unix(1000, 0)
+
+	// the SRV target carries a record without the address, which is signed together with the address of the SRV record
+	srvRecord := SignDNSSeedRecord(srvEntryNodeKey+"@entry-1.example.org:14646", now.Add(time.Hour), seedIdentity.Sign)
+	srvTargetRecord := srvEntryNodeKey + srvRecord[len(srvEntryNodeKey+"@entry-1.example.org:14646"):]
+
+	resolver := &mockResolver{
+		txt: map[string][]string{
+			"seed.example.org": {
+				SignDNSSeedRecord(entryNode, now.Add(time.Hour), seedIdentity.Sign),
+				SignDNSSeedRecord(entryNode, now.Add(time.Hour), seedIdentity.Sign),
+				SignDNSSeedRecord("expired@entry-2.example.org:14626", now.Add(-time.Second), seedIdentity.Sign),
+				SignDNSSeedRecord("forged@entry-3.example.org:14626", now.Add(time.Hour), identity.GenerateLocalIdentity().Sign),
+				"unrelated record",
+			},
+			"entry-1.example.org": {srvTargetRecord},
+		},
+		srv: map[string][]*net.SRV{
+			"seed.example.org": {{Target: "entry-1.example.org.", Port: 14646}},
+		},
+	}
+
+	entryNodes := resolveDNSSeeds(context.Background(), resolver, []string{"seed.example.org", "unknown.example.org"}, seedIdentity.PublicKey(), now, logger.NewNopLogger())
+	assert.Equal(t, []string{entryNode, srvEntryNodeKey + "@entry-1.example.org:14646"}, entryNodes)
+
+	// the signature binds the public key to the address of the SRV record
+	resolver.srv["seed.example.org"][0].Port = 14647
+	entryNodes = resolveDNSSeeds(context.Background(), resolver, []string{"seed.example.org"}, seedIdentity.PublicKey(), now, logger.NewNopLogger())
+	assert.Equal(t, []string{entryNode}, entryNodes)
+}
+
+func TestVerifyDNSSeedRecord(t *testing.T) {
+	seedIdentity := identity.GenerateLocalIdentity()
+	now := time.Unix(1000, 0)
+	record := SignDNSSeedRecord("key@host:14626", now, seedIdentity.Sign)
+
+	assert.NoError(t, verifyDNSSeedRecord(record, "key@host:14626", seedIdentity.PublicKey(), now))
+	assert.ErrorIs(t, verifyDNSSeedRecord(record, "key@host:14626", seedIdentity.PublicKey(), now.Add(time.Second)), ErrDNSSeedRecordExpired)
+	assert.ErrorIs(t, verifyDNSSeedRecord(record, "key@otherHost:14626", seedIdentity.PublicKey(), now), ErrInvalidDNSSeedSignature)
+	assert.ErrorIs(t, verifyDNSSeedRecord("key@host:14626", "key@host:14626", seedIdentity.PublicKey(), now), ErrInvalidDNSSeedRecord)
+}
+
+type mockResolver struct {
+	txt map[string][]string
+	srv map[string][]*net.SRV
+}
+
+func (m *mockResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	records, exists := m.txt[name]
+	if !exists {
+		return nil, errors.New("no such host")
+	}
+
+	return records, nil
+}
+
+func (m *mockResolver) LookupSRV(_ context.Context, service, proto, name string) (string, []*net.SRV, error) {
+	records, exists := m.srv[name]
+	if !exists {
+		return "", nil, errors.New("no such host")
+	}
+
+	return "_" + service + "._" + proto + "." + name, records, nil
+}
@@ -9,6 +9,12 @@ type ParametersDefinitionDiscovery struct {
 
 	// EntryNodes defines the config flag of the entry nodes.
 	EntryNodes []string `default:"2PV5487xMw5rasGBXXWeqSi4hLz7r19YBt8Y1TGAsQbj@analysisentry-01.devnet.shimmer.iota.cafe:15626,5EDH4uY78EA6wrBkHHAVBWBMDt7EcksRq6pjzipoW15B@entry-0.devnet.tanglebay.com:14646,CAB87iQZR6BjBrCgEBupQJ4gpEBgvGKKv3uuGVRBKb4n@entry-1.devnet.tanglebay.com:14646" usage:"list of trusted entry nodes for auto peering"`
+
+	// DNSSeeds defines the config flag of the DNS seeds.
+	DNSSeeds []string `usage:"list of DNS names whose TXT and SRV records announce additional entry nodes"`
+
+	// DNSSeedPublicKey defines the config flag of the public key of the DNS seeds.
+	DNSSeedPublicKey string `usage:"base58 encoded public key that the records of the DNS seeds must be signed with"`
 }
 
 // Parameters contains the configuration parameters of the autopeering peer discovery.
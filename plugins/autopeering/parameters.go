@@ -15,6 +15,12 @@ type ParametersDefinition struct {
 
 	// Ro defines the config flag of Ro.
 	Ro float64 `default:"2.0" usage:"Ro parameter"`
+
+	// InboundNeighborSize defines the config flag of the number of accepted (inbound) neighbors (must be positive).
+	InboundNeighborSize int `default:"4" usage:"the maximum number of accepted (inbound) neighbors (must be positive)"`
+
+	// OutboundNeighborSize defines the config flag of the number of chosen (outbound) neighbors (must be positive).
+	OutboundNeighborSize int `default:"4" usage:"the maximum number of chosen (outbound) neighbors (must be positive)"`
 }
 
 // Parameters contains the configuration parameters of the autopeering plugin.
@@ -15,6 +15,9 @@ import (
 	"github.com/iotaledger/hive.go/node"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/diagnostics"
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/mana"
 	net2 "github.com/iotaledger/goshimmer/packages/net"
@@ -37,7 +40,8 @@ type dependencies struct {
 	dig.In
 
 	Discovery             *discover.Protocol
-	Selection             *selection.Protocol
+	Selection             *neighborselection.Protocol
+	Diagnostics           *diagnostics.Tracker
 	Local                 *peer.Local
 	GossipMgr             *gossip.Manager        `optional:"true"`
 	ManaFunc              mana.ManaRetrievalFunc `optional:"true" name:"manaFunc"`
@@ -56,6 +60,10 @@ func init() {
 			Plugin.Panic(err)
 		}
 
+		if err := container.Provide(createDiagnostics); err != nil {
+			Plugin.Panic(err)
+		}
+
 		if err := container.Provide(func() *node.Plugin {
 			return Plugin
 		}, dig.Name("autopeering")); err != nil {
@@ -84,6 +92,7 @@ func configure(_ *node.Plugin) {
 		Plugin.LogFatalf("could not update services: %s", err)
 	}
 
+	deps.Diagnostics.Attach(deps.Selection.Events())
 	if deps.GossipMgr != nil {
 		configureGossipIntegration()
 	}
@@ -114,6 +123,7 @@ func configureGossipIntegration() {
 		}
 		go func() {
 			if err := mgr.AddInbound(context.Background(), ev.Peer, gossip.NeighborsGroupAuto); err != nil {
+				deps.Diagnostics.SetDropReason(ev.Peer.ID(), diagnostics.DropReasonGossipFailure)
 				deps.Selection.RemoveNeighbor(ev.Peer.ID())
 				Plugin.Logger().Debugw("error adding inbound", "id", ev.Peer.ID(), "err", err)
 			}
@@ -126,6 +136,7 @@ func configureGossipIntegration() {
 		}
 		go func() {
 			if err := mgr.AddOutbound(context.Background(), ev.Peer, gossip.NeighborsGroupAuto); err != nil {
+				deps.Diagnostics.SetDropReason(ev.Peer.ID(), diagnostics.DropReasonGossipFailure)
 				deps.Selection.RemoveNeighbor(ev.Peer.ID())
 				Plugin.Logger().Debugw("error adding outbound", "id", ev.Peer.ID(), "err", err)
 			}
@@ -133,6 +144,7 @@ func configureGossipIntegration() {
 	}))
 
 	mgr.NeighborsEvents(gossip.NeighborsGroupAuto).NeighborRemoved.Attach(events.NewClosure(func(n *gossip.Neighbor) {
+		deps.Diagnostics.SetDropReason(n.ID(), diagnostics.DropReasonGossipDisconnect)
 		deps.Selection.RemoveNeighbor(n.ID())
 	}))
 }
@@ -160,8 +172,8 @@ func configureEvents() {
 			Plugin.Logger().Infof("Peering accepted: %s / %s", ev.Peer.Address(), ev.Peer.ID())
 		}
 	}))
-	deps.Selection.Events().Dropped.Attach(events.NewClosure(func(ev *selection.DroppedEvent) {
-		Plugin.Logger().Infof("Peering dropped: %s", ev.DroppedID)
+	deps.Diagnostics.Events.NeighborDropped.Attach(event.NewClosure(func(drop *diagnostics.Drop) {
+		Plugin.Logger().Infof("Peering dropped: %s (%s)", drop.PeerID, drop.Reason)
 	}))
 }
 
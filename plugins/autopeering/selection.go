@@ -7,13 +7,16 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/selection"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/logger"
+
+	"github.com/iotaledger/goshimmer/packages/autopeering/diagnostics"
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 )
 
-func createPeerSel(localID *peer.Local, nbrDiscover *discover.Protocol) *selection.Protocol {
+func createPeerSel(localID *peer.Local, nbrDiscover *discover.Protocol) (*neighborselection.Protocol, error) {
 	// assure that the logger is available
 	log := logger.NewLogger(PluginName).Named("sel")
 
-	return selection.New(localID, nbrDiscover,
+	return neighborselection.New(localID, nbrDiscover, Parameters.InboundNeighborSize, Parameters.OutboundNeighborSize,
 		selection.Logger(log),
 		selection.NeighborValidator(selection.ValidatorFunc(isValidNeighbor)),
 		selection.UseMana(Parameters.Mana),
@@ -23,6 +26,17 @@ func createPeerSel(localID *peer.Local, nbrDiscover *discover.Protocol) *selecti
 	)
 }
 
+func createDiagnostics(localID *peer.Local, nbrDiscover *discover.Protocol) *diagnostics.Tracker {
+	return diagnostics.NewTracker(localID, Parameters.InboundNeighborSize, Parameters.OutboundNeighborSize, func() (candidates []*peer.Peer) {
+		for _, p := range nbrDiscover.GetVerifiedPeers() {
+			if isValidNeighbor(p) {
+				candidates = append(candidates, p)
+			}
+		}
+		return candidates
+	})
+}
+
 // isValidNeighbor checks whether a peer is a valid neighbor.
 func isValidNeighbor(p *peer.Peer) bool {
 	// gossip must be supported
@@ -5,9 +5,9 @@ import (
 
 	"github.com/iotaledger/hive.go/configuration"
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/node"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/broadcast/server"
@@ -54,7 +54,7 @@ func run(_ *node.Plugin) {
 	}
 
 	// Get Messages from node.
-	notifyNewMsg := events.NewClosure(func(messageID tangle.MessageID) {
+	notifyNewMsg := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			go func() {
 				server.Broadcast([]byte(message.String()))
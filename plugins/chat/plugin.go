@@ -7,6 +7,7 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/chat"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
@@ -38,7 +39,7 @@ type dependencies struct {
 }
 
 func configure(_ *node.Plugin) {
-	deps.Tangle.Booker.Events.MessageBooked.Attach(events.NewClosure(onReceiveMessageFromMessageLayer))
+	deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(onReceiveMessageFromMessageLayer))
 	configureWebAPI()
 }
 
@@ -3,6 +3,7 @@ package chat
 import (
 	"net/http"
 
+	"github.com/cockroachdb/errors"
 	"github.com/labstack/echo"
 
 	"github.com/iotaledger/goshimmer/packages/chat"
@@ -26,19 +27,19 @@ func SendChatMessage(c echo.Context) error {
 	}
 
 	if len(req.From) > maxFromToLength {
-		return c.JSON(http.StatusBadRequest, Response{Error: "sender is too long"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("sender is too long")))
 	}
 	if len(req.To) > maxFromToLength {
-		return c.JSON(http.StatusBadRequest, Response{Error: "receiver is too long"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("receiver is too long")))
 	}
 	if len(req.Message) > maxMessageLength {
-		return c.JSON(http.StatusBadRequest, Response{Error: "message is too long"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("message is too long")))
 	}
 
 	chatPayload := chat.NewPayload(req.From, req.To, req.Message)
 	msg, err := deps.Tangle.IssuePayload(chatPayload)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, Response{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	return c.JSON(http.StatusOK, Response{MessageID: msg.ID().Base58()})
@@ -17,6 +17,9 @@ type ParametersDefinition struct {
 
 	// DisablePlugins is the flag to manually disable node plugins.
 	DisablePlugins []string `usage:"a list of plugins that shall be disabled"`
+
+	// NetworkID is the ID of the network the node belongs to.
+	NetworkID uint32 `default:"0" usage:"the ID of the network the node belongs to (0 disables the network separation)"`
 }
 
 // Parameters contains the configuration parameters of the config plugin.
@@ -9,6 +9,8 @@ import (
 	"github.com/iotaledger/hive.go/node"
 	flag "github.com/spf13/pflag"
 	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 )
 
 // PluginName is the name of the config plugin.
@@ -92,10 +94,19 @@ func fetch(printConfig bool, ignoreSettingsAtPrint ...[]string) error {
 		PrintConfig(ignoreSettingsAtPrint...)
 	}
 
+	// plugins that can be started at runtime are always loaded, so the flags only determine whether they are started at boot
 	for _, pluginName := range Parameters.DisablePlugins {
+		if runtimePlugin, exists := runtimeplugin.Get(pluginName); exists {
+			runtimePlugin.SetEnabledAtBoot(false)
+			continue
+		}
 		node.DisabledPlugins[node.GetPluginIdentifier(pluginName)] = true
 	}
 	for _, pluginName := range Parameters.EnablePlugins {
+		if runtimePlugin, exists := runtimeplugin.Get(pluginName); exists {
+			runtimePlugin.SetEnabledAtBoot(true)
+			continue
+		}
 		node.EnabledPlugins[node.GetPluginIdentifier(pluginName)] = true
 	}
 
@@ -3,6 +3,9 @@ package plugins
 import (
 	"github.com/iotaledger/hive.go/node"
 
+	"github.com/iotaledger/goshimmer/plugins/accounting"
+	"github.com/iotaledger/goshimmer/plugins/analytics"
+	"github.com/iotaledger/goshimmer/plugins/archive"
 	"github.com/iotaledger/goshimmer/plugins/autopeering"
 	"github.com/iotaledger/goshimmer/plugins/banner"
 	"github.com/iotaledger/goshimmer/plugins/cli"
@@ -10,21 +13,31 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/database"
 	"github.com/iotaledger/goshimmer/plugins/drng"
+	"github.com/iotaledger/goshimmer/plugins/epochs"
 	"github.com/iotaledger/goshimmer/plugins/faucet"
 	"github.com/iotaledger/goshimmer/plugins/firewall"
 	"github.com/iotaledger/goshimmer/plugins/gossip"
 	"github.com/iotaledger/goshimmer/plugins/gracefulshutdown"
 	"github.com/iotaledger/goshimmer/plugins/logger"
+	"github.com/iotaledger/goshimmer/plugins/manaauditlog"
 	"github.com/iotaledger/goshimmer/plugins/manaeventlogger"
 	"github.com/iotaledger/goshimmer/plugins/manarefresher"
 	"github.com/iotaledger/goshimmer/plugins/manualpeering"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/goshimmer/plugins/nameregistry"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
+	"github.com/iotaledger/goshimmer/plugins/otvdecisionlog"
+	"github.com/iotaledger/goshimmer/plugins/otvstatementlog"
 	"github.com/iotaledger/goshimmer/plugins/peer"
 	"github.com/iotaledger/goshimmer/plugins/portcheck"
 	"github.com/iotaledger/goshimmer/plugins/pow"
 	"github.com/iotaledger/goshimmer/plugins/profiling"
+	"github.com/iotaledger/goshimmer/plugins/resourcemanager"
 	"github.com/iotaledger/goshimmer/plugins/spammer"
+	"github.com/iotaledger/goshimmer/plugins/statesync"
+	"github.com/iotaledger/goshimmer/plugins/watchlist"
+	"github.com/iotaledger/goshimmer/plugins/webhooks"
+	"github.com/iotaledger/goshimmer/plugins/workerpools"
 )
 
 // Core contains the core plugins of a GoShimmer node.
@@ -42,14 +55,27 @@ var Core = node.Plugins(
 	profiling.Plugin,
 	pow.Plugin,
 	clock.Plugin,
+	workerpools.Plugin,
+	statesync.Plugin,
 	messagelayer.Plugin,
 	gossip.Plugin,
+	resourcemanager.Plugin,
 	firewall.Plugin,
+	epochs.Plugin,
+	archive.Plugin,
+	watchlist.Plugin,
+	nameregistry.Plugin,
+	otvdecisionlog.Plugin,
+	otvstatementlog.Plugin,
 	messagelayer.ManaPlugin,
+	manaauditlog.Plugin,
 	manarefresher.Plugin,
 	drng.Plugin,
 	faucet.Plugin,
 	metrics.Plugin,
+	analytics.Plugin,
+	accounting.Plugin,
 	spammer.Plugin,
 	manaeventlogger.Plugin,
+	webhooks.Plugin,
 )
@@ -0,0 +1,173 @@
+package dagsvisualizer
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	coneDirectionPast   = "past"
+	coneDirectionFuture = "future"
+	coneDirectionBoth   = "both"
+
+	defaultConeDepth = 10
+)
+
+// coneRoute returns the past and/or the future cone of a message up to the requested depth. Every message of the cone
+// is assigned to a layer, so that the frontend can lay out the cone without walking it: the root is in layer 0, the
+// messages of the past cone are in negative layers and the messages of the future cone are in positive layers.
+func coneRoute(c echo.Context) error {
+	root, err := tangle.NewMessageID(c.Param("messageID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	depth := defaultConeDepth
+	if depthParam := c.QueryParam("depth"); depthParam != "" {
+		if depth, err = strconv.Atoi(depthParam); err != nil || depth < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("invalid depth")))
+		}
+	}
+	if depth > Parameters.MaxConeDepth {
+		depth = Parameters.MaxConeDepth
+	}
+
+	direction := c.QueryParam("direction")
+	if direction == "" {
+		direction = coneDirectionBoth
+	}
+	if direction != coneDirectionPast && direction != coneDirectionFuture && direction != coneDirectionBoth {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("invalid direction")))
+	}
+
+	if !deps.Tangle.Storage.Message(root).Consume(func(*tangle.Message) {}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("message not found")))
+	}
+
+	layers := map[tangle.MessageID]int{root: 0}
+	truncated := false
+	if direction != coneDirectionFuture {
+		pastLayers, pastTruncated := layeredCone(root, depth, Parameters.MaxConeSize, parents)
+		for messageID, layer := range pastLayers {
+			layers[messageID] = -layer
+		}
+		truncated = truncated || pastTruncated
+	}
+	if direction != coneDirectionPast {
+		futureLayers, futureTruncated := layeredCone(root, depth, Parameters.MaxConeSize, approvers)
+		for messageID, layer := range futureLayers {
+			layers[messageID] = layer
+		}
+		truncated = truncated || futureTruncated
+	}
+
+	result := coneResult{
+		Root:      root.Base58(),
+		Messages:  make([]*coneVertex, 0, len(layers)),
+		Truncated: truncated,
+	}
+	for messageID, layer := range layers {
+		if vertex := newTangleVertex(messageID); vertex != nil {
+			result.Messages = append(result.Messages, &coneVertex{tangleVertex: vertex, Layer: layer})
+		}
+	}
+	sort.Slice(result.Messages, func(i, j int) bool {
+		if result.Messages[i].Layer != result.Messages[j].Layer {
+			return result.Messages[i].Layer < result.Messages[j].Layer
+		}
+		return result.Messages[i].ID < result.Messages[j].ID
+	})
+
+	return c.JSON(http.StatusOK, result)
+}
+
+// layeredCone collects the messages that can be reached from the root by following the given edges at most depth
+// times and assigns each of them to the layer that equals the length of the longest path from the root within the
+// cone. This guarantees that every edge of the cone points from a lower to a higher layer. At most maxSize messages are
+// collected; truncated is true if the cone was larger.
+func layeredCone(root tangle.MessageID, depth, maxSize int, edges func(tangle.MessageID) tangle.MessageIDs) (layers map[tangle.MessageID]int, truncated bool) {
+	// collect the cone breadth-first, so that the messages closest to the root are kept when it is truncated
+	distances := map[tangle.MessageID]int{root: 0}
+	neighbors := make(map[tangle.MessageID]tangle.MessageIDs)
+	for queue := []tangle.MessageID{root}; len(queue) != 0; queue = queue[1:] {
+		messageID := queue[0]
+		neighbors[messageID] = edges(messageID)
+		if distances[messageID] == depth {
+			continue
+		}
+
+		for neighbor := range neighbors[messageID] {
+			if _, collected := distances[neighbor]; collected {
+				continue
+			}
+			if len(distances) >= maxSize {
+				truncated = true
+				continue
+			}
+
+			distances[neighbor] = distances[messageID] + 1
+			queue = append(queue, neighbor)
+		}
+	}
+
+	// assign the layers in topological order
+	pendingEdges := make(map[tangle.MessageID]int)
+	for messageID := range distances {
+		for neighbor := range neighbors[messageID] {
+			if _, collected := distances[neighbor]; collected {
+				pendingEdges[neighbor]++
+			}
+		}
+	}
+
+	layers = map[tangle.MessageID]int{root: 0}
+	for queue := []tangle.MessageID{root}; len(queue) != 0; queue = queue[1:] {
+		messageID := queue[0]
+		for neighbor := range neighbors[messageID] {
+			if _, collected := distances[neighbor]; !collected {
+				continue
+			}
+
+			if layers[messageID]+1 > layers[neighbor] {
+				layers[neighbor] = layers[messageID] + 1
+			}
+			if pendingEdges[neighbor]--; pendingEdges[neighbor] == 0 {
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+	delete(layers, root)
+
+	return layers, truncated
+}
+
+// parents returns the parents of all types of the given message.
+func parents(messageID tangle.MessageID) (parentIDs tangle.MessageIDs) {
+	parentIDs = tangle.NewMessageIDs()
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		message.ForEachParent(func(parent tangle.Parent) {
+			if parent.ID != tangle.EmptyMessageID {
+				parentIDs.Add(parent.ID)
+			}
+		})
+	})
+
+	return parentIDs
+}
+
+// approvers returns the messages that approve the given message.
+func approvers(messageID tangle.MessageID) (approverIDs tangle.MessageIDs) {
+	approverIDs = tangle.NewMessageIDs()
+	deps.Tangle.Storage.Approvers(messageID).Consume(func(approver *tangle.Approver) {
+		approverIDs.Add(approver.ApproverMessageID())
+	})
+
+	return approverIDs
+}
@@ -0,0 +1,44 @@
+package dagsvisualizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestLayeredCone(t *testing.T) {
+	root, a, b, c, d := newMessageID(1), newMessageID(2), newMessageID(3), newMessageID(4), newMessageID(5)
+	edges := map[tangle.MessageID]tangle.MessageIDs{
+		root: tangle.NewMessageIDs(a, b, c),
+		a:    tangle.NewMessageIDs(c),
+		b:    tangle.NewMessageIDs(c),
+		c:    tangle.NewMessageIDs(d),
+	}
+	edgesFunc := func(messageID tangle.MessageID) tangle.MessageIDs {
+		if messageIDs, exists := edges[messageID]; exists {
+			return messageIDs
+		}
+		return tangle.NewMessageIDs()
+	}
+
+	// c is a direct neighbor of the root, but it is placed behind a and b as it is also reachable through them
+	layers, truncated := layeredCone(root, 1, 100, edgesFunc)
+	assert.False(t, truncated)
+	assert.Equal(t, map[tangle.MessageID]int{a: 1, b: 1, c: 2}, layers)
+
+	layers, truncated = layeredCone(root, 3, 100, edgesFunc)
+	assert.False(t, truncated)
+	assert.Equal(t, map[tangle.MessageID]int{a: 1, b: 1, c: 2, d: 3}, layers)
+
+	layers, truncated = layeredCone(root, 3, 3, edgesFunc)
+	assert.True(t, truncated)
+	assert.Len(t, layers, 2)
+}
+
+func newMessageID(id byte) (messageID tangle.MessageID) {
+	messageID[0] = id
+
+	return messageID
+}
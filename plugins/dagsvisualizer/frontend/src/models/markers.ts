@@ -0,0 +1,24 @@
+export class markersResult {
+    sequences: Array<markerSequenceVertex>;
+    error: string;
+}
+
+export class markerSequenceVertex {
+    ID: number;
+    lowestIndex: number;
+    highestIndex: number;
+    referencedSequenceIDs: Array<number>;
+    markers: Array<markerVertex>;
+}
+
+export class markerVertex {
+    index: number;
+    messageID: string;
+    branchIDs: Array<string>;
+    referencedMarkers: Array<markerReference>;
+}
+
+export class markerReference {
+    sequenceID: number;
+    index: number;
+}
@@ -14,6 +14,12 @@ type ParametersDefinition struct {
 
 	// DevBindAddress defines the config flag of the dags visualizer binding address in development mode.
 	DevBindAddress string `default:"0.0.0.0:3000" usage:"the bind address of the dags visualizer in develop mode"`
+
+	// MaxConeDepth defines the maximum depth of the cones that are returned by the cone API.
+	MaxConeDepth int `default:"50" usage:"the maximum depth of the cones that are returned by the cone API"`
+
+	// MaxConeSize defines the maximum number of messages of a past or future cone that are returned by the cone API.
+	MaxConeSize int `default:"2000" usage:"the maximum number of messages of a past or future cone that are returned by the cone API"`
 }
 
 // Parameters contains the configuration parameters of the dags visualizer plugin.
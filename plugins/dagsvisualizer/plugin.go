@@ -3,6 +3,7 @@ package dagsvisualizer
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -14,6 +15,7 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/finality"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
@@ -25,8 +27,11 @@ var (
 	deps = new(dependencies)
 	// Plugin is the plugin instance of the dashboard plugin.
 	Plugin *node.Plugin
-	log    *logger.Logger
-	server *echo.Echo
+	// RuntimePlugin allows to start and stop the dags visualizer while the node is running.
+	RuntimePlugin *runtimeplugin.Plugin
+	log           *logger.Logger
+	server        *echo.Echo
+	serverWG      sync.WaitGroup
 )
 
 type dependencies struct {
@@ -38,11 +43,11 @@ type dependencies struct {
 
 func init() {
 	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	RuntimePlugin = runtimeplugin.New(PluginName, node.Enabled, start, stop)
 }
 
 func configure(plugin *node.Plugin) {
 	log = logger.NewLogger(plugin.Name)
-	configureServer()
 }
 
 func configureServer() {
@@ -61,38 +66,50 @@ func configureServer() {
 }
 
 func run(plugin *node.Plugin) {
-	runVisualizer()
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		if err := RuntimePlugin.Boot(); err != nil {
+			log.Errorf("Failed to start: %s", err)
+		}
+
+		<-ctx.Done()
 
-	plugin.LogInfof("Starting %s ...", PluginName)
-	if err := daemon.BackgroundWorker(PluginName, worker, shutdown.PriorityDashboard); err != nil {
+		RuntimePlugin.Shutdown()
+	}, shutdown.PriorityDashboard); err != nil {
 		plugin.Panicf("Error starting as daemon: %s", err)
 	}
 }
 
-func worker(ctx context.Context) {
-	defer log.Infof("Stopping %s ... done", PluginName)
+// start subscribes the visualizer to the events of the Tangle and starts the server.
+func start() error {
+	log.Infof("Starting %s ...", PluginName)
+
+	configureServer()
+	runVisualizer()
 
-	stopped := make(chan struct{})
+	serverWG.Add(1)
 	go func() {
+		defer serverWG.Done()
+
 		log.Infof("%s started, bind-address=%s", PluginName, Parameters.BindAddress)
-		if err := server.Start(Parameters.BindAddress); err != nil {
-			if !errors.Is(err, http.ErrServerClosed) {
-				log.Errorf("Error serving: %s", err)
-			}
-			close(stopped)
+		if err := server.Start(Parameters.BindAddress); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("Error serving: %s", err)
 		}
 	}()
 
-	// stop if we are shutting down or the server could not be started
-	select {
-	case <-ctx.Done():
-	case <-stopped:
-	}
+	return nil
+}
 
+// stop stops the server, disconnects the websocket clients and unsubscribes the visualizer from the events.
+func stop() {
 	log.Infof("Stopping %s ...", PluginName)
+	defer log.Infof("Stopping %s ... done", PluginName)
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
 	if err := server.Shutdown(ctx); err != nil {
 		log.Errorf("Error stopping: %s", err)
 	}
+	serverWG.Wait()
+
+	stopVisualizer()
 }
@@ -116,3 +116,40 @@ type searchResult struct {
 	Branches []*branchVertex `json:"branches"`
 	Error    string          `json:"error,omitempty"`
 }
+
+type markerSequenceVertex struct {
+	ID                    uint64          `json:"ID"`
+	LowestIndex           uint64          `json:"lowestIndex"`
+	HighestIndex          uint64          `json:"highestIndex"`
+	ReferencedSequenceIDs []uint64        `json:"referencedSequenceIDs"`
+	Markers               []*markerVertex `json:"markers"`
+}
+
+type markerVertex struct {
+	Index             uint64             `json:"index"`
+	MessageID         string             `json:"messageID"`
+	BranchIDs         []string           `json:"branchIDs"`
+	ReferencedMarkers []*markerReference `json:"referencedMarkers"`
+}
+
+type markerReference struct {
+	SequenceID uint64 `json:"sequenceID"`
+	Index      uint64 `json:"index"`
+}
+
+type markersResult struct {
+	Sequences []*markerSequenceVertex `json:"sequences"`
+	Error     string                  `json:"error,omitempty"`
+}
+
+type coneVertex struct {
+	*tangleVertex
+	Layer int `json:"layer"`
+}
+
+type coneResult struct {
+	Root      string        `json:"root"`
+	Messages  []*coneVertex `json:"messages"`
+	Truncated bool          `json:"truncated"`
+	Error     string        `json:"error,omitempty"`
+}
@@ -1,21 +1,22 @@
 package dagsvisualizer
 
 import (
-	"context"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
 
-	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/generics/walker"
+	"github.com/iotaledger/hive.go/types"
 	"github.com/iotaledger/hive.go/workerpool"
 	"github.com/labstack/echo"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
-	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/markers"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
@@ -24,8 +25,12 @@ var (
 	visualizerWorkerQueueSize = 500
 	visualizerWorkerPool      *workerpool.NonBlockingQueuedWorkerPool
 	maxWsMessageBufferSize    = 200
+	maxMarkerSequenceRange    = 100
 	buffer                    []*wsMessage
 	bufferMutex               sync.RWMutex
+
+	// detachEvents contains the functions that detach the visualizer from the events it is registered to.
+	detachEvents []func()
 )
 
 func setupVisualizer() {
@@ -36,23 +41,28 @@ func setupVisualizer() {
 }
 
 func runVisualizer() {
-	if err := daemon.BackgroundWorker("Dags Visualizer[Visualizer]", func(ctx context.Context) {
-		// register to events
-		registerTangleEvents()
-		registerUTXOEvents()
-		registerBranchEvents()
-
-		<-ctx.Done()
-		log.Info("Stopping DAGs Visualizer ...")
-		visualizerWorkerPool.Stop()
-		log.Info("Stopping DAGs Visualizer ... done")
-	}, shutdown.PriorityDashboard); err != nil {
-		log.Panicf("Failed to start as daemon: %s", err)
+	// register to events
+	registerTangleEvents()
+	registerUTXOEvents()
+	registerBranchEvents()
+}
+
+func stopVisualizer() {
+	for _, detachEvent := range detachEvents {
+		detachEvent()
 	}
+	detachEvents = nil
+
+	disconnectWsClients()
+	visualizerWorkerPool.Stop()
+
+	bufferMutex.Lock()
+	defer bufferMutex.Unlock()
+	buffer = nil
 }
 
 func registerTangleEvents() {
-	storeClosure := events.NewClosure(func(messageID tangle.MessageID) {
+	storeClosure := event.NewClosure(func(messageID tangle.MessageID) {
 		wsMsg := &wsMessage{
 			Type: MsgTypeTangleVertex,
 			Data: newTangleVertex(messageID),
@@ -61,7 +71,7 @@ func registerTangleEvents() {
 		storeWsMessage(wsMsg)
 	})
 
-	bookedClosure := events.NewClosure(func(messageID tangle.MessageID) {
+	bookedClosure := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(msgMetadata *tangle.MessageMetadata) {
 			branchIDs, err := deps.Tangle.Booker.MessageBranchIDs(messageID)
 			if err != nil {
@@ -81,7 +91,7 @@ func registerTangleEvents() {
 		})
 	})
 
-	msgConfirmedClosure := events.NewClosure(func(messageID tangle.MessageID) {
+	msgConfirmedClosure := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(msgMetadata *tangle.MessageMetadata) {
 			wsMsg := &wsMessage{
 				Type: MsgTypeTangleConfirmed,
@@ -96,7 +106,7 @@ func registerTangleEvents() {
 		})
 	})
 
-	fmUpdateClosure := events.NewClosure(func(fmUpdate *tangle.FutureMarkerUpdate) {
+	fmUpdateClosure := event.NewClosure(func(fmUpdate *tangle.FutureMarkerUpdate) {
 		wsMsg := &wsMessage{
 			Type: MsgTypeFutureMarkerUpdated,
 			Data: &tangleFutureMarkerUpdated{
@@ -112,10 +122,17 @@ func registerTangleEvents() {
 	deps.Tangle.Booker.Events.MessageBooked.Attach(bookedClosure)
 	deps.Tangle.Booker.MarkersManager.Events.FutureMarkerUpdated.Attach(fmUpdateClosure)
 	deps.FinalityGadget.Events().MessageConfirmed.Attach(msgConfirmedClosure)
+
+	detachEvents = append(detachEvents, func() {
+		deps.Tangle.Storage.Events.MessageStored.Detach(storeClosure)
+		deps.Tangle.Booker.Events.MessageBooked.Detach(bookedClosure)
+		deps.Tangle.Booker.MarkersManager.Events.FutureMarkerUpdated.Detach(fmUpdateClosure)
+		deps.FinalityGadget.Events().MessageConfirmed.Detach(msgConfirmedClosure)
+	})
 }
 
 func registerUTXOEvents() {
-	storeClosure := events.NewClosure(func(messageID tangle.MessageID) {
+	storeClosure := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(msg *tangle.Message) {
 			if msg.Payload().Type() == ledgerstate.TransactionType {
 				tx := msg.Payload().(*ledgerstate.Transaction)
@@ -129,7 +146,7 @@ func registerUTXOEvents() {
 		})
 	})
 
-	bookedClosure := events.NewClosure(func(messageID tangle.MessageID) {
+	bookedClosure := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			if message.Payload().Type() == ledgerstate.TransactionType {
 				tx := message.Payload().(*ledgerstate.Transaction)
@@ -152,7 +169,7 @@ func registerUTXOEvents() {
 		})
 	})
 
-	txConfirmedClosure := events.NewClosure(func(txID ledgerstate.TransactionID) {
+	txConfirmedClosure := event.NewClosure(func(txID ledgerstate.TransactionID) {
 		deps.Tangle.LedgerState.TransactionMetadata(txID).Consume(func(txMetadata *ledgerstate.TransactionMetadata) {
 			wsMsg := &wsMessage{
 				Type: MsgTypeUTXOConfirmed,
@@ -170,10 +187,16 @@ func registerUTXOEvents() {
 	deps.Tangle.Storage.Events.MessageStored.Attach(storeClosure)
 	deps.Tangle.Booker.Events.MessageBooked.Attach(bookedClosure)
 	deps.FinalityGadget.Events().TransactionConfirmed.Attach(txConfirmedClosure)
+
+	detachEvents = append(detachEvents, func() {
+		deps.Tangle.Storage.Events.MessageStored.Detach(storeClosure)
+		deps.Tangle.Booker.Events.MessageBooked.Detach(bookedClosure)
+		deps.FinalityGadget.Events().TransactionConfirmed.Detach(txConfirmedClosure)
+	})
 }
 
 func registerBranchEvents() {
-	createdClosure := events.NewClosure(func(branchID ledgerstate.BranchID) {
+	createdClosure := event.NewClosure(func(branchID ledgerstate.BranchID) {
 		wsMsg := &wsMessage{
 			Type: MsgTypeBranchVertex,
 			Data: newBranchVertex(branchID),
@@ -182,7 +205,7 @@ func registerBranchEvents() {
 		storeWsMessage(wsMsg)
 	})
 
-	parentUpdateClosure := events.NewClosure(func(parentUpdate *ledgerstate.BranchParentUpdate) {
+	parentUpdateClosure := event.NewClosure(func(parentUpdate *ledgerstate.BranchParentUpdate) {
 		wsMsg := &wsMessage{
 			Type: MsgTypeBranchParentsUpdate,
 			Data: &branchParentUpdate{
@@ -194,7 +217,7 @@ func registerBranchEvents() {
 		storeWsMessage(wsMsg)
 	})
 
-	branchConfirmedClosure := events.NewClosure(func(branchID ledgerstate.BranchID) {
+	branchConfirmedClosure := event.NewClosure(func(branchID ledgerstate.BranchID) {
 		wsMsg := &wsMessage{
 			Type: MsgTypeBranchConfirmed,
 			Data: &branchConfirmed{
@@ -205,7 +228,7 @@ func registerBranchEvents() {
 		storeWsMessage(wsMsg)
 	})
 
-	branchWeightChangedClosure := events.NewClosure(func(e *tangle.BranchWeightChangedEvent) {
+	branchWeightChangedClosure := event.NewClosure(func(e *tangle.BranchWeightChangedEvent) {
 		branchGoF, _ := deps.Tangle.LedgerState.UTXODAG.BranchGradeOfFinality(e.BranchID)
 		wsMsg := &wsMessage{
 			Type: MsgTypeBranchWeightChanged,
@@ -223,6 +246,13 @@ func registerBranchEvents() {
 	deps.FinalityGadget.Events().BranchConfirmed.Attach(branchConfirmedClosure)
 	deps.Tangle.LedgerState.BranchDAG.Events.BranchParentsUpdated.Attach(parentUpdateClosure)
 	deps.Tangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(branchWeightChangedClosure)
+
+	detachEvents = append(detachEvents, func() {
+		deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Detach(createdClosure)
+		deps.FinalityGadget.Events().BranchConfirmed.Detach(branchConfirmedClosure)
+		deps.Tangle.LedgerState.BranchDAG.Events.BranchParentsUpdated.Detach(parentUpdateClosure)
+		deps.Tangle.ApprovalWeightManager.Events.BranchWeightChanged.Detach(branchWeightChangedClosure)
+	})
 }
 
 func setupDagsVisualizerRoutes(routeGroup *echo.Group) {
@@ -250,7 +280,7 @@ func setupDagsVisualizerRoutes(routeGroup *echo.Group) {
 
 		reqValid := isTimeIntervalValid(startTimestamp, endTimestamp)
 		if !reqValid {
-			return c.JSON(http.StatusBadRequest, searchResult{Error: "invalid timestamp range"})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("invalid timestamp range")))
 		}
 
 		messages := []*tangleVertex{}
@@ -302,6 +332,25 @@ func setupDagsVisualizerRoutes(routeGroup *echo.Group) {
 
 		return c.JSON(http.StatusOK, searchResult{Messages: messages, Txs: txs, Branches: branches})
 	})
+
+	routeGroup.GET("/dagsvisualizer/markers/:startSequenceID/:endSequenceID", func(c echo.Context) (err error) {
+		startSequenceID, startErr := strconv.ParseUint(c.Param("startSequenceID"), 10, 64)
+		endSequenceID, endErr := strconv.ParseUint(c.Param("endSequenceID"), 10, 64)
+		if startErr != nil || endErr != nil || startSequenceID > endSequenceID || endSequenceID-startSequenceID >= uint64(maxMarkerSequenceRange) {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("invalid sequence ID range")))
+		}
+
+		sequences := []*markerSequenceVertex{}
+		for sequenceID := startSequenceID; sequenceID <= endSequenceID; sequenceID++ {
+			deps.Tangle.Booker.MarkersManager.Sequence(markers.SequenceID(sequenceID)).Consume(func(sequence *markers.Sequence) {
+				sequences = append(sequences, newMarkerSequenceVertex(sequence))
+			})
+		}
+
+		return c.JSON(http.StatusOK, markersResult{Sequences: sequences})
+	})
+
+	routeGroup.GET("/dagsvisualizer/cone/:messageID", coneRoute)
 }
 
 func parseStringToTimestamp(str string) (t time.Time) {
@@ -411,6 +460,51 @@ func newBranchVertex(branchID ledgerstate.BranchID) (ret *branchVertex) {
 	return
 }
 
+func newMarkerSequenceVertex(sequence *markers.Sequence) (ret *markerSequenceVertex) {
+	ret = &markerSequenceVertex{
+		ID:                    uint64(sequence.ID()),
+		LowestIndex:           uint64(sequence.LowestIndex()),
+		HighestIndex:          uint64(sequence.HighestIndex()),
+		ReferencedSequenceIDs: []uint64{},
+		Markers:               []*markerVertex{},
+	}
+
+	referencedSequenceIDs := markers.NewSequenceIDs()
+	for index := sequence.LowestIndex(); index <= sequence.HighestIndex(); index++ {
+		marker := markers.NewMarker(sequence.ID(), index)
+		vertex := &markerVertex{
+			Index:             uint64(index),
+			MessageID:         deps.Tangle.Booker.MarkersManager.MessageID(marker).Base58(),
+			BranchIDs:         []string{},
+			ReferencedMarkers: []*markerReference{},
+		}
+		if branchIDs, err := deps.Tangle.Booker.MarkersManager.PendingBranchIDs(marker); err == nil {
+			vertex.BranchIDs = branchIDs.Base58()
+		}
+
+		sequence.ReferencedMarkers(index).ForEachSorted(func(referencedSequenceID markers.SequenceID, referencedIndex markers.Index) bool {
+			vertex.ReferencedMarkers = append(vertex.ReferencedMarkers, &markerReference{
+				SequenceID: uint64(referencedSequenceID),
+				Index:      uint64(referencedIndex),
+			})
+			referencedSequenceIDs[referencedSequenceID] = types.Void
+
+			return true
+		})
+
+		ret.Markers = append(ret.Markers, vertex)
+	}
+
+	for referencedSequenceID := range referencedSequenceIDs {
+		ret.ReferencedSequenceIDs = append(ret.ReferencedSequenceIDs, uint64(referencedSequenceID))
+	}
+	sort.Slice(ret.ReferencedSequenceIDs, func(i, j int) bool {
+		return ret.ReferencedSequenceIDs[i] < ret.ReferencedSequenceIDs[j]
+	})
+
+	return ret
+}
+
 func storeWsMessage(msg *wsMessage) {
 	bufferMutex.Lock()
 	defer bufferMutex.Unlock()
@@ -35,6 +35,9 @@ type wsclient struct {
 	channel chan interface{}
 	// a channel which is closed when the websocket client is disconnected.
 	exit chan struct{}
+	// a channel which is closed when the websocket client should be disconnected by the server.
+	disconnect     chan struct{}
+	disconnectOnce sync.Once
 }
 
 // reigsters and creates a new websocket client.
@@ -45,8 +48,9 @@ func registerWSClient() (uint64, *wsclient) {
 
 	clientID := nextWsClientID
 	wsClient := &wsclient{
-		channel: make(chan interface{}, chanLen),
-		exit:    make(chan struct{}),
+		channel:    make(chan interface{}, chanLen),
+		exit:       make(chan struct{}),
+		disconnect: make(chan struct{}),
 	}
 	wsClients[clientID] = wsClient
 	nextWsClientID++
@@ -66,6 +70,18 @@ func removeWsClient(clientID uint64) {
 	close(wsClient.channel)
 }
 
+// disconnects all websocket clients.
+func disconnectWsClients() {
+	wsClientsMu.RLock()
+	defer wsClientsMu.RUnlock()
+
+	for _, wsClient := range wsClients {
+		wsClient.disconnectOnce.Do(func() {
+			close(wsClient.disconnect)
+		})
+	}
+}
+
 // broadcasts the given message to all connected websocket clients.
 func broadcastWsMessage(msg interface{}, dontDrop ...bool) {
 	wsClientsMu.RLock()
@@ -110,7 +126,13 @@ func websocketRoute(c echo.Context) error {
 	sendInitialData(ws)
 
 	for {
-		msg := <-wsClient.channel
+		var msg interface{}
+		select {
+		case msg = <-wsClient.channel:
+		case <-wsClient.disconnect:
+			return nil
+		}
+
 		if err := ws.SetWriteDeadline(time.Now().Add(webSocketWriteTimeout)); err != nil {
 			break
 		}
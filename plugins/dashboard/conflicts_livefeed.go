@@ -8,12 +8,12 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/workerpool"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
@@ -114,8 +114,8 @@ func runConflictLiveFeed() {
 			conflictHeap: &timeHeap{},
 		}
 
-		onBranchCreatedClosure := events.NewClosure(onBranchCreated)
-		onBranchWeightChangedClosure := events.NewClosure(onBranchWeightChanged)
+		onBranchCreatedClosure := event.NewClosure(onBranchCreated)
+		onBranchWeightChangedClosure := event.NewClosure(onBranchWeightChanged)
 		deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(onBranchCreatedClosure)
 		deps.Tangle.ApprovalWeightManager.Events.BranchWeightChanged.AttachAfter(onBranchWeightChangedClosure)
 
@@ -4,9 +4,9 @@ import (
 	"context"
 
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/workerpool"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
@@ -28,7 +28,11 @@ func configureLiveFeed() {
 }
 
 func runLiveFeed() {
-	notifyNewMsg := events.NewClosure(func(messageID tangle.MessageID) {
+	notifyNewMsg := event.NewClosure(func(messageID tangle.MessageID) {
+		if !hasWsClients() {
+			return
+		}
+
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			liveFeedWorkerPool.TrySubmit(message)
 		})
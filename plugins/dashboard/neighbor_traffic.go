@@ -0,0 +1,254 @@
+package dashboard
+
+import (
+	"context"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer/service"
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var neighborTraffic *neighborTrafficTracker
+
+func configureNeighborTraffic() {
+	neighborTraffic = newNeighborTrafficTracker(Parameters.NeighborTraffic.HistorySize)
+}
+
+func runNeighborTraffic() {
+	if err := daemon.BackgroundWorker("Dashboard[NeighborTraffic]", func(ctx context.Context) {
+		onBytesRejectedClosure := event.NewClosure(func(event *tangle.BytesRejectedEvent) {
+			if event.Peer != nil {
+				neighborTraffic.countRejection(event.Peer.ID(), errors.Is(event.Error, tangle.ErrReceivedDuplicateBytes))
+			}
+		})
+		onMessageRejectedClosure := event.NewClosure(func(event *tangle.MessageRejectedEvent) {
+			if event.Peer != nil {
+				neighborTraffic.countRejection(event.Peer.ID(), false)
+			}
+		})
+		deps.Tangle.Parser.Events.BytesRejected.Attach(onBytesRejectedClosure)
+		deps.Tangle.Parser.Events.MessageRejected.Attach(onMessageRejectedClosure)
+
+		<-ctx.Done()
+
+		log.Info("Stopping Dashboard[NeighborTraffic] ...")
+		deps.Tangle.Parser.Events.BytesRejected.Detach(onBytesRejectedClosure)
+		deps.Tangle.Parser.Events.MessageRejected.Detach(onMessageRejectedClosure)
+		log.Info("Stopping Dashboard[NeighborTraffic] ... done")
+	}, shutdown.PriorityDashboard); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// neighborTrafficCounters returns the current traffic counters of all neighbors.
+func neighborTrafficCounters() (counters map[identity.ID]*neighborTrafficCounter) {
+	counters = make(map[identity.ID]*neighborTrafficCounter)
+	if deps.GossipMgr == nil {
+		return counters
+	}
+
+	for _, neighbor := range deps.GossipMgr.AllNeighbors() {
+		host := neighbor.Peer.IP().String()
+		port := neighbor.Peer.Services().Get(service.GossipKey).Port()
+		counters[neighbor.Peer.ID()] = &neighborTrafficCounter{
+			Address:          net.JoinHostPort(host, strconv.Itoa(port)),
+			ConnectedSince:   neighbor.ConnectionEstablished(),
+			MessagesReceived: neighbor.MessagesRead(),
+			MessagesSent:     neighbor.MessagesWritten(),
+			RequestsReceived: neighbor.MessageRequestsReceived(),
+			RequestHits:      neighbor.MessageRequestHits(),
+			BytesReceived:    neighbor.BytesRead(),
+			BytesSent:        neighbor.BytesWritten(),
+		}
+	}
+
+	return counters
+}
+
+// region neighborTrafficTracker ///////////////////////////////////////////////////////////////////////////////////////
+
+// neighborTrafficTracker keeps a window of per-second samples of the traffic of every neighbor, so that operators can
+// tell which neighbors deliver useful messages and which ones mostly send duplicates or invalid data.
+type neighborTrafficTracker struct {
+	historySize int
+	neighbors   map[identity.ID]*neighborTrafficHistory
+	mutex       sync.RWMutex
+}
+
+// newNeighborTrafficTracker creates a neighborTrafficTracker that keeps the given number of samples per neighbor.
+func newNeighborTrafficTracker(historySize int) *neighborTrafficTracker {
+	return &neighborTrafficTracker{
+		historySize: historySize,
+		neighbors:   make(map[identity.ID]*neighborTrafficHistory),
+	}
+}
+
+// countRejection counts a message of the given neighbor that was rejected by the parser. Rejections of peers that are
+// not tracked as neighbors (e.g. the node itself) are ignored.
+func (n *neighborTrafficTracker) countRejection(neighborID identity.ID, duplicate bool) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	neighbor, exists := n.neighbors[neighborID]
+	if !exists {
+		return
+	}
+
+	if duplicate {
+		neighbor.duplicates++
+		return
+	}
+	neighbor.invalids++
+}
+
+// update takes a sample of the given counters of the current neighbors and returns the totals and the latest sample of
+// every neighbor. The history of neighbors that are no longer connected or that reconnected is reset.
+func (n *neighborTrafficTracker) update(now time.Time, counters map[identity.ID]*neighborTrafficCounter) (metrics []*neighborTrafficMetric) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	for neighborID, neighbor := range n.neighbors {
+		if counter, exists := counters[neighborID]; !exists || !counter.ConnectedSince.Equal(neighbor.total.ConnectedSince) {
+			delete(n.neighbors, neighborID)
+		}
+	}
+
+	metrics = make([]*neighborTrafficMetric, 0, len(counters))
+	for neighborID, counter := range counters {
+		neighbor, exists := n.neighbors[neighborID]
+		if !exists {
+			neighbor = &neighborTrafficHistory{total: *counter}
+			n.neighbors[neighborID] = neighbor
+		}
+
+		counter.Duplicates = neighbor.duplicates
+		counter.Invalids = neighbor.invalids
+		neighbor.addSample(&neighborTrafficSample{
+			Time:                   now.Unix(),
+			neighborTrafficCounter: counter.sub(&neighbor.total),
+		}, n.historySize)
+		neighbor.total = *counter
+
+		metrics = append(metrics, neighbor.metric(neighborID, false))
+	}
+	sortNeighborTrafficMetrics(metrics)
+
+	return metrics
+}
+
+// history returns the totals and the full window of samples of every neighbor.
+func (n *neighborTrafficTracker) history() (metrics []*neighborTrafficMetric) {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	metrics = make([]*neighborTrafficMetric, 0, len(n.neighbors))
+	for neighborID, neighbor := range n.neighbors {
+		metrics = append(metrics, neighbor.metric(neighborID, true))
+	}
+	sortNeighborTrafficMetrics(metrics)
+
+	return metrics
+}
+
+// sortNeighborTrafficMetrics orders the given metrics by the ID of their neighbor.
+func sortNeighborTrafficMetrics(metrics []*neighborTrafficMetric) {
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].ID < metrics[j].ID
+	})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region neighborTrafficHistory ///////////////////////////////////////////////////////////////////////////////////////
+
+// neighborTrafficHistory contains the totals and the recent samples of the traffic of a single neighbor.
+type neighborTrafficHistory struct {
+	total      neighborTrafficCounter
+	samples    []*neighborTrafficSample
+	duplicates uint64
+	invalids   uint64
+}
+
+// addSample appends the given sample and drops the oldest one if the window is full.
+func (n *neighborTrafficHistory) addSample(sample *neighborTrafficSample, historySize int) {
+	if len(n.samples) >= historySize {
+		n.samples = n.samples[len(n.samples)-historySize+1:]
+	}
+	n.samples = append(n.samples, sample)
+}
+
+// metric returns the neighborTrafficMetric of the neighbor with either the latest or all samples.
+func (n *neighborTrafficHistory) metric(neighborID identity.ID, fullHistory bool) *neighborTrafficMetric {
+	metric := &neighborTrafficMetric{
+		ID:    neighborID.String(),
+		Total: n.total,
+	}
+
+	switch {
+	case fullHistory:
+		metric.History = make([]*neighborTrafficSample, len(n.samples))
+		copy(metric.History, n.samples)
+	case len(n.samples) != 0:
+		metric.History = n.samples[len(n.samples)-1:]
+	}
+
+	return metric
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region neighborTrafficMetric ////////////////////////////////////////////////////////////////////////////////////////
+
+// neighborTrafficMetric is the data of the MsgTypeNeighborTrafficMetric message. It contains the totals of a neighbor
+// and either its latest sample or, when a client connects, all samples of the window.
+type neighborTrafficMetric struct {
+	ID      string                   `json:"id"`
+	Total   neighborTrafficCounter   `json:"total"`
+	History []*neighborTrafficSample `json:"history"`
+}
+
+// neighborTrafficSample contains the traffic of a neighbor since the previous sample.
+type neighborTrafficSample struct {
+	Time int64 `json:"time"`
+	neighborTrafficCounter
+}
+
+// neighborTrafficCounter contains the traffic counters of a neighbor.
+type neighborTrafficCounter struct {
+	Address          string    `json:"address,omitempty"`
+	ConnectedSince   time.Time `json:"-"`
+	MessagesReceived uint64    `json:"messagesReceived"`
+	MessagesSent     uint64    `json:"messagesSent"`
+	Duplicates       uint64    `json:"duplicates"`
+	Invalids         uint64    `json:"invalids"`
+	RequestsReceived uint64    `json:"requestsReceived"`
+	RequestHits      uint64    `json:"requestHits"`
+	BytesReceived    uint64    `json:"bytesReceived"`
+	BytesSent        uint64    `json:"bytesSent"`
+}
+
+// sub returns the difference of the counters to the given earlier counters.
+func (n *neighborTrafficCounter) sub(earlier *neighborTrafficCounter) neighborTrafficCounter {
+	return neighborTrafficCounter{
+		MessagesReceived: n.MessagesReceived - earlier.MessagesReceived,
+		MessagesSent:     n.MessagesSent - earlier.MessagesSent,
+		Duplicates:       n.Duplicates - earlier.Duplicates,
+		Invalids:         n.Invalids - earlier.Invalids,
+		RequestsReceived: n.RequestsReceived - earlier.RequestsReceived,
+		RequestHits:      n.RequestHits - earlier.RequestHits,
+		BytesReceived:    n.BytesReceived - earlier.BytesReceived,
+		BytesSent:        n.BytesSent - earlier.BytesSent,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,82 @@
+package dashboard
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNeighborTrafficTracker(t *testing.T) {
+	neighborA := identity.GenerateIdentity().ID()
+	neighborB := identity.GenerateIdentity().ID()
+	connectedSince := time.Now()
+	now := connectedSince
+
+	tracker := newNeighborTrafficTracker(2)
+
+	// rejections of peers that are not tracked are ignored
+	tracker.countRejection(neighborA, true)
+
+	metrics := tracker.update(now, map[identity.ID]*neighborTrafficCounter{
+		neighborA: {ConnectedSince: connectedSince, MessagesReceived: 10, BytesReceived: 100},
+	})
+	require.Len(t, metrics, 1)
+	assert.EqualValues(t, 10, metrics[0].Total.MessagesReceived)
+	assert.EqualValues(t, 0, metrics[0].Total.Duplicates)
+	require.Len(t, metrics[0].History, 1)
+	assert.EqualValues(t, 0, metrics[0].History[0].MessagesReceived)
+
+	tracker.countRejection(neighborA, true)
+	tracker.countRejection(neighborA, true)
+	tracker.countRejection(neighborA, false)
+
+	for i := uint64(1); i <= 2; i++ {
+		now = now.Add(time.Second)
+		metrics = tracker.update(now, map[identity.ID]*neighborTrafficCounter{
+			neighborA: {ConnectedSince: connectedSince, MessagesReceived: 10 + 5*i, BytesReceived: 100 + 50*i, RequestsReceived: i, RequestHits: i},
+		})
+	}
+	require.Len(t, metrics, 1)
+	assert.Equal(t, neighborTrafficCounter{
+		ConnectedSince:   connectedSince,
+		MessagesReceived: 20,
+		Duplicates:       2,
+		Invalids:         1,
+		RequestsReceived: 2,
+		RequestHits:      2,
+		BytesReceived:    200,
+	}, metrics[0].Total)
+	require.Len(t, metrics[0].History, 1)
+	assert.Equal(t, now.Unix(), metrics[0].History[0].Time)
+	assert.EqualValues(t, 5, metrics[0].History[0].MessagesReceived)
+	assert.EqualValues(t, 0, metrics[0].History[0].Duplicates)
+
+	// the window only contains the latest samples
+	history := tracker.history()
+	require.Len(t, history, 1)
+	require.Len(t, history[0].History, 2)
+	assert.EqualValues(t, 2, history[0].History[0].Duplicates)
+	assert.EqualValues(t, 1, history[0].History[0].Invalids)
+
+	// the history is reset when a neighbor reconnects or disconnects
+	now = now.Add(time.Second)
+	metrics = tracker.update(now, map[identity.ID]*neighborTrafficCounter{
+		neighborA: {ConnectedSince: now, MessagesReceived: 1},
+		neighborB: {ConnectedSince: now, MessagesReceived: 3},
+	})
+	require.Len(t, metrics, 2)
+	for _, metric := range metrics {
+		assert.EqualValues(t, 0, metric.Total.Duplicates)
+		assert.EqualValues(t, 0, metric.History[0].MessagesReceived)
+	}
+
+	tracker.update(now.Add(time.Second), map[identity.ID]*neighborTrafficCounter{
+		neighborB: {ConnectedSince: now, MessagesReceived: 4},
+	})
+	history = tracker.history()
+	require.Len(t, history, 1)
+	assert.Equal(t, neighborB.String(), history[0].ID)
+}
@@ -31,6 +31,12 @@ type ParametersDefinition struct {
 		// MaxCount defines the max number of conflicts stored on the dashboard.
 		MaxCount int `default:"100" usage:"max number of conflicts stored on the dashboard"`
 	}
+
+	// NeighborTraffic defines the config flag for the neighbor traffic statistics of the dashboard.
+	NeighborTraffic struct {
+		// HistorySize defines the number of per-second samples of the traffic that are kept for every neighbor.
+		HistorySize int `default:"60" usage:"number of per-second samples of the traffic that are kept for every neighbor"`
+	}
 }
 
 // Parameters contains the configuration parameters of the dashboard plugin.
@@ -11,7 +11,6 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
-	"github.com/iotaledger/hive.go/autopeering/selection"
 	"github.com/iotaledger/hive.go/configuration"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
@@ -21,6 +20,7 @@ import (
 	"github.com/labstack/echo/middleware"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 	"github.com/iotaledger/goshimmer/packages/chat"
 	"github.com/iotaledger/goshimmer/packages/drng"
 	"github.com/iotaledger/goshimmer/packages/gossip"
@@ -52,10 +52,10 @@ type dependencies struct {
 	Node         *configuration.Configuration
 	Local        *peer.Local
 	Tangle       *tangle.Tangle
-	Selection    *selection.Protocol `optional:"true"`
-	GossipMgr    *gossip.Manager     `optional:"true"`
-	DRNGInstance *drng.DRNG          `optional:"true"`
-	Chat         *chat.Chat          `optional:"true"`
+	Selection    *neighborselection.Protocol `optional:"true"`
+	GossipMgr    *gossip.Manager             `optional:"true"`
+	DRNGInstance *drng.DRNG                  `optional:"true"`
+	Chat         *chat.Chat                  `optional:"true"`
 }
 
 func init() {
@@ -72,6 +72,7 @@ func configure(plugin *node.Plugin) {
 	configureManaFeed()
 	configureServer()
 	configureConflictLiveFeed()
+	configureNeighborTraffic()
 }
 
 func configureServer() {
@@ -107,6 +108,7 @@ func run(*node.Plugin) {
 	runVisualizer()
 	runManaFeed()
 	runConflictLiveFeed()
+	runNeighborTraffic()
 	if deps.DRNGInstance != nil {
 		runDrngLiveFeed()
 	}
@@ -203,6 +205,8 @@ const (
 	MsgTypeConflictsConflict
 	// MsgTypeConflictsBranch defines a message that contains a branch update for the conflict tab.
 	MsgTypeConflictsBranch
+	// MsgTypeNeighborTrafficMetric defines a message that contains the traffic statistics of the neighbors.
+	MsgTypeNeighborTrafficMetric
 )
 
 type wsmsg struct {
@@ -6,10 +6,10 @@ import (
 	"sync"
 
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/workerpool"
 	"github.com/labstack/echo"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
@@ -21,7 +21,7 @@ var (
 	visualizerWorkerPool      *workerpool.NonBlockingQueuedWorkerPool
 
 	msgHistoryMutex    sync.RWMutex
-	msgFinalized       map[string]bool
+	msgFinalized       map[tangle.MessageID]bool
 	msgHistory         []*tangle.Message
 	maxMsgHistorySize  = 1000
 	numHistoryToRemove = 100
@@ -59,7 +59,7 @@ func configureVisualizer() {
 	}, workerpool.WorkerCount(visualizerWorkerCount), workerpool.QueueSize(visualizerWorkerQueueSize))
 
 	// configure msgHistory, msgSolid
-	msgFinalized = make(map[string]bool, maxMsgHistorySize)
+	msgFinalized = make(map[tangle.MessageID]bool, maxMsgHistorySize)
 	msgHistory = make([]*tangle.Message, 0, maxMsgHistorySize)
 }
 
@@ -80,20 +80,26 @@ func sendTipInfo(messageID tangle.MessageID, isTip bool) {
 }
 
 func runVisualizer() {
-	notifyNewMsg := events.NewClosure(func(messageID tangle.MessageID) {
+	notifyNewMsg := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			finalized := deps.Tangle.ConfirmationOracle.IsMessageConfirmed(messageID)
 			addToHistory(message, finalized)
-			visualizerWorkerPool.TrySubmit(message, finalized)
+			if hasWsClients() {
+				visualizerWorkerPool.TrySubmit(message, finalized)
+			}
 		})
 	})
 
-	notifyNewTip := events.NewClosure(func(tipEvent *tangle.TipEvent) {
-		visualizerWorkerPool.TrySubmit(tipEvent, tipEvent.MessageID, true)
+	notifyNewTip := event.NewClosure(func(tipEvent *tangle.TipEvent) {
+		if hasWsClients() {
+			visualizerWorkerPool.TrySubmit(tipEvent, tipEvent.MessageID, true)
+		}
 	})
 
-	notifyDeletedTip := events.NewClosure(func(tipEvent *tangle.TipEvent) {
-		visualizerWorkerPool.TrySubmit(tipEvent, tipEvent.MessageID, false)
+	notifyDeletedTip := event.NewClosure(func(tipEvent *tangle.TipEvent) {
+		if hasWsClients() {
+			visualizerWorkerPool.TrySubmit(tipEvent, tipEvent.MessageID, false)
+		}
 	})
 
 	if err := daemon.BackgroundWorker("Dashboard[Visualizer]", func(ctx context.Context) {
@@ -127,7 +133,7 @@ func setupVisualizerRoutes(routeGroup *echo.Group) {
 			res = append(res, vertex{
 				ID:              msg.ID().Base58(),
 				ParentIDsByType: prepareParentReferences(msg),
-				IsFinalized:     msgFinalized[msg.ID().Base58()],
+				IsFinalized:     msgFinalized[msg.ID()],
 				IsTx:            msg.Payload().Type() == ledgerstate.TransactionType,
 			})
 		}
@@ -139,19 +145,19 @@ func setupVisualizerRoutes(routeGroup *echo.Group) {
 func addToHistory(msg *tangle.Message, finalized bool) {
 	msgHistoryMutex.Lock()
 	defer msgHistoryMutex.Unlock()
-	if _, exist := msgFinalized[msg.ID().Base58()]; exist {
-		msgFinalized[msg.ID().Base58()] = finalized
+	if _, exist := msgFinalized[msg.ID()]; exist {
+		msgFinalized[msg.ID()] = finalized
 		return
 	}
 
 	// remove 100 old msgs if the slice is full
 	if len(msgHistory) >= maxMsgHistorySize {
 		for i := 0; i < numHistoryToRemove; i++ {
-			delete(msgFinalized, msgHistory[i].ID().Base58())
+			delete(msgFinalized, msgHistory[i].ID())
 		}
 		msgHistory = append(msgHistory[:0], msgHistory[numHistoryToRemove:maxMsgHistorySize]...)
 	}
 	// add new msg
 	msgHistory = append(msgHistory, msg)
-	msgFinalized[msg.ID().Base58()] = finalized
+	msgFinalized[msg.ID()] = finalized
 }
@@ -51,6 +51,7 @@ func configureWebSocketWorkerPool() {
 			broadcastWsMessage(&wsmsg{MsgTypeMPSMetric, x})
 			broadcastWsMessage(&wsmsg{MsgTypeNodeStatus, currentNodeStatus()})
 			broadcastWsMessage(&wsmsg{MsgTypeNeighborMetric, neighborMetrics()})
+			broadcastWsMessage(&wsmsg{MsgTypeNeighborTrafficMetric, neighborTraffic.update(time.Now(), neighborTrafficCounters())})
 			broadcastWsMessage(&wsmsg{MsgTypeTipsMetric, &tipsInfo{
 				TotalTips: deps.Tangle.TipManager.TipCount(),
 			}})
@@ -115,6 +116,14 @@ func removeWsClient(clientID uint64) {
 	close(wsClient.channel)
 }
 
+// returns whether at least one websocket client is connected, so that messages are only prepared if they are consumed.
+func hasWsClients() bool {
+	wsClientsMu.RLock()
+	defer wsClientsMu.RUnlock()
+
+	return len(wsClients) > 0
+}
+
 // broadcasts the given message to all connected websocket clients.
 func broadcastWsMessage(msg interface{}, dontDrop ...bool) {
 	wsClientsMu.RLock()
@@ -152,6 +161,9 @@ func sendInitialData(ws *websocket.Conn) error {
 	if err := ManaBufferInstance().SendMapOnline(ws); err != nil {
 		return err
 	}
+	if err := sendJSON(ws, &wsmsg{MsgTypeNeighborTrafficMetric, neighborTraffic.history()}); err != nil {
+		return err
+	}
 	sendAllConflicts()
 
 	return nil
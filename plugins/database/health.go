@@ -5,6 +5,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/kvstore"
+	"go.uber.org/atomic"
 
 	"github.com/iotaledger/goshimmer/packages/database"
 )
@@ -12,6 +13,9 @@ import (
 var (
 	healthStore kvstore.KVStore
 	healthKey   = []byte("db_health")
+
+	// incompleteShutdown is set if a component did not finish flushing its state on shutdown.
+	incompleteShutdown atomic.Bool
 )
 
 func configureHealthStore(store kvstore.KVStore) {
@@ -33,6 +37,12 @@ func MarkDatabaseHealthy() {
 	}
 }
 
+// MarkShutdownIncomplete records that a component did not finish flushing its state on shutdown, so that the database
+// stays marked as unhealthy when it is closed.
+func MarkShutdownIncomplete() {
+	incompleteShutdown.Store(true)
+}
+
 // IsDatabaseUnhealthy tells whether the database is unhealthy, meaning not shutdown properly.
 func IsDatabaseUnhealthy() bool {
 	contains, err := healthStore.Has(healthKey)
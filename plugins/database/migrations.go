@@ -0,0 +1,49 @@
+package database
+
+import (
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/byteutils"
+	"github.com/iotaledger/hive.go/kvstore"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// migrations contains the migrations that upgrade the stored data of older schema versions to the DBVersion. Every
+// change of the DBVersion adds the migration to the new version (with the storage prefixes of the realms it modifies),
+// so that existing databases are upgraded at startup instead of having to be deleted. Databases whose version has no
+// migration path to the DBVersion still need to be deleted.
+var migrations = []*database.Migration{
+	{
+		Version: 56,
+		Name:    "add below max depth flag to message metadata",
+		Realms:  []byte{database.PrefixTangle},
+		Migrate: migrateMessageMetadataBelowMaxDepth,
+	},
+}
+
+// migrateMessageMetadataBelowMaxDepth appends the (unset) below max depth flag to all stored MessageMetadata.
+func migrateMessageMetadataBelowMaxDepth(store kvstore.KVStore, progress database.ProgressFunc) (err error) {
+	messageMetadataStore := store.WithRealm([]byte{database.PrefixTangle, tangle.PrefixMessageMetadata})
+	batchedMutations := messageMetadataStore.Batched()
+
+	processed := uint64(0)
+	var setErr error
+	if err = messageMetadataStore.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		if setErr = batchedMutations.Set(key, byteutils.ConcatBytes(value, []byte{0})); setErr != nil {
+			return false
+		}
+		processed++
+		progress(processed, 0)
+
+		return true
+	}); err == nil {
+		err = setErr
+	}
+	if err != nil {
+		batchedMutations.Cancel()
+		return errors.Errorf("failed to migrate message metadata: %w", err)
+	}
+
+	return batchedMutations.Commit()
+}
@@ -19,6 +19,16 @@ type ParametersDefinition struct {
 
 	// ForceCacheTime is a new global cache time in seconds for object storage.
 	ForceCacheTime time.Duration `default:"-1s" usage:"interval of time for which objects should remain in memory. Zero time means no caching, negative value means use defaults"`
+
+	// FlushInterval defines the interval in which the batched writes of all storages are combined into a single write.
+	FlushInterval time.Duration `default:"50ms" usage:"interval in which the batched writes are combined into a single write. Zero disables the grouping"`
+
+	// MaxPendingMutations defines the number of batched mutations after which they are written before the end of the
+	// flush interval.
+	MaxPendingMutations int `default:"10000" usage:"number of batched mutations after which they are written before the end of the flush interval. Zero disables the limit"`
+
+	// Durability defines how the writes are protected against crashes.
+	Durability string `default:"none" usage:"durability of the writes: 'none' (no write-ahead log), 'wal' (unsynced write-ahead log) or 'sync' (synced write-ahead log)"`
 }
 
 // Parameters contains configuration parameters used by the storage layer.
@@ -17,6 +17,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/database"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/plugins/config"
 )
 
 // PluginName is the name of the database plugin.
@@ -29,6 +30,7 @@ var (
 	log    *logger.Logger
 
 	db                database.DB
+	groupCommitStore  *database.GroupCommitStore
 	cacheTimeProvider *database.CacheTimeProvider
 	cacheProviderOnce sync.Once
 )
@@ -54,6 +56,16 @@ func CacheTimeProvider() *database.CacheTimeProvider {
 	return cacheTimeProvider
 }
 
+// CommitPendingWrites writes the batched mutations that wait for the next group commit of the store (without syncing
+// them to disk).
+func CommitPendingWrites() error {
+	if groupCommitStore == nil {
+		return nil
+	}
+
+	return groupCommitStore.Commit()
+}
+
 func createCacheTimeProvider() {
 	cacheTimeProvider = database.NewCacheTimeProvider(Parameters.ForceCacheTime)
 }
@@ -65,13 +77,19 @@ func createStore() kvstore.KVStore {
 	if Parameters.InMemory {
 		db, err = database.NewMemDB()
 	} else {
-		db, err = database.NewDB(Parameters.Directory)
+		db, err = database.NewDB(Parameters.Directory, database.DurabilityMode(Parameters.Durability))
 	}
 	if err != nil {
 		log.Fatal("Unable to open the database, please delete the database folder. Error: %s", err)
 	}
 
-	return db.NewStore()
+	if Parameters.InMemory || Parameters.FlushInterval <= 0 {
+		return db.NewStore()
+	}
+
+	groupCommitStore = database.NewGroupCommitStore(db.NewStore(), Parameters.FlushInterval, Parameters.MaxPendingMutations)
+
+	return groupCommitStore
 }
 
 func configure(_ *node.Plugin) {
@@ -81,9 +99,19 @@ func configure(_ *node.Plugin) {
 		if errors.Is(err, ErrDBVersionIncompatible) {
 			log.Fatalf("The database scheme was updated. Please delete the database folder. %s", err)
 		}
+		if errors.Is(err, database.ErrMigrationFailed) {
+			log.Fatalf("Failed to migrate the database, the failed migration was rolled back. %s", err)
+		}
 		log.Fatalf("Failed to check database version: %s", err)
 	}
 
+	if err := checkNetworkID(healthStore, config.Parameters.NetworkID); err != nil {
+		if errors.Is(err, ErrNetworkIDMismatch) {
+			log.Fatalf("The database contains the data of a different network. Please delete the database folder. %s", err)
+		}
+		log.Fatalf("Failed to check network ID of database: %s", err)
+	}
+
 	if Parameters.Directory != "" {
 		val, err := strconv.ParseBool(Parameters.Dirty)
 		if err != nil {
@@ -113,20 +141,36 @@ func run(*node.Plugin) {
 }
 
 // manageDBLifetime takes care of managing the lifetime of the database. It marks the database as dirty up on
-// startup and unmarks it up on shutdown. Up on shutdown it will run the db GC and then close the database.
+// startup and unmarks it up on shutdown (unless a component did not finish its shutdown). Up on shutdown it will run
+// the db GC and then close the database.
 func manageDBLifetime(ctx context.Context) {
 	// we mark the database only as corrupted from within a background worker, which means
 	// that we only mark it as dirty, if the node actually started up properly (meaning no termination
 	// signal was received before all plugins loaded).
 	MarkDatabaseUnhealthy()
 	<-ctx.Done()
-	runDatabaseGC()
-	MarkDatabaseHealthy()
-	log.Infof("Syncing database to disk...")
-	if err := db.Close(); err != nil {
-		log.Errorf("Failed to flush the database: %s", err)
-	}
-	log.Infof("Syncing database to disk... done")
+
+	// the database is always closed, so the steps are not limited by a timeout
+	_ = shutdown.NewSequence("database", shutdown.WithLogger(log)).
+		Add("garbage collection", runDatabaseGC).
+		Add("flush batched writes", func() {
+			if groupCommitStore != nil {
+				groupCommitStore.Stop()
+			}
+		}).
+		Add("mark health", func() {
+			if incompleteShutdown.Load() {
+				log.Warnf("The database stays marked as not properly shutdown, because a component did not finish its shutdown.")
+				return
+			}
+			MarkDatabaseHealthy()
+		}).
+		Add("sync to disk", func() {
+			if err := db.Close(); err != nil {
+				log.Errorf("Failed to flush the database: %s", err)
+			}
+		}).
+		Run()
 }
 
 func runDatabaseGC() {
@@ -1,42 +1,65 @@
 package database
 
 import (
+	"encoding/binary"
 	"fmt"
 
 	"github.com/cockroachdb/errors"
 
 	"github.com/iotaledger/hive.go/kvstore"
+
+	"github.com/iotaledger/goshimmer/packages/database"
 )
 
 const (
 	// DBVersion defines the version of the database schema this version of GoShimmer supports.
-	// Every time there's a breaking change regarding the stored data, this version flag should be adjusted.
-	DBVersion = 54
+	// Every time there's a breaking change regarding the stored data, this version flag should be adjusted and a
+	// migration to the new version should be added to the migrations.
+	DBVersion = 56
 )
 
 var (
 	// ErrDBVersionIncompatible is returned when the database has an unexpected version.
 	ErrDBVersionIncompatible = errors.New("database version is not compatible. please delete your database folder and restart")
+	// ErrNetworkIDMismatch is returned when the database contains the data of a different network.
+	ErrNetworkIDMismatch = errors.New("database belongs to a different network. please delete your database folder and restart")
 	// the key under which the database is stored
 	dbVersionKey = []byte{0}
+	// the key under which the ID of the network of the database is stored
+	networkIDKey = []byte{1}
 )
 
-// checks whether the database is compatible with the current schema version.
-// also automatically sets the version if the database is new.
+// checks whether the database is compatible with the current schema version and applies the registered migrations if
+// it has an older version. Also automatically sets the version if the database is new.
 func checkDatabaseVersion(store kvstore.KVStore) error {
-	entry, err := store.Get(dbVersionKey)
+	migrator := database.NewMigrator(deps.Store, migrations, database.WithVersionKey(store, dbVersionKey), database.WithLogger(log))
+	if err := migrator.Migrate(DBVersion); err != nil {
+		if errors.Is(err, database.ErrMigrationUnavailable) {
+			return fmt.Errorf("%w: %s", ErrDBVersionIncompatible, err)
+		}
+		return err
+	}
+	return nil
+}
+
+// checks whether the database belongs to the network with the given ID.
+// also automatically sets the network ID if the database is new.
+func checkNetworkID(store kvstore.KVStore, networkID uint32) error {
+	entry, err := store.Get(networkIDKey)
 	if errors.Is(err, kvstore.ErrKeyNotFound) {
-		// set the version in an empty DB
-		return store.Set(dbVersionKey, []byte{DBVersion})
+		networkIDBytes := make([]byte, 4)
+		binary.LittleEndian.PutUint32(networkIDBytes, networkID)
+
+		return store.Set(networkIDKey, networkIDBytes)
 	}
 	if err != nil {
 		return err
 	}
-	if len(entry) == 0 {
-		return fmt.Errorf("%w: no database version was persisted", ErrDBVersionIncompatible)
+	if len(entry) != 4 {
+		return fmt.Errorf("%w: invalid network ID was persisted", ErrNetworkIDMismatch)
 	}
-	if entry[0] != DBVersion {
-		return fmt.Errorf("%w: supported version: %d, version of database: %d", ErrDBVersionIncompatible, DBVersion, entry[0])
+	if persistedNetworkID := binary.LittleEndian.Uint32(entry); persistedNetworkID != networkID {
+		return fmt.Errorf("%w: network ID of node: %d, network ID of database: %d", ErrNetworkIDMismatch, networkID, persistedNetworkID)
 	}
 	return nil
 }
@@ -0,0 +1,31 @@
+package doublespendalert
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the doubleSpendAlert plugin.
+type ParametersDefinition struct {
+	// Watchlist contains the addresses and outputs whose double spends are reported.
+	Watchlist struct {
+		// Addresses defines the base58 encoded addresses that are watched.
+		Addresses []string `usage:"list of base58 encoded addresses whose double spends are reported"`
+		// Outputs defines the base58 encoded output IDs that are watched.
+		Outputs []string `usage:"list of base58 encoded output IDs whose double spends are reported"`
+	}
+	// Webhooks defines the URLs that the alerts are posted to.
+	Webhooks []string `usage:"list of webhook URLs that the alerts are posted to"`
+	// WebhookTimeout defines the timeout of a single webhook call.
+	WebhookTimeout time.Duration `default:"5s" usage:"the timeout of a single webhook call"`
+	// QueueSize defines how many alerts are buffered while the webhooks are being called.
+	QueueSize int `default:"100" usage:"how many alerts are buffered while the webhooks are being called"`
+}
+
+// Parameters contains the configuration used by the doubleSpendAlert plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "doubleSpendAlert")
+}
@@ -0,0 +1,70 @@
+package doublespendalert
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/doublespendalert"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the double spend alert plugin.
+const PluginName = "DoubleSpendAlert"
+
+var (
+	// Plugin is the plugin instance of the double spend alert plugin.
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	deps   = new(dependencies)
+
+	monitor    *doublespendalert.Monitor
+	dispatcher *doublespendalert.WebhookDispatcher
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle *tangle.Tangle
+}
+
+func configure(plugin *node.Plugin) {
+	watchlist, err := doublespendalert.WatchlistFromBase58(Parameters.Watchlist.Addresses, Parameters.Watchlist.Outputs)
+	if err != nil {
+		plugin.LogFatalf("failed to parse watchlist: %s", err)
+		return
+	}
+	if watchlist.IsEmpty() {
+		plugin.LogWarn("watchlist is empty - no double spends will be reported")
+	}
+
+	dispatcher = doublespendalert.NewWebhookDispatcher(Parameters.Webhooks, Parameters.WebhookTimeout, Parameters.QueueSize)
+
+	monitor = doublespendalert.NewMonitor(deps.Tangle, watchlist)
+	monitor.Events.DoubleSpendDetected.Attach(events.NewClosure(func(alert *doublespendalert.Alert) {
+		plugin.LogWarnf("double spend of %s detected: transactions %v, watched addresses %v, watched outputs %v",
+			alert.ConflictID, alert.ConflictingTransactionIDs, alert.WatchedAddresses, alert.WatchedOutputs)
+
+		if len(Parameters.Webhooks) != 0 && !dispatcher.Submit(alert) {
+			plugin.LogErrorf("failed to dispatch alert for double spend of %s: webhook queue is full", alert.ConflictID)
+		}
+	}))
+	monitor.Setup()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		dispatcher.Run(ctx, func(err error) {
+			plugin.LogErrorf("failed to dispatch alert: %s", err)
+		})
+
+		plugin.LogInfof("Stopping %s ...", PluginName)
+		monitor.Shutdown()
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityDoubleSpendAlert); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
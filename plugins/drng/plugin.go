@@ -10,6 +10,7 @@ import (
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/drng"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
@@ -46,6 +47,13 @@ func init() {
 
 func configure(_ *node.Plugin) {
 	configureEvents()
+
+	deps.Tangle.Scheduler.RegisterPriorityClassifier(isDRNGMessage)
+}
+
+// isDRNGMessage returns true if the given message contains a dRNG payload.
+func isDRNGMessage(message *tangle.Message) bool {
+	return message.Payload().Type() == drng.PayloadType
 }
 
 func run(plugin *node.Plugin) {
@@ -93,7 +101,7 @@ func configureEvents() {
 		return
 	}
 
-	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		select {
 		case inbox <- messageID:
 		default:
@@ -0,0 +1,102 @@
+package epochs
+
+import (
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the epochs plugin.
+const PluginName = "Epochs"
+
+var (
+	// Plugin is the plugin instance of the epochs plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle    *tangle.Tangle
+	Server    *echo.Echo
+	DiffStore *epochs.DiffStore
+}
+
+type diffStoreDeps struct {
+	dig.In
+
+	Storage kvstore.KVStore
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(diffStoreDeps diffStoreDeps) *epochs.DiffStore {
+			return epochs.NewDiffStore(diffStoreDeps.Storage)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(plugin *node.Plugin) {
+	deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(event.NewClosure(func(transactionID ledgerstate.TransactionID) {
+		if err := storeConfirmedTransaction(transactionID); err != nil {
+			plugin.LogErrorf("failed to store epoch diff of transaction %s: %s", transactionID, err)
+		}
+	}))
+
+	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(func(branchID ledgerstate.BranchID) {
+		if err := storeBranchResolution(branchID, ledgerstate.Confirmed); err != nil {
+			plugin.LogErrorf("failed to store epoch diff of branch %s: %s", branchID, err)
+		}
+	}))
+
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchRejected.Attach(event.NewClosure(func(branchID ledgerstate.BranchID) {
+		if err := storeBranchResolution(branchID, ledgerstate.Rejected); err != nil {
+			plugin.LogErrorf("failed to store epoch diff of branch %s: %s", branchID, err)
+		}
+	}))
+
+	configureWebAPI()
+}
+
+// storeConfirmedTransaction adds the outputs created and spent by the given transaction to the diff of the epoch of
+// the transaction's timestamp.
+func storeConfirmedTransaction(transactionID ledgerstate.TransactionID) (err error) {
+	deps.Tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+		index := epochs.IndexFromTime(transaction.Essence().Timestamp())
+
+		spentOutputs := make(ledgerstate.Outputs, 0, len(transaction.Essence().Inputs()))
+		deps.Tangle.LedgerState.ConsumedOutputs(transaction).Consume(func(output ledgerstate.Output) {
+			spentOutputs = append(spentOutputs, output)
+		})
+
+		if err = deps.DiffStore.StoreSpentOutputs(index, spentOutputs); err != nil {
+			return
+		}
+		err = deps.DiffStore.StoreCreatedOutputs(index, transaction.Essence().Outputs())
+	})
+
+	return err
+}
+
+// storeBranchResolution adds the resolution of the given branch to the diff of the epoch of its conflicting
+// transaction.
+func storeBranchResolution(branchID ledgerstate.BranchID, inclusionState ledgerstate.InclusionState) (err error) {
+	deps.Tangle.LedgerState.Transaction(ledgerstate.TransactionID(branchID)).Consume(func(transaction *ledgerstate.Transaction) {
+		err = deps.DiffStore.StoreBranchResolution(epochs.IndexFromTime(transaction.Essence().Timestamp()), branchID, inclusionState)
+	})
+
+	return err
+}
@@ -0,0 +1,34 @@
+package epochs
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/epochs"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// RouteEpochDiffs defines the HTTP path for the epochs/:index/diffs endpoint.
+const RouteEpochDiffs = "epochs/:index/diffs"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteEpochDiffs, getEpochDiffsHandler)
+}
+
+// getEpochDiffsHandler returns the changes of the confirmed ledger state of the requested epoch.
+func getEpochDiffsHandler(c echo.Context) error {
+	index, err := strconv.ParseUint(c.Param("index"), 10, 64)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid epoch index in the URL")))
+	}
+
+	diff, err := deps.DiffStore.Diff(epochs.Index(index))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewEpochDiff(diff))
+}
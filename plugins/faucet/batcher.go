@@ -0,0 +1,124 @@
+package faucet
+
+import (
+	"context"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/faucet"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region fundingRequest ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// fundingRequest is a funding request that passed all checks and waits to be fulfilled.
+type fundingRequest struct {
+	address ledgerstate.Address
+	pledge  manaPledge
+}
+
+// newFundingRequest creates a fundingRequest from the given message. Mana is pledged to the issuer of the message unless
+// the request names different nodes.
+func newFundingRequest(requestMsg *tangle.Message) *fundingRequest {
+	faucetReq := requestMsg.Payload().(*faucet.Request)
+
+	emptyID := identity.ID{}
+	pledge := manaPledge{
+		accessManaPledgeID:    identity.NewID(requestMsg.IssuerPublicKey()),
+		consensusManaPledgeID: identity.NewID(requestMsg.IssuerPublicKey()),
+	}
+	if faucetReq.AccessManaPledgeID() != emptyID {
+		pledge.accessManaPledgeID = faucetReq.AccessManaPledgeID()
+	}
+	if faucetReq.ConsensusManaPledgeID() != emptyID {
+		pledge.consensusManaPledgeID = faucetReq.ConsensusManaPledgeID()
+	}
+
+	return &fundingRequest{
+		address: faucetReq.Address(),
+		pledge:  pledge,
+	}
+}
+
+// manaPledge contains the nodes that the mana of a funding transaction is pledged to.
+type manaPledge struct {
+	accessManaPledgeID    identity.ID
+	consensusManaPledgeID identity.ID
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region requestBatcher ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// requestBatcher collects funding requests and hands them over in batches that can be fulfilled by a single
+// transaction. As a transaction pledges its mana to a single pair of nodes, only requests that pledge to the same nodes
+// are batched together. A batch is handed over as soon as it is full or when the batch interval since the first pending
+// request has passed.
+type requestBatcher struct {
+	batchSize     int
+	batchInterval time.Duration
+	requests      chan *fundingRequest
+	flush         func(batch []*fundingRequest)
+}
+
+// newRequestBatcher creates a requestBatcher that queues up to queueSize requests and hands the batches over to flush.
+func newRequestBatcher(batchSize int, batchInterval time.Duration, queueSize int, flush func(batch []*fundingRequest)) *requestBatcher {
+	return &requestBatcher{
+		batchSize:     batchSize,
+		batchInterval: batchInterval,
+		requests:      make(chan *fundingRequest, queueSize),
+		flush:         flush,
+	}
+}
+
+// Submit queues the given request and returns false if the queue is full.
+func (r *requestBatcher) Submit(request *fundingRequest) (added bool) {
+	select {
+	case r.requests <- request:
+		return true
+	default:
+		return false
+	}
+}
+
+// Run batches the queued requests until the given context is done. Pending requests are dropped on shutdown.
+func (r *requestBatcher) Run(ctx context.Context) {
+	pending := make(map[manaPledge][]*fundingRequest)
+	timer := time.NewTimer(r.batchInterval)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case request := <-r.requests:
+			if len(pending) == 0 {
+				timer.Reset(r.batchInterval)
+			}
+
+			pending[request.pledge] = append(pending[request.pledge], request)
+			if len(pending[request.pledge]) >= r.batchSize {
+				r.flush(pending[request.pledge])
+				delete(pending, request.pledge)
+			}
+		case <-timer.C:
+			for pledge, batch := range pending {
+				r.flush(batch)
+				delete(pending, pledge)
+			}
+		}
+
+		if len(pending) == 0 && !timer.Stop() {
+			// drain the timer if it fired while the last batch was flushed because it was full
+			select {
+			case <-timer.C:
+			default:
+			}
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,69 @@
+package faucet
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestRequestBatcher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batches := make(chan []*fundingRequest, 10)
+	batcher := newRequestBatcher(3, 100*time.Millisecond, 10, func(batch []*fundingRequest) {
+		batches <- batch
+	})
+	go batcher.Run(ctx)
+
+	pledgeA := manaPledge{accessManaPledgeID: identity.GenerateIdentity().ID(), consensusManaPledgeID: identity.GenerateIdentity().ID()}
+	pledgeB := manaPledge{accessManaPledgeID: identity.GenerateIdentity().ID(), consensusManaPledgeID: identity.GenerateIdentity().ID()}
+
+	// a full batch is handed over immediately
+	for i := 0; i < 4; i++ {
+		require.True(t, batcher.Submit(newTestFundingRequest(pledgeA)))
+	}
+	require.True(t, batcher.Submit(newTestFundingRequest(pledgeB)))
+
+	batch := receiveBatch(t, batches)
+	assert.Len(t, batch, 3)
+
+	// the remaining requests are handed over after the batch interval, grouped by their pledge
+	remainingBatches := map[manaPledge]int{}
+	for i := 0; i < 2; i++ {
+		batch = receiveBatch(t, batches)
+		remainingBatches[batch[0].pledge] = len(batch)
+	}
+	assert.Equal(t, map[manaPledge]int{pledgeA: 1, pledgeB: 1}, remainingBatches)
+}
+
+func TestRequestBatcher_QueueFull(t *testing.T) {
+	batcher := newRequestBatcher(3, time.Second, 1, func([]*fundingRequest) {})
+
+	assert.True(t, batcher.Submit(newTestFundingRequest(manaPledge{})))
+	assert.False(t, batcher.Submit(newTestFundingRequest(manaPledge{})))
+}
+
+func newTestFundingRequest(pledge manaPledge) *fundingRequest {
+	return &fundingRequest{
+		address: ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey),
+		pledge:  pledge,
+	}
+}
+
+func receiveBatch(t *testing.T, batches chan []*fundingRequest) []*fundingRequest {
+	select {
+	case batch := <-batches:
+		return batch
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "batch was not handed over")
+		return nil
+	}
+}
@@ -0,0 +1,29 @@
+package faucet
+
+import (
+	"github.com/iotaledger/hive.go/events"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// Events defines the events of the plugin.
+var Events = pluginEvents{
+	FundingRequestFulfilled: events.NewEvent(fundingRequestFulfilledEventCaller),
+}
+
+type pluginEvents struct {
+	// Fired when the faucet sent funds to an address.
+	FundingRequestFulfilled *events.Event
+}
+
+// FundingRequestFulfilledEvent contains the details of a payout of the faucet.
+type FundingRequestFulfilledEvent struct {
+	Address       ledgerstate.Address
+	TransactionID string
+	MessageID     tangle.MessageID
+}
+
+func fundingRequestFulfilledEventCaller(handler interface{}, params ...interface{}) {
+	handler.(func(*FundingRequestFulfilledEvent))(params[0].(*FundingRequestFulfilledEvent))
+}
@@ -32,6 +32,17 @@ type ParametersDefinition struct {
 	// SplittingMultiplier * SupplyOutputsCount indicates how many funding outputs during funds replenishment.
 	SplittingMultiplier int `default:"25" usage:"SplittingMultiplier defines how many outputs each supply transaction will have."`
 
+	// RemainderOutputsCount defines the number of outputs the remainder funds of the faucet are split into, so that the
+	// supply transactions of a replenishment can be prepared concurrently.
+	RemainderOutputsCount int `default:"4" usage:"the number of outputs the remainder funds of the faucet are split into to prepare supply transactions concurrently"`
+
+	// BatchSize defines the maximum number of funding requests that are fulfilled by a single transaction.
+	BatchSize int `default:"20" usage:"the maximum number of funding requests that are fulfilled by a single transaction"`
+
+	// BatchInterval defines how long funding requests are collected before a transaction fulfills them, unless the
+	// batch is full earlier.
+	BatchInterval time.Duration `default:"1s" usage:"how long funding requests are collected before a transaction fulfills them"`
+
 	// GenesisTokenAmount is the total supply.
 	GenesisTokenAmount uint64 `default:"1000000000000000" usage:"GenesisTokenAmount is the total supply."`
 }
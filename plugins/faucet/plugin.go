@@ -9,7 +9,6 @@ import (
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/orderedmap"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/iotaledger/hive.go/workerpool"
@@ -18,10 +17,12 @@ import (
 	"go.uber.org/dig"
 
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/faucet"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/pow"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
@@ -34,9 +35,13 @@ const (
 
 var (
 	// Plugin is the "plugin" instance of the faucet application.
-	Plugin                   *node.Plugin
+	Plugin *node.Plugin
+	// RuntimePlugin allows to start and stop the faucet application while the node is running.
+	RuntimePlugin            *runtimeplugin.Plugin
 	_faucet                  *StateManager
 	powVerifier              = pow.New()
+	fundingBatcher           *requestBatcher
+	fundingQueueSize         = 500
 	fundingWorkerPool        *workerpool.NonBlockingQueuedWorkerPool
 	fundingWorkerCount       = runtime.GOMAXPROCS(0)
 	fundingWorkerQueueSize   = 500
@@ -50,6 +55,9 @@ var (
 	blackListMutex    sync.RWMutex
 	// signals that the faucet has initialized itself and can start funding requests.
 	initDone atomic.Bool
+	// stops the initialization and the replenishments of the faucet.
+	cancelFaucet context.CancelFunc
+	initWG       sync.WaitGroup
 
 	waitForManaWindow = 5 * time.Second
 	deps              = new(dependencies)
@@ -63,107 +71,158 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, run)
+	RuntimePlugin = runtimeplugin.New(PluginName, node.Disabled, start, stop)
 }
 
 // newFaucet gets the faucet component instance the faucet plugin has initialized.
-func newFaucet() *StateManager {
+func newFaucet() (*StateManager, error) {
 	if Parameters.Seed == "" {
-		Plugin.LogFatal("a seed must be defined when enabling the faucet plugin")
+		return nil, errors.New("a seed must be defined when enabling the faucet plugin")
 	}
 	seedBytes, err := base58.Decode(Parameters.Seed)
 	if err != nil {
-		Plugin.LogFatalf("configured seed for the faucet is invalid: %s", err)
+		return nil, errors.Errorf("configured seed for the faucet is invalid: %w", err)
 	}
 	if Parameters.TokensPerRequest <= 0 {
-		Plugin.LogFatalf("the amount of tokens to fulfill per request must be above zero")
+		return nil, errors.New("the amount of tokens to fulfill per request must be above zero")
 	}
 	if Parameters.MaxTransactionBookedAwaitTime <= 0 {
-		Plugin.LogFatalf("the max transaction booked await time must be more than 0")
+		return nil, errors.New("the max transaction booked await time must be more than 0")
 	}
 	if Parameters.SupplyOutputsCount <= 0 {
-		Plugin.LogFatalf("the number of faucet supply outputs should be more than 0")
+		return nil, errors.New("the number of faucet supply outputs should be more than 0")
 	}
 	if Parameters.SplittingMultiplier <= 0 {
-		Plugin.LogFatalf("the number of outputs for each supply transaction during funds splitting should be more than 0")
+		return nil, errors.New("the number of outputs for each supply transaction during funds splitting should be more than 0")
 	}
 	if Parameters.GenesisTokenAmount <= 0 {
-		Plugin.LogFatalf("the total supply should be more than 0")
+		return nil, errors.New("the total supply should be more than 0")
+	}
+	if Parameters.RemainderOutputsCount <= 0 || Parameters.RemainderOutputsCount > MaxRemainderOutputsCount {
+		return nil, errors.Errorf("the number of remainder outputs should be between 1 and %d", MaxRemainderOutputsCount)
+	}
+	if Parameters.BatchSize <= 0 || Parameters.BatchSize > MaxFaucetOutputsCount {
+		return nil, errors.Errorf("the number of funding requests per payout transaction should be between 1 and %d", MaxFaucetOutputsCount)
+	}
+	if Parameters.BatchInterval <= 0 {
+		return nil, errors.New("the batch interval must be more than 0")
 	}
 	return NewStateManager(
 		uint64(Parameters.TokensPerRequest),
 		walletseed.NewSeed(seedBytes),
 		uint64(Parameters.SupplyOutputsCount),
 		uint64(Parameters.SplittingMultiplier),
+		uint64(Parameters.RemainderOutputsCount),
 
 		Parameters.MaxTransactionBookedAwaitTime,
-	)
+	), nil
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		if err := RuntimePlugin.Boot(); err != nil {
+			plugin.LogFatalf("Failed to start: %s", err)
+		}
+
+		<-ctx.Done()
+
+		plugin.LogInfof("Stopping %s ...", PluginName)
+		RuntimePlugin.Shutdown()
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityFaucet); err != nil {
+		plugin.Logger().Panicf("Failed to start daemon: %s", err)
+	}
 }
 
-func configure(plugin *node.Plugin) {
+// start creates the faucet and starts to initialize it in the background. Funding requests are processed once the
+// initialization is done.
+func start() (err error) {
+	if _faucet, err = newFaucet(); err != nil {
+		return err
+	}
 	targetPoWDifficulty = Parameters.PowDifficulty
 	blacklist = orderedmap.New[string, bool]()
 	blacklistCapacity = Parameters.BlacklistCapacity
-	_faucet = newFaucet()
 
 	fundingWorkerPool = workerpool.NewNonBlockingQueuedWorkerPool(func(task workerpool.Task) {
-		msg := task.Param(0).(*tangle.Message)
-		addr := msg.Payload().(*faucet.Request).Address()
-		msg, txID, err := _faucet.FulFillFundingRequest(msg)
-		if err != nil {
-			plugin.LogWarnf("couldn't fulfill funding request to %s: %s", addr.Base58(), err)
-			return
-		}
-		plugin.LogInfof("sent funds to address %s via tx %s and msg %s", addr.Base58(), txID, msg.ID())
+		defer task.Return(nil)
+
+		fulfillFundingRequests(task.Param(0).([]*fundingRequest))
 	}, workerpool.WorkerCount(fundingWorkerCount), workerpool.QueueSize(fundingWorkerQueueSize))
+	fundingBatcher = newRequestBatcher(Parameters.BatchSize, Parameters.BatchInterval, fundingQueueSize, func(batch []*fundingRequest) {
+		if _, added := fundingWorkerPool.TrySubmit(batch); !added {
+			for _, request := range batch {
+				RemoveAddressFromBlacklist(request.address)
+			}
+			Plugin.LogInfof("dropped batch of %d funding requests as queue is full", len(batch))
+		}
+	})
 
 	preparingWorkerPool = workerpool.NewNonBlockingQueuedWorkerPool(_faucet.prepareTransactionTask,
 		workerpool.WorkerCount(preparingWorkerCount), workerpool.QueueSize(preparingWorkerQueueSize))
 
-	configureEvents()
+	var ctx context.Context
+	ctx, cancelFaucet = context.WithCancel(context.Background())
+	_faucet.shutdownSignal = ctx.Done()
+
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(onMessageProcessed)
+
+	initWG.Add(2)
+	go initialize(ctx)
+	go func() {
+		defer initWG.Done()
+
+		fundingBatcher.Run(ctx)
+	}()
+
+	return nil
 }
 
-func run(plugin *node.Plugin) {
-	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
-		defer plugin.LogInfof("Stopping %s ... done", PluginName)
+// stop stops processing funding requests and releases the resources of the faucet.
+func stop() {
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Detach(onMessageProcessed)
 
-		plugin.LogInfo("Waiting for node to become synced...")
-		if !waitUntilSynced(ctx) {
-			return
-		}
-		plugin.LogInfo("Waiting for node to become synced... done")
+	cancelFaucet()
+	initWG.Wait()
+	initDone.Store(false)
 
-		plugin.LogInfo("Waiting for node to have sufficient access mana")
-		if err := waitForMana(ctx); err != nil {
-			plugin.LogErrorf("failed to get sufficient access mana: %s", err)
-			return
-		}
-		plugin.LogInfo("Waiting for node to have sufficient access mana... done")
+	fundingWorkerPool.Stop()
+	preparingWorkerPool.Stop()
+}
 
-		plugin.LogInfof("Deriving faucet state from the ledger...")
+// initialize waits until the node is synced and has sufficient access mana and derives the state of the faucet.
+func initialize(ctx context.Context) {
+	defer initWG.Done()
 
-		// determine state, prepare more outputs if needed
-		if err := _faucet.DeriveStateFromTangle(ctx); err != nil {
-			plugin.LogErrorf("failed to derive state: %s", err)
-			return
-		}
-		plugin.LogInfo("Deriving faucet state from the ledger... done")
+	Plugin.LogInfo("Waiting for node to become synced...")
+	if !waitUntilSynced(ctx) {
+		return
+	}
+	Plugin.LogInfo("Waiting for node to become synced... done")
 
-		defer fundingWorkerPool.Stop()
-		defer preparingWorkerPool.Stop()
+	Plugin.LogInfo("Waiting for node to have sufficient access mana")
+	if err := waitForMana(ctx); err != nil {
+		Plugin.LogErrorf("failed to get sufficient access mana: %s", err)
+		return
+	}
+	Plugin.LogInfo("Waiting for node to have sufficient access mana... done")
 
-		initDone.Store(true)
+	Plugin.LogInfof("Deriving faucet state from the ledger...")
 
-		<-ctx.Done()
-		plugin.LogInfof("Stopping %s ...", PluginName)
-	}, shutdown.PriorityFaucet); err != nil {
-		plugin.Logger().Panicf("Failed to start daemon: %s", err)
+	// determine state, prepare more outputs if needed
+	if err := _faucet.DeriveStateFromTangle(ctx); err != nil {
+		Plugin.LogErrorf("failed to derive state: %s", err)
+		return
 	}
+	Plugin.LogInfo("Deriving faucet state from the ledger... done")
+
+	initDone.Store(true)
 }
 
 func waitUntilSynced(ctx context.Context) bool {
 	synced := make(chan struct{}, 1)
-	closure := events.NewClosure(func(e *tangle.SyncChangedEvent) {
+	closure := event.NewClosure(func(e *tangle.SyncChangedEvent) {
 		if e.Synced {
 			// use non-blocking send to prevent deadlocks in rare cases when the SyncedChanged events is spammed
 			select {
@@ -208,53 +267,77 @@ func waitForMana(ctx context.Context) error {
 			return nil
 		}
 		Plugin.LogDebugf("insufficient access mana: %f < %f", aMana, tangle.MinMana)
-		time.Sleep(waitForManaWindow)
+		select {
+		case <-ctx.Done():
+			return errors.New("faucet shutting down")
+		case <-time.After(waitForManaWindow):
+		}
 	}
 }
 
-func configureEvents() {
-	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
-		// Do not start picking up request while waiting for initialization.
-		// If faucet nodes crashes and you restart with a clean db, all previous faucet req msgs will be enqueued
-		// and addresses will be funded again. Therefore, do not process any faucet request messages until we are in
-		// sync and initialized.
-		if !initDone.Load() {
+// onMessageProcessed enqueues the funding requests that are processed by the message layer.
+var onMessageProcessed = event.NewClosure(func(messageID tangle.MessageID) {
+	// Do not start picking up request while waiting for initialization.
+	// If faucet nodes crashes and you restart with a clean db, all previous faucet req msgs will be enqueued
+	// and addresses will be funded again. Therefore, do not process any faucet request messages until we are in
+	// sync and initialized.
+	if !initDone.Load() {
+		return
+	}
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		if !faucet.IsFaucetReq(message) {
 			return
 		}
-		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
-			if !faucet.IsFaucetReq(message) {
-				return
-			}
-			fundingRequest := message.Payload().(*faucet.Request)
-			addr := fundingRequest.Address()
-
-			// verify PoW
-			leadingZeroes, err := powVerifier.LeadingZeros(fundingRequest.Bytes())
-			if err != nil {
-				Plugin.LogInfof("couldn't verify PoW of funding request for address %s", addr.Base58())
-				return
-			}
+		fundingRequest := message.Payload().(*faucet.Request)
+		addr := fundingRequest.Address()
 
-			if leadingZeroes < targetPoWDifficulty {
-				Plugin.LogInfof("funding request for address %s doesn't fulfill PoW requirement %d vs. %d", addr.Base58(), targetPoWDifficulty, leadingZeroes)
-				return
-			}
+		// verify PoW
+		leadingZeroes, err := powVerifier.LeadingZeros(fundingRequest.Bytes())
+		if err != nil {
+			Plugin.LogInfof("couldn't verify PoW of funding request for address %s", addr.Base58())
+			return
+		}
 
-			if IsAddressBlackListed(addr) {
-				Plugin.LogInfof("can't fund address %s since it is blacklisted", addr.Base58())
-				return
-			}
+		if leadingZeroes < targetPoWDifficulty {
+			Plugin.LogInfof("funding request for address %s doesn't fulfill PoW requirement %d vs. %d", addr.Base58(), targetPoWDifficulty, leadingZeroes)
+			return
+		}
 
-			// finally add it to the faucet to be processed
-			_, added := fundingWorkerPool.TrySubmit(message)
-			if !added {
-				RemoveAddressFromBlacklist(addr)
-				Plugin.LogInfof("dropped funding request for address %s as queue is full", addr.Base58())
-				return
-			}
-			Plugin.LogInfof("enqueued funding request for address %s", addr.Base58())
+		if IsAddressBlackListed(addr) {
+			Plugin.LogInfof("can't fund address %s since it is blacklisted", addr.Base58())
+			return
+		}
+
+		// finally add it to the faucet to be processed
+		if !fundingBatcher.Submit(newFundingRequest(message)) {
+			RemoveAddressFromBlacklist(addr)
+			Plugin.LogInfof("dropped funding request for address %s as queue is full", addr.Base58())
+			return
+		}
+		Plugin.LogInfof("enqueued funding request for address %s", addr.Base58())
+	})
+})
+
+// fulfillFundingRequests fulfills the given batch of funding requests within a single transaction.
+func fulfillFundingRequests(batch []*fundingRequest) {
+	addresses := make([]ledgerstate.Address, 0, len(batch))
+	for _, request := range batch {
+		addresses = append(addresses, request.address)
+	}
+
+	msg, txID, err := _faucet.FulFillFundingRequests(addresses, batch[0].pledge.accessManaPledgeID, batch[0].pledge.consensusManaPledgeID)
+	if err != nil {
+		Plugin.LogWarnf("couldn't fulfill %d funding requests: %s", len(batch), err)
+		return
+	}
+	Plugin.LogInfof("sent funds to %d addresses via tx %s and msg %s", len(addresses), txID, msg.ID())
+	for _, addr := range addresses {
+		Events.FundingRequestFulfilled.Trigger(&FundingRequestFulfilledEvent{
+			Address:       addr,
+			TransactionID: txID,
+			MessageID:     msg.ID(),
 		})
-	}))
+	}
 }
 
 // IsAddressBlackListed returns if an address is blacklisted.
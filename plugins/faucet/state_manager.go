@@ -3,12 +3,13 @@ package faucet
 import (
 	"container/list"
 	"context"
+	"math"
+	"sort"
 	"sync"
 	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/types"
 	"github.com/iotaledger/hive.go/typeutils"
@@ -17,7 +18,7 @@ import (
 
 	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
 	"github.com/iotaledger/goshimmer/packages/clock"
-	"github.com/iotaledger/goshimmer/packages/faucet"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
@@ -27,6 +28,9 @@ const (
 	// RemainderAddressIndex is the RemainderAddressIndex.
 	RemainderAddressIndex = 0
 
+	// MaxRemainderOutputsCount defines the max number of remainder outputs the faucet splits its funds into.
+	MaxRemainderOutputsCount = MaxFaucetOutputsCount
+
 	// MinFundingOutputsPercentage defines the min percentage of prepared funding outputs left that triggers a replenishment.
 	MinFundingOutputsPercentage = 0.3
 
@@ -68,6 +72,12 @@ type StateManager struct {
 	tokensPerSupplyOutput uint64
 	// the amount of tokens a supply replenishment will deduct from the faucet remainder
 	tokensUsedOnSupplyReplenishment uint64
+	// number of remainder outputs that the funds of the faucet are split into
+	remainderOutputsCount uint64
+	// number of remainder outputs that are spent concurrently by the supply transactions of a replenishment
+	supplyTransactionsCount uint64
+	// number of supply outputs that each supply transaction creates
+	supplyOutputsPerTransaction uint64
 
 	// the time to await for the transaction fulfilling a funding request
 	// to become booked in the value layer
@@ -92,6 +102,7 @@ func NewStateManager(
 	seed *walletseed.Seed,
 	supplyOutputsCount uint64,
 	splittingMultiplier uint64,
+	remainderOutputsCount uint64,
 	maxTxBookedTime time.Duration,
 ) *StateManager {
 	// the max number of outputs in a tx is 127, therefore, when creating the splitting tx, we can have at most
@@ -103,6 +114,16 @@ func NewStateManager(
 	if splittingMultiplier > MaxFaucetOutputsCount {
 		splittingMultiplier = MaxFaucetOutputsCount
 	}
+	if remainderOutputsCount > MaxRemainderOutputsCount {
+		remainderOutputsCount = MaxRemainderOutputsCount
+	}
+	// every remainder output funds its own supply transaction, the supply outputs are distributed evenly among them
+	supplyTransactionsCount := remainderOutputsCount
+	if supplyTransactionsCount > supplyOutputsCount {
+		supplyTransactionsCount = supplyOutputsCount
+	}
+	supplyOutputsPerTransaction := supplyOutputsCount / supplyTransactionsCount
+	supplyOutputsCount = supplyOutputsPerTransaction * supplyTransactionsCount
 
 	fState := newFundingState()
 	pState := newPreparingState(seed)
@@ -116,6 +137,9 @@ func NewStateManager(
 		maxTxBookedAwaitTime:            maxTxBookedTime,
 		tokensPerSupplyOutput:           tokensPerRequest * splittingMultiplier,
 		tokensUsedOnSupplyReplenishment: tokensPerRequest * splittingMultiplier * supplyOutputsCount,
+		remainderOutputsCount:           remainderOutputsCount,
+		supplyTransactionsCount:         supplyTransactionsCount,
+		supplyOutputsPerTransaction:     supplyOutputsPerTransaction,
 
 		fundingState:       fState,
 		replenishmentState: pState,
@@ -125,23 +149,34 @@ func NewStateManager(
 }
 
 // DeriveStateFromTangle derives the faucet state from a synchronized Tangle.
-//  - remainder output should always sit on address 0.
+//  - the first remainder output should always sit on address 0, the other ones on the address indices counting down
+//    from the highest address index.
 //  - supply outputs should be held on address indices 1-126
 //  - funding outputs start from address index 127
-//  - if no funding outputs are found, the faucet creates them from the remainder output.
+//  - if the remainder outputs do not match the configured number, the faucet splits them again.
+//  - if no funding outputs are found, the faucet creates them from the remainder outputs.
 func (s *StateManager) DeriveStateFromTangle(ctx context.Context) (err error) {
 	s.replenishmentState.IsReplenishing.Set()
 	defer s.replenishmentState.IsReplenishing.UnSet()
 
-	if err = s.findUnspentRemainderOutput(); err != nil {
+	if err = s.findUnspentRemainderOutputs(); err != nil {
 		return
 	}
+	Plugin.LogInfof("Found %d remainder outputs", len(s.replenishmentState.RemainderOutputs()))
 
-	endIndex := (Parameters.GenesisTokenAmount-s.replenishmentState.RemainderOutputBalance())/s.tokensPerRequest + MaxFaucetOutputsCount
+	endIndex := (Parameters.GenesisTokenAmount-s.replenishmentState.RemainderOutputsBalance())/s.tokensPerRequest + MaxFaucetOutputsCount
 	Plugin.LogInfof("Set last funding output address index to %d (%d outputs have been prepared in the faucet's lifetime)", endIndex, endIndex-MaxFaucetOutputsCount)
 
 	s.replenishmentState.SetLastFundingOutputAddressIndex(endIndex)
 
+	if s.remainderOutputsSplittingNeeded() {
+		Plugin.LogInfof("Splitting the remainder outputs into %d outputs...", s.remainderOutputsCount)
+		if err = s.splitRemainderOutputs(); err != nil {
+			return errors.Errorf("failed to split the remainder outputs: %w", err)
+		}
+		Plugin.LogInfof("Splitting the remainder outputs into %d outputs... DONE", s.remainderOutputsCount)
+	}
+
 	// check for any unfinished replenishments and use all available supply outputs
 	if supplyOutputsFound := s.findSupplyOutputs(); supplyOutputsFound > 0 {
 		Plugin.LogInfof("Found %d available supply outputs", s.replenishmentState.SupplyOutputsCount())
@@ -167,42 +202,29 @@ func (s *StateManager) DeriveStateFromTangle(ctx context.Context) (err error) {
 
 	Plugin.LogInfof("Added new funding outputs, last used address index is %d", s.replenishmentState.GetLastFundingOutputAddressIndex())
 	Plugin.LogInfof("There are currently %d funding outputs available", s.fundingState.FundingOutputsCount())
-	Plugin.LogInfof("Remainder output %s has %d tokens available", s.replenishmentState.RemainderOutputID().Base58(), s.replenishmentState.RemainderOutputBalance())
+	Plugin.LogInfof("%d remainder outputs have %d tokens available", len(s.replenishmentState.RemainderOutputs()), s.replenishmentState.RemainderOutputsBalance())
 
 	return err
 }
 
-// FulFillFundingRequest fulfills a faucet request by spending the next funding output to the requested address.
-// Mana of the transaction is pledged to the requesting node.
-func (s *StateManager) FulFillFundingRequest(requestMsg *tangle.Message) (*tangle.Message, string, error) {
-	faucetReq := requestMsg.Payload().(*faucet.Request)
-
+// FulFillFundingRequests fulfills the funding requests of the given addresses within a single transaction that spends
+// one funding output per address. Mana of the transaction is pledged to the given nodes.
+func (s *StateManager) FulFillFundingRequests(addresses []ledgerstate.Address, accessManaPledgeID, consensusManaPledgeID identity.ID) (*tangle.Message, string, error) {
 	if s.replenishThresholdReached() {
-		// wait for replenishment to finish if there is no funding outputs prepared
-		waitForPreparation := s.fundingState.FundingOutputsCount() == 0
+		// wait for replenishment to finish if there are not enough funding outputs prepared
+		waitForPreparation := s.fundingState.FundingOutputsCount() < len(addresses)
 		s.signalReplenishmentNeeded(waitForPreparation)
 	}
 
-	// get an output that we can spend
-	fundingOutput, fErr := s.fundingState.GetFundingOutput()
-	// we don't have funding outputs
+	// get the outputs that we can spend
+	fundingOutputs, fErr := s.fundingState.GetFundingOutputs(len(addresses))
+	// we don't have enough funding outputs
 	if errors.Is(fErr, ErrNotEnoughFundingOutputs) {
 		err := errors.Errorf("failed to gather funding outputs: %w", fErr)
 		return nil, "", err
 	}
 
-	// prepare funding tx, pledge mana to requester
-	emptyID := identity.ID{}
-	accessManaPledgeID := identity.NewID(requestMsg.IssuerPublicKey())
-	consensusManaPledgeID := identity.NewID(requestMsg.IssuerPublicKey())
-	if faucetReq.AccessManaPledgeID() != emptyID {
-		accessManaPledgeID = faucetReq.AccessManaPledgeID()
-	}
-	if faucetReq.ConsensusManaPledgeID() != emptyID {
-		consensusManaPledgeID = faucetReq.ConsensusManaPledgeID()
-	}
-
-	tx := s.prepareFaucetTransaction(faucetReq.Address(), fundingOutput, accessManaPledgeID, consensusManaPledgeID)
+	tx := s.prepareFaucetTransaction(addresses, fundingOutputs, accessManaPledgeID, consensusManaPledgeID)
 
 	// issue funding request
 	m, err := s.issueTx(tx)
@@ -235,18 +257,35 @@ func (s *StateManager) signalReplenishmentNeeded(wait bool) {
 	}
 }
 
-// prepareFaucetTransaction prepares a funding faucet transaction that spends fundingOutput to destAddr and pledges
-// mana to pledgeID.
-func (s *StateManager) prepareFaucetTransaction(destAddr ledgerstate.Address, fundingOutput *FaucetOutput, accessManaPledgeID, consensusManaPledgeID identity.ID) (tx *ledgerstate.Transaction) {
-	inputs := ledgerstate.NewInputs(ledgerstate.NewUTXOInput(fundingOutput.ID))
+// prepareFaucetTransaction prepares a funding faucet transaction that spends fundingOutputs to destAddrs and pledges
+// mana to the given nodes.
+func (s *StateManager) prepareFaucetTransaction(destAddrs []ledgerstate.Address, fundingOutputs []*FaucetOutput, accessManaPledgeID, consensusManaPledgeID identity.ID) (tx *ledgerstate.Transaction) {
+	// an address that is requested more than once receives a single output
+	balances := make(map[string]uint64, len(destAddrs))
+	uniqueAddrs := make([]ledgerstate.Address, 0, len(destAddrs))
+	for _, destAddr := range destAddrs {
+		if _, exists := balances[destAddr.Base58()]; !exists {
+			uniqueAddrs = append(uniqueAddrs, destAddr)
+		}
+		balances[destAddr.Base58()] += s.tokensPerRequest
+	}
+	outputs := make(ledgerstate.Outputs, 0, len(uniqueAddrs))
+	for _, destAddr := range uniqueAddrs {
+		outputs = append(outputs, s.createOutput(destAddr, balances[destAddr.Base58()]))
+	}
 
-	outputs := ledgerstate.NewOutputs(ledgerstate.NewSigLockedColoredOutput(
-		ledgerstate.NewColoredBalances(
-			map[ledgerstate.Color]uint64{
-				ledgerstate.ColorIOTA: s.tokensPerRequest,
-			}),
-		destAddr,
-	))
+	return s.createTransaction(fundingOutputs, outputs, accessManaPledgeID, consensusManaPledgeID)
+}
+
+// createTransaction creates a transaction that spends the given faucet outputs and signs each of them with the key of
+// its address.
+func (s *StateManager) createTransaction(spentOutputs []*FaucetOutput, outputs ledgerstate.Outputs, accessManaPledgeID, consensusManaPledgeID identity.ID) *ledgerstate.Transaction {
+	inputs := make(ledgerstate.Inputs, 0, len(spentOutputs))
+	spentOutputsByID := make(map[ledgerstate.OutputID]*FaucetOutput, len(spentOutputs))
+	for _, spentOutput := range spentOutputs {
+		inputs = append(inputs, ledgerstate.NewUTXOInput(spentOutput.ID))
+		spentOutputsByID[spentOutput.ID] = spentOutput
+	}
 
 	essence := ledgerstate.NewTransactionEssence(
 		0,
@@ -257,14 +296,15 @@ func (s *StateManager) prepareFaucetTransaction(destAddr ledgerstate.Address, fu
 		ledgerstate.NewOutputs(outputs...),
 	)
 
-	w := wallet{keyPair: *s.replenishmentState.seed.KeyPair(fundingOutput.AddressIndex)}
-	unlockBlock := ledgerstate.NewSignatureUnlockBlock(w.sign(essence))
+	// the unlock blocks have to follow the order of the sorted inputs of the essence
+	unlockBlocks := make(ledgerstate.UnlockBlocks, 0, len(essence.Inputs()))
+	for _, input := range essence.Inputs() {
+		spentOutput := spentOutputsByID[input.(*ledgerstate.UTXOInput).ReferencedOutputID()]
+		w := wallet{keyPair: *s.replenishmentState.seed.KeyPair(spentOutput.AddressIndex)}
+		unlockBlocks = append(unlockBlocks, ledgerstate.NewSignatureUnlockBlock(w.sign(essence)))
+	}
 
-	tx = ledgerstate.NewTransaction(
-		essence,
-		ledgerstate.UnlockBlocks{unlockBlock},
-	)
-	return
+	return ledgerstate.NewTransaction(essence, unlockBlocks)
 }
 
 // saveFundingOutputs saves the given slice of indices in StateManager and updates lastFundingOutputAddressIndex.
@@ -318,42 +358,92 @@ func (s *StateManager) findFundingOutputs() []*FaucetOutput {
 	return foundPreparedOutputs
 }
 
-// findUnspentRemainderOutput finds the remainder output and updates the state manager.
-func (s *StateManager) findUnspentRemainderOutput() error {
-	var foundRemainderOutput *FaucetOutput
-
-	remainderAddress := s.replenishmentState.seed.Address(RemainderAddressIndex).Address()
-
-	// remainder output should sit on address 0
-	deps.Tangle.LedgerState.CachedOutputsOnAddress(remainderAddress).Consume(func(output ledgerstate.Output) {
-		deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
-			if deps.Tangle.LedgerState.ConfirmedConsumer(output.ID()) == ledgerstate.GenesisTransactionID &&
-				deps.Tangle.ConfirmationOracle.IsOutputConfirmed(outputMetadata.ID()) {
-				iotaBalance, ok := output.Balances().Get(ledgerstate.ColorIOTA)
-				if !ok || iotaBalance < uint64(minFaucetBalanceMultiplier*float64(Parameters.GenesisTokenAmount)) {
-					return
-				}
-				if foundRemainderOutput != nil && iotaBalance < foundRemainderOutput.Balance {
-					// when multiple "big" unspent outputs sit on this address, take the biggest one
-					return
-				}
-				foundRemainderOutput = &FaucetOutput{
-					ID:           output.ID(),
-					Balance:      iotaBalance,
-					Address:      output.Address(),
-					AddressIndex: RemainderAddressIndex,
-				}
+// findUnspentRemainderOutputs finds the remainder outputs on all remainder addresses and updates the state manager.
+func (s *StateManager) findUnspentRemainderOutputs() error {
+	remainderOutputs := make(map[uint64]*FaucetOutput)
+	for remainderIndex := uint64(0); remainderIndex < MaxRemainderOutputsCount; remainderIndex++ {
+		addressIndex := remainderAddressIndex(remainderIndex)
+		remainderAddress := s.replenishmentState.seed.Address(addressIndex).Address()
+		s.replenishmentState.AddAddressToIndex(remainderAddress.Base58(), addressIndex)
+
+		deps.Tangle.LedgerState.CachedOutputsOnAddress(remainderAddress).Consume(func(output ledgerstate.Output) {
+			if deps.Tangle.LedgerState.ConfirmedConsumer(output.ID()) != ledgerstate.GenesisTransactionID ||
+				!deps.Tangle.ConfirmationOracle.IsOutputConfirmed(output.ID()) {
+				return
+			}
+			iotaBalance, ok := output.Balances().Get(ledgerstate.ColorIOTA)
+			if !ok {
+				return
+			}
+			if foundRemainderOutput, exists := remainderOutputs[remainderIndex]; exists && iotaBalance < foundRemainderOutput.Balance {
+				// when multiple unspent outputs sit on this address, take the biggest one
+				return
+			}
+			remainderOutputs[remainderIndex] = &FaucetOutput{
+				ID:           output.ID(),
+				Balance:      iotaBalance,
+				Address:      output.Address(),
+				AddressIndex: addressIndex,
 			}
 		})
-	})
-	if foundRemainderOutput == nil {
-		return errors.Errorf("can't find an output on address %s that has at least %d tokens", remainderAddress.Base58(), int(minFaucetBalanceMultiplier*float64(Parameters.GenesisTokenAmount)))
 	}
-	s.replenishmentState.SetRemainderOutput(foundRemainderOutput)
+
+	s.replenishmentState.SetRemainderOutputs(remainderOutputs)
+	if minBalance := uint64(minFaucetBalanceMultiplier * float64(Parameters.GenesisTokenAmount)); s.replenishmentState.RemainderOutputsBalance() < minBalance {
+		return errors.Errorf("can't find remainder outputs on address %s that have at least %d tokens", s.replenishmentState.seed.Address(RemainderAddressIndex).Address().Base58(), minBalance)
+	}
 
 	return nil
 }
 
+// remainderOutputsSplittingNeeded checks if the remainder outputs have to be split (again) because they do not sit on
+// the configured remainder addresses or because there are not enough of them that can fund a supply transaction.
+func (s *StateManager) remainderOutputsSplittingNeeded() bool {
+	remainderOutputs := s.replenishmentState.RemainderOutputs()
+	for remainderIndex := range remainderOutputs {
+		if remainderIndex >= s.remainderOutputsCount {
+			return true
+		}
+	}
+	if uint64(len(remainderOutputs)) != s.remainderOutputsCount {
+		return true
+	}
+
+	// rebalance the remainder outputs if more of them could fund a supply transaction after splitting them evenly
+	return uint64(len(s.supplyRemainderOutputs())) < s.supplyTransactionsCount &&
+		s.replenishmentState.RemainderOutputsBalance()/s.remainderOutputsCount >= s.tokensPerSupplyOutput*s.supplyOutputsPerTransaction
+}
+
+// supplyRemainderOutputs returns the indices of the remainder outputs that have enough funds for a supply transaction.
+func (s *StateManager) supplyRemainderOutputs() (remainderIndices []uint64) {
+	for remainderIndex, remainderOutput := range s.replenishmentState.RemainderOutputs() {
+		if remainderOutput.Balance >= s.tokensPerSupplyOutput*s.supplyOutputsPerTransaction {
+			remainderIndices = append(remainderIndices, remainderIndex)
+		}
+	}
+	sort.Slice(remainderIndices, func(i, j int) bool {
+		return remainderIndices[i] < remainderIndices[j]
+	})
+
+	return remainderIndices
+}
+
+// splitRemainderOutputs merges all remainder outputs and splits their funds evenly into remainderOutputsCount outputs.
+func (s *StateManager) splitRemainderOutputs() (err error) {
+	errChan := make(chan error)
+	listenerAttachedChan := make(chan types.Empty)
+	s.splittingEnv = newSplittingEnv()
+
+	go s.updateStateOnConfirmation(1, errChan, listenerAttachedChan)
+	<-listenerAttachedChan
+	if _, ok := preparingWorkerPool.TrySubmit(s.remainderSplittingTransactionElements, errChan); !ok {
+		Plugin.LogWarn("remainder splitting task not submitted, queue is full")
+	}
+
+	// wait for updateStateOnConfirmation to return
+	return <-s.splittingEnv.listeningFinished
+}
+
 // findSupplyOutputs looks for targetSupplyOutputsCount number of outputs and updates the StateManager.
 func (s *StateManager) findSupplyOutputs() uint64 {
 	var foundSupplyCount uint64
@@ -391,17 +481,17 @@ func (s *StateManager) findSupplyOutputs() uint64 {
 	return foundSupplyCount
 }
 
-// replenishSupplyAndFundingOutputs create a supply transaction splitting up the remainder output to targetSupplyOutputsCount outputs plus a new remainder output.
-// After the supply transaction is confirmed it uses each supply output and splits it for splittingMultiplier many times to generate funding outputs.
+// replenishSupplyAndFundingOutputs creates supply transactions splitting up the remainder outputs to targetSupplyOutputsCount outputs plus new remainder outputs.
+// After the supply transactions are confirmed it uses each supply output and splits it for splittingMultiplier many times to generate funding outputs.
 // After confirmation of each splitting transaction, outputs are added to fundingOutputs list.
-// The faucet remainder is stored on address 0. Next 126 indexes are reserved for supply outputs.
+// The first faucet remainder is stored on address 0. Next 126 indexes are reserved for supply outputs.
 func (s *StateManager) replenishSupplyAndFundingOutputs() (err error) {
 	s.replenishmentState.WaitGroup.Add(1)
 	defer s.replenishmentState.WaitGroup.Done()
 
 	defer s.replenishmentState.IsReplenishing.UnSet()
 
-	if err = s.findUnspentRemainderOutput(); err != nil {
+	if err = s.findUnspentRemainderOutputs(); err != nil {
 		return errors.Errorf("%w: %w", ErrMissingRemainderOutput, err)
 	}
 
@@ -410,6 +500,12 @@ func (s *StateManager) replenishSupplyAndFundingOutputs() (err error) {
 		return
 	}
 
+	if s.remainderOutputsSplittingNeeded() {
+		if err = s.splitRemainderOutputs(); err != nil {
+			return errors.Errorf("%w: failed to split the remainder outputs: %w", ErrSupplyPreparationFailed, err)
+		}
+	}
+
 	if err = s.replenishSupplyOutputs(); err != nil {
 		return errors.Errorf("%w: %w", ErrSupplyPreparationFailed, err)
 	}
@@ -437,19 +533,29 @@ func (s *StateManager) handleReplenishmentErrors(err error) error {
 
 // enoughFundsForSupplyReplenishment indicates if there are enough funds left to commence a supply replenishment.
 func (s *StateManager) enoughFundsForSupplyReplenishment() bool {
-	return s.replenishmentState.RemainderOutputBalance() >= s.tokensUsedOnSupplyReplenishment
+	return s.replenishmentState.RemainderOutputsBalance() >= s.tokensUsedOnSupplyReplenishment
 }
 
-// replenishSupplyOutputs takes the faucet remainder output and splits it up to create supply outputs that will be used for replenishing the funding outputs.
+// replenishSupplyOutputs takes the faucet remainder outputs and splits them up concurrently to create supply outputs that will be used for replenishing the funding outputs.
 func (s *StateManager) replenishSupplyOutputs() (err error) {
+	remainderIndices := s.supplyRemainderOutputs()
+	if len(remainderIndices) == 0 {
+		return ErrNotEnoughFunds
+	}
+	if uint64(len(remainderIndices)) > s.supplyTransactionsCount {
+		remainderIndices = remainderIndices[:s.supplyTransactionsCount]
+	}
+
 	errChan := make(chan error)
 	listenerAttachedChan := make(chan types.Empty)
 	s.splittingEnv = newSplittingEnv()
 
-	go s.updateStateOnConfirmation(1, errChan, listenerAttachedChan)
+	go s.updateStateOnConfirmation(uint64(len(remainderIndices)), errChan, listenerAttachedChan)
 	<-listenerAttachedChan
-	if _, ok := preparingWorkerPool.TrySubmit(s.supplyTransactionElements, errChan); !ok {
-		Plugin.LogWarn("supply replenishment task not submitted, queue is full")
+	for i, remainderIndex := range remainderIndices {
+		if _, ok := preparingWorkerPool.TrySubmit(s.supplyTransactionElements(remainderIndex, uint64(i)), errChan); !ok {
+			Plugin.LogWarn("supply replenishment task not submitted, queue is full")
+		}
 	}
 
 	// wait for updateStateOnConfirmation to return
@@ -460,7 +566,7 @@ func (s *StateManager) replenishSupplyOutputs() (err error) {
 // to create either supply or split transaction, error channel (param 1) to signal failure during preparation
 // or issuance and decrement number of expected confirmations.
 func (s *StateManager) prepareTransactionTask(task workerpool.Task) {
-	transactionElementsCallback := task.Param(0).(func() (inputs ledgerstate.Inputs, outputs ledgerstate.Outputs, w wallet, err error))
+	transactionElementsCallback := task.Param(0).(func() (spentOutputs []*FaucetOutput, outputs ledgerstate.Outputs, err error))
 	preparationFailed := task.Param(1).(chan error)
 
 	tx, err := s.createSplittingTx(transactionElementsCallback)
@@ -504,7 +610,7 @@ func (s *StateManager) updateStateOnConfirmation(txNumToProcess uint64, preparat
 	// buffered channel will store all confirmed transactions
 	txConfirmed := make(chan ledgerstate.TransactionID, txNumToProcess) // length is s.targetSupplyOutputsCount or 1
 
-	monitorTxConfirmation := events.NewClosure(func(transactionID ledgerstate.TransactionID) {
+	monitorTxConfirmation := event.NewClosure(func(transactionID ledgerstate.TransactionID) {
 		if s.splittingEnv.WasIssuedInThisPreparation(transactionID) {
 			txConfirmed <- transactionID
 		}
@@ -572,10 +678,13 @@ func (s *StateManager) onConfirmation(confirmedTx ledgerstate.TransactionID, iss
 	return false
 }
 
-// updateState takes a confirmed transaction (splitting or supply tx), and updates the faucet internal state based on its content.
+// updateState takes a confirmed transaction (remainder splitting, supply or splitting tx), and updates the faucet internal state based on its content.
 func (s *StateManager) updateState(transactionID ledgerstate.TransactionID) (err error) {
 	deps.Tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
-		newFaucetRemainderBalance := s.replenishmentState.RemainderOutputBalance() - s.tokensUsedOnSupplyReplenishment
+		// the remainder outputs that are spent by the transaction are replaced by its outputs
+		for _, input := range transaction.Essence().Inputs() {
+			s.replenishmentState.RemoveRemainderOutput(input.(*ledgerstate.UTXOInput).ReferencedOutputID())
+		}
 
 		// derive information from outputs
 		for _, output := range transaction.Essence().Outputs() {
@@ -584,28 +693,28 @@ func (s *StateManager) updateState(transactionID ledgerstate.TransactionID) (err
 				err = errors.Errorf("tx outputs don't have IOTA balance ")
 				return
 			}
-			switch iotaBalance {
-			case s.tokensPerRequest:
-				s.fundingState.FundingOutputsAdd(&FaucetOutput{
-					ID:           output.ID(),
-					Balance:      iotaBalance,
-					Address:      output.Address(),
-					AddressIndex: s.replenishmentState.GetAddressToIndex(output.Address().Base58()),
-				})
-			case newFaucetRemainderBalance:
-				s.replenishmentState.SetRemainderOutput(&FaucetOutput{
-					ID:           output.ID(),
-					Balance:      iotaBalance,
-					Address:      output.Address(),
-					AddressIndex: s.replenishmentState.GetAddressToIndex(output.Address().Base58()),
-				})
-			case s.tokensPerSupplyOutput:
-				s.replenishmentState.AddSupplyOutput(&FaucetOutput{
-					ID:           output.ID(),
-					Balance:      iotaBalance,
-					Address:      output.Address(),
-					AddressIndex: s.replenishmentState.GetAddressToIndex(output.Address().Base58()),
-				})
+			addressIndex, exists := s.replenishmentState.GetAddressToIndex(output.Address().Base58())
+			if !exists {
+				err = errors.Errorf("tx %s should not have output on address %s", transactionID.Base58(), output.Address().Base58())
+				return
+			}
+			faucetOutput := &FaucetOutput{
+				ID:           output.ID(),
+				Balance:      iotaBalance,
+				Address:      output.Address(),
+				AddressIndex: addressIndex,
+			}
+
+			if remainderIndex, isRemainder := remainderOutputIndex(addressIndex); isRemainder {
+				s.replenishmentState.SetRemainderOutput(remainderIndex, faucetOutput)
+				continue
+			}
+
+			switch {
+			case addressIndex <= MaxFaucetOutputsCount && iotaBalance == s.tokensPerSupplyOutput:
+				s.replenishmentState.AddSupplyOutput(faucetOutput)
+			case addressIndex > MaxFaucetOutputsCount && iotaBalance == s.tokensPerRequest:
+				s.fundingState.FundingOutputsAdd(faucetOutput)
 			default:
 				err = errors.Errorf("tx %s should not have output with balance %d", transactionID.Base58(), iotaBalance)
 				return
@@ -617,63 +726,88 @@ func (s *StateManager) updateState(transactionID ledgerstate.TransactionID) (err
 }
 
 // createSplittingTx creates splitting transaction based on provided callback function.
-func (s *StateManager) createSplittingTx(transactionElementsCallback func() (ledgerstate.Inputs, ledgerstate.Outputs, wallet, error)) (*ledgerstate.Transaction, error) {
-	inputs, outputs, w, err := transactionElementsCallback()
+func (s *StateManager) createSplittingTx(transactionElementsCallback func() ([]*FaucetOutput, ledgerstate.Outputs, error)) (*ledgerstate.Transaction, error) {
+	spentOutputs, outputs, err := transactionElementsCallback()
 	if err != nil {
 		return nil, err
 	}
-	essence := ledgerstate.NewTransactionEssence(
-		0,
-		clock.SyncedTime(),
-		deps.Local.ID(),
-		// consensus mana is pledged to EmptyNodeID
-		identity.ID{},
-		ledgerstate.NewInputs(inputs...),
-		ledgerstate.NewOutputs(outputs...),
-	)
 
-	unlockBlock := ledgerstate.NewSignatureUnlockBlock(w.sign(essence))
-
-	tx := ledgerstate.NewTransaction(
-		essence,
-		ledgerstate.UnlockBlocks{unlockBlock},
-	)
-	return tx, nil
+	// consensus mana is pledged to EmptyNodeID
+	return s.createTransaction(spentOutputs, outputs, deps.Local.ID(), identity.ID{}), nil
 }
 
-// supplyTransactionElements is a callback function used during supply transaction creation.
-// It takes the current remainder output and creates a supply transaction into targetSupplyOutputsCount
-// outputs and one remainder output. It uses address indices 1 to targetSupplyOutputsCount because each address in
-// a transaction output has to be unique and can prepare at most MaxFaucetOutputsCount supply outputs at once.
-func (s *StateManager) supplyTransactionElements() (inputs ledgerstate.Inputs, outputs ledgerstate.Outputs, w wallet, err error) {
-	inputs = ledgerstate.NewInputs(ledgerstate.NewUTXOInput(s.replenishmentState.RemainderOutputID()))
-	// prepare targetSupplyOutputsCount number of supply outputs for further splitting.
-	outputs = make(ledgerstate.Outputs, 0, s.targetSupplyOutputsCount+1)
+// remainderSplittingTransactionElements is a callback function used during creation of the remainder splitting
+// transaction. It merges all remainder outputs and splits their funds evenly into remainderOutputsCount outputs.
+func (s *StateManager) remainderSplittingTransactionElements() (spentOutputs []*FaucetOutput, outputs ledgerstate.Outputs, err error) {
+	remainderOutputs := s.replenishmentState.RemainderOutputs()
+	if len(remainderOutputs) == 0 {
+		return nil, nil, ErrMissingRemainderOutput
+	}
 
-	// all funding outputs will land on supply addresses 1 to 126
-	for index := uint64(1); index < s.targetSupplyOutputsCount+1; index++ {
-		outputs = append(outputs, s.createOutput(s.replenishmentState.seed.Address(index).Address(), s.tokensPerSupplyOutput))
-		s.replenishmentState.AddAddressToIndex(s.replenishmentState.seed.Address(index).Address().Base58(), index)
+	var balance uint64
+	spentOutputs = make([]*FaucetOutput, 0, len(remainderOutputs))
+	for _, remainderOutput := range remainderOutputs {
+		spentOutputs = append(spentOutputs, remainderOutput)
+		balance += remainderOutput.Balance
 	}
 
-	// add the remainder output
-	remainder := s.replenishmentState.RemainderOutputBalance() - s.tokensPerSupplyOutput*s.targetSupplyOutputsCount
-	outputs = append(outputs, s.createOutput(s.replenishmentState.seed.Address(RemainderAddressIndex).Address(), remainder))
+	outputs = make(ledgerstate.Outputs, 0, s.remainderOutputsCount)
+	for remainderIndex := uint64(0); remainderIndex < s.remainderOutputsCount; remainderIndex++ {
+		remainderBalance := balance / s.remainderOutputsCount
+		if remainderIndex == 0 {
+			// the first remainder output receives the tokens that can not be split evenly
+			remainderBalance += balance % s.remainderOutputsCount
+		}
+
+		addressIndex := remainderAddressIndex(remainderIndex)
+		addr := s.replenishmentState.seed.Address(addressIndex).Address()
+		outputs = append(outputs, s.createOutput(addr, remainderBalance))
+		s.replenishmentState.AddAddressToIndex(addr.Base58(), addressIndex)
+	}
 
-	w = wallet{keyPair: *s.replenishmentState.seed.KeyPair(RemainderAddressIndex)}
-	return
+	return spentOutputs, outputs, nil
+}
+
+// supplyTransactionElements returns a callback function used during supply transaction creation.
+// It takes the given remainder output and creates a supply transaction into supplyOutputsPerTransaction
+// outputs and one remainder output. The supply transactions of a replenishment use distinct address indices between 1
+// and targetSupplyOutputsCount because each address in a transaction output has to be unique and at most
+// MaxFaucetOutputsCount supply outputs can be prepared at once.
+func (s *StateManager) supplyTransactionElements(remainderIndex, supplyTransactionIndex uint64) func() ([]*FaucetOutput, ledgerstate.Outputs, error) {
+	return func() (spentOutputs []*FaucetOutput, outputs ledgerstate.Outputs, err error) {
+		remainderOutput, exists := s.replenishmentState.RemainderOutputs()[remainderIndex]
+		if !exists {
+			return nil, nil, errors.Errorf("remainder output %d: %w", remainderIndex, ErrMissingRemainderOutput)
+		}
+
+		// prepare supplyOutputsPerTransaction number of supply outputs for further splitting.
+		outputs = make(ledgerstate.Outputs, 0, s.supplyOutputsPerTransaction+1)
+
+		// all funding outputs will land on supply addresses 1 to 126
+		firstIndex := 1 + supplyTransactionIndex*s.supplyOutputsPerTransaction
+		for index := firstIndex; index < firstIndex+s.supplyOutputsPerTransaction; index++ {
+			outputs = append(outputs, s.createOutput(s.replenishmentState.seed.Address(index).Address(), s.tokensPerSupplyOutput))
+			s.replenishmentState.AddAddressToIndex(s.replenishmentState.seed.Address(index).Address().Base58(), index)
+		}
+
+		// add the remainder output
+		if remainder := remainderOutput.Balance - s.tokensPerSupplyOutput*s.supplyOutputsPerTransaction; remainder > 0 {
+			outputs = append(outputs, s.createOutput(remainderOutput.Address, remainder))
+		}
+
+		return []*FaucetOutput{remainderOutput}, outputs, nil
+	}
 }
 
 // splittingTransactionElements is a callback function used during creation of splitting transactions.
 // It splits a supply output into funding outputs and uses lastFundingOutputAddressIndex to derive their target address.
-func (s *StateManager) splittingTransactionElements() (inputs ledgerstate.Inputs, outputs ledgerstate.Outputs, w wallet, err error) {
+func (s *StateManager) splittingTransactionElements() (spentOutputs []*FaucetOutput, outputs ledgerstate.Outputs, err error) {
 	supplyOutput, err := s.replenishmentState.NextSupplyOutput()
 	if err != nil {
 		err = errors.Errorf("could not retrieve supply output: %w", err)
 		return
 	}
 
-	inputs = ledgerstate.NewInputs(ledgerstate.NewUTXOInput(supplyOutput.ID))
 	outputs = make(ledgerstate.Outputs, 0, s.splittingMultiplier)
 
 	for i := uint64(0); i < s.splittingMultiplier; i++ {
@@ -682,9 +816,8 @@ func (s *StateManager) splittingTransactionElements() (inputs ledgerstate.Inputs
 		outputs = append(outputs, s.createOutput(addr, s.tokensPerRequest))
 		s.replenishmentState.AddAddressToIndex(addr.Base58(), index)
 	}
-	w = wallet{keyPair: *s.replenishmentState.seed.KeyPair(supplyOutput.AddressIndex)}
 
-	return
+	return []*FaucetOutput{supplyOutput}, outputs, nil
 }
 
 // createOutput creates an output based on provided address and balance.
@@ -804,15 +937,19 @@ func (f *fundingState) FundingOutputsAdd(fundingOutput *FaucetOutput) {
 	f.fundingOutputs.PushBack(fundingOutput)
 }
 
-// GetFundingOutput returns the first funding output in the list.
-func (f *fundingState) GetFundingOutput() (fundingOutput *FaucetOutput, err error) {
+// GetFundingOutputs returns the given number of funding outputs from the front of the list. No output is removed if
+// there are not enough of them.
+func (f *fundingState) GetFundingOutputs(count int) (fundingOutputs []*FaucetOutput, err error) {
 	f.Lock()
 	defer f.Unlock()
 
-	if f.fundingOutputsCount() < 1 {
+	if f.fundingOutputsCount() < count {
 		return nil, ErrNotEnoughFundingOutputs
 	}
-	fundingOutput = f.fundingOutputs.Remove(f.fundingOutputs.Front()).(*FaucetOutput)
+	fundingOutputs = make([]*FaucetOutput, 0, count)
+	for i := 0; i < count; i++ {
+		fundingOutputs = append(fundingOutputs, f.fundingOutputs.Remove(f.fundingOutputs.Front()).(*FaucetOutput))
+	}
 	return
 }
 
@@ -822,8 +959,8 @@ func (f *fundingState) fundingOutputsCount() int {
 
 // replenishmentState keeps all variables and related methods used to track faucet state during replenishment.
 type replenishmentState struct {
-	// output that holds the remainder funds to the faucet, should always be on address 0
-	remainderOutput *FaucetOutput
+	// outputs that hold the remainder funds of the faucet by their index, the first one should always be on address 0
+	remainderOutputs map[uint64]*FaucetOutput
 	// outputs that hold funds during the replenishment phase, filled in only with outputs needed for next split, should always be on address 1
 	supplyOutputs *list.List
 	// the last funding output address index, should start from MaxFaucetOutputsCount + 1
@@ -850,32 +987,60 @@ func newPreparingState(seed *walletseed.Seed) *replenishmentState {
 		},
 		lastFundingOutputAddressIndex: MaxFaucetOutputsCount,
 		supplyOutputs:                 list.New(),
-		remainderOutput:               nil,
+		remainderOutputs:              make(map[uint64]*FaucetOutput),
 	}
 	return state
 }
 
-// RemainderOutputBalance returns the balance value of remainderOutput.
-func (p *replenishmentState) RemainderOutputBalance() uint64 {
+// RemainderOutputsBalance returns the total balance of the remainderOutputs.
+func (p *replenishmentState) RemainderOutputsBalance() (balance uint64) {
 	p.RLock()
 	defer p.RUnlock()
-	return p.remainderOutput.Balance
+
+	for _, remainderOutput := range p.remainderOutputs {
+		balance += remainderOutput.Balance
+	}
+	return balance
 }
 
-// RemainderOutputID returns the OutputID of remainderOutput.
-func (p *replenishmentState) RemainderOutputID() ledgerstate.OutputID {
+// RemainderOutputs returns a copy of the remainderOutputs.
+func (p *replenishmentState) RemainderOutputs() (remainderOutputs map[uint64]*FaucetOutput) {
 	p.RLock()
 	defer p.RUnlock()
-	id := p.remainderOutput.ID
-	return id
+
+	remainderOutputs = make(map[uint64]*FaucetOutput, len(p.remainderOutputs))
+	for remainderIndex, remainderOutput := range p.remainderOutputs {
+		remainderOutputs[remainderIndex] = remainderOutput
+	}
+	return remainderOutputs
 }
 
-// SetRemainderOutput sets provided output as remainderOutput.
-func (p *replenishmentState) SetRemainderOutput(output *FaucetOutput) {
+// SetRemainderOutputs replaces the remainderOutputs.
+func (p *replenishmentState) SetRemainderOutputs(remainderOutputs map[uint64]*FaucetOutput) {
 	p.Lock()
 	defer p.Unlock()
 
-	p.remainderOutput = output
+	p.remainderOutputs = remainderOutputs
+}
+
+// SetRemainderOutput sets provided output as the remainder output with the given index.
+func (p *replenishmentState) SetRemainderOutput(remainderIndex uint64, output *FaucetOutput) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.remainderOutputs[remainderIndex] = output
+}
+
+// RemoveRemainderOutput removes the remainder output with the given OutputID if it exists.
+func (p *replenishmentState) RemoveRemainderOutput(outputID ledgerstate.OutputID) {
+	p.Lock()
+	defer p.Unlock()
+
+	for remainderIndex, remainderOutput := range p.remainderOutputs {
+		if remainderOutput.ID == outputID {
+			delete(p.remainderOutputs, remainderIndex)
+		}
+	}
 }
 
 // nextSupplyOutput returns the first supply address in the list.
@@ -937,11 +1102,12 @@ func (p *replenishmentState) SetLastFundingOutputAddressIndex(index uint64) {
 }
 
 // GetAddressToIndex returns index for provided address based on addressToIndex map.
-func (p *replenishmentState) GetAddressToIndex(addr string) uint64 {
+func (p *replenishmentState) GetAddressToIndex(addr string) (index uint64, exists bool) {
 	p.RLock()
 	defer p.RUnlock()
 
-	return p.addressToIndex[addr]
+	index, exists = p.addressToIndex[addr]
+	return
 }
 
 // AddAddressToIndex adds address and corresponding index to the addressToIndex map.
@@ -967,3 +1133,24 @@ func (w wallet) publicKey() ed25519.PublicKey {
 func (w wallet) sign(txEssence *ledgerstate.TransactionEssence) *ledgerstate.ED25519Signature {
 	return ledgerstate.NewED25519Signature(w.publicKey(), w.privateKey().Sign(txEssence.Bytes()))
 }
+
+// remainderAddressIndex returns the address index of the remainder output with the given index. The first remainder
+// output sits on RemainderAddressIndex, the other ones on the address indices counting down from the highest address
+// index, so that they never collide with the supply and funding outputs.
+func remainderAddressIndex(remainderIndex uint64) uint64 {
+	if remainderIndex == 0 {
+		return RemainderAddressIndex
+	}
+	return math.MaxUint64 - remainderIndex + 1
+}
+
+// remainderOutputIndex returns the index of the remainder output that sits on the given address index.
+func remainderOutputIndex(addressIndex uint64) (remainderIndex uint64, isRemainder bool) {
+	if addressIndex == RemainderAddressIndex {
+		return 0, true
+	}
+	if addressIndex > math.MaxUint64-MaxRemainderOutputsCount+1 {
+		return math.MaxUint64 - addressIndex + 1, true
+	}
+	return 0, false
+}
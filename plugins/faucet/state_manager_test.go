@@ -0,0 +1,64 @@
+package faucet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	walletseed "github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestRemainderAddressIndex(t *testing.T) {
+	for remainderIndex := uint64(0); remainderIndex < MaxRemainderOutputsCount; remainderIndex++ {
+		addressIndex := remainderAddressIndex(remainderIndex)
+		assert.False(t, addressIndex > 0 && addressIndex <= MaxFaucetOutputsCount, "remainder output %d collides with the supply outputs", remainderIndex)
+
+		foundRemainderIndex, isRemainder := remainderOutputIndex(addressIndex)
+		assert.True(t, isRemainder)
+		assert.Equal(t, remainderIndex, foundRemainderIndex)
+	}
+
+	for _, addressIndex := range []uint64{1, MaxFaucetOutputsCount, MaxFaucetOutputsCount + 1, 1 << 40} {
+		_, isRemainder := remainderOutputIndex(addressIndex)
+		assert.False(t, isRemainder, "address index %d", addressIndex)
+	}
+}
+
+func TestNewStateManager_SupplyTransactions(t *testing.T) {
+	stateManager := NewStateManager(10, walletseed.NewSeed(), 20, 25, 3, time.Second)
+	assert.EqualValues(t, 3, stateManager.supplyTransactionsCount)
+	assert.EqualValues(t, 6, stateManager.supplyOutputsPerTransaction)
+	assert.EqualValues(t, 18, stateManager.targetSupplyOutputsCount)
+	assert.EqualValues(t, 18*25*10, stateManager.tokensUsedOnSupplyReplenishment)
+
+	// there are never more supply transactions than supply outputs
+	stateManager = NewStateManager(10, walletseed.NewSeed(), 2, 25, 4, time.Second)
+	assert.EqualValues(t, 2, stateManager.supplyTransactionsCount)
+	assert.EqualValues(t, 1, stateManager.supplyOutputsPerTransaction)
+}
+
+func TestStateManager_RemainderSplittingTransactionElements(t *testing.T) {
+	stateManager := NewStateManager(10, walletseed.NewSeed(), 20, 25, 3, time.Second)
+	stateManager.replenishmentState.SetRemainderOutputs(map[uint64]*FaucetOutput{
+		0: {ID: ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0), Balance: 1000, AddressIndex: remainderAddressIndex(0)},
+		5: {ID: ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 1), Balance: 2, AddressIndex: remainderAddressIndex(5)},
+	})
+
+	spentOutputs, outputs, err := stateManager.remainderSplittingTransactionElements()
+	require.NoError(t, err)
+	assert.Len(t, spentOutputs, 2)
+	require.Len(t, outputs, 3)
+
+	balances := make(map[uint64]uint64)
+	for _, output := range outputs {
+		addressIndex, exists := stateManager.replenishmentState.GetAddressToIndex(output.Address().Base58())
+		require.True(t, exists)
+		remainderIndex, isRemainder := remainderOutputIndex(addressIndex)
+		require.True(t, isRemainder)
+		balances[remainderIndex], _ = output.Balances().Get(ledgerstate.ColorIOTA)
+	}
+	assert.Equal(t, map[uint64]uint64{0: 334, 1: 334, 2: 334}, balances)
+}
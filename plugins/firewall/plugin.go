@@ -4,13 +4,13 @@ import (
 	"context"
 
 	"github.com/iotaledger/hive.go/autopeering/peer"
-	"github.com/iotaledger/hive.go/autopeering/selection"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 	"github.com/iotaledger/goshimmer/packages/firewall"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/ratelimiter"
@@ -37,7 +37,7 @@ type dependencies struct {
 
 type firewallDeps struct {
 	dig.In
-	AutopeeringMgr *selection.Protocol `optional:"true"`
+	AutopeeringMgr *neighborselection.Protocol `optional:"true"`
 	GossipMgr      *gossip.Manager
 }
 
@@ -0,0 +1,24 @@
+package fragmentation
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the fragmentation plugin.
+type ParametersDefinition struct {
+	// Timeout defines the time after the first fragment was received after which fragmented data is dropped.
+	Timeout time.Duration `default:"10m" usage:"the time after the first fragment was received after which fragmented data is dropped"`
+	// MaxBufferedSize defines the maximum total size of the fragmented data that is kept by the node.
+	MaxBufferedSize int `default:"268435456" usage:"the maximum total size of the fragmented data in bytes that is kept by the node (0 disables the limit)"`
+	// MaxBufferedSizePerIssuer defines the maximum size of the fragments of a single issuer that are kept by the node.
+	MaxBufferedSizePerIssuer int `default:"16777216" usage:"the maximum size of the fragments in bytes of a single issuer that are kept by the node (0 disables the limit)"`
+}
+
+// Parameters contains the configuration used by the fragmentation plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "fragmentation")
+}
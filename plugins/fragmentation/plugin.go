@@ -0,0 +1,96 @@
+package fragmentation
+
+import (
+	"context"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/timeutil"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/fragmentation"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the fragmentation plugin.
+const PluginName = "Fragmentation"
+
+// pruneInterval defines the interval in which expired fragmented data is dropped.
+const pruneInterval = 10 * time.Second
+
+var (
+	// Plugin is the plugin instance of the fragmentation plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle      *tangle.Tangle
+	Server      *echo.Echo
+	Reassembler *fragmentation.Reassembler
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newReassembler); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newReassembler creates the Reassembler that puts fragmented data back together.
+func newReassembler() *fragmentation.Reassembler {
+	return fragmentation.NewReassembler(fragmentation.Params{
+		Timeout:                  Parameters.Timeout,
+		MaxBufferedSize:          Parameters.MaxBufferedSize,
+		MaxBufferedSizePerIssuer: Parameters.MaxBufferedSizePerIssuer,
+	})
+}
+
+func configure(_ *node.Plugin) {
+	deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(onMessageBooked))
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		timeutil.NewTicker(deps.Reassembler.Prune, pruneInterval, ctx).WaitForGracefulShutdown()
+
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityFragmentation); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// onMessageBooked adds the fragment that is contained in the given message to the Reassembler.
+func onMessageBooked(messageID tangle.MessageID) {
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		fragment, isFragment := message.Payload().(*fragmentation.Payload)
+		if !isFragment {
+			return
+		}
+
+		data, completed, err := deps.Reassembler.AddFragment(fragment, messageID, identity.NewID(message.IssuerPublicKey()))
+		switch {
+		case errors.Is(err, fragmentation.ErrBufferFull):
+			Plugin.LogWarnf("dropped fragment %d of %s: %s", fragment.Index, fragment.DataID, err)
+		case err != nil:
+			Plugin.LogDebugf("rejected fragment %d of %s: %s", fragment.Index, fragment.DataID, err)
+		case completed:
+			Plugin.LogDebugf("reassembled %d bytes of %s", len(data), fragment.DataID)
+		}
+	})
+}
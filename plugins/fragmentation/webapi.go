@@ -0,0 +1,34 @@
+package fragmentation
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/fragmentation"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// RouteFragmentedData defines the HTTP path for the fragmentation/data/:dataID endpoint.
+const RouteFragmentedData = "fragmentation/data/:dataID"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteFragmentedData, getFragmentedDataHandler)
+}
+
+// getFragmentedDataHandler returns the state of the reassembly of fragmented data and the data itself once it is
+// complete.
+func getFragmentedDataHandler(c echo.Context) error {
+	dataID, err := fragmentation.DataIDFromBase58(c.Param("dataID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	reassembly, exists := deps.Reassembler.Reassembly(dataID)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("no fragments of %s were received", dataID.Base58())))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewFragmentedDataResponse(reassembly))
+}
@@ -2,27 +2,39 @@ package gossip
 
 import (
 	"context"
-	"fmt"
 	"net"
+	"strconv"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
 	"github.com/iotaledger/hive.go/crypto"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
 	"github.com/libp2p/go-libp2p"
+	"github.com/multiformats/go-multiaddr"
 
 	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/libp2putil"
 	"github.com/iotaledger/goshimmer/packages/ratelimiter"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
+	"github.com/iotaledger/goshimmer/plugins/config"
 )
 
-// ErrMessageNotFound is returned when a message could not be found in the Tangle.
-var ErrMessageNotFound = errors.New("message not found")
+var (
+	// ErrMessageNotFound is returned when a message could not be found in the Tangle.
+	ErrMessageNotFound = errors.New("message not found")
+
+	// ErrTransactionNotFound is returned when a transaction could not be found in the ledger state.
+	ErrTransactionNotFound = errors.New("transaction not found")
+)
 
 var localAddr *net.TCPAddr
 
-func createManager(lPeer *peer.Local, t *tangle.Tangle) *gossip.Manager {
+func createManager(lPeer *peer.Local, t *tangle.Tangle, workerPools *workerpools.Manager) *gossip.Manager {
 	var err error
 
 	// resolve the bind address
@@ -50,20 +62,63 @@ func createManager(lPeer *peer.Local, t *tangle.Tangle) *gossip.Manager {
 		msg, _ := cachedMessage.Unwrap()
 		return msg.Bytes(), nil
 	}
+	// loads the given transaction from the ledger state and returns it or an error if not found.
+	loadTransaction := func(transactionID ledgerstate.TransactionID) ([]byte, error) {
+		cachedTransaction := t.LedgerState.Transaction(transactionID)
+		defer cachedTransaction.Release()
+		transaction, exists := cachedTransaction.Unwrap()
+		if !exists {
+			return nil, ErrTransactionNotFound
+		}
+		return transaction.Bytes(), nil
+	}
 	libp2pIdentity, err := libp2putil.GetLibp2pIdentity(lPeer)
 	if err != nil {
 		Plugin.LogFatalf("Could not build libp2p identity from local peer: %s", err)
 	}
-	libp2pHost, err := libp2p.New(
-		context.Background(),
-		libp2p.ListenAddrStrings(fmt.Sprintf("/ip4/%s/tcp/%d", localAddr.IP, localAddr.Port)),
+	libp2pSecurity, err := libp2putil.GetLibp2pSecurity(libp2putil.SecurityMode(Parameters.Security))
+	if err != nil {
+		Plugin.LogFatalf("Invalid gossip security mode: %s", err)
+	}
+	listenAddrs, err := listenMultiaddrs()
+	if err != nil {
+		Plugin.LogFatalf("Invalid gossip bind address: %s", err)
+	}
+	for _, advertisedAddress := range Parameters.AdvertisedAddresses {
+		if _, err = gossip.AddressToMultiaddr(advertisedAddress); err != nil {
+			Plugin.LogFatalf("Invalid advertised gossip address: %s", err)
+		}
+	}
+	libp2pOptions := []libp2p.Option{
+		libp2p.ListenAddrs(listenAddrs...),
 		libp2pIdentity,
+		libp2pSecurity,
 		libp2p.NATPortMap(),
-	)
+	}
+	if Parameters.Proxy.Address != "" {
+		Plugin.LogInfof("Routing outbound neighbor connections through the SOCKS5 proxy at %s", Parameters.Proxy.Address)
+		libp2pOptions = append(libp2pOptions, libp2p.Transport(libp2putil.NewSOCKS5Transport(Parameters.Proxy.Address, Parameters.Proxy.Username, Parameters.Proxy.Password)))
+	}
+	libp2pHost, err := libp2p.New(context.Background(), libp2pOptions...)
 	if err != nil {
 		Plugin.LogFatalf("Couldn't create libp2p host: %s", err)
 	}
-	var opts []gossip.ManagerOption
+	features := gossip.DefaultFeatures()
+	features.NetworkID = config.Parameters.NetworkID
+	neighborBandwidthCaps, err := parseNeighborBandwidthCaps(Parameters.Bandwidth.NeighborCaps)
+	if err != nil {
+		Plugin.LogFatalf("Invalid neighbor bandwidth cap: %s", err)
+	}
+	opts := []gossip.ManagerOption{
+		gossip.WithWorkerPools(workerPools),
+		gossip.WithFeatures(features),
+		gossip.WithAdvertisedAddresses(Parameters.AdvertisedAddresses...),
+		gossip.WithBandwidthWindow(Parameters.Bandwidth.Window),
+		gossip.WithOutboundBandwidthCap(Parameters.Bandwidth.OutboundCap),
+	}
+	if Parameters.ServeTransactions {
+		opts = append(opts, gossip.WithLoadTransactionFunc(loadTransaction))
+	}
 	if Parameters.MessagesRateLimit != (messagesLimitParameters{}) {
 		Plugin.Logger().Infof("Initializing messages rate limiter with the following parameters: %+v",
 			Parameters.MessagesRateLimit)
@@ -89,9 +144,47 @@ func createManager(lPeer *peer.Local, t *tangle.Tangle) *gossip.Manager {
 		opts = append(opts, gossip.WithMessageRequestsRateLimiter(mrrl))
 	}
 	mgr := gossip.NewManager(libp2pHost, lPeer, loadMessage, Plugin.Logger(), opts...)
+	for neighborID, bytesPerWindow := range neighborBandwidthCaps {
+		mgr.SetOutboundBandwidthCap(neighborID, bytesPerWindow)
+	}
 	return mgr
 }
 
+// parseNeighborBandwidthCaps parses the outbound bandwidth caps of specific neighbors (publicKey:bytes).
+func parseNeighborBandwidthCaps(neighborCaps []string) (caps map[identity.ID]uint64, err error) {
+	caps = make(map[identity.ID]uint64, len(neighborCaps))
+	for _, neighborCap := range neighborCaps {
+		parts := strings.Split(neighborCap, ":")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("%s is not of the form publicKey:bytes", neighborCap)
+		}
+		publicKey, err := ed25519.PublicKeyFromString(parts[0])
+		if err != nil {
+			return nil, errors.Errorf("invalid public key of %s: %w", neighborCap, err)
+		}
+		bytesPerWindow, err := strconv.ParseUint(parts[1], 10, 64)
+		if err != nil {
+			return nil, errors.Errorf("invalid number of bytes of %s: %w", neighborCap, err)
+		}
+		caps[identity.NewID(publicKey)] = bytesPerWindow
+	}
+
+	return caps, nil
+}
+
+// listenMultiaddrs returns the multiaddrs of the bind addresses of the gossip service.
+func listenMultiaddrs() (listenAddrs []multiaddr.Multiaddr, err error) {
+	for _, bindAddress := range append([]string{Parameters.BindAddress}, Parameters.AdditionalBindAddresses...) {
+		listenAddr, err := gossip.ListenMultiaddr(bindAddress)
+		if err != nil {
+			return nil, err
+		}
+		listenAddrs = append(listenAddrs, listenAddr)
+	}
+
+	return listenAddrs, nil
+}
+
 func start(ctx context.Context) {
 	defer Plugin.LogInfo("Stopping " + PluginName + " ... done")
 	defer func() {
@@ -11,11 +11,41 @@ type ParametersDefinition struct {
 	// BindAddress defines on which address the gossip service should listen.
 	BindAddress string `default:"0.0.0.0:14666" usage:"the bind address for the gossip"`
 
+	// AdditionalBindAddresses defines further addresses that the gossip service listens on, e.g. to accept IPv6 connections.
+	AdditionalBindAddresses []string `usage:"further bind addresses for the gossip, e.g. [::]:14666 to accept IPv6 connections"`
+
+	// AdvertisedAddresses defines the addresses under which the gossip service can be reached in the order of preference.
+	AdvertisedAddresses []string `usage:"the addresses (host:port with an IPv4, IPv6 or DNS host) under which the gossip can be reached, in the order of preference, which are announced to the neighbors"`
+
 	// MissingMessageRequestRelayProbability defines the probability of missing message requests being relayed to other neighbors.
 	MissingMessageRequestRelayProbability float64 `default:"0.01" usage:"the probability of missing message requests being relayed to other neighbors"`
 
+	// ServeTransactions defines whether transaction payloads that are requested by their ID are served to the neighbors.
+	ServeTransactions bool `default:"true" usage:"whether to serve transaction payloads that are requested by their ID to the neighbors"`
+
+	// Security defines which secure channel protocols are offered and accepted on neighbor connections.
+	Security string `default:"any" usage:"the secure channel protocols of neighbor connections (any, tls or noise)"`
+
 	MessagesRateLimit        messagesLimitParameters
 	MessageRequestsRateLimit messageRequestsLimitParameters
+
+	// Bandwidth contains the settings of the bandwidth accounting and of the outbound caps of the neighbors.
+	Bandwidth bandwidthParameters
+
+	// Proxy contains the settings of the SOCKS5 proxy that outbound neighbor connections are routed through.
+	Proxy proxyParameters
+}
+
+type proxyParameters struct {
+	Address  string `default:"" usage:"the address of the SOCKS5 proxy (e.g. of a Tor client) that outbound neighbor connections are routed through (empty disables the proxy)"`
+	Username string `default:"" usage:"the username that is used to authenticate at the SOCKS5 proxy"`
+	Password string `default:"" usage:"the password that is used to authenticate at the SOCKS5 proxy"`
+}
+
+type bandwidthParameters struct {
+	Window       time.Duration `default:"1m" usage:"the rolling time window in which the traffic of every neighbor is accounted"`
+	OutboundCap  uint64        `default:"0" usage:"the soft cap of bytes per window that are sent to every neighbor before the gossiped messages are throttled (0 disables the throttling)"`
+	NeighborCaps []string      `usage:"the soft caps of specific neighbors (publicKey:bytes) that override the outboundCap"`
 }
 
 type messagesLimitParameters struct {
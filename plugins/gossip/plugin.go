@@ -8,6 +8,7 @@ import (
 	"github.com/iotaledger/hive.go/node"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
@@ -61,13 +62,13 @@ func configureLogging() {
 	deps.GossipMgr.NeighborsEvents(gossip.NeighborsGroupAuto).NeighborRemoved.Attach(events.NewClosure(func(n *gossip.Neighbor) {
 		Plugin.LogInfof("Neighbor removed: %s / %s", gossip.GetAddress(n.Peer), n.ID())
 	}))
-	deps.Tangle.Requester.Events.RequestStarted.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Requester.Events.RequestStarted.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		Plugin.LogDebugf("started to request missing Message with %s", messageID)
 	}))
-	deps.Tangle.Requester.Events.RequestStopped.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Requester.Events.RequestStopped.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		Plugin.LogDebugf("stopped to request missing Message with %s", messageID)
 	}))
-	deps.Tangle.Requester.Events.RequestFailed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Requester.Events.RequestFailed.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		Plugin.LogDebugf("failed to request missing Message with %s", messageID)
 	}))
 }
@@ -79,14 +80,28 @@ func configureMessageLayer() {
 	}))
 
 	// configure flow of outgoing messages (gossip upon dispatched messages)
-	deps.Tangle.Dispatcher.Events.MessageDispatched.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Dispatcher.Events.MessageDispatched.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+		// messages that were booked below max depth are not gossiped
+		belowMaxDepth := false
+		deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+			belowMaxDepth = messageMetadata.IsBelowMaxDepth()
+		})
+		if belowMaxDepth {
+			return
+		}
+
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			deps.GossipMgr.SendMessage(message.Bytes())
 		})
 	}))
 
+	// keep track of the neighbors that request the same missing messages
+	deps.GossipMgr.Events().MessageRequestUnanswered.Attach(events.NewClosure(func(event *gossip.MessageRequestUnansweredEvent) {
+		deps.Tangle.Requester.AddRequestingPeer(event.MessageID, event.Peer.ID())
+	}))
+
 	// request missing messages
-	deps.Tangle.Requester.Events.RequestIssued.Attach(events.NewClosure(func(sendRequest *tangle.SendRequestEvent) {
+	deps.Tangle.Requester.Events.RequestIssued.Attach(event.NewClosure(func(sendRequest *tangle.SendRequestEvent) {
 		Plugin.LogDebugf("requesting missing Message with %s", sendRequest.ID)
 
 		deps.GossipMgr.RequestMessage(sendRequest.ID[:])
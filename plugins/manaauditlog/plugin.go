@@ -0,0 +1,65 @@
+package manaauditlog
+
+import (
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/mana"
+)
+
+// PluginName is the name of the mana audit log plugin.
+const PluginName = "ManaAuditLog"
+
+var (
+	// Plugin is the plugin instance of the mana audit log plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server   *echo.Echo
+	AuditLog *mana.AuditLog
+}
+
+type auditLogDeps struct {
+	dig.In
+
+	Storage kvstore.KVStore
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(auditLogDeps auditLogDeps) (*mana.AuditLog, error) {
+			return mana.NewAuditLog(auditLogDeps.Storage)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(plugin *node.Plugin) {
+	// the closures are attached before the mana plugin loads the snapshot, so that its pledges are recorded as well
+	mana.Events().Pledged.Attach(events.NewClosure(func(ev *mana.PledgedEvent) {
+		record(plugin, ev)
+	}))
+	mana.Events().Revoked.Attach(events.NewClosure(func(ev *mana.RevokedEvent) {
+		record(plugin, ev)
+	}))
+
+	configureWebAPI()
+}
+
+// record adds the given mana event to the audit log.
+func record(plugin *node.Plugin, ev mana.Event) {
+	if err := deps.AuditLog.Record(ev); err != nil {
+		plugin.LogErrorf("failed to record %s in the mana audit log: %s", ev, err)
+	}
+}
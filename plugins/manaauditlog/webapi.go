@@ -0,0 +1,61 @@
+package manaauditlog
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+)
+
+// RouteAudit defines the HTTP path for the mana/audit endpoint.
+const RouteAudit = "mana/audit"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteAudit, getAuditHandler)
+}
+
+// getAuditHandler returns the recorded mana pledges and revocations that match the query parameters.
+func getAuditHandler(c echo.Context) error {
+	filter := &mana.AuditFilter{}
+	if nodeIDString := c.QueryParam("nodeID"); nodeIDString != "" {
+		nodeID, err := mana.IDFromStr(nodeIDString)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid nodeID")))
+		}
+		filter.NodeID = &nodeID
+	}
+	if transactionIDString := c.QueryParam("transactionID"); transactionIDString != "" {
+		transactionID, err := ledgerstate.TransactionIDFromBase58(transactionIDString)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid transactionID")))
+		}
+		filter.TransactionID = &transactionID
+	}
+	if sinceString := c.QueryParam("since"); sinceString != "" {
+		since, err := strconv.ParseInt(sinceString, 10, 64)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid since")))
+		}
+		filter.Since = time.Unix(since, 0)
+	}
+	if limitString := c.QueryParam("limit"); limitString != "" {
+		limit, err := strconv.Atoi(limitString)
+		if err != nil || limit < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitString)))
+		}
+		filter.Limit = limit
+	}
+
+	records, err := deps.AuditLog.Records(filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewManaAuditResponse(records))
+}
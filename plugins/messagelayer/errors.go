@@ -1,6 +1,18 @@
 package messagelayer
 
-import "github.com/cockroachdb/errors"
+import (
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
 
 // ErrQueryNotAllowed is returned when the node is not synced and mana debug mode is disabled.
 var ErrQueryNotAllowed = errors.New("mana query not allowed, node is not synced, debug mode disabled")
+
+func init() {
+	jsonmodels.RegisterErrorCode(ErrQueryNotAllowed, jsonmodels.ErrorCodeNotSynced, jsonmodels.SubsystemMana, true)
+	jsonmodels.RegisterErrorCode(ErrNotAllowedToPledgeManaToNode, jsonmodels.ErrorCodeManaPledgeNotAllowed, jsonmodels.SubsystemMana, false)
+	jsonmodels.RegisterErrorCode(ErrMessageWasNotIssuedInTime, jsonmodels.ErrorCodeIssuanceTimeout, jsonmodels.SubsystemTangle, true)
+	// the message was issued already, so retrying the request would issue it a second time
+	jsonmodels.RegisterErrorCode(ErrMessageWasNotBookedInTime, jsonmodels.ErrorCodeIssuanceTimeout, jsonmodels.SubsystemTangle, false)
+}
@@ -1,14 +1,24 @@
 package messagelayer
 
 import (
-	"github.com/iotaledger/hive.go/events"
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/identity"
 
 	"github.com/iotaledger/goshimmer/packages/consensus/finality"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/database"
 )
 
-var finalityGadget finality.Gadget
+var (
+	finalityGadget finality.Gadget
+
+	// confirmationJournal records the confirmation cascades of the finality gadget (nil if it is disabled).
+	confirmationJournal *finality.Journal
+)
 
 // FinalityGadget is the finality gadget instance.
 func FinalityGadget() finality.Gadget {
@@ -16,21 +26,56 @@ func FinalityGadget() finality.Gadget {
 }
 
 func configureFinality() {
-	deps.Tangle.ApprovalWeightManager.Events.MarkerWeightChanged.Attach(events.NewClosure(func(e *tangle.MarkerWeightChangedEvent) {
+	// roll the confirmation cascades forward that were interrupted by a crash
+	if replayedEntries, err := finalityGadget.ReplayJournal(); err != nil {
+		Plugin.LogErrorf("Failed to replay the confirmation journal: %s", err)
+	} else if replayedEntries > 0 {
+		Plugin.LogInfof("Replayed %d entries of the confirmation journal", replayedEntries)
+	}
+
+	deps.Tangle.ApprovalWeightManager.Events.MarkerWeightChanged.Attach(event.NewClosure(func(e *tangle.MarkerWeightChangedEvent) {
 		if err := finalityGadget.HandleMarker(e.Marker, e.Weight); err != nil {
 			Plugin.LogError(err)
 		}
 	}))
-	deps.Tangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(events.NewClosure(func(e *tangle.BranchWeightChangedEvent) {
+	deps.Tangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(event.NewClosure(func(e *tangle.BranchWeightChangedEvent) {
 		if err := finalityGadget.HandleBranch(e.BranchID, e.Weight); err != nil {
 			Plugin.LogError(err)
 		}
 	}))
 
 	// we need to update the WeightProvider on confirmation
-	finalityGadget.Events().MessageConfirmed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	finalityGadget.Events().MessageConfirmed.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			deps.Tangle.WeightProvider.Update(message.IssuingTime(), identity.NewID(message.IssuerPublicKey()))
 		})
 	}))
 }
+
+// runConfirmationJournalCheckpoints periodically commits the pending writes of the storage to remove the entries of the
+// completed confirmation cascades from the confirmation journal.
+func runConfirmationJournalCheckpoints() {
+	if confirmationJournal == nil || Parameters.ConfirmationJournal.CheckpointInterval <= 0 {
+		return
+	}
+
+	if err := daemon.BackgroundWorker("ConfirmationJournal", func(ctx context.Context) {
+		ticker := deps.Tangle.Options.Clock.NewTicker(Parameters.ConfirmationJournal.CheckpointInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C():
+				if removedEntries, err := confirmationJournal.Checkpoint(deps.Tangle.Options.Clock.Now(), database.CommitPendingWrites); err != nil {
+					Plugin.LogErrorf("Failed to checkpoint the confirmation journal: %s", err)
+				} else if removedEntries > 0 {
+					Plugin.LogDebugf("Removed %d entries of the confirmation journal", removedEntries)
+				}
+			}
+		}
+	}, shutdown.PriorityTangle); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
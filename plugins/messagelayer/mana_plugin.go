@@ -4,10 +4,11 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"os"
 	"sort"
+	"strings"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/generics/objectstorage"
@@ -18,6 +19,7 @@ import (
 	"go.uber.org/dig"
 
 	db_pkg "github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
@@ -28,6 +30,9 @@ import (
 const (
 	// PluginName is the name of the mana plugin.
 	PluginName = "Mana"
+
+	// accessManaProjectionSteps is the number of steps in which the access mana is projected.
+	accessManaProjectionSteps = 60
 )
 
 var (
@@ -41,7 +46,7 @@ var (
 	// consensusBaseManaPastVectorMetadataStorage *objectstorage.ObjectStorage
 	// consensusEventsLogStorage                  *objectstorage.ObjectStorage
 	// consensusEventsLogsStorageSize             atomic.Uint32.
-	onTransactionConfirmedClosure *events.Closure
+	onTransactionConfirmedClosure *event.Closure[ledgerstate.TransactionID]
 	// onPledgeEventClosure          *events.Closure
 	// onRevokeEventClosure          *events.Closure
 	// debuggingEnabled              bool.
@@ -60,7 +65,7 @@ func init() {
 func configureManaPlugin(*node.Plugin) {
 	manaLogger = logger.NewLogger(PluginName)
 
-	onTransactionConfirmedClosure = events.NewClosure(onTransactionConfirmed)
+	onTransactionConfirmedClosure = event.NewClosure(onTransactionConfirmed)
 	// onPledgeEventClosure = events.NewClosure(logPledgeEvent)
 	// onRevokeEventClosure = events.NewClosure(logRevokeEvent)
 
@@ -88,6 +93,7 @@ func configureManaPlugin(*node.Plugin) {
 	if err != nil {
 		manaLogger.Panic(err.Error())
 	}
+	deps.Tangle.MessageFactory.SetTransactionIssuanceFilter(CheckTransactionManaPledges)
 
 	// debuggingEnabled = ManaParameters.DebuggingEnabled
 
@@ -192,12 +198,11 @@ func runManaPlugin(_ *node.Plugin) {
 		if !readStoredManaVectors() {
 			// read snapshot file
 			if Parameters.Snapshot.File != "" {
-				snapshot := &ledgerstate.Snapshot{}
-				f, err := os.Open(Parameters.Snapshot.File)
-				if err != nil {
-					Plugin.Panic("can not open snapshot file:", err)
+				snapshot, err := readSnapshotFile(ctx, manaLogger)
+				if errors.Is(err, context.Canceled) {
+					return
 				}
-				if _, err := snapshot.ReadFrom(f); err != nil {
+				if err != nil {
 					Plugin.Panic("could not read snapshot file in Mana Plugin:", err)
 				}
 				loadSnapshot(snapshot)
@@ -457,6 +462,26 @@ func verifyPledgeNodes() error {
 	return nil
 }
 
+// CheckManaPledge returns an error if the node does not allow to pledge mana of the given type to the given node.
+func CheckManaPledge(manaType mana.Type, nodeID identity.ID) error {
+	allowedPledgeNodes := GetAllowedPledgeNodes(manaType)
+	if allowedPledgeNodes.IsFilterEnabled && !allowedPledgeNodes.Allowed.Has(nodeID) {
+		return errors.Errorf("not allowed to pledge %s mana to %s: %w", strings.ToLower(manaType.String()), nodeID.String(), ErrNotAllowedToPledgeManaToNode)
+	}
+
+	return nil
+}
+
+// CheckTransactionManaPledges returns an error if the given transaction pledges access or consensus mana to a node that
+// the node does not allow to pledge to. It is used to filter the transactions that are issued by the node.
+func CheckTransactionManaPledges(tx *ledgerstate.Transaction) error {
+	if err := CheckManaPledge(mana.AccessMana, tx.Essence().AccessPledgeID()); err != nil {
+		return err
+	}
+
+	return CheckManaPledge(mana.ConsensusMana, tx.Essence().ConsensusPledgeID())
+}
+
 // PendingManaOnOutput predicts how much mana (bm2) will be pledged to a node if the output specified is spent.
 func PendingManaOnOutput(outputID ledgerstate.OutputID) (float64, time.Time) {
 	cachedOutputMetadata := deps.Tangle.LedgerState.CachedOutputMetadata(outputID)
@@ -488,6 +513,27 @@ func GetPendingMana(value float64, n time.Duration) float64 {
 	return value * (1 - math.Pow(math.E, -mana.Decay*(n.Seconds())))
 }
 
+// ProjectAccessMana projects the access mana trajectory of the given node over the given interval.
+func ProjectAccessMana(nodeID identity.ID, interval time.Duration) (*mana.AccessManaProjection, error) {
+	if !QueryAllowed() {
+		return nil, ErrQueryNotAllowed
+	}
+
+	return baseManaVectors[mana.AccessMana].(*mana.AccessBaseManaVector).Projection(nodeID, time.Now(), interval, accessManaProjectionSteps)
+}
+
+// IssuableMessages projects the access mana trajectory of the local node over the given interval and returns it
+// together with the number of messages of the given size that the node can issue during the interval if the network is
+// congested, taking the messages of the node that are still waiting in the buffer of the scheduler into account.
+func IssuableMessages(messageSize int, interval time.Duration) (projection *mana.AccessManaProjection, issuableMessages int, err error) {
+	localID := deps.Tangle.Options.Identity.ID()
+	if projection, err = ProjectAccessMana(localID, interval); err != nil {
+		return nil, 0, err
+	}
+
+	return projection, projection.IssuableMessages(deps.Tangle.Scheduler.Rate(), deps.Tangle.Scheduler.NodeQueueSize(localID), messageSize), nil
+}
+
 //// GetLoggedEvents gets the events logs for the node IDs and time frame specified. If none is specified, it returns the logs for all nodes.
 //func GetLoggedEvents(identityIDs []identity.ID, startTime time.Time, endTime time.Time) (map[identity.ID]*EventsLogs, error) {
 //	logs := make(map[identity.ID]*EventsLogs)
@@ -770,6 +816,9 @@ func cleanupManaVectors() {
 	}
 }
 
+// ErrNotAllowedToPledgeManaToNode is returned if a transaction pledges mana to a node that is not allowed.
+var ErrNotAllowedToPledgeManaToNode = errors.New("not allowed to pledge mana to node")
+
 // AllowedPledge represents the nodes that mana is allowed to be pledged to.
 type AllowedPledge struct {
 	IsFilterEnabled bool
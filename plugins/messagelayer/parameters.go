@@ -12,10 +12,95 @@ type ParametersDefinition struct {
 	TangleWidth int `default:"0" usage:"the width of the Tangle"`
 	// TimeSinceConfirmationThreshold is used to set the limit for which tips with old unconfirmed messages in its past cone will not be selected.
 	TimeSinceConfirmationThreshold time.Duration `default:"12m" usage:"Time Since Confirmation (TSC) threshold"`
+	// MaxParentAge defines the biggest allowed time difference between the issuing times of a message and its parents.
+	MaxParentAge time.Duration `default:"30m" usage:"the biggest allowed time difference between the issuing times of a message and its parents"`
+	// MaxDepth defines how many markers the strong parents of a message may lie behind the confirmed markers.
+	MaxDepth uint64 `default:"0" usage:"the number of markers the strong parents of a message may lie behind the first unconfirmed marker of their sequences (0 disables the check)"`
+	// TipManager contains the configuration parameters of the eviction policy of the tip pool and of the tip selection.
+	TipManager struct {
+		// MaxTipAge defines the age of a tip after which it gets evicted from the tip pool.
+		MaxTipAge time.Duration `default:"29m" usage:"the age of a tip after which it gets evicted from the tip pool"`
+		// MinGradeOfFinality defines the grade of finality a tip needs to reach within the GradeOfFinalityGracePeriod.
+		MinGradeOfFinality uint8 `default:"0" usage:"the grade of finality a tip needs to reach within the grace period to not get evicted (0 disables the check)"`
+		// GradeOfFinalityGracePeriod defines the age of a tip at which its grade of finality is checked.
+		GradeOfFinalityGracePeriod time.Duration `default:"1m" usage:"the age of a tip at which its grade of finality is checked"`
+		// MaxUnconfirmedPastMarkers defines the estimated number of unconfirmed markers in the past cone of a tip above which the tip is avoided.
+		MaxUnconfirmedPastMarkers uint64 `default:"0" usage:"the estimated number of unconfirmed markers in the past cone of a tip above which the tip is avoided by the tip selection (0 disables the check)"`
+		// MaxUnscheduledPastMarkers defines the estimated number of unscheduled markers in the past cone of a tip above which the tip is avoided.
+		MaxUnscheduledPastMarkers uint64 `default:"0" usage:"the estimated number of unscheduled markers in the past cone of a tip above which the tip is avoided by the tip selection (0 disables the check)"`
+	}
+	// Blacklist contains the configuration parameters of the detection and handling of protocol violators.
+	Blacklist struct {
+		// CoolDownPeriod defines how long an issuer stays blacklisted after a protocol violation was detected.
+		CoolDownPeriod time.Duration `default:"10m" usage:"how long an issuer stays blacklisted after a protocol violation (0 disables the detection)"`
+		// ViolationThreshold defines how many rate violations within the ViolationWindow lead to an issuer getting blacklisted.
+		ViolationThreshold int `default:"100" usage:"the number of rate violations within the violation window after which an issuer gets blacklisted (0 disables the check)"`
+		// ViolationWindow defines the time window in which the rate violations of an issuer are counted.
+		ViolationWindow time.Duration `default:"1m" usage:"the time window in which the rate violations of an issuer are counted"`
+		// RejectMessages defines if the messages of blacklisted issuers are rejected instead of being deprioritized.
+		RejectMessages bool `default:"false" usage:"reject the messages of blacklisted issuers instead of deprioritizing them"`
+		// DetectEquivocations defines if issuers that reuse a sequence number for different messages are blacklisted.
+		DetectEquivocations bool `default:"false" usage:"blacklist issuers that reuse a sequence number for different messages"`
+	}
+	// IssuerIndex contains the retention limits of the index of the messages of every issuer.
+	IssuerIndex struct {
+		// MaxMessagesPerIssuer defines how many of the most recent messages of every issuer are indexed.
+		MaxMessagesPerIssuer int `default:"1000" usage:"the number of the most recent messages of every issuer that are indexed (0 disables the index)"`
+		// MaxIssuers defines how many issuers are indexed.
+		MaxIssuers int `default:"1000" usage:"the number of issuers that are indexed, the least active issuer is dropped first (0 disables the limit)"`
+		// Retention defines the age of the issuing time of a message after which it is dropped from the index.
+		Retention time.Duration `default:"1h" usage:"the age of the issuing time of a message after which it is dropped from the index (0 disables the limit)"`
+	}
+	// GradeOfFinality contains the approval weight thresholds of the grades of finality (the finality ladder) of the network.
+	GradeOfFinality struct {
+		// MessageThresholds defines the approval weight a message needs to reach each grade of finality.
+		MessageThresholds struct {
+			Low    float64 `default:"0.25" usage:"the approval weight a message needs to reach a low grade of finality"`
+			Medium float64 `default:"0.45" usage:"the approval weight a message needs to reach a medium grade of finality"`
+			High   float64 `default:"0.67" usage:"the approval weight a message needs to reach a high grade of finality"`
+		}
+		// BranchThresholds defines the approval weight a branch needs to reach each grade of finality.
+		BranchThresholds struct {
+			Low    float64 `default:"0.25" usage:"the approval weight a branch needs to reach a low grade of finality"`
+			Medium float64 `default:"0.45" usage:"the approval weight a branch needs to reach a medium grade of finality"`
+			High   float64 `default:"0.67" usage:"the approval weight a branch needs to reach a high grade of finality"`
+		}
+	}
+	// ConfirmationJournal contains the configuration parameters of the journal of the confirmation cascades.
+	ConfirmationJournal struct {
+		// Enabled defines if the confirmation cascades are journaled, so that they can be rolled forward after a crash.
+		Enabled bool `default:"false" usage:"journal the confirmation cascades, so that they are rolled forward after a crash"`
+		// Retention defines how long the entries of completed confirmation cascades are kept at least.
+		Retention time.Duration `default:"1m" usage:"the minimum time that the entries of completed confirmation cascades are kept (needs to exceed the cache time of the tangle)"`
+		// CheckpointInterval defines the interval in which the pending writes of the storage are committed to remove the
+		// entries of completed confirmation cascades.
+		CheckpointInterval time.Duration `default:"1m" usage:"the interval in which the pending writes of the storage are committed to remove the entries of completed confirmation cascades"`
+	}
+	// MetastabilityBreaker contains the configuration parameters of the random tie-breaking of long-unresolved conflicts.
+	MetastabilityBreaker struct {
+		// Enabled defines if the ties of long-unresolved conflicts are broken by the randomness of the dRNG.
+		Enabled bool `default:"false" usage:"break the ties of long-unresolved conflicts by the randomness of the dRNG"`
+		// Timeout defines the age of a conflict after which its ties are broken.
+		Timeout time.Duration `default:"5m" usage:"the age of a conflict after which its ties are broken"`
+		// Margin defines the difference of approval weight up to which conflicting branches are considered as tied.
+		Margin float64 `default:"0.05" usage:"the difference of approval weight up to which conflicting branches are considered as tied"`
+		// DRNGInstanceID defines the dRNG instance whose randomness is used to break the ties.
+		DRNGInstanceID uint32 `default:"1" usage:"the dRNG instance whose randomness is used to break the ties"`
+	}
+	// MaxConflictingConsumers defines the number of conflicting consumers of an output after which further conflicting transactions are parked.
+	MaxConflictingConsumers int `default:"32" usage:"the number of conflicting consumers of an output after which further conflicting transactions are parked until the conflict is resolved (0 disables the limit)"`
+	// ParkingTimeout defines the time after which parked messages whose conflicts were not resolved are marked as orphaned.
+	ParkingTimeout time.Duration `default:"1h" usage:"the time after which parked messages whose conflicts were not resolved are marked as orphaned (0 disables the timeout)"`
 	// Snapshot contains snapshots related configuration parameters.
 	Snapshot struct {
 		// File is the path to the snapshot file.
 		File string `default:"./snapshot.bin" usage:"the path to the snapshot file"`
+		// DownloadURL is the URL of a chunked snapshot that is downloaded if the snapshot file does not exist yet.
+		DownloadURL string `usage:"the URL of a chunked snapshot that is downloaded if the snapshot file does not exist"`
+		// DownloadAttempts is the number of times an interrupted snapshot download is resumed before giving up.
+		DownloadAttempts int `default:"5" usage:"the number of attempts to resume an interrupted snapshot download"`
+		// ProgressInterval defines how often the progress of the snapshot import is logged.
+		ProgressInterval time.Duration `default:"5s" usage:"the interval in which the progress of the snapshot import is logged"`
 		// GenesisNode is the identity of the node that is allowed to attach to the Genesis message.
 		GenesisNode string `default:"Gm7W191NDnqyF7KJycZqK7V6ENLwqxTwoKQN4SmpkB24" usage:"the node (base58 public key) that is allowed to attach to the genesis message"`
 	}
@@ -23,8 +108,17 @@ type ParametersDefinition struct {
 	// TangleTimeWindow defines the time window in which the node considers itself as synced according to TangleTime.
 	TangleTimeWindow time.Duration `default:"2m" usage:"the time window in which the node considers itself as synced according to TangleTime"`
 
+	// SolidificationTimeout defines the time after which messages that could not be solidified are marked as orphaned.
+	SolidificationTimeout time.Duration `default:"30m" usage:"the time after which messages that could not be solidified are marked as orphaned (0 disables the timeout)"`
+
 	// StartSynced defines if the node should start as synced.
 	StartSynced bool `default:"false" usage:"start as synced"`
+
+	// SlowEventHandlerThreshold defines the duration after which the execution of an event handler is reported as slow.
+	SlowEventHandlerThreshold time.Duration `default:"100ms" usage:"the duration after which the execution of an event handler is reported as slow"`
+
+	// ShutdownTimeout defines the maximum time that the Tangle may take to drain its queues and flush its storages.
+	ShutdownTimeout time.Duration `default:"60s" usage:"the maximum time to drain the queues and flush the storages of the tangle on shutdown (0 disables the limit)"`
 }
 
 // ManaParametersDefinition contains the definition of the parameters used by the mana plugin.
@@ -38,7 +132,7 @@ type ManaParametersDefinition struct {
 	// AllowedAccessPledge defines the list of nodes that access mana is allowed to be pledged to.
 	AllowedAccessPledge []string `usage:"list of nodes that access mana is allowed to be pledged to"`
 	// AllowedAccessFilterEnabled defines if access mana pledge filter is enabled.
-	AllowedAccessFilterEnabled bool `default:"false" usage:"list of nodes that consensus mana is allowed to be pledge to"`
+	AllowedAccessFilterEnabled bool `default:"false" usage:"if filtering on access mana pledge nodes is enabled"`
 	// AllowedConsensusPledge defines the list of nodes that consensus mana is allowed to be pledged to.
 	AllowedConsensusPledge []string `usage:"list of nodes that consensus mana is allowed to be pledge to"`
 	// AllowedConsensusFilterEnabled defines if consensus mana pledge filter is enabled.
@@ -70,6 +164,10 @@ type SchedulerParametersDefinition struct {
 	Rate string `default:"5ms" usage:"message scheduling interval [time duration string]"`
 	// ConfirmedMessageThreshold time threshold after which confirmed messages are not scheduled [time duration string]
 	ConfirmedMessageThreshold string `default:"1m" usage:"time threshold after which confirmed messages are not scheduled [time duration string]"`
+	// DropPolicy defines which messages are dropped when the buffer is full (oldest, newest or lowestMana).
+	DropPolicy string `default:"oldest" usage:"which messages are dropped when the buffer is full [oldest, newest, lowestMana]"`
+	// PriorityLaneShare defines the share of the bandwidth that the node's own consensus-critical messages can claim (0 disables the priority lane).
+	PriorityLaneShare float64 `default:"0.1" usage:"share of the bandwidth that the node's own consensus-critical messages can claim [0 disables the priority lane]"`
 }
 
 // Parameters contains the general configuration used by the messagelayer plugin.
@@ -2,7 +2,8 @@ package messagelayer
 
 import (
 	"context"
-	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/cockroachdb/errors"
@@ -16,12 +17,20 @@ import (
 	"github.com/iotaledger/hive.go/node"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/consensus/finality"
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	pkgdatabase "github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/drng"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/schedulerutils"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
+	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/database"
 	"github.com/iotaledger/goshimmer/plugins/remotelog"
 )
@@ -36,6 +45,9 @@ var (
 	snapshotLoadedKey = kvstore.Key("snapshot_loaded")
 )
 
+// metastabilityBreakerHistorySize is the number of past dRNG rounds that are kept to break the ties of conflicts.
+const metastabilityBreakerHistorySize = 1000
+
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 var (
@@ -57,8 +69,10 @@ type dependencies struct {
 type tangledeps struct {
 	dig.In
 
-	Storage kvstore.KVStore
-	Local   *peer.Local
+	Storage      kvstore.KVStore
+	Local        *peer.Local
+	WorkerPools  *workerpools.Manager
+	DRNGInstance *drng.DRNG `optional:"true"`
 }
 
 func init() {
@@ -82,56 +96,74 @@ func init() {
 }
 
 func configure(plugin *node.Plugin) {
-	deps.Tangle.Events.Error.Attach(events.NewClosure(func(err error) {
+	event.SetSlowHandlerThreshold(Parameters.SlowEventHandlerThreshold)
+	event.Events.SlowHandler.Attach(event.NewClosure(func(ev *event.SlowHandlerEvent) {
+		plugin.LogWarnf("slow handler %s of event %s took %s", ev.Handler, ev.EventName, ev.Duration)
+	}))
+
+	deps.Tangle.Events.Error.Attach(event.NewClosure(func(err error) {
 		plugin.LogError(err)
 	}))
 
 	// Messages created by the node need to pass through the normal flow.
-	deps.Tangle.MessageFactory.Events.MessageConstructed.Attach(events.NewClosure(func(message *tangle.Message) {
+	deps.Tangle.MessageFactory.Events.MessageConstructed.Attach(event.NewClosure(func(message *tangle.Message) {
 		deps.Tangle.ProcessGossipMessage(message.Bytes(), deps.Local.Peer)
 	}))
 
-	deps.Tangle.Storage.Events.MessageStored.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			deps.Tangle.WeightProvider.Update(message.IssuingTime(), identity.NewID(message.IssuerPublicKey()))
 		})
 	}))
 
-	deps.Tangle.Parser.Events.MessageRejected.Attach(events.NewClosure(func(ev *tangle.MessageRejectedEvent, err error) {
-		plugin.LogInfof("message with %s rejected in Parser: %v", ev.Message.ID().Base58(), err)
+	deps.Tangle.Parser.Events.MessageRejected.Attach(event.NewClosure(func(ev *tangle.MessageRejectedEvent) {
+		plugin.LogInfof("message with %s rejected in Parser: %v", ev.Message.ID().Base58(), ev.Error)
 	}))
 
-	deps.Tangle.Parser.Events.BytesRejected.Attach(events.NewClosure(func(ev *tangle.BytesRejectedEvent, err error) {
-		if errors.Is(err, tangle.ErrReceivedDuplicateBytes) {
+	deps.Tangle.Parser.Events.BytesRejected.Attach(event.NewClosure(func(ev *tangle.BytesRejectedEvent) {
+		if errors.Is(ev.Error, tangle.ErrReceivedDuplicateBytes) {
 			return
 		}
 
-		plugin.LogWarnf("bytes rejected from peer %s: %v", ev.Peer.ID(), err)
+		plugin.LogWarnf("bytes rejected from peer %s: %v", ev.Peer.ID(), ev.Error)
 	}))
 
-	deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		plugin.LogInfof("message rejected in Scheduler: %s", messageID.Base58())
 	}))
 
-	deps.Tangle.Scheduler.Events.NodeBlacklisted.Attach(events.NewClosure(func(nodeID identity.ID) {
+	deps.Tangle.Scheduler.Events.NodeBlacklisted.Attach(event.NewClosure(func(nodeID identity.ID) {
 		plugin.LogInfof("node %s is blacklisted in Scheduler", nodeID.String())
 	}))
 
-	deps.Tangle.TimeManager.Events.SyncChanged.Attach(events.NewClosure(func(ev *tangle.SyncChangedEvent) {
+	deps.Tangle.Blacklist.Events.IssuerRemoved.Attach(event.NewClosure(func(nodeID identity.ID) {
+		plugin.LogInfof("node %s is no longer blacklisted", nodeID.String())
+	}))
+
+	deps.Tangle.SequenceTracker.Events.SequenceNumberReused.Attach(event.NewClosure(func(ev *tangle.SequenceNumberReusedEvent) {
+		plugin.LogWarnf("node %s reused sequence number %d in message %s (previously used in %s)", ev.IssuerID, ev.SequenceNumber, ev.MessageID.Base58(), ev.PreviousMessageID.Base58())
+	}))
+
+	deps.Tangle.TimeManager.Events.SyncChanged.Attach(event.NewClosure(func(ev *tangle.SyncChangedEvent) {
 		plugin.LogInfo("Sync changed: ", ev.Synced)
 	}))
 
 	// read snapshot file
 	if loaded, _ := deps.Storage.Has(snapshotLoadedKey); !loaded && Parameters.Snapshot.File != "" {
-		snapshot := &ledgerstate.Snapshot{}
-		f, err := os.Open(Parameters.Snapshot.File)
-		if err != nil {
-			plugin.Panic("can not open snapshot file:", err)
-		}
 		plugin.LogInfof("reading snapshot from %s ...", Parameters.Snapshot.File)
-		if _, err := snapshot.ReadFrom(f); err != nil {
+
+		// the node is not running yet, so we listen for the shutdown signals ourselves to be able to abort the import
+		ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+		snapshot, err := readSnapshotFile(ctx, plugin.Logger())
+		cancel()
+		if errors.Is(err, context.Canceled) {
+			plugin.LogFatal("reading the snapshot was cancelled")
+		}
+		if err != nil {
 			plugin.Panic("could not read snapshot file in message layer plugin:", err)
 		}
+
+		plugin.LogInfof("applying snapshot with %d transactions and %d access mana entries ...", len(snapshot.Transactions), len(snapshot.AccessManaByNode))
 		if err = deps.Tangle.LedgerState.LoadSnapshot(snapshot); err != nil {
 			plugin.Panic("fail to load snapshot file in message layer plugin:", err)
 		}
@@ -148,9 +180,25 @@ func configure(plugin *node.Plugin) {
 }
 
 func run(*node.Plugin) {
+	runConfirmationJournalCheckpoints()
+
 	if err := daemon.BackgroundWorker("Tangle", func(ctx context.Context) {
 		<-ctx.Done()
-		deps.Tangle.Shutdown()
+
+		// the ingress (gossip and web API) is already stopped, as it uses a higher shutdown priority
+		shutdownSequence := deps.Tangle.ShutdownSequence(shutdown.WithLogger(Plugin.Logger()), shutdown.WithTimeout(Parameters.ShutdownTimeout))
+		if err := shutdownSequence.Run(); err != nil {
+			Plugin.LogErrorf("Failed to shut down the tangle: %s", err)
+			database.MarkShutdownIncomplete()
+			return
+		}
+
+		// the confirmation state was flushed completely, so there is nothing left to roll forward
+		if confirmationJournal != nil {
+			if err := confirmationJournal.Clear(); err != nil {
+				Plugin.LogErrorf("Failed to clear the confirmation journal: %s", err)
+			}
+		}
 	}, shutdown.PriorityTangle); err != nil {
 		Plugin.Panicf("Failed to start as daemon: %s", err)
 	}
@@ -160,15 +208,54 @@ func run(*node.Plugin) {
 
 // region Tangle ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
-var tangleInstance *tangle.Tangle
+var (
+	tangleInstance *tangle.Tangle
+
+	// metastabilityBreaker breaks the ties of the conflicts that are not resolved after a timeout (nil if disabled).
+	metastabilityBreaker *otv.MetastabilityBreaker
+
+	// preferenceOverrides contains the manually configured preferences of branches.
+	preferenceOverrides = otv.NewPreferenceOverrides()
+)
+
+// PreferenceOverrides returns the manually configured preferences of branches that take precedence over the approval
+// weight in the OnTangleVoting.
+func PreferenceOverrides() *otv.PreferenceOverrides {
+	return preferenceOverrides
+}
 
 // newTangle gets the tangle instance.
 func newTangle(deps tangledeps) *tangle.Tangle {
 	tangleInstance = tangle.New(
 		tangle.Store(deps.Storage),
 		tangle.Identity(deps.Local.LocalIdentity()),
+		tangle.WorkerPools(deps.WorkerPools),
 		tangle.Width(Parameters.TangleWidth),
 		tangle.TimeSinceConfirmationThreshold(Parameters.TimeSinceConfirmationThreshold),
+		tangle.MaxParentAge(Parameters.MaxParentAge),
+		tangle.MaxDepth(Parameters.MaxDepth),
+		tangle.TipManagerConfig(tangle.TipManagerParams{
+			MaxTipAge:                  Parameters.TipManager.MaxTipAge,
+			MinGradeOfFinality:         gof.GradeOfFinality(Parameters.TipManager.MinGradeOfFinality),
+			GradeOfFinalityGracePeriod: Parameters.TipManager.GradeOfFinalityGracePeriod,
+			MaxUnconfirmedPastMarkers:  Parameters.TipManager.MaxUnconfirmedPastMarkers,
+			MaxUnscheduledPastMarkers:  Parameters.TipManager.MaxUnscheduledPastMarkers,
+		}),
+		tangle.BlacklistConfig(tangle.BlacklistParams{
+			CoolDownPeriod:      Parameters.Blacklist.CoolDownPeriod,
+			ViolationThreshold:  Parameters.Blacklist.ViolationThreshold,
+			ViolationWindow:     Parameters.Blacklist.ViolationWindow,
+			RejectMessages:      Parameters.Blacklist.RejectMessages,
+			DetectEquivocations: Parameters.Blacklist.DetectEquivocations,
+		}),
+		tangle.IssuerIndexConfig(tangle.IssuerIndexParams{
+			MaxMessagesPerIssuer: Parameters.IssuerIndex.MaxMessagesPerIssuer,
+			MaxIssuers:           Parameters.IssuerIndex.MaxIssuers,
+			Retention:            Parameters.IssuerIndex.Retention,
+		}),
+		tangle.MaxConflictingConsumers(Parameters.MaxConflictingConsumers),
+		tangle.ParkingTimeout(Parameters.ParkingTimeout),
+		tangle.NetworkID(config.Parameters.NetworkID),
 		tangle.GenesisNode(Parameters.Snapshot.GenesisNode),
 		tangle.SchedulerConfig(tangle.SchedulerParams{
 			MaxBufferSize:                     SchedulerParameters.MaxBufferSize,
@@ -177,26 +264,122 @@ func newTangle(deps tangledeps) *tangle.Tangle {
 			AccessManaMapRetrieverFunc:        accessManaMapRetriever,
 			AccessManaRetrieveFunc:            accessManaRetriever,
 			TotalAccessManaRetrieveFunc:       totalAccessManaRetriever,
+			DropPolicy:                        parseDropPolicy(SchedulerParameters.DropPolicy),
+			PriorityLaneShare:                 SchedulerParameters.PriorityLaneShare,
 		}),
 		tangle.RateSetterConfig(tangle.RateSetterParams{
 			Initial: &RateSetterParameters.Initial,
 		}),
 		tangle.SyncTimeWindow(Parameters.TangleTimeWindow),
 		tangle.StartSynced(Parameters.StartSynced),
+		tangle.SolidificationTimeout(Parameters.SolidificationTimeout),
 		tangle.CacheTimeProvider(database.CacheTimeProvider()),
 	)
 
 	tangleInstance.Scheduler = tangle.NewScheduler(tangleInstance)
 	tangleInstance.WeightProvider = tangle.NewCManaWeightProvider(GetCMana, tangleInstance.TimeManager.Time, deps.Storage)
-	tangleInstance.OTVConsensusManager = tangle.NewOTVConsensusManager(otv.NewOnTangleVoting(tangleInstance.LedgerState.BranchDAG, tangleInstance.ApprovalWeightManager.WeightOfBranch))
+	tangleInstance.OTVConsensusManager = tangle.NewOTVConsensusManager(otv.NewOnTangleVoting(tangleInstance.LedgerState.BranchDAG, tangleInstance.ApprovalWeightManager.WeightOfBranch, onTangleVotingOptions(deps)...))
 
-	finalityGadget = finality.NewSimpleFinalityGadget(tangleInstance)
+	finalityGadget = finality.NewSimpleFinalityGadget(tangleInstance, finalityGadgetOptions(deps)...)
 	tangleInstance.ConfirmationOracle = finalityGadget
+	configureMetastabilityBreaker()
 
 	tangleInstance.Setup()
 	return tangleInstance
 }
 
+// finalityGadgetOptions returns the options of the SimpleFinalityGadget that configure the GoFTranslation and the
+// Journal of the confirmation cascades.
+func finalityGadgetOptions(deps tangledeps) (options []finality.Option) {
+	options = []finality.Option{finality.WithGoFTranslation(newGoFTranslation())}
+
+	if !Parameters.ConfirmationJournal.Enabled {
+		return options
+	}
+
+	var err error
+	if confirmationJournal, err = finality.NewJournal(deps.Storage, Parameters.ConfirmationJournal.Retention); err != nil {
+		Plugin.Panic(err)
+	}
+
+	return append(options, finality.WithJournal(confirmationJournal))
+}
+
+// onTangleVotingOptions returns the options of the OnTangleVoting that enable the PreferenceOverrides and the
+// configured MetastabilityBreaker.
+func onTangleVotingOptions(deps tangledeps) (options []otv.OnTangleVotingOption) {
+	options = []otv.OnTangleVotingOption{otv.WithPreferenceOverrides(preferenceOverrides)}
+
+	if !Parameters.MetastabilityBreaker.Enabled {
+		return options
+	}
+	if deps.DRNGInstance == nil {
+		Plugin.LogWarn("The metastability breaker is disabled as there is no dRNG")
+		return options
+	}
+
+	// keep the past rounds of the dRNG (also across restarts), so that every conflict is decided by the round after its
+	// timeout
+	instanceID := Parameters.MetastabilityBreaker.DRNGInstanceID
+	history, err := drng.NewHistory(metastabilityBreakerHistorySize, deps.Storage.WithRealm([]byte{pkgdatabase.PrefixDRNG}))
+	if err != nil {
+		Plugin.Panic(err)
+	}
+	deps.DRNGInstance.Events.Randomness.Attach(events.NewClosure(func(state *drng.State) {
+		if state == deps.DRNGInstance.LoadState(instanceID) {
+			history.Add(state.Randomness())
+		}
+	}))
+
+	randomnessFunc := func(after time.Time) (randomness []byte, exists bool) {
+		round, exists := history.FirstAfter(after)
+		if !exists {
+			// the round was missed (e.g. while the node was offline), so the tie is broken by the fallback scores
+			return nil, history.Passed(after)
+		}
+
+		return round.Randomness, len(round.Randomness) > 0
+	}
+
+	conflictTimeFunc := func(branchID ledgerstate.BranchID) (conflictTime time.Time, exists bool) {
+		tangleInstance.LedgerState.Transaction(ledgerstate.TransactionID(branchID)).Consume(func(transaction *ledgerstate.Transaction) {
+			conflictTime, exists = transaction.Essence().Timestamp(), true
+		})
+
+		return conflictTime, exists
+	}
+
+	metastabilityBreaker = otv.NewMetastabilityBreaker(randomnessFunc, conflictTimeFunc, clock.SyncedClock{}, Parameters.MetastabilityBreaker.Timeout, Parameters.MetastabilityBreaker.Margin)
+
+	return append(options, otv.WithMetastabilityBreaker(metastabilityBreaker))
+}
+
+// configureMetastabilityBreaker makes the MetastabilityBreaker forget the scores of the branches whose conflicts were
+// resolved.
+func configureMetastabilityBreaker() {
+	if metastabilityBreaker == nil {
+		return
+	}
+
+	finalityGadget.Events().BranchConfirmed.Attach(event.NewClosure(metastabilityBreaker.Forget))
+	tangleInstance.LedgerState.BranchDAG.Events.BranchRejected.Attach(event.NewClosure(metastabilityBreaker.Forget))
+}
+
+// newGoFTranslation creates the GoFTranslation of the finality gadget from the configured finality ladder.
+func newGoFTranslation() finality.GoFTranslation {
+	messageThresholds := finality.Thresholds(Parameters.GradeOfFinality.MessageThresholds)
+	if err := messageThresholds.Validate(); err != nil {
+		Plugin.Panicf("Invalid message grade of finality thresholds: %s", err)
+	}
+
+	branchThresholds := finality.Thresholds(Parameters.GradeOfFinality.BranchThresholds)
+	if err := branchThresholds.Validate(); err != nil {
+		Plugin.Panicf("Invalid branch grade of finality thresholds: %s", err)
+	}
+
+	return finality.NewThresholdTranslation(messageThresholds, branchThresholds)
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region Scheduler ///////////////////////////////////////////////////////////////////////////////////////////
@@ -210,6 +393,14 @@ func parseDuration(durationString string) time.Duration {
 	return duration
 }
 
+func parseDropPolicy(dropPolicyName string) schedulerutils.DropPolicy {
+	dropPolicy, err := schedulerutils.DropPolicyByName(dropPolicyName)
+	if err != nil {
+		Plugin.Panicf("Failed to configure scheduler: %s", err)
+	}
+	return dropPolicy
+}
+
 func accessManaMapRetriever() map[identity.ID]float64 {
 	nodeMap, _, err := GetManaMap(mana.AccessMana)
 	if err != nil {
@@ -246,7 +437,7 @@ func AwaitMessageToBeBooked(f func() (*tangle.Message, error), txID ledgerstate.
 	exit := make(chan struct{})
 	defer close(exit)
 
-	closure := events.NewClosure(func(msgID tangle.MessageID) {
+	closure := event.NewClosure(func(msgID tangle.MessageID) {
 		match := false
 		deps.Tangle.Storage.Message(msgID).Consume(func(message *tangle.Message) {
 			if message.Payload().Type() == ledgerstate.TransactionType {
@@ -289,7 +480,7 @@ func AwaitMessageToBeIssued(f func() (*tangle.Message, error), issuer ed25519.Pu
 	exit := make(chan struct{})
 	defer close(exit)
 
-	closure := events.NewClosure(func(messageID tangle.MessageID) {
+	closure := event.NewClosure(func(messageID tangle.MessageID) {
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 			if message.IssuerPublicKey() != issuer {
 				return
@@ -0,0 +1,65 @@
+package messagelayer
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/logger"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	"github.com/iotaledger/goshimmer/plugins/config"
+)
+
+// snapshotDownloadRetryInterval defines the time to wait before an interrupted snapshot download is resumed.
+const snapshotDownloadRetryInterval = 5 * time.Second
+
+// readSnapshotFile reads the configured snapshot file (in the legacy or in the chunked format) and logs the progress of
+// the import to the given logger. If the file does not exist and a download URL is configured, the snapshot is
+// downloaded first. The import is aborted if the context is cancelled.
+func readSnapshotFile(ctx context.Context, log *logger.Logger) (ledgerSnapshot *ledgerstate.Snapshot, err error) {
+	if err = ensureSnapshotFile(ctx); err != nil {
+		return nil, err
+	}
+
+	importer := snapshot.NewImporter(config.Parameters.NetworkID, snapshot.ProgressInterval(Parameters.Snapshot.ProgressInterval))
+	importer.Events.Progress.Attach(event.NewClosure(func(progress *snapshot.ImportProgress) {
+		log.Infof("reading snapshot from %s: %s", Parameters.Snapshot.File, progress)
+	}))
+
+	return importer.Import(ctx, Parameters.Snapshot.File)
+}
+
+// ensureSnapshotFile downloads the snapshot from the configured URL if the snapshot file does not exist. Interrupted
+// downloads are resumed from the last verified chunk.
+func ensureSnapshotFile(ctx context.Context) (err error) {
+	if _, err = os.Stat(Parameters.Snapshot.File); err == nil || !os.IsNotExist(err) || Parameters.Snapshot.DownloadURL == "" {
+		return nil
+	}
+
+	for attempt := 1; attempt <= Parameters.Snapshot.DownloadAttempts; attempt++ {
+		Plugin.LogInfof("downloading snapshot from %s (attempt %d/%d) ...", Parameters.Snapshot.DownloadURL, attempt, Parameters.Snapshot.DownloadAttempts)
+		if err = snapshot.Download(ctx, nil, Parameters.Snapshot.DownloadURL, Parameters.Snapshot.File); err == nil {
+			Plugin.LogInfof("downloading snapshot from %s ... done", Parameters.Snapshot.DownloadURL)
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return errors.Errorf("snapshot download was cancelled: %w", ctx.Err())
+		}
+
+		Plugin.LogWarnf("snapshot download failed: %s", err)
+		if attempt < Parameters.Snapshot.DownloadAttempts {
+			select {
+			case <-time.After(snapshotDownloadRetryInterval):
+			case <-ctx.Done():
+				return errors.Errorf("snapshot download was cancelled: %w", ctx.Err())
+			}
+		}
+	}
+
+	return errors.Errorf("failed to download snapshot from %s: %w", Parameters.Snapshot.DownloadURL, err)
+}
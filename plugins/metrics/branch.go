@@ -32,6 +32,12 @@ var (
 	// total time it took all branches to finalize. unit is milliseconds!
 	branchConfirmationTotalTime atomic.Uint64
 
+	// number of conflicting transactions parked since the node started.
+	parkedTransactionCount atomic.Uint64
+
+	// number of parked transactions released since the node started.
+	unparkedTransactionCount atomic.Uint64
+
 	// all active branches stored in this map, to avoid duplicated event triggers for branch confirmation.
 	activeBranches map[ledgerstate.BranchID]types.Empty
 
@@ -58,6 +64,16 @@ func FinalizedBranchCountDB() uint64 {
 	return initialFinalizedBranchCountDB + finalizedBranchCountDB.Load()
 }
 
+// ParkedTransactionCount returns the number of conflicting transactions that were parked since the node started.
+func ParkedTransactionCount() uint64 {
+	return parkedTransactionCount.Load()
+}
+
+// UnparkedTransactionCount returns the number of parked transactions that were released since the node started.
+func UnparkedTransactionCount() uint64 {
+	return unparkedTransactionCount.Load()
+}
+
 func measureInitialBranchStats() {
 	activeBranchesMutex.Lock()
 	defer activeBranchesMutex.Unlock()
@@ -70,6 +70,9 @@ func (c ComponentType) String() string {
 	}
 }
 
+// tipAgeBuckets are the upper bounds of the buckets of the tip age distribution.
+var tipAgeBuckets = []time.Duration{10 * time.Second, 30 * time.Second, time.Minute, 5 * time.Minute, 10 * time.Minute, 30 * time.Minute}
+
 // initial values at start of the node.
 var (
 	// number of solid messages in the database at startup.
@@ -126,6 +129,14 @@ var (
 	// current number of message tips.
 	messageTips atomic.Uint64
 
+	// current number of message tips per upper bound of their age (cumulative).
+	messageTipAges      = make(map[time.Duration]uint64)
+	messageTipAgesMutex syncutils.RWMutex
+
+	// number of tips evicted from the tip pool per reason (since start of the node).
+	evictedTipCountPerReason      = make(map[tangle.TipEvictionReason]uint64)
+	evictedTipCountPerReasonMutex syncutils.RWMutex
+
 	// total number of parents of all messages per parent type.
 	parentsCountPerType      = make(map[tangle.ParentsType]uint64)
 	parentsCountPerTypeMutex syncutils.RWMutex
@@ -140,6 +151,23 @@ var (
 	// number of messages being requested by the message layer.
 	solidificationRequests atomic.Uint64
 
+	// number of messages that were invalid because they were below max depth (since start of the node).
+	belowMaxDepthMessageCount atomic.Uint64
+
+	// number of messages that were booked as reattachments of an already booked transaction (since start of the node).
+	reattachmentCount atomic.Uint64
+
+	// number of messages per payload type that were invalid because a payload validator rejected their payload (since
+	// start of the node).
+	invalidPayloadCountPerType      = make(map[payload.Type]uint64)
+	invalidPayloadCountPerTypeMutex syncutils.RWMutex
+
+	// number of gaps detected in the sequence numbers of the issuers (since start of the node).
+	sequenceGapCount atomic.Uint64
+
+	// number of messages that reused the sequence number of another message of the same issuer (since start of the node).
+	sequenceNumberReuseCount atomic.Uint64
+
 	// counter for the received MPS (for dashboard).
 	mpsReceivedSinceLastMeasurement atomic.Uint64
 )
@@ -207,11 +235,90 @@ func MessageTips() uint64 {
 	return messageTips.Load()
 }
 
+// MessageTipAges returns the number of tips per upper bound of their age. The counts are cumulative, i.e. a tip is
+// counted in every bucket whose upper bound is bigger than or equal to its age.
+func MessageTipAges() map[time.Duration]uint64 {
+	messageTipAgesMutex.RLock()
+	defer messageTipAgesMutex.RUnlock()
+
+	// copy the original map
+	clone := make(map[time.Duration]uint64)
+	for key, element := range messageTipAges {
+		clone[key] = element
+	}
+
+	return clone
+}
+
+// EvictedTipCountPerReason returns the number of tips evicted from the tip pool per reason since the start of the node.
+func EvictedTipCountPerReason() map[tangle.TipEvictionReason]uint64 {
+	evictedTipCountPerReasonMutex.RLock()
+	defer evictedTipCountPerReasonMutex.RUnlock()
+
+	// copy the original map
+	clone := make(map[tangle.TipEvictionReason]uint64)
+	for key, element := range evictedTipCountPerReason {
+		clone[key] = element
+	}
+
+	return clone
+}
+
 // SolidificationRequests returns the number of solidification requests since start of node.
 func SolidificationRequests() uint64 {
 	return solidificationRequests.Load()
 }
 
+// BelowMaxDepthMessageCount returns the number of messages that were invalid because they were below max depth, since
+// the start of the node.
+func BelowMaxDepthMessageCount() uint64 {
+	return belowMaxDepthMessageCount.Load()
+}
+
+// ReattachmentCount returns the number of messages that were booked as reattachments of an already booked transaction,
+// since the start of the node.
+func ReattachmentCount() uint64 {
+	return reattachmentCount.Load()
+}
+
+// InvalidPayloadCountPerType returns the number of messages per payload type that were invalid because a payload
+// validator rejected their payload, since the start of the node.
+func InvalidPayloadCountPerType() map[payload.Type]uint64 {
+	invalidPayloadCountPerTypeMutex.RLock()
+	defer invalidPayloadCountPerTypeMutex.RUnlock()
+
+	// copy the original map
+	clone := make(map[payload.Type]uint64)
+	for key, element := range invalidPayloadCountPerType {
+		clone[key] = element
+	}
+
+	return clone
+}
+
+// SequenceGapCount returns the number of gaps detected in the sequence numbers of the issuers, since the start of the
+// node.
+func SequenceGapCount() uint64 {
+	return sequenceGapCount.Load()
+}
+
+// SequenceNumberReuseCount returns the number of messages that reused the sequence number of another message of the
+// same issuer, since the start of the node.
+func SequenceNumberReuseCount() uint64 {
+	return sequenceNumberReuseCount.Load()
+}
+
+// ParserAcceptedBytesCount returns the number of received message bytes that passed the duplicate filter of the parser.
+func ParserAcceptedBytesCount() uint64 {
+	return deps.Tangle.Parser.RecentlySeenBytesFilter().AcceptedCount()
+}
+
+// ParserDuplicateBytesCount returns the number of received message bytes that were dropped by the duplicate filter of
+// the parser before they were parsed.
+func ParserDuplicateBytesCount() uint64 {
+	return deps.Tangle.Parser.RecentlySeenBytesFilter().DuplicateCount()
+}
+
 // MessageRequestQueueSize returns the number of message requests the node currently has registered.
 func MessageRequestQueueSize() int64 {
 	return requestQueueSize.Load()
@@ -350,6 +457,13 @@ func increasePerPayloadCounter(p payload.Type) {
 	messageCountPerPayload[p]++
 }
 
+func increaseInvalidPayloadCounter(p payload.Type) {
+	invalidPayloadCountPerTypeMutex.Lock()
+	defer invalidPayloadCountPerTypeMutex.Unlock()
+
+	invalidPayloadCountPerType[p]++
+}
+
 func increasePerComponentCounter(c ComponentType) {
 	messageCountPerComponentMutex.Lock()
 	defer messageCountPerComponentMutex.Unlock()
@@ -385,6 +499,30 @@ func measurePerComponentCounter() {
 
 func measureMessageTips() {
 	messageTips.Store(uint64(deps.Tangle.TipManager.TipCount()))
+
+	tipAges := make(map[time.Duration]uint64)
+	for _, bucket := range tipAgeBuckets {
+		tipAges[bucket] = 0
+	}
+	for _, tipAge := range deps.Tangle.TipManager.TipAges() {
+		for _, bucket := range tipAgeBuckets {
+			if tipAge <= bucket {
+				tipAges[bucket]++
+			}
+		}
+	}
+
+	messageTipAgesMutex.Lock()
+	defer messageTipAgesMutex.Unlock()
+	messageTipAges = tipAges
+}
+
+// increases the counter of evicted tips for the given reason.
+func increaseEvictedTipCounter(reason tangle.TipEvictionReason) {
+	evictedTipCountPerReasonMutex.Lock()
+	defer evictedTipCountPerReasonMutex.Unlock()
+
+	evictedTipCountPerReason[reason]++
 }
 
 // increases the received MPS counter
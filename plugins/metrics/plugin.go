@@ -5,7 +5,6 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/autopeering/peer"
-	"github.com/iotaledger/hive.go/autopeering/selection"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/logger"
@@ -14,7 +13,9 @@ import (
 	"github.com/iotaledger/hive.go/types"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
@@ -38,8 +39,8 @@ type dependencies struct {
 	dig.In
 
 	Tangle    *tangle.Tangle
-	GossipMgr *gossip.Manager     `optional:"true"`
-	Selection *selection.Protocol `optional:"true"`
+	GossipMgr *gossip.Manager             `optional:"true"`
+	Selection *neighborselection.Protocol `optional:"true"`
 	Local     *peer.Local
 }
 
@@ -131,7 +132,7 @@ func registerLocalMetrics() {
 	//// Events declared in other packages which we want to listen to here ////
 
 	// increase received MPS counter whenever we attached a message
-	deps.Tangle.Storage.Events.MessageStored.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Storage.Events.MessageStored.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		sumTimeMutex.Lock()
 		defer sumTimeMutex.Unlock()
 		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
@@ -146,7 +147,7 @@ func registerLocalMetrics() {
 	}))
 
 	// messages can only become solid once, then they stay like that, hence no .Dec() part
-	deps.Tangle.Solidifier.Events.MessageSolid.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Solidifier.Events.MessageSolid.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(Solidifier)
 		sumTimeMutex.Lock()
 		defer sumTimeMutex.Unlock()
@@ -160,17 +161,17 @@ func registerLocalMetrics() {
 	}))
 
 	// fired when a message gets added to missing message storage
-	deps.Tangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(func(messageId tangle.MessageID) {
+	deps.Tangle.Solidifier.Events.MessageMissing.Attach(event.NewClosure(func(messageId tangle.MessageID) {
 		missingMessageCountDB.Inc()
 		solidificationRequests.Inc()
 	}))
 
 	// fired when a missing message was received and removed from missing message storage
-	deps.Tangle.Storage.Events.MissingMessageStored.Attach(events.NewClosure(func(tangle.MessageID) {
+	deps.Tangle.Storage.Events.MissingMessageStored.Attach(event.NewClosure(func(tangle.MessageID) {
 		missingMessageCountDB.Dec()
 	}))
 
-	deps.Tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(Scheduler)
 		sumTimeMutex.Lock()
 		defer sumTimeMutex.Unlock()
@@ -189,7 +190,7 @@ func registerLocalMetrics() {
 		})
 	}))
 
-	deps.Tangle.Booker.Events.MessageBooked.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(Booker)
 		sumTimeMutex.Lock()
 		defer sumTimeMutex.Unlock()
@@ -204,7 +205,31 @@ func registerLocalMetrics() {
 		})
 	}))
 
-	deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.TipManager.Events.TipEvicted.Attach(event.NewClosure(func(event *tangle.TipEvictedEvent) {
+		increaseEvictedTipCounter(event.Reason)
+	}))
+
+	deps.Tangle.Booker.Events.MessageBelowMaxDepth.Attach(event.NewClosure(func(tangle.MessageID) {
+		belowMaxDepthMessageCount.Inc()
+	}))
+
+	deps.Tangle.Booker.Events.TransactionReattached.Attach(event.NewClosure(func(*tangle.TransactionReattachedEvent) {
+		reattachmentCount.Inc()
+	}))
+
+	deps.Tangle.PayloadValidator.Events.PayloadInvalid.Attach(event.NewClosure(func(event *tangle.PayloadInvalidEvent) {
+		increaseInvalidPayloadCounter(event.PayloadType)
+	}))
+
+	deps.Tangle.SequenceTracker.Events.SequenceGapDetected.Attach(event.NewClosure(func(*tangle.SequenceGapEvent) {
+		sequenceGapCount.Inc()
+	}))
+
+	deps.Tangle.SequenceTracker.Events.SequenceNumberReused.Attach(event.NewClosure(func(*tangle.SequenceNumberReusedEvent) {
+		sequenceNumberReuseCount.Inc()
+	}))
+
+	deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(SchedulerDropped)
 		sumTimeMutex.Lock()
 		defer sumTimeMutex.Unlock()
@@ -217,7 +242,7 @@ func registerLocalMetrics() {
 		})
 	}))
 
-	deps.Tangle.Scheduler.Events.MessageSkipped.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.Scheduler.Events.MessageSkipped.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		increasePerComponentCounter(SchedulerSkipped)
 		sumTimeMutex.Lock()
 		defer sumTimeMutex.Unlock()
@@ -230,7 +255,7 @@ func registerLocalMetrics() {
 		})
 	}))
 
-	deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(events.NewClosure(func(messageID tangle.MessageID) {
+	deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(event.NewClosure(func(messageID tangle.MessageID) {
 		messageType := DataMessage
 		deps.Tangle.Utils.ComputeIfTransaction(messageID, func(_ ledgerstate.TransactionID) {
 			messageType = Transaction
@@ -253,7 +278,7 @@ func registerLocalMetrics() {
 		}
 	}))
 
-	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(events.NewClosure(func(branchID ledgerstate.BranchID) {
+	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(func(branchID ledgerstate.BranchID) {
 		activeBranchesMutex.Lock()
 		defer activeBranchesMutex.Unlock()
 		if _, exists := activeBranches[branchID]; !exists {
@@ -277,7 +302,7 @@ func registerLocalMetrics() {
 		delete(activeBranches, branchID)
 	}))
 
-	deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(events.NewClosure(func(branchID ledgerstate.BranchID) {
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(event.NewClosure(func(branchID ledgerstate.BranchID) {
 		activeBranchesMutex.Lock()
 		defer activeBranchesMutex.Unlock()
 		if _, exists := activeBranches[branchID]; !exists {
@@ -286,6 +311,13 @@ func registerLocalMetrics() {
 		}
 	}))
 
+	deps.Tangle.LedgerState.UTXODAG.Events().TransactionParked.Attach(event.NewClosure(func(_ ledgerstate.TransactionID) {
+		parkedTransactionCount.Inc()
+	}))
+	deps.Tangle.LedgerState.UTXODAG.Events().TransactionUnparked.Attach(event.NewClosure(func(_ ledgerstate.TransactionID) {
+		unparkedTransactionCount.Inc()
+	}))
+
 	metrics.Events().AnalysisOutboundBytes.Attach(events.NewClosure(func(amountBytes uint64) {
 		analysisOutboundBytes.Add(amountBytes)
 	}))
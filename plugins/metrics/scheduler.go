@@ -3,6 +3,7 @@ package metrics
 import (
 	"time"
 
+	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/packages/tangle/schedulerutils"
 
 	"github.com/iotaledger/hive.go/identity"
@@ -25,6 +26,12 @@ var (
 	// maxBufferSize maximum number of bytes that can be stored in the buffer.
 	maxBufferSize int
 
+	// droppedMessagesCount number of messages dropped because of a full buffer per drop policy.
+	droppedMessagesCount map[string]uint64
+
+	// priorityLaneStats statistics of the priority lane of the node's own consensus-critical messages.
+	priorityLaneStats tangle.PriorityLaneStats
+
 	// nodeQueueSizes current size of each node's queue.
 	nodeQueueSizes map[identity.ID]int
 	// nodeQueueSizes current amount of aMana of each node in the queue.
@@ -48,6 +55,8 @@ func measureSchedulerMetrics() {
 	schedulerRate = deps.Tangle.Scheduler.Rate()
 	readyMessagesCount = deps.Tangle.Scheduler.ReadyMessagesCount()
 	totalMessagesCount = deps.Tangle.Scheduler.TotalMessagesCount()
+	droppedMessagesCount = deps.Tangle.Scheduler.DroppedMessagesCount()
+	priorityLaneStats = deps.Tangle.Scheduler.PriorityLaneStats()
 }
 
 // SchedulerNodeQueueSizes current size of each node's queue.
@@ -78,6 +87,20 @@ func SchedulerNodeAManaAmount() map[string]float64 {
 	return clone
 }
 
+// SchedulerDroppedMessagesCount number of messages dropped because of a full buffer per drop policy.
+func SchedulerDroppedMessagesCount() map[string]uint64 {
+	nodeQueueSizesMutex.RLock()
+	defer nodeQueueSizesMutex.RUnlock()
+
+	// copy the original map
+	clone := make(map[string]uint64)
+	for key, element := range droppedMessagesCount {
+		clone[key] = element
+	}
+
+	return clone
+}
+
 // SchedulerTotalBufferMessagesCount returns if the node is synced based on tangle time.
 func SchedulerTotalBufferMessagesCount() int {
 	return totalMessagesCount
@@ -102,3 +125,11 @@ func SchedulerBufferSize() int {
 func SchedulerRate() int64 {
 	return schedulerRate.Milliseconds()
 }
+
+// SchedulerPriorityLaneStats statistics of the priority lane of the node's own consensus-critical messages.
+func SchedulerPriorityLaneStats() tangle.PriorityLaneStats {
+	nodeQueueSizesMutex.RLock()
+	defer nodeQueueSizesMutex.RUnlock()
+
+	return priorityLaneStats
+}
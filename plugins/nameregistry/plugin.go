@@ -0,0 +1,85 @@
+// Package nameregistry is a plugin that maintains the index of the human-readable names that are registered on the
+// Tangle and resolves them to their addresses.
+package nameregistry
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/nameregistry"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the name registry plugin.
+const PluginName = "NameRegistry"
+
+var (
+	// Plugin is the plugin instance of the name registry plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+
+	// closure to be executed when a message was booked.
+	onMessageBookedClosure = event.NewClosure(onMessageBooked)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle   *tangle.Tangle
+	Server   *echo.Echo
+	Registry *nameregistry.Registry
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(store kvstore.KVStore) *nameregistry.Registry {
+			return nameregistry.New(store)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(_ *node.Plugin) {
+	deps.Tangle.Booker.Events.MessageBooked.Attach(onMessageBookedClosure)
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+		deps.Tangle.Booker.Events.MessageBooked.Detach(onMessageBookedClosure)
+		deps.Registry.Shutdown()
+	}, shutdown.PriorityNameRegistry); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// onMessageBooked applies the registration that is contained in the given message to the Registry.
+func onMessageBooked(messageID tangle.MessageID) {
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		registration, isRegistration := message.Payload().(*nameregistry.Payload)
+		if !isRegistration {
+			return
+		}
+
+		record, err := deps.Registry.Apply(registration, messageID, message.IssuingTime())
+		if err != nil {
+			Plugin.LogDebugf("rejected registration in message %s: %s", messageID, err)
+			return
+		}
+		Plugin.LogDebugf("registered %s for %s in message %s", record.Name(), record.Target().Base58(), messageID)
+	})
+}
@@ -0,0 +1,33 @@
+package nameregistry
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/nameregistry"
+)
+
+// RouteResolveName defines the HTTP path for the names/:name endpoint.
+const RouteResolveName = "names/:name"
+
+func configureWebAPI() {
+	deps.Server.GET(RouteResolveName, resolveNameHandler)
+}
+
+// resolveNameHandler returns the record of the requested name.
+func resolveNameHandler(c echo.Context) error {
+	name := c.Param("name")
+	if err := nameregistry.ValidateName(name); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	record, exists := deps.Registry.Resolve(name)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("%s is not registered", name)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewNameRecord(record))
+}
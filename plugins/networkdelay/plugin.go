@@ -1,68 +1,76 @@
 package networkdelay
 
 import (
+	"context"
 	"sync"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/crypto/ed25519"
-	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"github.com/mr-tron/base58"
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
-	"github.com/iotaledger/goshimmer/plugins/remotelog"
 )
 
 const (
 	// PluginName contains the human readable name of the plugin.
 	PluginName = "NetworkDelay"
-
-	remoteLogType = "networkdelay"
 )
 
 var (
 	// App is the "plugin" instance of the network delay application.
 	app             *node.Plugin
+	runtimePlugin   *runtimeplugin.Plugin
 	once            sync.Once
 	deps            = new(dependencies)
-	myID            string
 	myPublicKey     ed25519.PublicKey
 	originPublicKey ed25519.PublicKey
 
 	// clockEnabled defines if the clock plugin is enabled.
 	clockEnabled bool
+
+	onMessageProcessed = event.NewClosure(onReceiveMessageFromMessageLayer)
 )
 
 type dependencies struct {
 	dig.In
 
-	Tangle       *tangle.Tangle
-	Local        *peer.Local
-	RemoteLogger *remotelog.RemoteLoggerConn `optional:"true"`
-	Server       *echo.Echo
-	ClockPlugin  *node.Plugin `name:"clock"`
+	Tangle        *tangle.Tangle
+	Local         *peer.Local
+	RemoteMetrics *remotemetrics.Client `optional:"true"`
+	Server        *echo.Echo            `optional:"true"`
+	ClockPlugin   *node.Plugin          `name:"clock" optional:"true"`
 }
 
 // App gets the plugin instance.
 func App() *node.Plugin {
 	once.Do(func() {
-		app = node.NewPlugin(PluginName, deps, node.Disabled, configure)
+		app = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+		runtimePlugin = runtimeplugin.New(PluginName, node.Disabled, start, stop)
 	})
 	return app
 }
 
-func configure(plugin *node.Plugin) {
-	if deps.RemoteLogger == nil {
-		plugin.LogInfo("Plugin is inactive since RemoteLogger is disabled")
-		return
-	}
+// RuntimePlugin returns the instance that allows to start and stop the network delay application while the node is
+// running.
+func RuntimePlugin() *runtimeplugin.Plugin {
+	App()
+	return runtimePlugin
+}
 
+func configure(plugin *node.Plugin) {
 	if deps.Local != nil {
-		myID = deps.Local.ID().String()
 		myPublicKey = deps.Local.PublicKey()
 	}
 
@@ -76,12 +84,41 @@ func configure(plugin *node.Plugin) {
 		plugin.LogFatalf("could not parse originPublicKey config entry as public key. %v", err)
 	}
 
-	configureWebAPI()
+	if deps.Server != nil {
+		configureWebAPI()
+	}
+
+	clockEnabled = deps.ClockPlugin != nil && !node.IsSkipped(deps.ClockPlugin)
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		if err := runtimePlugin.Boot(); err != nil {
+			plugin.LogErrorf("Failed to start: %s", err)
+		}
+
+		<-ctx.Done()
+
+		runtimePlugin.Shutdown()
+	}, shutdown.PriorityNetworkDelay); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// start subscribes to the message layer to report the delays of the received network delay messages.
+func start() error {
+	if deps.RemoteMetrics == nil {
+		return errors.New("RemoteMetrics is disabled")
+	}
 
-	// subscribe to message-layer
-	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(events.NewClosure(onReceiveMessageFromMessageLayer))
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(onMessageProcessed)
 
-	clockEnabled = !node.IsSkipped(deps.ClockPlugin)
+	return nil
+}
+
+// stop unsubscribes from the message layer.
+func stop() {
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Detach(onMessageProcessed)
 }
 
 func onReceiveMessageFromMessageLayer(messageID tangle.MessageID) {
@@ -118,40 +155,30 @@ func onReceiveMessageFromMessageLayer(messageID tangle.MessageID) {
 }
 
 func sendToRemoteLog(networkDelayObject *Payload, receiveTime int64) {
-	m := networkDelay{
-		NodeID:      myID,
-		ID:          networkDelayObject.id.String(),
+	sendNetworkDelayMetrics(&remotemetricsproto.NetworkDelayMetrics{
+		Id:          networkDelayObject.id.String(),
 		SentTime:    networkDelayObject.sentTime,
 		ReceiveTime: receiveTime,
 		Delta:       receiveTime - networkDelayObject.sentTime,
-		Clock:       clockEnabled,
-		Sync:        deps.Tangle.Synced(),
-		Type:        remoteLogType,
-	}
-	_ = deps.RemoteLogger.Send(m)
+	})
 }
 
 func sendPoWInfo(payload *Payload, powDelta time.Duration) {
-	m := networkDelay{
-		NodeID:      myID,
-		ID:          payload.id.String(),
-		SentTime:    0,
-		ReceiveTime: 0,
-		Delta:       powDelta.Nanoseconds(),
-		Clock:       clockEnabled,
-		Sync:        deps.Tangle.Synced(),
-		Type:        remoteLogType,
-	}
-	_ = deps.RemoteLogger.Send(m)
+	sendNetworkDelayMetrics(&remotemetricsproto.NetworkDelayMetrics{
+		Id:    payload.id.String(),
+		Delta: powDelta.Nanoseconds(),
+		Pow:   true,
+	})
 }
 
-type networkDelay struct {
-	NodeID      string `json:"nodeId"`
-	ID          string `json:"id"`
-	SentTime    int64  `json:"sentTime"`
-	ReceiveTime int64  `json:"receiveTime"`
-	Delta       int64  `json:"delta"`
-	Clock       bool   `json:"clock"`
-	Sync        bool   `json:"sync"`
-	Type        string `json:"type"`
+func sendNetworkDelayMetrics(record *remotemetricsproto.NetworkDelayMetrics) {
+	if deps.RemoteMetrics == nil {
+		return
+	}
+
+	record.Clock = clockEnabled
+	record.Synced = deps.Tangle.Synced()
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_NetworkDelay{NetworkDelay: record},
+	})
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/labstack/echo"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 )
 
 func configureWebAPI() {
@@ -16,12 +18,21 @@ func configureWebAPI() {
 
 // broadcastNetworkDelayPayload creates a message with a network delay object and
 // broadcasts it to the node's neighbors. It returns the message ID if successful.
-func broadcastNetworkDelayPayload(c echo.Context) error {
+func broadcastNetworkDelayPayload(c echo.Context) (err error) {
+	// the network delay application can not be stopped while the request is handled
+	if !runtimePlugin.WhileRunning(func() { err = issueNetworkDelayPayload(c) }) {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(runtimeplugin.ErrPluginStopped))
+	}
+
+	return err
+}
+
+func issueNetworkDelayPayload(c echo.Context) error {
 	// generate random id
 	rand.Seed(time.Now().UnixNano())
 	var id [32]byte
 	if _, err := rand.Read(id[:]); err != nil {
-		return c.JSON(http.StatusInternalServerError, Response{Error: err.Error()})
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
 	}
 
 	now := clock.SyncedTime().UnixNano()
@@ -32,7 +43,7 @@ func broadcastNetworkDelayPayload(c echo.Context) error {
 
 	msg, err := deps.Tangle.IssuePayload(payload)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, Response{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	sendPoWInfo(payload, time.Since(nowWithoutClock))
@@ -0,0 +1,103 @@
+package otvdecisionlog
+
+import (
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the OTV decision log plugin.
+const PluginName = "OTVDecisionLog"
+
+var (
+	// Plugin is the plugin instance of the OTV decision log plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+
+	// pendingBranchIDs contains the branches whose weight changed since the last check of the preferences.
+	pendingBranchIDs = ledgerstate.NewBranchIDs()
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle      *tangle.Tangle
+	Server      *echo.Echo
+	DecisionLog *otv.DecisionLog
+}
+
+type decisionLogDeps struct {
+	dig.In
+
+	Tangle  *tangle.Tangle
+	Storage kvstore.KVStore
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(decisionLogDeps decisionLogDeps) (*otv.DecisionLog, error) {
+			return otv.NewDecisionLog(decisionLogDeps.Storage, decisionLogDeps.Tangle.OTVConsensusManager, decisionLogDeps.Tangle.ApprovalWeightManager.WeightOfBranch)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(plugin *node.Plugin) {
+	// the events of the ApprovalWeightManager are triggered sequentially by its worker pool
+	deps.Tangle.ApprovalWeightManager.Events.BranchWeightChanged.Attach(event.NewClosure(func(event *tangle.BranchWeightChangedEvent) {
+		pendingBranchIDs.Add(event.BranchID)
+	}))
+
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+		if len(pendingBranchIDs) == 0 {
+			return
+		}
+
+		if err := deps.DecisionLog.Update(pendingBranchIDs, triggeringMarker(messageID)); err != nil {
+			plugin.LogErrorf("failed to update the decision log after processing message %s: %s", messageID, err)
+		}
+		pendingBranchIDs = ledgerstate.NewBranchIDs()
+	}))
+
+	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(deps.DecisionLog.Forget))
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchRejected.Attach(event.NewClosure(deps.DecisionLog.Forget))
+
+	configureWebAPI()
+}
+
+// triggeringMarker returns the marker of the given message or, if the message is no marker itself, the highest of its
+// past markers.
+func triggeringMarker(messageID tangle.MessageID) (marker *markers.Marker) {
+	deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		structureDetails := messageMetadata.StructureDetails()
+		if structureDetails == nil {
+			return
+		}
+
+		if structureDetails.IsPastMarker {
+			marker = structureDetails.PastMarkers.Marker()
+			return
+		}
+
+		structureDetails.PastMarkers.ForEach(func(sequenceID markers.SequenceID, index markers.Index) bool {
+			if marker == nil || index > marker.Index() {
+				marker = markers.NewMarker(sequenceID, index)
+			}
+			return true
+		})
+	})
+
+	return marker
+}
@@ -0,0 +1,61 @@
+package otvdecisionlog
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	// RouteDecisions defines the HTTP path for the otv/decisions endpoint.
+	RouteDecisions = "otv/decisions"
+
+	// RouteStatistics defines the HTTP path for the otv/statistics endpoint.
+	RouteStatistics = "otv/statistics"
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteDecisions, getDecisionsHandler)
+	deps.Server.GET(RouteStatistics, getStatisticsHandler)
+}
+
+// getDecisionsHandler returns the recorded preference flips that match the query parameters.
+func getDecisionsHandler(c echo.Context) (err error) {
+	filter := &otv.DecisionFilter{}
+	if branchIDString := c.QueryParam("branchID"); branchIDString != "" {
+		if filter.BranchID, err = ledgerstate.BranchIDFromBase58(branchIDString); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid branchID")))
+		}
+	}
+	if sinceString := c.QueryParam("since"); sinceString != "" {
+		since, parseErr := strconv.ParseInt(sinceString, 10, 64)
+		if parseErr != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(parseErr, "invalid since")))
+		}
+		filter.Since = time.Unix(since, 0)
+	}
+	if limitString := c.QueryParam("limit"); limitString != "" {
+		if filter.Limit, err = strconv.Atoi(limitString); err != nil || filter.Limit < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s", limitString)))
+		}
+	}
+
+	decisions, err := deps.DecisionLog.Decisions(filter)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewOTVDecisionsResponse(decisions))
+}
+
+// getStatisticsHandler returns the statistics about the preference flips.
+func getStatisticsHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.NewOTVStatisticsResponse(deps.DecisionLog.Statistics()))
+}
@@ -0,0 +1,77 @@
+package otvstatementlog
+
+import (
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/statementlog"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the OTV statement log plugin.
+const PluginName = "OTVStatementLog"
+
+var (
+	// Plugin is the plugin instance of the OTV statement log plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle       *tangle.Tangle
+	Server       *echo.Echo
+	Local        *peer.Local
+	StatementLog *statementlog.StatementLog
+}
+
+type statementLogDeps struct {
+	dig.In
+
+	Local   *peer.Local
+	Storage kvstore.KVStore
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(statementLogDeps statementLogDeps) (*statementlog.StatementLog, error) {
+			return statementlog.New(statementLogDeps.Storage, statementLogDeps.Local.LocalIdentity())
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(plugin *node.Plugin) {
+	localPublicKey := deps.Local.PublicKey()
+
+	// the votes of a message are counted once it was processed by the ApprovalWeightManager
+	deps.Tangle.ApprovalWeightManager.Events.MessageProcessed.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+			if message.IssuerPublicKey() != localPublicKey {
+				return
+			}
+
+			branchIDs, err := deps.Tangle.Booker.MessageBranchIDs(messageID)
+			if err != nil {
+				plugin.LogErrorf("failed to retrieve the branches of message %s: %s", messageID, err)
+				return
+			}
+
+			if _, err = deps.StatementLog.Record(message, branchIDs); err != nil {
+				plugin.LogErrorf("failed to record the statement of message %s: %s", messageID, err)
+			}
+		})
+	}))
+
+	configureWebAPI()
+}
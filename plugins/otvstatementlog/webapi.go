@@ -0,0 +1,46 @@
+package otvstatementlog
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+const (
+	// RouteStatements defines the HTTP path for the otv/statements endpoint.
+	RouteStatements = "otv/statements"
+
+	// maxStatementsPerExport defines the maximum number of statements that are exported by a single request.
+	maxStatementsPerExport = 1000
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteStatements, getStatementsHandler)
+}
+
+// getStatementsHandler returns the signed export of the statements of the node that match the query parameters.
+func getStatementsHandler(c echo.Context) (err error) {
+	var start uint64
+	if startString := c.QueryParam("start"); startString != "" {
+		if start, err = strconv.ParseUint(startString, 10, 64); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid start")))
+		}
+	}
+	limit := maxStatementsPerExport
+	if limitString := c.QueryParam("limit"); limitString != "" {
+		if limit, err = strconv.Atoi(limitString); err != nil || limit <= 0 || limit > maxStatementsPerExport {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid limit: %s (must be between 1 and %d)", limitString, maxStatementsPerExport)))
+		}
+	}
+
+	export, err := deps.StatementLog.Export(start, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewOTVStatementsResponse(export, deps.StatementLog.Size()))
+}
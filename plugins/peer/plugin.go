@@ -166,7 +166,7 @@ func initPeerDB() (*peer.DB, kvstore.KVStore, bool, error) {
 		return nil, nil, false, err
 	}
 
-	db, err := databasePkg.NewDB(Parameters.PeerDBDirectory)
+	db, err := databasePkg.NewDB(Parameters.PeerDBDirectory, databasePkg.DurabilityNone)
 	if err != nil {
 		return nil, nil, false, fmt.Errorf("error creating peer database: %s", err)
 	}
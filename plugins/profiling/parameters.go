@@ -1,6 +1,8 @@
 package profiling
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/configuration"
 )
 
@@ -8,6 +10,34 @@ import (
 type ParametersDefinition struct {
 	// BindAddress defines the bind address for the pprof server.
 	BindAddress string `default:"127.0.0.1:6061" usage:"bind address for the pprof server"`
+
+	// Capture contains the configuration of the profiles that are captured when an anomaly is detected.
+	Capture struct {
+		// Enabled defines whether profiles are captured when an anomaly is detected.
+		Enabled bool `default:"true" usage:"whether to capture profiles when an anomaly is detected"`
+		// Directory defines the directory in which the captured profiles are stored.
+		Directory string `default:"profiles" usage:"the directory in which the captured profiles are stored"`
+		// CPUProfileDuration defines for how long the CPU profile is recorded.
+		CPUProfileDuration time.Duration `default:"10s" usage:"for how long the CPU profile is recorded"`
+		// Cooldown defines the minimum time between two captures.
+		Cooldown time.Duration `default:"10m" usage:"the minimum time between two captures"`
+		// MaxCaptures defines how many captures are kept.
+		MaxCaptures int `default:"10" usage:"how many captures are kept (0 keeps all captures)"`
+		// CheckInterval defines the interval in which the heap usage and the number of goroutines are checked.
+		CheckInterval time.Duration `default:"10s" usage:"the interval in which the heap usage and the number of goroutines are checked"`
+	}
+
+	// Triggers contains the anomalies that trigger a capture.
+	Triggers struct {
+		// HeapUsage defines the heap usage above which the profiles are captured.
+		HeapUsage uint64 `default:"3000000000" usage:"the heap usage (in bytes) above which the profiles are captured (0 disables the trigger)"` // 3 GB
+		// Goroutines defines the number of goroutines above which the profiles are captured.
+		Goroutines int `default:"10000" usage:"the number of goroutines above which the profiles are captured (0 disables the trigger)"`
+		// BookingLatency defines the time between receiving and booking a message above which the profiles are captured.
+		BookingLatency time.Duration `default:"10s" usage:"the time between receiving and booking a message above which the profiles are captured while the node is synced (0 disables the trigger)"`
+		// SlowEventHandler defines whether the profiles are captured when a slow event handler is detected.
+		SlowEventHandler bool `default:"false" usage:"whether to capture the profiles when a slow event handler is detected"`
+	}
 }
 
 // Parameters contains the configuration used by the profiling plugin.
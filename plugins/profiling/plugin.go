@@ -1,30 +1,92 @@
 package profiling
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	// import required to profile
 	_ "net/http/pprof"
 	"runtime"
 
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/timeutil"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/profiling"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
 // PluginName is the name of the profiling plugin.
 const PluginName = "Profiling"
 
+const (
+	// TriggerHeapUsage is the name of the trigger that captures the profiles when the heap usage is too high.
+	TriggerHeapUsage = "heapUsage"
+	// TriggerGoroutines is the name of the trigger that captures the profiles when there are too many goroutines.
+	TriggerGoroutines = "goroutines"
+	// TriggerBookingLatency is the name of the trigger that captures the profiles when messages are booked too slowly.
+	TriggerBookingLatency = "bookingLatency"
+	// TriggerSlowEventHandler is the name of the trigger that captures the profiles when an event handler is too slow.
+	TriggerSlowEventHandler = "slowEventHandler"
+)
+
 var (
 	// Plugin is the profiling plugin.
 	Plugin *node.Plugin
+	deps   = new(dependencies)
 	log    *logger.Logger
 )
 
+type dependencies struct {
+	dig.In
+
+	Tangle   *tangle.Tangle
+	Server   *echo.Echo `optional:"true"`
+	Recorder *profiling.Recorder
+}
+
 func init() {
-	Plugin = node.NewPlugin(PluginName, nil, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newRecorder); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newRecorder creates the Recorder that stores the profiles that are captured when an anomaly is detected.
+func newRecorder() (*profiling.Recorder, error) {
+	return profiling.NewRecorder(Parameters.Capture.Directory,
+		profiling.WithCPUProfileDuration(Parameters.Capture.CPUProfileDuration),
+		profiling.WithCooldown(Parameters.Capture.Cooldown),
+		profiling.WithMaxCaptures(Parameters.Capture.MaxCaptures),
+	)
 }
 
 func configure(_ *node.Plugin) {
 	log = logger.NewLogger(PluginName)
+
+	deps.Recorder.Events.CaptureStored.Attach(event.NewClosure(func(capture *profiling.Capture) {
+		log.Infof("captured profiles %s (%s)", capture.ID, capture.Reason)
+	}))
+	deps.Recorder.Events.CaptureFailed.Attach(event.NewClosure(func(event *profiling.CaptureFailedEvent) {
+		log.Errorf("failed to capture profiles triggered by %s (%s): %s", event.Trigger, event.Reason, event.Error)
+	}))
+
+	if Parameters.Capture.Enabled {
+		configureTriggers()
+	}
+
+	if deps.Server != nil {
+		configureWebAPI()
+	}
 }
 
 func run(_ *node.Plugin) {
@@ -35,4 +97,55 @@ func run(_ *node.Plugin) {
 
 	log.Infof("%s started, bind-address=%s", PluginName, bindAddr)
 	go http.ListenAndServe(bindAddr, nil)
+
+	if !Parameters.Capture.Enabled || Parameters.Triggers.HeapUsage == 0 && Parameters.Triggers.Goroutines == 0 {
+		return
+	}
+
+	if err := daemon.BackgroundWorker("Profiling Anomaly Detector", func(ctx context.Context) {
+		timeutil.NewTicker(checkResourceUsage, Parameters.Capture.CheckInterval, ctx).WaitForGracefulShutdown()
+	}, shutdown.PriorityProfiling); err != nil {
+		log.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// configureTriggers attaches the triggers that capture the profiles when a latency anomaly is detected.
+func configureTriggers() {
+	if Parameters.Triggers.BookingLatency > 0 {
+		deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+			if !deps.Tangle.Synced() {
+				return
+			}
+
+			deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+				if latency := messageMetadata.BookedTime().Sub(messageMetadata.ReceivedTime()); latency >= Parameters.Triggers.BookingLatency {
+					deps.Recorder.Trigger(TriggerBookingLatency, fmt.Sprintf("message %s was booked %s after it was received", messageID, latency))
+				}
+			})
+		}))
+	}
+
+	if Parameters.Triggers.SlowEventHandler {
+		event.Events.SlowHandler.Attach(event.NewClosure(func(event *event.SlowHandlerEvent) {
+			deps.Recorder.Trigger(TriggerSlowEventHandler, fmt.Sprintf("handler %s of %s took %s", event.Handler, event.EventName, event.Duration))
+		}))
+	}
+}
+
+// checkResourceUsage captures the profiles when the heap usage or the number of goroutines exceed their thresholds.
+func checkResourceUsage() {
+	if goroutines := runtime.NumGoroutine(); Parameters.Triggers.Goroutines > 0 && goroutines >= Parameters.Triggers.Goroutines {
+		deps.Recorder.Trigger(TriggerGoroutines, fmt.Sprintf("%d goroutines are running", goroutines))
+		return
+	}
+
+	if Parameters.Triggers.HeapUsage == 0 {
+		return
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	if memStats.HeapAlloc >= Parameters.Triggers.HeapUsage {
+		deps.Recorder.Trigger(TriggerHeapUsage, fmt.Sprintf("heap usage of %d bytes", memStats.HeapAlloc))
+	}
 }
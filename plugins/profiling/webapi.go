@@ -0,0 +1,48 @@
+package profiling
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/profiling"
+)
+
+const (
+	// RouteProfileCaptures defines the HTTP path for the profiling/captures endpoint.
+	RouteProfileCaptures = "profiling/captures"
+
+	// RouteProfile defines the HTTP path for the profiling/captures/:captureID/:kind endpoint.
+	RouteProfile = "profiling/captures/:captureID/:kind"
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteProfileCaptures, getProfileCapturesHandler)
+	deps.Server.GET(RouteProfile, getProfileHandler)
+}
+
+// getProfileCapturesHandler returns the stored captures of profiles.
+func getProfileCapturesHandler(c echo.Context) error {
+	captures, err := deps.Recorder.Captures()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewProfileCapturesResponse(captures))
+}
+
+// getProfileHandler returns the requested profile of a capture in the pprof format.
+func getProfileHandler(c echo.Context) error {
+	path, err := deps.Recorder.ProfilePath(c.Param("captureID"), profiling.Kind(c.Param("kind")))
+	if err != nil {
+		if errors.Is(err, profiling.ErrCaptureNotFound) {
+			return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
+		}
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMEOctetStream)
+	return c.Attachment(path, c.Param("captureID")+"-"+c.Param("kind")+".pprof")
+}
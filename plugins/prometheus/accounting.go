@@ -0,0 +1,71 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	accountingplugin "github.com/iotaledger/goshimmer/plugins/accounting"
+)
+
+var (
+	accountingPayloadMessages *prometheus.GaugeVec
+	accountingPayloadBytes    *prometheus.GaugeVec
+	accountingIssuerMessages  *prometheus.GaugeVec
+	accountingIssuerBytes     *prometheus.GaugeVec
+	accountingIssuers         prometheus.Gauge
+)
+
+func registerAccountingMetrics() {
+	accountingPayloadMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "accounting_payload_messages",
+		Help: "number of messages issued per payload type within the accounting window",
+	}, []string{"payload_type"})
+
+	accountingPayloadBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "accounting_payload_bytes",
+		Help: "number of bytes issued per payload type within the accounting window",
+	}, []string{"payload_type"})
+
+	accountingIssuerMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "accounting_issuer_messages",
+		Help: "number of messages issued by the issuers with the most issued bytes within the accounting window",
+	}, []string{"issuer"})
+
+	accountingIssuerBytes = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "accounting_issuer_bytes",
+		Help: "number of bytes issued by the issuers with the most issued bytes within the accounting window",
+	}, []string{"issuer"})
+
+	accountingIssuers = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "accounting_issuers",
+		Help: "number of issuers that issued messages within the accounting window",
+	})
+
+	registry.MustRegister(accountingPayloadMessages)
+	registry.MustRegister(accountingPayloadBytes)
+	registry.MustRegister(accountingIssuerMessages)
+	registry.MustRegister(accountingIssuerBytes)
+	registry.MustRegister(accountingIssuers)
+
+	addCollect(collectAccountingMetrics)
+}
+
+func collectAccountingMetrics() {
+	report := deps.Accountant.Report(0)
+
+	accountingPayloadMessages.Reset()
+	accountingPayloadBytes.Reset()
+	for payloadType, usage := range report.PayloadTypes {
+		accountingPayloadMessages.WithLabelValues(payloadType.String()).Set(float64(usage.Messages))
+		accountingPayloadBytes.WithLabelValues(payloadType.String()).Set(float64(usage.Bytes))
+	}
+
+	// only the issuers with the most issued bytes are exposed to bound the cardinality of the metrics
+	accountingIssuerMessages.Reset()
+	accountingIssuerBytes.Reset()
+	for _, issuerUsage := range report.TopIssuers(accountingplugin.Parameters.MetricsIssuers) {
+		accountingIssuerMessages.WithLabelValues(issuerUsage.Issuer.String()).Set(float64(issuerUsage.Messages))
+		accountingIssuerBytes.WithLabelValues(issuerUsage.Issuer.String()).Set(float64(issuerUsage.Bytes))
+	}
+
+	accountingIssuers.Set(float64(len(report.Issuers)))
+}
@@ -0,0 +1,78 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/goshimmer/packages/analytics"
+)
+
+var (
+	analyticsTangleWidth            *prometheus.GaugeVec
+	analyticsConfirmationLatency    *prometheus.GaugeVec
+	analyticsOrphanageRate          prometheus.Gauge
+	analyticsOrphanedMessages       prometheus.Gauge
+	analyticsConflictResolutionTime *prometheus.GaugeVec
+	analyticsPendingConflicts       prometheus.Gauge
+)
+
+func registerAnalyticsMetrics() {
+	analyticsTangleWidth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "analytics_tangle_width",
+		Help: "number of tips of the tangle within the analytics window",
+	}, []string{"statistic"})
+
+	analyticsConfirmationLatency = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "analytics_confirmation_latency_seconds",
+		Help: "time between the issuance and the confirmation of the messages within the analytics window",
+	}, []string{"statistic"})
+
+	analyticsOrphanageRate = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "analytics_orphanage_rate",
+		Help: "fraction of the resolved messages that were orphaned within the analytics window",
+	})
+
+	analyticsOrphanedMessages = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "analytics_orphaned_messages",
+		Help: "number of messages that were orphaned within the analytics window",
+	})
+
+	analyticsConflictResolutionTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "analytics_conflict_resolution_time_seconds",
+		Help: "time between the creation and the resolution of the branches within the analytics window",
+	}, []string{"statistic"})
+
+	analyticsPendingConflicts = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "analytics_pending_conflicts",
+		Help: "number of branches that were created but not resolved, yet",
+	})
+
+	registry.MustRegister(analyticsTangleWidth)
+	registry.MustRegister(analyticsConfirmationLatency)
+	registry.MustRegister(analyticsOrphanageRate)
+	registry.MustRegister(analyticsOrphanedMessages)
+	registry.MustRegister(analyticsConflictResolutionTime)
+	registry.MustRegister(analyticsPendingConflicts)
+
+	addCollect(collectAnalyticsMetrics)
+}
+
+func collectAnalyticsMetrics() {
+	statistics := deps.Analytics.Statistics()
+
+	analyticsTangleWidth.WithLabelValues("current").Set(float64(statistics.TangleWidth.Current))
+	analyticsTangleWidth.WithLabelValues("average").Set(statistics.TangleWidth.Average)
+	analyticsTangleWidth.WithLabelValues("max").Set(float64(statistics.TangleWidth.Max))
+	setDurationStatistics(analyticsConfirmationLatency, statistics.ConfirmationLatency)
+	analyticsOrphanageRate.Set(statistics.OrphanageRate)
+	analyticsOrphanedMessages.Set(float64(statistics.OrphanedMessages))
+	setDurationStatistics(analyticsConflictResolutionTime, statistics.ConflictResolutionTime)
+	analyticsPendingConflicts.Set(float64(statistics.PendingConflicts))
+}
+
+// setDurationStatistics sets the gauges of the given vector to the given analytics.DurationStatistics.
+func setDurationStatistics(gaugeVec *prometheus.GaugeVec, statistics *analytics.DurationStatistics) {
+	gaugeVec.WithLabelValues("average").Set(statistics.Average.Seconds())
+	gaugeVec.WithLabelValues("p50").Set(statistics.P50.Seconds())
+	gaugeVec.WithLabelValues("p90").Set(statistics.P90.Seconds())
+	gaugeVec.WithLabelValues("p99").Set(statistics.P99.Seconds())
+}
@@ -0,0 +1,62 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+var (
+	eventHandlerPending        *prometheus.GaugeVec
+	eventHandlerExecutions     *prometheus.GaugeVec
+	eventHandlerSlowExecutions *prometheus.GaugeVec
+	eventHandlerMaxDuration    *prometheus.GaugeVec
+	eventHandlerTotalDuration  *prometheus.GaugeVec
+)
+
+func registerEventHandlerMetrics() {
+	labels := []string{"event", "handler"}
+
+	eventHandlerPending = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_handler_pending",
+		Help: "number of executions of an event handler that are currently in progress.",
+	}, labels)
+
+	eventHandlerExecutions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_handler_executions",
+		Help: "number of completed executions of an event handler.",
+	}, labels)
+
+	eventHandlerSlowExecutions = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_handler_slow_executions",
+		Help: "number of executions of an event handler that exceeded the slow handler threshold.",
+	}, labels)
+
+	eventHandlerMaxDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_handler_max_duration_seconds",
+		Help: "duration of the slowest execution of an event handler (in seconds).",
+	}, labels)
+
+	eventHandlerTotalDuration = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "event_handler_total_duration_seconds",
+		Help: "accumulated duration of all executions of an event handler (in seconds).",
+	}, labels)
+
+	registry.MustRegister(eventHandlerPending)
+	registry.MustRegister(eventHandlerExecutions)
+	registry.MustRegister(eventHandlerSlowExecutions)
+	registry.MustRegister(eventHandlerMaxDuration)
+	registry.MustRegister(eventHandlerTotalDuration)
+
+	addCollect(collectEventHandlerMetrics)
+}
+
+func collectEventHandlerMetrics() {
+	for _, metrics := range event.AllHandlerMetrics() {
+		eventHandlerPending.WithLabelValues(metrics.EventName, metrics.Handler).Set(float64(metrics.Pending))
+		eventHandlerExecutions.WithLabelValues(metrics.EventName, metrics.Handler).Set(float64(metrics.Executions))
+		eventHandlerSlowExecutions.WithLabelValues(metrics.EventName, metrics.Handler).Set(float64(metrics.SlowExecutions))
+		eventHandlerMaxDuration.WithLabelValues(metrics.EventName, metrics.Handler).Set(metrics.MaxDuration.Seconds())
+		eventHandlerTotalDuration.WithLabelValues(metrics.EventName, metrics.Handler).Set(metrics.TotalDuration.Seconds())
+	}
+}
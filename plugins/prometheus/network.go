@@ -12,6 +12,15 @@ var (
 	gossipOutboundPackets    prometheus.Gauge
 	autopeeringInboundBytes  prometheus.Gauge
 	autopeeringOutboundBytes prometheus.Gauge
+
+	gossipIncompatibleNeighbors prometheus.Gauge
+	gossipUnsupportedMessages   *prometheus.GaugeVec
+	gossipDroppedPackets        *prometheus.GaugeVec
+
+	gossipNeighborBandwidth         *prometheus.GaugeVec
+	gossipNeighborBandwidthCap      *prometheus.GaugeVec
+	gossipNeighborThrottledMessages *prometheus.GaugeVec
+	gossipThrottledMessages         prometheus.Gauge
 )
 
 func registerNetworkMetrics() {
@@ -35,6 +44,34 @@ func registerNetworkMetrics() {
 		Name: "traffic_analysis_outbound_bytes",
 		Help: "traffic_Analysis client TX network traffic [bytes].",
 	})
+	gossipIncompatibleNeighbors = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gossip_incompatible_neighbors",
+		Help: "number of neighbors that were rejected because of incompatible message versions",
+	})
+	gossipUnsupportedMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gossip_unsupported_messages",
+		Help: "number of messages that were dropped because their version was not negotiated with the neighbor",
+	}, []string{"direction"})
+	gossipDroppedPackets = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gossip_dropped_packets",
+		Help: "number of packets that were discarded because the gossip worker pools were full",
+	}, []string{"direction"})
+	gossipNeighborBandwidth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gossip_neighbor_bandwidth_bytes",
+		Help: "number of bytes that were exchanged with a neighbor during the bandwidth window",
+	}, []string{"neighbor", "direction"})
+	gossipNeighborBandwidthCap = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gossip_neighbor_bandwidth_cap_bytes",
+		Help: "soft cap of bytes per bandwidth window that are sent to a neighbor (0 if the neighbor is not throttled)",
+	}, []string{"neighbor"})
+	gossipNeighborThrottledMessages = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gossip_neighbor_throttled_messages",
+		Help: "number of messages that were not sent to a neighbor because it reached its outbound bandwidth cap",
+	}, []string{"neighbor"})
+	gossipThrottledMessages = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "gossip_throttled_messages",
+		Help: "number of messages that were not sent to neighbors because they reached their outbound bandwidth cap",
+	})
 
 	if deps.AutoPeeringConnMetric != nil {
 		registry.MustRegister(autopeeringInboundBytes)
@@ -43,6 +80,15 @@ func registerNetworkMetrics() {
 	registry.MustRegister(analysisOutboundBytes)
 	registry.MustRegister(gossipInboundPackets)
 	registry.MustRegister(gossipOutboundPackets)
+	if deps.GossipMgr != nil {
+		registry.MustRegister(gossipIncompatibleNeighbors)
+		registry.MustRegister(gossipUnsupportedMessages)
+		registry.MustRegister(gossipDroppedPackets)
+		registry.MustRegister(gossipNeighborBandwidth)
+		registry.MustRegister(gossipNeighborBandwidthCap)
+		registry.MustRegister(gossipNeighborThrottledMessages)
+		registry.MustRegister(gossipThrottledMessages)
+	}
 
 	addCollect(collectNetworkMetrics)
 }
@@ -55,4 +101,27 @@ func collectNetworkMetrics() {
 	analysisOutboundBytes.Set(float64(metrics.AnalysisOutboundBytes()))
 	gossipInboundPackets.Set(float64(metrics.GossipInboundPackets()))
 	gossipOutboundPackets.Set(float64(metrics.GossipOutboundPackets()))
+	if deps.GossipMgr != nil {
+		gossipIncompatibleNeighbors.Set(float64(deps.GossipMgr.IncompatibleNeighborsCount()))
+		gossipUnsupportedMessages.WithLabelValues("inbound").Set(float64(deps.GossipMgr.UnsupportedInboundMessagesCount()))
+		gossipUnsupportedMessages.WithLabelValues("outbound").Set(float64(deps.GossipMgr.UnsupportedOutboundMessagesCount()))
+		gossipDroppedPackets.WithLabelValues("inbound").Set(float64(deps.GossipMgr.DroppedInboundPacketsCount()))
+		gossipDroppedPackets.WithLabelValues("outbound").Set(float64(deps.GossipMgr.DroppedOutboundPacketsCount()))
+		gossipThrottledMessages.Set(float64(deps.GossipMgr.ThrottledOutboundMessagesCount()))
+		collectNeighborBandwidthMetrics()
+	}
+}
+
+// collectNeighborBandwidthMetrics sets the bandwidth metrics of the currently connected neighbors.
+func collectNeighborBandwidthMetrics() {
+	gossipNeighborBandwidth.Reset()
+	gossipNeighborBandwidthCap.Reset()
+	gossipNeighborThrottledMessages.Reset()
+	for _, neighbor := range deps.GossipMgr.AllNeighbors() {
+		neighborID := neighbor.ID().String()
+		gossipNeighborBandwidth.WithLabelValues(neighborID, "inbound").Set(float64(neighbor.InboundBandwidth()))
+		gossipNeighborBandwidth.WithLabelValues(neighborID, "outbound").Set(float64(neighbor.OutboundBandwidth()))
+		gossipNeighborBandwidthCap.WithLabelValues(neighborID).Set(float64(neighbor.OutboundBandwidthCap()))
+		gossipNeighborThrottledMessages.WithLabelValues(neighborID).Set(float64(neighbor.ThrottledMessages()))
+	}
 }
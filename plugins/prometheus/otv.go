@@ -0,0 +1,38 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/event"
+)
+
+var (
+	otvPreferenceFlips           prometheus.Counter
+	otvPreferenceFlipsLastMinute prometheus.Gauge
+)
+
+func registerOTVMetrics() {
+	otvPreferenceFlips = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "otv_preference_flips",
+		Help: "number of times the preference of the node for a branch flipped",
+	})
+
+	otvPreferenceFlipsLastMinute = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "otv_preference_flips_last_minute",
+		Help: "number of times the preference of the node for a branch flipped during the last minute",
+	})
+
+	registry.MustRegister(otvPreferenceFlips)
+	registry.MustRegister(otvPreferenceFlipsLastMinute)
+
+	deps.DecisionLog.Events.PreferenceFlipped.Attach(event.NewClosure(func(_ *otv.Decision) {
+		otvPreferenceFlips.Inc()
+	}))
+
+	addCollect(collectOTVMetrics)
+}
+
+func collectOTVMetrics() {
+	otvPreferenceFlipsLastMinute.Set(float64(deps.DecisionLog.Statistics().FlipsLastMinute))
+}
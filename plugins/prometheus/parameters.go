@@ -16,6 +16,8 @@ type ParametersDefinition struct {
 	PromhttpMetrics bool `default:"false" usage:"include promhttp metrics"`
 	// WorkerpoolMetrics defines whether to include workerpool metrics.
 	WorkerpoolMetrics bool `default:"false" usage:"include workerpool metrics"`
+	// EventHandlerMetrics defines whether to include the execution metrics of the event handlers.
+	EventHandlerMetrics bool `default:"false" usage:"include event handler metrics"`
 }
 
 // Parameters contains the configuration used by the prometheus plugin.
@@ -15,9 +15,14 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/accounting"
+	"github.com/iotaledger/goshimmer/packages/analytics"
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
 	"github.com/iotaledger/goshimmer/packages/gossip"
 	"github.com/iotaledger/goshimmer/packages/net"
+	"github.com/iotaledger/goshimmer/packages/resourcemanager"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
+	sharedworkerpools "github.com/iotaledger/goshimmer/packages/workerpools"
 	"github.com/iotaledger/goshimmer/plugins/metrics"
 )
 
@@ -40,8 +45,13 @@ type dependencies struct {
 	dig.In
 	AutopeeringPlugin     *node.Plugin `name:"autopeering" optional:"true"`
 	Local                 *peer.Local
-	GossipMgr             *gossip.Manager `optional:"true"`
-	AutoPeeringConnMetric *net.ConnMetric `optional:"true"`
+	GossipMgr             *gossip.Manager            `optional:"true"`
+	AutoPeeringConnMetric *net.ConnMetric            `optional:"true"`
+	WorkerPools           *sharedworkerpools.Manager `optional:"true"`
+	ResourceManager       *resourcemanager.Manager   `optional:"true"`
+	DecisionLog           *otv.DecisionLog           `optional:"true"`
+	Analytics             *analytics.Analytics       `optional:"true"`
+	Accountant            *accounting.Accountant     `optional:"true"`
 }
 
 func configure(plugin *node.Plugin) {
@@ -51,6 +61,26 @@ func configure(plugin *node.Plugin) {
 		registerWorkerpoolMetrics()
 	}
 
+	if Parameters.EventHandlerMetrics {
+		registerEventHandlerMetrics()
+	}
+
+	if deps.ResourceManager != nil {
+		registerResourceManagerMetrics()
+	}
+
+	if deps.DecisionLog != nil {
+		registerOTVMetrics()
+	}
+
+	if deps.Analytics != nil {
+		registerAnalyticsMetrics()
+	}
+
+	if deps.Accountant != nil {
+		registerAccountingMetrics()
+	}
+
 	if Parameters.GoMetrics {
 		registry.MustRegister(prometheus.NewGoCollector())
 	}
@@ -69,6 +99,7 @@ func configure(plugin *node.Plugin) {
 		registerTangleMetrics()
 		registerManaMetrics()
 		registerSchedulerMetrics()
+		registerStringCacheMetrics()
 	}
 
 	if metrics.Parameters.Global {
@@ -0,0 +1,38 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/resourcemanager"
+)
+
+var (
+	resourceManagerLevel         prometheus.Gauge
+	resourceManagerCachesFlushed prometheus.Counter
+)
+
+func registerResourceManagerMetrics() {
+	resourceManagerLevel = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "resource_manager_level",
+		Help: "memory pressure of the node (0 = normal, 1 = elevated, 2 = critical)",
+	})
+
+	resourceManagerCachesFlushed = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "resource_manager_caches_flushed",
+		Help: "number of times the caches were flushed due to memory pressure",
+	})
+
+	registry.MustRegister(resourceManagerLevel)
+	registry.MustRegister(resourceManagerCachesFlushed)
+
+	deps.ResourceManager.Events.CachesFlushed.Attach(event.NewClosure(func(_ *resourcemanager.CachesFlushedEvent) {
+		resourceManagerCachesFlushed.Inc()
+	}))
+
+	addCollect(collectResourceManagerMetrics)
+}
+
+func collectResourceManagerMetrics() {
+	resourceManagerLevel.Set(float64(deps.ResourceManager.Level()))
+}
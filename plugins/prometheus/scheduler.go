@@ -14,6 +14,11 @@ var (
 	totalMessagesCount prometheus.Gauge
 	bufferSize         prometheus.Gauge
 	maxBufferSize      prometheus.Gauge
+	droppedMessages    *prometheus.GaugeVec
+
+	priorityLaneSize     prometheus.Gauge
+	priorityLaneMessages prometheus.Gauge
+	priorityLaneShare    prometheus.Gauge
 )
 
 func registerSchedulerMetrics() {
@@ -58,6 +63,29 @@ func registerSchedulerMetrics() {
 		Help: "maximum number of bytes that can be stored in the buffer.",
 	})
 
+	droppedMessages = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "scheduler_buffer_dropped_msg_count",
+			Help: "number of messages dropped because of a full scheduler buffer per drop policy.",
+		}, []string{
+			"policy",
+		})
+
+	priorityLaneSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "scheduler_priority_lane_size",
+		Help: "number of bytes of the node's own consensus-critical messages waiting in the priority lane.",
+	})
+
+	priorityLaneMessages = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "scheduler_priority_lane_scheduled_msg_count",
+		Help: "number of messages scheduled from the priority lane.",
+	})
+
+	priorityLaneShare = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "scheduler_priority_lane_share",
+		Help: "share of the scheduled bytes that were scheduled from the priority lane.",
+	})
+
 	registry.MustRegister(queueSizePerNode)
 	registry.MustRegister(manaAmountPerNode)
 	registry.MustRegister(schedulerRate)
@@ -65,6 +93,10 @@ func registerSchedulerMetrics() {
 	registry.MustRegister(totalMessagesCount)
 	registry.MustRegister(bufferSize)
 	registry.MustRegister(maxBufferSize)
+	registry.MustRegister(droppedMessages)
+	registry.MustRegister(priorityLaneSize)
+	registry.MustRegister(priorityLaneMessages)
+	registry.MustRegister(priorityLaneShare)
 
 	addCollect(collectSchedulerMetrics)
 }
@@ -85,4 +117,11 @@ func collectSchedulerMetrics() {
 	totalMessagesCount.Set(float64(metrics.SchedulerTotalBufferMessagesCount()))
 	bufferSize.Set(float64(metrics.SchedulerBufferSize()))
 	maxBufferSize.Set(float64(metrics.SchedulerMaxBufferSize()))
+	for policy, count := range metrics.SchedulerDroppedMessagesCount() {
+		droppedMessages.WithLabelValues(policy).Set(float64(count))
+	}
+	stats := metrics.SchedulerPriorityLaneStats()
+	priorityLaneSize.Set(float64(stats.Size))
+	priorityLaneMessages.Set(float64(stats.ScheduledMessages))
+	priorityLaneShare.Set(stats.Share())
 }
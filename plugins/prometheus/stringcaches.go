@@ -0,0 +1,46 @@
+package prometheus
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/iotaledger/goshimmer/packages/stringcache"
+)
+
+var (
+	stringCacheSize   *prometheus.GaugeVec
+	stringCacheHits   *prometheus.GaugeVec
+	stringCacheMisses *prometheus.GaugeVec
+)
+
+func registerStringCacheMetrics() {
+	labels := []string{"cache"}
+
+	stringCacheSize = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "string_cache_size",
+		Help: "number of strings that are held by a string cache.",
+	}, labels)
+
+	stringCacheHits = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "string_cache_hits",
+		Help: "number of lookups that were answered by a string cache.",
+	}, labels)
+
+	stringCacheMisses = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "string_cache_misses",
+		Help: "number of lookups that required a string cache to encode a new string.",
+	}, labels)
+
+	registry.MustRegister(stringCacheSize)
+	registry.MustRegister(stringCacheHits)
+	registry.MustRegister(stringCacheMisses)
+
+	addCollect(collectStringCacheMetrics)
+}
+
+func collectStringCacheMetrics() {
+	for _, metrics := range stringcache.AllMetrics() {
+		stringCacheSize.WithLabelValues(metrics.Name).Set(float64(metrics.Size))
+		stringCacheHits.WithLabelValues(metrics.Name).Set(float64(metrics.Hits))
+		stringCacheMisses.WithLabelValues(metrics.Name).Set(float64(metrics.Misses))
+	}
+}
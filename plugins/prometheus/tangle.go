@@ -8,7 +8,15 @@ import (
 
 var (
 	messageTips                               prometheus.Gauge
+	messageTipAges                            *prometheus.GaugeVec
+	evictedTipCount                           *prometheus.GaugeVec
 	solidificationRequests                    prometheus.Gauge
+	belowMaxDepthMessageCount                 prometheus.Gauge
+	reattachmentCount                         prometheus.Gauge
+	invalidPayloadCount                       *prometheus.GaugeVec
+	sequenceGapCount                          prometheus.Gauge
+	sequenceNumberReuseCount                  prometheus.Gauge
+	parserRecentlySeenBytes                   *prometheus.GaugeVec
 	messagePerTypeCount                       *prometheus.GaugeVec
 	initialMessagePerComponentCount           *prometheus.GaugeVec
 	messagePerComponentCount                  *prometheus.GaugeVec
@@ -25,6 +33,8 @@ var (
 	branchConfirmationTotalTime               prometheus.Gauge
 	totalBranchCountDB                        prometheus.Gauge
 	finalizedBranchCountDB                    prometheus.Gauge
+	parkedTransactionCount                    prometheus.Gauge
+	unparkedTransactionCount                  prometheus.Gauge
 	finalizedMessageCount                     *prometheus.GaugeVec
 	messageFinalizationTotalTimeSinceReceived *prometheus.GaugeVec
 	messageFinalizationTotalTimeSinceIssued   *prometheus.GaugeVec
@@ -36,11 +46,63 @@ func registerTangleMetrics() {
 		Help: "Current number of tips in message tangle",
 	})
 
+	messageTipAges = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tangle_message_tips_age_count",
+			Help: "current number of tips in message tangle whose age is less than or equal to the given bucket",
+		}, []string{
+			"le",
+		})
+
+	evictedTipCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tangle_message_tips_evicted_count",
+			Help: "number of tips evicted from the tip pool without being referenced, since the start of the node",
+		}, []string{
+			"reason",
+		})
+
 	solidificationRequests = prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "tangle_message_solidification_missing_message_count",
 		Help: "Total number of messages requested by Solidifier.",
 	})
 
+	belowMaxDepthMessageCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_message_below_max_depth_count",
+		Help: "number of messages that were invalid because they were below max depth, since the start of the node",
+	})
+
+	reattachmentCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_reattachment_count",
+		Help: "number of messages that were booked as reattachments of an already booked transaction, since the start of the node",
+	})
+
+	invalidPayloadCount = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tangle_invalid_payload_count",
+			Help: "number of messages per payload type whose payload was rejected by a payload validator, since the start of the node",
+		}, []string{
+			"message_type",
+		})
+
+	sequenceGapCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_sequence_gap_count",
+		Help: "number of gaps detected in the sequence numbers of the issuers, since the start of the node",
+	})
+
+	sequenceNumberReuseCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_sequence_number_reuse_count",
+		Help: "number of messages that reused the sequence number of another message of the same issuer, since the start of the node",
+	})
+
+	parserRecentlySeenBytes = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "tangle_parser_recently_seen_bytes_count",
+			Help: "number of received message bytes that passed (accepted) or were dropped by (duplicate) the duplicate filter of the parser, since the start of the node",
+		}, []string{
+			"result",
+		})
+
 	messagePerTypeCount = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "tangle_messages_per_type_count",
@@ -163,8 +225,25 @@ func registerTangleMetrics() {
 		Help: "current number of confirmed branches",
 	})
 
+	parkedTransactionCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_parked_transaction_count",
+		Help: "number of conflicting transactions parked since the node started",
+	})
+	unparkedTransactionCount = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "tangle_unparked_transaction_count",
+		Help: "number of parked transactions released since the node started",
+	})
+
 	registry.MustRegister(messageTips)
+	registry.MustRegister(messageTipAges)
+	registry.MustRegister(evictedTipCount)
 	registry.MustRegister(solidificationRequests)
+	registry.MustRegister(belowMaxDepthMessageCount)
+	registry.MustRegister(reattachmentCount)
+	registry.MustRegister(invalidPayloadCount)
+	registry.MustRegister(sequenceGapCount)
+	registry.MustRegister(sequenceNumberReuseCount)
+	registry.MustRegister(parserRecentlySeenBytes)
 	registry.MustRegister(messagePerTypeCount)
 	registry.MustRegister(parentsCount)
 	registry.MustRegister(initialMessagePerComponentCount)
@@ -184,13 +263,30 @@ func registerTangleMetrics() {
 	registry.MustRegister(confirmedBranchCount)
 	registry.MustRegister(totalBranchCountDB)
 	registry.MustRegister(finalizedBranchCountDB)
+	registry.MustRegister(parkedTransactionCount)
+	registry.MustRegister(unparkedTransactionCount)
 
 	addCollect(collectTangleMetrics)
 }
 
 func collectTangleMetrics() {
 	messageTips.Set(float64(metrics.MessageTips()))
+	for bucket, count := range metrics.MessageTipAges() {
+		messageTipAges.WithLabelValues(bucket.String()).Set(float64(count))
+	}
+	for reason, count := range metrics.EvictedTipCountPerReason() {
+		evictedTipCount.WithLabelValues(reason.String()).Set(float64(count))
+	}
 	solidificationRequests.Set(float64(metrics.SolidificationRequests()))
+	belowMaxDepthMessageCount.Set(float64(metrics.BelowMaxDepthMessageCount()))
+	reattachmentCount.Set(float64(metrics.ReattachmentCount()))
+	for payloadType, count := range metrics.InvalidPayloadCountPerType() {
+		invalidPayloadCount.WithLabelValues(payloadType.String()).Set(float64(count))
+	}
+	sequenceGapCount.Set(float64(metrics.SequenceGapCount()))
+	sequenceNumberReuseCount.Set(float64(metrics.SequenceNumberReuseCount()))
+	parserRecentlySeenBytes.WithLabelValues("accepted").Set(float64(metrics.ParserAcceptedBytesCount()))
+	parserRecentlySeenBytes.WithLabelValues("duplicate").Set(float64(metrics.ParserDuplicateBytesCount()))
 	msgCountPerPayload := metrics.MessageCountSinceStartPerPayload()
 	for payloadType, count := range msgCountPerPayload {
 		messagePerTypeCount.WithLabelValues(payloadType.String()).Set(float64(count))
@@ -229,6 +325,8 @@ func collectTangleMetrics() {
 	branchConfirmationTotalTime.Set(float64(metrics.BranchConfirmationTotalTime()))
 	totalBranchCountDB.Set(float64(metrics.TotalBranchCountDB()))
 	finalizedBranchCountDB.Set(float64(metrics.FinalizedBranchCountDB()))
+	parkedTransactionCount.Set(float64(metrics.ParkedTransactionCount()))
+	unparkedTransactionCount.Set(float64(metrics.UnparkedTransactionCount()))
 
 	finalizedMessageCountPerType := metrics.FinalizedMessageCountPerType()
 	for messageType, count := range finalizedMessageCountPerType {
@@ -4,7 +4,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 )
 
-var workerpools *prometheus.GaugeVec
+var (
+	workerpools             *prometheus.GaugeVec
+	workerpoolsRunningTasks *prometheus.GaugeVec
+	workerpoolsExecuted     *prometheus.GaugeVec
+	workerpoolsDropped      *prometheus.GaugeVec
+)
 
 func registerWorkerpoolMetrics() {
 	workerpools = prometheus.NewGaugeVec(
@@ -17,11 +22,50 @@ func registerWorkerpoolMetrics() {
 		},
 	)
 
+	workerpoolsRunningTasks = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "workerpools_running_tasks",
+			Help: "number of tasks that are currently executed by a shared workerpool",
+		},
+		[]string{
+			"name",
+			"priority",
+		},
+	)
+
+	workerpoolsExecuted = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "workerpools_executed_tasks",
+			Help: "number of tasks that were executed by a shared workerpool",
+		},
+		[]string{
+			"name",
+			"priority",
+		},
+	)
+
+	workerpoolsDropped = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "workerpools_dropped_tasks",
+			Help: "number of tasks that were dropped by a shared workerpool",
+		},
+		[]string{
+			"name",
+			"priority",
+		},
+	)
+
 	registry.MustRegister(workerpools)
+	registry.MustRegister(workerpoolsRunningTasks)
+	registry.MustRegister(workerpoolsExecuted)
+	registry.MustRegister(workerpoolsDropped)
 
 	if deps.GossipMgr != nil {
 		addCollect(collectWorkerpoolMetrics)
 	}
+	if deps.WorkerPools != nil {
+		addCollect(collectSharedWorkerpoolMetrics)
+	}
 }
 
 func collectWorkerpoolMetrics() {
@@ -35,3 +79,12 @@ func collectWorkerpoolMetrics() {
 		name,
 	).Set(float64(load))
 }
+
+func collectSharedWorkerpoolMetrics() {
+	for _, metrics := range deps.WorkerPools.Metrics() {
+		workerpools.WithLabelValues(metrics.Name).Set(float64(metrics.PendingTasks))
+		workerpoolsRunningTasks.WithLabelValues(metrics.Name, metrics.Priority.String()).Set(float64(metrics.RunningTasks))
+		workerpoolsExecuted.WithLabelValues(metrics.Name, metrics.Priority.String()).Set(float64(metrics.ExecutedTasks))
+		workerpoolsDropped.WithLabelValues(metrics.Name, metrics.Priority.String()).Set(float64(metrics.DroppedTasks))
+	}
+}
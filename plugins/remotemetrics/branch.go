@@ -11,7 +11,7 @@ import (
 	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/consensus/gof"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
-	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
@@ -52,26 +52,22 @@ func onBranchConfirmed(branchID ledgerstate.BranchID) {
 		return
 	}
 
-	var nodeID string
-	if deps.Local != nil {
-		nodeID = deps.Local.Identity.ID().String()
-	}
-
-	record := &remotemetrics.BranchConfirmationMetrics{
-		Type:               "branchConfirmation",
-		NodeID:             nodeID,
-		MetricsLevel:       Parameters.MetricsLevel,
+	record := &remotemetricsproto.BranchConfirmation{
 		MessageID:          oldestAttachmentMessageID.Base58(),
 		BranchID:           branchID.Base58(),
-		CreatedTimestamp:   oldestAttachmentTime,
-		ConfirmedTimestamp: clock.SyncedTime(),
+		CreatedTimestamp:   unixNano(oldestAttachmentTime),
+		ConfirmedTimestamp: unixNano(clock.SyncedTime()),
 		DeltaConfirmed:     clock.Since(oldestAttachmentTime).Nanoseconds(),
 	}
 	deps.Tangle.Storage.Message(oldestAttachmentMessageID).Consume(func(message *tangle.Message) {
 		issuerID := identity.NewID(message.IssuerPublicKey())
 		record.IssuerID = issuerID.String()
 	})
-	_ = deps.RemoteLogger.Send(record)
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Branch{Branch: &remotemetricsproto.BranchMetrics{
+			Metric: &remotemetricsproto.BranchMetrics_Confirmation{Confirmation: record},
+		}},
+	})
 	sendBranchMetrics()
 }
 
@@ -80,15 +76,7 @@ func sendBranchMetrics() {
 		return
 	}
 
-	var myID string
-	if deps.Local != nil {
-		myID = deps.Local.Identity.ID().String()
-	}
-
-	record := remotemetrics.BranchCountUpdate{
-		Type:                           "branchCounts",
-		NodeID:                         myID,
-		MetricsLevel:                   Parameters.MetricsLevel,
+	record := &remotemetricsproto.BranchCounts{
 		TotalBranchCount:               branchTotalCountDB.Load() + initialBranchTotalCountDB,
 		InitialTotalBranchCount:        initialBranchTotalCountDB,
 		TotalBranchCountSinceStart:     branchTotalCountDB.Load(),
@@ -99,7 +87,11 @@ func sendBranchMetrics() {
 		InitialFinalizedBranchCount:    initialFinalizedBranchCountDB,
 		FinalizedBranchCountSinceStart: finalizedBranchCountDB.Load(),
 	}
-	_ = deps.RemoteLogger.Send(record)
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Branch{Branch: &remotemetricsproto.BranchMetrics{
+			Metric: &remotemetricsproto.BranchMetrics_Counts{Counts: record},
+		}},
+	})
 }
 
 func updateMetricCounts(branchID ledgerstate.BranchID, transactionID ledgerstate.TransactionID) (time.Time, tangle.MessageID, error) {
@@ -3,7 +3,7 @@ package remotemetrics
 import (
 	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/drng"
-	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
 )
 
 func onRandomnessReceived(state *drng.State) {
@@ -11,21 +11,15 @@ func onRandomnessReceived(state *drng.State) {
 		return
 	}
 
-	var nodeID string
-	if deps.Local != nil {
-		nodeID = deps.Local.ID().String()
-	}
-
-	record := &remotemetrics.DRNGMetrics{
-		Type:              "drng",
-		NodeID:            nodeID,
-		MetricsLevel:      Parameters.MetricsLevel,
+	record := &remotemetricsproto.DRNGMetrics{
 		InstanceID:        state.Committee().InstanceID,
 		Round:             state.Randomness().Round,
-		IssuedTimestamp:   state.Randomness().Timestamp,
-		ReceivedTimestamp: clock.SyncedTime(),
+		IssuedTimestamp:   unixNano(state.Randomness().Timestamp),
+		ReceivedTimestamp: unixNano(clock.SyncedTime()),
 		DeltaReceived:     clock.Since(state.Randomness().Timestamp).Nanoseconds(),
 	}
 
-	_ = deps.RemoteLogger.Send(record)
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Drng{Drng: record},
+	})
 }
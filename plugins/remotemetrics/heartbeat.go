@@ -0,0 +1,21 @@
+package remotemetrics
+
+import (
+	"time"
+
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
+	"github.com/iotaledger/goshimmer/plugins/banner"
+)
+
+func sendHeartbeat() {
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Heartbeat{Heartbeat: &remotemetricsproto.Heartbeat{
+			AppVersion:       banner.AppVersion,
+			Synced:           deps.Tangle.Synced(),
+			Uptime:           time.Since(startTime).Nanoseconds(),
+			Interval:         Parameters.HeartbeatInterval.Nanoseconds(),
+			SentEnvelopes:    deps.RemoteMetrics.SentEnvelopes(),
+			DroppedEnvelopes: deps.RemoteMetrics.DroppedEnvelopes(),
+		}},
+	})
+}
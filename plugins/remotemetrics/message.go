@@ -5,75 +5,70 @@ import (
 
 	"github.com/iotaledger/hive.go/identity"
 
+	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
-	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
 func onMessageScheduled(messageID tangle.MessageID) {
-	sendMessageSchedulerRecord(messageID, "messageScheduled")
-}
+	if !deps.Tangle.Synced() {
+		return
+	}
 
-func onMessageDiscarded(messageID tangle.MessageID) {
-	sendMessageSchedulerRecord(messageID, "messageDiscarded")
+	sendMessageMetrics(&remotemetricsproto.MessageMetrics{Metric: &remotemetricsproto.MessageMetrics_Scheduled{Scheduled: messageSchedulerRecord(messageID)}})
 }
 
-func sendMessageSchedulerRecord(messageID tangle.MessageID, recordType string) {
+func onMessageDiscarded(messageID tangle.MessageID) {
 	if !deps.Tangle.Synced() {
 		return
 	}
 
-	var nodeID string
-	if deps.Local != nil {
-		nodeID = deps.Local.Identity.ID().String()
-	}
+	sendMessageMetrics(&remotemetricsproto.MessageMetrics{Metric: &remotemetricsproto.MessageMetrics_Discarded{Discarded: messageSchedulerRecord(messageID)}})
+}
 
-	record := &remotemetrics.MessageScheduledMetrics{
-		Type:         recordType,
-		NodeID:       nodeID,
-		MetricsLevel: Parameters.MetricsLevel,
-		MessageID:    messageID.Base58(),
+func messageSchedulerRecord(messageID tangle.MessageID) (record *remotemetricsproto.MessageScheduled) {
+	record = &remotemetricsproto.MessageScheduled{
+		MessageID: messageID.Base58(),
 	}
 
+	var issuingTime time.Time
 	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 		issuerID := identity.NewID(message.IssuerPublicKey())
-		record.IssuedTimestamp = message.IssuingTime()
+		issuingTime = message.IssuingTime()
+		record.IssuedTimestamp = unixNano(issuingTime)
 		record.IssuerID = issuerID.String()
 		record.AccessMana = deps.Tangle.Scheduler.GetManaFromCache(issuerID)
-		record.StrongEdgeCount = len(message.ParentsByType(tangle.StrongParentType))
-		if weakParentsCount := len(message.ParentsByType(tangle.WeakParentType)); weakParentsCount > 0 {
-			record.StrongEdgeCount = weakParentsCount
-		}
-		if likeParentsCount := len(message.ParentsByType(tangle.ShallowLikeParentType)); likeParentsCount > 0 {
-			record.StrongEdgeCount = len(message.ParentsByType(tangle.ShallowLikeParentType))
-		}
+		record.StrongEdgeCount = uint32(len(message.ParentsByType(tangle.StrongParentType)))
+		record.WeakEdgeCount = uint32(len(message.ParentsByType(tangle.WeakParentType)))
+		record.LikeEdgeCount = uint32(len(message.ParentsByType(tangle.ShallowLikeParentType)))
 
 		deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
-			record.ReceivedTimestamp = messageMetadata.ReceivedTime()
-			record.ScheduledTimestamp = messageMetadata.ScheduledTime()
-			record.DroppedTimestamp = messageMetadata.DiscardedTime()
-			record.BookedTimestamp = messageMetadata.BookedTime()
+			record.ReceivedTimestamp = unixNano(messageMetadata.ReceivedTime())
+			record.ScheduledTimestamp = unixNano(messageMetadata.ScheduledTime())
+			record.DroppedTimestamp = unixNano(messageMetadata.DiscardedTime())
+			record.BookedTimestamp = unixNano(messageMetadata.BookedTime())
 			// may be overridden by tx data
-			record.SolidTimestamp = messageMetadata.SolidificationTime()
-			record.DeltaSolid = messageMetadata.SolidificationTime().Sub(record.IssuedTimestamp).Nanoseconds()
-			record.QueuedTimestamp = messageMetadata.QueuedTime()
-			record.DeltaBooked = messageMetadata.BookedTime().Sub(record.IssuedTimestamp).Nanoseconds()
-			record.GradeOfFinality = uint8(messageMetadata.GradeOfFinality())
-			record.GradeOfFinalityTimestamp = messageMetadata.GradeOfFinalityTime()
+			record.SolidTimestamp = unixNano(messageMetadata.SolidificationTime())
+			record.DeltaSolid = messageMetadata.SolidificationTime().Sub(issuingTime).Nanoseconds()
+			record.QueuedTimestamp = unixNano(messageMetadata.QueuedTime())
+			record.DeltaBooked = messageMetadata.BookedTime().Sub(issuingTime).Nanoseconds()
+			record.GradeOfFinality = uint32(messageMetadata.GradeOfFinality())
+			record.GradeOfFinalityTimestamp = unixNano(messageMetadata.GradeOfFinalityTime())
 			if !messageMetadata.GradeOfFinalityTime().IsZero() {
-				record.DeltaGradeOfFinalityTime = messageMetadata.GradeOfFinalityTime().Sub(record.IssuedTimestamp).Nanoseconds()
+				record.DeltaGradeOfFinalityTime = messageMetadata.GradeOfFinalityTime().Sub(issuingTime).Nanoseconds()
 			}
 
 			var scheduleDoneTime time.Time
 			// one of those conditions must be true
-			if !record.ScheduledTimestamp.IsZero() {
-				scheduleDoneTime = record.ScheduledTimestamp
-			} else if !record.DroppedTimestamp.IsZero() {
-				scheduleDoneTime = record.DroppedTimestamp
+			if !messageMetadata.ScheduledTime().IsZero() {
+				scheduleDoneTime = messageMetadata.ScheduledTime()
+			} else if !messageMetadata.DiscardedTime().IsZero() {
+				scheduleDoneTime = messageMetadata.DiscardedTime()
 			}
-			record.DeltaScheduledIssued = scheduleDoneTime.Sub(record.IssuedTimestamp).Nanoseconds()
+			record.DeltaScheduledIssued = scheduleDoneTime.Sub(issuingTime).Nanoseconds()
 			record.DeltaScheduledReceived = scheduleDoneTime.Sub(messageMetadata.ReceivedTime()).Nanoseconds()
-			record.DeltaReceivedIssued = messageMetadata.ReceivedTime().Sub(record.IssuedTimestamp).Nanoseconds()
+			record.DeltaReceivedIssued = messageMetadata.ReceivedTime().Sub(issuingTime).Nanoseconds()
 			record.SchedulingTime = scheduleDoneTime.Sub(messageMetadata.QueuedTime()).Nanoseconds()
 		})
 	})
@@ -81,13 +76,13 @@ func sendMessageSchedulerRecord(messageID tangle.MessageID, recordType string) {
 	// override message solidification data if message contains a transaction
 	deps.Tangle.Utils.ComputeIfTransaction(messageID, func(transactionID ledgerstate.TransactionID) {
 		deps.Tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
-			record.SolidTimestamp = transactionMetadata.SolidificationTime()
+			record.SolidTimestamp = unixNano(transactionMetadata.SolidificationTime())
 			record.TransactionID = transactionID.Base58()
-			record.DeltaSolid = transactionMetadata.SolidificationTime().Sub(record.IssuedTimestamp).Nanoseconds()
+			record.DeltaSolid = transactionMetadata.SolidificationTime().Sub(issuingTime).Nanoseconds()
 		})
 	})
 
-	_ = deps.RemoteLogger.Send(record)
+	return record
 }
 
 func onTransactionConfirmed(transactionID ledgerstate.TransactionID) {
@@ -108,79 +103,73 @@ func onMessageFinalized(messageID tangle.MessageID) {
 		return
 	}
 
-	var nodeID string
-	if deps.Local != nil {
-		nodeID = deps.Local.Identity.ID().String()
-	}
-
-	record := &remotemetrics.MessageFinalizedMetrics{
-		Type:         "messageFinalized",
-		NodeID:       nodeID,
-		MetricsLevel: Parameters.MetricsLevel,
-		MessageID:    messageID.Base58(),
+	confirmedTime := clock.SyncedTime()
+	record := &remotemetricsproto.MessageFinalized{
+		MessageID:          messageID.Base58(),
+		ConfirmedTimestamp: unixNano(confirmedTime),
 	}
 
+	var issuingTime time.Time
 	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 		issuerID := identity.NewID(message.IssuerPublicKey())
-		record.IssuedTimestamp = message.IssuingTime()
+		issuingTime = message.IssuingTime()
+		record.IssuedTimestamp = unixNano(issuingTime)
 		record.IssuerID = issuerID.String()
-		record.StrongEdgeCount = len(message.ParentsByType(tangle.StrongParentType))
-		if weakParentsCount := len(message.ParentsByType(tangle.WeakParentType)); weakParentsCount > 0 {
-			record.WeakEdgeCount = weakParentsCount
-		}
-		if shallowLikeParentsCount := len(message.ParentsByType(tangle.ShallowLikeParentType)); shallowLikeParentsCount > 0 {
-			record.ShallowLikeEdgeCount = shallowLikeParentsCount
-		}
-		if shallowDislikeParentsCount := len(message.ParentsByType(tangle.ShallowDislikeParentType)); shallowDislikeParentsCount > 0 {
-			record.ShallowDislikeEdgeCount = shallowDislikeParentsCount
-		}
+		record.DeltaConfirmed = confirmedTime.Sub(issuingTime).Nanoseconds()
+		record.StrongEdgeCount = uint32(len(message.ParentsByType(tangle.StrongParentType)))
+		record.WeakEdgeCount = uint32(len(message.ParentsByType(tangle.WeakParentType)))
+		record.ShallowLikeEdgeCount = uint32(len(message.ParentsByType(tangle.ShallowLikeParentType)))
+		record.ShallowDislikeEdgeCount = uint32(len(message.ParentsByType(tangle.ShallowDislikeParentType)))
 	})
 	deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
-		record.ScheduledTimestamp = messageMetadata.ScheduledTime()
-		record.DeltaScheduled = messageMetadata.ScheduledTime().Sub(record.IssuedTimestamp).Nanoseconds()
-		record.BookedTimestamp = messageMetadata.BookedTime()
-		record.DeltaBooked = messageMetadata.BookedTime().Sub(record.IssuedTimestamp).Nanoseconds()
+		record.ScheduledTimestamp = unixNano(messageMetadata.ScheduledTime())
+		record.DeltaScheduled = messageMetadata.ScheduledTime().Sub(issuingTime).Nanoseconds()
+		record.BookedTimestamp = unixNano(messageMetadata.BookedTime())
+		record.DeltaBooked = messageMetadata.BookedTime().Sub(issuingTime).Nanoseconds()
 	})
 
 	deps.Tangle.Utils.ComputeIfTransaction(messageID, func(transactionID ledgerstate.TransactionID) {
 		deps.Tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
-			record.SolidTimestamp = transactionMetadata.SolidificationTime()
+			record.SolidTimestamp = unixNano(transactionMetadata.SolidificationTime())
 			record.TransactionID = transactionID.Base58()
-			record.DeltaSolid = transactionMetadata.SolidificationTime().Sub(record.IssuedTimestamp).Nanoseconds()
+			record.DeltaSolid = transactionMetadata.SolidificationTime().Sub(issuingTime).Nanoseconds()
 		})
 	})
 
-	_ = deps.RemoteLogger.Send(record)
+	sendMessageMetrics(&remotemetricsproto.MessageMetrics{Metric: &remotemetricsproto.MessageMetrics_Finalized{Finalized: record}})
 }
 
 func onMissingMessageRequest(messageID tangle.MessageID) {
-	sendMissingMessageRecord(messageID, "missingMessage")
-}
+	if !deps.Tangle.Synced() {
+		return
+	}
 
-func onMissingMessageStored(messageID tangle.MessageID) {
-	sendMissingMessageRecord(messageID, "missingMessageStored")
+	sendMessageMetrics(&remotemetricsproto.MessageMetrics{Metric: &remotemetricsproto.MessageMetrics_Missing{Missing: missingMessageRecord(messageID)}})
 }
 
-func sendMissingMessageRecord(messageID tangle.MessageID, recordType string) {
+func onMissingMessageStored(messageID tangle.MessageID) {
 	if !deps.Tangle.Synced() {
 		return
 	}
 
-	var nodeID string
-	if deps.Local != nil {
-		nodeID = deps.Local.Identity.ID().String()
-	}
+	sendMessageMetrics(&remotemetricsproto.MessageMetrics{Metric: &remotemetricsproto.MessageMetrics_MissingStored{MissingStored: missingMessageRecord(messageID)}})
+}
 
-	record := &remotemetrics.MissingMessageMetrics{
-		Type:         recordType,
-		NodeID:       nodeID,
-		MetricsLevel: Parameters.MetricsLevel,
-		MessageID:    messageID.Base58(),
+func missingMessageRecord(messageID tangle.MessageID) (record *remotemetricsproto.MissingMessage) {
+	record = &remotemetricsproto.MissingMessage{
+		MessageID: messageID.Base58(),
 	}
 
 	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 		record.IssuerID = identity.NewID(message.IssuerPublicKey()).String()
 	})
 
-	_ = deps.RemoteLogger.Send(record)
+	return record
+}
+
+// sendMessageMetrics sends the given metrics of a message.
+func sendMessageMetrics(messageMetrics *remotemetricsproto.MessageMetrics) {
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Message{Message: messageMetrics},
+	})
 }
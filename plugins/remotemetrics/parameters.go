@@ -1,6 +1,8 @@
 package remotemetrics
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/configuration"
 )
 
@@ -8,6 +10,10 @@ import (
 type ParametersDefinition struct {
 	// MetricsLevelMetricsLevel used limit the amount of metrics sent to metrics collection service. The higher the value, the less logs is sent
 	MetricsLevel uint8 `default:"1" usage:"Numeric value to limit the amount of metrics sent to metrics collection service. The higher the value, the less logs is sent"`
+	// CollectorAddress defines the address of the collector that receives the metrics.
+	CollectorAddress string `default:"metrics-01.devnet.shimmer.iota.cafe:5214" usage:"the address of the remote metrics collector"`
+	// HeartbeatInterval defines the interval in which heartbeats are sent to the collector.
+	HeartbeatInterval time.Duration `default:"10s" usage:"the interval in which heartbeats are sent to the remote metrics collector"`
 }
 
 // Parameters contains the configuration used by the remotelog plugin.
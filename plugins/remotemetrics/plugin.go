@@ -1,6 +1,6 @@
 // Package remotemetrics is a plugin that enables log metrics too complex for Prometheus, but still interesting in terms of analysis and debugging.
-// It is enabled by default.
-// The destination can be set via logger.remotelog.serverAddress.
+// It is enabled by default, unless the remotelog plugin is disabled.
+// The metrics are sent as protobuf Envelopes to the collector that can be set via remotemetrics.collectorAddress.
 package remotemetrics
 
 import (
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/types"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/plugins/remotelog"
 
@@ -26,6 +27,9 @@ import (
 )
 
 const (
+	// PluginName is the name of the remote metrics plugin.
+	PluginName = "RemoteLogMetrics"
+
 	syncUpdateTime           = 500 * time.Millisecond
 	schedulerQueryUpdateTime = 5 * time.Second
 )
@@ -43,22 +47,35 @@ const (
 
 var (
 	// Plugin is the plugin instance of the remote plugin instance.
-	Plugin *node.Plugin
-	deps   = new(dependencies)
+	Plugin    *node.Plugin
+	deps      = new(dependencies)
+	startTime time.Time
 )
 
 type dependencies struct {
 	dig.In
 
-	Local        *peer.Local
-	Tangle       *tangle.Tangle
-	RemoteLogger *remotelog.RemoteLoggerConn `optional:"true"`
-	DrngInstance *drng.DRNG                  `optional:"true"`
-	ClockPlugin  *node.Plugin                `name:"clock" optional:"true"`
+	Local         *peer.Local
+	Tangle        *tangle.Tangle
+	RemoteMetrics *remotemetrics.Client `optional:"true"`
+	DrngInstance  *drng.DRNG            `optional:"true"`
+	ClockPlugin   *node.Plugin          `name:"clock" optional:"true"`
 }
 
 func init() {
-	Plugin = node.NewPlugin("RemoteLogMetrics", deps, node.Enabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if node.IsSkipped(remotelog.Plugin) {
+			return
+		}
+
+		if err := container.Provide(func(local *peer.Local) *remotemetrics.Client {
+			return remotemetrics.NewClient(Parameters.CollectorAddress, local.ID().String(), Parameters.MetricsLevel)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
 }
 
 func configure(_ *node.Plugin) {
@@ -84,12 +101,23 @@ func run(_ *node.Plugin) {
 	if node.IsSkipped(remotelog.Plugin) {
 		return
 	}
+	startTime = time.Now()
+
+	if err := daemon.BackgroundWorker("Remote Metrics Client", func(ctx context.Context) {
+		deps.RemoteMetrics.Run(ctx)
+	}, shutdown.PriorityRemoteLog); err != nil {
+		Plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+
 	// create a background worker that update the metrics every second
 	if err := daemon.BackgroundWorker("Node State Logger Updater", func(ctx context.Context) {
+		sendHeartbeat()
+
 		// Do not block until the Ticker is shutdown because we might want to start multiple Tickers and we can
 		// safely ignore the last execution when shutting down.
 		timeutil.NewTicker(func() { checkSynced() }, syncUpdateTime, ctx)
 		timeutil.NewTicker(func() { remotemetrics.Events().SchedulerQuery.Trigger(time.Now()) }, schedulerQueryUpdateTime, ctx)
+		timeutil.NewTicker(sendHeartbeat, Parameters.HeartbeatInterval, ctx)
 
 		// Wait before terminating so we get correct log messages from the daemon regarding the shutdown order.
 		<-ctx.Done()
@@ -126,9 +154,9 @@ func configureBranchConfirmationMetrics() {
 	if Parameters.MetricsLevel > Info {
 		return
 	}
-	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(events.NewClosure(onBranchConfirmed))
+	deps.Tangle.ConfirmationOracle.Events().BranchConfirmed.Attach(event.NewClosure(onBranchConfirmed))
 
-	deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(events.NewClosure(func(branchID ledgerstate.BranchID) {
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchCreated.Attach(event.NewClosure(func(branchID ledgerstate.BranchID) {
 		activeBranchesMutex.Lock()
 		defer activeBranchesMutex.Unlock()
 		if _, exists := activeBranches[branchID]; !exists {
@@ -143,9 +171,9 @@ func configureMessageFinalizedMetrics() {
 	if Parameters.MetricsLevel > Info {
 		return
 	} else if Parameters.MetricsLevel == Info {
-		deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(events.NewClosure(onTransactionConfirmed))
+		deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(event.NewClosure(onTransactionConfirmed))
 	} else {
-		deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(events.NewClosure(onMessageFinalized))
+		deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(event.NewClosure(onMessageFinalized))
 	}
 }
 
@@ -153,10 +181,10 @@ func configureMessageScheduledMetrics() {
 	if Parameters.MetricsLevel > Info {
 		return
 	} else if Parameters.MetricsLevel == Info {
-		deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(onMessageDiscarded))
+		deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(onMessageDiscarded))
 	} else {
-		deps.Tangle.Scheduler.Events.MessageScheduled.Attach(events.NewClosure(onMessageScheduled))
-		deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(events.NewClosure(onMessageDiscarded))
+		deps.Tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(onMessageScheduled))
+		deps.Tangle.Scheduler.Events.MessageDiscarded.Attach(event.NewClosure(onMessageDiscarded))
 	}
 }
 
@@ -165,6 +193,15 @@ func configureMissingMessageMetrics() {
 		return
 	}
 
-	deps.Tangle.Solidifier.Events.MessageMissing.Attach(events.NewClosure(onMissingMessageRequest))
-	deps.Tangle.Storage.Events.MissingMessageStored.Attach(events.NewClosure(onMissingMessageStored))
+	deps.Tangle.Solidifier.Events.MessageMissing.Attach(event.NewClosure(onMissingMessageRequest))
+	deps.Tangle.Storage.Events.MissingMessageStored.Attach(event.NewClosure(onMissingMessageStored))
+}
+
+// unixNano returns the given time as unix time in nanoseconds or 0 if the time is not set.
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
 }
@@ -3,32 +3,27 @@ package remotemetrics
 import (
 	"time"
 
-	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
-func obtainSchedulerStats(timestamp time.Time) {
+// obtainSchedulerStats sends a sample of the scheduler. The time of the sample is the timestamp of its Envelope.
+func obtainSchedulerStats(time.Time) {
 	scheduler := deps.Tangle.Scheduler
 	queueMap, aManaNormalizedMap := prepQueueMaps(scheduler)
 
-	var myID string
-	if deps.Local != nil {
-		myID = deps.Local.Identity.ID().String()
-	}
-	record := remotemetrics.SchedulerMetrics{
-		Type:                         "schedulerSample",
-		NodeID:                       myID,
+	record := &remotemetricsproto.SchedulerMetrics{
 		Synced:                       deps.Tangle.Synced(),
-		MetricsLevel:                 Parameters.MetricsLevel,
 		BufferSize:                   uint32(scheduler.BufferSize()),
 		BufferLength:                 uint32(scheduler.TotalMessagesCount()),
 		ReadyMessagesInBuffer:        uint32(scheduler.ReadyMessagesCount()),
 		QueueLengthPerNode:           queueMap,
 		AManaNormalizedLengthPerNode: aManaNormalizedMap,
-		Timestamp:                    timestamp,
 	}
 
-	_ = deps.RemoteLogger.Send(record)
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Scheduler{Scheduler: record},
+	})
 }
 
 func prepQueueMaps(s *tangle.Scheduler) (queueMap map[string]uint32, aManaNormalizedMap map[string]float64) {
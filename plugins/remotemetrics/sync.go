@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/goshimmer/packages/clock"
 	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
 )
 
 var isTangleTimeSynced atomic.Bool
@@ -13,14 +14,7 @@ func checkSynced() {
 	oldTangleTimeSynced := isTangleTimeSynced.Load()
 	tts := deps.Tangle.TimeManager.Synced()
 	if oldTangleTimeSynced != tts {
-		var myID string
-		if deps.Local != nil {
-			myID = deps.Local.ID().String()
-		}
 		syncStatusChangedEvent := remotemetrics.SyncStatusChangedEvent{
-			Type:                     "sync",
-			NodeID:                   myID,
-			MetricsLevel:             Parameters.MetricsLevel,
 			Time:                     clock.SyncedTime(),
 			LastConfirmedMessageTime: deps.Tangle.TimeManager.Time(),
 			CurrentStatus:            tts,
@@ -31,5 +25,11 @@ func checkSynced() {
 }
 
 func sendSyncStatusChangedEvent(syncUpdate remotemetrics.SyncStatusChangedEvent) {
-	_ = deps.RemoteLogger.Send(syncUpdate)
+	deps.RemoteMetrics.Send(&remotemetricsproto.Envelope{
+		Group: &remotemetricsproto.Envelope_Sync{Sync: &remotemetricsproto.SyncMetrics{
+			CurrentStatus:            syncUpdate.CurrentStatus,
+			PreviousStatus:           syncUpdate.PreviousStatus,
+			LastConfirmedMessageTime: unixNano(syncUpdate.LastConfirmedMessageTime),
+		}},
+	})
 }
@@ -7,12 +7,16 @@ import (
 	analysisclient "github.com/iotaledger/goshimmer/plugins/analysis/client"
 	analysisdashboard "github.com/iotaledger/goshimmer/plugins/analysis/dashboard"
 	analysisserver "github.com/iotaledger/goshimmer/plugins/analysis/server"
+	"github.com/iotaledger/goshimmer/plugins/anchor"
 	"github.com/iotaledger/goshimmer/plugins/chat"
+	"github.com/iotaledger/goshimmer/plugins/doublespendalert"
+	"github.com/iotaledger/goshimmer/plugins/fragmentation"
 	"github.com/iotaledger/goshimmer/plugins/networkdelay"
 	"github.com/iotaledger/goshimmer/plugins/prometheus"
 	"github.com/iotaledger/goshimmer/plugins/remotelog"
 	"github.com/iotaledger/goshimmer/plugins/remotemetrics"
 	"github.com/iotaledger/goshimmer/plugins/txstream"
+	"github.com/iotaledger/goshimmer/plugins/valuetracer"
 )
 
 // Research contains research plugins of a GoShimmer node.
@@ -27,4 +31,8 @@ var Research = node.Plugins(
 	txstream.Plugin,
 	activity.Plugin,
 	chat.Plugin,
+	doublespendalert.Plugin,
+	valuetracer.Plugin,
+	fragmentation.Plugin,
+	anchor.Plugin,
 )
@@ -0,0 +1,30 @@
+package resourcemanager
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the resourcemanager plugin.
+type ParametersDefinition struct {
+	// CheckInterval defines the interval in which the resource usage of the node is checked.
+	CheckInterval time.Duration `default:"5s" usage:"the interval in which the resource usage of the node is checked"`
+	// HeapSoftLimit defines the heap usage above which the node starts to shed load.
+	HeapSoftLimit uint64 `default:"3000000000" usage:"the heap usage (in bytes) above which the node starts to shed load (0 disables the limit)"` // 3 GB
+	// HeapHardLimit defines the heap usage above which the node flushes its caches and rejects non-critical API calls.
+	HeapHardLimit uint64 `default:"4000000000" usage:"the heap usage (in bytes) above which the node flushes its caches and rejects non-critical API calls (0 disables the limit)"` // 4 GB
+	// CacheSizeLimit defines the number of cached objects above which the caches are flushed.
+	CacheSizeLimit int `default:"1000000" usage:"the number of cached objects above which the caches are flushed (0 disables the limit)"`
+	// ElevatedInboundMessageRate defines the number of gossiped messages per second that are accepted above the soft limit.
+	ElevatedInboundMessageRate int `default:"500" usage:"the number of gossiped messages per second that are accepted above the heap soft limit (0 disables the limit)"`
+	// CriticalInboundMessageRate defines the number of gossiped messages per second that are accepted above the hard limit.
+	CriticalInboundMessageRate int `default:"100" usage:"the number of gossiped messages per second that are accepted above the heap hard limit (0 disables the limit)"`
+}
+
+// Parameters contains the configuration used by the resourcemanager plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "resourceManager")
+}
@@ -0,0 +1,95 @@
+package resourcemanager
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/timeutil"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/packages/resourcemanager"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the resourcemanager plugin.
+const PluginName = "ResourceManager"
+
+// tangleCacheName is the name under which the caches of the Tangle are registered.
+const tangleCacheName = "Tangle"
+
+var (
+	// Plugin is the plugin instance of the resourcemanager plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	ResourceManager *resourcemanager.Manager
+	Tangle          *tangle.Tangle
+	GossipMgr       *gossip.Manager `optional:"true"`
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newManager); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newManager creates the Manager that guards the memory usage of the node.
+func newManager() *resourcemanager.Manager {
+	return resourcemanager.NewManager(resourcemanager.Params{
+		HeapSoftLimit:  Parameters.HeapSoftLimit,
+		HeapHardLimit:  Parameters.HeapHardLimit,
+		CacheSizeLimit: Parameters.CacheSizeLimit,
+	})
+}
+
+func configure(plugin *node.Plugin) {
+	deps.ResourceManager.RegisterCache(tangleCacheName, deps.Tangle.Storage)
+
+	deps.ResourceManager.Events.LevelChanged.Attach(event.NewClosure(func(event *resourcemanager.LevelChangedEvent) {
+		plugin.LogWarnf("memory pressure changed from %s to %s (heap usage: %d bytes)", event.OldLevel, event.NewLevel, event.HeapUsage)
+
+		if deps.GossipMgr != nil {
+			deps.GossipMgr.SetInboundMessageRateLimit(inboundMessageRate(event.NewLevel))
+		}
+	}))
+	deps.ResourceManager.Events.CachesFlushed.Attach(event.NewClosure(func(event *resourcemanager.CachesFlushedEvent) {
+		plugin.LogWarnf("flushed %d cached objects (heap usage: %d bytes)", event.CacheSize, event.HeapUsage)
+	}))
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		timeutil.NewTicker(func() {
+			deps.ResourceManager.Check()
+		}, Parameters.CheckInterval, ctx).WaitForGracefulShutdown()
+
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityResourceManager); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// inboundMessageRate returns the number of gossiped messages per second that are accepted at the given Level.
+func inboundMessageRate(level resourcemanager.Level) int {
+	switch level {
+	case resourcemanager.LevelElevated:
+		return Parameters.ElevatedInboundMessageRate
+	case resourcemanager.LevelCritical:
+		return Parameters.CriticalInboundMessageRate
+	default:
+		return 0
+	}
+}
@@ -2,6 +2,7 @@ package spammer
 
 import (
 	"context"
+	"time"
 
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/logger"
@@ -9,9 +10,11 @@ import (
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/spammer"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
 )
 
 var messageSpammer *spammer.Spammer
@@ -22,34 +25,60 @@ const PluginName = "Spammer"
 var (
 	// Plugin is the plugin instance of the spammer plugin.
 	Plugin *node.Plugin
-	deps   = new(dependencies)
-	log    *logger.Logger
+	// RuntimePlugin allows to start and stop the spammer plugin while the node is running.
+	RuntimePlugin *runtimeplugin.Plugin
+	deps          = new(dependencies)
+	log           *logger.Logger
 )
 
 type dependencies struct {
 	dig.In
 
 	Tangle *tangle.Tangle
-	Server *echo.Echo
+	Server *echo.Echo `optional:"true"`
 }
 
 func init() {
-	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
+	RuntimePlugin = runtimeplugin.New(PluginName, node.Disabled, start, stop)
 }
 
 func configure(_ *node.Plugin) {
 	log = logger.NewLogger(PluginName)
 
-	messageSpammer = spammer.New(deps.Tangle.IssuePayload, log)
-	deps.Server.GET("spammer", handleRequest)
+	messageSpammer = spammer.New(deps.Tangle.IssuePayload, log, spammer.WithPacing(issuableMessages))
+	if deps.Server != nil {
+		deps.Server.GET("spammer", handleRequest)
+	}
 }
 
 func run(*node.Plugin) {
 	if err := daemon.BackgroundWorker("spammer", func(ctx context.Context) {
+		if err := RuntimePlugin.Boot(); err != nil {
+			log.Errorf("Failed to start: %s", err)
+		}
+
 		<-ctx.Done()
 
-		messageSpammer.Shutdown()
+		RuntimePlugin.Shutdown()
 	}, shutdown.PrioritySpammer); err != nil {
 		log.Panicf("Failed to start as daemon: %s", err)
 	}
 }
+
+// issuableMessages returns the number of messages of the given size that the node can issue during the given interval.
+func issuableMessages(messageSize int, interval time.Duration) (int, error) {
+	_, issuable, err := messagelayer.IssuableMessages(messageSize, interval)
+
+	return issuable, err
+}
+
+// start makes the spammer available via the web API.
+func start() error {
+	return nil
+}
+
+// stop stops spamming messages.
+func stop() {
+	messageSpammer.Shutdown()
+}
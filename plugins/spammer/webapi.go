@@ -4,17 +4,28 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/labstack/echo"
 
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 )
 
-func handleRequest(c echo.Context) error {
+func handleRequest(c echo.Context) (err error) {
 	var request jsonmodels.SpammerRequest
-	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.SpammerResponse{Error: err.Error()})
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
+	// the spammer can not be stopped while the request is handled
+	if !RuntimePlugin.WhileRunning(func() { err = handleCommand(c, request) }) {
+		return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(runtimeplugin.ErrPluginStopped))
+	}
+
+	return err
+}
+
+func handleCommand(c echo.Context, request jsonmodels.SpammerRequest) error {
 	switch request.Cmd {
 	case "start":
 		if request.Rate == 0 {
@@ -40,14 +51,14 @@ func handleRequest(c echo.Context) error {
 		}
 
 		messageSpammer.Shutdown()
-		messageSpammer.Start(request.Rate, timeUnit, request.IMIF)
-		log.Infof("Started spamming messages with %d %s and %s inter-message issuing function", request.Rate, request.Unit, request.IMIF)
+		messageSpammer.Start(request.Rate, timeUnit, request.IMIF, request.Paced)
+		log.Infof("Started spamming messages with %d %s and %s inter-message issuing function (paced: %t)", request.Rate, request.Unit, request.IMIF, request.Paced)
 		return c.JSON(http.StatusOK, jsonmodels.SpammerResponse{Message: "started spamming messages"})
 	case "stop":
 		messageSpammer.Shutdown()
 		log.Info("Stopped spamming messages")
 		return c.JSON(http.StatusOK, jsonmodels.SpammerResponse{Message: "stopped spamming messages"})
 	default:
-		return c.JSON(http.StatusBadRequest, jsonmodels.SpammerResponse{Error: "invalid cmd in request"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("invalid cmd in request")))
 	}
 }
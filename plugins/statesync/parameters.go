@@ -0,0 +1,29 @@
+package statesync
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the state sync plugin.
+type ParametersDefinition struct {
+	// TrustedPeer defines the peer (base58 public key@host:gossipPort) that the state is synchronized from.
+	TrustedPeer string `usage:"the peer (base58 public key@host:gossipPort) to fetch the confirmed state from on first start"`
+	// Commitment defines the base58 encoded epoch commitment that the received state has to match. The state of the epoch
+	// of the commitment is requested, otherwise the state of the latest finalized epoch of the trusted peer is accepted.
+	Commitment string `usage:"the base58 encoded epoch commitment that the state of the trusted peer has to match"`
+	// RecentMessages defines the maximum amount of recent messages that are requested from the trusted peer.
+	RecentMessages int `default:"1000" usage:"the maximum amount of recent messages to request from the trusted peer"`
+	// Timeout defines the time after which a state sync request is aborted.
+	Timeout time.Duration `default:"5m" usage:"the time after which a state sync request is aborted"`
+	// ServeInterval defines the minimum time between two state sync requests of the same peer that are served.
+	ServeInterval time.Duration `default:"10m" usage:"the minimum time between two state sync requests of the same peer that are served"`
+}
+
+// Parameters contains the configuration used by the state sync plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "stateSync")
+}
@@ -0,0 +1,302 @@
+package statesync
+
+import (
+	"context"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/generics/walker"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/network"
+	libp2ppeer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/libp2putil"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	"github.com/iotaledger/goshimmer/packages/statesync"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/config"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+)
+
+// PluginName is the name of the state sync plugin.
+const PluginName = "StateSync"
+
+var (
+	// Plugin is the plugin instance of the state sync plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+
+	// recentMessages contains the messages that were received from the trusted peer and still need to be processed.
+	recentMessages [][]byte
+
+	// lastServed contains the time at which the state was last served to a peer.
+	lastServed      = make(map[libp2ppeer.ID]time.Time)
+	lastServedMutex sync.Mutex
+)
+
+type dependencies struct {
+	dig.In
+
+	Local     *peer.Local
+	Tangle    *tangle.Tangle
+	GossipMgr *gossip.Manager `optional:"true"`
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+}
+
+func configure(plugin *node.Plugin) {
+	if deps.GossipMgr != nil {
+		deps.GossipMgr.Libp2pHost.SetStreamHandler(statesync.ProtocolID, handleStream)
+		deps.GossipMgr.EnableFeature(gossip.FeatureWarpSync)
+	}
+
+	if Parameters.TrustedPeer == "" || messagelayer.Parameters.Snapshot.File == "" {
+		return
+	}
+	if _, err := os.Stat(messagelayer.Parameters.Snapshot.File); !os.IsNotExist(err) {
+		plugin.LogInfof("snapshot file %s exists - skipping state sync", messagelayer.Parameters.Snapshot.File)
+		return
+	}
+
+	if err := syncState(); err != nil {
+		plugin.Panicf("failed to synchronize state from %s: %s", Parameters.TrustedPeer, err)
+	}
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		// the recent messages can only be processed once the snapshot was loaded and the node is running.
+		for _, messageBytes := range recentMessages {
+			deps.Tangle.ProcessGossipMessage(messageBytes, deps.Local.Peer)
+		}
+		if len(recentMessages) > 0 {
+			plugin.LogInfof("processed %d recent messages received from %s", len(recentMessages), Parameters.TrustedPeer)
+		}
+		recentMessages = nil
+
+		<-ctx.Done()
+		if deps.GossipMgr != nil {
+			deps.GossipMgr.Libp2pHost.RemoveStreamHandler(statesync.ProtocolID)
+		}
+	}, shutdown.PriorityStateSync); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// region client ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// syncState fetches the state from the trusted peer and stores the received ledger state as the snapshot file that is
+// loaded by the message layer.
+func syncState() (err error) {
+	var expectedCommitment *statesync.EpochCommitment
+	if Parameters.Commitment != "" {
+		if expectedCommitment, err = statesync.EpochCommitmentFromBase58(Parameters.Commitment); err != nil {
+			return err
+		}
+	} else {
+		Plugin.LogWarn("no epoch commitment configured - the state of the trusted peer is accepted as is")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), Parameters.Timeout)
+	defer cancel()
+
+	Plugin.LogInfof("synchronizing state from %s ...", Parameters.TrustedPeer)
+	state, err := fetchState(ctx, expectedCommitment)
+	if err != nil {
+		return err
+	}
+	Plugin.LogInfof("synchronizing state from %s ... done (%s, %d transactions, %d recent messages)", Parameters.TrustedPeer, state.Commitment, len(state.Snapshot.Transactions), len(state.RecentMessages))
+
+	f, err := os.OpenFile(messagelayer.Parameters.Snapshot.File, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Errorf("failed to create snapshot file: %w", err)
+	}
+	defer f.Close()
+	if _, err = snapshot.Write(f, state.Snapshot, snapshot.DefaultChunkSize, state.NetworkID); err != nil {
+		return errors.Errorf("failed to write snapshot file: %w", err)
+	}
+	recentMessages = state.RecentMessages
+
+	return nil
+}
+
+// fetchState opens a state sync stream to the trusted peer using a temporary libp2p host.
+func fetchState(ctx context.Context, expectedCommitment *statesync.EpochCommitment) (state *statesync.State, err error) {
+	addrInfo, err := trustedPeerAddrInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	host, err := libp2p.New(ctx, libp2p.NoListenAddrs)
+	if err != nil {
+		return nil, errors.Errorf("failed to create libp2p host: %w", err)
+	}
+	defer host.Close()
+
+	if err = host.Connect(ctx, *addrInfo); err != nil {
+		return nil, errors.Errorf("failed to connect to trusted peer: %w", err)
+	}
+	stream, err := host.NewStream(ctx, addrInfo.ID, statesync.ProtocolID)
+	if err != nil {
+		return nil, errors.Errorf("failed to open state sync stream: %w", err)
+	}
+	defer stream.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = stream.SetDeadline(deadline)
+	}
+
+	return statesync.Fetch(stream, Parameters.RecentMessages, expectedCommitment, config.Parameters.NetworkID)
+}
+
+// trustedPeerAddrInfo parses the configured trusted peer.
+func trustedPeerAddrInfo() (addrInfo *libp2ppeer.AddrInfo, err error) {
+	parts := strings.Split(Parameters.TrustedPeer, "@")
+	if len(parts) != 2 {
+		return nil, errors.Errorf("trusted peer %s is not in the format publicKey@host:port", Parameters.TrustedPeer)
+	}
+
+	publicKey, err := ed25519.PublicKeyFromString(parts[0])
+	if err != nil {
+		return nil, errors.Errorf("failed to parse public key of trusted peer: %w", err)
+	}
+	peerID, err := libp2putil.PublicKeyToLibp2pPeerID(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	tcpAddr, err := net.ResolveTCPAddr("tcp", parts[1])
+	if err != nil {
+		return nil, errors.Errorf("failed to resolve address of trusted peer: %w", err)
+	}
+	address, err := manet.FromNetAddr(tcpAddr)
+	if err != nil {
+		return nil, errors.Errorf("failed to convert address of trusted peer: %w", err)
+	}
+
+	return &libp2ppeer.AddrInfo{ID: peerID, Addrs: []multiaddr.Multiaddr{address}}, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region server ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+func handleStream(stream network.Stream) {
+	defer stream.Close()
+
+	remotePeer := stream.Conn().RemotePeer()
+	if !acquireServeSlot(remotePeer) {
+		Plugin.LogWarnf("rejected state sync request of %s: the state was served to it less than %s ago", remotePeer, Parameters.ServeInterval)
+		return
+	}
+
+	Plugin.LogInfof("serving state to %s", remotePeer)
+	if err := statesync.Serve(stream, currentState); err != nil {
+		Plugin.LogWarnf("failed to serve state to %s: %s", remotePeer, err)
+	}
+}
+
+// acquireServeSlot returns true if the state may be served to the given peer, which is only the case if it was not served
+// to the peer within the ServeInterval.
+func acquireServeSlot(peerID libp2ppeer.ID) bool {
+	lastServedMutex.Lock()
+	defer lastServedMutex.Unlock()
+
+	now := time.Now()
+	for servedPeerID, servedTime := range lastServed {
+		if now.Sub(servedTime) >= Parameters.ServeInterval {
+			delete(lastServed, servedPeerID)
+		}
+	}
+	if _, served := lastServed[peerID]; served {
+		return false
+	}
+	lastServed[peerID] = now
+
+	return true
+}
+
+// currentState returns the confirmed ledger state at the end of the given finalized epoch (0 selects the latest finalized
+// epoch), the access mana and the most recent messages of the node.
+func currentState(epochIndex statesync.EpochIndex, maxMessages int) (state *statesync.State, err error) {
+	latestFinalizedEpoch := statesync.LatestFinalizedEpoch(clock.SyncedTime())
+	if epochIndex == 0 {
+		epochIndex = latestFinalizedEpoch
+	}
+	if epochIndex > latestFinalizedEpoch {
+		return nil, errors.Errorf("requested epoch %d is newer than the latest finalized epoch %d: %w", epochIndex, latestFinalizedEpoch, statesync.ErrEpochNotFinalized)
+	}
+
+	ledgerSnapshot := deps.Tangle.LedgerState.ConfirmedSnapshotUTXO(epochIndex.EndTime())
+
+	accessMana, t, err := messagelayer.GetManaMap(mana.AccessMana)
+	if err != nil {
+		return nil, errors.Errorf("failed to retrieve access mana: %w", err)
+	}
+	ledgerSnapshot.AccessManaByNode = make(map[identity.ID]ledgerstate.AccessMana, len(accessMana))
+	for nodeID, value := range accessMana {
+		ledgerSnapshot.AccessManaByNode[nodeID] = ledgerstate.AccessMana{
+			Value:     value,
+			Timestamp: t,
+		}
+	}
+
+	return &statesync.State{
+		Commitment:     statesync.NewEpochCommitment(epochIndex, ledgerSnapshot),
+		Snapshot:       ledgerSnapshot,
+		RecentMessages: collectRecentMessages(maxMessages),
+		NetworkID:      config.Parameters.NetworkID,
+	}, nil
+}
+
+// collectRecentMessages walks the past cone of the current tips and returns the bytes of at most maxMessages messages,
+// ordered from old to new.
+func collectRecentMessages(maxMessages int) (messages [][]byte) {
+	if maxMessages <= 0 {
+		return nil
+	}
+
+	messages = make([][]byte, 0, maxMessages)
+	deps.Tangle.Utils.WalkMessage(func(message *tangle.Message, walker *walker.Walker[tangle.MessageID]) {
+		if len(messages) >= maxMessages {
+			walker.StopWalk()
+			return
+		}
+		messages = append(messages, message.Bytes())
+
+		message.ForEachParentByType(tangle.StrongParentType, func(parentMessageID tangle.MessageID) bool {
+			if parentMessageID != tangle.EmptyMessageID {
+				walker.Push(parentMessageID)
+			}
+			return true
+		})
+	}, deps.Tangle.TipManager.AllTips())
+
+	// the walk started at the tips, so we need to reverse the order
+	for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+		messages[i], messages[j] = messages[j], messages[i]
+	}
+
+	return messages
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
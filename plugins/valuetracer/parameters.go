@@ -0,0 +1,26 @@
+package valuetracer
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the value tracer plugin.
+type ParametersDefinition struct {
+	// Window defines the time span that is covered by the statistics.
+	Window time.Duration `default:"10m" usage:"the time span that is covered by the statistics"`
+	// TraceTimeout defines the time after the submission after which a transaction that was not confirmed is no longer traced.
+	TraceTimeout time.Duration `default:"2m" usage:"the time after the submission after which a transaction that was not confirmed is no longer traced"`
+	// MaxPendingTraces defines the maximum number of transactions that are traced at the same time.
+	MaxPendingTraces int `default:"10000" usage:"the maximum number of transactions that are traced at the same time (0 disables the limit)"`
+	// MaxCompletedTraces defines the maximum number of completed traces that are kept within the window.
+	MaxCompletedTraces int `default:"100000" usage:"the maximum number of completed traces that are kept within the window (0 disables the limit)"`
+}
+
+// Parameters contains the configuration used by the value tracer plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "valueTracer")
+}
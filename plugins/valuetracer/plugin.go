@@ -0,0 +1,116 @@
+package valuetracer
+
+import (
+	"context"
+	"time"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/iotaledger/hive.go/timeutil"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/valuetracer"
+)
+
+// PluginName is the name of the value tracer plugin.
+const PluginName = "ValueTracer"
+
+// pruneInterval defines the interval in which the traces of expired transactions are dropped.
+const pruneInterval = time.Second
+
+var (
+	// Plugin is the plugin instance of the value tracer plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle *tangle.Tangle
+	Server *echo.Echo
+	Tracer *valuetracer.Tracer
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newTracer); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newTracer creates the Tracer that measures the end-to-end latency of value transfers.
+func newTracer() *valuetracer.Tracer {
+	return valuetracer.New(valuetracer.Params{
+		Window:             Parameters.Window,
+		TraceTimeout:       Parameters.TraceTimeout,
+		MaxPendingTraces:   Parameters.MaxPendingTraces,
+		MaxCompletedTraces: Parameters.MaxCompletedTraces,
+	})
+}
+
+func configure(_ *node.Plugin) {
+	deps.Tangle.Booker.Events.MessageBooked.Attach(event.NewClosure(onMessageBooked))
+	deps.Tangle.Scheduler.Events.MessageScheduled.Attach(event.NewClosure(func(messageID tangle.MessageID) {
+		if transactionID, isTransaction := attachedTransactionID(messageID); isTransaction {
+			deps.Tracer.RecordStage(transactionID, valuetracer.StageScheduled, clock.SyncedTime())
+		}
+	}))
+	deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(event.NewClosure(deps.Tracer.TransactionConfirmed))
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		timeutil.NewTicker(deps.Tracer.Prune, pruneInterval, ctx).WaitForGracefulShutdown()
+
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityMetrics); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// onMessageBooked starts tracing the transaction of the given message and records the stages it passed so far.
+// Transactions that are booked while the node is not in sync are not traced, as they would distort the statistics.
+func onMessageBooked(messageID tangle.MessageID) {
+	if !deps.Tangle.Synced() {
+		return
+	}
+
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		transaction, isTransaction := message.Payload().(*ledgerstate.Transaction)
+		if !isTransaction || !deps.Tracer.StartTrace(transaction.ID(), messageID, transaction.Essence().Timestamp()) {
+			return
+		}
+
+		deps.Tracer.RecordStage(transaction.ID(), valuetracer.StageIssued, message.IssuingTime())
+		deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+			deps.Tracer.RecordStage(transaction.ID(), valuetracer.StageReceived, messageMetadata.ReceivedTime())
+			deps.Tracer.RecordStage(transaction.ID(), valuetracer.StageSolid, messageMetadata.SolidificationTime())
+			deps.Tracer.RecordStage(transaction.ID(), valuetracer.StageBooked, messageMetadata.BookedTime())
+		})
+	})
+}
+
+// attachedTransactionID returns the ID of the transaction that is attached in the given message.
+func attachedTransactionID(messageID tangle.MessageID) (transactionID ledgerstate.TransactionID, isTransaction bool) {
+	deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		if transaction, ok := message.Payload().(*ledgerstate.Transaction); ok {
+			transactionID, isTransaction = transaction.ID(), true
+		}
+	})
+
+	return transactionID, isTransaction
+}
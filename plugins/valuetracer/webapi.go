@@ -0,0 +1,44 @@
+package valuetracer
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	// RouteValueTracerStatistics defines the HTTP path for the valuetracer/statistics endpoint.
+	RouteValueTracerStatistics = "valuetracer/statistics"
+
+	// RouteValueTracerTrace defines the HTTP path for the valuetracer/traces/:transactionID endpoint.
+	RouteValueTracerTrace = "valuetracer/traces/:transactionID"
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteValueTracerStatistics, getStatisticsHandler)
+	deps.Server.GET(RouteValueTracerTrace, getTraceHandler)
+}
+
+// getStatisticsHandler returns the aggregated latencies of the value transfers.
+func getStatisticsHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, jsonmodels.NewValueTracerStatisticsResponse(deps.Tracer.Statistics()))
+}
+
+// getTraceHandler returns the trace of a single transaction.
+func getTraceHandler(c echo.Context) error {
+	transactionID, err := ledgerstate.TransactionIDFromBase58(c.Param("transactionID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	trace, exists := deps.Tracer.Trace(transactionID)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("transaction %s is not traced", transactionID.Base58())))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewValueTracerTraceResponse(trace))
+}
@@ -0,0 +1,18 @@
+package watchlist
+
+import (
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the watchList plugin.
+type ParametersDefinition struct {
+	// MaxItems defines the maximum number of items on the watch list.
+	MaxItems int `default:"1000" usage:"the maximum number of watched addresses, outputs and transactions (0 disables the limit)"`
+}
+
+// Parameters contains the configuration used by the watchList plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "watchList")
+}
@@ -0,0 +1,69 @@
+// Package watchlist is a plugin that maintains a persistent list of watched addresses, outputs and transactions and
+// serves the history of the events that happened to them.
+package watchlist
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/watchlist"
+)
+
+// PluginName is the name of the watch list plugin.
+const PluginName = "WatchList"
+
+var (
+	// Plugin is the plugin instance of the watch list plugin.
+	Plugin *node.Plugin
+
+	deps = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server    *echo.Echo
+	WatchList *watchlist.WatchList
+}
+
+type watchListDeps struct {
+	dig.In
+
+	Tangle  *tangle.Tangle
+	Storage kvstore.KVStore
+}
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(func(watchListDeps watchListDeps) *watchlist.WatchList {
+			return watchlist.New(watchListDeps.Tangle, watchListDeps.Storage, Parameters.MaxItems)
+		}); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+func configure(_ *node.Plugin) {
+	deps.WatchList.Setup()
+
+	configureWebAPI()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		<-ctx.Done()
+		deps.WatchList.Shutdown()
+	}, shutdown.PriorityWatchList); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
@@ -0,0 +1,104 @@
+package watchlist
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/watchlist"
+)
+
+const (
+	// RouteWatchList defines the HTTP path for the watch endpoint.
+	RouteWatchList = "watch"
+
+	// RouteWatchItem defines the HTTP path for the watch/:id endpoint.
+	RouteWatchItem = "watch/:id"
+
+	// RouteWatchEvents defines the HTTP path for the watch/:id/events endpoint.
+	RouteWatchEvents = "watch/:id/events"
+)
+
+func configureWebAPI() {
+	deps.Server.GET(RouteWatchList, getWatchItemsHandler)
+	deps.Server.POST(RouteWatchList, addWatchItemHandler)
+	deps.Server.DELETE(RouteWatchItem, removeWatchItemHandler)
+	deps.Server.GET(RouteWatchEvents, getWatchEventsHandler)
+}
+
+// getWatchItemsHandler returns the watched items in the order in which they were added.
+func getWatchItemsHandler(c echo.Context) error {
+	return c.JSON(http.StatusOK, watchItemsResponse())
+}
+
+// addWatchItemHandler adds the address, output or transaction of the request to the watch list.
+func addWatchItemHandler(c echo.Context) error {
+	var request jsonmodels.WatchItemRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid request")))
+	}
+
+	item, err := watchlist.ItemFromBase58(request.ID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	watchedItem, err := deps.WatchList.Add(item)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewWatchItem(watchedItem))
+}
+
+// removeWatchItemHandler removes the requested item and its events from the watch list and returns the remaining items.
+func removeWatchItemHandler(c echo.Context) error {
+	item, err := watchlist.ItemFromBase58(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if !deps.WatchList.Remove(item) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("%s is not watched", c.Param("id"))))
+	}
+
+	return c.JSON(http.StatusOK, watchItemsResponse())
+}
+
+// getWatchEventsHandler returns the history of the requested item.
+func getWatchEventsHandler(c echo.Context) error {
+	item, err := watchlist.ItemFromBase58(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	watchedItem, exists := deps.WatchList.Item(item)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("%s is not watched", c.Param("id"))))
+	}
+	events, _ := deps.WatchList.Events(item)
+
+	response := &jsonmodels.WatchEventsResponse{
+		Item:   jsonmodels.NewWatchItem(watchedItem),
+		Events: make([]*jsonmodels.WatchEvent, 0, len(events)),
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, jsonmodels.NewWatchEvent(event))
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// watchItemsResponse returns the JSON model of the watched items.
+func watchItemsResponse() (response *jsonmodels.WatchItemsResponse) {
+	items := deps.WatchList.Items()
+
+	response = &jsonmodels.WatchItemsResponse{Items: make([]*jsonmodels.WatchItem, 0, len(items))}
+	for _, item := range items {
+		response.Items = append(response.Items, jsonmodels.NewWatchItem(item))
+	}
+
+	return response
+}
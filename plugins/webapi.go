@@ -4,8 +4,11 @@ import (
 	"github.com/iotaledger/hive.go/node"
 
 	"github.com/iotaledger/goshimmer/plugins/webapi"
+	"github.com/iotaledger/goshimmer/plugins/webapi/admin"
 	"github.com/iotaledger/goshimmer/plugins/webapi/autopeering"
+	"github.com/iotaledger/goshimmer/plugins/webapi/blacklist"
 	"github.com/iotaledger/goshimmer/plugins/webapi/data"
+	"github.com/iotaledger/goshimmer/plugins/webapi/debug"
 	"github.com/iotaledger/goshimmer/plugins/webapi/drng"
 	"github.com/iotaledger/goshimmer/plugins/webapi/faucet"
 	"github.com/iotaledger/goshimmer/plugins/webapi/healthz"
@@ -14,7 +17,6 @@ import (
 	"github.com/iotaledger/goshimmer/plugins/webapi/mana"
 	"github.com/iotaledger/goshimmer/plugins/webapi/message"
 	"github.com/iotaledger/goshimmer/plugins/webapi/snapshot"
-	drngTools "github.com/iotaledger/goshimmer/plugins/webapi/tools/drng"
 	msgTools "github.com/iotaledger/goshimmer/plugins/webapi/tools/message"
 	"github.com/iotaledger/goshimmer/plugins/webapi/weightprovider"
 )
@@ -29,10 +31,12 @@ var WebAPI = node.Plugins(
 	message.Plugin,
 	autopeering.Plugin,
 	info.Plugin,
-	drngTools.Plugin,
 	msgTools.Plugin,
+	debug.Plugin,
 	mana.Plugin,
 	ledgerstate.Plugin,
 	snapshot.Plugin,
 	weightprovider.Plugin,
+	blacklist.Plugin,
+	admin.Plugin,
 )
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// errAutopeeringDisabled is returned if the neighbor sizes are requested while the autopeering is disabled.
+var errAutopeeringDisabled = errors.New("autopeering is disabled")
+
+// getNeighborSizesHandler returns the number of inbound and outbound neighbors of the autopeering.
+func getNeighborSizesHandler(c echo.Context) error {
+	if deps.Selection == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NeighborSizesResponse{Error: errAutopeeringDisabled.Error()})
+	}
+
+	inboundNeighborSize, outboundNeighborSize := deps.Selection.NeighborSizes()
+
+	return c.JSON(http.StatusOK, jsonmodels.NeighborSizesResponse{
+		InboundNeighborSize:  inboundNeighborSize,
+		OutboundNeighborSize: outboundNeighborSize,
+	})
+}
+
+// setNeighborSizesHandler changes the number of inbound and outbound neighbors of the autopeering. The salts are renewed,
+// so that the neighborhood is rebalanced with the new sizes.
+func setNeighborSizesHandler(c echo.Context) error {
+	if deps.Selection == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NeighborSizesResponse{Error: errAutopeeringDisabled.Error()})
+	}
+
+	var request jsonmodels.NeighborSizesRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NeighborSizesResponse{Error: err.Error()})
+	}
+
+	if err := deps.Selection.SetNeighborSizes(request.InboundNeighborSize, request.OutboundNeighborSize); err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, neighborselection.ErrInvalidNeighborSize) {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, jsonmodels.NeighborSizesResponse{Error: err.Error()})
+	}
+	if deps.Diagnostics != nil {
+		deps.Diagnostics.SetNeighborSizes(request.InboundNeighborSize, request.OutboundNeighborSize)
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NeighborSizesResponse{
+		InboundNeighborSize:  request.InboundNeighborSize,
+		OutboundNeighborSize: request.OutboundNeighborSize,
+	})
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/otv"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+)
+
+// errBranchPreferenceOverridesDisabled is returned if the branch preference endpoints are called while they are disabled.
+var errBranchPreferenceOverridesDisabled = errors.New("branch preference overrides are disabled (set webAPI.admin.branchPreferenceOverrides to enable them)")
+
+// getBranchPreferencesHandler returns all overridden preferences of branches.
+func getBranchPreferencesHandler(c echo.Context) error {
+	preferences := messagelayer.PreferenceOverrides().All()
+
+	resp := jsonmodels.BranchPreferencesResponse{BranchPreferences: make([]*jsonmodels.BranchPreference, 0, len(preferences))}
+	for branchID, preference := range preferences {
+		resp.BranchPreferences = append(resp.BranchPreferences, jsonmodels.NewBranchPreference(branchID, preference, deps.Tangle.OTVConsensusManager.BranchLiked(branchID)))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// setBranchPreferenceHandler overrides the preference of the requested branch.
+func setBranchPreferenceHandler(c echo.Context) error {
+	branchID, err := branchIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BranchPreferenceResponse{Error: err.Error()})
+	}
+
+	var request jsonmodels.BranchPreferenceRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BranchPreferenceResponse{Error: err.Error()})
+	}
+	preference, err := otv.PreferenceFromString(request.Preference)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BranchPreferenceResponse{Error: err.Error()})
+	}
+
+	if !deps.Tangle.LedgerState.BranchDAG.Branch(branchID).Consume(func(*ledgerstate.Branch) {}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.BranchPreferenceResponse{Error: errors.Errorf("failed to load %s", branchID).Error()})
+	}
+
+	messagelayer.PreferenceOverrides().Set(branchID, preference)
+
+	return c.JSON(http.StatusOK, jsonmodels.BranchPreferenceResponse{
+		BranchPreference: jsonmodels.NewBranchPreference(branchID, preference, deps.Tangle.OTVConsensusManager.BranchLiked(branchID)),
+	})
+}
+
+// removeBranchPreferenceHandler removes the override of the preference of the requested branch, so that its approval
+// weight decides again whether it is liked.
+func removeBranchPreferenceHandler(c echo.Context) error {
+	branchID, err := branchIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.BranchPreferenceResponse{Error: err.Error()})
+	}
+
+	if !messagelayer.PreferenceOverrides().Remove(branchID) {
+		return c.JSON(http.StatusNotFound, jsonmodels.BranchPreferenceResponse{Error: errors.Errorf("the preference of %s is not overridden", branchID).Error()})
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.BranchPreferenceResponse{
+		BranchPreference: jsonmodels.NewBranchPreference(branchID, 0, deps.Tangle.OTVConsensusManager.BranchLiked(branchID)),
+	})
+}
+
+// branchIDFromContext parses the BranchID of a branch that can be overridden from the branchID parameter.
+func branchIDFromContext(c echo.Context) (branchID ledgerstate.BranchID, err error) {
+	if branchID, err = ledgerstate.BranchIDFromBase58(c.Param("branchID")); err != nil {
+		return ledgerstate.UndefinedBranchID, err
+	}
+	if branchID == ledgerstate.MasterBranchID {
+		return ledgerstate.UndefinedBranchID, errors.New("the preference of the MasterBranch can not be overridden")
+	}
+
+	return branchID, nil
+}
+
+// branchPreferenceOverrides only serves the given handler if the branch preference overrides are enabled.
+func branchPreferenceOverrides(handler echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !Parameters.BranchPreferenceOverrides {
+			return c.JSON(http.StatusForbidden, jsonmodels.NewErrorResponse(errBranchPreferenceOverridesDisabled))
+		}
+
+		return handler(c)
+	}
+}
@@ -0,0 +1,20 @@
+package admin
+
+import (
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the admin endpoints.
+type ParametersDefinition struct {
+	// SchedulerControl defines whether the scheduler can be paused, stepped and inspected via the admin endpoints.
+	SchedulerControl bool `default:"false" usage:"whether the scheduler can be paused, stepped and inspected (only meant for test networks)"`
+	// BranchPreferenceOverrides defines whether the preferences of branches can be overridden via the admin endpoints.
+	BranchPreferenceOverrides bool `default:"false" usage:"whether the preferences of branches can be overridden (only meant for test networks)"`
+}
+
+// Parameters contains the configuration used by the admin endpoints.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "webAPI.admin")
+}
@@ -0,0 +1,91 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/autopeering/diagnostics"
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var (
+	// Plugin is the plugin instance of the web API admin endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server      *echo.Echo
+	Tangle      *tangle.Tangle
+	Selection   *neighborselection.Protocol `optional:"true"`
+	Diagnostics *diagnostics.Tracker        `optional:"true"`
+}
+
+func init() {
+	Plugin = node.NewPlugin("WebAPIAdminEndpoint", deps, node.Disabled, configure)
+}
+
+func configure(_ *node.Plugin) {
+	deps.Server.GET("admin/plugins", getPluginsHandler)
+	deps.Server.POST("admin/plugins/:plugin/start", startPluginHandler)
+	deps.Server.POST("admin/plugins/:plugin/stop", stopPluginHandler)
+	deps.Server.GET("admin/scheduler/buffer", schedulerControl(getSchedulerBufferHandler))
+	deps.Server.POST("admin/scheduler/pause", schedulerControl(pauseSchedulerHandler))
+	deps.Server.POST("admin/scheduler/resume", schedulerControl(resumeSchedulerHandler))
+	deps.Server.POST("admin/scheduler/step", schedulerControl(stepSchedulerHandler))
+	deps.Server.GET("admin/branches/preferences", branchPreferenceOverrides(getBranchPreferencesHandler))
+	deps.Server.POST("admin/branches/:branchID/preference", branchPreferenceOverrides(setBranchPreferenceHandler))
+	deps.Server.DELETE("admin/branches/:branchID/preference", branchPreferenceOverrides(removeBranchPreferenceHandler))
+	deps.Server.GET("admin/autopeering/neighborsizes", getNeighborSizesHandler)
+	deps.Server.POST("admin/autopeering/neighborsizes", setNeighborSizesHandler)
+}
+
+// getPluginsHandler returns the plugins that can be started and stopped while the node is running.
+func getPluginsHandler(c echo.Context) error {
+	plugins := runtimeplugin.Plugins()
+
+	resp := jsonmodels.RuntimePluginsResponse{Plugins: make([]*jsonmodels.RuntimePlugin, 0, len(plugins))}
+	for _, plugin := range plugins {
+		resp.Plugins = append(resp.Plugins, jsonmodels.NewRuntimePlugin(plugin))
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// startPluginHandler starts the requested plugin.
+func startPluginHandler(c echo.Context) error {
+	return changePluginState(c, (*runtimeplugin.Plugin).Start)
+}
+
+// stopPluginHandler stops the requested plugin and releases its resources.
+func stopPluginHandler(c echo.Context) error {
+	return changePluginState(c, (*runtimeplugin.Plugin).Stop)
+}
+
+// changePluginState applies the given state change to the requested plugin.
+func changePluginState(c echo.Context, changeState func(plugin *runtimeplugin.Plugin) error) error {
+	plugin, exists := runtimeplugin.Get(c.Param("plugin"))
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.RuntimePluginResponse{Error: errors.Errorf("plugin %s can not be started or stopped at runtime", c.Param("plugin")).Error()})
+	}
+
+	if err := changeState(plugin); err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, runtimeplugin.ErrPluginRunning) || errors.Is(err, runtimeplugin.ErrPluginStopped) || errors.Is(err, runtimeplugin.ErrPluginShutdown) {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, jsonmodels.RuntimePluginResponse{Plugin: jsonmodels.NewRuntimePlugin(plugin), Error: err.Error()})
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.RuntimePluginResponse{Plugin: jsonmodels.NewRuntimePlugin(plugin)})
+}
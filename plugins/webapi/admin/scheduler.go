@@ -0,0 +1,74 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// errSchedulerControlDisabled is returned if the scheduler endpoints are called while they are disabled.
+var errSchedulerControlDisabled = errors.New("scheduler control is disabled (set webAPI.admin.schedulerControl to enable it)")
+
+// pauseSchedulerHandler stops the scheduling of messages.
+func pauseSchedulerHandler(c echo.Context) error {
+	deps.Tangle.Scheduler.Pause()
+
+	return c.JSON(http.StatusOK, jsonmodels.NewSchedulerStateResponse(deps.Tangle.Scheduler))
+}
+
+// resumeSchedulerHandler continues the scheduling of messages.
+func resumeSchedulerHandler(c echo.Context) error {
+	deps.Tangle.Scheduler.Resume()
+
+	return c.JSON(http.StatusOK, jsonmodels.NewSchedulerStateResponse(deps.Tangle.Scheduler))
+}
+
+// stepSchedulerHandler schedules the next message while the scheduler is paused.
+func stepSchedulerHandler(c echo.Context) error {
+	messageID, scheduled, err := deps.Tangle.Scheduler.Step()
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		if errors.Is(err, tangle.ErrNotPaused) {
+			statusCode = http.StatusBadRequest
+		}
+
+		return c.JSON(statusCode, jsonmodels.SchedulerStepResponse{Error: err.Error()})
+	}
+	if !scheduled {
+		return c.JSON(http.StatusOK, jsonmodels.SchedulerStepResponse{})
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.SchedulerStepResponse{Scheduled: true, MessageID: messageID.Base58()})
+}
+
+// getSchedulerBufferHandler returns the contents of the buffer of the scheduler.
+func getSchedulerBufferHandler(c echo.Context) error {
+	snapshot := deps.Tangle.Scheduler.BufferSnapshot()
+
+	resp := jsonmodels.SchedulerBufferResponse{
+		Paused:        deps.Tangle.Scheduler.Paused(),
+		MaxBufferSize: deps.Tangle.Scheduler.MaxBufferSize(),
+		BufferSize:    deps.Tangle.Scheduler.BufferSize(),
+		NodeQueues:    make([]*jsonmodels.SchedulerNodeQueue, len(snapshot)),
+	}
+	for i, nodeQueueSnapshot := range snapshot {
+		resp.NodeQueues[i] = jsonmodels.NewSchedulerNodeQueue(nodeQueueSnapshot)
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// schedulerControl only serves the given handler if the scheduler control is enabled.
+func schedulerControl(handler echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !Parameters.SchedulerControl {
+			return c.JSON(http.StatusForbidden, jsonmodels.NewErrorResponse(errSchedulerControlDisabled))
+		}
+
+		return handler(c)
+	}
+}
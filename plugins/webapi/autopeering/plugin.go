@@ -1,18 +1,22 @@
 package autopeering
 
 import (
+	"encoding/hex"
 	"net"
 	"net/http"
 	"strconv"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/autopeering/discover"
 	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/autopeering/peer/service"
-	"github.com/iotaledger/hive.go/autopeering/selection"
+	"github.com/iotaledger/hive.go/autopeering/salt"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/autopeering/diagnostics"
+	"github.com/iotaledger/goshimmer/packages/autopeering/neighborselection"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 )
 
@@ -28,9 +32,10 @@ var (
 type dependencies struct {
 	dig.In
 
-	Server    *echo.Echo
-	Selection *selection.Protocol `optional:"true"`
-	Discover  *discover.Protocol  `optional:"true"`
+	Server      *echo.Echo
+	Selection   *neighborselection.Protocol `optional:"true"`
+	Discover    *discover.Protocol          `optional:"true"`
+	Diagnostics *diagnostics.Tracker        `optional:"true"`
 }
 
 func init() {
@@ -39,6 +44,7 @@ func init() {
 
 func configure(_ *node.Plugin) {
 	deps.Server.GET("autopeering/neighbors", getNeighbors)
+	deps.Server.GET("autopeering/diagnostics", getDiagnostics)
 }
 
 // getNeighbors returns the chosen and accepted neighbors of the node
@@ -67,6 +73,69 @@ func getNeighbors(c echo.Context) error {
 	return c.JSON(http.StatusOK, jsonmodels.GetNeighborsResponse{KnownPeers: knownPeers, Chosen: chosen, Accepted: accepted})
 }
 
+// getDiagnostics returns the salts, the candidates with their distances, the neighbors and the recently dropped
+// neighbors of the neighbor selection.
+func getDiagnostics(c echo.Context) error {
+	if deps.Diagnostics == nil {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("autopeering is disabled")))
+	}
+
+	response := jsonmodels.GetAutopeeringDiagnosticsResponse{
+		InboundNeighborSize:  deps.Diagnostics.InboundNeighborSize(),
+		OutboundNeighborSize: deps.Diagnostics.OutboundNeighborSize(),
+		PublicSalt:           createSalt(deps.Diagnostics.PublicSalt()),
+		PrivateSalt:          createSalt(deps.Diagnostics.PrivateSalt()),
+		OutboundCandidates:   createCandidates(deps.Diagnostics.OutboundCandidates()),
+		InboundCandidates:    createCandidates(deps.Diagnostics.InboundCandidates()),
+		Neighbors:            make([]jsonmodels.AutopeeringNeighbor, 0),
+		RecentDrops:          make([]jsonmodels.AutopeeringNeighborDrop, 0),
+	}
+	for _, neighbor := range deps.Diagnostics.Neighbors() {
+		response.Neighbors = append(response.Neighbors, jsonmodels.AutopeeringNeighbor{
+			ID:        neighbor.Peer.ID().String(),
+			Direction: string(neighbor.Direction),
+			Distance:  neighbor.Distance,
+			Since:     neighbor.Since.Unix(),
+		})
+	}
+	for _, drop := range deps.Diagnostics.RecentDrops() {
+		response.RecentDrops = append(response.RecentDrops, jsonmodels.AutopeeringNeighborDrop{
+			ID:        drop.PeerID.String(),
+			Direction: string(drop.Direction),
+			Distance:  drop.Distance,
+			Duration:  drop.Duration.Milliseconds(),
+			Reason:    string(drop.Reason),
+			Time:      drop.Time.Unix(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+func createSalt(s *salt.Salt) *jsonmodels.Salt {
+	if s == nil {
+		return nil
+	}
+
+	return &jsonmodels.Salt{
+		Bytes:      hex.EncodeToString(s.GetBytes()),
+		Expiration: s.GetExpiration().Unix(),
+	}
+}
+
+func createCandidates(candidates []*diagnostics.Candidate) []jsonmodels.PeeringCandidate {
+	result := make([]jsonmodels.PeeringCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		result = append(result, jsonmodels.PeeringCandidate{
+			ID:       candidate.Peer.ID().String(),
+			Distance: candidate.Distance,
+			Neighbor: candidate.Neighbor,
+		})
+	}
+
+	return result
+}
+
 func createNeighborFromPeer(p *peer.Peer) jsonmodels.Neighbor {
 	n := jsonmodels.Neighbor{
 		ID:        p.ID().String(),
@@ -0,0 +1,90 @@
+package blacklist
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+var (
+	// Plugin is the plugin instance of the web API blacklist endpoint plugin.
+	Plugin *node.Plugin
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Server *echo.Echo
+	Tangle *tangle.Tangle
+}
+
+func init() {
+	Plugin = node.NewPlugin("WebAPIBlacklistEndpoint", deps, node.Enabled, configure)
+}
+
+func configure(_ *node.Plugin) {
+	deps.Server.GET("blacklist", getBlacklistHandler)
+	deps.Server.POST("blacklist", addToBlacklistHandler)
+	deps.Server.DELETE("blacklist/:issuerID", removeFromBlacklistHandler)
+}
+
+// getBlacklistHandler returns the currently blacklisted issuers.
+func getBlacklistHandler(c echo.Context) error {
+	entries := deps.Tangle.Blacklist.Entries()
+
+	resp := jsonmodels.BlacklistResponse{Entries: make([]*jsonmodels.BlacklistEntry, 0, len(entries))}
+	for _, entry := range entries {
+		resp.Entries = append(resp.Entries, &jsonmodels.BlacklistEntry{
+			IssuerID: entry.IssuerID.EncodeBase58(),
+			Reason:   entry.Reason.String(),
+			Until:    entry.Until.Unix(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, resp)
+}
+
+// addToBlacklistHandler blacklists the issuer given in the request for the requested duration.
+func addToBlacklistHandler(c echo.Context) error {
+	var request jsonmodels.BlacklistRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	issuerID, err := identity.DecodeIDBase58(request.IssuerID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid issuer id")))
+	}
+
+	duration, err := time.ParseDuration(request.Duration)
+	if err != nil || duration <= 0 {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid duration '%s'", request.Duration)))
+	}
+
+	deps.Tangle.Blacklist.Add(issuerID, tangle.ViolationReasonManual, duration)
+
+	return getBlacklistHandler(c)
+}
+
+// removeFromBlacklistHandler removes the given issuer from the blacklist.
+func removeFromBlacklistHandler(c echo.Context) error {
+	issuerID, err := identity.DecodeIDBase58(c.Param("issuerID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid issuer id")))
+	}
+
+	if !deps.Tangle.Blacklist.Remove(issuerID) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("issuer %s is not blacklisted", issuerID)))
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
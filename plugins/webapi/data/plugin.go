@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
@@ -49,11 +50,11 @@ func broadcastData(c echo.Context) error {
 	var request jsonmodels.DataRequest
 	if err := c.Bind(&request); err != nil {
 		log.Info(err.Error())
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	if len(request.Data) == 0 {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: "no data provided"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("no data provided")))
 	}
 
 	issueData := func() (*tangle.Message, error) {
@@ -63,7 +64,7 @@ func broadcastData(c echo.Context) error {
 	// await MessageScheduled event to be triggered.
 	msg, err := messagelayer.AwaitMessageToBeIssued(issueData, deps.Tangle.Options.Identity.PublicKey(), maxIssuedAwaitTime)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, jsonmodels.DataResponse{Error: err.Error()})
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
 	}
 
 	return c.JSON(http.StatusOK, jsonmodels.DataResponse{ID: msg.ID().Base58()})
@@ -0,0 +1,103 @@
+package debug
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region BranchesHandler //////////////////////////////////////////////////////////////////////////////////////////////
+
+// BranchesHandler streams the table of all conflict branches that match the filter of the request.
+func BranchesHandler(c echo.Context) (err error) {
+	filter, err := branchFilterFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	table, err := newTableWriter(c, BranchesTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	deps.Tangle.LedgerState.BranchDAG.ForEachBranch(func(branch *ledgerstate.Branch) {
+		if err != nil || branch.ID() == ledgerstate.MasterBranchID {
+			return
+		}
+
+		if info := branchInfo(branch.ID()); filter.Matches(info) {
+			err = table.Write(info.toCSVRow())
+		}
+	})
+
+	return table.Close(err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region BranchInfo ///////////////////////////////////////////////////////////////////////////////////////////////////
+
+// BranchesTableDescription holds the description of the columns of the branch table.
+var BranchesTableDescription = []string{
+	"ID",
+	"ConflictSet",
+	"IssuanceTime",
+	"SolidTime",
+	"LazyBooked",
+	"GradeOfFinality",
+}
+
+// BranchInfo holds the information of a branch.
+type BranchInfo struct {
+	ID                string
+	ConflictSet       []string
+	IssuanceTimestamp time.Time
+	SolidTime         time.Time
+	LazyBooked        bool
+	GradeOfFinality   gof.GradeOfFinality
+}
+
+func branchInfo(branchID ledgerstate.BranchID) BranchInfo {
+	info := BranchInfo{
+		ID: branchID.Base58(),
+	}
+
+	deps.Tangle.LedgerState.BranchDAG.Branch(branchID).Consume(func(branch *ledgerstate.Branch) {
+		info.GradeOfFinality, _ = deps.Tangle.LedgerState.UTXODAG.BranchGradeOfFinality(branch.ID())
+
+		transactionID := ledgerstate.TransactionID(branchID)
+
+		info.ConflictSet = deps.Tangle.LedgerState.ConflictSet(transactionID).Base58s()
+
+		deps.Tangle.LedgerState.Transaction(transactionID).Consume(func(transaction *ledgerstate.Transaction) {
+			info.IssuanceTimestamp = transaction.Essence().Timestamp()
+		})
+
+		deps.Tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+			info.SolidTime = transactionMetadata.SolidificationTime()
+			info.LazyBooked = transactionMetadata.LazyBooked()
+		})
+	})
+
+	return info
+}
+
+func (b BranchInfo) toCSVRow() (row []string) {
+	return []string{
+		b.ID,
+		strings.Join(b.ConflictSet, ";"),
+		fmt.Sprint(b.IssuanceTimestamp.UnixNano()),
+		fmt.Sprint(b.SolidTime.UnixNano()),
+		fmt.Sprint(b.LazyBooked),
+		fmt.Sprint(b.GradeOfFinality),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
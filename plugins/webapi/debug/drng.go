@@ -0,0 +1,142 @@
+package debug
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/iotaledger/hive.go/marshalutil"
+	"github.com/labstack/echo"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/drng"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region DRNGHandler //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// DRNGHandler streams the table of all dRNG messages in the storage that match the filter of the request.
+func DRNGHandler(c echo.Context) (err error) {
+	filter, err := messageFilterFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	table, err := newTableWriter(c, DRNGTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	deps.Tangle.Storage.ForEachMessageMetadata(func(messageMetadata *tangle.MessageMetadata) bool {
+		if !filter.matchesMetadata(messageMetadata) {
+			return true
+		}
+
+		deps.Tangle.Storage.Message(messageMetadata.ID()).Consume(func(message *tangle.Message) {
+			if message.Payload().Type() != drng.PayloadType || !filter.MatchesMessage(message) {
+				return
+			}
+
+			if info := drngMessageInfo(message, messageMetadata); info != nil {
+				err = table.Write(info.toCSVRow())
+			}
+		})
+
+		return err == nil
+	})
+
+	return table.Close(err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region DRNGMessageInfo //////////////////////////////////////////////////////////////////////////////////////////////
+
+// DRNGTableDescription holds the description of the columns of the dRNG message table.
+var DRNGTableDescription = []string{
+	"ID",
+	"IssuerID",
+	"IssuerPublicKey",
+	"IssuanceTime",
+	"ArrivalTime",
+	"SolidTime",
+	"ScheduledTime",
+	"BookedTime",
+	"dRNGPayloadType",
+	"InstanceID",
+	"Round",
+	"PreviousSignature",
+	"Signature",
+	"DistributedPK",
+}
+
+// DRNGMessageInfo holds the information of a dRNG message.
+type DRNGMessageInfo struct {
+	ID                string
+	IssuerID          string
+	IssuerPublicKey   string
+	IssuanceTimestamp time.Time
+	ArrivalTime       time.Time
+	SolidTime         time.Time
+	ScheduledTime     time.Time
+	BookedTime        time.Time
+	PayloadType       string
+	InstanceID        uint32
+	Round             uint64
+	PreviousSignature string
+	Signature         string
+	DistributedPK     string
+}
+
+func drngMessageInfo(message *tangle.Message, messageMetadata *tangle.MessageMetadata) *DRNGMessageInfo {
+	msgInfo := &DRNGMessageInfo{
+		ID:                message.ID().Base58(),
+		IssuanceTimestamp: message.IssuingTime(),
+		IssuerID:          identity.NewID(message.IssuerPublicKey()).String(),
+		IssuerPublicKey:   message.IssuerPublicKey().String(),
+		ArrivalTime:       messageMetadata.ReceivedTime(),
+		SolidTime:         messageMetadata.SolidificationTime(),
+		ScheduledTime:     messageMetadata.ScheduledTime(),
+		BookedTime:        messageMetadata.BookedTime(),
+	}
+	drngPayload := message.Payload().(*drng.Payload)
+
+	// parse as CollectiveBeaconType
+	marshalUtil := marshalutil.New(drngPayload.Bytes())
+	collectiveBeacon, err := drng.CollectiveBeaconPayloadFromMarshalUtil(marshalUtil)
+	if err != nil {
+		return nil
+	}
+
+	msgInfo.PayloadType = collectiveBeacon.Type().String()
+	msgInfo.InstanceID = collectiveBeacon.InstanceID
+	msgInfo.Round = collectiveBeacon.Round
+	msgInfo.PreviousSignature = base58.Encode(collectiveBeacon.PrevSignature)
+	msgInfo.Signature = base58.Encode(collectiveBeacon.Signature)
+	msgInfo.DistributedPK = base58.Encode(collectiveBeacon.Dpk)
+
+	return msgInfo
+}
+
+func (d *DRNGMessageInfo) toCSVRow() (row []string) {
+	return []string{
+		d.ID,
+		d.IssuerID,
+		d.IssuerPublicKey,
+		fmt.Sprint(d.IssuanceTimestamp.UnixNano()),
+		fmt.Sprint(d.ArrivalTime.UnixNano()),
+		fmt.Sprint(d.SolidTime.UnixNano()),
+		fmt.Sprint(d.ScheduledTime.UnixNano()),
+		fmt.Sprint(d.BookedTime.UnixNano()),
+		d.PayloadType,
+		fmt.Sprint(d.InstanceID),
+		fmt.Sprint(d.Round),
+		d.PreviousSignature,
+		d.Signature,
+		d.DistributedPK,
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,218 @@
+package debug
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+// region messageFilter ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// messageFilter restricts a table to the messages that match the query parameters of a request.
+type messageFilter struct {
+	minRank     *uint64
+	maxRank     *uint64
+	issuer      *identity.ID
+	from        time.Time
+	to          time.Time
+	payloadType *payload.Type
+	solid       *bool
+	booked      *bool
+	orphaned    *bool
+	invalid     *bool
+}
+
+// messageFilterFromContext parses the messageFilter from the query parameters of an echo.Context.
+func messageFilterFromContext(c echo.Context) (filter *messageFilter, err error) {
+	filter = new(messageFilter)
+
+	if filter.minRank, err = uintQueryParam(c, "minRank"); err != nil {
+		return nil, err
+	}
+	if filter.maxRank, err = uintQueryParam(c, "maxRank"); err != nil {
+		return nil, err
+	}
+	if issuer := c.QueryParam("issuer"); issuer != "" {
+		issuerID, decodeErr := identity.DecodeIDBase58(issuer)
+		if decodeErr != nil {
+			return nil, errors.Errorf("invalid issuer %q: %w", issuer, decodeErr)
+		}
+		filter.issuer = &issuerID
+	}
+	if filter.from, err = timeQueryParam(c, "from"); err != nil {
+		return nil, err
+	}
+	if filter.to, err = timeQueryParam(c, "to"); err != nil {
+		return nil, err
+	}
+	payloadType, err := uintQueryParam(c, "payloadType")
+	if err != nil {
+		return nil, err
+	}
+	if payloadType != nil {
+		filter.payloadType = new(payload.Type)
+		*filter.payloadType = payload.Type(*payloadType)
+	}
+	if filter.solid, err = boolQueryParam(c, "solid"); err != nil {
+		return nil, err
+	}
+	if filter.booked, err = boolQueryParam(c, "booked"); err != nil {
+		return nil, err
+	}
+	if filter.orphaned, err = boolQueryParam(c, "orphaned"); err != nil {
+		return nil, err
+	}
+	if filter.invalid, err = boolQueryParam(c, "invalid"); err != nil {
+		return nil, err
+	}
+
+	return filter, nil
+}
+
+// Matches checks whether the Message with the given MessageMetadata matches the filter. The Message itself is only
+// loaded if the filter restricts its content.
+func (m *messageFilter) Matches(messageMetadata *tangle.MessageMetadata) (matches bool) {
+	if !m.matchesMetadata(messageMetadata) {
+		return false
+	}
+
+	if m.issuer == nil && m.from.IsZero() && m.to.IsZero() && m.payloadType == nil {
+		return true
+	}
+
+	deps.Tangle.Storage.Message(messageMetadata.ID()).Consume(func(message *tangle.Message) {
+		matches = m.MatchesMessage(message)
+	})
+
+	return matches
+}
+
+// MatchesMessage checks whether the content of the given Message matches the filter.
+func (m *messageFilter) MatchesMessage(message *tangle.Message) bool {
+	if m.issuer != nil && identity.NewID(message.IssuerPublicKey()) != *m.issuer {
+		return false
+	}
+	if !m.from.IsZero() && message.IssuingTime().Before(m.from) {
+		return false
+	}
+	if !m.to.IsZero() && message.IssuingTime().After(m.to) {
+		return false
+	}
+
+	return m.payloadType == nil || message.Payload().Type() == *m.payloadType
+}
+
+// matchesMetadata checks whether the given MessageMetadata matches the filter.
+func (m *messageFilter) matchesMetadata(messageMetadata *tangle.MessageMetadata) bool {
+	if m.minRank != nil || m.maxRank != nil {
+		var rank uint64
+		if structureDetails := messageMetadata.StructureDetails(); structureDetails != nil {
+			rank = structureDetails.Rank
+		}
+
+		if m.minRank != nil && rank < *m.minRank || m.maxRank != nil && rank > *m.maxRank {
+			return false
+		}
+	}
+
+	return matchesFlag(m.solid, messageMetadata.IsSolid()) &&
+		matchesFlag(m.booked, messageMetadata.IsBooked()) &&
+		matchesFlag(m.orphaned, messageMetadata.IsOrphaned()) &&
+		matchesFlag(m.invalid, messageMetadata.IsObjectivelyInvalid())
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region branchFilter /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// branchFilter restricts a table to the branches that match the query parameters of a request.
+type branchFilter struct {
+	lazyBooked         *bool
+	maxGradeOfFinality *gof.GradeOfFinality
+}
+
+// branchFilterFromContext parses the branchFilter from the query parameters of an echo.Context.
+func branchFilterFromContext(c echo.Context) (filter *branchFilter, err error) {
+	filter = new(branchFilter)
+
+	if filter.lazyBooked, err = boolQueryParam(c, "lazyBooked"); err != nil {
+		return nil, err
+	}
+	maxGradeOfFinality, err := uintQueryParam(c, "maxGradeOfFinality")
+	if err != nil {
+		return nil, err
+	}
+	if maxGradeOfFinality != nil {
+		filter.maxGradeOfFinality = new(gof.GradeOfFinality)
+		*filter.maxGradeOfFinality = gof.GradeOfFinality(*maxGradeOfFinality)
+	}
+
+	return filter, nil
+}
+
+// Matches checks whether the branch with the given BranchInfo matches the filter.
+func (b *branchFilter) Matches(branchInfo BranchInfo) bool {
+	if b.maxGradeOfFinality != nil && branchInfo.GradeOfFinality > *b.maxGradeOfFinality {
+		return false
+	}
+
+	return matchesFlag(b.lazyBooked, branchInfo.LazyBooked)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region utility functions ////////////////////////////////////////////////////////////////////////////////////////////
+
+// matchesFlag checks whether the given flag matches the expected value (nil matches every value).
+func matchesFlag(expected *bool, flag bool) bool {
+	return expected == nil || *expected == flag
+}
+
+// uintQueryParam parses the optional unsigned integer query parameter with the given name.
+func uintQueryParam(c echo.Context, name string) (value *uint64, err error) {
+	param := c.QueryParam(name)
+	if param == "" {
+		return nil, nil
+	}
+
+	parsedValue, err := strconv.ParseUint(param, 10, 64)
+	if err != nil {
+		return nil, errors.Errorf("invalid %s %q: %w", name, param, err)
+	}
+
+	return &parsedValue, nil
+}
+
+// boolQueryParam parses the optional boolean query parameter with the given name.
+func boolQueryParam(c echo.Context, name string) (value *bool, err error) {
+	param := c.QueryParam(name)
+	if param == "" {
+		return nil, nil
+	}
+
+	parsedValue, err := strconv.ParseBool(param)
+	if err != nil {
+		return nil, errors.Errorf("invalid %s %q: %w", name, param, err)
+	}
+
+	return &parsedValue, nil
+}
+
+// timeQueryParam parses the optional query parameter with the given name as a unix timestamp in seconds.
+func timeQueryParam(c echo.Context, name string) (value time.Time, err error) {
+	seconds, err := uintQueryParam(c, name)
+	if err != nil || seconds == nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(int64(*seconds), 0), nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,92 @@
+package debug
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/markers"
+)
+
+// region MarkersHandler ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// MarkersHandler streams the table of all marker sequences in the storage.
+func MarkersHandler(c echo.Context) (err error) {
+	table, err := newTableWriter(c, MarkersTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	deps.Tangle.Booker.MarkersManager.ForEachSequence(func(sequence *markers.Sequence) bool {
+		err = table.Write(sequenceInfo(sequence).toCSVRow())
+
+		return err == nil
+	})
+
+	return table.Close(err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SequenceInfo /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MarkersTableDescription holds the description of the columns of the marker sequence table.
+var MarkersTableDescription = []string{
+	"ID",
+	"LowestIndex",
+	"HighestIndex",
+	"ReferencedMarkers",
+	"LowestMarkerMessageID",
+	"HighestMarkerMessageID",
+	"HighestMarkerBranchIDs",
+}
+
+// SequenceInfo holds the information of a marker sequence.
+type SequenceInfo struct {
+	ID                     markers.SequenceID
+	LowestIndex            markers.Index
+	HighestIndex           markers.Index
+	ReferencedMarkers      string
+	LowestMarkerMessageID  string
+	HighestMarkerMessageID string
+	HighestMarkerBranchIDs []string
+}
+
+func sequenceInfo(sequence *markers.Sequence) SequenceInfo {
+	info := SequenceInfo{
+		ID:           sequence.ID(),
+		LowestIndex:  sequence.LowestIndex(),
+		HighestIndex: sequence.HighestIndex(),
+	}
+
+	if referencedMarkers := sequence.ReferencedMarkers(info.LowestIndex); referencedMarkers != nil {
+		info.ReferencedMarkers = referencedMarkers.SequenceToString()
+	}
+
+	lowestMarker := markers.NewMarker(info.ID, info.LowestIndex)
+	highestMarker := markers.NewMarker(info.ID, info.HighestIndex)
+	info.LowestMarkerMessageID = deps.Tangle.Booker.MarkersManager.MessageID(lowestMarker).Base58()
+	info.HighestMarkerMessageID = deps.Tangle.Booker.MarkersManager.MessageID(highestMarker).Base58()
+	if branchIDs, err := deps.Tangle.Booker.MarkersManager.PendingBranchIDs(highestMarker); err == nil {
+		info.HighestMarkerBranchIDs = branchIDs.Base58()
+	}
+
+	return info
+}
+
+func (s SequenceInfo) toCSVRow() (row []string) {
+	return []string{
+		fmt.Sprint(uint64(s.ID)),
+		fmt.Sprint(uint64(s.LowestIndex)),
+		fmt.Sprint(uint64(s.HighestIndex)),
+		s.ReferencedMarkers,
+		s.LowestMarkerMessageID,
+		s.HighestMarkerMessageID,
+		strings.Join(s.HighestMarkerBranchIDs, ";"),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,14 +1,11 @@
-package message
+package debug
 
 import (
-	"encoding/csv"
 	"fmt"
 	"net/http"
-	"strconv"
 	"strings"
 	"time"
 
-	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/generics/walker"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/labstack/echo"
@@ -19,99 +16,59 @@ import (
 	"github.com/iotaledger/goshimmer/packages/tangle"
 )
 
-// DiagnosticMessagesHandler runs the diagnostic over the Tangle.
-func DiagnosticMessagesHandler(c echo.Context) (err error) {
-	return runDiagnosticMessages(c)
-}
-
-// DiagnosticMessagesOnlyFirstWeakReferencesHandler runs the diagnostic over the Tangle.
-func DiagnosticMessagesOnlyFirstWeakReferencesHandler(c echo.Context) (err error) {
-	return runDiagnosticMessagesOnFirstWeakReferences(c)
-}
+// region MessagesHandler //////////////////////////////////////////////////////////////////////////////////////////////
 
-// DiagnosticMessagesRankHandler runs the diagnostic over the Tangle
-// for messages with rank >= of the given rank parameter.
-func DiagnosticMessagesRankHandler(c echo.Context) (err error) {
-	rank, err := rankFromContext(c)
+// MessagesHandler streams the table of all messages in the storage that match the filter of the request.
+func MessagesHandler(c echo.Context) (err error) {
+	filter, err := messageFilterFromContext(c)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
-	return runDiagnosticMessages(c, rank)
-}
-
-// region DiagnosticMessages code implementation /////////////////////////////////////////////////////////////////////////////////
-
-func runDiagnosticMessages(c echo.Context, rank ...uint64) (err error) {
-	// write Header and table description
-	c.Response().Header().Set(echo.HeaderContentType, "text/csv")
-	c.Response().WriteHeader(http.StatusOK)
-
-	csvWriter := csv.NewWriter(c.Response())
-	if err := csvWriter.Write(DiagnosticMessagesTableDescription); err != nil {
-		return errors.Errorf("failed to write table description row: %w", err)
-	}
-
-	startRank := uint64(0)
 
-	if len(rank) > 0 {
-		startRank = rank[0]
+	table, err := newTableWriter(c, MessagesTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
-	var writeErr error
-	deps.Tangle.Utils.WalkMessageID(func(messageID tangle.MessageID, walker *walker.Walker[tangle.MessageID]) {
-		messageInfo := getDiagnosticMessageInfo(messageID)
 
-		if messageInfo.Rank >= startRank {
-			if err := csvWriter.Write(messageInfo.toCSVRow()); err != nil {
-				writeErr = errors.Errorf("failed to write message diagnostic info row: %w", err)
-				return
-			}
+	deps.Tangle.Storage.ForEachMessageMetadata(func(messageMetadata *tangle.MessageMetadata) bool {
+		if !filter.Matches(messageMetadata) {
+			return true
 		}
 
-		deps.Tangle.Storage.Approvers(messageID).Consume(func(approver *tangle.Approver) {
-			walker.Push(approver.ApproverMessageID())
-		})
-	}, tangle.NewMessageIDs(tangle.EmptyMessageID))
-	if writeErr != nil {
-		return writeErr
-	}
-	csvWriter.Flush()
-	if err := csvWriter.Error(); err != nil {
-		return errors.Errorf("csv writer failed after flush: %w", err)
-	}
+		err = table.Write(messageInfo(messageMetadata.ID()).toCSVRow())
 
-	return nil
-}
+		return err == nil
+	})
 
-func runDiagnosticMessagesOnFirstWeakReferences(c echo.Context) (err error) {
-	// write Header and table description
-	c.Response().Header().Set(echo.HeaderContentType, "text/csv")
-	c.Response().WriteHeader(http.StatusOK)
+	return table.Close(err)
+}
 
-	csvWriter := csv.NewWriter(c.Response())
-	if err := csvWriter.Write(DiagnosticMessagesTableDescription); err != nil {
-		return errors.Errorf("failed to write table description row: %w", err)
+// FirstWeakMessageReferencesHandler streams the table of the first message with weak approvers that is found by
+// walking the Tangle from the genesis, followed by its parents.
+func FirstWeakMessageReferencesHandler(c echo.Context) (err error) {
+	table, err := newTableWriter(c, MessagesTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
-	var writeErr error
+
 	deps.Tangle.Utils.WalkMessageID(func(messageID tangle.MessageID, walker *walker.Walker[tangle.MessageID]) {
-		messageInfo := getDiagnosticMessageInfo(messageID)
+		info := messageInfo(messageID)
+
+		if len(info.WeakApprovers) > 0 {
+			walker.StopWalk()
 
-		if len(messageInfo.WeakApprovers) > 0 {
-			if err := csvWriter.Write(messageInfo.toCSVRow()); err != nil {
-				writeErr = errors.Errorf("failed to write message diagnostic info row: %w", err)
+			if err = table.Write(info.toCSVRow()); err != nil {
 				return
 			}
 
 			deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
 				message.ForEachParent(func(parent tangle.Parent) {
-					parentMessageInfo := getDiagnosticMessageInfo(parent.ID)
-					if err := csvWriter.Write(parentMessageInfo.toCSVRow()); err != nil {
-						writeErr = errors.Errorf("failed to write parent message diagnostic info row: %w", err)
-						return
+					if err == nil {
+						err = table.Write(messageInfo(parent.ID).toCSVRow())
 					}
 				})
 			})
 
-			walker.StopWalk()
 			return
 		}
 
@@ -121,20 +78,43 @@ func runDiagnosticMessagesOnFirstWeakReferences(c echo.Context) (err error) {
 			}
 		})
 	}, tangle.NewMessageIDs(tangle.EmptyMessageID))
-	if writeErr != nil {
-		return writeErr
+
+	return table.Close(err)
+}
+
+// TipsHandler streams the table of all tips that match the filter of the request.
+func TipsHandler(c echo.Context) (err error) {
+	filter, err := messageFilterFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	csvWriter.Flush()
-	if err := csvWriter.Error(); err != nil {
-		return errors.Errorf("csv writer failed after flush: %w", err)
+	table, err := newTableWriter(c, MessagesTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	return nil
+	for tipID := range deps.Tangle.TipManager.AllTips() {
+		deps.Tangle.Storage.MessageMetadata(tipID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+			if filter.Matches(messageMetadata) {
+				err = table.Write(messageInfo(tipID).toCSVRow())
+			}
+		})
+
+		if err != nil {
+			break
+		}
+	}
+
+	return table.Close(err)
 }
 
-// DiagnosticMessagesTableDescription holds the description of the diagnostic messages.
-var DiagnosticMessagesTableDescription = []string{
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region MessageInfo //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// MessagesTableDescription holds the description of the columns of the message tables.
+var MessagesTableDescription = []string{
 	"ID",
 	"IssuerID",
 	"IssuerPublicKey",
@@ -169,8 +149,8 @@ var DiagnosticMessagesTableDescription = []string{
 	"TransactionID",
 }
 
-// DiagnosticMessagesInfo holds the information of a message.
-type DiagnosticMessagesInfo struct {
+// MessageInfo holds the information of a message.
+type MessageInfo struct {
 	ID                      string
 	IssuerID                string
 	IssuerPublicKey         string
@@ -190,8 +170,6 @@ type DiagnosticMessagesInfo struct {
 	ShallowLikeApprovers    []string
 	ShallowDislikeApprovers []string
 	BranchIDs               []string
-	AddedBranchIDs          []string
-	SubtractedBranchIDs     []string
 	Scheduled               bool
 	Booked                  bool
 	ObjectivelyInvalid      bool
@@ -207,8 +185,8 @@ type DiagnosticMessagesInfo struct {
 	TransactionID           string
 }
 
-func getDiagnosticMessageInfo(messageID tangle.MessageID) *DiagnosticMessagesInfo {
-	msgInfo := &DiagnosticMessagesInfo{
+func messageInfo(messageID tangle.MessageID) *MessageInfo {
+	msgInfo := &MessageInfo{
 		ID: messageID.Base58(),
 	}
 
@@ -259,50 +237,43 @@ func getDiagnosticMessageInfo(messageID tangle.MessageID) *DiagnosticMessagesInf
 	return msgInfo
 }
 
-func (d *DiagnosticMessagesInfo) toCSVRow() (row []string) {
+func (m *MessageInfo) toCSVRow() (row []string) {
 	row = []string{
-		d.ID,
-		d.IssuerID,
-		d.IssuerPublicKey,
-		fmt.Sprint(d.IssuanceTimestamp.UnixNano()),
-		fmt.Sprint(d.ArrivalTime.UnixNano()),
-		fmt.Sprint(d.SolidTime.UnixNano()),
-		fmt.Sprint(d.ScheduledTime.UnixNano()),
-		fmt.Sprint(d.BookedTime.UnixNano()),
-		fmt.Sprint(d.GradeOfFinality.String()),
-		fmt.Sprint(d.GradeOfFinalityTime.UnixNano()),
-		strings.Join(d.StrongParents, ";"),
-		strings.Join(d.WeakParents, ";"),
-		strings.Join(d.ShallowDislikeParents, ";"),
-		strings.Join(d.ShallowLikeParents, ";"),
-		strings.Join(d.StrongApprovers, ";"),
-		strings.Join(d.WeakApprovers, ";"),
-		strings.Join(d.ShallowLikeApprovers, ";"),
-		strings.Join(d.ShallowDislikeParents, ";"),
-		strings.Join(d.BranchIDs, ";"),
-		fmt.Sprint(d.Scheduled),
-		fmt.Sprint(d.Booked),
-		fmt.Sprint(d.ObjectivelyInvalid),
-		fmt.Sprint(d.Rank),
-		fmt.Sprint(d.IsPastMarker),
-		d.PastMarkers,
-		fmt.Sprint(d.PMHI),
-		fmt.Sprint(d.PMLI),
-		d.FutureMarkers,
-		fmt.Sprint(d.FMHI),
-		fmt.Sprint(d.FMLI),
-		d.PayloadType,
-		d.TransactionID,
+		m.ID,
+		m.IssuerID,
+		m.IssuerPublicKey,
+		fmt.Sprint(m.IssuanceTimestamp.UnixNano()),
+		fmt.Sprint(m.ArrivalTime.UnixNano()),
+		fmt.Sprint(m.SolidTime.UnixNano()),
+		fmt.Sprint(m.ScheduledTime.UnixNano()),
+		fmt.Sprint(m.BookedTime.UnixNano()),
+		fmt.Sprint(m.GradeOfFinality.String()),
+		fmt.Sprint(m.GradeOfFinalityTime.UnixNano()),
+		strings.Join(m.StrongParents, ";"),
+		strings.Join(m.WeakParents, ";"),
+		strings.Join(m.ShallowDislikeParents, ";"),
+		strings.Join(m.ShallowLikeParents, ";"),
+		strings.Join(m.StrongApprovers, ";"),
+		strings.Join(m.WeakApprovers, ";"),
+		strings.Join(m.ShallowLikeApprovers, ";"),
+		strings.Join(m.ShallowDislikeApprovers, ";"),
+		strings.Join(m.BranchIDs, ";"),
+		fmt.Sprint(m.Scheduled),
+		fmt.Sprint(m.Booked),
+		fmt.Sprint(m.ObjectivelyInvalid),
+		fmt.Sprint(m.Rank),
+		fmt.Sprint(m.IsPastMarker),
+		m.PastMarkers,
+		fmt.Sprint(m.PMHI),
+		fmt.Sprint(m.PMLI),
+		m.FutureMarkers,
+		fmt.Sprint(m.FMHI),
+		fmt.Sprint(m.FMLI),
+		m.PayloadType,
+		m.TransactionID,
 	}
 
 	return row
 }
 
-// rankFromContext determines the marker rank from the rank parameter in an echo.Context.
-func rankFromContext(c echo.Context) (rank uint64, err error) {
-	rank, err = strconv.ParseUint(c.Param("rank"), 10, 64)
-
-	return rank, err
-}
-
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
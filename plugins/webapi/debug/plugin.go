@@ -0,0 +1,56 @@
+package debug
+
+import (
+	"github.com/iotaledger/hive.go/node"
+	"github.com/labstack/echo"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// PluginName is the name of the web API debug endpoint plugin.
+const PluginName = "WebAPIDebugEndpoint"
+
+var (
+	// Plugin is the plugin instance of the web API debug endpoint plugin.
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure)
+	deps   = new(dependencies)
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle *tangle.Tangle
+	Server *echo.Echo
+}
+
+const (
+	routeDebug = "debug"
+	// RouteDebugMessages is the API route for the table of all messages.
+	RouteDebugMessages = routeDebug + "/messages"
+	// RouteDebugFirstWeakMessageReferences is the API route for the table of the first weakly referenced messages.
+	RouteDebugFirstWeakMessageReferences = RouteDebugMessages + "/firstweakreferences"
+	// RouteDebugTips is the API route for the table of all tips.
+	RouteDebugTips = routeDebug + "/tips"
+	// RouteDebugUTXODAG is the API route for the table of all transactions.
+	RouteDebugUTXODAG = routeDebug + "/utxodag"
+	// RouteDebugBranches is the API route for the table of all branches.
+	RouteDebugBranches = routeDebug + "/branches"
+	// RouteDebugMarkers is the API route for the table of all marker sequences.
+	RouteDebugMarkers = routeDebug + "/markers"
+	// RouteDebugSequences is the API route for the table of the sequence number statistics of all issuers.
+	RouteDebugSequences = routeDebug + "/sequences"
+	// RouteDebugDRNG is the API route for the table of all dRNG messages.
+	RouteDebugDRNG = routeDebug + "/drng"
+)
+
+func configure(_ *node.Plugin) {
+	deps.Server.GET(RouteDebugMessages, MessagesHandler)
+	deps.Server.GET(RouteDebugFirstWeakMessageReferences, FirstWeakMessageReferencesHandler)
+	deps.Server.GET(RouteDebugTips, TipsHandler)
+	deps.Server.GET(RouteDebugUTXODAG, UTXODAGHandler)
+	deps.Server.GET(RouteDebugBranches, BranchesHandler)
+	deps.Server.GET(RouteDebugMarkers, MarkersHandler)
+	deps.Server.GET(RouteDebugSequences, SequencesHandler)
+	deps.Server.GET(RouteDebugDRNG, DRNGHandler)
+}
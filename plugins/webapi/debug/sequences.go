@@ -0,0 +1,62 @@
+package debug
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// region SequencesHandler /////////////////////////////////////////////////////////////////////////////////////////////
+
+// SequencesHandler streams the table of the sequence number statistics of all issuers.
+func SequencesHandler(c echo.Context) (err error) {
+	table, err := newTableWriter(c, SequencesTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	for _, status := range deps.Tangle.SequenceTracker.Statuses() {
+		if err = table.Write(sequenceStatusToCSVRow(status)); err != nil {
+			break
+		}
+	}
+
+	return table.Close(err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SequenceStatus ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// SequencesTableDescription holds the description of the columns of the sequence number table.
+var SequencesTableDescription = []string{
+	"IssuerID",
+	"HighestSequenceNumber",
+	"Messages",
+	"Gaps",
+	"MissingSequenceNumbers",
+	"OutstandingMissing",
+	"Reuses",
+	"OutOfOrder",
+	"OutsideOfWindow",
+}
+
+func sequenceStatusToCSVRow(status *tangle.SequenceStatus) []string {
+	return []string{
+		status.IssuerID.String(),
+		fmt.Sprint(status.HighestSequenceNumber),
+		fmt.Sprint(status.Messages),
+		fmt.Sprint(status.Gaps),
+		fmt.Sprint(status.MissingSequenceNumbers),
+		fmt.Sprint(status.OutstandingMissing),
+		fmt.Sprint(status.Reuses),
+		fmt.Sprint(status.OutOfOrder),
+		fmt.Sprint(status.OutsideOfWindow),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,188 @@
+package debug
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+)
+
+const (
+	// formatCSV is the format that encodes the rows of a table as comma separated values.
+	formatCSV = "csv"
+
+	// formatNDJSON is the format that encodes every row of a table as a JSON object on a separate line.
+	formatNDJSON = "ndjson"
+
+	// contentTypeNDJSON is the content type of newline delimited JSON.
+	contentTypeNDJSON = "application/x-ndjson"
+
+	// flushInterval defines after how many rows the buffered rows are sent to the client.
+	flushInterval = 100
+)
+
+// errLimitReached is returned by the tableWriter when the requested number of rows was written.
+var errLimitReached = errors.New("row limit reached")
+
+// region tableWriter //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// tableWriter streams the rows of a table to the client while they are collected, so that the node never has to hold a
+// complete table in memory.
+type tableWriter struct {
+	context     context.Context
+	response    *echo.Response
+	format      string
+	limit       int
+	writtenRows int
+	csvWriter   *csv.Writer
+	jsonWriter  *bufio.Writer
+	jsonKeys    [][]byte
+}
+
+// newTableWriter parses the format and limit query parameters of the request and writes the header of the table with
+// the given columns.
+func newTableWriter(c echo.Context, columns []string) (table *tableWriter, err error) {
+	table = &tableWriter{
+		context:  c.Request().Context(),
+		response: c.Response(),
+		format:   formatCSV,
+	}
+
+	if format := c.QueryParam("format"); format != "" {
+		table.format = format
+	}
+	if limit := c.QueryParam("limit"); limit != "" {
+		if table.limit, err = strconv.Atoi(limit); err != nil || table.limit < 0 {
+			return nil, errors.Errorf("invalid limit %q", limit)
+		}
+	}
+
+	switch table.format {
+	case formatCSV:
+		table.response.Header().Set(echo.HeaderContentType, "text/csv")
+		table.response.WriteHeader(http.StatusOK)
+
+		table.csvWriter = csv.NewWriter(table.response)
+		if err = table.csvWriter.Write(columns); err != nil {
+			return nil, errors.Errorf("failed to write table description row: %w", err)
+		}
+	case formatNDJSON:
+		table.jsonKeys = make([][]byte, len(columns))
+		for i, column := range columns {
+			if table.jsonKeys[i], err = json.Marshal(column); err != nil {
+				return nil, errors.Errorf("failed to encode column %s: %w", column, err)
+			}
+		}
+
+		table.response.Header().Set(echo.HeaderContentType, contentTypeNDJSON)
+		table.response.WriteHeader(http.StatusOK)
+
+		table.jsonWriter = bufio.NewWriter(table.response)
+	default:
+		return nil, errors.Errorf("unsupported format %q", table.format)
+	}
+
+	return table, nil
+}
+
+// Write writes a row to the table. It returns an error if the client went away or the requested number of rows was
+// written, so that the caller can stop collecting rows.
+func (t *tableWriter) Write(row []string) (err error) {
+	if err = t.context.Err(); err != nil {
+		return err
+	}
+	if t.limit > 0 && t.writtenRows >= t.limit {
+		return errLimitReached
+	}
+
+	switch t.format {
+	case formatCSV:
+		err = t.csvWriter.Write(row)
+	case formatNDJSON:
+		err = t.writeJSON(row)
+	}
+	if err != nil {
+		return errors.Errorf("failed to write row: %w", err)
+	}
+
+	if t.writtenRows++; t.writtenRows%flushInterval == 0 {
+		return t.flush()
+	}
+
+	return nil
+}
+
+// Close sends the remaining rows to the client. It takes the error that ended the table and returns it unless it only
+// signals that the table is complete or that the client went away.
+func (t *tableWriter) Close(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	if errors.Is(err, errLimitReached) {
+		err = nil
+	}
+
+	if flushErr := t.flush(); err == nil {
+		err = flushErr
+	}
+
+	return err
+}
+
+// writeJSON writes the row as a JSON object that keeps the order of the columns.
+func (t *tableWriter) writeJSON(row []string) (err error) {
+	if err = t.jsonWriter.WriteByte('{'); err != nil {
+		return err
+	}
+
+	for i, value := range row {
+		if i > 0 {
+			if err = t.jsonWriter.WriteByte(','); err != nil {
+				return err
+			}
+		}
+
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		if _, err = t.jsonWriter.Write(t.jsonKeys[i]); err != nil {
+			return err
+		}
+		if err = t.jsonWriter.WriteByte(':'); err != nil {
+			return err
+		}
+		if _, err = t.jsonWriter.Write(encodedValue); err != nil {
+			return err
+		}
+	}
+
+	_, err = t.jsonWriter.WriteString("}\n")
+
+	return err
+}
+
+// flush sends the buffered rows to the client.
+func (t *tableWriter) flush() (err error) {
+	switch t.format {
+	case formatCSV:
+		t.csvWriter.Flush()
+		err = t.csvWriter.Error()
+	case formatNDJSON:
+		err = t.jsonWriter.Flush()
+	}
+	if err != nil {
+		return errors.Errorf("failed to flush rows: %w", err)
+	}
+
+	t.response.Flush()
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,49 @@
+package debug
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTableWriter(t *testing.T) {
+	rows := [][]string{{"1", "a,b"}, {"2", `"c"`}, {"3", ""}}
+
+	csvResponse := doTableTestRequest(t, "/?limit=2", rows)
+	assert.Equal(t, http.StatusOK, csvResponse.Code)
+	assert.Equal(t, "text/csv", csvResponse.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "ID,Value\n1,\"a,b\"\n2,\"\"\"c\"\"\"\n", csvResponse.Body.String())
+
+	jsonResponse := doTableTestRequest(t, "/?format=ndjson", rows)
+	assert.Equal(t, http.StatusOK, jsonResponse.Code)
+	assert.Equal(t, contentTypeNDJSON, jsonResponse.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, "{\"ID\":\"1\",\"Value\":\"a,b\"}\n{\"ID\":\"2\",\"Value\":\"\\\"c\\\"\"}\n{\"ID\":\"3\",\"Value\":\"\"}\n", jsonResponse.Body.String())
+
+	// unknown formats and invalid limits are rejected
+	for _, query := range []string{"/?format=xml", "/?limit=-1", "/?limit=abc"} {
+		c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, query, nil), httptest.NewRecorder())
+		_, err := newTableWriter(c, []string{"ID"})
+		assert.Error(t, err, query)
+	}
+}
+
+func doTableTestRequest(t *testing.T, query string, rows [][]string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, query, nil), recorder)
+
+	table, err := newTableWriter(c, []string{"ID", "Value"})
+	require.NoError(t, err)
+
+	for _, row := range rows {
+		if err = table.Write(row); err != nil {
+			break
+		}
+	}
+	require.NoError(t, table.Close(err))
+
+	return recorder
+}
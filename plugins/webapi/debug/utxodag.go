@@ -0,0 +1,122 @@
+package debug
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region UTXODAGHandler ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// UTXODAGHandler streams the table of all transactions in the storage.
+func UTXODAGHandler(c echo.Context) (err error) {
+	table, err := newTableWriter(c, UTXODAGTableDescription)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	deps.Tangle.LedgerState.UTXODAG.ForEachTransaction(func(transaction *ledgerstate.Transaction) bool {
+		err = table.Write(transactionInfo(transaction).toCSVRow())
+
+		return err == nil
+	})
+
+	return table.Close(err)
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region TransactionInfo //////////////////////////////////////////////////////////////////////////////////////////////
+
+// UTXODAGTableDescription holds the description of the columns of the transaction table.
+var UTXODAGTableDescription = []string{
+	"ID",
+	"IssuanceTime",
+	"SolidTime",
+	"AccessManaPledgeID",
+	"ConsensusManaPledgeID",
+	"Inputs",
+	"Outputs",
+	"Attachments",
+	"BranchID",
+	"Conflicting",
+	"LazyBooked",
+	"GradeOfFinality",
+	"GradeOfFinalityTime",
+}
+
+// TransactionInfo holds the information of a transaction.
+type TransactionInfo struct {
+	// transaction essence
+	ID                    string
+	IssuanceTimestamp     time.Time
+	SolidTime             time.Time
+	AccessManaPledgeID    string
+	ConsensusManaPledgeID string
+	Inputs                ledgerstate.Inputs
+	Outputs               ledgerstate.Outputs
+	// attachments
+	Attachments []string
+	// transaction metadata
+	BranchIDs           []string
+	Conflicting         bool
+	LazyBooked          bool
+	GradeOfFinality     gof.GradeOfFinality
+	GradeOfFinalityTime time.Time
+}
+
+func transactionInfo(transaction *ledgerstate.Transaction) TransactionInfo {
+	transactionID := transaction.ID()
+	txInfo := TransactionInfo{
+		ID:                    transactionID.Base58(),
+		IssuanceTimestamp:     transaction.Essence().Timestamp(),
+		AccessManaPledgeID:    base58.Encode(transaction.Essence().AccessPledgeID().Bytes()),
+		ConsensusManaPledgeID: base58.Encode(transaction.Essence().ConsensusPledgeID().Bytes()),
+		Inputs:                transaction.Essence().Inputs(),
+		Outputs:               transaction.Essence().Outputs(),
+	}
+
+	for messageID := range deps.Tangle.Storage.AttachmentMessageIDs(transactionID) {
+		txInfo.Attachments = append(txInfo.Attachments, messageID.Base58())
+	}
+
+	deps.Tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+		txInfo.SolidTime = transactionMetadata.SolidificationTime()
+		txInfo.BranchIDs = transactionMetadata.BranchIDs().Base58()
+
+		txInfo.Conflicting = deps.Tangle.LedgerState.TransactionConflicting(transactionID)
+		txInfo.LazyBooked = transactionMetadata.LazyBooked()
+		txInfo.GradeOfFinality = transactionMetadata.GradeOfFinality()
+		txInfo.GradeOfFinalityTime = transactionMetadata.GradeOfFinalityTime()
+	})
+
+	return txInfo
+}
+
+func (t TransactionInfo) toCSVRow() (row []string) {
+	return []string{
+		t.ID,
+		fmt.Sprint(t.IssuanceTimestamp.UnixNano()),
+		fmt.Sprint(t.SolidTime.UnixNano()),
+		t.AccessManaPledgeID,
+		t.ConsensusManaPledgeID,
+		strings.Join(t.Inputs.Strings(), ";"),
+		strings.Join(t.Outputs.Strings(), ";"),
+		strings.Join(t.Attachments, ";"),
+		strings.Join(t.BranchIDs, ";"),
+		fmt.Sprint(t.Conflicting),
+		fmt.Sprint(t.LazyBooked),
+		fmt.Sprint(t.GradeOfFinality),
+		fmt.Sprint(t.GradeOfFinalityTime.UnixNano()),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -1,9 +1,9 @@
 package faucet
 
 import (
-	"fmt"
 	"net/http"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
@@ -44,7 +44,7 @@ func requestFunds(c echo.Context) error {
 	var request jsonmodels.FaucetRequest
 	if err := c.Bind(&request); err != nil {
 		Plugin.LogInfo(err.Error())
-		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	Plugin.LogInfo("Received - address:", request.Address)
@@ -52,7 +52,7 @@ func requestFunds(c echo.Context) error {
 
 	addr, err := ledgerstate.AddressFromBase58EncodedString(request.Address)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.FaucetResponse{Error: "Invalid address"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("Invalid address")))
 	}
 
 	var accessManaPledgeID identity.ID
@@ -60,14 +60,14 @@ func requestFunds(c echo.Context) error {
 	if request.AccessManaPledgeID != "" {
 		accessManaPledgeID, err = mana.IDFromStr(request.AccessManaPledgeID)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, jsonmodels.FaucetResponse{Error: "Invalid access mana node ID"})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("Invalid access mana node ID")))
 		}
 	}
 
 	if request.ConsensusManaPledgeID != "" {
 		consensusManaPledgeID, err = mana.IDFromStr(request.ConsensusManaPledgeID)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, jsonmodels.FaucetResponse{Error: "Invalid consensus mana node ID"})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("Invalid consensus mana node ID")))
 		}
 	}
 
@@ -75,7 +75,7 @@ func requestFunds(c echo.Context) error {
 
 	msg, err := deps.Tangle.MessageFactory.IssuePayload(faucetPayload)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, jsonmodels.FaucetResponse{Error: fmt.Sprintf("Failed to send faucetrequest: %s", err.Error())})
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(errors.Errorf("Failed to send faucetrequest: %s", err.Error())))
 	}
 
 	return c.JSON(http.StatusOK, jsonmodels.FaucetResponse{ID: msg.ID().Base58()})
@@ -0,0 +1,259 @@
+package webapi
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// IdempotentReplayedHeader is the HTTP header that marks responses that were replayed from the idempotency cache.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// region idempotencyMiddleware ////////////////////////////////////////////////////////////////////////////////////////
+
+// idempotencyMiddleware returns a middleware that executes POST requests carrying an idempotency key only once. Repeated
+// requests with the same key and body receive the cached response of the first successful request, while requests that
+// reuse a key with a different body are rejected. Requests that did not succeed are not cached so that they can be
+// retried. The bodies of these requests are limited to maxBodySize bytes, and at most maxEntries responses are kept,
+// evicting the least recently used ones first.
+func idempotencyMiddleware(ttl time.Duration, maxEntries int, maxBodySize int64) echo.MiddlewareFunc {
+	cache := newIdempotencyCache(ttl, maxEntries)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			key := request.Header.Get(jsonmodels.IdempotencyKeyHeader)
+			if request.Method != http.MethodPost || key == "" {
+				return next(c)
+			}
+
+			// the body is buffered to hash it, so its size needs to be limited
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), request.Body, maxBodySize))
+			if err != nil {
+				if int64(len(body)) >= maxBodySize {
+					return c.JSON(http.StatusRequestEntityTooLarge, jsonmodels.NewErrorResponse(errors.Errorf("request body exceeds %d bytes", maxBodySize)))
+				}
+				return errors.Errorf("failed to read request body: %w", echo.ErrBadRequest)
+			}
+			request.Body = io.NopCloser(bytes.NewReader(body))
+
+			// the keys of different tenants must not collide
+			cacheKey := request.URL.Path + "#" + key
+			if tenant, exists := tenantFromContext(c); exists {
+				cacheKey = tenant.name + "@" + cacheKey
+			}
+
+			entry, created, err := cache.acquire(request, cacheKey, sha256.Sum256(body))
+			if err != nil {
+				return c.JSON(http.StatusUnprocessableEntity, jsonmodels.NewErrorResponse(err))
+			}
+			if !created {
+				c.Response().Header().Set(IdempotentReplayedHeader, "true")
+				return c.Blob(entry.statusCode, entry.contentType, entry.body)
+			}
+
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			defer func() {
+				c.Response().Writer = recorder.ResponseWriter
+			}()
+
+			if err = next(c); err != nil {
+				// let the error handler write the response before the entry is released
+				c.Error(err)
+			}
+			cache.release(entry, c.Response().Status, c.Response().Header().Get(echo.HeaderContentType), recorder.body.Bytes())
+
+			return nil
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region idempotencyCache /////////////////////////////////////////////////////////////////////////////////////////////
+
+// idempotencyCache stores the responses of requests by their idempotency key. It keeps at most maxEntries completed
+// responses and evicts the least recently used ones first. As all entries live for the same ttl, the completed entries
+// are additionally kept in the order of their expiry, so that the expired ones can be evicted without scanning the
+// whole cache.
+type idempotencyCache struct {
+	ttl         time.Duration
+	maxEntries  int
+	entries     map[string]*idempotencyEntry
+	usage       *list.List
+	expirations *list.List
+	mutex       sync.Mutex
+}
+
+// idempotencyEntry is the cached response of a request.
+type idempotencyEntry struct {
+	cacheKey          string
+	element           *list.Element
+	expirationElement *list.Element
+	bodyHash          [sha256.Size]byte
+	done              chan struct{}
+	succeeded         bool
+	expiry            time.Time
+	statusCode        int
+	contentType       string
+	body              []byte
+}
+
+// newIdempotencyCache creates a cache whose entries expire after the given duration and that keeps at most the given
+// number of entries.
+func newIdempotencyCache(ttl time.Duration, maxEntries int) *idempotencyCache {
+	return &idempotencyCache{
+		ttl:         ttl,
+		maxEntries:  maxEntries,
+		entries:     make(map[string]*idempotencyEntry),
+		usage:       list.New(),
+		expirations: list.New(),
+	}
+}
+
+// acquire returns the completed entry of the given key or creates a new one that needs to be released by the caller.
+// If another request with the same key is in flight, acquire waits until it finished.
+func (i *idempotencyCache) acquire(request *http.Request, cacheKey string, bodyHash [sha256.Size]byte) (entry *idempotencyEntry, created bool, err error) {
+	for {
+		i.mutex.Lock()
+		i.evictExpired()
+
+		entry, exists := i.entries[cacheKey]
+		if !exists {
+			entry = &idempotencyEntry{
+				cacheKey: cacheKey,
+				bodyHash: bodyHash,
+				done:     make(chan struct{}),
+			}
+			entry.element = i.usage.PushFront(entry)
+			i.entries[cacheKey] = entry
+			i.evictLeastRecentlyUsed()
+			i.mutex.Unlock()
+
+			return entry, true, nil
+		}
+		i.usage.MoveToFront(entry.element)
+		i.mutex.Unlock()
+
+		if entry.bodyHash != bodyHash {
+			return nil, false, errors.Errorf("idempotency key was already used for a different request")
+		}
+
+		select {
+		case <-entry.done:
+			if entry.succeeded {
+				return entry, false, nil
+			}
+			// the request failed and its entry was removed, so we try to execute it ourselves
+		case <-request.Context().Done():
+			return nil, false, request.Context().Err()
+		}
+	}
+}
+
+// release completes the entry. Successful responses are cached, while the entries of failed requests are removed.
+func (i *idempotencyCache) release(entry *idempotencyEntry, statusCode int, contentType string, body []byte) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	if statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+		entry.succeeded = true
+		entry.expiry = time.Now().Add(i.ttl)
+		entry.statusCode = statusCode
+		entry.contentType = contentType
+		entry.body = body
+		entry.expirationElement = i.expirations.PushBack(entry)
+	} else {
+		i.remove(entry)
+	}
+
+	close(entry.done)
+}
+
+// evictExpired removes the expired entries from the cache, stopping at the first entry that did not expire, yet. The
+// mutex needs to be held by the caller.
+func (i *idempotencyCache) evictExpired() {
+	now := time.Now()
+	for element := i.expirations.Front(); element != nil; element = i.expirations.Front() {
+		entry := element.Value.(*idempotencyEntry)
+		if !now.After(entry.expiry) {
+			return
+		}
+
+		i.remove(entry)
+	}
+}
+
+// evictLeastRecentlyUsed removes the least recently used completed entries until the cache holds at most maxEntries
+// entries. Entries of requests that are still in flight are kept, so that they are not executed twice. The mutex needs
+// to be held by the caller.
+func (i *idempotencyCache) evictLeastRecentlyUsed() {
+	for element := i.usage.Back(); element != nil && len(i.entries) > i.maxEntries; {
+		entry := element.Value.(*idempotencyEntry)
+		element = element.Prev()
+
+		if entry.succeeded {
+			i.remove(entry)
+		}
+	}
+}
+
+// remove deletes the given entry from the cache. The mutex needs to be held by the caller.
+func (i *idempotencyCache) remove(entry *idempotencyEntry) {
+	if i.entries[entry.cacheKey] != entry {
+		return
+	}
+
+	delete(i.entries, entry.cacheKey)
+	i.usage.Remove(entry.element)
+	if entry.expirationElement != nil {
+		i.expirations.Remove(entry.expirationElement)
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region responseRecorder /////////////////////////////////////////////////////////////////////////////////////////////
+
+// responseRecorder is a http.ResponseWriter that keeps a copy of the written body.
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes the data to the underlying writer and records it.
+func (r *responseRecorder) Write(data []byte) (int, error) {
+	r.body.Write(data)
+	return r.ResponseWriter.Write(data)
+}
+
+// Flush implements the http.Flusher interface.
+func (r *responseRecorder) Flush() {
+	if flusher, ok := r.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Hijack implements the http.Hijacker interface.
+func (r *responseRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := r.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+
+	return hijacker.Hijack()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
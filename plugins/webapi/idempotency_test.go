@@ -0,0 +1,167 @@
+package webapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	var executions int32
+	server := newIdempotencyTestServer(func(c echo.Context) error {
+		atomic.AddInt32(&executions, 1)
+		return c.JSON(http.StatusOK, map[string]int32{"execution": atomic.LoadInt32(&executions)})
+	})
+
+	first := doIdempotencyTestRequest(server, "key", `{"data":"1"}`)
+	require.Equal(t, http.StatusOK, first.Code)
+	assert.Empty(t, first.Header().Get(IdempotentReplayedHeader))
+
+	// the same key and body return the cached response
+	replayed := doIdempotencyTestRequest(server, "key", `{"data":"1"}`)
+	require.Equal(t, http.StatusOK, replayed.Code)
+	assert.Equal(t, "true", replayed.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, first.Body.String(), replayed.Body.String())
+	assert.EqualValues(t, 1, atomic.LoadInt32(&executions))
+
+	// the same key with a different body is rejected
+	assert.Equal(t, http.StatusUnprocessableEntity, doIdempotencyTestRequest(server, "key", `{"data":"2"}`).Code)
+
+	// other keys and requests without keys are executed
+	assert.Equal(t, http.StatusOK, doIdempotencyTestRequest(server, "otherKey", `{"data":"1"}`).Code)
+	assert.Equal(t, http.StatusOK, doIdempotencyTestRequest(server, "", `{"data":"1"}`).Code)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&executions))
+}
+
+func TestIdempotencyMiddleware_Failure(t *testing.T) {
+	var executions int32
+	server := newIdempotencyTestServer(func(c echo.Context) error {
+		if atomic.AddInt32(&executions, 1) == 1 {
+			return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(echo.ErrInternalServerError))
+		}
+		return c.JSON(http.StatusOK, nil)
+	})
+
+	// failed requests are not cached, so a retry executes the request again
+	assert.Equal(t, http.StatusServiceUnavailable, doIdempotencyTestRequest(server, "key", `{}`).Code)
+	assert.Equal(t, http.StatusOK, doIdempotencyTestRequest(server, "key", `{}`).Code)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&executions))
+}
+
+func TestIdempotencyMiddleware_Concurrent(t *testing.T) {
+	var executions int32
+	server := newIdempotencyTestServer(func(c echo.Context) error {
+		atomic.AddInt32(&executions, 1)
+		time.Sleep(50 * time.Millisecond)
+		return c.JSON(http.StatusOK, nil)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.Equal(t, http.StatusOK, doIdempotencyTestRequest(server, "key", `{}`).Code)
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, atomic.LoadInt32(&executions))
+}
+
+func TestIdempotencyMiddleware_BodyLimit(t *testing.T) {
+	var executions int32
+	server := newIdempotencyTestServer(func(c echo.Context) error {
+		atomic.AddInt32(&executions, 1)
+		return c.JSON(http.StatusOK, nil)
+	})
+
+	// requests with an idempotency key need to fit into the body limit, while other requests are not affected
+	assert.Equal(t, http.StatusRequestEntityTooLarge, doIdempotencyTestRequest(server, "key", strings.Repeat("x", idempotencyTestMaxBodySize+1)).Code)
+	assert.Equal(t, http.StatusOK, doIdempotencyTestRequest(server, "key", strings.Repeat("x", idempotencyTestMaxBodySize)).Code)
+	assert.Equal(t, http.StatusOK, doIdempotencyTestRequest(server, "", strings.Repeat("x", idempotencyTestMaxBodySize+1)).Code)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&executions))
+}
+
+func TestIdempotencyCache_LeastRecentlyUsed(t *testing.T) {
+	cache := newIdempotencyCache(time.Minute, 2)
+	request := httptest.NewRequest(http.MethodPost, "/test", nil)
+
+	store := func(cacheKey string) (created bool) {
+		entry, created, err := cache.acquire(request, cacheKey, [32]byte{})
+		require.NoError(t, err)
+		if created {
+			cache.release(entry, http.StatusOK, echo.MIMEApplicationJSON, nil)
+		}
+
+		return created
+	}
+
+	assert.True(t, store("a"))
+	assert.True(t, store("b"))
+	// using "a" makes "b" the least recently used entry that is evicted by "c"
+	assert.False(t, store("a"))
+	assert.True(t, store("c"))
+	assert.Len(t, cache.entries, 2)
+	assert.Equal(t, 2, cache.usage.Len())
+	assert.Equal(t, 2, cache.expirations.Len())
+	assert.False(t, store("a"))
+	assert.False(t, store("c"))
+	assert.True(t, store("b"))
+}
+
+func TestIdempotencyCache_Expiry(t *testing.T) {
+	cache := newIdempotencyCache(time.Minute, 10)
+	request := httptest.NewRequest(http.MethodPost, "/test", nil)
+
+	for _, cacheKey := range []string{"a", "b", "c"} {
+		entry, created, err := cache.acquire(request, cacheKey, [32]byte{})
+		require.NoError(t, err)
+		require.True(t, created)
+		cache.release(entry, http.StatusOK, echo.MIMEApplicationJSON, nil)
+	}
+
+	// only the entries that expired before the first unexpired one are evicted
+	cache.entries["a"].expiry = time.Now().Add(-time.Second)
+	cache.entries["b"].expiry = time.Now().Add(-time.Second)
+	_, created, err := cache.acquire(request, "c", [32]byte{})
+	require.NoError(t, err)
+	assert.False(t, created)
+	assert.Len(t, cache.entries, 1)
+	assert.Equal(t, 1, cache.usage.Len())
+	assert.Equal(t, 1, cache.expirations.Len())
+}
+
+// idempotencyTestMaxBodySize contains the body limit of the test server.
+const idempotencyTestMaxBodySize = 64
+
+func newIdempotencyTestServer(handler echo.HandlerFunc) *echo.Echo {
+	server := echo.New()
+	server.Use(idempotencyMiddleware(time.Minute, 100, idempotencyTestMaxBodySize))
+	server.POST("/test", handler)
+
+	return server
+}
+
+func doIdempotencyTestRequest(server *echo.Echo, key, body string) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if key != "" {
+		request.Header.Set(jsonmodels.IdempotencyKeyHeader, key)
+	}
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	return recorder
+}
@@ -11,8 +11,10 @@ import (
 	"github.com/mr-tron/base58/base58"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/archive"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/runtimeplugin"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/plugins/autopeering/discovery"
 	"github.com/iotaledger/goshimmer/plugins/banner"
@@ -27,9 +29,10 @@ const PluginName = "WebAPIInfoEndpoint"
 type dependencies struct {
 	dig.In
 
-	Server *echo.Echo
-	Local  *peer.Local
-	Tangle *tangle.Tangle
+	Server  *echo.Echo
+	Local   *peer.Local
+	Tangle  *tangle.Tangle
+	Archive *archive.Archive `optional:"true"`
 }
 
 var (
@@ -89,10 +92,16 @@ func getInfo(c echo.Context) error {
 	var enabledPlugins []string
 	var disabledPlugins []string
 	for pluginName, plugin := range node.GetPlugins() {
-		if node.IsSkipped(plugin) {
-			disabledPlugins = append(disabledPlugins, pluginName)
-		} else {
+		enabled := !node.IsSkipped(plugin)
+		// plugins that can be started at runtime are always loaded, so they are only enabled while they are running
+		if runtimePlugin, exists := runtimeplugin.Get(pluginName); exists && enabled {
+			enabled = runtimePlugin.Running()
+		}
+
+		if enabled {
 			enabledPlugins = append(enabledPlugins, pluginName)
+		} else {
+			disabledPlugins = append(disabledPlugins, pluginName)
 		}
 	}
 
@@ -128,6 +137,14 @@ func getInfo(c echo.Context) error {
 		nodeQueueSizes[nodeID.String()] = size
 	}
 
+	var archiveMode jsonmodels.Archive
+	if deps.Archive != nil {
+		archiveMode.Enabled = true
+		for _, indexType := range deps.Archive.Indexes() {
+			archiveMode.Indexes = append(archiveMode.Indexes, string(indexType))
+		}
+	}
+
 	return c.JSON(http.StatusOK, jsonmodels.InfoResponse{
 		Version:                 banner.AppVersion,
 		NetworkVersion:          discovery.Parameters.NetworkVersion,
@@ -147,10 +164,13 @@ func getInfo(c echo.Context) error {
 		ManaDecay:             mana.Decay,
 		Scheduler: jsonmodels.Scheduler{
 			Running:           deps.Tangle.Scheduler.Running(),
+			Paused:            deps.Tangle.Scheduler.Paused(),
 			Rate:              deps.Tangle.Scheduler.Rate().String(),
 			MaxBufferSize:     deps.Tangle.Scheduler.MaxBufferSize(),
 			CurrentBufferSize: deps.Tangle.Scheduler.BufferSize(),
 			NodeQueueSizes:    nodeQueueSizes,
 		},
+		GradeOfFinality: jsonmodels.NewGradeOfFinality(messagelayer.FinalityGadget().GoFTranslation()),
+		Archive:         archiveMode,
 	})
 }
@@ -0,0 +1,98 @@
+package ledgerstate
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region GetAddressBalance ////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetAddressBalance is the handler for the /ledgerstate/addresses/:address/balance endpoint. It splits the balance of
+// the unspent outputs of the address into confirmed, pending and conflicting portions, so that wallets only spend
+// funds that can't be reverted by the resolution of a conflict.
+func GetAddressBalance(c echo.Context) error {
+	address, err := ledgerstate.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	res := jsonmodels.NewGetAddressBalanceResponse(address)
+
+	cachedOutputs := deps.Tangle.LedgerState.CachedOutputsOnAddress(address)
+	defer cachedOutputs.Release()
+
+	for _, output := range cachedOutputs.Unwrap() {
+		if output == nil {
+			continue
+		}
+
+		deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+			if outputMetadata.ConsumerCount() != 0 {
+				return
+			}
+
+			var balances map[string]uint64
+			switch outputBalanceState(outputMetadata) {
+			case balanceStateConfirmed:
+				balances = res.Confirmed
+			case balanceStatePending:
+				balances = res.Pending
+			default:
+				balances = res.Conflicting
+			}
+
+			output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+				balances[color.Base58()] += balance
+				return true
+			})
+		})
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// outputBalanceState returns the balanceState of the given unspent output.
+func outputBalanceState(outputMetadata *ledgerstate.OutputMetadata) balanceState {
+	if deps.Tangle.ConfirmationOracle.IsOutputConfirmed(outputMetadata.ID()) {
+		return balanceStateConfirmed
+	}
+
+	branchIDs := outputMetadata.BranchIDs()
+
+	return balanceStateOfBranches(branchIDs, deps.Tangle.LedgerState.BranchDAG.InclusionState(branchIDs))
+}
+
+// balanceStateOfBranches returns the balanceState of an unconfirmed output in the given branches.
+func balanceStateOfBranches(branchIDs ledgerstate.BranchIDs, inclusionState ledgerstate.InclusionState) balanceState {
+	switch {
+	case inclusionState == ledgerstate.Rejected:
+		return balanceStateConflicting
+	case inclusionState == ledgerstate.Confirmed, branchIDs.Is(ledgerstate.MasterBranchID):
+		return balanceStatePending
+	default:
+		// the output is part of a conflict that is not resolved yet
+		return balanceStateConflicting
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region balanceState /////////////////////////////////////////////////////////////////////////////////////////////////
+
+// balanceState is the state of the balance of an unspent output.
+type balanceState uint8
+
+const (
+	// balanceStateConfirmed is the state of outputs that are confirmed.
+	balanceStateConfirmed balanceState = iota
+	// balanceStatePending is the state of outputs that are not confirmed yet but that are not part of a conflict.
+	balanceStatePending
+	// balanceStateConflicting is the state of outputs that are part of a pending or rejected conflict.
+	balanceStateConflicting
+)
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,161 @@
+package ledgerstate
+
+import (
+	"net/http"
+
+	"github.com/iotaledger/hive.go/types"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// region PostAddressesBalanceProof ////////////////////////////////////////////////////////////////////////////////////
+
+// PostAddressesBalanceProof is the handler for the /ledgerstate/addresses/balanceProof endpoint. It returns the outputs
+// of the given addresses that are unspent under at least one resolution of the pending conflicts, together with the
+// balance that is confirmed and unspent under every resolution. Exchanges can safely credit the guaranteed balance.
+func PostAddressesBalanceProof(c echo.Context) error {
+	req := new(jsonmodels.PostAddressesBalanceProofRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	addresses := make([]ledgerstate.Address, len(req.Addresses))
+	for i, addressString := range req.Addresses {
+		var err error
+		if addresses[i], err = ledgerstate.AddressFromBase58EncodedString(addressString); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+	}
+
+	res := &jsonmodels.PostAddressesBalanceProofResponse{
+		BalanceProofs: make([]*jsonmodels.AddressBalanceProof, len(addresses)),
+	}
+	for i, address := range addresses {
+		res.BalanceProofs[i] = addressBalanceProof(address)
+	}
+
+	return c.JSON(http.StatusOK, res)
+}
+
+// addressBalanceProof collects the AddressBalanceProof of the given address.
+func addressBalanceProof(address ledgerstate.Address) (balanceProof *jsonmodels.AddressBalanceProof) {
+	balanceProof = jsonmodels.NewAddressBalanceProof(address)
+
+	cachedOutputs := deps.Tangle.LedgerState.CachedOutputsOnAddress(address)
+	defer cachedOutputs.Release()
+
+	for _, output := range cachedOutputs.Unwrap() {
+		if output == nil {
+			continue
+		}
+
+		proofOutput, spendable := balanceProofOutput(output)
+		if !spendable {
+			continue
+		}
+
+		balanceProof.Outputs = append(balanceProof.Outputs, proofOutput)
+		if !proofOutput.Guaranteed {
+			continue
+		}
+
+		output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+			balanceProof.GuaranteedBalances[color.Base58()] += balance
+			return true
+		})
+	}
+
+	return balanceProof
+}
+
+// balanceProofOutput collects the BalanceProofOutput of the given output. It returns false if the output is spent or
+// rejected under every resolution of the pending conflicts.
+func balanceProofOutput(output ledgerstate.Output) (proofOutput *jsonmodels.BalanceProofOutput, spendable bool) {
+	deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+		if deps.Tangle.LedgerState.BranchDAG.InclusionState(outputMetadata.BranchIDs()) == ledgerstate.Rejected {
+			return
+		}
+
+		proofOutput = &jsonmodels.BalanceProofOutput{
+			Output:           jsonmodels.NewOutput(output),
+			GradeOfFinality:  outputMetadata.GradeOfFinality(),
+			Confirmed:        deps.Tangle.ConfirmationOracle.IsOutputConfirmed(output.ID()),
+			Branches:         make([]*jsonmodels.BalanceProofBranch, 0),
+			PendingConsumers: make([]string, 0),
+		}
+
+		for branchID := range outputMetadata.BranchIDs() {
+			branchGoF, _ := deps.Tangle.LedgerState.UTXODAG.BranchGradeOfFinality(branchID)
+			proofOutput.Branches = append(proofOutput.Branches, &jsonmodels.BalanceProofBranch{
+				BranchID:        branchID.Base58(),
+				GradeOfFinality: branchGoF,
+				InclusionState:  deps.Tangle.LedgerState.BranchDAG.InclusionState(ledgerstate.NewBranchIDs(branchID)).String(),
+			})
+		}
+
+		consumerInclusionStates := make([]ledgerstate.InclusionState, 0)
+		deps.Tangle.LedgerState.Consumers(output.ID()).Consume(func(consumer *ledgerstate.Consumer) {
+			inclusionState := consumerInclusionState(consumer)
+			if inclusionState == ledgerstate.Pending {
+				proofOutput.PendingConsumers = append(proofOutput.PendingConsumers, consumer.TransactionID().Base58())
+			}
+			consumerInclusionStates = append(consumerInclusionStates, inclusionState)
+		})
+
+		spendable = !spentByConfirmedConsumer(consumerInclusionStates)
+		proofOutput.Guaranteed = guaranteedOutput(proofOutput.Confirmed, consumerInclusionStates)
+	})
+
+	return proofOutput, spendable
+}
+
+// consumerInclusionState returns the InclusionState of the transaction of the given Consumer. Transactions are only
+// considered to be confirmed if they are confirmed themselves, and not only their branches.
+func consumerInclusionState(consumer *ledgerstate.Consumer) ledgerstate.InclusionState {
+	if consumer.Valid() == types.False {
+		return ledgerstate.Rejected
+	}
+
+	if deps.Tangle.ConfirmationOracle.IsTransactionConfirmed(consumer.TransactionID()) {
+		return ledgerstate.Confirmed
+	}
+
+	// transactions that are not booked yet have no branches and are always pending
+	branchIDs := deps.Tangle.LedgerState.BranchIDs(consumer.TransactionID())
+	if len(branchIDs) != 0 && deps.Tangle.LedgerState.BranchDAG.InclusionState(branchIDs) == ledgerstate.Rejected {
+		return ledgerstate.Rejected
+	}
+
+	return ledgerstate.Pending
+}
+
+// spentByConfirmedConsumer checks whether one of the consumers with the given InclusionStates is confirmed.
+func spentByConfirmedConsumer(consumerInclusionStates []ledgerstate.InclusionState) bool {
+	for _, inclusionState := range consumerInclusionStates {
+		if inclusionState == ledgerstate.Confirmed {
+			return true
+		}
+	}
+
+	return false
+}
+
+// guaranteedOutput checks whether a confirmed output stays unspent under every resolution of the pending conflicts,
+// which is the case if all of its consumers are rejected.
+func guaranteedOutput(outputConfirmed bool, consumerInclusionStates []ledgerstate.InclusionState) bool {
+	if !outputConfirmed {
+		return false
+	}
+
+	for _, inclusionState := range consumerInclusionStates {
+		if inclusionState != ledgerstate.Rejected {
+			return false
+		}
+	}
+
+	return true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,20 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestGuaranteedOutput(t *testing.T) {
+	assert.True(t, guaranteedOutput(true, nil))
+	assert.True(t, guaranteedOutput(true, []ledgerstate.InclusionState{ledgerstate.Rejected, ledgerstate.Rejected}))
+	assert.False(t, guaranteedOutput(false, nil))
+	assert.False(t, guaranteedOutput(true, []ledgerstate.InclusionState{ledgerstate.Rejected, ledgerstate.Pending}))
+	assert.False(t, guaranteedOutput(true, []ledgerstate.InclusionState{ledgerstate.Confirmed}))
+
+	assert.False(t, spentByConfirmedConsumer([]ledgerstate.InclusionState{ledgerstate.Pending, ledgerstate.Rejected}))
+	assert.True(t, spentByConfirmedConsumer([]ledgerstate.InclusionState{ledgerstate.Pending, ledgerstate.Confirmed}))
+}
@@ -0,0 +1,19 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestBalanceStateOfBranches(t *testing.T) {
+	conflictBranchID := ledgerstate.BranchID{2}
+
+	assert.Equal(t, balanceStatePending, balanceStateOfBranches(ledgerstate.NewBranchIDs(ledgerstate.MasterBranchID), ledgerstate.Confirmed))
+	assert.Equal(t, balanceStatePending, balanceStateOfBranches(ledgerstate.NewBranchIDs(conflictBranchID), ledgerstate.Confirmed))
+	assert.Equal(t, balanceStateConflicting, balanceStateOfBranches(ledgerstate.NewBranchIDs(conflictBranchID), ledgerstate.Pending))
+	assert.Equal(t, balanceStateConflicting, balanceStateOfBranches(ledgerstate.NewBranchIDs(conflictBranchID), ledgerstate.Rejected))
+	assert.Equal(t, balanceStateConflicting, balanceStateOfBranches(ledgerstate.NewBranchIDs(ledgerstate.MasterBranchID, conflictBranchID), ledgerstate.Pending))
+}
@@ -0,0 +1,158 @@
+package ledgerstate
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/typeutils"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+)
+
+// maxFutureTimestampOffset defines how far the timestamp of a submitted transaction can be in the future.
+const maxFutureTimestampOffset = time.Minute
+
+// names of the checks that are reported by a dry-run.
+const (
+	checkSyntax              = "syntax"
+	checkSynced              = "synced"
+	checkDoubleSpendFilter   = "doubleSpendFilter"
+	checkAccessManaPledge    = "accessManaPledge"
+	checkConsensusManaPledge = "consensusManaPledge"
+	checkInputsSolid         = "inputsSolid"
+	checkBalances            = "balances"
+	checkUnlockBlocks        = "unlockBlocks"
+	checkAliasInitialState   = "aliasInitialState"
+	checkLedgerState         = "ledgerState"
+	checkTimestamp           = "timestamp"
+)
+
+// region Dry-run //////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// IsDryRun returns true if the request asks to only validate the submission without issuing it.
+func IsDryRun(c echo.Context) bool {
+	dryRun, err := strconv.ParseBool(c.QueryParam(jsonmodels.DryRunQueryParameter))
+
+	return err == nil && dryRun
+}
+
+// dryRunPostTransaction performs all checks of the PostTransaction endpoint without issuing the transaction.
+func dryRunPostTransaction(transactionBytes []byte) (res *jsonmodels.DryRunResponse) {
+	res = jsonmodels.NewDryRunResponse()
+
+	tx, err := new(ledgerstate.Transaction).FromBytes(transactionBytes)
+	if !res.AddCheck(checkSyntax, err) {
+		res.SkipChecks(checkSynced, checkDoubleSpendFilter, checkAccessManaPledge, checkConsensusManaPledge)
+		res.SkipChecks(transactionChecks...)
+		return res
+	}
+	res.TransactionID = tx.ID().Base58()
+
+	res.AddCheck(checkSynced, checkNodeSynced())
+	res.AddCheck(checkDoubleSpendFilter, checkDoubleSpends(tx))
+	DiagnoseManaPledges(tx, res)
+	DiagnoseTransaction(deps.Tangle.LedgerState, tx, res)
+
+	return res
+}
+
+// DiagnoseManaPledges adds the results of checking the mana pledges of the given transaction against the allowed
+// pledge lists of the node to the given DryRunResponse.
+func DiagnoseManaPledges(tx *ledgerstate.Transaction, res *jsonmodels.DryRunResponse) {
+	res.AddCheck(checkAccessManaPledge, messagelayer.CheckManaPledge(mana.AccessMana, tx.Essence().AccessPledgeID()))
+	res.AddCheck(checkConsensusManaPledge, messagelayer.CheckManaPledge(mana.ConsensusMana, tx.Essence().ConsensusPledgeID()))
+}
+
+// transactionChecks contains the names of the checks that are performed by DiagnoseTransaction.
+var transactionChecks = []string{checkInputsSolid, checkBalances, checkUnlockBlocks, checkAliasInitialState, checkLedgerState, checkTimestamp}
+
+// DiagnoseTransaction adds the results of the semantic validation of the given transaction against the given ledger
+// state to the given DryRunResponse.
+func DiagnoseTransaction(ledgerState *tangle.LedgerState, tx *ledgerstate.Transaction, res *jsonmodels.DryRunResponse) {
+	defer res.AddCheck(checkTimestamp, checkTransactionTimestamp(tx))
+
+	cachedConsumedOutputs := ledgerState.ConsumedOutputs(tx)
+	defer cachedConsumedOutputs.Release()
+	consumedOutputs := ledgerstate.Outputs(cachedConsumedOutputs.Unwrap())
+
+	missingInputs := make([]string, 0)
+	for i, consumedOutput := range consumedOutputs {
+		if typeutils.IsInterfaceNil(consumedOutput) {
+			missingInputs = append(missingInputs, tx.Essence().Inputs()[i].(*ledgerstate.UTXOInput).ReferencedOutputID().Base58())
+		}
+	}
+	if len(missingInputs) != 0 {
+		res.AddCheck(checkInputsSolid, errors.Errorf("unknown inputs %s: %w", strings.Join(missingInputs, ", "), ledgerstate.ErrTransactionNotSolid))
+		res.SkipChecks(checkBalances, checkUnlockBlocks, checkAliasInitialState, checkLedgerState)
+		return
+	}
+	res.AddCheck(checkInputsSolid, nil)
+
+	if !ledgerstate.TransactionBalancesValid(consumedOutputs, tx.Essence().Outputs()) {
+		res.AddCheck(checkBalances, errors.Errorf("sum of consumed and spent balances is not 0: %w", ledgerstate.ErrTransactionInvalid))
+	} else {
+		res.AddCheck(checkBalances, nil)
+	}
+
+	if valid, err := ledgerstate.UnlockBlocksValidWithError(consumedOutputs, tx); !valid || err != nil {
+		if err == nil {
+			err = errors.New("spending of referenced consumedOutputs is not authorized")
+		}
+		res.AddCheck(checkUnlockBlocks, errors.Errorf("%s: %w", err.Error(), ledgerstate.ErrTransactionInvalid))
+	} else {
+		res.AddCheck(checkUnlockBlocks, nil)
+	}
+
+	if !ledgerstate.AliasInitialStateValid(consumedOutputs, tx) {
+		res.AddCheck(checkAliasInitialState, errors.Errorf("initial state of created alias output is invalid: %w", ledgerstate.ErrTransactionInvalid))
+	} else {
+		res.AddCheck(checkAliasInitialState, nil)
+	}
+
+	// covers the remaining checks of the ledger, e.g. consumed outputs that reference each other
+	res.AddCheck(checkLedgerState, ledgerState.CheckTransaction(tx))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region Checks ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// checkNodeSynced returns an error if the node can't issue messages because it is not synced.
+func checkNodeSynced() error {
+	if !deps.Tangle.Synced() {
+		return errors.Errorf("can't issue payload: %w", tangle.ErrNotSynced)
+	}
+
+	return nil
+}
+
+// checkDoubleSpends returns an error if the transaction conflicts with a transaction that was submitted recently.
+func checkDoubleSpends(tx *ledgerstate.Transaction) error {
+	if has, conflictingID := doubleSpendFilter.HasConflict(tx.Essence().Inputs()); has {
+		return errors.Errorf("transaction is conflicting with previously submitted transaction %s", conflictingID.Base58())
+	}
+
+	return nil
+}
+
+// checkTransactionTimestamp returns an error if the timestamp of the transaction is too old or too far in the future.
+func checkTransactionTimestamp(tx *ledgerstate.Transaction) error {
+	if tx.Essence().Timestamp().Before(clock.SyncedTime().Add(-tangle.MaxReattachmentTimeMin)) {
+		return errors.Errorf("transaction timestamp is older than MaxReattachmentTime (%s) and cannot be issued", tangle.MaxReattachmentTimeMin)
+	}
+	if tx.Essence().Timestamp().Sub(clock.SyncedTime()) > maxFutureTimestampOffset {
+		return errors.New("transaction timestamp is in the future and cannot be issued; please readjust local clock")
+	}
+
+	return nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
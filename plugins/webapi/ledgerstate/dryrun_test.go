@@ -0,0 +1,48 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestDiagnoseTransaction(t *testing.T) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(testTangle, tangle.WithGenesisOutput("G", 3))
+	testTangle.Setup()
+
+	testFramework.CreateMessage("Valid", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("A", 3))
+	testFramework.CreateMessage("Unsolid", tangle.WithStrongParents("Valid"), tangle.WithInputs("A"), tangle.WithOutput("B", 3))
+
+	t.Run("valid transaction", func(t *testing.T) {
+		res := jsonmodels.NewDryRunResponse()
+		DiagnoseTransaction(testTangle.LedgerState, testFramework.Message("Valid").Payload().(*ledgerstate.Transaction), res)
+
+		assert.True(t, res.Valid)
+		require.Len(t, res.Checks, len(transactionChecks))
+		for _, checkName := range transactionChecks {
+			assert.Equal(t, jsonmodels.DryRunCheckPassed, res.Check(checkName).Status, checkName)
+		}
+	})
+
+	t.Run("unsolid transaction", func(t *testing.T) {
+		res := jsonmodels.NewDryRunResponse()
+		DiagnoseTransaction(testTangle.LedgerState, testFramework.Message("Unsolid").Payload().(*ledgerstate.Transaction), res)
+
+		assert.False(t, res.Valid)
+		require.Len(t, res.Checks, len(transactionChecks))
+		assert.Equal(t, jsonmodels.DryRunCheckFailed, res.Check(checkInputsSolid).Status)
+		assert.Contains(t, res.Check(checkInputsSolid).Error, ledgerstate.ErrTransactionNotSolid.Error())
+		for _, checkName := range []string{checkBalances, checkUnlockBlocks, checkAliasInitialState, checkLedgerState} {
+			assert.Equal(t, jsonmodels.DryRunCheckSkipped, res.Check(checkName).Status, checkName)
+		}
+		assert.Equal(t, jsonmodels.DryRunCheckPassed, res.Check(checkTimestamp).Status)
+	})
+}
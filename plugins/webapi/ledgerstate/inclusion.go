@@ -0,0 +1,221 @@
+package ledgerstate
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	// inclusionWriteTimeout defines the timeout for writing an update to a subscriber.
+	inclusionWriteTimeout = 5 * time.Second
+
+	// inclusionPingInterval defines the interval in which subscribers are pinged to keep the connection alive.
+	inclusionPingInterval = 30 * time.Second
+)
+
+var (
+	// inclusionSubscriptions contains the open subscriptions to grade of finality changes of transactions.
+	inclusionSubscriptions = newInclusionSubscriptionManager()
+
+	// closure to be executed on grade of finality changes of transactions.
+	onTransactionGoFChanged *event.Closure[*tangle.TransactionGoFChangedEvent]
+
+	inclusionUpgrader = websocket.Upgrader{
+		HandshakeTimeout: inclusionWriteTimeout,
+		CheckOrigin:      func(r *http.Request) bool { return true },
+	}
+)
+
+// region SubscribeTransactionInclusion ////////////////////////////////////////////////////////////////////////////////
+
+// SubscribeTransactionInclusion is the handler for the ledgerstate/transactions/:transactionID/inclusion/subscribe
+// endpoint. It upgrades the connection to a websocket and pushes the TransactionMetadata every time the grade of
+// finality of the transaction changes. The connection is closed once the transaction reached the highest grade of
+// finality.
+func SubscribeTransactionInclusion(c echo.Context) error {
+	transactionID, err := ledgerstate.TransactionIDFromBase58(c.Param("transactionID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	conn, err := inclusionUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	// subscribe before sending the current state, so that no change is missed
+	subscription := inclusionSubscriptions.Subscribe(transactionID)
+	defer inclusionSubscriptions.Unsubscribe(subscription)
+
+	// the client does not send any messages, but we need to read to process control messages and to detect closed
+	// connections
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, readErr := conn.NextReader(); readErr != nil {
+				return
+			}
+		}
+	}()
+
+	pingTicker := time.NewTicker(inclusionPingInterval)
+	defer pingTicker.Stop()
+
+	lastGoF := gof.None
+	sent := false
+	sendUpdate := func() (final bool, err error) {
+		var metadata *jsonmodels.TransactionMetadata
+		deps.Tangle.LedgerState.TransactionMetadata(transactionID).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+			metadata = jsonmodels.NewTransactionMetadata(transactionMetadata)
+		})
+		// the transaction is not known yet or the grade of finality did not change
+		if metadata == nil || (sent && metadata.GradeOfFinality == lastGoF) {
+			return false, nil
+		}
+
+		if err = conn.SetWriteDeadline(time.Now().Add(inclusionWriteTimeout)); err != nil {
+			return false, err
+		}
+		if err = conn.WriteJSON(metadata); err != nil {
+			return false, err
+		}
+		lastGoF, sent = metadata.GradeOfFinality, true
+
+		return lastGoF == gof.High, nil
+	}
+
+	for {
+		final, sendErr := sendUpdate()
+		if sendErr != nil {
+			return nil
+		}
+		if final {
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "transaction reached the highest grade of finality"), time.Now().Add(inclusionWriteTimeout))
+			return nil
+		}
+
+		select {
+		case <-subscription.updated:
+		case <-pingTicker.C:
+			if pingErr := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(inclusionWriteTimeout)); pingErr != nil {
+				return nil
+			}
+		case <-closed:
+			return nil
+		case <-inclusionSubscriptions.shutdown:
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "node is shutting down"), time.Now().Add(inclusionWriteTimeout))
+			return nil
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region inclusionSubscriptionManager /////////////////////////////////////////////////////////////////////////////////
+
+// inclusionSubscriptionManager manages the subscribers of the grade of finality changes of transactions.
+type inclusionSubscriptionManager struct {
+	subscriptions map[ledgerstate.TransactionID]map[*inclusionSubscription]struct{}
+	shutdown      chan struct{}
+	shutdownOnce  sync.Once
+	mutex         sync.RWMutex
+}
+
+// inclusionSubscription is a single subscription to the grade of finality changes of a transaction.
+type inclusionSubscription struct {
+	transactionID ledgerstate.TransactionID
+	updated       chan struct{}
+}
+
+// newInclusionSubscriptionManager creates an empty set of subscriptions.
+func newInclusionSubscriptionManager() *inclusionSubscriptionManager {
+	return &inclusionSubscriptionManager{
+		subscriptions: make(map[ledgerstate.TransactionID]map[*inclusionSubscription]struct{}),
+		shutdown:      make(chan struct{}),
+	}
+}
+
+// Subscribe creates a subscription to the grade of finality changes of the given transaction.
+func (i *inclusionSubscriptionManager) Subscribe(transactionID ledgerstate.TransactionID) *inclusionSubscription {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	subscription := &inclusionSubscription{
+		transactionID: transactionID,
+		updated:       make(chan struct{}, 1),
+	}
+	if _, exists := i.subscriptions[transactionID]; !exists {
+		i.subscriptions[transactionID] = make(map[*inclusionSubscription]struct{})
+	}
+	i.subscriptions[transactionID][subscription] = struct{}{}
+
+	return subscription
+}
+
+// Unsubscribe removes the given subscription.
+func (i *inclusionSubscriptionManager) Unsubscribe(subscription *inclusionSubscription) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	delete(i.subscriptions[subscription.transactionID], subscription)
+	if len(i.subscriptions[subscription.transactionID]) == 0 {
+		delete(i.subscriptions, subscription.transactionID)
+	}
+}
+
+// Notify notifies the subscribers of the given transaction about a change. It never blocks, since the subscribers read
+// the latest state of the transaction anyway.
+func (i *inclusionSubscriptionManager) Notify(transactionID ledgerstate.TransactionID) {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	for subscription := range i.subscriptions[transactionID] {
+		select {
+		case subscription.updated <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Shutdown closes all subscriptions.
+func (i *inclusionSubscriptionManager) Shutdown() {
+	i.shutdownOnce.Do(func() {
+		close(i.shutdown)
+	})
+}
+
+// Size returns the number of transactions that have subscribers.
+func (i *inclusionSubscriptionManager) Size() int {
+	i.mutex.RLock()
+	defer i.mutex.RUnlock()
+
+	return len(i.subscriptions)
+}
+
+// configureInclusionSubscriptions attaches the subscriptions to the grade of finality changes of the tangle.
+func configureInclusionSubscriptions() {
+	onTransactionGoFChanged = event.NewClosure(func(event *tangle.TransactionGoFChangedEvent) {
+		inclusionSubscriptions.Notify(event.TransactionID)
+	})
+	deps.Tangle.ConfirmationOracle.Events().TransactionGoFChanged.Attach(onTransactionGoFChanged)
+}
+
+// shutdownInclusionSubscriptions detaches the subscriptions and closes all open connections.
+func shutdownInclusionSubscriptions() {
+	deps.Tangle.ConfirmationOracle.Events().TransactionGoFChanged.Detach(onTransactionGoFChanged)
+	inclusionSubscriptions.Shutdown()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,37 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestInclusionSubscriptionManager(t *testing.T) {
+	manager := newInclusionSubscriptionManager()
+
+	transactionID := ledgerstate.TransactionID{1}
+	subscription1 := manager.Subscribe(transactionID)
+	subscription2 := manager.Subscribe(transactionID)
+	otherSubscription := manager.Subscribe(ledgerstate.TransactionID{2})
+	assert.Equal(t, 2, manager.Size())
+
+	// notifications never block and are coalesced
+	manager.Notify(transactionID)
+	manager.Notify(transactionID)
+	assert.Len(t, subscription1.updated, 1)
+	assert.Len(t, subscription2.updated, 1)
+	assert.Len(t, otherSubscription.updated, 0)
+
+	manager.Unsubscribe(subscription1)
+	manager.Unsubscribe(subscription2)
+	assert.Equal(t, 1, manager.Size())
+	manager.Unsubscribe(otherSubscription)
+	assert.Equal(t, 0, manager.Size())
+
+	manager.Shutdown()
+	manager.Shutdown()
+	_, open := <-manager.shutdown
+	assert.False(t, open)
+}
@@ -0,0 +1,103 @@
+package ledgerstate
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+)
+
+// batchOutputsRoute is the route of the PostOutputsBatch endpoint. The router treats the colon as the start of a path
+// parameter, so the handler checks that the parameter matches the suffix of the route.
+const (
+	batchOutputsRoute  = "ledgerstate/outputs:batch"
+	batchOutputsSuffix = ":batch"
+)
+
+// region PostOutputsBatch /////////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOutputsBatch is the handler for the /ledgerstate/outputs:batch endpoint. It returns the outputs with the given
+// IDs together with their metadata and the inclusion state of their branches in the order of the request. The outputs
+// are loaded in parallel, so that wallets can sync many outputs with a single request.
+func PostOutputsBatch(c echo.Context) error {
+	if c.Param("batch") != batchOutputsSuffix {
+		return echo.ErrNotFound
+	}
+
+	var request jsonmodels.PostOutputsBatchRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.PostOutputsBatchResponse{Error: err.Error()})
+	}
+	if len(request.OutputIDs) > webapi.Parameters.OutputsBatch.MaxOutputs {
+		return c.JSON(http.StatusBadRequest, jsonmodels.PostOutputsBatchResponse{Error: errors.Errorf("at most %d outputs can be requested at once", webapi.Parameters.OutputsBatch.MaxOutputs).Error()})
+	}
+
+	outputIDs := make([]ledgerstate.OutputID, len(request.OutputIDs))
+	for i, base58EncodedOutputID := range request.OutputIDs {
+		outputID, err := ledgerstate.OutputIDFromBase58(base58EncodedOutputID)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.PostOutputsBatchResponse{Error: errors.Errorf("invalid output ID %s: %w", base58EncodedOutputID, err).Error()})
+		}
+		outputIDs[i] = outputID
+	}
+
+	outputs := make([]*jsonmodels.BatchOutput, len(outputIDs))
+	forEachInParallel(len(outputIDs), webapi.Parameters.OutputsBatch.Workers, func(i int) {
+		outputs[i] = batchOutput(outputIDs[i])
+	})
+
+	return c.JSON(http.StatusOK, jsonmodels.PostOutputsBatchResponse{Outputs: outputs})
+}
+
+// batchOutput loads the output with the given ID together with its metadata and the inclusion state of its branches.
+func batchOutput(outputID ledgerstate.OutputID) (batchOutput *jsonmodels.BatchOutput) {
+	batchOutput = &jsonmodels.BatchOutput{OutputID: outputID.Base58()}
+	if !deps.Tangle.LedgerState.CachedOutput(outputID).Consume(func(output ledgerstate.Output) {
+		batchOutput.Output = jsonmodels.NewOutput(output)
+	}) {
+		batchOutput.Error = errors.Errorf("failed to load Output with %s", outputID).Error()
+		return batchOutput
+	}
+
+	if !deps.Tangle.LedgerState.CachedOutputMetadata(outputID).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+		batchOutput.Metadata = jsonmodels.NewOutputMetadata(outputMetadata, deps.Tangle.LedgerState.ConfirmedConsumer(outputID))
+		batchOutput.Metadata.Reservation = jsonOutputReservation(outputID)
+		batchOutput.InclusionState = deps.Tangle.LedgerState.BranchDAG.InclusionState(outputMetadata.BranchIDs()).String()
+	}) {
+		batchOutput.Error = errors.Errorf("failed to load OutputMetadata with %s", outputID).Error()
+	}
+
+	return batchOutput
+}
+
+// forEachInParallel calls the given function for every index in [0, count) using at most the given number of workers.
+func forEachInParallel(count, workers int, callback func(i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers && w < count; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				callback(i)
+			}
+		}()
+	}
+
+	for i := 0; i < count; i++ {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
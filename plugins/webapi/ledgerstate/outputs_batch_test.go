@@ -0,0 +1,28 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+)
+
+func TestForEachInParallel(t *testing.T) {
+	for _, workers := range []int{0, 1, 8, 200} {
+		results := make([]int, 100)
+		calls := atomic.NewInt32(0)
+		forEachInParallel(len(results), workers, func(i int) {
+			results[i] = i * i
+			calls.Inc()
+		})
+
+		assert.EqualValues(t, len(results), calls.Load())
+		for i, result := range results {
+			assert.Equal(t, i*i, result)
+		}
+	}
+
+	forEachInParallel(0, 8, func(int) {
+		assert.Fail(t, "callback called without indexes")
+	})
+}
@@ -0,0 +1,96 @@
+package ledgerstate
+
+import (
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// maxOverlayTransactions defines how many hypothetical transactions can be applied by a single request.
+const maxOverlayTransactions = 100
+
+// region PostOverlay //////////////////////////////////////////////////////////////////////////////////////////////////
+
+// PostOverlay is the handler for the /ledgerstate/overlay endpoint. It applies the given hypothetical transactions to
+// a temporary overlay of the ledger state and returns the resulting unspent outputs of the given addresses, without
+// persisting or issuing anything.
+func PostOverlay(c echo.Context) error {
+	req := new(jsonmodels.PostOverlayRequest)
+	if err := c.Bind(req); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	if len(req.TransactionsBytes) > maxOverlayTransactions {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("at most %d transactions can be applied, got %d", maxOverlayTransactions, len(req.TransactionsBytes))))
+	}
+
+	addresses := make([]ledgerstate.Address, len(req.Addresses))
+	for i, addressString := range req.Addresses {
+		var err error
+		if addresses[i], err = ledgerstate.AddressFromBase58EncodedString(addressString); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+	}
+
+	return c.JSON(http.StatusOK, simulateTransactions(deps.Tangle.LedgerState, req.TransactionsBytes, addresses))
+}
+
+// simulateTransactions applies the given transactions to a new overlay of the given ledger state and collects the
+// resulting unspent outputs of the given addresses. Invalid transactions are reported and skipped.
+func simulateTransactions(ledgerState *tangle.LedgerState, transactionsBytes [][]byte, addresses []ledgerstate.Address) (res *jsonmodels.PostOverlayResponse) {
+	res = &jsonmodels.PostOverlayResponse{
+		Transactions: make([]*jsonmodels.OverlayTransaction, len(transactionsBytes)),
+		Addresses:    make([]*jsonmodels.OverlayAddress, len(addresses)),
+	}
+
+	overlay := ledgerstate.NewOverlay(ledgerState.Ledgerstate)
+	for i, transactionBytes := range transactionsBytes {
+		res.Transactions[i] = applyOverlayTransaction(overlay, transactionBytes)
+	}
+
+	for i, address := range addresses {
+		overlayAddress := &jsonmodels.OverlayAddress{
+			Address:        jsonmodels.NewAddress(address),
+			UnspentOutputs: make([]*jsonmodels.Output, 0),
+			Balances:       make(map[string]uint64),
+		}
+		for _, output := range overlay.UnspentOutputs(address) {
+			overlayAddress.UnspentOutputs = append(overlayAddress.UnspentOutputs, jsonmodels.NewOutput(output))
+			output.Balances().ForEach(func(color ledgerstate.Color, balance uint64) bool {
+				overlayAddress.Balances[color.Base58()] += balance
+				return true
+			})
+		}
+		res.Addresses[i] = overlayAddress
+	}
+
+	return res
+}
+
+// applyOverlayTransaction parses the given transaction and applies it to the overlay.
+func applyOverlayTransaction(overlay *ledgerstate.Overlay, transactionBytes []byte) (result *jsonmodels.OverlayTransaction) {
+	result = &jsonmodels.OverlayTransaction{}
+
+	transaction, err := new(ledgerstate.Transaction).FromBytes(transactionBytes)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.TransactionID = transaction.ID().Base58()
+
+	conflicts, err := overlay.ApplyTransaction(transaction)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.Applied = true
+	result.Conflicts = conflicts.Base58s()
+
+	return result
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,49 @@
+package ledgerstate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestSimulateTransactions(t *testing.T) {
+	testTangle := tangle.NewTestTangle()
+	defer testTangle.Shutdown()
+
+	testFramework := tangle.NewMessageTestFramework(testTangle, tangle.WithGenesisOutput("G", 3))
+	testTangle.Setup()
+
+	testFramework.CreateMessage("Valid", tangle.WithStrongParents("Genesis"), tangle.WithInputs("G"), tangle.WithOutput("A", 3))
+	testFramework.CreateMessage("Unsolid", tangle.WithStrongParents("Valid"), tangle.WithInputs("A"), tangle.WithOutput("B", 3))
+
+	valid := testFramework.Message("Valid").Payload().(*ledgerstate.Transaction)
+	unsolid := testFramework.Message("Unsolid").Payload().(*ledgerstate.Transaction)
+	addressB := unsolid.Essence().Outputs()[0].Address()
+
+	// the second transaction spends the output of the first one, which only exists in the overlay
+	res := simulateTransactions(testTangle.LedgerState, [][]byte{valid.Bytes(), unsolid.Bytes(), unsolid.Bytes(), {1, 2, 3}}, []ledgerstate.Address{addressB})
+	require.Len(t, res.Transactions, 4)
+	for _, transaction := range res.Transactions[:2] {
+		assert.True(t, transaction.Applied, transaction.Error)
+		assert.Empty(t, transaction.Conflicts)
+	}
+	assert.False(t, res.Transactions[2].Applied)
+	assert.Contains(t, res.Transactions[2].Error, ledgerstate.ErrTransactionExists.Error())
+	assert.False(t, res.Transactions[3].Applied)
+	assert.Empty(t, res.Transactions[3].TransactionID)
+	assert.NotEmpty(t, res.Transactions[3].Error)
+
+	require.Len(t, res.Addresses, 1)
+	assert.Equal(t, addressB.Base58(), res.Addresses[0].Address.Base58)
+	assert.Len(t, res.Addresses[0].UnspentOutputs, 1)
+	assert.Equal(t, map[string]uint64{ledgerstate.ColorIOTA.Base58(): 3}, res.Addresses[0].Balances)
+
+	// nothing was persisted
+	assert.False(t, testTangle.LedgerState.Ledgerstate.CachedTransaction(unsolid.ID()).Consume(func(*ledgerstate.Transaction) {}))
+	res = simulateTransactions(testTangle.LedgerState, nil, []ledgerstate.Address{addressB})
+	assert.Empty(t, res.Addresses[0].UnspentOutputs)
+}
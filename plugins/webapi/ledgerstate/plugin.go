@@ -9,19 +9,20 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/daemon"
-	"github.com/iotaledger/hive.go/events"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
-	"github.com/iotaledger/goshimmer/packages/mana"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/webapiproto"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
 )
 
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -51,8 +52,11 @@ var (
 	// doubleSpendFilterOnce ensures that doubleSpendFilter is a singleton.
 	doubleSpendFilterOnce sync.Once
 
+	// supplyTracker keeps track of the supply statistics of the confirmed ledger state.
+	supplyTracker *SupplyTracker
+
 	// closure to be executed on transaction confirmation.
-	onTransactionConfirmed *events.Closure
+	onTransactionConfirmed *event.Closure[ledgerstate.TransactionID]
 
 	log *logger.Logger
 )
@@ -71,10 +75,14 @@ func Filter() *DoubleSpendFilter {
 
 func configure(_ *node.Plugin) {
 	doubleSpendFilter = Filter()
-	onTransactionConfirmed = events.NewClosure(func(transactionID ledgerstate.TransactionID) {
+	supplyTracker = NewSupplyTracker()
+	loadSupply()
+	onTransactionConfirmed = event.NewClosure(func(transactionID ledgerstate.TransactionID) {
 		doubleSpendFilter.Remove(transactionID)
+		deps.Tangle.LedgerState.Transaction(transactionID).Consume(supplyTracker.ApplyTransaction)
 	})
 	deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Attach(onTransactionConfirmed)
+	configureInclusionSubscriptions()
 	log = logger.NewLogger(PluginName)
 }
 
@@ -86,20 +94,49 @@ func run(*node.Plugin) {
 	// register endpoints
 	deps.Server.GET("ledgerstate/addresses/:address", GetAddress)
 	deps.Server.GET("ledgerstate/addresses/:address/unspentOutputs", GetAddressUnspentOutputs)
+	deps.Server.GET("ledgerstate/addresses/:address/balance", GetAddressBalance)
 	deps.Server.POST("ledgerstate/addresses/unspentOutputs", PostAddressUnspentOutputs)
+	deps.Server.POST("ledgerstate/addresses/balanceProof", PostAddressesBalanceProof)
 	deps.Server.GET("ledgerstate/branches/:branchID", GetBranch)
 	deps.Server.GET("ledgerstate/branches/:branchID/children", GetBranchChildren)
 	deps.Server.GET("ledgerstate/branches/:branchID/conflicts", GetBranchConflicts)
 	deps.Server.GET("ledgerstate/branches/:branchID/voters", GetBranchVoters)
 	deps.Server.GET("ledgerstate/branches/:branchID/sequenceids", GetBranchSequenceIDs)
+	deps.Server.GET("ledgerstate/supply", GetSupply)
+	deps.Server.POST("ledgerstate/overlay", PostOverlay)
+	deps.Server.POST(batchOutputsRoute, PostOutputsBatch)
+	deps.Server.GET("ledgerstate/outputs/:outputID", GetOutput)
 	deps.Server.GET("ledgerstate/outputs/:outputID/consumers", GetOutputConsumers)
 	deps.Server.GET("ledgerstate/outputs/:outputID/metadata", GetOutputMetadata)
+	deps.Server.POST("ledgerstate/outputs/:outputID/reservation", ReserveOutput)
+	deps.Server.DELETE("ledgerstate/outputs/:outputID/reservation", ReleaseOutputReservation)
 	deps.Server.GET("ledgerstate/transactions/:transactionID", GetTransaction)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/metadata", GetTransactionMetadata)
 	deps.Server.GET("ledgerstate/transactions/:transactionID/attachments", GetTransactionAttachments)
+	deps.Server.GET("ledgerstate/transactions/:transactionID/inclusion/subscribe", SubscribeTransactionInclusion)
 	deps.Server.POST("ledgerstate/transactions", PostTransaction)
 }
 
+// loadSupply initializes the SupplyTracker with the unspent outputs of the confirmed ledger state. It is executed
+// before the node processes any messages, so that the SupplyTracker can be updated incrementally afterwards.
+func loadSupply() {
+	deps.Tangle.LedgerState.UTXODAG.ForEachTransaction(func(transaction *ledgerstate.Transaction) bool {
+		if !deps.Tangle.ConfirmationOracle.IsTransactionConfirmed(transaction.ID()) {
+			return true
+		}
+
+		for _, output := range transaction.Essence().Outputs() {
+			deps.Tangle.LedgerState.CachedOutput(output.ID()).Consume(func(output ledgerstate.Output) {
+				if deps.Tangle.LedgerState.ConfirmedConsumer(output.ID()) == ledgerstate.GenesisTransactionID {
+					supplyTracker.AddOutput(output)
+				}
+			})
+		}
+
+		return true
+	})
+}
+
 func worker(ctx context.Context) {
 	defer log.Infof("Stopping %s ... done", PluginName)
 	func() {
@@ -111,11 +148,13 @@ func worker(ctx context.Context) {
 				return
 			case <-ticker.C:
 				doubleSpendFilter.CleanUp()
+				outputReservations.CleanUp()
 			}
 		}
 	}()
 	log.Infof("Stopping %s ...", PluginName)
 	deps.Tangle.ConfirmationOracle.Events().TransactionConfirmed.Detach(onTransactionConfirmed)
+	shutdownInclusionSubscriptions()
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -165,7 +204,13 @@ func GetAddressUnspentOutputs(c echo.Context) error {
 // PostAddressUnspentOutputs is the handler for the /ledgerstate/addresses/unspentOutputs endpoint.
 func PostAddressUnspentOutputs(c echo.Context) error {
 	req := new(jsonmodels.PostAddressesUnspentOutputsRequest)
-	if err := c.Bind(&req); err != nil {
+	if webapi.IsProtobufRequest(c) {
+		protobufRequest := new(webapiproto.PostAddressesUnspentOutputsRequest)
+		if err := webapi.BindProtobuf(c, protobufRequest); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+		req.Addresses = protobufRequest.Addresses
+	} else if err := c.Bind(&req); err != nil {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	addresses := make([]ledgerstate.Address, len(req.Addresses))
@@ -177,46 +222,75 @@ func PostAddressUnspentOutputs(c echo.Context) error {
 		}
 	}
 
+	if webapi.AcceptsProtobuf(c) {
+		res := &webapiproto.PostAddressesUnspentOutputsResponse{
+			UnspentOutputs: make([]*webapiproto.WalletOutputsOnAddress, len(addresses)),
+		}
+		for i, addy := range addresses {
+			outputsOnAddress := &webapiproto.WalletOutputsOnAddress{Address: addy.Bytes()}
+			forEachWalletOutput(addy, func(output ledgerstate.Output, outputMetadata *ledgerstate.OutputMetadata, timestamp time.Time) {
+				outputsOnAddress.Outputs = append(outputsOnAddress.Outputs, &webapiproto.WalletOutput{
+					Output:          &webapiproto.Output{OutputID: output.ID().Bytes(), Bytes: output.Bytes()},
+					GradeOfFinality: uint32(outputMetadata.GradeOfFinality()),
+					Timestamp:       timestamp.UnixNano(),
+					Conflicting:     !outputMetadata.BranchIDs().Contains(ledgerstate.MasterBranchID),
+				})
+			})
+			res.UnspentOutputs[i] = outputsOnAddress
+		}
+
+		return webapi.Protobuf(c, http.StatusOK, res)
+	}
+
 	res := &jsonmodels.PostAddressesUnspentOutputsResponse{
 		UnspentOutputs: make([]*jsonmodels.WalletOutputsOnAddress, len(addresses)),
 	}
 	for i, addy := range addresses {
-		res.UnspentOutputs[i] = new(jsonmodels.WalletOutputsOnAddress)
-		cachedOutputs := deps.Tangle.LedgerState.CachedOutputsOnAddress(addy)
-		res.UnspentOutputs[i].Address = jsonmodels.Address{
-			Type:   addy.Type().String(),
-			Base58: addy.Base58(),
+		outputsOnAddress := &jsonmodels.WalletOutputsOnAddress{
+			Address: jsonmodels.Address{
+				Type:   addy.Type().String(),
+				Base58: addy.Base58(),
+			},
+			Outputs: make([]jsonmodels.WalletOutput, 0),
 		}
-		res.UnspentOutputs[i].Outputs = make([]jsonmodels.WalletOutput, 0)
-
-		for _, output := range ledgerstate.Outputs(cachedOutputs.Unwrap()).Filter(func(output ledgerstate.Output) (isUnspent bool) {
-			deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
-				isUnspent = outputMetadata.ConsumerCount() == 0
-			})
-			return
-		}) {
-			cachedOutputMetadata := deps.Tangle.LedgerState.CachedOutputMetadata(output.ID())
-			cachedOutputMetadata.Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
-				if outputMetadata.ConsumerCount() == 0 {
-					cachedTx := deps.Tangle.LedgerState.Transaction(output.ID().TransactionID())
-					var timestamp time.Time
-					cachedTx.Consume(func(tx *ledgerstate.Transaction) {
-						timestamp = tx.Essence().Timestamp()
-					})
-					res.UnspentOutputs[i].Outputs = append(res.UnspentOutputs[i].Outputs, jsonmodels.WalletOutput{
-						Output:          *jsonmodels.NewOutput(output),
-						GradeOfFinality: outputMetadata.GradeOfFinality(),
-						Metadata:        jsonmodels.WalletOutputMetadata{Timestamp: timestamp},
-					})
-				}
+		forEachWalletOutput(addy, func(output ledgerstate.Output, outputMetadata *ledgerstate.OutputMetadata, timestamp time.Time) {
+			outputsOnAddress.Outputs = append(outputsOnAddress.Outputs, jsonmodels.WalletOutput{
+				Output:          *jsonmodels.NewOutput(output),
+				GradeOfFinality: outputMetadata.GradeOfFinality(),
+				Metadata: jsonmodels.WalletOutputMetadata{
+					Timestamp:   timestamp,
+					Conflicting: !outputMetadata.BranchIDs().Contains(ledgerstate.MasterBranchID),
+					Reservation: jsonOutputReservation(output.ID()),
+				},
 			})
-		}
-		cachedOutputs.Release()
+		})
+		res.UnspentOutputs[i] = outputsOnAddress
 	}
 
 	return c.JSON(http.StatusOK, res)
 }
 
+// forEachWalletOutput calls the callback for every unspent output of the given address together with its metadata and
+// the timestamp of the transaction that created it.
+func forEachWalletOutput(address ledgerstate.Address, callback func(output ledgerstate.Output, outputMetadata *ledgerstate.OutputMetadata, timestamp time.Time)) {
+	cachedOutputs := deps.Tangle.LedgerState.CachedOutputsOnAddress(address)
+	defer cachedOutputs.Release()
+
+	for _, output := range cachedOutputs.Unwrap() {
+		deps.Tangle.LedgerState.CachedOutputMetadata(output.ID()).Consume(func(outputMetadata *ledgerstate.OutputMetadata) {
+			if outputMetadata.ConsumerCount() != 0 {
+				return
+			}
+
+			var timestamp time.Time
+			deps.Tangle.LedgerState.Transaction(output.ID().TransactionID()).Consume(func(tx *ledgerstate.Transaction) {
+				timestamp = tx.Essence().Timestamp()
+			})
+			callback(output, outputMetadata, timestamp)
+		})
+	}
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region GetBranch ////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -335,6 +409,11 @@ func GetOutput(c echo.Context) (err error) {
 	}
 
 	if !deps.Tangle.LedgerState.CachedOutput(outputID).Consume(func(output ledgerstate.Output) {
+		if webapi.AcceptsProtobuf(c) {
+			err = webapi.Protobuf(c, http.StatusOK, &webapiproto.Output{OutputID: output.ID().Bytes(), Bytes: output.Bytes()})
+			return
+		}
+
 		err = c.JSON(http.StatusOK, jsonmodels.NewOutput(output))
 	}) {
 		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load Output with %s", outputID)))
@@ -375,6 +454,8 @@ func GetOutputMetadata(c echo.Context) (err error) {
 		confirmedConsumerID := deps.Tangle.LedgerState.ConfirmedConsumer(outputID)
 
 		jsonOutputMetadata := jsonmodels.NewOutputMetadata(outputMetadata, confirmedConsumerID)
+		jsonOutputMetadata.Consumers = outputConsumers(outputID)
+		jsonOutputMetadata.Reservation = jsonOutputReservation(outputID)
 
 		err = c.JSON(http.StatusOK, jsonOutputMetadata)
 	}) {
@@ -383,6 +464,22 @@ func GetOutputMetadata(c echo.Context) (err error) {
 	return
 }
 
+// outputConsumers returns all known consumers of the given output together with the state of the consuming
+// transactions.
+func outputConsumers(outputID ledgerstate.OutputID) (consumers []*jsonmodels.OutputConsumer) {
+	consumers = make([]*jsonmodels.OutputConsumer, 0)
+	deps.Tangle.LedgerState.Consumers(outputID).Consume(func(consumer *ledgerstate.Consumer) {
+		deps.Tangle.LedgerState.Transaction(consumer.TransactionID()).Consume(func(transaction *ledgerstate.Transaction) {
+			deps.Tangle.LedgerState.TransactionMetadata(consumer.TransactionID()).Consume(func(transactionMetadata *ledgerstate.TransactionMetadata) {
+				inclusionState := deps.Tangle.LedgerState.BranchDAG.InclusionState(transactionMetadata.BranchIDs())
+				consumers = append(consumers, jsonmodels.NewOutputConsumer(consumer, transaction, transactionMetadata, inclusionState))
+			})
+		})
+	})
+
+	return consumers
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region GetTransaction ///////////////////////////////////////////////////////////////////////////////////////////////
@@ -436,14 +533,26 @@ func GetTransactionAttachments(c echo.Context) (err error) {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
-	messageIDs := tangle.NewMessageIDs()
+	attachments := make([]*jsonmodels.TransactionAttachment, 0)
 	if !deps.Tangle.Storage.Attachments(transactionID).Consume(func(attachment *tangle.Attachment) {
-		messageIDs.Add(attachment.MessageID())
+		deps.Tangle.Storage.Message(attachment.MessageID()).Consume(func(message *tangle.Message) {
+			deps.Tangle.Storage.MessageMetadata(attachment.MessageID()).Consume(func(messageMetadata *tangle.MessageMetadata) {
+				branchIDs, err := deps.Tangle.Booker.MessageBranchIDs(attachment.MessageID())
+				if err != nil {
+					branchIDs = ledgerstate.NewBranchIDs()
+				}
+
+				attachments = append(attachments, jsonmodels.NewTransactionAttachment(message, messageMetadata, branchIDs))
+			})
+		})
 	}) {
 		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load GetTransactionAttachmentsResponse of Transaction with %s", transactionID)))
 	}
 
-	return c.JSON(http.StatusOK, jsonmodels.NewGetTransactionAttachmentsResponse(transactionID, messageIDs))
+	bestAttachmentID, _ := deps.Tangle.Utils.BestAttachment(transactionID)
+	canonicalAttachmentID, _ := deps.Tangle.Utils.CanonicalAttachment(transactionID)
+
+	return c.JSON(http.StatusOK, jsonmodels.NewGetTransactionAttachmentsResponse(transactionID, attachments, bestAttachmentID, canonicalAttachmentID))
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -471,61 +580,48 @@ func branchIDFromContext(c echo.Context) (branchID ledgerstate.BranchID, err err
 const maxBookedAwaitTime = 5 * time.Second
 
 // ErrNotAllowedToPledgeManaToNode defines an unsupported node to pledge mana to.
-var ErrNotAllowedToPledgeManaToNode = errors.New("not allowed to pledge mana to node")
+var ErrNotAllowedToPledgeManaToNode = messagelayer.ErrNotAllowedToPledgeManaToNode
 
-// PostTransaction sends a transaction.
+// PostTransaction sends a transaction. If the dryRun query parameter is set, the transaction is only validated and a
+// diagnosis of all checks is returned instead.
 func PostTransaction(c echo.Context) error {
 	var request jsonmodels.PostTransactionRequest
 	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if IsDryRun(c) {
+		return c.JSON(http.StatusOK, dryRunPostTransaction(request.TransactionBytes))
 	}
 
 	// parse tx
 	tx, err := new(ledgerstate.Transaction).FromBytes(request.TransactionBytes)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	// check if it would introduce a double spend known to the node locally
-	has, conflictingID := doubleSpendFilter.HasConflict(tx.Essence().Inputs())
-	if has {
-		err = errors.Errorf("transaction is conflicting with previously submitted transaction %s", conflictingID.Base58())
-		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: err.Error()})
+	if err = checkDoubleSpends(tx); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	// validate allowed mana pledge nodes.
-	allowedAccessMana := messagelayer.GetAllowedPledgeNodes(mana.AccessMana)
-	if allowedAccessMana.IsFilterEnabled {
-		if !allowedAccessMana.Allowed.Has(tx.Essence().AccessPledgeID()) {
-			return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{
-				Error: fmt.Errorf("not allowed to pledge access mana to %s: %w", tx.Essence().AccessPledgeID().String(), ErrNotAllowedToPledgeManaToNode).Error(),
-			})
-		}
-	}
-	allowedConsensusMana := messagelayer.GetAllowedPledgeNodes(mana.ConsensusMana)
-	if allowedConsensusMana.IsFilterEnabled {
-		if !allowedConsensusMana.Allowed.Has(tx.Essence().ConsensusPledgeID()) {
-			return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{
-				Error: fmt.Errorf("not allowed to pledge consensus mana to %s: %w", tx.Essence().ConsensusPledgeID().String(), ErrNotAllowedToPledgeManaToNode).Error(),
-			})
-		}
+	if err = messagelayer.CheckTransactionManaPledges(tx); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	// check transaction validity
 	if transactionErr := deps.Tangle.LedgerState.CheckTransaction(tx); transactionErr != nil {
-		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: transactionErr.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(transactionErr))
 	}
 
-	// check if transaction is too old
-	if tx.Essence().Timestamp().Before(clock.SyncedTime().Add(-tangle.MaxReattachmentTimeMin)) {
-		return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: fmt.Sprintf("transaction timestamp is older than MaxReattachmentTime (%s) and cannot be issued", tangle.MaxReattachmentTimeMin)})
+	// check if transaction is too old or too far in the future
+	if err = checkTransactionTimestamp(tx); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	// if transaction is in the future we wait until the time arrives
 	if tx.Essence().Timestamp().After(clock.SyncedTime()) {
-		if tx.Essence().Timestamp().Sub(clock.SyncedTime()) > time.Minute {
-			return c.JSON(http.StatusBadRequest, &jsonmodels.PostTransactionResponse{Error: "transaction timestamp is in the future and cannot be issued; please readjust local clock"})
-		}
 		time.Sleep(tx.Essence().Timestamp().Sub(clock.SyncedTime()) + 1*time.Nanosecond)
 	}
 
@@ -538,8 +634,10 @@ func PostTransaction(c echo.Context) error {
 	if _, err := messagelayer.AwaitMessageToBeBooked(issueTransaction, tx.ID(), maxBookedAwaitTime); err != nil {
 		// if we failed to issue the transaction, we remove it
 		doubleSpendFilter.Remove(tx.ID())
-		return c.JSON(http.StatusBadRequest, jsonmodels.PostTransactionResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
+	outputReservations.ReleaseInputs(tx)
+
 	return c.JSON(http.StatusOK, &jsonmodels.PostTransactionResponse{TransactionID: tx.ID().Base58()})
 }
 
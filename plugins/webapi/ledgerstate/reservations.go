@@ -0,0 +1,190 @@
+package ledgerstate
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/clock"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+// MaxOutputReservationTTL defines the maximum time for which an output can be reserved at once.
+const MaxOutputReservationTTL = 10 * time.Minute
+
+var (
+	// outputReservations contains the advisory reservations of outputs by wallet instances.
+	outputReservations = NewOutputReservations()
+
+	// ErrOutputReserved is returned if an output is reserved by a different owner.
+	ErrOutputReserved = errors.New("output is reserved by a different owner")
+)
+
+// region OutputReservations ///////////////////////////////////////////////////////////////////////////////////////////
+
+// OutputReservation is an advisory claim of an output by a wallet instance that intends to spend it.
+type OutputReservation struct {
+	OutputID      ledgerstate.OutputID
+	Owner         string
+	ReservedUntil time.Time
+}
+
+// OutputReservations keeps track of the short-lived reservations of outputs, so that cooperating wallet instances that
+// share the same seed do not build conflicting transactions that spend the same outputs. The reservations are advisory,
+// i.e. they are not enforced when a transaction is issued.
+type OutputReservations struct {
+	reservations map[ledgerstate.OutputID]*OutputReservation
+	mutex        sync.RWMutex
+}
+
+// NewOutputReservations creates a new OutputReservations instance.
+func NewOutputReservations() *OutputReservations {
+	return &OutputReservations{
+		reservations: make(map[ledgerstate.OutputID]*OutputReservation),
+	}
+}
+
+// Reserve reserves the output for the given owner for the given time. The reservation of the same owner is renewed.
+// It returns the existing reservation and false if the output is reserved by a different owner.
+func (o *OutputReservations) Reserve(outputID ledgerstate.OutputID, owner string, ttl time.Duration) (reservation *OutputReservation, reserved bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	now := clock.SyncedTime()
+	if existing, exists := o.reservations[outputID]; exists && existing.Owner != owner && existing.ReservedUntil.After(now) {
+		return existing, false
+	}
+
+	reservation = &OutputReservation{
+		OutputID:      outputID,
+		Owner:         owner,
+		ReservedUntil: now.Add(ttl),
+	}
+	o.reservations[outputID] = reservation
+
+	return reservation, true
+}
+
+// Release removes the reservation of the output if it is held by the given owner. It returns true if the reservation
+// was removed.
+func (o *OutputReservations) Release(outputID ledgerstate.OutputID, owner string) (released bool) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	existing, exists := o.reservations[outputID]
+	if !exists || existing.Owner != owner {
+		return false
+	}
+	delete(o.reservations, outputID)
+
+	return existing.ReservedUntil.After(clock.SyncedTime())
+}
+
+// ReleaseInputs removes the reservations of the outputs that are spent by the given transaction.
+func (o *OutputReservations) ReleaseInputs(tx *ledgerstate.Transaction) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	for _, input := range tx.Essence().Inputs() {
+		if input.Type() != ledgerstate.UTXOInputType {
+			continue
+		}
+		delete(o.reservations, input.(*ledgerstate.UTXOInput).ReferencedOutputID())
+	}
+}
+
+// Reservation returns the active reservation of the output.
+func (o *OutputReservations) Reservation(outputID ledgerstate.OutputID) (reservation *OutputReservation, exists bool) {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	if reservation, exists = o.reservations[outputID]; !exists || !reservation.ReservedUntil.After(clock.SyncedTime()) {
+		return nil, false
+	}
+
+	return reservation, true
+}
+
+// CleanUp removes the expired reservations.
+func (o *OutputReservations) CleanUp() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	now := clock.SyncedTime()
+	for outputID, reservation := range o.reservations {
+		if !reservation.ReservedUntil.After(now) {
+			delete(o.reservations, outputID)
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ReserveOutput ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// ReserveOutput is the handler for the POST ledgerstate/outputs/:outputID/reservation endpoint. It reserves the output
+// for the owner of the request, so that other wallet instances of the same seed do not select it as an input.
+func ReserveOutput(c echo.Context) error {
+	outputID, err := ledgerstate.OutputIDFromBase58(c.Param("outputID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	var request jsonmodels.ReserveOutputRequest
+	if err = c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	if request.Owner == "" {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("owner of the reservation is missing")))
+	}
+	ttl := time.Duration(request.TTL) * time.Second
+	if ttl <= 0 || ttl > MaxOutputReservationTTL {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("ttl of the reservation must be between 1s and %s", MaxOutputReservationTTL)))
+	}
+
+	if !deps.Tangle.LedgerState.CachedOutputMetadata(outputID).Consume(func(*ledgerstate.OutputMetadata) {}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("failed to load OutputMetadata with %s", outputID)))
+	}
+
+	reservation, reserved := outputReservations.Reserve(outputID, request.Owner, ttl)
+	if !reserved {
+		return c.JSON(http.StatusConflict, jsonmodels.NewErrorResponse(errors.Errorf("%w until %s", ErrOutputReserved, reservation.ReservedUntil.Format(time.RFC3339))))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewOutputReservation(reservation.OutputID, reservation.Owner, reservation.ReservedUntil))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region ReleaseOutputReservation /////////////////////////////////////////////////////////////////////////////////////
+
+// ReleaseOutputReservation is the handler for the DELETE ledgerstate/outputs/:outputID/reservation?owner=... endpoint.
+// It removes the reservation of the output if it is held by the given owner.
+func ReleaseOutputReservation(c echo.Context) error {
+	outputID, err := ledgerstate.OutputIDFromBase58(c.Param("outputID"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if !outputReservations.Release(outputID, c.QueryParam("owner")) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("output %s is not reserved by %s", outputID, c.QueryParam("owner"))))
+	}
+
+	return c.JSON(http.StatusOK, &jsonmodels.ReleaseOutputReservationResponse{Released: true})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// jsonOutputReservation returns the JSON model of the active reservation of the output (nil if it is not reserved).
+func jsonOutputReservation(outputID ledgerstate.OutputID) *jsonmodels.OutputReservation {
+	reservation, exists := outputReservations.Reservation(outputID)
+	if !exists {
+		return nil
+	}
+
+	return jsonmodels.NewOutputReservation(reservation.OutputID, reservation.Owner, reservation.ReservedUntil)
+}
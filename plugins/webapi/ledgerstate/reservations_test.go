@@ -0,0 +1,57 @@
+package ledgerstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestOutputReservations(t *testing.T) {
+	reservations := NewOutputReservations()
+	outputID := ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0)
+	otherOutputID := ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 1)
+
+	reservation, reserved := reservations.Reserve(outputID, "walletA", time.Minute)
+	require.True(t, reserved)
+	assert.Equal(t, "walletA", reservation.Owner)
+
+	// the output can not be reserved by a different owner, but the owner can renew the reservation
+	existing, reserved := reservations.Reserve(outputID, "walletB", time.Minute)
+	assert.False(t, reserved)
+	assert.Equal(t, reservation, existing)
+	renewed, reserved := reservations.Reserve(outputID, "walletA", 2*time.Minute)
+	require.True(t, reserved)
+	assert.True(t, renewed.ReservedUntil.After(reservation.ReservedUntil))
+
+	// only the owner can release the reservation
+	assert.False(t, reservations.Release(outputID, "walletB"))
+	assert.True(t, reservations.Release(outputID, "walletA"))
+	_, exists := reservations.Reservation(outputID)
+	assert.False(t, exists)
+
+	// expired reservations are not reported and can be taken over
+	_, reserved = reservations.Reserve(outputID, "walletA", time.Millisecond)
+	require.True(t, reserved)
+	time.Sleep(5 * time.Millisecond)
+	_, exists = reservations.Reservation(outputID)
+	assert.False(t, exists)
+	_, reserved = reservations.Reserve(outputID, "walletB", time.Minute)
+	assert.True(t, reserved)
+
+	// the reservations of the spent outputs are removed once a transaction was issued
+	_, reserved = reservations.Reserve(otherOutputID, "walletA", time.Millisecond)
+	require.True(t, reserved)
+	reservations.ReleaseInputs(newSupplyTestTransaction(outputID, ledgerstate.NewSigLockedSingleOutput(1, ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey))))
+	_, exists = reservations.Reservation(outputID)
+	assert.False(t, exists)
+
+	// expired reservations are removed by the clean up
+	time.Sleep(5 * time.Millisecond)
+	reservations.CleanUp()
+	assert.Empty(t, reservations.reservations)
+}
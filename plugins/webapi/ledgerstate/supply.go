@@ -0,0 +1,227 @@
+package ledgerstate
+
+import (
+	"container/heap"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	// defaultTopAddresses is the number of addresses in the balance distribution if the request doesn't specify it.
+	defaultTopAddresses = 10
+	// maxTopAddresses is the maximum number of addresses in the balance distribution.
+	maxTopAddresses = 100
+)
+
+// region GetSupply ////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetSupply is the handler for the /ledgerstate/supply endpoint. It returns the statistics of the confirmed ledger
+// state together with the addresses holding the largest balances.
+func GetSupply(c echo.Context) error {
+	top := defaultTopAddresses
+	if topParam := c.QueryParam("top"); topParam != "" {
+		parsedTop, err := strconv.Atoi(topParam)
+		if err != nil || parsedTop < 0 || parsedTop > maxTopAddresses {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("top must be a number between 0 and %d", maxTopAddresses)))
+		}
+		top = parsedTop
+	}
+
+	return c.JSON(http.StatusOK, supplyTracker.Supply(top))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region SupplyTracker ////////////////////////////////////////////////////////////////////////////////////////////////
+
+// SupplyTracker keeps track of the unspent outputs of the confirmed ledger state and the resulting balances of the
+// addresses. It is updated incrementally whenever a transaction is confirmed, so that the supply statistics don't
+// have to be recomputed from the ledger on every request.
+type SupplyTracker struct {
+	unspentOutputs  map[ledgerstate.OutputID]*trackedOutput
+	addressBalances map[string]uint64
+	totalSupply     uint64
+	dustOutputs     int
+	mutex           sync.RWMutex
+}
+
+// NewSupplyTracker creates a new empty SupplyTracker.
+func NewSupplyTracker() *SupplyTracker {
+	return &SupplyTracker{
+		unspentOutputs:  make(map[ledgerstate.OutputID]*trackedOutput),
+		addressBalances: make(map[string]uint64),
+	}
+}
+
+// AddOutput adds a confirmed unspent output to the SupplyTracker.
+func (s *SupplyTracker) AddOutput(output ledgerstate.Output) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.addOutput(output)
+}
+
+// ApplyTransaction updates the SupplyTracker with a confirmed transaction, i.e. it removes the outputs consumed by the
+// transaction and adds the outputs created by it.
+func (s *SupplyTracker) ApplyTransaction(transaction *ledgerstate.Transaction) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, input := range transaction.Essence().Inputs() {
+		if input.Type() != ledgerstate.UTXOInputType {
+			continue
+		}
+		s.removeOutput(input.(*ledgerstate.UTXOInput).ReferencedOutputID())
+	}
+
+	for _, output := range transaction.Essence().Outputs() {
+		s.addOutput(output)
+	}
+}
+
+// Supply returns the supply statistics of the confirmed ledger state with the given number of addresses holding the
+// largest balances.
+func (s *SupplyTracker) Supply(top int) *jsonmodels.GetSupplyResponse {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return &jsonmodels.GetSupplyResponse{
+		TotalSupply:    s.totalSupply,
+		UnspentOutputs: len(s.unspentOutputs),
+		DustOutputs:    s.dustOutputs,
+		DustThreshold:  ledgerstate.DustThresholdAliasOutputIOTA,
+		Addresses:      len(s.addressBalances),
+		TopAddresses:   s.topAddresses(top),
+	}
+}
+
+// addOutput adds the given output unless it is tracked already.
+func (s *SupplyTracker) addOutput(output ledgerstate.Output) {
+	if _, exists := s.unspentOutputs[output.ID()]; exists {
+		return
+	}
+
+	trackedOutput := &trackedOutput{
+		address: output.Address().Base58(),
+	}
+	output.Balances().ForEach(func(_ ledgerstate.Color, balance uint64) bool {
+		trackedOutput.balance += balance
+		return true
+	})
+
+	s.unspentOutputs[output.ID()] = trackedOutput
+	s.addressBalances[trackedOutput.address] += trackedOutput.balance
+	s.totalSupply += trackedOutput.balance
+	if trackedOutput.isDust() {
+		s.dustOutputs++
+	}
+}
+
+// removeOutput removes the output with the given ID if it is tracked.
+func (s *SupplyTracker) removeOutput(outputID ledgerstate.OutputID) {
+	trackedOutput, exists := s.unspentOutputs[outputID]
+	if !exists {
+		return
+	}
+	delete(s.unspentOutputs, outputID)
+
+	s.addressBalances[trackedOutput.address] -= trackedOutput.balance
+	if s.addressBalances[trackedOutput.address] == 0 {
+		delete(s.addressBalances, trackedOutput.address)
+	}
+	s.totalSupply -= trackedOutput.balance
+	if trackedOutput.isDust() {
+		s.dustOutputs--
+	}
+}
+
+// topAddresses returns the given number of addresses with the largest balances in descending order of their balance.
+func (s *SupplyTracker) topAddresses(top int) (topAddresses []*jsonmodels.AddressBalance) {
+	largestBalances := make(addressBalanceHeap, 0, top+1)
+	for address, balance := range s.addressBalances {
+		if top == 0 {
+			break
+		}
+
+		addressBalance := &jsonmodels.AddressBalance{Address: address, Balance: balance}
+		if len(largestBalances) < top {
+			heap.Push(&largestBalances, addressBalance)
+			continue
+		}
+		if lessBalance(largestBalances[0], addressBalance) {
+			largestBalances[0] = addressBalance
+			heap.Fix(&largestBalances, 0)
+		}
+	}
+
+	topAddresses = largestBalances
+	sort.Slice(topAddresses, func(i, j int) bool {
+		return lessBalance(topAddresses[j], topAddresses[i])
+	})
+
+	return topAddresses
+}
+
+// trackedOutput contains the information about an unspent output that is tracked by the SupplyTracker.
+type trackedOutput struct {
+	address string
+	balance uint64
+}
+
+// isDust returns true if the balance of the output is below the dust threshold.
+func (t *trackedOutput) isDust() bool {
+	return t.balance < ledgerstate.DustThresholdAliasOutputIOTA
+}
+
+// addressBalanceHeap is a min-heap of address balances that is used to determine the largest balances.
+type addressBalanceHeap []*jsonmodels.AddressBalance
+
+// Len is the number of elements in the collection.
+func (h addressBalanceHeap) Len() int {
+	return len(h)
+}
+
+// Less reports whether the element with index i should sort before the element with index j.
+func (h addressBalanceHeap) Less(i, j int) bool {
+	return lessBalance(h[i], h[j])
+}
+
+// Swap swaps the elements with indexes i and j.
+func (h addressBalanceHeap) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+// Push adds x as the last element to the heap.
+func (h *addressBalanceHeap) Push(x interface{}) {
+	*h = append(*h, x.(*jsonmodels.AddressBalance))
+}
+
+// Pop removes and returns the last element of the heap.
+func (h *addressBalanceHeap) Pop() interface{} {
+	n := len(*h)
+	data := (*h)[n-1]
+	(*h)[n-1] = nil // avoid memory leak
+	*h = (*h)[:n-1]
+
+	return data
+}
+
+// lessBalance returns true if the first address balance is smaller than the second one. Equal balances are ordered by
+// their address, so that the distribution is deterministic.
+func lessBalance(addressBalance, otherAddressBalance *jsonmodels.AddressBalance) bool {
+	if addressBalance.Balance != otherAddressBalance.Balance {
+		return addressBalance.Balance < otherAddressBalance.Balance
+	}
+
+	return addressBalance.Address > otherAddressBalance.Address
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,74 @@
+package ledgerstate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func TestSupplyTracker(t *testing.T) {
+	addresses := make([]ledgerstate.Address, 3)
+	for i := range addresses {
+		addresses[i] = ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	}
+
+	supplyTracker := NewSupplyTracker()
+	genesisOutput := ledgerstate.NewSigLockedSingleOutput(1000, addresses[0])
+	genesisOutput.SetID(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, 0))
+	supplyTracker.AddOutput(genesisOutput)
+	supplyTracker.AddOutput(genesisOutput)
+
+	supply := supplyTracker.Supply(10)
+	assert.EqualValues(t, 1000, supply.TotalSupply)
+	assert.Equal(t, 1, supply.UnspentOutputs)
+	assert.Equal(t, 0, supply.DustOutputs)
+	assert.Equal(t, []*jsonmodels.AddressBalance{{Address: addresses[0].Base58(), Balance: 1000}}, supply.TopAddresses)
+
+	// the genesis output is split among the addresses
+	transaction := newSupplyTestTransaction(genesisOutput.ID(),
+		ledgerstate.NewSigLockedSingleOutput(600, addresses[1]),
+		ledgerstate.NewSigLockedSingleOutput(350, addresses[2]),
+		ledgerstate.NewSigLockedSingleOutput(50, addresses[2]),
+	)
+	supplyTracker.ApplyTransaction(transaction)
+
+	supply = supplyTracker.Supply(1)
+	assert.EqualValues(t, 1000, supply.TotalSupply)
+	assert.Equal(t, 3, supply.UnspentOutputs)
+	assert.Equal(t, 1, supply.DustOutputs)
+	assert.Equal(t, 2, supply.Addresses)
+	assert.Equal(t, []*jsonmodels.AddressBalance{{Address: addresses[1].Base58(), Balance: 600}}, supply.TopAddresses)
+
+	supply = supplyTracker.Supply(10)
+	assert.Equal(t, []*jsonmodels.AddressBalance{
+		{Address: addresses[1].Base58(), Balance: 600},
+		{Address: addresses[2].Base58(), Balance: 400},
+	}, supply.TopAddresses)
+
+	// spending the dust output removes it
+	var dustOutputID ledgerstate.OutputID
+	for _, output := range transaction.Essence().Outputs() {
+		if balance, _ := output.Balances().Get(ledgerstate.ColorIOTA); balance == 50 {
+			dustOutputID = output.ID()
+		}
+	}
+	supplyTracker.ApplyTransaction(newSupplyTestTransaction(dustOutputID, ledgerstate.NewSigLockedSingleOutput(50, addresses[1])))
+
+	supply = supplyTracker.Supply(0)
+	assert.EqualValues(t, 1000, supply.TotalSupply)
+	assert.Equal(t, 3, supply.UnspentOutputs)
+	assert.Equal(t, 1, supply.DustOutputs)
+	assert.Empty(t, supply.TopAddresses)
+}
+
+func newSupplyTestTransaction(inputID ledgerstate.OutputID, outputs ...ledgerstate.Output) *ledgerstate.Transaction {
+	essence := ledgerstate.NewTransactionEssence(0, time.Now(), identity.ID{}, identity.ID{}, ledgerstate.NewInputs(ledgerstate.NewUTXOInput(inputID)), ledgerstate.NewOutputs(outputs...))
+
+	return ledgerstate.NewTransaction(essence, ledgerstate.UnlockBlocks{ledgerstate.NewReferenceUnlockBlock(0)})
+}
@@ -0,0 +1,39 @@
+package webapi
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/resourcemanager"
+)
+
+// ErrLoadShedding is returned if a request is rejected because the node is shedding load.
+var ErrLoadShedding = errors.New("node is shedding load due to critical memory usage")
+
+// region loadSheddingMiddleware ///////////////////////////////////////////////////////////////////////////////////////
+
+// loadSheddingMiddleware returns a middleware that rejects all requests to non-critical routes while the memory pressure
+// of the node is critical, so that the API does not allocate further memory until the pressure is relieved.
+func loadSheddingMiddleware(resourceManager *resourcemanager.Manager, criticalRoutes []string) echo.MiddlewareFunc {
+	isCriticalRoute := make(map[string]bool, len(criticalRoutes))
+	for _, criticalRoute := range criticalRoutes {
+		isCriticalRoute["/"+strings.Trim(criticalRoute, "/")] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if resourceManager.Level() != resourcemanager.LevelCritical || isCriticalRoute["/"+strings.Trim(c.Request().URL.Path, "/")] {
+				return next(c)
+			}
+
+			c.Response().Header().Set("Retry-After", "10")
+			return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(ErrLoadShedding))
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
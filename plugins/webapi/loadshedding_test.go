@@ -0,0 +1,44 @@
+package webapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/atomic"
+
+	"github.com/iotaledger/goshimmer/packages/resourcemanager"
+)
+
+func TestLoadSheddingMiddleware(t *testing.T) {
+	heapUsage := atomic.NewUint64(0)
+	resourceManager := resourcemanager.NewManager(resourcemanager.Params{HeapHardLimit: 100}, resourcemanager.WithHeapUsageFunc(heapUsage.Load))
+
+	server := echo.New()
+	server.Use(loadSheddingMiddleware(resourceManager, []string{"/", "healthz"}))
+	handler := func(c echo.Context) error { return c.NoContent(http.StatusOK) }
+	server.GET("/", handler)
+	server.GET("/healthz", handler)
+	server.GET("/info", handler)
+
+	doRequest := func(path string) int {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder.Code
+	}
+
+	assert.Equal(t, http.StatusOK, doRequest("/info"))
+
+	// only the critical routes are served while the memory usage is critical
+	heapUsage.Store(100)
+	resourceManager.Check()
+	assert.Equal(t, http.StatusServiceUnavailable, doRequest("/info"))
+	assert.Equal(t, http.StatusOK, doRequest("/healthz"))
+	assert.Equal(t, http.StatusOK, doRequest("/"))
+
+	heapUsage.Store(0)
+	resourceManager.Check()
+	assert.Equal(t, http.StatusOK, doRequest("/info"))
+}
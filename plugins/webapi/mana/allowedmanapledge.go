@@ -3,6 +3,7 @@ package mana
 import (
 	"net/http"
 
+	"github.com/cockroachdb/errors"
 	"github.com/iotaledger/hive.go/identity"
 	"github.com/labstack/echo"
 	"github.com/mr-tron/base58"
@@ -20,7 +21,7 @@ func allowedManaPledgeHandler(c echo.Context) error {
 		accessNodes = append(accessNodes, base58.Encode(element.Bytes()))
 	})
 	if len(accessNodes) == 0 {
-		return c.JSON(http.StatusNotFound, jsonmodels.AllowedManaPledgeResponse{Error: "No access mana pledge IDs are accepted"})
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("No access mana pledge IDs are accepted")))
 	}
 
 	consensus := manaPlugin.GetAllowedPledgeNodes(mana.ConsensusMana)
@@ -29,7 +30,7 @@ func allowedManaPledgeHandler(c echo.Context) error {
 		consensusNodes = append(consensusNodes, base58.Encode(element.Bytes()))
 	})
 	if len(consensusNodes) == 0 {
-		return c.JSON(http.StatusNotFound, jsonmodels.AllowedManaPledgeResponse{Error: "No consensus mana pledge IDs are accepted"})
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.New("No consensus mana pledge IDs are accepted")))
 	}
 
 	return c.JSON(http.StatusOK, jsonmodels.AllowedManaPledgeResponse{
@@ -30,7 +30,7 @@ type GetDelegatedManaResponse struct {
 func GetDelegatedOutputs(c echo.Context) error {
 	outputs, err := manarefresher.DelegatedOutputs()
 	if err != nil {
-		return c.JSON(http.StatusNotFound, &GetDelegatedOutputsResponse{Error: err.Error()})
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
 	}
 	delegatedOutputsJSON := make([]*jsonmodels.Output, len(outputs))
 	for i, o := range outputs {
@@ -17,11 +17,11 @@ import (
 func getManaHandler(c echo.Context) error {
 	var request jsonmodels.GetManaRequest
 	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetManaResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	ID, err := mana.IDFromStr(request.NodeID)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetManaResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	if request.NodeID == "" {
 		ID = deps.Local.ID()
@@ -33,7 +33,7 @@ func getManaHandler(c echo.Context) error {
 			accessMana = 0
 			tAccess = t
 		} else {
-			return c.JSON(http.StatusBadRequest, jsonmodels.GetManaResponse{Error: err.Error()})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 		}
 	}
 	consensusMana, tConsensus, err := manaPlugin.GetConsensusMana(ID, t)
@@ -42,7 +42,7 @@ func getManaHandler(c echo.Context) error {
 			consensusMana = 0
 			tConsensus = t
 		} else {
-			return c.JSON(http.StatusBadRequest, jsonmodels.GetManaResponse{Error: err.Error()})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 		}
 	}
 
@@ -25,11 +25,11 @@ func getNHighestConsensusHandler(c echo.Context) error {
 func nHighestHandler(c echo.Context, manaType mana.Type) error {
 	number, err := strconv.ParseUint(c.QueryParam("number"), 10, 32)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetNHighestResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	highestNodes, t, err := manaPlugin.GetHighestManaNodes(manaType, uint(number))
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetNHighestResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	var res []mana.NodeStr
 	for _, n := range highestNodes {
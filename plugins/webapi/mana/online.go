@@ -23,7 +23,7 @@ func getOnlineConsensusHandler(c echo.Context) error {
 func getOnlineHandler(c echo.Context, manaType mana.Type) error {
 	onlinePeersMana, t, err := manaPlugin.GetOnlineNodes(manaType)
 	if err != nil {
-		return c.JSON(http.StatusNotFound, jsonmodels.GetOnlineResponse{Error: err.Error()})
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(err))
 	}
 	resp := make([]jsonmodels.OnlineNodeStr, 0)
 	for index, value := range onlinePeersMana {
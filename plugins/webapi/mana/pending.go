@@ -14,11 +14,11 @@ import (
 func GetPendingMana(c echo.Context) error {
 	var req jsonmodels.PendingRequest
 	if err := c.Bind(&req); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.PendingResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	outputID, err := ledgerstate.OutputIDFromBase58(req.OutputID)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.PendingResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	pending, t := manaPlugin.PendingManaOnOutput(outputID)
 	return c.JSON(http.StatusOK, jsonmodels.PendingResponse{
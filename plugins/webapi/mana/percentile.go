@@ -17,11 +17,11 @@ import (
 func getPercentileHandler(c echo.Context) error {
 	var request jsonmodels.GetPercentileRequest
 	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetPercentileResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	ID, err := mana.IDFromStr(request.NodeID)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetPercentileResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	if request.NodeID == "" {
 		ID = deps.Local.ID()
@@ -29,26 +29,26 @@ func getPercentileHandler(c echo.Context) error {
 	t := time.Now()
 	access, tAccess, err := manaPlugin.GetManaMap(mana.AccessMana, t)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetPercentileResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	accessPercentile, err := access.GetPercentile(ID)
 	if err != nil {
 		if errors.Is(err, mana.ErrNodeNotFoundInBaseManaVector) {
 			accessPercentile = 0
 		} else {
-			return c.JSON(http.StatusBadRequest, jsonmodels.GetManaResponse{Error: err.Error()})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 		}
 	}
 	consensus, tConsensus, err := manaPlugin.GetManaMap(mana.ConsensusMana, t)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.GetPercentileResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	consensusPercentile, err := consensus.GetPercentile(ID)
 	if err != nil {
 		if errors.Is(err, mana.ErrNodeNotFoundInBaseManaVector) {
 			consensusPercentile = 0
 		} else {
-			return c.JSON(http.StatusBadRequest, jsonmodels.GetManaResponse{Error: err.Error()})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 		}
 	}
 	return c.JSON(http.StatusOK, jsonmodels.GetPercentileResponse{
@@ -31,6 +31,7 @@ func configure(_ *node.Plugin) {
 	deps.Server.GET("mana", getManaHandler)
 	deps.Server.GET("mana/all", getAllManaHandler)
 	deps.Server.GET("/mana/access/nhighest", getNHighestAccessHandler)
+	deps.Server.GET("mana/access/projection", getAccessManaProjectionHandler)
 	deps.Server.GET("/mana/consensus/nhighest", getNHighestConsensusHandler)
 	deps.Server.GET("/mana/percentile", getPercentileHandler)
 	deps.Server.GET("/mana/access/online", getOnlineAccessHandler)
@@ -0,0 +1,40 @@
+package mana
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	manaPlugin "github.com/iotaledger/goshimmer/plugins/messagelayer"
+)
+
+// defaultProjectionInterval is the interval (in seconds) over which the access mana is projected if none is requested.
+const defaultProjectionInterval = 60
+
+// getAccessManaProjectionHandler projects the access mana of the node and the number of messages that it can issue.
+func getAccessManaProjectionHandler(c echo.Context) error {
+	var request jsonmodels.AccessManaProjectionRequest
+	if err := c.Bind(&request); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	if request.MessageSize <= 0 || request.MessageSize > tangle.MaxMessageSize {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid messageSize: %d", request.MessageSize)))
+	}
+	if request.Interval < 0 {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid interval: %d", request.Interval)))
+	}
+	if request.Interval == 0 {
+		request.Interval = defaultProjectionInterval
+	}
+
+	projection, issuableMessages, err := manaPlugin.IssuableMessages(request.MessageSize, time.Duration(request.Interval)*time.Second)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewAccessManaProjectionResponse(projection, request.MessageSize, request.Interval, issuableMessages))
+}
@@ -4,7 +4,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/iotaledger/hive.go/stringify"
 	"github.com/labstack/echo"
@@ -13,12 +17,19 @@ import (
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/markers"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+	"github.com/iotaledger/goshimmer/packages/webapiproto"
+	"github.com/iotaledger/goshimmer/plugins/webapi"
+	ledgerstateAPI "github.com/iotaledger/goshimmer/plugins/webapi/ledgerstate"
 )
 
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// PluginName is the name of the web API message endpoint plugin.
+const PluginName = "WebAPIMessageEndpoint"
+
 var (
 	// Plugin holds the singleton instance of the plugin.
 	Plugin *node.Plugin
@@ -34,16 +45,27 @@ type dependencies struct {
 }
 
 func init() {
-	Plugin = node.NewPlugin("WebAPIMessageEndpoint", deps, node.Enabled, configure)
+	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
 }
 
 func configure(_ *node.Plugin) {
 	deps.Server.GET("messages/:messageID", GetMessage)
 	deps.Server.GET("messages/:messageID/metadata", GetMessageMetadata)
+	deps.Server.GET("messages/:messageID/approvedbyconfirmed", GetMessageApprovedByConfirmed)
 	deps.Server.POST("messages/payload", PostPayload)
 
+	deps.Server.GET("messages/issuer/:publicKey", GetIssuerMessages)
+	deps.Server.GET("messages/stream", StreamMessages)
 	deps.Server.GET("messages/sequences/:sequenceID", GetSequence)
 	deps.Server.GET("messages/sequences/:sequenceID/markerindexbranchidmapping", GetMarkerIndexBranchIDMapping)
+
+	configureStreamSubscriptions()
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker("WebAPIMessageStream", shutdownStreamSubscriptions, shutdown.PriorityWebAPI); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -104,6 +126,14 @@ func GetMessage(c echo.Context) (err error) {
 	}
 
 	if deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+		if webapi.AcceptsProtobuf(c) {
+			err = webapi.Protobuf(c, http.StatusOK, &webapiproto.Message{
+				Id:    message.ID().Bytes(),
+				Bytes: message.Bytes(),
+			})
+			return
+		}
+
 		err = c.JSON(http.StatusOK, jsonmodels.Message{
 			ID:                      message.ID().Base58(),
 			StrongParents:           message.ParentsByType(tangle.StrongParentType).Base58(),
@@ -137,6 +167,35 @@ func GetMessage(c echo.Context) (err error) {
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
+// region GetIssuerMessages ////////////////////////////////////////////////////////////////////////////////////////////
+
+// GetIssuerMessages is the handler for the /messages/issuer/:publicKey endpoint. It returns the indexed messages of the
+// issuer whose issuing time lies within the optional range given by the from (inclusive) and to (exclusive) query
+// parameters as unix timestamps.
+func GetIssuerMessages(c echo.Context) (err error) {
+	issuerPublicKey, err := ed25519.PublicKeyFromString(c.Param("publicKey"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Wrap(err, "invalid publicKey")))
+	}
+	from, err := timeFromQueryParam(c, "from")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+	to, err := timeFromQueryParam(c, "to")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	entries, truncated, exists := deps.Tangle.IssuerIndex.Messages(issuerPublicKey, from, to)
+	if !exists {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("no messages of issuer %s are indexed", issuerPublicKey)))
+	}
+
+	return c.JSON(http.StatusOK, jsonmodels.NewIssuerMessagesResponse(issuerPublicKey, entries, truncated))
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
 // region GetMessageMetadata ///////////////////////////////////////////////////////////////////////////////////////////
 
 // GetMessageMetadata is the handler for the /messages/:messageID/metadata endpoint.
@@ -176,21 +235,62 @@ func NewMessageMetadata(metadata *tangle.MessageMetadata) jsonmodels.MessageMeta
 		SubjectivelyInvalid: metadata.IsSubjectivelyInvalid(),
 		GradeOfFinality:     metadata.GradeOfFinality(),
 		GradeOfFinalityTime: metadata.GradeOfFinalityTime().Unix(),
+		Orphaned:            metadata.IsOrphaned(),
+		OrphanedTime:        metadata.OrphanedTime().Unix(),
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region GetMessageApprovedByConfirmed ////////////////////////////////////////////////////////////////////////////////
+
+// GetMessageApprovedByConfirmed is the handler for the /messages/:messageID/approvedbyconfirmed endpoint.
+func GetMessageApprovedByConfirmed(c echo.Context) (err error) {
+	messageID, err := messageIDFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	var booked bool
+	if !deps.Tangle.Storage.MessageMetadata(messageID).Consume(func(messageMetadata *tangle.MessageMetadata) {
+		booked = messageMetadata.IsBooked()
+	}) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(fmt.Errorf("failed to load MessageMetadata with %s", messageID)))
+	}
+	if !booked {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(fmt.Errorf("%s is not booked, yet", messageID)))
 	}
+
+	return c.JSON(http.StatusOK, jsonmodels.MessageApprovedByConfirmed{
+		ID:                  messageID.Base58(),
+		ApprovedByConfirmed: deps.Tangle.Utils.ApprovedByConfirmed(messageID),
+	})
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region PostPayload //////////////////////////////////////////////////////////////////////////////////////////////////
 
-// PostPayload is the handler for the /messages/payload endpoint.
+// PostPayload is the handler for the /messages/payload endpoint. If the dryRun query parameter is set, the payload is
+// only validated and a diagnosis of all checks is returned instead.
 func PostPayload(c echo.Context) error {
 	var request jsonmodels.PostPayloadRequest
-	if err := c.Bind(&request); err != nil {
+	if webapi.IsProtobufRequest(c) {
+		protobufRequest := new(webapiproto.PostPayloadRequest)
+		if err := webapi.BindProtobuf(c, protobufRequest); err != nil {
+			Plugin.LogInfo(err.Error())
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+		request.Payload = protobufRequest.Payload
+	} else if err := c.Bind(&request); err != nil {
 		Plugin.LogInfo(err.Error())
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
+	if ledgerstateAPI.IsDryRun(c) {
+		return c.JSON(http.StatusOK, dryRunPostPayload(request.Payload))
+	}
+
 	parsedPayload, _, err := payload.FromBytes(request.Payload)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
@@ -201,9 +301,40 @@ func PostPayload(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
+	if webapi.AcceptsProtobuf(c) {
+		return webapi.Protobuf(c, http.StatusOK, &webapiproto.PostPayloadResponse{Id: msg.ID().Bytes()})
+	}
+
 	return c.JSON(http.StatusOK, jsonmodels.NewPostPayloadResponse(msg))
 }
 
+// dryRunPostPayload performs all checks of the PostPayload endpoint without issuing the payload. Transactions are
+// additionally checked against the allowed mana pledge lists and validated against the ledger state, since they would
+// otherwise only be rejected after being booked.
+func dryRunPostPayload(payloadBytes []byte) (res *jsonmodels.DryRunResponse) {
+	res = jsonmodels.NewDryRunResponse()
+
+	parsedPayload, _, err := payload.FromBytes(payloadBytes)
+	if !res.AddCheck("syntax", err) {
+		res.SkipChecks("synced")
+		return res
+	}
+
+	if !deps.Tangle.Synced() {
+		res.AddCheck("synced", errors.Errorf("can't issue payload: %w", tangle.ErrNotSynced))
+	} else {
+		res.AddCheck("synced", nil)
+	}
+
+	if tx, isTransaction := parsedPayload.(*ledgerstate.Transaction); isTransaction {
+		res.TransactionID = tx.ID().Base58()
+		ledgerstateAPI.DiagnoseManaPledges(tx, res)
+		ledgerstateAPI.DiagnoseTransaction(deps.Tangle.LedgerState, tx, res)
+	}
+
+	return res
+}
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region messageIDFromContext /////////////////////////////////////////////////////////////////////////////////////////
@@ -221,6 +352,22 @@ func messageIDFromContext(c echo.Context) (messageID tangle.MessageID, err error
 	return
 }
 
+// timeFromQueryParam determines the time from the query parameter with the given name in an echo.Context, which
+// contains a unix timestamp. It returns the zero time if the parameter is omitted.
+func timeFromQueryParam(c echo.Context, name string) (value time.Time, err error) {
+	timeString := c.QueryParam(name)
+	if timeString == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(timeString, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.Errorf("invalid %s: %s", name, timeString)
+	}
+
+	return time.Unix(seconds, 0), nil
+}
+
 // sequenceIDFromContext determines the sequenceID from the sequenceID parameter in an echo.Context.
 func sequenceIDFromContext(c echo.Context) (id markers.SequenceID, err error) {
 	sequenceIDInt, err := strconv.Atoi(c.Param("sequenceID"))
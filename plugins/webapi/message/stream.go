@@ -0,0 +1,266 @@
+package message
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/gorilla/websocket"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+const (
+	// streamWriteTimeout defines the timeout for writing a message to a subscriber.
+	streamWriteTimeout = 5 * time.Second
+
+	// streamPingInterval defines the interval in which subscribers are pinged to keep the connection alive.
+	streamPingInterval = 30 * time.Second
+
+	// streamBufferSize defines how many messages are buffered for a subscriber before it is considered too slow and
+	// disconnected.
+	streamBufferSize = 1024
+)
+
+var (
+	// streamSubscriptions contains the open subscriptions to the message stream.
+	streamSubscriptions = newStreamSubscriptionManager()
+
+	// closure to be executed on grade of finality changes of messages.
+	onMessageGoFChanged *event.Closure[*tangle.MessageGoFChangedEvent]
+
+	streamUpgrader = websocket.Upgrader{
+		HandshakeTimeout: streamWriteTimeout,
+		CheckOrigin:      func(r *http.Request) bool { return true },
+	}
+)
+
+// region StreamMessages ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// StreamMessages is the handler for the messages/stream endpoint. It upgrades the connection to a websocket and pushes
+// every message once it reaches the grade of finality given by the minGoF query parameter (high by default). The
+// messages can be restricted to one or more payload types with the payloadType query parameter. Subscribers that can
+// not keep up with the stream are disconnected.
+func StreamMessages(c echo.Context) error {
+	filter, err := streamFilterFromContext(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	conn, err := streamUpgrader.Upgrade(c.Response(), c.Request(), nil)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	subscription := streamSubscriptions.Subscribe(filter)
+	defer streamSubscriptions.Unsubscribe(subscription)
+
+	// the client does not send any messages, but we need to read to process control messages and to detect closed
+	// connections
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, readErr := conn.NextReader(); readErr != nil {
+				return
+			}
+		}
+	}()
+
+	pingTicker := time.NewTicker(streamPingInterval)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case streamedMessage := <-subscription.messages:
+			if err = conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil {
+				return nil
+			}
+			if err = conn.WriteJSON(streamedMessage); err != nil {
+				return nil
+			}
+		case <-subscription.overflowed:
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "subscriber is too slow"), time.Now().Add(streamWriteTimeout))
+			return nil
+		case <-pingTicker.C:
+			if pingErr := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout)); pingErr != nil {
+				return nil
+			}
+		case <-closed:
+			return nil
+		case <-streamSubscriptions.shutdown:
+			_ = conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseGoingAway, "node is shutting down"), time.Now().Add(streamWriteTimeout))
+			return nil
+		}
+	}
+}
+
+// streamFilterFromContext parses the streamFilter from the query parameters of an echo.Context.
+func streamFilterFromContext(c echo.Context) (filter *streamFilter, err error) {
+	filter = &streamFilter{
+		minGoF: gof.High,
+	}
+
+	if minGoFString := c.QueryParam("minGoF"); minGoFString != "" {
+		minGoF, parseErr := strconv.ParseUint(minGoFString, 10, 8)
+		if parseErr != nil || gof.GradeOfFinality(minGoF) < gof.Low || gof.GradeOfFinality(minGoF) > gof.High {
+			return nil, errors.Errorf("invalid minGoF: %s", minGoFString)
+		}
+		filter.minGoF = gof.GradeOfFinality(minGoF)
+	}
+
+	for _, payloadTypeString := range c.QueryParams()["payloadType"] {
+		payloadType, parseErr := strconv.ParseUint(payloadTypeString, 10, 32)
+		if parseErr != nil {
+			return nil, errors.Errorf("invalid payloadType: %s", payloadTypeString)
+		}
+		if filter.payloadTypes == nil {
+			filter.payloadTypes = make(map[payload.Type]struct{})
+		}
+		filter.payloadTypes[payload.Type(payloadType)] = struct{}{}
+	}
+
+	return filter, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region streamSubscriptionManager ////////////////////////////////////////////////////////////////////////////////////
+
+// streamSubscriptionManager manages the subscribers of the message stream.
+type streamSubscriptionManager struct {
+	subscriptions map[*streamSubscription]struct{}
+	shutdown      chan struct{}
+	shutdownOnce  sync.Once
+	mutex         sync.RWMutex
+}
+
+// streamFilter defines which messages are sent to a subscriber.
+type streamFilter struct {
+	minGoF       gof.GradeOfFinality
+	payloadTypes map[payload.Type]struct{}
+}
+
+// streamSubscription is a single subscription to the message stream.
+type streamSubscription struct {
+	filter         *streamFilter
+	messages       chan *jsonmodels.StreamedMessage
+	overflowed     chan struct{}
+	overflowedOnce sync.Once
+}
+
+// newStreamSubscriptionManager creates an empty set of subscriptions.
+func newStreamSubscriptionManager() *streamSubscriptionManager {
+	return &streamSubscriptionManager{
+		subscriptions: make(map[*streamSubscription]struct{}),
+		shutdown:      make(chan struct{}),
+	}
+}
+
+// Subscribe creates a subscription to the messages that match the given filter.
+func (s *streamSubscriptionManager) Subscribe(filter *streamFilter) *streamSubscription {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	subscription := &streamSubscription{
+		filter:     filter,
+		messages:   make(chan *jsonmodels.StreamedMessage, streamBufferSize),
+		overflowed: make(chan struct{}),
+	}
+	s.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+// Unsubscribe removes the given subscription.
+func (s *streamSubscriptionManager) Unsubscribe(subscription *streamSubscription) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	delete(s.subscriptions, subscription)
+}
+
+// Publish sends the message of the given event to the subscribers whose minimum grade of finality was reached by the
+// change. The message is only loaded if at least one subscriber is interested in it. Publish never blocks: if the buffer
+// of a subscriber is full, the subscriber is marked as overflowed instead.
+func (s *streamSubscriptionManager) Publish(gofChangedEvent *tangle.MessageGoFChangedEvent, loadMessage func(gofChangedEvent *tangle.MessageGoFChangedEvent) *jsonmodels.StreamedMessage) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var streamedMessage *jsonmodels.StreamedMessage
+	for subscription := range s.subscriptions {
+		if gofChangedEvent.PreviousGradeOfFinality >= subscription.filter.minGoF || gofChangedEvent.GradeOfFinality < subscription.filter.minGoF {
+			continue
+		}
+
+		if streamedMessage == nil {
+			if streamedMessage = loadMessage(gofChangedEvent); streamedMessage == nil {
+				return
+			}
+		}
+		if subscription.filter.payloadTypes != nil {
+			if _, matches := subscription.filter.payloadTypes[payload.Type(streamedMessage.PayloadType)]; !matches {
+				continue
+			}
+		}
+
+		select {
+		case subscription.messages <- streamedMessage:
+		default:
+			subscription.overflowedOnce.Do(func() {
+				close(subscription.overflowed)
+			})
+		}
+	}
+}
+
+// Shutdown closes all subscriptions.
+func (s *streamSubscriptionManager) Shutdown() {
+	s.shutdownOnce.Do(func() {
+		close(s.shutdown)
+	})
+}
+
+// Size returns the number of subscribers.
+func (s *streamSubscriptionManager) Size() int {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return len(s.subscriptions)
+}
+
+// configureStreamSubscriptions attaches the subscriptions to the grade of finality changes of the tangle.
+func configureStreamSubscriptions() {
+	onMessageGoFChanged = event.NewClosure(func(event *tangle.MessageGoFChangedEvent) {
+		streamSubscriptions.Publish(event, loadStreamedMessage)
+	})
+	deps.Tangle.ConfirmationOracle.Events().MessageGoFChanged.Attach(onMessageGoFChanged)
+}
+
+// shutdownStreamSubscriptions detaches the subscriptions and closes all open connections.
+func shutdownStreamSubscriptions(ctx context.Context) {
+	<-ctx.Done()
+
+	deps.Tangle.ConfirmationOracle.Events().MessageGoFChanged.Detach(onMessageGoFChanged)
+	streamSubscriptions.Shutdown()
+}
+
+// loadStreamedMessage loads the message of the given event from the storage.
+func loadStreamedMessage(gofChangedEvent *tangle.MessageGoFChangedEvent) (streamedMessage *jsonmodels.StreamedMessage) {
+	deps.Tangle.Storage.Message(gofChangedEvent.MessageID).Consume(func(message *tangle.Message) {
+		streamedMessage = jsonmodels.NewStreamedMessage(message, gofChangedEvent.GradeOfFinality)
+	})
+
+	return streamedMessage
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
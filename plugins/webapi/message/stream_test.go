@@ -0,0 +1,70 @@
+package message
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/iotaledger/goshimmer/packages/consensus/gof"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/tangle/payload"
+)
+
+func TestStreamSubscriptionManager(t *testing.T) {
+	manager := newStreamSubscriptionManager()
+
+	loads := 0
+	loadMessage := func(payloadType payload.Type) func(*tangle.MessageGoFChangedEvent) *jsonmodels.StreamedMessage {
+		return func(gofChangedEvent *tangle.MessageGoFChangedEvent) *jsonmodels.StreamedMessage {
+			loads++
+			return &jsonmodels.StreamedMessage{ID: gofChangedEvent.MessageID.Base58(), PayloadType: uint32(payloadType), GradeOfFinality: gofChangedEvent.GradeOfFinality}
+		}
+	}
+
+	high := manager.Subscribe(&streamFilter{minGoF: gof.High})
+	medium := manager.Subscribe(&streamFilter{minGoF: gof.Medium})
+	data := manager.Subscribe(&streamFilter{minGoF: gof.Low, payloadTypes: map[payload.Type]struct{}{payload.GenericDataPayloadType: {}}})
+	assert.Equal(t, 3, manager.Size())
+
+	// messages are sent once, when they reach the minimum grade of finality
+	manager.Publish(&tangle.MessageGoFChangedEvent{MessageID: tangle.MessageID{1}, PreviousGradeOfFinality: gof.None, GradeOfFinality: gof.Low}, loadMessage(payload.GenericDataPayloadType))
+	manager.Publish(&tangle.MessageGoFChangedEvent{MessageID: tangle.MessageID{1}, PreviousGradeOfFinality: gof.Low, GradeOfFinality: gof.High}, loadMessage(payload.GenericDataPayloadType))
+	assert.Len(t, high.messages, 1)
+	assert.Len(t, medium.messages, 1)
+	assert.Len(t, data.messages, 1)
+	assert.Equal(t, gof.High, (<-high.messages).GradeOfFinality)
+
+	// messages of other payload types are filtered
+	manager.Publish(&tangle.MessageGoFChangedEvent{MessageID: tangle.MessageID{2}, PreviousGradeOfFinality: gof.None, GradeOfFinality: gof.High}, loadMessage(payload.Type(1337)))
+	assert.Len(t, high.messages, 1)
+	assert.Len(t, medium.messages, 2)
+	assert.Len(t, data.messages, 1)
+
+	// messages that nobody is interested in are not loaded
+	loads = 0
+	manager.Publish(&tangle.MessageGoFChangedEvent{MessageID: tangle.MessageID{3}, PreviousGradeOfFinality: gof.High, GradeOfFinality: gof.High}, loadMessage(payload.GenericDataPayloadType))
+	assert.Zero(t, loads)
+
+	// slow subscribers are marked as overflowed instead of blocking
+	for i := 0; i < streamBufferSize; i++ {
+		manager.Publish(&tangle.MessageGoFChangedEvent{MessageID: tangle.MessageID{4}, PreviousGradeOfFinality: gof.Medium, GradeOfFinality: gof.High}, loadMessage(payload.GenericDataPayloadType))
+	}
+	_, open := <-high.overflowed
+	assert.False(t, open)
+	select {
+	case <-medium.overflowed:
+		assert.Fail(t, "subscriber overflowed")
+	default:
+	}
+
+	manager.Unsubscribe(high)
+	manager.Unsubscribe(medium)
+	manager.Unsubscribe(data)
+	assert.Equal(t, 0, manager.Size())
+
+	manager.Shutdown()
+	manager.Shutdown()
+	_, open = <-manager.shutdown
+	assert.False(t, open)
+}
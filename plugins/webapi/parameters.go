@@ -1,6 +1,8 @@
 package webapi
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/configuration"
 )
 
@@ -18,6 +20,79 @@ type ParametersDefinition struct {
 		// Password defines the password used by the basic HTTP authentication.
 		Password string `default:"goshimmer" usage:"HTTP basic auth password"`
 	}
+
+	// IdempotencyKeyTTL defines how long the responses of requests with an idempotency key are kept.
+	IdempotencyKeyTTL time.Duration `default:"10m" usage:"how long the responses of requests with an idempotency key are kept"`
+	// IdempotencyMaxEntries defines the maximum number of responses of requests with an idempotency key that are kept.
+	IdempotencyMaxEntries int `default:"10000" usage:"the maximum number of responses of requests with an idempotency key that are kept"`
+	// IdempotencyMaxBodySize defines the maximum size of the body of a request with an idempotency key.
+	IdempotencyMaxBodySize int64 `default:"1048576" usage:"the maximum size of the body of a request with an idempotency key in bytes"`
+
+	// CriticalRoutes defines the routes that are still served while the node sheds load due to critical memory usage.
+	CriticalRoutes []string `default:"/,healthz,info" usage:"the routes that are still served while the node sheds load due to critical memory usage"`
+
+	// IssuancePrioritization
+	IssuancePrioritization struct {
+		// Enabled defines whether issuance requests are prioritized by the access mana of their issuers.
+		Enabled bool `default:"false" usage:"whether to prioritize issuance requests by the access mana of their issuers"`
+		// Routes defines the routes whose POST requests are prioritized.
+		Routes []string `default:"data,messages/payload,ledgerstate/transactions,faucet,tools/message,drng/collectiveBeacon,chat,networkdelay" usage:"the routes whose POST requests are prioritized"`
+		// MaxConcurrentRequests defines the number of issuance requests that are served concurrently.
+		MaxConcurrentRequests int `default:"8" usage:"the number of issuance requests that are served concurrently"`
+		// MaxQueueSize defines the number of issuance requests that wait for being served.
+		MaxQueueSize int `default:"100" usage:"the number of issuance requests that wait for being served"`
+		// QueueTimeout defines how long an issuance request waits for being served before it is rejected.
+		QueueTimeout time.Duration `default:"10s" usage:"how long an issuance request waits for being served before it is rejected"`
+		// MinAccessMana defines the access mana an issuer needs for its requests to be queued instead of being rejected.
+		MinAccessMana float64 `default:"0" usage:"the access mana an issuer needs for its requests to be queued instead of being rejected"`
+		// ChallengeTTL defines how long a challenge can be used by an issuer to prove its identity.
+		ChallengeTTL time.Duration `default:"1m" usage:"how long a challenge can be used by an issuer to prove its identity"`
+	}
+
+	// SignedResponses
+	SignedResponses struct {
+		// Enabled defines whether the responses of the signed routes are signed with the identity of the node.
+		Enabled bool `default:"false" usage:"whether to sign the responses of the signed routes with the identity of the node"`
+		// Routes defines the routes whose responses are signed.
+		Routes []string `default:"ledgerstate/addresses/:address,ledgerstate/addresses/:address/balance,ledgerstate/addresses/:address/unspentOutputs,ledgerstate/addresses/unspentOutputs,ledgerstate/addresses/balanceProof,ledgerstate/outputs/:outputID/metadata,ledgerstate/transactions/:transactionID/metadata,messages/:messageID/metadata,epochs/:index/diffs" usage:"the routes whose responses are signed"`
+	}
+
+	// OutputsBatch
+	OutputsBatch struct {
+		// MaxOutputs defines the maximum number of outputs that can be requested at once from ledgerstate/outputs:batch.
+		MaxOutputs int `default:"1000" usage:"the maximum number of outputs that can be requested at once"`
+		// Workers defines the number of workers that load the outputs of a batch request in parallel.
+		Workers int `default:"8" usage:"the number of workers that load the outputs of a batch request in parallel"`
+	}
+
+	// Tenants
+	Tenants struct {
+		// Enabled defines whether requests are scoped to the tenants that their API keys belong to.
+		Enabled bool `default:"false" usage:"whether to scope requests to the tenants that their API keys belong to"`
+		// APIKeys defines the API keys of the tenants in the form <tenant>:<apiKey>[:<issuanceQuota>].
+		APIKeys []string `usage:"the API keys of the tenants in the form <tenant>:<apiKey>[:<issuanceQuota>]"`
+		// OperatorAPIKeys defines the API keys of the operators of the node, which are not scoped to a tenant and can
+		// access all routes.
+		OperatorAPIKeys []string `usage:"the API keys of the operators of the node, which are not scoped to a tenant and can access all routes"`
+		// Required defines whether requests without an API key are rejected.
+		Required bool `default:"false" usage:"whether requests without an API key are rejected"`
+		// PublicRoutes defines the routes that are served without an API key if an API key is required.
+		PublicRoutes []string `default:"/,healthz,info" usage:"the routes that are served without an API key if an API key is required"`
+		// TenantRoutes defines the routes (including the routes below them) that can be accessed with the API key of a
+		// tenant.
+		TenantRoutes []string `default:"/,healthz,info,tenant,data,messages,ledgerstate,mana,balances,faucet,tools/message,drng,chat,networkdelay,challenge" usage:"the routes (including the routes below them) that can be accessed with the API key of a tenant"`
+		// IssuanceRoutes defines the routes whose POST requests count towards the issuance quota of a tenant.
+		IssuanceRoutes []string `default:"data,messages/payload,ledgerstate/transactions,faucet,tools/message,drng/collectiveBeacon,chat,networkdelay" usage:"the routes whose POST requests count towards the issuance quota of a tenant"`
+		// IssuanceQuota defines how many messages a tenant may issue per quota period if its API key does not define a
+		// quota (0 disables the limit).
+		IssuanceQuota int `default:"0" usage:"how many messages a tenant may issue per quota period if its API key does not define a quota"`
+		// QuotaPeriod defines the period after which the issuance quota of a tenant is reset.
+		QuotaPeriod time.Duration `default:"1h" usage:"the period after which the issuance quota of a tenant is reset"`
+		// MaxIndexedMessages defines how many of the most recent messages of a tenant are indexed.
+		MaxIndexedMessages int `default:"1000" usage:"how many of the most recent messages of a tenant are indexed"`
+		// MaxWatchedAddresses defines how many addresses a tenant can watch.
+		MaxWatchedAddresses int `default:"100" usage:"how many addresses a tenant can watch"`
+	}
 }
 
 // Parameters contains the configuration used by the webAPI plugin.
@@ -2,20 +2,26 @@ package webapi
 
 import (
 	"context"
-	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/autopeering/peer"
 	"github.com/iotaledger/hive.go/daemon"
 	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/identity"
 	"github.com/iotaledger/hive.go/logger"
 	"github.com/iotaledger/hive.go/node"
 	"github.com/labstack/echo"
 	"github.com/labstack/echo/middleware"
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/resourcemanager"
 	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/messagelayer"
 )
 
 // PluginName is the name of the web API plugin.
@@ -27,20 +33,40 @@ var (
 	deps   = new(dependencies)
 
 	log *logger.Logger
+
+	// challenges issues the challenges that are signed by issuers to prove their identity if the issuance
+	// prioritization is enabled.
+	challenges *issuerChallenges
+
+	// tenants contains the tenants that requests are scoped to if the tenants are enabled.
+	tenants *tenantRegistry
 )
 
 type dependencies struct {
 	dig.In
 
 	Server *echo.Echo
+	Tangle *tangle.Tangle `optional:"true"`
+}
+
+type serverDependencies struct {
+	dig.In
+
+	ResourceManager *resourcemanager.Manager `optional:"true"`
+	Local           *peer.Local              `optional:"true"`
 }
 
 func init() {
 	Plugin = node.NewPlugin(PluginName, deps, node.Enabled, configure, run)
 
+	jsonmodels.RegisterErrorCode(ErrIssuanceCapacityExhausted, jsonmodels.ErrorCodeOverloaded, jsonmodels.SubsystemWebAPI, true)
+	jsonmodels.RegisterErrorCode(ErrLoadShedding, jsonmodels.ErrorCodeOverloaded, jsonmodels.SubsystemWebAPI, true)
+	jsonmodels.RegisterErrorCode(ErrInvalidAPIKey, jsonmodels.ErrorCodeUnauthorized, jsonmodels.SubsystemWebAPI, false)
+	jsonmodels.RegisterErrorCode(ErrTenantQuotaExceeded, jsonmodels.ErrorCodeQuotaExceeded, jsonmodels.SubsystemWebAPI, true)
+
 	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
-		if err := container.Provide(func() *echo.Echo {
-			server := newServer()
+		if err := container.Provide(func(serverDeps serverDependencies) *echo.Echo {
+			server := newServer(serverDeps)
 			return server
 		}); err != nil {
 			Plugin.Panic(err)
@@ -49,7 +75,7 @@ func init() {
 }
 
 // newServer creates a server instance.
-func newServer() *echo.Echo {
+func newServer(serverDeps serverDependencies) *echo.Echo {
 	server := echo.New()
 	server.Use(middleware.CORSWithConfig(middleware.CORSConfig{
 		Skipper:      middleware.DefaultSkipper,
@@ -68,41 +94,71 @@ func newServer() *echo.Echo {
 		}))
 	}
 
-	server.HTTPErrorHandler = func(err error, c echo.Context) {
-		log.Warnf("Request failed: %s", err)
+	// if enabled, requests are scoped to the tenant that their API key belongs to
+	if Parameters.Tenants.Enabled {
+		var err error
+		if tenants, err = newTenantRegistry(
+			Parameters.Tenants.APIKeys,
+			Parameters.Tenants.OperatorAPIKeys,
+			Parameters.Tenants.IssuanceQuota,
+			Parameters.Tenants.QuotaPeriod,
+			Parameters.Tenants.MaxIndexedMessages,
+			Parameters.Tenants.MaxWatchedAddresses,
+		); err != nil {
+			Plugin.Panic(err)
+		}
+		server.Use(tenantAuthenticationMiddleware(tenants, Parameters.Tenants.Required, Parameters.Tenants.PublicRoutes, Parameters.Tenants.TenantRoutes))
+	}
 
-		var statusCode int
-		var message string
+	// if enabled, the responses of critical routes are signed, so that light clients can hold the node accountable
+	if Parameters.SignedResponses.Enabled {
+		if serverDeps.Local == nil {
+			Plugin.Panic("signed responses require the identity of the node")
+		}
+		server.Use(responseSigningMiddleware(serverDeps.Local.LocalIdentity(), Parameters.SignedResponses.Routes))
+	}
 
-		switch errors.Unwrap(err) {
-		case echo.ErrUnauthorized:
-			statusCode = http.StatusUnauthorized
-			message = "unauthorized"
+	// POST requests with an idempotency key are only executed once
+	server.Use(idempotencyMiddleware(Parameters.IdempotencyKeyTTL, Parameters.IdempotencyMaxEntries, Parameters.IdempotencyMaxBodySize))
 
-		case echo.ErrForbidden:
-			statusCode = http.StatusForbidden
-			message = "access forbidden"
+	// non-critical requests are rejected while the memory usage of the node is critical
+	if serverDeps.ResourceManager != nil {
+		server.Use(loadSheddingMiddleware(serverDeps.ResourceManager, Parameters.CriticalRoutes))
+	}
 
-		case echo.ErrInternalServerError:
-			statusCode = http.StatusInternalServerError
-			message = "internal server error"
+	// if enabled, issuance requests are served in the order of the access mana of their issuers while the node is busy
+	if Parameters.IssuancePrioritization.Enabled {
+		challenges = newIssuerChallenges(Parameters.IssuancePrioritization.ChallengeTTL)
+		scheduler := newIssuanceScheduler(
+			Parameters.IssuancePrioritization.MaxConcurrentRequests,
+			Parameters.IssuancePrioritization.MaxQueueSize,
+			Parameters.IssuancePrioritization.MinAccessMana,
+			Parameters.IssuancePrioritization.QueueTimeout,
+		)
+		server.Use(issuancePrioritizationMiddleware(scheduler, challenges, Parameters.IssuancePrioritization.Routes, issuerAccessMana))
+	}
 
-		case echo.ErrNotFound:
-			statusCode = http.StatusNotFound
-			message = "not found"
+	// the issuance requests of tenants count towards their quota and the issued messages are indexed
+	if tenants != nil {
+		server.Use(tenantIssuanceMiddleware(Parameters.Tenants.IssuanceRoutes, transactionAttachments))
+	}
+
+	server.HTTPErrorHandler = func(err error, c echo.Context) {
+		log.Warnf("Request failed: %s", err)
 
-		case echo.ErrBadRequest:
-			statusCode = http.StatusBadRequest
-			message = "bad request"
+		statusCode := http.StatusInternalServerError
+		var httpErr *echo.HTTPError
+		if errors.As(err, &httpErr) {
+			statusCode = httpErr.Code
+		}
 
-		default:
-			statusCode = http.StatusInternalServerError
-			message = "internal server error"
+		response := jsonmodels.NewErrorResponse(err)
+		if response.Code == jsonmodels.ErrorCodeUnknown {
+			response.Code = jsonmodels.ErrorCodeFromStatus(statusCode)
+			response.Subsystem = jsonmodels.SubsystemWebAPI
 		}
 
-		message = fmt.Sprintf("%s, error: %+v", message, err)
-		resErr := c.String(statusCode, message)
-		if resErr != nil {
+		if resErr := c.JSON(statusCode, response); resErr != nil {
 			log.Warnf("Failed to send error response: %s", resErr)
 		}
 	}
@@ -115,6 +171,36 @@ func configure(*node.Plugin) {
 	deps.Server.HideBanner = true
 	deps.Server.HidePort = true
 	deps.Server.GET("/", IndexRequest)
+	if challenges != nil {
+		deps.Server.GET("challenge", challenges.GetChallenge)
+	}
+	if tenants != nil {
+		deps.Server.GET("tenant", tenantHandler(getTenant))
+		deps.Server.GET("tenant/messages", tenantHandler(getTenantMessages))
+		deps.Server.GET("tenant/addresses", tenantHandler(getTenantAddresses))
+		deps.Server.PUT("tenant/addresses/:address", tenantHandler(watchTenantAddress))
+		deps.Server.DELETE("tenant/addresses/:address", tenantHandler(unwatchTenantAddress))
+	}
+}
+
+// issuerAccessMana returns the access mana of the issuer with the given identity. Issuers are treated as having no
+// access mana while the mana of the node can't be queried.
+func issuerAccessMana(issuerID identity.ID) float64 {
+	accessMana, _, err := messagelayer.GetAccessMana(issuerID)
+	if err != nil {
+		return 0
+	}
+
+	return accessMana
+}
+
+// transactionAttachments returns the IDs of the messages that contain the transaction with the given ID.
+func transactionAttachments(transactionID ledgerstate.TransactionID) tangle.MessageIDs {
+	if deps.Tangle == nil {
+		return nil
+	}
+
+	return deps.Tangle.Storage.AttachmentMessageIDs(transactionID)
 }
 
 func run(*node.Plugin) {
@@ -0,0 +1,408 @@
+package webapi
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/labstack/echo"
+	"github.com/mr-tron/base58"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// ErrIssuanceCapacityExhausted is returned if an issuance request can't be served because the node is under load.
+var ErrIssuanceCapacityExhausted = errors.New("issuance capacity of the node is exhausted")
+
+// challengePayloadLength is the length of the expiry time and the random nonce of a challenge.
+const challengePayloadLength = 8 + 16
+
+// region issuancePrioritizationMiddleware /////////////////////////////////////////////////////////////////////////////
+
+// issuancePrioritizationMiddleware returns a middleware that limits the number of concurrently served POST requests to
+// the given routes. If the limit is reached, requests are queued and served in the order of the access mana of their
+// issuers. Issuers prove their identity by signing a challenge of the node, while anonymous requests are treated as
+// requests of issuers without access mana.
+func issuancePrioritizationMiddleware(scheduler *issuanceScheduler, challenges *issuerChallenges, routes []string, accessManaFunc func(identity.ID) float64) echo.MiddlewareFunc {
+	isPrioritizedRoute := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		isPrioritizedRoute["/"+strings.Trim(route, "/")] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			if request.Method != http.MethodPost || !isPrioritizedRoute["/"+strings.Trim(request.URL.Path, "/")] {
+				return next(c)
+			}
+
+			var accessMana float64
+			issuerID, authenticated, err := challenges.issuerID(request)
+			if err != nil {
+				return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(err))
+			}
+			if authenticated {
+				accessMana = accessManaFunc(issuerID)
+			}
+
+			if err = scheduler.acquire(request.Context(), accessMana); err != nil {
+				c.Response().Header().Set("Retry-After", strconv.Itoa(int(scheduler.queueTimeout.Seconds())+1))
+				return c.JSON(http.StatusServiceUnavailable, jsonmodels.NewErrorResponse(err))
+			}
+			defer scheduler.release()
+
+			return next(c)
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region issuerChallenges /////////////////////////////////////////////////////////////////////////////////////////////
+
+// issuerChallenges issues the challenges that are signed by the issuers of requests to prove their identity. The
+// challenges are authenticated with a secret of the node, so that they can be verified without storing them. Only the
+// redeemed challenges are remembered until they expire, so that every challenge can be used for a single request.
+type issuerChallenges struct {
+	secret []byte
+	ttl    time.Duration
+
+	redeemed      map[string]time.Time
+	nextCleanup   time.Time
+	redeemedMutex sync.Mutex
+}
+
+// newIssuerChallenges creates a new issuerChallenges instance whose challenges are valid for the given duration.
+func newIssuerChallenges(ttl time.Duration) *issuerChallenges {
+	secret := make([]byte, sha256.Size)
+	if _, err := rand.Read(secret); err != nil {
+		panic(err)
+	}
+
+	return &issuerChallenges{
+		secret:   secret,
+		ttl:      ttl,
+		redeemed: make(map[string]time.Time),
+	}
+}
+
+// New returns a new challenge and the time until which it is valid.
+func (i *issuerChallenges) New() (challenge string, expiresAt time.Time) {
+	expiresAt = time.Now().Add(i.ttl)
+
+	challengeBytes := make([]byte, challengePayloadLength, challengePayloadLength+sha256.Size)
+	binary.BigEndian.PutUint64(challengeBytes, uint64(expiresAt.UnixNano()))
+	if _, err := rand.Read(challengeBytes[8:]); err != nil {
+		panic(err)
+	}
+
+	return base58.Encode(append(challengeBytes, i.mac(challengeBytes)...)), expiresAt
+}
+
+// issuerID returns the identity of the issuer that signed the given request. It returns false if the request does not
+// carry a signed challenge and an error if the challenge or its signature is invalid, or if the challenge was used
+// before.
+func (i *issuerChallenges) issuerID(request *http.Request) (issuerID identity.ID, authenticated bool, err error) {
+	publicKeyString := request.Header.Get(jsonmodels.IssuerPublicKeyHeader)
+	if publicKeyString == "" {
+		return issuerID, false, nil
+	}
+
+	publicKey, err := ed25519.PublicKeyFromString(publicKeyString)
+	if err != nil {
+		return issuerID, false, errors.Errorf("failed to parse issuer public key: %w", err)
+	}
+
+	challenge := request.Header.Get(jsonmodels.IssuerChallengeHeader)
+	expiresAt, err := i.verify(challenge)
+	if err != nil {
+		return issuerID, false, err
+	}
+
+	signatureBytes, err := base58.Decode(request.Header.Get(jsonmodels.IssuerSignatureHeader))
+	if err != nil {
+		return issuerID, false, errors.Errorf("failed to decode issuer signature: %w", err)
+	}
+	signature, _, err := ed25519.SignatureFromBytes(signatureBytes)
+	if err != nil {
+		return issuerID, false, errors.Errorf("failed to parse issuer signature: %w", err)
+	}
+
+	body, err := readBody(request)
+	if err != nil {
+		return issuerID, false, errors.Errorf("failed to read request body: %w", err)
+	}
+	if !publicKey.VerifySignature(jsonmodels.IssuerSignedMessage(challenge, request.Method, request.URL.Path, body), signature) {
+		return issuerID, false, errors.New("invalid signature of the request")
+	}
+
+	// the challenge is only redeemed after the signature was verified, so that forged requests can not use it up
+	if !i.redeem(challenge, expiresAt) {
+		return issuerID, false, errors.New("challenge was already used")
+	}
+
+	return identity.NewID(publicKey), true, nil
+}
+
+// verify returns the expiry time of the given challenge or an error if it was not issued by the node or if it expired.
+func (i *issuerChallenges) verify(challenge string) (expiresAt time.Time, err error) {
+	challengeBytes, err := base58.Decode(challenge)
+	if err != nil || len(challengeBytes) != challengePayloadLength+sha256.Size || !hmac.Equal(challengeBytes[challengePayloadLength:], i.mac(challengeBytes[:challengePayloadLength])) {
+		return expiresAt, errors.New("invalid challenge")
+	}
+	if expiresAt = time.Unix(0, int64(binary.BigEndian.Uint64(challengeBytes[:8]))); time.Now().After(expiresAt) {
+		return expiresAt, errors.New("challenge expired")
+	}
+
+	return expiresAt, nil
+}
+
+// redeem marks the given challenge as used and returns false if it was used before. Expired challenges are forgotten
+// at most once per TTL, since they are rejected by verify anyway.
+func (i *issuerChallenges) redeem(challenge string, expiresAt time.Time) bool {
+	i.redeemedMutex.Lock()
+	defer i.redeemedMutex.Unlock()
+
+	if now := time.Now(); now.After(i.nextCleanup) {
+		for redeemedChallenge, redeemedExpiresAt := range i.redeemed {
+			if now.After(redeemedExpiresAt) {
+				delete(i.redeemed, redeemedChallenge)
+			}
+		}
+		i.nextCleanup = now.Add(i.ttl)
+	}
+
+	if _, redeemed := i.redeemed[challenge]; redeemed {
+		return false
+	}
+	i.redeemed[challenge] = expiresAt
+
+	return true
+}
+
+// mac returns the message authentication code of the given challenge payload.
+func (i *issuerChallenges) mac(payload []byte) []byte {
+	mac := hmac.New(sha256.New, i.secret)
+	mac.Write(payload)
+
+	return mac.Sum(nil)
+}
+
+// GetChallenge is the handler for the /challenge endpoint.
+func (i *issuerChallenges) GetChallenge(c echo.Context) error {
+	challenge, expiresAt := i.New()
+
+	return c.JSON(http.StatusOK, &jsonmodels.GetChallengeResponse{
+		Challenge: challenge,
+		ExpiresAt: expiresAt.Unix(),
+	})
+}
+
+// readBody reads the body of the given request and replaces it, so that it can be read again by the handler.
+func readBody(request *http.Request) (body []byte, err error) {
+	if request.Body == nil {
+		return nil, nil
+	}
+	if body, err = io.ReadAll(request.Body); err != nil {
+		return nil, err
+	}
+	request.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region issuanceScheduler ////////////////////////////////////////////////////////////////////////////////////////////
+
+// issuanceScheduler limits the number of concurrently served issuance requests and queues the remaining requests by the
+// access mana of their issuers.
+type issuanceScheduler struct {
+	maxConcurrentRequests int
+	maxQueueSize          int
+	minAccessMana         float64
+	queueTimeout          time.Duration
+
+	runningRequests int
+	queue           waitingRequests
+	sequence        uint64
+	mutex           sync.Mutex
+}
+
+// newIssuanceScheduler creates a new issuanceScheduler. Requests of issuers with less than the given access mana are
+// rejected instead of being queued, and queued requests are rejected if they are not served within the given timeout.
+func newIssuanceScheduler(maxConcurrentRequests, maxQueueSize int, minAccessMana float64, queueTimeout time.Duration) *issuanceScheduler {
+	return &issuanceScheduler{
+		maxConcurrentRequests: maxConcurrentRequests,
+		maxQueueSize:          maxQueueSize,
+		minAccessMana:         minAccessMana,
+		queueTimeout:          queueTimeout,
+	}
+}
+
+// acquire blocks until the request of an issuer with the given access mana may be served. It returns an error if the
+// request was rejected, displaced from the queue by a request with more access mana or not served in time. Every
+// successful call must be followed by a call to release.
+func (s *issuanceScheduler) acquire(ctx context.Context, accessMana float64) error {
+	s.mutex.Lock()
+	if s.runningRequests < s.maxConcurrentRequests {
+		s.runningRequests++
+		s.mutex.Unlock()
+		return nil
+	}
+
+	if accessMana < s.minAccessMana {
+		s.mutex.Unlock()
+		return errors.Errorf("requests of issuers with less than %g access mana are rejected: %w", s.minAccessMana, ErrIssuanceCapacityExhausted)
+	}
+
+	if s.queue.Len() >= s.maxQueueSize {
+		lowestPriorityRequest := s.queue.lowestPriorityRequest()
+		if lowestPriorityRequest == nil || lowestPriorityRequest.accessMana >= accessMana {
+			s.mutex.Unlock()
+			return errors.Errorf("issuance queue is full: %w", ErrIssuanceCapacityExhausted)
+		}
+
+		heap.Remove(&s.queue, lowestPriorityRequest.index)
+		lowestPriorityRequest.admitted <- false
+	}
+
+	s.sequence++
+	request := &waitingRequest{
+		accessMana: accessMana,
+		sequence:   s.sequence,
+		admitted:   make(chan bool, 1),
+	}
+	heap.Push(&s.queue, request)
+	s.mutex.Unlock()
+
+	timer := time.NewTimer(s.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case admitted := <-request.admitted:
+		return s.admissionError(admitted)
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	s.mutex.Lock()
+	if request.index >= 0 {
+		heap.Remove(&s.queue, request.index)
+		s.mutex.Unlock()
+		return errors.Errorf("request was not served within %s: %w", s.queueTimeout, ErrIssuanceCapacityExhausted)
+	}
+	s.mutex.Unlock()
+
+	// the request was admitted or displaced concurrently
+	return s.admissionError(<-request.admitted)
+}
+
+// admissionError returns the error of a queued request that was admitted or displaced from the queue.
+func (s *issuanceScheduler) admissionError(admitted bool) error {
+	if !admitted {
+		return errors.Errorf("request was displaced by requests of issuers with more access mana: %w", ErrIssuanceCapacityExhausted)
+	}
+
+	return nil
+}
+
+// release marks a served request as finished and hands its slot to the queued request with the highest priority.
+func (s *issuanceScheduler) release() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.queue.Len() == 0 {
+		s.runningRequests--
+		return
+	}
+
+	heap.Pop(&s.queue).(*waitingRequest).admitted <- true
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region waitingRequests //////////////////////////////////////////////////////////////////////////////////////////////
+
+// waitingRequest is a request that waits for a slot of the issuanceScheduler.
+type waitingRequest struct {
+	accessMana float64
+	sequence   uint64
+	index      int
+	admitted   chan bool
+}
+
+// hasPriorityOver returns true if the request is served before the given request. Requests with more access mana are
+// served first, and requests with the same access mana in the order of their arrival.
+func (w *waitingRequest) hasPriorityOver(other *waitingRequest) bool {
+	if w.accessMana != other.accessMana {
+		return w.accessMana > other.accessMana
+	}
+
+	return w.sequence < other.sequence
+}
+
+// waitingRequests is a priority queue of waitingRequests that implements the heap.Interface.
+type waitingRequests []*waitingRequest
+
+// Len returns the number of waiting requests.
+func (w waitingRequests) Len() int {
+	return len(w)
+}
+
+// Less returns true if the request at index i has priority over the request at index j.
+func (w waitingRequests) Less(i, j int) bool {
+	return w[i].hasPriorityOver(w[j])
+}
+
+// Swap swaps the requests at the given indices.
+func (w waitingRequests) Swap(i, j int) {
+	w[i], w[j] = w[j], w[i]
+	w[i].index = i
+	w[j].index = j
+}
+
+// Push adds a request to the queue.
+func (w *waitingRequests) Push(x interface{}) {
+	request := x.(*waitingRequest)
+	request.index = len(*w)
+	*w = append(*w, request)
+}
+
+// Pop removes the last request from the queue.
+func (w *waitingRequests) Pop() interface{} {
+	old := *w
+	n := len(old)
+	request := old[n-1]
+	old[n-1] = nil
+	request.index = -1
+	*w = old[:n-1]
+
+	return request
+}
+
+// lowestPriorityRequest returns the request that is served last.
+func (w waitingRequests) lowestPriorityRequest() (lowestPriorityRequest *waitingRequest) {
+	for _, request := range w {
+		if lowestPriorityRequest == nil || lowestPriorityRequest.hasPriorityOver(request) {
+			lowestPriorityRequest = request
+		}
+	}
+
+	return lowestPriorityRequest
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,154 @@
+package webapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestIssuanceScheduler(t *testing.T) {
+	scheduler := newIssuanceScheduler(1, 2, 10, time.Minute)
+	require.NoError(t, scheduler.acquire(context.Background(), 0))
+
+	// requests below the minimum access mana are rejected while the capacity is exhausted
+	assert.ErrorIs(t, scheduler.acquire(context.Background(), 5), ErrIssuanceCapacityExhausted)
+
+	served := make(chan float64, 3)
+	results := make(map[float64]chan error)
+	queueRequest := func(accessMana float64) {
+		result := make(chan error, 1)
+		results[accessMana] = result
+		go func() {
+			err := scheduler.acquire(context.Background(), accessMana)
+			if err == nil {
+				served <- accessMana
+				scheduler.release()
+			}
+			result <- err
+		}()
+	}
+	waitForQueueSize := func(queueSize int) {
+		require.Eventually(t, func() bool {
+			scheduler.mutex.Lock()
+			defer scheduler.mutex.Unlock()
+			return scheduler.queue.Len() == queueSize
+		}, time.Second, time.Millisecond)
+	}
+
+	queueRequest(20)
+	waitForQueueSize(1)
+	queueRequest(30)
+	waitForQueueSize(2)
+
+	// the request with the least access mana is displaced from the full queue
+	queueRequest(40)
+	assert.ErrorIs(t, <-results[20], ErrIssuanceCapacityExhausted)
+
+	// the queued requests are served in the order of their access mana
+	scheduler.release()
+	assert.NoError(t, <-results[40])
+	assert.NoError(t, <-results[30])
+	assert.Equal(t, 40.0, <-served)
+	assert.Equal(t, 30.0, <-served)
+
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	assert.Equal(t, 0, scheduler.runningRequests)
+}
+
+func TestIssuanceScheduler_Timeout(t *testing.T) {
+	scheduler := newIssuanceScheduler(1, 1, 0, 10*time.Millisecond)
+	require.NoError(t, scheduler.acquire(context.Background(), 0))
+
+	assert.ErrorIs(t, scheduler.acquire(context.Background(), 0), ErrIssuanceCapacityExhausted)
+	assert.Equal(t, 0, scheduler.queue.Len())
+
+	scheduler.release()
+	assert.NoError(t, scheduler.acquire(context.Background(), 0))
+}
+
+func TestIssuancePrioritizationMiddleware(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+	challenges := newIssuerChallenges(time.Minute)
+
+	var receivedAccessMana float64
+	server := echo.New()
+	server.Use(issuancePrioritizationMiddleware(newIssuanceScheduler(1, 1, 0, time.Second), challenges, []string{"data"}, func(issuerID identity.ID) float64 {
+		assert.Equal(t, localIdentity.ID(), issuerID)
+		receivedAccessMana = 100
+		return receivedAccessMana
+	}))
+	server.POST("/data", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	doRequest := func(path, body string, headers map[string]string) int {
+		request := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		for key, value := range headers {
+			request.Header.Set(key, value)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder.Code
+	}
+	signRequest := func(challenge, path, body string) map[string]string {
+		return map[string]string{
+			jsonmodels.IssuerPublicKeyHeader: localIdentity.PublicKey().String(),
+			jsonmodels.IssuerChallengeHeader: challenge,
+			jsonmodels.IssuerSignatureHeader: localIdentity.Sign(jsonmodels.IssuerSignedMessage(challenge, http.MethodPost, path, []byte(body))).String(),
+		}
+	}
+
+	// anonymous requests are served without looking up any access mana
+	assert.Equal(t, http.StatusOK, doRequest("/data", "", nil))
+	assert.Zero(t, receivedAccessMana)
+
+	challenge, _ := challenges.New()
+	signedHeaders := signRequest(challenge, "/data", "body")
+	assert.Equal(t, http.StatusOK, doRequest("/data", "body", signedHeaders))
+	assert.Equal(t, 100.0, receivedAccessMana)
+
+	// challenges can only be used once
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/data", "body", signedHeaders))
+
+	// signatures of other requests are rejected
+	challenge, _ = challenges.New()
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/data", "other body", signRequest(challenge, "/data", "body")))
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/data", "body", signRequest(challenge, "/other", "body")))
+
+	// the rejected signatures did not use up the challenge
+	assert.Equal(t, http.StatusOK, doRequest("/data", "body", signRequest(challenge, "/data", "body")))
+
+	// forged challenges are rejected
+	otherChallenge, _ := newIssuerChallenges(time.Minute).New()
+	assert.Equal(t, http.StatusUnauthorized, doRequest("/data", "body", signRequest(otherChallenge, "/data", "body")))
+
+	// expired challenges are rejected
+	expiredChallenges := newIssuerChallenges(-time.Second)
+	expiredChallenges.secret = challenges.secret
+	expiredChallenge, _ := expiredChallenges.New()
+	_, err := challenges.verify(expiredChallenge)
+	assert.Error(t, err)
+}
+
+func TestIssuerChallenges_Redeem(t *testing.T) {
+	challenges := newIssuerChallenges(time.Minute)
+
+	assert.True(t, challenges.redeem("challenge", time.Now().Add(time.Minute)))
+	assert.False(t, challenges.redeem("challenge", time.Now().Add(time.Minute)))
+
+	// expired challenges are forgotten during the next cleanup
+	assert.True(t, challenges.redeem("expired", time.Now().Add(-time.Second)))
+	challenges.nextCleanup = time.Time{}
+	assert.True(t, challenges.redeem("other", time.Now().Add(time.Minute)))
+	assert.NotContains(t, challenges.redeemed, "expired")
+	assert.Contains(t, challenges.redeemed, "challenge")
+}
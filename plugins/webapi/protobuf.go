@@ -0,0 +1,45 @@
+package webapi
+
+import (
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// IsProtobufRequest returns true if the body of the request is encoded with protobuf.
+func IsProtobufRequest(c echo.Context) bool {
+	return strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), jsonmodels.ContentTypeProtobuf)
+}
+
+// AcceptsProtobuf returns true if the client accepts a protobuf encoded response.
+func AcceptsProtobuf(c echo.Context) bool {
+	return strings.Contains(c.Request().Header.Get(echo.HeaderAccept), jsonmodels.ContentTypeProtobuf)
+}
+
+// BindProtobuf decodes the protobuf encoded body of the request into the given message.
+func BindProtobuf(c echo.Context, message proto.Message) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return errors.Errorf("failed to read request body: %w", err)
+	}
+	if err = proto.Unmarshal(body, message); err != nil {
+		return errors.Errorf("can't parse request body as protobuf into %T: %w", message, err)
+	}
+
+	return nil
+}
+
+// Protobuf sends the protobuf encoded message with the given status code as the response.
+func Protobuf(c echo.Context, code int, message proto.Message) error {
+	data, err := proto.Marshal(message)
+	if err != nil {
+		return errors.Errorf("failed to encode %T with protobuf: %w", message, err)
+	}
+
+	return c.Blob(code, jsonmodels.ContentTypeProtobuf, data)
+}
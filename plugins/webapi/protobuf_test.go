@@ -0,0 +1,76 @@
+package webapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/webapiproto"
+)
+
+func TestProtobuf(t *testing.T) {
+	server := echo.New()
+	server.POST("/test", func(c echo.Context) error {
+		request := new(jsonmodels.PostPayloadRequest)
+		if IsProtobufRequest(c) {
+			protobufRequest := new(webapiproto.PostPayloadRequest)
+			if err := BindProtobuf(c, protobufRequest); err != nil {
+				return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+			}
+			request.Payload = protobufRequest.Payload
+		} else if err := c.Bind(request); err != nil {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+
+		if AcceptsProtobuf(c) {
+			return Protobuf(c, http.StatusOK, &webapiproto.PostPayloadResponse{Id: request.Payload})
+		}
+		return c.JSON(http.StatusOK, &jsonmodels.PostPayloadResponse{ID: string(request.Payload)})
+	})
+
+	protobufRequest, err := proto.Marshal(&webapiproto.PostPayloadRequest{Payload: []byte("payload")})
+	require.NoError(t, err)
+
+	// protobuf request and response
+	recorder := doProtobufTestRequest(server, jsonmodels.ContentTypeProtobuf, jsonmodels.ContentTypeProtobuf, protobufRequest)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.Equal(t, jsonmodels.ContentTypeProtobuf, recorder.Header().Get(echo.HeaderContentType))
+	response := new(webapiproto.PostPayloadResponse)
+	require.NoError(t, proto.Unmarshal(recorder.Body.Bytes(), response))
+	assert.Equal(t, []byte("payload"), response.Id)
+
+	// protobuf request with a JSON response
+	recorder = doProtobufTestRequest(server, jsonmodels.ContentTypeProtobuf, "", protobufRequest)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"id":"payload"}`, recorder.Body.String())
+
+	// JSON request with a protobuf response
+	recorder = doProtobufTestRequest(server, echo.MIMEApplicationJSON, "application/json, "+jsonmodels.ContentTypeProtobuf, []byte(`{"payload":"cGF5bG9hZA=="}`))
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.NoError(t, proto.Unmarshal(recorder.Body.Bytes(), response))
+	assert.Equal(t, []byte("payload"), response.Id)
+
+	// invalid protobuf requests are rejected
+	recorder = doProtobufTestRequest(server, jsonmodels.ContentTypeProtobuf, jsonmodels.ContentTypeProtobuf, []byte{0xFF})
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+}
+
+func doProtobufTestRequest(server *echo.Echo, contentType, accept string, body []byte) *httptest.ResponseRecorder {
+	request := httptest.NewRequest(http.MethodPost, "/test", bytes.NewReader(body))
+	request.Header.Set(echo.HeaderContentType, contentType)
+	if accept != "" {
+		request.Header.Set(echo.HeaderAccept, accept)
+	}
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	return recorder
+}
@@ -0,0 +1,84 @@
+package webapi
+
+import (
+	"bytes"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// region responseSigningMiddleware ////////////////////////////////////////////////////////////////////////////////////
+
+// responseSigningMiddleware returns a middleware that signs the responses of the given routes with the identity of the
+// node, so that light clients can prove which answer the node gave to their requests. The routes are matched against
+// the paths that the handlers were registered with (e.g. ledgerstate/addresses/:address/balance).
+func responseSigningMiddleware(localIdentity *identity.LocalIdentity, signedRoutes []string) echo.MiddlewareFunc {
+	isSignedRoute := make(map[string]bool, len(signedRoutes))
+	for _, signedRoute := range signedRoutes {
+		isSignedRoute["/"+strings.Trim(signedRoute, "/")] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isSignedRoute["/"+strings.Trim(c.Path(), "/")] {
+				return next(c)
+			}
+
+			// the signature headers need to be written before the body, so the response is buffered until it is complete
+			buffer := &bufferedResponseWriter{ResponseWriter: c.Response().Writer, statusCode: http.StatusOK}
+			c.Response().Writer = buffer
+			defer func() {
+				c.Response().Writer = buffer.ResponseWriter
+			}()
+
+			if err := next(c); err != nil {
+				// let the error handler write the response, so that errors are signed as well
+				c.Error(err)
+			}
+
+			request := c.Request()
+			signedResponse := jsonmodels.NewSignedResponse(request.Method, request.URL.RequestURI(), buffer.statusCode, time.Now().Unix(), buffer.body.Bytes(), localIdentity.PublicKey(), localIdentity.Sign)
+			for key, values := range signedResponse.Header() {
+				buffer.Header()[key] = values
+			}
+
+			return buffer.flush()
+		}
+	}
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region bufferedResponseWriter ///////////////////////////////////////////////////////////////////////////////////////
+
+// bufferedResponseWriter is a http.ResponseWriter that holds back the status code and the body until it is flushed.
+type bufferedResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+	body       bytes.Buffer
+}
+
+// WriteHeader records the status code of the response.
+func (b *bufferedResponseWriter) WriteHeader(statusCode int) {
+	b.statusCode = statusCode
+}
+
+// Write buffers the data.
+func (b *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return b.body.Write(data)
+}
+
+// flush writes the status code and the buffered body to the underlying writer.
+func (b *bufferedResponseWriter) flush() error {
+	b.ResponseWriter.WriteHeader(b.statusCode)
+	_, err := b.ResponseWriter.Write(b.body.Bytes())
+
+	return err
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,65 @@
+package webapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+func TestResponseSigningMiddleware(t *testing.T) {
+	localIdentity := identity.GenerateLocalIdentity()
+
+	server := echo.New()
+	server.Use(responseSigningMiddleware(localIdentity, []string{"balances/:address"}))
+	server.GET("balances/:address", func(c echo.Context) error {
+		if c.Param("address") == "invalid" {
+			return echo.ErrBadRequest
+		}
+		return c.JSON(http.StatusOK, map[string]uint64{c.Param("address"): 1337})
+	})
+	server.GET("info", func(c echo.Context) error {
+		return c.JSON(http.StatusOK, nil)
+	})
+
+	doRequest := func(requestURI string) (*httptest.ResponseRecorder, *jsonmodels.SignedResponse, error) {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, requestURI, nil))
+		signedResponse, err := jsonmodels.SignedResponseFromHeader(http.MethodGet, requestURI, recorder.Code, recorder.Header(), recorder.Body.Bytes())
+
+		return recorder, signedResponse, err
+	}
+
+	recorder, signedResponse, err := doRequest("/balances/address?details=true")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"address":1337}`, recorder.Body.String())
+	assert.NoError(t, signedResponse.Verify(localIdentity.PublicKey()))
+
+	// the signature does not match a different request, a modified body or another node
+	signedResponse.RequestURI = "/balances/otherAddress"
+	assert.ErrorIs(t, signedResponse.Verify(localIdentity.PublicKey()), jsonmodels.ErrInvalidResponseSignature)
+	signedResponse.RequestURI = "/balances/address?details=true"
+	signedResponse.Body = []byte(`{"address":1338}`)
+	assert.ErrorIs(t, signedResponse.Verify(localIdentity.PublicKey()), jsonmodels.ErrInvalidResponseSignature)
+	signedResponse.Body = []byte(`{"address":1337}`)
+	signedResponse.StatusCode = http.StatusNotFound
+	assert.ErrorIs(t, signedResponse.Verify(localIdentity.PublicKey()), jsonmodels.ErrInvalidResponseSignature)
+	assert.ErrorIs(t, signedResponse.Verify(identity.GenerateLocalIdentity().PublicKey()), jsonmodels.ErrInvalidResponseSignature)
+
+	// errors are signed as well
+	recorder, signedResponse, err = doRequest("/balances/invalid")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	assert.NoError(t, signedResponse.Verify(localIdentity.PublicKey()))
+
+	// the responses of other routes are not signed
+	_, _, err = doRequest("/info")
+	assert.ErrorIs(t, err, jsonmodels.ErrResponseNotSigned)
+}
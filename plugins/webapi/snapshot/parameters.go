@@ -0,0 +1,22 @@
+package snapshot
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the snapshot endpoint.
+type ParametersDefinition struct {
+	// ChunkSize defines the amount of payload bytes that are covered by a single checksum of a chunked snapshot.
+	ChunkSize int `default:"1048576" usage:"the size of the checksummed chunks of a streamed snapshot (in bytes, at most 16 MiB)"`
+	// MaxAge defines for how long a created chunked snapshot is served before it is replaced by a fresh one.
+	MaxAge time.Duration `default:"10m" usage:"the time after which a streamed snapshot is recreated"`
+}
+
+// Parameters contains the configuration used by the snapshot endpoint.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "webAPI.snapshot")
+}
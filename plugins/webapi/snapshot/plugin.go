@@ -1,13 +1,20 @@
 package snapshot
 
 import (
+	"fmt"
+	"net/http"
 	"os"
+	"sync"
+	"time"
 
 	"go.uber.org/dig"
 
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/ledgerstate"
 	"github.com/iotaledger/goshimmer/packages/mana"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
 	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/plugins/config"
 	"github.com/iotaledger/goshimmer/plugins/messagelayer"
 
 	"github.com/iotaledger/hive.go/identity"
@@ -18,7 +25,8 @@ import (
 // region Plugin ///////////////////////////////////////////////////////////////////////////////////////////////////////
 
 const (
-	snapshotFileName = "snapshot.bin"
+	snapshotFileName        = "snapshot.bin"
+	chunkedSnapshotFileName = "snapshot_v2.bin"
 )
 
 type dependencies struct {
@@ -33,6 +41,13 @@ var (
 	Plugin *node.Plugin
 
 	deps = new(dependencies)
+
+	// chunkedSnapshotMutex guards the creation of the chunked snapshot file.
+	chunkedSnapshotMutex sync.Mutex
+	// chunkedSnapshotETag contains the entity tag of the currently served chunked snapshot.
+	chunkedSnapshotETag string
+	// chunkedSnapshotCreated contains the time when the currently served chunked snapshot was created.
+	chunkedSnapshotCreated time.Time
 )
 
 func init() {
@@ -41,6 +56,7 @@ func init() {
 
 func configure(_ *node.Plugin) {
 	deps.Server.GET("snapshot", DumpCurrentLedger)
+	deps.Server.GET("snapshot/v2", StreamChunkedSnapshot)
 }
 
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -77,6 +93,95 @@ func DumpCurrentLedger(c echo.Context) (err error) {
 	return c.Attachment(snapshotFileName, snapshotFileName)
 }
 
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region StreamChunkedSnapshot ////////////////////////////////////////////////////////////////////////////////////////
+
+// StreamChunkedSnapshot streams a snapshot in the chunked and checksummed format. The same snapshot is served for the
+// configured MaxAge, so that clients can resume interrupted downloads with HTTP range requests.
+func StreamChunkedSnapshot(c echo.Context) (err error) {
+	f, eTag, err := openChunkedSnapshot()
+	if err != nil {
+		Plugin.LogErrorf("unable to create chunked snapshot: %s", err)
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+	defer f.Close()
+
+	fileInfo, err := f.Stat()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	// the ETag allows clients to resume via "If-Range" only as long as the snapshot did not change.
+	c.Response().Header().Set("ETag", eTag)
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", chunkedSnapshotFileName))
+	http.ServeContent(c.Response(), c.Request(), fileInfo.Name(), fileInfo.ModTime(), f)
+
+	return nil
+}
+
+// openChunkedSnapshot opens the current chunked snapshot file and returns it together with its entity tag. Both are
+// retrieved under the same lock, so that the served file always matches its ETag even if the snapshot is replaced while
+// it is being streamed.
+func openChunkedSnapshot() (f *os.File, eTag string, err error) {
+	chunkedSnapshotMutex.Lock()
+	defer chunkedSnapshotMutex.Unlock()
+
+	if eTag, err = currentChunkedSnapshot(); err != nil {
+		return nil, "", err
+	}
+	if f, err = os.Open(chunkedSnapshotFileName); err != nil {
+		return nil, "", err
+	}
+
+	return f, eTag, nil
+}
+
+// currentChunkedSnapshot makes sure that an up-to-date chunked snapshot file exists and returns its entity tag. It must
+// be called while holding the chunkedSnapshotMutex.
+func currentChunkedSnapshot() (eTag string, err error) {
+	if chunkedSnapshotETag != "" && time.Since(chunkedSnapshotCreated) < Parameters.MaxAge {
+		return chunkedSnapshotETag, nil
+	}
+
+	ledgerSnapshot := deps.Tangle.LedgerState.SnapshotUTXO()
+	if ledgerSnapshot.AccessManaByNode, err = snapshotAccessMana(); err != nil {
+		return "", err
+	}
+
+	tmpFileName := chunkedSnapshotFileName + ".tmp"
+	f, err := os.OpenFile(tmpFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return "", err
+	}
+	n, err := snapshot.Write(f, ledgerSnapshot, Parameters.ChunkSize, config.Parameters.NetworkID)
+	if err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	if _, err = f.Seek(0, 0); err != nil {
+		_ = f.Close()
+		return "", err
+	}
+	header, err := snapshot.ReadHeader(f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", err
+	}
+	if err = os.Rename(tmpFileName, chunkedSnapshotFileName); err != nil {
+		return "", err
+	}
+
+	Plugin.LogInfof("Created chunked snapshot with %d transactions and %d accessManaEntries (%s, %d bytes)", len(ledgerSnapshot.Transactions), len(ledgerSnapshot.AccessManaByNode), header, n)
+
+	chunkedSnapshotETag = header.ETag()
+	chunkedSnapshotCreated = time.Now()
+
+	return chunkedSnapshotETag, nil
+}
+
 // snapshotAccessMana returns snapshot of the current access mana.
 func snapshotAccessMana() (aManaSnapshot map[identity.ID]ledgerstate.AccessMana, err error) {
 	aManaSnapshot = make(map[identity.ID]ledgerstate.AccessMana)
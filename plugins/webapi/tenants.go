@@ -0,0 +1,465 @@
+package webapi
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// tenantContextKey is the key under which the tenant of a request is stored in the echo.Context.
+const tenantContextKey = "tenant"
+
+var (
+	// ErrInvalidAPIKey is returned if a request carries an unknown API key or lacks an API key that is required.
+	ErrInvalidAPIKey = errors.New("invalid API key")
+
+	// ErrRouteNotAllowed is returned if a tenant requests a route that is reserved for the operators of the node.
+	ErrRouteNotAllowed = errors.New("route is not available to tenants")
+
+	// ErrTenantQuotaExceeded is returned if a tenant exhausted its issuance quota.
+	ErrTenantQuotaExceeded = errors.New("issuance quota of the tenant is exhausted")
+
+	// ErrTooManyWatchedAddresses is returned if a tenant watches the maximum number of addresses already.
+	ErrTooManyWatchedAddresses = errors.New("tenant watches too many addresses")
+)
+
+// region tenantAuthenticationMiddleware ///////////////////////////////////////////////////////////////////////////////
+
+// tenantAuthenticationMiddleware returns a middleware that scopes requests to the tenant that their API key belongs to.
+// Requests with an unknown API key are rejected, while requests without an API key are only rejected if an API key is
+// required and the route is not public. Tenants can only access the given tenantRoutes (and the routes below them), so
+// that they can't interfere with the operation of the node, while the API keys of the operators can access all routes.
+func tenantAuthenticationMiddleware(registry *tenantRegistry, required bool, publicRoutes, tenantRoutes []string) echo.MiddlewareFunc {
+	isPublicRoute := make(map[string]bool, len(publicRoutes))
+	for _, publicRoute := range publicRoutes {
+		isPublicRoute["/"+strings.Trim(publicRoute, "/")] = true
+	}
+	isTenantRoute := make(map[string]bool, len(tenantRoutes))
+	for _, tenantRoute := range tenantRoutes {
+		isTenantRoute["/"+strings.Trim(tenantRoute, "/")] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			apiKey := c.Request().Header.Get(jsonmodels.APIKeyHeader)
+			if apiKey == "" {
+				if required && !isPublicRoute["/"+strings.Trim(c.Request().URL.Path, "/")] {
+					return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(errors.Errorf("missing %s header: %w", jsonmodels.APIKeyHeader, ErrInvalidAPIKey)))
+				}
+
+				return next(c)
+			}
+
+			if registry.IsOperator(apiKey) {
+				return next(c)
+			}
+
+			tenant, exists := registry.Tenant(apiKey)
+			if !exists {
+				return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(ErrInvalidAPIKey))
+			}
+			if !matchesRoutePrefix(isTenantRoute, c.Request().URL.Path) {
+				return c.JSON(http.StatusForbidden, jsonmodels.NewErrorResponse(ErrRouteNotAllowed))
+			}
+			c.Set(tenantContextKey, tenant)
+
+			return next(c)
+		}
+	}
+}
+
+// matchesRoutePrefix returns true if the given path or one of its parents is contained in the given routes.
+func matchesRoutePrefix(routes map[string]bool, path string) bool {
+	for route := "/" + strings.Trim(path, "/"); ; {
+		if routes[route] {
+			return true
+		}
+
+		parentEnd := strings.LastIndex(route, "/")
+		if parentEnd <= 0 {
+			return false
+		}
+		route = route[:parentEnd]
+	}
+}
+
+// tenantFromContext returns the tenant that the request of the given echo.Context is scoped to.
+func tenantFromContext(c echo.Context) (t *tenant, exists bool) {
+	t, exists = c.Get(tenantContextKey).(*tenant)
+
+	return t, exists
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region tenantIssuanceMiddleware /////////////////////////////////////////////////////////////////////////////////////
+
+// tenantIssuanceMiddleware returns a middleware that enforces the issuance quota of the tenants for POST requests to
+// the given routes and that adds the issued messages to the index of the tenant. The messages are determined from the
+// id or the transaction_id field of the JSON response, where the latter is resolved with the given function.
+func tenantIssuanceMiddleware(issuanceRoutes []string, transactionAttachments func(ledgerstate.TransactionID) tangle.MessageIDs) echo.MiddlewareFunc {
+	isIssuanceRoute := make(map[string]bool, len(issuanceRoutes))
+	for _, issuanceRoute := range issuanceRoutes {
+		isIssuanceRoute["/"+strings.Trim(issuanceRoute, "/")] = true
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			request := c.Request()
+			tenant, exists := tenantFromContext(c)
+			if !exists || request.Method != http.MethodPost || !isIssuanceRoute["/"+strings.Trim(request.URL.Path, "/")] {
+				return next(c)
+			}
+
+			if resetsAt, err := tenant.reserveIssuance(); err != nil {
+				c.Response().Header().Set("Retry-After", strconv.Itoa(int(time.Until(resetsAt).Seconds())+1))
+				return c.JSON(http.StatusTooManyRequests, jsonmodels.NewErrorResponse(err))
+			}
+
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+			defer func() {
+				c.Response().Writer = recorder.ResponseWriter
+			}()
+
+			if err := next(c); err != nil {
+				// let the error handler write the response so that we know whether the request succeeded
+				c.Error(err)
+			}
+
+			if status := c.Response().Status; status < http.StatusOK || status >= http.StatusMultipleChoices {
+				tenant.releaseIssuance()
+				return nil
+			}
+			tenant.addMessages(issuedMessageIDs(recorder.body.Bytes(), transactionAttachments))
+
+			return nil
+		}
+	}
+}
+
+// issuedMessageIDs returns the IDs of the messages that were issued according to the given JSON response body.
+func issuedMessageIDs(responseBody []byte, transactionAttachments func(ledgerstate.TransactionID) tangle.MessageIDs) (messageIDs []tangle.MessageID) {
+	var response struct {
+		ID            string `json:"id"`
+		TransactionID string `json:"transaction_id"`
+	}
+	if err := json.Unmarshal(responseBody, &response); err != nil {
+		return nil
+	}
+
+	if messageID, err := tangle.NewMessageID(response.ID); err == nil && response.ID != "" {
+		return []tangle.MessageID{messageID}
+	}
+
+	if transactionID, err := ledgerstate.TransactionIDFromBase58(response.TransactionID); err == nil && response.TransactionID != "" && transactionAttachments != nil {
+		for messageID := range transactionAttachments(transactionID) {
+			messageIDs = append(messageIDs, messageID)
+		}
+	}
+
+	return messageIDs
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region tenantRegistry ///////////////////////////////////////////////////////////////////////////////////////////////
+
+// tenantRegistry contains the tenants of the node mapped by the hash of their API keys, and the hashes of the API keys
+// of the operators of the node.
+type tenantRegistry struct {
+	tenantsByKeyHash  map[[sha256.Size]byte]*tenant
+	tenantsByName     map[string]*tenant
+	operatorKeyHashes map[[sha256.Size]byte]bool
+}
+
+// newTenantRegistry creates the tenants from the given API keys, which have the form <tenant>:<apiKey>[:<quota>]. A
+// tenant can have several API keys, but the quota is shared by all of them. API keys without a quota get the given
+// default quota. The operatorAPIKeys are not scoped to a tenant and can access all routes.
+func newTenantRegistry(apiKeys, operatorAPIKeys []string, defaultQuota int, quotaPeriod time.Duration, maxIndexedMessages, maxWatchedAddresses int) (registry *tenantRegistry, err error) {
+	registry = &tenantRegistry{
+		tenantsByKeyHash:  make(map[[sha256.Size]byte]*tenant),
+		tenantsByName:     make(map[string]*tenant),
+		operatorKeyHashes: make(map[[sha256.Size]byte]bool),
+	}
+
+	for _, operatorAPIKey := range operatorAPIKeys {
+		if operatorAPIKey == "" {
+			return nil, errors.New("invalid operator API key: the API key must not be empty")
+		}
+		registry.operatorKeyHashes[sha256.Sum256([]byte(operatorAPIKey))] = true
+	}
+
+	for _, apiKeyDefinition := range apiKeys {
+		parts := strings.Split(apiKeyDefinition, ":")
+		if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+			return nil, errors.Errorf("invalid API key definition %q: expected <tenant>:<apiKey>[:<issuanceQuota>]", parts[0])
+		}
+
+		quota := defaultQuota
+		if len(parts) == 3 {
+			if quota, err = strconv.Atoi(parts[2]); err != nil || quota < 0 {
+				return nil, errors.Errorf("invalid issuance quota of tenant %s: %s", parts[0], parts[2])
+			}
+		}
+
+		keyHash := sha256.Sum256([]byte(parts[1]))
+		if _, exists := registry.tenantsByKeyHash[keyHash]; exists || registry.operatorKeyHashes[keyHash] {
+			return nil, errors.Errorf("API key of tenant %s is used more than once", parts[0])
+		}
+
+		t, exists := registry.tenantsByName[parts[0]]
+		if !exists {
+			t = newTenant(parts[0], quota, quotaPeriod, maxIndexedMessages, maxWatchedAddresses)
+			registry.tenantsByName[parts[0]] = t
+		} else if len(parts) == 3 {
+			t.issuanceQuota = quota
+		}
+		registry.tenantsByKeyHash[keyHash] = t
+	}
+
+	return registry, nil
+}
+
+// Tenant returns the tenant that the given API key belongs to.
+func (t *tenantRegistry) Tenant(apiKey string) (tenant *tenant, exists bool) {
+	tenant, exists = t.tenantsByKeyHash[sha256.Sum256([]byte(apiKey))]
+
+	return tenant, exists
+}
+
+// IsOperator returns true if the given API key belongs to an operator of the node.
+func (t *tenantRegistry) IsOperator(apiKey string) bool {
+	return t.operatorKeyHashes[sha256.Sum256([]byte(apiKey))]
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region tenant ///////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// tenant is a customer of a shared node. It keeps track of the issuance quota, the issued messages and the watched
+// addresses of the customer, which are kept in memory and are only visible to the customer itself.
+type tenant struct {
+	name                string
+	issuanceQuota       int
+	quotaPeriod         time.Duration
+	maxIndexedMessages  int
+	maxWatchedAddresses int
+
+	periodStart      time.Time
+	issuedMessages   int
+	messageIDs       []tangle.MessageID
+	truncated        bool
+	watchedAddresses map[string]ledgerstate.Address
+	mutex            sync.RWMutex
+}
+
+// newTenant creates a new tenant with the given limits.
+func newTenant(name string, issuanceQuota int, quotaPeriod time.Duration, maxIndexedMessages, maxWatchedAddresses int) *tenant {
+	return &tenant{
+		name:                name,
+		issuanceQuota:       issuanceQuota,
+		quotaPeriod:         quotaPeriod,
+		maxIndexedMessages:  maxIndexedMessages,
+		maxWatchedAddresses: maxWatchedAddresses,
+		watchedAddresses:    make(map[string]ledgerstate.Address),
+	}
+}
+
+// reserveIssuance counts an issuance towards the quota of the current period. It returns an error together with the end
+// of the period if the quota is exhausted.
+func (t *tenant) reserveIssuance() (resetsAt time.Time, err error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if now := time.Now(); t.periodStart.IsZero() || !now.Before(t.periodStart.Add(t.quotaPeriod)) {
+		t.periodStart = now
+		t.issuedMessages = 0
+	}
+
+	if t.issuanceQuota > 0 && t.issuedMessages >= t.issuanceQuota {
+		return t.periodStart.Add(t.quotaPeriod), errors.Errorf("%d messages per %s: %w", t.issuanceQuota, t.quotaPeriod, ErrTenantQuotaExceeded)
+	}
+	t.issuedMessages++
+
+	return t.periodStart.Add(t.quotaPeriod), nil
+}
+
+// releaseIssuance refunds an issuance that was reserved for a request that failed.
+func (t *tenant) releaseIssuance() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.issuedMessages > 0 {
+		t.issuedMessages--
+	}
+}
+
+// addMessages adds the given messages to the index of the tenant and drops the oldest messages that exceed the limit.
+func (t *tenant) addMessages(messageIDs []tangle.MessageID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.messageIDs = append(t.messageIDs, messageIDs...)
+	if excess := len(t.messageIDs) - t.maxIndexedMessages; excess > 0 {
+		t.messageIDs = append(make([]tangle.MessageID, 0, t.maxIndexedMessages), t.messageIDs[excess:]...)
+		t.truncated = true
+	}
+}
+
+// Messages returns the indexed messages of the tenant in the order of their issuance and whether older messages were
+// dropped from the index.
+func (t *tenant) Messages() (messageIDs []tangle.MessageID, truncated bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	messageIDs = make([]tangle.MessageID, len(t.messageIDs))
+	copy(messageIDs, t.messageIDs)
+
+	return messageIDs, t.truncated
+}
+
+// WatchAddress adds the given address to the addresses that are watched by the tenant.
+func (t *tenant) WatchAddress(address ledgerstate.Address) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, exists := t.watchedAddresses[address.Base58()]; exists {
+		return nil
+	}
+	if len(t.watchedAddresses) >= t.maxWatchedAddresses {
+		return errors.Errorf("maximum of %d addresses reached: %w", t.maxWatchedAddresses, ErrTooManyWatchedAddresses)
+	}
+	t.watchedAddresses[address.Base58()] = address
+
+	return nil
+}
+
+// UnwatchAddress removes the given address from the addresses that are watched by the tenant. It returns false if the
+// address was not watched.
+func (t *tenant) UnwatchAddress(address ledgerstate.Address) (removed bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if _, removed = t.watchedAddresses[address.Base58()]; removed {
+		delete(t.watchedAddresses, address.Base58())
+	}
+
+	return removed
+}
+
+// WatchedAddresses returns the base58 encoded addresses that are watched by the tenant in lexical order.
+func (t *tenant) WatchedAddresses() (addresses []string) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	addresses = make([]string, 0, len(t.watchedAddresses))
+	for address := range t.watchedAddresses {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+// Response returns the JSON model of the tenant.
+func (t *tenant) Response() *jsonmodels.TenantResponse {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	response := &jsonmodels.TenantResponse{
+		Name:             t.name,
+		IssuanceQuota:    t.issuanceQuota,
+		IndexedMessages:  len(t.messageIDs),
+		WatchedAddresses: len(t.watchedAddresses),
+	}
+	if !t.periodStart.IsZero() && time.Now().Before(t.periodStart.Add(t.quotaPeriod)) {
+		response.IssuedMessages = t.issuedMessages
+		response.QuotaResetsAt = t.periodStart.Add(t.quotaPeriod).Unix()
+	}
+
+	return response
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
+
+// region tenant handlers //////////////////////////////////////////////////////////////////////////////////////////////
+
+// tenantHandler wraps a handler of the tenant endpoints and rejects requests that are not scoped to a tenant.
+func tenantHandler(handler func(c echo.Context, tenant *tenant) error) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		tenant, exists := tenantFromContext(c)
+		if !exists {
+			return c.JSON(http.StatusUnauthorized, jsonmodels.NewErrorResponse(errors.Errorf("missing %s header: %w", jsonmodels.APIKeyHeader, ErrInvalidAPIKey)))
+		}
+
+		return handler(c, tenant)
+	}
+}
+
+// getTenant is the handler for the GET /tenant endpoint.
+func getTenant(c echo.Context, tenant *tenant) error {
+	return c.JSON(http.StatusOK, tenant.Response())
+}
+
+// getTenantMessages is the handler for the GET /tenant/messages endpoint.
+func getTenantMessages(c echo.Context, tenant *tenant) error {
+	messageIDs, truncated := tenant.Messages()
+
+	response := &jsonmodels.TenantMessagesResponse{
+		MessageIDs: make([]string, len(messageIDs)),
+		Truncated:  truncated,
+	}
+	for i, messageID := range messageIDs {
+		response.MessageIDs[i] = messageID.Base58()
+	}
+
+	return c.JSON(http.StatusOK, response)
+}
+
+// getTenantAddresses is the handler for the GET /tenant/addresses endpoint.
+func getTenantAddresses(c echo.Context, tenant *tenant) error {
+	return c.JSON(http.StatusOK, &jsonmodels.TenantAddressesResponse{Addresses: tenant.WatchedAddresses()})
+}
+
+// watchTenantAddress is the handler for the PUT /tenant/addresses/:address endpoint.
+func watchTenantAddress(c echo.Context, tenant *tenant) error {
+	address, err := ledgerstate.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if err = tenant.WatchAddress(address); err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	return c.JSON(http.StatusOK, &jsonmodels.TenantAddressesResponse{Addresses: tenant.WatchedAddresses()})
+}
+
+// unwatchTenantAddress is the handler for the DELETE /tenant/addresses/:address endpoint.
+func unwatchTenantAddress(c echo.Context, tenant *tenant) error {
+	address, err := ledgerstate.AddressFromBase58EncodedString(c.Param("address"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	if !tenant.UnwatchAddress(address) {
+		return c.JSON(http.StatusNotFound, jsonmodels.NewErrorResponse(errors.Errorf("address %s is not watched", address.Base58())))
+	}
+
+	return c.JSON(http.StatusOK, &jsonmodels.TenantAddressesResponse{Addresses: tenant.WatchedAddresses()})
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,164 @@
+package webapi
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/labstack/echo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+func TestNewTenantRegistry(t *testing.T) {
+	registry, err := newTenantRegistry([]string{"alice:key1", "alice:key2:5", "bob:key3"}, []string{"operatorKey"}, 10, time.Hour, 10, 10)
+	require.NoError(t, err)
+
+	alice, exists := registry.Tenant("key1")
+	require.True(t, exists)
+	otherAlice, _ := registry.Tenant("key2")
+	assert.Same(t, alice, otherAlice)
+	assert.Equal(t, 5, alice.issuanceQuota)
+
+	bob, exists := registry.Tenant("key3")
+	require.True(t, exists)
+	assert.Equal(t, 10, bob.issuanceQuota)
+
+	_, exists = registry.Tenant("unknown")
+	assert.False(t, exists)
+
+	assert.True(t, registry.IsOperator("operatorKey"))
+	assert.False(t, registry.IsOperator("key1"))
+	_, exists = registry.Tenant("operatorKey")
+	assert.False(t, exists)
+
+	for _, invalidAPIKeys := range [][]string{{"alice"}, {":key"}, {"alice:key:-1"}, {"alice:key", "bob:key"}, {"alice:operatorKey"}} {
+		_, err = newTenantRegistry(invalidAPIKeys, []string{"operatorKey"}, 0, time.Hour, 10, 10)
+		assert.Error(t, err, invalidAPIKeys)
+	}
+	_, err = newTenantRegistry(nil, []string{""}, 0, time.Hour, 10, 10)
+	assert.Error(t, err)
+}
+
+func TestTenant(t *testing.T) {
+	tenant := newTenant("alice", 2, time.Hour, 2, 1)
+
+	// the quota is exhausted after two issuances and failed issuances are refunded
+	_, err := tenant.reserveIssuance()
+	require.NoError(t, err)
+	_, err = tenant.reserveIssuance()
+	require.NoError(t, err)
+	_, err = tenant.reserveIssuance()
+	assert.ErrorIs(t, err, ErrTenantQuotaExceeded)
+	tenant.releaseIssuance()
+	_, err = tenant.reserveIssuance()
+	assert.NoError(t, err)
+
+	// the quota is reset once the period ended
+	tenant.periodStart = time.Now().Add(-2 * time.Hour)
+	_, err = tenant.reserveIssuance()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, tenant.Response().IssuedMessages)
+
+	// only the most recent messages are indexed
+	messageIDs := []tangle.MessageID{randomMessageID(t), randomMessageID(t), randomMessageID(t)}
+	tenant.addMessages(messageIDs)
+	indexedMessageIDs, truncated := tenant.Messages()
+	assert.True(t, truncated)
+	assert.Equal(t, messageIDs[1:], indexedMessageIDs)
+
+	// the number of watched addresses is limited
+	address := ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)
+	require.NoError(t, tenant.WatchAddress(address))
+	require.NoError(t, tenant.WatchAddress(address))
+	assert.ErrorIs(t, tenant.WatchAddress(ledgerstate.NewED25519Address(ed25519.GenerateKeyPair().PublicKey)), ErrTooManyWatchedAddresses)
+	assert.Equal(t, []string{address.Base58()}, tenant.WatchedAddresses())
+	assert.True(t, tenant.UnwatchAddress(address))
+	assert.False(t, tenant.UnwatchAddress(address))
+}
+
+func TestTenantMiddlewares(t *testing.T) {
+	registry, err := newTenantRegistry([]string{"alice:aliceKey:1", "bob:bobKey:1"}, []string{"operatorKey"}, 0, time.Hour, 10, 10)
+	require.NoError(t, err)
+
+	issuedMessageID := randomMessageID(t)
+	server := echo.New()
+	server.Use(tenantAuthenticationMiddleware(registry, true, []string{"info"}, []string{"info", "data", "tenant"}))
+	server.Use(tenantIssuanceMiddleware([]string{"data"}, nil))
+	server.GET("/info", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	server.GET("/tenant/messages", tenantHandler(getTenantMessages))
+	server.POST("/admin/plugins/:plugin/stop", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	server.POST("/data", func(c echo.Context) error {
+		if c.QueryParam("fail") != "" {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(assert.AnError))
+		}
+		return c.JSON(http.StatusOK, jsonmodels.DataResponse{ID: issuedMessageID.Base58()})
+	})
+
+	doRequest := func(method, target, apiKey string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(method, target, nil)
+		if apiKey != "" {
+			request.Header.Set(jsonmodels.APIKeyHeader, apiKey)
+		}
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	// requests without a valid API key are only served on public routes
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodGet, "/info", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(http.MethodPost, "/data", "").Code)
+	assert.Equal(t, http.StatusUnauthorized, doRequest(http.MethodGet, "/info", "wrongKey").Code)
+
+	// tenants can only access the tenant routes, while operators can access all routes without being scoped to a tenant
+	assert.Equal(t, http.StatusForbidden, doRequest(http.MethodPost, "/admin/plugins/dashboard/stop", "aliceKey").Code)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodPost, "/admin/plugins/dashboard/stop", "operatorKey").Code)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodPost, "/data", "operatorKey").Code)
+
+	// failed requests don't count towards the quota
+	assert.Equal(t, http.StatusBadRequest, doRequest(http.MethodPost, "/data?fail=true", "aliceKey").Code)
+	assert.Equal(t, http.StatusOK, doRequest(http.MethodPost, "/data", "aliceKey").Code)
+	exhausted := doRequest(http.MethodPost, "/data", "aliceKey")
+	assert.Equal(t, http.StatusTooManyRequests, exhausted.Code)
+	assert.NotEmpty(t, exhausted.Header().Get("Retry-After"))
+
+	// the issued messages are only visible to the tenant that issued them
+	tenantMessages := func(apiKey string) (response *jsonmodels.TenantMessagesResponse) {
+		recorder := doRequest(http.MethodGet, "/tenant/messages", apiKey)
+		require.Equal(t, http.StatusOK, recorder.Code)
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &response))
+		return response
+	}
+	assert.Equal(t, []string{issuedMessageID.Base58()}, tenantMessages("aliceKey").MessageIDs)
+	assert.Empty(t, tenantMessages("bobKey").MessageIDs)
+}
+
+func TestMatchesRoutePrefix(t *testing.T) {
+	routes := map[string]bool{"/": true, "/tenant": true, "/tools/message": true}
+
+	assert.True(t, matchesRoutePrefix(routes, ""))
+	assert.True(t, matchesRoutePrefix(routes, "/"))
+	assert.True(t, matchesRoutePrefix(routes, "/tenant"))
+	assert.True(t, matchesRoutePrefix(routes, "/tenant/addresses/"))
+	assert.True(t, matchesRoutePrefix(routes, "/tools/message/pastcone"))
+	assert.False(t, matchesRoutePrefix(routes, "/tools"))
+	assert.False(t, matchesRoutePrefix(routes, "/tenants"))
+	assert.False(t, matchesRoutePrefix(routes, "/admin/plugins"))
+}
+
+func randomMessageID(t *testing.T) (messageID tangle.MessageID) {
+	messageIDBytes := make([]byte, tangle.MessageIDLength)
+	_, err := rand.Read(messageIDBytes)
+	require.NoError(t, err)
+	copy(messageID[:], messageIDBytes)
+
+	return messageID
+}
@@ -0,0 +1,99 @@
+package message
+
+import (
+	"bytes"
+	"net/http"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/graphexport"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// graphFileName is the name (without extension) of the file that is served by the GraphHandler.
+const graphFileName = "tangle"
+
+// GraphHandler exports a region of the message DAG and the branch DAG in GraphML, DOT or JSON Graph format. The region
+// starts at the requested entry points (the tips by default) and is bounded by the requested depth and number of
+// messages, which can not exceed the configured maximum.
+func GraphHandler(c echo.Context) error {
+	format, options, err := graphExportOptions(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	graph, err := graphexport.Capture(deps.Tangle, options...)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+	}
+
+	var buffer bytes.Buffer
+	if err = graph.Write(&buffer, format); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename="+graphFileName+format.FileExtension())
+
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, buffer.Bytes())
+}
+
+// graphExportOptions parses the format and the options of a graph export from the query parameters of the request.
+func graphExportOptions(c echo.Context) (format graphexport.Format, options []graphexport.Option, err error) {
+	if formatName := c.QueryParam("format"); formatName != "" {
+		if format, err = graphexport.FormatFromString(formatName); err != nil {
+			return format, nil, err
+		}
+	}
+
+	maxMessages := Parameters.GraphExportMaxMessages
+	if maxMessagesParam := c.QueryParam("maxMessages"); maxMessagesParam != "" {
+		requestedMaxMessages, parseErr := strconv.Atoi(maxMessagesParam)
+		if parseErr != nil || requestedMaxMessages < 0 {
+			return format, nil, errors.Errorf("invalid maxMessages %s", maxMessagesParam)
+		}
+		if requestedMaxMessages > 0 && (maxMessages <= 0 || requestedMaxMessages < maxMessages) {
+			maxMessages = requestedMaxMessages
+		}
+	}
+	options = append(options, graphexport.MaxMessages(maxMessages))
+
+	if maxDepthParam := c.QueryParam("maxDepth"); maxDepthParam != "" {
+		maxDepth, parseErr := strconv.Atoi(maxDepthParam)
+		if parseErr != nil || maxDepth < 0 {
+			return format, nil, errors.Errorf("invalid maxDepth %s", maxDepthParam)
+		}
+		options = append(options, graphexport.MaxDepth(maxDepth))
+	}
+
+	if branchesParam := c.QueryParam("branches"); branchesParam != "" {
+		branches, parseErr := strconv.ParseBool(branchesParam)
+		if parseErr != nil {
+			return format, nil, errors.Errorf("invalid branches %s", branchesParam)
+		}
+		options = append(options, graphexport.Branches(branches))
+	}
+
+	if entryPointParams := c.QueryParams()["entryPoint"]; len(entryPointParams) > 0 {
+		entryPoints := make([]tangle.MessageID, 0, len(entryPointParams))
+		for _, entryPointParam := range entryPointParams {
+			entryPoint, parseErr := tangle.NewMessageID(entryPointParam)
+			if parseErr != nil {
+				return format, nil, errors.Wrapf(parseErr, "invalid entryPoint %s", entryPointParam)
+			}
+			entryPoints = append(entryPoints, entryPoint)
+		}
+		options = append(options, graphexport.EntryPoints(entryPoints...))
+	}
+
+	if messageAttributes := c.QueryParams()["messageAttribute"]; len(messageAttributes) > 0 {
+		options = append(options, graphexport.MessageAttributes(messageAttributes...))
+	}
+	if branchAttributes := c.QueryParams()["branchAttribute"]; len(branchAttributes) > 0 {
+		options = append(options, graphexport.BranchAttributes(branchAttributes...))
+	}
+
+	return format, options, nil
+}
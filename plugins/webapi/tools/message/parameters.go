@@ -6,6 +6,12 @@ import "github.com/iotaledger/hive.go/configuration"
 type ParametersDefinition struct {
 	// Export path
 	ExportPath string `default:"." usage:"default export path"`
+	// UnconfirmedConeMaxMessages defines the maximum number of messages of an exported unconfirmed cone.
+	UnconfirmedConeMaxMessages int `default:"100000" usage:"the maximum number of messages of an exported unconfirmed cone (0 disables the limit)"`
+	// GraphExportMaxMessages defines the maximum number of messages of an exported graph.
+	GraphExportMaxMessages int `default:"10000" usage:"the maximum number of messages of an exported graph (0 disables the limit)"`
+	// SolidificationMaxBlockedDescendants defines the maximum number of blocked descendants that are counted per missing message.
+	SolidificationMaxBlockedDescendants int `default:"10000" usage:"the maximum number of blocked descendants that are counted per missing message in the solidification diagnostics (0 disables the limit)"`
 }
 
 // Parameters contains the configuration parameters of the web API tools endpoint plugin.
@@ -16,12 +16,12 @@ func PastconeHandler(c echo.Context) error {
 	var checkedMessageCount int
 	var request jsonmodels.PastconeRequest
 	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.PastconeResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	msgID, err := tangle.NewMessageID(request.ID)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.PastconeResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	// create a new stack that hold messages to check
@@ -33,18 +33,12 @@ type dependencies struct {
 
 const (
 	routeDiagnostics = "tools/diagnostic"
-	// RouteDiagnosticMessages is the API route for message diagnostics.
-	RouteDiagnosticMessages = routeDiagnostics + "/messages"
-	// RouteDiagnosticsFirstWeakMessageReferences is the API route for first weak message diagnostics.
-	RouteDiagnosticsFirstWeakMessageReferences = RouteDiagnosticMessages + "/firstweakreferences"
-	// RouteDiagnosticsMessageRank is the API route for message diagnostics with a rank filter.
-	RouteDiagnosticsMessageRank = RouteDiagnosticMessages + "/rank/:rank"
-	// RouteDiagnosticsUtxoDag is the API route for Utxo Dag diagnostics.
-	RouteDiagnosticsUtxoDag = routeDiagnostics + "/utxodag"
-	// RouteDiagnosticsBranches is the API route for branches diagnostics.
-	RouteDiagnosticsBranches = routeDiagnostics + "/branches"
-	// RouteDiagnosticsTips is the API route for tips diagnostics.
-	RouteDiagnosticsTips = routeDiagnostics + "/tips"
+	// RouteDiagnosticsUnconfirmedCone is the API route for the export of the unconfirmed cone of the Tangle.
+	RouteDiagnosticsUnconfirmedCone = routeDiagnostics + "/unconfirmedcone"
+	// RouteDiagnosticsGraph is the API route for the export of a region of the message DAG and the branch DAG.
+	RouteDiagnosticsGraph = routeDiagnostics + "/graph"
+	// RouteDiagnosticsSolidification is the API route for the dependency graph of the solidification.
+	RouteDiagnosticsSolidification = routeDiagnostics + "/solidification"
 )
 
 func configure(_ *node.Plugin) {
@@ -54,10 +48,7 @@ func configure(_ *node.Plugin) {
 	deps.Server.GET("tools/message/approval", ApprovalHandler)
 	deps.Server.GET("tools/message/orphanage", OrphanageHandler)
 	deps.Server.POST("tools/message", SendMessage)
-	deps.Server.GET(RouteDiagnosticMessages, DiagnosticMessagesHandler)
-	deps.Server.GET(RouteDiagnosticsFirstWeakMessageReferences, DiagnosticMessagesOnlyFirstWeakReferencesHandler)
-	deps.Server.GET(RouteDiagnosticsMessageRank, DiagnosticMessagesRankHandler)
-	deps.Server.GET(RouteDiagnosticsUtxoDag, DiagnosticUTXODAGHandler)
-	deps.Server.GET(RouteDiagnosticsBranches, DiagnosticBranchesHandler)
-	deps.Server.GET(RouteDiagnosticsTips, TipsDiagnosticHandler)
+	deps.Server.GET(RouteDiagnosticsUnconfirmedCone, UnconfirmedConeHandler)
+	deps.Server.GET(RouteDiagnosticsGraph, GraphHandler)
+	deps.Server.GET(RouteDiagnosticsSolidification, SolidificationHandler)
 }
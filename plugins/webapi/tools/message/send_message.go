@@ -1,13 +1,13 @@
 package message
 
 import (
-	"fmt"
 	"net/http"
 	"time"
 
-	"github.com/iotaledger/hive.go/events"
+	"github.com/cockroachdb/errors"
 	"github.com/labstack/echo"
 
+	"github.com/iotaledger/goshimmer/packages/event"
 	"github.com/iotaledger/goshimmer/packages/jsonmodels"
 	"github.com/iotaledger/goshimmer/packages/tangle"
 	"github.com/iotaledger/goshimmer/packages/tangle/payload"
@@ -18,22 +18,22 @@ const maxIssuedAwaitTime = 5 * time.Second
 // SendMessage is the handler for tools/message endpoint.
 func SendMessage(c echo.Context) error {
 	if !deps.Tangle.Synced() {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: tangle.ErrNotSynced.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(tangle.ErrNotSynced))
 	}
 
 	if c.Request().Body == nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: "invalid message, error: request body is missing"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("invalid message, error: request body is missing")))
 	}
 
 	var request jsonmodels.SendMessageRequest
 	if err := c.Bind(&request); err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	if len(request.Payload) == 0 {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: "no data provided"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("no data provided")))
 	}
 	if len(request.ParentMessageIDs) == 0 {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: "no parents provided"})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("no parents provided")))
 	}
 
 	references := tangle.NewParentMessageIDs()
@@ -41,18 +41,18 @@ func SendMessage(c echo.Context) error {
 		for _, ID := range p.MessageIDs {
 			msgID, err := tangle.NewMessageID(ID)
 			if err != nil {
-				return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: fmt.Sprintf("error decoding messageID: %s", ID)})
+				return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("error decoding messageID: %s", ID)))
 			}
 			references = references.Add(tangle.ParentsType(p.Type), msgID)
 		}
 	}
 	msgPayload, _, err := payload.GenericDataPayloadFromBytes(request.Payload)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 	msg, err := deps.Tangle.MessageFactory.IssuePayloadWithReferences(msgPayload, references)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: err.Error()})
+		return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
 	}
 
 	// await MessageScheduled event to be triggered.
@@ -60,7 +60,7 @@ func SendMessage(c echo.Context) error {
 	defer timer.Stop()
 	msgScheduled := make(chan bool)
 
-	messageScheduledClosure := events.NewClosure(func(messageID tangle.MessageID) {
+	messageScheduledClosure := event.NewClosure(func(messageID tangle.MessageID) {
 		if messageID.CompareTo(msg.ID()) == 0 {
 			msgScheduled <- true
 		}
@@ -73,7 +73,7 @@ L:
 	for {
 		select {
 		case <-timer.C:
-			return c.JSON(http.StatusBadRequest, jsonmodels.DataResponse{Error: "message not scheduled in time"})
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.New("message not scheduled in time")))
 		case <-msgScheduled:
 			break L
 		}
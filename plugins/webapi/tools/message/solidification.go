@@ -0,0 +1,45 @@
+package message
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// SolidificationHandler returns the dependency graph of the solidification: every missing message together with the
+// unsolid messages that wait for it, the age of its request and the neighbors that request it as well. The missing
+// messages that block the most descendants are returned first.
+func SolidificationHandler(c echo.Context) error {
+	maxBlockedDescendants := Parameters.SolidificationMaxBlockedDescendants
+	if maxParam := c.QueryParam("maxBlockedDescendants"); maxParam != "" {
+		requestedMax, err := strconv.Atoi(maxParam)
+		if err != nil || requestedMax < 0 {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(errors.Errorf("invalid maxBlockedDescendants %s", maxParam)))
+		}
+		if requestedMax > 0 && (maxBlockedDescendants <= 0 || requestedMax < maxBlockedDescendants) {
+			maxBlockedDescendants = requestedMax
+		}
+	}
+
+	missingDependencies := deps.Tangle.Solidifier.MissingDependencies(maxBlockedDescendants)
+	res := &jsonmodels.SolidificationResponse{
+		MissingMessages: make([]*jsonmodels.MissingDependency, 0, len(missingDependencies)),
+		Count:           len(missingDependencies),
+	}
+	for _, missingDependency := range missingDependencies {
+		res.MissingMessages = append(res.MissingMessages, jsonmodels.NewMissingDependency(missingDependency))
+	}
+	sort.Slice(res.MissingMessages, func(i, j int) bool {
+		if res.MissingMessages[i].BlockedDescendants != res.MissingMessages[j].BlockedDescendants {
+			return res.MissingMessages[i].BlockedDescendants > res.MissingMessages[j].BlockedDescendants
+		}
+		return res.MissingMessages[i].ID < res.MissingMessages[j].ID
+	})
+
+	return c.JSON(http.StatusOK, res)
+}
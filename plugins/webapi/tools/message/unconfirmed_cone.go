@@ -0,0 +1,36 @@
+package message
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+	"github.com/labstack/echo"
+
+	"github.com/iotaledger/goshimmer/packages/conearchive"
+	"github.com/iotaledger/goshimmer/packages/jsonmodels"
+)
+
+// unconfirmedConeFileName is the name of the archive that is served by the UnconfirmedConeHandler.
+const unconfirmedConeFileName = "unconfirmed_cone.bin.gz"
+
+// UnconfirmedConeHandler exports the currently unconfirmed cone of the Tangle (messages, metadata, branch assignments
+// and weights) as a compressed archive.
+func UnconfirmedConeHandler(c echo.Context) error {
+	archive, err := conearchive.Capture(deps.Tangle, Parameters.UnconfirmedConeMaxMessages)
+	if err != nil {
+		if errors.Is(err, conearchive.ErrLimitExceeded) {
+			return c.JSON(http.StatusBadRequest, jsonmodels.NewErrorResponse(err))
+		}
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	var buffer bytes.Buffer
+	if _, err = archive.WriteTo(&buffer); err != nil {
+		return c.JSON(http.StatusInternalServerError, jsonmodels.NewErrorResponse(err))
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, "attachment; filename="+unconfirmedConeFileName)
+
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, buffer.Bytes())
+}
@@ -0,0 +1,40 @@
+package webhooks
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the webhooks plugin.
+type ParametersDefinition struct {
+	// MessageConfirmed defines the webhooks that are called when a message containing a transaction is confirmed.
+	MessageConfirmed []string `usage:"list of webhook URLs that are called when a message containing a transaction is confirmed"`
+	// BranchRejected defines the webhooks that are called when a branch is rejected.
+	BranchRejected []string `usage:"list of webhook URLs that are called when a branch is rejected"`
+	// FaucetPayout defines the webhooks that are called when the faucet sent funds.
+	FaucetPayout []string `usage:"list of webhook URLs that are called when the faucet sent funds"`
+	// SyncChanged defines the webhooks that are called when the sync state of the node changes.
+	SyncChanged []string `usage:"list of webhook URLs that are called when the sync state of the node changes"`
+	// Secret defines the key that is used to sign the deliveries.
+	Secret string `usage:"the key that is used to sign the deliveries with HMAC-SHA256"`
+	// MaxAttempts defines the number of times a delivery is attempted before it is given up.
+	MaxAttempts int `default:"5" usage:"the number of times a delivery is attempted before it is given up"`
+	// RetryBackoff defines the delay before the first retry.
+	RetryBackoff time.Duration `default:"1s" usage:"the delay before the first retry, which doubles with every further attempt"`
+	// Timeout defines the timeout of a single webhook call.
+	Timeout time.Duration `default:"5s" usage:"the timeout of a single webhook call"`
+	// QueueSize defines the number of deliveries that are buffered while the webhooks are being called.
+	QueueSize int `default:"1000" usage:"the number of deliveries that are buffered while the webhooks are being called"`
+	// WorkerCount defines the number of webhook calls that are performed in parallel.
+	WorkerCount int `default:"4" usage:"the number of webhook calls that are performed in parallel"`
+	// DeadLetterLog defines the file that failed deliveries are written to.
+	DeadLetterLog string `default:"webhooks-deadletter.log" usage:"the file that failed deliveries are written to"`
+}
+
+// Parameters contains the configuration used by the webhooks plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "webhooks")
+}
@@ -0,0 +1,24 @@
+package webhooks
+
+// MessageConfirmed is the data of the EventMessageConfirmed deliveries.
+type MessageConfirmed struct {
+	MessageID     string `json:"messageID"`
+	TransactionID string `json:"transactionID"`
+}
+
+// BranchRejected is the data of the EventBranchRejected deliveries.
+type BranchRejected struct {
+	BranchID string `json:"branchID"`
+}
+
+// FaucetPayout is the data of the EventFaucetPayout deliveries.
+type FaucetPayout struct {
+	Address       string `json:"address"`
+	TransactionID string `json:"transactionID"`
+	MessageID     string `json:"messageID"`
+}
+
+// SyncChanged is the data of the EventSyncChanged deliveries.
+type SyncChanged struct {
+	Synced bool `json:"synced"`
+}
@@ -0,0 +1,159 @@
+package webhooks
+
+import (
+	"context"
+	"os"
+
+	"github.com/iotaledger/hive.go/daemon"
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/shutdown"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/webhook"
+	"github.com/iotaledger/goshimmer/plugins/faucet"
+)
+
+// PluginName is the name of the webhooks plugin.
+const PluginName = "Webhooks"
+
+const (
+	// EventMessageConfirmed is the event type of confirmed messages that contain a transaction.
+	EventMessageConfirmed = "messageConfirmed"
+	// EventBranchRejected is the event type of rejected branches.
+	EventBranchRejected = "branchRejected"
+	// EventFaucetPayout is the event type of funds sent by the faucet.
+	EventFaucetPayout = "faucetPayout"
+	// EventSyncChanged is the event type of changes of the sync state of the node.
+	EventSyncChanged = "syncChanged"
+)
+
+var (
+	// Plugin is the plugin instance of the webhooks plugin.
+	Plugin = node.NewPlugin(PluginName, deps, node.Disabled, configure, run)
+	deps   = new(dependencies)
+
+	dispatcher    *webhook.Dispatcher
+	deadLetterLog *os.File
+
+	onMessageConfirmed        *event.Closure[tangle.MessageID]
+	onBranchRejected          *event.Closure[ledgerstate.BranchID]
+	onFundingRequestFulfilled *events.Closure
+	onSyncChanged             *event.Closure[*tangle.SyncChangedEvent]
+)
+
+type dependencies struct {
+	dig.In
+
+	Tangle *tangle.Tangle
+}
+
+func configure(plugin *node.Plugin) {
+	var err error
+	if deadLetterLog, err = os.OpenFile(Parameters.DeadLetterLog, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o660); err != nil {
+		plugin.LogFatalf("failed to open dead letter log: %s", err)
+		return
+	}
+
+	dispatcher = webhook.NewDispatcher(
+		webhook.WithSecret([]byte(Parameters.Secret)),
+		webhook.WithMaxAttempts(Parameters.MaxAttempts),
+		webhook.WithRetryBackoff(Parameters.RetryBackoff),
+		webhook.WithTimeout(Parameters.Timeout),
+		webhook.WithQueueSize(Parameters.QueueSize),
+		webhook.WithWorkerCount(Parameters.WorkerCount),
+	)
+	dispatcher.Subscribe(EventMessageConfirmed, Parameters.MessageConfirmed...)
+	dispatcher.Subscribe(EventBranchRejected, Parameters.BranchRejected...)
+	dispatcher.Subscribe(EventFaucetPayout, Parameters.FaucetPayout...)
+	dispatcher.Subscribe(EventSyncChanged, Parameters.SyncChanged...)
+
+	writer := webhook.NewDeadLetterLog(deadLetterLog)
+	dispatcher.Events.DeliveryFailed.Attach(events.NewClosure(func(deadLetter *webhook.DeadLetter) {
+		plugin.LogWarnf("failed to deliver %s event %s to %s: %s", deadLetter.Delivery.Event, deadLetter.Delivery.ID, deadLetter.URL, deadLetter.Error)
+		if writeErr := writer.Write(deadLetter); writeErr != nil {
+			plugin.LogError(writeErr)
+		}
+	}))
+
+	configureEvents(plugin)
+}
+
+func run(plugin *node.Plugin) {
+	if err := daemon.BackgroundWorker(PluginName, func(ctx context.Context) {
+		dispatcher.Run(ctx)
+
+		plugin.LogInfof("Stopping %s ...", PluginName)
+		detachEvents()
+		if err := deadLetterLog.Close(); err != nil {
+			plugin.LogErrorf("failed to close dead letter log: %s", err)
+		}
+		plugin.LogInfof("Stopping %s ... done", PluginName)
+	}, shutdown.PriorityWebhooks); err != nil {
+		plugin.Panicf("Failed to start as daemon: %s", err)
+	}
+}
+
+// configureEvents attaches the dispatcher to the events that have webhooks.
+func configureEvents(plugin *node.Plugin) {
+	dispatch := func(event string, data interface{}) {
+		if err := dispatcher.Dispatch(event, data); err != nil {
+			plugin.LogErrorf("failed to dispatch %s event: %s", event, err)
+		}
+	}
+
+	onMessageConfirmed = event.NewClosure(func(messageID tangle.MessageID) {
+		deps.Tangle.Storage.Message(messageID).Consume(func(message *tangle.Message) {
+			transaction, ok := message.Payload().(*ledgerstate.Transaction)
+			if !ok {
+				return
+			}
+			dispatch(EventMessageConfirmed, &MessageConfirmed{
+				MessageID:     messageID.Base58(),
+				TransactionID: transaction.ID().Base58(),
+			})
+		})
+	})
+	onBranchRejected = event.NewClosure(func(branchID ledgerstate.BranchID) {
+		dispatch(EventBranchRejected, &BranchRejected{
+			BranchID: branchID.Base58(),
+		})
+	})
+	onFundingRequestFulfilled = events.NewClosure(func(event *faucet.FundingRequestFulfilledEvent) {
+		dispatch(EventFaucetPayout, &FaucetPayout{
+			Address:       event.Address.Base58(),
+			TransactionID: event.TransactionID,
+			MessageID:     event.MessageID.Base58(),
+		})
+	})
+	onSyncChanged = event.NewClosure(func(event *tangle.SyncChangedEvent) {
+		dispatch(EventSyncChanged, &SyncChanged{
+			Synced: event.Synced,
+		})
+	})
+
+	// only attach to the events that have webhooks, so that the node does not do any unnecessary work
+	if dispatcher.Subscribed(EventMessageConfirmed) {
+		deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Attach(onMessageConfirmed)
+	}
+	if dispatcher.Subscribed(EventBranchRejected) {
+		deps.Tangle.LedgerState.BranchDAG.Events.BranchRejected.Attach(onBranchRejected)
+	}
+	if dispatcher.Subscribed(EventFaucetPayout) {
+		faucet.Events.FundingRequestFulfilled.Attach(onFundingRequestFulfilled)
+	}
+	if dispatcher.Subscribed(EventSyncChanged) {
+		deps.Tangle.TimeManager.Events.SyncChanged.Attach(onSyncChanged)
+	}
+}
+
+// detachEvents detaches the dispatcher from all events.
+func detachEvents() {
+	deps.Tangle.ConfirmationOracle.Events().MessageConfirmed.Detach(onMessageConfirmed)
+	deps.Tangle.LedgerState.BranchDAG.Events.BranchRejected.Detach(onBranchRejected)
+	faucet.Events.FundingRequestFulfilled.Detach(onFundingRequestFulfilled)
+	deps.Tangle.TimeManager.Events.SyncChanged.Detach(onSyncChanged)
+}
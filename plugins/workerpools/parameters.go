@@ -0,0 +1,42 @@
+package workerpools
+
+import (
+	"github.com/iotaledger/hive.go/configuration"
+)
+
+// ParametersDefinition contains the definition of the parameters used by the workerpools plugin.
+type ParametersDefinition struct {
+	// WorkerBudget defines the number of concurrently executed tasks before the tasks of best-effort pools have to wait.
+	WorkerBudget int `default:"0" usage:"the number of concurrently executed tasks before best-effort tasks have to wait (0 uses the number of CPUs)"`
+	// Solidifier contains the sizing of the worker pool of the Solidifier.
+	Solidifier struct {
+		// WorkerCount defines the number of workers of the pool.
+		WorkerCount int `default:"0" usage:"the number of workers that solidify messages (0 uses the number of CPUs)"`
+		// QueueSize defines the number of tasks that can be queued.
+		QueueSize int `default:"0" usage:"the number of messages that can wait to be solidified (0 uses the default)"`
+	}
+	// Booker contains the sizing of the worker pool of the Booker, which always uses a single worker.
+	Booker struct {
+		// QueueSize defines the number of tasks that can be queued.
+		QueueSize int `default:"0" usage:"the number of messages that can wait to be booked (0 uses the default)"`
+	}
+	// ApprovalWeight contains the sizing of the worker pool of the ApprovalWeightManager, which always uses a single worker.
+	ApprovalWeight struct {
+		// QueueSize defines the number of tasks that can be queued.
+		QueueSize int `default:"0" usage:"the number of messages that can wait for their approval weight to be processed (0 uses the default)"`
+	}
+	// GossipWrite contains the sizing of the worker pool that writes the outgoing gossip packets.
+	GossipWrite struct {
+		// WorkerCount defines the number of workers of the pool.
+		WorkerCount int `default:"0" usage:"the number of workers that write gossip packets (0 uses the number of CPUs)"`
+		// QueueSize defines the number of tasks that can be queued.
+		QueueSize int `default:"0" usage:"the number of gossip packets that can wait to be written (0 uses the default)"`
+	}
+}
+
+// Parameters contains the configuration used by the workerpools plugin.
+var Parameters = &ParametersDefinition{}
+
+func init() {
+	configuration.BindParameters(Parameters, "workerPools")
+}
@@ -0,0 +1,47 @@
+package workerpools
+
+import (
+	"github.com/iotaledger/hive.go/events"
+	"github.com/iotaledger/hive.go/node"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/goshimmer/packages/gossip"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+	"github.com/iotaledger/goshimmer/packages/workerpools"
+)
+
+// PluginName is the name of the workerpools plugin.
+const PluginName = "WorkerPools"
+
+// Plugin is the plugin instance of the workerpools plugin.
+var Plugin *node.Plugin
+
+func init() {
+	Plugin = node.NewPlugin(PluginName, nil, node.Enabled)
+
+	Plugin.Events.Init.Attach(events.NewClosure(func(_ *node.Plugin, container *dig.Container) {
+		if err := container.Provide(newManager); err != nil {
+			Plugin.Panic(err)
+		}
+	}))
+}
+
+// newManager creates the shared worker pools Manager that is used by the Tangle and the gossip layer.
+func newManager() *workerpools.Manager {
+	return workerpools.NewManager(Parameters.WorkerBudget, map[string]workerpools.PoolParams{
+		tangle.SolidifierWorkerPoolName: {
+			WorkerCount: Parameters.Solidifier.WorkerCount,
+			QueueSize:   Parameters.Solidifier.QueueSize,
+		},
+		tangle.BookerWorkerPoolName: {
+			QueueSize: Parameters.Booker.QueueSize,
+		},
+		tangle.ApprovalWeightWorkerPoolName: {
+			QueueSize: Parameters.ApprovalWeight.QueueSize,
+		},
+		gossip.WriteWorkerPoolName: {
+			WorkerCount: Parameters.GossipWrite.WorkerCount,
+			QueueSize:   Parameters.GossipWrite.QueueSize,
+		},
+	})
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/kvstore"
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/packages/database"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate/audit"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	databaseplugin "github.com/iotaledger/goshimmer/plugins/database"
+)
+
+const (
+	cfgDatabase  = "db"
+	cfgSnapshot  = "snapshot"
+	cfgNetworkID = "networkID"
+	cfgReport    = "report"
+	cfgMaxShown  = "maxShown"
+)
+
+func init() {
+	flag.String(cfgDatabase, "mainnetdb", "the path of the database of the node (the node has to be stopped)")
+	flag.String(cfgSnapshot, "", "the path of the snapshot that the node was bootstrapped from (optional)")
+	flag.Uint32(cfgNetworkID, 0, "the ID of the network that the snapshot belongs to")
+	flag.String(cfgReport, "", "the path of a JSON file that the report is written to (optional)")
+	flag.Int(cfgMaxShown, 20, "the maximum number of violations per invariant that are printed")
+}
+
+func main() {
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+
+	db, err := database.NewDB(viper.GetString(cfgDatabase), database.DurabilityNone)
+	if err != nil {
+		log.Fatalf("failed to open database: %s", err)
+	}
+	defer db.Close()
+
+	store := db.NewStore()
+	if err = checkDatabaseVersion(store); err != nil {
+		log.Fatal(err)
+	}
+
+	var options []audit.Option
+	if snapshotPath := viper.GetString(cfgSnapshot); snapshotPath != "" {
+		ledgerSnapshot, readErr := readSnapshot(snapshotPath, viper.GetUint32(cfgNetworkID))
+		if readErr != nil {
+			log.Fatal(readErr)
+		}
+		options = append(options, audit.WithSnapshot(ledgerSnapshot))
+	}
+
+	ledger := ledgerstate.New(ledgerstate.Store(store), ledgerstate.CacheTimeProvider(database.NewCacheTimeProvider(0)))
+	log.Println("auditing ledger state...")
+	report := audit.New(ledger, options...).Run()
+	ledger.Shutdown()
+
+	printReport(report, viper.GetInt(cfgMaxShown))
+
+	if reportPath := viper.GetString(cfgReport); reportPath != "" {
+		if err = writeReport(report, reportPath); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("wrote report to %s", reportPath)
+	}
+
+	if !report.Consistent() {
+		db.Close()
+		os.Exit(1)
+	}
+}
+
+// checkDatabaseVersion checks that the database was written by a compatible version of the node without modifying it.
+func checkDatabaseVersion(store kvstore.KVStore) error {
+	entry, err := store.Get([]byte{0})
+	if err != nil {
+		return errors.Errorf("failed to read database version: %w", err)
+	}
+	if len(entry) == 0 || entry[0] != databaseplugin.DBVersion {
+		return errors.Errorf("%w: supported version: %d", databaseplugin.ErrDBVersionIncompatible, databaseplugin.DBVersion)
+	}
+
+	return nil
+}
+
+func readSnapshot(path string, networkID uint32) (ledgerSnapshot *ledgerstate.Snapshot, err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Errorf("failed to open snapshot %s: %w", path, err)
+	}
+	defer file.Close()
+
+	if ledgerSnapshot, err = snapshot.ReadAny(file, networkID); err != nil {
+		return nil, errors.Errorf("failed to read snapshot %s: %w", path, err)
+	}
+
+	return ledgerSnapshot, nil
+}
+
+func printReport(report *audit.Report, maxShown int) {
+	fmt.Printf("\n================= Ledger state audit ===============\n")
+	fmt.Printf("Transactions:              %d\n", report.Transactions)
+	fmt.Printf("Root transactions:         %d\n", report.RootTransactions)
+	fmt.Printf("Confirmed transactions:    %d\n", report.ConfirmedTransactions)
+	fmt.Printf("Branches:                  %d\n", report.Branches)
+	fmt.Printf("Conflicts:                 %d\n", report.Conflicts)
+	fmt.Printf("Supply:                    %d\n", report.Supply)
+	fmt.Printf("Confirmed unspent balance: %d\n", report.ConfirmedUnspentBalance)
+
+	for _, invariant := range audit.Invariants {
+		violations := report.ViolationsOf(invariant)
+
+		fmt.Printf("\n================= %s: %d violations ===============\n", invariant, len(violations))
+		for i, violation := range violations {
+			if i == maxShown {
+				fmt.Printf("... and %d more\n", len(violations)-maxShown)
+				break
+			}
+			fmt.Printf("%s: %s\n", violation.Subject, violation.Description)
+		}
+	}
+
+	if report.Consistent() {
+		fmt.Printf("\nThe ledger state is consistent.\n")
+	} else {
+		fmt.Printf("\nThe ledger state is INCONSISTENT.\n")
+	}
+}
+
+func writeReport(report *audit.Report, path string) (err error) {
+	reportBytes, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.Errorf("failed to marshal report: %w", err)
+	}
+	if err = os.WriteFile(path, reportBytes, 0o644); err != nil {
+		return errors.Errorf("failed to write report %s: %w", path, err)
+	}
+
+	return nil
+}
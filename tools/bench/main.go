@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/cockroachdb/errors"
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/packages/tangle/benchmark"
+)
+
+const (
+	cfgMessages        = "messages"
+	cfgTransactionRate = "transactionRate"
+	cfgConflictRate    = "conflictRate"
+	cfgParents         = "parents"
+	cfgSeed            = "seed"
+	cfgTimeout         = "timeout"
+	cfgReport          = "report"
+	cfgMinMPS          = "minMPS"
+)
+
+func init() {
+	flag.Int(cfgMessages, 10000, "the number of messages that are issued")
+	flag.Float64(cfgTransactionRate, 0.5, "the fraction of the messages that contain a transaction")
+	flag.Float64(cfgConflictRate, 0, "the fraction of the transactions that double spend an already spent output")
+	flag.Int(cfgParents, 2, "the number of strong parents of every message")
+	flag.Int64(cfgSeed, 1, "the seed of the generated message stream")
+	flag.Duration(cfgTimeout, 0, "the maximum duration of the run (defaults to one minute)")
+	flag.String(cfgReport, "", "the path of a JSON file that the result is written to (optional)")
+	flag.Float64(cfgMinMPS, 0, "the minimum throughput in messages per second, below which the command fails (optional)")
+}
+
+func main() {
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+
+	options := []benchmark.Option{
+		benchmark.Messages(viper.GetInt(cfgMessages)),
+		benchmark.TransactionRate(viper.GetFloat64(cfgTransactionRate)),
+		benchmark.ConflictRate(viper.GetFloat64(cfgConflictRate)),
+		benchmark.Parents(viper.GetInt(cfgParents)),
+		benchmark.Seed(viper.GetInt64(cfgSeed)),
+	}
+	if timeout := viper.GetDuration(cfgTimeout); timeout != 0 {
+		options = append(options, benchmark.Timeout(timeout))
+	}
+
+	log.Println("generating message stream...")
+	harness, err := benchmark.New(options...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("issuing %d messages...", harness.Messages())
+	result, err := harness.Run()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("\n================= Tangle benchmark ===============\n")
+	fmt.Print(result)
+
+	if reportPath := viper.GetString(cfgReport); reportPath != "" {
+		if err = writeReport(result, reportPath); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("wrote report to %s", reportPath)
+	}
+
+	if minMPS := viper.GetFloat64(cfgMinMPS); result.MessagesPerSecond < minMPS {
+		fmt.Printf("\nThe throughput is BELOW the minimum of %.2f MPS.\n", minMPS)
+		os.Exit(1)
+	}
+}
+
+func writeReport(result *benchmark.Result, path string) (err error) {
+	resultBytes, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return errors.Errorf("failed to marshal result: %w", err)
+	}
+	if err = os.WriteFile(path, resultBytes, 0o644); err != nil {
+		return errors.Errorf("failed to write report %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -19,6 +19,8 @@ func execAddressCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	listPtr := command.Bool("list", false, "list all addresses")
 	listUnspentPtr := command.Bool("listunspent", false, "list all unspent addresses")
 	listSpentPtr := command.Bool("listspent", false, "list all spent addresses")
+	derivePtr := command.Uint64("derive", 0, "list the given number of addresses that are deterministically derived from the seed")
+	deriveStartPtr := command.Uint64("start", 0, "index of the first derived address (used with -derive)")
 	helpPtr := command.Bool("help", false, "display this help screen")
 
 	err := command.Parse(os.Args[2:])
@@ -46,6 +48,9 @@ func execAddressCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 	if *newReceiveAddressPtr {
 		setFlagCount++
 	}
+	if *derivePtr > 0 {
+		setFlagCount++
+	}
 	if setFlagCount == 0 {
 		printUsage(command)
 	}
@@ -131,4 +136,20 @@ func execAddressCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
 			_, _ = fmt.Fprintf(w, "%s\t%s\t%s\n", "<EMPTY>", "<EMPTY>", "<EMPTY>")
 		}
 	}
+
+	if *derivePtr > 0 {
+		// initialize tab writer
+		w := new(tabwriter.Writer)
+		w.Init(os.Stdout, 0, 8, 2, '\t', 0)
+		defer w.Flush()
+
+		// print header
+		fmt.Println()
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", "INDEX", "ADDRESS")
+		_, _ = fmt.Fprintf(w, "%s\t%s\n", "-----", "--------------------------------------------")
+
+		for _, addr := range cliWallet.AddressManager().DeriveAddresses(*deriveStartPtr, *derivePtr) {
+			_, _ = fmt.Fprintf(w, "%d\t%s\n", addr.Index, addr.Base58())
+		}
+	}
 }
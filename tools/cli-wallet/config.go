@@ -14,6 +14,7 @@ type configuration struct {
 	ReuseAddresses       bool             `json:"reuse_addresses"`
 	FaucetPowDifficulty  int              `json:"faucetPowDifficulty"`
 	AssetRegistryNetwork string           `json:"assetRegistryNetwork"`
+	CoinSelection        string           `json:"coinSelection,omitempty"`
 }
 
 // internal variable that holds the config
@@ -28,7 +29,8 @@ var configJSON = `{
 	},
 	"reuse_addresses": false,
 	"faucetPowDifficulty": 25,
-	"assetRegistryNetwork": "nectar"
+	"assetRegistryNetwork": "nectar",
+	"coinSelection": "confidence"
 }`
 
 // load the config file
@@ -59,6 +59,12 @@ func loadWallet() *wallet.Wallet {
 
 	walletOptions = append(walletOptions, wallet.FaucetPowDifficulty(config.FaucetPowDifficulty))
 
+	coinSelection, err := wallet.NewCoinSelectionStrategy(config.CoinSelection)
+	if err != nil {
+		panic(err)
+	}
+	walletOptions = append(walletOptions, wallet.CoinSelection(coinSelection))
+
 	return wallet.New(walletOptions...)
 }
 
@@ -177,6 +183,8 @@ func printUsage(command *flag.FlagSet, optionalErrorMessage ...string) {
 		fmt.Println("        create an nft as an unforkable alias output")
 		fmt.Println("  transfer-nft")
 		fmt.Println("        transfer the ownership of an nft")
+		fmt.Println("  transition-alias")
+		fmt.Println("        update the state data, state controller or governance metadata of an alias (nft)")
 		fmt.Println("  destroy-nft")
 		fmt.Println("        destroy an nft")
 		fmt.Println("  deposit-to-nft")
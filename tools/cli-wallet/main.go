@@ -68,6 +68,7 @@ func main() {
 	reclaimDelegatedFundsCommand := flag.NewFlagSet("reclaim-delegated", flag.ExitOnError)
 	createNFTCommand := flag.NewFlagSet("create-nft", flag.ExitOnError)
 	transferNFTCommand := flag.NewFlagSet("transfer-nft", flag.ExitOnError)
+	transitionAliasCommand := flag.NewFlagSet("transition-alias", flag.ExitOnError)
 	destroyNFTCommand := flag.NewFlagSet("destroy-nft", flag.ExitOnError)
 	depositToNFTCommand := flag.NewFlagSet("deposit-to-nft", flag.ExitOnError)
 	withdrawFromNFTCommand := flag.NewFlagSet("withdraw-from-nft", flag.ExitOnError)
@@ -103,6 +104,8 @@ func main() {
 		execCreateNFTCommand(createNFTCommand, wallet)
 	case "transfer-nft":
 		execTransferNFTCommand(transferNFTCommand, wallet)
+	case "transition-alias":
+		execTransitionAliasCommand(transitionAliasCommand, wallet)
 	case "destroy-nft":
 		execDestroyNFTCommand(destroyNFTCommand, wallet)
 	case "deposit-to-nft":
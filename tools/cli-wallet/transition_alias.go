@@ -0,0 +1,80 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/iotaledger/goshimmer/client/wallet"
+	"github.com/iotaledger/goshimmer/client/wallet/packages/transitionaliasoptions"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+func execTransitionAliasCommand(command *flag.FlagSet, cliWallet *wallet.Wallet) {
+	command.Usage = func() {
+		printUsage(command)
+	}
+
+	helpPtr := command.Bool("help", false, "show this help screen")
+	aliasIDPtr := command.String("id", "", "unique identifier of the alias (nft) that should be transitioned")
+	stateDataPtr := command.String("state-data", "", "new state data of the alias (state transition)")
+	stateDataFilePtr := command.String("state-data-file", "", "file that contains the new state data of the alias (state transition)")
+	stateAddressPtr := command.String("state-addr", "", "new state controller address of the alias (governance transition)")
+	governanceMetadataPtr := command.String("governance-metadata", "", "new governance metadata of the alias (governance transition)")
+	waitForConfirmationPtr := command.Bool("wait", false, "wait until the transition is confirmed")
+	accessManaPledgeIDPtr := command.String("access-mana-id", "", "node ID to pledge access mana to")
+	consensusManaPledgeIDPtr := command.String("consensus-mana-id", "", "node ID to pledge consensus mana to")
+
+	err := command.Parse(os.Args[2:])
+	if err != nil {
+		panic(err)
+	}
+
+	if *helpPtr {
+		printUsage(command)
+	}
+
+	if *aliasIDPtr == "" {
+		printUsage(command, "an alias (nft) ID must be given for the transition")
+	}
+	aliasID, err := ledgerstate.AliasAddressFromBase58EncodedString(*aliasIDPtr)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+	if *stateDataPtr != "" && *stateDataFilePtr != "" {
+		printUsage(command, "please provide either state-data or state-data-file")
+	}
+
+	options := []transitionaliasoptions.TransitionAliasOption{
+		transitionaliasoptions.Alias(aliasID.Base58()),
+		transitionaliasoptions.WaitForConfirmation(*waitForConfirmationPtr),
+		transitionaliasoptions.AccessManaPledgeID(*accessManaPledgeIDPtr),
+		transitionaliasoptions.ConsensusManaPledgeID(*consensusManaPledgeIDPtr),
+	}
+	switch {
+	case *stateDataFilePtr != "":
+		stateData, readErr := os.ReadFile(*stateDataFilePtr)
+		if readErr != nil {
+			printUsage(command, readErr.Error())
+		}
+		options = append(options, transitionaliasoptions.StateData(stateData))
+	case *stateDataPtr != "":
+		options = append(options, transitionaliasoptions.StateData([]byte(*stateDataPtr)))
+	}
+	if *stateAddressPtr != "" {
+		options = append(options, transitionaliasoptions.StateAddress(*stateAddressPtr))
+	}
+	if *governanceMetadataPtr != "" {
+		options = append(options, transitionaliasoptions.GovernanceMetadata([]byte(*governanceMetadataPtr)))
+	}
+
+	fmt.Println("Transitioning alias...")
+	tx, err := cliWallet.TransitionAlias(options...)
+	if err != nil {
+		printUsage(command, err.Error())
+	}
+
+	fmt.Println()
+	fmt.Printf("Transition transaction ID: %s\n", tx.ID().Base58())
+	fmt.Println("Transitioning alias... [DONE]")
+}
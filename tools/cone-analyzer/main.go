@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/client"
+	"github.com/iotaledger/goshimmer/packages/conearchive"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+const (
+	cfgArchive          = "archive"
+	cfgNode             = "node"
+	cfgCSV              = "csv"
+	defaultArchiveName  = "./unconfirmed_cone.bin.gz"
+	oldestMessagesShown = 10
+)
+
+func init() {
+	flag.String(cfgArchive, defaultArchiveName, "the path of the unconfirmed cone archive")
+	flag.String(cfgNode, "", "the API URL of a node to download the unconfirmed cone from (the archive is stored at the archive path)")
+	flag.String(cfgCSV, "", "the path of a CSV file that the messages of the archive are exported to")
+}
+
+func main() {
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+
+	archivePath := viper.GetString(cfgArchive)
+	if nodeURL := viper.GetString(cfgNode); nodeURL != "" {
+		log.Printf("downloading unconfirmed cone from %s...", nodeURL)
+		archiveBytes, err := client.NewGoShimmerAPI(nodeURL).GetUnconfirmedCone()
+		if err != nil {
+			log.Fatalf("failed to download unconfirmed cone: %s", err)
+		}
+		if err = os.WriteFile(archivePath, archiveBytes, 0o644); err != nil {
+			log.Fatalf("failed to store unconfirmed cone: %s", err)
+		}
+	}
+
+	archive, err := readArchive(archivePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	printSummary(archive)
+
+	if csvPath := viper.GetString(cfgCSV); csvPath != "" {
+		if err = exportCSV(archive, csvPath); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("exported %d messages to %s", len(archive.Messages), csvPath)
+	}
+}
+
+func readArchive(path string) (archive *conearchive.Archive, err error) {
+	archiveBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive %s: %w", path, err)
+	}
+
+	if archive, err = conearchive.Read(bytes.NewReader(archiveBytes)); err != nil {
+		return nil, fmt.Errorf("failed to load archive %s: %w", path, err)
+	}
+
+	return archive, nil
+}
+
+func printSummary(archive *conearchive.Archive) {
+	var solid, booked, scheduled, invalid int
+	for _, entry := range archive.Messages {
+		if entry.Metadata.IsSolid() {
+			solid++
+		}
+		if entry.Metadata.IsBooked() {
+			booked++
+		}
+		if entry.Metadata.Scheduled() {
+			scheduled++
+		}
+		if entry.Metadata.IsObjectivelyInvalid() || entry.Metadata.IsSubjectivelyInvalid() {
+			invalid++
+		}
+	}
+
+	fmt.Printf("\n================= Unconfirmed cone captured at %s ===============\n", archive.Created)
+	fmt.Printf("Messages:  %d\n", len(archive.Messages))
+	fmt.Printf("Solid:     %d\n", solid)
+	fmt.Printf("Booked:    %d\n", booked)
+	fmt.Printf("Scheduled: %d\n", scheduled)
+	fmt.Printf("Invalid:   %d\n", invalid)
+
+	fmt.Printf("\n================= %d oldest unconfirmed messages ===============\n", oldestMessagesShown)
+	for i, entry := range archive.Messages {
+		if i == oldestMessagesShown {
+			break
+		}
+		fmt.Printf("%s issued %s by %s (solid: %t, booked: %t, scheduled: %t)\n", entry.Message.ID().Base58(), entry.Message.IssuingTime(), entry.Message.IssuerPublicKey(), entry.Metadata.IsSolid(), entry.Metadata.IsBooked(), entry.Metadata.Scheduled())
+	}
+
+	branches := make([]*conearchive.BranchEntry, len(archive.Branches))
+	copy(branches, archive.Branches)
+	sort.Slice(branches, func(i, j int) bool {
+		return branches[i].Weight > branches[j].Weight
+	})
+
+	fmt.Printf("\n================= %d branches ===============\n", len(branches))
+	for _, branch := range branches {
+		fmt.Printf("%s weight: %.4f, inclusion state: %s\n", branch.BranchID.Base58(), branch.Weight, branch.InclusionState)
+	}
+}
+
+func exportCSV(archive *conearchive.Archive, path string) (err error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	defer file.Close()
+
+	return writeCSV(archive, file)
+}
+
+func writeCSV(archive *conearchive.Archive, writer io.Writer) (err error) {
+	csvWriter := csv.NewWriter(writer)
+	if err = csvWriter.Write([]string{"ID", "IssuerPublicKey", "IssuingTime", "StrongParents", "WeakParents", "Solid", "Booked", "Scheduled", "ObjectivelyInvalid", "SubjectivelyInvalid", "GradeOfFinality", "BranchIDs"}); err != nil {
+		return fmt.Errorf("failed to write table description row: %w", err)
+	}
+
+	for _, entry := range archive.Messages {
+		branchIDs := make([]string, 0, len(entry.BranchIDs))
+		for branchID := range entry.BranchIDs {
+			branchIDs = append(branchIDs, branchID.Base58())
+		}
+		sort.Strings(branchIDs)
+
+		if err = csvWriter.Write([]string{
+			entry.Message.ID().Base58(),
+			entry.Message.IssuerPublicKey().String(),
+			strconv.FormatInt(entry.Message.IssuingTime().UnixNano(), 10),
+			strings.Join(parentIDs(entry.Message, tangle.StrongParentType), ";"),
+			strings.Join(parentIDs(entry.Message, tangle.WeakParentType), ";"),
+			strconv.FormatBool(entry.Metadata.IsSolid()),
+			strconv.FormatBool(entry.Metadata.IsBooked()),
+			strconv.FormatBool(entry.Metadata.Scheduled()),
+			strconv.FormatBool(entry.Metadata.IsObjectivelyInvalid()),
+			strconv.FormatBool(entry.Metadata.IsSubjectivelyInvalid()),
+			entry.Metadata.GradeOfFinality().String(),
+			strings.Join(branchIDs, ";"),
+		}); err != nil {
+			return fmt.Errorf("failed to write message %s: %w", entry.Message.ID(), err)
+		}
+	}
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
+
+func parentIDs(message *tangle.Message, parentType tangle.ParentsType) (parentIDs []string) {
+	parentIDs = make([]string, 0)
+	message.ForEachParentByType(parentType, func(parentMessageID tangle.MessageID) bool {
+		parentIDs = append(parentIDs, parentMessageID.Base58())
+		return true
+	})
+
+	return parentIDs
+}
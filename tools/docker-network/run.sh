@@ -15,6 +15,15 @@ DRNG=${3:-0}
 
 export DOCKER_BUILDKIT=1
 export COMPOSE_DOCKER_CLI_BUILD=1
+
+# TOPOLOGY can point to a network spec (see topology.example.yml) that replaces the default network
+if [ -n "$TOPOLOGY" ]
+then
+  echo "Generate network from $TOPOLOGY"
+  go run ./topology --spec "$TOPOLOGY" --out docker-compose.topology.yml || exit 1
+  export COMPOSE_FILE=docker-compose.topology.yml
+fi
+
 echo "Build GoShimmer"
 # Allow docker compose to build and cache an image
 docker-compose build
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/goshimmer/tools/docker-network/topology/netspec"
+)
+
+const (
+	cfgSpecFile   = "spec"
+	cfgOutputFile = "out"
+)
+
+func main() {
+	specFile := flag.String(cfgSpecFile, "topology.yml", "the path to the YAML file that describes the network")
+	outputFile := flag.String(cfgOutputFile, "docker-compose.topology.yml", "the path of the generated docker compose file")
+	flag.Parse()
+
+	spec, err := netspec.LoadSpec(*specFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	composeFile, err := netspec.Compose(spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*outputFile, composeFile, 0o644); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("created %s with %d nodes (%s topology, %d links)", *outputFile, spec.Nodes, spec.Topology.Type, len(spec.Links()))
+}
@@ -0,0 +1,208 @@
+package netspec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/mr-tron/base58"
+	"golang.org/x/crypto/blake2b"
+	"gopkg.in/yaml.v2"
+
+	"github.com/iotaledger/goshimmer/packages/manualpeering"
+)
+
+const (
+	// networkName is the name of the docker network that connects the nodes.
+	networkName = "shimmer"
+	// subnetPrefix is the prefix of the static IPs of the nodes.
+	subnetPrefix = "172.28"
+	// firstNodeHost is the host part of the IP of the first node.
+	firstNodeHost = 10
+	// gossipPort is the port that is used for gossip.
+	gossipPort = 14666
+	// webAPIPortBase is the host port of the web API of the first node. Node i uses webAPIPortBase+i.
+	webAPIPortBase = 8100
+	// device is the network device of the nodes.
+	device = "eth0"
+)
+
+// NodeName returns the name of the docker compose service of the node with the given index.
+func NodeName(node int) string {
+	return fmt.Sprintf("node_%d", node)
+}
+
+// NodeSeed returns the deterministic seed of the node with the given index.
+func NodeSeed(node int) []byte {
+	seed := blake2b.Sum256([]byte(fmt.Sprintf("goshimmer-docker-network-%d", node)))
+	return seed[:]
+}
+
+// NodeIP returns the static IP of the node with the given index.
+func NodeIP(node int) string {
+	host := firstNodeHost + node
+	return fmt.Sprintf("%s.%d.%d", subnetPrefix, host/256, host%256)
+}
+
+// Compose returns a docker compose file that starts the network that is described by the Spec. Every node is peered
+// with its neighbors using manual peering and the link latencies are configured by a tc sidecar in the network
+// namespace of the node.
+func Compose(spec *Spec) (composeFile []byte, err error) {
+	memory, err := spec.Resources.MemoryBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	neighbors := spec.Neighbors()
+	services := make(yaml.MapSlice, 0, 2*spec.Nodes)
+	for node := 0; node < spec.Nodes; node++ {
+		command, commandErr := nodeCommand(node, neighbors[node])
+		if commandErr != nil {
+			return nil, commandErr
+		}
+
+		service := composeService{
+			Build: &composeBuild{
+				Context: "../../",
+				Args:    map[string]string{"DOWNLOAD_SNAPSHOT": "0"},
+			},
+			StopGracePeriod: "1m",
+			Command:         command,
+			CPUs:            spec.Resources.CPUs,
+			MemLimit:        memory,
+			Secrets:         []string{"goshimmer.config.json", "goshimmer.message.snapshot.bin"},
+			Ports:           []string{fmt.Sprintf("%d:8080/tcp", webAPIPortBase+node)},
+			Networks: map[string]composeServiceNetwork{
+				networkName: {IPv4Address: NodeIP(node)},
+			},
+		}
+		if node == 0 {
+			service.Ports = append(service.Ports, "8081:8081/tcp", "8061:8061/tcp")
+		}
+		services = append(services, yaml.MapItem{Key: NodeName(node), Value: service})
+
+		latencies := make(map[string]Latency)
+		for other, latency := range spec.NodeLatencies(node) {
+			latencies[NodeIP(other)] = latency
+		}
+		if len(latencies) == 0 {
+			continue
+		}
+		services = append(services, yaml.MapItem{Key: NodeName(node) + "_tc", Value: composeService{
+			Image:       TrafficControlImage,
+			Entrypoint:  []string{"sh", "-c"},
+			Command:     []string{TrafficControlScript(device, latencies)},
+			CapAdd:      []string{"NET_ADMIN"},
+			NetworkMode: "service:" + NodeName(node),
+			DependsOn:   []string{NodeName(node)},
+		}})
+	}
+
+	return yaml.Marshal(composeDefinition{
+		Version:  "3.9",
+		Services: services,
+		Networks: map[string]composeNetwork{
+			networkName: {
+				Driver: "bridge",
+				IPAM: composeIPAM{Config: []composeIPAMConfig{{
+					Subnet: subnetPrefix + ".0.0/16",
+				}}},
+			},
+		},
+		Secrets: map[string]composeSecret{
+			"goshimmer.message.snapshot.bin": {File: "${SNAPSHOT_FILE:-../integration-tests/assets/7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih.bin}"},
+			"goshimmer.config.json":          {File: "${GOSHIMMER_CONFIG:-./config.docker.json}"},
+		},
+	})
+}
+
+// nodeCommand returns the command line arguments of the node with the given index.
+func nodeCommand(node int, neighbors []int) (command []string, err error) {
+	knownPeers := make([]*manualpeering.KnownPeerToAdd, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		knownPeers = append(knownPeers, &manualpeering.KnownPeerToAdd{
+			PublicKey: ed25519.PrivateKeyFromSeed(NodeSeed(neighbor)).Public(),
+			Address:   fmt.Sprintf("%s:%d", NodeName(neighbor), gossipPort),
+		})
+	}
+	knownPeersJSON, err := json.Marshal(knownPeers)
+	if err != nil {
+		return nil, errors.Errorf("failed to marshal known peers of node %d: %w", node, err)
+	}
+
+	enabledPlugins := []string{"bootstrap", "webAPIToolsEndpoint"}
+	if node == 0 {
+		enabledPlugins = append(enabledPlugins, "spammer", "activity")
+	}
+
+	return []string{
+		"--config=/run/secrets/goshimmer.config.json",
+		"--database.directory=/tmp/mainnetdb",
+		"--node.peerDBDirectory=/tmp/peerdb",
+		"--node.seed=base58:" + base58.Encode(NodeSeed(node)),
+		"--node.overwriteStoredSeed=true",
+		"--node.enablePlugins=" + strings.Join(enabledPlugins, ","),
+		"--node.disablePlugins=portcheck,clock,Firewall,AutoPeering",
+		"--manualPeering.knownPeers=" + string(knownPeersJSON),
+		"--messageLayer.snapshot.file=/run/secrets/goshimmer.message.snapshot.bin",
+		"--messageLayer.snapshot.genesisNode=",
+		"--messageLayer.startSynced=true",
+		"--mana.snapshotResetTime=true",
+		"--webAPI.exportPath=/tmp/",
+	}, nil
+}
+
+// region compose file definitions /////////////////////////////////////////////////////////////////////////////////////
+
+type composeDefinition struct {
+	Version  string                    `yaml:"version"`
+	Services yaml.MapSlice             `yaml:"services"`
+	Networks map[string]composeNetwork `yaml:"networks"`
+	Secrets  map[string]composeSecret  `yaml:"secrets"`
+}
+
+type composeService struct {
+	Build           *composeBuild                    `yaml:"build,omitempty"`
+	Image           string                           `yaml:"image,omitempty"`
+	StopGracePeriod string                           `yaml:"stop_grace_period,omitempty"`
+	Entrypoint      []string                         `yaml:"entrypoint,omitempty"`
+	Command         []string                         `yaml:"command,omitempty"`
+	CPUs            float64                          `yaml:"cpus,omitempty"`
+	MemLimit        int64                            `yaml:"mem_limit,omitempty"`
+	CapAdd          []string                         `yaml:"cap_add,omitempty"`
+	NetworkMode     string                           `yaml:"network_mode,omitempty"`
+	Secrets         []string                         `yaml:"secrets,omitempty"`
+	Ports           []string                         `yaml:"ports,omitempty"`
+	Networks        map[string]composeServiceNetwork `yaml:"networks,omitempty"`
+	DependsOn       []string                         `yaml:"depends_on,omitempty"`
+}
+
+type composeBuild struct {
+	Context string            `yaml:"context"`
+	Args    map[string]string `yaml:"args,omitempty"`
+}
+
+type composeServiceNetwork struct {
+	IPv4Address string `yaml:"ipv4_address"`
+}
+
+type composeNetwork struct {
+	Driver string      `yaml:"driver"`
+	IPAM   composeIPAM `yaml:"ipam"`
+}
+
+type composeIPAM struct {
+	Config []composeIPAMConfig `yaml:"config"`
+}
+
+type composeIPAMConfig struct {
+	Subnet string `yaml:"subnet"`
+}
+
+type composeSecret struct {
+	File string `yaml:"file"`
+}
+
+// endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -0,0 +1,134 @@
+package netspec
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+)
+
+func TestParseSpec(t *testing.T) {
+	spec, err := ParseSpec([]byte(`
+nodes: 4
+latency:
+  delay: 20ms
+  links:
+    - from: 1
+      to: 3
+      delay: 100ms
+      jitter: 10ms
+resources:
+  cpus: 0.5
+  memory: 512m
+`))
+	require.NoError(t, err)
+
+	assert.Equal(t, TopologyFull, spec.Topology.Type)
+	assert.Equal(t, Latency{Delay: 20 * time.Millisecond}, spec.LinkLatency(0, 1))
+	assert.Equal(t, Latency{Delay: 100 * time.Millisecond, Jitter: 10 * time.Millisecond}, spec.LinkLatency(3, 1))
+	assert.EqualValues(t, 500000000, spec.Resources.NanoCPUs())
+	memory, err := spec.Resources.MemoryBytes()
+	require.NoError(t, err)
+	assert.EqualValues(t, 512<<20, memory)
+
+	for _, invalidSpec := range []string{
+		`nodes: 0`,
+		`{nodes: 3, topology: {type: mesh}}`,
+		`{nodes: 3, topology: {type: random, degree: 3}}`,
+		`{nodes: 3, latency: {links: [{from: 1, to: 1, delay: 1s}]}}`,
+		`{nodes: 3, resources: {memory: lots}}`,
+		`{nodes: 3, unknown: true}`,
+	} {
+		_, err = ParseSpec([]byte(invalidSpec))
+		assert.ErrorIs(t, err, ErrInvalidSpec, invalidSpec)
+	}
+}
+
+func TestSpec_Links(t *testing.T) {
+	star := &Spec{Nodes: 4, Topology: TopologySpec{Type: TopologyStar}}
+	assert.Equal(t, []Link{{0, 1}, {0, 2}, {0, 3}}, star.Links())
+
+	ring := &Spec{Nodes: 4, Topology: TopologySpec{Type: TopologyRing}}
+	assert.Equal(t, []Link{{0, 1}, {0, 3}, {1, 2}, {2, 3}}, ring.Links())
+
+	full := &Spec{Nodes: 3, Topology: TopologySpec{Type: TopologyFull}}
+	assert.Equal(t, []Link{{0, 1}, {0, 2}, {1, 2}}, full.Links())
+
+	random := &Spec{Nodes: 20, Topology: TopologySpec{Type: TopologyRandom, Degree: 3, Seed: 7}}
+	assert.Equal(t, random.Links(), random.Links(), "the same seed must result in the same topology")
+	neighbors := random.Neighbors()
+	for node := range neighbors {
+		assert.GreaterOrEqual(t, len(neighbors[node]), 3)
+	}
+	assert.Len(t, reachable(neighbors, 0), 20, "random topology must be connected")
+}
+
+func TestTrafficControlCommands(t *testing.T) {
+	assert.Empty(t, TrafficControlCommands("eth0", nil))
+
+	assert.Equal(t, []string{
+		"tc qdisc replace dev eth0 root handle 1: htb default 1",
+		"tc class add dev eth0 parent 1: classid 1:1 htb rate 10gbit",
+		"tc class add dev eth0 parent 1: classid 1:2 htb rate 10gbit",
+		"tc qdisc add dev eth0 parent 1:2 handle 20: netem delay 20000us",
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.0.0.2/32 flowid 1:2",
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.0.0.3/32 flowid 1:2",
+		"tc class add dev eth0 parent 1: classid 1:3 htb rate 10gbit",
+		"tc qdisc add dev eth0 parent 1:3 handle 30: netem delay 100000us 5000us",
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.0.0.4/32 flowid 1:3",
+	}, TrafficControlCommands("eth0", map[string]Latency{
+		"10.0.0.3": {Delay: 20 * time.Millisecond},
+		"10.0.0.4": {Delay: 100 * time.Millisecond, Jitter: 5 * time.Millisecond},
+		"10.0.0.2": {Delay: 20 * time.Millisecond},
+	}))
+}
+
+func TestCompose(t *testing.T) {
+	spec := &Spec{
+		Nodes:    3,
+		Topology: TopologySpec{Type: TopologyStar},
+		Latency: LatencySpec{Links: []LinkLatency{
+			{From: 0, To: 2, Latency: Latency{Delay: 50 * time.Millisecond}},
+		}},
+	}
+
+	composeFile, err := Compose(spec)
+	require.NoError(t, err)
+
+	var definition struct {
+		Services map[string]struct {
+			Command     []string `yaml:"command"`
+			NetworkMode string   `yaml:"network_mode"`
+		} `yaml:"services"`
+	}
+	require.NoError(t, yaml.Unmarshal(composeFile, &definition))
+
+	// only the nodes of the delayed link need a tc sidecar
+	assert.Len(t, definition.Services, 5)
+	assert.Equal(t, "service:node_0", definition.Services["node_0_tc"].NetworkMode)
+	assert.Equal(t, []string{"tc qdisc replace dev eth0 root handle 1: htb default 1 && tc class add dev eth0 parent 1: classid 1:1 htb rate 10gbit && " +
+		"tc class add dev eth0 parent 1: classid 1:2 htb rate 10gbit && tc qdisc add dev eth0 parent 1:2 handle 20: netem delay 50000us && " +
+		"tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst " + NodeIP(0) + "/32 flowid 1:2"}, definition.Services["node_2_tc"].Command)
+	assert.Contains(t, strings.Join(definition.Services["node_1"].Command, " "), `"address":"node_0:14666"`)
+	assert.NotContains(t, strings.Join(definition.Services["node_1"].Command, " "), `"address":"node_2:14666"`)
+}
+
+func reachable(neighbors [][]int, start int) (visited map[int]struct{}) {
+	visited = map[int]struct{}{start: {}}
+	stack := []int{start}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, neighbor := range neighbors[node] {
+			if _, ok := visited[neighbor]; !ok {
+				visited[neighbor] = struct{}{}
+				stack = append(stack, neighbor)
+			}
+		}
+	}
+
+	return visited
+}
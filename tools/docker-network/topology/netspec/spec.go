@@ -0,0 +1,188 @@
+package netspec
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// TopologyFull connects every node with every other node.
+	TopologyFull = "full"
+	// TopologyStar connects every node with the first node.
+	TopologyStar = "star"
+	// TopologyRing connects every node with its predecessor and its successor.
+	TopologyRing = "ring"
+	// TopologyRandom connects every node with at least Degree randomly chosen nodes.
+	TopologyRandom = "random"
+)
+
+// ErrInvalidSpec is returned if a Spec contains invalid or inconsistent values.
+var ErrInvalidSpec = errors.New("invalid network spec")
+
+// Spec describes the topology, the link conditions and the resources of a GoShimmer docker network.
+type Spec struct {
+	// Nodes is the number of GoShimmer nodes of the network.
+	Nodes int `yaml:"nodes"`
+	// Topology defines how the nodes are connected.
+	Topology TopologySpec `yaml:"topology"`
+	// Latency defines the latency of the links between the nodes.
+	Latency LatencySpec `yaml:"latency"`
+	// Resources defines the resource limits of every node.
+	Resources ResourcesSpec `yaml:"resources"`
+}
+
+// TopologySpec defines how the nodes of the network are connected.
+type TopologySpec struct {
+	// Type is one of "full" (default), "star", "ring" or "random".
+	Type string `yaml:"type"`
+	// Degree is the minimum number of neighbors of every node in the random topology.
+	Degree int `yaml:"degree"`
+	// Seed is the seed of the random topology. The same seed always results in the same topology.
+	Seed int64 `yaml:"seed"`
+}
+
+// LatencySpec defines the latency of the links between the nodes.
+type LatencySpec struct {
+	// Latency is the latency that is used for all links without an explicit entry in Links.
+	Latency `yaml:",inline"`
+	// Links overrides the latency of individual links.
+	Links []LinkLatency `yaml:"links"`
+}
+
+// Latency is the one-way delay (and its jitter) of a link. The round-trip time of a link is twice its delay.
+type Latency struct {
+	// Delay is the one-way delay of the link.
+	Delay time.Duration `yaml:"delay"`
+	// Jitter is the random variation of the delay.
+	Jitter time.Duration `yaml:"jitter"`
+}
+
+// LinkLatency defines the latency of the link between two nodes (identified by their index).
+type LinkLatency struct {
+	// From is the index of the first node of the link.
+	From int `yaml:"from"`
+	// To is the index of the second node of the link.
+	To int `yaml:"to"`
+	// Latency is the latency of the link in both directions.
+	Latency `yaml:",inline"`
+}
+
+// ResourcesSpec defines the resource limits of a node.
+type ResourcesSpec struct {
+	// CPUs is the number of CPUs that are available to a node (0 means unlimited).
+	CPUs float64 `yaml:"cpus"`
+	// Memory is the memory that is available to a node, e.g. "512m" or "2g" (empty means unlimited).
+	Memory string `yaml:"memory"`
+}
+
+// LoadSpec reads the Spec from the YAML file at the given path.
+func LoadSpec(path string) (spec *Spec, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorf("failed to read network spec %s: %w", path, err)
+	}
+
+	return ParseSpec(data)
+}
+
+// ParseSpec parses and validates the given YAML encoded Spec.
+func ParseSpec(data []byte) (spec *Spec, err error) {
+	spec = &Spec{}
+	if err = yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, errors.Errorf("failed to parse network spec (%v): %w", err, ErrInvalidSpec)
+	}
+	if spec.Topology.Type == "" {
+		spec.Topology.Type = TopologyFull
+	}
+
+	if err = spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// Validate checks that the Spec is complete and consistent.
+func (s *Spec) Validate() (err error) {
+	if s.Nodes < 1 {
+		return errors.Errorf("at least one node is required: %w", ErrInvalidSpec)
+	}
+
+	switch s.Topology.Type {
+	case TopologyFull, TopologyStar, TopologyRing:
+	case TopologyRandom:
+		if s.Topology.Degree < 1 || s.Topology.Degree >= s.Nodes {
+			return errors.Errorf("degree of the random topology must be in [1, %d]: %w", s.Nodes-1, ErrInvalidSpec)
+		}
+	default:
+		return errors.Errorf("unknown topology %q: %w", s.Topology.Type, ErrInvalidSpec)
+	}
+
+	if s.Latency.Delay < 0 || s.Latency.Jitter < 0 {
+		return errors.Errorf("latency must not be negative: %w", ErrInvalidSpec)
+	}
+	for i, link := range s.Latency.Links {
+		if link.From < 0 || link.From >= s.Nodes || link.To < 0 || link.To >= s.Nodes || link.From == link.To {
+			return errors.Errorf("link latency %d references an invalid link %d-%d: %w", i, link.From, link.To, ErrInvalidSpec)
+		}
+		if link.Delay < 0 || link.Jitter < 0 {
+			return errors.Errorf("link latency %d must not be negative: %w", i, ErrInvalidSpec)
+		}
+	}
+
+	if s.Resources.CPUs < 0 {
+		return errors.Errorf("cpus must not be negative: %w", ErrInvalidSpec)
+	}
+	if _, err = s.Resources.MemoryBytes(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LinkLatency returns the latency of the link between the two nodes.
+func (s *Spec) LinkLatency(a, b int) (latency Latency) {
+	for _, link := range s.Latency.Links {
+		if (link.From == a && link.To == b) || (link.From == b && link.To == a) {
+			return link.Latency
+		}
+	}
+
+	return s.Latency.Latency
+}
+
+// NanoCPUs returns the CPU limit in units of 10^-9 CPUs (0 means unlimited).
+func (r ResourcesSpec) NanoCPUs() int64 {
+	return int64(r.CPUs * 1e9)
+}
+
+// MemoryBytes returns the memory limit in bytes (0 means unlimited).
+func (r ResourcesSpec) MemoryBytes() (bytes int64, err error) {
+	if r.Memory == "" {
+		return 0, nil
+	}
+
+	value, multiplier := strings.TrimSuffix(strings.ToLower(r.Memory), "b"), int64(1)
+	switch {
+	case strings.HasSuffix(value, "k"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(value, "m"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(value, "g"):
+		multiplier = 1 << 30
+	}
+	if multiplier != 1 {
+		value = value[:len(value)-1]
+	}
+
+	if bytes, err = strconv.ParseInt(value, 10, 64); err != nil || bytes < 0 {
+		return 0, errors.Errorf("invalid memory limit %q: %w", r.Memory, ErrInvalidSpec)
+	}
+
+	return bytes * multiplier, nil
+}
@@ -0,0 +1,111 @@
+package netspec
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// Link is an undirected connection between two nodes (identified by their index).
+type Link struct {
+	// A is the smaller index of the two nodes.
+	A int
+	// B is the larger index of the two nodes.
+	B int
+}
+
+// NewLink returns the Link between the two nodes.
+func NewLink(a, b int) Link {
+	if a > b {
+		a, b = b, a
+	}
+
+	return Link{A: a, B: b}
+}
+
+// Links returns the links of the topology of the network sorted by their node indices.
+func (s *Spec) Links() (links []Link) {
+	linkSet := make(map[Link]struct{})
+	switch s.Topology.Type {
+	case TopologyStar:
+		for i := 1; i < s.Nodes; i++ {
+			linkSet[NewLink(0, i)] = struct{}{}
+		}
+	case TopologyRing:
+		for i := 0; i < s.Nodes && s.Nodes > 1; i++ {
+			linkSet[NewLink(i, (i+1)%s.Nodes)] = struct{}{}
+		}
+	case TopologyRandom:
+		linkSet = randomLinks(s.Nodes, s.Topology.Degree, rand.New(rand.NewSource(s.Topology.Seed)))
+	default:
+		for i := 0; i < s.Nodes; i++ {
+			for j := i + 1; j < s.Nodes; j++ {
+				linkSet[NewLink(i, j)] = struct{}{}
+			}
+		}
+	}
+
+	links = make([]Link, 0, len(linkSet))
+	for link := range linkSet {
+		links = append(links, link)
+	}
+	sort.Slice(links, func(i, j int) bool {
+		if links[i].A != links[j].A {
+			return links[i].A < links[j].A
+		}
+		return links[i].B < links[j].B
+	})
+
+	return links
+}
+
+// Neighbors returns the indices of the neighbors of every node.
+func (s *Spec) Neighbors() (neighbors [][]int) {
+	neighbors = make([][]int, s.Nodes)
+	for _, link := range s.Links() {
+		neighbors[link.A] = append(neighbors[link.A], link.B)
+		neighbors[link.B] = append(neighbors[link.B], link.A)
+	}
+
+	return neighbors
+}
+
+// randomLinks creates a connected random graph in which every node has at least the given degree. It first creates a
+// random spanning tree and then adds random links until every node reached the degree.
+func randomLinks(nodes, degree int, rng *rand.Rand) (linkSet map[Link]struct{}) {
+	linkSet = make(map[Link]struct{})
+	degrees := make([]int, nodes)
+	addLink := func(a, b int) {
+		linkSet[NewLink(a, b)] = struct{}{}
+		degrees[a]++
+		degrees[b]++
+	}
+
+	order := rng.Perm(nodes)
+	for i := 1; i < nodes; i++ {
+		addLink(order[i], order[rng.Intn(i)])
+	}
+
+	for _, node := range order {
+		for degrees[node] < degree {
+			// prefer nodes that did not reach the degree yet to keep the degrees balanced
+			var candidates, fallbackCandidates []int
+			for other := 0; other < nodes; other++ {
+				if _, exists := linkSet[NewLink(node, other)]; exists || other == node {
+					continue
+				}
+				if degrees[other] < degree {
+					candidates = append(candidates, other)
+				} else {
+					fallbackCandidates = append(fallbackCandidates, other)
+				}
+			}
+			if len(candidates) == 0 {
+				candidates = fallbackCandidates
+			}
+
+			addLink(node, candidates[rng.Intn(len(candidates))])
+		}
+	}
+
+	return linkSet
+}
@@ -0,0 +1,84 @@
+package netspec
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TrafficControlImage is the docker image that is used to configure the link latencies of a node.
+const TrafficControlImage = "gaiadocker/iproute2"
+
+// NodeLatencies returns the latency of the links of the given node keyed by the index of the neighbor. Links without
+// any delay are omitted.
+func (s *Spec) NodeLatencies(node int) (latencies map[int]Latency) {
+	latencies = make(map[int]Latency)
+	for other := 0; other < s.Nodes; other++ {
+		if other == node {
+			continue
+		}
+		if latency := s.LinkLatency(node, other); latency.Delay > 0 || latency.Jitter > 0 {
+			latencies[other] = latency
+		}
+	}
+
+	return latencies
+}
+
+// TrafficControlCommands returns the tc commands that delay the egress traffic of the given device to the given
+// destination IPs. Destinations with the same latency share a netem qdisc, all other traffic is not delayed.
+func TrafficControlCommands(device string, latencies map[string]Latency) (commands []string) {
+	if len(latencies) == 0 {
+		return nil
+	}
+
+	ipsByLatency := make(map[Latency][]string)
+	for ip, latency := range latencies {
+		ipsByLatency[latency] = append(ipsByLatency[latency], ip)
+	}
+	distinctLatencies := make([]Latency, 0, len(ipsByLatency))
+	for latency := range ipsByLatency {
+		distinctLatencies = append(distinctLatencies, latency)
+	}
+	sort.Slice(distinctLatencies, func(i, j int) bool {
+		if distinctLatencies[i].Delay != distinctLatencies[j].Delay {
+			return distinctLatencies[i].Delay < distinctLatencies[j].Delay
+		}
+		return distinctLatencies[i].Jitter < distinctLatencies[j].Jitter
+	})
+
+	// class 1:1 is the default class of all traffic that is not delayed
+	commands = []string{
+		fmt.Sprintf("tc qdisc replace dev %s root handle 1: htb default 1", device),
+		fmt.Sprintf("tc class add dev %s parent 1: classid 1:1 htb rate 10gbit", device),
+	}
+	for i, latency := range distinctLatencies {
+		classID := i + 2
+		commands = append(commands,
+			fmt.Sprintf("tc class add dev %s parent 1: classid 1:%d htb rate 10gbit", device, classID),
+			fmt.Sprintf("tc qdisc add dev %s parent 1:%d handle %d: netem %s", device, classID, classID*10, latency.netemArgs()),
+		)
+
+		ips := ipsByLatency[latency]
+		sort.Strings(ips)
+		for _, ip := range ips {
+			commands = append(commands, fmt.Sprintf("tc filter add dev %s protocol ip parent 1: prio 1 u32 match ip dst %s/32 flowid 1:%d", device, ip, classID))
+		}
+	}
+
+	return commands
+}
+
+// TrafficControlScript returns the TrafficControlCommands as a single shell command.
+func TrafficControlScript(device string, latencies map[string]Latency) string {
+	return strings.Join(TrafficControlCommands(device, latencies), " && ")
+}
+
+// netemArgs returns the arguments of the netem qdisc that result in the Latency.
+func (l Latency) netemArgs() string {
+	if l.Jitter == 0 {
+		return fmt.Sprintf("delay %dus", l.Delay.Microseconds())
+	}
+
+	return fmt.Sprintf("delay %dus %dus", l.Delay.Microseconds(), l.Jitter.Microseconds())
+}
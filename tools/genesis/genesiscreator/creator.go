@@ -0,0 +1,161 @@
+package genesiscreator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+	"github.com/iotaledger/goshimmer/packages/tangle"
+)
+
+// CreateSnapshot creates the ledger snapshot that is described by the given Spec. Every allocation is created by its
+// own genesis transaction that pledges the mana of the allocated funds to the configured nodes.
+func CreateSnapshot(spec *Spec) (ledgerSnapshot *ledgerstate.Snapshot, err error) {
+	if err = spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	genesisTime := time.Unix(spec.genesisTime(), 0)
+	ledgerSnapshot = &ledgerstate.Snapshot{
+		Transactions:     make(map[ledgerstate.TransactionID]ledgerstate.Record),
+		AccessManaByNode: make(map[identity.ID]ledgerstate.AccessMana),
+	}
+
+	for i, allocation := range spec.Allocations {
+		address, _ := allocation.address()
+		accessPledgeKey, _ := allocation.accessPledgeKey()
+		consensusPledgeKey, _ := allocation.consensusPledgeKey()
+		accessPledgeID := identity.NewID(accessPledgeKey)
+
+		tx := ledgerstate.NewTransaction(ledgerstate.NewTransactionEssence(
+			0,
+			genesisTime,
+			accessPledgeID,
+			identity.NewID(consensusPledgeKey),
+			ledgerstate.NewInputs(ledgerstate.NewUTXOInput(ledgerstate.NewOutputID(ledgerstate.GenesisTransactionID, uint16(i)))),
+			ledgerstate.NewOutputs(ledgerstate.NewSigLockedColoredOutput(ledgerstate.NewColoredBalances(map[ledgerstate.Color]uint64{
+				ledgerstate.ColorIOTA: allocation.Amount,
+			}), address)),
+		), ledgerstate.UnlockBlocks{ledgerstate.NewReferenceUnlockBlock(0)})
+
+		ledgerSnapshot.Transactions[tx.ID()] = ledgerstate.Record{
+			Essence:        tx.Essence(),
+			UnlockBlocks:   tx.UnlockBlocks(),
+			UnspentOutputs: []bool{true},
+		}
+		addAccessMana(ledgerSnapshot, accessPledgeID, float64(allocation.Amount), genesisTime)
+	}
+
+	for _, manaPledge := range spec.ManaPledges {
+		publicKey, _ := ed25519.PublicKeyFromString(manaPledge.Node)
+		addAccessMana(ledgerSnapshot, identity.NewID(publicKey), manaPledge.Amount, genesisTime)
+	}
+
+	return ledgerSnapshot, nil
+}
+
+// WriteSnapshot writes the snapshot to the file and in the format that is defined by the Spec.
+func WriteSnapshot(spec *Spec, ledgerSnapshot *ledgerstate.Snapshot) (err error) {
+	f, err := os.OpenFile(spec.Snapshot.File, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o666)
+	if err != nil {
+		return errors.Errorf("unable to create snapshot file %s: %w", spec.Snapshot.File, err)
+	}
+	defer f.Close()
+
+	if spec.Snapshot.Format == FormatLegacy {
+		_, err = ledgerSnapshot.WriteTo(f)
+	} else {
+		_, err = snapshot.Write(f, ledgerSnapshot, snapshot.DefaultChunkSize, spec.NetworkID)
+	}
+	if err != nil {
+		return errors.Errorf("unable to write snapshot file %s: %w", spec.Snapshot.File, err)
+	}
+
+	return f.Close()
+}
+
+// NodeConfig returns the node configuration (in the format of config.json) that nodes of the network need to use.
+func NodeConfig(spec *Spec) (config map[string]interface{}) {
+	config = make(map[string]interface{})
+	for key, value := range spec.Parameters {
+		setConfigValue(config, key, value)
+	}
+
+	snapshotConfig := map[string]interface{}{
+		"file": spec.Snapshot.File,
+	}
+	if spec.GenesisNode != "" {
+		snapshotConfig["genesisNode"] = spec.GenesisNode
+	}
+	setConfigValue(config, "messageLayer.snapshot", snapshotConfig)
+	if spec.NetworkVersion != 0 {
+		setConfigValue(config, "autoPeering.networkVersion", spec.NetworkVersion)
+	}
+	if spec.NetworkID != 0 {
+		setConfigValue(config, "node.networkID", spec.NetworkID)
+	}
+
+	return config
+}
+
+// WriteNodeConfig writes the node configuration of the network as JSON to the given path.
+func WriteNodeConfig(spec *Spec, path string) (err error) {
+	configBytes, err := json.MarshalIndent(NodeConfig(spec), "", "  ")
+	if err != nil {
+		return errors.Errorf("unable to marshal node config: %w", err)
+	}
+
+	return os.WriteFile(path, append(configBytes, '\n'), 0o644)
+}
+
+// genesisTime returns the configured genesis time or the default genesis time of the Tangle.
+func (s *Spec) genesisTime() int64 {
+	if s.GenesisTime == 0 {
+		return tangle.DefaultGenesisTime
+	}
+
+	return s.GenesisTime
+}
+
+// addAccessMana adds the given amount of access mana to the node.
+func addAccessMana(ledgerSnapshot *ledgerstate.Snapshot, nodeID identity.ID, amount float64, timestamp time.Time) {
+	accessMana := ledgerSnapshot.AccessManaByNode[nodeID]
+	accessMana.Value += amount
+	accessMana.Timestamp = timestamp
+	ledgerSnapshot.AccessManaByNode[nodeID] = accessMana
+}
+
+// setConfigValue sets the value at the given dot separated key, merging nested maps.
+func setConfigValue(config map[string]interface{}, key string, value interface{}) {
+	path := strings.Split(key, ".")
+	for _, segment := range path[:len(path)-1] {
+		nested, ok := config[segment].(map[string]interface{})
+		if !ok {
+			nested = make(map[string]interface{})
+			config[segment] = nested
+		}
+		config = nested
+	}
+
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for nestedKey, nestedValue := range typedValue {
+			setConfigValue(config, path[len(path)-1]+"."+nestedKey, nestedValue)
+		}
+	case map[interface{}]interface{}:
+		// nested maps that are decoded from YAML use interface{} keys
+		for nestedKey, nestedValue := range typedValue {
+			setConfigValue(config, path[len(path)-1]+"."+fmt.Sprint(nestedKey), nestedValue)
+		}
+	default:
+		config[path[len(path)-1]] = value
+	}
+}
@@ -0,0 +1,100 @@
+package genesiscreator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/identity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+	"github.com/iotaledger/goshimmer/packages/snapshot"
+)
+
+const testSpec = `
+networkVersion: 1337
+networkID: 7
+snapshot:
+  format: chunked
+allocations:
+  - seed: 7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih
+    amount: 1000
+    accessPledge: EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP
+  - seed: 7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih
+    index: 1
+    amount: 500
+    accessPledge: EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP
+    consensusPledge: 9fC9crffh3xYuw3M114ZtxRFxxCFceG8vdq2RAjDVQCK
+manaPledges:
+  - node: 3kwsHfLDb7ifuxLbyMZneXq3s5heRWnXKKGPAARJDaUE
+    amount: 42
+parameters:
+  messageLayer.startSynced: true
+`
+
+func TestCreateSnapshot(t *testing.T) {
+	spec, err := ParseSpec([]byte(testSpec))
+	require.NoError(t, err)
+	spec.Snapshot.File = filepath.Join(t.TempDir(), "snapshot.bin")
+
+	ledgerSnapshot, err := CreateSnapshot(spec)
+	require.NoError(t, err)
+	assert.Len(t, ledgerSnapshot.Transactions, 2)
+	assert.Equal(t, 1500.0, ledgerSnapshot.AccessManaByNode[nodeID(t, "EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP")].Value)
+	assert.Equal(t, 42.0, ledgerSnapshot.AccessManaByNode[nodeID(t, "3kwsHfLDb7ifuxLbyMZneXq3s5heRWnXKKGPAARJDaUE")].Value)
+
+	consensusPledges := make(map[identity.ID]uint64)
+	for _, record := range ledgerSnapshot.Transactions {
+		record.Essence.Outputs()[0].Balances().ForEach(func(_ ledgerstate.Color, balance uint64) bool {
+			consensusPledges[record.Essence.ConsensusPledgeID()] += balance
+			return true
+		})
+	}
+	assert.EqualValues(t, 1000, consensusPledges[nodeID(t, "EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP")])
+	assert.EqualValues(t, 500, consensusPledges[nodeID(t, "9fC9crffh3xYuw3M114ZtxRFxxCFceG8vdq2RAjDVQCK")])
+
+	require.NoError(t, WriteSnapshot(spec, ledgerSnapshot))
+	f, err := os.Open(spec.Snapshot.File)
+	require.NoError(t, err)
+	defer f.Close()
+	readSnapshot, err := snapshot.Read(f, 7)
+	require.NoError(t, err)
+	assert.Len(t, readSnapshot.Transactions, 2)
+}
+
+func TestNodeConfig(t *testing.T) {
+	spec, err := ParseSpec([]byte(testSpec))
+	require.NoError(t, err)
+
+	config := NodeConfig(spec)
+	assert.Equal(t, map[string]interface{}{"networkVersion": uint32(1337)}, config["autoPeering"])
+	assert.Equal(t, map[string]interface{}{"networkID": uint32(7)}, config["node"])
+	messageLayerConfig := config["messageLayer"].(map[string]interface{})
+	assert.Equal(t, true, messageLayerConfig["startSynced"])
+	assert.Equal(t, "./snapshot.bin", messageLayerConfig["snapshot"].(map[string]interface{})["file"])
+}
+
+func TestParseSpec_Invalid(t *testing.T) {
+	for name, spec := range map[string]string{
+		"noAllocations": `networkVersion: 1`,
+		"noAmount":      "allocations:\n  - seed: 7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih\n    accessPledge: EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP",
+		"noPledge":      "allocations:\n  - seed: 7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih\n    amount: 1",
+		"unknownField":  "unknown: 1",
+		"legacyNetwork": "networkID: 1\nsnapshot:\n  format: legacy\nallocations:\n  - seed: 7R1itJx5hVuo9w9hjg5cwKFmek4HMSoBDgJZN8hKGxih\n    amount: 1\n    accessPledge: EYsaGXnUVA9aTYL9FwYEvoQ8d1HCJveQVL7vogu6pqCP",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseSpec([]byte(spec))
+			assert.ErrorIs(t, err, ErrInvalidSpec)
+		})
+	}
+}
+
+func nodeID(t *testing.T, publicKey string) identity.ID {
+	key, err := ed25519.PublicKeyFromString(publicKey)
+	require.NoError(t, err)
+
+	return identity.NewID(key)
+}
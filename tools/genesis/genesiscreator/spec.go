@@ -0,0 +1,199 @@
+package genesiscreator
+
+import (
+	"os"
+
+	"github.com/cockroachdb/errors"
+	"github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/mr-tron/base58"
+	"gopkg.in/yaml.v2"
+
+	"github.com/iotaledger/goshimmer/client/wallet/packages/seed"
+	"github.com/iotaledger/goshimmer/packages/ledgerstate"
+)
+
+const (
+	// FormatChunked selects the chunked and checksummed snapshot format.
+	FormatChunked = "chunked"
+	// FormatLegacy selects the legacy snapshot format.
+	FormatLegacy = "legacy"
+)
+
+// ErrInvalidSpec is returned if a Spec contains invalid or inconsistent values.
+var ErrInvalidSpec = errors.New("invalid genesis spec")
+
+// Spec describes the genesis of a network.
+type Spec struct {
+	// NetworkVersion is the autopeering network version that separates the network from others.
+	NetworkVersion uint32 `yaml:"networkVersion"`
+	// NetworkID is the ID of the network that is committed to by messages, gossip handshakes, databases and snapshots.
+	NetworkID uint32 `yaml:"networkID"`
+	// GenesisTime is the time (Unix in seconds) that is used for the genesis transactions. If zero, the default genesis
+	// time of the Tangle is used.
+	GenesisTime int64 `yaml:"genesisTime"`
+	// GenesisNode is the node (base58 public key) that is allowed to attach to the genesis message.
+	GenesisNode string `yaml:"genesisNode"`
+	// Snapshot contains the output settings of the generated snapshot.
+	Snapshot SnapshotSpec `yaml:"snapshot"`
+	// Allocations defines the funds that exist at genesis.
+	Allocations []Allocation `yaml:"allocations"`
+	// ManaPledges defines access mana that is granted to nodes without allocating any funds.
+	ManaPledges []ManaPledge `yaml:"manaPledges"`
+	// Parameters contains additional node parameters that are added to the generated node configuration.
+	Parameters map[string]interface{} `yaml:"parameters"`
+}
+
+// SnapshotSpec defines how the snapshot is written.
+type SnapshotSpec struct {
+	// File is the path of the generated snapshot file.
+	File string `yaml:"file"`
+	// Format is either "chunked" (default) or "legacy".
+	Format string `yaml:"format"`
+}
+
+// Allocation defines an output that exists at genesis.
+type Allocation struct {
+	// Address is the base58 encoded address that owns the funds.
+	Address string `yaml:"address"`
+	// Seed is a base58 encoded seed whose address with the given index owns the funds (alternative to Address).
+	Seed string `yaml:"seed"`
+	// Index is the index of the address that is derived from the Seed.
+	Index uint64 `yaml:"index"`
+	// Amount is the amount of IOTA tokens of the output.
+	Amount uint64 `yaml:"amount"`
+	// AccessPledge is the node (base58 public key) that the access mana of the allocation is pledged to.
+	AccessPledge string `yaml:"accessPledge"`
+	// ConsensusPledge is the node (base58 public key) that the consensus mana of the allocation is pledged to. If
+	// empty, the AccessPledge is used.
+	ConsensusPledge string `yaml:"consensusPledge"`
+}
+
+// ManaPledge grants access mana to a node.
+type ManaPledge struct {
+	// Node is the base58 public key of the node.
+	Node string `yaml:"node"`
+	// Amount is the amount of access mana that is granted.
+	Amount float64 `yaml:"amount"`
+}
+
+// LoadSpec reads the Spec from the YAML file at the given path.
+func LoadSpec(path string) (spec *Spec, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Errorf("failed to read genesis spec %s: %w", path, err)
+	}
+
+	return ParseSpec(data)
+}
+
+// ParseSpec parses and validates the given YAML encoded Spec.
+func ParseSpec(data []byte) (spec *Spec, err error) {
+	spec = &Spec{}
+	if err = yaml.UnmarshalStrict(data, spec); err != nil {
+		return nil, errors.Errorf("failed to parse genesis spec (%v): %w", err, ErrInvalidSpec)
+	}
+	if spec.Snapshot.File == "" {
+		spec.Snapshot.File = "./snapshot.bin"
+	}
+	if spec.Snapshot.Format == "" {
+		spec.Snapshot.Format = FormatChunked
+	}
+
+	if err = spec.Validate(); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// Validate checks that the Spec is complete and consistent.
+func (s *Spec) Validate() (err error) {
+	if s.Snapshot.Format != FormatChunked && s.Snapshot.Format != FormatLegacy {
+		return errors.Errorf("unknown snapshot format %q: %w", s.Snapshot.Format, ErrInvalidSpec)
+	}
+	if s.Snapshot.Format == FormatLegacy && s.NetworkID != 0 {
+		return errors.Errorf("the legacy snapshot format does not support a network ID: %w", ErrInvalidSpec)
+	}
+	if len(s.Allocations) == 0 {
+		return errors.Errorf("at least one allocation is required: %w", ErrInvalidSpec)
+	}
+	if len(s.Allocations) > ledgerstate.MaxOutputCount {
+		return errors.Errorf("at most %d allocations are supported: %w", ledgerstate.MaxOutputCount, ErrInvalidSpec)
+	}
+	if s.GenesisNode != "" {
+		if _, err = ed25519.PublicKeyFromString(s.GenesisNode); err != nil {
+			return errors.Errorf("invalid genesis node %q (%v): %w", s.GenesisNode, err, ErrInvalidSpec)
+		}
+	}
+
+	for i, allocation := range s.Allocations {
+		if allocation.Amount == 0 {
+			return errors.Errorf("allocation %d has no amount: %w", i, ErrInvalidSpec)
+		}
+		if _, err = allocation.address(); err != nil {
+			return errors.Errorf("allocation %d: %w", i, err)
+		}
+		if _, err = allocation.accessPledgeKey(); err != nil {
+			return errors.Errorf("allocation %d: %w", i, err)
+		}
+		if _, err = allocation.consensusPledgeKey(); err != nil {
+			return errors.Errorf("allocation %d: %w", i, err)
+		}
+	}
+
+	for i, manaPledge := range s.ManaPledges {
+		if manaPledge.Amount <= 0 {
+			return errors.Errorf("mana pledge %d has no amount: %w", i, ErrInvalidSpec)
+		}
+		if _, err = ed25519.PublicKeyFromString(manaPledge.Node); err != nil {
+			return errors.Errorf("mana pledge %d has an invalid node %q (%v): %w", i, manaPledge.Node, err, ErrInvalidSpec)
+		}
+	}
+
+	return nil
+}
+
+// address returns the address that owns the funds of the Allocation.
+func (a Allocation) address() (address ledgerstate.Address, err error) {
+	switch {
+	case a.Address != "" && a.Seed != "":
+		return nil, errors.Errorf("address and seed are mutually exclusive: %w", ErrInvalidSpec)
+	case a.Address != "":
+		if address, err = ledgerstate.AddressFromBase58EncodedString(a.Address); err != nil {
+			return nil, errors.Errorf("invalid address %q (%v): %w", a.Address, err, ErrInvalidSpec)
+		}
+		return address, nil
+	case a.Seed != "":
+		seedBytes, decodeErr := base58.Decode(a.Seed)
+		if decodeErr != nil || len(seedBytes) != ed25519.SeedSize {
+			return nil, errors.Errorf("invalid seed: %w", ErrInvalidSpec)
+		}
+		return seed.NewSeed(seedBytes).Address(a.Index).Address(), nil
+	default:
+		return nil, errors.Errorf("either address or seed is required: %w", ErrInvalidSpec)
+	}
+}
+
+// accessPledgeKey returns the public key of the node that receives the access mana of the Allocation.
+func (a Allocation) accessPledgeKey() (publicKey ed25519.PublicKey, err error) {
+	if a.AccessPledge == "" {
+		return ed25519.PublicKey{}, errors.Errorf("access pledge is required: %w", ErrInvalidSpec)
+	}
+	if publicKey, err = ed25519.PublicKeyFromString(a.AccessPledge); err != nil {
+		return ed25519.PublicKey{}, errors.Errorf("invalid access pledge %q (%v): %w", a.AccessPledge, err, ErrInvalidSpec)
+	}
+
+	return publicKey, nil
+}
+
+// consensusPledgeKey returns the public key of the node that receives the consensus mana of the Allocation.
+func (a Allocation) consensusPledgeKey() (publicKey ed25519.PublicKey, err error) {
+	if a.ConsensusPledge == "" {
+		return a.accessPledgeKey()
+	}
+	if publicKey, err = ed25519.PublicKeyFromString(a.ConsensusPledge); err != nil {
+		return ed25519.PublicKey{}, errors.Errorf("invalid consensus pledge %q (%v): %w", a.ConsensusPledge, err, ErrInvalidSpec)
+	}
+
+	return publicKey, nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"log"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/goshimmer/tools/genesis/genesiscreator"
+)
+
+const (
+	cfgSpecFile   = "spec"
+	cfgConfigFile = "config-out"
+)
+
+func main() {
+	specFile := flag.String(cfgSpecFile, "genesis.yml", "the path to the YAML file that describes the genesis")
+	configFile := flag.String(cfgConfigFile, "", "if set, the node configuration of the network is written to this path")
+	flag.Parse()
+
+	spec, err := genesiscreator.LoadSpec(*specFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ledgerSnapshot, err := genesiscreator.CreateSnapshot(spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = genesiscreator.WriteSnapshot(spec, ledgerSnapshot); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("created %s snapshot %s with %d allocations and %d access mana entries", spec.Snapshot.Format, spec.Snapshot.File, len(ledgerSnapshot.Transactions), len(ledgerSnapshot.AccessManaByNode))
+
+	if *configFile != "" {
+		if err = genesiscreator.WriteNodeConfig(spec, *configFile); err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("created node config %s", *configFile)
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"os"
+
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/client"
+	"github.com/iotaledger/goshimmer/packages/conearchive"
+	"github.com/iotaledger/goshimmer/packages/graphexport"
+)
+
+const (
+	cfgNode              = "node"
+	cfgArchive           = "archive"
+	cfgFormat            = "format"
+	cfgOutput            = "output"
+	cfgEntryPoints       = "entryPoints"
+	cfgMaxMessages       = "maxMessages"
+	cfgMaxDepth          = "maxDepth"
+	cfgMessageAttributes = "messageAttributes"
+	cfgBranchAttributes  = "branchAttributes"
+	cfgBranches          = "branches"
+	defaultNode          = "http://127.0.0.1:8080"
+	defaultOutputName    = "tangle"
+)
+
+func init() {
+	flag.String(cfgNode, defaultNode, "the API URL of the node that exports the graph")
+	flag.String(cfgArchive, "", "the path of an unconfirmed cone archive that is converted instead of querying a node")
+	flag.String(cfgFormat, graphexport.GraphML.String(), "the format of the graph (graphml, dot or json)")
+	flag.String(cfgOutput, "", "the path of the exported graph (default tangle.<format>)")
+	flag.StringSlice(cfgEntryPoints, nil, "the IDs of the messages whose past cone is exported (default the tips)")
+	flag.Int(cfgMaxMessages, 0, "the maximum number of exported messages (0 uses the maximum of the node)")
+	flag.Int(cfgMaxDepth, 0, "the maximum distance of an exported message to the entry points (0 disables the limit)")
+	flag.StringSlice(cfgMessageAttributes, graphexport.DefaultMessageAttributes, fmt.Sprintf("the exported attributes of messages %v", graphexport.MessageAttributeNames()))
+	flag.StringSlice(cfgBranchAttributes, graphexport.DefaultBranchAttributes, fmt.Sprintf("the exported attributes of branches %v", graphexport.BranchAttributeNames()))
+	flag.Bool(cfgBranches, true, "whether the branch DAG is exported")
+}
+
+func main() {
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+
+	format, err := graphexport.FormatFromString(viper.GetString(cfgFormat))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var graph []byte
+	if archivePath := viper.GetString(cfgArchive); archivePath != "" {
+		graph, err = convertArchive(archivePath, format)
+	} else {
+		graph, err = client.NewGoShimmerAPI(viper.GetString(cfgNode)).GetGraph(format.String(), client.GraphExportOptions{
+			EntryPoints:       viper.GetStringSlice(cfgEntryPoints),
+			MaxMessages:       viper.GetInt(cfgMaxMessages),
+			MaxDepth:          viper.GetInt(cfgMaxDepth),
+			MessageAttributes: viper.GetStringSlice(cfgMessageAttributes),
+			BranchAttributes:  viper.GetStringSlice(cfgBranchAttributes),
+			ExcludeBranches:   !viper.GetBool(cfgBranches),
+		})
+	}
+	if err != nil {
+		log.Fatalf("failed to export graph: %s", err)
+	}
+
+	outputPath := viper.GetString(cfgOutput)
+	if outputPath == "" {
+		outputPath = defaultOutputName + format.FileExtension()
+	}
+	if err = os.WriteFile(outputPath, graph, 0o644); err != nil {
+		log.Fatalf("failed to store graph: %s", err)
+	}
+	log.Printf("exported graph to %s", outputPath)
+}
+
+// convertArchive converts the unconfirmed cone archive at the given path to a graph in the given format.
+func convertArchive(path string, format graphexport.Format) (graph []byte, err error) {
+	archiveBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read archive %s: %w", path, err)
+	}
+	archive, err := conearchive.Read(bytes.NewReader(archiveBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load archive %s: %w", path, err)
+	}
+
+	archiveGraph, err := graphexport.FromArchive(archive,
+		graphexport.MessageAttributes(viper.GetStringSlice(cfgMessageAttributes)...),
+		graphexport.BranchAttributes(viper.GetStringSlice(cfgBranchAttributes)...),
+		graphexport.Branches(viper.GetBool(cfgBranches)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer bytes.Buffer
+	if err = archiveGraph.Write(&buffer, format); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
@@ -34,6 +34,10 @@ type GoShimmer struct {
 	Seed []byte
 	// Whether to use the same seed for the node's wallet.
 	UseNodeSeedAsWalletSeed bool
+	// NanoCPUs limits the CPU of the container in units of 10^-9 CPUs (0 means unlimited).
+	NanoCPUs int64
+	// Memory limits the memory of the container in bytes (0 means unlimited).
+	Memory int64
 
 	// Network specifies network-level configurations
 	Network
@@ -15,6 +15,7 @@ import (
 	"github.com/docker/docker/client"
 	"github.com/docker/go-connections/nat"
 
+	"github.com/iotaledger/goshimmer/tools/docker-network/topology/netspec"
 	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/framework/config"
 )
 
@@ -89,6 +90,10 @@ func (d *DockerContainer) CreateNode(ctx context.Context, conf config.GoShimmer)
 
 	return d.CreateContainer(ctx, conf.Name, containerConfig, &container.HostConfig{
 		Binds: []string{"goshimmer-testing-assets:/assets:rw"},
+		Resources: container.Resources{
+			NanoCPUs: conf.NanoCPUs,
+			Memory:   conf.Memory,
+		},
 	})
 }
 
@@ -188,6 +193,23 @@ func (d *DockerContainer) CreatePumba(ctx context.Context, name string, effected
 	return d.CreateContainer(ctx, name, containerConfig, hostConfig)
 }
 
+// CreateTrafficControl creates a new container that runs the given tc script in the network namespace of
+// effectedContainer.
+func (d *DockerContainer) CreateTrafficControl(ctx context.Context, name string, effectedContainerName string, script string) error {
+	hostConfig := &container.HostConfig{
+		NetworkMode: container.NetworkMode("container:" + effectedContainerName),
+		CapAdd:      strslice.StrSlice{"NET_ADMIN"},
+	}
+
+	containerConfig := &container.Config{
+		Image:      netspec.TrafficControlImage,
+		Entrypoint: strslice.StrSlice{"sh", "-c"},
+		Cmd:        strslice.StrSlice{script},
+	}
+
+	return d.CreateContainer(ctx, name, containerConfig, hostConfig)
+}
+
 // CreateContainer creates a new container with the given configuration.
 func (d *DockerContainer) CreateContainer(ctx context.Context, name string, containerConfig *container.Config, hostConfigs ...*container.HostConfig) error {
 	var hostConfig *container.HostConfig
@@ -235,6 +257,12 @@ func (d *DockerContainer) Kill(ctx context.Context, signal string) error {
 	return d.client.ContainerKill(ctx, d.Id, signal)
 }
 
+// Wait blocks until the container exits and returns its exit status.
+func (d *DockerContainer) Wait(ctx context.Context) (int, error) {
+	status, err := d.client.ContainerWait(ctx, d.Id)
+	return int(status), err
+}
+
 // ExitStatus returns the exit status according to the container information.
 func (d *DockerContainer) ExitStatus(ctx context.Context) (int, error) {
 	resp, err := d.client.ContainerInspect(ctx, d.Id)
@@ -27,6 +27,7 @@ type Network struct {
 	docker          *client.Client
 	tester          *DockerContainer
 	socatContainers []*DockerContainer
+	tcContainers    []*DockerContainer
 
 	entryNode *Node
 	peers     []*Node
@@ -123,7 +124,17 @@ func (n *Network) DoManualPeering(ctx context.Context, nodes ...*Node) error {
 		nodes = n.peers
 	}
 
-	if err := n.addManualPeers(nodes); err != nil {
+	// connect every node to all other nodes
+	neighbors := make([][]int, len(nodes))
+	for i := range nodes {
+		for j := range nodes {
+			if i != j {
+				neighbors[i] = append(neighbors[i], j)
+			}
+		}
+	}
+
+	if err := n.addManualPeers(nodes, neighbors); err != nil {
 		return errors.Wrap(err, "adding manual peers failed")
 	}
 	if err := n.waitForManualPeering(ctx, nodes); err != nil {
@@ -223,6 +234,13 @@ func (n *Network) Shutdown(ctx context.Context) error {
 		return err
 	}
 
+	// remove all traffic control containers, they already exited after configuring the latencies
+	for _, tc := range n.tcContainers {
+		if err := tc.Remove(ctx); err != nil {
+			return err
+		}
+	}
+
 	// remove entryNode container
 	if n.entryNode != nil {
 		if err := n.entryNode.Remove(ctx); err != nil {
@@ -413,16 +431,13 @@ func (n *Network) createPeers(ctx context.Context, numPeers int, networkConfig C
 	return nil
 }
 
-// addManualPeers instructs each node to add all the other nodes as peers.
-func (n *Network) addManualPeers(nodes []*Node) error {
+// addManualPeers instructs each node to add its neighbors (given by their index in nodes) as peers.
+func (n *Network) addManualPeers(nodes []*Node, neighbors [][]int) error {
 	log.Printf("Adding manual peers to %d nodes...", len(nodes))
 	for i := range nodes {
-		// connect to all other nodes
 		var peers []*manualpeering.KnownPeerToAdd
-		for j, node := range nodes {
-			if i == j {
-				continue
-			}
+		for _, j := range neighbors[i] {
+			node := nodes[j]
 			p := &manualpeering.KnownPeerToAdd{
 				PublicKey: node.PublicKey(),
 				Address:   fmt.Sprintf("%s:%d", node.Name(), gossipPort),
@@ -21,6 +21,7 @@ const (
 	containerNameReplica     = "replica_"
 	containerNameDrand       = "drand_"
 	containerNameSuffixPumba = "_pumba"
+	containerNameSuffixTC    = "_tc"
 
 	graceTimePumba = 3 * time.Second
 
@@ -92,6 +93,7 @@ func PeerConfig() config.GoShimmer {
 	c.Faucet.PowDifficulty = 1
 	c.Faucet.SupplyOutputsCount = 4
 	c.Faucet.SplittingMultiplier = 4
+	c.Faucet.RemainderOutputsCount = 1
 	c.Faucet.GenesisTokenAmount = 2500000000000000
 
 	c.Mana.Enabled = true
@@ -112,7 +114,7 @@ func EntryNodeConfig() config.GoShimmer {
 	c := PeerConfig()
 
 	c.DisabledPlugins = append(c.DisabledPlugins, "issuer", "metrics", "valuetransfers", "consensus", "manarefresher", "manualpeering", "chat",
-		"WebAPIDataEndpoint", "WebAPIDRNGEndpoint", "WebAPIFaucetEndpoint", "WebAPIMessageEndpoint", "Snapshot", "WebAPIToolsDRNGEndpoint",
+		"WebAPIDataEndpoint", "WebAPIDRNGEndpoint", "WebAPIFaucetEndpoint", "WebAPIMessageEndpoint", "Snapshot", "WebAPIDebugEndpoint",
 		"WebAPIToolsMessageEndpoint", "WebAPIWeightProviderEndpoint", "WebAPIInfoEndpoint", "WebAPILedgerstateEndpoint", "Firewall", "remotelog", "remotelogmetrics",
 		"DAGsVisualizer")
 	c.Gossip.Enabled = false
@@ -0,0 +1,128 @@
+package framework
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/iotaledger/goshimmer/tools/docker-network/topology/netspec"
+	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/framework/config"
+)
+
+// CreateNetworkFromSpec creates and returns a network whose size, topology, link latencies and resource limits are
+// described by the given network spec. The spec can be loaded from the same file that is used by the docker-network
+// tool using netspec.LoadSpec. It blocks until all peers are connected to their neighbors.
+func (f *Framework) CreateNetworkFromSpec(ctx context.Context, name string, spec *netspec.Spec, conf CreateNetworkConfig, cfgAlterFunc ...CfgAlterFunc) (*Network, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	memory, err := spec.Resources.MemoryBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	// the topology is created using manual peering
+	conf.Autopeering = false
+	resourcesAlterFunc := func(peerIndex int, isPeerMaster bool, cfg config.GoShimmer) config.GoShimmer {
+		if len(cfgAlterFunc) > 0 && cfgAlterFunc[0] != nil {
+			cfg = cfgAlterFunc[0](peerIndex, isPeerMaster, cfg)
+		}
+		cfg.NanoCPUs = spec.Resources.NanoCPUs()
+		cfg.Memory = memory
+		return cfg
+	}
+
+	network, err := f.CreateNetworkNoAutomaticManualPeering(ctx, name, spec.Nodes, conf, resourcesAlterFunc)
+	if err != nil {
+		return nil, err
+	}
+	if err = network.SetLatencies(ctx, spec); err != nil {
+		return nil, errors.Wrap(err, "setting link latencies failed")
+	}
+	if err = network.DoTopologyPeering(ctx, spec.Neighbors()); err != nil {
+		return nil, errors.Wrap(err, "topology peering failed")
+	}
+
+	return network, nil
+}
+
+// DoTopologyPeering connects every peer of the network to its neighbors using manual peering. The neighbors of a peer
+// are given by their index in Peers().
+// DoTopologyPeering blocks until all connections are established or the ctx has expired.
+func (n *Network) DoTopologyPeering(ctx context.Context, neighbors [][]int) error {
+	if len(neighbors) != len(n.peers) {
+		return errors.Errorf("topology has %d nodes but the network has %d peers", len(neighbors), len(n.peers))
+	}
+
+	if err := n.addManualPeers(n.peers, neighbors); err != nil {
+		return errors.Wrap(err, "adding manual peers failed")
+	}
+	if err := n.waitForManualPeering(ctx, n.peers); err != nil {
+		return errors.Wrap(err, "manual peering failed")
+	}
+	return nil
+}
+
+// SetLatencies delays the traffic between the peers of the network as defined by the link latencies of the spec.
+// The peers are matched to the nodes of the spec by their index in Peers().
+func (n *Network) SetLatencies(ctx context.Context, spec *netspec.Spec) error {
+	if spec.Nodes != len(n.peers) {
+		return errors.Errorf("spec has %d nodes but the network has %d peers", spec.Nodes, len(n.peers))
+	}
+
+	ips := make([]string, len(n.peers))
+	for i, peer := range n.peers {
+		ip, err := peer.DockerContainer.IP(ctx, n.name)
+		if err != nil {
+			return errors.Wrap(err, "failed to get container's IP")
+		}
+		ips[i] = ip
+	}
+
+	log.Println("Setting link latencies...")
+	for i, peer := range n.peers {
+		latencies := make(map[string]netspec.Latency)
+		for other, latency := range spec.NodeLatencies(i) {
+			latencies[ips[other]] = latency
+		}
+		if len(latencies) == 0 {
+			continue
+		}
+
+		if err := n.createTrafficControl(ctx, peer, latencies); err != nil {
+			return err
+		}
+	}
+	log.Println("Setting link latencies... done")
+
+	return nil
+}
+
+// createTrafficControl creates a container that configures the given latencies in the network namespace of the node
+// and waits until it has finished.
+func (n *Network) createTrafficControl(ctx context.Context, effectedNode *Node, latencies map[string]netspec.Latency) error {
+	// nodes are connected to the default bridge and to the test network, so all devices are configured
+	script := fmt.Sprintf("for dev in $(ls /sys/class/net | grep -v '^lo$'); do %s || exit 1; done",
+		netspec.TrafficControlScript("$dev", latencies))
+
+	container := NewDockerContainer(n.docker)
+	if err := container.CreateTrafficControl(ctx, effectedNode.Name()+containerNameSuffixTC, effectedNode.Name(), script); err != nil {
+		return err
+	}
+	n.tcContainers = append(n.tcContainers, container)
+
+	if err := container.Start(ctx); err != nil {
+		return err
+	}
+	status, err := container.Wait(ctx)
+	if err != nil {
+		return err
+	}
+	if status != 0 {
+		return errors.Errorf("traffic control of %s exited with code %d", effectedNode.Name(), status)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/goshimmer/tools/docker-network/topology/netspec"
+	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/framework"
+	"github.com/iotaledger/goshimmer/tools/integration-tests/tester/tests"
+)
+
+// TestCommonTopology checks that a network that is created from a network spec only peers with the neighbors of the
+// topology and that messages are still relayed through the delayed links.
+func TestCommonTopology(t *testing.T) {
+	const numMessages = 20
+
+	spec, err := netspec.ParseSpec([]byte(`
+nodes: 5
+topology:
+  type: ring
+latency:
+  delay: 50ms
+  jitter: 10ms
+resources:
+  cpus: 1
+  memory: 1g
+`))
+	require.NoError(t, err)
+	snapshotInfo := tests.EqualSnapshotDetails
+
+	ctx, cancel := tests.Context(context.Background(), t)
+	defer cancel()
+	n, err := f.CreateNetworkFromSpec(ctx, t.Name(), spec, framework.CreateNetworkConfig{
+		StartSynced: true,
+		Snapshots:   []framework.SnapshotInfo{snapshotInfo},
+		PeerMaster:  true,
+	}, tests.CommonSnapshotConfigFunc(t, snapshotInfo))
+	require.NoError(t, err)
+	defer tests.ShutdownNetwork(ctx, t, n)
+
+	// every peer of the ring is only connected to its predecessor and its successor
+	for _, peer := range n.Peers() {
+		manualPeers, err := peer.GetManualPeers()
+		require.NoError(t, err)
+		assert.Lenf(t, manualPeers, 2, "peer %s has unexpected neighbors", peer)
+	}
+
+	ids := tests.SendDataMessages(t, n.Peers()[:1], numMessages)
+	tests.RequireMessagesAvailable(t, n.Peers(), ids, time.Minute, tests.Tick)
+	tests.RequireMessagesEqual(t, n.Peers(), ids)
+}
@@ -128,7 +128,7 @@ func TestManaPledgeFilter(t *testing.T) {
 		AccessManaPledgeID:    accessPeer.Identity.ID(),
 		ConsensusManaPledgeID: accessPeer.Identity.ID(),
 	})
-	require.Contains(t, err.Error(), webapiledgerstate.ErrNotAllowedToPledgeManaToNode.Error())
+	require.ErrorIs(t, err, webapiledgerstate.ErrNotAllowedToPledgeManaToNode)
 
 	// pledge access mana to forbidden peer
 	_, err = tests.SendTransaction(t, faucet, accessPeer, ledgerstate.ColorIOTA, tokensPerRequest, tests.TransactionConfig{
@@ -138,7 +138,7 @@ func TestManaPledgeFilter(t *testing.T) {
 		ConsensusManaPledgeID: consensusPeer.Identity.ID(),
 	})
 	require.ErrorIs(t, err, client.ErrBadRequest)
-	require.Contains(t, err.Error(), webapiledgerstate.ErrNotAllowedToPledgeManaToNode.Error())
+	require.ErrorIs(t, err, webapiledgerstate.ErrNotAllowedToPledgeManaToNode)
 }
 
 func TestManaApis(t *testing.T) {
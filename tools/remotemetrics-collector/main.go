@@ -0,0 +1,176 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/iotaledger/goshimmer/packages/event"
+	"github.com/iotaledger/goshimmer/packages/remotemetrics"
+	pb "github.com/iotaledger/goshimmer/packages/remotemetrics/remotemetricsproto"
+)
+
+const (
+	cfgBindAddress            = "bindAddress"
+	cfgForwardAddress         = "forwardAddress"
+	cfgHeartbeatCheckInterval = "heartbeatCheckInterval"
+)
+
+func init() {
+	flag.String(cfgBindAddress, "0.0.0.0:5214", "the address that the collector listens on for the metrics of the nodes")
+	flag.String(cfgForwardAddress, "", "the UDP address (e.g. of logstash) that the metrics are forwarded to as JSON documents (empty prints them to stdout)")
+	flag.Duration(cfgHeartbeatCheckInterval, 10*time.Second, "the interval in which the collector checks for nodes that stopped sending heartbeats")
+}
+
+func main() {
+	flag.Parse()
+	if err := viper.BindPFlags(flag.CommandLine); err != nil {
+		panic(err)
+	}
+
+	output, err := openOutput(viper.GetString(cfgForwardAddress))
+	if err != nil {
+		log.Fatalf("failed to open output: %s", err)
+	}
+	defer output.Close()
+
+	listener, err := net.Listen("tcp", viper.GetString(cfgBindAddress))
+	if err != nil {
+		log.Fatalf("failed to listen: %s", err)
+	}
+	log.Printf("collecting remote metrics (protocol version %d) on %s", remotemetrics.ProtocolVersion, listener.Addr())
+
+	collector := remotemetrics.NewCollector()
+	collector.Events.EnvelopeReceived.Attach(event.NewClosure(func(event *remotemetrics.EnvelopeReceivedEvent) {
+		if writeErr := writeDocument(output, envelopeDocument(event.Envelope)); writeErr != nil {
+			log.Printf("failed to write metrics of %s: %s", event.Envelope.NodeID, writeErr)
+		}
+	}))
+	collector.Events.EnvelopeRejected.Attach(event.NewClosure(func(event *remotemetrics.EnvelopeRejectedEvent) {
+		log.Printf("rejected envelope from %s: %s", event.Address, event.Error)
+	}))
+	collector.Events.NodeSilent.Attach(event.NewClosure(func(node *remotemetrics.NodeStatus) {
+		log.Printf("node %s (%s) stopped sending heartbeats at %s", node.NodeID, node.Address, node.LastHeartbeat)
+		if writeErr := writeDocument(output, nodeSilentDocument(node)); writeErr != nil {
+			log.Printf("failed to write silent node %s: %s", node.NodeID, writeErr)
+		}
+	}))
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	go func() {
+		ticker := time.NewTicker(viper.GetDuration(cfgHeartbeatCheckInterval))
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case now := <-ticker.C:
+				collector.CheckHeartbeats(now)
+			}
+		}
+	}()
+
+	if err = collector.Serve(ctx, listener); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// openOutput returns the writer that the JSON documents are written to.
+func openOutput(forwardAddress string) (io.WriteCloser, error) {
+	if forwardAddress == "" {
+		return os.Stdout, nil
+	}
+
+	return net.Dial("udp", forwardAddress)
+}
+
+// writeDocument writes the given document as a single JSON line, so that every UDP datagram contains one document.
+func writeDocument(output io.Writer, document map[string]interface{}) error {
+	documentBytes, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+	_, err = output.Write(append(documentBytes, '\n'))
+
+	return err
+}
+
+// envelopeDocument converts the given Envelope into a JSON document whose type matches the one of the JSON based
+// remote metrics, so that existing pipelines keep routing the metrics to the same indices.
+func envelopeDocument(envelope *pb.Envelope) map[string]interface{} {
+	recordType, record := envelopeRecord(envelope)
+
+	document := make(map[string]interface{})
+	if recordBytes, err := json.Marshal(record); err == nil {
+		_ = json.Unmarshal(recordBytes, &document)
+	}
+	document["type"] = recordType
+	document["version"] = envelope.Version
+	document["nodeID"] = envelope.NodeID
+	document["metricsLevel"] = envelope.MetricsLevel
+	document["timestamp"] = time.Unix(0, envelope.Timestamp).UTC()
+
+	return document
+}
+
+// envelopeRecord returns the type and the record of the metric group of the given Envelope.
+func envelopeRecord(envelope *pb.Envelope) (recordType string, record interface{}) {
+	switch group := envelope.Group.(type) {
+	case *pb.Envelope_Heartbeat:
+		return "heartbeat", group.Heartbeat
+	case *pb.Envelope_Sync:
+		return "sync", group.Sync
+	case *pb.Envelope_Drng:
+		return "drng", group.Drng
+	case *pb.Envelope_Scheduler:
+		return "schedulerSample", group.Scheduler
+	case *pb.Envelope_NetworkDelay:
+		return "networkdelay", group.NetworkDelay
+	case *pb.Envelope_Branch:
+		switch metric := group.Branch.Metric.(type) {
+		case *pb.BranchMetrics_Confirmation:
+			return "branchConfirmation", metric.Confirmation
+		case *pb.BranchMetrics_Counts:
+			return "branchCounts", metric.Counts
+		}
+	case *pb.Envelope_Message:
+		switch metric := group.Message.Metric.(type) {
+		case *pb.MessageMetrics_Scheduled:
+			return "messageScheduled", metric.Scheduled
+		case *pb.MessageMetrics_Discarded:
+			return "messageDiscarded", metric.Discarded
+		case *pb.MessageMetrics_Finalized:
+			return "messageFinalized", metric.Finalized
+		case *pb.MessageMetrics_Missing:
+			return "missingMessage", metric.Missing
+		case *pb.MessageMetrics_MissingStored:
+			return "missingMessageStored", metric.MissingStored
+		}
+	}
+
+	return "unknown", nil
+}
+
+// nodeSilentDocument returns the JSON document that reports a node that stopped sending heartbeats.
+func nodeSilentDocument(node *remotemetrics.NodeStatus) map[string]interface{} {
+	return map[string]interface{}{
+		"type":          "nodeSilent",
+		"nodeID":        node.NodeID,
+		"address":       node.Address,
+		"appVersion":    node.AppVersion,
+		"lastHeartbeat": node.LastHeartbeat.UTC(),
+		"timestamp":     time.Now().UTC(),
+	}
+}